@@ -48,6 +48,35 @@ func runBackend(cmd *cobra.Command, args []string) {
 	// Merge headers from environment variables (e.g., METRICS_PROVIDER_HEADERS)
 	cfg.MergeHeaders()
 
+	sessionDuration, err := cfg.Backend.GetSessionDuration()
+	if err != nil {
+		log.Fatalf("Invalid configuration: %v", err)
+	}
+	if _, err := cfg.Backend.GetStreamHeartbeatInterval(); err != nil {
+		log.Fatalf("Invalid configuration: %v", err)
+	}
+	if _, err := cfg.Backend.GetCleanupInterval(); err != nil {
+		log.Fatalf("Invalid configuration: %v", err)
+	}
+	if _, err := cfg.Backend.GetMaxCommentLength(); err != nil {
+		log.Fatalf("Invalid configuration: %v", err)
+	}
+	if _, _, _, err := cfg.Backend.LoginRateLimit.GetDurations(); err != nil {
+		log.Fatalf("Invalid configuration: %v", err)
+	}
+	if _, err := cfg.Backend.GetTrustedProxies(); err != nil {
+		log.Fatalf("Invalid configuration: %v", err)
+	}
+	if err := cfg.Backend.ValidateTLS(); err != nil {
+		log.Fatalf("Invalid configuration: %v", err)
+	}
+	if err := cfg.Backend.ValidateDefaultFilterPresets(); err != nil {
+		log.Fatalf("Invalid configuration: %v", err)
+	}
+	if _, _, _, err := cfg.Backend.Database.GetPoolSettings(); err != nil {
+		log.Fatalf("Invalid configuration: %v", err)
+	}
+
 	// Get database type from flag first, then fall back to config
 	dbType := viper.GetString("backend.database.type")
 	if dbType == "" {
@@ -56,9 +85,10 @@ func runBackend(cmd *cobra.Command, args []string) {
 
 	fmt.Println("🚀 Starting Notificator Backend Server...")
 	fmt.Printf("   Config file: %s\n", viper.ConfigFileUsed())
-	fmt.Printf("   gRPC Listen: %s\n", cfg.Backend.GRPCListen)
+	fmt.Printf("   gRPC Listen: %s (TLS: %t)\n", cfg.Backend.GRPCListen, cfg.Backend.TLSEnabled())
 	fmt.Printf("   HTTP Listen: %s\n", cfg.Backend.HTTPListen)
 	fmt.Printf("   Database: %s\n", dbType)
+	fmt.Printf("   Session Duration: %s\n", sessionDuration)
 
 	server := backend.NewServer(cfg, dbType)
 
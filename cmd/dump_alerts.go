@@ -0,0 +1,122 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"notificator/config"
+	"notificator/internal/alertmanager"
+	"notificator/internal/models"
+)
+
+var (
+	dumpAlerts bool
+	dumpFormat string
+)
+
+// dumpedAlert is the JSON shape printed by --dump-alerts
+type dumpedAlert struct {
+	models.Alert
+	Source string `json:"source"`
+}
+
+func init() {
+	rootCmd.PersistentFlags().BoolVar(&dumpAlerts, "dump-alerts", false, "fetch alerts from all configured Alertmanagers, print them and exit")
+	rootCmd.PersistentFlags().StringVar(&dumpFormat, "format", "json", "output format for --dump-alerts: json or table")
+
+	// Runs before any command (including the default desktop one), so
+	// `notificator --dump-alerts` never opens a window.
+	rootCmd.PersistentPreRun = func(cmd *cobra.Command, args []string) {
+		if dumpAlerts {
+			os.Exit(runDumpAlerts(dumpFormat))
+		}
+	}
+}
+
+// runDumpAlerts prints the combined alert list and returns the exit code.
+func runDumpAlerts(format string) int {
+	if format != "json" && format != "table" {
+		fmt.Fprintf(os.Stderr, "❌ Unsupported format %q (use json or table)\n", format)
+		return 1
+	}
+
+	// Keep stdout for the alert list only: config loading and the
+	// Alertmanager client log progress with fmt.Printf.
+	out := os.Stdout
+	os.Stdout = os.Stderr
+	defer func() { os.Stdout = out }()
+
+	cfg, err := config.LoadConfigWithViper()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "❌ Failed to load config: %v\n", err)
+		return 1
+	}
+
+	multiClient := alertmanager.NewMultiClient(cfg)
+	if len(multiClient.GetHealthyClients()) == 0 {
+		fmt.Fprintln(os.Stderr, "❌ No healthy Alertmanagers available")
+		return 1
+	}
+
+	alerts, err := multiClient.FetchAllAlerts()
+	if err != nil {
+		if len(alerts) == 0 {
+			fmt.Fprintf(os.Stderr, "❌ Failed to fetch alerts: %v\n", err)
+			return 1
+		}
+		fmt.Fprintf(os.Stderr, "⚠️  Some Alertmanagers failed: %v\n", err)
+	}
+
+	// Concurrent fetches return alerts in no particular order
+	sort.SliceStable(alerts, func(i, j int) bool {
+		if alerts[i].Source != alerts[j].Source {
+			return alerts[i].Source < alerts[j].Source
+		}
+		return alerts[i].Alert.StartsAt.After(alerts[j].Alert.StartsAt)
+	})
+
+	if format == "table" {
+		err = writeAlertsTable(out, alerts)
+	} else {
+		err = writeAlertsJSON(out, alerts)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "❌ Failed to write alerts: %v\n", err)
+		return 1
+	}
+
+	return 0
+}
+
+func writeAlertsJSON(w io.Writer, alerts []alertmanager.AlertWithSource) error {
+	dumped := make([]dumpedAlert, 0, len(alerts))
+	for _, alert := range alerts {
+		dumped = append(dumped, dumpedAlert{Alert: alert.Alert, Source: alert.Source})
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(dumped)
+}
+
+func writeAlertsTable(w io.Writer, alerts []alertmanager.AlertWithSource) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "SOURCE\tALERTNAME\tSEVERITY\tSTATE\tSTARTED\tSUMMARY")
+	for _, alert := range alerts {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\n",
+			alert.Source,
+			alert.Alert.GetAlertName(),
+			alert.Alert.GetSeverity(),
+			alert.Alert.Status.State,
+			alert.Alert.StartsAt.Format("2006-01-02 15:04:05"),
+			alert.Alert.GetSummary(),
+		)
+	}
+	return tw.Flush()
+}
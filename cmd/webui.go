@@ -34,6 +34,11 @@ func init() {
 	// Bind flags to viper
 	viper.BindPFlag("webui.listen", webuiCmd.Flags().Lookup("listen"))
 	viper.BindPFlag("webui.backend", webuiCmd.Flags().Lookup("backend"))
+
+	// Explicit flags win over env, which wins over the config file. The plain
+	// names are what docker-compose and the Helm chart set.
+	viper.BindEnv("webui.listen", "NOTIFICATOR_WEBUI_LISTEN", "WEBUI_LISTEN")
+	viper.BindEnv("webui.backend", "NOTIFICATOR_WEBUI_BACKEND", "NOTIFICATOR_BACKEND_ADDRESS", "BACKEND_ADDRESS")
 }
 
 func runWebUI(cmd *cobra.Command, args []string) {
@@ -41,9 +46,11 @@ func runWebUI(cmd *cobra.Command, args []string) {
 	listenAddr := viper.GetString("webui.listen")
 	backendAddr := viper.GetString("webui.backend")
 
-	// Override with environment variable if set
-	if envBackend := viper.GetString("backend_address"); envBackend != "" {
-		backendAddr = envBackend
+	if listenAddr == "" {
+		log.Fatal("WebUI listen address is empty (set --listen or WEBUI_LISTEN)")
+	}
+	if backendAddr == "" {
+		log.Fatal("Backend address is empty (set --backend or BACKEND_ADDRESS)")
 	}
 
 	fmt.Println("🌐 Starting Notificator WebUI Server...")
@@ -4,8 +4,11 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"net"
 	"os"
 	"path/filepath"
+	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
@@ -29,6 +32,7 @@ type Config struct {
 
 type AdminConfig struct {
 	ImpersonationAllowedUsers []string `json:"impersonation_allowed_users"`
+	BootstrapFirstUser        bool     `json:"bootstrap_first_user"` // Make the user registered into an empty users table an admin
 }
 
 // CanImpersonate checks if a user (by username or email) is allowed to impersonate others
@@ -47,6 +51,220 @@ type BackendConfig struct {
 	GRPCClient string         `json:"grpc_client"` // Address for gRPC client (e.g., "localhost:50051")
 	HTTPListen string         `json:"http_listen"` // Port for HTTP server (e.g., ":8080")
 	Database   DatabaseConfig `json:"database"`
+
+	SessionDuration string               `json:"session_duration"` // Go duration string for login sessions (default: "168h")
+	LoginRateLimit  LoginRateLimitConfig `json:"login_rate_limit"`
+
+	// Callers (the WebUI) allowed to pass the end user's address in
+	// x-forwarded-for, as IPs or CIDRs (default: DefaultTrustedProxies)
+	TrustedProxies []string `json:"trusted_proxies" mapstructure:"trusted_proxies"`
+
+	StreamHeartbeatInterval string `json:"stream_heartbeat_interval"` // How often alert update streams send a HEARTBEAT (default: "30s")
+	CleanupInterval         string `json:"cleanup_interval"`          // How often expired resolved alerts and sessions are purged (default: "1h")
+	MaxCommentLength        int    `json:"max_comment_length"`        // Longest comment accepted, in characters (default: 1000)
+
+	// gRPC TLS. The server uses TLS when both files are set, otherwise it stays plaintext.
+	TLSCertFile string              `json:"tls_cert_file"`
+	TLSKeyFile  string              `json:"tls_key_file"`
+	ClientTLS   GRPCClientTLSConfig `json:"client_tls"` // How clients dial grpc_client
+
+	// Shared filter presets created at startup when missing, owned by the system user
+	DefaultFilterPresets []DefaultFilterPresetConfig `json:"default_filter_presets" mapstructure:"default_filter_presets"`
+}
+
+// DefaultFilterPresetConfig describes an org-wide filter preset. FilterData
+// uses the same keys as presets saved from the dashboard (severities,
+// statuses, teams, search, ...).
+type DefaultFilterPresetConfig struct {
+	Name        string                 `json:"name" mapstructure:"name"`
+	Description string                 `json:"description" mapstructure:"description"`
+	FilterData  map[string]interface{} `json:"filter_data" mapstructure:"filter_data"`
+}
+
+// ValidateDefaultFilterPresets rejects presets without a name and duplicate
+// names, since seeded presets are matched by name on restart
+func (b BackendConfig) ValidateDefaultFilterPresets() error {
+	seen := make(map[string]bool, len(b.DefaultFilterPresets))
+	for i, preset := range b.DefaultFilterPresets {
+		if strings.TrimSpace(preset.Name) == "" {
+			return fmt.Errorf("backend.default_filter_presets[%d] must have a name", i)
+		}
+		if seen[preset.Name] {
+			return fmt.Errorf("backend.default_filter_presets has duplicate name %q", preset.Name)
+		}
+		seen[preset.Name] = true
+	}
+	return nil
+}
+
+// GRPCClientTLSConfig controls how clients such as the WebUI dial the backend
+type GRPCClientTLSConfig struct {
+	Enabled            bool   `json:"enabled"`
+	CAFile             string `json:"ca_file"`              // PEM CA bundle; system roots when empty
+	InsecureSkipVerify bool   `json:"insecure_skip_verify"` // Accept any server certificate (self-signed dev setups only)
+}
+
+// TLSEnabled reports whether the gRPC server should serve TLS
+func (b BackendConfig) TLSEnabled() bool {
+	return b.TLSCertFile != "" && b.TLSKeyFile != ""
+}
+
+// ValidateTLS rejects a certificate without a key and vice versa, which would
+// otherwise silently fall back to plaintext
+func (b BackendConfig) ValidateTLS() error {
+	if (b.TLSCertFile == "") != (b.TLSKeyFile == "") {
+		return fmt.Errorf("backend.tls_cert_file and backend.tls_key_file must be set together")
+	}
+	return nil
+}
+
+// LoginRateLimitConfig throttles failed logins per username and per client IP.
+// Durations are Go duration strings; MaxAttempts <= 0 disables the limit.
+type LoginRateLimitConfig struct {
+	MaxAttempts int    `json:"max_attempts"` // Failures within Window before a lockout (default: 5)
+	Window      string `json:"window"`       // Window failures are counted over (default: "15m")
+	Lockout     string `json:"lockout"`      // First lockout, doubled on each repeat (default: "1m")
+	MaxLockout  string `json:"max_lockout"`  // Upper bound for a lockout (default: "1h")
+}
+
+// GetDurations parses the window, lockout and max lockout, falling back to the
+// defaults for empty values
+func (l LoginRateLimitConfig) GetDurations() (window, lockout, maxLockout time.Duration, err error) {
+	parse := func(name, value string, fallback time.Duration) (time.Duration, error) {
+		if value == "" {
+			return fallback, nil
+		}
+		d, err := time.ParseDuration(value)
+		if err != nil {
+			return 0, fmt.Errorf("invalid backend.login_rate_limit.%s %q: %w", name, value, err)
+		}
+		if d <= 0 {
+			return 0, fmt.Errorf("backend.login_rate_limit.%s must be positive, got %q", name, value)
+		}
+		return d, nil
+	}
+
+	if window, err = parse("window", l.Window, 15*time.Minute); err != nil {
+		return
+	}
+	if lockout, err = parse("lockout", l.Lockout, time.Minute); err != nil {
+		return
+	}
+	maxLockout, err = parse("max_lockout", l.MaxLockout, time.Hour)
+	return
+}
+
+// DefaultSessionDuration is the session lifetime used when session_duration is unset
+const DefaultSessionDuration = 7 * 24 * time.Hour
+
+// GetSessionDuration parses SessionDuration, falling back to
+// DefaultSessionDuration when it is empty. Zero or negative durations are rejected.
+func (b BackendConfig) GetSessionDuration() (time.Duration, error) {
+	if b.SessionDuration == "" {
+		return DefaultSessionDuration, nil
+	}
+	duration, err := time.ParseDuration(b.SessionDuration)
+	if err != nil {
+		return 0, fmt.Errorf("invalid backend.session_duration %q: %w", b.SessionDuration, err)
+	}
+	if duration <= 0 {
+		return 0, fmt.Errorf("backend.session_duration must be positive, got %q", b.SessionDuration)
+	}
+	return duration, nil
+}
+
+// DefaultTrustedProxies are the loopback and private networks whose
+// forwarded client addresses are trusted when trusted_proxies is unset
+var DefaultTrustedProxies = []string{"127.0.0.0/8", "::1/128", "10.0.0.0/8", "172.16.0.0/12", "192.168.0.0/16", "fc00::/7"}
+
+// GetTrustedProxies parses TrustedProxies, falling back to DefaultTrustedProxies
+// when it is empty
+func (b BackendConfig) GetTrustedProxies() ([]*net.IPNet, error) {
+	return ParseTrustedProxies("backend.trusted_proxies", b.TrustedProxies)
+}
+
+// ParseTrustedProxies parses IPs and CIDRs, falling back to
+// DefaultTrustedProxies when proxies is empty
+func ParseTrustedProxies(name string, proxies []string) ([]*net.IPNet, error) {
+	if len(proxies) == 0 {
+		proxies = DefaultTrustedProxies
+	}
+	nets := make([]*net.IPNet, 0, len(proxies))
+	for _, proxy := range proxies {
+		if !strings.Contains(proxy, "/") {
+			ip := net.ParseIP(proxy)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid %s entry %q", name, proxy)
+			}
+			bits := 8 * net.IPv6len
+			if ip.To4() != nil {
+				ip, bits = ip.To4(), 8*net.IPv4len
+			}
+			nets = append(nets, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		_, ipNet, err := net.ParseCIDR(proxy)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s entry %q: %w", name, proxy, err)
+		}
+		nets = append(nets, ipNet)
+	}
+	return nets, nil
+}
+
+// DefaultStreamHeartbeatInterval is the keepalive period used when
+// stream_heartbeat_interval is unset
+const DefaultStreamHeartbeatInterval = 30 * time.Second
+
+// GetStreamHeartbeatInterval parses StreamHeartbeatInterval, falling back to
+// DefaultStreamHeartbeatInterval when it is empty. Zero or negative intervals are rejected.
+func (b BackendConfig) GetStreamHeartbeatInterval() (time.Duration, error) {
+	if b.StreamHeartbeatInterval == "" {
+		return DefaultStreamHeartbeatInterval, nil
+	}
+	interval, err := time.ParseDuration(b.StreamHeartbeatInterval)
+	if err != nil {
+		return 0, fmt.Errorf("invalid backend.stream_heartbeat_interval %q: %w", b.StreamHeartbeatInterval, err)
+	}
+	if interval <= 0 {
+		return 0, fmt.Errorf("backend.stream_heartbeat_interval must be positive, got %q", b.StreamHeartbeatInterval)
+	}
+	return interval, nil
+}
+
+// DefaultMaxCommentLength is the comment length limit used when
+// max_comment_length is unset
+const DefaultMaxCommentLength = 1000
+
+// GetMaxCommentLength returns MaxCommentLength, falling back to
+// DefaultMaxCommentLength when it is zero. Negative lengths are rejected.
+func (b BackendConfig) GetMaxCommentLength() (int, error) {
+	if b.MaxCommentLength == 0 {
+		return DefaultMaxCommentLength, nil
+	}
+	if b.MaxCommentLength < 0 {
+		return 0, fmt.Errorf("backend.max_comment_length must be positive, got %d", b.MaxCommentLength)
+	}
+	return b.MaxCommentLength, nil
+}
+
+// DefaultCleanupInterval is the expiry sweep period used when cleanup_interval is unset
+const DefaultCleanupInterval = time.Hour
+
+// GetCleanupInterval parses CleanupInterval, falling back to
+// DefaultCleanupInterval when it is empty. Zero or negative intervals are rejected.
+func (b BackendConfig) GetCleanupInterval() (time.Duration, error) {
+	if b.CleanupInterval == "" {
+		return DefaultCleanupInterval, nil
+	}
+	interval, err := time.ParseDuration(b.CleanupInterval)
+	if err != nil {
+		return 0, fmt.Errorf("invalid backend.cleanup_interval %q: %w", b.CleanupInterval, err)
+	}
+	if interval <= 0 {
+		return 0, fmt.Errorf("backend.cleanup_interval must be positive, got %q", b.CleanupInterval)
+	}
+	return interval, nil
 }
 
 type DatabaseConfig struct {
@@ -58,6 +276,48 @@ type DatabaseConfig struct {
 	Password   string `json:"password"`
 	SSLMode    string `json:"ssl_mode"`
 	SQLitePath string `json:"sqlite_path"`
+
+	// Connection pool of the underlying sql.DB; zero values use the defaults below
+	MaxOpenConns    int    `json:"max_open_conns"`    // default: 100
+	MaxIdleConns    int    `json:"max_idle_conns"`    // default: 10
+	ConnMaxLifetime string `json:"conn_max_lifetime"` // Go duration string (default: "1h")
+}
+
+// Connection pool defaults, sized for a single backend against Postgres'
+// default max_connections of 100
+const (
+	DefaultMaxOpenConns    = 100
+	DefaultMaxIdleConns    = 10
+	DefaultConnMaxLifetime = time.Hour
+)
+
+// GetPoolSettings returns the pool limits with defaults applied. Negative
+// connection counts and non-positive lifetimes are rejected.
+func (d DatabaseConfig) GetPoolSettings() (maxOpen, maxIdle int, maxLifetime time.Duration, err error) {
+	if d.MaxOpenConns < 0 || d.MaxIdleConns < 0 {
+		return 0, 0, 0, fmt.Errorf("backend.database.max_open_conns and max_idle_conns must not be negative")
+	}
+
+	maxOpen, maxIdle, maxLifetime = DefaultMaxOpenConns, DefaultMaxIdleConns, DefaultConnMaxLifetime
+	if d.MaxOpenConns > 0 {
+		maxOpen = d.MaxOpenConns
+	}
+	if d.MaxIdleConns > 0 {
+		maxIdle = d.MaxIdleConns
+	}
+	if maxIdle > maxOpen {
+		maxIdle = maxOpen
+	}
+	if d.ConnMaxLifetime != "" {
+		maxLifetime, err = time.ParseDuration(d.ConnMaxLifetime)
+		if err != nil {
+			return 0, 0, 0, fmt.Errorf("invalid backend.database.conn_max_lifetime %q: %w", d.ConnMaxLifetime, err)
+		}
+		if maxLifetime <= 0 {
+			return 0, 0, 0, fmt.Errorf("backend.database.conn_max_lifetime must be positive, got %q", d.ConnMaxLifetime)
+		}
+	}
+	return maxOpen, maxIdle, maxLifetime, nil
 }
 
 type ResolvedAlertsConfig struct {
@@ -78,6 +338,24 @@ type AlertmanagerConfig struct {
 	Token    string            `json:"token"`
 	Headers  map[string]string `json:"headers"`
 	OAuth    *OAuthConfig      `json:"oauth,omitempty"`
+	Proxy    *ProxyConfig      `json:"proxy,omitempty"`
+
+	Timeout    time.Duration `json:"timeout"`     // Per-request timeout (default: 10s)
+	MaxRetries int           `json:"max_retries"` // Retries with exponential backoff for failed reads (default: 2)
+}
+
+const (
+	DefaultAlertmanagerTimeout    = 10 * time.Second
+	DefaultAlertmanagerMaxRetries = 2
+)
+
+// ProxyConfig configures a per-Alertmanager HTTP proxy, used instead of the
+// HTTP(S)_PROXY environment variables for that client only.
+type ProxyConfig struct {
+	URL      string   `json:"url"`
+	Username string   `json:"username,omitempty"`
+	Password string   `json:"password,omitempty"`
+	NoProxy  []string `json:"no_proxy,omitempty"` // NO_PROXY-style exceptions: hosts, ".domain" suffixes, CIDRs, "*"
 }
 
 type OAuthConfig struct {
@@ -107,16 +385,59 @@ type FilterStateConfig struct {
 }
 
 type NotificationConfig struct {
-	Enabled           bool            `json:"enabled"`
-	SoundEnabled      bool            `json:"sound_enabled"`
-	SoundPath         string          `json:"sound_path"`
-	AudioOutputDevice string          `json:"audio_output_device"`
-	ShowSystem        bool            `json:"show_system"`
-	CriticalOnly      bool            `json:"critical_only"`
-	MaxNotifications  int             `json:"max_notifications"`
-	CooldownSeconds   int             `json:"cooldown_seconds"`
-	SeverityRules     map[string]bool `json:"severity_rules"`
-	RespectFilters    bool            `json:"respect_filters"`
+	Enabled      bool   `json:"enabled"`
+	SoundEnabled bool   `json:"sound_enabled"`
+	SoundPath    string `json:"sound_path"`
+	// SeveritySounds maps a severity to its own sound file; severities not
+	// listed use SoundPath
+	SeveritySounds    map[string]string `json:"severity_sounds"`
+	AudioOutputDevice string            `json:"audio_output_device"`
+	ShowSystem        bool              `json:"show_system"`
+	CriticalOnly      bool              `json:"critical_only"`
+	MaxNotifications  int               `json:"max_notifications"`
+	CooldownSeconds   int               `json:"cooldown_seconds"`
+	// FingerprintCooldownSeconds suppresses repeat notifications for the same
+	// alert fingerprint while it keeps firing; cleared when the alert resolves
+	FingerprintCooldownSeconds int             `json:"fingerprint_cooldown_seconds"`
+	SeverityRules              map[string]bool `json:"severity_rules"`
+	RespectFilters             bool            `json:"respect_filters"`
+	Slack                      SlackConfig     `json:"slack"`
+	Email                      EmailConfig     `json:"email"`
+	PagerDuty                  PagerDutyConfig `json:"pagerduty"`
+}
+
+// SlackConfig posts notified alerts to a Slack incoming webhook
+type SlackConfig struct {
+	Enabled    bool   `json:"enabled"`
+	WebhookURL string `json:"webhook_url"`
+	Channel    string `json:"channel"`
+	// MinSeverity is the lowest severity posted (default "critical")
+	MinSeverity string `json:"min_severity"`
+}
+
+// EmailConfig sends digest emails of new critical alerts over SMTP
+type EmailConfig struct {
+	Enabled bool   `json:"enabled"`
+	Host    string `json:"host"`
+	Port    int    `json:"port"`
+	// TLS uses implicit TLS; otherwise STARTTLS is used when offered
+	TLS      bool     `json:"tls"`
+	Username string   `json:"username"`
+	Password string   `json:"password"`
+	From     string   `json:"from"`
+	To       []string `json:"to"`
+	// BatchWindowSeconds groups alerts firing within the window into one email
+	BatchWindowSeconds int `json:"batch_window_seconds"`
+}
+
+// PagerDutyConfig triggers and resolves PagerDuty incidents for critical alerts
+// through the Events API v2; skipped when RoutingKey is empty
+type PagerDutyConfig struct {
+	Enabled    bool   `json:"enabled"`
+	RoutingKey string `json:"routing_key"`
+	// SeverityMap maps alert severities to PagerDuty severities
+	SeverityMap map[string]string `json:"severity_map"`
+	EventsURL   string            `json:"events_url"`
 }
 
 type PollingConfig struct {
@@ -125,13 +446,43 @@ type PollingConfig struct {
 }
 
 type WebUIConfig struct {
-	Playground bool `json:"playground"`
+	Playground         bool           `json:"playground"`
+	SummaryAnnotations []string       `json:"summary_annotations"` // Annotation keys tried in order for the alert summary (default: ["summary"])
+	Priority           PriorityConfig `json:"priority"`
+
+	// ExternalURL is where users reach the WebUI (e.g. "https://notificator.example.com"),
+	// used to build links posted outside of it
+	ExternalURL string `json:"external_url" mapstructure:"external_url"`
+
+	// Reverse proxies in front of the WebUI whose X-Forwarded-For is trusted,
+	// as IPs or CIDRs (default: DefaultTrustedProxies)
+	TrustedProxies []string `json:"trusted_proxies" mapstructure:"trusted_proxies"`
+
+	// SeverityColors maps a severity to the "#rrggbb" color of its badges and
+	// rows, e.g. {"page": "#dc2626", "ticket": "#0891b2"}. Unmapped severities
+	// keep the built-in colors.
+	SeverityColors map[string]string `json:"severity_colors"`
+}
+
+// PriorityConfig tunes the priority score shown in the dashboard's Priority column
+type PriorityConfig struct {
+	SeverityWeights     map[string]float64            `json:"severity_weights"`     // Base score per severity
+	PerHour             float64                       `json:"per_hour"`             // Added per hour firing
+	MaxHours            float64                       `json:"max_hours"`            // Cap on the duration contribution (0 = no cap)
+	AcknowledgedPenalty float64                       `json:"acknowledged_penalty"` // Subtracted once acknowledged
+	LabelWeights        map[string]map[string]float64 `json:"label_weights"`        // Bonus per label value, e.g. {"env": {"production": 30}}
 }
 
 type SentryConfig struct {
 	Enabled     bool   `json:"enabled"`
 	BaseURL     string `json:"base_url"`     // Default Sentry instance URL (e.g., "https://sentry.io")
 	GlobalToken string `json:"global_token"` // Admin-configured fallback token
+
+	// Alerts without a sentry URL are matched to a project in Organization
+	// through the first of ProjectLabels they carry; unset Organization
+	// disables the lookup
+	Organization  string   `json:"organization"`
+	ProjectLabels []string `json:"project_labels"`
 }
 
 func DefaultConfig() *Config {
@@ -145,10 +496,12 @@ func DefaultConfig() *Config {
 	return &Config{
 		Alertmanagers: []AlertmanagerConfig{
 			{
-				Name:    "Default",
-				URL:     "http://localhost:9093",
-				Headers: headers,
-				OAuth:   oauthConfig,
+				Name:       "Default",
+				URL:        "http://localhost:9093",
+				Headers:    headers,
+				OAuth:      oauthConfig,
+				Timeout:    DefaultAlertmanagerTimeout,
+				MaxRetries: DefaultAlertmanagerMaxRetries,
 			},
 		},
 		GUI: GUIConfig{
@@ -170,14 +523,15 @@ func DefaultConfig() *Config {
 			},
 		},
 		Notifications: NotificationConfig{
-			Enabled:           true,
-			SoundEnabled:      true,
-			SoundPath:         getDefaultSoundPath(),
-			AudioOutputDevice: "default",
-			ShowSystem:        true,
-			CriticalOnly:      false,
-			MaxNotifications:  5,
-			CooldownSeconds:   300, // 5 minutes
+			Enabled:                    true,
+			SoundEnabled:               true,
+			SoundPath:                  getDefaultSoundPath(),
+			AudioOutputDevice:          "default",
+			ShowSystem:                 true,
+			CriticalOnly:               false,
+			MaxNotifications:           5,
+			CooldownSeconds:            300,  // 5 minutes
+			FingerprintCooldownSeconds: 3600, // 1 hour
 			SeverityRules: map[string]bool{
 				"critical": true,
 				"warning":  true,
@@ -204,6 +558,20 @@ func DefaultConfig() *Config {
 				User:       "notificator",
 				Password:   "",
 				SSLMode:    "disable",
+
+				MaxOpenConns:    DefaultMaxOpenConns,
+				MaxIdleConns:    DefaultMaxIdleConns,
+				ConnMaxLifetime: DefaultConnMaxLifetime.String(),
+			},
+			SessionDuration:         DefaultSessionDuration.String(),
+			StreamHeartbeatInterval: DefaultStreamHeartbeatInterval.String(),
+			CleanupInterval:         DefaultCleanupInterval.String(),
+			MaxCommentLength:        DefaultMaxCommentLength,
+			LoginRateLimit: LoginRateLimitConfig{
+				MaxAttempts: 5,
+				Window:      "15m",
+				Lockout:     "1m",
+				MaxLockout:  "1h",
 			},
 		},
 		ResolvedAlerts: ResolvedAlertsConfig{
@@ -215,7 +583,18 @@ func DefaultConfig() *Config {
 			RetentionDays: 90, // Keep alert statistics for 90 days by default
 		},
 		WebUI: WebUIConfig{
-			Playground: false, // Playground mode disabled by default
+			Playground:         false, // Playground mode disabled by default
+			SummaryAnnotations: []string{"summary"},
+			Priority: PriorityConfig{
+				SeverityWeights: map[string]float64{
+					"critical": 100,
+					"warning":  50,
+					"info":     10,
+				},
+				PerHour:             2,
+				MaxHours:            24,
+				AcknowledgedPenalty: 60,
+			},
 		},
 
 		// OAuth is disabled by default - must be explicitly configured
@@ -227,6 +606,17 @@ func getDefaultSoundPath() string {
 	return ""
 }
 
+// validateSeveritySounds drops severity sounds whose file does not exist so a
+// typo falls back to the default sound instead of failing startup
+func validateSeveritySounds(cfg *NotificationConfig) {
+	for severity, path := range cfg.SeveritySounds {
+		if _, err := os.Stat(path); err != nil {
+			log.Printf("Warning: sound file %q for severity %q is not usable (%v), using the default sound", path, severity, err)
+			delete(cfg.SeveritySounds, severity)
+		}
+	}
+}
+
 func (c *Config) SaveToFile(configPath string) error {
 	dir := filepath.Dir(configPath)
 	if err := os.MkdirAll(dir, 0755); err != nil {
@@ -276,6 +666,17 @@ func LoadConfigWithViper() (*Config, error) {
 				Password: viper.GetString(prefix + ".password"),
 				Token:    viper.GetString(prefix + ".token"),
 				Headers:  make(map[string]string),
+
+				Timeout:    DefaultAlertmanagerTimeout,
+				MaxRetries: DefaultAlertmanagerMaxRetries,
+			}
+
+			// Handle timeout/retry overrides, e.g. NOTIFICATOR_ALERTMANAGERS_0_TIMEOUT=30s
+			if timeout := viper.GetDuration(prefix + ".timeout"); timeout > 0 {
+				am.Timeout = timeout
+			}
+			if viper.IsSet(prefix + ".max_retries") {
+				am.MaxRetries = max(viper.GetInt(prefix+".max_retries"), 0)
 			}
 
 			// Handle OAuth config
@@ -286,6 +687,16 @@ func LoadConfigWithViper() (*Config, error) {
 				}
 			}
 
+			// Handle proxy config
+			if proxyURL := viper.GetString(prefix + ".proxy.url"); proxyURL != "" {
+				am.Proxy = &ProxyConfig{
+					URL:      proxyURL,
+					Username: viper.GetString(prefix + ".proxy.username"),
+					Password: viper.GetString(prefix + ".proxy.password"),
+					NoProxy:  ParseNoProxy(viper.GetStringSlice(prefix + ".proxy.no_proxy")),
+				}
+			}
+
 			// Load headers from environment variables
 			// Format: NOTIFICATOR_ALERTMANAGERS_0_HEADERS="X-Scope-OrgID=tenant1,X-Custom=value"
 			headersEnvVar := fmt.Sprintf("NOTIFICATOR_ALERTMANAGERS_%d_HEADERS", i)
@@ -321,8 +732,22 @@ func LoadConfigWithViper() (*Config, error) {
 		}
 	}
 
+	validateSeveritySounds(&cfg.Notifications)
+
 	initializeFilterStates(cfg)
 
+	// Summary annotation fallbacks, e.g. NOTIFICATOR_WEBUI_SUMMARY_ANNOTATIONS="summary,message,description"
+	if keys := parseCommaList(viper.GetStringSlice("webui.summary_annotations")); len(keys) > 0 {
+		cfg.WebUI.SummaryAnnotations = keys
+	}
+
+	// Trusted proxies, e.g. NOTIFICATOR_BACKEND_TRUSTED_PROXIES="10.0.0.0/8,192.168.1.10"
+	cfg.Backend.TrustedProxies = parseCommaList(viper.GetStringSlice("backend.trusted_proxies"))
+	cfg.WebUI.TrustedProxies = parseCommaList(viper.GetStringSlice("webui.trusted_proxies"))
+
+	loadPriorityConfig(&cfg.WebUI.Priority)
+	cfg.WebUI.SeverityColors = parseSeverityColors(viper.Get("webui.severity_colors"))
+
 	// Load Admin impersonation allowed users from environment variable (comma-separated)
 	if adminUsersEnv := os.Getenv("NOTIFICATOR_ADMIN_IMPERSONATION_ALLOWED_USERS"); adminUsersEnv != "" {
 		users := strings.Split(adminUsersEnv, ",")
@@ -341,6 +766,9 @@ func LoadConfigWithViper() (*Config, error) {
 			Enabled:     true,
 			BaseURL:     viper.GetString("sentry.base_url"),
 			GlobalToken: viper.GetString("sentry.global_token"),
+
+			Organization:  viper.GetString("sentry.organization"),
+			ProjectLabels: parseCommaList(viper.GetStringSlice("sentry.project_labels")),
 		}
 		log.Printf("DEBUG: Sentry config loaded - enabled: %v, base_url: %v", cfg.Sentry.Enabled, cfg.Sentry.BaseURL)
 	}
@@ -415,6 +843,19 @@ func setViperDefaults(cfg *Config) {
 	viper.SetDefault("backend.grpc_listen", cfg.Backend.GRPCListen)
 	viper.SetDefault("backend.grpc_client", cfg.Backend.GRPCClient)
 	viper.SetDefault("backend.http_listen", cfg.Backend.HTTPListen)
+	viper.SetDefault("backend.session_duration", cfg.Backend.SessionDuration)
+	viper.SetDefault("backend.stream_heartbeat_interval", cfg.Backend.StreamHeartbeatInterval)
+	viper.SetDefault("backend.cleanup_interval", cfg.Backend.CleanupInterval)
+	viper.SetDefault("backend.max_comment_length", cfg.Backend.MaxCommentLength)
+	viper.SetDefault("backend.login_rate_limit.max_attempts", cfg.Backend.LoginRateLimit.MaxAttempts)
+	viper.SetDefault("backend.login_rate_limit.window", cfg.Backend.LoginRateLimit.Window)
+	viper.SetDefault("backend.login_rate_limit.lockout", cfg.Backend.LoginRateLimit.Lockout)
+	viper.SetDefault("backend.login_rate_limit.max_lockout", cfg.Backend.LoginRateLimit.MaxLockout)
+	viper.SetDefault("backend.tls_cert_file", cfg.Backend.TLSCertFile)
+	viper.SetDefault("backend.tls_key_file", cfg.Backend.TLSKeyFile)
+	viper.SetDefault("backend.client_tls.enabled", cfg.Backend.ClientTLS.Enabled)
+	viper.SetDefault("backend.client_tls.ca_file", cfg.Backend.ClientTLS.CAFile)
+	viper.SetDefault("backend.client_tls.insecure_skip_verify", cfg.Backend.ClientTLS.InsecureSkipVerify)
 
 	// Database defaults - only set if not already configured from config file or env vars
 	// IMPORTANT: Don't set database.type default - let it come from config file
@@ -442,6 +883,9 @@ func setViperDefaults(cfg *Config) {
 	if !viper.IsSet("backend.database.sqlite_path") {
 		viper.SetDefault("backend.database.sqlite_path", cfg.Backend.Database.SQLitePath)
 	}
+	viper.SetDefault("backend.database.max_open_conns", cfg.Backend.Database.MaxOpenConns)
+	viper.SetDefault("backend.database.max_idle_conns", cfg.Backend.Database.MaxIdleConns)
+	viper.SetDefault("backend.database.conn_max_lifetime", cfg.Backend.Database.ConnMaxLifetime)
 
 	// GUI defaults - only set if not already configured from config file or env vars
 	if !viper.IsSet("gui.width") {
@@ -491,6 +935,9 @@ func setViperDefaults(cfg *Config) {
 	if !viper.IsSet("notifications.cooldown_seconds") {
 		viper.SetDefault("notifications.cooldown_seconds", cfg.Notifications.CooldownSeconds)
 	}
+	if !viper.IsSet("notifications.fingerprint_cooldown_seconds") {
+		viper.SetDefault("notifications.fingerprint_cooldown_seconds", cfg.Notifications.FingerprintCooldownSeconds)
+	}
 	if !viper.IsSet("notifications.respect_filters") {
 		viper.SetDefault("notifications.respect_filters", cfg.Notifications.RespectFilters)
 	}
@@ -537,6 +984,8 @@ func setViperDefaults(cfg *Config) {
 	viper.SetDefault("sentry.enabled", false)
 	viper.SetDefault("sentry.base_url", "https://sentry.io")
 	viper.SetDefault("sentry.global_token", "")
+	viper.SetDefault("sentry.organization", "")
+	viper.SetDefault("sentry.project_labels", []string{"sentry_project", "project", "service"})
 
 	// Group sync defaults
 	viper.SetDefault("oauth.group_sync.enabled", oauthDefaults.GroupSync.Enabled)
@@ -559,12 +1008,16 @@ func setViperDefaults(cfg *Config) {
 	viper.BindEnv("sentry.enabled", "NOTIFICATOR_SENTRY_ENABLED")
 	viper.BindEnv("sentry.base_url", "NOTIFICATOR_SENTRY_BASE_URL")
 	viper.BindEnv("sentry.global_token", "NOTIFICATOR_SENTRY_GLOBAL_TOKEN")
+	viper.BindEnv("sentry.organization", "NOTIFICATOR_SENTRY_ORGANIZATION")
+	viper.BindEnv("sentry.project_labels", "NOTIFICATOR_SENTRY_PROJECT_LABELS")
 
 	// Admin defaults
 	viper.SetDefault("admin.impersonation_allowed_users", []string{})
+	viper.SetDefault("admin.bootstrap_first_user", cfg.Admin.BootstrapFirstUser)
 
 	// Admin environment variable bindings
 	viper.BindEnv("admin.impersonation_allowed_users", "NOTIFICATOR_ADMIN_IMPERSONATION_ALLOWED_USERS")
+	viper.BindEnv("admin.bootstrap_first_user", "NOTIFICATOR_ADMIN_BOOTSTRAP_FIRST_USER")
 
 	// Alertmanager defaults - DISABLED to allow JSON config to work properly
 	// The alertmanager configuration should come from the config file, not defaults
@@ -622,6 +1075,15 @@ func setViperDefaults(cfg *Config) {
 
 	// WebUI environment variable bindings
 	viper.BindEnv("webui.playground", "WEBUI_PLAYGROUND", "NOTIFICATOR_WEBUI_PLAYGROUND")
+	viper.BindEnv("webui.summary_annotations", "NOTIFICATOR_WEBUI_SUMMARY_ANNOTATIONS")
+	viper.BindEnv("webui.trusted_proxies", "NOTIFICATOR_WEBUI_TRUSTED_PROXIES")
+	viper.BindEnv("webui.external_url", "NOTIFICATOR_WEBUI_EXTERNAL_URL")
+	viper.BindEnv("webui.severity_colors", "NOTIFICATOR_WEBUI_SEVERITY_COLORS")
+	viper.BindEnv("webui.priority.severity_weights", "NOTIFICATOR_WEBUI_PRIORITY_SEVERITY_WEIGHTS")
+	viper.BindEnv("webui.priority.per_hour", "NOTIFICATOR_WEBUI_PRIORITY_PER_HOUR")
+	viper.BindEnv("webui.priority.max_hours", "NOTIFICATOR_WEBUI_PRIORITY_MAX_HOURS")
+	viper.BindEnv("webui.priority.acknowledged_penalty", "NOTIFICATOR_WEBUI_PRIORITY_ACKNOWLEDGED_PENALTY")
+	viper.BindEnv("webui.priority.label_weights", "NOTIFICATOR_WEBUI_PRIORITY_LABEL_WEIGHTS")
 
 	// OAuth environment variable bindings
 	// Support both OAUTH_* and NOTIFICATOR_OAUTH_* patterns for flexibility
@@ -792,3 +1254,134 @@ func (c *Config) ValidateAlertmanagers() error {
 
 	return nil
 }
+
+// ParseNoProxy normalizes NO_PROXY-style entries, accepting both list values
+// and comma-separated strings (as set through environment variables).
+func ParseNoProxy(entries []string) []string {
+	return parseCommaList(entries)
+}
+
+func parseCommaList(entries []string) []string {
+	var result []string
+	for _, entry := range entries {
+		for _, part := range strings.Split(entry, ",") {
+			if trimmed := strings.TrimSpace(part); trimmed != "" {
+				result = append(result, trimmed)
+			}
+		}
+	}
+	return result
+}
+
+// loadPriorityConfig reads the priority weights explicitly: the keys are
+// snake_case and the maps can come either from the config file or from
+// environment variables such as
+// NOTIFICATOR_WEBUI_PRIORITY_SEVERITY_WEIGHTS="critical:100,warning:50" and
+// NOTIFICATOR_WEBUI_PRIORITY_LABEL_WEIGHTS="env=production:30,team=sre:10".
+func loadPriorityConfig(cfg *PriorityConfig) {
+	if weights := parseWeights(viper.Get("webui.priority.severity_weights")); len(weights) > 0 {
+		cfg.SeverityWeights = make(map[string]float64, len(weights))
+		for severity, weight := range weights {
+			cfg.SeverityWeights[strings.ToLower(severity)] = weight
+		}
+	}
+	if viper.IsSet("webui.priority.per_hour") {
+		cfg.PerHour = viper.GetFloat64("webui.priority.per_hour")
+	}
+	if viper.IsSet("webui.priority.max_hours") {
+		cfg.MaxHours = viper.GetFloat64("webui.priority.max_hours")
+	}
+	if viper.IsSet("webui.priority.acknowledged_penalty") {
+		cfg.AcknowledgedPenalty = viper.GetFloat64("webui.priority.acknowledged_penalty")
+	}
+
+	switch labels := viper.Get("webui.priority.label_weights").(type) {
+	case map[string]interface{}:
+		cfg.LabelWeights = make(map[string]map[string]float64, len(labels))
+		for label, values := range labels {
+			if weights := parseWeights(values); len(weights) > 0 {
+				cfg.LabelWeights[label] = weights
+			}
+		}
+	case string:
+		cfg.LabelWeights = make(map[string]map[string]float64)
+		for key, weight := range parseWeights(labels) {
+			label, value, ok := strings.Cut(key, "=")
+			if !ok {
+				continue
+			}
+			if cfg.LabelWeights[label] == nil {
+				cfg.LabelWeights[label] = make(map[string]float64)
+			}
+			cfg.LabelWeights[label][value] = weight
+		}
+	}
+}
+
+var hexColorPattern = regexp.MustCompile(`^#([0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`)
+
+// parseSeverityColors accepts a map from the config file or a
+// "severity:#color,..." string. Severities are lowercased and colors
+// normalized to "#rrggbb"; invalid colors are skipped with a warning.
+func parseSeverityColors(raw interface{}) map[string]string {
+	entries := make(map[string]string)
+
+	switch value := raw.(type) {
+	case map[string]interface{}:
+		for severity, color := range value {
+			entries[severity] = fmt.Sprint(color)
+		}
+	case map[string]string:
+		for severity, color := range value {
+			entries[severity] = color
+		}
+	case string:
+		for _, entry := range parseCommaList([]string{value}) {
+			if severity, color, ok := strings.Cut(entry, ":"); ok {
+				entries[severity] = color
+			}
+		}
+	}
+
+	colors := make(map[string]string, len(entries))
+	for severity, color := range entries {
+		severity = strings.ToLower(strings.TrimSpace(severity))
+		color = strings.ToLower(strings.TrimSpace(color))
+		if severity == "" || !hexColorPattern.MatchString(color) {
+			log.Printf("Warning: ignoring severity color %q for %q, expected #rgb or #rrggbb", color, severity)
+			continue
+		}
+		if len(color) == 4 {
+			color = string([]byte{'#', color[1], color[1], color[2], color[2], color[3], color[3]})
+		}
+		colors[severity] = color
+	}
+
+	return colors
+}
+
+// parseWeights accepts a map from the config file or a "key:weight,..." string
+func parseWeights(raw interface{}) map[string]float64 {
+	weights := make(map[string]float64)
+
+	switch value := raw.(type) {
+	case map[string]interface{}:
+		for key, weight := range value {
+			if parsed, err := strconv.ParseFloat(fmt.Sprint(weight), 64); err == nil {
+				weights[key] = parsed
+			}
+		}
+	case string:
+		for _, entry := range parseCommaList([]string{value}) {
+			key, weight, ok := strings.Cut(entry, ":")
+			if !ok {
+				continue
+			}
+			if parsed, err := strconv.ParseFloat(strings.TrimSpace(weight), 64); err == nil {
+				weights[strings.TrimSpace(key)] = parsed
+			}
+		}
+	}
+
+	return weights
+}
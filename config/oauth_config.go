@@ -45,6 +45,13 @@ type GroupSyncConfig struct {
 	DefaultRole    string        `json:"default_role" mapstructure:"default_role"`
 	ValidateGroups bool          `json:"validate_groups" mapstructure:"validate_groups"`
 	AuditChanges   bool          `json:"audit_changes" mapstructure:"audit_changes"`
+	// SyncInterval is how often the backend refreshes the groups of every
+	// OAuth user with a stored token; zero disables the periodic sync
+	SyncInterval time.Duration `json:"sync_interval" mapstructure:"sync_interval"`
+	// Permissions maps a group name to the permissions its members hold, such
+	// as "silence:create" or "ack:delete". Actions are only checked against
+	// groups once at least one group is listed.
+	Permissions map[string][]string `json:"permissions" mapstructure:"permissions"`
 }
 
 type OAuthSecurityConfig struct {
@@ -73,6 +80,7 @@ func DefaultOAuthConfig() *OAuthPortalConfig {
 			DefaultRole:    "viewer",
 			ValidateGroups: true,
 			AuditChanges:   false,
+			SyncInterval:   time.Hour,
 		},
 		Security: OAuthSecurityConfig{
 			StateTimeout:    10 * time.Minute,
@@ -128,6 +136,7 @@ func setOAuthViperDefaults(cfg *OAuthPortalConfig) {
 	viper.SetDefault("oauth.group_sync.default_role", cfg.GroupSync.DefaultRole)
 	viper.SetDefault("oauth.group_sync.validate_groups", cfg.GroupSync.ValidateGroups)
 	viper.SetDefault("oauth.group_sync.audit_changes", cfg.GroupSync.AuditChanges)
+	viper.SetDefault("oauth.group_sync.sync_interval", cfg.GroupSync.SyncInterval)
 
 	// Security defaults
 	viper.SetDefault("oauth.security.state_timeout", cfg.Security.StateTimeout)
@@ -155,6 +164,7 @@ func bindOAuthEnvironmentVariables() {
 	viper.BindEnv("oauth.group_sync.default_role", "OAUTH_DEFAULT_ROLE")
 	viper.BindEnv("oauth.group_sync.validate_groups", "OAUTH_VALIDATE_GROUPS")
 	viper.BindEnv("oauth.group_sync.audit_changes", "OAUTH_AUDIT_CHANGES")
+	viper.BindEnv("oauth.group_sync.sync_interval", "OAUTH_GROUP_SYNC_INTERVAL")
 
 	// Security settings
 	viper.BindEnv("oauth.security.state_timeout", "OAUTH_STATE_TIMEOUT")
@@ -3,9 +3,12 @@ package alertmanager
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -13,6 +16,15 @@ import (
 	"notificator/config"
 	"notificator/internal/auth"
 	"notificator/internal/models"
+
+	"golang.org/x/net/http/httpproxy"
+)
+
+var (
+	// ErrSilenceNotFound is returned when the Alertmanager does not know the silence
+	ErrSilenceNotFound = errors.New("silence not found")
+	// ErrSilenceAlreadyExpired is returned when expiring a silence that has already expired
+	ErrSilenceAlreadyExpired = errors.New("silence already expired")
 )
 
 type customHeaderRoundTripper struct {
@@ -30,11 +42,16 @@ func (c *customHeaderRoundTripper) RoundTrip(req *http.Request) (*http.Response,
 	return c.rt.RoundTrip(req)
 }
 
+// retryBaseDelay is the backoff before the first retry; it doubles on each
+// further attempt.
+var retryBaseDelay = 500 * time.Millisecond
+
 type Client struct {
 	Name       string
 	BaseURL    string
 	HTTPClient *http.Client
 	Timeout    time.Duration
+	MaxRetries int // Retries for failed read requests (transport errors and 5xx)
 
 	Username string
 	Password string
@@ -48,6 +65,9 @@ type Client struct {
 type MultiClient struct {
 	clients map[string]*Client
 	mutex   sync.RWMutex
+
+	health   map[string]*SourceHealth // name -> fetch history, see health.go
+	healthMu sync.Mutex
 }
 
 func NewClient(baseURL string) *Client {
@@ -289,7 +309,7 @@ func NewMultiClient(cfg *config.Config) *MultiClient {
 }
 
 func NewClientFromConfig(amConfig config.AlertmanagerConfig) *Client {
-	return NewClientWithConfig(
+	client := NewClientWithConfig(
 		amConfig.URL,
 		amConfig.Username,
 		amConfig.Password,
@@ -297,6 +317,61 @@ func NewClientFromConfig(amConfig config.AlertmanagerConfig) *Client {
 		amConfig.Headers,
 		amConfig.Name,
 	)
+
+	if amConfig.Proxy != nil && amConfig.Proxy.URL != "" {
+		if err := client.SetProxy(amConfig.Proxy); err != nil {
+			fmt.Printf("Warning: ignoring proxy for alertmanager '%s': %v\n", amConfig.Name, err)
+		}
+	}
+
+	client.SetTimeout(amConfig.Timeout)
+	if amConfig.MaxRetries > 0 {
+		client.MaxRetries = amConfig.MaxRetries
+	}
+
+	return client
+}
+
+// SetProxy routes this client's requests through the given proxy instead of
+// the HTTP(S)_PROXY environment variables. Hosts matching NoProxy are
+// reached directly.
+func (c *Client) SetProxy(proxyCfg *config.ProxyConfig) error {
+	transport, err := newProxyTransport(proxyCfg)
+	if err != nil {
+		return err
+	}
+
+	if headerRT, ok := c.HTTPClient.Transport.(*customHeaderRoundTripper); ok {
+		headerRT.rt = transport
+	} else {
+		c.HTTPClient.Transport = transport
+	}
+	return nil
+}
+
+func newProxyTransport(proxyCfg *config.ProxyConfig) (*http.Transport, error) {
+	proxyURL, err := url.Parse(proxyCfg.URL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid proxy URL: %w", err)
+	}
+	if proxyURL.Scheme == "" || proxyURL.Host == "" {
+		return nil, fmt.Errorf("invalid proxy URL %q: scheme and host are required", proxyCfg.URL)
+	}
+	if proxyCfg.Username != "" {
+		proxyURL.User = url.UserPassword(proxyCfg.Username, proxyCfg.Password)
+	}
+
+	proxyFunc := (&httpproxy.Config{
+		HTTPProxy:  proxyURL.String(),
+		HTTPSProxy: proxyURL.String(),
+		NoProxy:    strings.Join(proxyCfg.NoProxy, ","),
+	}).ProxyFunc()
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = func(req *http.Request) (*url.URL, error) {
+		return proxyFunc(req.URL)
+	}
+	return transport, nil
 }
 
 func (mc *MultiClient) GetClient(name string) (*Client, bool) {
@@ -331,6 +406,7 @@ func (mc *MultiClient) RemoveClient(name string) {
 	defer mc.mutex.Unlock()
 
 	delete(mc.clients, name)
+	mc.forgetHealth(name)
 }
 
 func (mc *MultiClient) UpdateClient(amConfig config.AlertmanagerConfig) {
@@ -349,22 +425,57 @@ func (c *Client) addAuth(req *http.Request) {
 	}
 }
 
-func (c *Client) FetchAlerts() ([]models.Alert, error) {
-	url := fmt.Sprintf("%s/api/v2/alerts", c.BaseURL)
+// getWithRetry issues a GET request, retrying transport errors and 5xx
+// responses up to c.MaxRetries times with exponential backoff. Each attempt is
+// bounded by the client's timeout, so a slow Alertmanager cannot hang callers.
+func (c *Client) getWithRetry(url string) (*http.Response, error) {
+	delay := retryBaseDelay
 
-	req, err := http.NewRequest("GET", url, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
+	for attempt := 0; ; attempt++ {
+		req, err := http.NewRequest("GET", url, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create request: %w", err)
+		}
+
+		req.Header.Set("Accept", "application/json")
+		req.Header.Set("User-Agent", "Notificator/1.0")
+
+		c.addAuth(req)
+
+		resp, err := c.HTTPClient.Do(req)
+		retryable := err != nil || resp.StatusCode >= http.StatusInternalServerError
+		if !retryable || attempt >= c.MaxRetries {
+			if err != nil {
+				return nil, fmt.Errorf("failed to execute request: %w", err)
+			}
+			return resp, nil
+		}
+
+		if resp != nil {
+			io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+		fmt.Printf("Alertmanager %s: request to %s failed (attempt %d/%d), retrying in %v\n", c.Name, url, attempt+1, c.MaxRetries+1, delay)
+		time.Sleep(delay)
+		delay *= 2
 	}
+}
 
-	req.Header.Set("Accept", "application/json")
-	req.Header.Set("User-Agent", "Notificator/1.0")
+// SetTimeout bounds every request made by this client, including each retry.
+func (c *Client) SetTimeout(timeout time.Duration) {
+	if timeout <= 0 {
+		return
+	}
+	c.Timeout = timeout
+	c.HTTPClient.Timeout = timeout
+}
 
-	c.addAuth(req)
+func (c *Client) FetchAlerts() ([]models.Alert, error) {
+	url := fmt.Sprintf("%s/api/v2/alerts", c.BaseURL)
 
-	resp, err := c.HTTPClient.Do(req)
+	resp, err := c.getWithRetry(url)
 	if err != nil {
-		return nil, fmt.Errorf("failed to execute request: %w", err)
+		return nil, err
 	}
 	defer resp.Body.Close()
 
@@ -409,19 +520,9 @@ func (c *Client) FetchActiveAlerts() ([]models.Alert, error) {
 func (c *Client) FetchSilence(silenceID string) (*models.Silence, error) {
 	url := fmt.Sprintf("%s/api/v2/silence/%s", c.BaseURL, silenceID)
 
-	req, err := http.NewRequest("GET", url, nil)
+	resp, err := c.getWithRetry(url)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
-	}
-
-	req.Header.Set("Accept", "application/json")
-	req.Header.Set("User-Agent", "Notificator/1.0")
-
-	c.addAuth(req)
-
-	resp, err := c.HTTPClient.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("failed to execute request: %w", err)
+		return nil, err
 	}
 	defer resp.Body.Close()
 
@@ -453,19 +554,9 @@ func (c *Client) FetchSilence(silenceID string) (*models.Silence, error) {
 func (c *Client) FetchSilences() ([]models.Silence, error) {
 	url := fmt.Sprintf("%s/api/v2/silences", c.BaseURL)
 
-	req, err := http.NewRequest("GET", url, nil)
+	resp, err := c.getWithRetry(url)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
-	}
-
-	req.Header.Set("Accept", "application/json")
-	req.Header.Set("User-Agent", "Notificator/1.0")
-
-	c.addAuth(req)
-
-	resp, err := c.HTTPClient.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("failed to execute request: %w", err)
+		return nil, err
 	}
 	defer resp.Body.Close()
 
@@ -521,6 +612,9 @@ func (c *Client) CreateSilence(silence models.Silence) (*models.Silence, error)
 		return nil, fmt.Errorf("failed to read response body: %w", err)
 	}
 
+	if resp.StatusCode == http.StatusNotFound && silence.ID != "" {
+		return nil, fmt.Errorf("%w: silence with ID %s not found", ErrSilenceNotFound, silence.ID)
+	}
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
 		return nil, fmt.Errorf("alertmanager returned status %d, body: %s", resp.StatusCode, string(body))
 	}
@@ -543,6 +637,19 @@ func (c *Client) CreateSilence(silence models.Silence) (*models.Silence, error)
 	return &createdSilence, nil
 }
 
+// UpdateSilence edits an existing silence. Alertmanager reuses the create
+// endpoint with the id populated; when the change cannot be applied in place
+// (e.g. the matchers changed) it expires the old silence and answers with a new
+// ID, so callers should use the ID of the returned silence from then on.
+func (c *Client) UpdateSilence(id string, silence models.Silence) (*models.Silence, error) {
+	if id == "" {
+		return nil, fmt.Errorf("silence ID is required to update a silence")
+	}
+
+	silence.ID = id
+	return c.CreateSilence(silence)
+}
+
 func (c *Client) DeleteSilence(silenceID string) error {
 	url := fmt.Sprintf("%s/api/v2/silence/%s", c.BaseURL, silenceID)
 
@@ -563,10 +670,14 @@ func (c *Client) DeleteSilence(silenceID string) error {
 	defer resp.Body.Close()
 
 	if resp.StatusCode == http.StatusNotFound {
-		return fmt.Errorf("silence with ID %s not found", silenceID)
+		return fmt.Errorf("%w: silence with ID %s not found", ErrSilenceNotFound, silenceID)
 	}
 	if resp.StatusCode != http.StatusOK {
 		body, _ := io.ReadAll(resp.Body)
+		// Alertmanager answers 500 with "silence <id> already expired" for expired silences
+		if strings.Contains(string(body), "already expired") {
+			return fmt.Errorf("%w: silence with ID %s", ErrSilenceAlreadyExpired, silenceID)
+		}
 		return fmt.Errorf("alertmanager returned status %d, body: %s", resp.StatusCode, string(body))
 	}
 
@@ -574,27 +685,130 @@ func (c *Client) DeleteSilence(silenceID string) error {
 }
 
 func (c *Client) TestConnection() error {
-	url := fmt.Sprintf("%s/api/v2/alerts", c.BaseURL) // v2 API doesn't have dedicated status endpoint
+	return c.DiagnoseConnection(1).Err
+}
 
-	req, err := http.NewRequest("GET", url, nil)
+// ConnectionDiagnostic is the detailed outcome of a connectivity test against
+// a single Alertmanager.
+type ConnectionDiagnostic struct {
+	Name           string   `json:"name"`
+	URL            string   `json:"url"`            // Final URL requested, after redirects
+	StatusCode     int      `json:"statusCode"`     // 0 when no response was received
+	LatencyMs      int64    `json:"latencyMs"`      // Latency of the last attempt
+	Attempts       int      `json:"attempts"`       // Number of attempts made
+	AuthApplied    string   `json:"authApplied"`    // "bearer", "basic" or "none"
+	HeadersApplied []string `json:"headersApplied"` // Names of custom (e.g. tenant) headers sent; values are withheld
+	ProxyURL       string   `json:"proxyUrl,omitempty"`
+	Error          string   `json:"error,omitempty"`
+
+	Err error `json:"-"`
+}
+
+// Healthy reports whether the diagnostic ended with a successful response
+func (d ConnectionDiagnostic) Healthy() bool {
+	return d.Err == nil
+}
+
+// DiagnoseConnection tests the connection to the Alertmanager, retrying
+// transport errors and 5xx responses up to attempts times, and reports how the
+// request was made.
+func (c *Client) DiagnoseConnection(attempts int) ConnectionDiagnostic {
+	if attempts < 1 {
+		attempts = 1
+	}
+
+	diag := ConnectionDiagnostic{
+		Name:        c.Name,
+		URL:         fmt.Sprintf("%s/api/v2/alerts", c.BaseURL), // v2 API doesn't have dedicated status endpoint
+		AuthApplied: "none",
+	}
+
+	for attempt := 1; attempt <= attempts; attempt++ {
+		diag.Attempts = attempt
+		retry := c.diagnoseOnce(&diag)
+		if !retry || attempt == attempts {
+			break
+		}
+		time.Sleep(time.Duration(attempt) * 500 * time.Millisecond)
+	}
+
+	if diag.Err != nil {
+		diag.Error = diag.Err.Error()
+	}
+	return diag
+}
+
+// diagnoseOnce performs a single test request, filling diag in place. It
+// returns true when the failure is worth retrying.
+func (c *Client) diagnoseOnce(diag *ConnectionDiagnostic) bool {
+	diag.StatusCode = 0
+	diag.Err = nil
+
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/api/v2/alerts", c.BaseURL), nil)
 	if err != nil {
-		return fmt.Errorf("failed to create request: %w", err)
+		diag.Err = fmt.Errorf("failed to create request: %w", err)
+		return false
 	}
 
 	c.addAuth(req)
+	if c.Token != "" {
+		diag.AuthApplied = "bearer"
+	} else if c.Username != "" && c.Password != "" {
+		diag.AuthApplied = "basic"
+	}
 
+	diag.HeadersApplied = make([]string, 0, len(c.Headers))
+	for key := range c.Headers {
+		diag.HeadersApplied = append(diag.HeadersApplied, key)
+	}
+	sort.Strings(diag.HeadersApplied)
+
+	if proxyURL := c.proxyURLFor(req); proxyURL != nil {
+		diag.ProxyURL = proxyURL.Redacted()
+	}
+
+	start := time.Now()
 	resp, err := c.HTTPClient.Do(req)
+	diag.LatencyMs = time.Since(start).Milliseconds()
 	if err != nil {
-		return fmt.Errorf("failed to connect to alertmanager: %w", err)
+		diag.Err = fmt.Errorf("failed to connect to alertmanager: %w", err)
+		return true
 	}
 	defer resp.Body.Close()
 
+	diag.StatusCode = resp.StatusCode
+	if resp.Request != nil && resp.Request.URL != nil {
+		diag.URL = resp.Request.URL.String()
+	}
+
 	if resp.StatusCode != http.StatusOK {
 		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("alertmanager returned status %d: %s", resp.StatusCode, string(body[:min(200, len(body))]))
+		diag.Err = fmt.Errorf("alertmanager returned status %d: %s", resp.StatusCode, string(body[:min(200, len(body))]))
+		return resp.StatusCode >= 500
 	}
 
-	return nil
+	return false
+}
+
+// proxyURLFor returns the proxy the client's transport would use for req, if any
+func (c *Client) proxyURLFor(req *http.Request) *url.URL {
+	rt := c.HTTPClient.Transport
+	if headerRT, ok := rt.(*customHeaderRoundTripper); ok {
+		rt = headerRT.rt
+	}
+	if rt == nil {
+		rt = http.DefaultTransport
+	}
+
+	transport, ok := rt.(*http.Transport)
+	if !ok || transport.Proxy == nil {
+		return nil
+	}
+	proxyURL, err := transport.Proxy(req)
+	if err != nil {
+		return nil
+	}
+	return proxyURL
 }
 
 func min(a, b int) int {
@@ -668,49 +882,85 @@ type SilenceWithSource struct {
 	Source  string // Name of the Alertmanager instance
 }
 
-// FetchAllAlertsDetailed fetches alerts from every configured Alertmanager and
-// reports per-source failures instead of collapsing them into a single error,
-// so callers can tell a partial fetch from a genuinely empty one.
+// FetchAllAlertsDetailed queries every Alertmanager concurrently and returns the
+// alerts that were fetched with each failed source's error (ErrCircuitOpen when skipped).
 func (mc *MultiClient) FetchAllAlertsDetailed() ([]AlertWithSource, map[string]error) {
 	mc.mutex.RLock()
 	defer mc.mutex.RUnlock()
 
-	var allAlerts []AlertWithSource
-	failedSources := make(map[string]error)
+	var (
+		wg            sync.WaitGroup
+		resultMu      sync.Mutex
+		allAlerts     []AlertWithSource
+		failedSources = make(map[string]error)
+	)
 
 	for name, client := range mc.clients {
-		alerts, err := client.FetchAlerts()
-		if err != nil {
+		if err := mc.allowFetch(name); err != nil {
+			resultMu.Lock()
 			failedSources[name] = err
+			resultMu.Unlock()
 			continue
 		}
 
-		for _, alert := range alerts {
-			allAlerts = append(allAlerts, AlertWithSource{
-				Alert:  alert,
-				Source: name,
-			})
-		}
+		wg.Add(1)
+		go func(name string, client *Client) {
+			defer wg.Done()
+
+			start := time.Now()
+			alerts, err := client.FetchAlerts()
+			mc.recordFetch(name, client.BaseURL, time.Since(start), len(alerts), err)
+
+			resultMu.Lock()
+			defer resultMu.Unlock()
+
+			if err != nil {
+				failedSources[name] = err
+				return
+			}
+
+			for _, alert := range alerts {
+				allAlerts = append(allAlerts, AlertWithSource{
+					Alert:  alert,
+					Source: name,
+				})
+			}
+		}(name, client)
 	}
 
+	wg.Wait()
+
 	return allAlerts, failedSources
 }
 
+// FetchAllAlerts returns the alerts of every Alertmanager that answered. When
+// some sources fail, the alerts that were fetched are still returned together
+// with an error naming each failed source; callers should use the alerts
+// whenever there are any.
 func (mc *MultiClient) FetchAllAlerts() ([]AlertWithSource, error) {
 	allAlerts, failedSources := mc.FetchAllAlertsDetailed()
 
-	if len(failedSources) > 0 && len(allAlerts) == 0 { // If all clients failed, return the first error
-		for name, err := range failedSources {
-			return nil, fmt.Errorf("failed to fetch alerts from %s: %w", name, err)
-		}
+	if len(failedSources) == 0 {
+		return allAlerts, nil
 	}
 
-	return allAlerts, nil
+	names := make([]string, 0, len(failedSources))
+	for name := range failedSources {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	errs := make([]error, 0, len(names))
+	for _, name := range names {
+		errs = append(errs, fmt.Errorf("failed to fetch alerts from %s: %w", name, failedSources[name]))
+	}
+
+	return allAlerts, errors.Join(errs...)
 }
 
 func (mc *MultiClient) FetchAllActiveAlerts() ([]AlertWithSource, error) {
 	allAlerts, err := mc.FetchAllAlerts()
-	if err != nil {
+	if err != nil && len(allAlerts) == 0 {
 		return nil, err
 	}
 
@@ -721,7 +971,7 @@ func (mc *MultiClient) FetchAllActiveAlerts() ([]AlertWithSource, error) {
 		}
 	}
 
-	return activeAlerts, nil
+	return activeAlerts, err
 }
 
 func (mc *MultiClient) FetchAllSilences() ([]SilenceWithSource, error) {
@@ -753,19 +1003,60 @@ func (mc *MultiClient) FetchAllSilences() ([]SilenceWithSource, error) {
 	return allSilences, nil
 }
 
+// TestAllConnections tests every Alertmanager whose circuit breaker is
+// closed; sources the fetches are skipping report ErrCircuitOpen instead.
 func (mc *MultiClient) TestAllConnections() map[string]error {
-	mc.mutex.RLock()
-	defer mc.mutex.RUnlock()
-
 	results := make(map[string]error)
 
-	for name, client := range mc.clients {
-		results[name] = client.TestConnection()
+	for name, diag := range mc.diagnoseConnections(1, true) {
+		results[name] = diag.Err
 	}
 
 	return results
 }
 
+// DiagnoseAllConnections tests every configured Alertmanager in parallel and
+// returns detailed per-source diagnostics keyed by name. It is an explicit
+// check, so sources with an open circuit breaker are tested too.
+func (mc *MultiClient) DiagnoseAllConnections(attempts int) map[string]ConnectionDiagnostic {
+	return mc.diagnoseConnections(attempts, false)
+}
+
+func (mc *MultiClient) diagnoseConnections(attempts int, honorBreaker bool) map[string]ConnectionDiagnostic {
+	// Work on a copy so SetProxy and config reloads aren't blocked behind
+	// the network calls and retry sleeps
+	clients := mc.GetAllClients()
+
+	results := make(map[string]ConnectionDiagnostic, len(clients))
+	var resultsMu sync.Mutex
+	var wg sync.WaitGroup
+
+	for name, client := range clients {
+		if honorBreaker {
+			if err := mc.circuitOpen(name); err != nil {
+				resultsMu.Lock()
+				results[name] = ConnectionDiagnostic{Name: name, URL: client.BaseURL, Error: err.Error(), Err: err}
+				resultsMu.Unlock()
+				continue
+			}
+		}
+
+		wg.Add(1)
+		go func(name string, client *Client) {
+			defer wg.Done()
+			diag := client.DiagnoseConnection(attempts)
+			diag.Name = name
+
+			resultsMu.Lock()
+			results[name] = diag
+			resultsMu.Unlock()
+		}(name, client)
+	}
+
+	wg.Wait()
+	return results
+}
+
 func (mc *MultiClient) CreateSilenceOnAlertmanager(alertmanagerName string, silence models.Silence) (*models.Silence, error) {
 	mc.mutex.RLock()
 	defer mc.mutex.RUnlock()
@@ -778,6 +1069,18 @@ func (mc *MultiClient) CreateSilenceOnAlertmanager(alertmanagerName string, sile
 	return client.CreateSilence(silence)
 }
 
+func (mc *MultiClient) UpdateSilenceOnAlertmanager(alertmanagerName, silenceID string, silence models.Silence) (*models.Silence, error) {
+	mc.mutex.RLock()
+	defer mc.mutex.RUnlock()
+
+	client, exists := mc.clients[alertmanagerName]
+	if !exists {
+		return nil, fmt.Errorf("alertmanager '%s' not found", alertmanagerName)
+	}
+
+	return client.UpdateSilence(silenceID, silence)
+}
+
 func (mc *MultiClient) FetchSilenceFromAlertmanager(alertmanagerName, silenceID string) (*models.Silence, error) {
 	mc.mutex.RLock()
 	defer mc.mutex.RUnlock()
@@ -0,0 +1,496 @@
+package alertmanager
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"notificator/config"
+	"notificator/internal/models"
+)
+
+func TestNewClientFromConfig_Proxy(t *testing.T) {
+	t.Run("transport uses the configured proxy", func(t *testing.T) {
+		client := NewClientFromConfig(config.AlertmanagerConfig{
+			Name: "segmented",
+			URL:  "http://alertmanager.segment-a.internal:9093",
+			Proxy: &config.ProxyConfig{
+				URL:     "http://proxy.segment-a.internal:3128",
+				NoProxy: []string{".direct.internal", "10.0.0.0/8"},
+			},
+		})
+
+		transport, ok := client.HTTPClient.Transport.(*http.Transport)
+		if !ok {
+			t.Fatalf("expected *http.Transport, got %T", client.HTTPClient.Transport)
+		}
+
+		req, _ := http.NewRequest("GET", client.BaseURL+"/api/v2/alerts", nil)
+		proxyURL, err := transport.Proxy(req)
+		if err != nil {
+			t.Fatalf("unexpected proxy error: %v", err)
+		}
+		if proxyURL == nil || proxyURL.Host != "proxy.segment-a.internal:3128" {
+			t.Errorf("expected proxy.segment-a.internal:3128, got %v", proxyURL)
+		}
+
+		for _, direct := range []string{"http://am.direct.internal:9093", "http://10.1.2.3:9093"} {
+			req, _ := http.NewRequest("GET", direct, nil)
+			proxyURL, err := transport.Proxy(req)
+			if err != nil {
+				t.Fatalf("unexpected proxy error for %s: %v", direct, err)
+			}
+			if proxyURL != nil {
+				t.Errorf("expected %s to bypass the proxy, got %v", direct, proxyURL)
+			}
+		}
+	})
+
+	t.Run("proxy wraps custom header transport", func(t *testing.T) {
+		client := NewClientFromConfig(config.AlertmanagerConfig{
+			Name:    "tenant",
+			URL:     "http://alertmanager.internal:9093",
+			Headers: map[string]string{"X-Scope-OrgID": "tenant1"},
+			Proxy:   &config.ProxyConfig{URL: "http://proxy.internal:3128"},
+		})
+
+		headerRT, ok := client.HTTPClient.Transport.(*customHeaderRoundTripper)
+		if !ok {
+			t.Fatalf("expected *customHeaderRoundTripper, got %T", client.HTTPClient.Transport)
+		}
+		if _, ok := headerRT.rt.(*http.Transport); !ok {
+			t.Fatalf("expected header round tripper to wrap *http.Transport, got %T", headerRT.rt)
+		}
+	})
+
+	t.Run("requests go through the proxy with credentials", func(t *testing.T) {
+		var gotHost, gotAuth string
+		proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			gotHost = r.Host
+			gotAuth = r.Header.Get("Proxy-Authorization")
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"status":"success"}`))
+		}))
+		defer proxy.Close()
+
+		client := NewClientFromConfig(config.AlertmanagerConfig{
+			Name: "proxied",
+			URL:  "http://alertmanager.behind-proxy.internal:9093",
+			Proxy: &config.ProxyConfig{
+				URL:      proxy.URL,
+				Username: "user",
+				Password: "secret",
+			},
+		})
+
+		resp, err := client.HTTPClient.Get(client.BaseURL + "/-/healthy")
+		if err != nil {
+			t.Fatalf("request through proxy failed: %v", err)
+		}
+		resp.Body.Close()
+
+		if gotHost != "alertmanager.behind-proxy.internal:9093" {
+			t.Errorf("expected proxy to receive request for alertmanager host, got %q", gotHost)
+		}
+		wantAuth := "Basic " + base64.StdEncoding.EncodeToString([]byte("user:secret"))
+		if gotAuth != wantAuth {
+			t.Errorf("expected Proxy-Authorization %q, got %q", wantAuth, gotAuth)
+		}
+	})
+
+	t.Run("invalid proxy URL is rejected", func(t *testing.T) {
+		client := NewClientWithConfig("http://alertmanager.internal:9093", "", "", "", nil, "invalid")
+		if err := client.SetProxy(&config.ProxyConfig{URL: "proxy.internal"}); err == nil {
+			t.Error("expected an error for a proxy URL without scheme")
+		}
+		if client.HTTPClient.Transport != nil {
+			t.Errorf("expected transport to be left untouched, got %T", client.HTTPClient.Transport)
+		}
+	})
+}
+
+func TestParseNoProxy(t *testing.T) {
+	got := config.ParseNoProxy([]string{"a.internal, .b.internal", "", "10.0.0.0/8"})
+	want := []string{"a.internal", ".b.internal", "10.0.0.0/8"}
+	if len(got) != len(want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("entry %d: expected %q, got %q", i, want[i], got[i])
+		}
+	}
+}
+
+func TestDiagnoseConnection(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		if r.Header.Get("X-Scope-OrgID") != "tenant1" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	client := NewClientWithConfig(server.URL, "", "", "token", map[string]string{"X-Scope-OrgID": "tenant1"}, "tenant")
+	diag := client.DiagnoseConnection(2)
+
+	if !diag.Healthy() {
+		t.Fatalf("expected healthy diagnostic, got error: %v", diag.Err)
+	}
+	if diag.Attempts != 2 {
+		t.Errorf("expected 2 attempts after a 502, got %d", diag.Attempts)
+	}
+	if diag.StatusCode != http.StatusOK {
+		t.Errorf("expected status 200, got %d", diag.StatusCode)
+	}
+	if diag.AuthApplied != "bearer" {
+		t.Errorf("expected bearer auth, got %q", diag.AuthApplied)
+	}
+	if len(diag.HeadersApplied) != 1 || diag.HeadersApplied[0] != "X-Scope-OrgID" {
+		t.Errorf("expected X-Scope-OrgID header to be reported, got %v", diag.HeadersApplied)
+	}
+	if diag.URL != server.URL+"/api/v2/alerts" {
+		t.Errorf("expected resolved URL %s/api/v2/alerts, got %s", server.URL, diag.URL)
+	}
+}
+
+func TestDeleteSilence(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("expected DELETE, got %s", r.Method)
+		}
+		switch r.URL.Path {
+		case "/api/v2/silence/active":
+			w.WriteHeader(http.StatusOK)
+		case "/api/v2/silence/expired":
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(`"silence expired already expired"`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClientWithConfig(server.URL, "", "", "", nil, "test")
+
+	if err := client.DeleteSilence("active"); err != nil {
+		t.Errorf("expected active silence to be expired, got %v", err)
+	}
+	if err := client.DeleteSilence("expired"); !errors.Is(err, ErrSilenceAlreadyExpired) {
+		t.Errorf("expected ErrSilenceAlreadyExpired, got %v", err)
+	}
+	if err := client.DeleteSilence("missing"); !errors.Is(err, ErrSilenceNotFound) {
+		t.Errorf("expected ErrSilenceNotFound, got %v", err)
+	}
+}
+
+func TestUpdateSilence(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/api/v2/silences" {
+			t.Errorf("expected POST /api/v2/silences, got %s %s", r.Method, r.URL.Path)
+		}
+
+		var silence models.Silence
+		if err := json.NewDecoder(r.Body).Decode(&silence); err != nil {
+			t.Fatalf("failed to decode silence: %v", err)
+		}
+
+		switch silence.ID {
+		case "in-place":
+			w.Write([]byte(`{"silenceID":"in-place"}`))
+		case "recreated":
+			// Alertmanager expires the old silence and answers with a new ID
+			w.Write([]byte(`{"silenceID":"new-id"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`"silence not found"`))
+		}
+	}))
+	defer server.Close()
+
+	client := NewClientWithConfig(server.URL, "", "", "", nil, "test")
+	silence := models.Silence{
+		Matchers: []models.SilenceMatcher{{Name: "alertname", Value: "HighCPU", IsEqual: models.BoolPtr(true)}},
+		StartsAt: time.Now(),
+		EndsAt:   time.Now().Add(2 * time.Hour),
+		Comment:  "extended",
+	}
+
+	updated, err := client.UpdateSilence("in-place", silence)
+	if err != nil || updated.ID != "in-place" {
+		t.Errorf("expected in-place update to keep its ID, got %v (err=%v)", updated, err)
+	}
+
+	updated, err = client.UpdateSilence("recreated", silence)
+	if err != nil || updated.ID != "new-id" {
+		t.Errorf("expected the new ID from Alertmanager, got %v (err=%v)", updated, err)
+	}
+
+	if _, err := client.UpdateSilence("missing", silence); !errors.Is(err, ErrSilenceNotFound) {
+		t.Errorf("expected ErrSilenceNotFound, got %v", err)
+	}
+
+	if _, err := client.UpdateSilence("", silence); err == nil {
+		t.Error("expected an error for an empty silence ID")
+	}
+}
+
+func TestFetchAlerts_RetryAndTimeout(t *testing.T) {
+	defer func(delay time.Duration) { retryBaseDelay = delay }(retryBaseDelay)
+	retryBaseDelay = time.Millisecond
+
+	t.Run("retries 5xx with backoff until success", func(t *testing.T) {
+		calls := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			if calls <= 2 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			w.Write([]byte(`[]`))
+		}))
+		defer server.Close()
+
+		client := NewClientFromConfig(config.AlertmanagerConfig{Name: "flaky", URL: server.URL, MaxRetries: 2})
+		if _, err := client.FetchAlerts(); err != nil {
+			t.Fatalf("expected fetch to succeed after retries, got %v", err)
+		}
+		if calls != 3 {
+			t.Errorf("expected 3 attempts, got %d", calls)
+		}
+	})
+
+	t.Run("gives up after MaxRetries", func(t *testing.T) {
+		calls := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			w.WriteHeader(http.StatusBadGateway)
+		}))
+		defer server.Close()
+
+		client := NewClientFromConfig(config.AlertmanagerConfig{Name: "down", URL: server.URL, MaxRetries: 1})
+		if _, err := client.FetchAlerts(); err == nil {
+			t.Fatal("expected an error once retries are exhausted")
+		}
+		if calls != 2 {
+			t.Errorf("expected 2 attempts, got %d", calls)
+		}
+	})
+
+	t.Run("client errors are not retried", func(t *testing.T) {
+		calls := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			w.WriteHeader(http.StatusUnauthorized)
+		}))
+		defer server.Close()
+
+		client := NewClientFromConfig(config.AlertmanagerConfig{Name: "unauthorized", URL: server.URL, MaxRetries: 3})
+		client.FetchAlerts()
+		if calls != 1 {
+			t.Errorf("expected a single attempt for a 401, got %d", calls)
+		}
+	})
+
+	t.Run("per-client timeout bounds slow alertmanagers", func(t *testing.T) {
+		release := make(chan struct{})
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			select {
+			case <-release:
+			case <-r.Context().Done():
+			}
+		}))
+		defer server.Close()
+		defer close(release)
+
+		client := NewClientFromConfig(config.AlertmanagerConfig{Name: "slow", URL: server.URL, Timeout: 50 * time.Millisecond})
+		if client.HTTPClient.Timeout != 50*time.Millisecond {
+			t.Fatalf("expected configured timeout on the HTTP client, got %v", client.HTTPClient.Timeout)
+		}
+
+		start := time.Now()
+		if _, err := client.FetchAlerts(); err == nil {
+			t.Fatal("expected a timeout error")
+		}
+		if elapsed := time.Since(start); elapsed > 2*time.Second {
+			t.Errorf("fetch should give up after the client timeout, took %v", elapsed)
+		}
+	})
+}
+
+func TestFetchAllAlerts_PartialFailure(t *testing.T) {
+	defer func(delay time.Duration) { retryBaseDelay = delay }(retryBaseDelay)
+	retryBaseDelay = time.Millisecond
+
+	healthy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode([]models.Alert{
+			{Labels: map[string]string{"alertname": "DiskFull"}, Status: models.AlertStatus{State: "active"}},
+			{Labels: map[string]string{"alertname": "HighLatency"}, Status: models.AlertStatus{State: "active"}},
+		})
+	}))
+	defer healthy.Close()
+
+	broken := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer broken.Close()
+
+	mc := NewMultiClient(&config.Config{Alertmanagers: []config.AlertmanagerConfig{
+		{Name: "healthy", URL: healthy.URL},
+		{Name: "broken", URL: broken.URL},
+	}})
+
+	alerts, err := mc.FetchAllAlerts()
+	if len(alerts) != 2 {
+		t.Fatalf("expected the healthy Alertmanager's 2 alerts, got %d", len(alerts))
+	}
+	for _, alert := range alerts {
+		if alert.Source != "healthy" {
+			t.Errorf("expected alerts tagged with source %q, got %q", "healthy", alert.Source)
+		}
+	}
+	if err == nil || !strings.Contains(err.Error(), "broken") {
+		t.Errorf("expected an error naming the failed source, got %v", err)
+	}
+	if strings.Contains(err.Error(), "healthy") {
+		t.Errorf("error should not mention sources that succeeded: %v", err)
+	}
+
+	active, err := mc.FetchAllActiveAlerts()
+	if len(active) != 2 || err == nil {
+		t.Errorf("expected partial active alerts plus an error, got %d alerts and %v", len(active), err)
+	}
+}
+
+func TestSourceHealth_RecordsFetchHistory(t *testing.T) {
+	defer func(delay time.Duration) { retryBaseDelay = delay }(retryBaseDelay)
+	retryBaseDelay = time.Millisecond
+
+	var failing atomic.Bool
+	failing.Store(true)
+	flaky := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if failing.Load() {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		json.NewEncoder(w).Encode([]models.Alert{{Labels: map[string]string{"alertname": "DiskFull"}}})
+	}))
+	defer flaky.Close()
+
+	mc := NewMultiClient(&config.Config{Alertmanagers: []config.AlertmanagerConfig{
+		{Name: "flaky", URL: flaky.URL},
+		{Name: "idle", URL: "http://idle.invalid"},
+	}})
+
+	if sources := mc.SourceHealth(); len(sources) != 2 || sources[0].Checked() || sources[1].Checked() {
+		t.Fatalf("expected both sources listed as not checked yet, got %+v", sources)
+	}
+	mc.RemoveClient("idle")
+
+	mc.FetchAllAlertsDetailed()
+	mc.FetchAllAlertsDetailed()
+	health := mc.SourceHealth()[0]
+	if health.Healthy || health.FailureCount != 2 || health.ConsecutiveFailures != 2 || health.LastError == "" || health.LastSuccess != nil {
+		t.Fatalf("expected two recorded failures, got %+v", health)
+	}
+
+	failing.Store(false)
+	mc.FetchAllAlertsDetailed()
+	health = mc.SourceHealth()[0]
+	if !health.Healthy || health.SuccessCount != 1 || health.FailureCount != 2 || health.ConsecutiveFailures != 0 {
+		t.Errorf("expected the source to recover, got %+v", health)
+	}
+	if health.AlertCount != 1 || health.LastSuccess == nil || health.LastError != "" || health.URL != flaky.URL {
+		t.Errorf("expected the successful fetch recorded, got %+v", health)
+	}
+}
+
+func TestFetchAllAlerts_CircuitBreaker(t *testing.T) {
+	defer func(delay, cooldown time.Duration) {
+		retryBaseDelay, breakerBaseCooldown = delay, cooldown
+	}(retryBaseDelay, breakerBaseCooldown)
+	retryBaseDelay = time.Millisecond
+	breakerBaseCooldown = 5 * time.Minute
+
+	var requests atomic.Int32
+	var failing atomic.Bool
+	failing.Store(true)
+	dead := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		if failing.Load() {
+			w.WriteHeader(http.StatusBadRequest) // not retried, one request per fetch
+			return
+		}
+		json.NewEncoder(w).Encode([]models.Alert{})
+	}))
+	defer dead.Close()
+
+	mc := NewMultiClient(&config.Config{Alertmanagers: []config.AlertmanagerConfig{{Name: "dead", URL: dead.URL}}})
+
+	for i := 0; i < breakerThreshold; i++ {
+		mc.FetchAllAlertsDetailed()
+	}
+	health := mc.SourceHealth()[0]
+	if !health.CircuitOpen || health.RetryAt == nil || time.Until(*health.RetryAt) < 4*time.Minute {
+		t.Fatalf("expected the circuit to open for the base cooldown, got %+v", health)
+	}
+
+	_, failed := mc.FetchAllAlertsDetailed()
+	if !errors.Is(failed["dead"], ErrCircuitOpen) || int(requests.Load()) != breakerThreshold {
+		t.Fatalf("expected the open circuit to skip the source, got %v after %d requests", failed["dead"], requests.Load())
+	}
+	if err := mc.TestAllConnections()["dead"]; !errors.Is(err, ErrCircuitOpen) || int(requests.Load()) != breakerThreshold {
+		t.Errorf("expected connection tests to honor the breaker, got %v", err)
+	}
+	if diag := mc.DiagnoseAllConnections(1)["dead"]; errors.Is(diag.Err, ErrCircuitOpen) {
+		t.Errorf("expected explicit diagnostics to test the source anyway, got %v", diag.Err)
+	}
+	if health := mc.SourceHealth()[0]; health.SkippedCount != 1 || health.FailureCount != breakerThreshold {
+		t.Errorf("expected the skip counted apart from failures, got %+v", health)
+	}
+
+	elapseCooldown := func() {
+		past := time.Now().Add(-time.Second)
+		mc.health["dead"].RetryAt = &past
+	}
+
+	// Once the cooldown has elapsed a failed probe doubles it
+	elapseCooldown()
+	mc.FetchAllAlertsDetailed()
+	health = mc.SourceHealth()[0]
+	if health.ConsecutiveFailures != breakerThreshold+1 || time.Until(*health.RetryAt) < 9*time.Minute {
+		t.Fatalf("expected a failed probe to reopen the circuit for twice as long, got %+v", health)
+	}
+
+	// A successful probe closes it
+	failing.Store(false)
+	elapseCooldown()
+	if _, failed := mc.FetchAllAlertsDetailed(); len(failed) != 0 {
+		t.Fatalf("expected the probe to succeed, got %v", failed)
+	}
+	if health := mc.SourceHealth()[0]; health.CircuitOpen || health.RetryAt != nil || !health.Healthy {
+		t.Errorf("expected the circuit closed after a successful probe, got %+v", health)
+	}
+}
+
+func TestBreakerCooldown_Capped(t *testing.T) {
+	if got := breakerCooldown(breakerThreshold); got != breakerBaseCooldown {
+		t.Errorf("expected the base cooldown when the circuit opens, got %s", got)
+	}
+	if got := breakerCooldown(breakerThreshold + 100); got != breakerMaxCooldown {
+		t.Errorf("expected the cooldown capped at %s, got %s", breakerMaxCooldown, got)
+	}
+}
@@ -0,0 +1,185 @@
+package alertmanager
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+)
+
+// ErrCircuitOpen is reported for a source that was skipped because its
+// circuit breaker is open
+var ErrCircuitOpen = errors.New("circuit breaker open")
+
+var (
+	// breakerThreshold is the number of consecutive failed fetches that opens
+	// a source's circuit breaker
+	breakerThreshold = 3
+	// breakerBaseCooldown is how long an open breaker skips its source before
+	// letting one fetch probe it; it doubles after every failed probe, up to
+	// breakerMaxCooldown
+	breakerBaseCooldown = 30 * time.Second
+	breakerMaxCooldown  = 10 * time.Minute
+)
+
+// SourceHealth is the fetch history of one Alertmanager, recorded by every
+// FetchAllAlertsDetailed call. Reading it never touches the network.
+//
+// After breakerThreshold consecutive failures the source's circuit opens:
+// fetches skip it until RetryAt, then a single fetch probes it. A successful
+// probe closes the circuit, a failed one doubles the cooldown.
+type SourceHealth struct {
+	Name                string     `json:"name"`
+	URL                 string     `json:"url"`
+	Healthy             bool       `json:"healthy"`
+	SuccessCount        int        `json:"successCount"`
+	FailureCount        int        `json:"failureCount"`
+	ConsecutiveFailures int        `json:"consecutiveFailures"`
+	LastSuccess         *time.Time `json:"lastSuccess,omitempty"`
+	LastFailure         *time.Time `json:"lastFailure,omitempty"`
+	LastError           string     `json:"lastError,omitempty"`
+	LatencyMs           int64      `json:"latencyMs"`
+	AlertCount          int        `json:"alertCount"`
+	CircuitOpen         bool       `json:"circuitOpen"`
+	RetryAt             *time.Time `json:"retryAt,omitempty"`
+	SkippedCount        int        `json:"skippedCount"` // fetches skipped while the circuit was open
+}
+
+// Checked reports whether the source has been fetched at least once
+func (h SourceHealth) Checked() bool {
+	return h.SuccessCount+h.FailureCount > 0
+}
+
+// recordFetch updates a source's history with the outcome of one fetch
+func (mc *MultiClient) recordFetch(name, url string, latency time.Duration, alertCount int, err error) {
+	mc.healthMu.Lock()
+	defer mc.healthMu.Unlock()
+
+	if mc.health == nil {
+		mc.health = make(map[string]*SourceHealth)
+	}
+	health, ok := mc.health[name]
+	if !ok {
+		health = &SourceHealth{Name: name}
+		mc.health[name] = health
+	}
+
+	health.URL = url
+	health.LatencyMs = latency.Milliseconds()
+	now := time.Now()
+	if err != nil {
+		health.Healthy = false
+		health.FailureCount++
+		health.ConsecutiveFailures++
+		health.LastFailure = &now
+		health.LastError = err.Error()
+
+		if health.ConsecutiveFailures >= breakerThreshold {
+			cooldown := breakerCooldown(health.ConsecutiveFailures)
+			retryAt := now.Add(cooldown)
+			if !health.CircuitOpen {
+				log.Printf("Alertmanager %s failed %d times in a row, skipping it for %s", name, health.ConsecutiveFailures, cooldown)
+			}
+			health.CircuitOpen = true
+			health.RetryAt = &retryAt
+		}
+		return
+	}
+
+	if health.CircuitOpen {
+		log.Printf("Alertmanager %s answered again after %d failures, resuming fetches", name, health.ConsecutiveFailures)
+	}
+	health.CircuitOpen = false
+	health.RetryAt = nil
+	health.Healthy = true
+	health.SuccessCount++
+	health.ConsecutiveFailures = 0
+	health.LastSuccess = &now
+	health.LastError = ""
+	health.AlertCount = alertCount
+}
+
+// breakerCooldown is how long to skip a source after its given number of
+// consecutive failures
+func breakerCooldown(failures int) time.Duration {
+	cooldown := breakerBaseCooldown
+	for i := breakerThreshold; i < failures && cooldown < breakerMaxCooldown; i++ {
+		cooldown *= 2
+	}
+	if cooldown > breakerMaxCooldown {
+		cooldown = breakerMaxCooldown
+	}
+	return cooldown
+}
+
+// allowFetch returns ErrCircuitOpen while the source's circuit is open and
+// its cooldown has not elapsed. Once it has, the caller is let through to
+// probe the source and RetryAt moves forward, so concurrent callers keep
+// skipping it until the probe's outcome is recorded.
+func (mc *MultiClient) allowFetch(name string) error {
+	mc.healthMu.Lock()
+	defer mc.healthMu.Unlock()
+
+	health, ok := mc.health[name]
+	if !ok || !health.CircuitOpen {
+		return nil
+	}
+	if err := health.breakerError(); err != nil {
+		health.SkippedCount++
+		return err
+	}
+
+	retryAt := time.Now().Add(breakerCooldown(health.ConsecutiveFailures))
+	health.RetryAt = &retryAt
+	return nil
+}
+
+// circuitOpen is allowFetch without letting a probe through or counting the
+// skip, for connection tests that should not hit a source the fetches avoid
+func (mc *MultiClient) circuitOpen(name string) error {
+	mc.healthMu.Lock()
+	defer mc.healthMu.Unlock()
+
+	if health, ok := mc.health[name]; ok && health.CircuitOpen {
+		return health.breakerError()
+	}
+	return nil
+}
+
+func (h *SourceHealth) breakerError() error {
+	if h.RetryAt == nil || !time.Now().Before(*h.RetryAt) {
+		return nil
+	}
+	return fmt.Errorf("%w after %d consecutive failures, next attempt at %s", ErrCircuitOpen, h.ConsecutiveFailures, h.RetryAt.Format(time.RFC3339))
+}
+
+// SourceHealth returns the recorded health of every configured Alertmanager,
+// sorted by name. Sources that have not been fetched yet are listed as not
+// checked rather than unhealthy.
+func (mc *MultiClient) SourceHealth() []SourceHealth {
+	mc.mutex.RLock()
+	defer mc.mutex.RUnlock()
+	mc.healthMu.Lock()
+	defer mc.healthMu.Unlock()
+
+	sources := make([]SourceHealth, 0, len(mc.clients))
+	for name, client := range mc.clients {
+		if health, ok := mc.health[name]; ok {
+			sources = append(sources, *health)
+			continue
+		}
+		sources = append(sources, SourceHealth{Name: name, URL: client.BaseURL})
+	}
+
+	sort.Slice(sources, func(i, j int) bool { return sources[i].Name < sources[j].Name })
+	return sources
+}
+
+// forgetHealth drops the history of a source that is no longer configured
+func (mc *MultiClient) forgetHealth(name string) {
+	mc.healthMu.Lock()
+	defer mc.healthMu.Unlock()
+
+	delete(mc.health, name)
+}
@@ -0,0 +1,147 @@
+package backend
+
+import (
+	"context"
+	"log"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"notificator/internal/backend/apiversion"
+	alertpb "notificator/internal/backend/proto/alert"
+)
+
+// requestShim upgrades a deprecated request shape sent by an older client to
+// the shape the current handlers expect. See package apiversion for the policy.
+type requestShim struct {
+	// deprecated describes the old shape and its replacement, for the warning
+	deprecated string
+	// removedIn is the API version that stops accepting the old shape
+	removedIn int
+	// upgrade rewrites req in place and reports whether the old shape was used
+	upgrade func(req interface{}) bool
+}
+
+var requestShims = []requestShim{
+	{
+		deprecated: "include_weekends without weekend_mode (use weekend_mode)",
+		removedIn:  3,
+		upgrade:    upgradeIncludeWeekends,
+	},
+	{
+		deprecated: "statistics view without time_range_mode (set time_range_mode to \"absolute\" or \"relative\")",
+		removedIn:  3,
+		upgrade:    upgradeViewTimeRangeMode,
+	},
+}
+
+// versionUnaryInterceptor negotiates the API version for unary calls and
+// applies the compatibility shims for older clients.
+func (s *Server) versionUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	version, err := negotiateVersion(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	applyRequestShims(info.FullMethod, version, req)
+
+	return handler(ctx, req)
+}
+
+// versionStreamInterceptor negotiates the API version for streaming calls.
+// Stream requests have no deprecated shapes yet, so no shims are applied.
+func (s *Server) versionStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if _, err := negotiateVersion(ss.Context()); err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
+
+// negotiateVersion rejects unsupported clients and advertises the server
+// version in the response header.
+func negotiateVersion(ctx context.Context) (int, error) {
+	version, err := apiversion.FromIncomingContext(ctx)
+	if err != nil {
+		return 0, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if version < apiversion.MinSupported {
+		return 0, status.Errorf(codes.FailedPrecondition,
+			"API version %d is no longer supported, minimum is %d", version, apiversion.MinSupported)
+	}
+
+	// Not every transport supports headers (e.g. direct handler calls in tests)
+	_ = grpc.SetHeader(ctx, apiversion.Header())
+
+	if version > apiversion.Current {
+		version = apiversion.Current
+	}
+	return version, nil
+}
+
+// applyRequestShims upgrades deprecated request shapes from clients older
+// than the current version and logs a deprecation warning for each one used.
+func applyRequestShims(method string, version int, req interface{}) {
+	if version >= apiversion.Current {
+		return
+	}
+
+	for _, shim := range requestShims {
+		if shim.upgrade(req) {
+			log.Printf("⚠️  Deprecated API usage in %s (client API v%d): %s; support will be removed in API v%d",
+				method, version, shim.deprecated, shim.removedIn)
+		}
+	}
+}
+
+func upgradeIncludeWeekends(req interface{}) bool {
+	switch r := req.(type) {
+	case *alertpb.QueryStatisticsRequest:
+		return upgradeWeekendMode(&r.WeekendMode, r.IncludeWeekends, r.FilterByTimeOfDay)
+	case *alertpb.GetAlertsByNameRequest:
+		return upgradeWeekendMode(&r.WeekendMode, r.IncludeWeekends, r.FilterByTimeOfDay)
+	case *alertpb.SaveStatisticsViewRequest:
+		if r.ViewData != nil {
+			return upgradeWeekendMode(&r.ViewData.WeekendMode, r.ViewData.IncludeWeekends, r.ViewData.FilterByTimeOfDay)
+		}
+	case *alertpb.UpdateStatisticsViewRequest:
+		if r.ViewData != nil {
+			return upgradeWeekendMode(&r.ViewData.WeekendMode, r.ViewData.IncludeWeekends, r.ViewData.FilterByTimeOfDay)
+		}
+	}
+	return false
+}
+
+// upgradeWeekendMode maps the old boolean onto weekend_mode. The flag only
+// ever mattered when time-of-day filtering was enabled.
+func upgradeWeekendMode(weekendMode *string, includeWeekends, filterByTimeOfDay bool) bool {
+	if *weekendMode != "" || !filterByTimeOfDay {
+		return false
+	}
+	if includeWeekends {
+		*weekendMode = "same_hours"
+	} else {
+		*weekendMode = "exclude"
+	}
+	return true
+}
+
+func upgradeViewTimeRangeMode(req interface{}) bool {
+	data := statisticsViewData(req)
+	if data == nil || data.TimeRangeMode != "" || data.StartDate == "" {
+		return false
+	}
+	// Old views only stored computed start/end dates
+	data.TimeRangeMode = "absolute"
+	return true
+}
+
+func statisticsViewData(req interface{}) *alertpb.StatisticsViewData {
+	switch r := req.(type) {
+	case *alertpb.SaveStatisticsViewRequest:
+		return r.ViewData
+	case *alertpb.UpdateStatisticsViewRequest:
+		return r.ViewData
+	}
+	return nil
+}
@@ -0,0 +1,139 @@
+package backend
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"notificator/internal/backend/apiversion"
+	alertpb "notificator/internal/backend/proto/alert"
+)
+
+// recordingStatisticsServer echoes back the weekend mode it received, so the
+// tests can see the request shape after the shims ran.
+type recordingStatisticsServer struct {
+	alertpb.UnimplementedStatisticsServiceServer
+	savedViewData *alertpb.StatisticsViewData
+}
+
+func (r *recordingStatisticsServer) QueryStatistics(ctx context.Context, req *alertpb.QueryStatisticsRequest) (*alertpb.QueryStatisticsResponse, error) {
+	return &alertpb.QueryStatisticsResponse{Success: true, Message: req.WeekendMode}, nil
+}
+
+func (r *recordingStatisticsServer) SaveStatisticsView(ctx context.Context, req *alertpb.SaveStatisticsViewRequest) (*alertpb.SaveStatisticsViewResponse, error) {
+	r.savedViewData = req.ViewData
+	return &alertpb.SaveStatisticsViewResponse{Success: true}, nil
+}
+
+func startVersionedTestServer(t *testing.T) (alertpb.StatisticsServiceClient, *recordingStatisticsServer) {
+	t.Helper()
+
+	s := &Server{}
+	listener := bufconn.Listen(1024 * 1024)
+	grpcServer := grpc.NewServer(
+		grpc.UnaryInterceptor(s.versionUnaryInterceptor),
+		grpc.StreamInterceptor(s.versionStreamInterceptor),
+	)
+	recorder := &recordingStatisticsServer{}
+	alertpb.RegisterStatisticsServiceServer(grpcServer, recorder)
+	go grpcServer.Serve(listener)
+	t.Cleanup(grpcServer.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("failed to dial test server: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	return alertpb.NewStatisticsServiceClient(conn), recorder
+}
+
+func TestAPIVersion_OldAndNewRequestShapes(t *testing.T) {
+	client, recorder := startVersionedTestServer(t)
+
+	t.Run("Legacy client using include_weekends", func(t *testing.T) {
+		var header metadata.MD
+		resp, err := client.QueryStatistics(context.Background(), &alertpb.QueryStatisticsRequest{
+			FilterByTimeOfDay: true,
+			IncludeWeekends:   false,
+		}, grpc.Header(&header))
+		if err != nil || !resp.Success {
+			t.Fatalf("legacy request should succeed, got %v", err)
+		}
+		if resp.Message != "exclude" {
+			t.Errorf("expected include_weekends=false to be upgraded to weekend_mode \"exclude\", got %q", resp.Message)
+		}
+		if version, _ := apiversion.FromMetadata(header); version != apiversion.Current {
+			t.Errorf("expected server to advertise API v%d, got %d", apiversion.Current, version)
+		}
+	})
+
+	t.Run("Current client using weekend_mode", func(t *testing.T) {
+		ctx := apiversion.AppendToOutgoingContext(context.Background())
+		resp, err := client.QueryStatistics(ctx, &alertpb.QueryStatisticsRequest{
+			FilterByTimeOfDay: true,
+			WeekendMode:       "full_weekends",
+		})
+		if err != nil || !resp.Success {
+			t.Fatalf("current request should succeed, got %v", err)
+		}
+		if resp.Message != "full_weekends" {
+			t.Errorf("expected weekend_mode to pass through unchanged, got %q", resp.Message)
+		}
+	})
+
+	t.Run("Current client is not shimmed", func(t *testing.T) {
+		ctx := apiversion.AppendToOutgoingContext(context.Background())
+		resp, err := client.QueryStatistics(ctx, &alertpb.QueryStatisticsRequest{FilterByTimeOfDay: true})
+		if err != nil || !resp.Success {
+			t.Fatalf("current request should succeed, got %v", err)
+		}
+		if resp.Message != "" {
+			t.Errorf("shims must only apply to older clients, got weekend_mode %q", resp.Message)
+		}
+	})
+
+	t.Run("Legacy statistics view without time_range_mode", func(t *testing.T) {
+		_, err := client.SaveStatisticsView(context.Background(), &alertpb.SaveStatisticsViewRequest{
+			Name: "old view",
+			ViewData: &alertpb.StatisticsViewData{
+				DateRangeType: "last_7_days",
+				StartDate:     "2024-01-01",
+				EndDate:       "2024-01-07",
+			},
+		})
+		if err != nil {
+			t.Fatalf("legacy view should be saved, got %v", err)
+		}
+		if recorder.savedViewData.TimeRangeMode != "absolute" {
+			t.Errorf("expected legacy view to be saved as absolute, got %q", recorder.savedViewData.TimeRangeMode)
+		}
+	})
+
+	t.Run("Newer client is served as current", func(t *testing.T) {
+		ctx := metadata.AppendToOutgoingContext(context.Background(), apiversion.MetadataKey, "99")
+		if _, err := client.QueryStatistics(ctx, &alertpb.QueryStatisticsRequest{}); err != nil {
+			t.Fatalf("newer client should be served, got %v", err)
+		}
+	})
+
+	t.Run("Invalid version is rejected", func(t *testing.T) {
+		ctx := metadata.AppendToOutgoingContext(context.Background(), apiversion.MetadataKey, "v2")
+		_, err := client.QueryStatistics(ctx, &alertpb.QueryStatisticsRequest{})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("expected InvalidArgument, got %v", err)
+		}
+	})
+}
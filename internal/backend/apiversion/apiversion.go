@@ -0,0 +1,84 @@
+// Package apiversion defines the version contract between the backend gRPC
+// server and its clients.
+//
+// Versioning policy:
+//
+//   - Clients send their API version in the "x-notificator-api-version"
+//     request metadata. Clients that predate versioning send nothing and are
+//     treated as version 1.
+//   - The server answers every call with its own version in the same header,
+//     so clients can tell which request shapes the server understands.
+//   - Versions below MinSupported are rejected with FailedPrecondition.
+//     Versions newer than Current are served as Current, so a newer client
+//     keeps working against an older server as long as it only uses fields
+//     that server knows about.
+//   - When a request shape is deprecated, the server keeps accepting it for
+//     at least one version: a shim upgrades the old shape to the new one and
+//     logs a deprecation warning naming the replacement and the version in
+//     which the old shape will be removed. Removing a shape means raising
+//     MinSupported past the version that used it.
+package apiversion
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// MetadataKey is the gRPC metadata key carrying the API version.
+	MetadataKey = "x-notificator-api-version"
+
+	// Current is the API version spoken by this build.
+	Current = 2
+
+	// MinSupported is the oldest client version the server still accepts.
+	MinSupported = 1
+
+	// Legacy is assumed for clients that do not send a version.
+	Legacy = 1
+)
+
+// FromIncomingContext returns the client's API version, defaulting to Legacy
+// when the client did not send one.
+func FromIncomingContext(ctx context.Context) (int, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return Legacy, nil
+	}
+	return fromMetadata(md)
+}
+
+// FromMetadata reads the API version from response headers or request
+// metadata. It returns 0 when the peer did not send one.
+func FromMetadata(md metadata.MD) (int, error) {
+	if len(md.Get(MetadataKey)) == 0 {
+		return 0, nil
+	}
+	return fromMetadata(md)
+}
+
+func fromMetadata(md metadata.MD) (int, error) {
+	values := md.Get(MetadataKey)
+	if len(values) == 0 || values[0] == "" {
+		return Legacy, nil
+	}
+
+	version, err := strconv.Atoi(values[0])
+	if err != nil || version < 1 {
+		return 0, fmt.Errorf("invalid API version %q", values[0])
+	}
+	return version, nil
+}
+
+// AppendToOutgoingContext attaches the Current version to an outgoing call.
+func AppendToOutgoingContext(ctx context.Context) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, MetadataKey, strconv.Itoa(Current))
+}
+
+// Header returns the metadata the server sends back with every response.
+func Header() metadata.MD {
+	return metadata.Pairs(MetadataKey, strconv.Itoa(Current))
+}
@@ -0,0 +1,83 @@
+package backend
+
+import (
+	"testing"
+	"time"
+
+	"notificator/config"
+	"notificator/internal/backend/database"
+)
+
+// newCleanupTestServer returns a server over a sqlite database holding one
+// expired and one live resolved alert and session
+func newCleanupTestServer(t *testing.T) *Server {
+	t.Helper()
+
+	db, err := database.NewGormDB("sqlite", config.DatabaseConfig{SQLitePath: t.TempDir() + "/test.db"})
+	if err != nil {
+		t.Fatalf("failed to create test database: %v", err)
+	}
+	if err := db.AutoMigrate(); err != nil {
+		t.Fatalf("failed to migrate test database: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	if _, err := db.CreateResolvedAlert("fp-expired", "test", []byte(`{}`), nil, nil, -1); err != nil {
+		t.Fatalf("failed to seed resolved alert: %v", err)
+	}
+	if _, err := db.CreateResolvedAlert("fp-live", "test", []byte(`{}`), nil, nil, 24); err != nil {
+		t.Fatalf("failed to seed resolved alert: %v", err)
+	}
+
+	user, err := db.CreateUser("alice", "alice@example.com", "hash")
+	if err != nil {
+		t.Fatalf("failed to create user: %v", err)
+	}
+	if err := db.CreateSession(user.ID, "session-expired", time.Now().Add(-time.Minute)); err != nil {
+		t.Fatalf("failed to create session: %v", err)
+	}
+	if err := db.CreateSession(user.ID, "session-live", time.Now().Add(time.Hour)); err != nil {
+		t.Fatalf("failed to create session: %v", err)
+	}
+
+	s := NewServer(config.DefaultConfig(), "sqlite")
+	s.db = db
+	return s
+}
+
+func TestPerformExpiryCleanup_PurgesExpiredRowsAndCountsThem(t *testing.T) {
+	s := newCleanupTestServer(t)
+
+	s.performExpiryCleanup()
+
+	if got := s.purgedResolvedAlerts.Load(); got != 1 {
+		t.Errorf("expected 1 purged resolved alert, got %d", got)
+	}
+	if got := s.purgedSessions.Load(); got != 1 {
+		t.Errorf("expected 1 purged session, got %d", got)
+	}
+	if count, err := s.db.GetResolvedAlertsCount(); err != nil || count != 1 {
+		t.Errorf("expected the live resolved alert to remain, got %d (%v)", count, err)
+	}
+	if _, err := s.db.GetUserBySession("session-live"); err != nil {
+		t.Errorf("expected the live session to remain: %v", err)
+	}
+
+	s.performExpiryCleanup()
+	if got := s.purgedResolvedAlerts.Load() + s.purgedSessions.Load(); got != 2 {
+		t.Errorf("expected a second sweep to purge nothing, counters at %d", got)
+	}
+}
+
+func TestStopCleanupJobs_IsIdempotent(t *testing.T) {
+	s := NewServer(config.DefaultConfig(), "sqlite")
+
+	s.stopCleanupJobs()
+	s.stopCleanupJobs()
+
+	select {
+	case <-s.cleanupDone:
+	default:
+		t.Error("expected the cleanup jobs to be signalled to stop")
+	}
+}
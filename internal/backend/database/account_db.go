@@ -0,0 +1,140 @@
+package database
+
+import (
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+
+	"notificator/internal/backend/models"
+	mainmodels "notificator/internal/models"
+)
+
+// userOwnedModels lists the tables holding per-user rows keyed by user_id,
+// removed together with the user by DeleteUserAccount
+var userOwnedModels = []interface{}{
+	&models.Session{},
+	&models.Comment{},
+	&models.CommentTag{},
+	&models.Acknowledgment{},
+	&models.Escalation{},
+	&models.Mention{},
+	&models.AlertActivity{},
+	&mainmodels.UserColorPreference{},
+	&models.NotificationPreference{},
+	&models.UserHiddenAlert{},
+	&models.UserHiddenRule{},
+	&models.UserDefaultFilterPreset{},
+	&models.FilterPreset{},
+	&models.UserColumnPreference{},
+	&models.UserGroup{},
+	&models.OAuthToken{},
+	&models.OAuthGroupCache{},
+	&models.UserSentryConfig{},
+	&models.StatisticsAggregate{},
+	&models.UserDefaultStatisticsView{},
+	&models.StatisticsView{},
+	&models.AnnotationButtonConfig{},
+}
+
+// DeleteUserAccount removes a user and every row they own in one transaction.
+// Other users' defaults pointing at the user's shared presets or views are
+// cleared first so they don't reference deleted rows.
+func (gdb *GormDB) DeleteUserAccount(userID string) error {
+	return gdb.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("filter_preset_id IN (?)",
+			tx.Model(&models.FilterPreset{}).Select("id").Where("user_id = ?", userID)).
+			Delete(&models.UserDefaultFilterPreset{}).Error; err != nil {
+			return fmt.Errorf("failed to clear default filter presets: %w", err)
+		}
+		if err := tx.Where("statistics_view_id IN (?)",
+			tx.Model(&models.StatisticsView{}).Select("id").Where("user_id = ?", userID)).
+			Delete(&models.UserDefaultStatisticsView{}).Error; err != nil {
+			return fmt.Errorf("failed to clear default statistics views: %w", err)
+		}
+
+		for _, model := range userOwnedModels {
+			// Unscoped so soft-deletable tables are really purged
+			if err := tx.Unscoped().Where("user_id = ?", userID).Delete(model).Error; err != nil {
+				return fmt.Errorf("failed to delete %T rows: %w", model, err)
+			}
+		}
+
+		if err := tx.Where("author_id = ?", userID).Delete(&models.Mention{}).Error; err != nil {
+			return fmt.Errorf("failed to delete mentions made by the user: %w", err)
+		}
+
+		// Audit entries and OAuth flows outlive the user, without the link
+		if err := tx.Model(&models.OAuthAuditLog{}).Where("user_id = ?", userID).Update("user_id", nil).Error; err != nil {
+			return fmt.Errorf("failed to detach audit logs: %w", err)
+		}
+		if err := tx.Model(&models.OAuthSession{}).Where("user_id = ?", userID).Update("user_id", nil).Error; err != nil {
+			return fmt.Errorf("failed to detach OAuth sessions: %w", err)
+		}
+
+		result := tx.Delete(&models.User{}, "id = ?", userID)
+		if result.Error != nil {
+			return fmt.Errorf("failed to delete user: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		return nil
+	})
+}
+
+// ExportUserData collects everything stored about a user
+func (gdb *GormDB) ExportUserData(userID string) (*models.UserDataExport, error) {
+	export := &models.UserDataExport{ExportedAt: time.Now()}
+
+	if err := gdb.db.First(&export.User, "id = ?", userID).Error; err != nil {
+		return nil, err
+	}
+
+	byUser := []struct {
+		name string
+		dest interface{}
+	}{
+		{"comments", &export.Comments},
+		{"comment tags", &export.CommentTags},
+		{"alert activity", &export.AlertActivities},
+		{"acknowledgments", &export.Acknowledgments},
+		{"escalations", &export.Escalations},
+		{"hidden alerts", &export.HiddenAlerts},
+		{"hidden rules", &export.HiddenRules},
+		{"color preferences", &export.ColorPreferences},
+		{"notification preferences", &export.NotificationPreferences},
+		{"filter presets", &export.FilterPresets},
+		{"column preferences", &export.ColumnPreferences},
+		{"statistics views", &export.StatisticsViews},
+		{"annotation button configs", &export.AnnotationButtonConfigs},
+		{"groups", &export.Groups},
+	}
+	for _, table := range byUser {
+		if err := gdb.db.Where("user_id = ?", userID).Order("created_at").Find(table.dest).Error; err != nil {
+			return nil, fmt.Errorf("failed to export %s: %w", table.name, err)
+		}
+	}
+
+	// Select the session timestamps only, never the session IDs
+	if err := gdb.db.Model(&models.Session{}).Select("created_at, expires_at").
+		Where("user_id = ?", userID).Order("created_at").Find(&export.Sessions).Error; err != nil {
+		return nil, fmt.Errorf("failed to export sessions: %w", err)
+	}
+
+	// Mentions of the user and mentions they made
+	if err := gdb.db.Where("user_id = ? OR author_id = ?", userID, userID).
+		Order("created_at").Find(&export.Mentions).Error; err != nil {
+		return nil, fmt.Errorf("failed to export mentions: %w", err)
+	}
+
+	var sentryConfig models.UserSentryConfig
+	err := gdb.db.Where("user_id = ?", userID).First(&sentryConfig).Error
+	if err == nil {
+		export.SentryConfig = &sentryConfig
+	} else if err != gorm.ErrRecordNotFound {
+		return nil, fmt.Errorf("failed to export Sentry config: %w", err)
+	}
+
+	return export, nil
+}
@@ -0,0 +1,116 @@
+package database
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"notificator/internal/backend/models"
+)
+
+// CreateAlertActivity appends an entry to an alert's timeline
+func (gdb *GormDB) CreateAlertActivity(activity *models.AlertActivity) error {
+	if err := gdb.db.Create(activity).Error; err != nil {
+		return fmt.Errorf("failed to create alert activity: %w", err)
+	}
+	return nil
+}
+
+// DeleteAlertActivityByRef drops the timeline entries pointing at a row that
+// no longer exists, e.g. a deleted comment
+func (gdb *GormDB) DeleteAlertActivityByRef(activityType, refID string) error {
+	return gdb.db.Where("type = ? AND ref_id = ?", activityType, refID).Delete(&models.AlertActivity{}).Error
+}
+
+// GetAlertActivity returns up to limit timeline entries for an alert, newest
+// first. Pass the created_at and id of the last entry already seen to get the
+// next page; a zero before starts from the newest entry.
+func (gdb *GormDB) GetAlertActivity(alertKey string, limit int, before time.Time, beforeID string) ([]models.AlertActivityWithUser, error) {
+	query := gdb.db.Table("alert_activities").
+		Select("alert_activities.*, users.username").
+		Joins("LEFT JOIN users ON users.id = alert_activities.user_id").
+		Where("alert_activities.alert_key = ?", alertKey)
+
+	if !before.IsZero() {
+		// Entries can share a timestamp, so the id breaks ties
+		query = query.Where("alert_activities.created_at < ? OR (alert_activities.created_at = ? AND alert_activities.id < ?)", before, before, beforeID)
+	}
+
+	var activities []models.AlertActivityWithUser
+	err := query.
+		Order("alert_activities.created_at DESC, alert_activities.id DESC").
+		Limit(limit).
+		Find(&activities).Error
+
+	return activities, err
+}
+
+// backfillAlertActivities seeds the timeline from existing comments,
+// acknowledgments, escalations and hidden alerts the first time the
+// alert_activities table is created.
+func (gdb *GormDB) backfillAlertActivities() error {
+	var count int64
+	if err := gdb.db.Model(&models.AlertActivity{}).Count(&count).Error; err != nil {
+		return err
+	}
+	if count > 0 {
+		return nil
+	}
+
+	var activities []models.AlertActivity
+
+	var comments []models.Comment
+	if err := gdb.db.Find(&comments).Error; err != nil {
+		return err
+	}
+	for _, c := range comments {
+		activities = append(activities, models.AlertActivity{
+			AlertKey: c.AlertKey, Type: models.ActivityComment, UserID: c.UserID,
+			RefID: c.ID, Content: c.Content, CreatedAt: c.CreatedAt,
+		})
+	}
+
+	var acks []models.Acknowledgment
+	if err := gdb.db.Find(&acks).Error; err != nil {
+		return err
+	}
+	for _, a := range acks {
+		activities = append(activities, models.AlertActivity{
+			AlertKey: a.AlertKey, Type: models.ActivityAck, UserID: a.UserID,
+			RefID: a.ID, Content: a.Reason, CreatedAt: a.CreatedAt,
+		})
+	}
+
+	var escalations []models.Escalation
+	if err := gdb.db.Find(&escalations).Error; err != nil {
+		return err
+	}
+	for _, e := range escalations {
+		activities = append(activities, models.AlertActivity{
+			AlertKey: e.AlertKey, Type: models.ActivityEscalation, UserID: e.UserID,
+			RefID: e.ID, Content: e.Reason, Target: e.EscalatedTo, CreatedAt: e.CreatedAt,
+		})
+	}
+
+	var hidden []models.UserHiddenAlert
+	if err := gdb.db.Find(&hidden).Error; err != nil {
+		return err
+	}
+	for _, h := range hidden {
+		activities = append(activities, models.AlertActivity{
+			AlertKey: h.Fingerprint, Type: models.ActivityHide, UserID: h.UserID,
+			RefID: h.ID, Content: h.Reason, CreatedAt: h.CreatedAt,
+		})
+	}
+
+	if len(activities) == 0 {
+		return nil
+	}
+
+	if err := gdb.db.CreateInBatches(activities, 500).Error; err != nil {
+		return err
+	}
+
+	log.Printf("✅ Backfilled %d alert activity entries", len(activities))
+	return nil
+}
@@ -0,0 +1,56 @@
+package database
+
+import (
+	"fmt"
+
+	"gorm.io/gorm"
+
+	"notificator/internal/backend/models"
+)
+
+// CreateCommentTags stores the #tags of a comment
+func (gdb *GormDB) CreateCommentTags(comment *models.Comment) error {
+	return createCommentTags(gdb.db, comment)
+}
+
+func createCommentTags(tx *gorm.DB, comment *models.Comment) error {
+	tags := models.ParseCommentTags(comment.Content)
+	if len(tags) == 0 {
+		return nil
+	}
+
+	rows := make([]models.CommentTag, 0, len(tags))
+	for _, tag := range tags {
+		rows = append(rows, models.CommentTag{
+			CommentID: comment.ID,
+			AlertKey:  comment.AlertKey,
+			UserID:    comment.UserID,
+			Tag:       tag,
+		})
+	}
+	if err := tx.Create(&rows).Error; err != nil {
+		return fmt.Errorf("failed to create comment tags: %w", err)
+	}
+	return nil
+}
+
+// DeleteCommentTagsByComment removes the tags of a comment
+func (gdb *GormDB) DeleteCommentTagsByComment(commentID string) error {
+	return gdb.db.Where("comment_id = ?", commentID).Delete(&models.CommentTag{}).Error
+}
+
+// GetCommentsByTag returns up to limit comments tagged with tag, newest
+// first. An empty alertKey searches every alert.
+func (gdb *GormDB) GetCommentsByTag(tag, alertKey string, limit int) ([]models.CommentWithUser, error) {
+	query := gdb.db.Table("comments").
+		Select("comments.*, users.username").
+		Joins("JOIN users ON users.id = comments.user_id").
+		Where("comments.id IN (?)", gdb.db.Model(&models.CommentTag{}).Select("comment_id").Where("tag = ?", models.NormalizeCommentTag(tag)))
+	if alertKey != "" {
+		query = query.Where("comments.alert_key = ?", alertKey)
+	}
+
+	var comments []models.CommentWithUser
+	err := query.Order("comments.created_at DESC").Limit(limit).Find(&comments).Error
+	return comments, err
+}
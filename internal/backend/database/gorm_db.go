@@ -7,11 +7,14 @@ import (
 	"log"
 	"os"
 	"path/filepath"
+	"strings"
+	"sync/atomic"
 	"time"
 
 	"gorm.io/driver/postgres"
 	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 	"gorm.io/gorm/logger"
 
 	"notificator/config"
@@ -20,14 +23,20 @@ import (
 )
 
 type GormDB struct {
-	db     *gorm.DB
-	dbType string // "sqlite" or "postgres"
+	db       *gorm.DB
+	dbType   string      // "sqlite" or "postgres"
+	migrated atomic.Bool // Set once AutoMigrate has completed
 }
 
 func NewGormDB(dbType string, cfg config.DatabaseConfig) (*GormDB, error) {
 	var db *gorm.DB
 	var err error
 
+	maxOpen, maxIdle, maxLifetime, err := cfg.GetPoolSettings()
+	if err != nil {
+		return nil, err
+	}
+
 	gormConfig := &gorm.Config{
 		Logger: logger.Default.LogMode(logger.Info),
 		NowFunc: func() time.Time {
@@ -78,9 +87,10 @@ func NewGormDB(dbType string, cfg config.DatabaseConfig) (*GormDB, error) {
 		return nil, fmt.Errorf("failed to get underlying sql.DB: %w", err)
 	}
 
-	sqlDB.SetMaxIdleConns(10)
-	sqlDB.SetMaxOpenConns(100)
-	sqlDB.SetConnMaxLifetime(time.Hour)
+	sqlDB.SetMaxIdleConns(maxIdle)
+	sqlDB.SetMaxOpenConns(maxOpen)
+	sqlDB.SetConnMaxLifetime(maxLifetime)
+	log.Printf("📊 Connection pool: max_open=%d max_idle=%d max_lifetime=%s", maxOpen, maxIdle, maxLifetime)
 
 	return &GormDB{
 		db:     db,
@@ -121,8 +131,13 @@ func (gdb *GormDB) AutoMigrate() error {
 		&models.User{},
 		&models.Session{},
 		&models.Comment{},
+		&models.CommentTag{},
 		&models.Acknowledgment{},
+		&models.Escalation{},
+		&models.Mention{},
+		&models.AlertActivity{},
 		&models.ResolvedAlert{},
+		&models.ResolvedAlertSettings{},
 		&mainmodels.UserColorPreference{},
 		// Browser notifications
 		&models.NotificationPreference{},
@@ -155,6 +170,11 @@ func (gdb *GormDB) AutoMigrate() error {
 		return fmt.Errorf("auto migration failed: %w", err)
 	}
 
+	// Needs the tables above, so it can't live in RunCustomMigrations
+	if err := gdb.backfillAlertActivities(); err != nil {
+		return fmt.Errorf("failed to backfill alert activities: %w", err)
+	}
+
 	// Create PostgreSQL-specific indexes for better JSONB query performance
 	if gdb.IsPostgreSQL() {
 		if err := gdb.createPostgreSQLIndexes(); err != nil {
@@ -163,10 +183,16 @@ func (gdb *GormDB) AutoMigrate() error {
 		}
 	}
 
+	gdb.migrated.Store(true)
 	log.Println("✅ Database migrations completed")
 	return nil
 }
 
+// Migrated reports whether AutoMigrate has completed on this connection
+func (gdb *GormDB) Migrated() bool {
+	return gdb.migrated.Load()
+}
+
 // createPostgreSQLIndexes creates PostgreSQL-specific indexes for optimal performance
 func (gdb *GormDB) createPostgreSQLIndexes() error {
 	log.Println("Creating PostgreSQL-specific indexes...")
@@ -232,10 +258,15 @@ func (gdb *GormDB) UpdateLastLogin(userID string) error {
 	return gdb.db.Model(&models.User{}).Where("id = ?", userID).Update("last_login", &now).Error
 }
 
+// UpdateUserPassword replaces the user's bcrypt password hash
+func (gdb *GormDB) UpdateUserPassword(userID, passwordHash string) error {
+	return gdb.db.Model(&models.User{}).Where("id = ?", userID).Update("password_hash", passwordHash).Error
+}
+
 func (gdb *GormDB) SearchUsers(query string, limit int) ([]models.User, error) {
 	var users []models.User
 
-	err := gdb.db.Where("LOWER(username) LIKE LOWER(?)", query+"%").
+	err := gdb.db.Where("LOWER(username) LIKE LOWER(?) AND id <> ?", query+"%", models.SystemUserID).
 		Limit(limit).
 		Order("username").
 		Find(&users).Error
@@ -252,12 +283,12 @@ func (gdb *GormDB) ListUsers(limit, offset int) ([]models.User, int64, error) {
 	var totalCount int64
 
 	// Get total count
-	if err := gdb.db.Model(&models.User{}).Count(&totalCount).Error; err != nil {
+	if err := gdb.db.Model(&models.User{}).Where("id <> ?", models.SystemUserID).Count(&totalCount).Error; err != nil {
 		return nil, 0, fmt.Errorf("failed to count users: %w", err)
 	}
 
 	// Get paginated users
-	query := gdb.db.Order("username")
+	query := gdb.db.Where("id <> ?", models.SystemUserID).Order("username")
 	if limit > 0 {
 		query = query.Limit(limit)
 	}
@@ -272,6 +303,56 @@ func (gdb *GormDB) ListUsers(limit, offset int) ([]models.User, int64, error) {
 	return users, totalCount, nil
 }
 
+// SetUserDisabled enables or disables a user. Disabling also revokes the
+// user's sessions; the account's data is left untouched.
+func (gdb *GormDB) SetUserDisabled(userID string, disabled bool) error {
+	return gdb.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&models.User{}).Where("id = ? AND id <> ?", userID, models.SystemUserID).Update("disabled", disabled)
+		if result.Error != nil {
+			return fmt.Errorf("failed to update user: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		if disabled {
+			if err := tx.Where("user_id = ?", userID).Delete(&models.Session{}).Error; err != nil {
+				return fmt.Errorf("failed to revoke sessions: %w", err)
+			}
+		}
+		return nil
+	})
+}
+
+// CountUsers returns the number of registered users, leaving out the system user
+func (gdb *GormDB) CountUsers() (int64, error) {
+	var count int64
+	if err := gdb.db.Model(&models.User{}).Where("id <> ?", models.SystemUserID).Count(&count).Error; err != nil {
+		return 0, fmt.Errorf("failed to count users: %w", err)
+	}
+	return count, nil
+}
+
+// SetUserAdmin grants or revokes a user's admin flag
+func (gdb *GormDB) SetUserAdmin(userID string, isAdmin bool) error {
+	if err := gdb.db.Model(&models.User{}).Where("id = ? AND id <> ?", userID, models.SystemUserID).Update("is_admin", isAdmin).Error; err != nil {
+		return fmt.Errorf("failed to update user: %w", err)
+	}
+	return nil
+}
+
+// PromoteSoleUser makes the user an admin if no other user exists. The check
+// and the update are one statement, so of two users registering into an empty
+// table at once, neither is promoted rather than both. It reports whether the
+// user was promoted.
+func (gdb *GormDB) PromoteSoleUser(userID string) (bool, error) {
+	others := gdb.db.Model(&models.User{}).Select("1").Where("id <> ? AND id <> ?", userID, models.SystemUserID)
+	result := gdb.db.Model(&models.User{}).Where("id = ? AND NOT EXISTS (?)", userID, others).Update("is_admin", true)
+	if result.Error != nil {
+		return false, fmt.Errorf("failed to update user: %w", result.Error)
+	}
+	return result.RowsAffected == 1, nil
+}
+
 func (gdb *GormDB) CreateSession(userID, sessionID string, expiresAt time.Time) error {
 	session := &models.Session{
 		ID:        sessionID,
@@ -285,7 +366,7 @@ func (gdb *GormDB) CreateSession(userID, sessionID string, expiresAt time.Time)
 func (gdb *GormDB) GetUserBySession(sessionID string) (*models.User, error) {
 	var user models.User
 	err := gdb.db.Joins("JOIN sessions ON sessions.user_id = users.id").
-		Where("sessions.id = ? AND sessions.expires_at > ?", sessionID, time.Now()).
+		Where("sessions.id = ? AND sessions.expires_at > ? AND users.disabled = ?", sessionID, time.Now(), false).
 		First(&user).Error
 
 	if err != nil {
@@ -294,12 +375,59 @@ func (gdb *GormDB) GetUserBySession(sessionID string) (*models.User, error) {
 	return &user, nil
 }
 
+// GetUserBySessionWithExpiry is GetUserBySession that also returns when the
+// session expires, in the same query
+func (gdb *GormDB) GetUserBySessionWithExpiry(sessionID string) (*models.User, time.Time, error) {
+	var row struct {
+		models.User
+		SessionExpiresAt time.Time
+	}
+	err := gdb.db.Table("users").
+		Select("users.*, sessions.expires_at AS session_expires_at").
+		Joins("JOIN sessions ON sessions.user_id = users.id").
+		Where("sessions.id = ? AND sessions.expires_at > ? AND users.disabled = ?", sessionID, time.Now(), false).
+		Take(&row).Error
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	return &row.User, row.SessionExpiresAt, nil
+}
+
+// GetSessionExpiry returns when an active session expires
+func (gdb *GormDB) GetSessionExpiry(sessionID string) (time.Time, error) {
+	var session models.Session
+	err := gdb.db.Where("id = ? AND expires_at > ?", sessionID, time.Now()).First(&session).Error
+	if err != nil {
+		return time.Time{}, err
+	}
+	return session.ExpiresAt, nil
+}
+
+// RefreshSession slides an active session's expiry to now+duration, keeping
+// its ID. Expired or unknown sessions are not revived.
+func (gdb *GormDB) RefreshSession(sessionID string, duration time.Duration) (time.Time, error) {
+	now := time.Now()
+	expiresAt := now.Add(duration)
+	result := gdb.db.Model(&models.Session{}).
+		Where("id = ? AND expires_at > ?", sessionID, now).
+		Update("expires_at", expiresAt)
+	if result.Error != nil {
+		return time.Time{}, fmt.Errorf("failed to refresh session: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return time.Time{}, gorm.ErrRecordNotFound
+	}
+	return expiresAt, nil
+}
+
 func (gdb *GormDB) DeleteSession(sessionID string) error {
 	return gdb.db.Delete(&models.Session{}, "id = ?", sessionID).Error
 }
 
-func (gdb *GormDB) CleanupExpiredSessions() error {
-	return gdb.db.Where("expires_at < ?", time.Now()).Delete(&models.Session{}).Error
+// CleanupExpiredSessions deletes sessions past their expiry and returns how many were removed
+func (gdb *GormDB) CleanupExpiredSessions() (int64, error) {
+	result := gdb.db.Where("expires_at < ?", time.Now()).Delete(&models.Session{})
+	return result.RowsAffected, result.Error
 }
 
 // ConnectedUserInfo represents a user with active session(s)
@@ -376,6 +504,35 @@ func (gdb *GormDB) GetComments(alertKey string) ([]models.CommentWithUser, error
 	return comments, err
 }
 
+// GetCommentsPage returns up to limit comments of an alert, newest first,
+// along with the alert's total comment count. Pass the created_at and id of
+// the oldest comment already loaded to get the next page; a zero before
+// starts from the newest comment.
+func (gdb *GormDB) GetCommentsPage(alertKey string, limit int, before time.Time, beforeID string) ([]models.CommentWithUser, int64, error) {
+	var total int64
+	if err := gdb.db.Model(&models.Comment{}).Where("alert_key = ?", alertKey).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	query := gdb.db.Table("comments").
+		Select("comments.*, users.username").
+		Joins("JOIN users ON users.id = comments.user_id").
+		Where("comments.alert_key = ?", alertKey)
+
+	if !before.IsZero() {
+		// Comments can share a timestamp, so the id breaks ties
+		query = query.Where("comments.created_at < ? OR (comments.created_at = ? AND comments.id < ?)", before, before, beforeID)
+	}
+
+	var comments []models.CommentWithUser
+	err := query.
+		Order("comments.created_at DESC, comments.id DESC").
+		Limit(limit).
+		Find(&comments).Error
+
+	return comments, total, err
+}
+
 // GetCommentCountsBatch retrieves comment counts for multiple alert keys in a single query.
 // This solves the N+1 query problem when loading comment counts for many alerts.
 // Returns a map of alert_key -> count.
@@ -421,7 +578,9 @@ func (gdb *GormDB) DeleteComment(commentID, userID string) error {
 	return nil
 }
 
-func (gdb *GormDB) CreateAcknowledgment(alertKey, userID, reason string) (*models.AcknowledgmentWithUser, error) {
+// CreateAcknowledgment replaces the user's acknowledgment of an alert. A nil
+// expiry keeps it until it is removed.
+func (gdb *GormDB) CreateAcknowledgment(alertKey, userID, reason string, expiry *models.AcknowledgmentExpiry) (*models.AcknowledgmentWithUser, error) {
 	gdb.db.Where("alert_key = ? AND user_id = ?", alertKey, userID).Delete(&models.Acknowledgment{})
 
 	ack := &models.Acknowledgment{
@@ -429,6 +588,7 @@ func (gdb *GormDB) CreateAcknowledgment(alertKey, userID, reason string) (*model
 		UserID:   userID,
 		Reason:   reason,
 	}
+	applyAcknowledgmentExpiry(ack, expiry)
 
 	if err := gdb.db.Create(ack).Error; err != nil {
 		return nil, fmt.Errorf("failed to create acknowledgment: %w", err)
@@ -437,6 +597,67 @@ func (gdb *GormDB) CreateAcknowledgment(alertKey, userID, reason string) (*model
 	return gdb.GetAcknowledgmentWithUser(ack.ID)
 }
 
+// CreateAcknowledgments acknowledges several alerts for one user in a single
+// transaction. A key that fails is rolled back to its savepoint and left out of
+// the returned map, so the others still go through.
+func (gdb *GormDB) CreateAcknowledgments(alertKeys []string, userID, reason string, expiry *models.AcknowledgmentExpiry) (map[string]*models.Acknowledgment, error) {
+	tx := gdb.db.Begin()
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	created := make(map[string]*models.Acknowledgment, len(alertKeys))
+	for i, alertKey := range alertKeys {
+		savepoint := fmt.Sprintf("ack_%d", i)
+		if err := tx.SavePoint(savepoint).Error; err != nil {
+			tx.Rollback()
+			return nil, fmt.Errorf("failed to create savepoint: %w", err)
+		}
+
+		ack := &models.Acknowledgment{
+			AlertKey: alertKey,
+			UserID:   userID,
+			Reason:   reason,
+		}
+		applyAcknowledgmentExpiry(ack, expiry)
+
+		err := tx.Where("alert_key = ? AND user_id = ?", alertKey, userID).Delete(&models.Acknowledgment{}).Error
+		if err == nil {
+			err = tx.Create(ack).Error
+		}
+		if err != nil {
+			log.Printf("Failed to acknowledge alert %s: %v", alertKey, err)
+			if err := tx.RollbackTo(savepoint).Error; err != nil {
+				tx.Rollback()
+				return nil, fmt.Errorf("failed to roll back to savepoint: %w", err)
+			}
+			continue
+		}
+
+		created[alertKey] = ack
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return nil, fmt.Errorf("failed to commit acknowledgments: %w", err)
+	}
+
+	return created, nil
+}
+
+func applyAcknowledgmentExpiry(ack *models.Acknowledgment, expiry *models.AcknowledgmentExpiry) {
+	if expiry == nil {
+		return
+	}
+	expiresAt := expiry.ExpiresAt
+	ack.ExpiresAt = &expiresAt
+	ack.Renotify = expiry.Renotify
+}
+
 func (gdb *GormDB) GetAcknowledgmentWithUser(ackID string) (*models.AcknowledgmentWithUser, error) {
 	var result models.AcknowledgmentWithUser
 	err := gdb.db.Table("acknowledgments").
@@ -463,6 +684,66 @@ func (gdb *GormDB) GetAcknowledgments(alertKey string) ([]models.AcknowledgmentW
 	return acks, err
 }
 
+// GetAcknowledgmentCountsBatch counts the acknowledgments of each of the given
+// alert keys in a single query, like GetCommentCountsBatch. Keys without
+// acknowledgments are absent from the map.
+func (gdb *GormDB) GetAcknowledgmentCountsBatch(alertKeys []string) (map[string]int, error) {
+	result := make(map[string]int)
+
+	if len(alertKeys) == 0 {
+		return result, nil
+	}
+
+	type countResult struct {
+		AlertKey string `gorm:"column:alert_key"`
+		Count    int    `gorm:"column:count"`
+	}
+
+	var counts []countResult
+	err := gdb.db.Table("acknowledgments").
+		Select("alert_key, COUNT(*) as count").
+		Where("alert_key IN ?", alertKeys).
+		Group("alert_key").
+		Find(&counts).Error
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get acknowledgment counts batch: %w", err)
+	}
+
+	for _, c := range counts {
+		result[c.AlertKey] = c.Count
+	}
+
+	return result, nil
+}
+
+// GetAcknowledgmentsPage returns up to limit acknowledgments of an alert, newest
+// first, along with the alert's total count. Like GetCommentsPage, before and
+// beforeID are the oldest acknowledgment already loaded.
+func (gdb *GormDB) GetAcknowledgmentsPage(alertKey string, limit int, before time.Time, beforeID string) ([]models.AcknowledgmentWithUser, int64, error) {
+	var total int64
+	if err := gdb.db.Model(&models.Acknowledgment{}).Where("alert_key = ?", alertKey).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	query := gdb.db.Table("acknowledgments").
+		Select("acknowledgments.*, users.username").
+		Joins("JOIN users ON users.id = acknowledgments.user_id").
+		Where("acknowledgments.alert_key = ?", alertKey)
+
+	if !before.IsZero() {
+		query = query.Where("acknowledgments.created_at < ? OR (acknowledgments.created_at = ? AND acknowledgments.id < ?)", before, before, beforeID)
+	}
+
+	var acks []models.AcknowledgmentWithUser
+	err := query.
+		Order("acknowledgments.created_at DESC, acknowledgments.id DESC").
+		Limit(limit).
+		Find(&acks).Error
+
+	return acks, total, err
+}
+
 func (gdb *GormDB) DeleteAcknowledgment(alertKey, userID string) error {
 	result := gdb.db.Where("alert_key = ? AND user_id = ?", alertKey, userID).Delete(&models.Acknowledgment{})
 	if result.Error != nil {
@@ -474,6 +755,83 @@ func (gdb *GormDB) DeleteAcknowledgment(alertKey, userID string) error {
 	return nil
 }
 
+// DeleteAcknowledgmentByID removes a single acknowledgment of an alert, only
+// if it belongs to userID
+func (gdb *GormDB) DeleteAcknowledgmentByID(ackID, alertKey, userID string) error {
+	result := gdb.db.Where("id = ? AND alert_key = ? AND user_id = ?", ackID, alertKey, userID).Delete(&models.Acknowledgment{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("acknowledgment not found or not authorized")
+	}
+	return nil
+}
+
+// ExpireAcknowledgments deletes the acknowledgments whose expiry has passed
+// and returns them, so their alerts can be announced as unacknowledged again
+func (gdb *GormDB) ExpireAcknowledgments() ([]models.Acknowledgment, error) {
+	var expired []models.Acknowledgment
+	if err := gdb.db.Where("expires_at IS NOT NULL AND expires_at <= ?", time.Now()).Find(&expired).Error; err != nil {
+		return nil, fmt.Errorf("failed to query expired acknowledgments: %w", err)
+	}
+	if len(expired) == 0 {
+		return nil, nil
+	}
+
+	// Delete by ID: an acknowledgment renewed since the query has a new one
+	ids := make([]string, len(expired))
+	for i, ack := range expired {
+		ids[i] = ack.ID
+	}
+	if err := gdb.db.Where("id IN ?", ids).Delete(&models.Acknowledgment{}).Error; err != nil {
+		return nil, fmt.Errorf("failed to delete expired acknowledgments: %w", err)
+	}
+	return expired, nil
+}
+
+func (gdb *GormDB) CreateEscalation(alertKey, userID, escalatedTo, targetType, reason string) (*models.EscalationWithUser, error) {
+	escalation := &models.Escalation{
+		AlertKey:    alertKey,
+		UserID:      userID,
+		EscalatedTo: escalatedTo,
+		TargetType:  targetType,
+		Reason:      reason,
+	}
+
+	if err := gdb.db.Create(escalation).Error; err != nil {
+		return nil, fmt.Errorf("failed to create escalation: %w", err)
+	}
+
+	return gdb.GetEscalationWithUser(escalation.ID)
+}
+
+func (gdb *GormDB) GetEscalationWithUser(escalationID string) (*models.EscalationWithUser, error) {
+	var result models.EscalationWithUser
+	err := gdb.db.Table("escalations").
+		Select("escalations.*, users.username").
+		Joins("JOIN users ON users.id = escalations.user_id").
+		Where("escalations.id = ?", escalationID).
+		First(&result).Error
+
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (gdb *GormDB) GetEscalations(alertKey string) ([]models.EscalationWithUser, error) {
+	var escalations []models.EscalationWithUser
+	err := gdb.db.Table("escalations").
+		Select("escalations.*, users.username").
+		Joins("JOIN users ON users.id = escalations.user_id").
+		Where("escalations.alert_key = ?", alertKey).
+		Order("escalations.created_at DESC").
+		Find(&escalations).Error
+
+	return escalations, err
+}
+
 // GetAllAcknowledgedAlerts retrieves the latest acknowledgment for each of the
 // given alert keys in a single query, mirroring GetCommentCountsBatch so the
 // scan is bounded by the number of live alerts instead of the whole table.
@@ -510,8 +868,53 @@ func (gdb *GormDB) GetAllAcknowledgedAlerts(alertKeys []string) (map[string]mode
 }
 
 func (gdb *GormDB) CreateResolvedAlert(fingerprint, source string, alertData, comments, acknowledgments []byte, ttlHours int) (*models.ResolvedAlert, error) {
+	resolvedAlert := newResolvedAlert(fingerprint, source, alertData, comments, acknowledgments, ttlHours)
+
+	if err := gdb.db.Create(resolvedAlert).Error; err != nil {
+		return nil, fmt.Errorf("failed to create resolved alert: %w", err)
+	}
+
+	return resolvedAlert, nil
+}
+
+// CreateResolvedAlertOnce stores a resolved alert unless an unexpired capture
+// of the same firing exists, i.e. one for the fingerprint resolved at or after
+// startsAt. It returns the stored row and whether it was newly created, so
+// several WebUI instances noticing the same resolution store it only once.
+func (gdb *GormDB) CreateResolvedAlertOnce(fingerprint, source string, alertData, comments, acknowledgments []byte, ttlHours int, startsAt time.Time) (*models.ResolvedAlert, bool, error) {
+	resolvedAlert := newResolvedAlert(fingerprint, source, alertData, comments, acknowledgments, ttlHours)
+	created := false
+
+	err := gdb.db.Transaction(func(tx *gorm.DB) error {
+		var existing models.ResolvedAlert
+		err := tx.Where("fingerprint = ? AND resolved_at >= ? AND expires_at > ?", fingerprint, startsAt, time.Now()).
+			Order("resolved_at DESC").
+			First(&existing).Error
+		if err == nil {
+			resolvedAlert = &existing
+			return nil
+		}
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+
+		if err := tx.Create(resolvedAlert).Error; err != nil {
+			return err
+		}
+		created = true
+		return nil
+	})
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to create resolved alert: %w", err)
+	}
+
+	return resolvedAlert, created, nil
+}
+
+// newResolvedAlert builds a resolved alert row resolved now and expiring after ttlHours
+func newResolvedAlert(fingerprint, source string, alertData, comments, acknowledgments []byte, ttlHours int) *models.ResolvedAlert {
 	now := time.Now()
-	resolvedAlert := &models.ResolvedAlert{
+	return &models.ResolvedAlert{
 		Fingerprint:     fingerprint,
 		AlertData:       models.JSONB(alertData),
 		Comments:        models.JSONB(comments),
@@ -520,19 +923,49 @@ func (gdb *GormDB) CreateResolvedAlert(fingerprint, source string, alertData, co
 		ExpiresAt:       now.Add(time.Duration(ttlHours) * time.Hour),
 		Source:          source,
 	}
+}
 
-	if err := gdb.db.Create(resolvedAlert).Error; err != nil {
-		return nil, fmt.Errorf("failed to create resolved alert: %w", err)
-	}
+func (gdb *GormDB) GetResolvedAlerts(limit, offset int) ([]models.ResolvedAlert, error) {
+	resolvedAlerts, _, err := gdb.SearchResolvedAlerts(ResolvedAlertFilter{}, limit, offset)
+	return resolvedAlerts, err
+}
 
-	return resolvedAlert, nil
+// ResolvedAlertFilter narrows SearchResolvedAlerts; zero fields match everything
+type ResolvedAlertFilter struct {
+	Source            string
+	FingerprintPrefix string
+	ResolvedAfter     time.Time // Inclusive
+	ResolvedBefore    time.Time // Exclusive
+	Ascending         bool      // Oldest resolution first instead of newest
 }
 
-func (gdb *GormDB) GetResolvedAlerts(limit, offset int) ([]models.ResolvedAlert, error) {
-	var resolvedAlerts []models.ResolvedAlert
+// SearchResolvedAlerts returns one page of unexpired resolved alerts matching
+// filter, ordered by resolution time, with the number of matches across all pages
+func (gdb *GormDB) SearchResolvedAlerts(filter ResolvedAlertFilter, limit, offset int) ([]models.ResolvedAlert, int64, error) {
+	query := gdb.db.Model(&models.ResolvedAlert{}).Where("expires_at > ?", time.Now())
+	if filter.Source != "" {
+		query = query.Where("source = ?", filter.Source)
+	}
+	if filter.FingerprintPrefix != "" {
+		query = query.Where(`fingerprint LIKE ? ESCAPE '\'`, escapeLikePattern(filter.FingerprintPrefix)+"%")
+	}
+	if !filter.ResolvedAfter.IsZero() {
+		query = query.Where("resolved_at >= ?", filter.ResolvedAfter)
+	}
+	if !filter.ResolvedBefore.IsZero() {
+		query = query.Where("resolved_at < ?", filter.ResolvedBefore)
+	}
 
-	query := gdb.db.Where("expires_at > ?", time.Now()).
-		Order("resolved_at DESC")
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	order := "resolved_at DESC"
+	if filter.Ascending {
+		order = "resolved_at ASC"
+	}
+	query = query.Order(order)
 
 	if limit > 0 {
 		query = query.Limit(limit)
@@ -542,13 +975,21 @@ func (gdb *GormDB) GetResolvedAlerts(limit, offset int) ([]models.ResolvedAlert,
 		query = query.Offset(offset)
 	}
 
+	var resolvedAlerts []models.ResolvedAlert
 	err := query.Find(&resolvedAlerts).Error
-	return resolvedAlerts, err
+	return resolvedAlerts, total, err
 }
 
+// escapeLikePattern escapes LIKE wildcards so value matches literally
+func escapeLikePattern(value string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(value)
+}
+
+// GetResolvedAlert returns the latest unexpired resolved capture of fingerprint
 func (gdb *GormDB) GetResolvedAlert(fingerprint string) (*models.ResolvedAlert, error) {
 	var resolvedAlert models.ResolvedAlert
 	err := gdb.db.Where("fingerprint = ? AND expires_at > ?", fingerprint, time.Now()).
+		Order("resolved_at DESC").
 		First(&resolvedAlert).Error
 
 	if err != nil {
@@ -558,8 +999,83 @@ func (gdb *GormDB) GetResolvedAlert(fingerprint string) (*models.ResolvedAlert,
 	return &resolvedAlert, nil
 }
 
+// ErrAlreadyRestored is returned by RestoreResolvedAlert for a resolved alert
+// whose discussion was restored before
+var ErrAlreadyRestored = errors.New("resolved alert was already restored")
+
+// RestoreResolvedAlert marks a resolved alert as restored and inserts the given
+// comments and acknowledgments, all in one transaction. Entries whose ID is
+// still present, or whose author no longer exists, are skipped. It returns how
+// many comments and acknowledgments were inserted.
+func (gdb *GormDB) RestoreResolvedAlert(resolvedAlertID string, comments []models.Comment, acknowledgments []models.Acknowledgment) (int, int, error) {
+	restoredComments, restoredAcks := 0, 0
+
+	err := gdb.db.Transaction(func(tx *gorm.DB) error {
+		// The conditional update is the double-restore guard
+		result := tx.Model(&models.ResolvedAlert{}).
+			Where("id = ? AND restored_at IS NULL", resolvedAlertID).
+			Update("restored_at", time.Now())
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrAlreadyRestored
+		}
+
+		userIDs := make([]string, 0, len(comments)+len(acknowledgments))
+		for _, comment := range comments {
+			userIDs = append(userIDs, comment.UserID)
+		}
+		for _, ack := range acknowledgments {
+			userIDs = append(userIDs, ack.UserID)
+		}
+		var existingUsers []string
+		if len(userIDs) > 0 {
+			if err := tx.Model(&models.User{}).Where("id IN ?", userIDs).Pluck("id", &existingUsers).Error; err != nil {
+				return err
+			}
+		}
+		userExists := make(map[string]bool, len(existingUsers))
+		for _, id := range existingUsers {
+			userExists[id] = true
+		}
+
+		for i := range comments {
+			if !userExists[comments[i].UserID] {
+				continue
+			}
+			result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&comments[i])
+			if result.Error != nil {
+				return result.Error
+			}
+			if result.RowsAffected > 0 {
+				if err := createCommentTags(tx, &comments[i]); err != nil {
+					return err
+				}
+			}
+			restoredComments += int(result.RowsAffected)
+		}
+		for i := range acknowledgments {
+			if !userExists[acknowledgments[i].UserID] {
+				continue
+			}
+			result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&acknowledgments[i])
+			if result.Error != nil {
+				return result.Error
+			}
+			restoredAcks += int(result.RowsAffected)
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return restoredComments, restoredAcks, nil
+}
+
 func (gdb *GormDB) CleanupExpiredResolvedAlerts() (int64, error) {
-	result := gdb.db.Where("expires_at < ?", time.Now()).Delete(&models.ResolvedAlert{})
+	result := gdb.db.Unscoped().Where("expires_at < ?", time.Now()).Delete(&models.ResolvedAlert{})
 	return result.RowsAffected, result.Error
 }
 
@@ -571,8 +1087,73 @@ func (gdb *GormDB) GetResolvedAlertsCount() (int64, error) {
 	return count, err
 }
 
-func (gdb *GormDB) RemoveAllResolvedAlerts() (int64, error) {
-	result := gdb.db.Delete(&models.ResolvedAlert{}, "1 = 1")
+// GetResolvedAlertTTLHours returns the resolved alert TTL saved from the
+// WebUI, or 0 when none was saved
+func (gdb *GormDB) GetResolvedAlertTTLHours() (int, error) {
+	var settings models.ResolvedAlertSettings
+	err := gdb.db.Limit(1).Find(&settings, 1).Error
+	if err != nil {
+		return 0, err
+	}
+	return settings.TTLHours, nil
+}
+
+// SetResolvedAlertTTLHours saves the TTL of the resolved alerts stored from
+// now on
+func (gdb *GormDB) SetResolvedAlertTTLHours(hours int, userID string) error {
+	settings := models.ResolvedAlertSettings{ID: 1, TTLHours: hours, UpdatedBy: userID}
+	return gdb.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"ttl_hours", "updated_by", "updated_at"}),
+	}).Create(&settings).Error
+}
+
+// ClearUndoWindow is how long the rows removed by RemoveAllResolvedAlerts and
+// ClearUserHiddenAlerts can be brought back before PurgeClearedRows deletes
+// them for good
+const ClearUndoWindow = 30 * time.Second
+
+// ErrUndoExpired is returned when undoing a clear whose undo window has ended
+var ErrUndoExpired = errors.New("the undo window has ended")
+
+// clearTimestamp is the deleted_at shared by every row of one clear, which
+// identifies them when it is undone. It is truncated to the precision both
+// databases store so it compares equal once read back.
+func clearTimestamp() time.Time {
+	return time.Now().UTC().Truncate(time.Microsecond)
+}
+
+// undoable returns ErrUndoExpired when the clear made at clearedAt can no
+// longer be undone
+func undoable(clearedAt time.Time) error {
+	if time.Since(clearedAt) > ClearUndoWindow {
+		return ErrUndoExpired
+	}
+	return nil
+}
+
+// RemoveAllResolvedAlerts soft-deletes every resolved alert and returns how
+// many were removed, along with the time that identifies them for
+// UndoRemoveAllResolvedAlerts
+func (gdb *GormDB) RemoveAllResolvedAlerts() (int64, time.Time, error) {
+	clearedAt := clearTimestamp()
+	result := gdb.db.Model(&models.ResolvedAlert{}).Where("1 = 1").Update("deleted_at", clearedAt)
+	if result.Error != nil {
+		return 0, time.Time{}, result.Error
+	}
+	return result.RowsAffected, clearedAt, nil
+}
+
+// UndoRemoveAllResolvedAlerts brings back the resolved alerts removed by the
+// RemoveAllResolvedAlerts call made at clearedAt and returns how many were
+// restored
+func (gdb *GormDB) UndoRemoveAllResolvedAlerts(clearedAt time.Time) (int64, error) {
+	if err := undoable(clearedAt); err != nil {
+		return 0, err
+	}
+	result := gdb.db.Unscoped().Model(&models.ResolvedAlert{}).
+		Where("deleted_at = ?", clearedAt.UTC()).
+		Update("deleted_at", nil)
 	if result.Error != nil {
 		return 0, result.Error
 	}
@@ -656,6 +1237,38 @@ func (gdb *GormDB) CreateUserHiddenAlert(userID, fingerprint, alertName, instanc
 	return hiddenAlert, nil
 }
 
+// SnoozeAlert hides an alert for a user until the given time, replacing any
+// earlier snooze or hide of the same alert
+func (gdb *GormDB) SnoozeAlert(userID, fingerprint, alertName, instance, reason string, until time.Time) (*models.UserHiddenAlert, error) {
+	var snoozed models.UserHiddenAlert
+	err := gdb.db.Where("user_id = ? AND fingerprint = ?", userID, fingerprint).First(&snoozed).Error
+	if err != nil && err != gorm.ErrRecordNotFound {
+		return nil, fmt.Errorf("failed to query existing hidden alert: %w", err)
+	}
+
+	snoozed.UserID = userID
+	snoozed.Fingerprint = fingerprint
+	snoozed.AlertName = alertName
+	snoozed.Instance = instance
+	snoozed.Reason = reason
+	snoozed.ExpiresAt = &until
+	if err := gdb.db.Save(&snoozed).Error; err != nil {
+		return nil, fmt.Errorf("failed to snooze alert: %w", err)
+	}
+
+	return &snoozed, nil
+}
+
+// CleanupExpiredSnoozes deletes snoozes whose end has passed and returns how
+// many were removed
+func (gdb *GormDB) CleanupExpiredSnoozes() (int64, error) {
+	result := gdb.db.Unscoped().Where("expires_at IS NOT NULL AND expires_at <= ?", time.Now()).Delete(&models.UserHiddenAlert{})
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to cleanup expired snoozes: %w", result.Error)
+	}
+	return result.RowsAffected, nil
+}
+
 // SaveHiddenAlert saves or updates a hidden alert for a user
 func (gdb *GormDB) SaveHiddenAlert(userID, fingerprint, alertName, instance, reason string) error {
 	hiddenAlert := &models.UserHiddenAlert{
@@ -692,22 +1305,37 @@ func (gdb *GormDB) SaveHiddenAlert(userID, fingerprint, alertName, instance, rea
 
 // RemoveHiddenAlert removes a hidden alert for a user
 func (gdb *GormDB) RemoveHiddenAlert(userID, fingerprint string) error {
-	result := gdb.db.Where("user_id = ? AND fingerprint = ?", userID, fingerprint).Delete(&models.UserHiddenAlert{})
+	result := gdb.db.Unscoped().Where("user_id = ? AND fingerprint = ?", userID, fingerprint).Delete(&models.UserHiddenAlert{})
 	if result.Error != nil {
 		return result.Error
 	}
 	return nil
 }
 
+// RemoveHiddenAlerts removes several hidden alerts for a user in one statement
+// and returns how many were removed
+func (gdb *GormDB) RemoveHiddenAlerts(userID string, fingerprints []string) (int64, error) {
+	if len(fingerprints) == 0 {
+		return 0, nil
+	}
+	result := gdb.db.Unscoped().Where("user_id = ? AND fingerprint IN ?", userID, fingerprints).Delete(&models.UserHiddenAlert{})
+	if result.Error != nil {
+		return 0, result.Error
+	}
+	return result.RowsAffected, nil
+}
+
 // RemoveUserHiddenAlert removes a hidden alert for a user (alias for consistency)
 func (gdb *GormDB) RemoveUserHiddenAlert(userID, fingerprint string) error {
 	return gdb.RemoveHiddenAlert(userID, fingerprint)
 }
 
-// GetUserHiddenAlerts gets all hidden alerts for a user
+// GetUserHiddenAlerts gets all hidden alerts for a user, leaving out snoozes
+// that have already ended
 func (gdb *GormDB) GetUserHiddenAlerts(userID string) ([]models.UserHiddenAlert, error) {
 	var hiddenAlerts []models.UserHiddenAlert
 	err := gdb.db.Where("user_id = ?", userID).
+		Where("expires_at IS NULL OR expires_at > ?", time.Now()).
 		Order("created_at DESC").
 		Find(&hiddenAlerts).Error
 	
@@ -789,20 +1417,63 @@ func (gdb *GormDB) GetUserHiddenRules(userID string) ([]models.UserHiddenRule, e
 
 // ClearAllHiddenAlerts removes all hidden alerts for a user
 func (gdb *GormDB) ClearAllHiddenAlerts(userID string) error {
-	result := gdb.db.Where("user_id = ?", userID).Delete(&models.UserHiddenAlert{})
+	_, _, err := gdb.ClearUserHiddenAlerts(userID)
+	return err
+}
+
+// ClearUserHiddenAlerts soft-deletes all hidden alerts of a user and returns
+// how many were removed, along with the time that identifies them for
+// UndoClearUserHiddenAlerts
+func (gdb *GormDB) ClearUserHiddenAlerts(userID string) (int64, time.Time, error) {
+	clearedAt := clearTimestamp()
+	result := gdb.db.Model(&models.UserHiddenAlert{}).Where("user_id = ?", userID).Update("deleted_at", clearedAt)
 	if result.Error != nil {
+		return 0, time.Time{}, result.Error
+	}
+	return result.RowsAffected, clearedAt, nil
+}
+
+// UndoClearUserHiddenAlerts brings back the hidden alerts removed by the
+// ClearUserHiddenAlerts call made at clearedAt and returns how many were
+// restored. Alerts the user hid again since are kept as they are now.
+func (gdb *GormDB) UndoClearUserHiddenAlerts(userID string, clearedAt time.Time) (int64, error) {
+	if err := undoable(clearedAt); err != nil {
+		return 0, err
+	}
+	var restored int64
+	err := gdb.db.Transaction(func(tx *gorm.DB) error {
+		rehidden := tx.Model(&models.UserHiddenAlert{}).Select("fingerprint").Where("user_id = ?", userID)
+		if err := tx.Unscoped().
+			Where("user_id = ? AND deleted_at = ? AND fingerprint IN (?)", userID, clearedAt.UTC(), rehidden).
+			Delete(&models.UserHiddenAlert{}).Error; err != nil {
+			return err
+		}
+
+		result := tx.Unscoped().Model(&models.UserHiddenAlert{}).
+			Where("user_id = ? AND deleted_at = ?", userID, clearedAt.UTC()).
+			Update("deleted_at", nil)
+		restored = result.RowsAffected
 		return result.Error
+	})
+	if err != nil {
+		return 0, err
 	}
-	return nil
+	return restored, nil
 }
 
-// ClearUserHiddenAlerts removes all hidden alerts for a user (alias for consistency)
-func (gdb *GormDB) ClearUserHiddenAlerts(userID string) (int64, error) {
-	result := gdb.db.Where("user_id = ?", userID).Delete(&models.UserHiddenAlert{})
-	if result.Error != nil {
-		return 0, result.Error
+// PurgeClearedRows hard-deletes the resolved and hidden alerts removed by a
+// clear whose undo window has ended, and returns how many rows were deleted
+func (gdb *GormDB) PurgeClearedRows() (int64, error) {
+	cutoff := time.Now().UTC().Add(-ClearUndoWindow)
+	var purged int64
+	for _, model := range []interface{}{&models.ResolvedAlert{}, &models.UserHiddenAlert{}} {
+		result := gdb.db.Unscoped().Where("deleted_at IS NOT NULL AND deleted_at < ?", cutoff).Delete(model)
+		if result.Error != nil {
+			return purged, fmt.Errorf("failed to purge cleared %T rows: %w", model, result.Error)
+		}
+		purged += result.RowsAffected
 	}
-	return result.RowsAffected, nil
+	return purged, nil
 }
 
 // Filter Presets Methods
@@ -815,6 +1486,52 @@ func (gdb *GormDB) CreateFilterPreset(preset *models.FilterPreset) (*models.Filt
 	return preset, nil
 }
 
+// EnsureSystemUser creates the disabled, passwordless system user that owns
+// seeded filter presets, if it does not exist yet
+func (gdb *GormDB) EnsureSystemUser() (*models.User, error) {
+	user := models.User{
+		ID:       models.SystemUserID,
+		Username: models.SystemUsername,
+		Disabled: true,
+	}
+	if err := gdb.db.Where("id = ?", models.SystemUserID).FirstOrCreate(&user).Error; err != nil {
+		return nil, fmt.Errorf("failed to ensure system user: %w", err)
+	}
+	return &user, nil
+}
+
+// SeedSharedFilterPresets creates each preset as a shared preset owned by the
+// system user, skipping names the system user already owns so restarts do not
+// duplicate them. It returns the number of presets created.
+func (gdb *GormDB) SeedSharedFilterPresets(presets []models.FilterPreset) (int, error) {
+	if _, err := gdb.EnsureSystemUser(); err != nil {
+		return 0, err
+	}
+
+	created := 0
+	for i := range presets {
+		preset := presets[i]
+		var count int64
+		if err := gdb.db.Model(&models.FilterPreset{}).
+			Where("user_id = ? AND name = ?", models.SystemUserID, preset.Name).
+			Count(&count).Error; err != nil {
+			return created, fmt.Errorf("failed to look up filter preset %q: %w", preset.Name, err)
+		}
+		if count > 0 {
+			continue
+		}
+
+		preset.ID = ""
+		preset.UserID = models.SystemUserID
+		preset.IsShared = true
+		if err := gdb.db.Create(&preset).Error; err != nil {
+			return created, fmt.Errorf("failed to seed filter preset %q: %w", preset.Name, err)
+		}
+		created++
+	}
+	return created, nil
+}
+
 // GetFilterPresets gets all filter presets for a user (private + shared)
 func (gdb *GormDB) GetFilterPresets(userID string, includeShared bool) ([]models.FilterPreset, error) {
 	var presets []models.FilterPreset
@@ -8,6 +8,7 @@ import (
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
 
+	"notificator/config"
 	"notificator/internal/backend/models"
 )
 
@@ -19,7 +20,7 @@ func newTestDB(t *testing.T) *GormDB {
 	if err != nil {
 		t.Fatalf("open sqlite: %v", err)
 	}
-	if err := db.AutoMigrate(&models.User{}, &models.Acknowledgment{}); err != nil {
+	if err := db.AutoMigrate(&models.User{}, &models.Acknowledgment{}, &models.Comment{}); err != nil {
 		t.Fatalf("migrate: %v", err)
 	}
 	return &GormDB{db: db, dbType: "sqlite"}
@@ -79,3 +80,83 @@ func TestAcknowledgmentCompositeIndexExists(t *testing.T) {
 		t.Fatal("composite index idx_acknowledgments_alert_key_created_at missing after migration")
 	}
 }
+
+func TestNewGormDBAppliesPoolSettings(t *testing.T) {
+	gdb, err := NewGormDB("sqlite", config.DatabaseConfig{
+		SQLitePath:      t.TempDir() + "/pool.db",
+		MaxOpenConns:    7,
+		ConnMaxLifetime: "5m",
+	})
+	if err != nil {
+		t.Fatalf("NewGormDB: %v", err)
+	}
+	defer gdb.Close()
+
+	sqlDB, err := gdb.db.DB()
+	if err != nil {
+		t.Fatalf("get sql.DB: %v", err)
+	}
+	if got := sqlDB.Stats().MaxOpenConnections; got != 7 {
+		t.Errorf("expected max open connections 7, got %d", got)
+	}
+
+	if _, err := NewGormDB("sqlite", config.DatabaseConfig{
+		SQLitePath:      t.TempDir() + "/pool.db",
+		ConnMaxLifetime: "forever",
+	}); err == nil {
+		t.Error("expected an invalid conn_max_lifetime to be rejected")
+	}
+}
+
+func TestCountBatchesMatchPerAlertCounts(t *testing.T) {
+	gdb := newTestDB(t)
+
+	alice := models.User{ID: "u1", Username: "alice", Email: "alice@example.com"}
+	if err := gdb.db.Create(&alice).Error; err != nil {
+		t.Fatalf("create user: %v", err)
+	}
+
+	keys := []string{"key-a", "key-b", "key-c"}
+	for i, key := range keys {
+		for n := 0; n < i+1; n++ {
+			if _, err := gdb.CreateComment(key, alice.ID, "note"); err != nil {
+				t.Fatalf("create comment: %v", err)
+			}
+		}
+		for n := 0; n < i; n++ {
+			if _, err := gdb.CreateAcknowledgment(key, alice.ID, "on it", nil); err != nil {
+				t.Fatalf("create ack: %v", err)
+			}
+		}
+	}
+
+	commentCounts, err := gdb.GetCommentCountsBatch(keys)
+	if err != nil {
+		t.Fatalf("GetCommentCountsBatch: %v", err)
+	}
+	ackCounts, err := gdb.GetAcknowledgmentCountsBatch(keys)
+	if err != nil {
+		t.Fatalf("GetAcknowledgmentCountsBatch: %v", err)
+	}
+
+	for _, key := range keys {
+		comments, err := gdb.GetComments(key)
+		if err != nil {
+			t.Fatalf("GetComments(%s): %v", key, err)
+		}
+		if commentCounts[key] != len(comments) {
+			t.Errorf("%s: batch counted %d comments, per-alert %d", key, commentCounts[key], len(comments))
+		}
+
+		acks, err := gdb.GetAcknowledgments(key)
+		if err != nil {
+			t.Fatalf("GetAcknowledgments(%s): %v", key, err)
+		}
+		if ackCounts[key] != len(acks) {
+			t.Errorf("%s: batch counted %d acknowledgments, per-alert %d", key, ackCounts[key], len(acks))
+		}
+	}
+	if _, ok := ackCounts["key-a"]; ok {
+		t.Errorf("keys without acknowledgments must be absent, got %v", ackCounts)
+	}
+}
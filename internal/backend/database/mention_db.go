@@ -0,0 +1,46 @@
+package database
+
+import (
+	"fmt"
+
+	"notificator/internal/backend/models"
+)
+
+// CreateMentions records the users a comment mentions. Usernames that don't
+// belong to an enabled user, and the comment's author, are dropped; the
+// mentioned users are returned.
+func (gdb *GormDB) CreateMentions(commentID, alertKey, authorID string, usernames []string) ([]models.User, error) {
+	if len(usernames) == 0 {
+		return nil, nil
+	}
+
+	var users []models.User
+	err := gdb.db.
+		Where("username IN ? AND disabled = ? AND id NOT IN ?", usernames, false, []string{authorID, models.SystemUserID}).
+		Find(&users).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up mentioned users: %w", err)
+	}
+	if len(users) == 0 {
+		return nil, nil
+	}
+
+	mentions := make([]models.Mention, 0, len(users))
+	for _, user := range users {
+		mentions = append(mentions, models.Mention{
+			CommentID: commentID,
+			AlertKey:  alertKey,
+			UserID:    user.ID,
+			AuthorID:  authorID,
+		})
+	}
+	if err := gdb.db.Create(&mentions).Error; err != nil {
+		return nil, fmt.Errorf("failed to create mentions: %w", err)
+	}
+	return users, nil
+}
+
+// DeleteMentionsByComment removes the mentions made by a comment
+func (gdb *GormDB) DeleteMentionsByComment(commentID string) error {
+	return gdb.db.Where("comment_id = ?", commentID).Delete(&models.Mention{}).Error
+}
@@ -47,7 +47,8 @@ func (gdb *GormDB) SaveUserNotificationPreference(pref *models.NotificationPrefe
 
 	// Update existing preference: only touch the explicit preference columns
 	// so a partially-populated caller struct can't zero out unrelated columns.
-	if err := gdb.db.Model(&existing).Select("browser_notifications_enabled", "enabled_severities", "sound_notifications_enabled", "updated_at").Updates(pref).Error; err != nil {
+	if err := gdb.db.Model(&existing).Select("browser_notifications_enabled", "enabled_severities", "sound_notifications_enabled",
+		"dnd_enabled", "dnd_days", "dnd_start_time", "dnd_end_time", "dnd_timezone", "dnd_allow_critical", "updated_at").Updates(pref).Error; err != nil {
 		return fmt.Errorf("failed to update notification preference: %w", err)
 	}
 
@@ -169,6 +169,15 @@ func (gdb *GormDB) GetUsersByGroup(provider, groupName string) ([]models.User, e
 	return users, err
 }
 
+// GroupExists reports whether any user belongs to a group with this name, whatever the provider
+func (gdb *GormDB) GroupExists(groupName string) (bool, error) {
+	var count int64
+	err := gdb.db.Model(&models.UserGroup{}).
+		Where("group_name = ?", groupName).
+		Count(&count).Error
+	return count > 0, err
+}
+
 func (gdb *GormDB) HasGroupPermission(userID, permission string) (bool, error) {
 	var count int64
 	err := gdb.db.Model(&models.UserGroup{}).
@@ -216,6 +225,18 @@ func (gdb *GormDB) RefreshOAuthToken(userID, provider, accessToken, refreshToken
 		Updates(updates).Error
 }
 
+// ListActiveOAuthTokens returns the stored tokens of enabled users for the
+// provider they sign in with
+func (gdb *GormDB) ListActiveOAuthTokens() ([]models.OAuthToken, error) {
+	var tokens []models.OAuthToken
+	err := gdb.db.
+		Joins("JOIN users ON users.id = oauth_tokens.user_id").
+		Where("users.disabled = ? AND users.o_auth_provider = oauth_tokens.provider", false).
+		Order("oauth_tokens.user_id").
+		Find(&tokens).Error
+	return tokens, err
+}
+
 func (gdb *GormDB) DeleteOAuthToken(userID, provider string) error {
 	return gdb.db.Where("user_id = ? AND provider = ?", userID, provider).Delete(&models.OAuthToken{}).Error
 }
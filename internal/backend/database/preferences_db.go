@@ -0,0 +1,186 @@
+package database
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"notificator/internal/backend/models"
+	mainmodels "notificator/internal/models"
+)
+
+// ExportPreferences collects a user's dashboard settings into a bundle
+func (gdb *GormDB) ExportPreferences(userID string) (*models.PreferencesBundle, error) {
+	bundle := &models.PreferencesBundle{
+		Version:    models.PreferencesBundleVersion,
+		ExportedAt: time.Now(),
+	}
+
+	sections := []struct {
+		name  string
+		dest  interface{}
+		order string
+	}{
+		{"color preferences", &bundle.ColorPreferences, "priority DESC, created_at ASC"},
+		{"filter presets", &bundle.FilterPresets, "created_at ASC"},
+		{"annotation button configs", &bundle.AnnotationButtonConfigs, "display_order ASC, created_at ASC"},
+		{"hidden rules", &bundle.HiddenRules, "priority DESC, created_at ASC"},
+	}
+	for _, section := range sections {
+		if err := gdb.db.Where("user_id = ?", userID).Order(section.order).Find(section.dest).Error; err != nil {
+			return nil, fmt.Errorf("failed to export %s: %w", section.name, err)
+		}
+	}
+
+	var pref models.NotificationPreference
+	err := gdb.db.Where("user_id = ?", userID).First(&pref).Error
+	if err == nil {
+		bundle.NotificationPreference = &pref
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, fmt.Errorf("failed to export notification preference: %w", err)
+	}
+
+	return bundle, nil
+}
+
+// ImportPreferences upserts a validated bundle into a user's settings in one
+// transaction. Entries are matched to existing rows by their natural key:
+// label conditions for color preferences, name for filter presets, label for
+// annotation buttons and label key, value and regex flag for hidden rules.
+// The entries must already carry the target user ID.
+func (gdb *GormDB) ImportPreferences(userID string, bundle *models.PreferencesBundle) (*models.PreferencesImportResult, error) {
+	result := &models.PreferencesImportResult{}
+
+	err := gdb.db.Transaction(func(tx *gorm.DB) error {
+		if err := importColorPreferences(tx, userID, bundle.ColorPreferences, result); err != nil {
+			return err
+		}
+
+		for i := range bundle.FilterPresets {
+			preset := &bundle.FilterPresets[i]
+			err := upsertByKey(tx, &models.FilterPreset{}, preset, result,
+				[]string{"description", "is_shared", "filter_data", "column_configs", "updated_at"},
+				"user_id = ? AND name = ?", userID, preset.Name)
+			if err != nil {
+				return fmt.Errorf("failed to import filter preset %q: %w", preset.Name, err)
+			}
+		}
+
+		for i := range bundle.AnnotationButtonConfigs {
+			button := &bundle.AnnotationButtonConfigs[i]
+			err := upsertByKey(tx, &models.AnnotationButtonConfig{}, button, result,
+				[]string{"annotation_keys", "color", "icon", "display_order", "enabled", "button_type", "updated_at"},
+				"user_id = ? AND label = ?", userID, button.Label)
+			if err != nil {
+				return fmt.Errorf("failed to import annotation button %q: %w", button.Label, err)
+			}
+		}
+
+		for i := range bundle.HiddenRules {
+			rule := &bundle.HiddenRules[i]
+			err := upsertByKey(tx, &models.UserHiddenRule{}, rule, result,
+				[]string{"name", "description", "is_enabled", "priority", "updated_at"},
+				"user_id = ? AND label_key = ? AND label_value = ? AND is_regex = ?",
+				userID, rule.LabelKey, rule.LabelValue, rule.IsRegex)
+			if err != nil {
+				return fmt.Errorf("failed to import hidden rule %q: %w", rule.Name, err)
+			}
+		}
+
+		if pref := bundle.NotificationPreference; pref != nil {
+			err := upsertByKey(tx, &models.NotificationPreference{}, pref, result,
+				[]string{"browser_notifications_enabled", "enabled_severities", "sound_notifications_enabled",
+					"dnd_enabled", "dnd_days", "dnd_start_time", "dnd_end_time", "dnd_timezone", "dnd_allow_critical", "updated_at"},
+				"user_id = ?", userID)
+			if err != nil {
+				return fmt.Errorf("failed to import notification preference: %w", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// upsertByKey updates the columns of the row matching query from value, or
+// creates value when no row matches. GORM inserts column defaults in place of
+// zero values and writes them back into value, so a created row is updated
+// again from a copy taken beforehand to keep false booleans.
+func upsertByKey(tx *gorm.DB, existing, value interface{}, result *models.PreferencesImportResult, columns []string, query string, args ...interface{}) error {
+	err := tx.Where(query, args...).First(existing).Error
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		imported := reflect.ValueOf(value).Elem().Interface()
+		if err := tx.Create(value).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(value).Select(columns).Updates(imported).Error; err != nil {
+			return err
+		}
+		result.Created++
+		return nil
+	case err != nil:
+		return err
+	}
+
+	if err := tx.Model(existing).Select(columns).Updates(value).Error; err != nil {
+		return err
+	}
+	result.Updated++
+	return nil
+}
+
+// importColorPreferences matches color preferences on their label conditions,
+// which are stored as JSON and compared after decoding
+func importColorPreferences(tx *gorm.DB, userID string, prefs []mainmodels.UserColorPreference, result *models.PreferencesImportResult) error {
+	var stored []mainmodels.UserColorPreference
+	if err := tx.Where("user_id = ?", userID).Find(&stored).Error; err != nil {
+		return fmt.Errorf("failed to load color preferences: %w", err)
+	}
+	byConditions := make(map[string]*mainmodels.UserColorPreference, len(stored))
+	for i := range stored {
+		byConditions[colorConditionsKey(&stored[i])] = &stored[i]
+	}
+
+	for i := range prefs {
+		pref := &prefs[i]
+		key := colorConditionsKey(pref)
+
+		existing, ok := byConditions[key]
+		if !ok {
+			pref.ID = uuid.New().String()
+			if err := tx.Create(pref).Error; err != nil {
+				return fmt.Errorf("failed to import color preference: %w", err)
+			}
+			byConditions[key] = pref
+			result.Created++
+			continue
+		}
+
+		if err := tx.Model(existing).
+			Select("color", "color_type", "priority", "bg_lightness_factor", "text_darkness_factor", "updated_at").
+			Updates(pref).Error; err != nil {
+			return fmt.Errorf("failed to import color preference: %w", err)
+		}
+		result.Updated++
+	}
+	return nil
+}
+
+// colorConditionsKey returns label conditions in a canonical form; encoding a
+// map sorts its keys
+func colorConditionsKey(pref *mainmodels.UserColorPreference) string {
+	conditions, err := pref.GetLabelConditions()
+	if err != nil || len(conditions) == 0 {
+		return "{}"
+	}
+	key, _ := json.Marshal(conditions)
+	return string(key)
+}
@@ -0,0 +1,49 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Alert activity types
+const (
+	ActivityComment    = "comment"
+	ActivityAck        = "ack"
+	ActivityAckRemoved = "ack_removed"
+	ActivityAckExpired = "ack_expired"
+	ActivityEscalation = "escalation"
+	ActivityHide       = "hide"
+	ActivitySnooze     = "snooze"
+)
+
+// AlertActivity is one entry of an alert's timeline. Entries are written when
+// the action happens, so events without a row of their own (an acknowledgment
+// being removed) are kept too.
+type AlertActivity struct {
+	ID       string `gorm:"primaryKey;type:varchar(32)" json:"id"`
+	AlertKey string `gorm:"not null;size:500;index:idx_alert_activities_alert_key_created_at,priority:1" json:"alert_key"`
+	Type     string `gorm:"not null;size:20" json:"type"`
+	UserID   string `gorm:"size:32" json:"user_id"`
+	// RefID points at the comment, acknowledgment, escalation or hidden alert row
+	RefID string `gorm:"size:32;index" json:"ref_id"`
+	// Content is the comment text, acknowledgment/escalation/hide reason
+	Content string `gorm:"type:text" json:"content"`
+	// Target is who an escalation went to
+	Target    string    `gorm:"size:255" json:"target"`
+	CreatedAt time.Time `gorm:"index:idx_alert_activities_alert_key_created_at,priority:2" json:"created_at"`
+}
+
+func (a *AlertActivity) BeforeCreate(tx *gorm.DB) error {
+	if a.ID == "" {
+		a.ID = GenerateID()
+	}
+	return nil
+}
+
+func (AlertActivity) TableName() string { return "alert_activities" }
+
+type AlertActivityWithUser struct {
+	AlertActivity
+	Username string `json:"username"`
+}
@@ -67,8 +67,9 @@ type AnnotationButtonConfig struct {
 	DisplayOrder   int               `gorm:"default:0;index:idx_user_display_order" json:"display_order"` // Lower numbers appear first
 	Enabled        bool              `gorm:"default:true" json:"enabled"`
 
-	// Button type (for default vs custom)
-	ButtonType     string            `gorm:"type:varchar(20);default:'custom'" json:"button_type"` // "default" or "custom"
+	// Button type: "default" and "custom" buttons open the annotation value as
+	// a link, "copy" buttons copy it to the clipboard
+	ButtonType     string            `gorm:"type:varchar(20);default:'custom'" json:"button_type"` // "default", "custom" or "copy"
 
 	// Timestamps
 	CreatedAt      time.Time         `json:"created_at"`
@@ -194,8 +195,8 @@ func (a *AnnotationButtonConfig) Validate() error {
 	}
 
 	// Validate button type
-	if a.ButtonType != "default" && a.ButtonType != "custom" {
-		return errors.New("button type must be 'default' or 'custom'")
+	if a.ButtonType != "default" && a.ButtonType != "custom" && a.ButtonType != "copy" {
+		return errors.New("button type must be 'default', 'custom' or 'copy'")
 	}
 
 	return nil
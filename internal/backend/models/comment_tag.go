@@ -0,0 +1,61 @@
+package models
+
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// CommentTag records a #tag used in a comment, so comments can be found by tag
+type CommentTag struct {
+	ID        string    `gorm:"primaryKey;type:varchar(32)" json:"id"`
+	CommentID string    `gorm:"not null;size:32;index" json:"comment_id"`
+	AlertKey  string    `gorm:"not null;size:500;index" json:"alert_key"`
+	UserID    string    `gorm:"not null;size:32;index" json:"user_id"` // Comment author
+	Tag       string    `gorm:"not null;size:100;index" json:"tag"`    // Lowercased, without the #
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func (t *CommentTag) BeforeCreate(tx *gorm.DB) error {
+	if t.ID == "" {
+		t.ID = GenerateID()
+	}
+	return nil
+}
+
+func (CommentTag) TableName() string { return "comment_tags" }
+
+// maxCommentTagLength is the longest tag stored; longer ones are cut
+const maxCommentTagLength = 100
+
+// commentTagPattern matches #tag at the start of a comment or after a
+// character that can't be part of a word, starting with a letter so issue
+// numbers like #123 aren't tags
+var commentTagPattern = regexp.MustCompile(`(?:^|[^\w&])#([A-Za-z][\w-]*)`)
+
+// ParseCommentTags returns the distinct tags of content, lowercased
+func ParseCommentTags(content string) []string {
+	var tags []string
+	seen := make(map[string]bool)
+	for _, match := range commentTagPattern.FindAllStringSubmatch(content, -1) {
+		tag := NormalizeCommentTag(match[1])
+		if seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		tags = append(tags, tag)
+	}
+	return tags
+}
+
+// NormalizeCommentTag turns a tag as typed, with or without its #, into the
+// stored form
+func NormalizeCommentTag(tag string) string {
+	tag = strings.ToLower(strings.TrimPrefix(strings.TrimSpace(tag), "#"))
+	if len(tag) > maxCommentTagLength {
+		tag = tag[:maxCommentTagLength]
+	}
+	return tag
+}
@@ -0,0 +1,26 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Mention records that a comment mentioned a user with @username
+type Mention struct {
+	ID        string    `gorm:"primaryKey;type:varchar(32)" json:"id"`
+	CommentID string    `gorm:"not null;size:32;index" json:"comment_id"`
+	AlertKey  string    `gorm:"not null;size:500" json:"alert_key"`
+	UserID    string    `gorm:"not null;size:32;index" json:"user_id"` // Mentioned user
+	AuthorID  string    `gorm:"not null;size:32" json:"author_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func (m *Mention) BeforeCreate(tx *gorm.DB) error {
+	if m.ID == "" {
+		m.ID = GenerateID()
+	}
+	return nil
+}
+
+func (Mention) TableName() string { return "mentions" }
@@ -19,6 +19,10 @@ type User struct {
 	UpdatedAt    time.Time  `json:"updated_at"`
 	LastLogin    *time.Time `json:"last_login,omitempty"`
 
+	// Access control
+	IsAdmin  bool `gorm:"default:false" json:"is_admin"`
+	Disabled bool `gorm:"default:false;index" json:"disabled"` // Disabled users cannot log in; their data is kept
+
 	// OAuth fields
 	OAuthProvider *string `gorm:"size:50" json:"oauth_provider,omitempty"`
 	OAuthID       *string `gorm:"size:255;index" json:"oauth_id,omitempty"`
@@ -33,6 +37,14 @@ type User struct {
 	Acknowledgments []Acknowledgment `gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE" json:"-"`
 }
 
+// SystemUserID identifies the disabled account that owns the shared filter
+// presets seeded from backend.default_filter_presets. It cannot log in and is
+// left out of user listings.
+const (
+	SystemUserID   = "system"
+	SystemUsername = "notificator-system"
+)
+
 func (u *User) BeforeCreate(tx *gorm.DB) error {
 	if u.ID == "" {
 		u.ID = GenerateID()
@@ -96,10 +108,21 @@ type Acknowledgment struct {
 	Reason    string    `gorm:"not null;type:text" json:"reason"`
 	CreatedAt time.Time `gorm:"index:idx_acknowledgments_alert_key_created_at,priority:2" json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
+	// ExpiresAt is when the acknowledgment lapses; nil keeps it until removed
+	ExpiresAt *time.Time `gorm:"index" json:"expires_at,omitempty"`
+	// Renotify asks for the alert to be notified again once ExpiresAt passes
+	Renotify bool `gorm:"not null;default:false" json:"renotify"`
 
 	User User `gorm:"foreignKey:UserID" json:"user,omitempty"`
 }
 
+// AcknowledgmentExpiry makes an acknowledgment lapse at ExpiresAt instead of
+// lasting until it is removed
+type AcknowledgmentExpiry struct {
+	ExpiresAt time.Time
+	Renotify  bool
+}
+
 func (a *Acknowledgment) BeforeCreate(tx *gorm.DB) error {
 	if a.ID == "" {
 		a.ID = GenerateID()
@@ -107,10 +130,37 @@ func (a *Acknowledgment) BeforeCreate(tx *gorm.DB) error {
 	return nil
 }
 
+// Escalation records that a user handed an alert over to another user or group
+type Escalation struct {
+	ID          string    `gorm:"primaryKey;type:varchar(32)" json:"id"`
+	AlertKey    string    `gorm:"not null;size:500;index" json:"alert_key"`
+	UserID      string    `gorm:"not null;size:32" json:"user_id"`
+	EscalatedTo string    `gorm:"not null;size:255" json:"escalated_to"`
+	TargetType  string    `gorm:"not null;size:10" json:"target_type"`
+	Reason      string    `gorm:"type:text" json:"reason"`
+	CreatedAt   time.Time `json:"created_at"`
+
+	User User `gorm:"foreignKey:UserID" json:"user,omitempty"`
+}
+
+// Escalation target types
+const (
+	EscalationTargetUser  = "user"
+	EscalationTargetGroup = "group"
+)
+
+func (e *Escalation) BeforeCreate(tx *gorm.DB) error {
+	if e.ID == "" {
+		e.ID = GenerateID()
+	}
+	return nil
+}
+
 func (User) TableName() string           { return "users" }
 func (Session) TableName() string        { return "sessions" }
 func (Comment) TableName() string        { return "comments" }
 func (Acknowledgment) TableName() string { return "acknowledgments" }
+func (Escalation) TableName() string     { return "escalations" }
 
 type CommentWithUser struct {
 	Comment
@@ -122,6 +172,11 @@ type AcknowledgmentWithUser struct {
 	Username string `json:"username"`
 }
 
+type EscalationWithUser struct {
+	Escalation
+	Username string `json:"username"`
+}
+
 type JSONB json.RawMessage
 
 func (j JSONB) Value() (driver.Value, error) {
@@ -157,12 +212,16 @@ type ResolvedAlert struct {
 	Comments        JSONB `gorm:"type:jsonb" json:"comments,omitempty"`
 	Acknowledgments JSONB `gorm:"type:jsonb" json:"acknowledgments,omitempty"`
 
-	ResolvedAt time.Time `gorm:"not null;index" json:"resolved_at"`
-	ExpiresAt  time.Time `gorm:"not null;index" json:"expires_at"`
-	Source     string    `gorm:"not null;size:255" json:"source"`
+	ResolvedAt time.Time  `gorm:"not null;index" json:"resolved_at"`
+	ExpiresAt  time.Time  `gorm:"not null;index" json:"expires_at"`
+	Source     string     `gorm:"not null;size:255" json:"source"`
+	RestoredAt *time.Time `json:"restored_at,omitempty"` // When the comments and acknowledgments were copied back to a recurring alert
 
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
+	// DeletedAt is set by "remove all": the row stays restorable until the
+	// undo window ends and the cleanup job purges it
+	DeletedAt gorm.DeletedAt `gorm:"index" json:"-"`
 }
 
 func (ra *ResolvedAlert) BeforeCreate(tx *gorm.DB) error {
@@ -174,6 +233,20 @@ func (ra *ResolvedAlert) BeforeCreate(tx *gorm.DB) error {
 
 func (ResolvedAlert) TableName() string { return "resolved_alerts" }
 
+// MaxResolvedAlertTTLHours is the longest TTL that can be set from the WebUI
+const MaxResolvedAlertTTLHours = 365 * 24
+
+// ResolvedAlertSettings holds the resolved alert TTL chosen in the WebUI,
+// which overrides the configured retention. It has a single row, ID 1.
+type ResolvedAlertSettings struct {
+	ID        uint      `gorm:"primaryKey" json:"id"`
+	TTLHours  int       `gorm:"not null" json:"ttl_hours"`
+	UpdatedBy string    `gorm:"type:varchar(32)" json:"updated_by"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func (ResolvedAlertSettings) TableName() string { return "resolved_alert_settings" }
+
 // FilterPreset represents a saved filter configuration for the dashboard
 type FilterPreset struct {
 	ID            string    `gorm:"primaryKey;type:varchar(32)" json:"id"`
@@ -219,7 +292,7 @@ func DefaultColumnConfigs() []ColumnConfig {
 	return []ColumnConfig{
 		{ID: "col_select", Label: "", FieldType: "system", FieldPath: "select", Formatter: "checkbox", Width: 50, Sortable: false, Visible: true, Order: 0, Resizable: false, Critical: true},
 		{ID: "col_alertname", Label: "Alert Name", FieldType: "system", FieldPath: "alertName", Formatter: "text", Width: 300, Sortable: true, Visible: true, Order: 1, Resizable: true, Critical: true},
-		{ID: "col_actions", Label: "Actions", FieldType: "system", FieldPath: "actions", Formatter: "actions", Width: 100, Sortable: false, Visible: true, Order: 2, Resizable: false, Critical: true},
+		{ID: "col_actions", Label: "Actions", FieldType: "system", FieldPath: "actions", Formatter: "actions", Width: 180, Sortable: false, Visible: true, Order: 2, Resizable: false, Critical: true},
 		{ID: "col_instance", Label: "Instance", FieldType: "system", FieldPath: "instance", Formatter: "text", Width: 350, Sortable: true, Visible: true, Order: 3, Resizable: true, Critical: false},
 		{ID: "col_severity", Label: "Severity", FieldType: "system", FieldPath: "severity", Formatter: "badge", Width: 150, Sortable: true, Visible: true, Order: 4, Resizable: true, Critical: false},
 		{ID: "col_status", Label: "Status", FieldType: "system", FieldPath: "status", Formatter: "badge", Width: 150, Sortable: true, Visible: true, Order: 5, Resizable: true, Critical: false},
@@ -4,6 +4,7 @@ import (
 	"database/sql/driver"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/google/uuid"
@@ -36,6 +37,33 @@ func (s SeverityList) Value() (driver.Value, error) {
 	return json.Marshal(s)
 }
 
+// WeekdayList stores the days of a do-not-disturb schedule as JSON, 0 being
+// Sunday as in time.Weekday
+type WeekdayList []int
+
+// Scan implements the sql.Scanner interface for database reading
+func (w *WeekdayList) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case nil:
+		*w = WeekdayList{}
+		return nil
+	case []byte:
+		return json.Unmarshal(v, w)
+	case string:
+		return json.Unmarshal([]byte(v), w)
+	default:
+		return errors.New("failed to unmarshal WeekdayList value")
+	}
+}
+
+// Value implements the driver.Valuer interface for database writing
+func (w WeekdayList) Value() (driver.Value, error) {
+	if len(w) == 0 {
+		return json.Marshal([]int{})
+	}
+	return json.Marshal(w)
+}
+
 // NotificationPreference stores user preferences for browser notifications
 type NotificationPreference struct {
 	ID        string    `gorm:"primaryKey;type:varchar(36)" json:"id"`
@@ -46,6 +74,17 @@ type NotificationPreference struct {
 	EnabledSeverities          SeverityList `gorm:"type:jsonb" json:"enabled_severities"` // Type handled by Scanner/Valuer
 	SoundNotificationsEnabled   bool         `gorm:"default:true" json:"sound_notifications_enabled"`
 
+	// Do-not-disturb schedule: on each of DNDDays notifications are muted from
+	// DNDStartTime to DNDEndTime ("15:04", in DNDTimezone). An end at or
+	// before the start ends on the following day. Alerts still show in the
+	// dashboard; DNDAllowCritical lets critical alerts notify anyway.
+	DNDEnabled       bool        `gorm:"default:false" json:"dnd_enabled"`
+	DNDDays          WeekdayList `gorm:"type:jsonb" json:"dnd_days"`
+	DNDStartTime     string      `gorm:"size:5" json:"dnd_start_time"`
+	DNDEndTime       string      `gorm:"size:5" json:"dnd_end_time"`
+	DNDTimezone      string      `gorm:"size:100" json:"dnd_timezone"` // IANA name; empty means UTC
+	DNDAllowCritical bool        `gorm:"default:false" json:"dnd_allow_critical"`
+
 	// Timestamps
 	CreatedAt time.Time      `json:"created_at"`
 	UpdatedAt time.Time      `json:"updated_at"`
@@ -81,6 +120,59 @@ func (n *NotificationPreference) IsSeverityEnabled(severity string) bool {
 	return false
 }
 
+// ValidateDND checks the do-not-disturb schedule. A disabled schedule is only
+// checked for values that are set, so it can be kept for later.
+func (n *NotificationPreference) ValidateDND() error {
+	if n.DNDEnabled && len(n.DNDDays) == 0 {
+		return errors.New("at least one day is required")
+	}
+	seen := make(map[int]bool, len(n.DNDDays))
+	for _, day := range n.DNDDays {
+		if day < 0 || day > 6 {
+			return fmt.Errorf("invalid day %d, expected 0 (Sunday) to 6 (Saturday)", day)
+		}
+		if seen[day] {
+			return fmt.Errorf("day %d is listed twice", day)
+		}
+		seen[day] = true
+	}
+
+	if n.DNDEnabled && (n.DNDStartTime == "" || n.DNDEndTime == "") {
+		return errors.New("start and end times are required")
+	}
+	var start, end time.Time
+	var err error
+	if n.DNDStartTime != "" {
+		if start, err = parseDNDTime(n.DNDStartTime); err != nil {
+			return fmt.Errorf("invalid start time %q, expected HH:MM", n.DNDStartTime)
+		}
+	}
+	if n.DNDEndTime != "" {
+		if end, err = parseDNDTime(n.DNDEndTime); err != nil {
+			return fmt.Errorf("invalid end time %q, expected HH:MM", n.DNDEndTime)
+		}
+	}
+	if n.DNDStartTime != "" && n.DNDEndTime != "" && start.Equal(end) {
+		return errors.New("start and end times must differ")
+	}
+
+	if n.DNDTimezone != "" {
+		if _, err := time.LoadLocation(n.DNDTimezone); err != nil {
+			return fmt.Errorf("unknown timezone %q", n.DNDTimezone)
+		}
+	}
+	return nil
+}
+
+// parseDNDTime parses a two-digit HH:MM time. time.Parse alone also accepts
+// a one-digit hour such as 9:30, which the browser's schedule check rejects.
+func parseDNDTime(value string) (time.Time, error) {
+	if len(value) != len("15:04") {
+		return time.Time{}, errors.New("expected HH:MM")
+	}
+	return time.Parse("15:04", value)
+}
+
 // BeforeCreate generates a UUID for the ID field before creating a new record
 func (n *NotificationPreference) BeforeCreate(tx *gorm.DB) error {
 	if n.ID == "" {
@@ -113,6 +113,7 @@ type OAuthUserInfo struct {
 	AvatarURL     string                 `json:"avatar_url,omitempty"`
 	EmailVerified bool                   `json:"email_verified"`
 	Groups        []OAuthGroupInfo       `json:"groups,omitempty"`
+	GroupsFetched bool                   `json:"-"` // Groups came from the provider, even if empty
 	CustomClaims  map[string]interface{} `json:"custom_claims,omitempty"`
 	Provider      string                 `json:"provider"`
 }
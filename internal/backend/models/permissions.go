@@ -0,0 +1,38 @@
+package models
+
+// Permissions a group can grant through oauth.group_sync.permissions, or
+// through a "<permission>": true entry in its synced permissions JSON
+const (
+	PermissionSilenceCreate = "silence:create" // create and edit silences
+	PermissionSilenceExpire = "silence:expire" // expire silences
+	PermissionAckCreate     = "ack:create"     // acknowledge alerts
+	PermissionAckDelete     = "ack:delete"     // remove other users' acknowledgments
+	PermissionCommentDelete = "comment:delete" // remove other users' comments
+	PermissionAlertEscalate = "alert:escalate" // escalate alerts to a user or group
+
+	// PermissionAll grants every permission
+	PermissionAll = "*"
+)
+
+// KnownPermissions lists every permission a group can grant
+var KnownPermissions = []string{
+	PermissionSilenceCreate,
+	PermissionSilenceExpire,
+	PermissionAckCreate,
+	PermissionAckDelete,
+	PermissionCommentDelete,
+	PermissionAlertEscalate,
+}
+
+// IsKnownPermission reports whether permission is part of the schema
+func IsKnownPermission(permission string) bool {
+	if permission == PermissionAll {
+		return true
+	}
+	for _, known := range KnownPermissions {
+		if permission == known {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,29 @@
+package models
+
+import (
+	"time"
+
+	mainmodels "notificator/internal/models"
+)
+
+// PreferencesBundleVersion is the bundle format written by ExportPreferences
+const PreferencesBundleVersion = 1
+
+// PreferencesBundle holds a user's dashboard settings, as exchanged by the
+// ExportPreferences and ImportPreferences RPCs. Row IDs, owners and timestamps
+// are ignored on import, so a bundle can be loaded into any account.
+type PreferencesBundle struct {
+	Version                 int                              `json:"version"`
+	ExportedAt              time.Time                        `json:"exported_at"`
+	ColorPreferences        []mainmodels.UserColorPreference `json:"color_preferences"`
+	FilterPresets           []FilterPreset                   `json:"filter_presets"`
+	AnnotationButtonConfigs []AnnotationButtonConfig         `json:"annotation_button_configs"`
+	HiddenRules             []UserHiddenRule                 `json:"hidden_rules"`
+	NotificationPreference  *NotificationPreference          `json:"notification_preference,omitempty"`
+}
+
+// PreferencesImportResult counts the rows an import created and updated
+type PreferencesImportResult struct {
+	Created int
+	Updated int
+}
@@ -0,0 +1,38 @@
+package models
+
+import (
+	"time"
+
+	mainmodels "notificator/internal/models"
+)
+
+// UserDataExport is everything stored about one user, as returned by the
+// ExportUserData RPC. Secrets (password hash, session IDs, OAuth and Sentry
+// tokens) are never included.
+type UserDataExport struct {
+	ExportedAt              time.Time                        `json:"exported_at"`
+	User                    User                             `json:"user"`
+	Sessions                []ExportedSession                `json:"sessions"`
+	Comments                []Comment                        `json:"comments"`
+	CommentTags             []CommentTag                     `json:"comment_tags"`
+	Mentions                []Mention                        `json:"mentions"`
+	AlertActivities         []AlertActivity                  `json:"alert_activities"`
+	Acknowledgments         []Acknowledgment                 `json:"acknowledgments"`
+	Escalations             []Escalation                     `json:"escalations"`
+	HiddenAlerts            []UserHiddenAlert                `json:"hidden_alerts"`
+	HiddenRules             []UserHiddenRule                 `json:"hidden_rules"`
+	ColorPreferences        []mainmodels.UserColorPreference `json:"color_preferences"`
+	NotificationPreferences []NotificationPreference         `json:"notification_preferences"`
+	FilterPresets           []FilterPreset                   `json:"filter_presets"`
+	ColumnPreferences       []UserColumnPreference           `json:"column_preferences"`
+	StatisticsViews         []StatisticsView                 `json:"statistics_views"`
+	AnnotationButtonConfigs []AnnotationButtonConfig         `json:"annotation_button_configs"`
+	Groups                  []UserGroup                      `json:"groups"`
+	SentryConfig            *UserSentryConfig                `json:"sentry_config,omitempty"`
+}
+
+// ExportedSession is a session without its ID, which is a bearer credential
+type ExportedSession struct {
+	CreatedAt time.Time `json:"created_at"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
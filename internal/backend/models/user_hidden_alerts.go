@@ -8,19 +8,30 @@ import (
 
 // UserHiddenAlert represents a specific alert hidden by a user
 type UserHiddenAlert struct {
-	ID          string    `gorm:"primaryKey;type:varchar(32)" json:"id"`
-	UserID      string    `gorm:"type:varchar(32);not null;index:idx_user_hidden,priority:1" json:"user_id"`
-	Fingerprint string    `gorm:"type:varchar(255);not null;index:idx_user_hidden,priority:2" json:"fingerprint"`
-	AlertName   string    `gorm:"type:varchar(255)" json:"alert_name"`
-	Instance    string    `gorm:"type:varchar(255)" json:"instance"`
-	Reason      string    `gorm:"type:text" json:"reason"`
-	CreatedAt   time.Time `json:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at"`
+	ID          string `gorm:"primaryKey;type:varchar(32)" json:"id"`
+	UserID      string `gorm:"type:varchar(32);not null;index:idx_user_hidden,priority:1" json:"user_id"`
+	Fingerprint string `gorm:"type:varchar(255);not null;index:idx_user_hidden,priority:2" json:"fingerprint"`
+	AlertName   string `gorm:"type:varchar(255)" json:"alert_name"`
+	Instance    string `gorm:"type:varchar(255)" json:"instance"`
+	Reason      string `gorm:"type:text" json:"reason"`
+	// ExpiresAt is set when the alert is snoozed rather than hidden: the alert
+	// shows again once it has passed
+	ExpiresAt *time.Time `gorm:"index" json:"expires_at,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+	// DeletedAt is set by "clear all": the row stays restorable until the
+	// undo window ends and the cleanup job purges it
+	DeletedAt gorm.DeletedAt `gorm:"index" json:"-"`
 
 	// Relations
 	User User `gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE" json:"-"`
 }
 
+// IsSnoozed reports whether the alert is snoozed rather than hidden for good
+func (u *UserHiddenAlert) IsSnoozed() bool {
+	return u.ExpiresAt != nil
+}
+
 // UserHiddenRule represents a label-based rule for hiding alerts
 type UserHiddenRule struct {
 	ID          string    `gorm:"primaryKey;type:varchar(32)" json:"id"`
@@ -61,4 +72,4 @@ func (UserHiddenAlert) TableName() string {
 // TableName specifies the table name for UserHiddenRule
 func (UserHiddenRule) TableName() string {
 	return "user_hidden_rules"
-}
\ No newline at end of file
+}
@@ -32,16 +32,28 @@ const (
 	UpdateType_COMMENT_DELETED        UpdateType = 2
 	UpdateType_ACKNOWLEDGMENT_ADDED   UpdateType = 3
 	UpdateType_ACKNOWLEDGMENT_DELETED UpdateType = 4
+	UpdateType_ESCALATION_ADDED       UpdateType = 5
+	UpdateType_HEARTBEAT              UpdateType = 6  // Keepalive with no payload; clients should not display it
+	UpdateType_MENTION                UpdateType = 7  // Sent only to the mentioned user's subscriptions, whatever alert they follow
+	UpdateType_PRESENCE_JOINED        UpdateType = 8  // A user started viewing the alert
+	UpdateType_PRESENCE_LEFT          UpdateType = 9  // A user stopped viewing the alert
+	UpdateType_ACKNOWLEDGMENT_EXPIRED UpdateType = 10 // An acknowledgment's expiry passed; carries its ID like ACKNOWLEDGMENT_DELETED
 )
 
 // Enum value maps for UpdateType.
 var (
 	UpdateType_name = map[int32]string{
-		0: "UNKNOWN_UPDATE",
-		1: "COMMENT_ADDED",
-		2: "COMMENT_DELETED",
-		3: "ACKNOWLEDGMENT_ADDED",
-		4: "ACKNOWLEDGMENT_DELETED",
+		0:  "UNKNOWN_UPDATE",
+		1:  "COMMENT_ADDED",
+		2:  "COMMENT_DELETED",
+		3:  "ACKNOWLEDGMENT_ADDED",
+		4:  "ACKNOWLEDGMENT_DELETED",
+		5:  "ESCALATION_ADDED",
+		6:  "HEARTBEAT",
+		7:  "MENTION",
+		8:  "PRESENCE_JOINED",
+		9:  "PRESENCE_LEFT",
+		10: "ACKNOWLEDGMENT_EXPIRED",
 	}
 	UpdateType_value = map[string]int32{
 		"UNKNOWN_UPDATE":         0,
@@ -49,6 +61,12 @@ var (
 		"COMMENT_DELETED":        2,
 		"ACKNOWLEDGMENT_ADDED":   3,
 		"ACKNOWLEDGMENT_DELETED": 4,
+		"ESCALATION_ADDED":       5,
+		"HEARTBEAT":              6,
+		"MENTION":                7,
+		"PRESENCE_JOINED":        8,
+		"PRESENCE_LEFT":          9,
+		"ACKNOWLEDGMENT_EXPIRED": 10,
 	}
 )
 
@@ -252,6 +270,8 @@ func (x *AddCommentResponse) GetMessage() string {
 type GetCommentsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	AlertKey      string                 `protobuf:"bytes,1,opt,name=alert_key,json=alertKey,proto3" json:"alert_key,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`  // Page size, newest first; 0 returns every comment oldest first
+	Before        string                 `protobuf:"bytes,4,opt,name=before,proto3" json:"before,omitempty"` // next_cursor from a previous page; empty for the newest comments
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -293,10 +313,26 @@ func (x *GetCommentsRequest) GetAlertKey() string {
 	return ""
 }
 
+func (x *GetCommentsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetCommentsRequest) GetBefore() string {
+	if x != nil {
+		return x.Before
+	}
+	return ""
+}
+
 type GetCommentsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Comments      []*Comment             `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`
-	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`                             // Comments in this response
+	TotalCount    int32                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"` // Comments on the alert
+	NextCursor    string                 `protobuf:"bytes,4,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`  // Empty when there are no older comments
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -345,6 +381,230 @@ func (x *GetCommentsResponse) GetCount() int32 {
 	return 0
 }
 
+func (x *GetCommentsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *GetCommentsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+// Comments carrying a #tag, newest first
+type GetCommentsByTagRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Tag           string                 `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`                           // With or without the leading #
+	AlertKey      string                 `protobuf:"bytes,3,opt,name=alert_key,json=alertKey,proto3" json:"alert_key,omitempty"` // Optional; every alert when empty
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`                      // Defaults to 100, at most 500
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCommentsByTagRequest) Reset() {
+	*x = GetCommentsByTagRequest{}
+	mi := &file_proto_alert_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCommentsByTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCommentsByTagRequest) ProtoMessage() {}
+
+func (x *GetCommentsByTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCommentsByTagRequest.ProtoReflect.Descriptor instead.
+func (*GetCommentsByTagRequest) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetCommentsByTagRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *GetCommentsByTagRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *GetCommentsByTagRequest) GetAlertKey() string {
+	if x != nil {
+		return x.AlertKey
+	}
+	return ""
+}
+
+func (x *GetCommentsByTagRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetCommentsByTagResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Comments      []*Comment             `protobuf:"bytes,3,rep,name=comments,proto3" json:"comments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCommentsByTagResponse) Reset() {
+	*x = GetCommentsByTagResponse{}
+	mi := &file_proto_alert_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCommentsByTagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCommentsByTagResponse) ProtoMessage() {}
+
+func (x *GetCommentsByTagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCommentsByTagResponse.ProtoReflect.Descriptor instead.
+func (*GetCommentsByTagResponse) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetCommentsByTagResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetCommentsByTagResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetCommentsByTagResponse) GetComments() []*Comment {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+// Limits AddComment enforces, so clients can check input before posting
+type GetCommentSettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCommentSettingsRequest) Reset() {
+	*x = GetCommentSettingsRequest{}
+	mi := &file_proto_alert_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCommentSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCommentSettingsRequest) ProtoMessage() {}
+
+func (x *GetCommentSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCommentSettingsRequest.ProtoReflect.Descriptor instead.
+func (*GetCommentSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{6}
+}
+
+type GetCommentSettingsResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	MaxCommentLength int32                  `protobuf:"varint,1,opt,name=max_comment_length,json=maxCommentLength,proto3" json:"max_comment_length,omitempty"` // In characters (Unicode code points)
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetCommentSettingsResponse) Reset() {
+	*x = GetCommentSettingsResponse{}
+	mi := &file_proto_alert_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCommentSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCommentSettingsResponse) ProtoMessage() {}
+
+func (x *GetCommentSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCommentSettingsResponse.ProtoReflect.Descriptor instead.
+func (*GetCommentSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetCommentSettingsResponse) GetMaxCommentLength() int32 {
+	if x != nil {
+		return x.MaxCommentLength
+	}
+	return 0
+}
+
 // Batch comment counts request - solves N+1 query problem
 type GetCommentCountsBatchRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -355,7 +615,7 @@ type GetCommentCountsBatchRequest struct {
 
 func (x *GetCommentCountsBatchRequest) Reset() {
 	*x = GetCommentCountsBatchRequest{}
-	mi := &file_proto_alert_proto_msgTypes[4]
+	mi := &file_proto_alert_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -367,7 +627,7 @@ func (x *GetCommentCountsBatchRequest) String() string {
 func (*GetCommentCountsBatchRequest) ProtoMessage() {}
 
 func (x *GetCommentCountsBatchRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[4]
+	mi := &file_proto_alert_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -380,7 +640,7 @@ func (x *GetCommentCountsBatchRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCommentCountsBatchRequest.ProtoReflect.Descriptor instead.
 func (*GetCommentCountsBatchRequest) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{4}
+	return file_proto_alert_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *GetCommentCountsBatchRequest) GetAlertKeys() []string {
@@ -399,7 +659,7 @@ type GetCommentCountsBatchResponse struct {
 
 func (x *GetCommentCountsBatchResponse) Reset() {
 	*x = GetCommentCountsBatchResponse{}
-	mi := &file_proto_alert_proto_msgTypes[5]
+	mi := &file_proto_alert_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -411,7 +671,7 @@ func (x *GetCommentCountsBatchResponse) String() string {
 func (*GetCommentCountsBatchResponse) ProtoMessage() {}
 
 func (x *GetCommentCountsBatchResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[5]
+	mi := &file_proto_alert_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -424,7 +684,7 @@ func (x *GetCommentCountsBatchResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCommentCountsBatchResponse.ProtoReflect.Descriptor instead.
 func (*GetCommentCountsBatchResponse) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{5}
+	return file_proto_alert_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GetCommentCountsBatchResponse) GetCounts() map[string]int32 {
@@ -444,7 +704,7 @@ type DeleteCommentRequest struct {
 
 func (x *DeleteCommentRequest) Reset() {
 	*x = DeleteCommentRequest{}
-	mi := &file_proto_alert_proto_msgTypes[6]
+	mi := &file_proto_alert_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -456,7 +716,7 @@ func (x *DeleteCommentRequest) String() string {
 func (*DeleteCommentRequest) ProtoMessage() {}
 
 func (x *DeleteCommentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[6]
+	mi := &file_proto_alert_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -469,7 +729,7 @@ func (x *DeleteCommentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteCommentRequest.ProtoReflect.Descriptor instead.
 func (*DeleteCommentRequest) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{6}
+	return file_proto_alert_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *DeleteCommentRequest) GetSessionId() string {
@@ -496,7 +756,7 @@ type DeleteCommentResponse struct {
 
 func (x *DeleteCommentResponse) Reset() {
 	*x = DeleteCommentResponse{}
-	mi := &file_proto_alert_proto_msgTypes[7]
+	mi := &file_proto_alert_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -508,7 +768,7 @@ func (x *DeleteCommentResponse) String() string {
 func (*DeleteCommentResponse) ProtoMessage() {}
 
 func (x *DeleteCommentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[7]
+	mi := &file_proto_alert_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -521,7 +781,7 @@ func (x *DeleteCommentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteCommentResponse.ProtoReflect.Descriptor instead.
 func (*DeleteCommentResponse) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{7}
+	return file_proto_alert_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *DeleteCommentResponse) GetSuccess() bool {
@@ -552,7 +812,7 @@ type Comment struct {
 
 func (x *Comment) Reset() {
 	*x = Comment{}
-	mi := &file_proto_alert_proto_msgTypes[8]
+	mi := &file_proto_alert_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -564,7 +824,7 @@ func (x *Comment) String() string {
 func (*Comment) ProtoMessage() {}
 
 func (x *Comment) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[8]
+	mi := &file_proto_alert_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -577,7 +837,7 @@ func (x *Comment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Comment.ProtoReflect.Descriptor instead.
 func (*Comment) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{8}
+	return file_proto_alert_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *Comment) GetId() string {
@@ -628,13 +888,15 @@ type AddAcknowledgmentRequest struct {
 	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
 	AlertKey      string                 `protobuf:"bytes,2,opt,name=alert_key,json=alertKey,proto3" json:"alert_key,omitempty"`
 	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // Optional: the acknowledgment lapses at this time instead of lasting until removed
+	Renotify      bool                   `protobuf:"varint,5,opt,name=renotify,proto3" json:"renotify,omitempty"`                   // With expires_at: notify about the alert again when the acknowledgment lapses
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *AddAcknowledgmentRequest) Reset() {
 	*x = AddAcknowledgmentRequest{}
-	mi := &file_proto_alert_proto_msgTypes[9]
+	mi := &file_proto_alert_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -646,7 +908,7 @@ func (x *AddAcknowledgmentRequest) String() string {
 func (*AddAcknowledgmentRequest) ProtoMessage() {}
 
 func (x *AddAcknowledgmentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[9]
+	mi := &file_proto_alert_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -659,7 +921,7 @@ func (x *AddAcknowledgmentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddAcknowledgmentRequest.ProtoReflect.Descriptor instead.
 func (*AddAcknowledgmentRequest) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{9}
+	return file_proto_alert_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *AddAcknowledgmentRequest) GetSessionId() string {
@@ -683,6 +945,20 @@ func (x *AddAcknowledgmentRequest) GetReason() string {
 	return ""
 }
 
+func (x *AddAcknowledgmentRequest) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *AddAcknowledgmentRequest) GetRenotify() bool {
+	if x != nil {
+		return x.Renotify
+	}
+	return false
+}
+
 type AddAcknowledgmentResponse struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	Success        bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -694,7 +970,7 @@ type AddAcknowledgmentResponse struct {
 
 func (x *AddAcknowledgmentResponse) Reset() {
 	*x = AddAcknowledgmentResponse{}
-	mi := &file_proto_alert_proto_msgTypes[10]
+	mi := &file_proto_alert_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -706,7 +982,7 @@ func (x *AddAcknowledgmentResponse) String() string {
 func (*AddAcknowledgmentResponse) ProtoMessage() {}
 
 func (x *AddAcknowledgmentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[10]
+	mi := &file_proto_alert_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -719,7 +995,7 @@ func (x *AddAcknowledgmentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddAcknowledgmentResponse.ProtoReflect.Descriptor instead.
 func (*AddAcknowledgmentResponse) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{10}
+	return file_proto_alert_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *AddAcknowledgmentResponse) GetSuccess() bool {
@@ -746,13 +1022,15 @@ func (x *AddAcknowledgmentResponse) GetMessage() string {
 type GetAcknowledgmentsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	AlertKey      string                 `protobuf:"bytes,1,opt,name=alert_key,json=alertKey,proto3" json:"alert_key,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`  // Page size, newest first; 0 returns every acknowledgment
+	Before        string                 `protobuf:"bytes,4,opt,name=before,proto3" json:"before,omitempty"` // next_cursor from a previous page; empty for the newest acknowledgments
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetAcknowledgmentsRequest) Reset() {
 	*x = GetAcknowledgmentsRequest{}
-	mi := &file_proto_alert_proto_msgTypes[11]
+	mi := &file_proto_alert_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -764,7 +1042,7 @@ func (x *GetAcknowledgmentsRequest) String() string {
 func (*GetAcknowledgmentsRequest) ProtoMessage() {}
 
 func (x *GetAcknowledgmentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[11]
+	mi := &file_proto_alert_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -777,7 +1055,7 @@ func (x *GetAcknowledgmentsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAcknowledgmentsRequest.ProtoReflect.Descriptor instead.
 func (*GetAcknowledgmentsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{11}
+	return file_proto_alert_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *GetAcknowledgmentsRequest) GetAlertKey() string {
@@ -787,17 +1065,33 @@ func (x *GetAcknowledgmentsRequest) GetAlertKey() string {
 	return ""
 }
 
+func (x *GetAcknowledgmentsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetAcknowledgmentsRequest) GetBefore() string {
+	if x != nil {
+		return x.Before
+	}
+	return ""
+}
+
 type GetAcknowledgmentsResponse struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	Acknowledgments []*Acknowledgment      `protobuf:"bytes,1,rep,name=acknowledgments,proto3" json:"acknowledgments,omitempty"`
-	Count           int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Count           int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`                             // Acknowledgments in this response
+	TotalCount      int32                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"` // Acknowledgments on the alert
+	NextCursor      string                 `protobuf:"bytes,4,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`  // Empty when there are no older acknowledgments
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
 
 func (x *GetAcknowledgmentsResponse) Reset() {
 	*x = GetAcknowledgmentsResponse{}
-	mi := &file_proto_alert_proto_msgTypes[12]
+	mi := &file_proto_alert_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -809,7 +1103,7 @@ func (x *GetAcknowledgmentsResponse) String() string {
 func (*GetAcknowledgmentsResponse) ProtoMessage() {}
 
 func (x *GetAcknowledgmentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[12]
+	mi := &file_proto_alert_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -822,7 +1116,7 @@ func (x *GetAcknowledgmentsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAcknowledgmentsResponse.ProtoReflect.Descriptor instead.
 func (*GetAcknowledgmentsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{12}
+	return file_proto_alert_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *GetAcknowledgmentsResponse) GetAcknowledgments() []*Acknowledgment {
@@ -839,6 +1133,20 @@ func (x *GetAcknowledgmentsResponse) GetCount() int32 {
 	return 0
 }
 
+func (x *GetAcknowledgmentsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *GetAcknowledgmentsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
 type GetAllAcknowledgedAlertsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	AlertKeys     []string               `protobuf:"bytes,1,rep,name=alert_keys,json=alertKeys,proto3" json:"alert_keys,omitempty"` // Only return acknowledgments for these alert keys
@@ -848,7 +1156,7 @@ type GetAllAcknowledgedAlertsRequest struct {
 
 func (x *GetAllAcknowledgedAlertsRequest) Reset() {
 	*x = GetAllAcknowledgedAlertsRequest{}
-	mi := &file_proto_alert_proto_msgTypes[13]
+	mi := &file_proto_alert_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -860,7 +1168,7 @@ func (x *GetAllAcknowledgedAlertsRequest) String() string {
 func (*GetAllAcknowledgedAlertsRequest) ProtoMessage() {}
 
 func (x *GetAllAcknowledgedAlertsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[13]
+	mi := &file_proto_alert_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -873,7 +1181,7 @@ func (x *GetAllAcknowledgedAlertsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAllAcknowledgedAlertsRequest.ProtoReflect.Descriptor instead.
 func (*GetAllAcknowledgedAlertsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{13}
+	return file_proto_alert_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *GetAllAcknowledgedAlertsRequest) GetAlertKeys() []string {
@@ -884,16 +1192,17 @@ func (x *GetAllAcknowledgedAlertsRequest) GetAlertKeys() []string {
 }
 
 type GetAllAcknowledgedAlertsResponse struct {
-	state              protoimpl.MessageState     `protogen:"open.v1"`
-	AcknowledgedAlerts map[string]*Acknowledgment `protobuf:"bytes,1,rep,name=acknowledged_alerts,json=acknowledgedAlerts,proto3" json:"acknowledged_alerts,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // alert_key -> latest acknowledgment
-	Count              int32                      `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+	state                protoimpl.MessageState     `protogen:"open.v1"`
+	AcknowledgedAlerts   map[string]*Acknowledgment `protobuf:"bytes,1,rep,name=acknowledged_alerts,json=acknowledgedAlerts,proto3" json:"acknowledged_alerts,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // alert_key -> latest acknowledgment
+	Count                int32                      `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	AcknowledgmentCounts map[string]int32           `protobuf:"bytes,3,rep,name=acknowledgment_counts,json=acknowledgmentCounts,proto3" json:"acknowledgment_counts,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"` // alert_key -> number of acknowledgments
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
 func (x *GetAllAcknowledgedAlertsResponse) Reset() {
 	*x = GetAllAcknowledgedAlertsResponse{}
-	mi := &file_proto_alert_proto_msgTypes[14]
+	mi := &file_proto_alert_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -905,7 +1214,7 @@ func (x *GetAllAcknowledgedAlertsResponse) String() string {
 func (*GetAllAcknowledgedAlertsResponse) ProtoMessage() {}
 
 func (x *GetAllAcknowledgedAlertsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[14]
+	mi := &file_proto_alert_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -918,7 +1227,7 @@ func (x *GetAllAcknowledgedAlertsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAllAcknowledgedAlertsResponse.ProtoReflect.Descriptor instead.
 func (*GetAllAcknowledgedAlertsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{14}
+	return file_proto_alert_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *GetAllAcknowledgedAlertsResponse) GetAcknowledgedAlerts() map[string]*Acknowledgment {
@@ -935,17 +1244,25 @@ func (x *GetAllAcknowledgedAlertsResponse) GetCount() int32 {
 	return 0
 }
 
+func (x *GetAllAcknowledgedAlertsResponse) GetAcknowledgmentCounts() map[string]int32 {
+	if x != nil {
+		return x.AcknowledgmentCounts
+	}
+	return nil
+}
+
 type DeleteAcknowledgmentRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	AlertKey      string                 `protobuf:"bytes,2,opt,name=alert_key,json=alertKey,proto3" json:"alert_key,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	SessionId        string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	AlertKey         string                 `protobuf:"bytes,2,opt,name=alert_key,json=alertKey,proto3" json:"alert_key,omitempty"`
+	AcknowledgmentId string                 `protobuf:"bytes,3,opt,name=acknowledgment_id,json=acknowledgmentId,proto3" json:"acknowledgment_id,omitempty"` // Optional: remove only this acknowledgment; empty removes all of the caller's
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *DeleteAcknowledgmentRequest) Reset() {
 	*x = DeleteAcknowledgmentRequest{}
-	mi := &file_proto_alert_proto_msgTypes[15]
+	mi := &file_proto_alert_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -957,7 +1274,7 @@ func (x *DeleteAcknowledgmentRequest) String() string {
 func (*DeleteAcknowledgmentRequest) ProtoMessage() {}
 
 func (x *DeleteAcknowledgmentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[15]
+	mi := &file_proto_alert_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -970,7 +1287,7 @@ func (x *DeleteAcknowledgmentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteAcknowledgmentRequest.ProtoReflect.Descriptor instead.
 func (*DeleteAcknowledgmentRequest) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{15}
+	return file_proto_alert_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *DeleteAcknowledgmentRequest) GetSessionId() string {
@@ -987,6 +1304,13 @@ func (x *DeleteAcknowledgmentRequest) GetAlertKey() string {
 	return ""
 }
 
+func (x *DeleteAcknowledgmentRequest) GetAcknowledgmentId() string {
+	if x != nil {
+		return x.AcknowledgmentId
+	}
+	return ""
+}
+
 type DeleteAcknowledgmentResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -997,7 +1321,7 @@ type DeleteAcknowledgmentResponse struct {
 
 func (x *DeleteAcknowledgmentResponse) Reset() {
 	*x = DeleteAcknowledgmentResponse{}
-	mi := &file_proto_alert_proto_msgTypes[16]
+	mi := &file_proto_alert_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1009,7 +1333,7 @@ func (x *DeleteAcknowledgmentResponse) String() string {
 func (*DeleteAcknowledgmentResponse) ProtoMessage() {}
 
 func (x *DeleteAcknowledgmentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[16]
+	mi := &file_proto_alert_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1022,7 +1346,7 @@ func (x *DeleteAcknowledgmentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteAcknowledgmentResponse.ProtoReflect.Descriptor instead.
 func (*DeleteAcknowledgmentResponse) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{16}
+	return file_proto_alert_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *DeleteAcknowledgmentResponse) GetSuccess() bool {
@@ -1039,6 +1363,150 @@ func (x *DeleteAcknowledgmentResponse) GetMessage() string {
 	return ""
 }
 
+type BulkAcknowledgeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	AlertKeys     []string               `protobuf:"bytes,2,rep,name=alert_keys,json=alertKeys,proto3" json:"alert_keys,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // Optional: the acknowledgments lapse at this time
+	Renotify      bool                   `protobuf:"varint,5,opt,name=renotify,proto3" json:"renotify,omitempty"`                   // With expires_at: notify about the alerts again when they lapse
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkAcknowledgeRequest) Reset() {
+	*x = BulkAcknowledgeRequest{}
+	mi := &file_proto_alert_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkAcknowledgeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkAcknowledgeRequest) ProtoMessage() {}
+
+func (x *BulkAcknowledgeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkAcknowledgeRequest.ProtoReflect.Descriptor instead.
+func (*BulkAcknowledgeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *BulkAcknowledgeRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *BulkAcknowledgeRequest) GetAlertKeys() []string {
+	if x != nil {
+		return x.AlertKeys
+	}
+	return nil
+}
+
+func (x *BulkAcknowledgeRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *BulkAcknowledgeRequest) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *BulkAcknowledgeRequest) GetRenotify() bool {
+	if x != nil {
+		return x.Renotify
+	}
+	return false
+}
+
+type BulkAcknowledgeResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Success         bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // True only if every alert key was acknowledged
+	Message         string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Results         map[string]bool        `protobuf:"bytes,3,rep,name=results,proto3" json:"results,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"` // alert_key -> acknowledged
+	Acknowledgments []*Acknowledgment      `protobuf:"bytes,4,rep,name=acknowledgments,proto3" json:"acknowledgments,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *BulkAcknowledgeResponse) Reset() {
+	*x = BulkAcknowledgeResponse{}
+	mi := &file_proto_alert_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkAcknowledgeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkAcknowledgeResponse) ProtoMessage() {}
+
+func (x *BulkAcknowledgeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkAcknowledgeResponse.ProtoReflect.Descriptor instead.
+func (*BulkAcknowledgeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *BulkAcknowledgeResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *BulkAcknowledgeResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *BulkAcknowledgeResponse) GetResults() map[string]bool {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *BulkAcknowledgeResponse) GetAcknowledgments() []*Acknowledgment {
+	if x != nil {
+		return x.Acknowledgments
+	}
+	return nil
+}
+
 type Acknowledgment struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -1047,13 +1515,15 @@ type Acknowledgment struct {
 	Username      string                 `protobuf:"bytes,4,opt,name=username,proto3" json:"username,omitempty"`
 	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
 	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // Unset for acknowledgments that last until removed
+	Renotify      bool                   `protobuf:"varint,8,opt,name=renotify,proto3" json:"renotify,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Acknowledgment) Reset() {
 	*x = Acknowledgment{}
-	mi := &file_proto_alert_proto_msgTypes[17]
+	mi := &file_proto_alert_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1065,7 +1535,7 @@ func (x *Acknowledgment) String() string {
 func (*Acknowledgment) ProtoMessage() {}
 
 func (x *Acknowledgment) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[17]
+	mi := &file_proto_alert_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1078,7 +1548,7 @@ func (x *Acknowledgment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Acknowledgment.ProtoReflect.Descriptor instead.
 func (*Acknowledgment) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{17}
+	return file_proto_alert_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *Acknowledgment) GetId() string {
@@ -1123,30 +1593,47 @@ func (x *Acknowledgment) GetCreatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
-// Real-time Updates Messages
-type SubscribeToAlertUpdatesRequest struct {
+func (x *Acknowledgment) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *Acknowledgment) GetRenotify() bool {
+	if x != nil {
+		return x.Renotify
+	}
+	return false
+}
+
+// Escalation Messages
+type EscalateAlertRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
 	AlertKey      string                 `protobuf:"bytes,2,opt,name=alert_key,json=alertKey,proto3" json:"alert_key,omitempty"`
+	EscalatedTo   string                 `protobuf:"bytes,3,opt,name=escalated_to,json=escalatedTo,proto3" json:"escalated_to,omitempty"` // Username or group name
+	TargetType    string                 `protobuf:"bytes,4,opt,name=target_type,json=targetType,proto3" json:"target_type,omitempty"`    // "user" or "group"
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SubscribeToAlertUpdatesRequest) Reset() {
-	*x = SubscribeToAlertUpdatesRequest{}
-	mi := &file_proto_alert_proto_msgTypes[18]
+func (x *EscalateAlertRequest) Reset() {
+	*x = EscalateAlertRequest{}
+	mi := &file_proto_alert_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SubscribeToAlertUpdatesRequest) String() string {
+func (x *EscalateAlertRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubscribeToAlertUpdatesRequest) ProtoMessage() {}
+func (*EscalateAlertRequest) ProtoMessage() {}
 
-func (x *SubscribeToAlertUpdatesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[18]
+func (x *EscalateAlertRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1157,56 +1644,70 @@ func (x *SubscribeToAlertUpdatesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubscribeToAlertUpdatesRequest.ProtoReflect.Descriptor instead.
-func (*SubscribeToAlertUpdatesRequest) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use EscalateAlertRequest.ProtoReflect.Descriptor instead.
+func (*EscalateAlertRequest) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *SubscribeToAlertUpdatesRequest) GetSessionId() string {
+func (x *EscalateAlertRequest) GetSessionId() string {
 	if x != nil {
 		return x.SessionId
 	}
 	return ""
 }
 
-func (x *SubscribeToAlertUpdatesRequest) GetAlertKey() string {
+func (x *EscalateAlertRequest) GetAlertKey() string {
 	if x != nil {
 		return x.AlertKey
 	}
 	return ""
 }
 
-type AlertUpdate struct {
-	state      protoimpl.MessageState `protogen:"open.v1"`
-	AlertKey   string                 `protobuf:"bytes,1,opt,name=alert_key,json=alertKey,proto3" json:"alert_key,omitempty"`
-	UpdateType UpdateType             `protobuf:"varint,2,opt,name=update_type,json=updateType,proto3,enum=notificator.alert.UpdateType" json:"update_type,omitempty"`
-	// Types that are valid to be assigned to UpdateData:
-	//
-	//	*AlertUpdate_Comment
-	//	*AlertUpdate_Acknowledgment
-	//	*AlertUpdate_DeletedCommentId
-	//	*AlertUpdate_DeletedAcknowledgmentId
-	UpdateData    isAlertUpdate_UpdateData `protobuf_oneof:"update_data"`
-	Timestamp     *timestamppb.Timestamp   `protobuf:"bytes,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+func (x *EscalateAlertRequest) GetEscalatedTo() string {
+	if x != nil {
+		return x.EscalatedTo
+	}
+	return ""
+}
+
+func (x *EscalateAlertRequest) GetTargetType() string {
+	if x != nil {
+		return x.TargetType
+	}
+	return ""
+}
+
+func (x *EscalateAlertRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type EscalateAlertResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Escalation    *Escalation            `protobuf:"bytes,2,opt,name=escalation,proto3" json:"escalation,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AlertUpdate) Reset() {
-	*x = AlertUpdate{}
-	mi := &file_proto_alert_proto_msgTypes[19]
+func (x *EscalateAlertResponse) Reset() {
+	*x = EscalateAlertResponse{}
+	mi := &file_proto_alert_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AlertUpdate) String() string {
+func (x *EscalateAlertResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AlertUpdate) ProtoMessage() {}
+func (*EscalateAlertResponse) ProtoMessage() {}
 
-func (x *AlertUpdate) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[19]
+func (x *EscalateAlertResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1217,127 +1718,99 @@ func (x *AlertUpdate) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AlertUpdate.ProtoReflect.Descriptor instead.
-func (*AlertUpdate) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use EscalateAlertResponse.ProtoReflect.Descriptor instead.
+func (*EscalateAlertResponse) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *AlertUpdate) GetAlertKey() string {
+func (x *EscalateAlertResponse) GetSuccess() bool {
 	if x != nil {
-		return x.AlertKey
+		return x.Success
 	}
-	return ""
+	return false
 }
 
-func (x *AlertUpdate) GetUpdateType() UpdateType {
+func (x *EscalateAlertResponse) GetEscalation() *Escalation {
 	if x != nil {
-		return x.UpdateType
+		return x.Escalation
 	}
-	return UpdateType_UNKNOWN_UPDATE
+	return nil
 }
 
-func (x *AlertUpdate) GetUpdateData() isAlertUpdate_UpdateData {
+func (x *EscalateAlertResponse) GetMessage() string {
 	if x != nil {
-		return x.UpdateData
+		return x.Message
 	}
-	return nil
+	return ""
 }
 
-func (x *AlertUpdate) GetComment() *Comment {
-	if x != nil {
-		if x, ok := x.UpdateData.(*AlertUpdate_Comment); ok {
-			return x.Comment
-		}
-	}
-	return nil
+type GetEscalationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AlertKey      string                 `protobuf:"bytes,1,opt,name=alert_key,json=alertKey,proto3" json:"alert_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AlertUpdate) GetAcknowledgment() *Acknowledgment {
-	if x != nil {
-		if x, ok := x.UpdateData.(*AlertUpdate_Acknowledgment); ok {
-			return x.Acknowledgment
-		}
-	}
-	return nil
+func (x *GetEscalationsRequest) Reset() {
+	*x = GetEscalationsRequest{}
+	mi := &file_proto_alert_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *AlertUpdate) GetDeletedCommentId() string {
-	if x != nil {
-		if x, ok := x.UpdateData.(*AlertUpdate_DeletedCommentId); ok {
-			return x.DeletedCommentId
-		}
-	}
-	return ""
+func (x *GetEscalationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *AlertUpdate) GetDeletedAcknowledgmentId() string {
-	if x != nil {
-		if x, ok := x.UpdateData.(*AlertUpdate_DeletedAcknowledgmentId); ok {
-			return x.DeletedAcknowledgmentId
-		}
-	}
-	return ""
-}
+func (*GetEscalationsRequest) ProtoMessage() {}
 
-func (x *AlertUpdate) GetTimestamp() *timestamppb.Timestamp {
+func (x *GetEscalationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[26]
 	if x != nil {
-		return x.Timestamp
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
-}
-
-type isAlertUpdate_UpdateData interface {
-	isAlertUpdate_UpdateData()
-}
-
-type AlertUpdate_Comment struct {
-	Comment *Comment `protobuf:"bytes,3,opt,name=comment,proto3,oneof"`
-}
-
-type AlertUpdate_Acknowledgment struct {
-	Acknowledgment *Acknowledgment `protobuf:"bytes,4,opt,name=acknowledgment,proto3,oneof"`
+	return mi.MessageOf(x)
 }
 
-type AlertUpdate_DeletedCommentId struct {
-	DeletedCommentId string `protobuf:"bytes,5,opt,name=deleted_comment_id,json=deletedCommentId,proto3,oneof"`
+// Deprecated: Use GetEscalationsRequest.ProtoReflect.Descriptor instead.
+func (*GetEscalationsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{26}
 }
 
-type AlertUpdate_DeletedAcknowledgmentId struct {
-	DeletedAcknowledgmentId string `protobuf:"bytes,6,opt,name=deleted_acknowledgment_id,json=deletedAcknowledgmentId,proto3,oneof"`
+func (x *GetEscalationsRequest) GetAlertKey() string {
+	if x != nil {
+		return x.AlertKey
+	}
+	return ""
 }
 
-func (*AlertUpdate_Comment) isAlertUpdate_UpdateData() {}
-
-func (*AlertUpdate_Acknowledgment) isAlertUpdate_UpdateData() {}
-
-func (*AlertUpdate_DeletedCommentId) isAlertUpdate_UpdateData() {}
-
-func (*AlertUpdate_DeletedAcknowledgmentId) isAlertUpdate_UpdateData() {}
-
-// User Color Preferences Messages
-type GetUserColorPreferencesRequest struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	SessionId         string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	ImpersonateUserId string                 `protobuf:"bytes,2,opt,name=impersonate_user_id,json=impersonateUserId,proto3" json:"impersonate_user_id,omitempty"` // Optional: load preferences for this user instead
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+type GetEscalationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Escalations   []*Escalation          `protobuf:"bytes,1,rep,name=escalations,proto3" json:"escalations,omitempty"`
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserColorPreferencesRequest) Reset() {
-	*x = GetUserColorPreferencesRequest{}
-	mi := &file_proto_alert_proto_msgTypes[20]
+func (x *GetEscalationsResponse) Reset() {
+	*x = GetEscalationsResponse{}
+	mi := &file_proto_alert_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserColorPreferencesRequest) String() string {
+func (x *GetEscalationsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserColorPreferencesRequest) ProtoMessage() {}
+func (*GetEscalationsResponse) ProtoMessage() {}
 
-func (x *GetUserColorPreferencesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[20]
+func (x *GetEscalationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1348,49 +1821,54 @@ func (x *GetUserColorPreferencesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserColorPreferencesRequest.ProtoReflect.Descriptor instead.
-func (*GetUserColorPreferencesRequest) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use GetEscalationsResponse.ProtoReflect.Descriptor instead.
+func (*GetEscalationsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *GetUserColorPreferencesRequest) GetSessionId() string {
+func (x *GetEscalationsResponse) GetEscalations() []*Escalation {
 	if x != nil {
-		return x.SessionId
+		return x.Escalations
 	}
-	return ""
+	return nil
 }
 
-func (x *GetUserColorPreferencesRequest) GetImpersonateUserId() string {
+func (x *GetEscalationsResponse) GetCount() int32 {
 	if x != nil {
-		return x.ImpersonateUserId
+		return x.Count
 	}
-	return ""
+	return 0
 }
 
-type GetUserColorPreferencesResponse struct {
+type Escalation struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Preferences   []*UserColorPreference `protobuf:"bytes,1,rep,name=preferences,proto3" json:"preferences,omitempty"`
-	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AlertKey      string                 `protobuf:"bytes,2,opt,name=alert_key,json=alertKey,proto3" json:"alert_key,omitempty"`
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Who escalated
+	Username      string                 `protobuf:"bytes,4,opt,name=username,proto3" json:"username,omitempty"`
+	EscalatedTo   string                 `protobuf:"bytes,5,opt,name=escalated_to,json=escalatedTo,proto3" json:"escalated_to,omitempty"`
+	TargetType    string                 `protobuf:"bytes,6,opt,name=target_type,json=targetType,proto3" json:"target_type,omitempty"`
+	Reason        string                 `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserColorPreferencesResponse) Reset() {
-	*x = GetUserColorPreferencesResponse{}
-	mi := &file_proto_alert_proto_msgTypes[21]
+func (x *Escalation) Reset() {
+	*x = Escalation{}
+	mi := &file_proto_alert_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserColorPreferencesResponse) String() string {
+func (x *Escalation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserColorPreferencesResponse) ProtoMessage() {}
+func (*Escalation) ProtoMessage() {}
 
-func (x *GetUserColorPreferencesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[21]
+func (x *Escalation) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1401,56 +1879,94 @@ func (x *GetUserColorPreferencesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserColorPreferencesResponse.ProtoReflect.Descriptor instead.
-func (*GetUserColorPreferencesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use Escalation.ProtoReflect.Descriptor instead.
+func (*Escalation) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *GetUserColorPreferencesResponse) GetPreferences() []*UserColorPreference {
+func (x *Escalation) GetId() string {
 	if x != nil {
-		return x.Preferences
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-func (x *GetUserColorPreferencesResponse) GetSuccess() bool {
+func (x *Escalation) GetAlertKey() string {
 	if x != nil {
-		return x.Success
+		return x.AlertKey
 	}
-	return false
+	return ""
 }
 
-func (x *GetUserColorPreferencesResponse) GetMessage() string {
+func (x *Escalation) GetUserId() string {
 	if x != nil {
-		return x.Message
+		return x.UserId
 	}
 	return ""
 }
 
-type SaveUserColorPreferencesRequest struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	SessionId         string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	Preferences       []*UserColorPreference `protobuf:"bytes,2,rep,name=preferences,proto3" json:"preferences,omitempty"`
-	ImpersonateUserId string                 `protobuf:"bytes,3,opt,name=impersonate_user_id,json=impersonateUserId,proto3" json:"impersonate_user_id,omitempty"` // Optional: save preferences for this user instead
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+func (x *Escalation) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
 }
 
-func (x *SaveUserColorPreferencesRequest) Reset() {
-	*x = SaveUserColorPreferencesRequest{}
-	mi := &file_proto_alert_proto_msgTypes[22]
+func (x *Escalation) GetEscalatedTo() string {
+	if x != nil {
+		return x.EscalatedTo
+	}
+	return ""
+}
+
+func (x *Escalation) GetTargetType() string {
+	if x != nil {
+		return x.TargetType
+	}
+	return ""
+}
+
+func (x *Escalation) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *Escalation) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// Mention tells a user that a comment mentioned them with @username
+type Mention struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CommentId     string                 `protobuf:"bytes,1,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	AlertKey      string                 `protobuf:"bytes,2,opt,name=alert_key,json=alertKey,proto3" json:"alert_key,omitempty"`          // Alert the comment was added to
+	MentionedBy   string                 `protobuf:"bytes,3,opt,name=mentioned_by,json=mentionedBy,proto3" json:"mentioned_by,omitempty"` // Username of the comment's author
+	Content       string                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Mention) Reset() {
+	*x = Mention{}
+	mi := &file_proto_alert_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SaveUserColorPreferencesRequest) String() string {
+func (x *Mention) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SaveUserColorPreferencesRequest) ProtoMessage() {}
+func (*Mention) ProtoMessage() {}
 
-func (x *SaveUserColorPreferencesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[22]
+func (x *Mention) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1461,55 +1977,72 @@ func (x *SaveUserColorPreferencesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SaveUserColorPreferencesRequest.ProtoReflect.Descriptor instead.
-func (*SaveUserColorPreferencesRequest) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use Mention.ProtoReflect.Descriptor instead.
+func (*Mention) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *SaveUserColorPreferencesRequest) GetSessionId() string {
+func (x *Mention) GetCommentId() string {
 	if x != nil {
-		return x.SessionId
+		return x.CommentId
 	}
 	return ""
 }
 
-func (x *SaveUserColorPreferencesRequest) GetPreferences() []*UserColorPreference {
+func (x *Mention) GetAlertKey() string {
 	if x != nil {
-		return x.Preferences
+		return x.AlertKey
 	}
-	return nil
+	return ""
 }
 
-func (x *SaveUserColorPreferencesRequest) GetImpersonateUserId() string {
+func (x *Mention) GetMentionedBy() string {
 	if x != nil {
-		return x.ImpersonateUserId
+		return x.MentionedBy
 	}
 	return ""
 }
 
-type SaveUserColorPreferencesResponse struct {
+func (x *Mention) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *Mention) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// Presence lists who has the alert open. The subscription's first update
+// carries it with only viewers set; PRESENCE_JOINED and PRESENCE_LEFT also
+// name the user who came or went.
+type Presence struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	User          *Viewer                `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Viewers       []*Viewer              `protobuf:"bytes,2,rep,name=viewers,proto3" json:"viewers,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SaveUserColorPreferencesResponse) Reset() {
-	*x = SaveUserColorPreferencesResponse{}
-	mi := &file_proto_alert_proto_msgTypes[23]
+func (x *Presence) Reset() {
+	*x = Presence{}
+	mi := &file_proto_alert_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SaveUserColorPreferencesResponse) String() string {
+func (x *Presence) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SaveUserColorPreferencesResponse) ProtoMessage() {}
+func (*Presence) ProtoMessage() {}
 
-func (x *SaveUserColorPreferencesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[23]
+func (x *Presence) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1520,49 +2053,48 @@ func (x *SaveUserColorPreferencesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SaveUserColorPreferencesResponse.ProtoReflect.Descriptor instead.
-func (*SaveUserColorPreferencesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use Presence.ProtoReflect.Descriptor instead.
+func (*Presence) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *SaveUserColorPreferencesResponse) GetSuccess() bool {
+func (x *Presence) GetUser() *Viewer {
 	if x != nil {
-		return x.Success
+		return x.User
 	}
-	return false
+	return nil
 }
 
-func (x *SaveUserColorPreferencesResponse) GetMessage() string {
+func (x *Presence) GetViewers() []*Viewer {
 	if x != nil {
-		return x.Message
+		return x.Viewers
 	}
-	return ""
+	return nil
 }
 
-type DeleteUserColorPreferenceRequest struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	SessionId         string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	PreferenceId      string                 `protobuf:"bytes,2,opt,name=preference_id,json=preferenceId,proto3" json:"preference_id,omitempty"`
-	ImpersonateUserId string                 `protobuf:"bytes,3,opt,name=impersonate_user_id,json=impersonateUserId,proto3" json:"impersonate_user_id,omitempty"` // Optional: delete preference for this user instead
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+type Viewer struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteUserColorPreferenceRequest) Reset() {
-	*x = DeleteUserColorPreferenceRequest{}
-	mi := &file_proto_alert_proto_msgTypes[24]
+func (x *Viewer) Reset() {
+	*x = Viewer{}
+	mi := &file_proto_alert_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteUserColorPreferenceRequest) String() string {
+func (x *Viewer) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteUserColorPreferenceRequest) ProtoMessage() {}
+func (*Viewer) ProtoMessage() {}
 
-func (x *DeleteUserColorPreferenceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[24]
+func (x *Viewer) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1573,55 +2105,109 @@ func (x *DeleteUserColorPreferenceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteUserColorPreferenceRequest.ProtoReflect.Descriptor instead.
-func (*DeleteUserColorPreferenceRequest) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use Viewer.ProtoReflect.Descriptor instead.
+func (*Viewer) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *DeleteUserColorPreferenceRequest) GetSessionId() string {
+func (x *Viewer) GetUserId() string {
 	if x != nil {
-		return x.SessionId
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *DeleteUserColorPreferenceRequest) GetPreferenceId() string {
+func (x *Viewer) GetUsername() string {
 	if x != nil {
-		return x.PreferenceId
+		return x.Username
 	}
 	return ""
 }
 
-func (x *DeleteUserColorPreferenceRequest) GetImpersonateUserId() string {
+// Activity Timeline Messages
+type GetAlertActivityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AlertKey      string                 `protobuf:"bytes,1,opt,name=alert_key,json=alertKey,proto3" json:"alert_key,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`  // Defaults to 50, max 200
+	Before        string                 `protobuf:"bytes,3,opt,name=before,proto3" json:"before,omitempty"` // next_cursor from a previous page; empty for the newest entries
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAlertActivityRequest) Reset() {
+	*x = GetAlertActivityRequest{}
+	mi := &file_proto_alert_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAlertActivityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAlertActivityRequest) ProtoMessage() {}
+
+func (x *GetAlertActivityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[32]
 	if x != nil {
-		return x.ImpersonateUserId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAlertActivityRequest.ProtoReflect.Descriptor instead.
+func (*GetAlertActivityRequest) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetAlertActivityRequest) GetAlertKey() string {
+	if x != nil {
+		return x.AlertKey
 	}
 	return ""
 }
 
-type DeleteUserColorPreferenceResponse struct {
+func (x *GetAlertActivityRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetAlertActivityRequest) GetBefore() string {
+	if x != nil {
+		return x.Before
+	}
+	return ""
+}
+
+type GetAlertActivityResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Activities    []*AlertActivity       `protobuf:"bytes,1,rep,name=activities,proto3" json:"activities,omitempty"`                   // Newest first
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"` // Empty when there are no older entries
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteUserColorPreferenceResponse) Reset() {
-	*x = DeleteUserColorPreferenceResponse{}
-	mi := &file_proto_alert_proto_msgTypes[25]
+func (x *GetAlertActivityResponse) Reset() {
+	*x = GetAlertActivityResponse{}
+	mi := &file_proto_alert_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteUserColorPreferenceResponse) String() string {
+func (x *GetAlertActivityResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteUserColorPreferenceResponse) ProtoMessage() {}
+func (*GetAlertActivityResponse) ProtoMessage() {}
 
-func (x *DeleteUserColorPreferenceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[25]
+func (x *GetAlertActivityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1632,56 +2218,55 @@ func (x *DeleteUserColorPreferenceResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteUserColorPreferenceResponse.ProtoReflect.Descriptor instead.
-func (*DeleteUserColorPreferenceResponse) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use GetAlertActivityResponse.ProtoReflect.Descriptor instead.
+func (*GetAlertActivityResponse) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *DeleteUserColorPreferenceResponse) GetSuccess() bool {
+func (x *GetAlertActivityResponse) GetActivities() []*AlertActivity {
 	if x != nil {
-		return x.Success
+		return x.Activities
 	}
-	return false
+	return nil
 }
 
-func (x *DeleteUserColorPreferenceResponse) GetMessage() string {
+func (x *GetAlertActivityResponse) GetNextCursor() string {
 	if x != nil {
-		return x.Message
+		return x.NextCursor
 	}
 	return ""
 }
 
-type UserColorPreference struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	Id                 string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                                                                                                            // Unique ID for the preference
-	UserId             string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                                                                                                      // User who owns this preference
-	LabelConditions    map[string]string      `protobuf:"bytes,3,rep,name=label_conditions,json=labelConditions,proto3" json:"label_conditions,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Key-value pairs: {Team: "team-infrastructure", Severity: "Warning"}
-	Color              string                 `protobuf:"bytes,4,opt,name=color,proto3" json:"color,omitempty"`                                                                                                                      // Color value (e.g., "GRAY", "#FF5733", "red-500")
-	ColorType          string                 `protobuf:"bytes,5,opt,name=color_type,json=colorType,proto3" json:"color_type,omitempty"`                                                                                             // Type: "severity", "custom", "tailwind"
-	Priority           int32                  `protobuf:"varint,6,opt,name=priority,proto3" json:"priority,omitempty"`                                                                                                               // Higher numbers = higher priority (for conflicts)
-	CreatedAt          *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt          *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	BgLightnessFactor  float32                `protobuf:"fixed32,9,opt,name=bg_lightness_factor,json=bgLightnessFactor,proto3" json:"bg_lightness_factor,omitempty"`     // Background lightness adjustment factor
-	TextDarknessFactor float32                `protobuf:"fixed32,10,opt,name=text_darkness_factor,json=textDarknessFactor,proto3" json:"text_darkness_factor,omitempty"` // Text darkness adjustment factor
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+type AlertActivity struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AlertKey      string                 `protobuf:"bytes,2,opt,name=alert_key,json=alertKey,proto3" json:"alert_key,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"` // comment, ack, ack_removed, ack_expired, escalation, hide or snooze
+	UserId        string                 `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username      string                 `protobuf:"bytes,5,opt,name=username,proto3" json:"username,omitempty"`
+	Content       string                 `protobuf:"bytes,6,opt,name=content,proto3" json:"content,omitempty"` // Comment text or reason
+	Target        string                 `protobuf:"bytes,7,opt,name=target,proto3" json:"target,omitempty"`   // Escalation target
+	RefId         string                 `protobuf:"bytes,8,opt,name=ref_id,json=refId,proto3" json:"ref_id,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UserColorPreference) Reset() {
-	*x = UserColorPreference{}
-	mi := &file_proto_alert_proto_msgTypes[26]
+func (x *AlertActivity) Reset() {
+	*x = AlertActivity{}
+	mi := &file_proto_alert_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserColorPreference) String() string {
+func (x *AlertActivity) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserColorPreference) ProtoMessage() {}
+func (*AlertActivity) ProtoMessage() {}
 
-func (x *UserColorPreference) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[26]
+func (x *AlertActivity) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1692,109 +2277,162 @@ func (x *UserColorPreference) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserColorPreference.ProtoReflect.Descriptor instead.
-func (*UserColorPreference) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use AlertActivity.ProtoReflect.Descriptor instead.
+func (*AlertActivity) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *UserColorPreference) GetId() string {
+func (x *AlertActivity) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *UserColorPreference) GetUserId() string {
+func (x *AlertActivity) GetAlertKey() string {
+	if x != nil {
+		return x.AlertKey
+	}
+	return ""
+}
+
+func (x *AlertActivity) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *AlertActivity) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *UserColorPreference) GetLabelConditions() map[string]string {
+func (x *AlertActivity) GetUsername() string {
 	if x != nil {
-		return x.LabelConditions
+		return x.Username
 	}
-	return nil
+	return ""
 }
 
-func (x *UserColorPreference) GetColor() string {
+func (x *AlertActivity) GetContent() string {
 	if x != nil {
-		return x.Color
+		return x.Content
 	}
 	return ""
 }
 
-func (x *UserColorPreference) GetColorType() string {
+func (x *AlertActivity) GetTarget() string {
 	if x != nil {
-		return x.ColorType
+		return x.Target
 	}
 	return ""
 }
 
-func (x *UserColorPreference) GetPriority() int32 {
+func (x *AlertActivity) GetRefId() string {
 	if x != nil {
-		return x.Priority
+		return x.RefId
 	}
-	return 0
+	return ""
 }
 
-func (x *UserColorPreference) GetCreatedAt() *timestamppb.Timestamp {
+func (x *AlertActivity) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.CreatedAt
 	}
 	return nil
 }
 
-func (x *UserColorPreference) GetUpdatedAt() *timestamppb.Timestamp {
+// Real-time Updates Messages
+type SubscribeToAlertUpdatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	AlertKey      string                 `protobuf:"bytes,2,opt,name=alert_key,json=alertKey,proto3" json:"alert_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeToAlertUpdatesRequest) Reset() {
+	*x = SubscribeToAlertUpdatesRequest{}
+	mi := &file_proto_alert_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeToAlertUpdatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeToAlertUpdatesRequest) ProtoMessage() {}
+
+func (x *SubscribeToAlertUpdatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[35]
 	if x != nil {
-		return x.UpdatedAt
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *UserColorPreference) GetBgLightnessFactor() float32 {
+// Deprecated: Use SubscribeToAlertUpdatesRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeToAlertUpdatesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *SubscribeToAlertUpdatesRequest) GetSessionId() string {
 	if x != nil {
-		return x.BgLightnessFactor
+		return x.SessionId
 	}
-	return 0
+	return ""
 }
 
-func (x *UserColorPreference) GetTextDarknessFactor() float32 {
+func (x *SubscribeToAlertUpdatesRequest) GetAlertKey() string {
 	if x != nil {
-		return x.TextDarknessFactor
+		return x.AlertKey
 	}
-	return 0
+	return ""
 }
 
-// Resolved Alert Messages
-type CreateResolvedAlertRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Fingerprint     string                 `protobuf:"bytes,1,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
-	Source          string                 `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
-	AlertData       []byte                 `protobuf:"bytes,3,opt,name=alert_data,json=alertData,proto3" json:"alert_data,omitempty"` // JSON serialized DashboardAlert
-	Comments        []byte                 `protobuf:"bytes,4,opt,name=comments,proto3" json:"comments,omitempty"`                    // JSON serialized comments array
-	Acknowledgments []byte                 `protobuf:"bytes,5,opt,name=acknowledgments,proto3" json:"acknowledgments,omitempty"`      // JSON serialized acknowledgments array
-	TtlHours        int32                  `protobuf:"varint,6,opt,name=ttl_hours,json=ttlHours,proto3" json:"ttl_hours,omitempty"`   // TTL in hours (default 24)
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type AlertUpdate struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	AlertKey   string                 `protobuf:"bytes,1,opt,name=alert_key,json=alertKey,proto3" json:"alert_key,omitempty"`
+	UpdateType UpdateType             `protobuf:"varint,2,opt,name=update_type,json=updateType,proto3,enum=notificator.alert.UpdateType" json:"update_type,omitempty"`
+	// Types that are valid to be assigned to UpdateData:
+	//
+	//	*AlertUpdate_Comment
+	//	*AlertUpdate_Acknowledgment
+	//	*AlertUpdate_DeletedCommentId
+	//	*AlertUpdate_DeletedAcknowledgmentId
+	//	*AlertUpdate_Escalation
+	//	*AlertUpdate_Mention
+	//	*AlertUpdate_Presence
+	UpdateData          isAlertUpdate_UpdateData `protobuf_oneof:"update_data"`
+	Timestamp           *timestamppb.Timestamp   `protobuf:"bytes,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	HeartbeatIntervalMs int64                    `protobuf:"varint,11,opt,name=heartbeat_interval_ms,json=heartbeatIntervalMs,proto3" json:"heartbeat_interval_ms,omitempty"` // Set on the connection confirmation: how often the stream sends a HEARTBEAT
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
-func (x *CreateResolvedAlertRequest) Reset() {
-	*x = CreateResolvedAlertRequest{}
-	mi := &file_proto_alert_proto_msgTypes[27]
+func (x *AlertUpdate) Reset() {
+	*x = AlertUpdate{}
+	mi := &file_proto_alert_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateResolvedAlertRequest) String() string {
+func (x *AlertUpdate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateResolvedAlertRequest) ProtoMessage() {}
+func (*AlertUpdate) ProtoMessage() {}
 
-func (x *CreateResolvedAlertRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[27]
+func (x *AlertUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1805,136 +2443,179 @@ func (x *CreateResolvedAlertRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateResolvedAlertRequest.ProtoReflect.Descriptor instead.
-func (*CreateResolvedAlertRequest) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use AlertUpdate.ProtoReflect.Descriptor instead.
+func (*AlertUpdate) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *CreateResolvedAlertRequest) GetFingerprint() string {
+func (x *AlertUpdate) GetAlertKey() string {
 	if x != nil {
-		return x.Fingerprint
+		return x.AlertKey
 	}
 	return ""
 }
 
-func (x *CreateResolvedAlertRequest) GetSource() string {
+func (x *AlertUpdate) GetUpdateType() UpdateType {
 	if x != nil {
-		return x.Source
+		return x.UpdateType
 	}
-	return ""
+	return UpdateType_UNKNOWN_UPDATE
 }
 
-func (x *CreateResolvedAlertRequest) GetAlertData() []byte {
+func (x *AlertUpdate) GetUpdateData() isAlertUpdate_UpdateData {
 	if x != nil {
-		return x.AlertData
+		return x.UpdateData
 	}
 	return nil
 }
 
-func (x *CreateResolvedAlertRequest) GetComments() []byte {
+func (x *AlertUpdate) GetComment() *Comment {
 	if x != nil {
-		return x.Comments
+		if x, ok := x.UpdateData.(*AlertUpdate_Comment); ok {
+			return x.Comment
+		}
 	}
 	return nil
 }
 
-func (x *CreateResolvedAlertRequest) GetAcknowledgments() []byte {
+func (x *AlertUpdate) GetAcknowledgment() *Acknowledgment {
 	if x != nil {
-		return x.Acknowledgments
+		if x, ok := x.UpdateData.(*AlertUpdate_Acknowledgment); ok {
+			return x.Acknowledgment
+		}
 	}
 	return nil
 }
 
-func (x *CreateResolvedAlertRequest) GetTtlHours() int32 {
+func (x *AlertUpdate) GetDeletedCommentId() string {
 	if x != nil {
-		return x.TtlHours
+		if x, ok := x.UpdateData.(*AlertUpdate_DeletedCommentId); ok {
+			return x.DeletedCommentId
+		}
 	}
-	return 0
-}
-
-type CreateResolvedAlertResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	ResolvedAlert *ResolvedAlertInfo     `protobuf:"bytes,2,opt,name=resolved_alert,json=resolvedAlert,proto3" json:"resolved_alert,omitempty"`
-	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *CreateResolvedAlertResponse) Reset() {
-	*x = CreateResolvedAlertResponse{}
-	mi := &file_proto_alert_proto_msgTypes[28]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+	return ""
 }
 
-func (x *CreateResolvedAlertResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *AlertUpdate) GetDeletedAcknowledgmentId() string {
+	if x != nil {
+		if x, ok := x.UpdateData.(*AlertUpdate_DeletedAcknowledgmentId); ok {
+			return x.DeletedAcknowledgmentId
+		}
+	}
+	return ""
 }
 
-func (*CreateResolvedAlertResponse) ProtoMessage() {}
-
-func (x *CreateResolvedAlertResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[28]
+func (x *AlertUpdate) GetEscalation() *Escalation {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
+		if x, ok := x.UpdateData.(*AlertUpdate_Escalation); ok {
+			return x.Escalation
 		}
-		return ms
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use CreateResolvedAlertResponse.ProtoReflect.Descriptor instead.
-func (*CreateResolvedAlertResponse) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{28}
+func (x *AlertUpdate) GetMention() *Mention {
+	if x != nil {
+		if x, ok := x.UpdateData.(*AlertUpdate_Mention); ok {
+			return x.Mention
+		}
+	}
+	return nil
 }
 
-func (x *CreateResolvedAlertResponse) GetSuccess() bool {
+func (x *AlertUpdate) GetPresence() *Presence {
 	if x != nil {
-		return x.Success
+		if x, ok := x.UpdateData.(*AlertUpdate_Presence); ok {
+			return x.Presence
+		}
 	}
-	return false
+	return nil
 }
 
-func (x *CreateResolvedAlertResponse) GetResolvedAlert() *ResolvedAlertInfo {
+func (x *AlertUpdate) GetTimestamp() *timestamppb.Timestamp {
 	if x != nil {
-		return x.ResolvedAlert
+		return x.Timestamp
 	}
 	return nil
 }
 
-func (x *CreateResolvedAlertResponse) GetMessage() string {
+func (x *AlertUpdate) GetHeartbeatIntervalMs() int64 {
 	if x != nil {
-		return x.Message
+		return x.HeartbeatIntervalMs
 	}
-	return ""
+	return 0
 }
 
-type GetResolvedAlertsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset        int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type isAlertUpdate_UpdateData interface {
+	isAlertUpdate_UpdateData()
 }
 
-func (x *GetResolvedAlertsRequest) Reset() {
-	*x = GetResolvedAlertsRequest{}
-	mi := &file_proto_alert_proto_msgTypes[29]
+type AlertUpdate_Comment struct {
+	Comment *Comment `protobuf:"bytes,3,opt,name=comment,proto3,oneof"`
+}
+
+type AlertUpdate_Acknowledgment struct {
+	Acknowledgment *Acknowledgment `protobuf:"bytes,4,opt,name=acknowledgment,proto3,oneof"`
+}
+
+type AlertUpdate_DeletedCommentId struct {
+	DeletedCommentId string `protobuf:"bytes,5,opt,name=deleted_comment_id,json=deletedCommentId,proto3,oneof"`
+}
+
+type AlertUpdate_DeletedAcknowledgmentId struct {
+	DeletedAcknowledgmentId string `protobuf:"bytes,6,opt,name=deleted_acknowledgment_id,json=deletedAcknowledgmentId,proto3,oneof"`
+}
+
+type AlertUpdate_Escalation struct {
+	Escalation *Escalation `protobuf:"bytes,8,opt,name=escalation,proto3,oneof"`
+}
+
+type AlertUpdate_Mention struct {
+	Mention *Mention `protobuf:"bytes,9,opt,name=mention,proto3,oneof"`
+}
+
+type AlertUpdate_Presence struct {
+	Presence *Presence `protobuf:"bytes,10,opt,name=presence,proto3,oneof"`
+}
+
+func (*AlertUpdate_Comment) isAlertUpdate_UpdateData() {}
+
+func (*AlertUpdate_Acknowledgment) isAlertUpdate_UpdateData() {}
+
+func (*AlertUpdate_DeletedCommentId) isAlertUpdate_UpdateData() {}
+
+func (*AlertUpdate_DeletedAcknowledgmentId) isAlertUpdate_UpdateData() {}
+
+func (*AlertUpdate_Escalation) isAlertUpdate_UpdateData() {}
+
+func (*AlertUpdate_Mention) isAlertUpdate_UpdateData() {}
+
+func (*AlertUpdate_Presence) isAlertUpdate_UpdateData() {}
+
+// User Color Preferences Messages
+type GetUserColorPreferencesRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	SessionId         string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	ImpersonateUserId string                 `protobuf:"bytes,2,opt,name=impersonate_user_id,json=impersonateUserId,proto3" json:"impersonate_user_id,omitempty"` // Optional: load preferences for this user instead
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GetUserColorPreferencesRequest) Reset() {
+	*x = GetUserColorPreferencesRequest{}
+	mi := &file_proto_alert_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetResolvedAlertsRequest) String() string {
+func (x *GetUserColorPreferencesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetResolvedAlertsRequest) ProtoMessage() {}
+func (*GetUserColorPreferencesRequest) ProtoMessage() {}
 
-func (x *GetResolvedAlertsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[29]
+func (x *GetUserColorPreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1945,50 +2626,49 @@ func (x *GetResolvedAlertsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetResolvedAlertsRequest.ProtoReflect.Descriptor instead.
-func (*GetResolvedAlertsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use GetUserColorPreferencesRequest.ProtoReflect.Descriptor instead.
+func (*GetUserColorPreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *GetResolvedAlertsRequest) GetLimit() int32 {
+func (x *GetUserColorPreferencesRequest) GetSessionId() string {
 	if x != nil {
-		return x.Limit
+		return x.SessionId
 	}
-	return 0
+	return ""
 }
 
-func (x *GetResolvedAlertsRequest) GetOffset() int32 {
+func (x *GetUserColorPreferencesRequest) GetImpersonateUserId() string {
 	if x != nil {
-		return x.Offset
+		return x.ImpersonateUserId
 	}
-	return 0
+	return ""
 }
 
-type GetResolvedAlertsResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	ResolvedAlerts []*ResolvedAlertInfo   `protobuf:"bytes,1,rep,name=resolved_alerts,json=resolvedAlerts,proto3" json:"resolved_alerts,omitempty"`
-	TotalCount     int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
-	Success        bool                   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
-	Message        string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type GetUserColorPreferencesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Preferences   []*UserColorPreference `protobuf:"bytes,1,rep,name=preferences,proto3" json:"preferences,omitempty"`
+	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetResolvedAlertsResponse) Reset() {
-	*x = GetResolvedAlertsResponse{}
-	mi := &file_proto_alert_proto_msgTypes[30]
+func (x *GetUserColorPreferencesResponse) Reset() {
+	*x = GetUserColorPreferencesResponse{}
+	mi := &file_proto_alert_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetResolvedAlertsResponse) String() string {
+func (x *GetUserColorPreferencesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetResolvedAlertsResponse) ProtoMessage() {}
+func (*GetUserColorPreferencesResponse) ProtoMessage() {}
 
-func (x *GetResolvedAlertsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[30]
+func (x *GetUserColorPreferencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1999,61 +2679,56 @@ func (x *GetResolvedAlertsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetResolvedAlertsResponse.ProtoReflect.Descriptor instead.
-func (*GetResolvedAlertsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use GetUserColorPreferencesResponse.ProtoReflect.Descriptor instead.
+func (*GetUserColorPreferencesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *GetResolvedAlertsResponse) GetResolvedAlerts() []*ResolvedAlertInfo {
+func (x *GetUserColorPreferencesResponse) GetPreferences() []*UserColorPreference {
 	if x != nil {
-		return x.ResolvedAlerts
+		return x.Preferences
 	}
 	return nil
 }
 
-func (x *GetResolvedAlertsResponse) GetTotalCount() int32 {
-	if x != nil {
-		return x.TotalCount
-	}
-	return 0
-}
-
-func (x *GetResolvedAlertsResponse) GetSuccess() bool {
+func (x *GetUserColorPreferencesResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *GetResolvedAlertsResponse) GetMessage() string {
+func (x *GetUserColorPreferencesResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-type GetResolvedAlertRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Fingerprint   string                 `protobuf:"bytes,1,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type SaveUserColorPreferencesRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	SessionId         string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Preferences       []*UserColorPreference `protobuf:"bytes,2,rep,name=preferences,proto3" json:"preferences,omitempty"`
+	ImpersonateUserId string                 `protobuf:"bytes,3,opt,name=impersonate_user_id,json=impersonateUserId,proto3" json:"impersonate_user_id,omitempty"` // Optional: save preferences for this user instead
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *GetResolvedAlertRequest) Reset() {
-	*x = GetResolvedAlertRequest{}
-	mi := &file_proto_alert_proto_msgTypes[31]
+func (x *SaveUserColorPreferencesRequest) Reset() {
+	*x = SaveUserColorPreferencesRequest{}
+	mi := &file_proto_alert_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetResolvedAlertRequest) String() string {
+func (x *SaveUserColorPreferencesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetResolvedAlertRequest) ProtoMessage() {}
+func (*SaveUserColorPreferencesRequest) ProtoMessage() {}
 
-func (x *GetResolvedAlertRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[31]
+func (x *SaveUserColorPreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2064,42 +2739,55 @@ func (x *GetResolvedAlertRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetResolvedAlertRequest.ProtoReflect.Descriptor instead.
-func (*GetResolvedAlertRequest) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use SaveUserColorPreferencesRequest.ProtoReflect.Descriptor instead.
+func (*SaveUserColorPreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *GetResolvedAlertRequest) GetFingerprint() string {
+func (x *SaveUserColorPreferencesRequest) GetSessionId() string {
 	if x != nil {
-		return x.Fingerprint
+		return x.SessionId
 	}
 	return ""
 }
 
-type GetResolvedAlertResponse struct {
+func (x *SaveUserColorPreferencesRequest) GetPreferences() []*UserColorPreference {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+func (x *SaveUserColorPreferencesRequest) GetImpersonateUserId() string {
+	if x != nil {
+		return x.ImpersonateUserId
+	}
+	return ""
+}
+
+type SaveUserColorPreferencesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	ResolvedAlert *ResolvedAlertInfo     `protobuf:"bytes,2,opt,name=resolved_alert,json=resolvedAlert,proto3" json:"resolved_alert,omitempty"`
-	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetResolvedAlertResponse) Reset() {
-	*x = GetResolvedAlertResponse{}
-	mi := &file_proto_alert_proto_msgTypes[32]
+func (x *SaveUserColorPreferencesResponse) Reset() {
+	*x = SaveUserColorPreferencesResponse{}
+	mi := &file_proto_alert_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetResolvedAlertResponse) String() string {
+func (x *SaveUserColorPreferencesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetResolvedAlertResponse) ProtoMessage() {}
+func (*SaveUserColorPreferencesResponse) ProtoMessage() {}
 
-func (x *GetResolvedAlertResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[32]
+func (x *SaveUserColorPreferencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2110,54 +2798,49 @@ func (x *GetResolvedAlertResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetResolvedAlertResponse.ProtoReflect.Descriptor instead.
-func (*GetResolvedAlertResponse) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use SaveUserColorPreferencesResponse.ProtoReflect.Descriptor instead.
+func (*SaveUserColorPreferencesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *GetResolvedAlertResponse) GetSuccess() bool {
+func (x *SaveUserColorPreferencesResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *GetResolvedAlertResponse) GetResolvedAlert() *ResolvedAlertInfo {
-	if x != nil {
-		return x.ResolvedAlert
-	}
-	return nil
-}
-
-func (x *GetResolvedAlertResponse) GetMessage() string {
+func (x *SaveUserColorPreferencesResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-type RemoveAllResolvedAlertsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type DeleteUserColorPreferenceRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	SessionId         string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	PreferenceId      string                 `protobuf:"bytes,2,opt,name=preference_id,json=preferenceId,proto3" json:"preference_id,omitempty"`
+	ImpersonateUserId string                 `protobuf:"bytes,3,opt,name=impersonate_user_id,json=impersonateUserId,proto3" json:"impersonate_user_id,omitempty"` // Optional: delete preference for this user instead
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *RemoveAllResolvedAlertsRequest) Reset() {
-	*x = RemoveAllResolvedAlertsRequest{}
-	mi := &file_proto_alert_proto_msgTypes[33]
+func (x *DeleteUserColorPreferenceRequest) Reset() {
+	*x = DeleteUserColorPreferenceRequest{}
+	mi := &file_proto_alert_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveAllResolvedAlertsRequest) String() string {
+func (x *DeleteUserColorPreferenceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveAllResolvedAlertsRequest) ProtoMessage() {}
+func (*DeleteUserColorPreferenceRequest) ProtoMessage() {}
 
-func (x *RemoveAllResolvedAlertsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[33]
+func (x *DeleteUserColorPreferenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2168,42 +2851,55 @@ func (x *RemoveAllResolvedAlertsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveAllResolvedAlertsRequest.ProtoReflect.Descriptor instead.
-func (*RemoveAllResolvedAlertsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use DeleteUserColorPreferenceRequest.ProtoReflect.Descriptor instead.
+func (*DeleteUserColorPreferenceRequest) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *RemoveAllResolvedAlertsRequest) GetSessionId() string {
+func (x *DeleteUserColorPreferenceRequest) GetSessionId() string {
 	if x != nil {
 		return x.SessionId
 	}
 	return ""
 }
 
-type RemoveAllResolvedAlertsResponse struct {
+func (x *DeleteUserColorPreferenceRequest) GetPreferenceId() string {
+	if x != nil {
+		return x.PreferenceId
+	}
+	return ""
+}
+
+func (x *DeleteUserColorPreferenceRequest) GetImpersonateUserId() string {
+	if x != nil {
+		return x.ImpersonateUserId
+	}
+	return ""
+}
+
+type DeleteUserColorPreferenceResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	RemovedCount  int32                  `protobuf:"varint,2,opt,name=removed_count,json=removedCount,proto3" json:"removed_count,omitempty"`
-	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RemoveAllResolvedAlertsResponse) Reset() {
-	*x = RemoveAllResolvedAlertsResponse{}
-	mi := &file_proto_alert_proto_msgTypes[34]
+func (x *DeleteUserColorPreferenceResponse) Reset() {
+	*x = DeleteUserColorPreferenceResponse{}
+	mi := &file_proto_alert_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveAllResolvedAlertsResponse) String() string {
+func (x *DeleteUserColorPreferenceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveAllResolvedAlertsResponse) ProtoMessage() {}
+func (*DeleteUserColorPreferenceResponse) ProtoMessage() {}
 
-func (x *RemoveAllResolvedAlertsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[34]
+func (x *DeleteUserColorPreferenceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2214,54 +2910,56 @@ func (x *RemoveAllResolvedAlertsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveAllResolvedAlertsResponse.ProtoReflect.Descriptor instead.
-func (*RemoveAllResolvedAlertsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use DeleteUserColorPreferenceResponse.ProtoReflect.Descriptor instead.
+func (*DeleteUserColorPreferenceResponse) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *RemoveAllResolvedAlertsResponse) GetSuccess() bool {
+func (x *DeleteUserColorPreferenceResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *RemoveAllResolvedAlertsResponse) GetRemovedCount() int32 {
-	if x != nil {
-		return x.RemovedCount
-	}
-	return 0
-}
-
-func (x *RemoveAllResolvedAlertsResponse) GetMessage() string {
+func (x *DeleteUserColorPreferenceResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-type StreamResolvedAlertUpdatesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type UserColorPreference struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                                                                                                            // Unique ID for the preference
+	UserId             string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                                                                                                      // User who owns this preference
+	LabelConditions    map[string]string      `protobuf:"bytes,3,rep,name=label_conditions,json=labelConditions,proto3" json:"label_conditions,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Key-value pairs: {Team: "team-infrastructure", Severity: "Warning"}
+	Color              string                 `protobuf:"bytes,4,opt,name=color,proto3" json:"color,omitempty"`                                                                                                                      // Color value (e.g., "GRAY", "#FF5733", "red-500")
+	ColorType          string                 `protobuf:"bytes,5,opt,name=color_type,json=colorType,proto3" json:"color_type,omitempty"`                                                                                             // Type: "severity", "custom", "tailwind"
+	Priority           int32                  `protobuf:"varint,6,opt,name=priority,proto3" json:"priority,omitempty"`                                                                                                               // Higher numbers = higher priority (for conflicts)
+	CreatedAt          *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt          *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	BgLightnessFactor  float32                `protobuf:"fixed32,9,opt,name=bg_lightness_factor,json=bgLightnessFactor,proto3" json:"bg_lightness_factor,omitempty"`     // Background lightness adjustment factor
+	TextDarknessFactor float32                `protobuf:"fixed32,10,opt,name=text_darkness_factor,json=textDarknessFactor,proto3" json:"text_darkness_factor,omitempty"` // Text darkness adjustment factor
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
-func (x *StreamResolvedAlertUpdatesRequest) Reset() {
-	*x = StreamResolvedAlertUpdatesRequest{}
-	mi := &file_proto_alert_proto_msgTypes[35]
+func (x *UserColorPreference) Reset() {
+	*x = UserColorPreference{}
+	mi := &file_proto_alert_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StreamResolvedAlertUpdatesRequest) String() string {
+func (x *UserColorPreference) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamResolvedAlertUpdatesRequest) ProtoMessage() {}
+func (*UserColorPreference) ProtoMessage() {}
 
-func (x *StreamResolvedAlertUpdatesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[35]
+func (x *UserColorPreference) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2272,117 +2970,110 @@ func (x *StreamResolvedAlertUpdatesRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamResolvedAlertUpdatesRequest.ProtoReflect.Descriptor instead.
-func (*StreamResolvedAlertUpdatesRequest) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use UserColorPreference.ProtoReflect.Descriptor instead.
+func (*UserColorPreference) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *StreamResolvedAlertUpdatesRequest) GetSessionId() string {
+func (x *UserColorPreference) GetId() string {
 	if x != nil {
-		return x.SessionId
+		return x.Id
 	}
 	return ""
 }
 
-type ResolvedAlertUpdate struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	Fingerprint   string                  `protobuf:"bytes,1,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
-	UpdateType    ResolvedAlertUpdateType `protobuf:"varint,2,opt,name=update_type,json=updateType,proto3,enum=notificator.alert.ResolvedAlertUpdateType" json:"update_type,omitempty"`
-	ResolvedAlert *ResolvedAlertInfo      `protobuf:"bytes,3,opt,name=resolved_alert,json=resolvedAlert,proto3" json:"resolved_alert,omitempty"`
-	Timestamp     *timestamppb.Timestamp  `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *UserColorPreference) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
 }
 
-func (x *ResolvedAlertUpdate) Reset() {
-	*x = ResolvedAlertUpdate{}
-	mi := &file_proto_alert_proto_msgTypes[36]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *UserColorPreference) GetLabelConditions() map[string]string {
+	if x != nil {
+		return x.LabelConditions
+	}
+	return nil
 }
 
-func (x *ResolvedAlertUpdate) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *UserColorPreference) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
 }
 
-func (*ResolvedAlertUpdate) ProtoMessage() {}
-
-func (x *ResolvedAlertUpdate) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[36]
+func (x *UserColorPreference) GetColorType() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.ColorType
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use ResolvedAlertUpdate.ProtoReflect.Descriptor instead.
-func (*ResolvedAlertUpdate) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{36}
+func (x *UserColorPreference) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
 }
 
-func (x *ResolvedAlertUpdate) GetFingerprint() string {
+func (x *UserColorPreference) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Fingerprint
+		return x.CreatedAt
 	}
-	return ""
+	return nil
 }
 
-func (x *ResolvedAlertUpdate) GetUpdateType() ResolvedAlertUpdateType {
+func (x *UserColorPreference) GetUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.UpdateType
+		return x.UpdatedAt
 	}
-	return ResolvedAlertUpdateType_UNKNOWN_RESOLVED_UPDATE
+	return nil
 }
 
-func (x *ResolvedAlertUpdate) GetResolvedAlert() *ResolvedAlertInfo {
+func (x *UserColorPreference) GetBgLightnessFactor() float32 {
 	if x != nil {
-		return x.ResolvedAlert
+		return x.BgLightnessFactor
 	}
-	return nil
+	return 0
 }
 
-func (x *ResolvedAlertUpdate) GetTimestamp() *timestamppb.Timestamp {
+func (x *UserColorPreference) GetTextDarknessFactor() float32 {
 	if x != nil {
-		return x.Timestamp
+		return x.TextDarknessFactor
 	}
-	return nil
+	return 0
 }
 
-type ResolvedAlertInfo struct {
+// Resolved Alert Messages
+type CreateResolvedAlertRequest struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
-	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Fingerprint     string                 `protobuf:"bytes,2,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
+	Fingerprint     string                 `protobuf:"bytes,1,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
+	Source          string                 `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
 	AlertData       []byte                 `protobuf:"bytes,3,opt,name=alert_data,json=alertData,proto3" json:"alert_data,omitempty"` // JSON serialized DashboardAlert
 	Comments        []byte                 `protobuf:"bytes,4,opt,name=comments,proto3" json:"comments,omitempty"`                    // JSON serialized comments array
 	Acknowledgments []byte                 `protobuf:"bytes,5,opt,name=acknowledgments,proto3" json:"acknowledgments,omitempty"`      // JSON serialized acknowledgments array
-	ResolvedAt      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=resolved_at,json=resolvedAt,proto3" json:"resolved_at,omitempty"`
-	ExpiresAt       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
-	Source          string                 `protobuf:"bytes,8,opt,name=source,proto3" json:"source,omitempty"`
-	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	TtlHours        int32                  `protobuf:"varint,6,opt,name=ttl_hours,json=ttlHours,proto3" json:"ttl_hours,omitempty"`   // TTL in hours (default 24)
+	StartsAt        *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`    // When the alert started firing; when set, a second capture of the same firing is ignored
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
 
-func (x *ResolvedAlertInfo) Reset() {
-	*x = ResolvedAlertInfo{}
-	mi := &file_proto_alert_proto_msgTypes[37]
+func (x *CreateResolvedAlertRequest) Reset() {
+	*x = CreateResolvedAlertRequest{}
+	mi := &file_proto_alert_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ResolvedAlertInfo) String() string {
+func (x *CreateResolvedAlertRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResolvedAlertInfo) ProtoMessage() {}
+func (*CreateResolvedAlertRequest) ProtoMessage() {}
 
-func (x *ResolvedAlertInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[37]
+func (x *CreateResolvedAlertRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2393,105 +3084,85 @@ func (x *ResolvedAlertInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResolvedAlertInfo.ProtoReflect.Descriptor instead.
-func (*ResolvedAlertInfo) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{37}
-}
-
-func (x *ResolvedAlertInfo) GetId() string {
+// Deprecated: Use CreateResolvedAlertRequest.ProtoReflect.Descriptor instead.
+func (*CreateResolvedAlertRequest) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *CreateResolvedAlertRequest) GetFingerprint() string {
 	if x != nil {
-		return x.Id
+		return x.Fingerprint
 	}
 	return ""
 }
 
-func (x *ResolvedAlertInfo) GetFingerprint() string {
+func (x *CreateResolvedAlertRequest) GetSource() string {
 	if x != nil {
-		return x.Fingerprint
+		return x.Source
 	}
 	return ""
 }
 
-func (x *ResolvedAlertInfo) GetAlertData() []byte {
+func (x *CreateResolvedAlertRequest) GetAlertData() []byte {
 	if x != nil {
 		return x.AlertData
 	}
 	return nil
 }
 
-func (x *ResolvedAlertInfo) GetComments() []byte {
+func (x *CreateResolvedAlertRequest) GetComments() []byte {
 	if x != nil {
 		return x.Comments
 	}
 	return nil
 }
 
-func (x *ResolvedAlertInfo) GetAcknowledgments() []byte {
+func (x *CreateResolvedAlertRequest) GetAcknowledgments() []byte {
 	if x != nil {
 		return x.Acknowledgments
 	}
 	return nil
 }
 
-func (x *ResolvedAlertInfo) GetResolvedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.ResolvedAt
-	}
-	return nil
-}
-
-func (x *ResolvedAlertInfo) GetExpiresAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.ExpiresAt
-	}
-	return nil
-}
-
-func (x *ResolvedAlertInfo) GetSource() string {
-	if x != nil {
-		return x.Source
-	}
-	return ""
-}
-
-func (x *ResolvedAlertInfo) GetCreatedAt() *timestamppb.Timestamp {
+func (x *CreateResolvedAlertRequest) GetTtlHours() int32 {
 	if x != nil {
-		return x.CreatedAt
+		return x.TtlHours
 	}
-	return nil
+	return 0
 }
 
-func (x *ResolvedAlertInfo) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *CreateResolvedAlertRequest) GetStartsAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.UpdatedAt
+		return x.StartsAt
 	}
 	return nil
 }
 
-// User Hidden Alerts Messages
-type GetUserHiddenAlertsRequest struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	SessionId         string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	ImpersonateUserId string                 `protobuf:"bytes,2,opt,name=impersonate_user_id,json=impersonateUserId,proto3" json:"impersonate_user_id,omitempty"` // Optional: load hidden alerts for this user instead
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+type CreateResolvedAlertResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Success         bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	ResolvedAlert   *ResolvedAlertInfo     `protobuf:"bytes,2,opt,name=resolved_alert,json=resolvedAlert,proto3" json:"resolved_alert,omitempty"`
+	Message         string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	AlreadyCaptured bool                   `protobuf:"varint,4,opt,name=already_captured,json=alreadyCaptured,proto3" json:"already_captured,omitempty"` // resolved_alert is the existing capture of this firing
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *GetUserHiddenAlertsRequest) Reset() {
-	*x = GetUserHiddenAlertsRequest{}
-	mi := &file_proto_alert_proto_msgTypes[38]
+func (x *CreateResolvedAlertResponse) Reset() {
+	*x = CreateResolvedAlertResponse{}
+	mi := &file_proto_alert_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserHiddenAlertsRequest) String() string {
+func (x *CreateResolvedAlertResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserHiddenAlertsRequest) ProtoMessage() {}
+func (*CreateResolvedAlertResponse) ProtoMessage() {}
 
-func (x *GetUserHiddenAlertsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[38]
+func (x *CreateResolvedAlertResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2502,112 +3173,68 @@ func (x *GetUserHiddenAlertsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserHiddenAlertsRequest.ProtoReflect.Descriptor instead.
-func (*GetUserHiddenAlertsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{38}
-}
-
-func (x *GetUserHiddenAlertsRequest) GetSessionId() string {
-	if x != nil {
-		return x.SessionId
-	}
-	return ""
-}
-
-func (x *GetUserHiddenAlertsRequest) GetImpersonateUserId() string {
-	if x != nil {
-		return x.ImpersonateUserId
-	}
-	return ""
-}
-
-type GetUserHiddenAlertsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	HiddenAlerts  []*UserHiddenAlert     `protobuf:"bytes,1,rep,name=hidden_alerts,json=hiddenAlerts,proto3" json:"hidden_alerts,omitempty"`
-	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetUserHiddenAlertsResponse) Reset() {
-	*x = GetUserHiddenAlertsResponse{}
-	mi := &file_proto_alert_proto_msgTypes[39]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetUserHiddenAlertsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use CreateResolvedAlertResponse.ProtoReflect.Descriptor instead.
+func (*CreateResolvedAlertResponse) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{45}
 }
 
-func (*GetUserHiddenAlertsResponse) ProtoMessage() {}
-
-func (x *GetUserHiddenAlertsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[39]
+func (x *CreateResolvedAlertResponse) GetSuccess() bool {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Success
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetUserHiddenAlertsResponse.ProtoReflect.Descriptor instead.
-func (*GetUserHiddenAlertsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{39}
+	return false
 }
 
-func (x *GetUserHiddenAlertsResponse) GetHiddenAlerts() []*UserHiddenAlert {
+func (x *CreateResolvedAlertResponse) GetResolvedAlert() *ResolvedAlertInfo {
 	if x != nil {
-		return x.HiddenAlerts
+		return x.ResolvedAlert
 	}
 	return nil
 }
 
-func (x *GetUserHiddenAlertsResponse) GetSuccess() bool {
+func (x *CreateResolvedAlertResponse) GetMessage() string {
 	if x != nil {
-		return x.Success
+		return x.Message
 	}
-	return false
+	return ""
 }
 
-func (x *GetUserHiddenAlertsResponse) GetMessage() string {
+func (x *CreateResolvedAlertResponse) GetAlreadyCaptured() bool {
 	if x != nil {
-		return x.Message
+		return x.AlreadyCaptured
 	}
-	return ""
+	return false
 }
 
-type HideAlertRequest struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	SessionId         string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	Fingerprint       string                 `protobuf:"bytes,2,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
-	AlertName         string                 `protobuf:"bytes,3,opt,name=alert_name,json=alertName,proto3" json:"alert_name,omitempty"`
-	Instance          string                 `protobuf:"bytes,4,opt,name=instance,proto3" json:"instance,omitempty"`
-	Reason            string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
-	ImpersonateUserId string                 `protobuf:"bytes,6,opt,name=impersonate_user_id,json=impersonateUserId,proto3" json:"impersonate_user_id,omitempty"` // Optional: hide for this user instead
+type GetResolvedAlertsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Limit  int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	// Optional filters; total_count in the response counts every match
+	Source            string                 `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"` // Exact source (Alertmanager) name
+	FingerprintPrefix string                 `protobuf:"bytes,4,opt,name=fingerprint_prefix,json=fingerprintPrefix,proto3" json:"fingerprint_prefix,omitempty"`
+	ResolvedAfter     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=resolved_after,json=resolvedAfter,proto3" json:"resolved_after,omitempty"`    // Inclusive
+	ResolvedBefore    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=resolved_before,json=resolvedBefore,proto3" json:"resolved_before,omitempty"` // Exclusive
+	SortOrder         string                 `protobuf:"bytes,7,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`                // "desc" (default, newest first) or "asc"
 	unknownFields     protoimpl.UnknownFields
 	sizeCache         protoimpl.SizeCache
 }
 
-func (x *HideAlertRequest) Reset() {
-	*x = HideAlertRequest{}
-	mi := &file_proto_alert_proto_msgTypes[40]
+func (x *GetResolvedAlertsRequest) Reset() {
+	*x = GetResolvedAlertsRequest{}
+	mi := &file_proto_alert_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *HideAlertRequest) String() string {
+func (x *GetResolvedAlertsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HideAlertRequest) ProtoMessage() {}
+func (*GetResolvedAlertsRequest) ProtoMessage() {}
 
-func (x *HideAlertRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[40]
+func (x *GetResolvedAlertsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2618,77 +3245,85 @@ func (x *HideAlertRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HideAlertRequest.ProtoReflect.Descriptor instead.
-func (*HideAlertRequest) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use GetResolvedAlertsRequest.ProtoReflect.Descriptor instead.
+func (*GetResolvedAlertsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *HideAlertRequest) GetSessionId() string {
+func (x *GetResolvedAlertsRequest) GetLimit() int32 {
 	if x != nil {
-		return x.SessionId
+		return x.Limit
 	}
-	return ""
+	return 0
 }
 
-func (x *HideAlertRequest) GetFingerprint() string {
+func (x *GetResolvedAlertsRequest) GetOffset() int32 {
 	if x != nil {
-		return x.Fingerprint
+		return x.Offset
 	}
-	return ""
+	return 0
 }
 
-func (x *HideAlertRequest) GetAlertName() string {
+func (x *GetResolvedAlertsRequest) GetSource() string {
 	if x != nil {
-		return x.AlertName
+		return x.Source
 	}
 	return ""
 }
 
-func (x *HideAlertRequest) GetInstance() string {
+func (x *GetResolvedAlertsRequest) GetFingerprintPrefix() string {
 	if x != nil {
-		return x.Instance
+		return x.FingerprintPrefix
 	}
 	return ""
 }
 
-func (x *HideAlertRequest) GetReason() string {
+func (x *GetResolvedAlertsRequest) GetResolvedAfter() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Reason
+		return x.ResolvedAfter
 	}
-	return ""
+	return nil
 }
 
-func (x *HideAlertRequest) GetImpersonateUserId() string {
+func (x *GetResolvedAlertsRequest) GetResolvedBefore() *timestamppb.Timestamp {
 	if x != nil {
-		return x.ImpersonateUserId
+		return x.ResolvedBefore
+	}
+	return nil
+}
+
+func (x *GetResolvedAlertsRequest) GetSortOrder() string {
+	if x != nil {
+		return x.SortOrder
 	}
 	return ""
 }
 
-type HideAlertResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	HiddenAlert   *UserHiddenAlert       `protobuf:"bytes,2,opt,name=hidden_alert,json=hiddenAlert,proto3" json:"hidden_alert,omitempty"`
-	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type GetResolvedAlertsResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ResolvedAlerts []*ResolvedAlertInfo   `protobuf:"bytes,1,rep,name=resolved_alerts,json=resolvedAlerts,proto3" json:"resolved_alerts,omitempty"`
+	TotalCount     int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Success        bool                   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	Message        string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *HideAlertResponse) Reset() {
-	*x = HideAlertResponse{}
-	mi := &file_proto_alert_proto_msgTypes[41]
+func (x *GetResolvedAlertsResponse) Reset() {
+	*x = GetResolvedAlertsResponse{}
+	mi := &file_proto_alert_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *HideAlertResponse) String() string {
+func (x *GetResolvedAlertsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HideAlertResponse) ProtoMessage() {}
+func (*GetResolvedAlertsResponse) ProtoMessage() {}
 
-func (x *HideAlertResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[41]
+func (x *GetResolvedAlertsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2699,56 +3334,61 @@ func (x *HideAlertResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HideAlertResponse.ProtoReflect.Descriptor instead.
-func (*HideAlertResponse) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use GetResolvedAlertsResponse.ProtoReflect.Descriptor instead.
+func (*GetResolvedAlertsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *HideAlertResponse) GetSuccess() bool {
+func (x *GetResolvedAlertsResponse) GetResolvedAlerts() []*ResolvedAlertInfo {
 	if x != nil {
-		return x.Success
+		return x.ResolvedAlerts
 	}
-	return false
+	return nil
 }
 
-func (x *HideAlertResponse) GetHiddenAlert() *UserHiddenAlert {
+func (x *GetResolvedAlertsResponse) GetTotalCount() int32 {
 	if x != nil {
-		return x.HiddenAlert
+		return x.TotalCount
 	}
-	return nil
+	return 0
 }
 
-func (x *HideAlertResponse) GetMessage() string {
+func (x *GetResolvedAlertsResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Message
+		return x.Success
 	}
-	return ""
+	return false
 }
 
-type UnhideAlertRequest struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	SessionId         string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	Fingerprint       string                 `protobuf:"bytes,2,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
-	ImpersonateUserId string                 `protobuf:"bytes,3,opt,name=impersonate_user_id,json=impersonateUserId,proto3" json:"impersonate_user_id,omitempty"` // Optional: unhide for this user instead
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+func (x *GetResolvedAlertsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
 }
 
-func (x *UnhideAlertRequest) Reset() {
-	*x = UnhideAlertRequest{}
-	mi := &file_proto_alert_proto_msgTypes[42]
+type GetResolvedAlertRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Fingerprint   string                 `protobuf:"bytes,1,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetResolvedAlertRequest) Reset() {
+	*x = GetResolvedAlertRequest{}
+	mi := &file_proto_alert_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UnhideAlertRequest) String() string {
+func (x *GetResolvedAlertRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnhideAlertRequest) ProtoMessage() {}
+func (*GetResolvedAlertRequest) ProtoMessage() {}
 
-func (x *UnhideAlertRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[42]
+func (x *GetResolvedAlertRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2759,55 +3399,42 @@ func (x *UnhideAlertRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnhideAlertRequest.ProtoReflect.Descriptor instead.
-func (*UnhideAlertRequest) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{42}
-}
-
-func (x *UnhideAlertRequest) GetSessionId() string {
-	if x != nil {
-		return x.SessionId
-	}
-	return ""
+// Deprecated: Use GetResolvedAlertRequest.ProtoReflect.Descriptor instead.
+func (*GetResolvedAlertRequest) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *UnhideAlertRequest) GetFingerprint() string {
+func (x *GetResolvedAlertRequest) GetFingerprint() string {
 	if x != nil {
 		return x.Fingerprint
 	}
 	return ""
 }
 
-func (x *UnhideAlertRequest) GetImpersonateUserId() string {
-	if x != nil {
-		return x.ImpersonateUserId
-	}
-	return ""
-}
-
-type UnhideAlertResponse struct {
+type GetResolvedAlertResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	ResolvedAlert *ResolvedAlertInfo     `protobuf:"bytes,2,opt,name=resolved_alert,json=resolvedAlert,proto3" json:"resolved_alert,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UnhideAlertResponse) Reset() {
-	*x = UnhideAlertResponse{}
-	mi := &file_proto_alert_proto_msgTypes[43]
+func (x *GetResolvedAlertResponse) Reset() {
+	*x = GetResolvedAlertResponse{}
+	mi := &file_proto_alert_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UnhideAlertResponse) String() string {
+func (x *GetResolvedAlertResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnhideAlertResponse) ProtoMessage() {}
+func (*GetResolvedAlertResponse) ProtoMessage() {}
 
-func (x *UnhideAlertResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[43]
+func (x *GetResolvedAlertResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2818,48 +3445,57 @@ func (x *UnhideAlertResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnhideAlertResponse.ProtoReflect.Descriptor instead.
-func (*UnhideAlertResponse) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use GetResolvedAlertResponse.ProtoReflect.Descriptor instead.
+func (*GetResolvedAlertResponse) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *UnhideAlertResponse) GetSuccess() bool {
+func (x *GetResolvedAlertResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *UnhideAlertResponse) GetMessage() string {
+func (x *GetResolvedAlertResponse) GetResolvedAlert() *ResolvedAlertInfo {
+	if x != nil {
+		return x.ResolvedAlert
+	}
+	return nil
+}
+
+func (x *GetResolvedAlertResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-type ClearAllHiddenAlertsRequest struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	SessionId         string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	ImpersonateUserId string                 `protobuf:"bytes,2,opt,name=impersonate_user_id,json=impersonateUserId,proto3" json:"impersonate_user_id,omitempty"` // Optional: clear for this user instead
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+// Copies the comments and acknowledgments of the latest resolved capture of
+// a fingerprint back onto the live alert. Each capture can be restored once.
+type RestoreResolvedAlertRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Fingerprint   string                 `protobuf:"bytes,2,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ClearAllHiddenAlertsRequest) Reset() {
-	*x = ClearAllHiddenAlertsRequest{}
-	mi := &file_proto_alert_proto_msgTypes[44]
+func (x *RestoreResolvedAlertRequest) Reset() {
+	*x = RestoreResolvedAlertRequest{}
+	mi := &file_proto_alert_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ClearAllHiddenAlertsRequest) String() string {
+func (x *RestoreResolvedAlertRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ClearAllHiddenAlertsRequest) ProtoMessage() {}
+func (*RestoreResolvedAlertRequest) ProtoMessage() {}
 
-func (x *ClearAllHiddenAlertsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[44]
+func (x *RestoreResolvedAlertRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2870,49 +3506,50 @@ func (x *ClearAllHiddenAlertsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ClearAllHiddenAlertsRequest.ProtoReflect.Descriptor instead.
-func (*ClearAllHiddenAlertsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use RestoreResolvedAlertRequest.ProtoReflect.Descriptor instead.
+func (*RestoreResolvedAlertRequest) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *ClearAllHiddenAlertsRequest) GetSessionId() string {
+func (x *RestoreResolvedAlertRequest) GetSessionId() string {
 	if x != nil {
 		return x.SessionId
 	}
 	return ""
 }
 
-func (x *ClearAllHiddenAlertsRequest) GetImpersonateUserId() string {
+func (x *RestoreResolvedAlertRequest) GetFingerprint() string {
 	if x != nil {
-		return x.ImpersonateUserId
+		return x.Fingerprint
 	}
 	return ""
 }
 
-type ClearAllHiddenAlertsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	ClearedCount  int32                  `protobuf:"varint,2,opt,name=cleared_count,json=clearedCount,proto3" json:"cleared_count,omitempty"`
-	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type RestoreResolvedAlertResponse struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	Success                 bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message                 string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	RestoredComments        int32                  `protobuf:"varint,3,opt,name=restored_comments,json=restoredComments,proto3" json:"restored_comments,omitempty"`
+	RestoredAcknowledgments int32                  `protobuf:"varint,4,opt,name=restored_acknowledgments,json=restoredAcknowledgments,proto3" json:"restored_acknowledgments,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
 }
 
-func (x *ClearAllHiddenAlertsResponse) Reset() {
-	*x = ClearAllHiddenAlertsResponse{}
-	mi := &file_proto_alert_proto_msgTypes[45]
+func (x *RestoreResolvedAlertResponse) Reset() {
+	*x = RestoreResolvedAlertResponse{}
+	mi := &file_proto_alert_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ClearAllHiddenAlertsResponse) String() string {
+func (x *RestoreResolvedAlertResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ClearAllHiddenAlertsResponse) ProtoMessage() {}
+func (*RestoreResolvedAlertResponse) ProtoMessage() {}
 
-func (x *ClearAllHiddenAlertsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[45]
+func (x *RestoreResolvedAlertResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2923,61 +3560,61 @@ func (x *ClearAllHiddenAlertsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ClearAllHiddenAlertsResponse.ProtoReflect.Descriptor instead.
-func (*ClearAllHiddenAlertsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{45}
+// Deprecated: Use RestoreResolvedAlertResponse.ProtoReflect.Descriptor instead.
+func (*RestoreResolvedAlertResponse) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *ClearAllHiddenAlertsResponse) GetSuccess() bool {
+func (x *RestoreResolvedAlertResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *ClearAllHiddenAlertsResponse) GetClearedCount() int32 {
+func (x *RestoreResolvedAlertResponse) GetMessage() string {
 	if x != nil {
-		return x.ClearedCount
+		return x.Message
+	}
+	return ""
+}
+
+func (x *RestoreResolvedAlertResponse) GetRestoredComments() int32 {
+	if x != nil {
+		return x.RestoredComments
 	}
 	return 0
 }
 
-func (x *ClearAllHiddenAlertsResponse) GetMessage() string {
+func (x *RestoreResolvedAlertResponse) GetRestoredAcknowledgments() int32 {
 	if x != nil {
-		return x.Message
+		return x.RestoredAcknowledgments
 	}
-	return ""
+	return 0
 }
 
-type UserHiddenAlert struct {
+type RemoveAllResolvedAlertsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Fingerprint   string                 `protobuf:"bytes,3,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
-	AlertName     string                 `protobuf:"bytes,4,opt,name=alert_name,json=alertName,proto3" json:"alert_name,omitempty"`
-	Instance      string                 `protobuf:"bytes,5,opt,name=instance,proto3" json:"instance,omitempty"`
-	Reason        string                 `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UserHiddenAlert) Reset() {
-	*x = UserHiddenAlert{}
-	mi := &file_proto_alert_proto_msgTypes[46]
+func (x *RemoveAllResolvedAlertsRequest) Reset() {
+	*x = RemoveAllResolvedAlertsRequest{}
+	mi := &file_proto_alert_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserHiddenAlert) String() string {
+func (x *RemoveAllResolvedAlertsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserHiddenAlert) ProtoMessage() {}
+func (*RemoveAllResolvedAlertsRequest) ProtoMessage() {}
 
-func (x *UserHiddenAlert) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[46]
+func (x *RemoveAllResolvedAlertsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2988,91 +3625,119 @@ func (x *UserHiddenAlert) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserHiddenAlert.ProtoReflect.Descriptor instead.
-func (*UserHiddenAlert) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{46}
+// Deprecated: Use RemoveAllResolvedAlertsRequest.ProtoReflect.Descriptor instead.
+func (*RemoveAllResolvedAlertsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *UserHiddenAlert) GetId() string {
+func (x *RemoveAllResolvedAlertsRequest) GetSessionId() string {
 	if x != nil {
-		return x.Id
+		return x.SessionId
 	}
 	return ""
 }
 
-func (x *UserHiddenAlert) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
+type RemoveAllResolvedAlertsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	RemovedCount  int32                  `protobuf:"varint,2,opt,name=removed_count,json=removedCount,proto3" json:"removed_count,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	ClearedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=cleared_at,json=clearedAt,proto3" json:"cleared_at,omitempty"` // Pass to UndoRemoveAllResolvedAlerts to bring the alerts back
+	UndoUntil     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=undo_until,json=undoUntil,proto3" json:"undo_until,omitempty"` // The removal is final after this time
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UserHiddenAlert) GetFingerprint() string {
+func (x *RemoveAllResolvedAlertsResponse) Reset() {
+	*x = RemoveAllResolvedAlertsResponse{}
+	mi := &file_proto_alert_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveAllResolvedAlertsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveAllResolvedAlertsResponse) ProtoMessage() {}
+
+func (x *RemoveAllResolvedAlertsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[53]
 	if x != nil {
-		return x.Fingerprint
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *UserHiddenAlert) GetAlertName() string {
+// Deprecated: Use RemoveAllResolvedAlertsResponse.ProtoReflect.Descriptor instead.
+func (*RemoveAllResolvedAlertsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *RemoveAllResolvedAlertsResponse) GetSuccess() bool {
 	if x != nil {
-		return x.AlertName
+		return x.Success
 	}
-	return ""
+	return false
 }
 
-func (x *UserHiddenAlert) GetInstance() string {
+func (x *RemoveAllResolvedAlertsResponse) GetRemovedCount() int32 {
 	if x != nil {
-		return x.Instance
+		return x.RemovedCount
 	}
-	return ""
+	return 0
 }
 
-func (x *UserHiddenAlert) GetReason() string {
+func (x *RemoveAllResolvedAlertsResponse) GetMessage() string {
 	if x != nil {
-		return x.Reason
+		return x.Message
 	}
 	return ""
 }
 
-func (x *UserHiddenAlert) GetCreatedAt() *timestamppb.Timestamp {
+func (x *RemoveAllResolvedAlertsResponse) GetClearedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.CreatedAt
+		return x.ClearedAt
 	}
 	return nil
 }
 
-func (x *UserHiddenAlert) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *RemoveAllResolvedAlertsResponse) GetUndoUntil() *timestamppb.Timestamp {
 	if x != nil {
-		return x.UpdatedAt
+		return x.UndoUntil
 	}
 	return nil
 }
 
-// User Hidden Rules Messages
-type GetUserHiddenRulesRequest struct {
+// Undoes a "clear all" while its undo window is open
+type UndoClearRequest struct {
 	state             protoimpl.MessageState `protogen:"open.v1"`
 	SessionId         string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	ImpersonateUserId string                 `protobuf:"bytes,2,opt,name=impersonate_user_id,json=impersonateUserId,proto3" json:"impersonate_user_id,omitempty"` // Optional: load rules for this user instead
+	ClearedAt         *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=cleared_at,json=clearedAt,proto3" json:"cleared_at,omitempty"`                           // From the clear response
+	ImpersonateUserId string                 `protobuf:"bytes,3,opt,name=impersonate_user_id,json=impersonateUserId,proto3" json:"impersonate_user_id,omitempty"` // Optional: for hidden alerts cleared for this user
 	unknownFields     protoimpl.UnknownFields
 	sizeCache         protoimpl.SizeCache
 }
 
-func (x *GetUserHiddenRulesRequest) Reset() {
-	*x = GetUserHiddenRulesRequest{}
-	mi := &file_proto_alert_proto_msgTypes[47]
+func (x *UndoClearRequest) Reset() {
+	*x = UndoClearRequest{}
+	mi := &file_proto_alert_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserHiddenRulesRequest) String() string {
+func (x *UndoClearRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserHiddenRulesRequest) ProtoMessage() {}
+func (*UndoClearRequest) ProtoMessage() {}
 
-func (x *GetUserHiddenRulesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[47]
+func (x *UndoClearRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3083,49 +3748,56 @@ func (x *GetUserHiddenRulesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserHiddenRulesRequest.ProtoReflect.Descriptor instead.
-func (*GetUserHiddenRulesRequest) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{47}
+// Deprecated: Use UndoClearRequest.ProtoReflect.Descriptor instead.
+func (*UndoClearRequest) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *GetUserHiddenRulesRequest) GetSessionId() string {
+func (x *UndoClearRequest) GetSessionId() string {
 	if x != nil {
 		return x.SessionId
 	}
 	return ""
 }
 
-func (x *GetUserHiddenRulesRequest) GetImpersonateUserId() string {
+func (x *UndoClearRequest) GetClearedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ClearedAt
+	}
+	return nil
+}
+
+func (x *UndoClearRequest) GetImpersonateUserId() string {
 	if x != nil {
 		return x.ImpersonateUserId
 	}
 	return ""
 }
 
-type GetUserHiddenRulesResponse struct {
+type UndoClearResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	HiddenRules   []*UserHiddenRule      `protobuf:"bytes,1,rep,name=hidden_rules,json=hiddenRules,proto3" json:"hidden_rules,omitempty"`
-	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	RestoredCount int32                  `protobuf:"varint,2,opt,name=restored_count,json=restoredCount,proto3" json:"restored_count,omitempty"`
 	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserHiddenRulesResponse) Reset() {
-	*x = GetUserHiddenRulesResponse{}
-	mi := &file_proto_alert_proto_msgTypes[48]
+func (x *UndoClearResponse) Reset() {
+	*x = UndoClearResponse{}
+	mi := &file_proto_alert_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserHiddenRulesResponse) String() string {
+func (x *UndoClearResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserHiddenRulesResponse) ProtoMessage() {}
+func (*UndoClearResponse) ProtoMessage() {}
 
-func (x *GetUserHiddenRulesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[48]
+func (x *UndoClearResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3136,56 +3808,53 @@ func (x *GetUserHiddenRulesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserHiddenRulesResponse.ProtoReflect.Descriptor instead.
-func (*GetUserHiddenRulesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{48}
+// Deprecated: Use UndoClearResponse.ProtoReflect.Descriptor instead.
+func (*UndoClearResponse) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *GetUserHiddenRulesResponse) GetHiddenRules() []*UserHiddenRule {
+func (x *UndoClearResponse) GetSuccess() bool {
 	if x != nil {
-		return x.HiddenRules
+		return x.Success
 	}
-	return nil
+	return false
 }
 
-func (x *GetUserHiddenRulesResponse) GetSuccess() bool {
+func (x *UndoClearResponse) GetRestoredCount() int32 {
 	if x != nil {
-		return x.Success
+		return x.RestoredCount
 	}
-	return false
+	return 0
 }
 
-func (x *GetUserHiddenRulesResponse) GetMessage() string {
+func (x *UndoClearResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-type SaveHiddenRuleRequest struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	SessionId         string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	Rule              *UserHiddenRule        `protobuf:"bytes,2,opt,name=rule,proto3" json:"rule,omitempty"`
-	ImpersonateUserId string                 `protobuf:"bytes,3,opt,name=impersonate_user_id,json=impersonateUserId,proto3" json:"impersonate_user_id,omitempty"` // Optional: save rule for this user instead
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+type GetResolvedAlertSettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SaveHiddenRuleRequest) Reset() {
-	*x = SaveHiddenRuleRequest{}
-	mi := &file_proto_alert_proto_msgTypes[49]
+func (x *GetResolvedAlertSettingsRequest) Reset() {
+	*x = GetResolvedAlertSettingsRequest{}
+	mi := &file_proto_alert_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SaveHiddenRuleRequest) String() string {
+func (x *GetResolvedAlertSettingsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SaveHiddenRuleRequest) ProtoMessage() {}
+func (*GetResolvedAlertSettingsRequest) ProtoMessage() {}
 
-func (x *SaveHiddenRuleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[49]
+func (x *GetResolvedAlertSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3196,56 +3865,33 @@ func (x *SaveHiddenRuleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SaveHiddenRuleRequest.ProtoReflect.Descriptor instead.
-func (*SaveHiddenRuleRequest) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{49}
-}
-
-func (x *SaveHiddenRuleRequest) GetSessionId() string {
-	if x != nil {
-		return x.SessionId
-	}
-	return ""
-}
-
-func (x *SaveHiddenRuleRequest) GetRule() *UserHiddenRule {
-	if x != nil {
-		return x.Rule
-	}
-	return nil
-}
-
-func (x *SaveHiddenRuleRequest) GetImpersonateUserId() string {
-	if x != nil {
-		return x.ImpersonateUserId
-	}
-	return ""
+// Deprecated: Use GetResolvedAlertSettingsRequest.ProtoReflect.Descriptor instead.
+func (*GetResolvedAlertSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{56}
 }
 
-type SaveHiddenRuleResponse struct {
+type GetResolvedAlertSettingsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Rule          *UserHiddenRule        `protobuf:"bytes,2,opt,name=rule,proto3" json:"rule,omitempty"`
-	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	TtlHours      int32                  `protobuf:"varint,1,opt,name=ttl_hours,json=ttlHours,proto3" json:"ttl_hours,omitempty"` // 0 when never saved: callers keep their configured retention
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SaveHiddenRuleResponse) Reset() {
-	*x = SaveHiddenRuleResponse{}
-	mi := &file_proto_alert_proto_msgTypes[50]
+func (x *GetResolvedAlertSettingsResponse) Reset() {
+	*x = GetResolvedAlertSettingsResponse{}
+	mi := &file_proto_alert_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SaveHiddenRuleResponse) String() string {
+func (x *GetResolvedAlertSettingsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SaveHiddenRuleResponse) ProtoMessage() {}
+func (*GetResolvedAlertSettingsResponse) ProtoMessage() {}
 
-func (x *SaveHiddenRuleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[50]
+func (x *GetResolvedAlertSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3256,56 +3902,41 @@ func (x *SaveHiddenRuleResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SaveHiddenRuleResponse.ProtoReflect.Descriptor instead.
-func (*SaveHiddenRuleResponse) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{50}
-}
-
-func (x *SaveHiddenRuleResponse) GetSuccess() bool {
-	if x != nil {
-		return x.Success
-	}
-	return false
-}
-
-func (x *SaveHiddenRuleResponse) GetRule() *UserHiddenRule {
-	if x != nil {
-		return x.Rule
-	}
-	return nil
+// Deprecated: Use GetResolvedAlertSettingsResponse.ProtoReflect.Descriptor instead.
+func (*GetResolvedAlertSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *SaveHiddenRuleResponse) GetMessage() string {
+func (x *GetResolvedAlertSettingsResponse) GetTtlHours() int32 {
 	if x != nil {
-		return x.Message
+		return x.TtlHours
 	}
-	return ""
+	return 0
 }
 
-type RemoveHiddenRuleRequest struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	SessionId         string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	RuleId            string                 `protobuf:"bytes,2,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
-	ImpersonateUserId string                 `protobuf:"bytes,3,opt,name=impersonate_user_id,json=impersonateUserId,proto3" json:"impersonate_user_id,omitempty"` // Optional: remove rule for this user instead
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+type SaveResolvedAlertSettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	TtlHours      int32                  `protobuf:"varint,2,opt,name=ttl_hours,json=ttlHours,proto3" json:"ttl_hours,omitempty"` // TTL of the resolved alerts stored from now on, 1h to 365 days
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RemoveHiddenRuleRequest) Reset() {
-	*x = RemoveHiddenRuleRequest{}
-	mi := &file_proto_alert_proto_msgTypes[51]
+func (x *SaveResolvedAlertSettingsRequest) Reset() {
+	*x = SaveResolvedAlertSettingsRequest{}
+	mi := &file_proto_alert_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveHiddenRuleRequest) String() string {
+func (x *SaveResolvedAlertSettingsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveHiddenRuleRequest) ProtoMessage() {}
+func (*SaveResolvedAlertSettingsRequest) ProtoMessage() {}
 
-func (x *RemoveHiddenRuleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[51]
+func (x *SaveResolvedAlertSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3316,33 +3947,26 @@ func (x *RemoveHiddenRuleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveHiddenRuleRequest.ProtoReflect.Descriptor instead.
-func (*RemoveHiddenRuleRequest) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{51}
+// Deprecated: Use SaveResolvedAlertSettingsRequest.ProtoReflect.Descriptor instead.
+func (*SaveResolvedAlertSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *RemoveHiddenRuleRequest) GetSessionId() string {
+func (x *SaveResolvedAlertSettingsRequest) GetSessionId() string {
 	if x != nil {
 		return x.SessionId
 	}
 	return ""
 }
 
-func (x *RemoveHiddenRuleRequest) GetRuleId() string {
-	if x != nil {
-		return x.RuleId
-	}
-	return ""
-}
-
-func (x *RemoveHiddenRuleRequest) GetImpersonateUserId() string {
+func (x *SaveResolvedAlertSettingsRequest) GetTtlHours() int32 {
 	if x != nil {
-		return x.ImpersonateUserId
+		return x.TtlHours
 	}
-	return ""
+	return 0
 }
 
-type RemoveHiddenRuleResponse struct {
+type SaveResolvedAlertSettingsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
@@ -3350,21 +3974,21 @@ type RemoveHiddenRuleResponse struct {
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RemoveHiddenRuleResponse) Reset() {
-	*x = RemoveHiddenRuleResponse{}
-	mi := &file_proto_alert_proto_msgTypes[52]
+func (x *SaveResolvedAlertSettingsResponse) Reset() {
+	*x = SaveResolvedAlertSettingsResponse{}
+	mi := &file_proto_alert_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveHiddenRuleResponse) String() string {
+func (x *SaveResolvedAlertSettingsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveHiddenRuleResponse) ProtoMessage() {}
+func (*SaveResolvedAlertSettingsResponse) ProtoMessage() {}
 
-func (x *RemoveHiddenRuleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[52]
+func (x *SaveResolvedAlertSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3375,57 +3999,47 @@ func (x *RemoveHiddenRuleResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveHiddenRuleResponse.ProtoReflect.Descriptor instead.
-func (*RemoveHiddenRuleResponse) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{52}
+// Deprecated: Use SaveResolvedAlertSettingsResponse.ProtoReflect.Descriptor instead.
+func (*SaveResolvedAlertSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *RemoveHiddenRuleResponse) GetSuccess() bool {
+func (x *SaveResolvedAlertSettingsResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *RemoveHiddenRuleResponse) GetMessage() string {
+func (x *SaveResolvedAlertSettingsResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-type UserHiddenRule struct {
+type StreamResolvedAlertUpdatesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	LabelKey      string                 `protobuf:"bytes,5,opt,name=label_key,json=labelKey,proto3" json:"label_key,omitempty"`
-	LabelValue    string                 `protobuf:"bytes,6,opt,name=label_value,json=labelValue,proto3" json:"label_value,omitempty"`
-	IsRegex       bool                   `protobuf:"varint,7,opt,name=is_regex,json=isRegex,proto3" json:"is_regex,omitempty"`
-	IsEnabled     bool                   `protobuf:"varint,8,opt,name=is_enabled,json=isEnabled,proto3" json:"is_enabled,omitempty"`
-	Priority      int32                  `protobuf:"varint,9,opt,name=priority,proto3" json:"priority,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UserHiddenRule) Reset() {
-	*x = UserHiddenRule{}
-	mi := &file_proto_alert_proto_msgTypes[53]
+func (x *StreamResolvedAlertUpdatesRequest) Reset() {
+	*x = StreamResolvedAlertUpdatesRequest{}
+	mi := &file_proto_alert_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserHiddenRule) String() string {
+func (x *StreamResolvedAlertUpdatesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserHiddenRule) ProtoMessage() {}
+func (*StreamResolvedAlertUpdatesRequest) ProtoMessage() {}
 
-func (x *UserHiddenRule) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[53]
+func (x *StreamResolvedAlertUpdatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3436,111 +4050,118 @@ func (x *UserHiddenRule) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserHiddenRule.ProtoReflect.Descriptor instead.
-func (*UserHiddenRule) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{53}
+// Deprecated: Use StreamResolvedAlertUpdatesRequest.ProtoReflect.Descriptor instead.
+func (*StreamResolvedAlertUpdatesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *UserHiddenRule) GetId() string {
+func (x *StreamResolvedAlertUpdatesRequest) GetSessionId() string {
 	if x != nil {
-		return x.Id
+		return x.SessionId
 	}
 	return ""
 }
 
-func (x *UserHiddenRule) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
+type ResolvedAlertUpdate struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Fingerprint   string                  `protobuf:"bytes,1,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
+	UpdateType    ResolvedAlertUpdateType `protobuf:"varint,2,opt,name=update_type,json=updateType,proto3,enum=notificator.alert.ResolvedAlertUpdateType" json:"update_type,omitempty"`
+	ResolvedAlert *ResolvedAlertInfo      `protobuf:"bytes,3,opt,name=resolved_alert,json=resolvedAlert,proto3" json:"resolved_alert,omitempty"`
+	Timestamp     *timestamppb.Timestamp  `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UserHiddenRule) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+func (x *ResolvedAlertUpdate) Reset() {
+	*x = ResolvedAlertUpdate{}
+	mi := &file_proto_alert_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserHiddenRule) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
+func (x *ResolvedAlertUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *UserHiddenRule) GetLabelKey() string {
+func (*ResolvedAlertUpdate) ProtoMessage() {}
+
+func (x *ResolvedAlertUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[61]
 	if x != nil {
-		return x.LabelKey
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *UserHiddenRule) GetLabelValue() string {
-	if x != nil {
-		return x.LabelValue
-	}
-	return ""
-}
-
-func (x *UserHiddenRule) GetIsRegex() bool {
-	if x != nil {
-		return x.IsRegex
-	}
-	return false
+// Deprecated: Use ResolvedAlertUpdate.ProtoReflect.Descriptor instead.
+func (*ResolvedAlertUpdate) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *UserHiddenRule) GetIsEnabled() bool {
+func (x *ResolvedAlertUpdate) GetFingerprint() string {
 	if x != nil {
-		return x.IsEnabled
+		return x.Fingerprint
 	}
-	return false
+	return ""
 }
 
-func (x *UserHiddenRule) GetPriority() int32 {
+func (x *ResolvedAlertUpdate) GetUpdateType() ResolvedAlertUpdateType {
 	if x != nil {
-		return x.Priority
+		return x.UpdateType
 	}
-	return 0
+	return ResolvedAlertUpdateType_UNKNOWN_RESOLVED_UPDATE
 }
 
-func (x *UserHiddenRule) GetCreatedAt() *timestamppb.Timestamp {
+func (x *ResolvedAlertUpdate) GetResolvedAlert() *ResolvedAlertInfo {
 	if x != nil {
-		return x.CreatedAt
+		return x.ResolvedAlert
 	}
 	return nil
 }
 
-func (x *UserHiddenRule) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *ResolvedAlertUpdate) GetTimestamp() *timestamppb.Timestamp {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Timestamp
 	}
 	return nil
 }
 
-// Notification Preferences Messages
-type GetNotificationPreferencesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type ResolvedAlertInfo struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Fingerprint     string                 `protobuf:"bytes,2,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
+	AlertData       []byte                 `protobuf:"bytes,3,opt,name=alert_data,json=alertData,proto3" json:"alert_data,omitempty"` // JSON serialized DashboardAlert
+	Comments        []byte                 `protobuf:"bytes,4,opt,name=comments,proto3" json:"comments,omitempty"`                    // JSON serialized comments array
+	Acknowledgments []byte                 `protobuf:"bytes,5,opt,name=acknowledgments,proto3" json:"acknowledgments,omitempty"`      // JSON serialized acknowledgments array
+	ResolvedAt      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=resolved_at,json=resolvedAt,proto3" json:"resolved_at,omitempty"`
+	ExpiresAt       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	Source          string                 `protobuf:"bytes,8,opt,name=source,proto3" json:"source,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	RestoredAt      *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=restored_at,json=restoredAt,proto3" json:"restored_at,omitempty"` // Set once its discussion was restored onto the live alert
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *GetNotificationPreferencesRequest) Reset() {
-	*x = GetNotificationPreferencesRequest{}
-	mi := &file_proto_alert_proto_msgTypes[54]
+func (x *ResolvedAlertInfo) Reset() {
+	*x = ResolvedAlertInfo{}
+	mi := &file_proto_alert_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetNotificationPreferencesRequest) String() string {
+func (x *ResolvedAlertInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetNotificationPreferencesRequest) ProtoMessage() {}
+func (*ResolvedAlertInfo) ProtoMessage() {}
 
-func (x *GetNotificationPreferencesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[54]
+func (x *ResolvedAlertInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3551,103 +4172,112 @@ func (x *GetNotificationPreferencesRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetNotificationPreferencesRequest.ProtoReflect.Descriptor instead.
-func (*GetNotificationPreferencesRequest) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{54}
+// Deprecated: Use ResolvedAlertInfo.ProtoReflect.Descriptor instead.
+func (*ResolvedAlertInfo) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *GetNotificationPreferencesRequest) GetSessionId() string {
+func (x *ResolvedAlertInfo) GetId() string {
 	if x != nil {
-		return x.SessionId
+		return x.Id
 	}
 	return ""
 }
 
-type GetNotificationPreferencesResponse struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	Success       bool                    `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Preferences   *NotificationPreference `protobuf:"bytes,2,opt,name=preferences,proto3" json:"preferences,omitempty"`
-	Message       string                  `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ResolvedAlertInfo) GetFingerprint() string {
+	if x != nil {
+		return x.Fingerprint
+	}
+	return ""
 }
 
-func (x *GetNotificationPreferencesResponse) Reset() {
-	*x = GetNotificationPreferencesResponse{}
-	mi := &file_proto_alert_proto_msgTypes[55]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *ResolvedAlertInfo) GetAlertData() []byte {
+	if x != nil {
+		return x.AlertData
+	}
+	return nil
 }
 
-func (x *GetNotificationPreferencesResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ResolvedAlertInfo) GetComments() []byte {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
 }
 
-func (*GetNotificationPreferencesResponse) ProtoMessage() {}
+func (x *ResolvedAlertInfo) GetAcknowledgments() []byte {
+	if x != nil {
+		return x.Acknowledgments
+	}
+	return nil
+}
 
-func (x *GetNotificationPreferencesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[55]
+func (x *ResolvedAlertInfo) GetResolvedAt() *timestamppb.Timestamp {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.ResolvedAt
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use GetNotificationPreferencesResponse.ProtoReflect.Descriptor instead.
-func (*GetNotificationPreferencesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{55}
+func (x *ResolvedAlertInfo) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
 }
 
-func (x *GetNotificationPreferencesResponse) GetSuccess() bool {
+func (x *ResolvedAlertInfo) GetSource() string {
 	if x != nil {
-		return x.Success
+		return x.Source
 	}
-	return false
+	return ""
 }
 
-func (x *GetNotificationPreferencesResponse) GetPreferences() *NotificationPreference {
+func (x *ResolvedAlertInfo) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Preferences
+		return x.CreatedAt
 	}
 	return nil
 }
 
-func (x *GetNotificationPreferencesResponse) GetMessage() string {
+func (x *ResolvedAlertInfo) GetUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Message
+		return x.UpdatedAt
 	}
-	return ""
+	return nil
 }
 
-type SaveNotificationPreferencesRequest struct {
-	state                       protoimpl.MessageState `protogen:"open.v1"`
-	SessionId                   string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	BrowserNotificationsEnabled bool                   `protobuf:"varint,2,opt,name=browser_notifications_enabled,json=browserNotificationsEnabled,proto3" json:"browser_notifications_enabled,omitempty"`
-	EnabledSeverities           []string               `protobuf:"bytes,3,rep,name=enabled_severities,json=enabledSeverities,proto3" json:"enabled_severities,omitempty"`
-	SoundNotificationsEnabled   bool                   `protobuf:"varint,4,opt,name=sound_notifications_enabled,json=soundNotificationsEnabled,proto3" json:"sound_notifications_enabled,omitempty"`
-	unknownFields               protoimpl.UnknownFields
-	sizeCache                   protoimpl.SizeCache
+func (x *ResolvedAlertInfo) GetRestoredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RestoredAt
+	}
+	return nil
 }
 
-func (x *SaveNotificationPreferencesRequest) Reset() {
-	*x = SaveNotificationPreferencesRequest{}
-	mi := &file_proto_alert_proto_msgTypes[56]
+// User Hidden Alerts Messages
+type GetUserHiddenAlertsRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	SessionId         string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	ImpersonateUserId string                 `protobuf:"bytes,2,opt,name=impersonate_user_id,json=impersonateUserId,proto3" json:"impersonate_user_id,omitempty"` // Optional: load hidden alerts for this user instead
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GetUserHiddenAlertsRequest) Reset() {
+	*x = GetUserHiddenAlertsRequest{}
+	mi := &file_proto_alert_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SaveNotificationPreferencesRequest) String() string {
+func (x *GetUserHiddenAlertsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SaveNotificationPreferencesRequest) ProtoMessage() {}
+func (*GetUserHiddenAlertsRequest) ProtoMessage() {}
 
-func (x *SaveNotificationPreferencesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[56]
+func (x *GetUserHiddenAlertsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3658,63 +4288,49 @@ func (x *SaveNotificationPreferencesRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SaveNotificationPreferencesRequest.ProtoReflect.Descriptor instead.
-func (*SaveNotificationPreferencesRequest) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{56}
+// Deprecated: Use GetUserHiddenAlertsRequest.ProtoReflect.Descriptor instead.
+func (*GetUserHiddenAlertsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *SaveNotificationPreferencesRequest) GetSessionId() string {
+func (x *GetUserHiddenAlertsRequest) GetSessionId() string {
 	if x != nil {
 		return x.SessionId
 	}
 	return ""
 }
 
-func (x *SaveNotificationPreferencesRequest) GetBrowserNotificationsEnabled() bool {
-	if x != nil {
-		return x.BrowserNotificationsEnabled
-	}
-	return false
-}
-
-func (x *SaveNotificationPreferencesRequest) GetEnabledSeverities() []string {
-	if x != nil {
-		return x.EnabledSeverities
-	}
-	return nil
-}
-
-func (x *SaveNotificationPreferencesRequest) GetSoundNotificationsEnabled() bool {
+func (x *GetUserHiddenAlertsRequest) GetImpersonateUserId() string {
 	if x != nil {
-		return x.SoundNotificationsEnabled
+		return x.ImpersonateUserId
 	}
-	return false
+	return ""
 }
 
-type SaveNotificationPreferencesResponse struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	Success       bool                    `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Preferences   *NotificationPreference `protobuf:"bytes,2,opt,name=preferences,proto3" json:"preferences,omitempty"`
-	Message       string                  `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+type GetUserHiddenAlertsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HiddenAlerts  []*UserHiddenAlert     `protobuf:"bytes,1,rep,name=hidden_alerts,json=hiddenAlerts,proto3" json:"hidden_alerts,omitempty"`
+	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SaveNotificationPreferencesResponse) Reset() {
-	*x = SaveNotificationPreferencesResponse{}
-	mi := &file_proto_alert_proto_msgTypes[57]
+func (x *GetUserHiddenAlertsResponse) Reset() {
+	*x = GetUserHiddenAlertsResponse{}
+	mi := &file_proto_alert_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SaveNotificationPreferencesResponse) String() string {
+func (x *GetUserHiddenAlertsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SaveNotificationPreferencesResponse) ProtoMessage() {}
+func (*GetUserHiddenAlertsResponse) ProtoMessage() {}
 
-func (x *SaveNotificationPreferencesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[57]
+func (x *GetUserHiddenAlertsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3725,60 +4341,60 @@ func (x *SaveNotificationPreferencesResponse) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SaveNotificationPreferencesResponse.ProtoReflect.Descriptor instead.
-func (*SaveNotificationPreferencesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{57}
+// Deprecated: Use GetUserHiddenAlertsResponse.ProtoReflect.Descriptor instead.
+func (*GetUserHiddenAlertsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *SaveNotificationPreferencesResponse) GetSuccess() bool {
+func (x *GetUserHiddenAlertsResponse) GetHiddenAlerts() []*UserHiddenAlert {
 	if x != nil {
-		return x.Success
+		return x.HiddenAlerts
 	}
-	return false
+	return nil
 }
 
-func (x *SaveNotificationPreferencesResponse) GetPreferences() *NotificationPreference {
+func (x *GetUserHiddenAlertsResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Preferences
+		return x.Success
 	}
-	return nil
+	return false
 }
 
-func (x *SaveNotificationPreferencesResponse) GetMessage() string {
+func (x *GetUserHiddenAlertsResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-type NotificationPreference struct {
-	state                       protoimpl.MessageState `protogen:"open.v1"`
-	Id                          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId                      string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	BrowserNotificationsEnabled bool                   `protobuf:"varint,3,opt,name=browser_notifications_enabled,json=browserNotificationsEnabled,proto3" json:"browser_notifications_enabled,omitempty"`
-	EnabledSeverities           []string               `protobuf:"bytes,4,rep,name=enabled_severities,json=enabledSeverities,proto3" json:"enabled_severities,omitempty"`
-	SoundNotificationsEnabled   bool                   `protobuf:"varint,5,opt,name=sound_notifications_enabled,json=soundNotificationsEnabled,proto3" json:"sound_notifications_enabled,omitempty"`
-	CreatedAt                   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt                   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields               protoimpl.UnknownFields
-	sizeCache                   protoimpl.SizeCache
+type HideAlertRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	SessionId         string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Fingerprint       string                 `protobuf:"bytes,2,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
+	AlertName         string                 `protobuf:"bytes,3,opt,name=alert_name,json=alertName,proto3" json:"alert_name,omitempty"`
+	Instance          string                 `protobuf:"bytes,4,opt,name=instance,proto3" json:"instance,omitempty"`
+	Reason            string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	ImpersonateUserId string                 `protobuf:"bytes,6,opt,name=impersonate_user_id,json=impersonateUserId,proto3" json:"impersonate_user_id,omitempty"` // Optional: hide for this user instead
+	SnoozeUntil       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=snooze_until,json=snoozeUntil,proto3" json:"snooze_until,omitempty"`                     // Optional: snooze until this time instead of hiding for good
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *NotificationPreference) Reset() {
-	*x = NotificationPreference{}
-	mi := &file_proto_alert_proto_msgTypes[58]
+func (x *HideAlertRequest) Reset() {
+	*x = HideAlertRequest{}
+	mi := &file_proto_alert_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *NotificationPreference) String() string {
+func (x *HideAlertRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NotificationPreference) ProtoMessage() {}
+func (*HideAlertRequest) ProtoMessage() {}
 
-func (x *NotificationPreference) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[58]
+func (x *HideAlertRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3789,145 +4405,84 @@ func (x *NotificationPreference) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NotificationPreference.ProtoReflect.Descriptor instead.
-func (*NotificationPreference) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{58}
+// Deprecated: Use HideAlertRequest.ProtoReflect.Descriptor instead.
+func (*HideAlertRequest) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *NotificationPreference) GetId() string {
+func (x *HideAlertRequest) GetSessionId() string {
 	if x != nil {
-		return x.Id
+		return x.SessionId
 	}
 	return ""
 }
 
-func (x *NotificationPreference) GetUserId() string {
+func (x *HideAlertRequest) GetFingerprint() string {
 	if x != nil {
-		return x.UserId
+		return x.Fingerprint
 	}
 	return ""
 }
 
-func (x *NotificationPreference) GetBrowserNotificationsEnabled() bool {
-	if x != nil {
-		return x.BrowserNotificationsEnabled
-	}
-	return false
-}
-
-func (x *NotificationPreference) GetEnabledSeverities() []string {
-	if x != nil {
-		return x.EnabledSeverities
-	}
-	return nil
-}
-
-func (x *NotificationPreference) GetSoundNotificationsEnabled() bool {
-	if x != nil {
-		return x.SoundNotificationsEnabled
-	}
-	return false
-}
-
-func (x *NotificationPreference) GetCreatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return nil
-}
-
-func (x *NotificationPreference) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *HideAlertRequest) GetAlertName() string {
 	if x != nil {
-		return x.UpdatedAt
+		return x.AlertName
 	}
-	return nil
-}
-
-// Filter Presets Messages
-type GetFilterPresetsRequest struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	SessionId         string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	IncludeShared     bool                   `protobuf:"varint,2,opt,name=include_shared,json=includeShared,proto3" json:"include_shared,omitempty"`
-	ImpersonateUserId string                 `protobuf:"bytes,3,opt,name=impersonate_user_id,json=impersonateUserId,proto3" json:"impersonate_user_id,omitempty"` // Optional: load presets for this user instead
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
-}
-
-func (x *GetFilterPresetsRequest) Reset() {
-	*x = GetFilterPresetsRequest{}
-	mi := &file_proto_alert_proto_msgTypes[59]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetFilterPresetsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return ""
 }
 
-func (*GetFilterPresetsRequest) ProtoMessage() {}
-
-func (x *GetFilterPresetsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[59]
+func (x *HideAlertRequest) GetInstance() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Instance
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetFilterPresetsRequest.ProtoReflect.Descriptor instead.
-func (*GetFilterPresetsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{59}
+	return ""
 }
 
-func (x *GetFilterPresetsRequest) GetSessionId() string {
+func (x *HideAlertRequest) GetReason() string {
 	if x != nil {
-		return x.SessionId
+		return x.Reason
 	}
 	return ""
 }
 
-func (x *GetFilterPresetsRequest) GetIncludeShared() bool {
+func (x *HideAlertRequest) GetImpersonateUserId() string {
 	if x != nil {
-		return x.IncludeShared
+		return x.ImpersonateUserId
 	}
-	return false
+	return ""
 }
 
-func (x *GetFilterPresetsRequest) GetImpersonateUserId() string {
+func (x *HideAlertRequest) GetSnoozeUntil() *timestamppb.Timestamp {
 	if x != nil {
-		return x.ImpersonateUserId
+		return x.SnoozeUntil
 	}
-	return ""
+	return nil
 }
 
-type GetFilterPresetsResponse struct {
+type HideAlertResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Presets       []*FilterPreset        `protobuf:"bytes,2,rep,name=presets,proto3" json:"presets,omitempty"`
+	HiddenAlert   *UserHiddenAlert       `protobuf:"bytes,2,opt,name=hidden_alert,json=hiddenAlert,proto3" json:"hidden_alert,omitempty"`
 	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetFilterPresetsResponse) Reset() {
-	*x = GetFilterPresetsResponse{}
-	mi := &file_proto_alert_proto_msgTypes[60]
+func (x *HideAlertResponse) Reset() {
+	*x = HideAlertResponse{}
+	mi := &file_proto_alert_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetFilterPresetsResponse) String() string {
+func (x *HideAlertResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFilterPresetsResponse) ProtoMessage() {}
+func (*HideAlertResponse) ProtoMessage() {}
 
-func (x *GetFilterPresetsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[60]
+func (x *HideAlertResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3938,59 +4493,57 @@ func (x *GetFilterPresetsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFilterPresetsResponse.ProtoReflect.Descriptor instead.
-func (*GetFilterPresetsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{60}
+// Deprecated: Use HideAlertResponse.ProtoReflect.Descriptor instead.
+func (*HideAlertResponse) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *GetFilterPresetsResponse) GetSuccess() bool {
+func (x *HideAlertResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *GetFilterPresetsResponse) GetPresets() []*FilterPreset {
+func (x *HideAlertResponse) GetHiddenAlert() *UserHiddenAlert {
 	if x != nil {
-		return x.Presets
+		return x.HiddenAlert
 	}
 	return nil
 }
 
-func (x *GetFilterPresetsResponse) GetMessage() string {
+func (x *HideAlertResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-type SaveFilterPresetRequest struct {
+type UnhideAlertRequest struct {
 	state             protoimpl.MessageState `protogen:"open.v1"`
 	SessionId         string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	Name              string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description       string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	IsShared          bool                   `protobuf:"varint,4,opt,name=is_shared,json=isShared,proto3" json:"is_shared,omitempty"`
-	FilterData        []byte                 `protobuf:"bytes,5,opt,name=filter_data,json=filterData,proto3" json:"filter_data,omitempty"`                        // JSON serialized filter state
-	ImpersonateUserId string                 `protobuf:"bytes,6,opt,name=impersonate_user_id,json=impersonateUserId,proto3" json:"impersonate_user_id,omitempty"` // Optional: save preset for this user instead
+	Fingerprint       string                 `protobuf:"bytes,2,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
+	ImpersonateUserId string                 `protobuf:"bytes,3,opt,name=impersonate_user_id,json=impersonateUserId,proto3" json:"impersonate_user_id,omitempty"` // Optional: unhide for this user instead
+	Fingerprints      []string               `protobuf:"bytes,4,rep,name=fingerprints,proto3" json:"fingerprints,omitempty"`                                      // Optional: more alerts to unhide in the same call
 	unknownFields     protoimpl.UnknownFields
 	sizeCache         protoimpl.SizeCache
 }
 
-func (x *SaveFilterPresetRequest) Reset() {
-	*x = SaveFilterPresetRequest{}
-	mi := &file_proto_alert_proto_msgTypes[61]
+func (x *UnhideAlertRequest) Reset() {
+	*x = UnhideAlertRequest{}
+	mi := &file_proto_alert_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SaveFilterPresetRequest) String() string {
+func (x *UnhideAlertRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SaveFilterPresetRequest) ProtoMessage() {}
+func (*UnhideAlertRequest) ProtoMessage() {}
 
-func (x *SaveFilterPresetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[61]
+func (x *UnhideAlertRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4001,77 +4554,63 @@ func (x *SaveFilterPresetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SaveFilterPresetRequest.ProtoReflect.Descriptor instead.
-func (*SaveFilterPresetRequest) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{61}
+// Deprecated: Use UnhideAlertRequest.ProtoReflect.Descriptor instead.
+func (*UnhideAlertRequest) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *SaveFilterPresetRequest) GetSessionId() string {
+func (x *UnhideAlertRequest) GetSessionId() string {
 	if x != nil {
 		return x.SessionId
 	}
 	return ""
 }
 
-func (x *SaveFilterPresetRequest) GetName() string {
+func (x *UnhideAlertRequest) GetFingerprint() string {
 	if x != nil {
-		return x.Name
+		return x.Fingerprint
 	}
 	return ""
 }
 
-func (x *SaveFilterPresetRequest) GetDescription() string {
+func (x *UnhideAlertRequest) GetImpersonateUserId() string {
 	if x != nil {
-		return x.Description
+		return x.ImpersonateUserId
 	}
 	return ""
 }
 
-func (x *SaveFilterPresetRequest) GetIsShared() bool {
-	if x != nil {
-		return x.IsShared
-	}
-	return false
-}
-
-func (x *SaveFilterPresetRequest) GetFilterData() []byte {
+func (x *UnhideAlertRequest) GetFingerprints() []string {
 	if x != nil {
-		return x.FilterData
+		return x.Fingerprints
 	}
 	return nil
 }
 
-func (x *SaveFilterPresetRequest) GetImpersonateUserId() string {
-	if x != nil {
-		return x.ImpersonateUserId
-	}
-	return ""
-}
-
-type SaveFilterPresetResponse struct {
+type UnhideAlertResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Preset        *FilterPreset          `protobuf:"bytes,2,opt,name=preset,proto3" json:"preset,omitempty"`
-	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	UnhiddenCount int32                  `protobuf:"varint,3,opt,name=unhidden_count,json=unhiddenCount,proto3" json:"unhidden_count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SaveFilterPresetResponse) Reset() {
-	*x = SaveFilterPresetResponse{}
-	mi := &file_proto_alert_proto_msgTypes[62]
+func (x *UnhideAlertResponse) Reset() {
+	*x = UnhideAlertResponse{}
+	mi := &file_proto_alert_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SaveFilterPresetResponse) String() string {
+func (x *UnhideAlertResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SaveFilterPresetResponse) ProtoMessage() {}
+func (*UnhideAlertResponse) ProtoMessage() {}
 
-func (x *SaveFilterPresetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[62]
+func (x *UnhideAlertResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4082,60 +4621,55 @@ func (x *SaveFilterPresetResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SaveFilterPresetResponse.ProtoReflect.Descriptor instead.
-func (*SaveFilterPresetResponse) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{62}
+// Deprecated: Use UnhideAlertResponse.ProtoReflect.Descriptor instead.
+func (*UnhideAlertResponse) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *SaveFilterPresetResponse) GetSuccess() bool {
+func (x *UnhideAlertResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *SaveFilterPresetResponse) GetPreset() *FilterPreset {
+func (x *UnhideAlertResponse) GetMessage() string {
 	if x != nil {
-		return x.Preset
+		return x.Message
 	}
-	return nil
+	return ""
 }
 
-func (x *SaveFilterPresetResponse) GetMessage() string {
+func (x *UnhideAlertResponse) GetUnhiddenCount() int32 {
 	if x != nil {
-		return x.Message
+		return x.UnhiddenCount
 	}
-	return ""
+	return 0
 }
 
-type UpdateFilterPresetRequest struct {
+type ClearAllHiddenAlertsRequest struct {
 	state             protoimpl.MessageState `protogen:"open.v1"`
 	SessionId         string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	PresetId          string                 `protobuf:"bytes,2,opt,name=preset_id,json=presetId,proto3" json:"preset_id,omitempty"`
-	Name              string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Description       string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	IsShared          bool                   `protobuf:"varint,5,opt,name=is_shared,json=isShared,proto3" json:"is_shared,omitempty"`
-	FilterData        []byte                 `protobuf:"bytes,6,opt,name=filter_data,json=filterData,proto3" json:"filter_data,omitempty"`                        // JSON serialized filter state
-	ImpersonateUserId string                 `protobuf:"bytes,7,opt,name=impersonate_user_id,json=impersonateUserId,proto3" json:"impersonate_user_id,omitempty"` // Optional: update preset for this user instead
+	ImpersonateUserId string                 `protobuf:"bytes,2,opt,name=impersonate_user_id,json=impersonateUserId,proto3" json:"impersonate_user_id,omitempty"` // Optional: clear for this user instead
 	unknownFields     protoimpl.UnknownFields
 	sizeCache         protoimpl.SizeCache
 }
 
-func (x *UpdateFilterPresetRequest) Reset() {
-	*x = UpdateFilterPresetRequest{}
-	mi := &file_proto_alert_proto_msgTypes[63]
+func (x *ClearAllHiddenAlertsRequest) Reset() {
+	*x = ClearAllHiddenAlertsRequest{}
+	mi := &file_proto_alert_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateFilterPresetRequest) String() string {
+func (x *ClearAllHiddenAlertsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateFilterPresetRequest) ProtoMessage() {}
+func (*ClearAllHiddenAlertsRequest) ProtoMessage() {}
 
-func (x *UpdateFilterPresetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[63]
+func (x *ClearAllHiddenAlertsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4146,84 +4680,51 @@ func (x *UpdateFilterPresetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateFilterPresetRequest.ProtoReflect.Descriptor instead.
-func (*UpdateFilterPresetRequest) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{63}
+// Deprecated: Use ClearAllHiddenAlertsRequest.ProtoReflect.Descriptor instead.
+func (*ClearAllHiddenAlertsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *UpdateFilterPresetRequest) GetSessionId() string {
+func (x *ClearAllHiddenAlertsRequest) GetSessionId() string {
 	if x != nil {
 		return x.SessionId
 	}
 	return ""
 }
 
-func (x *UpdateFilterPresetRequest) GetPresetId() string {
-	if x != nil {
-		return x.PresetId
-	}
-	return ""
-}
-
-func (x *UpdateFilterPresetRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *UpdateFilterPresetRequest) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
-}
-
-func (x *UpdateFilterPresetRequest) GetIsShared() bool {
-	if x != nil {
-		return x.IsShared
-	}
-	return false
-}
-
-func (x *UpdateFilterPresetRequest) GetFilterData() []byte {
-	if x != nil {
-		return x.FilterData
-	}
-	return nil
-}
-
-func (x *UpdateFilterPresetRequest) GetImpersonateUserId() string {
+func (x *ClearAllHiddenAlertsRequest) GetImpersonateUserId() string {
 	if x != nil {
 		return x.ImpersonateUserId
 	}
 	return ""
 }
 
-type UpdateFilterPresetResponse struct {
+type ClearAllHiddenAlertsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Preset        *FilterPreset          `protobuf:"bytes,2,opt,name=preset,proto3" json:"preset,omitempty"`
+	ClearedCount  int32                  `protobuf:"varint,2,opt,name=cleared_count,json=clearedCount,proto3" json:"cleared_count,omitempty"`
 	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	ClearedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=cleared_at,json=clearedAt,proto3" json:"cleared_at,omitempty"` // Pass to UndoClearAllHiddenAlerts to bring the alerts back
+	UndoUntil     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=undo_until,json=undoUntil,proto3" json:"undo_until,omitempty"` // The clear is final after this time
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateFilterPresetResponse) Reset() {
-	*x = UpdateFilterPresetResponse{}
-	mi := &file_proto_alert_proto_msgTypes[64]
+func (x *ClearAllHiddenAlertsResponse) Reset() {
+	*x = ClearAllHiddenAlertsResponse{}
+	mi := &file_proto_alert_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateFilterPresetResponse) String() string {
+func (x *ClearAllHiddenAlertsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateFilterPresetResponse) ProtoMessage() {}
+func (*ClearAllHiddenAlertsResponse) ProtoMessage() {}
 
-func (x *UpdateFilterPresetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[64]
+func (x *ClearAllHiddenAlertsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4234,56 +4735,76 @@ func (x *UpdateFilterPresetResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateFilterPresetResponse.ProtoReflect.Descriptor instead.
-func (*UpdateFilterPresetResponse) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{64}
+// Deprecated: Use ClearAllHiddenAlertsResponse.ProtoReflect.Descriptor instead.
+func (*ClearAllHiddenAlertsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *UpdateFilterPresetResponse) GetSuccess() bool {
+func (x *ClearAllHiddenAlertsResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *UpdateFilterPresetResponse) GetPreset() *FilterPreset {
+func (x *ClearAllHiddenAlertsResponse) GetClearedCount() int32 {
 	if x != nil {
-		return x.Preset
+		return x.ClearedCount
 	}
-	return nil
+	return 0
 }
 
-func (x *UpdateFilterPresetResponse) GetMessage() string {
+func (x *ClearAllHiddenAlertsResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-type DeleteFilterPresetRequest struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	SessionId         string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	PresetId          string                 `protobuf:"bytes,2,opt,name=preset_id,json=presetId,proto3" json:"preset_id,omitempty"`
-	ImpersonateUserId string                 `protobuf:"bytes,3,opt,name=impersonate_user_id,json=impersonateUserId,proto3" json:"impersonate_user_id,omitempty"` // Optional: delete preset for this user instead
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+func (x *ClearAllHiddenAlertsResponse) GetClearedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ClearedAt
+	}
+	return nil
 }
 
-func (x *DeleteFilterPresetRequest) Reset() {
-	*x = DeleteFilterPresetRequest{}
-	mi := &file_proto_alert_proto_msgTypes[65]
+func (x *ClearAllHiddenAlertsResponse) GetUndoUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UndoUntil
+	}
+	return nil
+}
+
+type UserHiddenAlert struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Fingerprint   string                 `protobuf:"bytes,3,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
+	AlertName     string                 `protobuf:"bytes,4,opt,name=alert_name,json=alertName,proto3" json:"alert_name,omitempty"`
+	Instance      string                 `protobuf:"bytes,5,opt,name=instance,proto3" json:"instance,omitempty"`
+	Reason        string                 `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // Set for snoozed alerts
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserHiddenAlert) Reset() {
+	*x = UserHiddenAlert{}
+	mi := &file_proto_alert_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteFilterPresetRequest) String() string {
+func (x *UserHiddenAlert) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteFilterPresetRequest) ProtoMessage() {}
+func (*UserHiddenAlert) ProtoMessage() {}
 
-func (x *DeleteFilterPresetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[65]
+func (x *UserHiddenAlert) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4294,108 +4815,98 @@ func (x *DeleteFilterPresetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteFilterPresetRequest.ProtoReflect.Descriptor instead.
-func (*DeleteFilterPresetRequest) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{65}
+// Deprecated: Use UserHiddenAlert.ProtoReflect.Descriptor instead.
+func (*UserHiddenAlert) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *DeleteFilterPresetRequest) GetSessionId() string {
+func (x *UserHiddenAlert) GetId() string {
 	if x != nil {
-		return x.SessionId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *DeleteFilterPresetRequest) GetPresetId() string {
+func (x *UserHiddenAlert) GetUserId() string {
 	if x != nil {
-		return x.PresetId
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *DeleteFilterPresetRequest) GetImpersonateUserId() string {
+func (x *UserHiddenAlert) GetFingerprint() string {
 	if x != nil {
-		return x.ImpersonateUserId
+		return x.Fingerprint
 	}
 	return ""
 }
 
-type DeleteFilterPresetResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *DeleteFilterPresetResponse) Reset() {
-	*x = DeleteFilterPresetResponse{}
-	mi := &file_proto_alert_proto_msgTypes[66]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *UserHiddenAlert) GetAlertName() string {
+	if x != nil {
+		return x.AlertName
+	}
+	return ""
 }
 
-func (x *DeleteFilterPresetResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *UserHiddenAlert) GetInstance() string {
+	if x != nil {
+		return x.Instance
+	}
+	return ""
 }
 
-func (*DeleteFilterPresetResponse) ProtoMessage() {}
-
-func (x *DeleteFilterPresetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[66]
+func (x *UserHiddenAlert) GetReason() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Reason
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use DeleteFilterPresetResponse.ProtoReflect.Descriptor instead.
-func (*DeleteFilterPresetResponse) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{66}
+func (x *UserHiddenAlert) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
 }
 
-func (x *DeleteFilterPresetResponse) GetSuccess() bool {
+func (x *UserHiddenAlert) GetUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Success
+		return x.UpdatedAt
 	}
-	return false
+	return nil
 }
 
-func (x *DeleteFilterPresetResponse) GetMessage() string {
+func (x *UserHiddenAlert) GetExpiresAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Message
+		return x.ExpiresAt
 	}
-	return ""
+	return nil
 }
 
-type SetDefaultFilterPresetRequest struct {
+// User Hidden Rules Messages
+type GetUserHiddenRulesRequest struct {
 	state             protoimpl.MessageState `protogen:"open.v1"`
 	SessionId         string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	PresetId          string                 `protobuf:"bytes,2,opt,name=preset_id,json=presetId,proto3" json:"preset_id,omitempty"`
-	ImpersonateUserId string                 `protobuf:"bytes,3,opt,name=impersonate_user_id,json=impersonateUserId,proto3" json:"impersonate_user_id,omitempty"` // Optional: set default for this user instead
+	ImpersonateUserId string                 `protobuf:"bytes,2,opt,name=impersonate_user_id,json=impersonateUserId,proto3" json:"impersonate_user_id,omitempty"` // Optional: load rules for this user instead
 	unknownFields     protoimpl.UnknownFields
 	sizeCache         protoimpl.SizeCache
 }
 
-func (x *SetDefaultFilterPresetRequest) Reset() {
-	*x = SetDefaultFilterPresetRequest{}
-	mi := &file_proto_alert_proto_msgTypes[67]
+func (x *GetUserHiddenRulesRequest) Reset() {
+	*x = GetUserHiddenRulesRequest{}
+	mi := &file_proto_alert_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetDefaultFilterPresetRequest) String() string {
+func (x *GetUserHiddenRulesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetDefaultFilterPresetRequest) ProtoMessage() {}
+func (*GetUserHiddenRulesRequest) ProtoMessage() {}
 
-func (x *SetDefaultFilterPresetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[67]
+func (x *GetUserHiddenRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4406,55 +4917,49 @@ func (x *SetDefaultFilterPresetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetDefaultFilterPresetRequest.ProtoReflect.Descriptor instead.
-func (*SetDefaultFilterPresetRequest) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{67}
+// Deprecated: Use GetUserHiddenRulesRequest.ProtoReflect.Descriptor instead.
+func (*GetUserHiddenRulesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *SetDefaultFilterPresetRequest) GetSessionId() string {
+func (x *GetUserHiddenRulesRequest) GetSessionId() string {
 	if x != nil {
 		return x.SessionId
 	}
 	return ""
 }
 
-func (x *SetDefaultFilterPresetRequest) GetPresetId() string {
+func (x *GetUserHiddenRulesRequest) GetImpersonateUserId() string {
 	if x != nil {
-		return x.PresetId
+		return x.ImpersonateUserId
 	}
 	return ""
 }
 
-func (x *SetDefaultFilterPresetRequest) GetImpersonateUserId() string {
-	if x != nil {
-		return x.ImpersonateUserId
-	}
-	return ""
-}
-
-type SetDefaultFilterPresetResponse struct {
+type GetUserHiddenRulesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	HiddenRules   []*UserHiddenRule      `protobuf:"bytes,1,rep,name=hidden_rules,json=hiddenRules,proto3" json:"hidden_rules,omitempty"`
+	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SetDefaultFilterPresetResponse) Reset() {
-	*x = SetDefaultFilterPresetResponse{}
-	mi := &file_proto_alert_proto_msgTypes[68]
+func (x *GetUserHiddenRulesResponse) Reset() {
+	*x = GetUserHiddenRulesResponse{}
+	mi := &file_proto_alert_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetDefaultFilterPresetResponse) String() string {
+func (x *GetUserHiddenRulesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetDefaultFilterPresetResponse) ProtoMessage() {}
+func (*GetUserHiddenRulesResponse) ProtoMessage() {}
 
-func (x *SetDefaultFilterPresetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[68]
+func (x *GetUserHiddenRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4465,55 +4970,56 @@ func (x *SetDefaultFilterPresetResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetDefaultFilterPresetResponse.ProtoReflect.Descriptor instead.
-func (*SetDefaultFilterPresetResponse) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{68}
+// Deprecated: Use GetUserHiddenRulesResponse.ProtoReflect.Descriptor instead.
+func (*GetUserHiddenRulesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *SetDefaultFilterPresetResponse) GetSuccess() bool {
+func (x *GetUserHiddenRulesResponse) GetHiddenRules() []*UserHiddenRule {
+	if x != nil {
+		return x.HiddenRules
+	}
+	return nil
+}
+
+func (x *GetUserHiddenRulesResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *SetDefaultFilterPresetResponse) GetMessage() string {
+func (x *GetUserHiddenRulesResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-type FilterPreset struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	IsShared      bool                   `protobuf:"varint,5,opt,name=is_shared,json=isShared,proto3" json:"is_shared,omitempty"`
-	IsDefault     bool                   `protobuf:"varint,6,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
-	FilterData    []byte                 `protobuf:"bytes,7,opt,name=filter_data,json=filterData,proto3" json:"filter_data,omitempty"` // JSON serialized filter state
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type SaveHiddenRuleRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	SessionId         string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Rule              *UserHiddenRule        `protobuf:"bytes,2,opt,name=rule,proto3" json:"rule,omitempty"`
+	ImpersonateUserId string                 `protobuf:"bytes,3,opt,name=impersonate_user_id,json=impersonateUserId,proto3" json:"impersonate_user_id,omitempty"` // Optional: save rule for this user instead
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *FilterPreset) Reset() {
-	*x = FilterPreset{}
-	mi := &file_proto_alert_proto_msgTypes[69]
+func (x *SaveHiddenRuleRequest) Reset() {
+	*x = SaveHiddenRuleRequest{}
+	mi := &file_proto_alert_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FilterPreset) String() string {
+func (x *SaveHiddenRuleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FilterPreset) ProtoMessage() {}
+func (*SaveHiddenRuleRequest) ProtoMessage() {}
 
-func (x *FilterPreset) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[69]
+func (x *SaveHiddenRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4524,97 +5030,116 @@ func (x *FilterPreset) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FilterPreset.ProtoReflect.Descriptor instead.
-func (*FilterPreset) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{69}
+// Deprecated: Use SaveHiddenRuleRequest.ProtoReflect.Descriptor instead.
+func (*SaveHiddenRuleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *FilterPreset) GetId() string {
+func (x *SaveHiddenRuleRequest) GetSessionId() string {
 	if x != nil {
-		return x.Id
+		return x.SessionId
 	}
 	return ""
 }
 
-func (x *FilterPreset) GetUserId() string {
+func (x *SaveHiddenRuleRequest) GetRule() *UserHiddenRule {
 	if x != nil {
-		return x.UserId
+		return x.Rule
 	}
-	return ""
+	return nil
 }
 
-func (x *FilterPreset) GetName() string {
+func (x *SaveHiddenRuleRequest) GetImpersonateUserId() string {
 	if x != nil {
-		return x.Name
+		return x.ImpersonateUserId
 	}
 	return ""
 }
 
-func (x *FilterPreset) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
+type SaveHiddenRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Rule          *UserHiddenRule        `protobuf:"bytes,2,opt,name=rule,proto3" json:"rule,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FilterPreset) GetIsShared() bool {
-	if x != nil {
-		return x.IsShared
-	}
-	return false
+func (x *SaveHiddenRuleResponse) Reset() {
+	*x = SaveHiddenRuleResponse{}
+	mi := &file_proto_alert_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *FilterPreset) GetIsDefault() bool {
+func (x *SaveHiddenRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveHiddenRuleResponse) ProtoMessage() {}
+
+func (x *SaveHiddenRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[75]
 	if x != nil {
-		return x.IsDefault
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *FilterPreset) GetFilterData() []byte {
+// Deprecated: Use SaveHiddenRuleResponse.ProtoReflect.Descriptor instead.
+func (*SaveHiddenRuleResponse) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *SaveHiddenRuleResponse) GetSuccess() bool {
 	if x != nil {
-		return x.FilterData
+		return x.Success
 	}
-	return nil
+	return false
 }
 
-func (x *FilterPreset) GetCreatedAt() *timestamppb.Timestamp {
+func (x *SaveHiddenRuleResponse) GetRule() *UserHiddenRule {
 	if x != nil {
-		return x.CreatedAt
+		return x.Rule
 	}
 	return nil
 }
 
-func (x *FilterPreset) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *SaveHiddenRuleResponse) GetMessage() string {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Message
 	}
-	return nil
+	return ""
 }
 
-// Annotation Button Config Messages
-type GetAnnotationButtonConfigsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type RemoveHiddenRuleRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	SessionId         string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	RuleId            string                 `protobuf:"bytes,2,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	ImpersonateUserId string                 `protobuf:"bytes,3,opt,name=impersonate_user_id,json=impersonateUserId,proto3" json:"impersonate_user_id,omitempty"` // Optional: remove rule for this user instead
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *GetAnnotationButtonConfigsRequest) Reset() {
-	*x = GetAnnotationButtonConfigsRequest{}
-	mi := &file_proto_alert_proto_msgTypes[70]
+func (x *RemoveHiddenRuleRequest) Reset() {
+	*x = RemoveHiddenRuleRequest{}
+	mi := &file_proto_alert_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAnnotationButtonConfigsRequest) String() string {
+func (x *RemoveHiddenRuleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAnnotationButtonConfigsRequest) ProtoMessage() {}
+func (*RemoveHiddenRuleRequest) ProtoMessage() {}
 
-func (x *GetAnnotationButtonConfigsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[70]
+func (x *RemoveHiddenRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4625,42 +5150,55 @@ func (x *GetAnnotationButtonConfigsRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAnnotationButtonConfigsRequest.ProtoReflect.Descriptor instead.
-func (*GetAnnotationButtonConfigsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{70}
+// Deprecated: Use RemoveHiddenRuleRequest.ProtoReflect.Descriptor instead.
+func (*RemoveHiddenRuleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *GetAnnotationButtonConfigsRequest) GetSessionId() string {
+func (x *RemoveHiddenRuleRequest) GetSessionId() string {
 	if x != nil {
 		return x.SessionId
 	}
 	return ""
 }
 
-type GetAnnotationButtonConfigsResponse struct {
-	state         protoimpl.MessageState    `protogen:"open.v1"`
-	Success       bool                      `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Configs       []*AnnotationButtonConfig `protobuf:"bytes,2,rep,name=configs,proto3" json:"configs,omitempty"`
-	Message       string                    `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+func (x *RemoveHiddenRuleRequest) GetRuleId() string {
+	if x != nil {
+		return x.RuleId
+	}
+	return ""
+}
+
+func (x *RemoveHiddenRuleRequest) GetImpersonateUserId() string {
+	if x != nil {
+		return x.ImpersonateUserId
+	}
+	return ""
+}
+
+type RemoveHiddenRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAnnotationButtonConfigsResponse) Reset() {
-	*x = GetAnnotationButtonConfigsResponse{}
-	mi := &file_proto_alert_proto_msgTypes[71]
+func (x *RemoveHiddenRuleResponse) Reset() {
+	*x = RemoveHiddenRuleResponse{}
+	mi := &file_proto_alert_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAnnotationButtonConfigsResponse) String() string {
+func (x *RemoveHiddenRuleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAnnotationButtonConfigsResponse) ProtoMessage() {}
+func (*RemoveHiddenRuleResponse) ProtoMessage() {}
 
-func (x *GetAnnotationButtonConfigsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[71]
+func (x *RemoveHiddenRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4671,55 +5209,57 @@ func (x *GetAnnotationButtonConfigsResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAnnotationButtonConfigsResponse.ProtoReflect.Descriptor instead.
-func (*GetAnnotationButtonConfigsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{71}
+// Deprecated: Use RemoveHiddenRuleResponse.ProtoReflect.Descriptor instead.
+func (*RemoveHiddenRuleResponse) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{77}
 }
 
-func (x *GetAnnotationButtonConfigsResponse) GetSuccess() bool {
+func (x *RemoveHiddenRuleResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *GetAnnotationButtonConfigsResponse) GetConfigs() []*AnnotationButtonConfig {
-	if x != nil {
-		return x.Configs
-	}
-	return nil
-}
-
-func (x *GetAnnotationButtonConfigsResponse) GetMessage() string {
+func (x *RemoveHiddenRuleResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-type SaveAnnotationButtonConfigsRequest struct {
-	state         protoimpl.MessageState    `protogen:"open.v1"`
-	SessionId     string                    `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	Configs       []*AnnotationButtonConfig `protobuf:"bytes,2,rep,name=configs,proto3" json:"configs,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *SaveAnnotationButtonConfigsRequest) Reset() {
-	*x = SaveAnnotationButtonConfigsRequest{}
-	mi := &file_proto_alert_proto_msgTypes[72]
+type UserHiddenRule struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	LabelKey      string                 `protobuf:"bytes,5,opt,name=label_key,json=labelKey,proto3" json:"label_key,omitempty"`
+	LabelValue    string                 `protobuf:"bytes,6,opt,name=label_value,json=labelValue,proto3" json:"label_value,omitempty"`
+	IsRegex       bool                   `protobuf:"varint,7,opt,name=is_regex,json=isRegex,proto3" json:"is_regex,omitempty"`
+	IsEnabled     bool                   `protobuf:"varint,8,opt,name=is_enabled,json=isEnabled,proto3" json:"is_enabled,omitempty"`
+	Priority      int32                  `protobuf:"varint,9,opt,name=priority,proto3" json:"priority,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserHiddenRule) Reset() {
+	*x = UserHiddenRule{}
+	mi := &file_proto_alert_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SaveAnnotationButtonConfigsRequest) String() string {
+func (x *UserHiddenRule) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SaveAnnotationButtonConfigsRequest) ProtoMessage() {}
+func (*UserHiddenRule) ProtoMessage() {}
 
-func (x *SaveAnnotationButtonConfigsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[72]
+func (x *UserHiddenRule) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4730,100 +5270,111 @@ func (x *SaveAnnotationButtonConfigsRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SaveAnnotationButtonConfigsRequest.ProtoReflect.Descriptor instead.
-func (*SaveAnnotationButtonConfigsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{72}
+// Deprecated: Use UserHiddenRule.ProtoReflect.Descriptor instead.
+func (*UserHiddenRule) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{78}
 }
 
-func (x *SaveAnnotationButtonConfigsRequest) GetSessionId() string {
+func (x *UserHiddenRule) GetId() string {
 	if x != nil {
-		return x.SessionId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *SaveAnnotationButtonConfigsRequest) GetConfigs() []*AnnotationButtonConfig {
+func (x *UserHiddenRule) GetUserId() string {
 	if x != nil {
-		return x.Configs
+		return x.UserId
 	}
-	return nil
+	return ""
 }
 
-type SaveAnnotationButtonConfigsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *UserHiddenRule) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
 }
 
-func (x *SaveAnnotationButtonConfigsResponse) Reset() {
-	*x = SaveAnnotationButtonConfigsResponse{}
-	mi := &file_proto_alert_proto_msgTypes[73]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *UserHiddenRule) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
 }
 
-func (x *SaveAnnotationButtonConfigsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *UserHiddenRule) GetLabelKey() string {
+	if x != nil {
+		return x.LabelKey
+	}
+	return ""
 }
 
-func (*SaveAnnotationButtonConfigsResponse) ProtoMessage() {}
-
-func (x *SaveAnnotationButtonConfigsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[73]
+func (x *UserHiddenRule) GetLabelValue() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.LabelValue
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use SaveAnnotationButtonConfigsResponse.ProtoReflect.Descriptor instead.
-func (*SaveAnnotationButtonConfigsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{73}
+func (x *UserHiddenRule) GetIsRegex() bool {
+	if x != nil {
+		return x.IsRegex
+	}
+	return false
 }
 
-func (x *SaveAnnotationButtonConfigsResponse) GetSuccess() bool {
+func (x *UserHiddenRule) GetIsEnabled() bool {
 	if x != nil {
-		return x.Success
+		return x.IsEnabled
 	}
 	return false
 }
 
-func (x *SaveAnnotationButtonConfigsResponse) GetMessage() string {
+func (x *UserHiddenRule) GetPriority() int32 {
 	if x != nil {
-		return x.Message
+		return x.Priority
 	}
-	return ""
+	return 0
 }
 
-type CreateAnnotationButtonConfigRequest struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	SessionId     string                  `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	Config        *AnnotationButtonConfig `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+func (x *UserHiddenRule) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *UserHiddenRule) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// Notification Preferences Messages
+type GetNotificationPreferencesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateAnnotationButtonConfigRequest) Reset() {
-	*x = CreateAnnotationButtonConfigRequest{}
-	mi := &file_proto_alert_proto_msgTypes[74]
+func (x *GetNotificationPreferencesRequest) Reset() {
+	*x = GetNotificationPreferencesRequest{}
+	mi := &file_proto_alert_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateAnnotationButtonConfigRequest) String() string {
+func (x *GetNotificationPreferencesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateAnnotationButtonConfigRequest) ProtoMessage() {}
+func (*GetNotificationPreferencesRequest) ProtoMessage() {}
 
-func (x *CreateAnnotationButtonConfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[74]
+func (x *GetNotificationPreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4834,49 +5385,42 @@ func (x *CreateAnnotationButtonConfigRequest) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateAnnotationButtonConfigRequest.ProtoReflect.Descriptor instead.
-func (*CreateAnnotationButtonConfigRequest) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{74}
+// Deprecated: Use GetNotificationPreferencesRequest.ProtoReflect.Descriptor instead.
+func (*GetNotificationPreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *CreateAnnotationButtonConfigRequest) GetSessionId() string {
+func (x *GetNotificationPreferencesRequest) GetSessionId() string {
 	if x != nil {
 		return x.SessionId
 	}
 	return ""
 }
 
-func (x *CreateAnnotationButtonConfigRequest) GetConfig() *AnnotationButtonConfig {
-	if x != nil {
-		return x.Config
-	}
-	return nil
-}
-
-type CreateAnnotationButtonConfigResponse struct {
+type GetNotificationPreferencesResponse struct {
 	state         protoimpl.MessageState  `protogen:"open.v1"`
 	Success       bool                    `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Config        *AnnotationButtonConfig `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+	Preferences   *NotificationPreference `protobuf:"bytes,2,opt,name=preferences,proto3" json:"preferences,omitempty"`
 	Message       string                  `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateAnnotationButtonConfigResponse) Reset() {
-	*x = CreateAnnotationButtonConfigResponse{}
-	mi := &file_proto_alert_proto_msgTypes[75]
+func (x *GetNotificationPreferencesResponse) Reset() {
+	*x = GetNotificationPreferencesResponse{}
+	mi := &file_proto_alert_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateAnnotationButtonConfigResponse) String() string {
+func (x *GetNotificationPreferencesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateAnnotationButtonConfigResponse) ProtoMessage() {}
+func (*GetNotificationPreferencesResponse) ProtoMessage() {}
 
-func (x *CreateAnnotationButtonConfigResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[75]
+func (x *GetNotificationPreferencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4887,55 +5431,58 @@ func (x *CreateAnnotationButtonConfigResponse) ProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateAnnotationButtonConfigResponse.ProtoReflect.Descriptor instead.
-func (*CreateAnnotationButtonConfigResponse) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{75}
+// Deprecated: Use GetNotificationPreferencesResponse.ProtoReflect.Descriptor instead.
+func (*GetNotificationPreferencesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{80}
 }
 
-func (x *CreateAnnotationButtonConfigResponse) GetSuccess() bool {
+func (x *GetNotificationPreferencesResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *CreateAnnotationButtonConfigResponse) GetConfig() *AnnotationButtonConfig {
+func (x *GetNotificationPreferencesResponse) GetPreferences() *NotificationPreference {
 	if x != nil {
-		return x.Config
+		return x.Preferences
 	}
 	return nil
 }
 
-func (x *CreateAnnotationButtonConfigResponse) GetMessage() string {
+func (x *GetNotificationPreferencesResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-type UpdateAnnotationButtonConfigRequest struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	SessionId     string                  `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	Config        *AnnotationButtonConfig `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type SaveNotificationPreferencesRequest struct {
+	state                       protoimpl.MessageState `protogen:"open.v1"`
+	SessionId                   string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	BrowserNotificationsEnabled bool                   `protobuf:"varint,2,opt,name=browser_notifications_enabled,json=browserNotificationsEnabled,proto3" json:"browser_notifications_enabled,omitempty"`
+	EnabledSeverities           []string               `protobuf:"bytes,3,rep,name=enabled_severities,json=enabledSeverities,proto3" json:"enabled_severities,omitempty"`
+	SoundNotificationsEnabled   bool                   `protobuf:"varint,4,opt,name=sound_notifications_enabled,json=soundNotificationsEnabled,proto3" json:"sound_notifications_enabled,omitempty"`
+	Dnd                         *DoNotDisturbSchedule  `protobuf:"bytes,5,opt,name=dnd,proto3" json:"dnd,omitempty"` // Unset keeps the stored schedule
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
 }
 
-func (x *UpdateAnnotationButtonConfigRequest) Reset() {
-	*x = UpdateAnnotationButtonConfigRequest{}
-	mi := &file_proto_alert_proto_msgTypes[76]
+func (x *SaveNotificationPreferencesRequest) Reset() {
+	*x = SaveNotificationPreferencesRequest{}
+	mi := &file_proto_alert_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateAnnotationButtonConfigRequest) String() string {
+func (x *SaveNotificationPreferencesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateAnnotationButtonConfigRequest) ProtoMessage() {}
+func (*SaveNotificationPreferencesRequest) ProtoMessage() {}
 
-func (x *UpdateAnnotationButtonConfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[76]
+func (x *SaveNotificationPreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4946,49 +5493,70 @@ func (x *UpdateAnnotationButtonConfigRequest) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateAnnotationButtonConfigRequest.ProtoReflect.Descriptor instead.
-func (*UpdateAnnotationButtonConfigRequest) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{76}
+// Deprecated: Use SaveNotificationPreferencesRequest.ProtoReflect.Descriptor instead.
+func (*SaveNotificationPreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{81}
 }
 
-func (x *UpdateAnnotationButtonConfigRequest) GetSessionId() string {
+func (x *SaveNotificationPreferencesRequest) GetSessionId() string {
 	if x != nil {
 		return x.SessionId
 	}
 	return ""
 }
 
-func (x *UpdateAnnotationButtonConfigRequest) GetConfig() *AnnotationButtonConfig {
+func (x *SaveNotificationPreferencesRequest) GetBrowserNotificationsEnabled() bool {
 	if x != nil {
-		return x.Config
+		return x.BrowserNotificationsEnabled
+	}
+	return false
+}
+
+func (x *SaveNotificationPreferencesRequest) GetEnabledSeverities() []string {
+	if x != nil {
+		return x.EnabledSeverities
 	}
 	return nil
 }
 
-type UpdateAnnotationButtonConfigResponse struct {
+func (x *SaveNotificationPreferencesRequest) GetSoundNotificationsEnabled() bool {
+	if x != nil {
+		return x.SoundNotificationsEnabled
+	}
+	return false
+}
+
+func (x *SaveNotificationPreferencesRequest) GetDnd() *DoNotDisturbSchedule {
+	if x != nil {
+		return x.Dnd
+	}
+	return nil
+}
+
+type SaveNotificationPreferencesResponse struct {
 	state         protoimpl.MessageState  `protogen:"open.v1"`
 	Success       bool                    `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Config        *AnnotationButtonConfig `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+	Preferences   *NotificationPreference `protobuf:"bytes,2,opt,name=preferences,proto3" json:"preferences,omitempty"`
 	Message       string                  `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateAnnotationButtonConfigResponse) Reset() {
-	*x = UpdateAnnotationButtonConfigResponse{}
-	mi := &file_proto_alert_proto_msgTypes[77]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+func (x *SaveNotificationPreferencesResponse) Reset() {
+	*x = SaveNotificationPreferencesResponse{}
+	mi := &file_proto_alert_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateAnnotationButtonConfigResponse) String() string {
+func (x *SaveNotificationPreferencesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateAnnotationButtonConfigResponse) ProtoMessage() {}
+func (*SaveNotificationPreferencesResponse) ProtoMessage() {}
 
-func (x *UpdateAnnotationButtonConfigResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[77]
+func (x *SaveNotificationPreferencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4999,55 +5567,61 @@ func (x *UpdateAnnotationButtonConfigResponse) ProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateAnnotationButtonConfigResponse.ProtoReflect.Descriptor instead.
-func (*UpdateAnnotationButtonConfigResponse) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{77}
+// Deprecated: Use SaveNotificationPreferencesResponse.ProtoReflect.Descriptor instead.
+func (*SaveNotificationPreferencesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{82}
 }
 
-func (x *UpdateAnnotationButtonConfigResponse) GetSuccess() bool {
+func (x *SaveNotificationPreferencesResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *UpdateAnnotationButtonConfigResponse) GetConfig() *AnnotationButtonConfig {
+func (x *SaveNotificationPreferencesResponse) GetPreferences() *NotificationPreference {
 	if x != nil {
-		return x.Config
+		return x.Preferences
 	}
 	return nil
 }
 
-func (x *UpdateAnnotationButtonConfigResponse) GetMessage() string {
+func (x *SaveNotificationPreferencesResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-type DeleteAnnotationButtonConfigRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	ConfigId      string                 `protobuf:"bytes,2,opt,name=config_id,json=configId,proto3" json:"config_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type NotificationPreference struct {
+	state                       protoimpl.MessageState `protogen:"open.v1"`
+	Id                          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId                      string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	BrowserNotificationsEnabled bool                   `protobuf:"varint,3,opt,name=browser_notifications_enabled,json=browserNotificationsEnabled,proto3" json:"browser_notifications_enabled,omitempty"`
+	EnabledSeverities           []string               `protobuf:"bytes,4,rep,name=enabled_severities,json=enabledSeverities,proto3" json:"enabled_severities,omitempty"`
+	SoundNotificationsEnabled   bool                   `protobuf:"varint,5,opt,name=sound_notifications_enabled,json=soundNotificationsEnabled,proto3" json:"sound_notifications_enabled,omitempty"`
+	CreatedAt                   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt                   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Dnd                         *DoNotDisturbSchedule  `protobuf:"bytes,8,opt,name=dnd,proto3" json:"dnd,omitempty"`
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
 }
 
-func (x *DeleteAnnotationButtonConfigRequest) Reset() {
-	*x = DeleteAnnotationButtonConfigRequest{}
-	mi := &file_proto_alert_proto_msgTypes[78]
+func (x *NotificationPreference) Reset() {
+	*x = NotificationPreference{}
+	mi := &file_proto_alert_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteAnnotationButtonConfigRequest) String() string {
+func (x *NotificationPreference) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteAnnotationButtonConfigRequest) ProtoMessage() {}
+func (*NotificationPreference) ProtoMessage() {}
 
-func (x *DeleteAnnotationButtonConfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[78]
+func (x *NotificationPreference) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5058,109 +5632,96 @@ func (x *DeleteAnnotationButtonConfigRequest) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteAnnotationButtonConfigRequest.ProtoReflect.Descriptor instead.
-func (*DeleteAnnotationButtonConfigRequest) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{78}
+// Deprecated: Use NotificationPreference.ProtoReflect.Descriptor instead.
+func (*NotificationPreference) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{83}
 }
 
-func (x *DeleteAnnotationButtonConfigRequest) GetSessionId() string {
+func (x *NotificationPreference) GetId() string {
 	if x != nil {
-		return x.SessionId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *DeleteAnnotationButtonConfigRequest) GetConfigId() string {
+func (x *NotificationPreference) GetUserId() string {
 	if x != nil {
-		return x.ConfigId
+		return x.UserId
 	}
 	return ""
 }
 
-type DeleteAnnotationButtonConfigResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *DeleteAnnotationButtonConfigResponse) Reset() {
-	*x = DeleteAnnotationButtonConfigResponse{}
-	mi := &file_proto_alert_proto_msgTypes[79]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *NotificationPreference) GetBrowserNotificationsEnabled() bool {
+	if x != nil {
+		return x.BrowserNotificationsEnabled
+	}
+	return false
 }
 
-func (x *DeleteAnnotationButtonConfigResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *NotificationPreference) GetEnabledSeverities() []string {
+	if x != nil {
+		return x.EnabledSeverities
+	}
+	return nil
 }
 
-func (*DeleteAnnotationButtonConfigResponse) ProtoMessage() {}
-
-func (x *DeleteAnnotationButtonConfigResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[79]
+func (x *NotificationPreference) GetSoundNotificationsEnabled() bool {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.SoundNotificationsEnabled
 	}
-	return mi.MessageOf(x)
+	return false
 }
 
-// Deprecated: Use DeleteAnnotationButtonConfigResponse.ProtoReflect.Descriptor instead.
-func (*DeleteAnnotationButtonConfigResponse) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{79}
+func (x *NotificationPreference) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
 }
 
-func (x *DeleteAnnotationButtonConfigResponse) GetSuccess() bool {
+func (x *NotificationPreference) GetUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Success
+		return x.UpdatedAt
 	}
-	return false
+	return nil
 }
 
-func (x *DeleteAnnotationButtonConfigResponse) GetMessage() string {
+func (x *NotificationPreference) GetDnd() *DoNotDisturbSchedule {
 	if x != nil {
-		return x.Message
+		return x.Dnd
 	}
-	return ""
+	return nil
 }
 
-type AnnotationButtonConfig struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId         string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Label          string                 `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
-	AnnotationKeys []string               `protobuf:"bytes,4,rep,name=annotation_keys,json=annotationKeys,proto3" json:"annotation_keys,omitempty"` // List of annotation keys to check
-	Color          string                 `protobuf:"bytes,5,opt,name=color,proto3" json:"color,omitempty"`
-	Icon           string                 `protobuf:"bytes,6,opt,name=icon,proto3" json:"icon,omitempty"`
-	DisplayOrder   int32                  `protobuf:"varint,7,opt,name=display_order,json=displayOrder,proto3" json:"display_order,omitempty"`
-	Enabled        bool                   `protobuf:"varint,8,opt,name=enabled,proto3" json:"enabled,omitempty"`
-	ButtonType     string                 `protobuf:"bytes,9,opt,name=button_type,json=buttonType,proto3" json:"button_type,omitempty"` // "default" or "custom"
-	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+// Notifications are muted on each day (0 = Sunday) from start_time to
+// end_time ("HH:MM" in timezone); an end at or before the start ends the next day
+type DoNotDisturbSchedule struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Enabled       bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Days          []int32                `protobuf:"varint,2,rep,packed,name=days,proto3" json:"days,omitempty"`
+	StartTime     string                 `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime       string                 `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Timezone      string                 `protobuf:"bytes,5,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	AllowCritical bool                   `protobuf:"varint,6,opt,name=allow_critical,json=allowCritical,proto3" json:"allow_critical,omitempty"` // Critical alerts still notify during the window
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AnnotationButtonConfig) Reset() {
-	*x = AnnotationButtonConfig{}
-	mi := &file_proto_alert_proto_msgTypes[80]
+func (x *DoNotDisturbSchedule) Reset() {
+	*x = DoNotDisturbSchedule{}
+	mi := &file_proto_alert_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AnnotationButtonConfig) String() string {
+func (x *DoNotDisturbSchedule) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AnnotationButtonConfig) ProtoMessage() {}
+func (*DoNotDisturbSchedule) ProtoMessage() {}
 
-func (x *AnnotationButtonConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[80]
+func (x *DoNotDisturbSchedule) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5171,127 +5732,78 @@ func (x *AnnotationButtonConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AnnotationButtonConfig.ProtoReflect.Descriptor instead.
-func (*AnnotationButtonConfig) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{80}
-}
-
-func (x *AnnotationButtonConfig) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *AnnotationButtonConfig) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
+// Deprecated: Use DoNotDisturbSchedule.ProtoReflect.Descriptor instead.
+func (*DoNotDisturbSchedule) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{84}
 }
 
-func (x *AnnotationButtonConfig) GetLabel() string {
+func (x *DoNotDisturbSchedule) GetEnabled() bool {
 	if x != nil {
-		return x.Label
+		return x.Enabled
 	}
-	return ""
+	return false
 }
 
-func (x *AnnotationButtonConfig) GetAnnotationKeys() []string {
+func (x *DoNotDisturbSchedule) GetDays() []int32 {
 	if x != nil {
-		return x.AnnotationKeys
+		return x.Days
 	}
 	return nil
 }
 
-func (x *AnnotationButtonConfig) GetColor() string {
+func (x *DoNotDisturbSchedule) GetStartTime() string {
 	if x != nil {
-		return x.Color
+		return x.StartTime
 	}
 	return ""
 }
 
-func (x *AnnotationButtonConfig) GetIcon() string {
+func (x *DoNotDisturbSchedule) GetEndTime() string {
 	if x != nil {
-		return x.Icon
+		return x.EndTime
 	}
 	return ""
 }
 
-func (x *AnnotationButtonConfig) GetDisplayOrder() int32 {
-	if x != nil {
-		return x.DisplayOrder
-	}
-	return 0
-}
-
-func (x *AnnotationButtonConfig) GetEnabled() bool {
-	if x != nil {
-		return x.Enabled
-	}
-	return false
-}
-
-func (x *AnnotationButtonConfig) GetButtonType() string {
+func (x *DoNotDisturbSchedule) GetTimezone() string {
 	if x != nil {
-		return x.ButtonType
+		return x.Timezone
 	}
 	return ""
 }
 
-func (x *AnnotationButtonConfig) GetCreatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return nil
-}
-
-func (x *AnnotationButtonConfig) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *DoNotDisturbSchedule) GetAllowCritical() bool {
 	if x != nil {
-		return x.UpdatedAt
+		return x.AllowCritical
 	}
-	return nil
+	return false
 }
 
-type QueryStatisticsRequest struct {
+// Filter Presets Messages
+type GetFilterPresetsRequest struct {
 	state             protoimpl.MessageState `protogen:"open.v1"`
 	SessionId         string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	StartDate         *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
-	EndDate           *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
-	ApplyRules        bool                   `protobuf:"varint,4,opt,name=apply_rules,json=applyRules,proto3" json:"apply_rules,omitempty"`                            // Apply user's on-call rules
-	GroupBy           string                 `protobuf:"bytes,5,opt,name=group_by,json=groupBy,proto3" json:"group_by,omitempty"`                                      // "severity", "team", "period", "alert_name", or empty for overall
-	PeriodType        string                 `protobuf:"bytes,6,opt,name=period_type,json=periodType,proto3" json:"period_type,omitempty"`                             // "hour", "day", "week", "month" (required if group_by="period")
-	Limit             int32                  `protobuf:"varint,7,opt,name=limit,proto3" json:"limit,omitempty"`                                                        // Limit results (for alert_name grouping)
-	Offset            int32                  `protobuf:"varint,8,opt,name=offset,proto3" json:"offset,omitempty"`                                                      // Pagination offset
-	FilterByTimeOfDay bool                   `protobuf:"varint,9,opt,name=filter_by_time_of_day,json=filterByTimeOfDay,proto3" json:"filter_by_time_of_day,omitempty"` // Enable time-of-day filtering
-	TimeOfDayStart    string                 `protobuf:"bytes,10,opt,name=time_of_day_start,json=timeOfDayStart,proto3" json:"time_of_day_start,omitempty"`            // "HH:MM" format (e.g., "22:00")
-	TimeOfDayEnd      string                 `protobuf:"bytes,11,opt,name=time_of_day_end,json=timeOfDayEnd,proto3" json:"time_of_day_end,omitempty"`                  // "HH:MM" format (e.g., "06:00") - supports cross-midnight
-	IncludeWeekends   bool                   `protobuf:"varint,12,opt,name=include_weekends,json=includeWeekends,proto3" json:"include_weekends,omitempty"`            // Include weekends in time-of-day filter (default: true)
-	SecondaryGroupBy  string                 `protobuf:"bytes,13,opt,name=secondary_group_by,json=secondaryGroupBy,proto3" json:"secondary_group_by,omitempty"`        // For period grouping: sub-group breakdown by "severity", "team", "alert_name"
-	Severities        []string               `protobuf:"bytes,14,rep,name=severities,proto3" json:"severities,omitempty"`                                              // Filter by severities (multi-select, OR logic)
-	Teams             []string               `protobuf:"bytes,15,rep,name=teams,proto3" json:"teams,omitempty"`                                                        // Filter by teams (multi-select, OR logic)
-	WeekendMode       string                 `protobuf:"bytes,16,opt,name=weekend_mode,json=weekendMode,proto3" json:"weekend_mode,omitempty"`                         // "exclude", "same_hours", "full_weekends"
-	IncludeSilenced   bool                   `protobuf:"varint,17,opt,name=include_silenced,json=includeSilenced,proto3" json:"include_silenced,omitempty"`            // Include alerts silenced at fire time (default: false)
-	Timezone          string                 `protobuf:"bytes,18,opt,name=timezone,proto3" json:"timezone,omitempty"`                                                  // IANA timezone for time-of-day/weekend/period bucketing (e.g. "Europe/Paris"); empty = UTC
+	IncludeShared     bool                   `protobuf:"varint,2,opt,name=include_shared,json=includeShared,proto3" json:"include_shared,omitempty"`
+	ImpersonateUserId string                 `protobuf:"bytes,3,opt,name=impersonate_user_id,json=impersonateUserId,proto3" json:"impersonate_user_id,omitempty"` // Optional: load presets for this user instead
 	unknownFields     protoimpl.UnknownFields
 	sizeCache         protoimpl.SizeCache
 }
 
-func (x *QueryStatisticsRequest) Reset() {
-	*x = QueryStatisticsRequest{}
-	mi := &file_proto_alert_proto_msgTypes[81]
+func (x *GetFilterPresetsRequest) Reset() {
+	*x = GetFilterPresetsRequest{}
+	mi := &file_proto_alert_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *QueryStatisticsRequest) String() string {
+func (x *GetFilterPresetsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryStatisticsRequest) ProtoMessage() {}
+func (*GetFilterPresetsRequest) ProtoMessage() {}
 
-func (x *QueryStatisticsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[81]
+func (x *GetFilterPresetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5302,164 +5814,119 @@ func (x *QueryStatisticsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QueryStatisticsRequest.ProtoReflect.Descriptor instead.
-func (*QueryStatisticsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{81}
+// Deprecated: Use GetFilterPresetsRequest.ProtoReflect.Descriptor instead.
+func (*GetFilterPresetsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{85}
 }
 
-func (x *QueryStatisticsRequest) GetSessionId() string {
+func (x *GetFilterPresetsRequest) GetSessionId() string {
 	if x != nil {
 		return x.SessionId
 	}
 	return ""
 }
 
-func (x *QueryStatisticsRequest) GetStartDate() *timestamppb.Timestamp {
-	if x != nil {
-		return x.StartDate
-	}
-	return nil
-}
-
-func (x *QueryStatisticsRequest) GetEndDate() *timestamppb.Timestamp {
-	if x != nil {
-		return x.EndDate
-	}
-	return nil
-}
-
-func (x *QueryStatisticsRequest) GetApplyRules() bool {
+func (x *GetFilterPresetsRequest) GetIncludeShared() bool {
 	if x != nil {
-		return x.ApplyRules
+		return x.IncludeShared
 	}
 	return false
 }
 
-func (x *QueryStatisticsRequest) GetGroupBy() string {
+func (x *GetFilterPresetsRequest) GetImpersonateUserId() string {
 	if x != nil {
-		return x.GroupBy
+		return x.ImpersonateUserId
 	}
 	return ""
 }
 
-func (x *QueryStatisticsRequest) GetPeriodType() string {
-	if x != nil {
-		return x.PeriodType
-	}
-	return ""
+type GetFilterPresetsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Presets       []*FilterPreset        `protobuf:"bytes,2,rep,name=presets,proto3" json:"presets,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *QueryStatisticsRequest) GetLimit() int32 {
-	if x != nil {
-		return x.Limit
-	}
-	return 0
+func (x *GetFilterPresetsResponse) Reset() {
+	*x = GetFilterPresetsResponse{}
+	mi := &file_proto_alert_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *QueryStatisticsRequest) GetOffset() int32 {
-	if x != nil {
-		return x.Offset
-	}
-	return 0
+func (x *GetFilterPresetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *QueryStatisticsRequest) GetFilterByTimeOfDay() bool {
-	if x != nil {
-		return x.FilterByTimeOfDay
-	}
-	return false
-}
+func (*GetFilterPresetsResponse) ProtoMessage() {}
 
-func (x *QueryStatisticsRequest) GetTimeOfDayStart() string {
+func (x *GetFilterPresetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[86]
 	if x != nil {
-		return x.TimeOfDayStart
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *QueryStatisticsRequest) GetTimeOfDayEnd() string {
-	if x != nil {
-		return x.TimeOfDayEnd
-	}
-	return ""
+// Deprecated: Use GetFilterPresetsResponse.ProtoReflect.Descriptor instead.
+func (*GetFilterPresetsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{86}
 }
 
-func (x *QueryStatisticsRequest) GetIncludeWeekends() bool {
+func (x *GetFilterPresetsResponse) GetSuccess() bool {
 	if x != nil {
-		return x.IncludeWeekends
+		return x.Success
 	}
 	return false
 }
 
-func (x *QueryStatisticsRequest) GetSecondaryGroupBy() string {
-	if x != nil {
-		return x.SecondaryGroupBy
-	}
-	return ""
-}
-
-func (x *QueryStatisticsRequest) GetSeverities() []string {
-	if x != nil {
-		return x.Severities
-	}
-	return nil
-}
-
-func (x *QueryStatisticsRequest) GetTeams() []string {
+func (x *GetFilterPresetsResponse) GetPresets() []*FilterPreset {
 	if x != nil {
-		return x.Teams
+		return x.Presets
 	}
 	return nil
 }
 
-func (x *QueryStatisticsRequest) GetWeekendMode() string {
-	if x != nil {
-		return x.WeekendMode
-	}
-	return ""
-}
-
-func (x *QueryStatisticsRequest) GetIncludeSilenced() bool {
-	if x != nil {
-		return x.IncludeSilenced
-	}
-	return false
-}
-
-func (x *QueryStatisticsRequest) GetTimezone() string {
+func (x *GetFilterPresetsResponse) GetMessage() string {
 	if x != nil {
-		return x.Timezone
+		return x.Message
 	}
 	return ""
 }
 
-type QueryStatisticsResponse struct {
-	state         protoimpl.MessageState           `protogen:"open.v1"`
-	Success       bool                             `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	TimeRange     *TimeRange                       `protobuf:"bytes,2,opt,name=time_range,json=timeRange,proto3" json:"time_range,omitempty"`
-	TotalAlerts   int64                            `protobuf:"varint,3,opt,name=total_alerts,json=totalAlerts,proto3" json:"total_alerts,omitempty"`
-	Statistics    map[string]*AggregatedStatistics `protobuf:"bytes,4,rep,name=statistics,proto3" json:"statistics,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Key varies by group_by
-	Breakdown     []*BreakdownItem                 `protobuf:"bytes,5,rep,name=breakdown,proto3" json:"breakdown,omitempty"`                                                                             // Used for period grouping
-	Message       string                           `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type SaveFilterPresetRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	SessionId         string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Name              string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description       string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	IsShared          bool                   `protobuf:"varint,4,opt,name=is_shared,json=isShared,proto3" json:"is_shared,omitempty"`
+	FilterData        []byte                 `protobuf:"bytes,5,opt,name=filter_data,json=filterData,proto3" json:"filter_data,omitempty"`                        // JSON serialized filter state
+	ImpersonateUserId string                 `protobuf:"bytes,6,opt,name=impersonate_user_id,json=impersonateUserId,proto3" json:"impersonate_user_id,omitempty"` // Optional: save preset for this user instead
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *QueryStatisticsResponse) Reset() {
-	*x = QueryStatisticsResponse{}
-	mi := &file_proto_alert_proto_msgTypes[82]
+func (x *SaveFilterPresetRequest) Reset() {
+	*x = SaveFilterPresetRequest{}
+	mi := &file_proto_alert_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *QueryStatisticsResponse) String() string {
+func (x *SaveFilterPresetRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryStatisticsResponse) ProtoMessage() {}
+func (*SaveFilterPresetRequest) ProtoMessage() {}
 
-func (x *QueryStatisticsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[82]
+func (x *SaveFilterPresetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5470,76 +5937,77 @@ func (x *QueryStatisticsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QueryStatisticsResponse.ProtoReflect.Descriptor instead.
-func (*QueryStatisticsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{82}
+// Deprecated: Use SaveFilterPresetRequest.ProtoReflect.Descriptor instead.
+func (*SaveFilterPresetRequest) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{87}
 }
 
-func (x *QueryStatisticsResponse) GetSuccess() bool {
+func (x *SaveFilterPresetRequest) GetSessionId() string {
 	if x != nil {
-		return x.Success
+		return x.SessionId
 	}
-	return false
+	return ""
 }
 
-func (x *QueryStatisticsResponse) GetTimeRange() *TimeRange {
+func (x *SaveFilterPresetRequest) GetName() string {
 	if x != nil {
-		return x.TimeRange
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *QueryStatisticsResponse) GetTotalAlerts() int64 {
+func (x *SaveFilterPresetRequest) GetDescription() string {
 	if x != nil {
-		return x.TotalAlerts
+		return x.Description
 	}
-	return 0
+	return ""
 }
 
-func (x *QueryStatisticsResponse) GetStatistics() map[string]*AggregatedStatistics {
+func (x *SaveFilterPresetRequest) GetIsShared() bool {
 	if x != nil {
-		return x.Statistics
+		return x.IsShared
 	}
-	return nil
+	return false
 }
 
-func (x *QueryStatisticsResponse) GetBreakdown() []*BreakdownItem {
+func (x *SaveFilterPresetRequest) GetFilterData() []byte {
 	if x != nil {
-		return x.Breakdown
+		return x.FilterData
 	}
 	return nil
 }
 
-func (x *QueryStatisticsResponse) GetMessage() string {
+func (x *SaveFilterPresetRequest) GetImpersonateUserId() string {
 	if x != nil {
-		return x.Message
+		return x.ImpersonateUserId
 	}
 	return ""
 }
 
-type TimeRange struct {
+type SaveFilterPresetResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Start         *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
-	End           *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Preset        *FilterPreset          `protobuf:"bytes,2,opt,name=preset,proto3" json:"preset,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TimeRange) Reset() {
-	*x = TimeRange{}
-	mi := &file_proto_alert_proto_msgTypes[83]
+func (x *SaveFilterPresetResponse) Reset() {
+	*x = SaveFilterPresetResponse{}
+	mi := &file_proto_alert_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TimeRange) String() string {
+func (x *SaveFilterPresetResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TimeRange) ProtoMessage() {}
+func (*SaveFilterPresetResponse) ProtoMessage() {}
 
-func (x *TimeRange) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[83]
+func (x *SaveFilterPresetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5550,51 +6018,60 @@ func (x *TimeRange) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TimeRange.ProtoReflect.Descriptor instead.
-func (*TimeRange) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{83}
+// Deprecated: Use SaveFilterPresetResponse.ProtoReflect.Descriptor instead.
+func (*SaveFilterPresetResponse) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{88}
 }
 
-func (x *TimeRange) GetStart() *timestamppb.Timestamp {
+func (x *SaveFilterPresetResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Start
+		return x.Success
 	}
-	return nil
+	return false
 }
 
-func (x *TimeRange) GetEnd() *timestamppb.Timestamp {
+func (x *SaveFilterPresetResponse) GetPreset() *FilterPreset {
 	if x != nil {
-		return x.End
+		return x.Preset
 	}
 	return nil
 }
 
-type AggregatedStatistics struct {
+func (x *SaveFilterPresetResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type UpdateFilterPresetRequest struct {
 	state             protoimpl.MessageState `protogen:"open.v1"`
-	Count             int32                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
-	AvgMttrSeconds    float64                `protobuf:"fixed64,2,opt,name=avg_mttr_seconds,json=avgMttrSeconds,proto3" json:"avg_mttr_seconds,omitempty"` // Mean Time To Resolve (resolved - fired)
-	TotalMttrSeconds  int32                  `protobuf:"varint,3,opt,name=total_mttr_seconds,json=totalMttrSeconds,proto3" json:"total_mttr_seconds,omitempty"`
-	AvgMttaSeconds    float64                `protobuf:"fixed64,4,opt,name=avg_mtta_seconds,json=avgMttaSeconds,proto3" json:"avg_mtta_seconds,omitempty"`            // Mean Time To Acknowledge (acknowledged - fired)
-	AvgFixTimeSeconds float64                `protobuf:"fixed64,5,opt,name=avg_fix_time_seconds,json=avgFixTimeSeconds,proto3" json:"avg_fix_time_seconds,omitempty"` // Fix Time (resolved - acknowledged)
+	SessionId         string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	PresetId          string                 `protobuf:"bytes,2,opt,name=preset_id,json=presetId,proto3" json:"preset_id,omitempty"`
+	Name              string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description       string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	IsShared          bool                   `protobuf:"varint,5,opt,name=is_shared,json=isShared,proto3" json:"is_shared,omitempty"`
+	FilterData        []byte                 `protobuf:"bytes,6,opt,name=filter_data,json=filterData,proto3" json:"filter_data,omitempty"`                        // JSON serialized filter state
+	ImpersonateUserId string                 `protobuf:"bytes,7,opt,name=impersonate_user_id,json=impersonateUserId,proto3" json:"impersonate_user_id,omitempty"` // Optional: update preset for this user instead
 	unknownFields     protoimpl.UnknownFields
 	sizeCache         protoimpl.SizeCache
 }
 
-func (x *AggregatedStatistics) Reset() {
-	*x = AggregatedStatistics{}
-	mi := &file_proto_alert_proto_msgTypes[84]
+func (x *UpdateFilterPresetRequest) Reset() {
+	*x = UpdateFilterPresetRequest{}
+	mi := &file_proto_alert_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AggregatedStatistics) String() string {
+func (x *UpdateFilterPresetRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AggregatedStatistics) ProtoMessage() {}
+func (*UpdateFilterPresetRequest) ProtoMessage() {}
 
-func (x *AggregatedStatistics) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[84]
+func (x *UpdateFilterPresetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5605,72 +6082,84 @@ func (x *AggregatedStatistics) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AggregatedStatistics.ProtoReflect.Descriptor instead.
-func (*AggregatedStatistics) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{84}
+// Deprecated: Use UpdateFilterPresetRequest.ProtoReflect.Descriptor instead.
+func (*UpdateFilterPresetRequest) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{89}
 }
 
-func (x *AggregatedStatistics) GetCount() int32 {
+func (x *UpdateFilterPresetRequest) GetSessionId() string {
 	if x != nil {
-		return x.Count
+		return x.SessionId
 	}
-	return 0
+	return ""
 }
 
-func (x *AggregatedStatistics) GetAvgMttrSeconds() float64 {
+func (x *UpdateFilterPresetRequest) GetPresetId() string {
 	if x != nil {
-		return x.AvgMttrSeconds
+		return x.PresetId
 	}
-	return 0
+	return ""
 }
 
-func (x *AggregatedStatistics) GetTotalMttrSeconds() int32 {
+func (x *UpdateFilterPresetRequest) GetName() string {
 	if x != nil {
-		return x.TotalMttrSeconds
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *AggregatedStatistics) GetAvgMttaSeconds() float64 {
+func (x *UpdateFilterPresetRequest) GetDescription() string {
 	if x != nil {
-		return x.AvgMttaSeconds
+		return x.Description
 	}
-	return 0
+	return ""
 }
 
-func (x *AggregatedStatistics) GetAvgFixTimeSeconds() float64 {
+func (x *UpdateFilterPresetRequest) GetIsShared() bool {
 	if x != nil {
-		return x.AvgFixTimeSeconds
+		return x.IsShared
 	}
-	return 0
+	return false
 }
 
-type BreakdownItem struct {
-	state         protoimpl.MessageState           `protogen:"open.v1"`
-	Period        string                           `protobuf:"bytes,1,opt,name=period,proto3" json:"period,omitempty"` // e.g., "2025-10-22", "2025-W05", "2025-10-22T14:00"
-	StartTime     *timestamppb.Timestamp           `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
-	EndTime       *timestamppb.Timestamp           `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
-	TotalCount    int32                            `protobuf:"varint,4,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
-	Statistics    map[string]*AggregatedStatistics `protobuf:"bytes,5,rep,name=statistics,proto3" json:"statistics,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Breakdown by secondary_group_by within period (default: severity)
+func (x *UpdateFilterPresetRequest) GetFilterData() []byte {
+	if x != nil {
+		return x.FilterData
+	}
+	return nil
+}
+
+func (x *UpdateFilterPresetRequest) GetImpersonateUserId() string {
+	if x != nil {
+		return x.ImpersonateUserId
+	}
+	return ""
+}
+
+type UpdateFilterPresetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Preset        *FilterPreset          `protobuf:"bytes,2,opt,name=preset,proto3" json:"preset,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BreakdownItem) Reset() {
-	*x = BreakdownItem{}
-	mi := &file_proto_alert_proto_msgTypes[85]
+func (x *UpdateFilterPresetResponse) Reset() {
+	*x = UpdateFilterPresetResponse{}
+	mi := &file_proto_alert_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BreakdownItem) String() string {
+func (x *UpdateFilterPresetResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BreakdownItem) ProtoMessage() {}
+func (*UpdateFilterPresetResponse) ProtoMessage() {}
 
-func (x *BreakdownItem) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[85]
+func (x *UpdateFilterPresetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5681,74 +6170,56 @@ func (x *BreakdownItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BreakdownItem.ProtoReflect.Descriptor instead.
-func (*BreakdownItem) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{85}
-}
-
-func (x *BreakdownItem) GetPeriod() string {
-	if x != nil {
-		return x.Period
-	}
-	return ""
+// Deprecated: Use UpdateFilterPresetResponse.ProtoReflect.Descriptor instead.
+func (*UpdateFilterPresetResponse) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{90}
 }
 
-func (x *BreakdownItem) GetStartTime() *timestamppb.Timestamp {
+func (x *UpdateFilterPresetResponse) GetSuccess() bool {
 	if x != nil {
-		return x.StartTime
+		return x.Success
 	}
-	return nil
+	return false
 }
 
-func (x *BreakdownItem) GetEndTime() *timestamppb.Timestamp {
+func (x *UpdateFilterPresetResponse) GetPreset() *FilterPreset {
 	if x != nil {
-		return x.EndTime
+		return x.Preset
 	}
 	return nil
 }
 
-func (x *BreakdownItem) GetTotalCount() int32 {
-	if x != nil {
-		return x.TotalCount
-	}
-	return 0
-}
-
-func (x *BreakdownItem) GetStatistics() map[string]*AggregatedStatistics {
+func (x *UpdateFilterPresetResponse) GetMessage() string {
 	if x != nil {
-		return x.Statistics
+		return x.Message
 	}
-	return nil
+	return ""
 }
 
-type QueryHeatmapRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	SessionId       string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	StartDate       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
-	EndDate         *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
-	Severities      []string               `protobuf:"bytes,4,rep,name=severities,proto3" json:"severities,omitempty"`
-	Teams           []string               `protobuf:"bytes,5,rep,name=teams,proto3" json:"teams,omitempty"`
-	IncludeSilenced bool                   `protobuf:"varint,6,opt,name=include_silenced,json=includeSilenced,proto3" json:"include_silenced,omitempty"`
-	Timezone        string                 `protobuf:"bytes,7,opt,name=timezone,proto3" json:"timezone,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type DeleteFilterPresetRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	SessionId         string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	PresetId          string                 `protobuf:"bytes,2,opt,name=preset_id,json=presetId,proto3" json:"preset_id,omitempty"`
+	ImpersonateUserId string                 `protobuf:"bytes,3,opt,name=impersonate_user_id,json=impersonateUserId,proto3" json:"impersonate_user_id,omitempty"` // Optional: delete preset for this user instead
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *QueryHeatmapRequest) Reset() {
-	*x = QueryHeatmapRequest{}
-	mi := &file_proto_alert_proto_msgTypes[86]
+func (x *DeleteFilterPresetRequest) Reset() {
+	*x = DeleteFilterPresetRequest{}
+	mi := &file_proto_alert_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *QueryHeatmapRequest) String() string {
+func (x *DeleteFilterPresetRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryHeatmapRequest) ProtoMessage() {}
+func (*DeleteFilterPresetRequest) ProtoMessage() {}
 
-func (x *QueryHeatmapRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[86]
+func (x *DeleteFilterPresetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5759,85 +6230,108 @@ func (x *QueryHeatmapRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QueryHeatmapRequest.ProtoReflect.Descriptor instead.
-func (*QueryHeatmapRequest) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{86}
+// Deprecated: Use DeleteFilterPresetRequest.ProtoReflect.Descriptor instead.
+func (*DeleteFilterPresetRequest) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{91}
 }
 
-func (x *QueryHeatmapRequest) GetSessionId() string {
+func (x *DeleteFilterPresetRequest) GetSessionId() string {
 	if x != nil {
 		return x.SessionId
 	}
 	return ""
 }
 
-func (x *QueryHeatmapRequest) GetStartDate() *timestamppb.Timestamp {
+func (x *DeleteFilterPresetRequest) GetPresetId() string {
 	if x != nil {
-		return x.StartDate
+		return x.PresetId
 	}
-	return nil
+	return ""
 }
 
-func (x *QueryHeatmapRequest) GetEndDate() *timestamppb.Timestamp {
+func (x *DeleteFilterPresetRequest) GetImpersonateUserId() string {
 	if x != nil {
-		return x.EndDate
+		return x.ImpersonateUserId
 	}
-	return nil
+	return ""
 }
 
-func (x *QueryHeatmapRequest) GetSeverities() []string {
-	if x != nil {
-		return x.Severities
-	}
-	return nil
+type DeleteFilterPresetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *QueryHeatmapRequest) GetTeams() []string {
+func (x *DeleteFilterPresetResponse) Reset() {
+	*x = DeleteFilterPresetResponse{}
+	mi := &file_proto_alert_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteFilterPresetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFilterPresetResponse) ProtoMessage() {}
+
+func (x *DeleteFilterPresetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[92]
 	if x != nil {
-		return x.Teams
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *QueryHeatmapRequest) GetIncludeSilenced() bool {
+// Deprecated: Use DeleteFilterPresetResponse.ProtoReflect.Descriptor instead.
+func (*DeleteFilterPresetResponse) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *DeleteFilterPresetResponse) GetSuccess() bool {
 	if x != nil {
-		return x.IncludeSilenced
+		return x.Success
 	}
 	return false
 }
 
-func (x *QueryHeatmapRequest) GetTimezone() string {
+func (x *DeleteFilterPresetResponse) GetMessage() string {
 	if x != nil {
-		return x.Timezone
+		return x.Message
 	}
 	return ""
 }
 
-type HeatmapCell struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Dow            int32                  `protobuf:"varint,1,opt,name=dow,proto3" json:"dow,omitempty"`   // 0=Sunday .. 6=Saturday (PostgreSQL EXTRACT(DOW))
-	Hour           int32                  `protobuf:"varint,2,opt,name=hour,proto3" json:"hour,omitempty"` // 0..23
-	Count          int64                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
-	AvgMttrSeconds float64                `protobuf:"fixed64,4,opt,name=avg_mttr_seconds,json=avgMttrSeconds,proto3" json:"avg_mttr_seconds,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type SetDefaultFilterPresetRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	SessionId         string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	PresetId          string                 `protobuf:"bytes,2,opt,name=preset_id,json=presetId,proto3" json:"preset_id,omitempty"`
+	ImpersonateUserId string                 `protobuf:"bytes,3,opt,name=impersonate_user_id,json=impersonateUserId,proto3" json:"impersonate_user_id,omitempty"` // Optional: set default for this user instead
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *HeatmapCell) Reset() {
-	*x = HeatmapCell{}
-	mi := &file_proto_alert_proto_msgTypes[87]
+func (x *SetDefaultFilterPresetRequest) Reset() {
+	*x = SetDefaultFilterPresetRequest{}
+	mi := &file_proto_alert_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *HeatmapCell) String() string {
+func (x *SetDefaultFilterPresetRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HeatmapCell) ProtoMessage() {}
+func (*SetDefaultFilterPresetRequest) ProtoMessage() {}
 
-func (x *HeatmapCell) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[87]
+func (x *SetDefaultFilterPresetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5848,63 +6342,55 @@ func (x *HeatmapCell) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HeatmapCell.ProtoReflect.Descriptor instead.
-func (*HeatmapCell) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{87}
+// Deprecated: Use SetDefaultFilterPresetRequest.ProtoReflect.Descriptor instead.
+func (*SetDefaultFilterPresetRequest) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{93}
 }
 
-func (x *HeatmapCell) GetDow() int32 {
+func (x *SetDefaultFilterPresetRequest) GetSessionId() string {
 	if x != nil {
-		return x.Dow
-	}
-	return 0
-}
-
-func (x *HeatmapCell) GetHour() int32 {
-	if x != nil {
-		return x.Hour
+		return x.SessionId
 	}
-	return 0
+	return ""
 }
 
-func (x *HeatmapCell) GetCount() int64 {
+func (x *SetDefaultFilterPresetRequest) GetPresetId() string {
 	if x != nil {
-		return x.Count
+		return x.PresetId
 	}
-	return 0
+	return ""
 }
 
-func (x *HeatmapCell) GetAvgMttrSeconds() float64 {
+func (x *SetDefaultFilterPresetRequest) GetImpersonateUserId() string {
 	if x != nil {
-		return x.AvgMttrSeconds
+		return x.ImpersonateUserId
 	}
-	return 0
+	return ""
 }
 
-type QueryHeatmapResponse struct {
+type SetDefaultFilterPresetResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Cells         []*HeatmapCell         `protobuf:"bytes,3,rep,name=cells,proto3" json:"cells,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *QueryHeatmapResponse) Reset() {
-	*x = QueryHeatmapResponse{}
-	mi := &file_proto_alert_proto_msgTypes[88]
+func (x *SetDefaultFilterPresetResponse) Reset() {
+	*x = SetDefaultFilterPresetResponse{}
+	mi := &file_proto_alert_proto_msgTypes[94]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *QueryHeatmapResponse) String() string {
+func (x *SetDefaultFilterPresetResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryHeatmapResponse) ProtoMessage() {}
+func (*SetDefaultFilterPresetResponse) ProtoMessage() {}
 
-func (x *QueryHeatmapResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[88]
+func (x *SetDefaultFilterPresetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[94]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5915,62 +6401,55 @@ func (x *QueryHeatmapResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QueryHeatmapResponse.ProtoReflect.Descriptor instead.
-func (*QueryHeatmapResponse) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{88}
+// Deprecated: Use SetDefaultFilterPresetResponse.ProtoReflect.Descriptor instead.
+func (*SetDefaultFilterPresetResponse) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{94}
 }
 
-func (x *QueryHeatmapResponse) GetSuccess() bool {
+func (x *SetDefaultFilterPresetResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *QueryHeatmapResponse) GetMessage() string {
+func (x *SetDefaultFilterPresetResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *QueryHeatmapResponse) GetCells() []*HeatmapCell {
-	if x != nil {
-		return x.Cells
-	}
-	return nil
-}
-
-type QueryFlappingAlertsRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	SessionId       string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	StartDate       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
-	EndDate         *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
-	Severities      []string               `protobuf:"bytes,4,rep,name=severities,proto3" json:"severities,omitempty"`
-	Teams           []string               `protobuf:"bytes,5,rep,name=teams,proto3" json:"teams,omitempty"`
-	IncludeSilenced bool                   `protobuf:"varint,6,opt,name=include_silenced,json=includeSilenced,proto3" json:"include_silenced,omitempty"`
-	MinFires        int32                  `protobuf:"varint,7,opt,name=min_fires,json=minFires,proto3" json:"min_fires,omitempty"`
-	Limit           int32                  `protobuf:"varint,8,opt,name=limit,proto3" json:"limit,omitempty"`
-	Timezone        string                 `protobuf:"bytes,9,opt,name=timezone,proto3" json:"timezone,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type FilterPreset struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	IsShared      bool                   `protobuf:"varint,5,opt,name=is_shared,json=isShared,proto3" json:"is_shared,omitempty"`
+	IsDefault     bool                   `protobuf:"varint,6,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
+	FilterData    []byte                 `protobuf:"bytes,7,opt,name=filter_data,json=filterData,proto3" json:"filter_data,omitempty"` // JSON serialized filter state
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *QueryFlappingAlertsRequest) Reset() {
-	*x = QueryFlappingAlertsRequest{}
-	mi := &file_proto_alert_proto_msgTypes[89]
+func (x *FilterPreset) Reset() {
+	*x = FilterPreset{}
+	mi := &file_proto_alert_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *QueryFlappingAlertsRequest) String() string {
+func (x *FilterPreset) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryFlappingAlertsRequest) ProtoMessage() {}
+func (*FilterPreset) ProtoMessage() {}
 
-func (x *QueryFlappingAlertsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[89]
+func (x *FilterPreset) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5981,104 +6460,97 @@ func (x *QueryFlappingAlertsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QueryFlappingAlertsRequest.ProtoReflect.Descriptor instead.
-func (*QueryFlappingAlertsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{89}
+// Deprecated: Use FilterPreset.ProtoReflect.Descriptor instead.
+func (*FilterPreset) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{95}
 }
 
-func (x *QueryFlappingAlertsRequest) GetSessionId() string {
+func (x *FilterPreset) GetId() string {
 	if x != nil {
-		return x.SessionId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *QueryFlappingAlertsRequest) GetStartDate() *timestamppb.Timestamp {
+func (x *FilterPreset) GetUserId() string {
 	if x != nil {
-		return x.StartDate
+		return x.UserId
 	}
-	return nil
+	return ""
 }
 
-func (x *QueryFlappingAlertsRequest) GetEndDate() *timestamppb.Timestamp {
+func (x *FilterPreset) GetName() string {
 	if x != nil {
-		return x.EndDate
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *QueryFlappingAlertsRequest) GetSeverities() []string {
+func (x *FilterPreset) GetDescription() string {
 	if x != nil {
-		return x.Severities
+		return x.Description
 	}
-	return nil
+	return ""
 }
 
-func (x *QueryFlappingAlertsRequest) GetTeams() []string {
+func (x *FilterPreset) GetIsShared() bool {
 	if x != nil {
-		return x.Teams
+		return x.IsShared
 	}
-	return nil
+	return false
 }
 
-func (x *QueryFlappingAlertsRequest) GetIncludeSilenced() bool {
+func (x *FilterPreset) GetIsDefault() bool {
 	if x != nil {
-		return x.IncludeSilenced
+		return x.IsDefault
 	}
 	return false
 }
 
-func (x *QueryFlappingAlertsRequest) GetMinFires() int32 {
+func (x *FilterPreset) GetFilterData() []byte {
 	if x != nil {
-		return x.MinFires
+		return x.FilterData
 	}
-	return 0
+	return nil
 }
 
-func (x *QueryFlappingAlertsRequest) GetLimit() int32 {
+func (x *FilterPreset) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Limit
+		return x.CreatedAt
 	}
-	return 0
+	return nil
 }
 
-func (x *QueryFlappingAlertsRequest) GetTimezone() string {
+func (x *FilterPreset) GetUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Timezone
+		return x.UpdatedAt
 	}
-	return ""
+	return nil
 }
 
-type FlappingAlert struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Fingerprint    string                 `protobuf:"bytes,1,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
-	AlertName      string                 `protobuf:"bytes,2,opt,name=alert_name,json=alertName,proto3" json:"alert_name,omitempty"`
-	Team           string                 `protobuf:"bytes,3,opt,name=team,proto3" json:"team,omitempty"`
-	Severity       string                 `protobuf:"bytes,4,opt,name=severity,proto3" json:"severity,omitempty"`
-	FireCount      int64                  `protobuf:"varint,5,opt,name=fire_count,json=fireCount,proto3" json:"fire_count,omitempty"`
-	AvgGapSeconds  float64                `protobuf:"fixed64,6,opt,name=avg_gap_seconds,json=avgGapSeconds,proto3" json:"avg_gap_seconds,omitempty"`
-	FiresPerHour   float64                `protobuf:"fixed64,7,opt,name=fires_per_hour,json=firesPerHour,proto3" json:"fires_per_hour,omitempty"`
-	AvgMttrSeconds float64                `protobuf:"fixed64,8,opt,name=avg_mttr_seconds,json=avgMttrSeconds,proto3" json:"avg_mttr_seconds,omitempty"`
-	FlapScore      int64                  `protobuf:"varint,9,opt,name=flap_score,json=flapScore,proto3" json:"flap_score,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+// Annotation Button Config Messages
+type GetAnnotationButtonConfigsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FlappingAlert) Reset() {
-	*x = FlappingAlert{}
-	mi := &file_proto_alert_proto_msgTypes[90]
+func (x *GetAnnotationButtonConfigsRequest) Reset() {
+	*x = GetAnnotationButtonConfigsRequest{}
+	mi := &file_proto_alert_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FlappingAlert) String() string {
+func (x *GetAnnotationButtonConfigsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FlappingAlert) ProtoMessage() {}
+func (*GetAnnotationButtonConfigsRequest) ProtoMessage() {}
 
-func (x *FlappingAlert) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[90]
+func (x *GetAnnotationButtonConfigsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6089,98 +6561,153 @@ func (x *FlappingAlert) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FlappingAlert.ProtoReflect.Descriptor instead.
-func (*FlappingAlert) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{90}
+// Deprecated: Use GetAnnotationButtonConfigsRequest.ProtoReflect.Descriptor instead.
+func (*GetAnnotationButtonConfigsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{96}
 }
 
-func (x *FlappingAlert) GetFingerprint() string {
+func (x *GetAnnotationButtonConfigsRequest) GetSessionId() string {
 	if x != nil {
-		return x.Fingerprint
+		return x.SessionId
 	}
 	return ""
 }
 
-func (x *FlappingAlert) GetAlertName() string {
-	if x != nil {
-		return x.AlertName
-	}
-	return ""
+type GetAnnotationButtonConfigsResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Success       bool                      `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Configs       []*AnnotationButtonConfig `protobuf:"bytes,2,rep,name=configs,proto3" json:"configs,omitempty"`
+	Message       string                    `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FlappingAlert) GetTeam() string {
-	if x != nil {
-		return x.Team
-	}
-	return ""
+func (x *GetAnnotationButtonConfigsResponse) Reset() {
+	*x = GetAnnotationButtonConfigsResponse{}
+	mi := &file_proto_alert_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *FlappingAlert) GetSeverity() string {
-	if x != nil {
-		return x.Severity
-	}
-	return ""
+func (x *GetAnnotationButtonConfigsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *FlappingAlert) GetFireCount() int64 {
+func (*GetAnnotationButtonConfigsResponse) ProtoMessage() {}
+
+func (x *GetAnnotationButtonConfigsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[97]
 	if x != nil {
-		return x.FireCount
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *FlappingAlert) GetAvgGapSeconds() float64 {
+// Deprecated: Use GetAnnotationButtonConfigsResponse.ProtoReflect.Descriptor instead.
+func (*GetAnnotationButtonConfigsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *GetAnnotationButtonConfigsResponse) GetSuccess() bool {
 	if x != nil {
-		return x.AvgGapSeconds
+		return x.Success
 	}
-	return 0
+	return false
 }
 
-func (x *FlappingAlert) GetFiresPerHour() float64 {
+func (x *GetAnnotationButtonConfigsResponse) GetConfigs() []*AnnotationButtonConfig {
 	if x != nil {
-		return x.FiresPerHour
+		return x.Configs
 	}
-	return 0
+	return nil
 }
 
-func (x *FlappingAlert) GetAvgMttrSeconds() float64 {
+func (x *GetAnnotationButtonConfigsResponse) GetMessage() string {
 	if x != nil {
-		return x.AvgMttrSeconds
+		return x.Message
 	}
-	return 0
+	return ""
 }
 
-func (x *FlappingAlert) GetFlapScore() int64 {
+type SaveAnnotationButtonConfigsRequest struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	SessionId     string                    `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Configs       []*AnnotationButtonConfig `protobuf:"bytes,2,rep,name=configs,proto3" json:"configs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveAnnotationButtonConfigsRequest) Reset() {
+	*x = SaveAnnotationButtonConfigsRequest{}
+	mi := &file_proto_alert_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveAnnotationButtonConfigsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveAnnotationButtonConfigsRequest) ProtoMessage() {}
+
+func (x *SaveAnnotationButtonConfigsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[98]
 	if x != nil {
-		return x.FlapScore
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-type QueryFlappingAlertsResponse struct {
+// Deprecated: Use SaveAnnotationButtonConfigsRequest.ProtoReflect.Descriptor instead.
+func (*SaveAnnotationButtonConfigsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *SaveAnnotationButtonConfigsRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *SaveAnnotationButtonConfigsRequest) GetConfigs() []*AnnotationButtonConfig {
+	if x != nil {
+		return x.Configs
+	}
+	return nil
+}
+
+type SaveAnnotationButtonConfigsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Alerts        []*FlappingAlert       `protobuf:"bytes,3,rep,name=alerts,proto3" json:"alerts,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *QueryFlappingAlertsResponse) Reset() {
-	*x = QueryFlappingAlertsResponse{}
-	mi := &file_proto_alert_proto_msgTypes[91]
+func (x *SaveAnnotationButtonConfigsResponse) Reset() {
+	*x = SaveAnnotationButtonConfigsResponse{}
+	mi := &file_proto_alert_proto_msgTypes[99]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *QueryFlappingAlertsResponse) String() string {
+func (x *SaveAnnotationButtonConfigsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryFlappingAlertsResponse) ProtoMessage() {}
+func (*SaveAnnotationButtonConfigsResponse) ProtoMessage() {}
 
-func (x *QueryFlappingAlertsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[91]
+func (x *SaveAnnotationButtonConfigsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[99]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6191,57 +6718,48 @@ func (x *QueryFlappingAlertsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QueryFlappingAlertsResponse.ProtoReflect.Descriptor instead.
-func (*QueryFlappingAlertsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{91}
+// Deprecated: Use SaveAnnotationButtonConfigsResponse.ProtoReflect.Descriptor instead.
+func (*SaveAnnotationButtonConfigsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{99}
 }
 
-func (x *QueryFlappingAlertsResponse) GetSuccess() bool {
+func (x *SaveAnnotationButtonConfigsResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *QueryFlappingAlertsResponse) GetMessage() string {
+func (x *SaveAnnotationButtonConfigsResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *QueryFlappingAlertsResponse) GetAlerts() []*FlappingAlert {
-	if x != nil {
-		return x.Alerts
-	}
-	return nil
-}
-
-type SaveOnCallRuleRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	RuleName      string                 `protobuf:"bytes,2,opt,name=rule_name,json=ruleName,proto3" json:"rule_name,omitempty"`
-	RuleConfig    *RuleConfig            `protobuf:"bytes,3,opt,name=rule_config,json=ruleConfig,proto3" json:"rule_config,omitempty"`
-	IsActive      bool                   `protobuf:"varint,4,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+type CreateAnnotationButtonConfigRequest struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	SessionId     string                  `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Config        *AnnotationButtonConfig `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SaveOnCallRuleRequest) Reset() {
-	*x = SaveOnCallRuleRequest{}
-	mi := &file_proto_alert_proto_msgTypes[92]
+func (x *CreateAnnotationButtonConfigRequest) Reset() {
+	*x = CreateAnnotationButtonConfigRequest{}
+	mi := &file_proto_alert_proto_msgTypes[100]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SaveOnCallRuleRequest) String() string {
+func (x *CreateAnnotationButtonConfigRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SaveOnCallRuleRequest) ProtoMessage() {}
+func (*CreateAnnotationButtonConfigRequest) ProtoMessage() {}
 
-func (x *SaveOnCallRuleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[92]
+func (x *CreateAnnotationButtonConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[100]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6252,63 +6770,49 @@ func (x *SaveOnCallRuleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SaveOnCallRuleRequest.ProtoReflect.Descriptor instead.
-func (*SaveOnCallRuleRequest) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{92}
+// Deprecated: Use CreateAnnotationButtonConfigRequest.ProtoReflect.Descriptor instead.
+func (*CreateAnnotationButtonConfigRequest) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{100}
 }
 
-func (x *SaveOnCallRuleRequest) GetSessionId() string {
+func (x *CreateAnnotationButtonConfigRequest) GetSessionId() string {
 	if x != nil {
 		return x.SessionId
 	}
 	return ""
 }
 
-func (x *SaveOnCallRuleRequest) GetRuleName() string {
-	if x != nil {
-		return x.RuleName
-	}
-	return ""
-}
-
-func (x *SaveOnCallRuleRequest) GetRuleConfig() *RuleConfig {
+func (x *CreateAnnotationButtonConfigRequest) GetConfig() *AnnotationButtonConfig {
 	if x != nil {
-		return x.RuleConfig
+		return x.Config
 	}
 	return nil
 }
 
-func (x *SaveOnCallRuleRequest) GetIsActive() bool {
-	if x != nil {
-		return x.IsActive
-	}
-	return false
-}
-
-type SaveOnCallRuleResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Rule          *OnCallRule            `protobuf:"bytes,2,opt,name=rule,proto3" json:"rule,omitempty"`
-	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+type CreateAnnotationButtonConfigResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Success       bool                    `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Config        *AnnotationButtonConfig `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+	Message       string                  `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SaveOnCallRuleResponse) Reset() {
-	*x = SaveOnCallRuleResponse{}
-	mi := &file_proto_alert_proto_msgTypes[93]
+func (x *CreateAnnotationButtonConfigResponse) Reset() {
+	*x = CreateAnnotationButtonConfigResponse{}
+	mi := &file_proto_alert_proto_msgTypes[101]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SaveOnCallRuleResponse) String() string {
+func (x *CreateAnnotationButtonConfigResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SaveOnCallRuleResponse) ProtoMessage() {}
+func (*CreateAnnotationButtonConfigResponse) ProtoMessage() {}
 
-func (x *SaveOnCallRuleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[93]
+func (x *CreateAnnotationButtonConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[101]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6319,55 +6823,55 @@ func (x *SaveOnCallRuleResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SaveOnCallRuleResponse.ProtoReflect.Descriptor instead.
-func (*SaveOnCallRuleResponse) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{93}
+// Deprecated: Use CreateAnnotationButtonConfigResponse.ProtoReflect.Descriptor instead.
+func (*CreateAnnotationButtonConfigResponse) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{101}
 }
 
-func (x *SaveOnCallRuleResponse) GetSuccess() bool {
+func (x *CreateAnnotationButtonConfigResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *SaveOnCallRuleResponse) GetRule() *OnCallRule {
+func (x *CreateAnnotationButtonConfigResponse) GetConfig() *AnnotationButtonConfig {
 	if x != nil {
-		return x.Rule
+		return x.Config
 	}
 	return nil
 }
 
-func (x *SaveOnCallRuleResponse) GetMessage() string {
+func (x *CreateAnnotationButtonConfigResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-type GetOnCallRulesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	ActiveOnly    bool                   `protobuf:"varint,2,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"`
+type UpdateAnnotationButtonConfigRequest struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	SessionId     string                  `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Config        *AnnotationButtonConfig `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOnCallRulesRequest) Reset() {
-	*x = GetOnCallRulesRequest{}
-	mi := &file_proto_alert_proto_msgTypes[94]
+func (x *UpdateAnnotationButtonConfigRequest) Reset() {
+	*x = UpdateAnnotationButtonConfigRequest{}
+	mi := &file_proto_alert_proto_msgTypes[102]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOnCallRulesRequest) String() string {
+func (x *UpdateAnnotationButtonConfigRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOnCallRulesRequest) ProtoMessage() {}
+func (*UpdateAnnotationButtonConfigRequest) ProtoMessage() {}
 
-func (x *GetOnCallRulesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[94]
+func (x *UpdateAnnotationButtonConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[102]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6378,49 +6882,49 @@ func (x *GetOnCallRulesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOnCallRulesRequest.ProtoReflect.Descriptor instead.
-func (*GetOnCallRulesRequest) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{94}
+// Deprecated: Use UpdateAnnotationButtonConfigRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAnnotationButtonConfigRequest) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{102}
 }
 
-func (x *GetOnCallRulesRequest) GetSessionId() string {
+func (x *UpdateAnnotationButtonConfigRequest) GetSessionId() string {
 	if x != nil {
 		return x.SessionId
 	}
 	return ""
 }
 
-func (x *GetOnCallRulesRequest) GetActiveOnly() bool {
+func (x *UpdateAnnotationButtonConfigRequest) GetConfig() *AnnotationButtonConfig {
 	if x != nil {
-		return x.ActiveOnly
+		return x.Config
 	}
-	return false
+	return nil
 }
 
-type GetOnCallRulesResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Rules         []*OnCallRule          `protobuf:"bytes,2,rep,name=rules,proto3" json:"rules,omitempty"`
-	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+type UpdateAnnotationButtonConfigResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Success       bool                    `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Config        *AnnotationButtonConfig `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+	Message       string                  `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOnCallRulesResponse) Reset() {
-	*x = GetOnCallRulesResponse{}
-	mi := &file_proto_alert_proto_msgTypes[95]
+func (x *UpdateAnnotationButtonConfigResponse) Reset() {
+	*x = UpdateAnnotationButtonConfigResponse{}
+	mi := &file_proto_alert_proto_msgTypes[103]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOnCallRulesResponse) String() string {
+func (x *UpdateAnnotationButtonConfigResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOnCallRulesResponse) ProtoMessage() {}
+func (*UpdateAnnotationButtonConfigResponse) ProtoMessage() {}
 
-func (x *GetOnCallRulesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[95]
+func (x *UpdateAnnotationButtonConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[103]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6431,55 +6935,55 @@ func (x *GetOnCallRulesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOnCallRulesResponse.ProtoReflect.Descriptor instead.
-func (*GetOnCallRulesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{95}
+// Deprecated: Use UpdateAnnotationButtonConfigResponse.ProtoReflect.Descriptor instead.
+func (*UpdateAnnotationButtonConfigResponse) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{103}
 }
 
-func (x *GetOnCallRulesResponse) GetSuccess() bool {
+func (x *UpdateAnnotationButtonConfigResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *GetOnCallRulesResponse) GetRules() []*OnCallRule {
+func (x *UpdateAnnotationButtonConfigResponse) GetConfig() *AnnotationButtonConfig {
 	if x != nil {
-		return x.Rules
+		return x.Config
 	}
 	return nil
 }
 
-func (x *GetOnCallRulesResponse) GetMessage() string {
+func (x *UpdateAnnotationButtonConfigResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-type GetOnCallRuleRequest struct {
+type DeleteAnnotationButtonConfigRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	RuleId        string                 `protobuf:"bytes,2,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	ConfigId      string                 `protobuf:"bytes,2,opt,name=config_id,json=configId,proto3" json:"config_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOnCallRuleRequest) Reset() {
-	*x = GetOnCallRuleRequest{}
-	mi := &file_proto_alert_proto_msgTypes[96]
+func (x *DeleteAnnotationButtonConfigRequest) Reset() {
+	*x = DeleteAnnotationButtonConfigRequest{}
+	mi := &file_proto_alert_proto_msgTypes[104]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOnCallRuleRequest) String() string {
+func (x *DeleteAnnotationButtonConfigRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOnCallRuleRequest) ProtoMessage() {}
+func (*DeleteAnnotationButtonConfigRequest) ProtoMessage() {}
 
-func (x *GetOnCallRuleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[96]
+func (x *DeleteAnnotationButtonConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[104]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6490,49 +6994,48 @@ func (x *GetOnCallRuleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOnCallRuleRequest.ProtoReflect.Descriptor instead.
-func (*GetOnCallRuleRequest) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{96}
+// Deprecated: Use DeleteAnnotationButtonConfigRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAnnotationButtonConfigRequest) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{104}
 }
 
-func (x *GetOnCallRuleRequest) GetSessionId() string {
+func (x *DeleteAnnotationButtonConfigRequest) GetSessionId() string {
 	if x != nil {
 		return x.SessionId
 	}
 	return ""
 }
 
-func (x *GetOnCallRuleRequest) GetRuleId() string {
+func (x *DeleteAnnotationButtonConfigRequest) GetConfigId() string {
 	if x != nil {
-		return x.RuleId
+		return x.ConfigId
 	}
 	return ""
 }
 
-type GetOnCallRuleResponse struct {
+type DeleteAnnotationButtonConfigResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Rule          *OnCallRule            `protobuf:"bytes,2,opt,name=rule,proto3" json:"rule,omitempty"`
-	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOnCallRuleResponse) Reset() {
-	*x = GetOnCallRuleResponse{}
-	mi := &file_proto_alert_proto_msgTypes[97]
+func (x *DeleteAnnotationButtonConfigResponse) Reset() {
+	*x = DeleteAnnotationButtonConfigResponse{}
+	mi := &file_proto_alert_proto_msgTypes[105]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOnCallRuleResponse) String() string {
+func (x *DeleteAnnotationButtonConfigResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOnCallRuleResponse) ProtoMessage() {}
+func (*DeleteAnnotationButtonConfigResponse) ProtoMessage() {}
 
-func (x *GetOnCallRuleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[97]
+func (x *DeleteAnnotationButtonConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[105]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6543,58 +7046,57 @@ func (x *GetOnCallRuleResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOnCallRuleResponse.ProtoReflect.Descriptor instead.
-func (*GetOnCallRuleResponse) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{97}
+// Deprecated: Use DeleteAnnotationButtonConfigResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAnnotationButtonConfigResponse) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{105}
 }
 
-func (x *GetOnCallRuleResponse) GetSuccess() bool {
+func (x *DeleteAnnotationButtonConfigResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *GetOnCallRuleResponse) GetRule() *OnCallRule {
-	if x != nil {
-		return x.Rule
-	}
-	return nil
-}
-
-func (x *GetOnCallRuleResponse) GetMessage() string {
+func (x *DeleteAnnotationButtonConfigResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-type UpdateOnCallRuleRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	RuleId        string                 `protobuf:"bytes,2,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
-	RuleName      string                 `protobuf:"bytes,3,opt,name=rule_name,json=ruleName,proto3" json:"rule_name,omitempty"`
-	RuleConfig    *RuleConfig            `protobuf:"bytes,4,opt,name=rule_config,json=ruleConfig,proto3" json:"rule_config,omitempty"`
-	IsActive      bool                   `protobuf:"varint,5,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type AnnotationButtonConfig struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId         string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Label          string                 `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	AnnotationKeys []string               `protobuf:"bytes,4,rep,name=annotation_keys,json=annotationKeys,proto3" json:"annotation_keys,omitempty"` // List of annotation keys to check
+	Color          string                 `protobuf:"bytes,5,opt,name=color,proto3" json:"color,omitempty"`
+	Icon           string                 `protobuf:"bytes,6,opt,name=icon,proto3" json:"icon,omitempty"`
+	DisplayOrder   int32                  `protobuf:"varint,7,opt,name=display_order,json=displayOrder,proto3" json:"display_order,omitempty"`
+	Enabled        bool                   `protobuf:"varint,8,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	ButtonType     string                 `protobuf:"bytes,9,opt,name=button_type,json=buttonType,proto3" json:"button_type,omitempty"` // "default" or "custom"
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *UpdateOnCallRuleRequest) Reset() {
-	*x = UpdateOnCallRuleRequest{}
-	mi := &file_proto_alert_proto_msgTypes[98]
+func (x *AnnotationButtonConfig) Reset() {
+	*x = AnnotationButtonConfig{}
+	mi := &file_proto_alert_proto_msgTypes[106]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateOnCallRuleRequest) String() string {
+func (x *AnnotationButtonConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateOnCallRuleRequest) ProtoMessage() {}
+func (*AnnotationButtonConfig) ProtoMessage() {}
 
-func (x *UpdateOnCallRuleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[98]
+func (x *AnnotationButtonConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[106]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6605,129 +7107,127 @@ func (x *UpdateOnCallRuleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateOnCallRuleRequest.ProtoReflect.Descriptor instead.
-func (*UpdateOnCallRuleRequest) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{98}
+// Deprecated: Use AnnotationButtonConfig.ProtoReflect.Descriptor instead.
+func (*AnnotationButtonConfig) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{106}
 }
 
-func (x *UpdateOnCallRuleRequest) GetSessionId() string {
+func (x *AnnotationButtonConfig) GetId() string {
 	if x != nil {
-		return x.SessionId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *UpdateOnCallRuleRequest) GetRuleId() string {
+func (x *AnnotationButtonConfig) GetUserId() string {
 	if x != nil {
-		return x.RuleId
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *UpdateOnCallRuleRequest) GetRuleName() string {
+func (x *AnnotationButtonConfig) GetLabel() string {
 	if x != nil {
-		return x.RuleName
+		return x.Label
 	}
 	return ""
 }
 
-func (x *UpdateOnCallRuleRequest) GetRuleConfig() *RuleConfig {
+func (x *AnnotationButtonConfig) GetAnnotationKeys() []string {
 	if x != nil {
-		return x.RuleConfig
+		return x.AnnotationKeys
 	}
 	return nil
 }
 
-func (x *UpdateOnCallRuleRequest) GetIsActive() bool {
+func (x *AnnotationButtonConfig) GetColor() string {
 	if x != nil {
-		return x.IsActive
+		return x.Color
 	}
-	return false
-}
-
-type UpdateOnCallRuleResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Rule          *OnCallRule            `protobuf:"bytes,2,opt,name=rule,proto3" json:"rule,omitempty"`
-	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *UpdateOnCallRuleResponse) Reset() {
-	*x = UpdateOnCallRuleResponse{}
-	mi := &file_proto_alert_proto_msgTypes[99]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+	return ""
 }
 
-func (x *UpdateOnCallRuleResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *AnnotationButtonConfig) GetIcon() string {
+	if x != nil {
+		return x.Icon
+	}
+	return ""
 }
 
-func (*UpdateOnCallRuleResponse) ProtoMessage() {}
-
-func (x *UpdateOnCallRuleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[99]
+func (x *AnnotationButtonConfig) GetDisplayOrder() int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.DisplayOrder
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use UpdateOnCallRuleResponse.ProtoReflect.Descriptor instead.
-func (*UpdateOnCallRuleResponse) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{99}
+func (x *AnnotationButtonConfig) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
 }
 
-func (x *UpdateOnCallRuleResponse) GetSuccess() bool {
+func (x *AnnotationButtonConfig) GetButtonType() string {
 	if x != nil {
-		return x.Success
+		return x.ButtonType
 	}
-	return false
+	return ""
 }
 
-func (x *UpdateOnCallRuleResponse) GetRule() *OnCallRule {
+func (x *AnnotationButtonConfig) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Rule
+		return x.CreatedAt
 	}
 	return nil
 }
 
-func (x *UpdateOnCallRuleResponse) GetMessage() string {
+func (x *AnnotationButtonConfig) GetUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Message
+		return x.UpdatedAt
 	}
-	return ""
+	return nil
 }
 
-type DeleteOnCallRuleRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	RuleId        string                 `protobuf:"bytes,2,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type QueryStatisticsRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	SessionId         string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	StartDate         *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate           *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	ApplyRules        bool                   `protobuf:"varint,4,opt,name=apply_rules,json=applyRules,proto3" json:"apply_rules,omitempty"`                            // Apply user's on-call rules
+	GroupBy           string                 `protobuf:"bytes,5,opt,name=group_by,json=groupBy,proto3" json:"group_by,omitempty"`                                      // "severity", "team", "period", "alert_name", or empty for overall
+	PeriodType        string                 `protobuf:"bytes,6,opt,name=period_type,json=periodType,proto3" json:"period_type,omitempty"`                             // "hour", "day", "week", "month" (required if group_by="period")
+	Limit             int32                  `protobuf:"varint,7,opt,name=limit,proto3" json:"limit,omitempty"`                                                        // Limit results (for alert_name grouping)
+	Offset            int32                  `protobuf:"varint,8,opt,name=offset,proto3" json:"offset,omitempty"`                                                      // Pagination offset
+	FilterByTimeOfDay bool                   `protobuf:"varint,9,opt,name=filter_by_time_of_day,json=filterByTimeOfDay,proto3" json:"filter_by_time_of_day,omitempty"` // Enable time-of-day filtering
+	TimeOfDayStart    string                 `protobuf:"bytes,10,opt,name=time_of_day_start,json=timeOfDayStart,proto3" json:"time_of_day_start,omitempty"`            // "HH:MM" format (e.g., "22:00")
+	TimeOfDayEnd      string                 `protobuf:"bytes,11,opt,name=time_of_day_end,json=timeOfDayEnd,proto3" json:"time_of_day_end,omitempty"`                  // "HH:MM" format (e.g., "06:00") - supports cross-midnight
+	IncludeWeekends   bool                   `protobuf:"varint,12,opt,name=include_weekends,json=includeWeekends,proto3" json:"include_weekends,omitempty"`            // Include weekends in time-of-day filter (default: true)
+	SecondaryGroupBy  string                 `protobuf:"bytes,13,opt,name=secondary_group_by,json=secondaryGroupBy,proto3" json:"secondary_group_by,omitempty"`        // For period grouping: sub-group breakdown by "severity", "team", "alert_name"
+	Severities        []string               `protobuf:"bytes,14,rep,name=severities,proto3" json:"severities,omitempty"`                                              // Filter by severities (multi-select, OR logic)
+	Teams             []string               `protobuf:"bytes,15,rep,name=teams,proto3" json:"teams,omitempty"`                                                        // Filter by teams (multi-select, OR logic)
+	WeekendMode       string                 `protobuf:"bytes,16,opt,name=weekend_mode,json=weekendMode,proto3" json:"weekend_mode,omitempty"`                         // "exclude", "same_hours", "full_weekends"
+	IncludeSilenced   bool                   `protobuf:"varint,17,opt,name=include_silenced,json=includeSilenced,proto3" json:"include_silenced,omitempty"`            // Include alerts silenced at fire time (default: false)
+	Timezone          string                 `protobuf:"bytes,18,opt,name=timezone,proto3" json:"timezone,omitempty"`                                                  // IANA timezone for time-of-day/weekend/period bucketing (e.g. "Europe/Paris"); empty = UTC
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *DeleteOnCallRuleRequest) Reset() {
-	*x = DeleteOnCallRuleRequest{}
-	mi := &file_proto_alert_proto_msgTypes[100]
+func (x *QueryStatisticsRequest) Reset() {
+	*x = QueryStatisticsRequest{}
+	mi := &file_proto_alert_proto_msgTypes[107]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteOnCallRuleRequest) String() string {
+func (x *QueryStatisticsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteOnCallRuleRequest) ProtoMessage() {}
+func (*QueryStatisticsRequest) ProtoMessage() {}
 
-func (x *DeleteOnCallRuleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[100]
+func (x *QueryStatisticsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[107]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6738,162 +7238,164 @@ func (x *DeleteOnCallRuleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteOnCallRuleRequest.ProtoReflect.Descriptor instead.
-func (*DeleteOnCallRuleRequest) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{100}
+// Deprecated: Use QueryStatisticsRequest.ProtoReflect.Descriptor instead.
+func (*QueryStatisticsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{107}
 }
 
-func (x *DeleteOnCallRuleRequest) GetSessionId() string {
+func (x *QueryStatisticsRequest) GetSessionId() string {
 	if x != nil {
 		return x.SessionId
 	}
 	return ""
 }
 
-func (x *DeleteOnCallRuleRequest) GetRuleId() string {
+func (x *QueryStatisticsRequest) GetStartDate() *timestamppb.Timestamp {
 	if x != nil {
-		return x.RuleId
+		return x.StartDate
 	}
-	return ""
+	return nil
 }
 
-type DeleteOnCallRuleResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *QueryStatisticsRequest) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
 }
 
-func (x *DeleteOnCallRuleResponse) Reset() {
-	*x = DeleteOnCallRuleResponse{}
-	mi := &file_proto_alert_proto_msgTypes[101]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *QueryStatisticsRequest) GetApplyRules() bool {
+	if x != nil {
+		return x.ApplyRules
+	}
+	return false
 }
 
-func (x *DeleteOnCallRuleResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *QueryStatisticsRequest) GetGroupBy() string {
+	if x != nil {
+		return x.GroupBy
+	}
+	return ""
 }
 
-func (*DeleteOnCallRuleResponse) ProtoMessage() {}
+func (x *QueryStatisticsRequest) GetPeriodType() string {
+	if x != nil {
+		return x.PeriodType
+	}
+	return ""
+}
 
-func (x *DeleteOnCallRuleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[101]
+func (x *QueryStatisticsRequest) GetLimit() int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Limit
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use DeleteOnCallRuleResponse.ProtoReflect.Descriptor instead.
-func (*DeleteOnCallRuleResponse) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{101}
+func (x *QueryStatisticsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
 }
 
-func (x *DeleteOnCallRuleResponse) GetSuccess() bool {
+func (x *QueryStatisticsRequest) GetFilterByTimeOfDay() bool {
 	if x != nil {
-		return x.Success
+		return x.FilterByTimeOfDay
 	}
 	return false
 }
 
-func (x *DeleteOnCallRuleResponse) GetMessage() string {
+func (x *QueryStatisticsRequest) GetTimeOfDayStart() string {
 	if x != nil {
-		return x.Message
+		return x.TimeOfDayStart
 	}
 	return ""
 }
 
-type TestOnCallRuleRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	RuleConfig    *RuleConfig            `protobuf:"bytes,2,opt,name=rule_config,json=ruleConfig,proto3" json:"rule_config,omitempty"`
-	SampleSize    int32                  `protobuf:"varint,3,opt,name=sample_size,json=sampleSize,proto3" json:"sample_size,omitempty"` // Number of sample alerts to return
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *QueryStatisticsRequest) GetTimeOfDayEnd() string {
+	if x != nil {
+		return x.TimeOfDayEnd
+	}
+	return ""
 }
 
-func (x *TestOnCallRuleRequest) Reset() {
-	*x = TestOnCallRuleRequest{}
-	mi := &file_proto_alert_proto_msgTypes[102]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *QueryStatisticsRequest) GetIncludeWeekends() bool {
+	if x != nil {
+		return x.IncludeWeekends
+	}
+	return false
 }
 
-func (x *TestOnCallRuleRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *QueryStatisticsRequest) GetSecondaryGroupBy() string {
+	if x != nil {
+		return x.SecondaryGroupBy
+	}
+	return ""
 }
 
-func (*TestOnCallRuleRequest) ProtoMessage() {}
-
-func (x *TestOnCallRuleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[102]
+func (x *QueryStatisticsRequest) GetSeverities() []string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Severities
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use TestOnCallRuleRequest.ProtoReflect.Descriptor instead.
-func (*TestOnCallRuleRequest) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{102}
+func (x *QueryStatisticsRequest) GetTeams() []string {
+	if x != nil {
+		return x.Teams
+	}
+	return nil
 }
 
-func (x *TestOnCallRuleRequest) GetSessionId() string {
+func (x *QueryStatisticsRequest) GetWeekendMode() string {
 	if x != nil {
-		return x.SessionId
+		return x.WeekendMode
 	}
 	return ""
 }
 
-func (x *TestOnCallRuleRequest) GetRuleConfig() *RuleConfig {
+func (x *QueryStatisticsRequest) GetIncludeSilenced() bool {
 	if x != nil {
-		return x.RuleConfig
+		return x.IncludeSilenced
 	}
-	return nil
+	return false
 }
 
-func (x *TestOnCallRuleRequest) GetSampleSize() int32 {
+func (x *QueryStatisticsRequest) GetTimezone() string {
 	if x != nil {
-		return x.SampleSize
+		return x.Timezone
 	}
-	return 0
+	return ""
 }
 
-type TestOnCallRuleResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	TotalMatches  int64                  `protobuf:"varint,2,opt,name=total_matches,json=totalMatches,proto3" json:"total_matches,omitempty"`
-	SampleAlerts  []*AlertStatistic      `protobuf:"bytes,3,rep,name=sample_alerts,json=sampleAlerts,proto3" json:"sample_alerts,omitempty"`
-	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+type QueryStatisticsResponse struct {
+	state         protoimpl.MessageState           `protogen:"open.v1"`
+	Success       bool                             `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	TimeRange     *TimeRange                       `protobuf:"bytes,2,opt,name=time_range,json=timeRange,proto3" json:"time_range,omitempty"`
+	TotalAlerts   int64                            `protobuf:"varint,3,opt,name=total_alerts,json=totalAlerts,proto3" json:"total_alerts,omitempty"`
+	Statistics    map[string]*AggregatedStatistics `protobuf:"bytes,4,rep,name=statistics,proto3" json:"statistics,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Key varies by group_by
+	Breakdown     []*BreakdownItem                 `protobuf:"bytes,5,rep,name=breakdown,proto3" json:"breakdown,omitempty"`                                                                             // Used for period grouping
+	Message       string                           `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TestOnCallRuleResponse) Reset() {
-	*x = TestOnCallRuleResponse{}
-	mi := &file_proto_alert_proto_msgTypes[103]
+func (x *QueryStatisticsResponse) Reset() {
+	*x = QueryStatisticsResponse{}
+	mi := &file_proto_alert_proto_msgTypes[108]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TestOnCallRuleResponse) String() string {
+func (x *QueryStatisticsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TestOnCallRuleResponse) ProtoMessage() {}
+func (*QueryStatisticsResponse) ProtoMessage() {}
 
-func (x *TestOnCallRuleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[103]
+func (x *QueryStatisticsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[108]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6904,67 +7406,76 @@ func (x *TestOnCallRuleResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TestOnCallRuleResponse.ProtoReflect.Descriptor instead.
-func (*TestOnCallRuleResponse) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{103}
+// Deprecated: Use QueryStatisticsResponse.ProtoReflect.Descriptor instead.
+func (*QueryStatisticsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{108}
 }
 
-func (x *TestOnCallRuleResponse) GetSuccess() bool {
+func (x *QueryStatisticsResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *TestOnCallRuleResponse) GetTotalMatches() int64 {
+func (x *QueryStatisticsResponse) GetTimeRange() *TimeRange {
 	if x != nil {
-		return x.TotalMatches
+		return x.TimeRange
+	}
+	return nil
+}
+
+func (x *QueryStatisticsResponse) GetTotalAlerts() int64 {
+	if x != nil {
+		return x.TotalAlerts
 	}
 	return 0
 }
 
-func (x *TestOnCallRuleResponse) GetSampleAlerts() []*AlertStatistic {
+func (x *QueryStatisticsResponse) GetStatistics() map[string]*AggregatedStatistics {
 	if x != nil {
-		return x.SampleAlerts
+		return x.Statistics
 	}
 	return nil
 }
 
-func (x *TestOnCallRuleResponse) GetMessage() string {
+func (x *QueryStatisticsResponse) GetBreakdown() []*BreakdownItem {
+	if x != nil {
+		return x.Breakdown
+	}
+	return nil
+}
+
+func (x *QueryStatisticsResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-type OnCallRule struct {
+type TimeRange struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	RuleName      string                 `protobuf:"bytes,3,opt,name=rule_name,json=ruleName,proto3" json:"rule_name,omitempty"`
-	RuleConfig    *RuleConfig            `protobuf:"bytes,4,opt,name=rule_config,json=ruleConfig,proto3" json:"rule_config,omitempty"`
-	IsActive      bool                   `protobuf:"varint,5,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Start         *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	End           *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OnCallRule) Reset() {
-	*x = OnCallRule{}
-	mi := &file_proto_alert_proto_msgTypes[104]
+func (x *TimeRange) Reset() {
+	*x = TimeRange{}
+	mi := &file_proto_alert_proto_msgTypes[109]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OnCallRule) String() string {
+func (x *TimeRange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OnCallRule) ProtoMessage() {}
+func (*TimeRange) ProtoMessage() {}
 
-func (x *OnCallRule) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[104]
+func (x *TimeRange) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[109]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6975,139 +7486,127 @@ func (x *OnCallRule) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OnCallRule.ProtoReflect.Descriptor instead.
-func (*OnCallRule) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{104}
+// Deprecated: Use TimeRange.ProtoReflect.Descriptor instead.
+func (*TimeRange) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{109}
 }
 
-func (x *OnCallRule) GetId() string {
+func (x *TimeRange) GetStart() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Id
+		return x.Start
 	}
-	return ""
+	return nil
 }
 
-func (x *OnCallRule) GetUserId() string {
+func (x *TimeRange) GetEnd() *timestamppb.Timestamp {
 	if x != nil {
-		return x.UserId
+		return x.End
 	}
-	return ""
+	return nil
 }
 
-func (x *OnCallRule) GetRuleName() string {
-	if x != nil {
-		return x.RuleName
-	}
-	return ""
+type AggregatedStatistics struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Count             int32                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	AvgMttrSeconds    float64                `protobuf:"fixed64,2,opt,name=avg_mttr_seconds,json=avgMttrSeconds,proto3" json:"avg_mttr_seconds,omitempty"` // Mean Time To Resolve (resolved - fired)
+	TotalMttrSeconds  int32                  `protobuf:"varint,3,opt,name=total_mttr_seconds,json=totalMttrSeconds,proto3" json:"total_mttr_seconds,omitempty"`
+	AvgMttaSeconds    float64                `protobuf:"fixed64,4,opt,name=avg_mtta_seconds,json=avgMttaSeconds,proto3" json:"avg_mtta_seconds,omitempty"`            // Mean Time To Acknowledge (acknowledged - fired)
+	AvgFixTimeSeconds float64                `protobuf:"fixed64,5,opt,name=avg_fix_time_seconds,json=avgFixTimeSeconds,proto3" json:"avg_fix_time_seconds,omitempty"` // Fix Time (resolved - acknowledged)
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *OnCallRule) GetRuleConfig() *RuleConfig {
-	if x != nil {
-		return x.RuleConfig
-	}
-	return nil
+func (x *AggregatedStatistics) Reset() {
+	*x = AggregatedStatistics{}
+	mi := &file_proto_alert_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *OnCallRule) GetIsActive() bool {
-	if x != nil {
-		return x.IsActive
-	}
-	return false
+func (x *AggregatedStatistics) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *OnCallRule) GetCreatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return nil
-}
+func (*AggregatedStatistics) ProtoMessage() {}
 
-func (x *OnCallRule) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *AggregatedStatistics) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[110]
 	if x != nil {
-		return x.UpdatedAt
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
-}
-
-type RuleConfig struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Criteria      []*RuleCriterion       `protobuf:"bytes,1,rep,name=criteria,proto3" json:"criteria,omitempty"`
-	Logic         string                 `protobuf:"bytes,2,opt,name=logic,proto3" json:"logic,omitempty"` // "AND" or "OR"
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	return mi.MessageOf(x)
 }
 
-func (x *RuleConfig) Reset() {
-	*x = RuleConfig{}
-	mi := &file_proto_alert_proto_msgTypes[105]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+// Deprecated: Use AggregatedStatistics.ProtoReflect.Descriptor instead.
+func (*AggregatedStatistics) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{110}
 }
 
-func (x *RuleConfig) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *AggregatedStatistics) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
 }
 
-func (*RuleConfig) ProtoMessage() {}
-
-func (x *RuleConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[105]
+func (x *AggregatedStatistics) GetAvgMttrSeconds() float64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.AvgMttrSeconds
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use RuleConfig.ProtoReflect.Descriptor instead.
-func (*RuleConfig) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{105}
+func (x *AggregatedStatistics) GetTotalMttrSeconds() int32 {
+	if x != nil {
+		return x.TotalMttrSeconds
+	}
+	return 0
 }
 
-func (x *RuleConfig) GetCriteria() []*RuleCriterion {
+func (x *AggregatedStatistics) GetAvgMttaSeconds() float64 {
 	if x != nil {
-		return x.Criteria
+		return x.AvgMttaSeconds
 	}
-	return nil
+	return 0
 }
 
-func (x *RuleConfig) GetLogic() string {
+func (x *AggregatedStatistics) GetAvgFixTimeSeconds() float64 {
 	if x != nil {
-		return x.Logic
+		return x.AvgFixTimeSeconds
 	}
-	return ""
+	return 0
 }
 
-type RuleCriterion struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`         // "severity", "label", "alert_name"
-	Operator      string                 `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"` // "equals", "in", "not_equals", "contains", "regex", "starts_with", "ends_with"
-	Value         string                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`       // Single value (for equals, not_equals, contains, etc.)
-	Values        []string               `protobuf:"bytes,4,rep,name=values,proto3" json:"values,omitempty"`     // Multiple values (for "in" operator)
-	Key           string                 `protobuf:"bytes,5,opt,name=key,proto3" json:"key,omitempty"`           // Label key (for label type)
-	Pattern       string                 `protobuf:"bytes,6,opt,name=pattern,proto3" json:"pattern,omitempty"`   // Pattern/regex (for alert_name type)
+type BreakdownItem struct {
+	state         protoimpl.MessageState           `protogen:"open.v1"`
+	Period        string                           `protobuf:"bytes,1,opt,name=period,proto3" json:"period,omitempty"` // e.g., "2025-10-22", "2025-W05", "2025-10-22T14:00"
+	StartTime     *timestamppb.Timestamp           `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime       *timestamppb.Timestamp           `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	TotalCount    int32                            `protobuf:"varint,4,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Statistics    map[string]*AggregatedStatistics `protobuf:"bytes,5,rep,name=statistics,proto3" json:"statistics,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Breakdown by secondary_group_by within period (default: severity)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RuleCriterion) Reset() {
-	*x = RuleCriterion{}
-	mi := &file_proto_alert_proto_msgTypes[106]
+func (x *BreakdownItem) Reset() {
+	*x = BreakdownItem{}
+	mi := &file_proto_alert_proto_msgTypes[111]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RuleCriterion) String() string {
+func (x *BreakdownItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RuleCriterion) ProtoMessage() {}
+func (*BreakdownItem) ProtoMessage() {}
 
-func (x *RuleCriterion) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[106]
+func (x *BreakdownItem) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[111]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7118,87 +7617,74 @@ func (x *RuleCriterion) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RuleCriterion.ProtoReflect.Descriptor instead.
-func (*RuleCriterion) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{106}
-}
-
-func (x *RuleCriterion) GetType() string {
-	if x != nil {
-		return x.Type
-	}
-	return ""
+// Deprecated: Use BreakdownItem.ProtoReflect.Descriptor instead.
+func (*BreakdownItem) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{111}
 }
 
-func (x *RuleCriterion) GetOperator() string {
+func (x *BreakdownItem) GetPeriod() string {
 	if x != nil {
-		return x.Operator
+		return x.Period
 	}
 	return ""
 }
 
-func (x *RuleCriterion) GetValue() string {
+func (x *BreakdownItem) GetStartTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Value
+		return x.StartTime
 	}
-	return ""
+	return nil
 }
 
-func (x *RuleCriterion) GetValues() []string {
+func (x *BreakdownItem) GetEndTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Values
+		return x.EndTime
 	}
 	return nil
 }
 
-func (x *RuleCriterion) GetKey() string {
+func (x *BreakdownItem) GetTotalCount() int32 {
 	if x != nil {
-		return x.Key
+		return x.TotalCount
 	}
-	return ""
+	return 0
 }
 
-func (x *RuleCriterion) GetPattern() string {
+func (x *BreakdownItem) GetStatistics() map[string]*AggregatedStatistics {
 	if x != nil {
-		return x.Pattern
+		return x.Statistics
 	}
-	return ""
+	return nil
 }
 
-type AlertStatistic struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Fingerprint    string                 `protobuf:"bytes,2,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
-	AlertName      string                 `protobuf:"bytes,3,opt,name=alert_name,json=alertName,proto3" json:"alert_name,omitempty"`
-	Severity       string                 `protobuf:"bytes,4,opt,name=severity,proto3" json:"severity,omitempty"`
-	Metadata       []byte                 `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"` // JSON serialized metadata
-	FiredAt        *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=fired_at,json=firedAt,proto3" json:"fired_at,omitempty"`
-	ResolvedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=resolved_at,json=resolvedAt,proto3" json:"resolved_at,omitempty"`
-	AcknowledgedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=acknowledged_at,json=acknowledgedAt,proto3" json:"acknowledged_at,omitempty"`
-	MttrSeconds    int32                  `protobuf:"varint,9,opt,name=mttr_seconds,json=mttrSeconds,proto3" json:"mttr_seconds,omitempty"`  // Mean Time To Resolve (resolved - fired)
-	MttaSeconds    int32                  `protobuf:"varint,10,opt,name=mtta_seconds,json=mttaSeconds,proto3" json:"mtta_seconds,omitempty"` // Mean Time To Acknowledge (acknowledged - fired)
-	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	FixTimeSeconds int32                  `protobuf:"varint,13,opt,name=fix_time_seconds,json=fixTimeSeconds,proto3" json:"fix_time_seconds,omitempty"` // Fix Time (resolved - acknowledged)
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type QueryHeatmapRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	SessionId       string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	StartDate       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate         *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Severities      []string               `protobuf:"bytes,4,rep,name=severities,proto3" json:"severities,omitempty"`
+	Teams           []string               `protobuf:"bytes,5,rep,name=teams,proto3" json:"teams,omitempty"`
+	IncludeSilenced bool                   `protobuf:"varint,6,opt,name=include_silenced,json=includeSilenced,proto3" json:"include_silenced,omitempty"`
+	Timezone        string                 `protobuf:"bytes,7,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *AlertStatistic) Reset() {
-	*x = AlertStatistic{}
-	mi := &file_proto_alert_proto_msgTypes[107]
+func (x *QueryHeatmapRequest) Reset() {
+	*x = QueryHeatmapRequest{}
+	mi := &file_proto_alert_proto_msgTypes[112]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AlertStatistic) String() string {
+func (x *QueryHeatmapRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AlertStatistic) ProtoMessage() {}
+func (*QueryHeatmapRequest) ProtoMessage() {}
 
-func (x *AlertStatistic) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[107]
+func (x *QueryHeatmapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[112]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7209,124 +7695,152 @@ func (x *AlertStatistic) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AlertStatistic.ProtoReflect.Descriptor instead.
-func (*AlertStatistic) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{107}
-}
-
-func (x *AlertStatistic) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
+// Deprecated: Use QueryHeatmapRequest.ProtoReflect.Descriptor instead.
+func (*QueryHeatmapRequest) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{112}
 }
 
-func (x *AlertStatistic) GetFingerprint() string {
+func (x *QueryHeatmapRequest) GetSessionId() string {
 	if x != nil {
-		return x.Fingerprint
+		return x.SessionId
 	}
 	return ""
 }
 
-func (x *AlertStatistic) GetAlertName() string {
+func (x *QueryHeatmapRequest) GetStartDate() *timestamppb.Timestamp {
 	if x != nil {
-		return x.AlertName
+		return x.StartDate
 	}
-	return ""
+	return nil
 }
 
-func (x *AlertStatistic) GetSeverity() string {
+func (x *QueryHeatmapRequest) GetEndDate() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Severity
+		return x.EndDate
 	}
-	return ""
+	return nil
 }
 
-func (x *AlertStatistic) GetMetadata() []byte {
+func (x *QueryHeatmapRequest) GetSeverities() []string {
 	if x != nil {
-		return x.Metadata
+		return x.Severities
 	}
 	return nil
 }
 
-func (x *AlertStatistic) GetFiredAt() *timestamppb.Timestamp {
+func (x *QueryHeatmapRequest) GetTeams() []string {
 	if x != nil {
-		return x.FiredAt
+		return x.Teams
 	}
 	return nil
 }
 
-func (x *AlertStatistic) GetResolvedAt() *timestamppb.Timestamp {
+func (x *QueryHeatmapRequest) GetIncludeSilenced() bool {
 	if x != nil {
-		return x.ResolvedAt
+		return x.IncludeSilenced
 	}
-	return nil
+	return false
 }
 
-func (x *AlertStatistic) GetAcknowledgedAt() *timestamppb.Timestamp {
+func (x *QueryHeatmapRequest) GetTimezone() string {
 	if x != nil {
-		return x.AcknowledgedAt
+		return x.Timezone
 	}
-	return nil
+	return ""
 }
 
-func (x *AlertStatistic) GetMttrSeconds() int32 {
-	if x != nil {
-		return x.MttrSeconds
-	}
-	return 0
+type HeatmapCell struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Dow            int32                  `protobuf:"varint,1,opt,name=dow,proto3" json:"dow,omitempty"`   // 0=Sunday .. 6=Saturday (PostgreSQL EXTRACT(DOW))
+	Hour           int32                  `protobuf:"varint,2,opt,name=hour,proto3" json:"hour,omitempty"` // 0..23
+	Count          int64                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	AvgMttrSeconds float64                `protobuf:"fixed64,4,opt,name=avg_mttr_seconds,json=avgMttrSeconds,proto3" json:"avg_mttr_seconds,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *AlertStatistic) GetMttaSeconds() int32 {
-	if x != nil {
-		return x.MttaSeconds
-	}
-	return 0
+func (x *HeatmapCell) Reset() {
+	*x = HeatmapCell{}
+	mi := &file_proto_alert_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *AlertStatistic) GetCreatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return nil
+func (x *HeatmapCell) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *AlertStatistic) GetUpdatedAt() *timestamppb.Timestamp {
+func (*HeatmapCell) ProtoMessage() {}
+
+func (x *HeatmapCell) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[113]
 	if x != nil {
-		return x.UpdatedAt
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *AlertStatistic) GetFixTimeSeconds() int32 {
+// Deprecated: Use HeatmapCell.ProtoReflect.Descriptor instead.
+func (*HeatmapCell) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *HeatmapCell) GetDow() int32 {
 	if x != nil {
-		return x.FixTimeSeconds
+		return x.Dow
 	}
 	return 0
 }
 
-type GetStatisticsSummaryRequest struct {
+func (x *HeatmapCell) GetHour() int32 {
+	if x != nil {
+		return x.Hour
+	}
+	return 0
+}
+
+func (x *HeatmapCell) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *HeatmapCell) GetAvgMttrSeconds() float64 {
+	if x != nil {
+		return x.AvgMttrSeconds
+	}
+	return 0
+}
+
+type QueryHeatmapResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Cells         []*HeatmapCell         `protobuf:"bytes,3,rep,name=cells,proto3" json:"cells,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetStatisticsSummaryRequest) Reset() {
-	*x = GetStatisticsSummaryRequest{}
-	mi := &file_proto_alert_proto_msgTypes[108]
+func (x *QueryHeatmapResponse) Reset() {
+	*x = QueryHeatmapResponse{}
+	mi := &file_proto_alert_proto_msgTypes[114]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetStatisticsSummaryRequest) String() string {
+func (x *QueryHeatmapResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetStatisticsSummaryRequest) ProtoMessage() {}
+func (*QueryHeatmapResponse) ProtoMessage() {}
 
-func (x *GetStatisticsSummaryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[108]
+func (x *QueryHeatmapResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[114]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7337,45 +7851,1900 @@ func (x *GetStatisticsSummaryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetStatisticsSummaryRequest.ProtoReflect.Descriptor instead.
-func (*GetStatisticsSummaryRequest) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{108}
+// Deprecated: Use QueryHeatmapResponse.ProtoReflect.Descriptor instead.
+func (*QueryHeatmapResponse) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{114}
 }
 
-func (x *GetStatisticsSummaryRequest) GetSessionId() string {
+func (x *QueryHeatmapResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *QueryHeatmapResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *QueryHeatmapResponse) GetCells() []*HeatmapCell {
+	if x != nil {
+		return x.Cells
+	}
+	return nil
+}
+
+type QueryFlappingAlertsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	SessionId       string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	StartDate       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate         *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Severities      []string               `protobuf:"bytes,4,rep,name=severities,proto3" json:"severities,omitempty"`
+	Teams           []string               `protobuf:"bytes,5,rep,name=teams,proto3" json:"teams,omitempty"`
+	IncludeSilenced bool                   `protobuf:"varint,6,opt,name=include_silenced,json=includeSilenced,proto3" json:"include_silenced,omitempty"`
+	MinFires        int32                  `protobuf:"varint,7,opt,name=min_fires,json=minFires,proto3" json:"min_fires,omitempty"`
+	Limit           int32                  `protobuf:"varint,8,opt,name=limit,proto3" json:"limit,omitempty"`
+	Timezone        string                 `protobuf:"bytes,9,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *QueryFlappingAlertsRequest) Reset() {
+	*x = QueryFlappingAlertsRequest{}
+	mi := &file_proto_alert_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryFlappingAlertsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryFlappingAlertsRequest) ProtoMessage() {}
+
+func (x *QueryFlappingAlertsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryFlappingAlertsRequest.ProtoReflect.Descriptor instead.
+func (*QueryFlappingAlertsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *QueryFlappingAlertsRequest) GetSessionId() string {
 	if x != nil {
 		return x.SessionId
 	}
 	return ""
 }
 
-type GetStatisticsSummaryResponse struct {
-	state           protoimpl.MessageState           `protogen:"open.v1"`
-	Success         bool                             `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	TotalStatistics int64                            `protobuf:"varint,2,opt,name=total_statistics,json=totalStatistics,proto3" json:"total_statistics,omitempty"`
-	BySeverity      map[string]*AggregatedStatistics `protobuf:"bytes,3,rep,name=by_severity,json=bySeverity,proto3" json:"by_severity,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	EarliestAlert   *timestamppb.Timestamp           `protobuf:"bytes,4,opt,name=earliest_alert,json=earliestAlert,proto3" json:"earliest_alert,omitempty"`
-	LatestAlert     *timestamppb.Timestamp           `protobuf:"bytes,5,opt,name=latest_alert,json=latestAlert,proto3" json:"latest_alert,omitempty"`
-	Message         string                           `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+func (x *QueryFlappingAlertsRequest) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *QueryFlappingAlertsRequest) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *QueryFlappingAlertsRequest) GetSeverities() []string {
+	if x != nil {
+		return x.Severities
+	}
+	return nil
+}
+
+func (x *QueryFlappingAlertsRequest) GetTeams() []string {
+	if x != nil {
+		return x.Teams
+	}
+	return nil
+}
+
+func (x *QueryFlappingAlertsRequest) GetIncludeSilenced() bool {
+	if x != nil {
+		return x.IncludeSilenced
+	}
+	return false
+}
+
+func (x *QueryFlappingAlertsRequest) GetMinFires() int32 {
+	if x != nil {
+		return x.MinFires
+	}
+	return 0
+}
+
+func (x *QueryFlappingAlertsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *QueryFlappingAlertsRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+type FlappingAlert struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Fingerprint    string                 `protobuf:"bytes,1,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
+	AlertName      string                 `protobuf:"bytes,2,opt,name=alert_name,json=alertName,proto3" json:"alert_name,omitempty"`
+	Team           string                 `protobuf:"bytes,3,opt,name=team,proto3" json:"team,omitempty"`
+	Severity       string                 `protobuf:"bytes,4,opt,name=severity,proto3" json:"severity,omitempty"`
+	FireCount      int64                  `protobuf:"varint,5,opt,name=fire_count,json=fireCount,proto3" json:"fire_count,omitempty"`
+	AvgGapSeconds  float64                `protobuf:"fixed64,6,opt,name=avg_gap_seconds,json=avgGapSeconds,proto3" json:"avg_gap_seconds,omitempty"`
+	FiresPerHour   float64                `protobuf:"fixed64,7,opt,name=fires_per_hour,json=firesPerHour,proto3" json:"fires_per_hour,omitempty"`
+	AvgMttrSeconds float64                `protobuf:"fixed64,8,opt,name=avg_mttr_seconds,json=avgMttrSeconds,proto3" json:"avg_mttr_seconds,omitempty"`
+	FlapScore      int64                  `protobuf:"varint,9,opt,name=flap_score,json=flapScore,proto3" json:"flap_score,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *FlappingAlert) Reset() {
+	*x = FlappingAlert{}
+	mi := &file_proto_alert_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FlappingAlert) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlappingAlert) ProtoMessage() {}
+
+func (x *FlappingAlert) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlappingAlert.ProtoReflect.Descriptor instead.
+func (*FlappingAlert) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *FlappingAlert) GetFingerprint() string {
+	if x != nil {
+		return x.Fingerprint
+	}
+	return ""
+}
+
+func (x *FlappingAlert) GetAlertName() string {
+	if x != nil {
+		return x.AlertName
+	}
+	return ""
+}
+
+func (x *FlappingAlert) GetTeam() string {
+	if x != nil {
+		return x.Team
+	}
+	return ""
+}
+
+func (x *FlappingAlert) GetSeverity() string {
+	if x != nil {
+		return x.Severity
+	}
+	return ""
+}
+
+func (x *FlappingAlert) GetFireCount() int64 {
+	if x != nil {
+		return x.FireCount
+	}
+	return 0
+}
+
+func (x *FlappingAlert) GetAvgGapSeconds() float64 {
+	if x != nil {
+		return x.AvgGapSeconds
+	}
+	return 0
+}
+
+func (x *FlappingAlert) GetFiresPerHour() float64 {
+	if x != nil {
+		return x.FiresPerHour
+	}
+	return 0
+}
+
+func (x *FlappingAlert) GetAvgMttrSeconds() float64 {
+	if x != nil {
+		return x.AvgMttrSeconds
+	}
+	return 0
+}
+
+func (x *FlappingAlert) GetFlapScore() int64 {
+	if x != nil {
+		return x.FlapScore
+	}
+	return 0
+}
+
+type QueryFlappingAlertsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Alerts        []*FlappingAlert       `protobuf:"bytes,3,rep,name=alerts,proto3" json:"alerts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryFlappingAlertsResponse) Reset() {
+	*x = QueryFlappingAlertsResponse{}
+	mi := &file_proto_alert_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryFlappingAlertsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryFlappingAlertsResponse) ProtoMessage() {}
+
+func (x *QueryFlappingAlertsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryFlappingAlertsResponse.ProtoReflect.Descriptor instead.
+func (*QueryFlappingAlertsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *QueryFlappingAlertsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *QueryFlappingAlertsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *QueryFlappingAlertsResponse) GetAlerts() []*FlappingAlert {
+	if x != nil {
+		return x.Alerts
+	}
+	return nil
+}
+
+type SaveOnCallRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	RuleName      string                 `protobuf:"bytes,2,opt,name=rule_name,json=ruleName,proto3" json:"rule_name,omitempty"`
+	RuleConfig    *RuleConfig            `protobuf:"bytes,3,opt,name=rule_config,json=ruleConfig,proto3" json:"rule_config,omitempty"`
+	IsActive      bool                   `protobuf:"varint,4,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveOnCallRuleRequest) Reset() {
+	*x = SaveOnCallRuleRequest{}
+	mi := &file_proto_alert_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveOnCallRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveOnCallRuleRequest) ProtoMessage() {}
+
+func (x *SaveOnCallRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[118]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveOnCallRuleRequest.ProtoReflect.Descriptor instead.
+func (*SaveOnCallRuleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *SaveOnCallRuleRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *SaveOnCallRuleRequest) GetRuleName() string {
+	if x != nil {
+		return x.RuleName
+	}
+	return ""
+}
+
+func (x *SaveOnCallRuleRequest) GetRuleConfig() *RuleConfig {
+	if x != nil {
+		return x.RuleConfig
+	}
+	return nil
+}
+
+func (x *SaveOnCallRuleRequest) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+type SaveOnCallRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Rule          *OnCallRule            `protobuf:"bytes,2,opt,name=rule,proto3" json:"rule,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveOnCallRuleResponse) Reset() {
+	*x = SaveOnCallRuleResponse{}
+	mi := &file_proto_alert_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveOnCallRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveOnCallRuleResponse) ProtoMessage() {}
+
+func (x *SaveOnCallRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[119]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveOnCallRuleResponse.ProtoReflect.Descriptor instead.
+func (*SaveOnCallRuleResponse) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *SaveOnCallRuleResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *SaveOnCallRuleResponse) GetRule() *OnCallRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+func (x *SaveOnCallRuleResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type GetOnCallRulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	ActiveOnly    bool                   `protobuf:"varint,2,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOnCallRulesRequest) Reset() {
+	*x = GetOnCallRulesRequest{}
+	mi := &file_proto_alert_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOnCallRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOnCallRulesRequest) ProtoMessage() {}
+
+func (x *GetOnCallRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[120]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOnCallRulesRequest.ProtoReflect.Descriptor instead.
+func (*GetOnCallRulesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *GetOnCallRulesRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *GetOnCallRulesRequest) GetActiveOnly() bool {
+	if x != nil {
+		return x.ActiveOnly
+	}
+	return false
+}
+
+type GetOnCallRulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Rules         []*OnCallRule          `protobuf:"bytes,2,rep,name=rules,proto3" json:"rules,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOnCallRulesResponse) Reset() {
+	*x = GetOnCallRulesResponse{}
+	mi := &file_proto_alert_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOnCallRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOnCallRulesResponse) ProtoMessage() {}
+
+func (x *GetOnCallRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[121]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOnCallRulesResponse.ProtoReflect.Descriptor instead.
+func (*GetOnCallRulesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *GetOnCallRulesResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetOnCallRulesResponse) GetRules() []*OnCallRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *GetOnCallRulesResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type GetOnCallRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	RuleId        string                 `protobuf:"bytes,2,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOnCallRuleRequest) Reset() {
+	*x = GetOnCallRuleRequest{}
+	mi := &file_proto_alert_proto_msgTypes[122]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOnCallRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOnCallRuleRequest) ProtoMessage() {}
+
+func (x *GetOnCallRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[122]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOnCallRuleRequest.ProtoReflect.Descriptor instead.
+func (*GetOnCallRuleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *GetOnCallRuleRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *GetOnCallRuleRequest) GetRuleId() string {
+	if x != nil {
+		return x.RuleId
+	}
+	return ""
+}
+
+type GetOnCallRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Rule          *OnCallRule            `protobuf:"bytes,2,opt,name=rule,proto3" json:"rule,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOnCallRuleResponse) Reset() {
+	*x = GetOnCallRuleResponse{}
+	mi := &file_proto_alert_proto_msgTypes[123]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOnCallRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOnCallRuleResponse) ProtoMessage() {}
+
+func (x *GetOnCallRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[123]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOnCallRuleResponse.ProtoReflect.Descriptor instead.
+func (*GetOnCallRuleResponse) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *GetOnCallRuleResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetOnCallRuleResponse) GetRule() *OnCallRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+func (x *GetOnCallRuleResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type UpdateOnCallRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	RuleId        string                 `protobuf:"bytes,2,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	RuleName      string                 `protobuf:"bytes,3,opt,name=rule_name,json=ruleName,proto3" json:"rule_name,omitempty"`
+	RuleConfig    *RuleConfig            `protobuf:"bytes,4,opt,name=rule_config,json=ruleConfig,proto3" json:"rule_config,omitempty"`
+	IsActive      bool                   `protobuf:"varint,5,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateOnCallRuleRequest) Reset() {
+	*x = UpdateOnCallRuleRequest{}
+	mi := &file_proto_alert_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateOnCallRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOnCallRuleRequest) ProtoMessage() {}
+
+func (x *UpdateOnCallRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[124]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateOnCallRuleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateOnCallRuleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *UpdateOnCallRuleRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *UpdateOnCallRuleRequest) GetRuleId() string {
+	if x != nil {
+		return x.RuleId
+	}
+	return ""
+}
+
+func (x *UpdateOnCallRuleRequest) GetRuleName() string {
+	if x != nil {
+		return x.RuleName
+	}
+	return ""
+}
+
+func (x *UpdateOnCallRuleRequest) GetRuleConfig() *RuleConfig {
+	if x != nil {
+		return x.RuleConfig
+	}
+	return nil
+}
+
+func (x *UpdateOnCallRuleRequest) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+type UpdateOnCallRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Rule          *OnCallRule            `protobuf:"bytes,2,opt,name=rule,proto3" json:"rule,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateOnCallRuleResponse) Reset() {
+	*x = UpdateOnCallRuleResponse{}
+	mi := &file_proto_alert_proto_msgTypes[125]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateOnCallRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOnCallRuleResponse) ProtoMessage() {}
+
+func (x *UpdateOnCallRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[125]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateOnCallRuleResponse.ProtoReflect.Descriptor instead.
+func (*UpdateOnCallRuleResponse) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *UpdateOnCallRuleResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *UpdateOnCallRuleResponse) GetRule() *OnCallRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+func (x *UpdateOnCallRuleResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type DeleteOnCallRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	RuleId        string                 `protobuf:"bytes,2,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteOnCallRuleRequest) Reset() {
+	*x = DeleteOnCallRuleRequest{}
+	mi := &file_proto_alert_proto_msgTypes[126]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteOnCallRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteOnCallRuleRequest) ProtoMessage() {}
+
+func (x *DeleteOnCallRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[126]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteOnCallRuleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteOnCallRuleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *DeleteOnCallRuleRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *DeleteOnCallRuleRequest) GetRuleId() string {
+	if x != nil {
+		return x.RuleId
+	}
+	return ""
+}
+
+type DeleteOnCallRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteOnCallRuleResponse) Reset() {
+	*x = DeleteOnCallRuleResponse{}
+	mi := &file_proto_alert_proto_msgTypes[127]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteOnCallRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteOnCallRuleResponse) ProtoMessage() {}
+
+func (x *DeleteOnCallRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[127]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteOnCallRuleResponse.ProtoReflect.Descriptor instead.
+func (*DeleteOnCallRuleResponse) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *DeleteOnCallRuleResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DeleteOnCallRuleResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type TestOnCallRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	RuleConfig    *RuleConfig            `protobuf:"bytes,2,opt,name=rule_config,json=ruleConfig,proto3" json:"rule_config,omitempty"`
+	SampleSize    int32                  `protobuf:"varint,3,opt,name=sample_size,json=sampleSize,proto3" json:"sample_size,omitempty"` // Number of sample alerts to return
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TestOnCallRuleRequest) Reset() {
+	*x = TestOnCallRuleRequest{}
+	mi := &file_proto_alert_proto_msgTypes[128]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestOnCallRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestOnCallRuleRequest) ProtoMessage() {}
+
+func (x *TestOnCallRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[128]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestOnCallRuleRequest.ProtoReflect.Descriptor instead.
+func (*TestOnCallRuleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *TestOnCallRuleRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *TestOnCallRuleRequest) GetRuleConfig() *RuleConfig {
+	if x != nil {
+		return x.RuleConfig
+	}
+	return nil
+}
+
+func (x *TestOnCallRuleRequest) GetSampleSize() int32 {
+	if x != nil {
+		return x.SampleSize
+	}
+	return 0
+}
+
+type TestOnCallRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	TotalMatches  int64                  `protobuf:"varint,2,opt,name=total_matches,json=totalMatches,proto3" json:"total_matches,omitempty"`
+	SampleAlerts  []*AlertStatistic      `protobuf:"bytes,3,rep,name=sample_alerts,json=sampleAlerts,proto3" json:"sample_alerts,omitempty"`
+	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TestOnCallRuleResponse) Reset() {
+	*x = TestOnCallRuleResponse{}
+	mi := &file_proto_alert_proto_msgTypes[129]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestOnCallRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestOnCallRuleResponse) ProtoMessage() {}
+
+func (x *TestOnCallRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[129]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestOnCallRuleResponse.ProtoReflect.Descriptor instead.
+func (*TestOnCallRuleResponse) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *TestOnCallRuleResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *TestOnCallRuleResponse) GetTotalMatches() int64 {
+	if x != nil {
+		return x.TotalMatches
+	}
+	return 0
+}
+
+func (x *TestOnCallRuleResponse) GetSampleAlerts() []*AlertStatistic {
+	if x != nil {
+		return x.SampleAlerts
+	}
+	return nil
+}
+
+func (x *TestOnCallRuleResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type OnCallRule struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RuleName      string                 `protobuf:"bytes,3,opt,name=rule_name,json=ruleName,proto3" json:"rule_name,omitempty"`
+	RuleConfig    *RuleConfig            `protobuf:"bytes,4,opt,name=rule_config,json=ruleConfig,proto3" json:"rule_config,omitempty"`
+	IsActive      bool                   `protobuf:"varint,5,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OnCallRule) Reset() {
+	*x = OnCallRule{}
+	mi := &file_proto_alert_proto_msgTypes[130]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OnCallRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OnCallRule) ProtoMessage() {}
+
+func (x *OnCallRule) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[130]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OnCallRule.ProtoReflect.Descriptor instead.
+func (*OnCallRule) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *OnCallRule) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *OnCallRule) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *OnCallRule) GetRuleName() string {
+	if x != nil {
+		return x.RuleName
+	}
+	return ""
+}
+
+func (x *OnCallRule) GetRuleConfig() *RuleConfig {
+	if x != nil {
+		return x.RuleConfig
+	}
+	return nil
+}
+
+func (x *OnCallRule) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *OnCallRule) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *OnCallRule) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type RuleConfig struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Criteria      []*RuleCriterion       `protobuf:"bytes,1,rep,name=criteria,proto3" json:"criteria,omitempty"`
+	Logic         string                 `protobuf:"bytes,2,opt,name=logic,proto3" json:"logic,omitempty"` // "AND" or "OR"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RuleConfig) Reset() {
+	*x = RuleConfig{}
+	mi := &file_proto_alert_proto_msgTypes[131]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RuleConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuleConfig) ProtoMessage() {}
+
+func (x *RuleConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[131]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RuleConfig.ProtoReflect.Descriptor instead.
+func (*RuleConfig) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *RuleConfig) GetCriteria() []*RuleCriterion {
+	if x != nil {
+		return x.Criteria
+	}
+	return nil
+}
+
+func (x *RuleConfig) GetLogic() string {
+	if x != nil {
+		return x.Logic
+	}
+	return ""
+}
+
+type RuleCriterion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`         // "severity", "label", "alert_name"
+	Operator      string                 `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"` // "equals", "in", "not_equals", "contains", "regex", "starts_with", "ends_with"
+	Value         string                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`       // Single value (for equals, not_equals, contains, etc.)
+	Values        []string               `protobuf:"bytes,4,rep,name=values,proto3" json:"values,omitempty"`     // Multiple values (for "in" operator)
+	Key           string                 `protobuf:"bytes,5,opt,name=key,proto3" json:"key,omitempty"`           // Label key (for label type)
+	Pattern       string                 `protobuf:"bytes,6,opt,name=pattern,proto3" json:"pattern,omitempty"`   // Pattern/regex (for alert_name type)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RuleCriterion) Reset() {
+	*x = RuleCriterion{}
+	mi := &file_proto_alert_proto_msgTypes[132]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RuleCriterion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuleCriterion) ProtoMessage() {}
+
+func (x *RuleCriterion) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[132]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RuleCriterion.ProtoReflect.Descriptor instead.
+func (*RuleCriterion) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *RuleCriterion) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *RuleCriterion) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *RuleCriterion) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *RuleCriterion) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *RuleCriterion) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *RuleCriterion) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+type AlertStatistic struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Fingerprint    string                 `protobuf:"bytes,2,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
+	AlertName      string                 `protobuf:"bytes,3,opt,name=alert_name,json=alertName,proto3" json:"alert_name,omitempty"`
+	Severity       string                 `protobuf:"bytes,4,opt,name=severity,proto3" json:"severity,omitempty"`
+	Metadata       []byte                 `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"` // JSON serialized metadata
+	FiredAt        *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=fired_at,json=firedAt,proto3" json:"fired_at,omitempty"`
+	ResolvedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=resolved_at,json=resolvedAt,proto3" json:"resolved_at,omitempty"`
+	AcknowledgedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=acknowledged_at,json=acknowledgedAt,proto3" json:"acknowledged_at,omitempty"`
+	MttrSeconds    int32                  `protobuf:"varint,9,opt,name=mttr_seconds,json=mttrSeconds,proto3" json:"mttr_seconds,omitempty"`  // Mean Time To Resolve (resolved - fired)
+	MttaSeconds    int32                  `protobuf:"varint,10,opt,name=mtta_seconds,json=mttaSeconds,proto3" json:"mtta_seconds,omitempty"` // Mean Time To Acknowledge (acknowledged - fired)
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	FixTimeSeconds int32                  `protobuf:"varint,13,opt,name=fix_time_seconds,json=fixTimeSeconds,proto3" json:"fix_time_seconds,omitempty"` // Fix Time (resolved - acknowledged)
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AlertStatistic) Reset() {
+	*x = AlertStatistic{}
+	mi := &file_proto_alert_proto_msgTypes[133]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AlertStatistic) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AlertStatistic) ProtoMessage() {}
+
+func (x *AlertStatistic) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[133]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AlertStatistic.ProtoReflect.Descriptor instead.
+func (*AlertStatistic) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *AlertStatistic) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AlertStatistic) GetFingerprint() string {
+	if x != nil {
+		return x.Fingerprint
+	}
+	return ""
+}
+
+func (x *AlertStatistic) GetAlertName() string {
+	if x != nil {
+		return x.AlertName
+	}
+	return ""
+}
+
+func (x *AlertStatistic) GetSeverity() string {
+	if x != nil {
+		return x.Severity
+	}
+	return ""
+}
+
+func (x *AlertStatistic) GetMetadata() []byte {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *AlertStatistic) GetFiredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FiredAt
+	}
+	return nil
+}
+
+func (x *AlertStatistic) GetResolvedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ResolvedAt
+	}
+	return nil
+}
+
+func (x *AlertStatistic) GetAcknowledgedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AcknowledgedAt
+	}
+	return nil
+}
+
+func (x *AlertStatistic) GetMttrSeconds() int32 {
+	if x != nil {
+		return x.MttrSeconds
+	}
+	return 0
+}
+
+func (x *AlertStatistic) GetMttaSeconds() int32 {
+	if x != nil {
+		return x.MttaSeconds
+	}
+	return 0
+}
+
+func (x *AlertStatistic) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *AlertStatistic) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *AlertStatistic) GetFixTimeSeconds() int32 {
+	if x != nil {
+		return x.FixTimeSeconds
+	}
+	return 0
+}
+
+type GetStatisticsSummaryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStatisticsSummaryRequest) Reset() {
+	*x = GetStatisticsSummaryRequest{}
+	mi := &file_proto_alert_proto_msgTypes[134]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStatisticsSummaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatisticsSummaryRequest) ProtoMessage() {}
+
+func (x *GetStatisticsSummaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[134]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatisticsSummaryRequest.ProtoReflect.Descriptor instead.
+func (*GetStatisticsSummaryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *GetStatisticsSummaryRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+type GetStatisticsSummaryResponse struct {
+	state           protoimpl.MessageState           `protogen:"open.v1"`
+	Success         bool                             `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	TotalStatistics int64                            `protobuf:"varint,2,opt,name=total_statistics,json=totalStatistics,proto3" json:"total_statistics,omitempty"`
+	BySeverity      map[string]*AggregatedStatistics `protobuf:"bytes,3,rep,name=by_severity,json=bySeverity,proto3" json:"by_severity,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	EarliestAlert   *timestamppb.Timestamp           `protobuf:"bytes,4,opt,name=earliest_alert,json=earliestAlert,proto3" json:"earliest_alert,omitempty"`
+	LatestAlert     *timestamppb.Timestamp           `protobuf:"bytes,5,opt,name=latest_alert,json=latestAlert,proto3" json:"latest_alert,omitempty"`
+	Message         string                           `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetStatisticsSummaryResponse) Reset() {
+	*x = GetStatisticsSummaryResponse{}
+	mi := &file_proto_alert_proto_msgTypes[135]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStatisticsSummaryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatisticsSummaryResponse) ProtoMessage() {}
+
+func (x *GetStatisticsSummaryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[135]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatisticsSummaryResponse.ProtoReflect.Descriptor instead.
+func (*GetStatisticsSummaryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *GetStatisticsSummaryResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetStatisticsSummaryResponse) GetTotalStatistics() int64 {
+	if x != nil {
+		return x.TotalStatistics
+	}
+	return 0
+}
+
+func (x *GetStatisticsSummaryResponse) GetBySeverity() map[string]*AggregatedStatistics {
+	if x != nil {
+		return x.BySeverity
+	}
+	return nil
+}
+
+func (x *GetStatisticsSummaryResponse) GetEarliestAlert() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EarliestAlert
+	}
+	return nil
+}
+
+func (x *GetStatisticsSummaryResponse) GetLatestAlert() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LatestAlert
+	}
+	return nil
+}
+
+func (x *GetStatisticsSummaryResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type CaptureAlertFiredRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Fingerprint    string                 `protobuf:"bytes,1,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
+	AlertName      string                 `protobuf:"bytes,2,opt,name=alert_name,json=alertName,proto3" json:"alert_name,omitempty"`
+	Severity       string                 `protobuf:"bytes,3,opt,name=severity,proto3" json:"severity,omitempty"`
+	StartsAt       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	Metadata       []byte                 `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`                                      // JSON metadata containing labels, annotations, etc.
+	SilencedAtFire bool                   `protobuf:"varint,6,opt,name=silenced_at_fire,json=silencedAtFire,proto3" json:"silenced_at_fire,omitempty"` // Whether the alert was silenced when it fired
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CaptureAlertFiredRequest) Reset() {
+	*x = CaptureAlertFiredRequest{}
+	mi := &file_proto_alert_proto_msgTypes[136]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CaptureAlertFiredRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CaptureAlertFiredRequest) ProtoMessage() {}
+
+func (x *CaptureAlertFiredRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[136]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CaptureAlertFiredRequest.ProtoReflect.Descriptor instead.
+func (*CaptureAlertFiredRequest) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *CaptureAlertFiredRequest) GetFingerprint() string {
+	if x != nil {
+		return x.Fingerprint
+	}
+	return ""
+}
+
+func (x *CaptureAlertFiredRequest) GetAlertName() string {
+	if x != nil {
+		return x.AlertName
+	}
+	return ""
+}
+
+func (x *CaptureAlertFiredRequest) GetSeverity() string {
+	if x != nil {
+		return x.Severity
+	}
+	return ""
+}
+
+func (x *CaptureAlertFiredRequest) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *CaptureAlertFiredRequest) GetMetadata() []byte {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *CaptureAlertFiredRequest) GetSilencedAtFire() bool {
+	if x != nil {
+		return x.SilencedAtFire
+	}
+	return false
+}
+
+type CaptureAlertFiredResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CaptureAlertFiredResponse) Reset() {
+	*x = CaptureAlertFiredResponse{}
+	mi := &file_proto_alert_proto_msgTypes[137]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CaptureAlertFiredResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CaptureAlertFiredResponse) ProtoMessage() {}
+
+func (x *CaptureAlertFiredResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[137]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CaptureAlertFiredResponse.ProtoReflect.Descriptor instead.
+func (*CaptureAlertFiredResponse) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *CaptureAlertFiredResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *CaptureAlertFiredResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type UpdateAlertResolvedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Fingerprint   string                 `protobuf:"bytes,1,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
+	ResolvedAt    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=resolved_at,json=resolvedAt,proto3" json:"resolved_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAlertResolvedRequest) Reset() {
+	*x = UpdateAlertResolvedRequest{}
+	mi := &file_proto_alert_proto_msgTypes[138]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAlertResolvedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAlertResolvedRequest) ProtoMessage() {}
+
+func (x *UpdateAlertResolvedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[138]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAlertResolvedRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAlertResolvedRequest) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *UpdateAlertResolvedRequest) GetFingerprint() string {
+	if x != nil {
+		return x.Fingerprint
+	}
+	return ""
+}
+
+func (x *UpdateAlertResolvedRequest) GetResolvedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ResolvedAt
+	}
+	return nil
+}
+
+type UpdateAlertResolvedResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAlertResolvedResponse) Reset() {
+	*x = UpdateAlertResolvedResponse{}
+	mi := &file_proto_alert_proto_msgTypes[139]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAlertResolvedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAlertResolvedResponse) ProtoMessage() {}
+
+func (x *UpdateAlertResolvedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[139]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAlertResolvedResponse.ProtoReflect.Descriptor instead.
+func (*UpdateAlertResolvedResponse) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{139}
+}
+
+func (x *UpdateAlertResolvedResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *UpdateAlertResolvedResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type UpdateAlertAcknowledgedRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Fingerprint    string                 `protobuf:"bytes,1,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
+	AcknowledgedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=acknowledged_at,json=acknowledgedAt,proto3" json:"acknowledged_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateAlertAcknowledgedRequest) Reset() {
+	*x = UpdateAlertAcknowledgedRequest{}
+	mi := &file_proto_alert_proto_msgTypes[140]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAlertAcknowledgedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAlertAcknowledgedRequest) ProtoMessage() {}
+
+func (x *UpdateAlertAcknowledgedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[140]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAlertAcknowledgedRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAlertAcknowledgedRequest) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *UpdateAlertAcknowledgedRequest) GetFingerprint() string {
+	if x != nil {
+		return x.Fingerprint
+	}
+	return ""
+}
+
+func (x *UpdateAlertAcknowledgedRequest) GetAcknowledgedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AcknowledgedAt
+	}
+	return nil
+}
+
+type UpdateAlertAcknowledgedResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAlertAcknowledgedResponse) Reset() {
+	*x = UpdateAlertAcknowledgedResponse{}
+	mi := &file_proto_alert_proto_msgTypes[141]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAlertAcknowledgedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAlertAcknowledgedResponse) ProtoMessage() {}
+
+func (x *UpdateAlertAcknowledgedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[141]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAlertAcknowledgedResponse.ProtoReflect.Descriptor instead.
+func (*UpdateAlertAcknowledgedResponse) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{141}
+}
+
+func (x *UpdateAlertAcknowledgedResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *UpdateAlertAcknowledgedResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type QueryRecentlyResolvedRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	SessionId       string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	StartDate       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate         *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Severity        []string               `protobuf:"bytes,4,rep,name=severity,proto3" json:"severity,omitempty"`                                       // Optional filter
+	Teams           []string               `protobuf:"bytes,5,rep,name=teams,proto3" json:"teams,omitempty"`                                             // Optional filter (OR logic)
+	AlertNames      []string               `protobuf:"bytes,6,rep,name=alert_names,json=alertNames,proto3" json:"alert_names,omitempty"`                 // Optional filter (OR logic, LIKE search)
+	Limit           int32                  `protobuf:"varint,7,opt,name=limit,proto3" json:"limit,omitempty"`                                            // Default 100, max 1000
+	Offset          int32                  `protobuf:"varint,8,opt,name=offset,proto3" json:"offset,omitempty"`                                          // For pagination
+	IncludeSilenced bool                   `protobuf:"varint,9,opt,name=include_silenced,json=includeSilenced,proto3" json:"include_silenced,omitempty"` // Whether to include silenced (suppressed) alerts (default: false)
+	SearchQuery     string                 `protobuf:"bytes,10,opt,name=search_query,json=searchQuery,proto3" json:"search_query,omitempty"`             // Search across alert name, instance, summary, description
+	UserId          string                 `protobuf:"bytes,11,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                            // User ID for hidden alerts filtering
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
 
-func (x *GetStatisticsSummaryResponse) Reset() {
-	*x = GetStatisticsSummaryResponse{}
-	mi := &file_proto_alert_proto_msgTypes[109]
+func (x *QueryRecentlyResolvedRequest) Reset() {
+	*x = QueryRecentlyResolvedRequest{}
+	mi := &file_proto_alert_proto_msgTypes[142]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetStatisticsSummaryResponse) String() string {
+func (x *QueryRecentlyResolvedRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetStatisticsSummaryResponse) ProtoMessage() {}
+func (*QueryRecentlyResolvedRequest) ProtoMessage() {}
 
-func (x *GetStatisticsSummaryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[109]
+func (x *QueryRecentlyResolvedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[142]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7386,80 +9755,125 @@ func (x *GetStatisticsSummaryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetStatisticsSummaryResponse.ProtoReflect.Descriptor instead.
-func (*GetStatisticsSummaryResponse) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{109}
+// Deprecated: Use QueryRecentlyResolvedRequest.ProtoReflect.Descriptor instead.
+func (*QueryRecentlyResolvedRequest) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{142}
 }
 
-func (x *GetStatisticsSummaryResponse) GetSuccess() bool {
+func (x *QueryRecentlyResolvedRequest) GetSessionId() string {
 	if x != nil {
-		return x.Success
+		return x.SessionId
 	}
-	return false
+	return ""
 }
 
-func (x *GetStatisticsSummaryResponse) GetTotalStatistics() int64 {
+func (x *QueryRecentlyResolvedRequest) GetStartDate() *timestamppb.Timestamp {
 	if x != nil {
-		return x.TotalStatistics
+		return x.StartDate
 	}
-	return 0
+	return nil
 }
 
-func (x *GetStatisticsSummaryResponse) GetBySeverity() map[string]*AggregatedStatistics {
+func (x *QueryRecentlyResolvedRequest) GetEndDate() *timestamppb.Timestamp {
 	if x != nil {
-		return x.BySeverity
+		return x.EndDate
 	}
 	return nil
 }
 
-func (x *GetStatisticsSummaryResponse) GetEarliestAlert() *timestamppb.Timestamp {
+func (x *QueryRecentlyResolvedRequest) GetSeverity() []string {
 	if x != nil {
-		return x.EarliestAlert
+		return x.Severity
 	}
 	return nil
 }
 
-func (x *GetStatisticsSummaryResponse) GetLatestAlert() *timestamppb.Timestamp {
+func (x *QueryRecentlyResolvedRequest) GetTeams() []string {
 	if x != nil {
-		return x.LatestAlert
+		return x.Teams
 	}
 	return nil
 }
 
-func (x *GetStatisticsSummaryResponse) GetMessage() string {
+func (x *QueryRecentlyResolvedRequest) GetAlertNames() []string {
 	if x != nil {
-		return x.Message
+		return x.AlertNames
+	}
+	return nil
+}
+
+func (x *QueryRecentlyResolvedRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *QueryRecentlyResolvedRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *QueryRecentlyResolvedRequest) GetIncludeSilenced() bool {
+	if x != nil {
+		return x.IncludeSilenced
+	}
+	return false
+}
+
+func (x *QueryRecentlyResolvedRequest) GetSearchQuery() string {
+	if x != nil {
+		return x.SearchQuery
 	}
 	return ""
 }
 
-type CaptureAlertFiredRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Fingerprint    string                 `protobuf:"bytes,1,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
-	AlertName      string                 `protobuf:"bytes,2,opt,name=alert_name,json=alertName,proto3" json:"alert_name,omitempty"`
-	Severity       string                 `protobuf:"bytes,3,opt,name=severity,proto3" json:"severity,omitempty"`
-	StartsAt       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
-	Metadata       []byte                 `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`                                      // JSON metadata containing labels, annotations, etc.
-	SilencedAtFire bool                   `protobuf:"varint,6,opt,name=silenced_at_fire,json=silencedAtFire,proto3" json:"silenced_at_fire,omitempty"` // Whether the alert was silenced when it fired
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *QueryRecentlyResolvedRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
 }
 
-func (x *CaptureAlertFiredRequest) Reset() {
-	*x = CaptureAlertFiredRequest{}
-	mi := &file_proto_alert_proto_msgTypes[110]
+type ResolvedAlertItem struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Fingerprint     string                 `protobuf:"bytes,1,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
+	AlertName       string                 `protobuf:"bytes,2,opt,name=alert_name,json=alertName,proto3" json:"alert_name,omitempty"`
+	Severity        string                 `protobuf:"bytes,3,opt,name=severity,proto3" json:"severity,omitempty"`
+	OccurrenceCount int32                  `protobuf:"varint,4,opt,name=occurrence_count,json=occurrenceCount,proto3" json:"occurrence_count,omitempty"` // How many times resolved in time range
+	FirstFiredAt    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=first_fired_at,json=firstFiredAt,proto3" json:"first_fired_at,omitempty"`         // Earliest fired_at
+	LastResolvedAt  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_resolved_at,json=lastResolvedAt,proto3" json:"last_resolved_at,omitempty"`   // Most recent resolved_at
+	TotalMttr       int32                  `protobuf:"varint,7,opt,name=total_mttr,json=totalMttr,proto3" json:"total_mttr,omitempty"`                   // Sum of MTTR (resolved - fired)
+	AvgMttr         float64                `protobuf:"fixed64,8,opt,name=avg_mttr,json=avgMttr,proto3" json:"avg_mttr,omitempty"`                        // Average MTTR
+	TotalMtta       int32                  `protobuf:"varint,9,opt,name=total_mtta,json=totalMtta,proto3" json:"total_mtta,omitempty"`                   // Sum of MTTA (acknowledged - fired)
+	AvgMtta         float64                `protobuf:"fixed64,10,opt,name=avg_mtta,json=avgMtta,proto3" json:"avg_mtta,omitempty"`                       // Average MTTA
+	Labels          map[string]string      `protobuf:"bytes,11,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Annotations     map[string]string      `protobuf:"bytes,12,rep,name=annotations,proto3" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Source          string                 `protobuf:"bytes,13,opt,name=source,proto3" json:"source,omitempty"`
+	Instance        string                 `protobuf:"bytes,14,opt,name=instance,proto3" json:"instance,omitempty"`
+	Team            string                 `protobuf:"bytes,15,opt,name=team,proto3" json:"team,omitempty"`
+	AvgFixTime      float64                `protobuf:"fixed64,16,opt,name=avg_fix_time,json=avgFixTime,proto3" json:"avg_fix_time,omitempty"` // Average Fix Time (resolved - acknowledged)
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ResolvedAlertItem) Reset() {
+	*x = ResolvedAlertItem{}
+	mi := &file_proto_alert_proto_msgTypes[143]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CaptureAlertFiredRequest) String() string {
+func (x *ResolvedAlertItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CaptureAlertFiredRequest) ProtoMessage() {}
+func (*ResolvedAlertItem) ProtoMessage() {}
 
-func (x *CaptureAlertFiredRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[110]
+func (x *ResolvedAlertItem) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[143]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7470,180 +9884,150 @@ func (x *CaptureAlertFiredRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CaptureAlertFiredRequest.ProtoReflect.Descriptor instead.
-func (*CaptureAlertFiredRequest) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{110}
+// Deprecated: Use ResolvedAlertItem.ProtoReflect.Descriptor instead.
+func (*ResolvedAlertItem) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{143}
 }
 
-func (x *CaptureAlertFiredRequest) GetFingerprint() string {
+func (x *ResolvedAlertItem) GetFingerprint() string {
 	if x != nil {
 		return x.Fingerprint
 	}
 	return ""
 }
 
-func (x *CaptureAlertFiredRequest) GetAlertName() string {
+func (x *ResolvedAlertItem) GetAlertName() string {
 	if x != nil {
 		return x.AlertName
 	}
 	return ""
 }
 
-func (x *CaptureAlertFiredRequest) GetSeverity() string {
+func (x *ResolvedAlertItem) GetSeverity() string {
 	if x != nil {
 		return x.Severity
 	}
 	return ""
 }
 
-func (x *CaptureAlertFiredRequest) GetStartsAt() *timestamppb.Timestamp {
+func (x *ResolvedAlertItem) GetOccurrenceCount() int32 {
 	if x != nil {
-		return x.StartsAt
+		return x.OccurrenceCount
 	}
-	return nil
+	return 0
 }
 
-func (x *CaptureAlertFiredRequest) GetMetadata() []byte {
+func (x *ResolvedAlertItem) GetFirstFiredAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Metadata
+		return x.FirstFiredAt
 	}
 	return nil
 }
 
-func (x *CaptureAlertFiredRequest) GetSilencedAtFire() bool {
+func (x *ResolvedAlertItem) GetLastResolvedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.SilencedAtFire
+		return x.LastResolvedAt
 	}
-	return false
+	return nil
 }
 
-type CaptureAlertFiredResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ResolvedAlertItem) GetTotalMttr() int32 {
+	if x != nil {
+		return x.TotalMttr
+	}
+	return 0
 }
 
-func (x *CaptureAlertFiredResponse) Reset() {
-	*x = CaptureAlertFiredResponse{}
-	mi := &file_proto_alert_proto_msgTypes[111]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *ResolvedAlertItem) GetAvgMttr() float64 {
+	if x != nil {
+		return x.AvgMttr
+	}
+	return 0
 }
 
-func (x *CaptureAlertFiredResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ResolvedAlertItem) GetTotalMtta() int32 {
+	if x != nil {
+		return x.TotalMtta
+	}
+	return 0
 }
 
-func (*CaptureAlertFiredResponse) ProtoMessage() {}
-
-func (x *CaptureAlertFiredResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[111]
+func (x *ResolvedAlertItem) GetAvgMtta() float64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.AvgMtta
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use CaptureAlertFiredResponse.ProtoReflect.Descriptor instead.
-func (*CaptureAlertFiredResponse) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{111}
+func (x *ResolvedAlertItem) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
 }
 
-func (x *CaptureAlertFiredResponse) GetSuccess() bool {
+func (x *ResolvedAlertItem) GetAnnotations() map[string]string {
 	if x != nil {
-		return x.Success
+		return x.Annotations
 	}
-	return false
+	return nil
 }
 
-func (x *CaptureAlertFiredResponse) GetMessage() string {
+func (x *ResolvedAlertItem) GetSource() string {
 	if x != nil {
-		return x.Message
+		return x.Source
 	}
 	return ""
 }
 
-type UpdateAlertResolvedRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Fingerprint   string                 `protobuf:"bytes,1,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
-	ResolvedAt    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=resolved_at,json=resolvedAt,proto3" json:"resolved_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *UpdateAlertResolvedRequest) Reset() {
-	*x = UpdateAlertResolvedRequest{}
-	mi := &file_proto_alert_proto_msgTypes[112]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *UpdateAlertResolvedRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*UpdateAlertResolvedRequest) ProtoMessage() {}
-
-func (x *UpdateAlertResolvedRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[112]
+func (x *ResolvedAlertItem) GetInstance() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Instance
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use UpdateAlertResolvedRequest.ProtoReflect.Descriptor instead.
-func (*UpdateAlertResolvedRequest) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{112}
+	return ""
 }
 
-func (x *UpdateAlertResolvedRequest) GetFingerprint() string {
+func (x *ResolvedAlertItem) GetTeam() string {
 	if x != nil {
-		return x.Fingerprint
+		return x.Team
 	}
 	return ""
 }
 
-func (x *UpdateAlertResolvedRequest) GetResolvedAt() *timestamppb.Timestamp {
+func (x *ResolvedAlertItem) GetAvgFixTime() float64 {
 	if x != nil {
-		return x.ResolvedAt
+		return x.AvgFixTime
 	}
-	return nil
+	return 0
 }
 
-type UpdateAlertResolvedResponse struct {
+type QueryRecentlyResolvedResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Alerts        []*ResolvedAlertItem   `protobuf:"bytes,3,rep,name=alerts,proto3" json:"alerts,omitempty"`
+	TotalCount    int64                  `protobuf:"varint,4,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	StartDate     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateAlertResolvedResponse) Reset() {
-	*x = UpdateAlertResolvedResponse{}
-	mi := &file_proto_alert_proto_msgTypes[113]
+func (x *QueryRecentlyResolvedResponse) Reset() {
+	*x = QueryRecentlyResolvedResponse{}
+	mi := &file_proto_alert_proto_msgTypes[144]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateAlertResolvedResponse) String() string {
+func (x *QueryRecentlyResolvedResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateAlertResolvedResponse) ProtoMessage() {}
+func (*QueryRecentlyResolvedResponse) ProtoMessage() {}
 
-func (x *UpdateAlertResolvedResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[113]
+func (x *QueryRecentlyResolvedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[144]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7654,48 +10038,77 @@ func (x *UpdateAlertResolvedResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateAlertResolvedResponse.ProtoReflect.Descriptor instead.
-func (*UpdateAlertResolvedResponse) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{113}
+// Deprecated: Use QueryRecentlyResolvedResponse.ProtoReflect.Descriptor instead.
+func (*QueryRecentlyResolvedResponse) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{144}
 }
 
-func (x *UpdateAlertResolvedResponse) GetSuccess() bool {
+func (x *QueryRecentlyResolvedResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *UpdateAlertResolvedResponse) GetMessage() string {
+func (x *QueryRecentlyResolvedResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-type UpdateAlertAcknowledgedRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Fingerprint    string                 `protobuf:"bytes,1,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
-	AcknowledgedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=acknowledged_at,json=acknowledgedAt,proto3" json:"acknowledged_at,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *QueryRecentlyResolvedResponse) GetAlerts() []*ResolvedAlertItem {
+	if x != nil {
+		return x.Alerts
+	}
+	return nil
 }
 
-func (x *UpdateAlertAcknowledgedRequest) Reset() {
-	*x = UpdateAlertAcknowledgedRequest{}
-	mi := &file_proto_alert_proto_msgTypes[114]
+func (x *QueryRecentlyResolvedResponse) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *QueryRecentlyResolvedResponse) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *QueryRecentlyResolvedResponse) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+type GetAlertHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Fingerprint   string                 `protobuf:"bytes,2,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"` // Maximum number of history records to return (0 = no limit)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAlertHistoryRequest) Reset() {
+	*x = GetAlertHistoryRequest{}
+	mi := &file_proto_alert_proto_msgTypes[145]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateAlertAcknowledgedRequest) String() string {
+func (x *GetAlertHistoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateAlertAcknowledgedRequest) ProtoMessage() {}
+func (*GetAlertHistoryRequest) ProtoMessage() {}
 
-func (x *UpdateAlertAcknowledgedRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[114]
+func (x *GetAlertHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[145]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7706,48 +10119,56 @@ func (x *UpdateAlertAcknowledgedRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateAlertAcknowledgedRequest.ProtoReflect.Descriptor instead.
-func (*UpdateAlertAcknowledgedRequest) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{114}
+// Deprecated: Use GetAlertHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetAlertHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{145}
 }
 
-func (x *UpdateAlertAcknowledgedRequest) GetFingerprint() string {
+func (x *GetAlertHistoryRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *GetAlertHistoryRequest) GetFingerprint() string {
 	if x != nil {
 		return x.Fingerprint
 	}
 	return ""
 }
 
-func (x *UpdateAlertAcknowledgedRequest) GetAcknowledgedAt() *timestamppb.Timestamp {
+func (x *GetAlertHistoryRequest) GetLimit() int32 {
 	if x != nil {
-		return x.AcknowledgedAt
+		return x.Limit
 	}
-	return nil
+	return 0
 }
 
-type UpdateAlertAcknowledgedResponse struct {
+type GetAlertHistoryResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	History       []*AlertStatistic      `protobuf:"bytes,3,rep,name=history,proto3" json:"history,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateAlertAcknowledgedResponse) Reset() {
-	*x = UpdateAlertAcknowledgedResponse{}
-	mi := &file_proto_alert_proto_msgTypes[115]
+func (x *GetAlertHistoryResponse) Reset() {
+	*x = GetAlertHistoryResponse{}
+	mi := &file_proto_alert_proto_msgTypes[146]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateAlertAcknowledgedResponse) String() string {
+func (x *GetAlertHistoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateAlertAcknowledgedResponse) ProtoMessage() {}
+func (*GetAlertHistoryResponse) ProtoMessage() {}
 
-func (x *UpdateAlertAcknowledgedResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[115]
+func (x *GetAlertHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[146]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7758,57 +10179,66 @@ func (x *UpdateAlertAcknowledgedResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateAlertAcknowledgedResponse.ProtoReflect.Descriptor instead.
-func (*UpdateAlertAcknowledgedResponse) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{115}
+// Deprecated: Use GetAlertHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetAlertHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{146}
 }
 
-func (x *UpdateAlertAcknowledgedResponse) GetSuccess() bool {
+func (x *GetAlertHistoryResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *UpdateAlertAcknowledgedResponse) GetMessage() string {
+func (x *GetAlertHistoryResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-type QueryRecentlyResolvedRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	SessionId       string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	StartDate       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
-	EndDate         *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
-	Severity        []string               `protobuf:"bytes,4,rep,name=severity,proto3" json:"severity,omitempty"`                                       // Optional filter
-	Teams           []string               `protobuf:"bytes,5,rep,name=teams,proto3" json:"teams,omitempty"`                                             // Optional filter (OR logic)
-	AlertNames      []string               `protobuf:"bytes,6,rep,name=alert_names,json=alertNames,proto3" json:"alert_names,omitempty"`                 // Optional filter (OR logic, LIKE search)
-	Limit           int32                  `protobuf:"varint,7,opt,name=limit,proto3" json:"limit,omitempty"`                                            // Default 100, max 1000
-	Offset          int32                  `protobuf:"varint,8,opt,name=offset,proto3" json:"offset,omitempty"`                                          // For pagination
-	IncludeSilenced bool                   `protobuf:"varint,9,opt,name=include_silenced,json=includeSilenced,proto3" json:"include_silenced,omitempty"` // Whether to include silenced (suppressed) alerts (default: false)
-	SearchQuery     string                 `protobuf:"bytes,10,opt,name=search_query,json=searchQuery,proto3" json:"search_query,omitempty"`             // Search across alert name, instance, summary, description
-	UserId          string                 `protobuf:"bytes,11,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                            // User ID for hidden alerts filtering
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *GetAlertHistoryResponse) GetHistory() []*AlertStatistic {
+	if x != nil {
+		return x.History
+	}
+	return nil
 }
 
-func (x *QueryRecentlyResolvedRequest) Reset() {
-	*x = QueryRecentlyResolvedRequest{}
-	mi := &file_proto_alert_proto_msgTypes[116]
+type GetAlertsByNameRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	SessionId         string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	StartDate         *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate           *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	AlertName         string                 `protobuf:"bytes,4,opt,name=alert_name,json=alertName,proto3" json:"alert_name,omitempty"`
+	ApplyRules        bool                   `protobuf:"varint,5,opt,name=apply_rules,json=applyRules,proto3" json:"apply_rules,omitempty"`
+	FilterByTimeOfDay bool                   `protobuf:"varint,6,opt,name=filter_by_time_of_day,json=filterByTimeOfDay,proto3" json:"filter_by_time_of_day,omitempty"`
+	TimeOfDayStart    string                 `protobuf:"bytes,7,opt,name=time_of_day_start,json=timeOfDayStart,proto3" json:"time_of_day_start,omitempty"`
+	TimeOfDayEnd      string                 `protobuf:"bytes,8,opt,name=time_of_day_end,json=timeOfDayEnd,proto3" json:"time_of_day_end,omitempty"`
+	Limit             int32                  `protobuf:"varint,9,opt,name=limit,proto3" json:"limit,omitempty"`
+	IncludeWeekends   bool                   `protobuf:"varint,10,opt,name=include_weekends,json=includeWeekends,proto3" json:"include_weekends,omitempty"` // Include weekends in time-of-day filter (default: true)
+	WeekendMode       string                 `protobuf:"bytes,11,opt,name=weekend_mode,json=weekendMode,proto3" json:"weekend_mode,omitempty"`              // "exclude", "same_hours", "full_weekends"
+	Severities        []string               `protobuf:"bytes,12,rep,name=severities,proto3" json:"severities,omitempty"`                                   // Filter by severities (multi-select, OR logic)
+	Teams             []string               `protobuf:"bytes,13,rep,name=teams,proto3" json:"teams,omitempty"`                                             // Filter by teams (multi-select, OR logic)
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GetAlertsByNameRequest) Reset() {
+	*x = GetAlertsByNameRequest{}
+	mi := &file_proto_alert_proto_msgTypes[147]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *QueryRecentlyResolvedRequest) String() string {
+func (x *GetAlertsByNameRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryRecentlyResolvedRequest) ProtoMessage() {}
+func (*GetAlertsByNameRequest) ProtoMessage() {}
 
-func (x *QueryRecentlyResolvedRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[116]
+func (x *GetAlertsByNameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[147]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7819,125 +10249,127 @@ func (x *QueryRecentlyResolvedRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QueryRecentlyResolvedRequest.ProtoReflect.Descriptor instead.
-func (*QueryRecentlyResolvedRequest) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{116}
+// Deprecated: Use GetAlertsByNameRequest.ProtoReflect.Descriptor instead.
+func (*GetAlertsByNameRequest) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{147}
 }
 
-func (x *QueryRecentlyResolvedRequest) GetSessionId() string {
+func (x *GetAlertsByNameRequest) GetSessionId() string {
 	if x != nil {
 		return x.SessionId
 	}
 	return ""
 }
 
-func (x *QueryRecentlyResolvedRequest) GetStartDate() *timestamppb.Timestamp {
+func (x *GetAlertsByNameRequest) GetStartDate() *timestamppb.Timestamp {
 	if x != nil {
 		return x.StartDate
 	}
 	return nil
 }
 
-func (x *QueryRecentlyResolvedRequest) GetEndDate() *timestamppb.Timestamp {
+func (x *GetAlertsByNameRequest) GetEndDate() *timestamppb.Timestamp {
 	if x != nil {
 		return x.EndDate
 	}
 	return nil
 }
 
-func (x *QueryRecentlyResolvedRequest) GetSeverity() []string {
+func (x *GetAlertsByNameRequest) GetAlertName() string {
 	if x != nil {
-		return x.Severity
+		return x.AlertName
 	}
-	return nil
+	return ""
 }
 
-func (x *QueryRecentlyResolvedRequest) GetTeams() []string {
+func (x *GetAlertsByNameRequest) GetApplyRules() bool {
 	if x != nil {
-		return x.Teams
+		return x.ApplyRules
 	}
-	return nil
+	return false
 }
 
-func (x *QueryRecentlyResolvedRequest) GetAlertNames() []string {
+func (x *GetAlertsByNameRequest) GetFilterByTimeOfDay() bool {
 	if x != nil {
-		return x.AlertNames
+		return x.FilterByTimeOfDay
 	}
-	return nil
+	return false
 }
 
-func (x *QueryRecentlyResolvedRequest) GetLimit() int32 {
+func (x *GetAlertsByNameRequest) GetTimeOfDayStart() string {
 	if x != nil {
-		return x.Limit
+		return x.TimeOfDayStart
 	}
-	return 0
+	return ""
 }
 
-func (x *QueryRecentlyResolvedRequest) GetOffset() int32 {
+func (x *GetAlertsByNameRequest) GetTimeOfDayEnd() string {
 	if x != nil {
-		return x.Offset
+		return x.TimeOfDayEnd
+	}
+	return ""
+}
+
+func (x *GetAlertsByNameRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
 	}
 	return 0
 }
 
-func (x *QueryRecentlyResolvedRequest) GetIncludeSilenced() bool {
+func (x *GetAlertsByNameRequest) GetIncludeWeekends() bool {
 	if x != nil {
-		return x.IncludeSilenced
+		return x.IncludeWeekends
 	}
 	return false
 }
 
-func (x *QueryRecentlyResolvedRequest) GetSearchQuery() string {
+func (x *GetAlertsByNameRequest) GetWeekendMode() string {
 	if x != nil {
-		return x.SearchQuery
+		return x.WeekendMode
 	}
 	return ""
 }
 
-func (x *QueryRecentlyResolvedRequest) GetUserId() string {
+func (x *GetAlertsByNameRequest) GetSeverities() []string {
 	if x != nil {
-		return x.UserId
+		return x.Severities
 	}
-	return ""
+	return nil
 }
 
-type ResolvedAlertItem struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Fingerprint     string                 `protobuf:"bytes,1,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
-	AlertName       string                 `protobuf:"bytes,2,opt,name=alert_name,json=alertName,proto3" json:"alert_name,omitempty"`
-	Severity        string                 `protobuf:"bytes,3,opt,name=severity,proto3" json:"severity,omitempty"`
-	OccurrenceCount int32                  `protobuf:"varint,4,opt,name=occurrence_count,json=occurrenceCount,proto3" json:"occurrence_count,omitempty"` // How many times resolved in time range
-	FirstFiredAt    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=first_fired_at,json=firstFiredAt,proto3" json:"first_fired_at,omitempty"`         // Earliest fired_at
-	LastResolvedAt  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_resolved_at,json=lastResolvedAt,proto3" json:"last_resolved_at,omitempty"`   // Most recent resolved_at
-	TotalMttr       int32                  `protobuf:"varint,7,opt,name=total_mttr,json=totalMttr,proto3" json:"total_mttr,omitempty"`                   // Sum of MTTR (resolved - fired)
-	AvgMttr         float64                `protobuf:"fixed64,8,opt,name=avg_mttr,json=avgMttr,proto3" json:"avg_mttr,omitempty"`                        // Average MTTR
-	TotalMtta       int32                  `protobuf:"varint,9,opt,name=total_mtta,json=totalMtta,proto3" json:"total_mtta,omitempty"`                   // Sum of MTTA (acknowledged - fired)
-	AvgMtta         float64                `protobuf:"fixed64,10,opt,name=avg_mtta,json=avgMtta,proto3" json:"avg_mtta,omitempty"`                       // Average MTTA
-	Labels          map[string]string      `protobuf:"bytes,11,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	Annotations     map[string]string      `protobuf:"bytes,12,rep,name=annotations,proto3" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	Source          string                 `protobuf:"bytes,13,opt,name=source,proto3" json:"source,omitempty"`
-	Instance        string                 `protobuf:"bytes,14,opt,name=instance,proto3" json:"instance,omitempty"`
-	Team            string                 `protobuf:"bytes,15,opt,name=team,proto3" json:"team,omitempty"`
-	AvgFixTime      float64                `protobuf:"fixed64,16,opt,name=avg_fix_time,json=avgFixTime,proto3" json:"avg_fix_time,omitempty"` // Average Fix Time (resolved - acknowledged)
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *GetAlertsByNameRequest) GetTeams() []string {
+	if x != nil {
+		return x.Teams
+	}
+	return nil
 }
 
-func (x *ResolvedAlertItem) Reset() {
-	*x = ResolvedAlertItem{}
-	mi := &file_proto_alert_proto_msgTypes[117]
+type GetAlertsByNameResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Alerts        []*AlertStatistic      `protobuf:"bytes,3,rep,name=alerts,proto3" json:"alerts,omitempty"`
+	TotalCount    int64                  `protobuf:"varint,4,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAlertsByNameResponse) Reset() {
+	*x = GetAlertsByNameResponse{}
+	mi := &file_proto_alert_proto_msgTypes[148]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ResolvedAlertItem) String() string {
+func (x *GetAlertsByNameResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResolvedAlertItem) ProtoMessage() {}
+func (*GetAlertsByNameResponse) ProtoMessage() {}
 
-func (x *ResolvedAlertItem) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[117]
+func (x *GetAlertsByNameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[148]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7948,150 +10380,188 @@ func (x *ResolvedAlertItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResolvedAlertItem.ProtoReflect.Descriptor instead.
-func (*ResolvedAlertItem) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{117}
+// Deprecated: Use GetAlertsByNameResponse.ProtoReflect.Descriptor instead.
+func (*GetAlertsByNameResponse) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{148}
 }
 
-func (x *ResolvedAlertItem) GetFingerprint() string {
+func (x *GetAlertsByNameResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Fingerprint
+		return x.Success
 	}
-	return ""
+	return false
 }
 
-func (x *ResolvedAlertItem) GetAlertName() string {
+func (x *GetAlertsByNameResponse) GetMessage() string {
 	if x != nil {
-		return x.AlertName
+		return x.Message
 	}
 	return ""
 }
 
-func (x *ResolvedAlertItem) GetSeverity() string {
+func (x *GetAlertsByNameResponse) GetAlerts() []*AlertStatistic {
 	if x != nil {
-		return x.Severity
+		return x.Alerts
 	}
-	return ""
+	return nil
 }
 
-func (x *ResolvedAlertItem) GetOccurrenceCount() int32 {
+func (x *GetAlertsByNameResponse) GetTotalCount() int64 {
 	if x != nil {
-		return x.OccurrenceCount
+		return x.TotalCount
 	}
 	return 0
 }
 
-func (x *ResolvedAlertItem) GetFirstFiredAt() *timestamppb.Timestamp {
+type ColumnConfig struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	FieldType     string                 `protobuf:"bytes,3,opt,name=field_type,json=fieldType,proto3" json:"field_type,omitempty"`
+	FieldPath     string                 `protobuf:"bytes,4,opt,name=field_path,json=fieldPath,proto3" json:"field_path,omitempty"`
+	Formatter     string                 `protobuf:"bytes,5,opt,name=formatter,proto3" json:"formatter,omitempty"`
+	Width         int32                  `protobuf:"varint,6,opt,name=width,proto3" json:"width,omitempty"`
+	Sortable      bool                   `protobuf:"varint,7,opt,name=sortable,proto3" json:"sortable,omitempty"`
+	Visible       bool                   `protobuf:"varint,8,opt,name=visible,proto3" json:"visible,omitempty"`
+	Order         int32                  `protobuf:"varint,9,opt,name=order,proto3" json:"order,omitempty"`
+	Resizable     bool                   `protobuf:"varint,10,opt,name=resizable,proto3" json:"resizable,omitempty"`
+	Critical      bool                   `protobuf:"varint,11,opt,name=critical,proto3" json:"critical,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ColumnConfig) Reset() {
+	*x = ColumnConfig{}
+	mi := &file_proto_alert_proto_msgTypes[149]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ColumnConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ColumnConfig) ProtoMessage() {}
+
+func (x *ColumnConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[149]
 	if x != nil {
-		return x.FirstFiredAt
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *ResolvedAlertItem) GetLastResolvedAt() *timestamppb.Timestamp {
+// Deprecated: Use ColumnConfig.ProtoReflect.Descriptor instead.
+func (*ColumnConfig) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{149}
+}
+
+func (x *ColumnConfig) GetId() string {
 	if x != nil {
-		return x.LastResolvedAt
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-func (x *ResolvedAlertItem) GetTotalMttr() int32 {
+func (x *ColumnConfig) GetLabel() string {
 	if x != nil {
-		return x.TotalMttr
+		return x.Label
 	}
-	return 0
+	return ""
 }
 
-func (x *ResolvedAlertItem) GetAvgMttr() float64 {
+func (x *ColumnConfig) GetFieldType() string {
 	if x != nil {
-		return x.AvgMttr
+		return x.FieldType
 	}
-	return 0
+	return ""
 }
 
-func (x *ResolvedAlertItem) GetTotalMtta() int32 {
+func (x *ColumnConfig) GetFieldPath() string {
 	if x != nil {
-		return x.TotalMtta
+		return x.FieldPath
 	}
-	return 0
+	return ""
 }
 
-func (x *ResolvedAlertItem) GetAvgMtta() float64 {
+func (x *ColumnConfig) GetFormatter() string {
 	if x != nil {
-		return x.AvgMtta
+		return x.Formatter
 	}
-	return 0
+	return ""
 }
 
-func (x *ResolvedAlertItem) GetLabels() map[string]string {
+func (x *ColumnConfig) GetWidth() int32 {
 	if x != nil {
-		return x.Labels
+		return x.Width
 	}
-	return nil
+	return 0
 }
 
-func (x *ResolvedAlertItem) GetAnnotations() map[string]string {
+func (x *ColumnConfig) GetSortable() bool {
 	if x != nil {
-		return x.Annotations
+		return x.Sortable
 	}
-	return nil
+	return false
 }
 
-func (x *ResolvedAlertItem) GetSource() string {
+func (x *ColumnConfig) GetVisible() bool {
 	if x != nil {
-		return x.Source
+		return x.Visible
 	}
-	return ""
+	return false
 }
 
-func (x *ResolvedAlertItem) GetInstance() string {
+func (x *ColumnConfig) GetOrder() int32 {
 	if x != nil {
-		return x.Instance
+		return x.Order
 	}
-	return ""
+	return 0
 }
 
-func (x *ResolvedAlertItem) GetTeam() string {
+func (x *ColumnConfig) GetResizable() bool {
 	if x != nil {
-		return x.Team
+		return x.Resizable
 	}
-	return ""
+	return false
 }
 
-func (x *ResolvedAlertItem) GetAvgFixTime() float64 {
+func (x *ColumnConfig) GetCritical() bool {
 	if x != nil {
-		return x.AvgFixTime
+		return x.Critical
 	}
-	return 0
+	return false
 }
 
-type QueryRecentlyResolvedResponse struct {
+type ColumnPreferences struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Alerts        []*ResolvedAlertItem   `protobuf:"bytes,3,rep,name=alerts,proto3" json:"alerts,omitempty"`
-	TotalCount    int64                  `protobuf:"varint,4,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
-	StartDate     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
-	EndDate       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ColumnConfigs []*ColumnConfig        `protobuf:"bytes,2,rep,name=column_configs,json=columnConfigs,proto3" json:"column_configs,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *QueryRecentlyResolvedResponse) Reset() {
-	*x = QueryRecentlyResolvedResponse{}
-	mi := &file_proto_alert_proto_msgTypes[118]
+func (x *ColumnPreferences) Reset() {
+	*x = ColumnPreferences{}
+	mi := &file_proto_alert_proto_msgTypes[150]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *QueryRecentlyResolvedResponse) String() string {
+func (x *ColumnPreferences) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryRecentlyResolvedResponse) ProtoMessage() {}
+func (*ColumnPreferences) ProtoMessage() {}
 
-func (x *QueryRecentlyResolvedResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[118]
+func (x *ColumnPreferences) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[150]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8102,77 +10572,61 @@ func (x *QueryRecentlyResolvedResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QueryRecentlyResolvedResponse.ProtoReflect.Descriptor instead.
-func (*QueryRecentlyResolvedResponse) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{118}
-}
-
-func (x *QueryRecentlyResolvedResponse) GetSuccess() bool {
-	if x != nil {
-		return x.Success
-	}
-	return false
+// Deprecated: Use ColumnPreferences.ProtoReflect.Descriptor instead.
+func (*ColumnPreferences) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{150}
 }
 
-func (x *QueryRecentlyResolvedResponse) GetMessage() string {
+func (x *ColumnPreferences) GetUserId() string {
 	if x != nil {
-		return x.Message
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *QueryRecentlyResolvedResponse) GetAlerts() []*ResolvedAlertItem {
+func (x *ColumnPreferences) GetColumnConfigs() []*ColumnConfig {
 	if x != nil {
-		return x.Alerts
+		return x.ColumnConfigs
 	}
 	return nil
 }
 
-func (x *QueryRecentlyResolvedResponse) GetTotalCount() int64 {
-	if x != nil {
-		return x.TotalCount
-	}
-	return 0
-}
-
-func (x *QueryRecentlyResolvedResponse) GetStartDate() *timestamppb.Timestamp {
+func (x *ColumnPreferences) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.StartDate
+		return x.CreatedAt
 	}
 	return nil
 }
 
-func (x *QueryRecentlyResolvedResponse) GetEndDate() *timestamppb.Timestamp {
+func (x *ColumnPreferences) GetUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.EndDate
+		return x.UpdatedAt
 	}
 	return nil
 }
 
-type GetAlertHistoryRequest struct {
+type GetUserColumnPreferencesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	Fingerprint   string                 `protobuf:"bytes,2,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
-	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"` // Maximum number of history records to return (0 = no limit)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAlertHistoryRequest) Reset() {
-	*x = GetAlertHistoryRequest{}
-	mi := &file_proto_alert_proto_msgTypes[119]
+func (x *GetUserColumnPreferencesRequest) Reset() {
+	*x = GetUserColumnPreferencesRequest{}
+	mi := &file_proto_alert_proto_msgTypes[151]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAlertHistoryRequest) String() string {
+func (x *GetUserColumnPreferencesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAlertHistoryRequest) ProtoMessage() {}
+func (*GetUserColumnPreferencesRequest) ProtoMessage() {}
 
-func (x *GetAlertHistoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[119]
+func (x *GetUserColumnPreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[151]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8183,56 +10637,42 @@ func (x *GetAlertHistoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAlertHistoryRequest.ProtoReflect.Descriptor instead.
-func (*GetAlertHistoryRequest) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{119}
+// Deprecated: Use GetUserColumnPreferencesRequest.ProtoReflect.Descriptor instead.
+func (*GetUserColumnPreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{151}
 }
 
-func (x *GetAlertHistoryRequest) GetSessionId() string {
+func (x *GetUserColumnPreferencesRequest) GetSessionId() string {
 	if x != nil {
 		return x.SessionId
 	}
-	return ""
-}
-
-func (x *GetAlertHistoryRequest) GetFingerprint() string {
-	if x != nil {
-		return x.Fingerprint
-	}
-	return ""
-}
-
-func (x *GetAlertHistoryRequest) GetLimit() int32 {
-	if x != nil {
-		return x.Limit
-	}
-	return 0
+	return ""
 }
 
-type GetAlertHistoryResponse struct {
+type GetUserColumnPreferencesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	History       []*AlertStatistic      `protobuf:"bytes,3,rep,name=history,proto3" json:"history,omitempty"`
+	Preferences   *ColumnPreferences     `protobuf:"bytes,2,opt,name=preferences,proto3" json:"preferences,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAlertHistoryResponse) Reset() {
-	*x = GetAlertHistoryResponse{}
-	mi := &file_proto_alert_proto_msgTypes[120]
+func (x *GetUserColumnPreferencesResponse) Reset() {
+	*x = GetUserColumnPreferencesResponse{}
+	mi := &file_proto_alert_proto_msgTypes[152]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAlertHistoryResponse) String() string {
+func (x *GetUserColumnPreferencesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAlertHistoryResponse) ProtoMessage() {}
+func (*GetUserColumnPreferencesResponse) ProtoMessage() {}
 
-func (x *GetAlertHistoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[120]
+func (x *GetUserColumnPreferencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[152]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8243,66 +10683,55 @@ func (x *GetAlertHistoryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAlertHistoryResponse.ProtoReflect.Descriptor instead.
-func (*GetAlertHistoryResponse) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{120}
+// Deprecated: Use GetUserColumnPreferencesResponse.ProtoReflect.Descriptor instead.
+func (*GetUserColumnPreferencesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{152}
 }
 
-func (x *GetAlertHistoryResponse) GetSuccess() bool {
+func (x *GetUserColumnPreferencesResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *GetAlertHistoryResponse) GetMessage() string {
+func (x *GetUserColumnPreferencesResponse) GetPreferences() *ColumnPreferences {
 	if x != nil {
-		return x.Message
+		return x.Preferences
 	}
-	return ""
+	return nil
 }
 
-func (x *GetAlertHistoryResponse) GetHistory() []*AlertStatistic {
+func (x *GetUserColumnPreferencesResponse) GetMessage() string {
 	if x != nil {
-		return x.History
+		return x.Message
 	}
-	return nil
+	return ""
 }
 
-type GetAlertsByNameRequest struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	SessionId         string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	StartDate         *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
-	EndDate           *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
-	AlertName         string                 `protobuf:"bytes,4,opt,name=alert_name,json=alertName,proto3" json:"alert_name,omitempty"`
-	ApplyRules        bool                   `protobuf:"varint,5,opt,name=apply_rules,json=applyRules,proto3" json:"apply_rules,omitempty"`
-	FilterByTimeOfDay bool                   `protobuf:"varint,6,opt,name=filter_by_time_of_day,json=filterByTimeOfDay,proto3" json:"filter_by_time_of_day,omitempty"`
-	TimeOfDayStart    string                 `protobuf:"bytes,7,opt,name=time_of_day_start,json=timeOfDayStart,proto3" json:"time_of_day_start,omitempty"`
-	TimeOfDayEnd      string                 `protobuf:"bytes,8,opt,name=time_of_day_end,json=timeOfDayEnd,proto3" json:"time_of_day_end,omitempty"`
-	Limit             int32                  `protobuf:"varint,9,opt,name=limit,proto3" json:"limit,omitempty"`
-	IncludeWeekends   bool                   `protobuf:"varint,10,opt,name=include_weekends,json=includeWeekends,proto3" json:"include_weekends,omitempty"` // Include weekends in time-of-day filter (default: true)
-	WeekendMode       string                 `protobuf:"bytes,11,opt,name=weekend_mode,json=weekendMode,proto3" json:"weekend_mode,omitempty"`              // "exclude", "same_hours", "full_weekends"
-	Severities        []string               `protobuf:"bytes,12,rep,name=severities,proto3" json:"severities,omitempty"`                                   // Filter by severities (multi-select, OR logic)
-	Teams             []string               `protobuf:"bytes,13,rep,name=teams,proto3" json:"teams,omitempty"`                                             // Filter by teams (multi-select, OR logic)
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+type SaveUserColumnPreferencesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	ColumnConfigs []*ColumnConfig        `protobuf:"bytes,2,rep,name=column_configs,json=columnConfigs,proto3" json:"column_configs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAlertsByNameRequest) Reset() {
-	*x = GetAlertsByNameRequest{}
-	mi := &file_proto_alert_proto_msgTypes[121]
+func (x *SaveUserColumnPreferencesRequest) Reset() {
+	*x = SaveUserColumnPreferencesRequest{}
+	mi := &file_proto_alert_proto_msgTypes[153]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAlertsByNameRequest) String() string {
+func (x *SaveUserColumnPreferencesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAlertsByNameRequest) ProtoMessage() {}
+func (*SaveUserColumnPreferencesRequest) ProtoMessage() {}
 
-func (x *GetAlertsByNameRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[121]
+func (x *SaveUserColumnPreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[153]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8313,127 +10742,100 @@ func (x *GetAlertsByNameRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAlertsByNameRequest.ProtoReflect.Descriptor instead.
-func (*GetAlertsByNameRequest) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{121}
+// Deprecated: Use SaveUserColumnPreferencesRequest.ProtoReflect.Descriptor instead.
+func (*SaveUserColumnPreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{153}
 }
 
-func (x *GetAlertsByNameRequest) GetSessionId() string {
+func (x *SaveUserColumnPreferencesRequest) GetSessionId() string {
 	if x != nil {
 		return x.SessionId
 	}
 	return ""
 }
 
-func (x *GetAlertsByNameRequest) GetStartDate() *timestamppb.Timestamp {
-	if x != nil {
-		return x.StartDate
-	}
-	return nil
-}
-
-func (x *GetAlertsByNameRequest) GetEndDate() *timestamppb.Timestamp {
+func (x *SaveUserColumnPreferencesRequest) GetColumnConfigs() []*ColumnConfig {
 	if x != nil {
-		return x.EndDate
+		return x.ColumnConfigs
 	}
 	return nil
 }
 
-func (x *GetAlertsByNameRequest) GetAlertName() string {
-	if x != nil {
-		return x.AlertName
-	}
-	return ""
+type SaveUserColumnPreferencesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAlertsByNameRequest) GetApplyRules() bool {
-	if x != nil {
-		return x.ApplyRules
-	}
-	return false
+func (x *SaveUserColumnPreferencesResponse) Reset() {
+	*x = SaveUserColumnPreferencesResponse{}
+	mi := &file_proto_alert_proto_msgTypes[154]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAlertsByNameRequest) GetFilterByTimeOfDay() bool {
-	if x != nil {
-		return x.FilterByTimeOfDay
-	}
-	return false
+func (x *SaveUserColumnPreferencesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *GetAlertsByNameRequest) GetTimeOfDayStart() string {
-	if x != nil {
-		return x.TimeOfDayStart
-	}
-	return ""
-}
+func (*SaveUserColumnPreferencesResponse) ProtoMessage() {}
 
-func (x *GetAlertsByNameRequest) GetTimeOfDayEnd() string {
+func (x *SaveUserColumnPreferencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[154]
 	if x != nil {
-		return x.TimeOfDayEnd
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *GetAlertsByNameRequest) GetLimit() int32 {
-	if x != nil {
-		return x.Limit
-	}
-	return 0
+// Deprecated: Use SaveUserColumnPreferencesResponse.ProtoReflect.Descriptor instead.
+func (*SaveUserColumnPreferencesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{154}
 }
 
-func (x *GetAlertsByNameRequest) GetIncludeWeekends() bool {
+func (x *SaveUserColumnPreferencesResponse) GetSuccess() bool {
 	if x != nil {
-		return x.IncludeWeekends
+		return x.Success
 	}
 	return false
 }
 
-func (x *GetAlertsByNameRequest) GetWeekendMode() string {
+func (x *SaveUserColumnPreferencesResponse) GetMessage() string {
 	if x != nil {
-		return x.WeekendMode
+		return x.Message
 	}
 	return ""
 }
 
-func (x *GetAlertsByNameRequest) GetSeverities() []string {
-	if x != nil {
-		return x.Severities
-	}
-	return nil
-}
-
-func (x *GetAlertsByNameRequest) GetTeams() []string {
-	if x != nil {
-		return x.Teams
-	}
-	return nil
-}
-
-type GetAlertsByNameResponse struct {
+// Preferences Bundle Messages
+type ExportPreferencesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Alerts        []*AlertStatistic      `protobuf:"bytes,3,rep,name=alerts,proto3" json:"alerts,omitempty"`
-	TotalCount    int64                  `protobuf:"varint,4,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAlertsByNameResponse) Reset() {
-	*x = GetAlertsByNameResponse{}
-	mi := &file_proto_alert_proto_msgTypes[122]
+func (x *ExportPreferencesRequest) Reset() {
+	*x = ExportPreferencesRequest{}
+	mi := &file_proto_alert_proto_msgTypes[155]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAlertsByNameResponse) String() string {
+func (x *ExportPreferencesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAlertsByNameResponse) ProtoMessage() {}
+func (*ExportPreferencesRequest) ProtoMessage() {}
 
-func (x *GetAlertsByNameResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[122]
+func (x *ExportPreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[155]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8444,71 +10846,42 @@ func (x *GetAlertsByNameResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAlertsByNameResponse.ProtoReflect.Descriptor instead.
-func (*GetAlertsByNameResponse) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{122}
-}
-
-func (x *GetAlertsByNameResponse) GetSuccess() bool {
-	if x != nil {
-		return x.Success
-	}
-	return false
+// Deprecated: Use ExportPreferencesRequest.ProtoReflect.Descriptor instead.
+func (*ExportPreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{155}
 }
 
-func (x *GetAlertsByNameResponse) GetMessage() string {
+func (x *ExportPreferencesRequest) GetSessionId() string {
 	if x != nil {
-		return x.Message
+		return x.SessionId
 	}
 	return ""
 }
 
-func (x *GetAlertsByNameResponse) GetAlerts() []*AlertStatistic {
-	if x != nil {
-		return x.Alerts
-	}
-	return nil
-}
-
-func (x *GetAlertsByNameResponse) GetTotalCount() int64 {
-	if x != nil {
-		return x.TotalCount
-	}
-	return 0
-}
-
-type ColumnConfig struct {
+type ExportPreferencesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
-	FieldType     string                 `protobuf:"bytes,3,opt,name=field_type,json=fieldType,proto3" json:"field_type,omitempty"`
-	FieldPath     string                 `protobuf:"bytes,4,opt,name=field_path,json=fieldPath,proto3" json:"field_path,omitempty"`
-	Formatter     string                 `protobuf:"bytes,5,opt,name=formatter,proto3" json:"formatter,omitempty"`
-	Width         int32                  `protobuf:"varint,6,opt,name=width,proto3" json:"width,omitempty"`
-	Sortable      bool                   `protobuf:"varint,7,opt,name=sortable,proto3" json:"sortable,omitempty"`
-	Visible       bool                   `protobuf:"varint,8,opt,name=visible,proto3" json:"visible,omitempty"`
-	Order         int32                  `protobuf:"varint,9,opt,name=order,proto3" json:"order,omitempty"`
-	Resizable     bool                   `protobuf:"varint,10,opt,name=resizable,proto3" json:"resizable,omitempty"`
-	Critical      bool                   `protobuf:"varint,11,opt,name=critical,proto3" json:"critical,omitempty"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Bundle        []byte                 `protobuf:"bytes,3,opt,name=bundle,proto3" json:"bundle,omitempty"` // JSON preferences bundle
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ColumnConfig) Reset() {
-	*x = ColumnConfig{}
-	mi := &file_proto_alert_proto_msgTypes[123]
+func (x *ExportPreferencesResponse) Reset() {
+	*x = ExportPreferencesResponse{}
+	mi := &file_proto_alert_proto_msgTypes[156]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ColumnConfig) String() string {
+func (x *ExportPreferencesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ColumnConfig) ProtoMessage() {}
+func (*ExportPreferencesResponse) ProtoMessage() {}
 
-func (x *ColumnConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[123]
+func (x *ExportPreferencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[156]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8519,113 +10892,109 @@ func (x *ColumnConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ColumnConfig.ProtoReflect.Descriptor instead.
-func (*ColumnConfig) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{123}
-}
-
-func (x *ColumnConfig) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
+// Deprecated: Use ExportPreferencesResponse.ProtoReflect.Descriptor instead.
+func (*ExportPreferencesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{156}
 }
 
-func (x *ColumnConfig) GetLabel() string {
+func (x *ExportPreferencesResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Label
+		return x.Success
 	}
-	return ""
+	return false
 }
 
-func (x *ColumnConfig) GetFieldType() string {
+func (x *ExportPreferencesResponse) GetMessage() string {
 	if x != nil {
-		return x.FieldType
+		return x.Message
 	}
 	return ""
 }
 
-func (x *ColumnConfig) GetFieldPath() string {
+func (x *ExportPreferencesResponse) GetBundle() []byte {
 	if x != nil {
-		return x.FieldPath
+		return x.Bundle
 	}
-	return ""
+	return nil
 }
 
-func (x *ColumnConfig) GetFormatter() string {
-	if x != nil {
-		return x.Formatter
-	}
-	return ""
+type ImportPreferencesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Bundle        []byte                 `protobuf:"bytes,2,opt,name=bundle,proto3" json:"bundle,omitempty"` // JSON preferences bundle, as returned by ExportPreferences
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ColumnConfig) GetWidth() int32 {
-	if x != nil {
-		return x.Width
-	}
-	return 0
+func (x *ImportPreferencesRequest) Reset() {
+	*x = ImportPreferencesRequest{}
+	mi := &file_proto_alert_proto_msgTypes[157]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *ColumnConfig) GetSortable() bool {
-	if x != nil {
-		return x.Sortable
-	}
-	return false
+func (x *ImportPreferencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *ColumnConfig) GetVisible() bool {
+func (*ImportPreferencesRequest) ProtoMessage() {}
+
+func (x *ImportPreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[157]
 	if x != nil {
-		return x.Visible
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *ColumnConfig) GetOrder() int32 {
-	if x != nil {
-		return x.Order
-	}
-	return 0
+// Deprecated: Use ImportPreferencesRequest.ProtoReflect.Descriptor instead.
+func (*ImportPreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{157}
 }
 
-func (x *ColumnConfig) GetResizable() bool {
+func (x *ImportPreferencesRequest) GetSessionId() string {
 	if x != nil {
-		return x.Resizable
+		return x.SessionId
 	}
-	return false
+	return ""
 }
 
-func (x *ColumnConfig) GetCritical() bool {
+func (x *ImportPreferencesRequest) GetBundle() []byte {
 	if x != nil {
-		return x.Critical
+		return x.Bundle
 	}
-	return false
+	return nil
 }
 
-type ColumnPreferences struct {
+type ImportPreferencesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	ColumnConfigs []*ColumnConfig        `protobuf:"bytes,2,rep,name=column_configs,json=columnConfigs,proto3" json:"column_configs,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Created       int32                  `protobuf:"varint,3,opt,name=created,proto3" json:"created,omitempty"` // Entries added to the user's settings
+	Updated       int32                  `protobuf:"varint,4,opt,name=updated,proto3" json:"updated,omitempty"` // Existing entries overwritten
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ColumnPreferences) Reset() {
-	*x = ColumnPreferences{}
-	mi := &file_proto_alert_proto_msgTypes[124]
+func (x *ImportPreferencesResponse) Reset() {
+	*x = ImportPreferencesResponse{}
+	mi := &file_proto_alert_proto_msgTypes[158]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ColumnPreferences) String() string {
+func (x *ImportPreferencesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ColumnPreferences) ProtoMessage() {}
+func (*ImportPreferencesResponse) ProtoMessage() {}
 
-func (x *ColumnPreferences) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[124]
+func (x *ImportPreferencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[158]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8636,61 +11005,63 @@ func (x *ColumnPreferences) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ColumnPreferences.ProtoReflect.Descriptor instead.
-func (*ColumnPreferences) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{124}
+// Deprecated: Use ImportPreferencesResponse.ProtoReflect.Descriptor instead.
+func (*ImportPreferencesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{158}
 }
 
-func (x *ColumnPreferences) GetUserId() string {
+func (x *ImportPreferencesResponse) GetSuccess() bool {
 	if x != nil {
-		return x.UserId
+		return x.Success
 	}
-	return ""
+	return false
 }
 
-func (x *ColumnPreferences) GetColumnConfigs() []*ColumnConfig {
+func (x *ImportPreferencesResponse) GetMessage() string {
 	if x != nil {
-		return x.ColumnConfigs
+		return x.Message
 	}
-	return nil
+	return ""
 }
 
-func (x *ColumnPreferences) GetCreatedAt() *timestamppb.Timestamp {
+func (x *ImportPreferencesResponse) GetCreated() int32 {
 	if x != nil {
-		return x.CreatedAt
+		return x.Created
 	}
-	return nil
+	return 0
 }
 
-func (x *ColumnPreferences) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *ImportPreferencesResponse) GetUpdated() int32 {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Updated
 	}
-	return nil
+	return 0
 }
 
-type GetUserColumnPreferencesRequest struct {
+// Permission Messages
+type CheckPermissionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Permission    string                 `protobuf:"bytes,2,opt,name=permission,proto3" json:"permission,omitempty"` // e.g. "silence:create"
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserColumnPreferencesRequest) Reset() {
-	*x = GetUserColumnPreferencesRequest{}
-	mi := &file_proto_alert_proto_msgTypes[125]
+func (x *CheckPermissionRequest) Reset() {
+	*x = CheckPermissionRequest{}
+	mi := &file_proto_alert_proto_msgTypes[159]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserColumnPreferencesRequest) String() string {
+func (x *CheckPermissionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserColumnPreferencesRequest) ProtoMessage() {}
+func (*CheckPermissionRequest) ProtoMessage() {}
 
-func (x *GetUserColumnPreferencesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[125]
+func (x *CheckPermissionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[159]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8701,42 +11072,49 @@ func (x *GetUserColumnPreferencesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserColumnPreferencesRequest.ProtoReflect.Descriptor instead.
-func (*GetUserColumnPreferencesRequest) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{125}
+// Deprecated: Use CheckPermissionRequest.ProtoReflect.Descriptor instead.
+func (*CheckPermissionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{159}
 }
 
-func (x *GetUserColumnPreferencesRequest) GetSessionId() string {
+func (x *CheckPermissionRequest) GetSessionId() string {
 	if x != nil {
 		return x.SessionId
 	}
 	return ""
 }
 
-type GetUserColumnPreferencesResponse struct {
+func (x *CheckPermissionRequest) GetPermission() string {
+	if x != nil {
+		return x.Permission
+	}
+	return ""
+}
+
+type CheckPermissionResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Preferences   *ColumnPreferences     `protobuf:"bytes,2,opt,name=preferences,proto3" json:"preferences,omitempty"`
-	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Allowed       bool                   `protobuf:"varint,2,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"` // Why the permission is refused
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserColumnPreferencesResponse) Reset() {
-	*x = GetUserColumnPreferencesResponse{}
-	mi := &file_proto_alert_proto_msgTypes[126]
+func (x *CheckPermissionResponse) Reset() {
+	*x = CheckPermissionResponse{}
+	mi := &file_proto_alert_proto_msgTypes[160]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserColumnPreferencesResponse) String() string {
+func (x *CheckPermissionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserColumnPreferencesResponse) ProtoMessage() {}
+func (*CheckPermissionResponse) ProtoMessage() {}
 
-func (x *GetUserColumnPreferencesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[126]
+func (x *CheckPermissionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[160]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8747,55 +11125,54 @@ func (x *GetUserColumnPreferencesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserColumnPreferencesResponse.ProtoReflect.Descriptor instead.
-func (*GetUserColumnPreferencesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{126}
+// Deprecated: Use CheckPermissionResponse.ProtoReflect.Descriptor instead.
+func (*CheckPermissionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{160}
 }
 
-func (x *GetUserColumnPreferencesResponse) GetSuccess() bool {
+func (x *CheckPermissionResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *GetUserColumnPreferencesResponse) GetPreferences() *ColumnPreferences {
+func (x *CheckPermissionResponse) GetAllowed() bool {
 	if x != nil {
-		return x.Preferences
+		return x.Allowed
 	}
-	return nil
+	return false
 }
 
-func (x *GetUserColumnPreferencesResponse) GetMessage() string {
+func (x *CheckPermissionResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-type SaveUserColumnPreferencesRequest struct {
+// Health Messages
+type HealthCheckRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	ColumnConfigs []*ColumnConfig        `protobuf:"bytes,2,rep,name=column_configs,json=columnConfigs,proto3" json:"column_configs,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SaveUserColumnPreferencesRequest) Reset() {
-	*x = SaveUserColumnPreferencesRequest{}
-	mi := &file_proto_alert_proto_msgTypes[127]
+func (x *HealthCheckRequest) Reset() {
+	*x = HealthCheckRequest{}
+	mi := &file_proto_alert_proto_msgTypes[161]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SaveUserColumnPreferencesRequest) String() string {
+func (x *HealthCheckRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SaveUserColumnPreferencesRequest) ProtoMessage() {}
+func (*HealthCheckRequest) ProtoMessage() {}
 
-func (x *SaveUserColumnPreferencesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[127]
+func (x *HealthCheckRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[161]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8806,48 +11183,37 @@ func (x *SaveUserColumnPreferencesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SaveUserColumnPreferencesRequest.ProtoReflect.Descriptor instead.
-func (*SaveUserColumnPreferencesRequest) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{127}
-}
-
-func (x *SaveUserColumnPreferencesRequest) GetSessionId() string {
-	if x != nil {
-		return x.SessionId
-	}
-	return ""
-}
-
-func (x *SaveUserColumnPreferencesRequest) GetColumnConfigs() []*ColumnConfig {
-	if x != nil {
-		return x.ColumnConfigs
-	}
-	return nil
+// Deprecated: Use HealthCheckRequest.ProtoReflect.Descriptor instead.
+func (*HealthCheckRequest) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{161}
 }
 
-type SaveUserColumnPreferencesResponse struct {
+type HealthCheckResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`                                 // "ready", "degraded" (migrations not run) or "unavailable" (database down)
+	DatabaseType  string                 `protobuf:"bytes,2,opt,name=database_type,json=databaseType,proto3" json:"database_type,omitempty"` // "sqlite" or "postgres"
+	DatabaseUp    bool                   `protobuf:"varint,3,opt,name=database_up,json=databaseUp,proto3" json:"database_up,omitempty"`
+	Migrated      bool                   `protobuf:"varint,4,opt,name=migrated,proto3" json:"migrated,omitempty"`
+	Message       string                 `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"` // Database error when unavailable
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SaveUserColumnPreferencesResponse) Reset() {
-	*x = SaveUserColumnPreferencesResponse{}
-	mi := &file_proto_alert_proto_msgTypes[128]
+func (x *HealthCheckResponse) Reset() {
+	*x = HealthCheckResponse{}
+	mi := &file_proto_alert_proto_msgTypes[162]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SaveUserColumnPreferencesResponse) String() string {
+func (x *HealthCheckResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SaveUserColumnPreferencesResponse) ProtoMessage() {}
+func (*HealthCheckResponse) ProtoMessage() {}
 
-func (x *SaveUserColumnPreferencesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[128]
+func (x *HealthCheckResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_alert_proto_msgTypes[162]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8858,19 +11224,40 @@ func (x *SaveUserColumnPreferencesResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SaveUserColumnPreferencesResponse.ProtoReflect.Descriptor instead.
-func (*SaveUserColumnPreferencesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{128}
+// Deprecated: Use HealthCheckResponse.ProtoReflect.Descriptor instead.
+func (*HealthCheckResponse) Descriptor() ([]byte, []int) {
+	return file_proto_alert_proto_rawDescGZIP(), []int{162}
 }
 
-func (x *SaveUserColumnPreferencesResponse) GetSuccess() bool {
+func (x *HealthCheckResponse) GetStatus() string {
 	if x != nil {
-		return x.Success
+		return x.Status
+	}
+	return ""
+}
+
+func (x *HealthCheckResponse) GetDatabaseType() string {
+	if x != nil {
+		return x.DatabaseType
+	}
+	return ""
+}
+
+func (x *HealthCheckResponse) GetDatabaseUp() bool {
+	if x != nil {
+		return x.DatabaseUp
 	}
 	return false
 }
 
-func (x *SaveUserColumnPreferencesResponse) GetMessage() string {
+func (x *HealthCheckResponse) GetMigrated() bool {
+	if x != nil {
+		return x.Migrated
+	}
+	return false
+}
+
+func (x *HealthCheckResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
@@ -8888,7 +11275,7 @@ type GetStatisticsViewsRequest struct {
 
 func (x *GetStatisticsViewsRequest) Reset() {
 	*x = GetStatisticsViewsRequest{}
-	mi := &file_proto_alert_proto_msgTypes[129]
+	mi := &file_proto_alert_proto_msgTypes[163]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8900,7 +11287,7 @@ func (x *GetStatisticsViewsRequest) String() string {
 func (*GetStatisticsViewsRequest) ProtoMessage() {}
 
 func (x *GetStatisticsViewsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[129]
+	mi := &file_proto_alert_proto_msgTypes[163]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8913,7 +11300,7 @@ func (x *GetStatisticsViewsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetStatisticsViewsRequest.ProtoReflect.Descriptor instead.
 func (*GetStatisticsViewsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{129}
+	return file_proto_alert_proto_rawDescGZIP(), []int{163}
 }
 
 func (x *GetStatisticsViewsRequest) GetSessionId() string {
@@ -8948,7 +11335,7 @@ type GetStatisticsViewsResponse struct {
 
 func (x *GetStatisticsViewsResponse) Reset() {
 	*x = GetStatisticsViewsResponse{}
-	mi := &file_proto_alert_proto_msgTypes[130]
+	mi := &file_proto_alert_proto_msgTypes[164]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8960,7 +11347,7 @@ func (x *GetStatisticsViewsResponse) String() string {
 func (*GetStatisticsViewsResponse) ProtoMessage() {}
 
 func (x *GetStatisticsViewsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[130]
+	mi := &file_proto_alert_proto_msgTypes[164]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8973,7 +11360,7 @@ func (x *GetStatisticsViewsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetStatisticsViewsResponse.ProtoReflect.Descriptor instead.
 func (*GetStatisticsViewsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{130}
+	return file_proto_alert_proto_rawDescGZIP(), []int{164}
 }
 
 func (x *GetStatisticsViewsResponse) GetSuccess() bool {
@@ -9011,7 +11398,7 @@ type SaveStatisticsViewRequest struct {
 
 func (x *SaveStatisticsViewRequest) Reset() {
 	*x = SaveStatisticsViewRequest{}
-	mi := &file_proto_alert_proto_msgTypes[131]
+	mi := &file_proto_alert_proto_msgTypes[165]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9023,7 +11410,7 @@ func (x *SaveStatisticsViewRequest) String() string {
 func (*SaveStatisticsViewRequest) ProtoMessage() {}
 
 func (x *SaveStatisticsViewRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[131]
+	mi := &file_proto_alert_proto_msgTypes[165]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9036,7 +11423,7 @@ func (x *SaveStatisticsViewRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SaveStatisticsViewRequest.ProtoReflect.Descriptor instead.
 func (*SaveStatisticsViewRequest) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{131}
+	return file_proto_alert_proto_rawDescGZIP(), []int{165}
 }
 
 func (x *SaveStatisticsViewRequest) GetSessionId() string {
@@ -9092,7 +11479,7 @@ type SaveStatisticsViewResponse struct {
 
 func (x *SaveStatisticsViewResponse) Reset() {
 	*x = SaveStatisticsViewResponse{}
-	mi := &file_proto_alert_proto_msgTypes[132]
+	mi := &file_proto_alert_proto_msgTypes[166]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9104,7 +11491,7 @@ func (x *SaveStatisticsViewResponse) String() string {
 func (*SaveStatisticsViewResponse) ProtoMessage() {}
 
 func (x *SaveStatisticsViewResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[132]
+	mi := &file_proto_alert_proto_msgTypes[166]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9117,7 +11504,7 @@ func (x *SaveStatisticsViewResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SaveStatisticsViewResponse.ProtoReflect.Descriptor instead.
 func (*SaveStatisticsViewResponse) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{132}
+	return file_proto_alert_proto_rawDescGZIP(), []int{166}
 }
 
 func (x *SaveStatisticsViewResponse) GetSuccess() bool {
@@ -9156,7 +11543,7 @@ type UpdateStatisticsViewRequest struct {
 
 func (x *UpdateStatisticsViewRequest) Reset() {
 	*x = UpdateStatisticsViewRequest{}
-	mi := &file_proto_alert_proto_msgTypes[133]
+	mi := &file_proto_alert_proto_msgTypes[167]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9168,7 +11555,7 @@ func (x *UpdateStatisticsViewRequest) String() string {
 func (*UpdateStatisticsViewRequest) ProtoMessage() {}
 
 func (x *UpdateStatisticsViewRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[133]
+	mi := &file_proto_alert_proto_msgTypes[167]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9181,7 +11568,7 @@ func (x *UpdateStatisticsViewRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateStatisticsViewRequest.ProtoReflect.Descriptor instead.
 func (*UpdateStatisticsViewRequest) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{133}
+	return file_proto_alert_proto_rawDescGZIP(), []int{167}
 }
 
 func (x *UpdateStatisticsViewRequest) GetSessionId() string {
@@ -9244,7 +11631,7 @@ type UpdateStatisticsViewResponse struct {
 
 func (x *UpdateStatisticsViewResponse) Reset() {
 	*x = UpdateStatisticsViewResponse{}
-	mi := &file_proto_alert_proto_msgTypes[134]
+	mi := &file_proto_alert_proto_msgTypes[168]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9256,7 +11643,7 @@ func (x *UpdateStatisticsViewResponse) String() string {
 func (*UpdateStatisticsViewResponse) ProtoMessage() {}
 
 func (x *UpdateStatisticsViewResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[134]
+	mi := &file_proto_alert_proto_msgTypes[168]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9269,7 +11656,7 @@ func (x *UpdateStatisticsViewResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateStatisticsViewResponse.ProtoReflect.Descriptor instead.
 func (*UpdateStatisticsViewResponse) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{134}
+	return file_proto_alert_proto_rawDescGZIP(), []int{168}
 }
 
 func (x *UpdateStatisticsViewResponse) GetSuccess() bool {
@@ -9304,7 +11691,7 @@ type DeleteStatisticsViewRequest struct {
 
 func (x *DeleteStatisticsViewRequest) Reset() {
 	*x = DeleteStatisticsViewRequest{}
-	mi := &file_proto_alert_proto_msgTypes[135]
+	mi := &file_proto_alert_proto_msgTypes[169]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9316,7 +11703,7 @@ func (x *DeleteStatisticsViewRequest) String() string {
 func (*DeleteStatisticsViewRequest) ProtoMessage() {}
 
 func (x *DeleteStatisticsViewRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[135]
+	mi := &file_proto_alert_proto_msgTypes[169]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9329,7 +11716,7 @@ func (x *DeleteStatisticsViewRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteStatisticsViewRequest.ProtoReflect.Descriptor instead.
 func (*DeleteStatisticsViewRequest) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{135}
+	return file_proto_alert_proto_rawDescGZIP(), []int{169}
 }
 
 func (x *DeleteStatisticsViewRequest) GetSessionId() string {
@@ -9363,7 +11750,7 @@ type DeleteStatisticsViewResponse struct {
 
 func (x *DeleteStatisticsViewResponse) Reset() {
 	*x = DeleteStatisticsViewResponse{}
-	mi := &file_proto_alert_proto_msgTypes[136]
+	mi := &file_proto_alert_proto_msgTypes[170]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9375,7 +11762,7 @@ func (x *DeleteStatisticsViewResponse) String() string {
 func (*DeleteStatisticsViewResponse) ProtoMessage() {}
 
 func (x *DeleteStatisticsViewResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[136]
+	mi := &file_proto_alert_proto_msgTypes[170]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9388,7 +11775,7 @@ func (x *DeleteStatisticsViewResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteStatisticsViewResponse.ProtoReflect.Descriptor instead.
 func (*DeleteStatisticsViewResponse) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{136}
+	return file_proto_alert_proto_rawDescGZIP(), []int{170}
 }
 
 func (x *DeleteStatisticsViewResponse) GetSuccess() bool {
@@ -9416,7 +11803,7 @@ type SetDefaultStatisticsViewRequest struct {
 
 func (x *SetDefaultStatisticsViewRequest) Reset() {
 	*x = SetDefaultStatisticsViewRequest{}
-	mi := &file_proto_alert_proto_msgTypes[137]
+	mi := &file_proto_alert_proto_msgTypes[171]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9428,7 +11815,7 @@ func (x *SetDefaultStatisticsViewRequest) String() string {
 func (*SetDefaultStatisticsViewRequest) ProtoMessage() {}
 
 func (x *SetDefaultStatisticsViewRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[137]
+	mi := &file_proto_alert_proto_msgTypes[171]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9441,7 +11828,7 @@ func (x *SetDefaultStatisticsViewRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetDefaultStatisticsViewRequest.ProtoReflect.Descriptor instead.
 func (*SetDefaultStatisticsViewRequest) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{137}
+	return file_proto_alert_proto_rawDescGZIP(), []int{171}
 }
 
 func (x *SetDefaultStatisticsViewRequest) GetSessionId() string {
@@ -9475,7 +11862,7 @@ type SetDefaultStatisticsViewResponse struct {
 
 func (x *SetDefaultStatisticsViewResponse) Reset() {
 	*x = SetDefaultStatisticsViewResponse{}
-	mi := &file_proto_alert_proto_msgTypes[138]
+	mi := &file_proto_alert_proto_msgTypes[172]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9487,7 +11874,7 @@ func (x *SetDefaultStatisticsViewResponse) String() string {
 func (*SetDefaultStatisticsViewResponse) ProtoMessage() {}
 
 func (x *SetDefaultStatisticsViewResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[138]
+	mi := &file_proto_alert_proto_msgTypes[172]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9500,7 +11887,7 @@ func (x *SetDefaultStatisticsViewResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetDefaultStatisticsViewResponse.ProtoReflect.Descriptor instead.
 func (*SetDefaultStatisticsViewResponse) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{138}
+	return file_proto_alert_proto_rawDescGZIP(), []int{172}
 }
 
 func (x *SetDefaultStatisticsViewResponse) GetSuccess() bool {
@@ -9534,7 +11921,7 @@ type StatisticsView struct {
 
 func (x *StatisticsView) Reset() {
 	*x = StatisticsView{}
-	mi := &file_proto_alert_proto_msgTypes[139]
+	mi := &file_proto_alert_proto_msgTypes[173]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9546,7 +11933,7 @@ func (x *StatisticsView) String() string {
 func (*StatisticsView) ProtoMessage() {}
 
 func (x *StatisticsView) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[139]
+	mi := &file_proto_alert_proto_msgTypes[173]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9559,7 +11946,7 @@ func (x *StatisticsView) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StatisticsView.ProtoReflect.Descriptor instead.
 func (*StatisticsView) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{139}
+	return file_proto_alert_proto_rawDescGZIP(), []int{173}
 }
 
 func (x *StatisticsView) GetId() string {
@@ -9638,7 +12025,7 @@ type RelativeTimeConfig struct {
 
 func (x *RelativeTimeConfig) Reset() {
 	*x = RelativeTimeConfig{}
-	mi := &file_proto_alert_proto_msgTypes[140]
+	mi := &file_proto_alert_proto_msgTypes[174]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9650,7 +12037,7 @@ func (x *RelativeTimeConfig) String() string {
 func (*RelativeTimeConfig) ProtoMessage() {}
 
 func (x *RelativeTimeConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[140]
+	mi := &file_proto_alert_proto_msgTypes[174]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9663,7 +12050,7 @@ func (x *RelativeTimeConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RelativeTimeConfig.ProtoReflect.Descriptor instead.
 func (*RelativeTimeConfig) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{140}
+	return file_proto_alert_proto_rawDescGZIP(), []int{174}
 }
 
 func (x *RelativeTimeConfig) GetValue() int32 {
@@ -9724,7 +12111,7 @@ type StatisticsViewData struct {
 
 func (x *StatisticsViewData) Reset() {
 	*x = StatisticsViewData{}
-	mi := &file_proto_alert_proto_msgTypes[141]
+	mi := &file_proto_alert_proto_msgTypes[175]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9736,7 +12123,7 @@ func (x *StatisticsViewData) String() string {
 func (*StatisticsViewData) ProtoMessage() {}
 
 func (x *StatisticsViewData) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_alert_proto_msgTypes[141]
+	mi := &file_proto_alert_proto_msgTypes[175]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9749,7 +12136,7 @@ func (x *StatisticsViewData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StatisticsViewData.ProtoReflect.Descriptor instead.
 func (*StatisticsViewData) Descriptor() ([]byte, []int) {
-	return file_proto_alert_proto_rawDescGZIP(), []int{141}
+	return file_proto_alert_proto_rawDescGZIP(), []int{175}
 }
 
 func (x *StatisticsViewData) GetDateRangeType() string {
@@ -9905,12 +12292,31 @@ const file_proto_alert_proto_rawDesc = "" +
 	"\x12AddCommentResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x124\n" +
 	"\acomment\x18\x02 \x01(\v2\x1a.notificator.alert.CommentR\acomment\x12\x18\n" +
-	"\amessage\x18\x03 \x01(\tR\amessage\"1\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"e\n" +
 	"\x12GetCommentsRequest\x12\x1b\n" +
-	"\talert_key\x18\x01 \x01(\tR\balertKey\"c\n" +
+	"\talert_key\x18\x01 \x01(\tR\balertKey\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06before\x18\x04 \x01(\tR\x06beforeJ\x04\b\x03\x10\x04\"\xa5\x01\n" +
 	"\x13GetCommentsResponse\x126\n" +
 	"\bcomments\x18\x01 \x03(\v2\x1a.notificator.alert.CommentR\bcomments\x12\x14\n" +
-	"\x05count\x18\x02 \x01(\x05R\x05count\"=\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\x12\x1f\n" +
+	"\vtotal_count\x18\x03 \x01(\x05R\n" +
+	"totalCount\x12\x1f\n" +
+	"\vnext_cursor\x18\x04 \x01(\tR\n" +
+	"nextCursor\"}\n" +
+	"\x17GetCommentsByTagRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x10\n" +
+	"\x03tag\x18\x02 \x01(\tR\x03tag\x12\x1b\n" +
+	"\talert_key\x18\x03 \x01(\tR\balertKey\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\"\x86\x01\n" +
+	"\x18GetCommentsByTagResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x126\n" +
+	"\bcomments\x18\x03 \x03(\v2\x1a.notificator.alert.CommentR\bcomments\"\x1b\n" +
+	"\x19GetCommentSettingsRequest\"J\n" +
+	"\x1aGetCommentSettingsResponse\x12,\n" +
+	"\x12max_comment_length\x18\x01 \x01(\x05R\x10maxCommentLength\"=\n" +
 	"\x1cGetCommentCountsBatchRequest\x12\x1d\n" +
 	"\n" +
 	"alert_keys\x18\x01 \x03(\tR\talertKeys\"\xb0\x01\n" +
@@ -9934,37 +12340,68 @@ const file_proto_alert_proto_rawDesc = "" +
 	"\busername\x18\x04 \x01(\tR\busername\x12\x18\n" +
 	"\acontent\x18\x05 \x01(\tR\acontent\x129\n" +
 	"\n" +
-	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"n\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xc5\x01\n" +
 	"\x18AddAcknowledgmentRequest\x12\x1d\n" +
 	"\n" +
 	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x1b\n" +
 	"\talert_key\x18\x02 \x01(\tR\balertKey\x12\x16\n" +
-	"\x06reason\x18\x03 \x01(\tR\x06reason\"\x9a\x01\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x129\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12\x1a\n" +
+	"\brenotify\x18\x05 \x01(\bR\brenotify\"\x9a\x01\n" +
 	"\x19AddAcknowledgmentResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12I\n" +
 	"\x0eacknowledgment\x18\x02 \x01(\v2!.notificator.alert.AcknowledgmentR\x0eacknowledgment\x12\x18\n" +
-	"\amessage\x18\x03 \x01(\tR\amessage\"8\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"l\n" +
 	"\x19GetAcknowledgmentsRequest\x12\x1b\n" +
-	"\talert_key\x18\x01 \x01(\tR\balertKey\"\x7f\n" +
+	"\talert_key\x18\x01 \x01(\tR\balertKey\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06before\x18\x04 \x01(\tR\x06beforeJ\x04\b\x03\x10\x04\"\xc1\x01\n" +
 	"\x1aGetAcknowledgmentsResponse\x12K\n" +
 	"\x0facknowledgments\x18\x01 \x03(\v2!.notificator.alert.AcknowledgmentR\x0facknowledgments\x12\x14\n" +
-	"\x05count\x18\x02 \x01(\x05R\x05count\"@\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\x12\x1f\n" +
+	"\vtotal_count\x18\x03 \x01(\x05R\n" +
+	"totalCount\x12\x1f\n" +
+	"\vnext_cursor\x18\x04 \x01(\tR\n" +
+	"nextCursor\"@\n" +
 	"\x1fGetAllAcknowledgedAlertsRequest\x12\x1d\n" +
 	"\n" +
-	"alert_keys\x18\x01 \x03(\tR\talertKeys\"\xa0\x02\n" +
+	"alert_keys\x18\x01 \x03(\tR\talertKeys\"\xee\x03\n" +
 	" GetAllAcknowledgedAlertsResponse\x12|\n" +
 	"\x13acknowledged_alerts\x18\x01 \x03(\v2K.notificator.alert.GetAllAcknowledgedAlertsResponse.AcknowledgedAlertsEntryR\x12acknowledgedAlerts\x12\x14\n" +
-	"\x05count\x18\x02 \x01(\x05R\x05count\x1ah\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\x12\x82\x01\n" +
+	"\x15acknowledgment_counts\x18\x03 \x03(\v2M.notificator.alert.GetAllAcknowledgedAlertsResponse.AcknowledgmentCountsEntryR\x14acknowledgmentCounts\x1ah\n" +
 	"\x17AcknowledgedAlertsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x127\n" +
-	"\x05value\x18\x02 \x01(\v2!.notificator.alert.AcknowledgmentR\x05value:\x028\x01\"Y\n" +
+	"\x05value\x18\x02 \x01(\v2!.notificator.alert.AcknowledgmentR\x05value:\x028\x01\x1aG\n" +
+	"\x19AcknowledgmentCountsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"\x86\x01\n" +
 	"\x1bDeleteAcknowledgmentRequest\x12\x1d\n" +
 	"\n" +
 	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x1b\n" +
-	"\talert_key\x18\x02 \x01(\tR\balertKey\"R\n" +
+	"\talert_key\x18\x02 \x01(\tR\balertKey\x12+\n" +
+	"\x11acknowledgment_id\x18\x03 \x01(\tR\x10acknowledgmentId\"R\n" +
 	"\x1cDeleteAcknowledgmentResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\"\xc5\x01\n" +
+	"\x16BulkAcknowledgeRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x1d\n" +
+	"\n" +
+	"alert_keys\x18\x02 \x03(\tR\talertKeys\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x129\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12\x1a\n" +
+	"\brenotify\x18\x05 \x01(\bR\brenotify\"\xa9\x02\n" +
+	"\x17BulkAcknowledgeResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12Q\n" +
+	"\aresults\x18\x03 \x03(\v27.notificator.alert.BulkAcknowledgeResponse.ResultsEntryR\aresults\x12K\n" +
+	"\x0facknowledgments\x18\x04 \x03(\v2!.notificator.alert.AcknowledgmentR\x0facknowledgments\x1a:\n" +
+	"\fResultsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\bR\x05value:\x028\x01\"\x9c\x02\n" +
 	"\x0eAcknowledgment\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
 	"\talert_key\x18\x02 \x01(\tR\balertKey\x12\x17\n" +
@@ -9972,11 +12409,80 @@ const file_proto_alert_proto_rawDesc = "" +
 	"\busername\x18\x04 \x01(\tR\busername\x12\x16\n" +
 	"\x06reason\x18\x05 \x01(\tR\x06reason\x129\n" +
 	"\n" +
-	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\\\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"expires_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12\x1a\n" +
+	"\brenotify\x18\b \x01(\bR\brenotify\"\xae\x01\n" +
+	"\x14EscalateAlertRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x1b\n" +
+	"\talert_key\x18\x02 \x01(\tR\balertKey\x12!\n" +
+	"\fescalated_to\x18\x03 \x01(\tR\vescalatedTo\x12\x1f\n" +
+	"\vtarget_type\x18\x04 \x01(\tR\n" +
+	"targetType\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\"\x8a\x01\n" +
+	"\x15EscalateAlertResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12=\n" +
+	"\n" +
+	"escalation\x18\x02 \x01(\v2\x1d.notificator.alert.EscalationR\n" +
+	"escalation\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"4\n" +
+	"\x15GetEscalationsRequest\x12\x1b\n" +
+	"\talert_key\x18\x01 \x01(\tR\balertKey\"o\n" +
+	"\x16GetEscalationsResponse\x12?\n" +
+	"\vescalations\x18\x01 \x03(\v2\x1d.notificator.alert.EscalationR\vescalations\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\"\x85\x02\n" +
+	"\n" +
+	"Escalation\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\talert_key\x18\x02 \x01(\tR\balertKey\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x04 \x01(\tR\busername\x12!\n" +
+	"\fescalated_to\x18\x05 \x01(\tR\vescalatedTo\x12\x1f\n" +
+	"\vtarget_type\x18\x06 \x01(\tR\n" +
+	"targetType\x12\x16\n" +
+	"\x06reason\x18\a \x01(\tR\x06reason\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xbd\x01\n" +
+	"\aMention\x12\x1d\n" +
+	"\n" +
+	"comment_id\x18\x01 \x01(\tR\tcommentId\x12\x1b\n" +
+	"\talert_key\x18\x02 \x01(\tR\balertKey\x12!\n" +
+	"\fmentioned_by\x18\x03 \x01(\tR\vmentionedBy\x12\x18\n" +
+	"\acontent\x18\x04 \x01(\tR\acontent\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"n\n" +
+	"\bPresence\x12-\n" +
+	"\x04user\x18\x01 \x01(\v2\x19.notificator.alert.ViewerR\x04user\x123\n" +
+	"\aviewers\x18\x02 \x03(\v2\x19.notificator.alert.ViewerR\aviewers\"=\n" +
+	"\x06Viewer\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\"d\n" +
+	"\x17GetAlertActivityRequest\x12\x1b\n" +
+	"\talert_key\x18\x01 \x01(\tR\balertKey\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06before\x18\x03 \x01(\tR\x06before\"}\n" +
+	"\x18GetAlertActivityResponse\x12@\n" +
+	"\n" +
+	"activities\x18\x01 \x03(\v2 .notificator.alert.AlertActivityR\n" +
+	"activities\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"\x89\x02\n" +
+	"\rAlertActivity\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\talert_key\x18\x02 \x01(\tR\balertKey\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x05 \x01(\tR\busername\x12\x18\n" +
+	"\acontent\x18\x06 \x01(\tR\acontent\x12\x16\n" +
+	"\x06target\x18\a \x01(\tR\x06target\x12\x15\n" +
+	"\x06ref_id\x18\b \x01(\tR\x05refId\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\\\n" +
 	"\x1eSubscribeToAlertUpdatesRequest\x12\x1d\n" +
 	"\n" +
 	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x1b\n" +
-	"\talert_key\x18\x02 \x01(\tR\balertKey\"\xa6\x03\n" +
+	"\talert_key\x18\x02 \x01(\tR\balertKey\"\x8e\x05\n" +
 	"\vAlertUpdate\x12\x1b\n" +
 	"\talert_key\x18\x01 \x01(\tR\balertKey\x12>\n" +
 	"\vupdate_type\x18\x02 \x01(\x0e2\x1d.notificator.alert.UpdateTypeR\n" +
@@ -9984,8 +12490,15 @@ const file_proto_alert_proto_rawDesc = "" +
 	"\acomment\x18\x03 \x01(\v2\x1a.notificator.alert.CommentH\x00R\acomment\x12K\n" +
 	"\x0eacknowledgment\x18\x04 \x01(\v2!.notificator.alert.AcknowledgmentH\x00R\x0eacknowledgment\x12.\n" +
 	"\x12deleted_comment_id\x18\x05 \x01(\tH\x00R\x10deletedCommentId\x12<\n" +
-	"\x19deleted_acknowledgment_id\x18\x06 \x01(\tH\x00R\x17deletedAcknowledgmentId\x128\n" +
-	"\ttimestamp\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\ttimestampB\r\n" +
+	"\x19deleted_acknowledgment_id\x18\x06 \x01(\tH\x00R\x17deletedAcknowledgmentId\x12?\n" +
+	"\n" +
+	"escalation\x18\b \x01(\v2\x1d.notificator.alert.EscalationH\x00R\n" +
+	"escalation\x126\n" +
+	"\amention\x18\t \x01(\v2\x1a.notificator.alert.MentionH\x00R\amention\x129\n" +
+	"\bpresence\x18\n" +
+	" \x01(\v2\x1b.notificator.alert.PresenceH\x00R\bpresence\x128\n" +
+	"\ttimestamp\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x122\n" +
+	"\x15heartbeat_interval_ms\x18\v \x01(\x03R\x13heartbeatIntervalMsB\r\n" +
 	"\vupdate_data\"o\n" +
 	"\x1eGetUserColorPreferencesRequest\x12\x1d\n" +
 	"\n" +
@@ -10028,7 +12541,7 @@ const file_proto_alert_proto_rawDesc = "" +
 	" \x01(\x02R\x12textDarknessFactor\x1aB\n" +
 	"\x14LabelConditionsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xd8\x01\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x91\x02\n" +
 	"\x1aCreateResolvedAlertRequest\x12 \n" +
 	"\vfingerprint\x18\x01 \x01(\tR\vfingerprint\x12\x16\n" +
 	"\x06source\x18\x02 \x01(\tR\x06source\x12\x1d\n" +
@@ -10036,14 +12549,22 @@ const file_proto_alert_proto_rawDesc = "" +
 	"alert_data\x18\x03 \x01(\fR\talertData\x12\x1a\n" +
 	"\bcomments\x18\x04 \x01(\fR\bcomments\x12(\n" +
 	"\x0facknowledgments\x18\x05 \x01(\fR\x0facknowledgments\x12\x1b\n" +
-	"\tttl_hours\x18\x06 \x01(\x05R\bttlHours\"\x9e\x01\n" +
+	"\tttl_hours\x18\x06 \x01(\x05R\bttlHours\x127\n" +
+	"\tstarts_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\bstartsAt\"\xc9\x01\n" +
 	"\x1bCreateResolvedAlertResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12K\n" +
 	"\x0eresolved_alert\x18\x02 \x01(\v2$.notificator.alert.ResolvedAlertInfoR\rresolvedAlert\x12\x18\n" +
-	"\amessage\x18\x03 \x01(\tR\amessage\"H\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12)\n" +
+	"\x10already_captured\x18\x04 \x01(\bR\x0falreadyCaptured\"\xb6\x02\n" +
 	"\x18GetResolvedAlertsRequest\x12\x14\n" +
 	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x16\n" +
-	"\x06offset\x18\x02 \x01(\x05R\x06offset\"\xbf\x01\n" +
+	"\x06offset\x18\x02 \x01(\x05R\x06offset\x12\x16\n" +
+	"\x06source\x18\x03 \x01(\tR\x06source\x12-\n" +
+	"\x12fingerprint_prefix\x18\x04 \x01(\tR\x11fingerprintPrefix\x12A\n" +
+	"\x0eresolved_after\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\rresolvedAfter\x12C\n" +
+	"\x0fresolved_before\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x0eresolvedBefore\x12\x1d\n" +
+	"\n" +
+	"sort_order\x18\a \x01(\tR\tsortOrder\"\xbf\x01\n" +
 	"\x19GetResolvedAlertsResponse\x12M\n" +
 	"\x0fresolved_alerts\x18\x01 \x03(\v2$.notificator.alert.ResolvedAlertInfoR\x0eresolvedAlerts\x12\x1f\n" +
 	"\vtotal_count\x18\x02 \x01(\x05R\n" +
@@ -10055,14 +12576,47 @@ const file_proto_alert_proto_rawDesc = "" +
 	"\x18GetResolvedAlertResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12K\n" +
 	"\x0eresolved_alert\x18\x02 \x01(\v2$.notificator.alert.ResolvedAlertInfoR\rresolvedAlert\x12\x18\n" +
-	"\amessage\x18\x03 \x01(\tR\amessage\"?\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"^\n" +
+	"\x1bRestoreResolvedAlertRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12 \n" +
+	"\vfingerprint\x18\x02 \x01(\tR\vfingerprint\"\xba\x01\n" +
+	"\x1cRestoreResolvedAlertResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12+\n" +
+	"\x11restored_comments\x18\x03 \x01(\x05R\x10restoredComments\x129\n" +
+	"\x18restored_acknowledgments\x18\x04 \x01(\x05R\x17restoredAcknowledgments\"?\n" +
 	"\x1eRemoveAllResolvedAlertsRequest\x12\x1d\n" +
 	"\n" +
-	"session_id\x18\x01 \x01(\tR\tsessionId\"z\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\"\xf0\x01\n" +
 	"\x1fRemoveAllResolvedAlertsResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12#\n" +
 	"\rremoved_count\x18\x02 \x01(\x05R\fremovedCount\x12\x18\n" +
-	"\amessage\x18\x03 \x01(\tR\amessage\"B\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x129\n" +
+	"\n" +
+	"cleared_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tclearedAt\x129\n" +
+	"\n" +
+	"undo_until\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tundoUntil\"\x9c\x01\n" +
+	"\x10UndoClearRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x129\n" +
+	"\n" +
+	"cleared_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tclearedAt\x12.\n" +
+	"\x13impersonate_user_id\x18\x03 \x01(\tR\x11impersonateUserId\"n\n" +
+	"\x11UndoClearResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12%\n" +
+	"\x0erestored_count\x18\x02 \x01(\x05R\rrestoredCount\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"!\n" +
+	"\x1fGetResolvedAlertSettingsRequest\"?\n" +
+	" GetResolvedAlertSettingsResponse\x12\x1b\n" +
+	"\tttl_hours\x18\x01 \x01(\x05R\bttlHours\"^\n" +
+	" SaveResolvedAlertSettingsRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x1b\n" +
+	"\tttl_hours\x18\x02 \x01(\x05R\bttlHours\"W\n" +
+	"!SaveResolvedAlertSettingsResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"B\n" +
 	"!StreamResolvedAlertUpdatesRequest\x12\x1d\n" +
 	"\n" +
 	"session_id\x18\x01 \x01(\tR\tsessionId\"\x8b\x02\n" +
@@ -10071,7 +12625,7 @@ const file_proto_alert_proto_rawDesc = "" +
 	"\vupdate_type\x18\x02 \x01(\x0e2*.notificator.alert.ResolvedAlertUpdateTypeR\n" +
 	"updateType\x12K\n" +
 	"\x0eresolved_alert\x18\x03 \x01(\v2$.notificator.alert.ResolvedAlertInfoR\rresolvedAlert\x128\n" +
-	"\ttimestamp\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\"\xb0\x03\n" +
+	"\ttimestamp\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\"\xed\x03\n" +
 	"\x11ResolvedAlertInfo\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12 \n" +
 	"\vfingerprint\x18\x02 \x01(\tR\vfingerprint\x12\x1d\n" +
@@ -10088,7 +12642,9 @@ const file_proto_alert_proto_rawDesc = "" +
 	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
 	"updated_at\x18\n" +
-	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"k\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12;\n" +
+	"\vrestored_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"restoredAt\"k\n" +
 	"\x1aGetUserHiddenAlertsRequest\x12\x1d\n" +
 	"\n" +
 	"session_id\x18\x01 \x01(\tR\tsessionId\x12.\n" +
@@ -10096,7 +12652,7 @@ const file_proto_alert_proto_rawDesc = "" +
 	"\x1bGetUserHiddenAlertsResponse\x12G\n" +
 	"\rhidden_alerts\x18\x01 \x03(\v2\".notificator.alert.UserHiddenAlertR\fhiddenAlerts\x12\x18\n" +
 	"\asuccess\x18\x02 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x03 \x01(\tR\amessage\"\xd6\x01\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"\x95\x02\n" +
 	"\x10HideAlertRequest\x12\x1d\n" +
 	"\n" +
 	"session_id\x18\x01 \x01(\tR\tsessionId\x12 \n" +
@@ -10105,27 +12661,34 @@ const file_proto_alert_proto_rawDesc = "" +
 	"alert_name\x18\x03 \x01(\tR\talertName\x12\x1a\n" +
 	"\binstance\x18\x04 \x01(\tR\binstance\x12\x16\n" +
 	"\x06reason\x18\x05 \x01(\tR\x06reason\x12.\n" +
-	"\x13impersonate_user_id\x18\x06 \x01(\tR\x11impersonateUserId\"\x8e\x01\n" +
+	"\x13impersonate_user_id\x18\x06 \x01(\tR\x11impersonateUserId\x12=\n" +
+	"\fsnooze_until\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\vsnoozeUntil\"\x8e\x01\n" +
 	"\x11HideAlertResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12E\n" +
 	"\fhidden_alert\x18\x02 \x01(\v2\".notificator.alert.UserHiddenAlertR\vhiddenAlert\x12\x18\n" +
-	"\amessage\x18\x03 \x01(\tR\amessage\"\x85\x01\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"\xa9\x01\n" +
 	"\x12UnhideAlertRequest\x12\x1d\n" +
 	"\n" +
 	"session_id\x18\x01 \x01(\tR\tsessionId\x12 \n" +
 	"\vfingerprint\x18\x02 \x01(\tR\vfingerprint\x12.\n" +
-	"\x13impersonate_user_id\x18\x03 \x01(\tR\x11impersonateUserId\"I\n" +
+	"\x13impersonate_user_id\x18\x03 \x01(\tR\x11impersonateUserId\x12\"\n" +
+	"\ffingerprints\x18\x04 \x03(\tR\ffingerprints\"p\n" +
 	"\x13UnhideAlertResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"l\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12%\n" +
+	"\x0eunhidden_count\x18\x03 \x01(\x05R\runhiddenCount\"l\n" +
 	"\x1bClearAllHiddenAlertsRequest\x12\x1d\n" +
 	"\n" +
 	"session_id\x18\x01 \x01(\tR\tsessionId\x12.\n" +
-	"\x13impersonate_user_id\x18\x02 \x01(\tR\x11impersonateUserId\"w\n" +
+	"\x13impersonate_user_id\x18\x02 \x01(\tR\x11impersonateUserId\"\xed\x01\n" +
 	"\x1cClearAllHiddenAlertsResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12#\n" +
 	"\rcleared_count\x18\x02 \x01(\x05R\fclearedCount\x12\x18\n" +
-	"\amessage\x18\x03 \x01(\tR\amessage\"\xa5\x02\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x129\n" +
+	"\n" +
+	"cleared_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tclearedAt\x129\n" +
+	"\n" +
+	"undo_until\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tundoUntil\"\xe0\x02\n" +
 	"\x0fUserHiddenAlert\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12 \n" +
@@ -10137,7 +12700,9 @@ const file_proto_alert_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"j\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x129\n" +
+	"\n" +
+	"expires_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"j\n" +
 	"\x19GetUserHiddenRulesRequest\x12\x1d\n" +
 	"\n" +
 	"session_id\x18\x01 \x01(\tR\tsessionId\x12.\n" +
@@ -10186,17 +12751,18 @@ const file_proto_alert_proto_rawDesc = "" +
 	"\"GetNotificationPreferencesResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12K\n" +
 	"\vpreferences\x18\x02 \x01(\v2).notificator.alert.NotificationPreferenceR\vpreferences\x12\x18\n" +
-	"\amessage\x18\x03 \x01(\tR\amessage\"\xf6\x01\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"\xb1\x02\n" +
 	"\"SaveNotificationPreferencesRequest\x12\x1d\n" +
 	"\n" +
 	"session_id\x18\x01 \x01(\tR\tsessionId\x12B\n" +
 	"\x1dbrowser_notifications_enabled\x18\x02 \x01(\bR\x1bbrowserNotificationsEnabled\x12-\n" +
 	"\x12enabled_severities\x18\x03 \x03(\tR\x11enabledSeverities\x12>\n" +
-	"\x1bsound_notifications_enabled\x18\x04 \x01(\bR\x19soundNotificationsEnabled\"\xa6\x01\n" +
+	"\x1bsound_notifications_enabled\x18\x04 \x01(\bR\x19soundNotificationsEnabled\x129\n" +
+	"\x03dnd\x18\x05 \x01(\v2'.notificator.alert.DoNotDisturbScheduleR\x03dnd\"\xa6\x01\n" +
 	"#SaveNotificationPreferencesResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12K\n" +
 	"\vpreferences\x18\x02 \x01(\v2).notificator.alert.NotificationPreferenceR\vpreferences\x12\x18\n" +
-	"\amessage\x18\x03 \x01(\tR\amessage\"\xea\x02\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"\xa5\x03\n" +
 	"\x16NotificationPreference\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12B\n" +
@@ -10206,7 +12772,16 @@ const file_proto_alert_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x8f\x01\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x129\n" +
+	"\x03dnd\x18\b \x01(\v2'.notificator.alert.DoNotDisturbScheduleR\x03dnd\"\xc1\x01\n" +
+	"\x14DoNotDisturbSchedule\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x12\n" +
+	"\x04days\x18\x02 \x03(\x05R\x04days\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\x03 \x01(\tR\tstartTime\x12\x19\n" +
+	"\bend_time\x18\x04 \x01(\tR\aendTime\x12\x1a\n" +
+	"\btimezone\x18\x05 \x01(\tR\btimezone\x12%\n" +
+	"\x0eallow_critical\x18\x06 \x01(\bR\rallowCritical\"\x8f\x01\n" +
 	"\x17GetFilterPresetsRequest\x12\x1d\n" +
 	"\n" +
 	"session_id\x18\x01 \x01(\tR\tsessionId\x12%\n" +
@@ -10702,7 +13277,41 @@ const file_proto_alert_proto_rawDesc = "" +
 	"\x0ecolumn_configs\x18\x02 \x03(\v2\x1f.notificator.alert.ColumnConfigR\rcolumnConfigs\"W\n" +
 	"!SaveUserColumnPreferencesResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"\x91\x01\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"9\n" +
+	"\x18ExportPreferencesRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\"g\n" +
+	"\x19ExportPreferencesResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x16\n" +
+	"\x06bundle\x18\x03 \x01(\fR\x06bundle\"Q\n" +
+	"\x18ImportPreferencesRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x16\n" +
+	"\x06bundle\x18\x02 \x01(\fR\x06bundle\"\x83\x01\n" +
+	"\x19ImportPreferencesResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x18\n" +
+	"\acreated\x18\x03 \x01(\x05R\acreated\x12\x18\n" +
+	"\aupdated\x18\x04 \x01(\x05R\aupdated\"W\n" +
+	"\x16CheckPermissionRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x1e\n" +
+	"\n" +
+	"permission\x18\x02 \x01(\tR\n" +
+	"permission\"g\n" +
+	"\x17CheckPermissionResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\aallowed\x18\x02 \x01(\bR\aallowed\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"\x14\n" +
+	"\x12HealthCheckRequest\"\xa9\x01\n" +
+	"\x13HealthCheckResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12#\n" +
+	"\rdatabase_type\x18\x02 \x01(\tR\fdatabaseType\x12\x1f\n" +
+	"\vdatabase_up\x18\x03 \x01(\bR\n" +
+	"databaseUp\x12\x1a\n" +
+	"\bmigrated\x18\x04 \x01(\bR\bmigrated\x12\x18\n" +
+	"\amessage\x18\x05 \x01(\tR\amessage\"\x91\x01\n" +
 	"\x19GetStatisticsViewsRequest\x12\x1d\n" +
 	"\n" +
 	"session_id\x18\x01 \x01(\tR\tsessionId\x12%\n" +
@@ -10797,41 +13406,59 @@ const file_proto_alert_proto_rawDesc = "" +
 	"severities\x18\x12 \x03(\tR\n" +
 	"severities\x12\x14\n" +
 	"\x05teams\x18\x13 \x03(\tR\x05teams\x12!\n" +
-	"\fweekend_mode\x18\x14 \x01(\tR\vweekendMode*~\n" +
+	"\fweekend_mode\x18\x14 \x01(\tR\vweekendMode*\xf4\x01\n" +
 	"\n" +
 	"UpdateType\x12\x12\n" +
 	"\x0eUNKNOWN_UPDATE\x10\x00\x12\x11\n" +
 	"\rCOMMENT_ADDED\x10\x01\x12\x13\n" +
 	"\x0fCOMMENT_DELETED\x10\x02\x12\x18\n" +
 	"\x14ACKNOWLEDGMENT_ADDED\x10\x03\x12\x1a\n" +
-	"\x16ACKNOWLEDGMENT_DELETED\x10\x04*n\n" +
+	"\x16ACKNOWLEDGMENT_DELETED\x10\x04\x12\x14\n" +
+	"\x10ESCALATION_ADDED\x10\x05\x12\r\n" +
+	"\tHEARTBEAT\x10\x06\x12\v\n" +
+	"\aMENTION\x10\a\x12\x13\n" +
+	"\x0fPRESENCE_JOINED\x10\b\x12\x11\n" +
+	"\rPRESENCE_LEFT\x10\t\x12\x1a\n" +
+	"\x16ACKNOWLEDGMENT_EXPIRED\x10\n" +
+	"*n\n" +
 	"\x17ResolvedAlertUpdateType\x12\x1b\n" +
 	"\x17UNKNOWN_RESOLVED_UPDATE\x10\x00\x12\x1a\n" +
 	"\x16RESOLVED_ALERT_CREATED\x10\x01\x12\x1a\n" +
-	"\x16RESOLVED_ALERT_EXPIRED\x10\x022\x85$\n" +
+	"\x16RESOLVED_ALERT_EXPIRED\x10\x022\x881\n" +
 	"\fAlertService\x12Y\n" +
 	"\n" +
 	"AddComment\x12$.notificator.alert.AddCommentRequest\x1a%.notificator.alert.AddCommentResponse\x12\\\n" +
 	"\vGetComments\x12%.notificator.alert.GetCommentsRequest\x1a&.notificator.alert.GetCommentsResponse\x12z\n" +
 	"\x15GetCommentCountsBatch\x12/.notificator.alert.GetCommentCountsBatchRequest\x1a0.notificator.alert.GetCommentCountsBatchResponse\x12b\n" +
-	"\rDeleteComment\x12'.notificator.alert.DeleteCommentRequest\x1a(.notificator.alert.DeleteCommentResponse\x12n\n" +
+	"\rDeleteComment\x12'.notificator.alert.DeleteCommentRequest\x1a(.notificator.alert.DeleteCommentResponse\x12k\n" +
+	"\x10GetCommentsByTag\x12*.notificator.alert.GetCommentsByTagRequest\x1a+.notificator.alert.GetCommentsByTagResponse\x12q\n" +
+	"\x12GetCommentSettings\x12,.notificator.alert.GetCommentSettingsRequest\x1a-.notificator.alert.GetCommentSettingsResponse\x12n\n" +
 	"\x11AddAcknowledgment\x12+.notificator.alert.AddAcknowledgmentRequest\x1a,.notificator.alert.AddAcknowledgmentResponse\x12q\n" +
 	"\x12GetAcknowledgments\x12,.notificator.alert.GetAcknowledgmentsRequest\x1a-.notificator.alert.GetAcknowledgmentsResponse\x12\x83\x01\n" +
 	"\x18GetAllAcknowledgedAlerts\x122.notificator.alert.GetAllAcknowledgedAlertsRequest\x1a3.notificator.alert.GetAllAcknowledgedAlertsResponse\x12w\n" +
-	"\x14DeleteAcknowledgment\x12..notificator.alert.DeleteAcknowledgmentRequest\x1a/.notificator.alert.DeleteAcknowledgmentResponse\x12n\n" +
+	"\x14DeleteAcknowledgment\x12..notificator.alert.DeleteAcknowledgmentRequest\x1a/.notificator.alert.DeleteAcknowledgmentResponse\x12h\n" +
+	"\x0fBulkAcknowledge\x12).notificator.alert.BulkAcknowledgeRequest\x1a*.notificator.alert.BulkAcknowledgeResponse\x12b\n" +
+	"\rEscalateAlert\x12'.notificator.alert.EscalateAlertRequest\x1a(.notificator.alert.EscalateAlertResponse\x12e\n" +
+	"\x0eGetEscalations\x12(.notificator.alert.GetEscalationsRequest\x1a).notificator.alert.GetEscalationsResponse\x12k\n" +
+	"\x10GetAlertActivity\x12*.notificator.alert.GetAlertActivityRequest\x1a+.notificator.alert.GetAlertActivityResponse\x12n\n" +
 	"\x17SubscribeToAlertUpdates\x121.notificator.alert.SubscribeToAlertUpdatesRequest\x1a\x1e.notificator.alert.AlertUpdate0\x01\x12t\n" +
 	"\x13CreateResolvedAlert\x12-.notificator.alert.CreateResolvedAlertRequest\x1a..notificator.alert.CreateResolvedAlertResponse\x12n\n" +
 	"\x11GetResolvedAlerts\x12+.notificator.alert.GetResolvedAlertsRequest\x1a,.notificator.alert.GetResolvedAlertsResponse\x12k\n" +
-	"\x10GetResolvedAlert\x12*.notificator.alert.GetResolvedAlertRequest\x1a+.notificator.alert.GetResolvedAlertResponse\x12\x80\x01\n" +
-	"\x17RemoveAllResolvedAlerts\x121.notificator.alert.RemoveAllResolvedAlertsRequest\x1a2.notificator.alert.RemoveAllResolvedAlertsResponse\x12|\n" +
-	"\x1aStreamResolvedAlertUpdates\x124.notificator.alert.StreamResolvedAlertUpdatesRequest\x1a&.notificator.alert.ResolvedAlertUpdate0\x01\x12\x80\x01\n" +
+	"\x10GetResolvedAlert\x12*.notificator.alert.GetResolvedAlertRequest\x1a+.notificator.alert.GetResolvedAlertResponse\x12w\n" +
+	"\x14RestoreResolvedAlert\x12..notificator.alert.RestoreResolvedAlertRequest\x1a/.notificator.alert.RestoreResolvedAlertResponse\x12\x80\x01\n" +
+	"\x17RemoveAllResolvedAlerts\x121.notificator.alert.RemoveAllResolvedAlertsRequest\x1a2.notificator.alert.RemoveAllResolvedAlertsResponse\x12h\n" +
+	"\x1bUndoRemoveAllResolvedAlerts\x12#.notificator.alert.UndoClearRequest\x1a$.notificator.alert.UndoClearResponse\x12|\n" +
+	"\x1aStreamResolvedAlertUpdates\x124.notificator.alert.StreamResolvedAlertUpdatesRequest\x1a&.notificator.alert.ResolvedAlertUpdate0\x01\x12\x83\x01\n" +
+	"\x18GetResolvedAlertSettings\x122.notificator.alert.GetResolvedAlertSettingsRequest\x1a3.notificator.alert.GetResolvedAlertSettingsResponse\x12\x86\x01\n" +
+	"\x19SaveResolvedAlertSettings\x123.notificator.alert.SaveResolvedAlertSettingsRequest\x1a4.notificator.alert.SaveResolvedAlertSettingsResponse\x12\x80\x01\n" +
 	"\x17GetUserColorPreferences\x121.notificator.alert.GetUserColorPreferencesRequest\x1a2.notificator.alert.GetUserColorPreferencesResponse\x12\x83\x01\n" +
 	"\x18SaveUserColorPreferences\x122.notificator.alert.SaveUserColorPreferencesRequest\x1a3.notificator.alert.SaveUserColorPreferencesResponse\x12\x86\x01\n" +
 	"\x19DeleteUserColorPreference\x123.notificator.alert.DeleteUserColorPreferenceRequest\x1a4.notificator.alert.DeleteUserColorPreferenceResponse\x12t\n" +
 	"\x13GetUserHiddenAlerts\x12-.notificator.alert.GetUserHiddenAlertsRequest\x1a..notificator.alert.GetUserHiddenAlertsResponse\x12V\n" +
 	"\tHideAlert\x12#.notificator.alert.HideAlertRequest\x1a$.notificator.alert.HideAlertResponse\x12\\\n" +
 	"\vUnhideAlert\x12%.notificator.alert.UnhideAlertRequest\x1a&.notificator.alert.UnhideAlertResponse\x12w\n" +
-	"\x14ClearAllHiddenAlerts\x12..notificator.alert.ClearAllHiddenAlertsRequest\x1a/.notificator.alert.ClearAllHiddenAlertsResponse\x12q\n" +
+	"\x14ClearAllHiddenAlerts\x12..notificator.alert.ClearAllHiddenAlertsRequest\x1a/.notificator.alert.ClearAllHiddenAlertsResponse\x12e\n" +
+	"\x18UndoClearAllHiddenAlerts\x12#.notificator.alert.UndoClearRequest\x1a$.notificator.alert.UndoClearResponse\x12q\n" +
 	"\x12GetUserHiddenRules\x12,.notificator.alert.GetUserHiddenRulesRequest\x1a-.notificator.alert.GetUserHiddenRulesResponse\x12e\n" +
 	"\x0eSaveHiddenRule\x12(.notificator.alert.SaveHiddenRuleRequest\x1a).notificator.alert.SaveHiddenRuleResponse\x12k\n" +
 	"\x10RemoveHiddenRule\x12*.notificator.alert.RemoveHiddenRuleRequest\x1a+.notificator.alert.RemoveHiddenRuleResponse\x12\x89\x01\n" +
@@ -10848,7 +13475,11 @@ const file_proto_alert_proto_rawDesc = "" +
 	"\x1cUpdateAnnotationButtonConfig\x126.notificator.alert.UpdateAnnotationButtonConfigRequest\x1a7.notificator.alert.UpdateAnnotationButtonConfigResponse\x12\x8f\x01\n" +
 	"\x1cDeleteAnnotationButtonConfig\x126.notificator.alert.DeleteAnnotationButtonConfigRequest\x1a7.notificator.alert.DeleteAnnotationButtonConfigResponse\x12\x83\x01\n" +
 	"\x18GetUserColumnPreferences\x122.notificator.alert.GetUserColumnPreferencesRequest\x1a3.notificator.alert.GetUserColumnPreferencesResponse\x12\x86\x01\n" +
-	"\x19SaveUserColumnPreferences\x123.notificator.alert.SaveUserColumnPreferencesRequest\x1a4.notificator.alert.SaveUserColumnPreferencesResponse2\xd7\x12\n" +
+	"\x19SaveUserColumnPreferences\x123.notificator.alert.SaveUserColumnPreferencesRequest\x1a4.notificator.alert.SaveUserColumnPreferencesResponse\x12n\n" +
+	"\x11ExportPreferences\x12+.notificator.alert.ExportPreferencesRequest\x1a,.notificator.alert.ExportPreferencesResponse\x12n\n" +
+	"\x11ImportPreferences\x12+.notificator.alert.ImportPreferencesRequest\x1a,.notificator.alert.ImportPreferencesResponse\x12h\n" +
+	"\x0fCheckPermission\x12).notificator.alert.CheckPermissionRequest\x1a*.notificator.alert.CheckPermissionResponse\x12\\\n" +
+	"\vHealthCheck\x12%.notificator.alert.HealthCheckRequest\x1a&.notificator.alert.HealthCheckResponse2\xd7\x12\n" +
 	"\x11StatisticsService\x12h\n" +
 	"\x0fQueryStatistics\x12).notificator.alert.QueryStatisticsRequest\x1a*.notificator.alert.QueryStatisticsResponse\x12_\n" +
 	"\fQueryHeatmap\x12&.notificator.alert.QueryHeatmapRequest\x1a'.notificator.alert.QueryHeatmapResponse\x12t\n" +
@@ -10885,7 +13516,7 @@ func file_proto_alert_proto_rawDescGZIP() []byte {
 }
 
 var file_proto_alert_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_proto_alert_proto_msgTypes = make([]protoimpl.MessageInfo, 150)
+var file_proto_alert_proto_msgTypes = make([]protoimpl.MessageInfo, 186)
 var file_proto_alert_proto_goTypes = []any{
 	(UpdateType)(0),                              // 0: notificator.alert.UpdateType
 	(ResolvedAlertUpdateType)(0),                 // 1: notificator.alert.ResolvedAlertUpdateType
@@ -10893,402 +13524,499 @@ var file_proto_alert_proto_goTypes = []any{
 	(*AddCommentResponse)(nil),                   // 3: notificator.alert.AddCommentResponse
 	(*GetCommentsRequest)(nil),                   // 4: notificator.alert.GetCommentsRequest
 	(*GetCommentsResponse)(nil),                  // 5: notificator.alert.GetCommentsResponse
-	(*GetCommentCountsBatchRequest)(nil),         // 6: notificator.alert.GetCommentCountsBatchRequest
-	(*GetCommentCountsBatchResponse)(nil),        // 7: notificator.alert.GetCommentCountsBatchResponse
-	(*DeleteCommentRequest)(nil),                 // 8: notificator.alert.DeleteCommentRequest
-	(*DeleteCommentResponse)(nil),                // 9: notificator.alert.DeleteCommentResponse
-	(*Comment)(nil),                              // 10: notificator.alert.Comment
-	(*AddAcknowledgmentRequest)(nil),             // 11: notificator.alert.AddAcknowledgmentRequest
-	(*AddAcknowledgmentResponse)(nil),            // 12: notificator.alert.AddAcknowledgmentResponse
-	(*GetAcknowledgmentsRequest)(nil),            // 13: notificator.alert.GetAcknowledgmentsRequest
-	(*GetAcknowledgmentsResponse)(nil),           // 14: notificator.alert.GetAcknowledgmentsResponse
-	(*GetAllAcknowledgedAlertsRequest)(nil),      // 15: notificator.alert.GetAllAcknowledgedAlertsRequest
-	(*GetAllAcknowledgedAlertsResponse)(nil),     // 16: notificator.alert.GetAllAcknowledgedAlertsResponse
-	(*DeleteAcknowledgmentRequest)(nil),          // 17: notificator.alert.DeleteAcknowledgmentRequest
-	(*DeleteAcknowledgmentResponse)(nil),         // 18: notificator.alert.DeleteAcknowledgmentResponse
-	(*Acknowledgment)(nil),                       // 19: notificator.alert.Acknowledgment
-	(*SubscribeToAlertUpdatesRequest)(nil),       // 20: notificator.alert.SubscribeToAlertUpdatesRequest
-	(*AlertUpdate)(nil),                          // 21: notificator.alert.AlertUpdate
-	(*GetUserColorPreferencesRequest)(nil),       // 22: notificator.alert.GetUserColorPreferencesRequest
-	(*GetUserColorPreferencesResponse)(nil),      // 23: notificator.alert.GetUserColorPreferencesResponse
-	(*SaveUserColorPreferencesRequest)(nil),      // 24: notificator.alert.SaveUserColorPreferencesRequest
-	(*SaveUserColorPreferencesResponse)(nil),     // 25: notificator.alert.SaveUserColorPreferencesResponse
-	(*DeleteUserColorPreferenceRequest)(nil),     // 26: notificator.alert.DeleteUserColorPreferenceRequest
-	(*DeleteUserColorPreferenceResponse)(nil),    // 27: notificator.alert.DeleteUserColorPreferenceResponse
-	(*UserColorPreference)(nil),                  // 28: notificator.alert.UserColorPreference
-	(*CreateResolvedAlertRequest)(nil),           // 29: notificator.alert.CreateResolvedAlertRequest
-	(*CreateResolvedAlertResponse)(nil),          // 30: notificator.alert.CreateResolvedAlertResponse
-	(*GetResolvedAlertsRequest)(nil),             // 31: notificator.alert.GetResolvedAlertsRequest
-	(*GetResolvedAlertsResponse)(nil),            // 32: notificator.alert.GetResolvedAlertsResponse
-	(*GetResolvedAlertRequest)(nil),              // 33: notificator.alert.GetResolvedAlertRequest
-	(*GetResolvedAlertResponse)(nil),             // 34: notificator.alert.GetResolvedAlertResponse
-	(*RemoveAllResolvedAlertsRequest)(nil),       // 35: notificator.alert.RemoveAllResolvedAlertsRequest
-	(*RemoveAllResolvedAlertsResponse)(nil),      // 36: notificator.alert.RemoveAllResolvedAlertsResponse
-	(*StreamResolvedAlertUpdatesRequest)(nil),    // 37: notificator.alert.StreamResolvedAlertUpdatesRequest
-	(*ResolvedAlertUpdate)(nil),                  // 38: notificator.alert.ResolvedAlertUpdate
-	(*ResolvedAlertInfo)(nil),                    // 39: notificator.alert.ResolvedAlertInfo
-	(*GetUserHiddenAlertsRequest)(nil),           // 40: notificator.alert.GetUserHiddenAlertsRequest
-	(*GetUserHiddenAlertsResponse)(nil),          // 41: notificator.alert.GetUserHiddenAlertsResponse
-	(*HideAlertRequest)(nil),                     // 42: notificator.alert.HideAlertRequest
-	(*HideAlertResponse)(nil),                    // 43: notificator.alert.HideAlertResponse
-	(*UnhideAlertRequest)(nil),                   // 44: notificator.alert.UnhideAlertRequest
-	(*UnhideAlertResponse)(nil),                  // 45: notificator.alert.UnhideAlertResponse
-	(*ClearAllHiddenAlertsRequest)(nil),          // 46: notificator.alert.ClearAllHiddenAlertsRequest
-	(*ClearAllHiddenAlertsResponse)(nil),         // 47: notificator.alert.ClearAllHiddenAlertsResponse
-	(*UserHiddenAlert)(nil),                      // 48: notificator.alert.UserHiddenAlert
-	(*GetUserHiddenRulesRequest)(nil),            // 49: notificator.alert.GetUserHiddenRulesRequest
-	(*GetUserHiddenRulesResponse)(nil),           // 50: notificator.alert.GetUserHiddenRulesResponse
-	(*SaveHiddenRuleRequest)(nil),                // 51: notificator.alert.SaveHiddenRuleRequest
-	(*SaveHiddenRuleResponse)(nil),               // 52: notificator.alert.SaveHiddenRuleResponse
-	(*RemoveHiddenRuleRequest)(nil),              // 53: notificator.alert.RemoveHiddenRuleRequest
-	(*RemoveHiddenRuleResponse)(nil),             // 54: notificator.alert.RemoveHiddenRuleResponse
-	(*UserHiddenRule)(nil),                       // 55: notificator.alert.UserHiddenRule
-	(*GetNotificationPreferencesRequest)(nil),    // 56: notificator.alert.GetNotificationPreferencesRequest
-	(*GetNotificationPreferencesResponse)(nil),   // 57: notificator.alert.GetNotificationPreferencesResponse
-	(*SaveNotificationPreferencesRequest)(nil),   // 58: notificator.alert.SaveNotificationPreferencesRequest
-	(*SaveNotificationPreferencesResponse)(nil),  // 59: notificator.alert.SaveNotificationPreferencesResponse
-	(*NotificationPreference)(nil),               // 60: notificator.alert.NotificationPreference
-	(*GetFilterPresetsRequest)(nil),              // 61: notificator.alert.GetFilterPresetsRequest
-	(*GetFilterPresetsResponse)(nil),             // 62: notificator.alert.GetFilterPresetsResponse
-	(*SaveFilterPresetRequest)(nil),              // 63: notificator.alert.SaveFilterPresetRequest
-	(*SaveFilterPresetResponse)(nil),             // 64: notificator.alert.SaveFilterPresetResponse
-	(*UpdateFilterPresetRequest)(nil),            // 65: notificator.alert.UpdateFilterPresetRequest
-	(*UpdateFilterPresetResponse)(nil),           // 66: notificator.alert.UpdateFilterPresetResponse
-	(*DeleteFilterPresetRequest)(nil),            // 67: notificator.alert.DeleteFilterPresetRequest
-	(*DeleteFilterPresetResponse)(nil),           // 68: notificator.alert.DeleteFilterPresetResponse
-	(*SetDefaultFilterPresetRequest)(nil),        // 69: notificator.alert.SetDefaultFilterPresetRequest
-	(*SetDefaultFilterPresetResponse)(nil),       // 70: notificator.alert.SetDefaultFilterPresetResponse
-	(*FilterPreset)(nil),                         // 71: notificator.alert.FilterPreset
-	(*GetAnnotationButtonConfigsRequest)(nil),    // 72: notificator.alert.GetAnnotationButtonConfigsRequest
-	(*GetAnnotationButtonConfigsResponse)(nil),   // 73: notificator.alert.GetAnnotationButtonConfigsResponse
-	(*SaveAnnotationButtonConfigsRequest)(nil),   // 74: notificator.alert.SaveAnnotationButtonConfigsRequest
-	(*SaveAnnotationButtonConfigsResponse)(nil),  // 75: notificator.alert.SaveAnnotationButtonConfigsResponse
-	(*CreateAnnotationButtonConfigRequest)(nil),  // 76: notificator.alert.CreateAnnotationButtonConfigRequest
-	(*CreateAnnotationButtonConfigResponse)(nil), // 77: notificator.alert.CreateAnnotationButtonConfigResponse
-	(*UpdateAnnotationButtonConfigRequest)(nil),  // 78: notificator.alert.UpdateAnnotationButtonConfigRequest
-	(*UpdateAnnotationButtonConfigResponse)(nil), // 79: notificator.alert.UpdateAnnotationButtonConfigResponse
-	(*DeleteAnnotationButtonConfigRequest)(nil),  // 80: notificator.alert.DeleteAnnotationButtonConfigRequest
-	(*DeleteAnnotationButtonConfigResponse)(nil), // 81: notificator.alert.DeleteAnnotationButtonConfigResponse
-	(*AnnotationButtonConfig)(nil),               // 82: notificator.alert.AnnotationButtonConfig
-	(*QueryStatisticsRequest)(nil),               // 83: notificator.alert.QueryStatisticsRequest
-	(*QueryStatisticsResponse)(nil),              // 84: notificator.alert.QueryStatisticsResponse
-	(*TimeRange)(nil),                            // 85: notificator.alert.TimeRange
-	(*AggregatedStatistics)(nil),                 // 86: notificator.alert.AggregatedStatistics
-	(*BreakdownItem)(nil),                        // 87: notificator.alert.BreakdownItem
-	(*QueryHeatmapRequest)(nil),                  // 88: notificator.alert.QueryHeatmapRequest
-	(*HeatmapCell)(nil),                          // 89: notificator.alert.HeatmapCell
-	(*QueryHeatmapResponse)(nil),                 // 90: notificator.alert.QueryHeatmapResponse
-	(*QueryFlappingAlertsRequest)(nil),           // 91: notificator.alert.QueryFlappingAlertsRequest
-	(*FlappingAlert)(nil),                        // 92: notificator.alert.FlappingAlert
-	(*QueryFlappingAlertsResponse)(nil),          // 93: notificator.alert.QueryFlappingAlertsResponse
-	(*SaveOnCallRuleRequest)(nil),                // 94: notificator.alert.SaveOnCallRuleRequest
-	(*SaveOnCallRuleResponse)(nil),               // 95: notificator.alert.SaveOnCallRuleResponse
-	(*GetOnCallRulesRequest)(nil),                // 96: notificator.alert.GetOnCallRulesRequest
-	(*GetOnCallRulesResponse)(nil),               // 97: notificator.alert.GetOnCallRulesResponse
-	(*GetOnCallRuleRequest)(nil),                 // 98: notificator.alert.GetOnCallRuleRequest
-	(*GetOnCallRuleResponse)(nil),                // 99: notificator.alert.GetOnCallRuleResponse
-	(*UpdateOnCallRuleRequest)(nil),              // 100: notificator.alert.UpdateOnCallRuleRequest
-	(*UpdateOnCallRuleResponse)(nil),             // 101: notificator.alert.UpdateOnCallRuleResponse
-	(*DeleteOnCallRuleRequest)(nil),              // 102: notificator.alert.DeleteOnCallRuleRequest
-	(*DeleteOnCallRuleResponse)(nil),             // 103: notificator.alert.DeleteOnCallRuleResponse
-	(*TestOnCallRuleRequest)(nil),                // 104: notificator.alert.TestOnCallRuleRequest
-	(*TestOnCallRuleResponse)(nil),               // 105: notificator.alert.TestOnCallRuleResponse
-	(*OnCallRule)(nil),                           // 106: notificator.alert.OnCallRule
-	(*RuleConfig)(nil),                           // 107: notificator.alert.RuleConfig
-	(*RuleCriterion)(nil),                        // 108: notificator.alert.RuleCriterion
-	(*AlertStatistic)(nil),                       // 109: notificator.alert.AlertStatistic
-	(*GetStatisticsSummaryRequest)(nil),          // 110: notificator.alert.GetStatisticsSummaryRequest
-	(*GetStatisticsSummaryResponse)(nil),         // 111: notificator.alert.GetStatisticsSummaryResponse
-	(*CaptureAlertFiredRequest)(nil),             // 112: notificator.alert.CaptureAlertFiredRequest
-	(*CaptureAlertFiredResponse)(nil),            // 113: notificator.alert.CaptureAlertFiredResponse
-	(*UpdateAlertResolvedRequest)(nil),           // 114: notificator.alert.UpdateAlertResolvedRequest
-	(*UpdateAlertResolvedResponse)(nil),          // 115: notificator.alert.UpdateAlertResolvedResponse
-	(*UpdateAlertAcknowledgedRequest)(nil),       // 116: notificator.alert.UpdateAlertAcknowledgedRequest
-	(*UpdateAlertAcknowledgedResponse)(nil),      // 117: notificator.alert.UpdateAlertAcknowledgedResponse
-	(*QueryRecentlyResolvedRequest)(nil),         // 118: notificator.alert.QueryRecentlyResolvedRequest
-	(*ResolvedAlertItem)(nil),                    // 119: notificator.alert.ResolvedAlertItem
-	(*QueryRecentlyResolvedResponse)(nil),        // 120: notificator.alert.QueryRecentlyResolvedResponse
-	(*GetAlertHistoryRequest)(nil),               // 121: notificator.alert.GetAlertHistoryRequest
-	(*GetAlertHistoryResponse)(nil),              // 122: notificator.alert.GetAlertHistoryResponse
-	(*GetAlertsByNameRequest)(nil),               // 123: notificator.alert.GetAlertsByNameRequest
-	(*GetAlertsByNameResponse)(nil),              // 124: notificator.alert.GetAlertsByNameResponse
-	(*ColumnConfig)(nil),                         // 125: notificator.alert.ColumnConfig
-	(*ColumnPreferences)(nil),                    // 126: notificator.alert.ColumnPreferences
-	(*GetUserColumnPreferencesRequest)(nil),      // 127: notificator.alert.GetUserColumnPreferencesRequest
-	(*GetUserColumnPreferencesResponse)(nil),     // 128: notificator.alert.GetUserColumnPreferencesResponse
-	(*SaveUserColumnPreferencesRequest)(nil),     // 129: notificator.alert.SaveUserColumnPreferencesRequest
-	(*SaveUserColumnPreferencesResponse)(nil),    // 130: notificator.alert.SaveUserColumnPreferencesResponse
-	(*GetStatisticsViewsRequest)(nil),            // 131: notificator.alert.GetStatisticsViewsRequest
-	(*GetStatisticsViewsResponse)(nil),           // 132: notificator.alert.GetStatisticsViewsResponse
-	(*SaveStatisticsViewRequest)(nil),            // 133: notificator.alert.SaveStatisticsViewRequest
-	(*SaveStatisticsViewResponse)(nil),           // 134: notificator.alert.SaveStatisticsViewResponse
-	(*UpdateStatisticsViewRequest)(nil),          // 135: notificator.alert.UpdateStatisticsViewRequest
-	(*UpdateStatisticsViewResponse)(nil),         // 136: notificator.alert.UpdateStatisticsViewResponse
-	(*DeleteStatisticsViewRequest)(nil),          // 137: notificator.alert.DeleteStatisticsViewRequest
-	(*DeleteStatisticsViewResponse)(nil),         // 138: notificator.alert.DeleteStatisticsViewResponse
-	(*SetDefaultStatisticsViewRequest)(nil),      // 139: notificator.alert.SetDefaultStatisticsViewRequest
-	(*SetDefaultStatisticsViewResponse)(nil),     // 140: notificator.alert.SetDefaultStatisticsViewResponse
-	(*StatisticsView)(nil),                       // 141: notificator.alert.StatisticsView
-	(*RelativeTimeConfig)(nil),                   // 142: notificator.alert.RelativeTimeConfig
-	(*StatisticsViewData)(nil),                   // 143: notificator.alert.StatisticsViewData
-	nil,                                          // 144: notificator.alert.GetCommentCountsBatchResponse.CountsEntry
-	nil,                                          // 145: notificator.alert.GetAllAcknowledgedAlertsResponse.AcknowledgedAlertsEntry
-	nil,                                          // 146: notificator.alert.UserColorPreference.LabelConditionsEntry
-	nil,                                          // 147: notificator.alert.QueryStatisticsResponse.StatisticsEntry
-	nil,                                          // 148: notificator.alert.BreakdownItem.StatisticsEntry
-	nil,                                          // 149: notificator.alert.GetStatisticsSummaryResponse.BySeverityEntry
-	nil,                                          // 150: notificator.alert.ResolvedAlertItem.LabelsEntry
-	nil,                                          // 151: notificator.alert.ResolvedAlertItem.AnnotationsEntry
-	(*timestamppb.Timestamp)(nil),                // 152: google.protobuf.Timestamp
+	(*GetCommentsByTagRequest)(nil),              // 6: notificator.alert.GetCommentsByTagRequest
+	(*GetCommentsByTagResponse)(nil),             // 7: notificator.alert.GetCommentsByTagResponse
+	(*GetCommentSettingsRequest)(nil),            // 8: notificator.alert.GetCommentSettingsRequest
+	(*GetCommentSettingsResponse)(nil),           // 9: notificator.alert.GetCommentSettingsResponse
+	(*GetCommentCountsBatchRequest)(nil),         // 10: notificator.alert.GetCommentCountsBatchRequest
+	(*GetCommentCountsBatchResponse)(nil),        // 11: notificator.alert.GetCommentCountsBatchResponse
+	(*DeleteCommentRequest)(nil),                 // 12: notificator.alert.DeleteCommentRequest
+	(*DeleteCommentResponse)(nil),                // 13: notificator.alert.DeleteCommentResponse
+	(*Comment)(nil),                              // 14: notificator.alert.Comment
+	(*AddAcknowledgmentRequest)(nil),             // 15: notificator.alert.AddAcknowledgmentRequest
+	(*AddAcknowledgmentResponse)(nil),            // 16: notificator.alert.AddAcknowledgmentResponse
+	(*GetAcknowledgmentsRequest)(nil),            // 17: notificator.alert.GetAcknowledgmentsRequest
+	(*GetAcknowledgmentsResponse)(nil),           // 18: notificator.alert.GetAcknowledgmentsResponse
+	(*GetAllAcknowledgedAlertsRequest)(nil),      // 19: notificator.alert.GetAllAcknowledgedAlertsRequest
+	(*GetAllAcknowledgedAlertsResponse)(nil),     // 20: notificator.alert.GetAllAcknowledgedAlertsResponse
+	(*DeleteAcknowledgmentRequest)(nil),          // 21: notificator.alert.DeleteAcknowledgmentRequest
+	(*DeleteAcknowledgmentResponse)(nil),         // 22: notificator.alert.DeleteAcknowledgmentResponse
+	(*BulkAcknowledgeRequest)(nil),               // 23: notificator.alert.BulkAcknowledgeRequest
+	(*BulkAcknowledgeResponse)(nil),              // 24: notificator.alert.BulkAcknowledgeResponse
+	(*Acknowledgment)(nil),                       // 25: notificator.alert.Acknowledgment
+	(*EscalateAlertRequest)(nil),                 // 26: notificator.alert.EscalateAlertRequest
+	(*EscalateAlertResponse)(nil),                // 27: notificator.alert.EscalateAlertResponse
+	(*GetEscalationsRequest)(nil),                // 28: notificator.alert.GetEscalationsRequest
+	(*GetEscalationsResponse)(nil),               // 29: notificator.alert.GetEscalationsResponse
+	(*Escalation)(nil),                           // 30: notificator.alert.Escalation
+	(*Mention)(nil),                              // 31: notificator.alert.Mention
+	(*Presence)(nil),                             // 32: notificator.alert.Presence
+	(*Viewer)(nil),                               // 33: notificator.alert.Viewer
+	(*GetAlertActivityRequest)(nil),              // 34: notificator.alert.GetAlertActivityRequest
+	(*GetAlertActivityResponse)(nil),             // 35: notificator.alert.GetAlertActivityResponse
+	(*AlertActivity)(nil),                        // 36: notificator.alert.AlertActivity
+	(*SubscribeToAlertUpdatesRequest)(nil),       // 37: notificator.alert.SubscribeToAlertUpdatesRequest
+	(*AlertUpdate)(nil),                          // 38: notificator.alert.AlertUpdate
+	(*GetUserColorPreferencesRequest)(nil),       // 39: notificator.alert.GetUserColorPreferencesRequest
+	(*GetUserColorPreferencesResponse)(nil),      // 40: notificator.alert.GetUserColorPreferencesResponse
+	(*SaveUserColorPreferencesRequest)(nil),      // 41: notificator.alert.SaveUserColorPreferencesRequest
+	(*SaveUserColorPreferencesResponse)(nil),     // 42: notificator.alert.SaveUserColorPreferencesResponse
+	(*DeleteUserColorPreferenceRequest)(nil),     // 43: notificator.alert.DeleteUserColorPreferenceRequest
+	(*DeleteUserColorPreferenceResponse)(nil),    // 44: notificator.alert.DeleteUserColorPreferenceResponse
+	(*UserColorPreference)(nil),                  // 45: notificator.alert.UserColorPreference
+	(*CreateResolvedAlertRequest)(nil),           // 46: notificator.alert.CreateResolvedAlertRequest
+	(*CreateResolvedAlertResponse)(nil),          // 47: notificator.alert.CreateResolvedAlertResponse
+	(*GetResolvedAlertsRequest)(nil),             // 48: notificator.alert.GetResolvedAlertsRequest
+	(*GetResolvedAlertsResponse)(nil),            // 49: notificator.alert.GetResolvedAlertsResponse
+	(*GetResolvedAlertRequest)(nil),              // 50: notificator.alert.GetResolvedAlertRequest
+	(*GetResolvedAlertResponse)(nil),             // 51: notificator.alert.GetResolvedAlertResponse
+	(*RestoreResolvedAlertRequest)(nil),          // 52: notificator.alert.RestoreResolvedAlertRequest
+	(*RestoreResolvedAlertResponse)(nil),         // 53: notificator.alert.RestoreResolvedAlertResponse
+	(*RemoveAllResolvedAlertsRequest)(nil),       // 54: notificator.alert.RemoveAllResolvedAlertsRequest
+	(*RemoveAllResolvedAlertsResponse)(nil),      // 55: notificator.alert.RemoveAllResolvedAlertsResponse
+	(*UndoClearRequest)(nil),                     // 56: notificator.alert.UndoClearRequest
+	(*UndoClearResponse)(nil),                    // 57: notificator.alert.UndoClearResponse
+	(*GetResolvedAlertSettingsRequest)(nil),      // 58: notificator.alert.GetResolvedAlertSettingsRequest
+	(*GetResolvedAlertSettingsResponse)(nil),     // 59: notificator.alert.GetResolvedAlertSettingsResponse
+	(*SaveResolvedAlertSettingsRequest)(nil),     // 60: notificator.alert.SaveResolvedAlertSettingsRequest
+	(*SaveResolvedAlertSettingsResponse)(nil),    // 61: notificator.alert.SaveResolvedAlertSettingsResponse
+	(*StreamResolvedAlertUpdatesRequest)(nil),    // 62: notificator.alert.StreamResolvedAlertUpdatesRequest
+	(*ResolvedAlertUpdate)(nil),                  // 63: notificator.alert.ResolvedAlertUpdate
+	(*ResolvedAlertInfo)(nil),                    // 64: notificator.alert.ResolvedAlertInfo
+	(*GetUserHiddenAlertsRequest)(nil),           // 65: notificator.alert.GetUserHiddenAlertsRequest
+	(*GetUserHiddenAlertsResponse)(nil),          // 66: notificator.alert.GetUserHiddenAlertsResponse
+	(*HideAlertRequest)(nil),                     // 67: notificator.alert.HideAlertRequest
+	(*HideAlertResponse)(nil),                    // 68: notificator.alert.HideAlertResponse
+	(*UnhideAlertRequest)(nil),                   // 69: notificator.alert.UnhideAlertRequest
+	(*UnhideAlertResponse)(nil),                  // 70: notificator.alert.UnhideAlertResponse
+	(*ClearAllHiddenAlertsRequest)(nil),          // 71: notificator.alert.ClearAllHiddenAlertsRequest
+	(*ClearAllHiddenAlertsResponse)(nil),         // 72: notificator.alert.ClearAllHiddenAlertsResponse
+	(*UserHiddenAlert)(nil),                      // 73: notificator.alert.UserHiddenAlert
+	(*GetUserHiddenRulesRequest)(nil),            // 74: notificator.alert.GetUserHiddenRulesRequest
+	(*GetUserHiddenRulesResponse)(nil),           // 75: notificator.alert.GetUserHiddenRulesResponse
+	(*SaveHiddenRuleRequest)(nil),                // 76: notificator.alert.SaveHiddenRuleRequest
+	(*SaveHiddenRuleResponse)(nil),               // 77: notificator.alert.SaveHiddenRuleResponse
+	(*RemoveHiddenRuleRequest)(nil),              // 78: notificator.alert.RemoveHiddenRuleRequest
+	(*RemoveHiddenRuleResponse)(nil),             // 79: notificator.alert.RemoveHiddenRuleResponse
+	(*UserHiddenRule)(nil),                       // 80: notificator.alert.UserHiddenRule
+	(*GetNotificationPreferencesRequest)(nil),    // 81: notificator.alert.GetNotificationPreferencesRequest
+	(*GetNotificationPreferencesResponse)(nil),   // 82: notificator.alert.GetNotificationPreferencesResponse
+	(*SaveNotificationPreferencesRequest)(nil),   // 83: notificator.alert.SaveNotificationPreferencesRequest
+	(*SaveNotificationPreferencesResponse)(nil),  // 84: notificator.alert.SaveNotificationPreferencesResponse
+	(*NotificationPreference)(nil),               // 85: notificator.alert.NotificationPreference
+	(*DoNotDisturbSchedule)(nil),                 // 86: notificator.alert.DoNotDisturbSchedule
+	(*GetFilterPresetsRequest)(nil),              // 87: notificator.alert.GetFilterPresetsRequest
+	(*GetFilterPresetsResponse)(nil),             // 88: notificator.alert.GetFilterPresetsResponse
+	(*SaveFilterPresetRequest)(nil),              // 89: notificator.alert.SaveFilterPresetRequest
+	(*SaveFilterPresetResponse)(nil),             // 90: notificator.alert.SaveFilterPresetResponse
+	(*UpdateFilterPresetRequest)(nil),            // 91: notificator.alert.UpdateFilterPresetRequest
+	(*UpdateFilterPresetResponse)(nil),           // 92: notificator.alert.UpdateFilterPresetResponse
+	(*DeleteFilterPresetRequest)(nil),            // 93: notificator.alert.DeleteFilterPresetRequest
+	(*DeleteFilterPresetResponse)(nil),           // 94: notificator.alert.DeleteFilterPresetResponse
+	(*SetDefaultFilterPresetRequest)(nil),        // 95: notificator.alert.SetDefaultFilterPresetRequest
+	(*SetDefaultFilterPresetResponse)(nil),       // 96: notificator.alert.SetDefaultFilterPresetResponse
+	(*FilterPreset)(nil),                         // 97: notificator.alert.FilterPreset
+	(*GetAnnotationButtonConfigsRequest)(nil),    // 98: notificator.alert.GetAnnotationButtonConfigsRequest
+	(*GetAnnotationButtonConfigsResponse)(nil),   // 99: notificator.alert.GetAnnotationButtonConfigsResponse
+	(*SaveAnnotationButtonConfigsRequest)(nil),   // 100: notificator.alert.SaveAnnotationButtonConfigsRequest
+	(*SaveAnnotationButtonConfigsResponse)(nil),  // 101: notificator.alert.SaveAnnotationButtonConfigsResponse
+	(*CreateAnnotationButtonConfigRequest)(nil),  // 102: notificator.alert.CreateAnnotationButtonConfigRequest
+	(*CreateAnnotationButtonConfigResponse)(nil), // 103: notificator.alert.CreateAnnotationButtonConfigResponse
+	(*UpdateAnnotationButtonConfigRequest)(nil),  // 104: notificator.alert.UpdateAnnotationButtonConfigRequest
+	(*UpdateAnnotationButtonConfigResponse)(nil), // 105: notificator.alert.UpdateAnnotationButtonConfigResponse
+	(*DeleteAnnotationButtonConfigRequest)(nil),  // 106: notificator.alert.DeleteAnnotationButtonConfigRequest
+	(*DeleteAnnotationButtonConfigResponse)(nil), // 107: notificator.alert.DeleteAnnotationButtonConfigResponse
+	(*AnnotationButtonConfig)(nil),               // 108: notificator.alert.AnnotationButtonConfig
+	(*QueryStatisticsRequest)(nil),               // 109: notificator.alert.QueryStatisticsRequest
+	(*QueryStatisticsResponse)(nil),              // 110: notificator.alert.QueryStatisticsResponse
+	(*TimeRange)(nil),                            // 111: notificator.alert.TimeRange
+	(*AggregatedStatistics)(nil),                 // 112: notificator.alert.AggregatedStatistics
+	(*BreakdownItem)(nil),                        // 113: notificator.alert.BreakdownItem
+	(*QueryHeatmapRequest)(nil),                  // 114: notificator.alert.QueryHeatmapRequest
+	(*HeatmapCell)(nil),                          // 115: notificator.alert.HeatmapCell
+	(*QueryHeatmapResponse)(nil),                 // 116: notificator.alert.QueryHeatmapResponse
+	(*QueryFlappingAlertsRequest)(nil),           // 117: notificator.alert.QueryFlappingAlertsRequest
+	(*FlappingAlert)(nil),                        // 118: notificator.alert.FlappingAlert
+	(*QueryFlappingAlertsResponse)(nil),          // 119: notificator.alert.QueryFlappingAlertsResponse
+	(*SaveOnCallRuleRequest)(nil),                // 120: notificator.alert.SaveOnCallRuleRequest
+	(*SaveOnCallRuleResponse)(nil),               // 121: notificator.alert.SaveOnCallRuleResponse
+	(*GetOnCallRulesRequest)(nil),                // 122: notificator.alert.GetOnCallRulesRequest
+	(*GetOnCallRulesResponse)(nil),               // 123: notificator.alert.GetOnCallRulesResponse
+	(*GetOnCallRuleRequest)(nil),                 // 124: notificator.alert.GetOnCallRuleRequest
+	(*GetOnCallRuleResponse)(nil),                // 125: notificator.alert.GetOnCallRuleResponse
+	(*UpdateOnCallRuleRequest)(nil),              // 126: notificator.alert.UpdateOnCallRuleRequest
+	(*UpdateOnCallRuleResponse)(nil),             // 127: notificator.alert.UpdateOnCallRuleResponse
+	(*DeleteOnCallRuleRequest)(nil),              // 128: notificator.alert.DeleteOnCallRuleRequest
+	(*DeleteOnCallRuleResponse)(nil),             // 129: notificator.alert.DeleteOnCallRuleResponse
+	(*TestOnCallRuleRequest)(nil),                // 130: notificator.alert.TestOnCallRuleRequest
+	(*TestOnCallRuleResponse)(nil),               // 131: notificator.alert.TestOnCallRuleResponse
+	(*OnCallRule)(nil),                           // 132: notificator.alert.OnCallRule
+	(*RuleConfig)(nil),                           // 133: notificator.alert.RuleConfig
+	(*RuleCriterion)(nil),                        // 134: notificator.alert.RuleCriterion
+	(*AlertStatistic)(nil),                       // 135: notificator.alert.AlertStatistic
+	(*GetStatisticsSummaryRequest)(nil),          // 136: notificator.alert.GetStatisticsSummaryRequest
+	(*GetStatisticsSummaryResponse)(nil),         // 137: notificator.alert.GetStatisticsSummaryResponse
+	(*CaptureAlertFiredRequest)(nil),             // 138: notificator.alert.CaptureAlertFiredRequest
+	(*CaptureAlertFiredResponse)(nil),            // 139: notificator.alert.CaptureAlertFiredResponse
+	(*UpdateAlertResolvedRequest)(nil),           // 140: notificator.alert.UpdateAlertResolvedRequest
+	(*UpdateAlertResolvedResponse)(nil),          // 141: notificator.alert.UpdateAlertResolvedResponse
+	(*UpdateAlertAcknowledgedRequest)(nil),       // 142: notificator.alert.UpdateAlertAcknowledgedRequest
+	(*UpdateAlertAcknowledgedResponse)(nil),      // 143: notificator.alert.UpdateAlertAcknowledgedResponse
+	(*QueryRecentlyResolvedRequest)(nil),         // 144: notificator.alert.QueryRecentlyResolvedRequest
+	(*ResolvedAlertItem)(nil),                    // 145: notificator.alert.ResolvedAlertItem
+	(*QueryRecentlyResolvedResponse)(nil),        // 146: notificator.alert.QueryRecentlyResolvedResponse
+	(*GetAlertHistoryRequest)(nil),               // 147: notificator.alert.GetAlertHistoryRequest
+	(*GetAlertHistoryResponse)(nil),              // 148: notificator.alert.GetAlertHistoryResponse
+	(*GetAlertsByNameRequest)(nil),               // 149: notificator.alert.GetAlertsByNameRequest
+	(*GetAlertsByNameResponse)(nil),              // 150: notificator.alert.GetAlertsByNameResponse
+	(*ColumnConfig)(nil),                         // 151: notificator.alert.ColumnConfig
+	(*ColumnPreferences)(nil),                    // 152: notificator.alert.ColumnPreferences
+	(*GetUserColumnPreferencesRequest)(nil),      // 153: notificator.alert.GetUserColumnPreferencesRequest
+	(*GetUserColumnPreferencesResponse)(nil),     // 154: notificator.alert.GetUserColumnPreferencesResponse
+	(*SaveUserColumnPreferencesRequest)(nil),     // 155: notificator.alert.SaveUserColumnPreferencesRequest
+	(*SaveUserColumnPreferencesResponse)(nil),    // 156: notificator.alert.SaveUserColumnPreferencesResponse
+	(*ExportPreferencesRequest)(nil),             // 157: notificator.alert.ExportPreferencesRequest
+	(*ExportPreferencesResponse)(nil),            // 158: notificator.alert.ExportPreferencesResponse
+	(*ImportPreferencesRequest)(nil),             // 159: notificator.alert.ImportPreferencesRequest
+	(*ImportPreferencesResponse)(nil),            // 160: notificator.alert.ImportPreferencesResponse
+	(*CheckPermissionRequest)(nil),               // 161: notificator.alert.CheckPermissionRequest
+	(*CheckPermissionResponse)(nil),              // 162: notificator.alert.CheckPermissionResponse
+	(*HealthCheckRequest)(nil),                   // 163: notificator.alert.HealthCheckRequest
+	(*HealthCheckResponse)(nil),                  // 164: notificator.alert.HealthCheckResponse
+	(*GetStatisticsViewsRequest)(nil),            // 165: notificator.alert.GetStatisticsViewsRequest
+	(*GetStatisticsViewsResponse)(nil),           // 166: notificator.alert.GetStatisticsViewsResponse
+	(*SaveStatisticsViewRequest)(nil),            // 167: notificator.alert.SaveStatisticsViewRequest
+	(*SaveStatisticsViewResponse)(nil),           // 168: notificator.alert.SaveStatisticsViewResponse
+	(*UpdateStatisticsViewRequest)(nil),          // 169: notificator.alert.UpdateStatisticsViewRequest
+	(*UpdateStatisticsViewResponse)(nil),         // 170: notificator.alert.UpdateStatisticsViewResponse
+	(*DeleteStatisticsViewRequest)(nil),          // 171: notificator.alert.DeleteStatisticsViewRequest
+	(*DeleteStatisticsViewResponse)(nil),         // 172: notificator.alert.DeleteStatisticsViewResponse
+	(*SetDefaultStatisticsViewRequest)(nil),      // 173: notificator.alert.SetDefaultStatisticsViewRequest
+	(*SetDefaultStatisticsViewResponse)(nil),     // 174: notificator.alert.SetDefaultStatisticsViewResponse
+	(*StatisticsView)(nil),                       // 175: notificator.alert.StatisticsView
+	(*RelativeTimeConfig)(nil),                   // 176: notificator.alert.RelativeTimeConfig
+	(*StatisticsViewData)(nil),                   // 177: notificator.alert.StatisticsViewData
+	nil,                                          // 178: notificator.alert.GetCommentCountsBatchResponse.CountsEntry
+	nil,                                          // 179: notificator.alert.GetAllAcknowledgedAlertsResponse.AcknowledgedAlertsEntry
+	nil,                                          // 180: notificator.alert.GetAllAcknowledgedAlertsResponse.AcknowledgmentCountsEntry
+	nil,                                          // 181: notificator.alert.BulkAcknowledgeResponse.ResultsEntry
+	nil,                                          // 182: notificator.alert.UserColorPreference.LabelConditionsEntry
+	nil,                                          // 183: notificator.alert.QueryStatisticsResponse.StatisticsEntry
+	nil,                                          // 184: notificator.alert.BreakdownItem.StatisticsEntry
+	nil,                                          // 185: notificator.alert.GetStatisticsSummaryResponse.BySeverityEntry
+	nil,                                          // 186: notificator.alert.ResolvedAlertItem.LabelsEntry
+	nil,                                          // 187: notificator.alert.ResolvedAlertItem.AnnotationsEntry
+	(*timestamppb.Timestamp)(nil),                // 188: google.protobuf.Timestamp
 }
 var file_proto_alert_proto_depIdxs = []int32{
-	10,  // 0: notificator.alert.AddCommentResponse.comment:type_name -> notificator.alert.Comment
-	10,  // 1: notificator.alert.GetCommentsResponse.comments:type_name -> notificator.alert.Comment
-	144, // 2: notificator.alert.GetCommentCountsBatchResponse.counts:type_name -> notificator.alert.GetCommentCountsBatchResponse.CountsEntry
-	152, // 3: notificator.alert.Comment.created_at:type_name -> google.protobuf.Timestamp
-	19,  // 4: notificator.alert.AddAcknowledgmentResponse.acknowledgment:type_name -> notificator.alert.Acknowledgment
-	19,  // 5: notificator.alert.GetAcknowledgmentsResponse.acknowledgments:type_name -> notificator.alert.Acknowledgment
-	145, // 6: notificator.alert.GetAllAcknowledgedAlertsResponse.acknowledged_alerts:type_name -> notificator.alert.GetAllAcknowledgedAlertsResponse.AcknowledgedAlertsEntry
-	152, // 7: notificator.alert.Acknowledgment.created_at:type_name -> google.protobuf.Timestamp
-	0,   // 8: notificator.alert.AlertUpdate.update_type:type_name -> notificator.alert.UpdateType
-	10,  // 9: notificator.alert.AlertUpdate.comment:type_name -> notificator.alert.Comment
-	19,  // 10: notificator.alert.AlertUpdate.acknowledgment:type_name -> notificator.alert.Acknowledgment
-	152, // 11: notificator.alert.AlertUpdate.timestamp:type_name -> google.protobuf.Timestamp
-	28,  // 12: notificator.alert.GetUserColorPreferencesResponse.preferences:type_name -> notificator.alert.UserColorPreference
-	28,  // 13: notificator.alert.SaveUserColorPreferencesRequest.preferences:type_name -> notificator.alert.UserColorPreference
-	146, // 14: notificator.alert.UserColorPreference.label_conditions:type_name -> notificator.alert.UserColorPreference.LabelConditionsEntry
-	152, // 15: notificator.alert.UserColorPreference.created_at:type_name -> google.protobuf.Timestamp
-	152, // 16: notificator.alert.UserColorPreference.updated_at:type_name -> google.protobuf.Timestamp
-	39,  // 17: notificator.alert.CreateResolvedAlertResponse.resolved_alert:type_name -> notificator.alert.ResolvedAlertInfo
-	39,  // 18: notificator.alert.GetResolvedAlertsResponse.resolved_alerts:type_name -> notificator.alert.ResolvedAlertInfo
-	39,  // 19: notificator.alert.GetResolvedAlertResponse.resolved_alert:type_name -> notificator.alert.ResolvedAlertInfo
-	1,   // 20: notificator.alert.ResolvedAlertUpdate.update_type:type_name -> notificator.alert.ResolvedAlertUpdateType
-	39,  // 21: notificator.alert.ResolvedAlertUpdate.resolved_alert:type_name -> notificator.alert.ResolvedAlertInfo
-	152, // 22: notificator.alert.ResolvedAlertUpdate.timestamp:type_name -> google.protobuf.Timestamp
-	152, // 23: notificator.alert.ResolvedAlertInfo.resolved_at:type_name -> google.protobuf.Timestamp
-	152, // 24: notificator.alert.ResolvedAlertInfo.expires_at:type_name -> google.protobuf.Timestamp
-	152, // 25: notificator.alert.ResolvedAlertInfo.created_at:type_name -> google.protobuf.Timestamp
-	152, // 26: notificator.alert.ResolvedAlertInfo.updated_at:type_name -> google.protobuf.Timestamp
-	48,  // 27: notificator.alert.GetUserHiddenAlertsResponse.hidden_alerts:type_name -> notificator.alert.UserHiddenAlert
-	48,  // 28: notificator.alert.HideAlertResponse.hidden_alert:type_name -> notificator.alert.UserHiddenAlert
-	152, // 29: notificator.alert.UserHiddenAlert.created_at:type_name -> google.protobuf.Timestamp
-	152, // 30: notificator.alert.UserHiddenAlert.updated_at:type_name -> google.protobuf.Timestamp
-	55,  // 31: notificator.alert.GetUserHiddenRulesResponse.hidden_rules:type_name -> notificator.alert.UserHiddenRule
-	55,  // 32: notificator.alert.SaveHiddenRuleRequest.rule:type_name -> notificator.alert.UserHiddenRule
-	55,  // 33: notificator.alert.SaveHiddenRuleResponse.rule:type_name -> notificator.alert.UserHiddenRule
-	152, // 34: notificator.alert.UserHiddenRule.created_at:type_name -> google.protobuf.Timestamp
-	152, // 35: notificator.alert.UserHiddenRule.updated_at:type_name -> google.protobuf.Timestamp
-	60,  // 36: notificator.alert.GetNotificationPreferencesResponse.preferences:type_name -> notificator.alert.NotificationPreference
-	60,  // 37: notificator.alert.SaveNotificationPreferencesResponse.preferences:type_name -> notificator.alert.NotificationPreference
-	152, // 38: notificator.alert.NotificationPreference.created_at:type_name -> google.protobuf.Timestamp
-	152, // 39: notificator.alert.NotificationPreference.updated_at:type_name -> google.protobuf.Timestamp
-	71,  // 40: notificator.alert.GetFilterPresetsResponse.presets:type_name -> notificator.alert.FilterPreset
-	71,  // 41: notificator.alert.SaveFilterPresetResponse.preset:type_name -> notificator.alert.FilterPreset
-	71,  // 42: notificator.alert.UpdateFilterPresetResponse.preset:type_name -> notificator.alert.FilterPreset
-	152, // 43: notificator.alert.FilterPreset.created_at:type_name -> google.protobuf.Timestamp
-	152, // 44: notificator.alert.FilterPreset.updated_at:type_name -> google.protobuf.Timestamp
-	82,  // 45: notificator.alert.GetAnnotationButtonConfigsResponse.configs:type_name -> notificator.alert.AnnotationButtonConfig
-	82,  // 46: notificator.alert.SaveAnnotationButtonConfigsRequest.configs:type_name -> notificator.alert.AnnotationButtonConfig
-	82,  // 47: notificator.alert.CreateAnnotationButtonConfigRequest.config:type_name -> notificator.alert.AnnotationButtonConfig
-	82,  // 48: notificator.alert.CreateAnnotationButtonConfigResponse.config:type_name -> notificator.alert.AnnotationButtonConfig
-	82,  // 49: notificator.alert.UpdateAnnotationButtonConfigRequest.config:type_name -> notificator.alert.AnnotationButtonConfig
-	82,  // 50: notificator.alert.UpdateAnnotationButtonConfigResponse.config:type_name -> notificator.alert.AnnotationButtonConfig
-	152, // 51: notificator.alert.AnnotationButtonConfig.created_at:type_name -> google.protobuf.Timestamp
-	152, // 52: notificator.alert.AnnotationButtonConfig.updated_at:type_name -> google.protobuf.Timestamp
-	152, // 53: notificator.alert.QueryStatisticsRequest.start_date:type_name -> google.protobuf.Timestamp
-	152, // 54: notificator.alert.QueryStatisticsRequest.end_date:type_name -> google.protobuf.Timestamp
-	85,  // 55: notificator.alert.QueryStatisticsResponse.time_range:type_name -> notificator.alert.TimeRange
-	147, // 56: notificator.alert.QueryStatisticsResponse.statistics:type_name -> notificator.alert.QueryStatisticsResponse.StatisticsEntry
-	87,  // 57: notificator.alert.QueryStatisticsResponse.breakdown:type_name -> notificator.alert.BreakdownItem
-	152, // 58: notificator.alert.TimeRange.start:type_name -> google.protobuf.Timestamp
-	152, // 59: notificator.alert.TimeRange.end:type_name -> google.protobuf.Timestamp
-	152, // 60: notificator.alert.BreakdownItem.start_time:type_name -> google.protobuf.Timestamp
-	152, // 61: notificator.alert.BreakdownItem.end_time:type_name -> google.protobuf.Timestamp
-	148, // 62: notificator.alert.BreakdownItem.statistics:type_name -> notificator.alert.BreakdownItem.StatisticsEntry
-	152, // 63: notificator.alert.QueryHeatmapRequest.start_date:type_name -> google.protobuf.Timestamp
-	152, // 64: notificator.alert.QueryHeatmapRequest.end_date:type_name -> google.protobuf.Timestamp
-	89,  // 65: notificator.alert.QueryHeatmapResponse.cells:type_name -> notificator.alert.HeatmapCell
-	152, // 66: notificator.alert.QueryFlappingAlertsRequest.start_date:type_name -> google.protobuf.Timestamp
-	152, // 67: notificator.alert.QueryFlappingAlertsRequest.end_date:type_name -> google.protobuf.Timestamp
-	92,  // 68: notificator.alert.QueryFlappingAlertsResponse.alerts:type_name -> notificator.alert.FlappingAlert
-	107, // 69: notificator.alert.SaveOnCallRuleRequest.rule_config:type_name -> notificator.alert.RuleConfig
-	106, // 70: notificator.alert.SaveOnCallRuleResponse.rule:type_name -> notificator.alert.OnCallRule
-	106, // 71: notificator.alert.GetOnCallRulesResponse.rules:type_name -> notificator.alert.OnCallRule
-	106, // 72: notificator.alert.GetOnCallRuleResponse.rule:type_name -> notificator.alert.OnCallRule
-	107, // 73: notificator.alert.UpdateOnCallRuleRequest.rule_config:type_name -> notificator.alert.RuleConfig
-	106, // 74: notificator.alert.UpdateOnCallRuleResponse.rule:type_name -> notificator.alert.OnCallRule
-	107, // 75: notificator.alert.TestOnCallRuleRequest.rule_config:type_name -> notificator.alert.RuleConfig
-	109, // 76: notificator.alert.TestOnCallRuleResponse.sample_alerts:type_name -> notificator.alert.AlertStatistic
-	107, // 77: notificator.alert.OnCallRule.rule_config:type_name -> notificator.alert.RuleConfig
-	152, // 78: notificator.alert.OnCallRule.created_at:type_name -> google.protobuf.Timestamp
-	152, // 79: notificator.alert.OnCallRule.updated_at:type_name -> google.protobuf.Timestamp
-	108, // 80: notificator.alert.RuleConfig.criteria:type_name -> notificator.alert.RuleCriterion
-	152, // 81: notificator.alert.AlertStatistic.fired_at:type_name -> google.protobuf.Timestamp
-	152, // 82: notificator.alert.AlertStatistic.resolved_at:type_name -> google.protobuf.Timestamp
-	152, // 83: notificator.alert.AlertStatistic.acknowledged_at:type_name -> google.protobuf.Timestamp
-	152, // 84: notificator.alert.AlertStatistic.created_at:type_name -> google.protobuf.Timestamp
-	152, // 85: notificator.alert.AlertStatistic.updated_at:type_name -> google.protobuf.Timestamp
-	149, // 86: notificator.alert.GetStatisticsSummaryResponse.by_severity:type_name -> notificator.alert.GetStatisticsSummaryResponse.BySeverityEntry
-	152, // 87: notificator.alert.GetStatisticsSummaryResponse.earliest_alert:type_name -> google.protobuf.Timestamp
-	152, // 88: notificator.alert.GetStatisticsSummaryResponse.latest_alert:type_name -> google.protobuf.Timestamp
-	152, // 89: notificator.alert.CaptureAlertFiredRequest.starts_at:type_name -> google.protobuf.Timestamp
-	152, // 90: notificator.alert.UpdateAlertResolvedRequest.resolved_at:type_name -> google.protobuf.Timestamp
-	152, // 91: notificator.alert.UpdateAlertAcknowledgedRequest.acknowledged_at:type_name -> google.protobuf.Timestamp
-	152, // 92: notificator.alert.QueryRecentlyResolvedRequest.start_date:type_name -> google.protobuf.Timestamp
-	152, // 93: notificator.alert.QueryRecentlyResolvedRequest.end_date:type_name -> google.protobuf.Timestamp
-	152, // 94: notificator.alert.ResolvedAlertItem.first_fired_at:type_name -> google.protobuf.Timestamp
-	152, // 95: notificator.alert.ResolvedAlertItem.last_resolved_at:type_name -> google.protobuf.Timestamp
-	150, // 96: notificator.alert.ResolvedAlertItem.labels:type_name -> notificator.alert.ResolvedAlertItem.LabelsEntry
-	151, // 97: notificator.alert.ResolvedAlertItem.annotations:type_name -> notificator.alert.ResolvedAlertItem.AnnotationsEntry
-	119, // 98: notificator.alert.QueryRecentlyResolvedResponse.alerts:type_name -> notificator.alert.ResolvedAlertItem
-	152, // 99: notificator.alert.QueryRecentlyResolvedResponse.start_date:type_name -> google.protobuf.Timestamp
-	152, // 100: notificator.alert.QueryRecentlyResolvedResponse.end_date:type_name -> google.protobuf.Timestamp
-	109, // 101: notificator.alert.GetAlertHistoryResponse.history:type_name -> notificator.alert.AlertStatistic
-	152, // 102: notificator.alert.GetAlertsByNameRequest.start_date:type_name -> google.protobuf.Timestamp
-	152, // 103: notificator.alert.GetAlertsByNameRequest.end_date:type_name -> google.protobuf.Timestamp
-	109, // 104: notificator.alert.GetAlertsByNameResponse.alerts:type_name -> notificator.alert.AlertStatistic
-	125, // 105: notificator.alert.ColumnPreferences.column_configs:type_name -> notificator.alert.ColumnConfig
-	152, // 106: notificator.alert.ColumnPreferences.created_at:type_name -> google.protobuf.Timestamp
-	152, // 107: notificator.alert.ColumnPreferences.updated_at:type_name -> google.protobuf.Timestamp
-	126, // 108: notificator.alert.GetUserColumnPreferencesResponse.preferences:type_name -> notificator.alert.ColumnPreferences
-	125, // 109: notificator.alert.SaveUserColumnPreferencesRequest.column_configs:type_name -> notificator.alert.ColumnConfig
-	141, // 110: notificator.alert.GetStatisticsViewsResponse.views:type_name -> notificator.alert.StatisticsView
-	143, // 111: notificator.alert.SaveStatisticsViewRequest.view_data:type_name -> notificator.alert.StatisticsViewData
-	141, // 112: notificator.alert.SaveStatisticsViewResponse.view:type_name -> notificator.alert.StatisticsView
-	143, // 113: notificator.alert.UpdateStatisticsViewRequest.view_data:type_name -> notificator.alert.StatisticsViewData
-	141, // 114: notificator.alert.UpdateStatisticsViewResponse.view:type_name -> notificator.alert.StatisticsView
-	143, // 115: notificator.alert.StatisticsView.view_data:type_name -> notificator.alert.StatisticsViewData
-	152, // 116: notificator.alert.StatisticsView.created_at:type_name -> google.protobuf.Timestamp
-	152, // 117: notificator.alert.StatisticsView.updated_at:type_name -> google.protobuf.Timestamp
-	142, // 118: notificator.alert.StatisticsViewData.relative_from:type_name -> notificator.alert.RelativeTimeConfig
-	142, // 119: notificator.alert.StatisticsViewData.relative_until:type_name -> notificator.alert.RelativeTimeConfig
-	19,  // 120: notificator.alert.GetAllAcknowledgedAlertsResponse.AcknowledgedAlertsEntry.value:type_name -> notificator.alert.Acknowledgment
-	86,  // 121: notificator.alert.QueryStatisticsResponse.StatisticsEntry.value:type_name -> notificator.alert.AggregatedStatistics
-	86,  // 122: notificator.alert.BreakdownItem.StatisticsEntry.value:type_name -> notificator.alert.AggregatedStatistics
-	86,  // 123: notificator.alert.GetStatisticsSummaryResponse.BySeverityEntry.value:type_name -> notificator.alert.AggregatedStatistics
-	2,   // 124: notificator.alert.AlertService.AddComment:input_type -> notificator.alert.AddCommentRequest
-	4,   // 125: notificator.alert.AlertService.GetComments:input_type -> notificator.alert.GetCommentsRequest
-	6,   // 126: notificator.alert.AlertService.GetCommentCountsBatch:input_type -> notificator.alert.GetCommentCountsBatchRequest
-	8,   // 127: notificator.alert.AlertService.DeleteComment:input_type -> notificator.alert.DeleteCommentRequest
-	11,  // 128: notificator.alert.AlertService.AddAcknowledgment:input_type -> notificator.alert.AddAcknowledgmentRequest
-	13,  // 129: notificator.alert.AlertService.GetAcknowledgments:input_type -> notificator.alert.GetAcknowledgmentsRequest
-	15,  // 130: notificator.alert.AlertService.GetAllAcknowledgedAlerts:input_type -> notificator.alert.GetAllAcknowledgedAlertsRequest
-	17,  // 131: notificator.alert.AlertService.DeleteAcknowledgment:input_type -> notificator.alert.DeleteAcknowledgmentRequest
-	20,  // 132: notificator.alert.AlertService.SubscribeToAlertUpdates:input_type -> notificator.alert.SubscribeToAlertUpdatesRequest
-	29,  // 133: notificator.alert.AlertService.CreateResolvedAlert:input_type -> notificator.alert.CreateResolvedAlertRequest
-	31,  // 134: notificator.alert.AlertService.GetResolvedAlerts:input_type -> notificator.alert.GetResolvedAlertsRequest
-	33,  // 135: notificator.alert.AlertService.GetResolvedAlert:input_type -> notificator.alert.GetResolvedAlertRequest
-	35,  // 136: notificator.alert.AlertService.RemoveAllResolvedAlerts:input_type -> notificator.alert.RemoveAllResolvedAlertsRequest
-	37,  // 137: notificator.alert.AlertService.StreamResolvedAlertUpdates:input_type -> notificator.alert.StreamResolvedAlertUpdatesRequest
-	22,  // 138: notificator.alert.AlertService.GetUserColorPreferences:input_type -> notificator.alert.GetUserColorPreferencesRequest
-	24,  // 139: notificator.alert.AlertService.SaveUserColorPreferences:input_type -> notificator.alert.SaveUserColorPreferencesRequest
-	26,  // 140: notificator.alert.AlertService.DeleteUserColorPreference:input_type -> notificator.alert.DeleteUserColorPreferenceRequest
-	40,  // 141: notificator.alert.AlertService.GetUserHiddenAlerts:input_type -> notificator.alert.GetUserHiddenAlertsRequest
-	42,  // 142: notificator.alert.AlertService.HideAlert:input_type -> notificator.alert.HideAlertRequest
-	44,  // 143: notificator.alert.AlertService.UnhideAlert:input_type -> notificator.alert.UnhideAlertRequest
-	46,  // 144: notificator.alert.AlertService.ClearAllHiddenAlerts:input_type -> notificator.alert.ClearAllHiddenAlertsRequest
-	49,  // 145: notificator.alert.AlertService.GetUserHiddenRules:input_type -> notificator.alert.GetUserHiddenRulesRequest
-	51,  // 146: notificator.alert.AlertService.SaveHiddenRule:input_type -> notificator.alert.SaveHiddenRuleRequest
-	53,  // 147: notificator.alert.AlertService.RemoveHiddenRule:input_type -> notificator.alert.RemoveHiddenRuleRequest
-	56,  // 148: notificator.alert.AlertService.GetNotificationPreferences:input_type -> notificator.alert.GetNotificationPreferencesRequest
-	58,  // 149: notificator.alert.AlertService.SaveNotificationPreferences:input_type -> notificator.alert.SaveNotificationPreferencesRequest
-	61,  // 150: notificator.alert.AlertService.GetFilterPresets:input_type -> notificator.alert.GetFilterPresetsRequest
-	63,  // 151: notificator.alert.AlertService.SaveFilterPreset:input_type -> notificator.alert.SaveFilterPresetRequest
-	65,  // 152: notificator.alert.AlertService.UpdateFilterPreset:input_type -> notificator.alert.UpdateFilterPresetRequest
-	67,  // 153: notificator.alert.AlertService.DeleteFilterPreset:input_type -> notificator.alert.DeleteFilterPresetRequest
-	69,  // 154: notificator.alert.AlertService.SetDefaultFilterPreset:input_type -> notificator.alert.SetDefaultFilterPresetRequest
-	72,  // 155: notificator.alert.AlertService.GetAnnotationButtonConfigs:input_type -> notificator.alert.GetAnnotationButtonConfigsRequest
-	74,  // 156: notificator.alert.AlertService.SaveAnnotationButtonConfigs:input_type -> notificator.alert.SaveAnnotationButtonConfigsRequest
-	76,  // 157: notificator.alert.AlertService.CreateAnnotationButtonConfig:input_type -> notificator.alert.CreateAnnotationButtonConfigRequest
-	78,  // 158: notificator.alert.AlertService.UpdateAnnotationButtonConfig:input_type -> notificator.alert.UpdateAnnotationButtonConfigRequest
-	80,  // 159: notificator.alert.AlertService.DeleteAnnotationButtonConfig:input_type -> notificator.alert.DeleteAnnotationButtonConfigRequest
-	127, // 160: notificator.alert.AlertService.GetUserColumnPreferences:input_type -> notificator.alert.GetUserColumnPreferencesRequest
-	129, // 161: notificator.alert.AlertService.SaveUserColumnPreferences:input_type -> notificator.alert.SaveUserColumnPreferencesRequest
-	83,  // 162: notificator.alert.StatisticsService.QueryStatistics:input_type -> notificator.alert.QueryStatisticsRequest
-	88,  // 163: notificator.alert.StatisticsService.QueryHeatmap:input_type -> notificator.alert.QueryHeatmapRequest
-	91,  // 164: notificator.alert.StatisticsService.QueryFlappingAlerts:input_type -> notificator.alert.QueryFlappingAlertsRequest
-	94,  // 165: notificator.alert.StatisticsService.SaveOnCallRule:input_type -> notificator.alert.SaveOnCallRuleRequest
-	96,  // 166: notificator.alert.StatisticsService.GetOnCallRules:input_type -> notificator.alert.GetOnCallRulesRequest
-	98,  // 167: notificator.alert.StatisticsService.GetOnCallRule:input_type -> notificator.alert.GetOnCallRuleRequest
-	100, // 168: notificator.alert.StatisticsService.UpdateOnCallRule:input_type -> notificator.alert.UpdateOnCallRuleRequest
-	102, // 169: notificator.alert.StatisticsService.DeleteOnCallRule:input_type -> notificator.alert.DeleteOnCallRuleRequest
-	104, // 170: notificator.alert.StatisticsService.TestOnCallRule:input_type -> notificator.alert.TestOnCallRuleRequest
-	110, // 171: notificator.alert.StatisticsService.GetStatisticsSummary:input_type -> notificator.alert.GetStatisticsSummaryRequest
-	112, // 172: notificator.alert.StatisticsService.CaptureAlertFired:input_type -> notificator.alert.CaptureAlertFiredRequest
-	114, // 173: notificator.alert.StatisticsService.UpdateAlertResolved:input_type -> notificator.alert.UpdateAlertResolvedRequest
-	116, // 174: notificator.alert.StatisticsService.UpdateAlertAcknowledged:input_type -> notificator.alert.UpdateAlertAcknowledgedRequest
-	118, // 175: notificator.alert.StatisticsService.QueryRecentlyResolved:input_type -> notificator.alert.QueryRecentlyResolvedRequest
-	121, // 176: notificator.alert.StatisticsService.GetAlertHistory:input_type -> notificator.alert.GetAlertHistoryRequest
-	123, // 177: notificator.alert.StatisticsService.GetAlertsByName:input_type -> notificator.alert.GetAlertsByNameRequest
-	131, // 178: notificator.alert.StatisticsService.GetStatisticsViews:input_type -> notificator.alert.GetStatisticsViewsRequest
-	133, // 179: notificator.alert.StatisticsService.SaveStatisticsView:input_type -> notificator.alert.SaveStatisticsViewRequest
-	135, // 180: notificator.alert.StatisticsService.UpdateStatisticsView:input_type -> notificator.alert.UpdateStatisticsViewRequest
-	137, // 181: notificator.alert.StatisticsService.DeleteStatisticsView:input_type -> notificator.alert.DeleteStatisticsViewRequest
-	139, // 182: notificator.alert.StatisticsService.SetDefaultStatisticsView:input_type -> notificator.alert.SetDefaultStatisticsViewRequest
-	3,   // 183: notificator.alert.AlertService.AddComment:output_type -> notificator.alert.AddCommentResponse
-	5,   // 184: notificator.alert.AlertService.GetComments:output_type -> notificator.alert.GetCommentsResponse
-	7,   // 185: notificator.alert.AlertService.GetCommentCountsBatch:output_type -> notificator.alert.GetCommentCountsBatchResponse
-	9,   // 186: notificator.alert.AlertService.DeleteComment:output_type -> notificator.alert.DeleteCommentResponse
-	12,  // 187: notificator.alert.AlertService.AddAcknowledgment:output_type -> notificator.alert.AddAcknowledgmentResponse
-	14,  // 188: notificator.alert.AlertService.GetAcknowledgments:output_type -> notificator.alert.GetAcknowledgmentsResponse
-	16,  // 189: notificator.alert.AlertService.GetAllAcknowledgedAlerts:output_type -> notificator.alert.GetAllAcknowledgedAlertsResponse
-	18,  // 190: notificator.alert.AlertService.DeleteAcknowledgment:output_type -> notificator.alert.DeleteAcknowledgmentResponse
-	21,  // 191: notificator.alert.AlertService.SubscribeToAlertUpdates:output_type -> notificator.alert.AlertUpdate
-	30,  // 192: notificator.alert.AlertService.CreateResolvedAlert:output_type -> notificator.alert.CreateResolvedAlertResponse
-	32,  // 193: notificator.alert.AlertService.GetResolvedAlerts:output_type -> notificator.alert.GetResolvedAlertsResponse
-	34,  // 194: notificator.alert.AlertService.GetResolvedAlert:output_type -> notificator.alert.GetResolvedAlertResponse
-	36,  // 195: notificator.alert.AlertService.RemoveAllResolvedAlerts:output_type -> notificator.alert.RemoveAllResolvedAlertsResponse
-	38,  // 196: notificator.alert.AlertService.StreamResolvedAlertUpdates:output_type -> notificator.alert.ResolvedAlertUpdate
-	23,  // 197: notificator.alert.AlertService.GetUserColorPreferences:output_type -> notificator.alert.GetUserColorPreferencesResponse
-	25,  // 198: notificator.alert.AlertService.SaveUserColorPreferences:output_type -> notificator.alert.SaveUserColorPreferencesResponse
-	27,  // 199: notificator.alert.AlertService.DeleteUserColorPreference:output_type -> notificator.alert.DeleteUserColorPreferenceResponse
-	41,  // 200: notificator.alert.AlertService.GetUserHiddenAlerts:output_type -> notificator.alert.GetUserHiddenAlertsResponse
-	43,  // 201: notificator.alert.AlertService.HideAlert:output_type -> notificator.alert.HideAlertResponse
-	45,  // 202: notificator.alert.AlertService.UnhideAlert:output_type -> notificator.alert.UnhideAlertResponse
-	47,  // 203: notificator.alert.AlertService.ClearAllHiddenAlerts:output_type -> notificator.alert.ClearAllHiddenAlertsResponse
-	50,  // 204: notificator.alert.AlertService.GetUserHiddenRules:output_type -> notificator.alert.GetUserHiddenRulesResponse
-	52,  // 205: notificator.alert.AlertService.SaveHiddenRule:output_type -> notificator.alert.SaveHiddenRuleResponse
-	54,  // 206: notificator.alert.AlertService.RemoveHiddenRule:output_type -> notificator.alert.RemoveHiddenRuleResponse
-	57,  // 207: notificator.alert.AlertService.GetNotificationPreferences:output_type -> notificator.alert.GetNotificationPreferencesResponse
-	59,  // 208: notificator.alert.AlertService.SaveNotificationPreferences:output_type -> notificator.alert.SaveNotificationPreferencesResponse
-	62,  // 209: notificator.alert.AlertService.GetFilterPresets:output_type -> notificator.alert.GetFilterPresetsResponse
-	64,  // 210: notificator.alert.AlertService.SaveFilterPreset:output_type -> notificator.alert.SaveFilterPresetResponse
-	66,  // 211: notificator.alert.AlertService.UpdateFilterPreset:output_type -> notificator.alert.UpdateFilterPresetResponse
-	68,  // 212: notificator.alert.AlertService.DeleteFilterPreset:output_type -> notificator.alert.DeleteFilterPresetResponse
-	70,  // 213: notificator.alert.AlertService.SetDefaultFilterPreset:output_type -> notificator.alert.SetDefaultFilterPresetResponse
-	73,  // 214: notificator.alert.AlertService.GetAnnotationButtonConfigs:output_type -> notificator.alert.GetAnnotationButtonConfigsResponse
-	75,  // 215: notificator.alert.AlertService.SaveAnnotationButtonConfigs:output_type -> notificator.alert.SaveAnnotationButtonConfigsResponse
-	77,  // 216: notificator.alert.AlertService.CreateAnnotationButtonConfig:output_type -> notificator.alert.CreateAnnotationButtonConfigResponse
-	79,  // 217: notificator.alert.AlertService.UpdateAnnotationButtonConfig:output_type -> notificator.alert.UpdateAnnotationButtonConfigResponse
-	81,  // 218: notificator.alert.AlertService.DeleteAnnotationButtonConfig:output_type -> notificator.alert.DeleteAnnotationButtonConfigResponse
-	128, // 219: notificator.alert.AlertService.GetUserColumnPreferences:output_type -> notificator.alert.GetUserColumnPreferencesResponse
-	130, // 220: notificator.alert.AlertService.SaveUserColumnPreferences:output_type -> notificator.alert.SaveUserColumnPreferencesResponse
-	84,  // 221: notificator.alert.StatisticsService.QueryStatistics:output_type -> notificator.alert.QueryStatisticsResponse
-	90,  // 222: notificator.alert.StatisticsService.QueryHeatmap:output_type -> notificator.alert.QueryHeatmapResponse
-	93,  // 223: notificator.alert.StatisticsService.QueryFlappingAlerts:output_type -> notificator.alert.QueryFlappingAlertsResponse
-	95,  // 224: notificator.alert.StatisticsService.SaveOnCallRule:output_type -> notificator.alert.SaveOnCallRuleResponse
-	97,  // 225: notificator.alert.StatisticsService.GetOnCallRules:output_type -> notificator.alert.GetOnCallRulesResponse
-	99,  // 226: notificator.alert.StatisticsService.GetOnCallRule:output_type -> notificator.alert.GetOnCallRuleResponse
-	101, // 227: notificator.alert.StatisticsService.UpdateOnCallRule:output_type -> notificator.alert.UpdateOnCallRuleResponse
-	103, // 228: notificator.alert.StatisticsService.DeleteOnCallRule:output_type -> notificator.alert.DeleteOnCallRuleResponse
-	105, // 229: notificator.alert.StatisticsService.TestOnCallRule:output_type -> notificator.alert.TestOnCallRuleResponse
-	111, // 230: notificator.alert.StatisticsService.GetStatisticsSummary:output_type -> notificator.alert.GetStatisticsSummaryResponse
-	113, // 231: notificator.alert.StatisticsService.CaptureAlertFired:output_type -> notificator.alert.CaptureAlertFiredResponse
-	115, // 232: notificator.alert.StatisticsService.UpdateAlertResolved:output_type -> notificator.alert.UpdateAlertResolvedResponse
-	117, // 233: notificator.alert.StatisticsService.UpdateAlertAcknowledged:output_type -> notificator.alert.UpdateAlertAcknowledgedResponse
-	120, // 234: notificator.alert.StatisticsService.QueryRecentlyResolved:output_type -> notificator.alert.QueryRecentlyResolvedResponse
-	122, // 235: notificator.alert.StatisticsService.GetAlertHistory:output_type -> notificator.alert.GetAlertHistoryResponse
-	124, // 236: notificator.alert.StatisticsService.GetAlertsByName:output_type -> notificator.alert.GetAlertsByNameResponse
-	132, // 237: notificator.alert.StatisticsService.GetStatisticsViews:output_type -> notificator.alert.GetStatisticsViewsResponse
-	134, // 238: notificator.alert.StatisticsService.SaveStatisticsView:output_type -> notificator.alert.SaveStatisticsViewResponse
-	136, // 239: notificator.alert.StatisticsService.UpdateStatisticsView:output_type -> notificator.alert.UpdateStatisticsViewResponse
-	138, // 240: notificator.alert.StatisticsService.DeleteStatisticsView:output_type -> notificator.alert.DeleteStatisticsViewResponse
-	140, // 241: notificator.alert.StatisticsService.SetDefaultStatisticsView:output_type -> notificator.alert.SetDefaultStatisticsViewResponse
-	183, // [183:242] is the sub-list for method output_type
-	124, // [124:183] is the sub-list for method input_type
-	124, // [124:124] is the sub-list for extension type_name
-	124, // [124:124] is the sub-list for extension extendee
-	0,   // [0:124] is the sub-list for field type_name
+	14,  // 0: notificator.alert.AddCommentResponse.comment:type_name -> notificator.alert.Comment
+	14,  // 1: notificator.alert.GetCommentsResponse.comments:type_name -> notificator.alert.Comment
+	14,  // 2: notificator.alert.GetCommentsByTagResponse.comments:type_name -> notificator.alert.Comment
+	178, // 3: notificator.alert.GetCommentCountsBatchResponse.counts:type_name -> notificator.alert.GetCommentCountsBatchResponse.CountsEntry
+	188, // 4: notificator.alert.Comment.created_at:type_name -> google.protobuf.Timestamp
+	188, // 5: notificator.alert.AddAcknowledgmentRequest.expires_at:type_name -> google.protobuf.Timestamp
+	25,  // 6: notificator.alert.AddAcknowledgmentResponse.acknowledgment:type_name -> notificator.alert.Acknowledgment
+	25,  // 7: notificator.alert.GetAcknowledgmentsResponse.acknowledgments:type_name -> notificator.alert.Acknowledgment
+	179, // 8: notificator.alert.GetAllAcknowledgedAlertsResponse.acknowledged_alerts:type_name -> notificator.alert.GetAllAcknowledgedAlertsResponse.AcknowledgedAlertsEntry
+	180, // 9: notificator.alert.GetAllAcknowledgedAlertsResponse.acknowledgment_counts:type_name -> notificator.alert.GetAllAcknowledgedAlertsResponse.AcknowledgmentCountsEntry
+	188, // 10: notificator.alert.BulkAcknowledgeRequest.expires_at:type_name -> google.protobuf.Timestamp
+	181, // 11: notificator.alert.BulkAcknowledgeResponse.results:type_name -> notificator.alert.BulkAcknowledgeResponse.ResultsEntry
+	25,  // 12: notificator.alert.BulkAcknowledgeResponse.acknowledgments:type_name -> notificator.alert.Acknowledgment
+	188, // 13: notificator.alert.Acknowledgment.created_at:type_name -> google.protobuf.Timestamp
+	188, // 14: notificator.alert.Acknowledgment.expires_at:type_name -> google.protobuf.Timestamp
+	30,  // 15: notificator.alert.EscalateAlertResponse.escalation:type_name -> notificator.alert.Escalation
+	30,  // 16: notificator.alert.GetEscalationsResponse.escalations:type_name -> notificator.alert.Escalation
+	188, // 17: notificator.alert.Escalation.created_at:type_name -> google.protobuf.Timestamp
+	188, // 18: notificator.alert.Mention.created_at:type_name -> google.protobuf.Timestamp
+	33,  // 19: notificator.alert.Presence.user:type_name -> notificator.alert.Viewer
+	33,  // 20: notificator.alert.Presence.viewers:type_name -> notificator.alert.Viewer
+	36,  // 21: notificator.alert.GetAlertActivityResponse.activities:type_name -> notificator.alert.AlertActivity
+	188, // 22: notificator.alert.AlertActivity.created_at:type_name -> google.protobuf.Timestamp
+	0,   // 23: notificator.alert.AlertUpdate.update_type:type_name -> notificator.alert.UpdateType
+	14,  // 24: notificator.alert.AlertUpdate.comment:type_name -> notificator.alert.Comment
+	25,  // 25: notificator.alert.AlertUpdate.acknowledgment:type_name -> notificator.alert.Acknowledgment
+	30,  // 26: notificator.alert.AlertUpdate.escalation:type_name -> notificator.alert.Escalation
+	31,  // 27: notificator.alert.AlertUpdate.mention:type_name -> notificator.alert.Mention
+	32,  // 28: notificator.alert.AlertUpdate.presence:type_name -> notificator.alert.Presence
+	188, // 29: notificator.alert.AlertUpdate.timestamp:type_name -> google.protobuf.Timestamp
+	45,  // 30: notificator.alert.GetUserColorPreferencesResponse.preferences:type_name -> notificator.alert.UserColorPreference
+	45,  // 31: notificator.alert.SaveUserColorPreferencesRequest.preferences:type_name -> notificator.alert.UserColorPreference
+	182, // 32: notificator.alert.UserColorPreference.label_conditions:type_name -> notificator.alert.UserColorPreference.LabelConditionsEntry
+	188, // 33: notificator.alert.UserColorPreference.created_at:type_name -> google.protobuf.Timestamp
+	188, // 34: notificator.alert.UserColorPreference.updated_at:type_name -> google.protobuf.Timestamp
+	188, // 35: notificator.alert.CreateResolvedAlertRequest.starts_at:type_name -> google.protobuf.Timestamp
+	64,  // 36: notificator.alert.CreateResolvedAlertResponse.resolved_alert:type_name -> notificator.alert.ResolvedAlertInfo
+	188, // 37: notificator.alert.GetResolvedAlertsRequest.resolved_after:type_name -> google.protobuf.Timestamp
+	188, // 38: notificator.alert.GetResolvedAlertsRequest.resolved_before:type_name -> google.protobuf.Timestamp
+	64,  // 39: notificator.alert.GetResolvedAlertsResponse.resolved_alerts:type_name -> notificator.alert.ResolvedAlertInfo
+	64,  // 40: notificator.alert.GetResolvedAlertResponse.resolved_alert:type_name -> notificator.alert.ResolvedAlertInfo
+	188, // 41: notificator.alert.RemoveAllResolvedAlertsResponse.cleared_at:type_name -> google.protobuf.Timestamp
+	188, // 42: notificator.alert.RemoveAllResolvedAlertsResponse.undo_until:type_name -> google.protobuf.Timestamp
+	188, // 43: notificator.alert.UndoClearRequest.cleared_at:type_name -> google.protobuf.Timestamp
+	1,   // 44: notificator.alert.ResolvedAlertUpdate.update_type:type_name -> notificator.alert.ResolvedAlertUpdateType
+	64,  // 45: notificator.alert.ResolvedAlertUpdate.resolved_alert:type_name -> notificator.alert.ResolvedAlertInfo
+	188, // 46: notificator.alert.ResolvedAlertUpdate.timestamp:type_name -> google.protobuf.Timestamp
+	188, // 47: notificator.alert.ResolvedAlertInfo.resolved_at:type_name -> google.protobuf.Timestamp
+	188, // 48: notificator.alert.ResolvedAlertInfo.expires_at:type_name -> google.protobuf.Timestamp
+	188, // 49: notificator.alert.ResolvedAlertInfo.created_at:type_name -> google.protobuf.Timestamp
+	188, // 50: notificator.alert.ResolvedAlertInfo.updated_at:type_name -> google.protobuf.Timestamp
+	188, // 51: notificator.alert.ResolvedAlertInfo.restored_at:type_name -> google.protobuf.Timestamp
+	73,  // 52: notificator.alert.GetUserHiddenAlertsResponse.hidden_alerts:type_name -> notificator.alert.UserHiddenAlert
+	188, // 53: notificator.alert.HideAlertRequest.snooze_until:type_name -> google.protobuf.Timestamp
+	73,  // 54: notificator.alert.HideAlertResponse.hidden_alert:type_name -> notificator.alert.UserHiddenAlert
+	188, // 55: notificator.alert.ClearAllHiddenAlertsResponse.cleared_at:type_name -> google.protobuf.Timestamp
+	188, // 56: notificator.alert.ClearAllHiddenAlertsResponse.undo_until:type_name -> google.protobuf.Timestamp
+	188, // 57: notificator.alert.UserHiddenAlert.created_at:type_name -> google.protobuf.Timestamp
+	188, // 58: notificator.alert.UserHiddenAlert.updated_at:type_name -> google.protobuf.Timestamp
+	188, // 59: notificator.alert.UserHiddenAlert.expires_at:type_name -> google.protobuf.Timestamp
+	80,  // 60: notificator.alert.GetUserHiddenRulesResponse.hidden_rules:type_name -> notificator.alert.UserHiddenRule
+	80,  // 61: notificator.alert.SaveHiddenRuleRequest.rule:type_name -> notificator.alert.UserHiddenRule
+	80,  // 62: notificator.alert.SaveHiddenRuleResponse.rule:type_name -> notificator.alert.UserHiddenRule
+	188, // 63: notificator.alert.UserHiddenRule.created_at:type_name -> google.protobuf.Timestamp
+	188, // 64: notificator.alert.UserHiddenRule.updated_at:type_name -> google.protobuf.Timestamp
+	85,  // 65: notificator.alert.GetNotificationPreferencesResponse.preferences:type_name -> notificator.alert.NotificationPreference
+	86,  // 66: notificator.alert.SaveNotificationPreferencesRequest.dnd:type_name -> notificator.alert.DoNotDisturbSchedule
+	85,  // 67: notificator.alert.SaveNotificationPreferencesResponse.preferences:type_name -> notificator.alert.NotificationPreference
+	188, // 68: notificator.alert.NotificationPreference.created_at:type_name -> google.protobuf.Timestamp
+	188, // 69: notificator.alert.NotificationPreference.updated_at:type_name -> google.protobuf.Timestamp
+	86,  // 70: notificator.alert.NotificationPreference.dnd:type_name -> notificator.alert.DoNotDisturbSchedule
+	97,  // 71: notificator.alert.GetFilterPresetsResponse.presets:type_name -> notificator.alert.FilterPreset
+	97,  // 72: notificator.alert.SaveFilterPresetResponse.preset:type_name -> notificator.alert.FilterPreset
+	97,  // 73: notificator.alert.UpdateFilterPresetResponse.preset:type_name -> notificator.alert.FilterPreset
+	188, // 74: notificator.alert.FilterPreset.created_at:type_name -> google.protobuf.Timestamp
+	188, // 75: notificator.alert.FilterPreset.updated_at:type_name -> google.protobuf.Timestamp
+	108, // 76: notificator.alert.GetAnnotationButtonConfigsResponse.configs:type_name -> notificator.alert.AnnotationButtonConfig
+	108, // 77: notificator.alert.SaveAnnotationButtonConfigsRequest.configs:type_name -> notificator.alert.AnnotationButtonConfig
+	108, // 78: notificator.alert.CreateAnnotationButtonConfigRequest.config:type_name -> notificator.alert.AnnotationButtonConfig
+	108, // 79: notificator.alert.CreateAnnotationButtonConfigResponse.config:type_name -> notificator.alert.AnnotationButtonConfig
+	108, // 80: notificator.alert.UpdateAnnotationButtonConfigRequest.config:type_name -> notificator.alert.AnnotationButtonConfig
+	108, // 81: notificator.alert.UpdateAnnotationButtonConfigResponse.config:type_name -> notificator.alert.AnnotationButtonConfig
+	188, // 82: notificator.alert.AnnotationButtonConfig.created_at:type_name -> google.protobuf.Timestamp
+	188, // 83: notificator.alert.AnnotationButtonConfig.updated_at:type_name -> google.protobuf.Timestamp
+	188, // 84: notificator.alert.QueryStatisticsRequest.start_date:type_name -> google.protobuf.Timestamp
+	188, // 85: notificator.alert.QueryStatisticsRequest.end_date:type_name -> google.protobuf.Timestamp
+	111, // 86: notificator.alert.QueryStatisticsResponse.time_range:type_name -> notificator.alert.TimeRange
+	183, // 87: notificator.alert.QueryStatisticsResponse.statistics:type_name -> notificator.alert.QueryStatisticsResponse.StatisticsEntry
+	113, // 88: notificator.alert.QueryStatisticsResponse.breakdown:type_name -> notificator.alert.BreakdownItem
+	188, // 89: notificator.alert.TimeRange.start:type_name -> google.protobuf.Timestamp
+	188, // 90: notificator.alert.TimeRange.end:type_name -> google.protobuf.Timestamp
+	188, // 91: notificator.alert.BreakdownItem.start_time:type_name -> google.protobuf.Timestamp
+	188, // 92: notificator.alert.BreakdownItem.end_time:type_name -> google.protobuf.Timestamp
+	184, // 93: notificator.alert.BreakdownItem.statistics:type_name -> notificator.alert.BreakdownItem.StatisticsEntry
+	188, // 94: notificator.alert.QueryHeatmapRequest.start_date:type_name -> google.protobuf.Timestamp
+	188, // 95: notificator.alert.QueryHeatmapRequest.end_date:type_name -> google.protobuf.Timestamp
+	115, // 96: notificator.alert.QueryHeatmapResponse.cells:type_name -> notificator.alert.HeatmapCell
+	188, // 97: notificator.alert.QueryFlappingAlertsRequest.start_date:type_name -> google.protobuf.Timestamp
+	188, // 98: notificator.alert.QueryFlappingAlertsRequest.end_date:type_name -> google.protobuf.Timestamp
+	118, // 99: notificator.alert.QueryFlappingAlertsResponse.alerts:type_name -> notificator.alert.FlappingAlert
+	133, // 100: notificator.alert.SaveOnCallRuleRequest.rule_config:type_name -> notificator.alert.RuleConfig
+	132, // 101: notificator.alert.SaveOnCallRuleResponse.rule:type_name -> notificator.alert.OnCallRule
+	132, // 102: notificator.alert.GetOnCallRulesResponse.rules:type_name -> notificator.alert.OnCallRule
+	132, // 103: notificator.alert.GetOnCallRuleResponse.rule:type_name -> notificator.alert.OnCallRule
+	133, // 104: notificator.alert.UpdateOnCallRuleRequest.rule_config:type_name -> notificator.alert.RuleConfig
+	132, // 105: notificator.alert.UpdateOnCallRuleResponse.rule:type_name -> notificator.alert.OnCallRule
+	133, // 106: notificator.alert.TestOnCallRuleRequest.rule_config:type_name -> notificator.alert.RuleConfig
+	135, // 107: notificator.alert.TestOnCallRuleResponse.sample_alerts:type_name -> notificator.alert.AlertStatistic
+	133, // 108: notificator.alert.OnCallRule.rule_config:type_name -> notificator.alert.RuleConfig
+	188, // 109: notificator.alert.OnCallRule.created_at:type_name -> google.protobuf.Timestamp
+	188, // 110: notificator.alert.OnCallRule.updated_at:type_name -> google.protobuf.Timestamp
+	134, // 111: notificator.alert.RuleConfig.criteria:type_name -> notificator.alert.RuleCriterion
+	188, // 112: notificator.alert.AlertStatistic.fired_at:type_name -> google.protobuf.Timestamp
+	188, // 113: notificator.alert.AlertStatistic.resolved_at:type_name -> google.protobuf.Timestamp
+	188, // 114: notificator.alert.AlertStatistic.acknowledged_at:type_name -> google.protobuf.Timestamp
+	188, // 115: notificator.alert.AlertStatistic.created_at:type_name -> google.protobuf.Timestamp
+	188, // 116: notificator.alert.AlertStatistic.updated_at:type_name -> google.protobuf.Timestamp
+	185, // 117: notificator.alert.GetStatisticsSummaryResponse.by_severity:type_name -> notificator.alert.GetStatisticsSummaryResponse.BySeverityEntry
+	188, // 118: notificator.alert.GetStatisticsSummaryResponse.earliest_alert:type_name -> google.protobuf.Timestamp
+	188, // 119: notificator.alert.GetStatisticsSummaryResponse.latest_alert:type_name -> google.protobuf.Timestamp
+	188, // 120: notificator.alert.CaptureAlertFiredRequest.starts_at:type_name -> google.protobuf.Timestamp
+	188, // 121: notificator.alert.UpdateAlertResolvedRequest.resolved_at:type_name -> google.protobuf.Timestamp
+	188, // 122: notificator.alert.UpdateAlertAcknowledgedRequest.acknowledged_at:type_name -> google.protobuf.Timestamp
+	188, // 123: notificator.alert.QueryRecentlyResolvedRequest.start_date:type_name -> google.protobuf.Timestamp
+	188, // 124: notificator.alert.QueryRecentlyResolvedRequest.end_date:type_name -> google.protobuf.Timestamp
+	188, // 125: notificator.alert.ResolvedAlertItem.first_fired_at:type_name -> google.protobuf.Timestamp
+	188, // 126: notificator.alert.ResolvedAlertItem.last_resolved_at:type_name -> google.protobuf.Timestamp
+	186, // 127: notificator.alert.ResolvedAlertItem.labels:type_name -> notificator.alert.ResolvedAlertItem.LabelsEntry
+	187, // 128: notificator.alert.ResolvedAlertItem.annotations:type_name -> notificator.alert.ResolvedAlertItem.AnnotationsEntry
+	145, // 129: notificator.alert.QueryRecentlyResolvedResponse.alerts:type_name -> notificator.alert.ResolvedAlertItem
+	188, // 130: notificator.alert.QueryRecentlyResolvedResponse.start_date:type_name -> google.protobuf.Timestamp
+	188, // 131: notificator.alert.QueryRecentlyResolvedResponse.end_date:type_name -> google.protobuf.Timestamp
+	135, // 132: notificator.alert.GetAlertHistoryResponse.history:type_name -> notificator.alert.AlertStatistic
+	188, // 133: notificator.alert.GetAlertsByNameRequest.start_date:type_name -> google.protobuf.Timestamp
+	188, // 134: notificator.alert.GetAlertsByNameRequest.end_date:type_name -> google.protobuf.Timestamp
+	135, // 135: notificator.alert.GetAlertsByNameResponse.alerts:type_name -> notificator.alert.AlertStatistic
+	151, // 136: notificator.alert.ColumnPreferences.column_configs:type_name -> notificator.alert.ColumnConfig
+	188, // 137: notificator.alert.ColumnPreferences.created_at:type_name -> google.protobuf.Timestamp
+	188, // 138: notificator.alert.ColumnPreferences.updated_at:type_name -> google.protobuf.Timestamp
+	152, // 139: notificator.alert.GetUserColumnPreferencesResponse.preferences:type_name -> notificator.alert.ColumnPreferences
+	151, // 140: notificator.alert.SaveUserColumnPreferencesRequest.column_configs:type_name -> notificator.alert.ColumnConfig
+	175, // 141: notificator.alert.GetStatisticsViewsResponse.views:type_name -> notificator.alert.StatisticsView
+	177, // 142: notificator.alert.SaveStatisticsViewRequest.view_data:type_name -> notificator.alert.StatisticsViewData
+	175, // 143: notificator.alert.SaveStatisticsViewResponse.view:type_name -> notificator.alert.StatisticsView
+	177, // 144: notificator.alert.UpdateStatisticsViewRequest.view_data:type_name -> notificator.alert.StatisticsViewData
+	175, // 145: notificator.alert.UpdateStatisticsViewResponse.view:type_name -> notificator.alert.StatisticsView
+	177, // 146: notificator.alert.StatisticsView.view_data:type_name -> notificator.alert.StatisticsViewData
+	188, // 147: notificator.alert.StatisticsView.created_at:type_name -> google.protobuf.Timestamp
+	188, // 148: notificator.alert.StatisticsView.updated_at:type_name -> google.protobuf.Timestamp
+	176, // 149: notificator.alert.StatisticsViewData.relative_from:type_name -> notificator.alert.RelativeTimeConfig
+	176, // 150: notificator.alert.StatisticsViewData.relative_until:type_name -> notificator.alert.RelativeTimeConfig
+	25,  // 151: notificator.alert.GetAllAcknowledgedAlertsResponse.AcknowledgedAlertsEntry.value:type_name -> notificator.alert.Acknowledgment
+	112, // 152: notificator.alert.QueryStatisticsResponse.StatisticsEntry.value:type_name -> notificator.alert.AggregatedStatistics
+	112, // 153: notificator.alert.BreakdownItem.StatisticsEntry.value:type_name -> notificator.alert.AggregatedStatistics
+	112, // 154: notificator.alert.GetStatisticsSummaryResponse.BySeverityEntry.value:type_name -> notificator.alert.AggregatedStatistics
+	2,   // 155: notificator.alert.AlertService.AddComment:input_type -> notificator.alert.AddCommentRequest
+	4,   // 156: notificator.alert.AlertService.GetComments:input_type -> notificator.alert.GetCommentsRequest
+	10,  // 157: notificator.alert.AlertService.GetCommentCountsBatch:input_type -> notificator.alert.GetCommentCountsBatchRequest
+	12,  // 158: notificator.alert.AlertService.DeleteComment:input_type -> notificator.alert.DeleteCommentRequest
+	6,   // 159: notificator.alert.AlertService.GetCommentsByTag:input_type -> notificator.alert.GetCommentsByTagRequest
+	8,   // 160: notificator.alert.AlertService.GetCommentSettings:input_type -> notificator.alert.GetCommentSettingsRequest
+	15,  // 161: notificator.alert.AlertService.AddAcknowledgment:input_type -> notificator.alert.AddAcknowledgmentRequest
+	17,  // 162: notificator.alert.AlertService.GetAcknowledgments:input_type -> notificator.alert.GetAcknowledgmentsRequest
+	19,  // 163: notificator.alert.AlertService.GetAllAcknowledgedAlerts:input_type -> notificator.alert.GetAllAcknowledgedAlertsRequest
+	21,  // 164: notificator.alert.AlertService.DeleteAcknowledgment:input_type -> notificator.alert.DeleteAcknowledgmentRequest
+	23,  // 165: notificator.alert.AlertService.BulkAcknowledge:input_type -> notificator.alert.BulkAcknowledgeRequest
+	26,  // 166: notificator.alert.AlertService.EscalateAlert:input_type -> notificator.alert.EscalateAlertRequest
+	28,  // 167: notificator.alert.AlertService.GetEscalations:input_type -> notificator.alert.GetEscalationsRequest
+	34,  // 168: notificator.alert.AlertService.GetAlertActivity:input_type -> notificator.alert.GetAlertActivityRequest
+	37,  // 169: notificator.alert.AlertService.SubscribeToAlertUpdates:input_type -> notificator.alert.SubscribeToAlertUpdatesRequest
+	46,  // 170: notificator.alert.AlertService.CreateResolvedAlert:input_type -> notificator.alert.CreateResolvedAlertRequest
+	48,  // 171: notificator.alert.AlertService.GetResolvedAlerts:input_type -> notificator.alert.GetResolvedAlertsRequest
+	50,  // 172: notificator.alert.AlertService.GetResolvedAlert:input_type -> notificator.alert.GetResolvedAlertRequest
+	52,  // 173: notificator.alert.AlertService.RestoreResolvedAlert:input_type -> notificator.alert.RestoreResolvedAlertRequest
+	54,  // 174: notificator.alert.AlertService.RemoveAllResolvedAlerts:input_type -> notificator.alert.RemoveAllResolvedAlertsRequest
+	56,  // 175: notificator.alert.AlertService.UndoRemoveAllResolvedAlerts:input_type -> notificator.alert.UndoClearRequest
+	62,  // 176: notificator.alert.AlertService.StreamResolvedAlertUpdates:input_type -> notificator.alert.StreamResolvedAlertUpdatesRequest
+	58,  // 177: notificator.alert.AlertService.GetResolvedAlertSettings:input_type -> notificator.alert.GetResolvedAlertSettingsRequest
+	60,  // 178: notificator.alert.AlertService.SaveResolvedAlertSettings:input_type -> notificator.alert.SaveResolvedAlertSettingsRequest
+	39,  // 179: notificator.alert.AlertService.GetUserColorPreferences:input_type -> notificator.alert.GetUserColorPreferencesRequest
+	41,  // 180: notificator.alert.AlertService.SaveUserColorPreferences:input_type -> notificator.alert.SaveUserColorPreferencesRequest
+	43,  // 181: notificator.alert.AlertService.DeleteUserColorPreference:input_type -> notificator.alert.DeleteUserColorPreferenceRequest
+	65,  // 182: notificator.alert.AlertService.GetUserHiddenAlerts:input_type -> notificator.alert.GetUserHiddenAlertsRequest
+	67,  // 183: notificator.alert.AlertService.HideAlert:input_type -> notificator.alert.HideAlertRequest
+	69,  // 184: notificator.alert.AlertService.UnhideAlert:input_type -> notificator.alert.UnhideAlertRequest
+	71,  // 185: notificator.alert.AlertService.ClearAllHiddenAlerts:input_type -> notificator.alert.ClearAllHiddenAlertsRequest
+	56,  // 186: notificator.alert.AlertService.UndoClearAllHiddenAlerts:input_type -> notificator.alert.UndoClearRequest
+	74,  // 187: notificator.alert.AlertService.GetUserHiddenRules:input_type -> notificator.alert.GetUserHiddenRulesRequest
+	76,  // 188: notificator.alert.AlertService.SaveHiddenRule:input_type -> notificator.alert.SaveHiddenRuleRequest
+	78,  // 189: notificator.alert.AlertService.RemoveHiddenRule:input_type -> notificator.alert.RemoveHiddenRuleRequest
+	81,  // 190: notificator.alert.AlertService.GetNotificationPreferences:input_type -> notificator.alert.GetNotificationPreferencesRequest
+	83,  // 191: notificator.alert.AlertService.SaveNotificationPreferences:input_type -> notificator.alert.SaveNotificationPreferencesRequest
+	87,  // 192: notificator.alert.AlertService.GetFilterPresets:input_type -> notificator.alert.GetFilterPresetsRequest
+	89,  // 193: notificator.alert.AlertService.SaveFilterPreset:input_type -> notificator.alert.SaveFilterPresetRequest
+	91,  // 194: notificator.alert.AlertService.UpdateFilterPreset:input_type -> notificator.alert.UpdateFilterPresetRequest
+	93,  // 195: notificator.alert.AlertService.DeleteFilterPreset:input_type -> notificator.alert.DeleteFilterPresetRequest
+	95,  // 196: notificator.alert.AlertService.SetDefaultFilterPreset:input_type -> notificator.alert.SetDefaultFilterPresetRequest
+	98,  // 197: notificator.alert.AlertService.GetAnnotationButtonConfigs:input_type -> notificator.alert.GetAnnotationButtonConfigsRequest
+	100, // 198: notificator.alert.AlertService.SaveAnnotationButtonConfigs:input_type -> notificator.alert.SaveAnnotationButtonConfigsRequest
+	102, // 199: notificator.alert.AlertService.CreateAnnotationButtonConfig:input_type -> notificator.alert.CreateAnnotationButtonConfigRequest
+	104, // 200: notificator.alert.AlertService.UpdateAnnotationButtonConfig:input_type -> notificator.alert.UpdateAnnotationButtonConfigRequest
+	106, // 201: notificator.alert.AlertService.DeleteAnnotationButtonConfig:input_type -> notificator.alert.DeleteAnnotationButtonConfigRequest
+	153, // 202: notificator.alert.AlertService.GetUserColumnPreferences:input_type -> notificator.alert.GetUserColumnPreferencesRequest
+	155, // 203: notificator.alert.AlertService.SaveUserColumnPreferences:input_type -> notificator.alert.SaveUserColumnPreferencesRequest
+	157, // 204: notificator.alert.AlertService.ExportPreferences:input_type -> notificator.alert.ExportPreferencesRequest
+	159, // 205: notificator.alert.AlertService.ImportPreferences:input_type -> notificator.alert.ImportPreferencesRequest
+	161, // 206: notificator.alert.AlertService.CheckPermission:input_type -> notificator.alert.CheckPermissionRequest
+	163, // 207: notificator.alert.AlertService.HealthCheck:input_type -> notificator.alert.HealthCheckRequest
+	109, // 208: notificator.alert.StatisticsService.QueryStatistics:input_type -> notificator.alert.QueryStatisticsRequest
+	114, // 209: notificator.alert.StatisticsService.QueryHeatmap:input_type -> notificator.alert.QueryHeatmapRequest
+	117, // 210: notificator.alert.StatisticsService.QueryFlappingAlerts:input_type -> notificator.alert.QueryFlappingAlertsRequest
+	120, // 211: notificator.alert.StatisticsService.SaveOnCallRule:input_type -> notificator.alert.SaveOnCallRuleRequest
+	122, // 212: notificator.alert.StatisticsService.GetOnCallRules:input_type -> notificator.alert.GetOnCallRulesRequest
+	124, // 213: notificator.alert.StatisticsService.GetOnCallRule:input_type -> notificator.alert.GetOnCallRuleRequest
+	126, // 214: notificator.alert.StatisticsService.UpdateOnCallRule:input_type -> notificator.alert.UpdateOnCallRuleRequest
+	128, // 215: notificator.alert.StatisticsService.DeleteOnCallRule:input_type -> notificator.alert.DeleteOnCallRuleRequest
+	130, // 216: notificator.alert.StatisticsService.TestOnCallRule:input_type -> notificator.alert.TestOnCallRuleRequest
+	136, // 217: notificator.alert.StatisticsService.GetStatisticsSummary:input_type -> notificator.alert.GetStatisticsSummaryRequest
+	138, // 218: notificator.alert.StatisticsService.CaptureAlertFired:input_type -> notificator.alert.CaptureAlertFiredRequest
+	140, // 219: notificator.alert.StatisticsService.UpdateAlertResolved:input_type -> notificator.alert.UpdateAlertResolvedRequest
+	142, // 220: notificator.alert.StatisticsService.UpdateAlertAcknowledged:input_type -> notificator.alert.UpdateAlertAcknowledgedRequest
+	144, // 221: notificator.alert.StatisticsService.QueryRecentlyResolved:input_type -> notificator.alert.QueryRecentlyResolvedRequest
+	147, // 222: notificator.alert.StatisticsService.GetAlertHistory:input_type -> notificator.alert.GetAlertHistoryRequest
+	149, // 223: notificator.alert.StatisticsService.GetAlertsByName:input_type -> notificator.alert.GetAlertsByNameRequest
+	165, // 224: notificator.alert.StatisticsService.GetStatisticsViews:input_type -> notificator.alert.GetStatisticsViewsRequest
+	167, // 225: notificator.alert.StatisticsService.SaveStatisticsView:input_type -> notificator.alert.SaveStatisticsViewRequest
+	169, // 226: notificator.alert.StatisticsService.UpdateStatisticsView:input_type -> notificator.alert.UpdateStatisticsViewRequest
+	171, // 227: notificator.alert.StatisticsService.DeleteStatisticsView:input_type -> notificator.alert.DeleteStatisticsViewRequest
+	173, // 228: notificator.alert.StatisticsService.SetDefaultStatisticsView:input_type -> notificator.alert.SetDefaultStatisticsViewRequest
+	3,   // 229: notificator.alert.AlertService.AddComment:output_type -> notificator.alert.AddCommentResponse
+	5,   // 230: notificator.alert.AlertService.GetComments:output_type -> notificator.alert.GetCommentsResponse
+	11,  // 231: notificator.alert.AlertService.GetCommentCountsBatch:output_type -> notificator.alert.GetCommentCountsBatchResponse
+	13,  // 232: notificator.alert.AlertService.DeleteComment:output_type -> notificator.alert.DeleteCommentResponse
+	7,   // 233: notificator.alert.AlertService.GetCommentsByTag:output_type -> notificator.alert.GetCommentsByTagResponse
+	9,   // 234: notificator.alert.AlertService.GetCommentSettings:output_type -> notificator.alert.GetCommentSettingsResponse
+	16,  // 235: notificator.alert.AlertService.AddAcknowledgment:output_type -> notificator.alert.AddAcknowledgmentResponse
+	18,  // 236: notificator.alert.AlertService.GetAcknowledgments:output_type -> notificator.alert.GetAcknowledgmentsResponse
+	20,  // 237: notificator.alert.AlertService.GetAllAcknowledgedAlerts:output_type -> notificator.alert.GetAllAcknowledgedAlertsResponse
+	22,  // 238: notificator.alert.AlertService.DeleteAcknowledgment:output_type -> notificator.alert.DeleteAcknowledgmentResponse
+	24,  // 239: notificator.alert.AlertService.BulkAcknowledge:output_type -> notificator.alert.BulkAcknowledgeResponse
+	27,  // 240: notificator.alert.AlertService.EscalateAlert:output_type -> notificator.alert.EscalateAlertResponse
+	29,  // 241: notificator.alert.AlertService.GetEscalations:output_type -> notificator.alert.GetEscalationsResponse
+	35,  // 242: notificator.alert.AlertService.GetAlertActivity:output_type -> notificator.alert.GetAlertActivityResponse
+	38,  // 243: notificator.alert.AlertService.SubscribeToAlertUpdates:output_type -> notificator.alert.AlertUpdate
+	47,  // 244: notificator.alert.AlertService.CreateResolvedAlert:output_type -> notificator.alert.CreateResolvedAlertResponse
+	49,  // 245: notificator.alert.AlertService.GetResolvedAlerts:output_type -> notificator.alert.GetResolvedAlertsResponse
+	51,  // 246: notificator.alert.AlertService.GetResolvedAlert:output_type -> notificator.alert.GetResolvedAlertResponse
+	53,  // 247: notificator.alert.AlertService.RestoreResolvedAlert:output_type -> notificator.alert.RestoreResolvedAlertResponse
+	55,  // 248: notificator.alert.AlertService.RemoveAllResolvedAlerts:output_type -> notificator.alert.RemoveAllResolvedAlertsResponse
+	57,  // 249: notificator.alert.AlertService.UndoRemoveAllResolvedAlerts:output_type -> notificator.alert.UndoClearResponse
+	63,  // 250: notificator.alert.AlertService.StreamResolvedAlertUpdates:output_type -> notificator.alert.ResolvedAlertUpdate
+	59,  // 251: notificator.alert.AlertService.GetResolvedAlertSettings:output_type -> notificator.alert.GetResolvedAlertSettingsResponse
+	61,  // 252: notificator.alert.AlertService.SaveResolvedAlertSettings:output_type -> notificator.alert.SaveResolvedAlertSettingsResponse
+	40,  // 253: notificator.alert.AlertService.GetUserColorPreferences:output_type -> notificator.alert.GetUserColorPreferencesResponse
+	42,  // 254: notificator.alert.AlertService.SaveUserColorPreferences:output_type -> notificator.alert.SaveUserColorPreferencesResponse
+	44,  // 255: notificator.alert.AlertService.DeleteUserColorPreference:output_type -> notificator.alert.DeleteUserColorPreferenceResponse
+	66,  // 256: notificator.alert.AlertService.GetUserHiddenAlerts:output_type -> notificator.alert.GetUserHiddenAlertsResponse
+	68,  // 257: notificator.alert.AlertService.HideAlert:output_type -> notificator.alert.HideAlertResponse
+	70,  // 258: notificator.alert.AlertService.UnhideAlert:output_type -> notificator.alert.UnhideAlertResponse
+	72,  // 259: notificator.alert.AlertService.ClearAllHiddenAlerts:output_type -> notificator.alert.ClearAllHiddenAlertsResponse
+	57,  // 260: notificator.alert.AlertService.UndoClearAllHiddenAlerts:output_type -> notificator.alert.UndoClearResponse
+	75,  // 261: notificator.alert.AlertService.GetUserHiddenRules:output_type -> notificator.alert.GetUserHiddenRulesResponse
+	77,  // 262: notificator.alert.AlertService.SaveHiddenRule:output_type -> notificator.alert.SaveHiddenRuleResponse
+	79,  // 263: notificator.alert.AlertService.RemoveHiddenRule:output_type -> notificator.alert.RemoveHiddenRuleResponse
+	82,  // 264: notificator.alert.AlertService.GetNotificationPreferences:output_type -> notificator.alert.GetNotificationPreferencesResponse
+	84,  // 265: notificator.alert.AlertService.SaveNotificationPreferences:output_type -> notificator.alert.SaveNotificationPreferencesResponse
+	88,  // 266: notificator.alert.AlertService.GetFilterPresets:output_type -> notificator.alert.GetFilterPresetsResponse
+	90,  // 267: notificator.alert.AlertService.SaveFilterPreset:output_type -> notificator.alert.SaveFilterPresetResponse
+	92,  // 268: notificator.alert.AlertService.UpdateFilterPreset:output_type -> notificator.alert.UpdateFilterPresetResponse
+	94,  // 269: notificator.alert.AlertService.DeleteFilterPreset:output_type -> notificator.alert.DeleteFilterPresetResponse
+	96,  // 270: notificator.alert.AlertService.SetDefaultFilterPreset:output_type -> notificator.alert.SetDefaultFilterPresetResponse
+	99,  // 271: notificator.alert.AlertService.GetAnnotationButtonConfigs:output_type -> notificator.alert.GetAnnotationButtonConfigsResponse
+	101, // 272: notificator.alert.AlertService.SaveAnnotationButtonConfigs:output_type -> notificator.alert.SaveAnnotationButtonConfigsResponse
+	103, // 273: notificator.alert.AlertService.CreateAnnotationButtonConfig:output_type -> notificator.alert.CreateAnnotationButtonConfigResponse
+	105, // 274: notificator.alert.AlertService.UpdateAnnotationButtonConfig:output_type -> notificator.alert.UpdateAnnotationButtonConfigResponse
+	107, // 275: notificator.alert.AlertService.DeleteAnnotationButtonConfig:output_type -> notificator.alert.DeleteAnnotationButtonConfigResponse
+	154, // 276: notificator.alert.AlertService.GetUserColumnPreferences:output_type -> notificator.alert.GetUserColumnPreferencesResponse
+	156, // 277: notificator.alert.AlertService.SaveUserColumnPreferences:output_type -> notificator.alert.SaveUserColumnPreferencesResponse
+	158, // 278: notificator.alert.AlertService.ExportPreferences:output_type -> notificator.alert.ExportPreferencesResponse
+	160, // 279: notificator.alert.AlertService.ImportPreferences:output_type -> notificator.alert.ImportPreferencesResponse
+	162, // 280: notificator.alert.AlertService.CheckPermission:output_type -> notificator.alert.CheckPermissionResponse
+	164, // 281: notificator.alert.AlertService.HealthCheck:output_type -> notificator.alert.HealthCheckResponse
+	110, // 282: notificator.alert.StatisticsService.QueryStatistics:output_type -> notificator.alert.QueryStatisticsResponse
+	116, // 283: notificator.alert.StatisticsService.QueryHeatmap:output_type -> notificator.alert.QueryHeatmapResponse
+	119, // 284: notificator.alert.StatisticsService.QueryFlappingAlerts:output_type -> notificator.alert.QueryFlappingAlertsResponse
+	121, // 285: notificator.alert.StatisticsService.SaveOnCallRule:output_type -> notificator.alert.SaveOnCallRuleResponse
+	123, // 286: notificator.alert.StatisticsService.GetOnCallRules:output_type -> notificator.alert.GetOnCallRulesResponse
+	125, // 287: notificator.alert.StatisticsService.GetOnCallRule:output_type -> notificator.alert.GetOnCallRuleResponse
+	127, // 288: notificator.alert.StatisticsService.UpdateOnCallRule:output_type -> notificator.alert.UpdateOnCallRuleResponse
+	129, // 289: notificator.alert.StatisticsService.DeleteOnCallRule:output_type -> notificator.alert.DeleteOnCallRuleResponse
+	131, // 290: notificator.alert.StatisticsService.TestOnCallRule:output_type -> notificator.alert.TestOnCallRuleResponse
+	137, // 291: notificator.alert.StatisticsService.GetStatisticsSummary:output_type -> notificator.alert.GetStatisticsSummaryResponse
+	139, // 292: notificator.alert.StatisticsService.CaptureAlertFired:output_type -> notificator.alert.CaptureAlertFiredResponse
+	141, // 293: notificator.alert.StatisticsService.UpdateAlertResolved:output_type -> notificator.alert.UpdateAlertResolvedResponse
+	143, // 294: notificator.alert.StatisticsService.UpdateAlertAcknowledged:output_type -> notificator.alert.UpdateAlertAcknowledgedResponse
+	146, // 295: notificator.alert.StatisticsService.QueryRecentlyResolved:output_type -> notificator.alert.QueryRecentlyResolvedResponse
+	148, // 296: notificator.alert.StatisticsService.GetAlertHistory:output_type -> notificator.alert.GetAlertHistoryResponse
+	150, // 297: notificator.alert.StatisticsService.GetAlertsByName:output_type -> notificator.alert.GetAlertsByNameResponse
+	166, // 298: notificator.alert.StatisticsService.GetStatisticsViews:output_type -> notificator.alert.GetStatisticsViewsResponse
+	168, // 299: notificator.alert.StatisticsService.SaveStatisticsView:output_type -> notificator.alert.SaveStatisticsViewResponse
+	170, // 300: notificator.alert.StatisticsService.UpdateStatisticsView:output_type -> notificator.alert.UpdateStatisticsViewResponse
+	172, // 301: notificator.alert.StatisticsService.DeleteStatisticsView:output_type -> notificator.alert.DeleteStatisticsViewResponse
+	174, // 302: notificator.alert.StatisticsService.SetDefaultStatisticsView:output_type -> notificator.alert.SetDefaultStatisticsViewResponse
+	229, // [229:303] is the sub-list for method output_type
+	155, // [155:229] is the sub-list for method input_type
+	155, // [155:155] is the sub-list for extension type_name
+	155, // [155:155] is the sub-list for extension extendee
+	0,   // [0:155] is the sub-list for field type_name
 }
 
 func init() { file_proto_alert_proto_init() }
@@ -11296,11 +14024,14 @@ func file_proto_alert_proto_init() {
 	if File_proto_alert_proto != nil {
 		return
 	}
-	file_proto_alert_proto_msgTypes[19].OneofWrappers = []any{
+	file_proto_alert_proto_msgTypes[36].OneofWrappers = []any{
 		(*AlertUpdate_Comment)(nil),
 		(*AlertUpdate_Acknowledgment)(nil),
 		(*AlertUpdate_DeletedCommentId)(nil),
 		(*AlertUpdate_DeletedAcknowledgmentId)(nil),
+		(*AlertUpdate_Escalation)(nil),
+		(*AlertUpdate_Mention)(nil),
+		(*AlertUpdate_Presence)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -11308,7 +14039,7 @@ func file_proto_alert_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_alert_proto_rawDesc), len(file_proto_alert_proto_rawDesc)),
 			NumEnums:      2,
-			NumMessages:   150,
+			NumMessages:   186,
 			NumExtensions: 0,
 			NumServices:   2,
 		},
@@ -25,16 +25,26 @@ const (
 	AlertService_GetComments_FullMethodName                  = "/notificator.alert.AlertService/GetComments"
 	AlertService_GetCommentCountsBatch_FullMethodName        = "/notificator.alert.AlertService/GetCommentCountsBatch"
 	AlertService_DeleteComment_FullMethodName                = "/notificator.alert.AlertService/DeleteComment"
+	AlertService_GetCommentsByTag_FullMethodName             = "/notificator.alert.AlertService/GetCommentsByTag"
+	AlertService_GetCommentSettings_FullMethodName           = "/notificator.alert.AlertService/GetCommentSettings"
 	AlertService_AddAcknowledgment_FullMethodName            = "/notificator.alert.AlertService/AddAcknowledgment"
 	AlertService_GetAcknowledgments_FullMethodName           = "/notificator.alert.AlertService/GetAcknowledgments"
 	AlertService_GetAllAcknowledgedAlerts_FullMethodName     = "/notificator.alert.AlertService/GetAllAcknowledgedAlerts"
 	AlertService_DeleteAcknowledgment_FullMethodName         = "/notificator.alert.AlertService/DeleteAcknowledgment"
+	AlertService_BulkAcknowledge_FullMethodName              = "/notificator.alert.AlertService/BulkAcknowledge"
+	AlertService_EscalateAlert_FullMethodName                = "/notificator.alert.AlertService/EscalateAlert"
+	AlertService_GetEscalations_FullMethodName               = "/notificator.alert.AlertService/GetEscalations"
+	AlertService_GetAlertActivity_FullMethodName             = "/notificator.alert.AlertService/GetAlertActivity"
 	AlertService_SubscribeToAlertUpdates_FullMethodName      = "/notificator.alert.AlertService/SubscribeToAlertUpdates"
 	AlertService_CreateResolvedAlert_FullMethodName          = "/notificator.alert.AlertService/CreateResolvedAlert"
 	AlertService_GetResolvedAlerts_FullMethodName            = "/notificator.alert.AlertService/GetResolvedAlerts"
 	AlertService_GetResolvedAlert_FullMethodName             = "/notificator.alert.AlertService/GetResolvedAlert"
+	AlertService_RestoreResolvedAlert_FullMethodName         = "/notificator.alert.AlertService/RestoreResolvedAlert"
 	AlertService_RemoveAllResolvedAlerts_FullMethodName      = "/notificator.alert.AlertService/RemoveAllResolvedAlerts"
+	AlertService_UndoRemoveAllResolvedAlerts_FullMethodName  = "/notificator.alert.AlertService/UndoRemoveAllResolvedAlerts"
 	AlertService_StreamResolvedAlertUpdates_FullMethodName   = "/notificator.alert.AlertService/StreamResolvedAlertUpdates"
+	AlertService_GetResolvedAlertSettings_FullMethodName     = "/notificator.alert.AlertService/GetResolvedAlertSettings"
+	AlertService_SaveResolvedAlertSettings_FullMethodName    = "/notificator.alert.AlertService/SaveResolvedAlertSettings"
 	AlertService_GetUserColorPreferences_FullMethodName      = "/notificator.alert.AlertService/GetUserColorPreferences"
 	AlertService_SaveUserColorPreferences_FullMethodName     = "/notificator.alert.AlertService/SaveUserColorPreferences"
 	AlertService_DeleteUserColorPreference_FullMethodName    = "/notificator.alert.AlertService/DeleteUserColorPreference"
@@ -42,6 +52,7 @@ const (
 	AlertService_HideAlert_FullMethodName                    = "/notificator.alert.AlertService/HideAlert"
 	AlertService_UnhideAlert_FullMethodName                  = "/notificator.alert.AlertService/UnhideAlert"
 	AlertService_ClearAllHiddenAlerts_FullMethodName         = "/notificator.alert.AlertService/ClearAllHiddenAlerts"
+	AlertService_UndoClearAllHiddenAlerts_FullMethodName     = "/notificator.alert.AlertService/UndoClearAllHiddenAlerts"
 	AlertService_GetUserHiddenRules_FullMethodName           = "/notificator.alert.AlertService/GetUserHiddenRules"
 	AlertService_SaveHiddenRule_FullMethodName               = "/notificator.alert.AlertService/SaveHiddenRule"
 	AlertService_RemoveHiddenRule_FullMethodName             = "/notificator.alert.AlertService/RemoveHiddenRule"
@@ -59,6 +70,10 @@ const (
 	AlertService_DeleteAnnotationButtonConfig_FullMethodName = "/notificator.alert.AlertService/DeleteAnnotationButtonConfig"
 	AlertService_GetUserColumnPreferences_FullMethodName     = "/notificator.alert.AlertService/GetUserColumnPreferences"
 	AlertService_SaveUserColumnPreferences_FullMethodName    = "/notificator.alert.AlertService/SaveUserColumnPreferences"
+	AlertService_ExportPreferences_FullMethodName            = "/notificator.alert.AlertService/ExportPreferences"
+	AlertService_ImportPreferences_FullMethodName            = "/notificator.alert.AlertService/ImportPreferences"
+	AlertService_CheckPermission_FullMethodName              = "/notificator.alert.AlertService/CheckPermission"
+	AlertService_HealthCheck_FullMethodName                  = "/notificator.alert.AlertService/HealthCheck"
 )
 
 // AlertServiceClient is the client API for AlertService service.
@@ -72,19 +87,31 @@ type AlertServiceClient interface {
 	GetComments(ctx context.Context, in *GetCommentsRequest, opts ...grpc.CallOption) (*GetCommentsResponse, error)
 	GetCommentCountsBatch(ctx context.Context, in *GetCommentCountsBatchRequest, opts ...grpc.CallOption) (*GetCommentCountsBatchResponse, error)
 	DeleteComment(ctx context.Context, in *DeleteCommentRequest, opts ...grpc.CallOption) (*DeleteCommentResponse, error)
+	GetCommentsByTag(ctx context.Context, in *GetCommentsByTagRequest, opts ...grpc.CallOption) (*GetCommentsByTagResponse, error)
+	GetCommentSettings(ctx context.Context, in *GetCommentSettingsRequest, opts ...grpc.CallOption) (*GetCommentSettingsResponse, error)
 	// Acknowledgments
 	AddAcknowledgment(ctx context.Context, in *AddAcknowledgmentRequest, opts ...grpc.CallOption) (*AddAcknowledgmentResponse, error)
 	GetAcknowledgments(ctx context.Context, in *GetAcknowledgmentsRequest, opts ...grpc.CallOption) (*GetAcknowledgmentsResponse, error)
 	GetAllAcknowledgedAlerts(ctx context.Context, in *GetAllAcknowledgedAlertsRequest, opts ...grpc.CallOption) (*GetAllAcknowledgedAlertsResponse, error)
 	DeleteAcknowledgment(ctx context.Context, in *DeleteAcknowledgmentRequest, opts ...grpc.CallOption) (*DeleteAcknowledgmentResponse, error)
+	BulkAcknowledge(ctx context.Context, in *BulkAcknowledgeRequest, opts ...grpc.CallOption) (*BulkAcknowledgeResponse, error)
+	// Escalations
+	EscalateAlert(ctx context.Context, in *EscalateAlertRequest, opts ...grpc.CallOption) (*EscalateAlertResponse, error)
+	GetEscalations(ctx context.Context, in *GetEscalationsRequest, opts ...grpc.CallOption) (*GetEscalationsResponse, error)
+	// Activity timeline
+	GetAlertActivity(ctx context.Context, in *GetAlertActivityRequest, opts ...grpc.CallOption) (*GetAlertActivityResponse, error)
 	// Real-time Updates
 	SubscribeToAlertUpdates(ctx context.Context, in *SubscribeToAlertUpdatesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[AlertUpdate], error)
 	// Resolved Alerts
 	CreateResolvedAlert(ctx context.Context, in *CreateResolvedAlertRequest, opts ...grpc.CallOption) (*CreateResolvedAlertResponse, error)
 	GetResolvedAlerts(ctx context.Context, in *GetResolvedAlertsRequest, opts ...grpc.CallOption) (*GetResolvedAlertsResponse, error)
 	GetResolvedAlert(ctx context.Context, in *GetResolvedAlertRequest, opts ...grpc.CallOption) (*GetResolvedAlertResponse, error)
+	RestoreResolvedAlert(ctx context.Context, in *RestoreResolvedAlertRequest, opts ...grpc.CallOption) (*RestoreResolvedAlertResponse, error)
 	RemoveAllResolvedAlerts(ctx context.Context, in *RemoveAllResolvedAlertsRequest, opts ...grpc.CallOption) (*RemoveAllResolvedAlertsResponse, error)
+	UndoRemoveAllResolvedAlerts(ctx context.Context, in *UndoClearRequest, opts ...grpc.CallOption) (*UndoClearResponse, error)
 	StreamResolvedAlertUpdates(ctx context.Context, in *StreamResolvedAlertUpdatesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ResolvedAlertUpdate], error)
+	GetResolvedAlertSettings(ctx context.Context, in *GetResolvedAlertSettingsRequest, opts ...grpc.CallOption) (*GetResolvedAlertSettingsResponse, error)
+	SaveResolvedAlertSettings(ctx context.Context, in *SaveResolvedAlertSettingsRequest, opts ...grpc.CallOption) (*SaveResolvedAlertSettingsResponse, error)
 	// User Color Preferences
 	GetUserColorPreferences(ctx context.Context, in *GetUserColorPreferencesRequest, opts ...grpc.CallOption) (*GetUserColorPreferencesResponse, error)
 	SaveUserColorPreferences(ctx context.Context, in *SaveUserColorPreferencesRequest, opts ...grpc.CallOption) (*SaveUserColorPreferencesResponse, error)
@@ -94,6 +121,7 @@ type AlertServiceClient interface {
 	HideAlert(ctx context.Context, in *HideAlertRequest, opts ...grpc.CallOption) (*HideAlertResponse, error)
 	UnhideAlert(ctx context.Context, in *UnhideAlertRequest, opts ...grpc.CallOption) (*UnhideAlertResponse, error)
 	ClearAllHiddenAlerts(ctx context.Context, in *ClearAllHiddenAlertsRequest, opts ...grpc.CallOption) (*ClearAllHiddenAlertsResponse, error)
+	UndoClearAllHiddenAlerts(ctx context.Context, in *UndoClearRequest, opts ...grpc.CallOption) (*UndoClearResponse, error)
 	// User Hidden Rules
 	GetUserHiddenRules(ctx context.Context, in *GetUserHiddenRulesRequest, opts ...grpc.CallOption) (*GetUserHiddenRulesResponse, error)
 	SaveHiddenRule(ctx context.Context, in *SaveHiddenRuleRequest, opts ...grpc.CallOption) (*SaveHiddenRuleResponse, error)
@@ -116,6 +144,14 @@ type AlertServiceClient interface {
 	// User Column Preferences
 	GetUserColumnPreferences(ctx context.Context, in *GetUserColumnPreferencesRequest, opts ...grpc.CallOption) (*GetUserColumnPreferencesResponse, error)
 	SaveUserColumnPreferences(ctx context.Context, in *SaveUserColumnPreferencesRequest, opts ...grpc.CallOption) (*SaveUserColumnPreferencesResponse, error)
+	// Preferences bundle (color preferences, filter presets, annotation buttons,
+	// hidden rules and notification preferences as one JSON document)
+	ExportPreferences(ctx context.Context, in *ExportPreferencesRequest, opts ...grpc.CallOption) (*ExportPreferencesResponse, error)
+	ImportPreferences(ctx context.Context, in *ImportPreferencesRequest, opts ...grpc.CallOption) (*ImportPreferencesResponse, error)
+	// Group permissions, for actions performed outside the backend (silences)
+	CheckPermission(ctx context.Context, in *CheckPermissionRequest, opts ...grpc.CallOption) (*CheckPermissionResponse, error)
+	// Health (no session required)
+	HealthCheck(ctx context.Context, in *HealthCheckRequest, opts ...grpc.CallOption) (*HealthCheckResponse, error)
 }
 
 type alertServiceClient struct {
@@ -166,6 +202,26 @@ func (c *alertServiceClient) DeleteComment(ctx context.Context, in *DeleteCommen
 	return out, nil
 }
 
+func (c *alertServiceClient) GetCommentsByTag(ctx context.Context, in *GetCommentsByTagRequest, opts ...grpc.CallOption) (*GetCommentsByTagResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCommentsByTagResponse)
+	err := c.cc.Invoke(ctx, AlertService_GetCommentsByTag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *alertServiceClient) GetCommentSettings(ctx context.Context, in *GetCommentSettingsRequest, opts ...grpc.CallOption) (*GetCommentSettingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCommentSettingsResponse)
+	err := c.cc.Invoke(ctx, AlertService_GetCommentSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *alertServiceClient) AddAcknowledgment(ctx context.Context, in *AddAcknowledgmentRequest, opts ...grpc.CallOption) (*AddAcknowledgmentResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(AddAcknowledgmentResponse)
@@ -206,6 +262,46 @@ func (c *alertServiceClient) DeleteAcknowledgment(ctx context.Context, in *Delet
 	return out, nil
 }
 
+func (c *alertServiceClient) BulkAcknowledge(ctx context.Context, in *BulkAcknowledgeRequest, opts ...grpc.CallOption) (*BulkAcknowledgeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkAcknowledgeResponse)
+	err := c.cc.Invoke(ctx, AlertService_BulkAcknowledge_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *alertServiceClient) EscalateAlert(ctx context.Context, in *EscalateAlertRequest, opts ...grpc.CallOption) (*EscalateAlertResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EscalateAlertResponse)
+	err := c.cc.Invoke(ctx, AlertService_EscalateAlert_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *alertServiceClient) GetEscalations(ctx context.Context, in *GetEscalationsRequest, opts ...grpc.CallOption) (*GetEscalationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetEscalationsResponse)
+	err := c.cc.Invoke(ctx, AlertService_GetEscalations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *alertServiceClient) GetAlertActivity(ctx context.Context, in *GetAlertActivityRequest, opts ...grpc.CallOption) (*GetAlertActivityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAlertActivityResponse)
+	err := c.cc.Invoke(ctx, AlertService_GetAlertActivity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *alertServiceClient) SubscribeToAlertUpdates(ctx context.Context, in *SubscribeToAlertUpdatesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[AlertUpdate], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	stream, err := c.cc.NewStream(ctx, &AlertService_ServiceDesc.Streams[0], AlertService_SubscribeToAlertUpdates_FullMethodName, cOpts...)
@@ -255,6 +351,16 @@ func (c *alertServiceClient) GetResolvedAlert(ctx context.Context, in *GetResolv
 	return out, nil
 }
 
+func (c *alertServiceClient) RestoreResolvedAlert(ctx context.Context, in *RestoreResolvedAlertRequest, opts ...grpc.CallOption) (*RestoreResolvedAlertResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreResolvedAlertResponse)
+	err := c.cc.Invoke(ctx, AlertService_RestoreResolvedAlert_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *alertServiceClient) RemoveAllResolvedAlerts(ctx context.Context, in *RemoveAllResolvedAlertsRequest, opts ...grpc.CallOption) (*RemoveAllResolvedAlertsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(RemoveAllResolvedAlertsResponse)
@@ -265,6 +371,16 @@ func (c *alertServiceClient) RemoveAllResolvedAlerts(ctx context.Context, in *Re
 	return out, nil
 }
 
+func (c *alertServiceClient) UndoRemoveAllResolvedAlerts(ctx context.Context, in *UndoClearRequest, opts ...grpc.CallOption) (*UndoClearResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UndoClearResponse)
+	err := c.cc.Invoke(ctx, AlertService_UndoRemoveAllResolvedAlerts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *alertServiceClient) StreamResolvedAlertUpdates(ctx context.Context, in *StreamResolvedAlertUpdatesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ResolvedAlertUpdate], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	stream, err := c.cc.NewStream(ctx, &AlertService_ServiceDesc.Streams[1], AlertService_StreamResolvedAlertUpdates_FullMethodName, cOpts...)
@@ -284,6 +400,26 @@ func (c *alertServiceClient) StreamResolvedAlertUpdates(ctx context.Context, in
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type AlertService_StreamResolvedAlertUpdatesClient = grpc.ServerStreamingClient[ResolvedAlertUpdate]
 
+func (c *alertServiceClient) GetResolvedAlertSettings(ctx context.Context, in *GetResolvedAlertSettingsRequest, opts ...grpc.CallOption) (*GetResolvedAlertSettingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetResolvedAlertSettingsResponse)
+	err := c.cc.Invoke(ctx, AlertService_GetResolvedAlertSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *alertServiceClient) SaveResolvedAlertSettings(ctx context.Context, in *SaveResolvedAlertSettingsRequest, opts ...grpc.CallOption) (*SaveResolvedAlertSettingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SaveResolvedAlertSettingsResponse)
+	err := c.cc.Invoke(ctx, AlertService_SaveResolvedAlertSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *alertServiceClient) GetUserColorPreferences(ctx context.Context, in *GetUserColorPreferencesRequest, opts ...grpc.CallOption) (*GetUserColorPreferencesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetUserColorPreferencesResponse)
@@ -354,6 +490,16 @@ func (c *alertServiceClient) ClearAllHiddenAlerts(ctx context.Context, in *Clear
 	return out, nil
 }
 
+func (c *alertServiceClient) UndoClearAllHiddenAlerts(ctx context.Context, in *UndoClearRequest, opts ...grpc.CallOption) (*UndoClearResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UndoClearResponse)
+	err := c.cc.Invoke(ctx, AlertService_UndoClearAllHiddenAlerts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *alertServiceClient) GetUserHiddenRules(ctx context.Context, in *GetUserHiddenRulesRequest, opts ...grpc.CallOption) (*GetUserHiddenRulesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetUserHiddenRulesResponse)
@@ -524,6 +670,46 @@ func (c *alertServiceClient) SaveUserColumnPreferences(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *alertServiceClient) ExportPreferences(ctx context.Context, in *ExportPreferencesRequest, opts ...grpc.CallOption) (*ExportPreferencesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportPreferencesResponse)
+	err := c.cc.Invoke(ctx, AlertService_ExportPreferences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *alertServiceClient) ImportPreferences(ctx context.Context, in *ImportPreferencesRequest, opts ...grpc.CallOption) (*ImportPreferencesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportPreferencesResponse)
+	err := c.cc.Invoke(ctx, AlertService_ImportPreferences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *alertServiceClient) CheckPermission(ctx context.Context, in *CheckPermissionRequest, opts ...grpc.CallOption) (*CheckPermissionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckPermissionResponse)
+	err := c.cc.Invoke(ctx, AlertService_CheckPermission_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *alertServiceClient) HealthCheck(ctx context.Context, in *HealthCheckRequest, opts ...grpc.CallOption) (*HealthCheckResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HealthCheckResponse)
+	err := c.cc.Invoke(ctx, AlertService_HealthCheck_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AlertServiceServer is the server API for AlertService service.
 // All implementations must embed UnimplementedAlertServiceServer
 // for forward compatibility.
@@ -535,19 +721,31 @@ type AlertServiceServer interface {
 	GetComments(context.Context, *GetCommentsRequest) (*GetCommentsResponse, error)
 	GetCommentCountsBatch(context.Context, *GetCommentCountsBatchRequest) (*GetCommentCountsBatchResponse, error)
 	DeleteComment(context.Context, *DeleteCommentRequest) (*DeleteCommentResponse, error)
+	GetCommentsByTag(context.Context, *GetCommentsByTagRequest) (*GetCommentsByTagResponse, error)
+	GetCommentSettings(context.Context, *GetCommentSettingsRequest) (*GetCommentSettingsResponse, error)
 	// Acknowledgments
 	AddAcknowledgment(context.Context, *AddAcknowledgmentRequest) (*AddAcknowledgmentResponse, error)
 	GetAcknowledgments(context.Context, *GetAcknowledgmentsRequest) (*GetAcknowledgmentsResponse, error)
 	GetAllAcknowledgedAlerts(context.Context, *GetAllAcknowledgedAlertsRequest) (*GetAllAcknowledgedAlertsResponse, error)
 	DeleteAcknowledgment(context.Context, *DeleteAcknowledgmentRequest) (*DeleteAcknowledgmentResponse, error)
+	BulkAcknowledge(context.Context, *BulkAcknowledgeRequest) (*BulkAcknowledgeResponse, error)
+	// Escalations
+	EscalateAlert(context.Context, *EscalateAlertRequest) (*EscalateAlertResponse, error)
+	GetEscalations(context.Context, *GetEscalationsRequest) (*GetEscalationsResponse, error)
+	// Activity timeline
+	GetAlertActivity(context.Context, *GetAlertActivityRequest) (*GetAlertActivityResponse, error)
 	// Real-time Updates
 	SubscribeToAlertUpdates(*SubscribeToAlertUpdatesRequest, grpc.ServerStreamingServer[AlertUpdate]) error
 	// Resolved Alerts
 	CreateResolvedAlert(context.Context, *CreateResolvedAlertRequest) (*CreateResolvedAlertResponse, error)
 	GetResolvedAlerts(context.Context, *GetResolvedAlertsRequest) (*GetResolvedAlertsResponse, error)
 	GetResolvedAlert(context.Context, *GetResolvedAlertRequest) (*GetResolvedAlertResponse, error)
+	RestoreResolvedAlert(context.Context, *RestoreResolvedAlertRequest) (*RestoreResolvedAlertResponse, error)
 	RemoveAllResolvedAlerts(context.Context, *RemoveAllResolvedAlertsRequest) (*RemoveAllResolvedAlertsResponse, error)
+	UndoRemoveAllResolvedAlerts(context.Context, *UndoClearRequest) (*UndoClearResponse, error)
 	StreamResolvedAlertUpdates(*StreamResolvedAlertUpdatesRequest, grpc.ServerStreamingServer[ResolvedAlertUpdate]) error
+	GetResolvedAlertSettings(context.Context, *GetResolvedAlertSettingsRequest) (*GetResolvedAlertSettingsResponse, error)
+	SaveResolvedAlertSettings(context.Context, *SaveResolvedAlertSettingsRequest) (*SaveResolvedAlertSettingsResponse, error)
 	// User Color Preferences
 	GetUserColorPreferences(context.Context, *GetUserColorPreferencesRequest) (*GetUserColorPreferencesResponse, error)
 	SaveUserColorPreferences(context.Context, *SaveUserColorPreferencesRequest) (*SaveUserColorPreferencesResponse, error)
@@ -557,6 +755,7 @@ type AlertServiceServer interface {
 	HideAlert(context.Context, *HideAlertRequest) (*HideAlertResponse, error)
 	UnhideAlert(context.Context, *UnhideAlertRequest) (*UnhideAlertResponse, error)
 	ClearAllHiddenAlerts(context.Context, *ClearAllHiddenAlertsRequest) (*ClearAllHiddenAlertsResponse, error)
+	UndoClearAllHiddenAlerts(context.Context, *UndoClearRequest) (*UndoClearResponse, error)
 	// User Hidden Rules
 	GetUserHiddenRules(context.Context, *GetUserHiddenRulesRequest) (*GetUserHiddenRulesResponse, error)
 	SaveHiddenRule(context.Context, *SaveHiddenRuleRequest) (*SaveHiddenRuleResponse, error)
@@ -579,6 +778,14 @@ type AlertServiceServer interface {
 	// User Column Preferences
 	GetUserColumnPreferences(context.Context, *GetUserColumnPreferencesRequest) (*GetUserColumnPreferencesResponse, error)
 	SaveUserColumnPreferences(context.Context, *SaveUserColumnPreferencesRequest) (*SaveUserColumnPreferencesResponse, error)
+	// Preferences bundle (color preferences, filter presets, annotation buttons,
+	// hidden rules and notification preferences as one JSON document)
+	ExportPreferences(context.Context, *ExportPreferencesRequest) (*ExportPreferencesResponse, error)
+	ImportPreferences(context.Context, *ImportPreferencesRequest) (*ImportPreferencesResponse, error)
+	// Group permissions, for actions performed outside the backend (silences)
+	CheckPermission(context.Context, *CheckPermissionRequest) (*CheckPermissionResponse, error)
+	// Health (no session required)
+	HealthCheck(context.Context, *HealthCheckRequest) (*HealthCheckResponse, error)
 	mustEmbedUnimplementedAlertServiceServer()
 }
 
@@ -601,6 +808,12 @@ func (UnimplementedAlertServiceServer) GetCommentCountsBatch(context.Context, *G
 func (UnimplementedAlertServiceServer) DeleteComment(context.Context, *DeleteCommentRequest) (*DeleteCommentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteComment not implemented")
 }
+func (UnimplementedAlertServiceServer) GetCommentsByTag(context.Context, *GetCommentsByTagRequest) (*GetCommentsByTagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCommentsByTag not implemented")
+}
+func (UnimplementedAlertServiceServer) GetCommentSettings(context.Context, *GetCommentSettingsRequest) (*GetCommentSettingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCommentSettings not implemented")
+}
 func (UnimplementedAlertServiceServer) AddAcknowledgment(context.Context, *AddAcknowledgmentRequest) (*AddAcknowledgmentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddAcknowledgment not implemented")
 }
@@ -613,6 +826,18 @@ func (UnimplementedAlertServiceServer) GetAllAcknowledgedAlerts(context.Context,
 func (UnimplementedAlertServiceServer) DeleteAcknowledgment(context.Context, *DeleteAcknowledgmentRequest) (*DeleteAcknowledgmentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteAcknowledgment not implemented")
 }
+func (UnimplementedAlertServiceServer) BulkAcknowledge(context.Context, *BulkAcknowledgeRequest) (*BulkAcknowledgeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkAcknowledge not implemented")
+}
+func (UnimplementedAlertServiceServer) EscalateAlert(context.Context, *EscalateAlertRequest) (*EscalateAlertResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EscalateAlert not implemented")
+}
+func (UnimplementedAlertServiceServer) GetEscalations(context.Context, *GetEscalationsRequest) (*GetEscalationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEscalations not implemented")
+}
+func (UnimplementedAlertServiceServer) GetAlertActivity(context.Context, *GetAlertActivityRequest) (*GetAlertActivityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAlertActivity not implemented")
+}
 func (UnimplementedAlertServiceServer) SubscribeToAlertUpdates(*SubscribeToAlertUpdatesRequest, grpc.ServerStreamingServer[AlertUpdate]) error {
 	return status.Errorf(codes.Unimplemented, "method SubscribeToAlertUpdates not implemented")
 }
@@ -625,12 +850,24 @@ func (UnimplementedAlertServiceServer) GetResolvedAlerts(context.Context, *GetRe
 func (UnimplementedAlertServiceServer) GetResolvedAlert(context.Context, *GetResolvedAlertRequest) (*GetResolvedAlertResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetResolvedAlert not implemented")
 }
+func (UnimplementedAlertServiceServer) RestoreResolvedAlert(context.Context, *RestoreResolvedAlertRequest) (*RestoreResolvedAlertResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreResolvedAlert not implemented")
+}
 func (UnimplementedAlertServiceServer) RemoveAllResolvedAlerts(context.Context, *RemoveAllResolvedAlertsRequest) (*RemoveAllResolvedAlertsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveAllResolvedAlerts not implemented")
 }
+func (UnimplementedAlertServiceServer) UndoRemoveAllResolvedAlerts(context.Context, *UndoClearRequest) (*UndoClearResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndoRemoveAllResolvedAlerts not implemented")
+}
 func (UnimplementedAlertServiceServer) StreamResolvedAlertUpdates(*StreamResolvedAlertUpdatesRequest, grpc.ServerStreamingServer[ResolvedAlertUpdate]) error {
 	return status.Errorf(codes.Unimplemented, "method StreamResolvedAlertUpdates not implemented")
 }
+func (UnimplementedAlertServiceServer) GetResolvedAlertSettings(context.Context, *GetResolvedAlertSettingsRequest) (*GetResolvedAlertSettingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetResolvedAlertSettings not implemented")
+}
+func (UnimplementedAlertServiceServer) SaveResolvedAlertSettings(context.Context, *SaveResolvedAlertSettingsRequest) (*SaveResolvedAlertSettingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveResolvedAlertSettings not implemented")
+}
 func (UnimplementedAlertServiceServer) GetUserColorPreferences(context.Context, *GetUserColorPreferencesRequest) (*GetUserColorPreferencesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUserColorPreferences not implemented")
 }
@@ -652,6 +889,9 @@ func (UnimplementedAlertServiceServer) UnhideAlert(context.Context, *UnhideAlert
 func (UnimplementedAlertServiceServer) ClearAllHiddenAlerts(context.Context, *ClearAllHiddenAlertsRequest) (*ClearAllHiddenAlertsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ClearAllHiddenAlerts not implemented")
 }
+func (UnimplementedAlertServiceServer) UndoClearAllHiddenAlerts(context.Context, *UndoClearRequest) (*UndoClearResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndoClearAllHiddenAlerts not implemented")
+}
 func (UnimplementedAlertServiceServer) GetUserHiddenRules(context.Context, *GetUserHiddenRulesRequest) (*GetUserHiddenRulesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUserHiddenRules not implemented")
 }
@@ -703,6 +943,18 @@ func (UnimplementedAlertServiceServer) GetUserColumnPreferences(context.Context,
 func (UnimplementedAlertServiceServer) SaveUserColumnPreferences(context.Context, *SaveUserColumnPreferencesRequest) (*SaveUserColumnPreferencesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SaveUserColumnPreferences not implemented")
 }
+func (UnimplementedAlertServiceServer) ExportPreferences(context.Context, *ExportPreferencesRequest) (*ExportPreferencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportPreferences not implemented")
+}
+func (UnimplementedAlertServiceServer) ImportPreferences(context.Context, *ImportPreferencesRequest) (*ImportPreferencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportPreferences not implemented")
+}
+func (UnimplementedAlertServiceServer) CheckPermission(context.Context, *CheckPermissionRequest) (*CheckPermissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckPermission not implemented")
+}
+func (UnimplementedAlertServiceServer) HealthCheck(context.Context, *HealthCheckRequest) (*HealthCheckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HealthCheck not implemented")
+}
 func (UnimplementedAlertServiceServer) mustEmbedUnimplementedAlertServiceServer() {}
 func (UnimplementedAlertServiceServer) testEmbeddedByValue()                      {}
 
@@ -796,6 +1048,42 @@ func _AlertService_DeleteComment_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AlertService_GetCommentsByTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCommentsByTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlertServiceServer).GetCommentsByTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AlertService_GetCommentsByTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlertServiceServer).GetCommentsByTag(ctx, req.(*GetCommentsByTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AlertService_GetCommentSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCommentSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlertServiceServer).GetCommentSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AlertService_GetCommentSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlertServiceServer).GetCommentSettings(ctx, req.(*GetCommentSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AlertService_AddAcknowledgment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AddAcknowledgmentRequest)
 	if err := dec(in); err != nil {
@@ -868,6 +1156,78 @@ func _AlertService_DeleteAcknowledgment_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AlertService_BulkAcknowledge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkAcknowledgeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlertServiceServer).BulkAcknowledge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AlertService_BulkAcknowledge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlertServiceServer).BulkAcknowledge(ctx, req.(*BulkAcknowledgeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AlertService_EscalateAlert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EscalateAlertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlertServiceServer).EscalateAlert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AlertService_EscalateAlert_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlertServiceServer).EscalateAlert(ctx, req.(*EscalateAlertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AlertService_GetEscalations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEscalationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlertServiceServer).GetEscalations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AlertService_GetEscalations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlertServiceServer).GetEscalations(ctx, req.(*GetEscalationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AlertService_GetAlertActivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAlertActivityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlertServiceServer).GetAlertActivity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AlertService_GetAlertActivity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlertServiceServer).GetAlertActivity(ctx, req.(*GetAlertActivityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AlertService_SubscribeToAlertUpdates_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(SubscribeToAlertUpdatesRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -933,6 +1293,24 @@ func _AlertService_GetResolvedAlert_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AlertService_RestoreResolvedAlert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreResolvedAlertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlertServiceServer).RestoreResolvedAlert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AlertService_RestoreResolvedAlert_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlertServiceServer).RestoreResolvedAlert(ctx, req.(*RestoreResolvedAlertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AlertService_RemoveAllResolvedAlerts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RemoveAllResolvedAlertsRequest)
 	if err := dec(in); err != nil {
@@ -951,6 +1329,24 @@ func _AlertService_RemoveAllResolvedAlerts_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AlertService_UndoRemoveAllResolvedAlerts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UndoClearRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlertServiceServer).UndoRemoveAllResolvedAlerts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AlertService_UndoRemoveAllResolvedAlerts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlertServiceServer).UndoRemoveAllResolvedAlerts(ctx, req.(*UndoClearRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AlertService_StreamResolvedAlertUpdates_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(StreamResolvedAlertUpdatesRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -962,6 +1358,42 @@ func _AlertService_StreamResolvedAlertUpdates_Handler(srv interface{}, stream gr
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type AlertService_StreamResolvedAlertUpdatesServer = grpc.ServerStreamingServer[ResolvedAlertUpdate]
 
+func _AlertService_GetResolvedAlertSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetResolvedAlertSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlertServiceServer).GetResolvedAlertSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AlertService_GetResolvedAlertSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlertServiceServer).GetResolvedAlertSettings(ctx, req.(*GetResolvedAlertSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AlertService_SaveResolvedAlertSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveResolvedAlertSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlertServiceServer).SaveResolvedAlertSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AlertService_SaveResolvedAlertSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlertServiceServer).SaveResolvedAlertSettings(ctx, req.(*SaveResolvedAlertSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AlertService_GetUserColorPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetUserColorPreferencesRequest)
 	if err := dec(in); err != nil {
@@ -1088,6 +1520,24 @@ func _AlertService_ClearAllHiddenAlerts_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AlertService_UndoClearAllHiddenAlerts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UndoClearRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlertServiceServer).UndoClearAllHiddenAlerts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AlertService_UndoClearAllHiddenAlerts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlertServiceServer).UndoClearAllHiddenAlerts(ctx, req.(*UndoClearRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AlertService_GetUserHiddenRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetUserHiddenRulesRequest)
 	if err := dec(in); err != nil {
@@ -1394,6 +1844,78 @@ func _AlertService_SaveUserColumnPreferences_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AlertService_ExportPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportPreferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlertServiceServer).ExportPreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AlertService_ExportPreferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlertServiceServer).ExportPreferences(ctx, req.(*ExportPreferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AlertService_ImportPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportPreferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlertServiceServer).ImportPreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AlertService_ImportPreferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlertServiceServer).ImportPreferences(ctx, req.(*ImportPreferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AlertService_CheckPermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckPermissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlertServiceServer).CheckPermission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AlertService_CheckPermission_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlertServiceServer).CheckPermission(ctx, req.(*CheckPermissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AlertService_HealthCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HealthCheckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlertServiceServer).HealthCheck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AlertService_HealthCheck_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlertServiceServer).HealthCheck(ctx, req.(*HealthCheckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AlertService_ServiceDesc is the grpc.ServiceDesc for AlertService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1417,6 +1939,14 @@ var AlertService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteComment",
 			Handler:    _AlertService_DeleteComment_Handler,
 		},
+		{
+			MethodName: "GetCommentsByTag",
+			Handler:    _AlertService_GetCommentsByTag_Handler,
+		},
+		{
+			MethodName: "GetCommentSettings",
+			Handler:    _AlertService_GetCommentSettings_Handler,
+		},
 		{
 			MethodName: "AddAcknowledgment",
 			Handler:    _AlertService_AddAcknowledgment_Handler,
@@ -1433,6 +1963,22 @@ var AlertService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteAcknowledgment",
 			Handler:    _AlertService_DeleteAcknowledgment_Handler,
 		},
+		{
+			MethodName: "BulkAcknowledge",
+			Handler:    _AlertService_BulkAcknowledge_Handler,
+		},
+		{
+			MethodName: "EscalateAlert",
+			Handler:    _AlertService_EscalateAlert_Handler,
+		},
+		{
+			MethodName: "GetEscalations",
+			Handler:    _AlertService_GetEscalations_Handler,
+		},
+		{
+			MethodName: "GetAlertActivity",
+			Handler:    _AlertService_GetAlertActivity_Handler,
+		},
 		{
 			MethodName: "CreateResolvedAlert",
 			Handler:    _AlertService_CreateResolvedAlert_Handler,
@@ -1445,10 +1991,26 @@ var AlertService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetResolvedAlert",
 			Handler:    _AlertService_GetResolvedAlert_Handler,
 		},
+		{
+			MethodName: "RestoreResolvedAlert",
+			Handler:    _AlertService_RestoreResolvedAlert_Handler,
+		},
 		{
 			MethodName: "RemoveAllResolvedAlerts",
 			Handler:    _AlertService_RemoveAllResolvedAlerts_Handler,
 		},
+		{
+			MethodName: "UndoRemoveAllResolvedAlerts",
+			Handler:    _AlertService_UndoRemoveAllResolvedAlerts_Handler,
+		},
+		{
+			MethodName: "GetResolvedAlertSettings",
+			Handler:    _AlertService_GetResolvedAlertSettings_Handler,
+		},
+		{
+			MethodName: "SaveResolvedAlertSettings",
+			Handler:    _AlertService_SaveResolvedAlertSettings_Handler,
+		},
 		{
 			MethodName: "GetUserColorPreferences",
 			Handler:    _AlertService_GetUserColorPreferences_Handler,
@@ -1477,6 +2039,10 @@ var AlertService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ClearAllHiddenAlerts",
 			Handler:    _AlertService_ClearAllHiddenAlerts_Handler,
 		},
+		{
+			MethodName: "UndoClearAllHiddenAlerts",
+			Handler:    _AlertService_UndoClearAllHiddenAlerts_Handler,
+		},
 		{
 			MethodName: "GetUserHiddenRules",
 			Handler:    _AlertService_GetUserHiddenRules_Handler,
@@ -1545,6 +2111,22 @@ var AlertService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SaveUserColumnPreferences",
 			Handler:    _AlertService_SaveUserColumnPreferences_Handler,
 		},
+		{
+			MethodName: "ExportPreferences",
+			Handler:    _AlertService_ExportPreferences_Handler,
+		},
+		{
+			MethodName: "ImportPreferences",
+			Handler:    _AlertService_ImportPreferences_Handler,
+		},
+		{
+			MethodName: "CheckPermission",
+			Handler:    _AlertService_CheckPermission_Handler,
+		},
+		{
+			MethodName: "HealthCheck",
+			Handler:    _AlertService_HealthCheck_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -450,6 +450,7 @@ type ValidateSessionResponse struct {
 	Valid         bool                   `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
 	User          *User                  `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
 	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -505,6 +506,429 @@ func (x *ValidateSessionResponse) GetMessage() string {
 	return ""
 }
 
+func (x *ValidateSessionResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type RefreshSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefreshSessionRequest) Reset() {
+	*x = RefreshSessionRequest{}
+	mi := &file_proto_auth_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshSessionRequest) ProtoMessage() {}
+
+func (x *RefreshSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshSessionRequest.ProtoReflect.Descriptor instead.
+func (*RefreshSessionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RefreshSessionRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+type RefreshSessionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefreshSessionResponse) Reset() {
+	*x = RefreshSessionResponse{}
+	mi := &file_proto_auth_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshSessionResponse) ProtoMessage() {}
+
+func (x *RefreshSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshSessionResponse.ProtoReflect.Descriptor instead.
+func (*RefreshSessionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RefreshSessionResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *RefreshSessionResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *RefreshSessionResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type ChangePasswordRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	OldPassword   string                 `protobuf:"bytes,2,opt,name=old_password,json=oldPassword,proto3" json:"old_password,omitempty"`
+	NewPassword   string                 `protobuf:"bytes,3,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangePasswordRequest) Reset() {
+	*x = ChangePasswordRequest{}
+	mi := &file_proto_auth_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangePasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangePasswordRequest) ProtoMessage() {}
+
+func (x *ChangePasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangePasswordRequest.ProtoReflect.Descriptor instead.
+func (*ChangePasswordRequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ChangePasswordRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *ChangePasswordRequest) GetOldPassword() string {
+	if x != nil {
+		return x.OldPassword
+	}
+	return ""
+}
+
+func (x *ChangePasswordRequest) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+type ChangePasswordResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangePasswordResponse) Reset() {
+	*x = ChangePasswordResponse{}
+	mi := &file_proto_auth_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangePasswordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangePasswordResponse) ProtoMessage() {}
+
+func (x *ChangePasswordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangePasswordResponse.ProtoReflect.Descriptor instead.
+func (*ChangePasswordResponse) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ChangePasswordResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ChangePasswordResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type DeleteAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAccountRequest) Reset() {
+	*x = DeleteAccountRequest{}
+	mi := &file_proto_auth_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAccountRequest) ProtoMessage() {}
+
+func (x *DeleteAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAccountRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAccountRequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DeleteAccountRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+type DeleteAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAccountResponse) Reset() {
+	*x = DeleteAccountResponse{}
+	mi := &file_proto_auth_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAccountResponse) ProtoMessage() {}
+
+func (x *DeleteAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAccountResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAccountResponse) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DeleteAccountResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DeleteAccountResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ExportUserDataRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportUserDataRequest) Reset() {
+	*x = ExportUserDataRequest{}
+	mi := &file_proto_auth_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportUserDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportUserDataRequest) ProtoMessage() {}
+
+func (x *ExportUserDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportUserDataRequest.ProtoReflect.Descriptor instead.
+func (*ExportUserDataRequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ExportUserDataRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+type ExportUserDataResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          []byte                 `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"` // JSON document of all the user's stored data
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportUserDataResponse) Reset() {
+	*x = ExportUserDataResponse{}
+	mi := &file_proto_auth_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportUserDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportUserDataResponse) ProtoMessage() {}
+
+func (x *ExportUserDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportUserDataResponse.ProtoReflect.Descriptor instead.
+func (*ExportUserDataResponse) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ExportUserDataResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ExportUserDataResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ExportUserDataResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
 type GetProfileRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
@@ -514,7 +938,7 @@ type GetProfileRequest struct {
 
 func (x *GetProfileRequest) Reset() {
 	*x = GetProfileRequest{}
-	mi := &file_proto_auth_proto_msgTypes[8]
+	mi := &file_proto_auth_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -526,7 +950,7 @@ func (x *GetProfileRequest) String() string {
 func (*GetProfileRequest) ProtoMessage() {}
 
 func (x *GetProfileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[8]
+	mi := &file_proto_auth_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -539,7 +963,7 @@ func (x *GetProfileRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetProfileRequest.ProtoReflect.Descriptor instead.
 func (*GetProfileRequest) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{8}
+	return file_proto_auth_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *GetProfileRequest) GetSessionId() string {
@@ -559,7 +983,7 @@ type GetProfileResponse struct {
 
 func (x *GetProfileResponse) Reset() {
 	*x = GetProfileResponse{}
-	mi := &file_proto_auth_proto_msgTypes[9]
+	mi := &file_proto_auth_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -571,7 +995,7 @@ func (x *GetProfileResponse) String() string {
 func (*GetProfileResponse) ProtoMessage() {}
 
 func (x *GetProfileResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[9]
+	mi := &file_proto_auth_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -584,7 +1008,7 @@ func (x *GetProfileResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetProfileResponse.ProtoReflect.Descriptor instead.
 func (*GetProfileResponse) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{9}
+	return file_proto_auth_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *GetProfileResponse) GetUser() *User {
@@ -611,13 +1035,15 @@ type User struct {
 	OauthProvider string                 `protobuf:"bytes,6,opt,name=oauth_provider,json=oauthProvider,proto3" json:"oauth_provider,omitempty"` // OAuth provider name
 	OauthId       string                 `protobuf:"bytes,7,opt,name=oauth_id,json=oauthId,proto3" json:"oauth_id,omitempty"`                   // OAuth user ID
 	Timezone      string                 `protobuf:"bytes,8,opt,name=timezone,proto3" json:"timezone,omitempty"`                                // IANA timezone (e.g., "Europe/Paris")
+	IsAdmin       bool                   `protobuf:"varint,9,opt,name=is_admin,json=isAdmin,proto3" json:"is_admin,omitempty"`
+	Disabled      bool                   `protobuf:"varint,10,opt,name=disabled,proto3" json:"disabled,omitempty"` // Disabled users cannot log in
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *User) Reset() {
 	*x = User{}
-	mi := &file_proto_auth_proto_msgTypes[10]
+	mi := &file_proto_auth_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -629,7 +1055,7 @@ func (x *User) String() string {
 func (*User) ProtoMessage() {}
 
 func (x *User) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[10]
+	mi := &file_proto_auth_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -642,7 +1068,7 @@ func (x *User) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use User.ProtoReflect.Descriptor instead.
 func (*User) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{10}
+	return file_proto_auth_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *User) GetId() string {
@@ -701,6 +1127,20 @@ func (x *User) GetTimezone() string {
 	return ""
 }
 
+func (x *User) GetIsAdmin() bool {
+	if x != nil {
+		return x.IsAdmin
+	}
+	return false
+}
+
+func (x *User) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
 type SearchUsersRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
@@ -711,7 +1151,7 @@ type SearchUsersRequest struct {
 
 func (x *SearchUsersRequest) Reset() {
 	*x = SearchUsersRequest{}
-	mi := &file_proto_auth_proto_msgTypes[11]
+	mi := &file_proto_auth_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -723,7 +1163,7 @@ func (x *SearchUsersRequest) String() string {
 func (*SearchUsersRequest) ProtoMessage() {}
 
 func (x *SearchUsersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[11]
+	mi := &file_proto_auth_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -736,7 +1176,7 @@ func (x *SearchUsersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchUsersRequest.ProtoReflect.Descriptor instead.
 func (*SearchUsersRequest) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{11}
+	return file_proto_auth_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *SearchUsersRequest) GetQuery() string {
@@ -763,7 +1203,7 @@ type SearchUsersResponse struct {
 
 func (x *SearchUsersResponse) Reset() {
 	*x = SearchUsersResponse{}
-	mi := &file_proto_auth_proto_msgTypes[12]
+	mi := &file_proto_auth_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -775,7 +1215,7 @@ func (x *SearchUsersResponse) String() string {
 func (*SearchUsersResponse) ProtoMessage() {}
 
 func (x *SearchUsersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[12]
+	mi := &file_proto_auth_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -788,7 +1228,7 @@ func (x *SearchUsersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchUsersResponse.ProtoReflect.Descriptor instead.
 func (*SearchUsersResponse) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{12}
+	return file_proto_auth_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *SearchUsersResponse) GetUsers() []*User {
@@ -816,7 +1256,7 @@ type ListUsersRequest struct {
 
 func (x *ListUsersRequest) Reset() {
 	*x = ListUsersRequest{}
-	mi := &file_proto_auth_proto_msgTypes[13]
+	mi := &file_proto_auth_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -828,7 +1268,7 @@ func (x *ListUsersRequest) String() string {
 func (*ListUsersRequest) ProtoMessage() {}
 
 func (x *ListUsersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[13]
+	mi := &file_proto_auth_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -841,7 +1281,7 @@ func (x *ListUsersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUsersRequest.ProtoReflect.Descriptor instead.
 func (*ListUsersRequest) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{13}
+	return file_proto_auth_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *ListUsersRequest) GetSessionId() string {
@@ -877,7 +1317,7 @@ type ListUsersResponse struct {
 
 func (x *ListUsersResponse) Reset() {
 	*x = ListUsersResponse{}
-	mi := &file_proto_auth_proto_msgTypes[14]
+	mi := &file_proto_auth_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -889,7 +1329,7 @@ func (x *ListUsersResponse) String() string {
 func (*ListUsersResponse) ProtoMessage() {}
 
 func (x *ListUsersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[14]
+	mi := &file_proto_auth_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -902,7 +1342,7 @@ func (x *ListUsersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUsersResponse.ProtoReflect.Descriptor instead.
 func (*ListUsersResponse) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{14}
+	return file_proto_auth_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *ListUsersResponse) GetSuccess() bool {
@@ -933,6 +1373,118 @@ func (x *ListUsersResponse) GetTotalCount() int32 {
 	return 0
 }
 
+type SetUserDisabledRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Disabled      bool                   `protobuf:"varint,3,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetUserDisabledRequest) Reset() {
+	*x = SetUserDisabledRequest{}
+	mi := &file_proto_auth_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetUserDisabledRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUserDisabledRequest) ProtoMessage() {}
+
+func (x *SetUserDisabledRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUserDisabledRequest.ProtoReflect.Descriptor instead.
+func (*SetUserDisabledRequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SetUserDisabledRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *SetUserDisabledRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetUserDisabledRequest) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+type SetUserDisabledResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetUserDisabledResponse) Reset() {
+	*x = SetUserDisabledResponse{}
+	mi := &file_proto_auth_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetUserDisabledResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUserDisabledResponse) ProtoMessage() {}
+
+func (x *SetUserDisabledResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUserDisabledResponse.ProtoReflect.Descriptor instead.
+func (*SetUserDisabledResponse) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SetUserDisabledResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *SetUserDisabledResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
 // OAuth Messages
 type OAuthAuthURLRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -944,7 +1496,7 @@ type OAuthAuthURLRequest struct {
 
 func (x *OAuthAuthURLRequest) Reset() {
 	*x = OAuthAuthURLRequest{}
-	mi := &file_proto_auth_proto_msgTypes[15]
+	mi := &file_proto_auth_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -956,7 +1508,7 @@ func (x *OAuthAuthURLRequest) String() string {
 func (*OAuthAuthURLRequest) ProtoMessage() {}
 
 func (x *OAuthAuthURLRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[15]
+	mi := &file_proto_auth_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -969,7 +1521,7 @@ func (x *OAuthAuthURLRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OAuthAuthURLRequest.ProtoReflect.Descriptor instead.
 func (*OAuthAuthURLRequest) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{15}
+	return file_proto_auth_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *OAuthAuthURLRequest) GetProvider() string {
@@ -997,7 +1549,7 @@ type OAuthAuthURLResponse struct {
 
 func (x *OAuthAuthURLResponse) Reset() {
 	*x = OAuthAuthURLResponse{}
-	mi := &file_proto_auth_proto_msgTypes[16]
+	mi := &file_proto_auth_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1009,7 +1561,7 @@ func (x *OAuthAuthURLResponse) String() string {
 func (*OAuthAuthURLResponse) ProtoMessage() {}
 
 func (x *OAuthAuthURLResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[16]
+	mi := &file_proto_auth_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1022,7 +1574,7 @@ func (x *OAuthAuthURLResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OAuthAuthURLResponse.ProtoReflect.Descriptor instead.
 func (*OAuthAuthURLResponse) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{16}
+	return file_proto_auth_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *OAuthAuthURLResponse) GetSuccess() bool {
@@ -1057,7 +1609,7 @@ type OAuthCallbackRequest struct {
 
 func (x *OAuthCallbackRequest) Reset() {
 	*x = OAuthCallbackRequest{}
-	mi := &file_proto_auth_proto_msgTypes[17]
+	mi := &file_proto_auth_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1069,7 +1621,7 @@ func (x *OAuthCallbackRequest) String() string {
 func (*OAuthCallbackRequest) ProtoMessage() {}
 
 func (x *OAuthCallbackRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[17]
+	mi := &file_proto_auth_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1082,7 +1634,7 @@ func (x *OAuthCallbackRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OAuthCallbackRequest.ProtoReflect.Descriptor instead.
 func (*OAuthCallbackRequest) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{17}
+	return file_proto_auth_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *OAuthCallbackRequest) GetProvider() string {
@@ -1114,7 +1666,7 @@ type GetOAuthProvidersRequest struct {
 
 func (x *GetOAuthProvidersRequest) Reset() {
 	*x = GetOAuthProvidersRequest{}
-	mi := &file_proto_auth_proto_msgTypes[18]
+	mi := &file_proto_auth_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1126,7 +1678,7 @@ func (x *GetOAuthProvidersRequest) String() string {
 func (*GetOAuthProvidersRequest) ProtoMessage() {}
 
 func (x *GetOAuthProvidersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[18]
+	mi := &file_proto_auth_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1139,7 +1691,7 @@ func (x *GetOAuthProvidersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetOAuthProvidersRequest.ProtoReflect.Descriptor instead.
 func (*GetOAuthProvidersRequest) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{18}
+	return file_proto_auth_proto_rawDescGZIP(), []int{28}
 }
 
 type GetOAuthProvidersResponse struct {
@@ -1151,7 +1703,7 @@ type GetOAuthProvidersResponse struct {
 
 func (x *GetOAuthProvidersResponse) Reset() {
 	*x = GetOAuthProvidersResponse{}
-	mi := &file_proto_auth_proto_msgTypes[19]
+	mi := &file_proto_auth_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1163,7 +1715,7 @@ func (x *GetOAuthProvidersResponse) String() string {
 func (*GetOAuthProvidersResponse) ProtoMessage() {}
 
 func (x *GetOAuthProvidersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[19]
+	mi := &file_proto_auth_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1176,7 +1728,7 @@ func (x *GetOAuthProvidersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetOAuthProvidersResponse.ProtoReflect.Descriptor instead.
 func (*GetOAuthProvidersResponse) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{19}
+	return file_proto_auth_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *GetOAuthProvidersResponse) GetProviders() []*OAuthProvider {
@@ -1194,7 +1746,7 @@ type GetOAuthConfigRequest struct {
 
 func (x *GetOAuthConfigRequest) Reset() {
 	*x = GetOAuthConfigRequest{}
-	mi := &file_proto_auth_proto_msgTypes[20]
+	mi := &file_proto_auth_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1206,7 +1758,7 @@ func (x *GetOAuthConfigRequest) String() string {
 func (*GetOAuthConfigRequest) ProtoMessage() {}
 
 func (x *GetOAuthConfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[20]
+	mi := &file_proto_auth_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1219,7 +1771,7 @@ func (x *GetOAuthConfigRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetOAuthConfigRequest.ProtoReflect.Descriptor instead.
 func (*GetOAuthConfigRequest) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{20}
+	return file_proto_auth_proto_rawDescGZIP(), []int{30}
 }
 
 type GetOAuthConfigResponse struct {
@@ -1233,7 +1785,7 @@ type GetOAuthConfigResponse struct {
 
 func (x *GetOAuthConfigResponse) Reset() {
 	*x = GetOAuthConfigResponse{}
-	mi := &file_proto_auth_proto_msgTypes[21]
+	mi := &file_proto_auth_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1245,7 +1797,7 @@ func (x *GetOAuthConfigResponse) String() string {
 func (*GetOAuthConfigResponse) ProtoMessage() {}
 
 func (x *GetOAuthConfigResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[21]
+	mi := &file_proto_auth_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1258,7 +1810,7 @@ func (x *GetOAuthConfigResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetOAuthConfigResponse.ProtoReflect.Descriptor instead.
 func (*GetOAuthConfigResponse) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{21}
+	return file_proto_auth_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *GetOAuthConfigResponse) GetEnabled() bool {
@@ -1293,7 +1845,7 @@ type OAuthProvider struct {
 
 func (x *OAuthProvider) Reset() {
 	*x = OAuthProvider{}
-	mi := &file_proto_auth_proto_msgTypes[22]
+	mi := &file_proto_auth_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1305,7 +1857,7 @@ func (x *OAuthProvider) String() string {
 func (*OAuthProvider) ProtoMessage() {}
 
 func (x *OAuthProvider) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[22]
+	mi := &file_proto_auth_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1318,7 +1870,7 @@ func (x *OAuthProvider) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OAuthProvider.ProtoReflect.Descriptor instead.
 func (*OAuthProvider) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{22}
+	return file_proto_auth_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *OAuthProvider) GetName() string {
@@ -1352,7 +1904,7 @@ type GetUserGroupsRequest struct {
 
 func (x *GetUserGroupsRequest) Reset() {
 	*x = GetUserGroupsRequest{}
-	mi := &file_proto_auth_proto_msgTypes[23]
+	mi := &file_proto_auth_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1364,7 +1916,7 @@ func (x *GetUserGroupsRequest) String() string {
 func (*GetUserGroupsRequest) ProtoMessage() {}
 
 func (x *GetUserGroupsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[23]
+	mi := &file_proto_auth_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1377,7 +1929,7 @@ func (x *GetUserGroupsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserGroupsRequest.ProtoReflect.Descriptor instead.
 func (*GetUserGroupsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{23}
+	return file_proto_auth_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *GetUserGroupsRequest) GetUserId() string {
@@ -1396,7 +1948,7 @@ type GetUserGroupsResponse struct {
 
 func (x *GetUserGroupsResponse) Reset() {
 	*x = GetUserGroupsResponse{}
-	mi := &file_proto_auth_proto_msgTypes[24]
+	mi := &file_proto_auth_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1408,7 +1960,7 @@ func (x *GetUserGroupsResponse) String() string {
 func (*GetUserGroupsResponse) ProtoMessage() {}
 
 func (x *GetUserGroupsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[24]
+	mi := &file_proto_auth_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1421,7 +1973,7 @@ func (x *GetUserGroupsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserGroupsResponse.ProtoReflect.Descriptor instead.
 func (*GetUserGroupsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{24}
+	return file_proto_auth_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *GetUserGroupsResponse) GetGroups() []*UserGroup {
@@ -1445,7 +1997,7 @@ type UserGroup struct {
 
 func (x *UserGroup) Reset() {
 	*x = UserGroup{}
-	mi := &file_proto_auth_proto_msgTypes[25]
+	mi := &file_proto_auth_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1457,7 +2009,7 @@ func (x *UserGroup) String() string {
 func (*UserGroup) ProtoMessage() {}
 
 func (x *UserGroup) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[25]
+	mi := &file_proto_auth_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1470,7 +2022,7 @@ func (x *UserGroup) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserGroup.ProtoReflect.Descriptor instead.
 func (*UserGroup) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{25}
+	return file_proto_auth_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *UserGroup) GetId() string {
@@ -1525,7 +2077,7 @@ type SyncUserGroupsRequest struct {
 
 func (x *SyncUserGroupsRequest) Reset() {
 	*x = SyncUserGroupsRequest{}
-	mi := &file_proto_auth_proto_msgTypes[26]
+	mi := &file_proto_auth_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1537,7 +2089,7 @@ func (x *SyncUserGroupsRequest) String() string {
 func (*SyncUserGroupsRequest) ProtoMessage() {}
 
 func (x *SyncUserGroupsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[26]
+	mi := &file_proto_auth_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1550,7 +2102,7 @@ func (x *SyncUserGroupsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SyncUserGroupsRequest.ProtoReflect.Descriptor instead.
 func (*SyncUserGroupsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{26}
+	return file_proto_auth_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *SyncUserGroupsRequest) GetUserId() string {
@@ -1578,7 +2130,7 @@ type SyncUserGroupsResponse struct {
 
 func (x *SyncUserGroupsResponse) Reset() {
 	*x = SyncUserGroupsResponse{}
-	mi := &file_proto_auth_proto_msgTypes[27]
+	mi := &file_proto_auth_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1590,7 +2142,7 @@ func (x *SyncUserGroupsResponse) String() string {
 func (*SyncUserGroupsResponse) ProtoMessage() {}
 
 func (x *SyncUserGroupsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[27]
+	mi := &file_proto_auth_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1603,7 +2155,7 @@ func (x *SyncUserGroupsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SyncUserGroupsResponse.ProtoReflect.Descriptor instead.
 func (*SyncUserGroupsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{27}
+	return file_proto_auth_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *SyncUserGroupsResponse) GetSuccess() bool {
@@ -1637,7 +2189,7 @@ type GetUserSentryConfigRequest struct {
 
 func (x *GetUserSentryConfigRequest) Reset() {
 	*x = GetUserSentryConfigRequest{}
-	mi := &file_proto_auth_proto_msgTypes[28]
+	mi := &file_proto_auth_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1649,7 +2201,7 @@ func (x *GetUserSentryConfigRequest) String() string {
 func (*GetUserSentryConfigRequest) ProtoMessage() {}
 
 func (x *GetUserSentryConfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[28]
+	mi := &file_proto_auth_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1662,7 +2214,7 @@ func (x *GetUserSentryConfigRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserSentryConfigRequest.ProtoReflect.Descriptor instead.
 func (*GetUserSentryConfigRequest) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{28}
+	return file_proto_auth_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *GetUserSentryConfigRequest) GetUserId() string {
@@ -1683,7 +2235,7 @@ type GetUserSentryConfigResponse struct {
 
 func (x *GetUserSentryConfigResponse) Reset() {
 	*x = GetUserSentryConfigResponse{}
-	mi := &file_proto_auth_proto_msgTypes[29]
+	mi := &file_proto_auth_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1695,7 +2247,7 @@ func (x *GetUserSentryConfigResponse) String() string {
 func (*GetUserSentryConfigResponse) ProtoMessage() {}
 
 func (x *GetUserSentryConfigResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[29]
+	mi := &file_proto_auth_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1708,7 +2260,7 @@ func (x *GetUserSentryConfigResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserSentryConfigResponse.ProtoReflect.Descriptor instead.
 func (*GetUserSentryConfigResponse) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{29}
+	return file_proto_auth_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *GetUserSentryConfigResponse) GetSuccess() bool {
@@ -1744,7 +2296,7 @@ type SaveUserSentryConfigRequest struct {
 
 func (x *SaveUserSentryConfigRequest) Reset() {
 	*x = SaveUserSentryConfigRequest{}
-	mi := &file_proto_auth_proto_msgTypes[30]
+	mi := &file_proto_auth_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1756,7 +2308,7 @@ func (x *SaveUserSentryConfigRequest) String() string {
 func (*SaveUserSentryConfigRequest) ProtoMessage() {}
 
 func (x *SaveUserSentryConfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[30]
+	mi := &file_proto_auth_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1769,7 +2321,7 @@ func (x *SaveUserSentryConfigRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SaveUserSentryConfigRequest.ProtoReflect.Descriptor instead.
 func (*SaveUserSentryConfigRequest) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{30}
+	return file_proto_auth_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *SaveUserSentryConfigRequest) GetUserId() string {
@@ -1810,7 +2362,7 @@ type SaveUserSentryConfigResponse struct {
 
 func (x *SaveUserSentryConfigResponse) Reset() {
 	*x = SaveUserSentryConfigResponse{}
-	mi := &file_proto_auth_proto_msgTypes[31]
+	mi := &file_proto_auth_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1822,7 +2374,7 @@ func (x *SaveUserSentryConfigResponse) String() string {
 func (*SaveUserSentryConfigResponse) ProtoMessage() {}
 
 func (x *SaveUserSentryConfigResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[31]
+	mi := &file_proto_auth_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1835,7 +2387,7 @@ func (x *SaveUserSentryConfigResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SaveUserSentryConfigResponse.ProtoReflect.Descriptor instead.
 func (*SaveUserSentryConfigResponse) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{31}
+	return file_proto_auth_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *SaveUserSentryConfigResponse) GetSuccess() bool {
@@ -1862,7 +2414,7 @@ type DeleteUserSentryConfigRequest struct {
 
 func (x *DeleteUserSentryConfigRequest) Reset() {
 	*x = DeleteUserSentryConfigRequest{}
-	mi := &file_proto_auth_proto_msgTypes[32]
+	mi := &file_proto_auth_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1874,7 +2426,7 @@ func (x *DeleteUserSentryConfigRequest) String() string {
 func (*DeleteUserSentryConfigRequest) ProtoMessage() {}
 
 func (x *DeleteUserSentryConfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[32]
+	mi := &file_proto_auth_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1887,7 +2439,7 @@ func (x *DeleteUserSentryConfigRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteUserSentryConfigRequest.ProtoReflect.Descriptor instead.
 func (*DeleteUserSentryConfigRequest) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{32}
+	return file_proto_auth_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *DeleteUserSentryConfigRequest) GetUserId() string {
@@ -1914,7 +2466,7 @@ type DeleteUserSentryConfigResponse struct {
 
 func (x *DeleteUserSentryConfigResponse) Reset() {
 	*x = DeleteUserSentryConfigResponse{}
-	mi := &file_proto_auth_proto_msgTypes[33]
+	mi := &file_proto_auth_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1926,7 +2478,7 @@ func (x *DeleteUserSentryConfigResponse) String() string {
 func (*DeleteUserSentryConfigResponse) ProtoMessage() {}
 
 func (x *DeleteUserSentryConfigResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[33]
+	mi := &file_proto_auth_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1939,7 +2491,7 @@ func (x *DeleteUserSentryConfigResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteUserSentryConfigResponse.ProtoReflect.Descriptor instead.
 func (*DeleteUserSentryConfigResponse) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{33}
+	return file_proto_auth_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *DeleteUserSentryConfigResponse) GetSuccess() bool {
@@ -1966,7 +2518,7 @@ type GetUserSentryTokenRequest struct {
 
 func (x *GetUserSentryTokenRequest) Reset() {
 	*x = GetUserSentryTokenRequest{}
-	mi := &file_proto_auth_proto_msgTypes[34]
+	mi := &file_proto_auth_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1978,7 +2530,7 @@ func (x *GetUserSentryTokenRequest) String() string {
 func (*GetUserSentryTokenRequest) ProtoMessage() {}
 
 func (x *GetUserSentryTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[34]
+	mi := &file_proto_auth_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1991,7 +2543,7 @@ func (x *GetUserSentryTokenRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserSentryTokenRequest.ProtoReflect.Descriptor instead.
 func (*GetUserSentryTokenRequest) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{34}
+	return file_proto_auth_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *GetUserSentryTokenRequest) GetUserId() string {
@@ -2020,7 +2572,7 @@ type GetUserSentryTokenResponse struct {
 
 func (x *GetUserSentryTokenResponse) Reset() {
 	*x = GetUserSentryTokenResponse{}
-	mi := &file_proto_auth_proto_msgTypes[35]
+	mi := &file_proto_auth_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2032,7 +2584,7 @@ func (x *GetUserSentryTokenResponse) String() string {
 func (*GetUserSentryTokenResponse) ProtoMessage() {}
 
 func (x *GetUserSentryTokenResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[35]
+	mi := &file_proto_auth_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2045,7 +2597,7 @@ func (x *GetUserSentryTokenResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserSentryTokenResponse.ProtoReflect.Descriptor instead.
 func (*GetUserSentryTokenResponse) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{35}
+	return file_proto_auth_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *GetUserSentryTokenResponse) GetSuccess() bool {
@@ -2088,7 +2640,7 @@ type UserSentryConfig struct {
 
 func (x *UserSentryConfig) Reset() {
 	*x = UserSentryConfig{}
-	mi := &file_proto_auth_proto_msgTypes[36]
+	mi := &file_proto_auth_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2100,7 +2652,7 @@ func (x *UserSentryConfig) String() string {
 func (*UserSentryConfig) ProtoMessage() {}
 
 func (x *UserSentryConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[36]
+	mi := &file_proto_auth_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2113,7 +2665,7 @@ func (x *UserSentryConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserSentryConfig.ProtoReflect.Descriptor instead.
 func (*UserSentryConfig) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{36}
+	return file_proto_auth_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *UserSentryConfig) GetUserId() string {
@@ -2154,7 +2706,7 @@ type GetConnectedUsersRequest struct {
 
 func (x *GetConnectedUsersRequest) Reset() {
 	*x = GetConnectedUsersRequest{}
-	mi := &file_proto_auth_proto_msgTypes[37]
+	mi := &file_proto_auth_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2166,7 +2718,7 @@ func (x *GetConnectedUsersRequest) String() string {
 func (*GetConnectedUsersRequest) ProtoMessage() {}
 
 func (x *GetConnectedUsersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[37]
+	mi := &file_proto_auth_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2179,7 +2731,7 @@ func (x *GetConnectedUsersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetConnectedUsersRequest.ProtoReflect.Descriptor instead.
 func (*GetConnectedUsersRequest) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{37}
+	return file_proto_auth_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *GetConnectedUsersRequest) GetSessionId() string {
@@ -2201,7 +2753,7 @@ type GetConnectedUsersResponse struct {
 
 func (x *GetConnectedUsersResponse) Reset() {
 	*x = GetConnectedUsersResponse{}
-	mi := &file_proto_auth_proto_msgTypes[38]
+	mi := &file_proto_auth_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2213,7 +2765,7 @@ func (x *GetConnectedUsersResponse) String() string {
 func (*GetConnectedUsersResponse) ProtoMessage() {}
 
 func (x *GetConnectedUsersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[38]
+	mi := &file_proto_auth_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2226,7 +2778,7 @@ func (x *GetConnectedUsersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetConnectedUsersResponse.ProtoReflect.Descriptor instead.
 func (*GetConnectedUsersResponse) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{38}
+	return file_proto_auth_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *GetConnectedUsersResponse) GetSuccess() bool {
@@ -2270,7 +2822,7 @@ type ConnectedUser struct {
 
 func (x *ConnectedUser) Reset() {
 	*x = ConnectedUser{}
-	mi := &file_proto_auth_proto_msgTypes[39]
+	mi := &file_proto_auth_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2282,7 +2834,7 @@ func (x *ConnectedUser) String() string {
 func (*ConnectedUser) ProtoMessage() {}
 
 func (x *ConnectedUser) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[39]
+	mi := &file_proto_auth_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2295,7 +2847,7 @@ func (x *ConnectedUser) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConnectedUser.ProtoReflect.Descriptor instead.
 func (*ConnectedUser) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{39}
+	return file_proto_auth_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *ConnectedUser) GetUserId() string {
@@ -2369,17 +2921,48 @@ const file_proto_auth_proto_rawDesc = "" +
 	"\amessage\x18\x02 \x01(\tR\amessage\"7\n" +
 	"\x16ValidateSessionRequest\x12\x1d\n" +
 	"\n" +
-	"session_id\x18\x01 \x01(\tR\tsessionId\"u\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\"\xb0\x01\n" +
 	"\x17ValidateSessionResponse\x12\x14\n" +
 	"\x05valid\x18\x01 \x01(\bR\x05valid\x12*\n" +
 	"\x04user\x18\x02 \x01(\v2\x16.notificator.auth.UserR\x04user\x12\x18\n" +
-	"\amessage\x18\x03 \x01(\tR\amessage\"2\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x129\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"6\n" +
+	"\x15RefreshSessionRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\"\x87\x01\n" +
+	"\x16RefreshSessionResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x129\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"|\n" +
+	"\x15ChangePasswordRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12!\n" +
+	"\fold_password\x18\x02 \x01(\tR\voldPassword\x12!\n" +
+	"\fnew_password\x18\x03 \x01(\tR\vnewPassword\"L\n" +
+	"\x16ChangePasswordResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"5\n" +
+	"\x14DeleteAccountRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\"K\n" +
+	"\x15DeleteAccountResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"6\n" +
+	"\x15ExportUserDataRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\"`\n" +
+	"\x16ExportUserDataResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x12\n" +
+	"\x04data\x18\x03 \x01(\fR\x04data\"2\n" +
 	"\x11GetProfileRequest\x12\x1d\n" +
 	"\n" +
 	"session_id\x18\x01 \x01(\tR\tsessionId\"e\n" +
 	"\x12GetProfileResponse\x12*\n" +
 	"\x04user\x18\x01 \x01(\v2\x16.notificator.auth.UserR\x04user\x12#\n" +
-	"\rcomment_count\x18\x02 \x01(\x03R\fcommentCount\"\x9c\x02\n" +
+	"\rcomment_count\x18\x02 \x01(\x03R\fcommentCount\"\xd3\x02\n" +
 	"\x04User\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
 	"\busername\x18\x02 \x01(\tR\busername\x12\x14\n" +
@@ -2390,7 +2973,10 @@ const file_proto_auth_proto_rawDesc = "" +
 	"last_login\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tlastLogin\x12%\n" +
 	"\x0eoauth_provider\x18\x06 \x01(\tR\roauthProvider\x12\x19\n" +
 	"\boauth_id\x18\a \x01(\tR\aoauthId\x12\x1a\n" +
-	"\btimezone\x18\b \x01(\tR\btimezone\"@\n" +
+	"\btimezone\x18\b \x01(\tR\btimezone\x12\x19\n" +
+	"\bis_admin\x18\t \x01(\bR\aisAdmin\x12\x1a\n" +
+	"\bdisabled\x18\n" +
+	" \x01(\bR\bdisabled\"@\n" +
 	"\x12SearchUsersRequest\x12\x14\n" +
 	"\x05query\x18\x01 \x01(\tR\x05query\x12\x14\n" +
 	"\x05limit\x18\x02 \x01(\x05R\x05limit\"d\n" +
@@ -2408,7 +2994,15 @@ const file_proto_auth_proto_rawDesc = "" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12,\n" +
 	"\x05users\x18\x03 \x03(\v2\x16.notificator.auth.UserR\x05users\x12\x1f\n" +
 	"\vtotal_count\x18\x04 \x01(\x05R\n" +
-	"totalCount\"G\n" +
+	"totalCount\"l\n" +
+	"\x16SetUserDisabledRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1a\n" +
+	"\bdisabled\x18\x03 \x01(\bR\bdisabled\"M\n" +
+	"\x17SetUserDisabledResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"G\n" +
 	"\x13OAuthAuthURLRequest\x12\x1a\n" +
 	"\bprovider\x18\x01 \x01(\tR\bprovider\x12\x14\n" +
 	"\x05state\x18\x02 \x01(\tR\x05state\"a\n" +
@@ -2502,16 +3096,21 @@ const file_proto_auth_proto_rawDesc = "" +
 	"\busername\x18\x02 \x01(\tR\busername\x12\x14\n" +
 	"\x05email\x18\x03 \x01(\tR\x05email\x12#\n" +
 	"\rsession_count\x18\x04 \x01(\x05R\fsessionCount\x12?\n" +
-	"\rlast_activity\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\flastActivity2\x87\x0e\n" +
+	"\rlast_activity\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\flastActivity2\x80\x12\n" +
 	"\vAuthService\x12Q\n" +
 	"\bRegister\x12!.notificator.auth.RegisterRequest\x1a\".notificator.auth.RegisterResponse\x12H\n" +
 	"\x05Login\x12\x1e.notificator.auth.LoginRequest\x1a\x1f.notificator.auth.LoginResponse\x12K\n" +
 	"\x06Logout\x12\x1f.notificator.auth.LogoutRequest\x1a .notificator.auth.LogoutResponse\x12f\n" +
-	"\x0fValidateSession\x12(.notificator.auth.ValidateSessionRequest\x1a).notificator.auth.ValidateSessionResponse\x12W\n" +
+	"\x0fValidateSession\x12(.notificator.auth.ValidateSessionRequest\x1a).notificator.auth.ValidateSessionResponse\x12c\n" +
+	"\x0eRefreshSession\x12'.notificator.auth.RefreshSessionRequest\x1a(.notificator.auth.RefreshSessionResponse\x12c\n" +
+	"\x0eChangePassword\x12'.notificator.auth.ChangePasswordRequest\x1a(.notificator.auth.ChangePasswordResponse\x12`\n" +
+	"\rDeleteAccount\x12&.notificator.auth.DeleteAccountRequest\x1a'.notificator.auth.DeleteAccountResponse\x12c\n" +
+	"\x0eExportUserData\x12'.notificator.auth.ExportUserDataRequest\x1a(.notificator.auth.ExportUserDataResponse\x12W\n" +
 	"\n" +
 	"GetProfile\x12#.notificator.auth.GetProfileRequest\x1a$.notificator.auth.GetProfileResponse\x12Z\n" +
 	"\vSearchUsers\x12$.notificator.auth.SearchUsersRequest\x1a%.notificator.auth.SearchUsersResponse\x12T\n" +
-	"\tListUsers\x12\".notificator.auth.ListUsersRequest\x1a#.notificator.auth.ListUsersResponse\x12`\n" +
+	"\tListUsers\x12\".notificator.auth.ListUsersRequest\x1a#.notificator.auth.ListUsersResponse\x12f\n" +
+	"\x0fSetUserDisabled\x12(.notificator.auth.SetUserDisabledRequest\x1a).notificator.auth.SetUserDisabledResponse\x12`\n" +
 	"\x0fGetOAuthAuthURL\x12%.notificator.auth.OAuthAuthURLRequest\x1a&.notificator.auth.OAuthAuthURLResponse\x12X\n" +
 	"\rOAuthCallback\x12&.notificator.auth.OAuthCallbackRequest\x1a\x1f.notificator.auth.LoginResponse\x12l\n" +
 	"\x11GetOAuthProviders\x12*.notificator.auth.GetOAuthProvidersRequest\x1a+.notificator.auth.GetOAuthProvidersResponse\x12c\n" +
@@ -2536,7 +3135,7 @@ func file_proto_auth_proto_rawDescGZIP() []byte {
 	return file_proto_auth_proto_rawDescData
 }
 
-var file_proto_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 40)
+var file_proto_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 50)
 var file_proto_auth_proto_goTypes = []any{
 	(*RegisterRequest)(nil),                // 0: notificator.auth.RegisterRequest
 	(*RegisterResponse)(nil),               // 1: notificator.auth.RegisterResponse
@@ -2546,98 +3145,120 @@ var file_proto_auth_proto_goTypes = []any{
 	(*LogoutResponse)(nil),                 // 5: notificator.auth.LogoutResponse
 	(*ValidateSessionRequest)(nil),         // 6: notificator.auth.ValidateSessionRequest
 	(*ValidateSessionResponse)(nil),        // 7: notificator.auth.ValidateSessionResponse
-	(*GetProfileRequest)(nil),              // 8: notificator.auth.GetProfileRequest
-	(*GetProfileResponse)(nil),             // 9: notificator.auth.GetProfileResponse
-	(*User)(nil),                           // 10: notificator.auth.User
-	(*SearchUsersRequest)(nil),             // 11: notificator.auth.SearchUsersRequest
-	(*SearchUsersResponse)(nil),            // 12: notificator.auth.SearchUsersResponse
-	(*ListUsersRequest)(nil),               // 13: notificator.auth.ListUsersRequest
-	(*ListUsersResponse)(nil),              // 14: notificator.auth.ListUsersResponse
-	(*OAuthAuthURLRequest)(nil),            // 15: notificator.auth.OAuthAuthURLRequest
-	(*OAuthAuthURLResponse)(nil),           // 16: notificator.auth.OAuthAuthURLResponse
-	(*OAuthCallbackRequest)(nil),           // 17: notificator.auth.OAuthCallbackRequest
-	(*GetOAuthProvidersRequest)(nil),       // 18: notificator.auth.GetOAuthProvidersRequest
-	(*GetOAuthProvidersResponse)(nil),      // 19: notificator.auth.GetOAuthProvidersResponse
-	(*GetOAuthConfigRequest)(nil),          // 20: notificator.auth.GetOAuthConfigRequest
-	(*GetOAuthConfigResponse)(nil),         // 21: notificator.auth.GetOAuthConfigResponse
-	(*OAuthProvider)(nil),                  // 22: notificator.auth.OAuthProvider
-	(*GetUserGroupsRequest)(nil),           // 23: notificator.auth.GetUserGroupsRequest
-	(*GetUserGroupsResponse)(nil),          // 24: notificator.auth.GetUserGroupsResponse
-	(*UserGroup)(nil),                      // 25: notificator.auth.UserGroup
-	(*SyncUserGroupsRequest)(nil),          // 26: notificator.auth.SyncUserGroupsRequest
-	(*SyncUserGroupsResponse)(nil),         // 27: notificator.auth.SyncUserGroupsResponse
-	(*GetUserSentryConfigRequest)(nil),     // 28: notificator.auth.GetUserSentryConfigRequest
-	(*GetUserSentryConfigResponse)(nil),    // 29: notificator.auth.GetUserSentryConfigResponse
-	(*SaveUserSentryConfigRequest)(nil),    // 30: notificator.auth.SaveUserSentryConfigRequest
-	(*SaveUserSentryConfigResponse)(nil),   // 31: notificator.auth.SaveUserSentryConfigResponse
-	(*DeleteUserSentryConfigRequest)(nil),  // 32: notificator.auth.DeleteUserSentryConfigRequest
-	(*DeleteUserSentryConfigResponse)(nil), // 33: notificator.auth.DeleteUserSentryConfigResponse
-	(*GetUserSentryTokenRequest)(nil),      // 34: notificator.auth.GetUserSentryTokenRequest
-	(*GetUserSentryTokenResponse)(nil),     // 35: notificator.auth.GetUserSentryTokenResponse
-	(*UserSentryConfig)(nil),               // 36: notificator.auth.UserSentryConfig
-	(*GetConnectedUsersRequest)(nil),       // 37: notificator.auth.GetConnectedUsersRequest
-	(*GetConnectedUsersResponse)(nil),      // 38: notificator.auth.GetConnectedUsersResponse
-	(*ConnectedUser)(nil),                  // 39: notificator.auth.ConnectedUser
-	(*timestamppb.Timestamp)(nil),          // 40: google.protobuf.Timestamp
+	(*RefreshSessionRequest)(nil),          // 8: notificator.auth.RefreshSessionRequest
+	(*RefreshSessionResponse)(nil),         // 9: notificator.auth.RefreshSessionResponse
+	(*ChangePasswordRequest)(nil),          // 10: notificator.auth.ChangePasswordRequest
+	(*ChangePasswordResponse)(nil),         // 11: notificator.auth.ChangePasswordResponse
+	(*DeleteAccountRequest)(nil),           // 12: notificator.auth.DeleteAccountRequest
+	(*DeleteAccountResponse)(nil),          // 13: notificator.auth.DeleteAccountResponse
+	(*ExportUserDataRequest)(nil),          // 14: notificator.auth.ExportUserDataRequest
+	(*ExportUserDataResponse)(nil),         // 15: notificator.auth.ExportUserDataResponse
+	(*GetProfileRequest)(nil),              // 16: notificator.auth.GetProfileRequest
+	(*GetProfileResponse)(nil),             // 17: notificator.auth.GetProfileResponse
+	(*User)(nil),                           // 18: notificator.auth.User
+	(*SearchUsersRequest)(nil),             // 19: notificator.auth.SearchUsersRequest
+	(*SearchUsersResponse)(nil),            // 20: notificator.auth.SearchUsersResponse
+	(*ListUsersRequest)(nil),               // 21: notificator.auth.ListUsersRequest
+	(*ListUsersResponse)(nil),              // 22: notificator.auth.ListUsersResponse
+	(*SetUserDisabledRequest)(nil),         // 23: notificator.auth.SetUserDisabledRequest
+	(*SetUserDisabledResponse)(nil),        // 24: notificator.auth.SetUserDisabledResponse
+	(*OAuthAuthURLRequest)(nil),            // 25: notificator.auth.OAuthAuthURLRequest
+	(*OAuthAuthURLResponse)(nil),           // 26: notificator.auth.OAuthAuthURLResponse
+	(*OAuthCallbackRequest)(nil),           // 27: notificator.auth.OAuthCallbackRequest
+	(*GetOAuthProvidersRequest)(nil),       // 28: notificator.auth.GetOAuthProvidersRequest
+	(*GetOAuthProvidersResponse)(nil),      // 29: notificator.auth.GetOAuthProvidersResponse
+	(*GetOAuthConfigRequest)(nil),          // 30: notificator.auth.GetOAuthConfigRequest
+	(*GetOAuthConfigResponse)(nil),         // 31: notificator.auth.GetOAuthConfigResponse
+	(*OAuthProvider)(nil),                  // 32: notificator.auth.OAuthProvider
+	(*GetUserGroupsRequest)(nil),           // 33: notificator.auth.GetUserGroupsRequest
+	(*GetUserGroupsResponse)(nil),          // 34: notificator.auth.GetUserGroupsResponse
+	(*UserGroup)(nil),                      // 35: notificator.auth.UserGroup
+	(*SyncUserGroupsRequest)(nil),          // 36: notificator.auth.SyncUserGroupsRequest
+	(*SyncUserGroupsResponse)(nil),         // 37: notificator.auth.SyncUserGroupsResponse
+	(*GetUserSentryConfigRequest)(nil),     // 38: notificator.auth.GetUserSentryConfigRequest
+	(*GetUserSentryConfigResponse)(nil),    // 39: notificator.auth.GetUserSentryConfigResponse
+	(*SaveUserSentryConfigRequest)(nil),    // 40: notificator.auth.SaveUserSentryConfigRequest
+	(*SaveUserSentryConfigResponse)(nil),   // 41: notificator.auth.SaveUserSentryConfigResponse
+	(*DeleteUserSentryConfigRequest)(nil),  // 42: notificator.auth.DeleteUserSentryConfigRequest
+	(*DeleteUserSentryConfigResponse)(nil), // 43: notificator.auth.DeleteUserSentryConfigResponse
+	(*GetUserSentryTokenRequest)(nil),      // 44: notificator.auth.GetUserSentryTokenRequest
+	(*GetUserSentryTokenResponse)(nil),     // 45: notificator.auth.GetUserSentryTokenResponse
+	(*UserSentryConfig)(nil),               // 46: notificator.auth.UserSentryConfig
+	(*GetConnectedUsersRequest)(nil),       // 47: notificator.auth.GetConnectedUsersRequest
+	(*GetConnectedUsersResponse)(nil),      // 48: notificator.auth.GetConnectedUsersResponse
+	(*ConnectedUser)(nil),                  // 49: notificator.auth.ConnectedUser
+	(*timestamppb.Timestamp)(nil),          // 50: google.protobuf.Timestamp
 }
 var file_proto_auth_proto_depIdxs = []int32{
-	10, // 0: notificator.auth.LoginResponse.user:type_name -> notificator.auth.User
-	40, // 1: notificator.auth.LoginResponse.expires_at:type_name -> google.protobuf.Timestamp
-	10, // 2: notificator.auth.ValidateSessionResponse.user:type_name -> notificator.auth.User
-	10, // 3: notificator.auth.GetProfileResponse.user:type_name -> notificator.auth.User
-	40, // 4: notificator.auth.User.created_at:type_name -> google.protobuf.Timestamp
-	40, // 5: notificator.auth.User.last_login:type_name -> google.protobuf.Timestamp
-	10, // 6: notificator.auth.SearchUsersResponse.users:type_name -> notificator.auth.User
-	10, // 7: notificator.auth.ListUsersResponse.users:type_name -> notificator.auth.User
-	22, // 8: notificator.auth.GetOAuthProvidersResponse.providers:type_name -> notificator.auth.OAuthProvider
-	22, // 9: notificator.auth.GetOAuthConfigResponse.providers:type_name -> notificator.auth.OAuthProvider
-	25, // 10: notificator.auth.GetUserGroupsResponse.groups:type_name -> notificator.auth.UserGroup
-	36, // 11: notificator.auth.GetUserSentryConfigResponse.config:type_name -> notificator.auth.UserSentryConfig
-	40, // 12: notificator.auth.UserSentryConfig.created_at:type_name -> google.protobuf.Timestamp
-	40, // 13: notificator.auth.UserSentryConfig.updated_at:type_name -> google.protobuf.Timestamp
-	39, // 14: notificator.auth.GetConnectedUsersResponse.users:type_name -> notificator.auth.ConnectedUser
-	40, // 15: notificator.auth.ConnectedUser.last_activity:type_name -> google.protobuf.Timestamp
-	0,  // 16: notificator.auth.AuthService.Register:input_type -> notificator.auth.RegisterRequest
-	2,  // 17: notificator.auth.AuthService.Login:input_type -> notificator.auth.LoginRequest
-	4,  // 18: notificator.auth.AuthService.Logout:input_type -> notificator.auth.LogoutRequest
-	6,  // 19: notificator.auth.AuthService.ValidateSession:input_type -> notificator.auth.ValidateSessionRequest
-	8,  // 20: notificator.auth.AuthService.GetProfile:input_type -> notificator.auth.GetProfileRequest
-	11, // 21: notificator.auth.AuthService.SearchUsers:input_type -> notificator.auth.SearchUsersRequest
-	13, // 22: notificator.auth.AuthService.ListUsers:input_type -> notificator.auth.ListUsersRequest
-	15, // 23: notificator.auth.AuthService.GetOAuthAuthURL:input_type -> notificator.auth.OAuthAuthURLRequest
-	17, // 24: notificator.auth.AuthService.OAuthCallback:input_type -> notificator.auth.OAuthCallbackRequest
-	18, // 25: notificator.auth.AuthService.GetOAuthProviders:input_type -> notificator.auth.GetOAuthProvidersRequest
-	20, // 26: notificator.auth.AuthService.GetOAuthConfig:input_type -> notificator.auth.GetOAuthConfigRequest
-	23, // 27: notificator.auth.AuthService.GetUserGroups:input_type -> notificator.auth.GetUserGroupsRequest
-	26, // 28: notificator.auth.AuthService.SyncUserGroups:input_type -> notificator.auth.SyncUserGroupsRequest
-	28, // 29: notificator.auth.AuthService.GetUserSentryConfig:input_type -> notificator.auth.GetUserSentryConfigRequest
-	34, // 30: notificator.auth.AuthService.GetUserSentryToken:input_type -> notificator.auth.GetUserSentryTokenRequest
-	30, // 31: notificator.auth.AuthService.SaveUserSentryConfig:input_type -> notificator.auth.SaveUserSentryConfigRequest
-	32, // 32: notificator.auth.AuthService.DeleteUserSentryConfig:input_type -> notificator.auth.DeleteUserSentryConfigRequest
-	37, // 33: notificator.auth.AuthService.GetConnectedUsers:input_type -> notificator.auth.GetConnectedUsersRequest
-	1,  // 34: notificator.auth.AuthService.Register:output_type -> notificator.auth.RegisterResponse
-	3,  // 35: notificator.auth.AuthService.Login:output_type -> notificator.auth.LoginResponse
-	5,  // 36: notificator.auth.AuthService.Logout:output_type -> notificator.auth.LogoutResponse
-	7,  // 37: notificator.auth.AuthService.ValidateSession:output_type -> notificator.auth.ValidateSessionResponse
-	9,  // 38: notificator.auth.AuthService.GetProfile:output_type -> notificator.auth.GetProfileResponse
-	12, // 39: notificator.auth.AuthService.SearchUsers:output_type -> notificator.auth.SearchUsersResponse
-	14, // 40: notificator.auth.AuthService.ListUsers:output_type -> notificator.auth.ListUsersResponse
-	16, // 41: notificator.auth.AuthService.GetOAuthAuthURL:output_type -> notificator.auth.OAuthAuthURLResponse
-	3,  // 42: notificator.auth.AuthService.OAuthCallback:output_type -> notificator.auth.LoginResponse
-	19, // 43: notificator.auth.AuthService.GetOAuthProviders:output_type -> notificator.auth.GetOAuthProvidersResponse
-	21, // 44: notificator.auth.AuthService.GetOAuthConfig:output_type -> notificator.auth.GetOAuthConfigResponse
-	24, // 45: notificator.auth.AuthService.GetUserGroups:output_type -> notificator.auth.GetUserGroupsResponse
-	27, // 46: notificator.auth.AuthService.SyncUserGroups:output_type -> notificator.auth.SyncUserGroupsResponse
-	29, // 47: notificator.auth.AuthService.GetUserSentryConfig:output_type -> notificator.auth.GetUserSentryConfigResponse
-	35, // 48: notificator.auth.AuthService.GetUserSentryToken:output_type -> notificator.auth.GetUserSentryTokenResponse
-	31, // 49: notificator.auth.AuthService.SaveUserSentryConfig:output_type -> notificator.auth.SaveUserSentryConfigResponse
-	33, // 50: notificator.auth.AuthService.DeleteUserSentryConfig:output_type -> notificator.auth.DeleteUserSentryConfigResponse
-	38, // 51: notificator.auth.AuthService.GetConnectedUsers:output_type -> notificator.auth.GetConnectedUsersResponse
-	34, // [34:52] is the sub-list for method output_type
-	16, // [16:34] is the sub-list for method input_type
-	16, // [16:16] is the sub-list for extension type_name
-	16, // [16:16] is the sub-list for extension extendee
-	0,  // [0:16] is the sub-list for field type_name
+	18, // 0: notificator.auth.LoginResponse.user:type_name -> notificator.auth.User
+	50, // 1: notificator.auth.LoginResponse.expires_at:type_name -> google.protobuf.Timestamp
+	18, // 2: notificator.auth.ValidateSessionResponse.user:type_name -> notificator.auth.User
+	50, // 3: notificator.auth.ValidateSessionResponse.expires_at:type_name -> google.protobuf.Timestamp
+	50, // 4: notificator.auth.RefreshSessionResponse.expires_at:type_name -> google.protobuf.Timestamp
+	18, // 5: notificator.auth.GetProfileResponse.user:type_name -> notificator.auth.User
+	50, // 6: notificator.auth.User.created_at:type_name -> google.protobuf.Timestamp
+	50, // 7: notificator.auth.User.last_login:type_name -> google.protobuf.Timestamp
+	18, // 8: notificator.auth.SearchUsersResponse.users:type_name -> notificator.auth.User
+	18, // 9: notificator.auth.ListUsersResponse.users:type_name -> notificator.auth.User
+	32, // 10: notificator.auth.GetOAuthProvidersResponse.providers:type_name -> notificator.auth.OAuthProvider
+	32, // 11: notificator.auth.GetOAuthConfigResponse.providers:type_name -> notificator.auth.OAuthProvider
+	35, // 12: notificator.auth.GetUserGroupsResponse.groups:type_name -> notificator.auth.UserGroup
+	46, // 13: notificator.auth.GetUserSentryConfigResponse.config:type_name -> notificator.auth.UserSentryConfig
+	50, // 14: notificator.auth.UserSentryConfig.created_at:type_name -> google.protobuf.Timestamp
+	50, // 15: notificator.auth.UserSentryConfig.updated_at:type_name -> google.protobuf.Timestamp
+	49, // 16: notificator.auth.GetConnectedUsersResponse.users:type_name -> notificator.auth.ConnectedUser
+	50, // 17: notificator.auth.ConnectedUser.last_activity:type_name -> google.protobuf.Timestamp
+	0,  // 18: notificator.auth.AuthService.Register:input_type -> notificator.auth.RegisterRequest
+	2,  // 19: notificator.auth.AuthService.Login:input_type -> notificator.auth.LoginRequest
+	4,  // 20: notificator.auth.AuthService.Logout:input_type -> notificator.auth.LogoutRequest
+	6,  // 21: notificator.auth.AuthService.ValidateSession:input_type -> notificator.auth.ValidateSessionRequest
+	8,  // 22: notificator.auth.AuthService.RefreshSession:input_type -> notificator.auth.RefreshSessionRequest
+	10, // 23: notificator.auth.AuthService.ChangePassword:input_type -> notificator.auth.ChangePasswordRequest
+	12, // 24: notificator.auth.AuthService.DeleteAccount:input_type -> notificator.auth.DeleteAccountRequest
+	14, // 25: notificator.auth.AuthService.ExportUserData:input_type -> notificator.auth.ExportUserDataRequest
+	16, // 26: notificator.auth.AuthService.GetProfile:input_type -> notificator.auth.GetProfileRequest
+	19, // 27: notificator.auth.AuthService.SearchUsers:input_type -> notificator.auth.SearchUsersRequest
+	21, // 28: notificator.auth.AuthService.ListUsers:input_type -> notificator.auth.ListUsersRequest
+	23, // 29: notificator.auth.AuthService.SetUserDisabled:input_type -> notificator.auth.SetUserDisabledRequest
+	25, // 30: notificator.auth.AuthService.GetOAuthAuthURL:input_type -> notificator.auth.OAuthAuthURLRequest
+	27, // 31: notificator.auth.AuthService.OAuthCallback:input_type -> notificator.auth.OAuthCallbackRequest
+	28, // 32: notificator.auth.AuthService.GetOAuthProviders:input_type -> notificator.auth.GetOAuthProvidersRequest
+	30, // 33: notificator.auth.AuthService.GetOAuthConfig:input_type -> notificator.auth.GetOAuthConfigRequest
+	33, // 34: notificator.auth.AuthService.GetUserGroups:input_type -> notificator.auth.GetUserGroupsRequest
+	36, // 35: notificator.auth.AuthService.SyncUserGroups:input_type -> notificator.auth.SyncUserGroupsRequest
+	38, // 36: notificator.auth.AuthService.GetUserSentryConfig:input_type -> notificator.auth.GetUserSentryConfigRequest
+	44, // 37: notificator.auth.AuthService.GetUserSentryToken:input_type -> notificator.auth.GetUserSentryTokenRequest
+	40, // 38: notificator.auth.AuthService.SaveUserSentryConfig:input_type -> notificator.auth.SaveUserSentryConfigRequest
+	42, // 39: notificator.auth.AuthService.DeleteUserSentryConfig:input_type -> notificator.auth.DeleteUserSentryConfigRequest
+	47, // 40: notificator.auth.AuthService.GetConnectedUsers:input_type -> notificator.auth.GetConnectedUsersRequest
+	1,  // 41: notificator.auth.AuthService.Register:output_type -> notificator.auth.RegisterResponse
+	3,  // 42: notificator.auth.AuthService.Login:output_type -> notificator.auth.LoginResponse
+	5,  // 43: notificator.auth.AuthService.Logout:output_type -> notificator.auth.LogoutResponse
+	7,  // 44: notificator.auth.AuthService.ValidateSession:output_type -> notificator.auth.ValidateSessionResponse
+	9,  // 45: notificator.auth.AuthService.RefreshSession:output_type -> notificator.auth.RefreshSessionResponse
+	11, // 46: notificator.auth.AuthService.ChangePassword:output_type -> notificator.auth.ChangePasswordResponse
+	13, // 47: notificator.auth.AuthService.DeleteAccount:output_type -> notificator.auth.DeleteAccountResponse
+	15, // 48: notificator.auth.AuthService.ExportUserData:output_type -> notificator.auth.ExportUserDataResponse
+	17, // 49: notificator.auth.AuthService.GetProfile:output_type -> notificator.auth.GetProfileResponse
+	20, // 50: notificator.auth.AuthService.SearchUsers:output_type -> notificator.auth.SearchUsersResponse
+	22, // 51: notificator.auth.AuthService.ListUsers:output_type -> notificator.auth.ListUsersResponse
+	24, // 52: notificator.auth.AuthService.SetUserDisabled:output_type -> notificator.auth.SetUserDisabledResponse
+	26, // 53: notificator.auth.AuthService.GetOAuthAuthURL:output_type -> notificator.auth.OAuthAuthURLResponse
+	3,  // 54: notificator.auth.AuthService.OAuthCallback:output_type -> notificator.auth.LoginResponse
+	29, // 55: notificator.auth.AuthService.GetOAuthProviders:output_type -> notificator.auth.GetOAuthProvidersResponse
+	31, // 56: notificator.auth.AuthService.GetOAuthConfig:output_type -> notificator.auth.GetOAuthConfigResponse
+	34, // 57: notificator.auth.AuthService.GetUserGroups:output_type -> notificator.auth.GetUserGroupsResponse
+	37, // 58: notificator.auth.AuthService.SyncUserGroups:output_type -> notificator.auth.SyncUserGroupsResponse
+	39, // 59: notificator.auth.AuthService.GetUserSentryConfig:output_type -> notificator.auth.GetUserSentryConfigResponse
+	45, // 60: notificator.auth.AuthService.GetUserSentryToken:output_type -> notificator.auth.GetUserSentryTokenResponse
+	41, // 61: notificator.auth.AuthService.SaveUserSentryConfig:output_type -> notificator.auth.SaveUserSentryConfigResponse
+	43, // 62: notificator.auth.AuthService.DeleteUserSentryConfig:output_type -> notificator.auth.DeleteUserSentryConfigResponse
+	48, // 63: notificator.auth.AuthService.GetConnectedUsers:output_type -> notificator.auth.GetConnectedUsersResponse
+	41, // [41:64] is the sub-list for method output_type
+	18, // [18:41] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
 }
 
 func init() { file_proto_auth_proto_init() }
@@ -2651,7 +3272,7 @@ func file_proto_auth_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_auth_proto_rawDesc), len(file_proto_auth_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   40,
+			NumMessages:   50,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
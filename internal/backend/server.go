@@ -2,17 +2,21 @@ package backend
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
 	"net"
 	"net/http"
 	"os"
 	"os/signal"
+	"sync"
+	"sync/atomic"
 	"syscall"
 	"time"
 
 	"github.com/spf13/viper"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/reflection"
 
 	"notificator/config"
@@ -27,14 +31,20 @@ type Server struct {
 	alertService      *services.AlertServiceGorm
 	statisticsService *services.StatisticsServiceGorm
 	oauthService      *services.OAuthService
+	loginLimiter      *services.LoginLimiter
+	trustedProxies    []*net.IPNet
 	statisticsWorker  *services.StatisticsWorkerPool
 	db                *database.GormDB
 	config            *config.Config
 	dbType            string
 	grpcServer        *grpc.Server
 	httpServer        *http.Server
-	cleanupTicker     *time.Ticker
 	cleanupDone       chan bool
+	stopCleanupOnce   sync.Once
+
+	// Rows deleted by the expiry cleanup since startup, reported by /metrics
+	purgedResolvedAlerts atomic.Int64
+	purgedSessions       atomic.Int64
 }
 
 func NewServer(cfg *config.Config, dbType string) *Server {
@@ -64,9 +74,10 @@ func (s *Server) Start() error {
 		return fmt.Errorf("failed to start HTTP server: %w", err)
 	}
 
-	s.startResolvedAlertCleanup()
+	s.startExpiryCleanup()
+	s.startAcknowledgmentExpiry()
 	s.startStatisticsCleanup()
-	s.startSessionCleanup()
+	s.startGroupSync()
 
 	shutdownChan := make(chan struct{})
 	s.setupGracefulShutdown(shutdownChan)
@@ -97,6 +108,10 @@ func (s *Server) initDatabase() error {
 			User:       "postgres",
 			Password:   "postgres",
 			SSLMode:    "disable",
+
+			MaxOpenConns:    s.config.Backend.Database.MaxOpenConns,
+			MaxIdleConns:    s.config.Backend.Database.MaxIdleConns,
+			ConnMaxLifetime: s.config.Backend.Database.ConnMaxLifetime,
 		}
 	}
 
@@ -124,7 +139,35 @@ func (s *Server) initServices() {
 	}
 
 	s.authService = services.NewAuthServiceGorm(s.db, s.oauthService)
+	if sessionDuration, err := s.config.Backend.GetSessionDuration(); err == nil {
+		s.authService.SetSessionDuration(sessionDuration)
+	} else {
+		log.Printf("⚠️  %v, keeping the default session duration of %s", err, config.DefaultSessionDuration)
+	}
+	if window, lockout, maxLockout, err := s.config.Backend.LoginRateLimit.GetDurations(); err == nil {
+		s.loginLimiter = services.NewLoginLimiter(s.config.Backend.LoginRateLimit.MaxAttempts, window, lockout, maxLockout)
+		s.authService.SetLoginLimiter(s.loginLimiter)
+	}
+	if trustedProxies, err := s.config.Backend.GetTrustedProxies(); err == nil {
+		s.trustedProxies = trustedProxies
+		s.authService.SetTrustedProxies(trustedProxies)
+	} else {
+		log.Printf("⚠️  %v, ignoring forwarded client addresses", err)
+	}
+	s.authService.SetAdminConfig(s.config.Admin)
 	s.alertService = services.NewAlertServiceGorm(s.db)
+	s.alertService.SetAdminConfig(s.config.Admin)
+	if heartbeatInterval, err := s.config.Backend.GetStreamHeartbeatInterval(); err == nil {
+		s.alertService.SetHeartbeatInterval(heartbeatInterval)
+	}
+	if maxCommentLength, err := s.config.Backend.GetMaxCommentLength(); err == nil {
+		s.alertService.SetMaxCommentLength(maxCommentLength)
+	}
+	s.alertService.SeedDefaultFilterPresets(s.config.Backend.DefaultFilterPresets)
+	if permissions := services.NewPermissionChecker(s.db, s.config.OAuth); permissions != nil {
+		s.alertService.SetPermissionChecker(permissions)
+		log.Printf("🔐 Group permissions enforced for alert actions")
+	}
 	s.statisticsService = services.NewStatisticsServiceGorm(s.db)
 
 	// Initialize statistics worker pool
@@ -151,7 +194,17 @@ func (s *Server) startGRPCServer() error {
 	}
 
 	opts := []grpc.ServerOption{
-		grpc.UnaryInterceptor(s.loggingUnaryInterceptor),
+		grpc.ChainUnaryInterceptor(s.loggingUnaryInterceptor, s.versionUnaryInterceptor),
+		grpc.StreamInterceptor(s.versionStreamInterceptor),
+	}
+
+	if s.config.Backend.TLSEnabled() {
+		creds, err := credentials.NewServerTLSFromFile(s.config.Backend.TLSCertFile, s.config.Backend.TLSKeyFile)
+		if err != nil {
+			lis.Close()
+			return fmt.Errorf("failed to load gRPC TLS certificate: %w", err)
+		}
+		opts = append(opts, grpc.Creds(creds))
 	}
 
 	s.grpcServer = grpc.NewServer(opts...)
@@ -162,7 +215,11 @@ func (s *Server) startGRPCServer() error {
 
 	reflection.Register(s.grpcServer)
 
-	log.Printf("🚀 gRPC server starting on %s", listenAddr)
+	if s.config.Backend.TLSEnabled() {
+		log.Printf("🚀 gRPC server starting on %s (TLS)", listenAddr)
+	} else {
+		log.Printf("🚀 gRPC server starting on %s", listenAddr)
+	}
 
 	go func() {
 		if err := s.grpcServer.Serve(lis); err != nil {
@@ -177,6 +234,7 @@ func (s *Server) startHTTPServer() error {
 	mux := http.NewServeMux()
 
 	mux.HandleFunc("/health", s.healthCheckHandler)
+	mux.HandleFunc("/ready", s.readinessHandler)
 	mux.HandleFunc("/metrics", s.metricsHandler)
 
 	httpAddr := s.config.Backend.HTTPListen
@@ -213,7 +271,7 @@ func (s *Server) setupGracefulShutdown(shutdownChan chan struct{}) {
 		<-c
 		log.Println("🛑 Shutting down servers...")
 
-		s.stopResolvedAlertCleanup()
+		s.stopCleanupJobs()
 
 		// Stop statistics worker pool first to finish queued jobs
 		if s.statisticsWorker != nil {
@@ -256,6 +314,8 @@ func (s *Server) RunMigrations() error {
 }
 
 func (s *Server) Close() error {
+	s.stopCleanupJobs()
+
 	if s.grpcServer != nil {
 		s.grpcServer.Stop()
 	}
@@ -286,57 +346,154 @@ func (s *Server) loggingUnaryInterceptor(ctx context.Context, req interface{}, i
 		status = "ERROR"
 	}
 
-	log.Printf("[gRPC] %s %s %v %s", info.FullMethod, status, duration, getClientIP(ctx))
+	log.Printf("[gRPC] %s %s %v %s", info.FullMethod, status, duration, s.getClientIP(ctx))
 
 	return resp, err
 }
 
-func (s *Server) startResolvedAlertCleanup() {
-	s.cleanupTicker = time.NewTicker(1 * time.Hour)
+// startExpiryCleanup starts the background sweep that purges expired
+// resolved alerts, sessions and snoozes every backend.cleanup_interval
+func (s *Server) startExpiryCleanup() {
+	interval, err := s.config.Backend.GetCleanupInterval()
+	if err != nil {
+		interval = config.DefaultCleanupInterval
+	}
 
-	log.Println("🧹 Starting resolved alert cleanup job (runs every hour)")
+	log.Printf("🧹 Starting expiry cleanup job (runs every %s)", interval)
 
 	go func() {
-		s.performResolvedAlertCleanup()
+		s.performExpiryCleanup()
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
 
 		for {
 			select {
-			case <-s.cleanupTicker.C:
-				s.performResolvedAlertCleanup()
+			case <-ticker.C:
+				s.performExpiryCleanup()
 			case <-s.cleanupDone:
-				log.Println("🛑 Stopping resolved alert cleanup job")
+				log.Println("🛑 Stopping expiry cleanup job")
 				return
 			}
 		}
 	}()
 }
 
-func (s *Server) performResolvedAlertCleanup() {
+// performExpiryCleanup deletes resolved alerts, sessions and snoozes whose
+// expires_at is in the past, rows removed by a "clear all" that can no longer
+// be undone, and stale login-attempt counters, and adds the deleted resolved alerts and sessions to the purge counters
+// reported by /metrics
+func (s *Server) performExpiryCleanup() {
+	s.loginLimiter.Cleanup()
+
 	if s.db == nil {
-		log.Println("⚠️  Database not initialized, skipping resolved alert cleanup")
+		log.Println("⚠️  Database not initialized, skipping expiry cleanup")
 		return
 	}
 
-	log.Println("🧹 Running resolved alert cleanup...")
-
-	deletedCount, err := s.db.CleanupExpiredResolvedAlerts()
-	if err != nil {
+	if deletedCount, err := s.db.CleanupExpiredResolvedAlerts(); err != nil {
 		log.Printf("❌ Error during resolved alert cleanup: %v", err)
-		return
+	} else {
+		s.purgedResolvedAlerts.Add(deletedCount)
+		log.Printf("✅ Cleaned up %d expired resolved alerts", deletedCount)
 	}
 
-	if deletedCount > 0 {
-		log.Printf("✅ Cleaned up %d expired resolved alerts", deletedCount)
+	if deletedCount, err := s.db.CleanupExpiredSessions(); err != nil {
+		log.Printf("❌ Error during expired session cleanup: %v", err)
+	} else {
+		s.purgedSessions.Add(deletedCount)
+		log.Printf("✅ Cleaned up %d expired sessions", deletedCount)
+	}
+
+	if deletedCount, err := s.db.CleanupExpiredSnoozes(); err != nil {
+		log.Printf("❌ Error during expired snooze cleanup: %v", err)
 	} else {
-		log.Println("✅ No expired resolved alerts to clean up")
+		log.Printf("✅ Cleaned up %d expired snoozes", deletedCount)
+	}
+
+	if deletedCount, err := s.db.PurgeClearedRows(); err != nil {
+		log.Printf("❌ Error during cleared rows purge: %v", err)
+	} else {
+		log.Printf("✅ Purged %d cleared resolved and hidden alerts", deletedCount)
 	}
 }
 
-func (s *Server) stopResolvedAlertCleanup() {
-	if s.cleanupTicker != nil {
-		s.cleanupTicker.Stop()
+// ackExpiryInterval is how often lapsed acknowledgments are looked for; it is
+// much shorter than the cleanup interval since acks can last minutes
+const ackExpiryInterval = time.Minute
+
+// startAcknowledgmentExpiry starts the background sweep that removes
+// acknowledgments whose expiry has passed
+func (s *Server) startAcknowledgmentExpiry() {
+	log.Printf("⏰ Starting acknowledgment expiry job (runs every %s)", ackExpiryInterval)
+
+	go func() {
+		ticker := time.NewTicker(ackExpiryInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				if expired, err := s.alertService.ExpireAcknowledgments(); err != nil {
+					log.Printf("❌ Error expiring acknowledgments: %v", err)
+				} else if expired > 0 {
+					log.Printf("✅ Expired %d acknowledgments", expired)
+				}
+			case <-s.cleanupDone:
+				log.Println("🛑 Stopping acknowledgment expiry job")
+				return
+			}
+		}
+	}()
+}
+
+// stopCleanupJobs stops every background cleanup job; it is safe to call more than once
+func (s *Server) stopCleanupJobs() {
+	s.stopCleanupOnce.Do(func() {
 		close(s.cleanupDone)
+	})
+}
+
+// startGroupSync starts the background job that refreshes OAuth users' groups
+// every oauth.group_sync.sync_interval, so IdP membership changes apply without
+// a new login
+func (s *Server) startGroupSync() {
+	if s.oauthService == nil {
+		return
+	}
+
+	groupSync := s.config.OAuth.GroupSync
+	if !groupSync.Enabled || groupSync.SyncInterval <= 0 {
+		log.Println("ℹ️  Periodic OAuth group sync disabled")
+		return
 	}
+
+	log.Printf("🔄 Starting OAuth group sync job (runs every %s)", groupSync.SyncInterval)
+
+	go func() {
+		ticker := time.NewTicker(groupSync.SyncInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				s.performGroupSync()
+			case <-s.cleanupDone:
+				log.Println("🛑 Stopping OAuth group sync job")
+				return
+			}
+		}
+	}()
+}
+
+// performGroupSync refreshes the groups of every OAuth user with a stored token
+func (s *Server) performGroupSync() {
+	synced, skipped, err := s.oauthService.SyncAllUserGroups()
+	if err != nil {
+		log.Printf("❌ Error during OAuth group sync: %v", err)
+		return
+	}
+	log.Printf("✅ Synced OAuth groups for %d users (%d skipped)", synced, skipped)
 }
 
 // startStatisticsCleanup starts a background job to clean up old alert statistics
@@ -393,43 +550,6 @@ func (s *Server) performStatisticsCleanup() {
 	}
 }
 
-// startSessionCleanup starts a background job to remove expired sessions
-func (s *Server) startSessionCleanup() {
-	log.Println("🧹 Starting expired session cleanup job (runs every hour)")
-
-	go func() {
-		s.performSessionCleanup()
-
-		ticker := time.NewTicker(1 * time.Hour)
-		defer ticker.Stop()
-
-		for {
-			select {
-			case <-ticker.C:
-				s.performSessionCleanup()
-			case <-s.cleanupDone:
-				log.Println("🛑 Stopping expired session cleanup job")
-				return
-			}
-		}
-	}()
-}
-
-// performSessionCleanup removes sessions whose expires_at is in the past
-func (s *Server) performSessionCleanup() {
-	if s.db == nil {
-		log.Println("⚠️  Database not initialized, skipping session cleanup")
-		return
-	}
-
-	if err := s.db.CleanupExpiredSessions(); err != nil {
-		log.Printf("❌ Error during expired session cleanup: %v", err)
-		return
-	}
-
-	log.Println("✅ Expired sessions cleaned up")
-}
-
 func (s *Server) healthCheckHandler(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json")
 
@@ -443,6 +563,20 @@ func (s *Server) healthCheckHandler(w http.ResponseWriter, r *http.Request) {
 	fmt.Fprint(w, `{"status":"healthy","database":"up"}`)
 }
 
+// readinessHandler answers 200 only when the database is reachable and
+// migrated, so Kubernetes can hold traffic without restarting the pod
+func (s *Server) readinessHandler(w http.ResponseWriter, r *http.Request) {
+	readiness := s.db.CheckReadiness()
+
+	w.Header().Set("Content-Type", "application/json")
+	if readiness.Ready() {
+		w.WriteHeader(http.StatusOK)
+	} else {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	json.NewEncoder(w).Encode(readiness)
+}
+
 func (s *Server) metricsHandler(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json")
 
@@ -460,12 +594,15 @@ func (s *Server) metricsHandler(w http.ResponseWriter, r *http.Request) {
 		"total_comments": %d,
 		"total_acknowledgments": %d,
 		"resolved_alerts": %d,
+		"purged_resolved_alerts": %d,
+		"purged_sessions": %d,
 		"timestamp": "%s"
-	}`, stats["users"], stats["active_sessions"], stats["comments"], stats["acknowledgments"], stats["resolved_alerts"], time.Now().Format(time.RFC3339))
+	}`, stats["users"], stats["active_sessions"], stats["comments"], stats["acknowledgments"], stats["resolved_alerts"],
+		s.purgedResolvedAlerts.Load(), s.purgedSessions.Load(), time.Now().Format(time.RFC3339))
 }
 
-func getClientIP(ctx context.Context) string {
-	return "unknown"
+func (s *Server) getClientIP(ctx context.Context) string {
+	return services.ClientIPFromContext(ctx, s.trustedProxies)
 }
 
 func (s *Server) IsHealthy() bool {
@@ -0,0 +1,131 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"notificator/internal/backend/database"
+	"notificator/internal/backend/models"
+	authpb "notificator/internal/backend/proto/auth"
+)
+
+// setupAuthServiceWithUserData seeds alice with a comment, an
+// acknowledgment, a hidden alert and a Sentry token, and bob with a comment
+func setupAuthServiceWithUserData(t *testing.T) (*AuthServiceGorm, *database.GormDB, string) {
+	t.Helper()
+
+	db := newTestDB(t)
+
+	alice := createTestUser(t, db, "alice", "session-1")
+	bob := createTestUser(t, db, "bob", "")
+
+	comment, err := db.CreateComment("fp-1", alice.ID, "looking #disk @bob")
+	if err != nil {
+		t.Fatalf("failed to seed comment: %v", err)
+	}
+	if err := db.CreateCommentTags(&comment.Comment); err != nil {
+		t.Fatalf("failed to seed comment tags: %v", err)
+	}
+	if _, err := db.CreateMentions(comment.ID, "fp-1", alice.ID, []string{"bob"}); err != nil {
+		t.Fatalf("failed to seed mention: %v", err)
+	}
+	if _, err := db.CreateComment("fp-1", bob.ID, "me too"); err != nil {
+		t.Fatalf("failed to seed comment: %v", err)
+	}
+	if _, err := db.CreateAcknowledgment("fp-1", alice.ID, "on it", nil); err != nil {
+		t.Fatalf("failed to seed acknowledgment: %v", err)
+	}
+	if _, err := db.CreateUserHiddenAlert(alice.ID, "fp-2", "Noisy", "host-1", "flapping"); err != nil {
+		t.Fatalf("failed to seed hidden alert: %v", err)
+	}
+	if err := db.SaveUserSentryConfig(alice.ID, "secret-token", "https://sentry.io"); err != nil {
+		t.Fatalf("failed to seed Sentry config: %v", err)
+	}
+
+	return NewAuthServiceGorm(db, nil), db, alice.ID
+}
+
+func TestExportUserData(t *testing.T) {
+	svc, _, _ := setupAuthServiceWithUserData(t)
+
+	resp, err := svc.ExportUserData(context.Background(), &authpb.ExportUserDataRequest{SessionId: "session-1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !resp.Success {
+		t.Fatalf("expected Success=true, got message %q", resp.Message)
+	}
+
+	var export models.UserDataExport
+	if err := json.Unmarshal(resp.Data, &export); err != nil {
+		t.Fatalf("export is not valid JSON: %v", err)
+	}
+	if export.User.Username != "alice" {
+		t.Errorf("expected alice's export, got user %q", export.User.Username)
+	}
+	if len(export.Comments) != 1 || export.Comments[0].Content != "looking #disk @bob" {
+		t.Errorf("expected only alice's comment, got %+v", export.Comments)
+	}
+	if len(export.Acknowledgments) != 1 || len(export.HiddenAlerts) != 1 || export.SentryConfig == nil {
+		t.Errorf("expected acknowledgment, hidden alert and Sentry config in export, got %+v", export)
+	}
+	if len(export.CommentTags) != 1 || len(export.Mentions) != 1 {
+		t.Errorf("expected alice's comment tag and mention in export, got %+v / %+v", export.CommentTags, export.Mentions)
+	}
+	if len(export.Sessions) != 1 || export.Sessions[0].ExpiresAt.IsZero() {
+		t.Errorf("expected alice's session timestamps in export, got %+v", export.Sessions)
+	}
+	if strings.Contains(string(resp.Data), "secret-token") {
+		t.Error("export must not contain the Sentry token")
+	}
+	if strings.Contains(string(resp.Data), "session-1") {
+		t.Error("export must not contain session IDs")
+	}
+}
+
+func TestDeleteAccount(t *testing.T) {
+	svc, db, aliceID := setupAuthServiceWithUserData(t)
+
+	resp, err := svc.DeleteAccount(context.Background(), &authpb.DeleteAccountRequest{SessionId: "session-1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !resp.Success {
+		t.Fatalf("expected Success=true, got message %q", resp.Message)
+	}
+
+	if _, err := db.GetUserByID(aliceID); err == nil {
+		t.Error("expected the user to be deleted")
+	}
+	if _, err := db.GetUserBySession("session-1"); err == nil {
+		t.Error("expected the session to be deleted")
+	}
+	if _, err := db.ExportUserData(aliceID); err == nil {
+		t.Error("expected no data left to export")
+	}
+
+	comments, err := db.GetComments("fp-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(comments) != 1 || comments[0].Content != "me too" {
+		t.Errorf("expected only bob's comment to remain, got %+v", comments)
+	}
+}
+
+func TestDeleteAccount_RequiresValidSession(t *testing.T) {
+	svc, db, aliceID := setupAuthServiceWithUserData(t)
+
+	resp, err := svc.DeleteAccount(context.Background(), &authpb.DeleteAccountRequest{SessionId: "missing"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Success {
+		t.Error("expected Success=false for an invalid session")
+	}
+	if _, err := db.GetUserByID(aliceID); err != nil {
+		t.Errorf("expected the user to remain: %v", err)
+	}
+}
@@ -0,0 +1,32 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	alertpb "notificator/internal/backend/proto/alert"
+)
+
+func TestGetAllAcknowledgedAlerts_ReturnsDatabaseErrors(t *testing.T) {
+	svc := newTestServiceWithSession(t)
+	ctx := context.Background()
+
+	if resp, err := svc.AddAcknowledgment(ctx, &alertpb.AddAcknowledgmentRequest{SessionId: "session-1", AlertKey: "fp-1"}); err != nil || !resp.Success {
+		t.Fatalf("AddAcknowledgment failed: %v %v", err, resp)
+	}
+	resp, err := svc.GetAllAcknowledgedAlerts(ctx, &alertpb.GetAllAcknowledgedAlertsRequest{AlertKeys: []string{"fp-1"}})
+	if err != nil || resp.AcknowledgmentCounts["fp-1"] != 1 || resp.AcknowledgedAlerts["fp-1"] == nil {
+		t.Fatalf("expected fp-1's acknowledgment and count, got %v %v", err, resp)
+	}
+
+	sqlDB, err := svc.db.GetDB().DB()
+	if err != nil {
+		t.Fatalf("failed to get the sql database: %v", err)
+	}
+	sqlDB.Close()
+
+	// An empty answer would make the WebUI clear fp-1's acknowledgment
+	if resp, err := svc.GetAllAcknowledgedAlerts(ctx, &alertpb.GetAllAcknowledgedAlertsRequest{AlertKeys: []string{"fp-1"}}); err == nil {
+		t.Errorf("expected the database error to be returned, got %v", resp)
+	}
+}
@@ -0,0 +1,41 @@
+package services
+
+import (
+	"log"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"notificator/internal/backend/models"
+	alertpb "notificator/internal/backend/proto/alert"
+)
+
+// ExpireAcknowledgments removes the acknowledgments whose expiry has passed
+// and tells each alert's subscribers that it is unacknowledged again. It
+// returns how many acknowledgments lapsed.
+func (s *AlertServiceGorm) ExpireAcknowledgments() (int, error) {
+	expired, err := s.db.ExpireAcknowledgments()
+	if err != nil {
+		return 0, err
+	}
+
+	for _, ack := range expired {
+		log.Printf("Acknowledgment %s of alert %s by %s expired", ack.ID, ack.AlertKey, ack.UserID)
+
+		s.recordActivity(&models.AlertActivity{
+			AlertKey: ack.AlertKey,
+			Type:     models.ActivityAckExpired,
+			UserID:   ack.UserID,
+			RefID:    ack.ID,
+			Content:  ack.Reason,
+		})
+
+		s.broadcastUpdate(ack.AlertKey, &alertpb.AlertUpdate{
+			AlertKey:   ack.AlertKey,
+			UpdateType: alertpb.UpdateType_ACKNOWLEDGMENT_EXPIRED,
+			UpdateData: &alertpb.AlertUpdate_DeletedAcknowledgmentId{DeletedAcknowledgmentId: ack.ID},
+			Timestamp:  timestamppb.Now(),
+		})
+	}
+
+	return len(expired), nil
+}
@@ -0,0 +1,55 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"notificator/internal/backend/models"
+	alertpb "notificator/internal/backend/proto/alert"
+)
+
+func TestExpireAcknowledgments(t *testing.T) {
+	svc, stream := setupSubscribedAlertService(t)
+	ctx := context.Background()
+
+	past, err := svc.AddAcknowledgment(ctx, &alertpb.AddAcknowledgmentRequest{SessionId: "session-1", AlertKey: "fp-1", ExpiresAt: timestamppb.New(time.Now().Add(-time.Minute))})
+	if err != nil || past.Success {
+		t.Fatalf("expected an expiry in the past to be rejected, got %v %v", err, past)
+	}
+
+	until := time.Now().Add(2 * time.Hour)
+	resp, err := svc.AddAcknowledgment(ctx, &alertpb.AddAcknowledgmentRequest{SessionId: "session-1", AlertKey: "fp-1", ExpiresAt: timestamppb.New(until), Renotify: true})
+	if err != nil || !resp.Success {
+		t.Fatalf("AddAcknowledgment failed: %v %v", err, resp)
+	}
+	if !resp.Acknowledgment.ExpiresAt.AsTime().Equal(until.UTC()) || !resp.Acknowledgment.Renotify {
+		t.Errorf("expected the expiry stored with the acknowledgment, got %+v", resp.Acknowledgment)
+	}
+	if _, err := svc.AddAcknowledgment(ctx, &alertpb.AddAcknowledgmentRequest{SessionId: "session-1", AlertKey: "fp-2"}); err != nil {
+		t.Fatalf("AddAcknowledgment failed: %v", err)
+	}
+
+	if expired, err := svc.ExpireAcknowledgments(); err != nil || expired != 0 {
+		t.Fatalf("expected nothing to expire yet, got %d (%v)", expired, err)
+	}
+
+	svc.db.GetDB().Model(&models.Acknowledgment{}).Where("id = ?", resp.Acknowledgment.Id).Update("expires_at", time.Now().Add(-time.Second))
+	if expired, err := svc.ExpireAcknowledgments(); err != nil || expired != 1 {
+		t.Fatalf("expected one lapsed acknowledgment, got %d (%v)", expired, err)
+	}
+
+	updates := stream.waitForUpdates(t, 3)
+	if updates[2].UpdateType != alertpb.UpdateType_ACKNOWLEDGMENT_EXPIRED || updates[2].GetDeletedAcknowledgmentId() != resp.Acknowledgment.Id {
+		t.Errorf("expected an ACKNOWLEDGMENT_EXPIRED broadcast, got %v", updates[2])
+	}
+
+	if acks, _ := svc.db.GetAcknowledgments("fp-1"); len(acks) != 0 {
+		t.Errorf("expected the lapsed acknowledgment removed, got %+v", acks)
+	}
+	if acks, _ := svc.db.GetAcknowledgments("fp-2"); len(acks) != 1 {
+		t.Errorf("expected the acknowledgment without expiry kept, got %+v", acks)
+	}
+}
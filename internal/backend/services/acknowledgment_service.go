@@ -16,7 +16,7 @@ func NewAcknowledgmentService(db *database.GormDB) *AcknowledgmentService {
 }
 
 func (s *AcknowledgmentService) AddAcknowledgment(ctx context.Context, alertKey, userID, reason string) (*models.AcknowledgmentWithUser, error) {
-	return s.db.CreateAcknowledgment(alertKey, userID, reason)
+	return s.db.CreateAcknowledgment(alertKey, userID, reason, nil)
 }
 
 func (s *AcknowledgmentService) GetAcknowledgments(ctx context.Context, alertKey string) ([]models.AcknowledgmentWithUser, error) {
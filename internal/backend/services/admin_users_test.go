@@ -0,0 +1,208 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+
+	"notificator/config"
+	"notificator/internal/backend/database"
+	authpb "notificator/internal/backend/proto/auth"
+)
+
+// setupAdminUsers seeds an admin and a regular user, each with a session
+func setupAdminUsers(t *testing.T) (*AuthServiceGorm, *database.GormDB, string) {
+	t.Helper()
+
+	db := newTestDB(t)
+
+	hash, err := bcrypt.GenerateFromPassword([]byte("secret"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatalf("failed to hash password: %v", err)
+	}
+	admin, err := db.CreateUser("admin", "admin@example.com", string(hash))
+	if err != nil {
+		t.Fatalf("failed to create user: %v", err)
+	}
+	bob, err := db.CreateUser("bob", "bob@example.com", string(hash))
+	if err != nil {
+		t.Fatalf("failed to create user: %v", err)
+	}
+	if err := db.CreateSession(admin.ID, "admin-session", time.Now().Add(time.Hour)); err != nil {
+		t.Fatalf("failed to create session: %v", err)
+	}
+	if err := db.CreateSession(bob.ID, "bob-session", time.Now().Add(time.Hour)); err != nil {
+		t.Fatalf("failed to create session: %v", err)
+	}
+
+	if err := db.SetUserAdmin(admin.ID, true); err != nil {
+		t.Fatalf("failed to promote admin: %v", err)
+	}
+
+	return NewAuthServiceGorm(db, nil), db, bob.ID
+}
+
+func TestRegister_BootstrapsAdminIntoEmptyUsersTable(t *testing.T) {
+	db := newTestDB(t)
+	svc := NewAuthServiceGorm(db, nil)
+	svc.SetAdminConfig(config.AdminConfig{BootstrapFirstUser: true})
+
+	for _, username := range []string{"first", "second"} {
+		resp, err := svc.Register(context.Background(), &authpb.RegisterRequest{Username: username, Password: "long-enough-password"})
+		if err != nil || !resp.Success {
+			t.Fatalf("failed to register %s: %v %v", username, err, resp)
+		}
+	}
+
+	for username, wantAdmin := range map[string]bool{"first": true, "second": false} {
+		user, err := db.GetUserByUsername(username)
+		if err != nil {
+			t.Fatalf("failed to get %s: %v", username, err)
+		}
+		if user.IsAdmin != wantAdmin {
+			t.Errorf("%s: expected IsAdmin=%t, got %t", username, wantAdmin, user.IsAdmin)
+		}
+	}
+}
+
+func TestRegister_NeverPromotesExistingUsers(t *testing.T) {
+	svc, db, bobID := setupAdminUsers(t)
+	if err := db.SetUserAdmin(bobID, false); err != nil {
+		t.Fatalf("failed to update bob: %v", err)
+	}
+	svc.SetAdminConfig(config.AdminConfig{BootstrapFirstUser: true})
+
+	resp, err := svc.Register(context.Background(), &authpb.RegisterRequest{Username: "carol", Password: "long-enough-password"})
+	if err != nil || !resp.Success {
+		t.Fatalf("failed to register carol: %v %v", err, resp)
+	}
+	carol, err := db.GetUserByUsername("carol")
+	if err != nil {
+		t.Fatalf("failed to get carol: %v", err)
+	}
+	if carol.IsAdmin {
+		t.Error("expected a user registered next to existing ones to stay a regular user")
+	}
+}
+
+func TestPromoteBootstrapAdmin_SkipsConcurrentFirstUsers(t *testing.T) {
+	db := newTestDB(t)
+	svc := NewAuthServiceGorm(db, nil)
+	svc.SetAdminConfig(config.AdminConfig{BootstrapFirstUser: true})
+
+	// Both registrations saw an empty users table before either was created
+	first := createTestUser(t, db, "first", "")
+	second := createTestUser(t, db, "second", "")
+	svc.promoteBootstrapAdmin(first)
+	svc.promoteBootstrapAdmin(second)
+
+	for _, username := range []string{"first", "second"} {
+		user, err := db.GetUserByUsername(username)
+		if err != nil {
+			t.Fatalf("failed to get %s: %v", username, err)
+		}
+		if user.IsAdmin {
+			t.Errorf("expected %s not to be promoted next to another new user", username)
+		}
+	}
+}
+
+func TestListUsers_RequiresAdmin(t *testing.T) {
+	svc, _, _ := setupAdminUsers(t)
+
+	resp, err := svc.ListUsers(context.Background(), &authpb.ListUsersRequest{SessionId: "bob-session"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Success {
+		t.Error("expected a non-admin to be refused")
+	}
+
+	resp, err = svc.ListUsers(context.Background(), &authpb.ListUsersRequest{SessionId: "admin-session", Limit: 1, Offset: 1})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !resp.Success {
+		t.Fatalf("expected Success=true, got message %q", resp.Message)
+	}
+	if resp.TotalCount != 2 || len(resp.Users) != 1 || resp.Users[0].Username != "bob" {
+		t.Errorf("expected page with bob out of 2 users, got %d users of %d", len(resp.Users), resp.TotalCount)
+	}
+}
+
+func TestListUsers_AllowsImpersonators(t *testing.T) {
+	svc, _, _ := setupAdminUsers(t)
+	svc.SetAdminConfig(config.AdminConfig{ImpersonationAllowedUsers: []string{"bob@example.com"}})
+
+	resp, err := svc.ListUsers(context.Background(), &authpb.ListUsersRequest{SessionId: "bob-session"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !resp.Success {
+		t.Errorf("expected an impersonation-allowed user to list users, got %q", resp.Message)
+	}
+}
+
+func TestSetUserDisabled_BlocksLoginAndKeepsUser(t *testing.T) {
+	svc, db, bobID := setupAdminUsers(t)
+
+	resp, err := svc.SetUserDisabled(context.Background(), &authpb.SetUserDisabledRequest{SessionId: "bob-session", UserId: bobID, Disabled: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Success {
+		t.Fatal("expected a non-admin to be refused")
+	}
+
+	resp, err = svc.SetUserDisabled(context.Background(), &authpb.SetUserDisabledRequest{SessionId: "admin-session", UserId: bobID, Disabled: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !resp.Success {
+		t.Fatalf("expected Success=true, got message %q", resp.Message)
+	}
+
+	if _, err := db.GetUserBySession("bob-session"); err == nil {
+		t.Error("expected the disabled user's session to be revoked")
+	}
+	login, err := svc.Login(context.Background(), &authpb.LoginRequest{Username: "bob", Password: "secret"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if login.Success {
+		t.Error("expected login to fail for a disabled user")
+	}
+	if _, err := db.GetUserByID(bobID); err != nil {
+		t.Errorf("expected the disabled user to be kept: %v", err)
+	}
+
+	resp, err = svc.SetUserDisabled(context.Background(), &authpb.SetUserDisabledRequest{SessionId: "admin-session", UserId: bobID, Disabled: false})
+	if err != nil || !resp.Success {
+		t.Fatalf("expected re-enabling to succeed, got %v / %+v", err, resp)
+	}
+	login, err = svc.Login(context.Background(), &authpb.LoginRequest{Username: "bob", Password: "secret"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !login.Success {
+		t.Errorf("expected login to succeed after re-enabling, got %q", login.Message)
+	}
+}
+
+func TestSetUserDisabled_RejectsSelf(t *testing.T) {
+	svc, db, _ := setupAdminUsers(t)
+
+	admin, err := db.GetUserByUsername("admin")
+	if err != nil {
+		t.Fatalf("failed to load admin: %v", err)
+	}
+	resp, err := svc.SetUserDisabled(context.Background(), &authpb.SetUserDisabledRequest{SessionId: "admin-session", UserId: admin.ID, Disabled: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Success {
+		t.Error("expected an admin to be unable to disable themselves")
+	}
+}
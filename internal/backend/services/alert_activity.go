@@ -0,0 +1,112 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"notificator/internal/backend/models"
+	alertpb "notificator/internal/backend/proto/alert"
+)
+
+const (
+	defaultActivityLimit = 50
+	maxActivityLimit     = 200
+)
+
+// recordActivity appends to the alert's timeline. The action it describes has
+// already succeeded, so a failure here is only logged.
+func (s *AlertServiceGorm) recordActivity(activity *models.AlertActivity) {
+	if err := s.db.CreateAlertActivity(activity); err != nil {
+		log.Printf("Error recording %s activity for alert %s: %v", activity.Type, activity.AlertKey, err)
+	}
+}
+
+// GetAlertActivity implements the GetAlertActivity RPC method
+func (s *AlertServiceGorm) GetAlertActivity(ctx context.Context, req *alertpb.GetAlertActivityRequest) (*alertpb.GetAlertActivityResponse, error) {
+	if req.AlertKey == "" {
+		return &alertpb.GetAlertActivityResponse{
+			Activities: []*alertpb.AlertActivity{},
+		}, nil
+	}
+
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = defaultActivityLimit
+	}
+	if limit > maxActivityLimit {
+		limit = maxActivityLimit
+	}
+
+	before, beforeID, err := decodePageCursor(req.Before)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid cursor: %v", err)
+	}
+
+	// Fetch one extra entry to know whether there is another page
+	activities, err := s.db.GetAlertActivity(req.AlertKey, limit+1, before, beforeID)
+	if err != nil {
+		log.Printf("Error getting activity for alert %s: %v", req.AlertKey, err)
+		return &alertpb.GetAlertActivityResponse{
+			Activities: []*alertpb.AlertActivity{},
+		}, nil
+	}
+
+	var nextCursor string
+	if len(activities) > limit {
+		activities = activities[:limit]
+		last := activities[len(activities)-1]
+		nextCursor = encodePageCursor(last.CreatedAt, last.ID)
+	}
+
+	protoActivities := make([]*alertpb.AlertActivity, len(activities))
+	for i, activity := range activities {
+		protoActivities[i] = &alertpb.AlertActivity{
+			Id:        activity.ID,
+			AlertKey:  activity.AlertKey,
+			Type:      activity.Type,
+			UserId:    activity.UserID,
+			Username:  activity.Username,
+			Content:   activity.Content,
+			Target:    activity.Target,
+			RefId:     activity.RefID,
+			CreatedAt: timestamppb.New(activity.CreatedAt),
+		}
+	}
+
+	return &alertpb.GetAlertActivityResponse{
+		Activities: protoActivities,
+		NextCursor: nextCursor,
+	}, nil
+}
+
+// Cursors of newest-first pages (activity, comments, acknowledgments) are
+// "<created_at RFC3339Nano>|<id>" of the last entry of a page
+func encodePageCursor(createdAt time.Time, id string) string {
+	return createdAt.UTC().Format(time.RFC3339Nano) + "|" + id
+}
+
+func decodePageCursor(cursor string) (time.Time, string, error) {
+	if cursor == "" {
+		return time.Time{}, "", nil
+	}
+
+	timestamp, id, ok := strings.Cut(cursor, "|")
+	if !ok || id == "" {
+		return time.Time{}, "", fmt.Errorf("malformed cursor %q", cursor)
+	}
+
+	createdAt, err := time.Parse(time.RFC3339Nano, timestamp)
+	if err != nil {
+		return time.Time{}, "", err
+	}
+
+	// Compare in the zone timestamps were written in
+	return createdAt.Local(), id, nil
+}
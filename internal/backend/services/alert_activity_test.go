@@ -0,0 +1,106 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	alertpb "notificator/internal/backend/proto/alert"
+)
+
+func TestGetAlertActivity_RecordsActions(t *testing.T) {
+	svc := newTestServiceWithSession(t)
+	ctx := context.Background()
+
+	if _, err := svc.AddComment(ctx, &alertpb.AddCommentRequest{SessionId: "session-1", AlertKey: "fp-1", Content: "first"}); err != nil {
+		t.Fatalf("AddComment: %v", err)
+	}
+	doomed, err := svc.AddComment(ctx, &alertpb.AddCommentRequest{SessionId: "session-1", AlertKey: "fp-1", Content: "typo"})
+	if err != nil {
+		t.Fatalf("AddComment: %v", err)
+	}
+	if _, err := svc.AddAcknowledgment(ctx, &alertpb.AddAcknowledgmentRequest{SessionId: "session-1", AlertKey: "fp-1", Reason: "on it"}); err != nil {
+		t.Fatalf("AddAcknowledgment: %v", err)
+	}
+	if _, err := svc.DeleteAcknowledgment(ctx, &alertpb.DeleteAcknowledgmentRequest{SessionId: "session-1", AlertKey: "fp-1"}); err != nil {
+		t.Fatalf("DeleteAcknowledgment: %v", err)
+	}
+	if _, err := svc.HideAlert(ctx, &alertpb.HideAlertRequest{SessionId: "session-1", Fingerprint: "fp-1", Reason: "noise"}); err != nil {
+		t.Fatalf("HideAlert: %v", err)
+	}
+	if _, err := svc.DeleteComment(ctx, &alertpb.DeleteCommentRequest{SessionId: "session-1", CommentId: doomed.Comment.Id}); err != nil {
+		t.Fatalf("DeleteComment: %v", err)
+	}
+
+	resp, err := svc.GetAlertActivity(ctx, &alertpb.GetAlertActivityRequest{AlertKey: "fp-1"})
+	if err != nil {
+		t.Fatalf("GetAlertActivity: %v", err)
+	}
+
+	counts := make(map[string]int)
+	for _, activity := range resp.Activities {
+		counts[activity.Type]++
+		if activity.Username != "alice" {
+			t.Errorf("expected username alice on %s activity, got %q", activity.Type, activity.Username)
+		}
+		if activity.Type == "comment" && activity.Content == "typo" {
+			t.Error("deleted comment should be removed from the timeline")
+		}
+	}
+	want := map[string]int{"comment": 1, "ack": 1, "ack_removed": 1, "hide": 1}
+	for activityType, n := range want {
+		if counts[activityType] != n {
+			t.Errorf("expected %d %s activities, got %d (all: %v)", n, activityType, counts[activityType], counts)
+		}
+	}
+	if resp.NextCursor != "" {
+		t.Errorf("expected a single page, got cursor %q", resp.NextCursor)
+	}
+}
+
+func TestGetAlertActivity_Paginates(t *testing.T) {
+	svc := newTestServiceWithSession(t)
+	ctx := context.Background()
+
+	for i := 0; i < 5; i++ {
+		if _, err := svc.AddComment(ctx, &alertpb.AddCommentRequest{SessionId: "session-1", AlertKey: "fp-1", Content: "comment"}); err != nil {
+			t.Fatalf("AddComment: %v", err)
+		}
+	}
+
+	seen := make(map[string]bool)
+	var previous *alertpb.AlertActivity
+	cursor := ""
+	pages := 0
+	for {
+		resp, err := svc.GetAlertActivity(ctx, &alertpb.GetAlertActivityRequest{AlertKey: "fp-1", Limit: 2, Before: cursor})
+		if err != nil {
+			t.Fatalf("GetAlertActivity: %v", err)
+		}
+		pages++
+		for _, activity := range resp.Activities {
+			if seen[activity.Id] {
+				t.Fatalf("activity %s returned twice", activity.Id)
+			}
+			seen[activity.Id] = true
+			if previous != nil && activity.CreatedAt.AsTime().After(previous.CreatedAt.AsTime()) {
+				t.Errorf("activities are not newest first")
+			}
+			previous = activity
+		}
+		if resp.NextCursor == "" {
+			break
+		}
+		cursor = resp.NextCursor
+		if pages > 5 {
+			t.Fatal("pagination did not terminate")
+		}
+	}
+
+	if len(seen) != 5 || pages != 3 {
+		t.Errorf("expected 5 activities over 3 pages, got %d over %d", len(seen), pages)
+	}
+
+	if _, err := svc.GetAlertActivity(ctx, &alertpb.GetAlertActivityRequest{AlertKey: "fp-1", Before: "garbage"}); err == nil {
+		t.Error("expected an error for a malformed cursor")
+	}
+}
@@ -0,0 +1,305 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	alertpb "notificator/internal/backend/proto/alert"
+)
+
+// recordingAlertStream is a server stream that records sent updates and
+// flags overlapping Send calls
+type recordingAlertStream struct {
+	grpc.ServerStream
+	ctx        context.Context
+	inFlight   atomic.Int32
+	concurrent atomic.Bool
+
+	mu      sync.Mutex
+	updates []*alertpb.AlertUpdate
+}
+
+func (r *recordingAlertStream) Context() context.Context {
+	return r.ctx
+}
+
+func (r *recordingAlertStream) Send(update *alertpb.AlertUpdate) error {
+	if r.inFlight.Add(1) > 1 {
+		r.concurrent.Store(true)
+	}
+	defer r.inFlight.Add(-1)
+
+	// Widen the window in which an unserialized sender would overlap
+	time.Sleep(100 * time.Microsecond)
+
+	r.mu.Lock()
+	r.updates = append(r.updates, update)
+	r.mu.Unlock()
+	return nil
+}
+
+func (r *recordingAlertStream) received() []*alertpb.AlertUpdate {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]*alertpb.AlertUpdate(nil), r.updates...)
+}
+
+// waitForUpdates polls until the stream has recorded want updates
+func (r *recordingAlertStream) waitForUpdates(t *testing.T, want int) []*alertpb.AlertUpdate {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		got := r.received()
+		if len(got) >= want {
+			return got
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("expected %d updates, got %d", want, len(got))
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+// subscribeInBackground runs SubscribeToAlertUpdates for "fp-1" on stream
+// and returns a channel receiving its result
+func subscribeInBackground(svc *AlertServiceGorm, stream grpc.ServerStreamingServer[alertpb.AlertUpdate]) <-chan error {
+	done := make(chan error, 1)
+	go func() {
+		done <- svc.SubscribeToAlertUpdates(&alertpb.SubscribeToAlertUpdatesRequest{SessionId: "session-1", AlertKey: "fp-1"}, stream)
+	}()
+	return done
+}
+
+// setupSubscribedAlertService starts a subscription to "fp-1" on a fresh
+// service and waits for its initial update
+func setupSubscribedAlertService(t *testing.T) (*AlertServiceGorm, *recordingAlertStream) {
+	t.Helper()
+
+	svc := newTestServiceWithSession(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := &recordingAlertStream{ctx: ctx}
+
+	done := subscribeInBackground(svc, stream)
+	t.Cleanup(func() {
+		cancel()
+		if err := <-done; err != nil {
+			t.Errorf("subscription ended with error: %v", err)
+		}
+	})
+
+	stream.waitForUpdates(t, 1)
+	return svc, stream
+}
+
+func TestBroadcastUpdate_SerializesConcurrentComments(t *testing.T) {
+	svc, stream := setupSubscribedAlertService(t)
+
+	const comments = 40
+	var wg sync.WaitGroup
+	for i := 0; i < comments; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			resp, err := svc.AddComment(context.Background(), &alertpb.AddCommentRequest{
+				SessionId: "session-1",
+				AlertKey:  "fp-1",
+				Content:   fmt.Sprintf("comment %d", i),
+			})
+			if err != nil || !resp.Success {
+				t.Errorf("AddComment failed: %v %v", err, resp)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	updates := stream.waitForUpdates(t, comments+1)
+	if stream.concurrent.Load() {
+		t.Error("expected Send calls on one stream to never overlap")
+	}
+
+	seen := make(map[string]bool)
+	for _, update := range updates[1:] {
+		if update.UpdateType != alertpb.UpdateType_COMMENT_ADDED {
+			t.Fatalf("expected only comment updates, got %v", update.UpdateType)
+		}
+		seen[update.GetComment().Content] = true
+	}
+	if len(seen) != comments {
+		t.Errorf("expected %d distinct comments delivered, got %d", comments, len(seen))
+	}
+}
+
+func TestBroadcastUpdate_DeliversInOrder(t *testing.T) {
+	svc, stream := setupSubscribedAlertService(t)
+
+	const count = 50
+	for i := 0; i < count; i++ {
+		svc.broadcastUpdate("fp-1", &alertpb.AlertUpdate{
+			AlertKey:   "fp-1",
+			UpdateType: alertpb.UpdateType_COMMENT_ADDED,
+			UpdateData: &alertpb.AlertUpdate_Comment{Comment: &alertpb.Comment{Content: fmt.Sprintf("%d", i)}},
+			Timestamp:  timestamppb.Now(),
+		})
+	}
+
+	updates := stream.waitForUpdates(t, count+1)
+	for i, update := range updates[1:] {
+		if got := update.GetComment().Content; got != fmt.Sprintf("%d", i) {
+			t.Fatalf("expected update %d in position %d, got %s", i, i, got)
+		}
+	}
+}
+
+// failingAlertStream accepts the initial update and fails every send after it
+type failingAlertStream struct {
+	grpc.ServerStream
+	ctx   context.Context
+	sends atomic.Int32
+}
+
+func (f *failingAlertStream) Context() context.Context {
+	return f.ctx
+}
+
+func (f *failingAlertStream) Send(*alertpb.AlertUpdate) error {
+	if f.sends.Add(1) > 1 {
+		return errors.New("connection reset")
+	}
+	return nil
+}
+
+func TestSubscribeToAlertUpdates_SendsHeartbeats(t *testing.T) {
+	svc := newTestServiceWithSession(t)
+	svc.SetHeartbeatInterval(10 * time.Millisecond)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := &recordingAlertStream{ctx: ctx}
+	done := subscribeInBackground(svc, stream)
+	t.Cleanup(func() {
+		cancel()
+		<-done
+	})
+
+	updates := stream.waitForUpdates(t, 3)
+	if interval := updates[0].HeartbeatIntervalMs; interval != 10 {
+		t.Errorf("expected the connection confirmation to carry the 10ms heartbeat interval, got %d", interval)
+	}
+	for _, update := range updates[1:] {
+		if update.UpdateType != alertpb.UpdateType_HEARTBEAT {
+			t.Fatalf("expected heartbeats on an idle stream, got %v", update.UpdateType)
+		}
+	}
+}
+
+func TestSubscribeToAlertUpdates_FailedHeartbeatRemovesSubscription(t *testing.T) {
+	svc := newTestServiceWithSession(t)
+	svc.SetHeartbeatInterval(10 * time.Millisecond)
+
+	stream := &failingAlertStream{ctx: context.Background()}
+	select {
+	case err := <-subscribeInBackground(svc, stream):
+		if err == nil {
+			t.Error("expected the failed heartbeat to end the subscription with an error")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("subscription did not end after a failed heartbeat")
+	}
+
+	svc.subsMutex.RLock()
+	remaining := len(svc.subscriptions["fp-1"])
+	svc.subsMutex.RUnlock()
+	if remaining != 0 {
+		t.Errorf("expected the subscription to be removed, %d remain", remaining)
+	}
+}
+
+// recordingResolvedStream is a resolved alert stream that counts sends and
+// flags overlapping ones
+type recordingResolvedStream struct {
+	grpc.ServerStream
+	ctx        context.Context
+	inFlight   atomic.Int32
+	concurrent atomic.Bool
+	sends      atomic.Int32
+}
+
+func (r *recordingResolvedStream) Context() context.Context {
+	return r.ctx
+}
+
+func (r *recordingResolvedStream) Send(*alertpb.ResolvedAlertUpdate) error {
+	if r.inFlight.Add(1) > 1 {
+		r.concurrent.Store(true)
+	}
+	defer r.inFlight.Add(-1)
+	time.Sleep(100 * time.Microsecond)
+	r.sends.Add(1)
+	return nil
+}
+
+func resolvedSubscriberCount() int {
+	resolvedAlertSubscriptionsMutex.RLock()
+	defer resolvedAlertSubscriptionsMutex.RUnlock()
+	return len(resolvedAlertSubscriptions["global"])
+}
+
+func TestStreamResolvedAlertUpdates_SerializesAndEndsWithClient(t *testing.T) {
+	svc := newTestServiceWithSession(t)
+	before := resolvedSubscriberCount()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := &recordingResolvedStream{ctx: ctx}
+	done := make(chan error, 1)
+	go func() {
+		done <- svc.StreamResolvedAlertUpdates(&alertpb.StreamResolvedAlertUpdatesRequest{SessionId: "session-1"}, stream)
+	}()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for resolvedSubscriberCount() == before {
+		if time.Now().After(deadline) {
+			t.Fatal("subscription was not registered")
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	const updates = 20
+	var wg sync.WaitGroup
+	for i := 0; i < updates; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			svc.broadcastResolvedAlertUpdate("fp-1", &alertpb.ResolvedAlertUpdate{})
+		}()
+	}
+	wg.Wait()
+	for stream.sends.Load() < updates {
+		if time.Now().After(deadline) {
+			t.Fatalf("expected %d sends, got %d", updates, stream.sends.Load())
+		}
+		time.Sleep(time.Millisecond)
+	}
+	if stream.concurrent.Load() {
+		t.Error("expected sends on one stream to never overlap")
+	}
+
+	cancel()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Errorf("expected a clean end, got %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("stream did not end when its client went away")
+	}
+	if got := resolvedSubscriberCount(); got != before {
+		t.Errorf("expected the subscription to be removed, %d remain", got-before)
+	}
+}
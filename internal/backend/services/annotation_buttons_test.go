@@ -0,0 +1,42 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	alertpb "notificator/internal/backend/proto/alert"
+)
+
+func TestSaveAnnotationButtonConfigs_AcceptsCopyButtons(t *testing.T) {
+	svc := newTestServiceWithSession(t)
+
+	save := func(buttonType string) *alertpb.SaveAnnotationButtonConfigsResponse {
+		t.Helper()
+		resp, err := svc.SaveAnnotationButtonConfigs(context.Background(), &alertpb.SaveAnnotationButtonConfigsRequest{
+			SessionId: "session-1",
+			Configs: []*alertpb.AnnotationButtonConfig{
+				{Label: "Runbook", AnnotationKeys: []string{"runbook_url"}, Color: "#4f46e5", DisplayOrder: 1, Enabled: true, ButtonType: "custom"},
+				{Label: "Trace ID", AnnotationKeys: []string{"trace_id"}, Color: "#0891b2", DisplayOrder: 0, Enabled: true, ButtonType: buttonType},
+			},
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return resp
+	}
+
+	if resp := save("clipboard"); resp.Success {
+		t.Errorf("expected an unknown button type to be rejected")
+	}
+	if resp := save("copy"); !resp.Success {
+		t.Fatalf("expected a copy button to be saved, got %q", resp.Message)
+	}
+
+	got, err := svc.GetAnnotationButtonConfigs(context.Background(), &alertpb.GetAnnotationButtonConfigsRequest{SessionId: "session-1"})
+	if err != nil || !got.Success {
+		t.Fatalf("failed to get annotation buttons: %v %v", err, got)
+	}
+	if len(got.Configs) != 2 || got.Configs[0].Label != "Trace ID" || got.Configs[0].ButtonType != "copy" {
+		t.Errorf("expected the copy button first by display order, got %+v", got.Configs)
+	}
+}
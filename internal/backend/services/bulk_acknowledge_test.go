@@ -0,0 +1,71 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	alertpb "notificator/internal/backend/proto/alert"
+)
+
+func TestBulkAcknowledge_AcknowledgesEveryKey(t *testing.T) {
+	svc := newTestServiceWithSession(t)
+
+	stream := &recordingStream{updates: make(chan *alertpb.AlertUpdate, 1)}
+	sub := &Subscription{AlertKey: "fp-2", Stream: stream}
+	svc.addSubscription(sub)
+	defer svc.removeSubscription(sub)
+
+	resp, err := svc.BulkAcknowledge(context.Background(), &alertpb.BulkAcknowledgeRequest{
+		SessionId: "session-1",
+		AlertKeys: []string{"fp-1", "fp-2", "fp-1"},
+		Reason:    "maintenance window",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !resp.Success {
+		t.Fatalf("expected Success=true, got message %q", resp.Message)
+	}
+	if len(resp.Results) != 2 || !resp.Results["fp-1"] || !resp.Results["fp-2"] {
+		t.Errorf("expected both keys to succeed, got %v", resp.Results)
+	}
+	if len(resp.Acknowledgments) != 2 {
+		t.Errorf("expected 2 acknowledgments, got %d", len(resp.Acknowledgments))
+	}
+
+	select {
+	case update := <-stream.updates:
+		if update.UpdateType != alertpb.UpdateType_ACKNOWLEDGMENT_ADDED || update.GetAcknowledgment().GetAlertKey() != "fp-2" {
+			t.Errorf("unexpected broadcast: %+v", update)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected an ACKNOWLEDGMENT_ADDED broadcast")
+	}
+
+	acks, err := svc.GetAcknowledgments(context.Background(), &alertpb.GetAcknowledgmentsRequest{AlertKey: "fp-1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(acks.Acknowledgments) != 1 || acks.Acknowledgments[0].Reason != "maintenance window" {
+		t.Errorf("expected one stored acknowledgment, got %+v", acks.Acknowledgments)
+	}
+}
+
+func TestBulkAcknowledge_ReportsPartialFailure(t *testing.T) {
+	svc := newTestServiceWithSession(t)
+
+	resp, err := svc.BulkAcknowledge(context.Background(), &alertpb.BulkAcknowledgeRequest{
+		SessionId: "session-1",
+		AlertKeys: []string{"fp-1", ""},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Success {
+		t.Error("expected Success=false when a key fails")
+	}
+	if !resp.Results["fp-1"] || resp.Results[""] {
+		t.Errorf("expected fp-1 to succeed and the empty key to fail, got %v", resp.Results)
+	}
+}
@@ -0,0 +1,100 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+
+	"notificator/internal/backend/database"
+	authpb "notificator/internal/backend/proto/auth"
+)
+
+// setupAuthServiceWithPassword seeds a local user with password "old-secret"
+// and an OAuth-only user, each with an active session
+func setupAuthServiceWithPassword(t *testing.T) (*AuthServiceGorm, *database.GormDB) {
+	t.Helper()
+
+	db := newTestDB(t)
+
+	hash, err := bcrypt.GenerateFromPassword([]byte("old-secret"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatalf("failed to hash password: %v", err)
+	}
+	user, err := db.CreateUser("alice", "alice@example.com", string(hash))
+	if err != nil {
+		t.Fatalf("failed to create user: %v", err)
+	}
+	if err := db.CreateSession(user.ID, "session-1", time.Now().Add(time.Hour)); err != nil {
+		t.Fatalf("failed to create session: %v", err)
+	}
+
+	oauthUser, err := db.CreateUser("bob", "bob@example.com", "")
+	if err != nil {
+		t.Fatalf("failed to create user: %v", err)
+	}
+	if err := db.CreateSession(oauthUser.ID, "session-oauth", time.Now().Add(time.Hour)); err != nil {
+		t.Fatalf("failed to create session: %v", err)
+	}
+
+	return NewAuthServiceGorm(db, nil), db
+}
+
+func TestChangePassword(t *testing.T) {
+	svc, _ := setupAuthServiceWithPassword(t)
+
+	resp, err := svc.ChangePassword(context.Background(), &authpb.ChangePasswordRequest{
+		SessionId:   "session-1",
+		OldPassword: "old-secret",
+		NewPassword: "new-secret",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !resp.Success {
+		t.Fatalf("expected Success=true, got message %q", resp.Message)
+	}
+
+	login, err := svc.Login(context.Background(), &authpb.LoginRequest{Username: "alice", Password: "new-secret"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !login.Success {
+		t.Errorf("expected login with the new password to succeed, got %q", login.Message)
+	}
+}
+
+func TestChangePassword_Rejections(t *testing.T) {
+	tests := []struct {
+		name string
+		req  *authpb.ChangePasswordRequest
+	}{
+		{"wrong old password", &authpb.ChangePasswordRequest{SessionId: "session-1", OldPassword: "nope", NewPassword: "new-secret"}},
+		{"new password too short", &authpb.ChangePasswordRequest{SessionId: "session-1", OldPassword: "old-secret", NewPassword: "abc"}},
+		{"invalid session", &authpb.ChangePasswordRequest{SessionId: "missing", OldPassword: "old-secret", NewPassword: "new-secret"}},
+		{"oauth-only user", &authpb.ChangePasswordRequest{SessionId: "session-oauth", OldPassword: "anything", NewPassword: "new-secret"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, _ := setupAuthServiceWithPassword(t)
+
+			resp, err := svc.ChangePassword(context.Background(), tt.req)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if resp.Success {
+				t.Fatal("expected Success=false")
+			}
+
+			login, err := svc.Login(context.Background(), &authpb.LoginRequest{Username: "alice", Password: "old-secret"})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !login.Success {
+				t.Error("expected the old password to keep working")
+			}
+		})
+	}
+}
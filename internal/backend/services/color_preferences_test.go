@@ -0,0 +1,95 @@
+package services
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	alertpb "notificator/internal/backend/proto/alert"
+)
+
+func saveColorPreference(t *testing.T, svc *AlertServiceGorm, pref *alertpb.UserColorPreference) *alertpb.SaveUserColorPreferencesResponse {
+	t.Helper()
+	resp, err := svc.SaveUserColorPreferences(context.Background(), &alertpb.SaveUserColorPreferencesRequest{
+		SessionId:   "session-1",
+		Preferences: []*alertpb.UserColorPreference{pref},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return resp
+}
+
+func TestSaveUserColorPreferences_RejectsInvalidPreferences(t *testing.T) {
+	svc := newTestServiceWithSession(t)
+
+	for name, pref := range map[string]*alertpb.UserColorPreference{
+		"tailwind markup": {Color: "red-500\" onclick=\"x", ColorType: "tailwind", Priority: 1},
+		"unknown type":    {Color: "#ff0000", ColorType: "gradient", Priority: 1},
+	} {
+		if resp := saveColorPreference(t, svc, pref); resp.Success {
+			t.Errorf("%s: expected Success=false", name)
+		}
+	}
+
+	user, _ := svc.db.GetUserBySession("session-1")
+	if stored, _ := svc.db.GetUserColorPreferences(user.ID); len(stored) != 0 {
+		t.Errorf("expected nothing stored, got %d preferences", len(stored))
+	}
+}
+
+func TestSaveUserColorPreferences_SanitizesColorAndFactors(t *testing.T) {
+	svc := newTestServiceWithSession(t)
+
+	resp := saveColorPreference(t, svc, &alertpb.UserColorPreference{
+		Color:              "red;background:url(javascript:x)",
+		ColorType:          "custom",
+		Priority:           5,
+		BgLightnessFactor:  3,
+		TextDarknessFactor: float32(math.NaN()),
+	})
+	if !resp.Success {
+		t.Fatalf("expected Success=true, got message %q", resp.Message)
+	}
+
+	user, _ := svc.db.GetUserBySession("session-1")
+	stored, err := svc.db.GetUserColorPreferences(user.ID)
+	if err != nil || len(stored) != 1 {
+		t.Fatalf("expected 1 stored preference, got %d (%v)", len(stored), err)
+	}
+	if stored[0].Color != "#6366f1" {
+		t.Errorf("expected the unsafe color to be replaced, got %q", stored[0].Color)
+	}
+	if stored[0].BgLightnessFactor != 1 || stored[0].TextDarknessFactor != 0 {
+		t.Errorf("expected factors clamped to 1 and 0, got %v and %v", stored[0].BgLightnessFactor, stored[0].TextDarknessFactor)
+	}
+}
+
+func TestSaveUserColorPreferences_RaisesLegacyPriorities(t *testing.T) {
+	svc := newTestServiceWithSession(t)
+
+	resp, err := svc.SaveUserColorPreferences(context.Background(), &alertpb.SaveUserColorPreferencesRequest{
+		SessionId: "session-1",
+		Preferences: []*alertpb.UserColorPreference{
+			{Color: "#ff0000", ColorType: "custom", Priority: 0},
+			{Color: "#00ff00", ColorType: "custom", Priority: -3},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !resp.Success {
+		t.Fatalf("expected Success=true, got message %q", resp.Message)
+	}
+
+	user, _ := svc.db.GetUserBySession("session-1")
+	stored, err := svc.db.GetUserColorPreferences(user.ID)
+	if err != nil || len(stored) != 2 {
+		t.Fatalf("expected 2 stored preferences, got %d (%v)", len(stored), err)
+	}
+	for _, pref := range stored {
+		if pref.Priority != 1 {
+			t.Errorf("expected priority raised to 1, got %d", pref.Priority)
+		}
+	}
+}
@@ -0,0 +1,31 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	alertpb "notificator/internal/backend/proto/alert"
+)
+
+func TestAddComment_EnforcesMaxCommentLength(t *testing.T) {
+	svc := newTestServiceWithSession(t)
+	svc.SetMaxCommentLength(5)
+	ctx := context.Background()
+
+	settings, err := svc.GetCommentSettings(ctx, &alertpb.GetCommentSettingsRequest{})
+	if err != nil || settings.MaxCommentLength != 5 {
+		t.Fatalf("expected the configured limit to be reported, got %v %v", err, settings)
+	}
+
+	// Counted in characters, not bytes
+	resp, err := svc.AddComment(ctx, &alertpb.AddCommentRequest{SessionId: "session-1", AlertKey: "fp-1", Content: "héllo"})
+	if err != nil || !resp.Success {
+		t.Errorf("expected a 5-character comment to be accepted, got %v %v", err, resp)
+	}
+
+	resp, err = svc.AddComment(ctx, &alertpb.AddCommentRequest{SessionId: "session-1", AlertKey: "fp-1", Content: "hello!"})
+	if err != nil || resp.Success || !strings.Contains(resp.Message, "maximum is 5") {
+		t.Errorf("expected a 6-character comment to be rejected, got %v %v", err, resp)
+	}
+}
@@ -0,0 +1,65 @@
+package services
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"notificator/internal/backend/models"
+	alertpb "notificator/internal/backend/proto/alert"
+)
+
+func TestParseCommentTags(t *testing.T) {
+	for content, want := range map[string][]string{
+		"#RootCause found, see #followup": {"rootcause", "followup"},
+		"#rootcause again #RootCause":     {"rootcause"},
+		"issue #123 and a#b and it's":     nil,
+		"(#db-migration)":                 {"db-migration"},
+	} {
+		if got := models.ParseCommentTags(content); !reflect.DeepEqual(got, want) {
+			t.Errorf("ParseCommentTags(%q) = %v, want %v", content, got, want)
+		}
+	}
+}
+
+func TestGetCommentsByTag(t *testing.T) {
+	svc := newTestServiceWithSession(t)
+	ctx := context.Background()
+
+	var tagged []string
+	for _, comment := range []struct{ alertKey, content string }{
+		{"fp-1", "disk full #RootCause"},
+		{"fp-1", "nothing to see"},
+		{"fp-2", "same #rootcause here #followup"},
+	} {
+		resp, err := svc.AddComment(ctx, &alertpb.AddCommentRequest{SessionId: "session-1", AlertKey: comment.alertKey, Content: comment.content})
+		if err != nil || !resp.Success {
+			t.Fatalf("AddComment failed: %v %v", err, resp)
+		}
+		tagged = append(tagged, resp.Comment.Id)
+	}
+
+	resp, err := svc.GetCommentsByTag(ctx, &alertpb.GetCommentsByTagRequest{SessionId: "session-1", Tag: "#rootcause", AlertKey: "fp-1"})
+	if err != nil || !resp.Success || len(resp.Comments) != 1 || resp.Comments[0].Id != tagged[0] {
+		t.Fatalf("expected fp-1's rootcause comment, got %v %v", err, resp)
+	}
+
+	resp, err = svc.GetCommentsByTag(ctx, &alertpb.GetCommentsByTagRequest{SessionId: "session-1", Tag: "RootCause"})
+	if err != nil || len(resp.Comments) != 2 || resp.Comments[0].Id != tagged[2] {
+		t.Fatalf("expected both rootcause comments newest first, got %v %v", err, resp)
+	}
+
+	if _, err := svc.DeleteComment(ctx, &alertpb.DeleteCommentRequest{SessionId: "session-1", CommentId: tagged[2]}); err != nil {
+		t.Fatalf("DeleteComment failed: %v", err)
+	}
+	var remaining int64
+	svc.db.GetDB().Model(&models.CommentTag{}).Where("comment_id = ?", tagged[2]).Count(&remaining)
+	if remaining != 0 {
+		t.Errorf("expected the deleted comment's tags removed, got %d", remaining)
+	}
+
+	resp, err = svc.GetCommentsByTag(ctx, &alertpb.GetCommentsByTagRequest{SessionId: "session-1", Tag: "#"})
+	if err != nil || resp.Success {
+		t.Errorf("expected an empty tag to be rejected, got %v %v", err, resp)
+	}
+}
@@ -0,0 +1,69 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	alertpb "notificator/internal/backend/proto/alert"
+)
+
+func TestGetComments_Paginates(t *testing.T) {
+	db := newTestDB(t)
+
+	user := createTestUser(t, db, "alice", "")
+	for i := 0; i < 5; i++ {
+		if _, err := db.CreateComment("fp-1", user.ID, fmt.Sprintf("comment %d", i)); err != nil {
+			t.Fatalf("failed to seed comment: %v", err)
+		}
+		// Keep created_at distinct so the order is deterministic
+		time.Sleep(2 * time.Millisecond)
+	}
+
+	svc := NewAlertServiceGorm(db)
+
+	first, err := svc.GetComments(context.Background(), &alertpb.GetCommentsRequest{AlertKey: "fp-1", Limit: 2})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if first.NextCursor == "" {
+		t.Fatal("expected a cursor to the older comments")
+	}
+
+	// A comment added while paging must not shift the next page
+	if _, err := db.CreateComment("fp-1", user.ID, "comment 5"); err != nil {
+		t.Fatalf("failed to add comment: %v", err)
+	}
+
+	page, err := svc.GetComments(context.Background(), &alertpb.GetCommentsRequest{AlertKey: "fp-1", Limit: 2, Before: first.NextCursor})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if page.Count != 2 || page.TotalCount != 6 {
+		t.Fatalf("expected 2 of 6 comments, got %d of %d", page.Count, page.TotalCount)
+	}
+	if page.Comments[0].Content != "comment 2" || page.Comments[1].Content != "comment 1" {
+		t.Errorf("expected comments 2 and 1 newest first, got %q and %q", page.Comments[0].Content, page.Comments[1].Content)
+	}
+
+	last, err := svc.GetComments(context.Background(), &alertpb.GetCommentsRequest{AlertKey: "fp-1", Limit: 2, Before: page.NextCursor})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if last.Count != 1 || last.Comments[0].Content != "comment 0" || last.NextCursor != "" {
+		t.Errorf("expected only comment 0 and no further cursor, got %d comments, cursor %q", last.Count, last.NextCursor)
+	}
+
+	if _, err := svc.GetComments(context.Background(), &alertpb.GetCommentsRequest{AlertKey: "fp-1", Limit: 2, Before: "garbage"}); err == nil {
+		t.Error("expected an invalid cursor to be rejected")
+	}
+
+	all, err := svc.GetComments(context.Background(), &alertpb.GetCommentsRequest{AlertKey: "fp-1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if all.Count != 6 || all.TotalCount != 6 || all.Comments[0].Content != "comment 0" {
+		t.Errorf("expected every comment oldest first without a limit, got %d of %d", all.Count, all.TotalCount)
+	}
+}
@@ -0,0 +1,128 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"notificator/internal/backend/database"
+	"notificator/internal/backend/models"
+	alertpb "notificator/internal/backend/proto/alert"
+)
+
+// setupResolvedAlertCapture returns a service over an empty, migrated database
+func setupResolvedAlertCapture(t *testing.T) (*AlertServiceGorm, *database.GormDB) {
+	t.Helper()
+
+	db := newTestDB(t)
+
+	return NewAlertServiceGorm(db), db
+}
+
+func captureResolved(t *testing.T, svc *AlertServiceGorm, startsAt time.Time) *alertpb.CreateResolvedAlertResponse {
+	t.Helper()
+	resp, err := svc.CreateResolvedAlert(context.Background(), &alertpb.CreateResolvedAlertRequest{
+		Fingerprint:     "fp-1",
+		Source:          "test",
+		AlertData:       []byte(`{}`),
+		Comments:        []byte(`[{"content":"looking"}]`),
+		Acknowledgments: []byte(`[]`),
+		TtlHours:        48,
+		StartsAt:        timestamppb.New(startsAt),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !resp.Success {
+		t.Fatalf("expected Success=true, got message %q", resp.Message)
+	}
+	return resp
+}
+
+func TestCreateResolvedAlert_IgnoresDuplicateCaptureOfSameFiring(t *testing.T) {
+	svc, db := setupResolvedAlertCapture(t)
+	startsAt := time.Now().Add(-time.Hour)
+
+	first := captureResolved(t, svc, startsAt)
+	if first.AlreadyCaptured {
+		t.Fatal("expected the first capture to be stored")
+	}
+	if ttl := time.Until(first.ResolvedAlert.ExpiresAt.AsTime()); ttl < 47*time.Hour {
+		t.Errorf("expected the requested 48h TTL, got %v", ttl)
+	}
+
+	second := captureResolved(t, svc, startsAt)
+	if !second.AlreadyCaptured || second.ResolvedAlert.Id != first.ResolvedAlert.Id {
+		t.Errorf("expected the existing capture %s to be returned, got %+v", first.ResolvedAlert.Id, second)
+	}
+	if count := resolvedAlertCount(t, db); count != 1 {
+		t.Errorf("expected 1 resolved alert, got %d", count)
+	}
+}
+
+func TestCreateResolvedAlert_StoresLaterFiringOfSameFingerprint(t *testing.T) {
+	svc, db := setupResolvedAlertCapture(t)
+
+	captureResolved(t, svc, time.Now().Add(-time.Hour))
+	refired := captureResolved(t, svc, time.Now().Add(time.Second))
+	if refired.AlreadyCaptured {
+		t.Error("expected a firing that started after the last resolution to be stored")
+	}
+	if count := resolvedAlertCount(t, db); count != 2 {
+		t.Errorf("expected 2 resolved alerts, got %d", count)
+	}
+}
+
+func TestSaveResolvedAlertSettings_PersistsTTL(t *testing.T) {
+	svc, db := setupResolvedAlertCapture(t)
+	ctx := context.Background()
+
+	user := createTestUser(t, db, "tester", "session-1")
+
+	settings, err := svc.GetResolvedAlertSettings(ctx, &alertpb.GetResolvedAlertSettingsRequest{})
+	if err != nil || settings.TtlHours != 0 {
+		t.Fatalf("expected no TTL before one is saved, got %v %v", err, settings)
+	}
+
+	resp, err := svc.SaveResolvedAlertSettings(ctx, &alertpb.SaveResolvedAlertSettingsRequest{SessionId: "session-1", TtlHours: 12})
+	if err != nil || resp.Success {
+		t.Fatalf("expected a non-admin save to be refused, got %v %v", err, resp)
+	}
+	if err := db.SetUserAdmin(user.ID, true); err != nil {
+		t.Fatalf("failed to make tester an admin: %v", err)
+	}
+
+	for _, hours := range []int32{0, models.MaxResolvedAlertTTLHours + 1} {
+		resp, err := svc.SaveResolvedAlertSettings(ctx, &alertpb.SaveResolvedAlertSettingsRequest{SessionId: "session-1", TtlHours: hours})
+		if err != nil || resp.Success {
+			t.Errorf("expected a TTL of %dh to be rejected, got %v %v", hours, err, resp)
+		}
+	}
+
+	// Saving twice updates the single settings row
+	for _, hours := range []int32{72, 12} {
+		resp, err := svc.SaveResolvedAlertSettings(ctx, &alertpb.SaveResolvedAlertSettingsRequest{SessionId: "session-1", TtlHours: hours})
+		if err != nil || !resp.Success {
+			t.Fatalf("save failed: %v %v", err, resp)
+		}
+	}
+
+	settings, err = svc.GetResolvedAlertSettings(ctx, &alertpb.GetResolvedAlertSettingsRequest{})
+	if err != nil || settings.TtlHours != 12 {
+		t.Errorf("expected the last saved TTL of 12h, got %v %v", err, settings)
+	}
+}
+
+func TestCreateResolvedAlert_SavedTTLWinsOverRequestedTTL(t *testing.T) {
+	svc, db := setupResolvedAlertCapture(t)
+	if err := db.SetResolvedAlertTTLHours(6, "admin"); err != nil {
+		t.Fatalf("failed to save the TTL: %v", err)
+	}
+
+	resp := captureResolved(t, svc, time.Now().Add(-time.Hour))
+	if ttl := time.Until(resp.ResolvedAlert.ExpiresAt.AsTime()); ttl > 6*time.Hour || ttl < 5*time.Hour {
+		t.Errorf("expected the saved 6h TTL instead of the requested 48h, got %v", ttl)
+	}
+}
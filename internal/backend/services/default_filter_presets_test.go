@@ -0,0 +1,69 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"notificator/config"
+	"notificator/internal/backend/models"
+	alertpb "notificator/internal/backend/proto/alert"
+)
+
+func TestSeedDefaultFilterPresets_SharesPresetsOnceAcrossRestarts(t *testing.T) {
+	svc := newTestServiceWithSession(t)
+	presets := []config.DefaultFilterPresetConfig{
+		{Name: "Critical firing only", FilterData: map[string]interface{}{"severities": []string{"critical"}, "statuses": []string{"firing"}}},
+		{Name: "My team", Description: "Alerts routed to the infra team", FilterData: map[string]interface{}{"teams": []string{"infra"}}},
+	}
+
+	svc.SeedDefaultFilterPresets(presets)
+	svc.SeedDefaultFilterPresets(presets)
+
+	resp, err := svc.GetFilterPresets(context.Background(), &alertpb.GetFilterPresetsRequest{SessionId: "session-1", IncludeShared: true})
+	if err != nil || !resp.Success {
+		t.Fatalf("failed to get filter presets: %v %v", err, resp)
+	}
+	if len(resp.Presets) != 2 {
+		t.Fatalf("expected the 2 seeded presets once each, got %d", len(resp.Presets))
+	}
+	var critical *alertpb.FilterPreset
+	for _, preset := range resp.Presets {
+		if preset.UserId != models.SystemUserID || !preset.IsShared {
+			t.Errorf("expected %q to be shared by the system user, got %+v", preset.Name, preset)
+		}
+		if preset.Name == "Critical firing only" {
+			critical = preset
+		}
+	}
+	if critical == nil || string(critical.FilterData) != `{"severities":["critical"],"statuses":["firing"]}` {
+		t.Fatalf("expected the critical preset's filter data, got %+v", critical)
+	}
+
+	def, err := svc.SetDefaultFilterPreset(context.Background(), &alertpb.SetDefaultFilterPresetRequest{SessionId: "session-1", PresetId: critical.Id})
+	if err != nil || !def.Success {
+		t.Errorf("expected a seeded preset to be usable as default: %v %v", err, def)
+	}
+}
+
+func TestSystemUser_HiddenFromListingsAndBootstrap(t *testing.T) {
+	svc := newTestServiceWithSession(t)
+	svc.SeedDefaultFilterPresets([]config.DefaultFilterPresetConfig{{Name: "Everything"}})
+
+	users, total, err := svc.db.ListUsers(0, 0)
+	if err != nil {
+		t.Fatalf("failed to list users: %v", err)
+	}
+	if total != 1 || len(users) != 1 || users[0].Username != "alice" {
+		t.Errorf("expected only alice to be listed, got %d users (total %d)", len(users), total)
+	}
+	if found, _ := svc.db.SearchUsers("notificator", 10); len(found) != 0 {
+		t.Errorf("expected the system user to be left out of searches, got %+v", found)
+	}
+
+	if count, err := svc.db.CountUsers(); err != nil || count != 1 {
+		t.Errorf("expected the system user to be left out of the user count, got %d (%v)", count, err)
+	}
+	if err := svc.db.SetUserDisabled(models.SystemUserID, false); err == nil {
+		t.Errorf("expected the system user to stay disabled")
+	}
+}
@@ -0,0 +1,93 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	alertpb "notificator/internal/backend/proto/alert"
+)
+
+// setupAlertServiceWithAcks seeds one acknowledgment by the session owner and
+// one by another user on the same alert
+func setupAlertServiceWithAcks(t *testing.T) (*AlertServiceGorm, string, string) {
+	t.Helper()
+
+	db := newTestDB(t)
+
+	user := createTestUser(t, db, "alice", "session-1")
+	other := createTestUser(t, db, "bob", "")
+
+	own, err := db.CreateAcknowledgment("fp-1", user.ID, "on it", nil)
+	if err != nil {
+		t.Fatalf("failed to seed acknowledgment: %v", err)
+	}
+	foreign, err := db.CreateAcknowledgment("fp-1", other.ID, "me too", nil)
+	if err != nil {
+		t.Fatalf("failed to seed acknowledgment: %v", err)
+	}
+
+	return NewAlertServiceGorm(db), own.ID, foreign.ID
+}
+
+func TestDeleteAcknowledgment_ByID(t *testing.T) {
+	svc, ownID, foreignID := setupAlertServiceWithAcks(t)
+
+	stream := &recordingStream{updates: make(chan *alertpb.AlertUpdate, 1)}
+	sub := &Subscription{AlertKey: "fp-1", Stream: stream}
+	svc.addSubscription(sub)
+	defer svc.removeSubscription(sub)
+
+	resp, err := svc.DeleteAcknowledgment(context.Background(), &alertpb.DeleteAcknowledgmentRequest{
+		SessionId:        "session-1",
+		AlertKey:         "fp-1",
+		AcknowledgmentId: ownID,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !resp.Success {
+		t.Fatalf("expected Success=true, got message %q", resp.Message)
+	}
+
+	select {
+	case update := <-stream.updates:
+		if update.GetDeletedAcknowledgmentId() != ownID {
+			t.Errorf("expected broadcast of acknowledgment %q, got %q", ownID, update.GetDeletedAcknowledgmentId())
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected an ACKNOWLEDGMENT_DELETED broadcast")
+	}
+
+	acks, err := svc.GetAcknowledgments(context.Background(), &alertpb.GetAcknowledgmentsRequest{AlertKey: "fp-1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(acks.Acknowledgments) != 1 || acks.Acknowledgments[0].Id != foreignID {
+		t.Errorf("expected only the other user's acknowledgment to remain, got %+v", acks.Acknowledgments)
+	}
+}
+
+func TestDeleteAcknowledgment_RejectsOtherUsersAck(t *testing.T) {
+	svc, _, foreignID := setupAlertServiceWithAcks(t)
+
+	resp, err := svc.DeleteAcknowledgment(context.Background(), &alertpb.DeleteAcknowledgmentRequest{
+		SessionId:        "session-1",
+		AlertKey:         "fp-1",
+		AcknowledgmentId: foreignID,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Success {
+		t.Error("expected Success=false when deleting another user's acknowledgment")
+	}
+
+	acks, err := svc.GetAcknowledgments(context.Background(), &alertpb.GetAcknowledgmentsRequest{AlertKey: "fp-1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(acks.Acknowledgments) != 2 {
+		t.Errorf("expected both acknowledgments to remain, got %d", len(acks.Acknowledgments))
+	}
+}
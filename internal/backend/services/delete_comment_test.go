@@ -0,0 +1,98 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+
+	alertpb "notificator/internal/backend/proto/alert"
+)
+
+// recordingStream captures the updates broadcast to a subscriber
+type recordingStream struct {
+	grpc.ServerStream
+	updates chan *alertpb.AlertUpdate
+}
+
+func (r *recordingStream) Send(update *alertpb.AlertUpdate) error {
+	r.updates <- update
+	return nil
+}
+
+func setupAlertServiceWithComment(t *testing.T) (*AlertServiceGorm, string) {
+	t.Helper()
+
+	db := newTestDB(t)
+
+	user := createTestUser(t, db, "tester", "session-1")
+
+	comment, err := db.CreateComment("fp-1", user.ID, "looking into it")
+	if err != nil {
+		t.Fatalf("failed to seed comment: %v", err)
+	}
+
+	return NewAlertServiceGorm(db), comment.ID
+}
+
+func TestDeleteComment_BroadcastsDeletion(t *testing.T) {
+	svc, commentID := setupAlertServiceWithComment(t)
+
+	stream := &recordingStream{updates: make(chan *alertpb.AlertUpdate, 1)}
+	sub := &Subscription{AlertKey: "fp-1", Stream: stream}
+	svc.addSubscription(sub)
+	defer svc.removeSubscription(sub)
+
+	resp, err := svc.DeleteComment(context.Background(), &alertpb.DeleteCommentRequest{
+		SessionId: "session-1",
+		CommentId: commentID,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !resp.Success {
+		t.Fatalf("expected Success=true, got message %q", resp.Message)
+	}
+
+	select {
+	case update := <-stream.updates:
+		if update.UpdateType != alertpb.UpdateType_COMMENT_DELETED {
+			t.Errorf("expected COMMENT_DELETED, got %v", update.UpdateType)
+		}
+		if update.AlertKey != "fp-1" {
+			t.Errorf("expected alert key fp-1, got %q", update.AlertKey)
+		}
+		if update.GetDeletedCommentId() != commentID {
+			t.Errorf("expected deleted comment id %q, got %q", commentID, update.GetDeletedCommentId())
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected a COMMENT_DELETED broadcast")
+	}
+}
+
+func TestDeleteComment_UnknownCommentDoesNotBroadcast(t *testing.T) {
+	svc, _ := setupAlertServiceWithComment(t)
+
+	stream := &recordingStream{updates: make(chan *alertpb.AlertUpdate, 1)}
+	sub := &Subscription{AlertKey: "fp-1", Stream: stream}
+	svc.addSubscription(sub)
+	defer svc.removeSubscription(sub)
+
+	resp, err := svc.DeleteComment(context.Background(), &alertpb.DeleteCommentRequest{
+		SessionId: "session-1",
+		CommentId: "missing",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Success {
+		t.Error("expected Success=false for unknown comment")
+	}
+
+	select {
+	case update := <-stream.updates:
+		t.Errorf("expected no broadcast, got %v", update.UpdateType)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
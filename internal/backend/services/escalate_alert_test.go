@@ -0,0 +1,127 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"notificator/internal/backend/models"
+	alertpb "notificator/internal/backend/proto/alert"
+)
+
+func setupAlertServiceForEscalation(t *testing.T) *AlertServiceGorm {
+	t.Helper()
+
+	db := newTestDB(t)
+
+	createTestUser(t, db, "alice", "session-1")
+
+	oncall := createTestUser(t, db, "bob", "")
+	if err := db.SyncUserGroups(oncall.ID, "github", []models.OAuthGroupInfo{{Name: "sre"}}); err != nil {
+		t.Fatalf("failed to seed group: %v", err)
+	}
+
+	return NewAlertServiceGorm(db)
+}
+
+func TestEscalateAlert_RecordsAndBroadcasts(t *testing.T) {
+	svc := setupAlertServiceForEscalation(t)
+
+	stream := &recordingStream{updates: make(chan *alertpb.AlertUpdate, 1)}
+	sub := &Subscription{AlertKey: "fp-1", Stream: stream}
+	svc.addSubscription(sub)
+	defer svc.removeSubscription(sub)
+
+	resp, err := svc.EscalateAlert(context.Background(), &alertpb.EscalateAlertRequest{
+		SessionId:   "session-1",
+		AlertKey:    "fp-1",
+		EscalatedTo: "bob",
+		TargetType:  models.EscalationTargetUser,
+		Reason:      "needs a database expert",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !resp.Success {
+		t.Fatalf("expected Success=true, got message %q", resp.Message)
+	}
+	if resp.Escalation.Username != "alice" || resp.Escalation.EscalatedTo != "bob" {
+		t.Errorf("unexpected escalation: %+v", resp.Escalation)
+	}
+
+	select {
+	case update := <-stream.updates:
+		if update.UpdateType != alertpb.UpdateType_ESCALATION_ADDED {
+			t.Errorf("expected ESCALATION_ADDED, got %v", update.UpdateType)
+		}
+		if update.GetEscalation().GetId() != resp.Escalation.Id {
+			t.Errorf("expected broadcast of escalation %q, got %+v", resp.Escalation.Id, update.GetEscalation())
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected an ESCALATION_ADDED broadcast")
+	}
+
+	list, err := svc.GetEscalations(context.Background(), &alertpb.GetEscalationsRequest{AlertKey: "fp-1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if list.Count != 1 || list.Escalations[0].Reason != "needs a database expert" {
+		t.Errorf("expected the stored escalation, got %+v", list.Escalations)
+	}
+}
+
+func TestEscalateAlert_ToGroup(t *testing.T) {
+	svc := setupAlertServiceForEscalation(t)
+
+	resp, err := svc.EscalateAlert(context.Background(), &alertpb.EscalateAlertRequest{
+		SessionId:   "session-1",
+		AlertKey:    "fp-1",
+		EscalatedTo: "sre",
+		TargetType:  models.EscalationTargetGroup,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !resp.Success {
+		t.Fatalf("expected Success=true, got message %q", resp.Message)
+	}
+}
+
+func TestEscalateAlert_RejectsUnknownTargets(t *testing.T) {
+	svc := setupAlertServiceForEscalation(t)
+
+	tests := []struct {
+		name       string
+		target     string
+		targetType string
+	}{
+		{name: "unknown user", target: "carol", targetType: models.EscalationTargetUser},
+		{name: "unknown group", target: "dba", targetType: models.EscalationTargetGroup},
+		{name: "invalid target type", target: "bob", targetType: "team"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := svc.EscalateAlert(context.Background(), &alertpb.EscalateAlertRequest{
+				SessionId:   "session-1",
+				AlertKey:    "fp-1",
+				EscalatedTo: tt.target,
+				TargetType:  tt.targetType,
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if resp.Success {
+				t.Error("expected Success=false")
+			}
+		})
+	}
+
+	list, err := svc.GetEscalations(context.Background(), &alertpb.GetEscalationsRequest{AlertKey: "fp-1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if list.Count != 0 {
+		t.Errorf("expected no escalations to be stored, got %d", list.Count)
+	}
+}
@@ -0,0 +1,100 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"notificator/internal/backend/models"
+	alertpb "notificator/internal/backend/proto/alert"
+)
+
+// seedResolvedAlert stores a resolved alert and backdates its resolution by ago
+func seedResolvedAlert(t *testing.T, svc *AlertServiceGorm, fingerprint, source string, ago time.Duration) {
+	t.Helper()
+
+	stored, err := svc.db.CreateResolvedAlert(fingerprint, source, []byte(`{}`), nil, nil, 24)
+	if err != nil {
+		t.Fatalf("failed to seed resolved alert: %v", err)
+	}
+	if err := svc.db.GetDB().Model(&models.ResolvedAlert{}).Where("id = ?", stored.ID).
+		Update("resolved_at", time.Now().Add(-ago)).Error; err != nil {
+		t.Fatalf("failed to backdate resolved alert: %v", err)
+	}
+}
+
+// setupResolvedAlertSearch seeds four resolved alerts across two sources,
+// resolved 1 to 4 hours ago
+func setupResolvedAlertSearch(t *testing.T) *AlertServiceGorm {
+	t.Helper()
+
+	svc, _ := setupResolvedAlertCapture(t)
+	seedResolvedAlert(t, svc, "abc1", "prod", time.Hour)
+	seedResolvedAlert(t, svc, "abc2", "staging", 2*time.Hour)
+	seedResolvedAlert(t, svc, "abd3", "prod", 3*time.Hour)
+	seedResolvedAlert(t, svc, "a_c4", "prod", 4*time.Hour)
+	return svc
+}
+
+func searchResolved(t *testing.T, svc *AlertServiceGorm, req *alertpb.GetResolvedAlertsRequest) ([]string, int32) {
+	t.Helper()
+
+	resp, err := svc.GetResolvedAlerts(context.Background(), req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !resp.Success {
+		t.Fatalf("expected Success=true, got message %q", resp.Message)
+	}
+	fingerprints := make([]string, len(resp.ResolvedAlerts))
+	for i, alert := range resp.ResolvedAlerts {
+		fingerprints[i] = alert.Fingerprint
+	}
+	return fingerprints, resp.TotalCount
+}
+
+func TestGetResolvedAlerts_FiltersAndCountsMatches(t *testing.T) {
+	svc := setupResolvedAlertSearch(t)
+
+	got, total := searchResolved(t, svc, &alertpb.GetResolvedAlertsRequest{Source: "prod", FingerprintPrefix: "ab", Limit: 1})
+	if total != 2 || len(got) != 1 || got[0] != "abc1" {
+		t.Errorf("expected newest of 2 prod matches, got %v of %d", got, total)
+	}
+
+	// LIKE wildcards in the prefix match literally
+	got, total = searchResolved(t, svc, &alertpb.GetResolvedAlertsRequest{FingerprintPrefix: "a_"})
+	if total != 1 || len(got) != 1 || got[0] != "a_c4" {
+		t.Errorf("expected only a_c4, got %v of %d", got, total)
+	}
+
+	got, total = searchResolved(t, svc, &alertpb.GetResolvedAlertsRequest{
+		ResolvedAfter:  timestamppb.New(time.Now().Add(-3*time.Hour - time.Minute)),
+		ResolvedBefore: timestamppb.New(time.Now().Add(-time.Hour - time.Minute)),
+		SortOrder:      "asc",
+	})
+	if total != 2 || len(got) != 2 || got[0] != "abd3" || got[1] != "abc2" {
+		t.Errorf("expected abd3 then abc2, got %v of %d", got, total)
+	}
+}
+
+func TestGetResolvedAlerts_RejectsInvalidQueries(t *testing.T) {
+	svc := setupResolvedAlertSearch(t)
+
+	for name, req := range map[string]*alertpb.GetResolvedAlertsRequest{
+		"bad sort": {SortOrder: "sideways"},
+		"empty range": {
+			ResolvedAfter:  timestamppb.New(time.Now()),
+			ResolvedBefore: timestamppb.New(time.Now().Add(-time.Hour)),
+		},
+	} {
+		resp, err := svc.GetResolvedAlerts(context.Background(), req)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", name, err)
+		}
+		if resp.Success {
+			t.Errorf("%s: expected Success=false", name)
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"notificator/config"
+	"notificator/internal/backend/database"
+	alertpb "notificator/internal/backend/proto/alert"
+)
+
+// setupHealthCheckDB opens a sqlite database, migrating it only when asked
+func setupHealthCheckDB(t *testing.T, migrate bool) *database.GormDB {
+	t.Helper()
+
+	db, err := database.NewGormDB("sqlite", config.DatabaseConfig{SQLitePath: t.TempDir() + "/test.db"})
+	if err != nil {
+		t.Fatalf("failed to create test database: %v", err)
+	}
+	if migrate {
+		if err := db.AutoMigrate(); err != nil {
+			t.Fatalf("failed to migrate test database: %v", err)
+		}
+	}
+	return db
+}
+
+func TestHealthCheck_ReadyAfterMigrations(t *testing.T) {
+	svc := NewAlertServiceGorm(setupHealthCheckDB(t, true))
+
+	resp, err := svc.HealthCheck(context.Background(), &alertpb.HealthCheckRequest{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Status != database.ReadinessReady || !resp.DatabaseUp || !resp.Migrated {
+		t.Errorf("expected a ready, migrated database, got %+v", resp)
+	}
+	if resp.DatabaseType != "sqlite" {
+		t.Errorf("expected database type sqlite, got %q", resp.DatabaseType)
+	}
+}
+
+func TestHealthCheck_DegradedWithoutMigrations(t *testing.T) {
+	svc := NewAlertServiceGorm(setupHealthCheckDB(t, false))
+
+	resp, err := svc.HealthCheck(context.Background(), &alertpb.HealthCheckRequest{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Status != database.ReadinessDegraded || !resp.DatabaseUp || resp.Migrated {
+		t.Errorf("expected a degraded, unmigrated database, got %+v", resp)
+	}
+}
+
+func TestHealthCheck_UnavailableWhenDatabaseClosed(t *testing.T) {
+	db := setupHealthCheckDB(t, true)
+	if err := db.Close(); err != nil {
+		t.Fatalf("failed to close database: %v", err)
+	}
+	svc := NewAlertServiceGorm(db)
+
+	resp, err := svc.HealthCheck(context.Background(), &alertpb.HealthCheckRequest{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Status != database.ReadinessUnavailable || resp.DatabaseUp || resp.Message != "database unavailable" {
+		t.Errorf("expected an unavailable database with a generic error, got %+v", resp)
+	}
+}
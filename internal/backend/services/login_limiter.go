@@ -0,0 +1,177 @@
+package services
+
+import (
+	"context"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// ForwardedForMetadataKey carries the end user's address when the caller
+// (the WebUI) proxies a login on their behalf
+const ForwardedForMetadataKey = "x-forwarded-for"
+
+// LoginLimiter throttles failed logins per key (a username or a client IP).
+// After maxAttempts failures within window the key is locked out; each
+// further lockout doubles, from lockout up to maxLockout. State is in memory,
+// so it resets when the backend restarts.
+type LoginLimiter struct {
+	maxAttempts int
+	window      time.Duration
+	lockout     time.Duration
+	maxLockout  time.Duration
+
+	mu       sync.Mutex
+	attempts map[string]*loginAttempts
+	now      func() time.Time
+}
+
+type loginAttempts struct {
+	failures    []time.Time
+	lockouts    int
+	lockedUntil time.Time
+}
+
+// NewLoginLimiter creates a limiter; maxAttempts <= 0 disables it
+func NewLoginLimiter(maxAttempts int, window, lockout, maxLockout time.Duration) *LoginLimiter {
+	return &LoginLimiter{
+		maxAttempts: maxAttempts,
+		window:      window,
+		lockout:     lockout,
+		maxLockout:  maxLockout,
+		attempts:    make(map[string]*loginAttempts),
+		now:         time.Now,
+	}
+}
+
+// Allowed reports whether none of the keys is currently locked out
+func (l *LoginLimiter) Allowed(keys ...string) bool {
+	if l == nil || l.maxAttempts <= 0 {
+		return true
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	for _, key := range keys {
+		if entry, ok := l.attempts[key]; ok && now.Before(entry.lockedUntil) {
+			return false
+		}
+	}
+	return true
+}
+
+// RecordFailure counts a failed login against each key
+func (l *LoginLimiter) RecordFailure(keys ...string) {
+	if l == nil || l.maxAttempts <= 0 {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	for _, key := range keys {
+		entry, ok := l.attempts[key]
+		if !ok {
+			entry = &loginAttempts{}
+			l.attempts[key] = entry
+		}
+
+		entry.failures = append(pruneFailures(entry.failures, now.Add(-l.window)), now)
+		if len(entry.failures) < l.maxAttempts {
+			continue
+		}
+
+		duration := l.lockout << entry.lockouts
+		if duration <= 0 || duration > l.maxLockout {
+			duration = l.maxLockout
+		} else {
+			entry.lockouts++
+		}
+		entry.lockedUntil = now.Add(duration)
+		entry.failures = nil
+	}
+}
+
+// RecordSuccess forgets the failures of a key after a successful login
+func (l *LoginLimiter) RecordSuccess(key string) {
+	if l == nil {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	delete(l.attempts, key)
+}
+
+// Cleanup drops keys that are neither locked out nor have recent failures
+func (l *LoginLimiter) Cleanup() {
+	if l == nil {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	for key, entry := range l.attempts {
+		// Lockout escalation is remembered for one window after the lockout ends
+		if now.After(entry.lockedUntil.Add(l.window)) && len(pruneFailures(entry.failures, now.Add(-l.window))) == 0 {
+			delete(l.attempts, key)
+		}
+	}
+}
+
+func pruneFailures(failures []time.Time, cutoff time.Time) []time.Time {
+	kept := failures[:0]
+	for _, failure := range failures {
+		if failure.After(cutoff) {
+			kept = append(kept, failure)
+		}
+	}
+	return kept
+}
+
+// ClientIPFromContext returns the address of the user behind a gRPC call: the
+// first x-forwarded-for entry when a trusted proxy (the WebUI) sent one,
+// otherwise the peer address. Forwarded addresses from other peers are ignored
+// so callers cannot pick the address they are throttled under.
+func ClientIPFromContext(ctx context.Context, trustedProxies []*net.IPNet) string {
+	peerIP := "unknown"
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		peerIP = p.Addr.String()
+		if host, _, err := net.SplitHostPort(peerIP); err == nil {
+			peerIP = host
+		}
+	}
+
+	if !ipInNets(net.ParseIP(peerIP), trustedProxies) {
+		return peerIP
+	}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(ForwardedForMetadataKey); len(values) > 0 {
+			if ip := strings.TrimSpace(strings.Split(values[0], ",")[0]); ip != "" {
+				return ip
+			}
+		}
+	}
+	return peerIP
+}
+
+func ipInNets(ip net.IP, nets []*net.IPNet) bool {
+	if ip == nil {
+		return false
+	}
+	for _, n := range nets {
+		if n.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,130 @@
+package services
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	authpb "notificator/internal/backend/proto/auth"
+)
+
+func TestLoginLimiter_LocksOutAndEscalates(t *testing.T) {
+	now := time.Now()
+	limiter := NewLoginLimiter(3, 10*time.Minute, time.Minute, 5*time.Minute)
+	limiter.now = func() time.Time { return now }
+
+	for i := 0; i < 2; i++ {
+		limiter.RecordFailure("user:alice")
+	}
+	if !limiter.Allowed("user:alice") {
+		t.Fatal("expected no lockout below the threshold")
+	}
+
+	limiter.RecordFailure("user:alice")
+	if limiter.Allowed("user:alice") {
+		t.Fatal("expected a lockout after 3 failures")
+	}
+	if !limiter.Allowed("user:bob") {
+		t.Error("expected other keys to be unaffected")
+	}
+
+	now = now.Add(time.Minute + time.Second)
+	if !limiter.Allowed("user:alice") {
+		t.Fatal("expected the first lockout to last one minute")
+	}
+
+	for i := 0; i < 3; i++ {
+		limiter.RecordFailure("user:alice")
+	}
+	now = now.Add(time.Minute + time.Second)
+	if limiter.Allowed("user:alice") {
+		t.Error("expected the second lockout to last longer than the first")
+	}
+	now = now.Add(time.Minute)
+	if !limiter.Allowed("user:alice") {
+		t.Error("expected the second lockout to last two minutes")
+	}
+}
+
+func TestLoginLimiter_SuccessAndCleanup(t *testing.T) {
+	now := time.Now()
+	limiter := NewLoginLimiter(2, 10*time.Minute, time.Minute, time.Hour)
+	limiter.now = func() time.Time { return now }
+
+	limiter.RecordFailure("user:alice")
+	limiter.RecordSuccess("user:alice")
+	limiter.RecordFailure("user:alice")
+	if !limiter.Allowed("user:alice") {
+		t.Error("expected a successful login to reset the failure count")
+	}
+
+	limiter.RecordFailure("ip:10.0.0.1")
+	now = now.Add(11 * time.Minute)
+	limiter.Cleanup()
+	if len(limiter.attempts) != 0 {
+		t.Errorf("expected stale entries to be dropped, got %d", len(limiter.attempts))
+	}
+}
+
+func TestClientIPFromContext(t *testing.T) {
+	_, webui, _ := net.ParseCIDR("10.0.0.0/24")
+	trusted := []*net.IPNet{webui}
+
+	ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("10.0.0.5"), Port: 4242}})
+	if ip := ClientIPFromContext(ctx, trusted); ip != "10.0.0.5" {
+		t.Errorf("expected peer address, got %q", ip)
+	}
+
+	ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(ForwardedForMetadataKey, "203.0.113.7, 10.0.0.5"))
+	if ip := ClientIPFromContext(ctx, trusted); ip != "203.0.113.7" {
+		t.Errorf("expected forwarded address, got %q", ip)
+	}
+	if ip := ClientIPFromContext(ctx, nil); ip != "10.0.0.5" {
+		t.Errorf("expected the forwarded address to be ignored without trusted proxies, got %q", ip)
+	}
+
+	spoofed := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("198.51.100.9"), Port: 4242}})
+	spoofed = metadata.NewIncomingContext(spoofed, metadata.Pairs(ForwardedForMetadataKey, "203.0.113.7"))
+	if ip := ClientIPFromContext(spoofed, trusted); ip != "198.51.100.9" {
+		t.Errorf("expected an untrusted peer's forwarded address to be ignored, got %q", ip)
+	}
+}
+
+func TestLogin_RateLimited(t *testing.T) {
+	db := newTestDB(t)
+	hash, err := bcrypt.GenerateFromPassword([]byte("secret"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatalf("failed to hash password: %v", err)
+	}
+	if _, err := db.CreateUser("alice", "alice@example.com", string(hash)); err != nil {
+		t.Fatalf("failed to create user: %v", err)
+	}
+
+	svc := NewAuthServiceGorm(db, nil)
+	svc.SetLoginLimiter(NewLoginLimiter(2, time.Minute, time.Minute, time.Hour))
+
+	login := func(username, password string) *authpb.LoginResponse {
+		resp, err := svc.Login(context.Background(), &authpb.LoginRequest{Username: username, Password: password})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return resp
+	}
+
+	login("alice", "wrong")
+	login("alice", "wrong")
+	if resp := login("alice", "secret"); resp.Success {
+		t.Fatal("expected the correct password to be refused while locked out")
+	}
+
+	login("nobody", "wrong")
+	locked := login("nobody", "wrong")
+	if locked.Message != login("alice", "wrong").Message {
+		t.Errorf("expected the same lockout message for unknown users, got %q", locked.Message)
+	}
+}
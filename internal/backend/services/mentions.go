@@ -0,0 +1,85 @@
+package services
+
+import (
+	"log"
+	"regexp"
+	"strings"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"notificator/internal/backend/models"
+	alertpb "notificator/internal/backend/proto/alert"
+)
+
+// mentionPattern matches @username at the start of a comment or after a
+// character that can't end an email address's local part
+var mentionPattern = regexp.MustCompile(`(?:^|[^\w.+@-])@(\w[\w.+-]*)`)
+
+// parseMentions returns the distinct usernames mentioned in content
+func parseMentions(content string) []string {
+	var usernames []string
+	seen := make(map[string]bool)
+	for _, match := range mentionPattern.FindAllStringSubmatch(content, -1) {
+		// A sentence ending right after the name isn't part of it
+		username := strings.TrimRight(match[1], ".")
+		if username == "" || seen[username] {
+			continue
+		}
+		seen[username] = true
+		usernames = append(usernames, username)
+	}
+	return usernames
+}
+
+// notifyMentions records the users a new comment mentions and sends each of
+// them a MENTION update. Words like @everyone that aren't usernames are ignored.
+func (s *AlertServiceGorm) notifyMentions(comment *models.CommentWithUser) {
+	usernames := parseMentions(comment.Content)
+	if len(usernames) == 0 {
+		return
+	}
+
+	mentioned, err := s.db.CreateMentions(comment.ID, comment.AlertKey, comment.UserID, usernames)
+	if err != nil {
+		log.Printf("Error recording mentions for comment %s: %v", comment.ID, err)
+		return
+	}
+
+	for _, user := range mentioned {
+		s.broadcastToUser(user.ID, &alertpb.AlertUpdate{
+			AlertKey:   comment.AlertKey,
+			UpdateType: alertpb.UpdateType_MENTION,
+			UpdateData: &alertpb.AlertUpdate_Mention{Mention: &alertpb.Mention{
+				CommentId:   comment.ID,
+				AlertKey:    comment.AlertKey,
+				MentionedBy: comment.Username,
+				Content:     comment.Content,
+				CreatedAt:   timestamppb.New(comment.CreatedAt),
+			}},
+			Timestamp: timestamppb.Now(),
+		})
+	}
+}
+
+// broadcastToUser queues update on every active subscription of userID,
+// whichever alert it follows
+func (s *AlertServiceGorm) broadcastToUser(userID string, update *alertpb.AlertUpdate) {
+	var subs []*Subscription
+	s.subsMutex.RLock()
+	for _, alertSubs := range s.subscriptions {
+		for _, sub := range alertSubs {
+			if sub.UserID == userID {
+				subs = append(subs, sub)
+			}
+		}
+	}
+	s.subsMutex.RUnlock()
+
+	for _, sub := range subs {
+		select {
+		case sub.updates <- update:
+		default:
+			log.Printf("Dropping %s update for user %s: subscriber is not keeping up", update.UpdateType, userID)
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package services
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"notificator/internal/backend/models"
+	alertpb "notificator/internal/backend/proto/alert"
+)
+
+func TestParseMentions(t *testing.T) {
+	for content, want := range map[string][]string{
+		"@alice can you check?":             {"alice"},
+		"cc @bob.smith and @alice, @alice.": {"bob.smith", "alice"},
+		"mail carol@example.com instead":    nil,
+		"(@dave) @@erin":                    {"dave"},
+		"no mentions here":                  nil,
+	} {
+		if got := parseMentions(content); !reflect.DeepEqual(got, want) {
+			t.Errorf("parseMentions(%q) = %v, want %v", content, got, want)
+		}
+	}
+}
+
+func TestAddComment_SendsMentionToMentionedUsersSubscriptions(t *testing.T) {
+	svc, stream := setupSubscribedAlertService(t)
+	bob := createTestUser(t, svc.db, "bob", "session-2")
+
+	// alice follows fp-1 only; the mention on fp-9 still reaches her
+	resp, err := svc.AddComment(context.Background(), &alertpb.AddCommentRequest{
+		SessionId: "session-2",
+		AlertKey:  "fp-9",
+		Content:   "@alice can you check? cc @everyone @bob bob@example.com",
+	})
+	if err != nil || !resp.Success {
+		t.Fatalf("AddComment failed: %v %v", err, resp)
+	}
+
+	updates := stream.waitForUpdates(t, 2)
+	mention := updates[1].GetMention()
+	if updates[1].UpdateType != alertpb.UpdateType_MENTION || mention == nil {
+		t.Fatalf("expected a mention update, got %v", updates[1])
+	}
+	if mention.AlertKey != "fp-9" || mention.MentionedBy != "bob" || mention.CommentId != resp.Comment.Id {
+		t.Errorf("unexpected mention %+v", mention)
+	}
+
+	var mentions []models.Mention
+	if err := svc.db.GetDB().Find(&mentions).Error; err != nil {
+		t.Fatalf("failed to load mentions: %v", err)
+	}
+	if len(mentions) != 1 || mentions[0].UserID == bob.ID || mentions[0].AuthorID != bob.ID {
+		t.Fatalf("expected only alice's mention recorded, got %+v", mentions)
+	}
+
+	if _, err := svc.DeleteComment(context.Background(), &alertpb.DeleteCommentRequest{SessionId: "session-2", CommentId: resp.Comment.Id}); err != nil {
+		t.Fatalf("DeleteComment failed: %v", err)
+	}
+	var remaining int64
+	svc.db.GetDB().Model(&models.Mention{}).Count(&remaining)
+	if remaining != 0 {
+		t.Errorf("expected the comment's mentions removed with it, got %d", remaining)
+	}
+}
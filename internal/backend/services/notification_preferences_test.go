@@ -0,0 +1,71 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	alertpb "notificator/internal/backend/proto/alert"
+)
+
+func saveNotificationPreferences(t *testing.T, svc *AlertServiceGorm, dnd *alertpb.DoNotDisturbSchedule) *alertpb.SaveNotificationPreferencesResponse {
+	t.Helper()
+	resp, err := svc.SaveNotificationPreferences(context.Background(), &alertpb.SaveNotificationPreferencesRequest{
+		SessionId:                 "session-1",
+		EnabledSeverities:         []string{"critical"},
+		SoundNotificationsEnabled: true,
+		Dnd:                       dnd,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return resp
+}
+
+func TestSaveNotificationPreferences_RejectsInvalidDNDSchedule(t *testing.T) {
+	svc := newTestServiceWithSession(t)
+
+	for name, dnd := range map[string]*alertpb.DoNotDisturbSchedule{
+		"no days":          {Enabled: true, StartTime: "22:00", EndTime: "07:00"},
+		"day out of range": {Enabled: true, Days: []int32{7}, StartTime: "22:00", EndTime: "07:00"},
+		"missing end":      {Enabled: true, Days: []int32{1}, StartTime: "22:00"},
+		"bad time":         {Enabled: true, Days: []int32{1}, StartTime: "25:00", EndTime: "07:00"},
+		"one-digit hour":   {Enabled: true, Days: []int32{1}, StartTime: "22:00", EndTime: "7:00"},
+		"empty window":     {Enabled: true, Days: []int32{1}, StartTime: "08:00", EndTime: "08:00"},
+		"bad timezone":     {Enabled: true, Days: []int32{1}, StartTime: "22:00", EndTime: "07:00", Timezone: "Mars/Olympus"},
+	} {
+		if resp := saveNotificationPreferences(t, svc, dnd); resp.Success {
+			t.Errorf("%s: expected Success=false", name)
+		}
+	}
+}
+
+func TestSaveNotificationPreferences_KeepsDNDScheduleWhenOmitted(t *testing.T) {
+	svc := newTestServiceWithSession(t)
+
+	schedule := &alertpb.DoNotDisturbSchedule{
+		Enabled:       true,
+		Days:          []int32{1, 2, 3, 4, 5},
+		StartTime:     "22:00",
+		EndTime:       "07:00",
+		Timezone:      "Europe/Paris",
+		AllowCritical: true,
+	}
+	if resp := saveNotificationPreferences(t, svc, schedule); !resp.Success {
+		t.Fatalf("expected Success=true, got message %q", resp.Message)
+	}
+
+	// A client that only toggles the other settings leaves the schedule alone
+	if resp := saveNotificationPreferences(t, svc, nil); !resp.Success {
+		t.Fatalf("expected Success=true, got message %q", resp.Message)
+	}
+
+	resp, err := svc.GetNotificationPreferences(context.Background(), &alertpb.GetNotificationPreferencesRequest{SessionId: "session-1"})
+	if err != nil || !resp.Success {
+		t.Fatalf("failed to get preferences: %v %v", err, resp)
+	}
+	dnd := resp.Preferences.Dnd
+	if dnd == nil || !dnd.Enabled || len(dnd.Days) != 5 || dnd.StartTime != "22:00" || dnd.EndTime != "07:00" ||
+		dnd.Timezone != "Europe/Paris" || !dnd.AllowCritical {
+		t.Errorf("expected the stored schedule back, got %+v", dnd)
+	}
+}
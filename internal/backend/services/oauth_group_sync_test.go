@@ -0,0 +1,146 @@
+package services
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"notificator/config"
+	"notificator/internal/backend/models"
+)
+
+// setupGroupSync starts a fake "corp" identity provider that renews tokens
+// with the refresh token "refresh-ok" and answers group requests for the
+// access token "fresh-token" with *groups, or a 500 when it is empty
+func setupGroupSync(t *testing.T, groups *string) (*AlertServiceGorm, *OAuthService) {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil || r.PostForm.Get("refresh_token") != "refresh-ok" {
+			http.Error(w, `{"error":"invalid_grant"}`, http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"access_token":"fresh-token","refresh_token":"refresh-ok","token_type":"Bearer","expires_in":3600}`))
+	})
+	authorized := func(next http.HandlerFunc) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("Authorization") != "Bearer fresh-token" {
+				http.Error(w, "unauthorized", http.StatusUnauthorized)
+				return
+			}
+			next(w, r)
+		}
+	}
+	mux.HandleFunc("/userinfo", authorized(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"sub":"carol-id","username":"carol"}`))
+	}))
+	mux.HandleFunc("/groups", authorized(func(w http.ResponseWriter, r *http.Request) {
+		if *groups == "" {
+			http.Error(w, "unavailable", http.StatusInternalServerError)
+			return
+		}
+		w.Write([]byte(*groups))
+	}))
+	idp := httptest.NewServer(mux)
+	t.Cleanup(idp.Close)
+
+	svc := newTestServiceWithSession(t)
+	oauthService, err := NewOAuthService(svc.db, &config.OAuthPortalConfig{
+		Enabled:     true,
+		RedirectURL: "https://notificator.example.com/api/v1/oauth",
+		Providers: map[string]config.OAuthProvider{
+			"corp": {
+				ClientID:     "client",
+				ClientSecret: "secret",
+				Scopes:       []string{"openid"},
+				AuthURL:      idp.URL + "/authorize",
+				TokenURL:     idp.URL + "/token",
+				UserInfoURL:  idp.URL + "/userinfo",
+				GroupsURL:    idp.URL + "/groups",
+				Enabled:      true,
+			},
+		},
+		GroupSync: config.GroupSyncConfig{Enabled: true},
+	})
+	if err != nil {
+		t.Fatalf("failed to create OAuth service: %v", err)
+	}
+	return svc, oauthService
+}
+
+func createOAuthUserWithToken(t *testing.T, svc *AlertServiceGorm, username, refreshToken string) *models.User {
+	t.Helper()
+
+	user, err := svc.db.CreateOAuthUser("corp", username+"-id", &models.OAuthUserInfo{Username: username, Email: username + "@example.com"})
+	if err != nil {
+		t.Fatalf("failed to create OAuth user: %v", err)
+	}
+	expired := time.Now().Add(-time.Hour)
+	if err := svc.db.StoreOAuthToken(user.ID, "corp", "stale-token", refreshToken, "Bearer", &expired, nil); err != nil {
+		t.Fatalf("failed to store OAuth token: %v", err)
+	}
+	return user
+}
+
+func TestSyncAllUserGroups_RefreshesTokensAndSkipsUnrefreshable(t *testing.T) {
+	groupsBody := `["sre"]`
+	svc, oauthService := setupGroupSync(t, &groupsBody)
+	carol := createOAuthUserWithToken(t, svc, "carol", "refresh-ok")
+	dave := createOAuthUserWithToken(t, svc, "dave", "")
+	erin := createOAuthUserWithToken(t, svc, "erin", "refresh-ok")
+	if err := svc.db.SetUserDisabled(erin.ID, true); err != nil {
+		t.Fatalf("failed to disable erin: %v", err)
+	}
+
+	synced, skipped, err := oauthService.SyncAllUserGroups()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if synced != 1 || skipped != 1 {
+		t.Errorf("expected carol synced and dave skipped, got %d synced and %d skipped", synced, skipped)
+	}
+
+	groups, err := svc.db.GetUserGroups(carol.ID)
+	if err != nil || len(groups) != 1 || groups[0].GroupName != "sre" {
+		t.Errorf("expected carol in the sre group, got %+v (%v)", groups, err)
+	}
+	token, err := svc.db.GetOAuthToken(carol.ID, "corp")
+	if err != nil || token.AccessToken != "fresh-token" || token.ExpiresAt == nil || !token.ExpiresAt.After(time.Now()) {
+		t.Errorf("expected carol's refreshed token to be saved, got %+v (%v)", token, err)
+	}
+
+	for _, user := range []*models.User{dave, erin} {
+		if groups, _ := svc.db.GetUserGroups(user.ID); len(groups) != 0 {
+			t.Errorf("expected no groups for %s, got %+v", user.Username, groups)
+		}
+	}
+}
+
+func TestSyncUserGroups_ClearsLastGroupAndKeepsGroupsOnFailure(t *testing.T) {
+	groupsBody := `["sre"]`
+	svc, oauthService := setupGroupSync(t, &groupsBody)
+	carol := createOAuthUserWithToken(t, svc, "carol", "refresh-ok")
+
+	if _, err := oauthService.SyncUserGroups(carol.ID, "corp"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	groupsBody = ""
+	if synced, skipped, _ := oauthService.SyncAllUserGroups(); synced != 0 || skipped != 1 {
+		t.Errorf("expected a failed group fetch to be skipped, got %d synced and %d skipped", synced, skipped)
+	}
+	if groups, _ := svc.db.GetUserGroups(carol.ID); len(groups) != 1 {
+		t.Errorf("expected carol to keep their groups after a failed fetch, got %+v", groups)
+	}
+
+	groupsBody = `[]`
+	if _, err := oauthService.SyncUserGroups(carol.ID, "corp"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if groups, _ := svc.db.GetUserGroups(carol.ID); len(groups) != 0 {
+		t.Errorf("expected carol's last group to be removed, got %+v", groups)
+	}
+}
@@ -3,6 +3,7 @@ package services
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"log"
@@ -17,6 +18,10 @@ import (
 	"notificator/internal/backend/models"
 )
 
+// ErrOAuthTokenUnavailable is returned by SyncUserGroups when the user has no
+// stored token or it expired and cannot be refreshed
+var ErrOAuthTokenUnavailable = errors.New("OAuth token not found or expired")
+
 type OAuthService struct {
 	db         *database.GormDB
 	config     *config.OAuthPortalConfig
@@ -185,6 +190,7 @@ func (s *OAuthService) GetUserInfo(provider string, token *oauth2.Token) (*model
 			log.Printf("⚠️ Failed to get user groups for %s: %v", provider, err)
 		} else {
 			userInfo.Groups = groups
+			userInfo.GroupsFetched = true
 		}
 	}
 
@@ -511,6 +517,8 @@ func (s *OAuthService) getGenericGroups(provider string, token *oauth2.Token, cl
 			return nil, fmt.Errorf("failed to parse groups: %w", err)
 		}
 
+		// The failed decode above can leave empty entries behind
+		groups = nil
 		for _, name := range groupNames {
 			groups = append(groups, models.OAuthGroupInfo{
 				Name: name,
@@ -531,7 +539,8 @@ func (s *OAuthService) CreateOrUpdateOAuthUser(provider string, userInfo *models
 			return nil, fmt.Errorf("failed to update existing OAuth user: %w", err)
 		}
 
-		if s.config.ShouldSyncGroups(provider) && len(userInfo.Groups) > 0 {
+		// A failed group fetch keeps the stored groups; an empty list clears them
+		if s.config.ShouldSyncGroups(provider) && userInfo.GroupsFetched {
 			if err := s.db.SyncUserGroups(user.ID, provider, userInfo.Groups); err != nil {
 				log.Printf("⚠️ Failed to sync groups for user %s: %v", user.ID, err)
 			}
@@ -550,6 +559,95 @@ func (s *OAuthService) CreateOrUpdateOAuthUser(provider string, userInfo *models
 	return user, nil
 }
 
+// SyncUserGroups refreshes a user's groups from the provider with their
+// stored token and returns how many were synced. A token renewed through its
+// refresh token is saved back for the next sync.
+func (s *OAuthService) SyncUserGroups(userID, provider string) (int, error) {
+	stored, err := s.db.GetOAuthToken(userID, provider)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %v", ErrOAuthTokenUnavailable, err)
+	}
+
+	token, err := s.refreshStoredToken(stored)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %v", ErrOAuthTokenUnavailable, err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	groups, err := s.getUserGroups(provider, token, s.clients[provider].Client(ctx, token))
+	if err != nil {
+		return 0, fmt.Errorf("failed to get user groups: %w", err)
+	}
+
+	// Sync even an empty list so leaving the last group is propagated
+	if err := s.db.SyncUserGroups(userID, provider, groups); err != nil {
+		return 0, fmt.Errorf("failed to update user groups: %w", err)
+	}
+	return len(groups), nil
+}
+
+// refreshStoredToken returns a valid token for stored, using its refresh
+// token when it has expired and persisting the renewed token
+func (s *OAuthService) refreshStoredToken(stored *models.OAuthToken) (*oauth2.Token, error) {
+	client, exists := s.clients[stored.Provider]
+	if !exists {
+		return nil, fmt.Errorf("provider %s not configured", stored.Provider)
+	}
+
+	token := &oauth2.Token{
+		AccessToken:  stored.AccessToken,
+		RefreshToken: stored.RefreshToken,
+		TokenType:    stored.TokenType,
+	}
+	if stored.ExpiresAt != nil {
+		token.Expiry = *stored.ExpiresAt
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	fresh, err := client.TokenSource(ctx, token).Token()
+	if err != nil {
+		return nil, err
+	}
+
+	if fresh.AccessToken != stored.AccessToken {
+		var expiresAt *time.Time
+		if !fresh.Expiry.IsZero() {
+			expiresAt = &fresh.Expiry
+		}
+		if err := s.db.RefreshOAuthToken(stored.UserID, stored.Provider, fresh.AccessToken, fresh.RefreshToken, expiresAt); err != nil {
+			log.Printf("⚠️ Failed to save refreshed OAuth token for user %s: %v", stored.UserID, err)
+		}
+	}
+	return fresh, nil
+}
+
+// SyncAllUserGroups refreshes the groups of every enabled OAuth user with a
+// stored token for a provider that syncs groups. Users whose token cannot be
+// refreshed or whose provider rejects it are logged and skipped.
+func (s *OAuthService) SyncAllUserGroups() (synced, skipped int, err error) {
+	tokens, err := s.db.ListActiveOAuthTokens()
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to list OAuth tokens: %w", err)
+	}
+
+	for _, token := range tokens {
+		if !s.config.ShouldSyncGroups(token.Provider) {
+			continue
+		}
+		if _, err := s.SyncUserGroups(token.UserID, token.Provider); err != nil {
+			log.Printf("⚠️ Skipping group sync for user %s (provider: %s): %v", token.UserID, token.Provider, err)
+			skipped++
+			continue
+		}
+		synced++
+	}
+	return synced, skipped, nil
+}
+
 func (s *OAuthService) LogActivity(userID *string, provider, action string, success bool, errorMsg, ipAddress, userAgent string, metadata map[string]interface{}) {
 	if err := s.db.LogOAuthActivity(userID, provider, action, success, errorMsg, ipAddress, userAgent, metadata); err != nil {
 		log.Printf("⚠️ Failed to log OAuth activity: %v", err)
@@ -0,0 +1,85 @@
+package services
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+
+	"notificator/config"
+	"notificator/internal/backend/database"
+	"notificator/internal/backend/models"
+)
+
+// PermissionChecker decides which alert actions a user may take from the
+// OAuth groups they belong to. A nil checker allows every action, which keeps
+// deployments without group permissions working as before.
+type PermissionChecker struct {
+	db     *database.GormDB
+	grants map[string][]string // lowercased group name -> permissions
+}
+
+// NewPermissionChecker returns a checker for oauth.group_sync.permissions, or
+// nil when OAuth, group sync or the permission list is not configured
+func NewPermissionChecker(db *database.GormDB, cfg *config.OAuthPortalConfig) *PermissionChecker {
+	if cfg == nil || !cfg.Enabled || !cfg.GroupSync.Enabled || len(cfg.GroupSync.Permissions) == 0 {
+		return nil
+	}
+
+	grants := make(map[string][]string, len(cfg.GroupSync.Permissions))
+	for group, permissions := range cfg.GroupSync.Permissions {
+		for _, permission := range permissions {
+			if !models.IsKnownPermission(permission) {
+				log.Printf("⚠️ Ignoring unknown permission %q granted to group %s", permission, group)
+				continue
+			}
+			key := strings.ToLower(group)
+			grants[key] = append(grants[key], permission)
+		}
+	}
+	return &PermissionChecker{db: db, grants: grants}
+}
+
+// Allowed reports whether user may perform the action guarded by permission.
+// Admins hold every permission.
+func (p *PermissionChecker) Allowed(user *models.User, permission string) (bool, error) {
+	if p == nil || user.IsAdmin {
+		return true, nil
+	}
+
+	groups, err := p.db.GetUserGroups(user.ID)
+	if err != nil {
+		return false, fmt.Errorf("failed to load groups of user %s: %w", user.ID, err)
+	}
+
+	for _, group := range groups {
+		for _, granted := range p.grants[strings.ToLower(group.GroupName)] {
+			if granted == permission || granted == models.PermissionAll {
+				return true, nil
+			}
+		}
+		if groupGrants(group, permission) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// groupGrants reports whether the permissions JSON synced from the provider
+// sets permission to true
+func groupGrants(group models.UserGroup, permission string) bool {
+	if len(group.Permissions) == 0 {
+		return false
+	}
+	var permissions map[string]interface{}
+	if err := json.Unmarshal(group.Permissions, &permissions); err != nil {
+		return false
+	}
+	granted, _ := permissions[permission].(bool)
+	return granted
+}
+
+// permissionDenied is the message returned to callers lacking permission
+func permissionDenied(permission string) string {
+	return fmt.Sprintf("Permission denied: %s is required", permission)
+}
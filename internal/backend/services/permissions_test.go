@@ -0,0 +1,109 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"notificator/config"
+	"notificator/internal/backend/models"
+	alertpb "notificator/internal/backend/proto/alert"
+)
+
+// setupPermissions gives alice ("session-1") the synced group "SRE" and
+// creates bob ("session-2") with an acknowledgment of "fp-1"
+func setupPermissions(t *testing.T) (*AlertServiceGorm, *config.OAuthPortalConfig, string) {
+	t.Helper()
+
+	svc := newTestServiceWithSession(t)
+	alice, err := svc.db.GetUserBySession("session-1")
+	if err != nil {
+		t.Fatalf("failed to load alice: %v", err)
+	}
+	if err := svc.db.SyncUserGroups(alice.ID, "corp", []models.OAuthGroupInfo{{Name: "SRE"}}); err != nil {
+		t.Fatalf("failed to sync groups: %v", err)
+	}
+	bob := createTestUser(t, svc.db, "bob", "session-2")
+	ack, err := svc.db.CreateAcknowledgment("fp-1", bob.ID, "on it", nil)
+	if err != nil {
+		t.Fatalf("failed to create acknowledgment: %v", err)
+	}
+
+	cfg := &config.OAuthPortalConfig{
+		Enabled: true,
+		GroupSync: config.GroupSyncConfig{
+			Enabled:     true,
+			Permissions: map[string][]string{"sre": {models.PermissionAckCreate, models.PermissionAckDelete}},
+		},
+	}
+	return svc, cfg, ack.ID
+}
+
+func TestPermissions_AllowEverythingWithoutGroupPermissions(t *testing.T) {
+	svc, cfg, ackID := setupPermissions(t)
+	cfg.GroupSync.Permissions = nil
+	svc.SetPermissionChecker(NewPermissionChecker(svc.db, cfg))
+
+	resp, err := svc.AddAcknowledgment(context.Background(), &alertpb.AddAcknowledgmentRequest{SessionId: "session-2", AlertKey: "fp-2"})
+	if err != nil || !resp.Success {
+		t.Errorf("expected bob to acknowledge without group permissions: %v %v", err, resp)
+	}
+
+	del, err := svc.DeleteAcknowledgment(context.Background(), &alertpb.DeleteAcknowledgmentRequest{SessionId: "session-1", AlertKey: "fp-1", AcknowledgmentId: ackID})
+	if err != nil || del.Success {
+		t.Errorf("expected alice to still only remove her own acknowledgments: %v %v", err, del)
+	}
+}
+
+func TestPermissions_EnforcedFromGroups(t *testing.T) {
+	svc, cfg, ackID := setupPermissions(t)
+	svc.SetPermissionChecker(NewPermissionChecker(svc.db, cfg))
+	ctx := context.Background()
+
+	denied, err := svc.AddAcknowledgment(ctx, &alertpb.AddAcknowledgmentRequest{SessionId: "session-2", AlertKey: "fp-2"})
+	if err != nil || denied.Success || !strings.Contains(denied.Message, "Permission denied") {
+		t.Errorf("expected bob's acknowledgment to be denied, got %v %v", err, denied)
+	}
+	bulk, err := svc.BulkAcknowledge(ctx, &alertpb.BulkAcknowledgeRequest{SessionId: "session-2", AlertKeys: []string{"fp-2"}})
+	if err != nil || bulk.Success || !strings.Contains(bulk.Message, "ack:create") {
+		t.Errorf("expected bob's bulk acknowledgment to be denied, got %v %v", err, bulk)
+	}
+
+	allowed, err := svc.AddAcknowledgment(ctx, &alertpb.AddAcknowledgmentRequest{SessionId: "session-1", AlertKey: "fp-2"})
+	if err != nil || !allowed.Success {
+		t.Errorf("expected alice's SRE group to grant ack:create: %v %v", err, allowed)
+	}
+
+	del, err := svc.DeleteAcknowledgment(ctx, &alertpb.DeleteAcknowledgmentRequest{SessionId: "session-1", AlertKey: "fp-1", AcknowledgmentId: ackID})
+	if err != nil || !del.Success {
+		t.Fatalf("expected ack:delete to remove bob's acknowledgment: %v %v", err, del)
+	}
+	if acks, _ := svc.db.GetAcknowledgments("fp-1"); len(acks) != 0 {
+		t.Errorf("expected bob's acknowledgment removed, got %+v", acks)
+	}
+
+	escalate, err := svc.EscalateAlert(ctx, &alertpb.EscalateAlertRequest{SessionId: "session-1", AlertKey: "fp-1", EscalatedTo: "bob", TargetType: models.EscalationTargetUser})
+	if err != nil || escalate.Success || !strings.Contains(escalate.Message, models.PermissionAlertEscalate) {
+		t.Errorf("expected escalation to need alert:escalate, got %v %v", err, escalate)
+	}
+}
+
+func TestCheckPermission(t *testing.T) {
+	svc, cfg, _ := setupPermissions(t)
+	cfg.GroupSync.Permissions["SRE"] = []string{models.PermissionSilenceCreate}
+	delete(cfg.GroupSync.Permissions, "sre")
+	svc.SetPermissionChecker(NewPermissionChecker(svc.db, cfg))
+	ctx := context.Background()
+
+	for session, want := range map[string]bool{"session-1": true, "session-2": false} {
+		resp, err := svc.CheckPermission(ctx, &alertpb.CheckPermissionRequest{SessionId: session, Permission: models.PermissionSilenceCreate})
+		if err != nil || !resp.Success || resp.Allowed != want {
+			t.Errorf("%s: expected allowed=%t, got %v %v", session, want, err, resp)
+		}
+	}
+
+	resp, err := svc.CheckPermission(ctx, &alertpb.CheckPermissionRequest{SessionId: "session-1", Permission: "silence:nuke"})
+	if err != nil || resp.Success {
+		t.Errorf("expected an unknown permission to be rejected, got %v %v", err, resp)
+	}
+}
@@ -0,0 +1,131 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"notificator/internal/backend/models"
+	alertpb "notificator/internal/backend/proto/alert"
+	mainmodels "notificator/internal/models"
+)
+
+// setupPreferencesBundle gives alice ("session-1") one entry in every bundle
+// section, with the boolean settings turned off, and creates bob with the
+// session "session-2"
+func setupPreferencesBundle(t *testing.T) (*AlertServiceGorm, string, string) {
+	t.Helper()
+
+	svc := newTestServiceWithSession(t)
+	alice, err := svc.db.GetUserBySession("session-1")
+	if err != nil {
+		t.Fatalf("failed to load alice: %v", err)
+	}
+	bob := createTestUser(t, svc.db, "bob", "session-2")
+
+	color := mainmodels.UserColorPreference{ID: "color-1", UserID: alice.ID, Color: "#ff0000", ColorType: "custom", Priority: 5, BgLightnessFactor: 0.8}
+	if err := color.SetLabelConditions(mainmodels.LabelConditionsMap{"team": "infra", "severity": "critical"}); err != nil {
+		t.Fatalf("failed to set label conditions: %v", err)
+	}
+	if err := svc.db.SaveUserColorPreferences(alice.ID, []mainmodels.UserColorPreference{color}); err != nil {
+		t.Fatalf("failed to seed color preference: %v", err)
+	}
+	if _, err := svc.db.CreateFilterPreset(&models.FilterPreset{UserID: alice.ID, Name: "Critical", FilterData: models.JSONB(`{"severities":["critical"]}`)}); err != nil {
+		t.Fatalf("failed to seed filter preset: %v", err)
+	}
+
+	// These columns default to true, so they are switched off after creation
+	gdb := svc.db.GetDB()
+	seeds := []struct {
+		row    interface{}
+		column string
+	}{
+		{&models.AnnotationButtonConfig{UserID: alice.ID, Label: "Runbook", AnnotationKeys: models.AnnotationKeyList{"runbook"}, Color: "#4f46e5", ButtonType: "custom"}, "enabled"},
+		{&models.UserHiddenRule{UserID: alice.ID, Name: "Noisy hosts", LabelKey: "instance", LabelValue: "test-.*", IsRegex: true}, "is_enabled"},
+		{&models.NotificationPreference{UserID: alice.ID, EnabledSeverities: models.SeverityList{"critical"}}, "sound_notifications_enabled"},
+	}
+	for _, seed := range seeds {
+		if err := gdb.Create(seed.row).Error; err != nil {
+			t.Fatalf("failed to seed %T: %v", seed.row, err)
+		}
+		if err := gdb.Model(seed.row).Update(seed.column, false).Error; err != nil {
+			t.Fatalf("failed to disable %T: %v", seed.row, err)
+		}
+	}
+
+	return svc, alice.ID, bob.ID
+}
+
+func importPreferences(t *testing.T, svc *AlertServiceGorm, sessionID string, bundle []byte) *alertpb.ImportPreferencesResponse {
+	t.Helper()
+	resp, err := svc.ImportPreferences(context.Background(), &alertpb.ImportPreferencesRequest{SessionId: sessionID, Bundle: bundle})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return resp
+}
+
+func TestPreferencesBundle_RoundTripsIntoAnotherAccount(t *testing.T) {
+	svc, aliceID, bobID := setupPreferencesBundle(t)
+
+	exported, err := svc.ExportPreferences(context.Background(), &alertpb.ExportPreferencesRequest{SessionId: "session-1"})
+	if err != nil || !exported.Success {
+		t.Fatalf("export failed: %v %v", err, exported)
+	}
+
+	first := importPreferences(t, svc, "session-2", exported.Bundle)
+	if !first.Success || first.Created != 5 || first.Updated != 0 {
+		t.Fatalf("expected 5 entries created, got %+v", first)
+	}
+
+	bundle, err := svc.db.ExportPreferences(bobID)
+	if err != nil {
+		t.Fatalf("failed to export bob's preferences: %v", err)
+	}
+	if len(bundle.ColorPreferences) != 1 || bundle.ColorPreferences[0].ID == "color-1" || bundle.ColorPreferences[0].BgLightnessFactor != 0.8 {
+		t.Errorf("expected a copy of alice's color preference, got %+v", bundle.ColorPreferences)
+	}
+	if len(bundle.FilterPresets) != 1 || string(bundle.FilterPresets[0].FilterData) != `{"severities":["critical"]}` {
+		t.Errorf("expected alice's filter preset, got %+v", bundle.FilterPresets)
+	}
+	if len(bundle.AnnotationButtonConfigs) != 1 || bundle.AnnotationButtonConfigs[0].Enabled {
+		t.Errorf("expected alice's disabled annotation button, got %+v", bundle.AnnotationButtonConfigs)
+	}
+	if len(bundle.HiddenRules) != 1 || bundle.HiddenRules[0].IsEnabled {
+		t.Errorf("expected alice's disabled hidden rule, got %+v", bundle.HiddenRules)
+	}
+	if pref := bundle.NotificationPreference; pref == nil || pref.SoundNotificationsEnabled {
+		t.Errorf("expected alice's muted notification preference, got %+v", pref)
+	}
+
+	again := importPreferences(t, svc, "session-2", exported.Bundle)
+	if !again.Success || again.Created != 0 || again.Updated != 5 {
+		t.Errorf("expected a second import to update the 5 entries, got %+v", again)
+	}
+	if alice, _ := svc.db.ExportPreferences(aliceID); len(alice.FilterPresets) != 1 {
+		t.Errorf("expected alice's presets untouched, got %d", len(alice.FilterPresets))
+	}
+}
+
+func TestImportPreferences_RejectsInvalidBundleWithoutWriting(t *testing.T) {
+	svc, _, bobID := setupPreferencesBundle(t)
+
+	for name, bundle := range map[string]string{
+		"not json":        `{`,
+		"unknown version": `{"version": 99}`,
+		"nameless preset": `{"version": 1, "filter_presets": [{"name": "", "filter_data": "e30="}]}`,
+		"bad regex":       `{"version": 1, "color_preferences": [{"color": "#fff", "color_type": "custom", "priority": 1}], "hidden_rules": [{"labelKey": "job", "labelValue": "(", "isRegex": true}]}`,
+		"unknown type":    `{"version": 1, "color_preferences": [{"color": "#fff", "color_type": "gradient", "priority": 1}]}`,
+	} {
+		if resp := importPreferences(t, svc, "session-2", []byte(bundle)); resp.Success {
+			t.Errorf("%s: expected Success=false", name)
+		}
+	}
+
+	bundle, err := svc.db.ExportPreferences(bobID)
+	if err != nil {
+		t.Fatalf("failed to export bob's preferences: %v", err)
+	}
+	if len(bundle.ColorPreferences)+len(bundle.FilterPresets)+len(bundle.HiddenRules) != 0 {
+		t.Errorf("expected nothing imported, got %+v", bundle)
+	}
+}
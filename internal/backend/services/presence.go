@@ -0,0 +1,65 @@
+package services
+
+import (
+	"log"
+	"sort"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	alertpb "notificator/internal/backend/proto/alert"
+)
+
+// viewersOf lists the distinct users behind subs, ordered by username
+func viewersOf(subs []*Subscription) []*alertpb.Viewer {
+	var viewers []*alertpb.Viewer
+	seen := make(map[string]bool)
+	for _, sub := range subs {
+		if seen[sub.UserID] {
+			continue
+		}
+		seen[sub.UserID] = true
+		viewers = append(viewers, &alertpb.Viewer{UserId: sub.UserID, Username: sub.Username})
+	}
+	sort.Slice(viewers, func(i, j int) bool { return viewers[i].Username < viewers[j].Username })
+	return viewers
+}
+
+// broadcastPresence tells the alert's other subscribers that sub's user
+// joined or left, along with who is viewing now. A user with the alert open
+// in several places only joins with the first and leaves with the last.
+func (s *AlertServiceGorm) broadcastPresence(sub *Subscription, updateType alertpb.UpdateType) {
+	var others []*Subscription
+	s.subsMutex.RLock()
+	current := append([]*Subscription(nil), s.subscriptions[sub.AlertKey]...)
+	s.subsMutex.RUnlock()
+
+	for _, other := range current {
+		if other == sub {
+			continue
+		}
+		if other.UserID == sub.UserID {
+			return
+		}
+		others = append(others, other)
+	}
+	if len(others) == 0 {
+		return
+	}
+
+	update := &alertpb.AlertUpdate{
+		AlertKey:   sub.AlertKey,
+		UpdateType: updateType,
+		UpdateData: &alertpb.AlertUpdate_Presence{Presence: &alertpb.Presence{
+			User:    &alertpb.Viewer{UserId: sub.UserID, Username: sub.Username},
+			Viewers: viewersOf(current),
+		}},
+		Timestamp: timestamppb.Now(),
+	}
+	for _, other := range others {
+		select {
+		case other.updates <- update:
+		default:
+			log.Printf("Dropping %s update for alert %s: subscriber %s is not keeping up", updateType, sub.AlertKey, other.UserID)
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	alertpb "notificator/internal/backend/proto/alert"
+)
+
+func viewerNames(presence *alertpb.Presence) []string {
+	var names []string
+	for _, viewer := range presence.GetViewers() {
+		names = append(names, viewer.Username)
+	}
+	return names
+}
+
+func TestSubscribeToAlertUpdates_BroadcastsPresence(t *testing.T) {
+	svc, alice := setupSubscribedAlertService(t)
+	if got := viewerNames(alice.received()[0].GetPresence()); len(got) != 1 || got[0] != "alice" {
+		t.Fatalf("expected alice's first update to list her as the only viewer, got %v", got)
+	}
+
+	createTestUser(t, svc.db, "bob", "session-2")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	bobStream := &recordingAlertStream{ctx: ctx}
+	done := make(chan error, 1)
+	go func() {
+		done <- svc.SubscribeToAlertUpdates(&alertpb.SubscribeToAlertUpdatesRequest{SessionId: "session-2", AlertKey: "fp-1"}, bobStream)
+	}()
+
+	if got := viewerNames(bobStream.waitForUpdates(t, 1)[0].GetPresence()); len(got) != 2 || got[0] != "alice" || got[1] != "bob" {
+		t.Errorf("expected bob to see alice and himself viewing, got %v", got)
+	}
+	joined := alice.waitForUpdates(t, 2)[1]
+	if joined.UpdateType != alertpb.UpdateType_PRESENCE_JOINED || joined.GetPresence().GetUser().GetUsername() != "bob" {
+		t.Errorf("expected alice to be told bob joined, got %v", joined)
+	}
+
+	cancel()
+	if err := <-done; err != nil {
+		t.Fatalf("bob's subscription ended with error: %v", err)
+	}
+	left := alice.waitForUpdates(t, 3)[2]
+	if left.UpdateType != alertpb.UpdateType_PRESENCE_LEFT || left.GetPresence().GetUser().GetUsername() != "bob" {
+		t.Errorf("expected alice to be told bob left, got %v", left)
+	}
+	if got := viewerNames(left.GetPresence()); len(got) != 1 || got[0] != "alice" {
+		t.Errorf("expected only alice still viewing, got %v", got)
+	}
+}
+
+func TestSubscribeToAlertUpdates_SecondSubscriptionOfSameUserIsNotAJoin(t *testing.T) {
+	svc, first := setupSubscribedAlertService(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	second := &recordingAlertStream{ctx: ctx}
+	done := subscribeInBackground(svc, second)
+	if got := viewerNames(second.waitForUpdates(t, 1)[0].GetPresence()); len(got) != 1 || got[0] != "alice" {
+		t.Errorf("expected alice listed once, got %v", got)
+	}
+	cancel()
+	<-done
+
+	// The next update the first stream sees is this marker, not a presence change
+	svc.broadcastUpdate("fp-1", &alertpb.AlertUpdate{AlertKey: "fp-1", UpdateType: alertpb.UpdateType_COMMENT_ADDED})
+	if updates := first.waitForUpdates(t, 2); updates[1].UpdateType != alertpb.UpdateType_COMMENT_ADDED {
+		t.Errorf("expected no presence updates for alice's own second subscription, got %v", updates[1].UpdateType)
+	}
+}
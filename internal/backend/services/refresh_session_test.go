@@ -0,0 +1,91 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"notificator/internal/backend/database"
+	authpb "notificator/internal/backend/proto/auth"
+)
+
+// setupAuthServiceWithSessions seeds one session close to expiry and one
+// that has already expired
+func setupAuthServiceWithSessions(t *testing.T) (*AuthServiceGorm, *database.GormDB) {
+	t.Helper()
+
+	db := newTestDB(t)
+
+	user := createTestUser(t, db, "alice", "")
+	if err := db.CreateSession(user.ID, "session-1", time.Now().Add(30*time.Minute)); err != nil {
+		t.Fatalf("failed to create session: %v", err)
+	}
+	if err := db.CreateSession(user.ID, "session-expired", time.Now().Add(-time.Minute)); err != nil {
+		t.Fatalf("failed to create session: %v", err)
+	}
+
+	return NewAuthServiceGorm(db, nil), db
+}
+
+func TestRefreshSession_ExtendsExpiry(t *testing.T) {
+	svc, db := setupAuthServiceWithSessions(t)
+
+	resp, err := svc.RefreshSession(context.Background(), &authpb.RefreshSessionRequest{SessionId: "session-1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !resp.Success {
+		t.Fatalf("expected Success=true, got message %q", resp.Message)
+	}
+	if remaining := time.Until(resp.ExpiresAt.AsTime()); remaining < svc.sessionDuration-time.Minute {
+		t.Errorf("expected expiry about %v from now, got %v", svc.sessionDuration, remaining)
+	}
+
+	stored, err := db.GetSessionExpiry("session-1")
+	if err != nil {
+		t.Fatalf("expected session to remain valid: %v", err)
+	}
+	if !stored.Equal(resp.ExpiresAt.AsTime()) {
+		t.Errorf("expected stored expiry %v, got %v", resp.ExpiresAt.AsTime(), stored)
+	}
+
+	validated, err := svc.ValidateSession(context.Background(), &authpb.ValidateSessionRequest{SessionId: "session-1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !validated.Valid || validated.ExpiresAt == nil {
+		t.Fatalf("expected a valid session with an expiry, got %+v", validated)
+	}
+	if !validated.ExpiresAt.AsTime().Equal(stored) || validated.User.GetUsername() != "alice" {
+		t.Errorf("expected alice's session expiring at %v, got %+v", stored, validated)
+	}
+}
+
+func TestRefreshSession_RejectsExpiredSession(t *testing.T) {
+	svc, db := setupAuthServiceWithSessions(t)
+
+	resp, err := svc.RefreshSession(context.Background(), &authpb.RefreshSessionRequest{SessionId: "session-expired"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Success {
+		t.Error("expected Success=false for an expired session")
+	}
+
+	if _, err := db.GetSessionExpiry("session-expired"); err == nil {
+		t.Error("expected the expired session to stay expired")
+	}
+}
+
+func TestRefreshSession_UsesConfiguredDuration(t *testing.T) {
+	svc, _ := setupAuthServiceWithSessions(t)
+	svc.SetSessionDuration(2 * time.Hour)
+
+	resp, err := svc.RefreshSession(context.Background(), &authpb.RefreshSessionRequest{SessionId: "session-1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if remaining := time.Until(resp.ExpiresAt.AsTime()); remaining > 2*time.Hour || remaining < 2*time.Hour-time.Minute {
+		t.Errorf("expected expiry about 2h from now, got %v", remaining)
+	}
+}
@@ -3,23 +3,18 @@ package services
 import (
 	"context"
 	"testing"
-	"time"
 
-	"notificator/config"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
 	"notificator/internal/backend/database"
+	"notificator/internal/backend/models"
 	alertpb "notificator/internal/backend/proto/alert"
 )
 
 func setupAlertServiceWithResolvedAlert(t *testing.T) (*AlertServiceGorm, *database.GormDB) {
 	t.Helper()
 
-	db, err := database.NewGormDB("sqlite", config.DatabaseConfig{SQLitePath: t.TempDir() + "/test.db"})
-	if err != nil {
-		t.Fatalf("failed to create test database: %v", err)
-	}
-	if err := db.AutoMigrate(); err != nil {
-		t.Fatalf("failed to migrate test database: %v", err)
-	}
+	db := newTestDB(t)
 
 	if _, err := db.CreateResolvedAlert("fp-1", "test", []byte(`{}`), []byte(`[]`), []byte(`[]`), 24); err != nil {
 		t.Fatalf("failed to seed resolved alert: %v", err)
@@ -70,13 +65,7 @@ func TestRemoveAllResolvedAlerts_RejectsInvalidSession(t *testing.T) {
 func TestRemoveAllResolvedAlerts_ValidSessionDeletes(t *testing.T) {
 	svc, db := setupAlertServiceWithResolvedAlert(t)
 
-	user, err := db.CreateUser("tester", "tester@example.com", "hash")
-	if err != nil {
-		t.Fatalf("failed to create user: %v", err)
-	}
-	if err := db.CreateSession(user.ID, "session-1", time.Now().Add(time.Hour)); err != nil {
-		t.Fatalf("failed to create session: %v", err)
-	}
+	createTestUser(t, db, "tester", "session-1")
 
 	resp, err := svc.RemoveAllResolvedAlerts(context.Background(), &alertpb.RemoveAllResolvedAlertsRequest{SessionId: "session-1"})
 	if err != nil {
@@ -92,3 +81,65 @@ func TestRemoveAllResolvedAlerts_ValidSessionDeletes(t *testing.T) {
 		t.Errorf("expected all resolved alerts removed, got count %d", count)
 	}
 }
+
+func TestUndoRemoveAllResolvedAlerts_RestoresWithinWindow(t *testing.T) {
+	svc, db := setupAlertServiceWithResolvedAlert(t)
+
+	createTestUser(t, db, "tester", "session-1")
+
+	removed, err := svc.RemoveAllResolvedAlerts(context.Background(), &alertpb.RemoveAllResolvedAlertsRequest{SessionId: "session-1"})
+	if err != nil || !removed.Success || removed.ClearedAt == nil {
+		t.Fatalf("remove failed: %v %v", err, removed)
+	}
+	if got := removed.UndoUntil.AsTime().Sub(removed.ClearedAt.AsTime()); got != database.ClearUndoWindow {
+		t.Errorf("expected the undo to be offered for %s, got %s", database.ClearUndoWindow, got)
+	}
+
+	resp, err := svc.UndoRemoveAllResolvedAlerts(context.Background(), &alertpb.UndoClearRequest{SessionId: "session-1", ClearedAt: removed.ClearedAt})
+	if err != nil || !resp.Success {
+		t.Fatalf("undo failed: %v %v", err, resp)
+	}
+	if resp.RestoredCount != 1 {
+		t.Errorf("expected RestoredCount=1, got %d", resp.RestoredCount)
+	}
+	if count := resolvedAlertCount(t, db); count != 1 {
+		t.Errorf("expected the resolved alert back, got count %d", count)
+	}
+
+	// Nothing is left to purge once the removal is undone
+	if purged, err := db.PurgeClearedRows(); err != nil || purged != 0 {
+		t.Errorf("expected nothing purged, got %d (%v)", purged, err)
+	}
+}
+
+func TestUndoRemoveAllResolvedAlerts_RefusesAfterWindow(t *testing.T) {
+	svc, db := setupAlertServiceWithResolvedAlert(t)
+
+	createTestUser(t, db, "tester", "session-1")
+
+	removed, clearedAt, err := db.RemoveAllResolvedAlerts()
+	if err != nil || removed != 1 {
+		t.Fatalf("remove failed: %d %v", removed, err)
+	}
+
+	// Move the removal out of the undo window
+	past := clearedAt.Add(-2 * database.ClearUndoWindow)
+	db.GetDB().Unscoped().Model(&models.ResolvedAlert{}).Where("deleted_at IS NOT NULL").Update("deleted_at", past)
+
+	resp, err := svc.UndoRemoveAllResolvedAlerts(context.Background(), &alertpb.UndoClearRequest{SessionId: "session-1", ClearedAt: timestamppb.New(past)})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Success {
+		t.Error("expected Success=false once the undo window has ended")
+	}
+
+	if purged, err := db.PurgeClearedRows(); err != nil || purged != 1 {
+		t.Errorf("expected the removed alert purged, got %d (%v)", purged, err)
+	}
+	var remaining int64
+	db.GetDB().Unscoped().Model(&models.ResolvedAlert{}).Count(&remaining)
+	if remaining != 0 {
+		t.Errorf("expected no row left after the purge, got %d", remaining)
+	}
+}
@@ -0,0 +1,91 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"notificator/internal/backend/database"
+	alertpb "notificator/internal/backend/proto/alert"
+)
+
+// setupRestorableAlert stores a resolved capture of "fp-1" holding one comment
+// and one acknowledgment by alice, whose live copies were deleted
+func setupRestorableAlert(t *testing.T) (*AlertServiceGorm, *database.GormDB) {
+	t.Helper()
+
+	svc, db := setupResolvedAlertCapture(t)
+	user := createTestUser(t, db, "alice", "session-1")
+
+	createdAt := timestamppb.New(time.Now().Add(-2 * time.Hour))
+	comments, _ := json.Marshal([]*alertpb.Comment{
+		{Id: "comment-1", AlertKey: "fp-1", UserId: user.ID, Username: "alice", Content: "disk full again", CreatedAt: createdAt},
+		{Id: "comment-2", AlertKey: "fp-1", UserId: "deleted-user", Username: "bob", Content: "gone", CreatedAt: createdAt},
+	})
+	acks, _ := json.Marshal([]*alertpb.Acknowledgment{
+		{Id: "ack-1", AlertKey: "fp-1", UserId: user.ID, Username: "alice", Reason: "on it", CreatedAt: createdAt},
+	})
+	if _, err := db.CreateResolvedAlert("fp-1", "test", []byte(`{}`), comments, acks, 24); err != nil {
+		t.Fatalf("failed to seed resolved alert: %v", err)
+	}
+
+	return svc, db
+}
+
+func TestRestoreResolvedAlert_CopiesDiscussionOnce(t *testing.T) {
+	svc, db := setupRestorableAlert(t)
+	req := &alertpb.RestoreResolvedAlertRequest{SessionId: "session-1", Fingerprint: "fp-1"}
+
+	resp, err := svc.RestoreResolvedAlert(context.Background(), req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !resp.Success {
+		t.Fatalf("expected Success=true, got message %q", resp.Message)
+	}
+	if resp.RestoredComments != 1 || resp.RestoredAcknowledgments != 1 {
+		t.Errorf("expected 1 comment (the other author is gone) and 1 ack, got %d and %d", resp.RestoredComments, resp.RestoredAcknowledgments)
+	}
+
+	comments, err := db.GetComments("fp-1")
+	if err != nil {
+		t.Fatalf("failed to load comments: %v", err)
+	}
+	if len(comments) != 1 || comments[0].Content != "disk full again" {
+		t.Errorf("expected the restored comment on the live alert, got %+v", comments)
+	}
+	if time.Since(comments[0].CreatedAt) < time.Hour {
+		t.Errorf("expected the original comment time to be kept, got %v", comments[0].CreatedAt)
+	}
+
+	again, err := svc.RestoreResolvedAlert(context.Background(), req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if again.Success {
+		t.Error("expected a second restore to be refused")
+	}
+	if comments, _ := db.GetComments("fp-1"); len(comments) != 1 {
+		t.Errorf("expected no duplicate comments, got %d", len(comments))
+	}
+
+	resolved, err := svc.GetResolvedAlert(context.Background(), &alertpb.GetResolvedAlertRequest{Fingerprint: "fp-1"})
+	if err != nil || resolved.ResolvedAlert.RestoredAt == nil {
+		t.Errorf("expected the capture to be marked restored, got %+v (%v)", resolved, err)
+	}
+}
+
+func TestRestoreResolvedAlert_RequiresSession(t *testing.T) {
+	svc, _ := setupRestorableAlert(t)
+
+	resp, err := svc.RestoreResolvedAlert(context.Background(), &alertpb.RestoreResolvedAlertRequest{SessionId: "nope", Fingerprint: "fp-1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Success {
+		t.Error("expected an invalid session to be refused")
+	}
+}
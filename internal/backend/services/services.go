@@ -5,17 +5,25 @@ import (
 	"crypto/rand"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
+	"math"
+	"net"
+	"regexp"
 	"strings"
 	"sync"
 	"time"
+	"unicode/utf8"
 
 	"golang.org/x/crypto/bcrypt"
-	"golang.org/x/oauth2"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
 
+	"notificator/config"
 	"notificator/internal/backend/database"
 	"notificator/internal/backend/models"
 	alertpb "notificator/internal/backend/proto/alert"
@@ -23,17 +31,103 @@ import (
 	mainmodels "notificator/internal/models"
 )
 
+// minPasswordLength is the shortest password accepted for local accounts
+const minPasswordLength = 4
+
 type AuthServiceGorm struct {
 	authpb.UnimplementedAuthServiceServer
-	db           *database.GormDB
-	oauthService *OAuthService
+	db              *database.GormDB
+	oauthService    *OAuthService
+	sessionDuration time.Duration // Lifetime of new and refreshed sessions
+	loginLimiter    *LoginLimiter // Nil disables login throttling
+	trustedProxies  []*net.IPNet  // Peers whose x-forwarded-for is used for throttling
+	admin           config.AdminConfig
 }
 
 func NewAuthServiceGorm(db *database.GormDB, oauthService *OAuthService) *AuthServiceGorm {
 	return &AuthServiceGorm{
-		db:           db,
-		oauthService: oauthService,
+		db:              db,
+		oauthService:    oauthService,
+		sessionDuration: config.DefaultSessionDuration,
+	}
+}
+
+// SetSessionDuration sets how long new and refreshed sessions stay valid
+func (s *AuthServiceGorm) SetSessionDuration(duration time.Duration) {
+	s.sessionDuration = duration
+}
+
+// SetLoginLimiter enables throttling of failed logins
+func (s *AuthServiceGorm) SetLoginLimiter(limiter *LoginLimiter) {
+	s.loginLimiter = limiter
+}
+
+// SetTrustedProxies sets the peers (the WebUI) whose forwarded client address
+// is used to throttle logins. Without any, the peer address is used.
+func (s *AuthServiceGorm) SetTrustedProxies(trustedProxies []*net.IPNet) {
+	s.trustedProxies = trustedProxies
+}
+
+// SetAdminConfig sets who besides is_admin users counts as an admin and
+// whether the first registered user is promoted
+func (s *AuthServiceGorm) SetAdminConfig(admin config.AdminConfig) {
+	s.admin = admin
+}
+
+// bootstrappingAdmin reports whether the next user to be created becomes an
+// admin: admin.bootstrap_first_user is enabled and no user is registered yet.
+// Existing installations never get a user promoted.
+func (s *AuthServiceGorm) bootstrappingAdmin() bool {
+	if !s.admin.BootstrapFirstUser {
+		return false
+	}
+	count, err := s.db.CountUsers()
+	if err != nil {
+		log.Printf("Error counting users for admin bootstrap: %v", err)
+		return false
+	}
+	return count == 0
+}
+
+// promoteBootstrapAdmin makes the first registered user an admin, unless
+// another user was created alongside it
+func (s *AuthServiceGorm) promoteBootstrapAdmin(user *models.User) {
+	promoted, err := s.db.PromoteSoleUser(user.ID)
+	if err != nil {
+		log.Printf("Error promoting first user %s to admin: %v", user.Username, err)
+		return
+	}
+	if !promoted {
+		log.Printf("Not promoting %s to admin: another user registered at the same time", user.Username)
+		return
+	}
+	user.IsAdmin = true
+	log.Printf("Promoted first user %s to admin", user.Username)
+}
+
+// isAdminUser reports whether a user may call admin RPCs: is_admin users and
+// those on the impersonation allow-list
+func isAdminUser(admin config.AdminConfig, user *models.User) bool {
+	return user.IsAdmin || admin.CanImpersonate(user.Username) || admin.CanImpersonate(user.Email)
+}
+
+// isAdmin reports whether a user may call admin RPCs. Users allowed to
+// impersonate are admins too, so the WebUI impersonation picker keeps working.
+func (s *AuthServiceGorm) isAdmin(user *models.User) bool {
+	return isAdminUser(s.admin, user)
+}
+
+// requireAdmin returns the session's user if it is an admin, or a message
+// describing why the call is refused
+func (s *AuthServiceGorm) requireAdmin(sessionID string) (*models.User, string) {
+	user, err := s.db.GetUserBySession(sessionID)
+	if err != nil {
+		return nil, "Invalid session"
 	}
+	if !s.isAdmin(user) {
+		return nil, "Admin access required"
+	}
+	return user, ""
 }
 
 func (s *AuthServiceGorm) Register(ctx context.Context, req *authpb.RegisterRequest) (*authpb.RegisterResponse, error) {
@@ -44,10 +138,10 @@ func (s *AuthServiceGorm) Register(ctx context.Context, req *authpb.RegisterRequ
 		}, nil
 	}
 
-	if len(req.Password) < 4 {
+	if len(req.Password) < minPasswordLength {
 		return &authpb.RegisterResponse{
 			Success: false,
-			Message: "Password must be at least 4 characters long",
+			Message: fmt.Sprintf("Password must be at least %d characters long", minPasswordLength),
 		}, nil
 	}
 
@@ -71,6 +165,7 @@ func (s *AuthServiceGorm) Register(ctx context.Context, req *authpb.RegisterRequ
 	}
 
 	// Create user
+	bootstrap := s.bootstrappingAdmin()
 	user, err := s.db.CreateUser(req.Username, req.Email, string(passwordHash))
 	if err != nil {
 		log.Printf("Error creating user: %v", err)
@@ -79,6 +174,9 @@ func (s *AuthServiceGorm) Register(ctx context.Context, req *authpb.RegisterRequ
 			Message: "Failed to create user",
 		}, nil
 	}
+	if bootstrap {
+		s.promoteBootstrapAdmin(user)
+	}
 
 	return &authpb.RegisterResponse{
 		Success: true,
@@ -96,9 +194,20 @@ func (s *AuthServiceGorm) Login(ctx context.Context, req *authpb.LoginRequest) (
 		}, nil
 	}
 
+	// Unknown usernames are throttled like real ones so lockouts don't reveal which exist
+	usernameKey := "user:" + strings.ToLower(req.Username)
+	ipKey := "ip:" + ClientIPFromContext(ctx, s.trustedProxies)
+	if !s.loginLimiter.Allowed(usernameKey, ipKey) {
+		return &authpb.LoginResponse{
+			Success: false,
+			Message: "Too many login attempts, please try again later",
+		}, nil
+	}
+
 	// Get user by username
 	user, err := s.db.GetUserByUsername(req.Username)
 	if err != nil {
+		s.loginLimiter.RecordFailure(usernameKey, ipKey)
 		return &authpb.LoginResponse{
 			Success: false,
 			Message: "Invalid credentials",
@@ -106,11 +215,19 @@ func (s *AuthServiceGorm) Login(ctx context.Context, req *authpb.LoginRequest) (
 	}
 
 	if err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(req.Password)); err != nil {
+		s.loginLimiter.RecordFailure(usernameKey, ipKey)
 		return &authpb.LoginResponse{
 			Success: false,
 			Message: "Invalid credentials",
 		}, nil
 	}
+	if user.Disabled {
+		return &authpb.LoginResponse{
+			Success: false,
+			Message: "Account is disabled",
+		}, nil
+	}
+	s.loginLimiter.RecordSuccess(usernameKey)
 
 	// Generate session ID
 	sessionID, err := generateSessionID()
@@ -122,7 +239,7 @@ func (s *AuthServiceGorm) Login(ctx context.Context, req *authpb.LoginRequest) (
 		}, nil
 	}
 
-	expiresAt := time.Now().Add(7 * 24 * time.Hour)
+	expiresAt := time.Now().Add(s.sessionDuration)
 	if err := s.db.CreateSession(user.ID, sessionID, expiresAt); err != nil {
 		log.Printf("Error creating session: %v", err)
 		return &authpb.LoginResponse{
@@ -146,6 +263,7 @@ func (s *AuthServiceGorm) Login(ctx context.Context, req *authpb.LoginRequest) (
 			Username:  user.Username,
 			Email:     user.Email,
 			CreatedAt: timestamppb.New(user.CreatedAt),
+			IsAdmin:   s.isAdmin(user),
 		},
 	}, nil
 }
@@ -182,7 +300,7 @@ func (s *AuthServiceGorm) ValidateSession(ctx context.Context, req *authpb.Valid
 		}, nil
 	}
 
-	user, err := s.db.GetUserBySession(req.SessionId)
+	user, expiresAt, err := s.db.GetUserBySessionWithExpiry(req.SessionId)
 	if err != nil {
 		return &authpb.ValidateSessionResponse{
 			Valid:   false,
@@ -198,7 +316,180 @@ func (s *AuthServiceGorm) ValidateSession(ctx context.Context, req *authpb.Valid
 			Username:  user.Username,
 			Email:     user.Email,
 			CreatedAt: timestamppb.New(user.CreatedAt),
+			IsAdmin:   s.isAdmin(user),
 		},
+		ExpiresAt: timestamppb.New(expiresAt),
+	}, nil
+}
+
+// RefreshSession implements the RefreshSession RPC method. It extends an
+// active session to the configured session duration from now without issuing a new ID.
+func (s *AuthServiceGorm) RefreshSession(ctx context.Context, req *authpb.RefreshSessionRequest) (*authpb.RefreshSessionResponse, error) {
+	if req.SessionId == "" {
+		return &authpb.RefreshSessionResponse{
+			Success: false,
+			Message: "Session ID is required",
+		}, nil
+	}
+
+	expiresAt, err := s.db.RefreshSession(req.SessionId, s.sessionDuration)
+	if err != nil {
+		return &authpb.RefreshSessionResponse{
+			Success: false,
+			Message: "Invalid session",
+		}, nil
+	}
+
+	return &authpb.RefreshSessionResponse{
+		Success:   true,
+		Message:   "Session refreshed",
+		ExpiresAt: timestamppb.New(expiresAt),
+	}, nil
+}
+
+// ChangePassword implements the ChangePassword RPC method. Only users with a
+// local password can change it; OAuth-only accounts are rejected.
+func (s *AuthServiceGorm) ChangePassword(ctx context.Context, req *authpb.ChangePasswordRequest) (*authpb.ChangePasswordResponse, error) {
+	if req.SessionId == "" {
+		return &authpb.ChangePasswordResponse{
+			Success: false,
+			Message: "Session ID is required",
+		}, nil
+	}
+
+	if req.OldPassword == "" || req.NewPassword == "" {
+		return &authpb.ChangePasswordResponse{
+			Success: false,
+			Message: "Current and new passwords are required",
+		}, nil
+	}
+
+	user, err := s.db.GetUserBySession(req.SessionId)
+	if err != nil {
+		return &authpb.ChangePasswordResponse{
+			Success: false,
+			Message: "Invalid session",
+		}, nil
+	}
+
+	if !user.HasPassword() {
+		return &authpb.ChangePasswordResponse{
+			Success: false,
+			Message: "This account signs in with OAuth and has no password to change",
+		}, nil
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(req.OldPassword)); err != nil {
+		return &authpb.ChangePasswordResponse{
+			Success: false,
+			Message: "Current password is incorrect",
+		}, nil
+	}
+
+	if len(req.NewPassword) < minPasswordLength {
+		return &authpb.ChangePasswordResponse{
+			Success: false,
+			Message: fmt.Sprintf("Password must be at least %d characters long", minPasswordLength),
+		}, nil
+	}
+
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte(req.NewPassword), bcrypt.DefaultCost)
+	if err != nil {
+		log.Printf("Error hashing password: %v", err)
+		return &authpb.ChangePasswordResponse{
+			Success: false,
+			Message: "Internal server error",
+		}, nil
+	}
+
+	if err := s.db.UpdateUserPassword(user.ID, string(passwordHash)); err != nil {
+		log.Printf("Error updating password for user %s: %v", user.ID, err)
+		return &authpb.ChangePasswordResponse{
+			Success: false,
+			Message: "Failed to update password",
+		}, nil
+	}
+
+	return &authpb.ChangePasswordResponse{
+		Success: true,
+		Message: "Password changed successfully",
+	}, nil
+}
+
+// DeleteAccount implements the DeleteAccount RPC method. It removes the
+// session's user together with all of their data.
+func (s *AuthServiceGorm) DeleteAccount(ctx context.Context, req *authpb.DeleteAccountRequest) (*authpb.DeleteAccountResponse, error) {
+	if req.SessionId == "" {
+		return &authpb.DeleteAccountResponse{
+			Success: false,
+			Message: "Session ID is required",
+		}, nil
+	}
+
+	user, err := s.db.GetUserBySession(req.SessionId)
+	if err != nil {
+		return &authpb.DeleteAccountResponse{
+			Success: false,
+			Message: "Invalid session",
+		}, nil
+	}
+
+	if err := s.db.DeleteUserAccount(user.ID); err != nil {
+		log.Printf("Error deleting account of user %s: %v", user.ID, err)
+		return &authpb.DeleteAccountResponse{
+			Success: false,
+			Message: "Failed to delete account",
+		}, nil
+	}
+
+	log.Printf("Deleted account of user %s (%s)", user.Username, user.ID)
+
+	return &authpb.DeleteAccountResponse{
+		Success: true,
+		Message: "Account deleted",
+	}, nil
+}
+
+// ExportUserData implements the ExportUserData RPC method. It returns the
+// session's user data as a JSON document.
+func (s *AuthServiceGorm) ExportUserData(ctx context.Context, req *authpb.ExportUserDataRequest) (*authpb.ExportUserDataResponse, error) {
+	if req.SessionId == "" {
+		return &authpb.ExportUserDataResponse{
+			Success: false,
+			Message: "Session ID is required",
+		}, nil
+	}
+
+	user, err := s.db.GetUserBySession(req.SessionId)
+	if err != nil {
+		return &authpb.ExportUserDataResponse{
+			Success: false,
+			Message: "Invalid session",
+		}, nil
+	}
+
+	export, err := s.db.ExportUserData(user.ID)
+	if err != nil {
+		log.Printf("Error exporting data of user %s: %v", user.ID, err)
+		return &authpb.ExportUserDataResponse{
+			Success: false,
+			Message: "Failed to export user data",
+		}, nil
+	}
+
+	data, err := json.MarshalIndent(export, "", "  ")
+	if err != nil {
+		log.Printf("Error encoding data export of user %s: %v", user.ID, err)
+		return &authpb.ExportUserDataResponse{
+			Success: false,
+			Message: "Failed to export user data",
+		}, nil
+	}
+
+	return &authpb.ExportUserDataResponse{
+		Success: true,
+		Message: "User data exported",
+		Data:    data,
 	}, nil
 }
 
@@ -266,12 +557,10 @@ func (s *AuthServiceGorm) SearchUsers(ctx context.Context, req *authpb.SearchUse
 }
 
 func (s *AuthServiceGorm) ListUsers(ctx context.Context, req *authpb.ListUsersRequest) (*authpb.ListUsersResponse, error) {
-	// Validate session
-	_, err := s.db.GetUserBySession(req.SessionId)
-	if err != nil {
+	if _, msg := s.requireAdmin(req.SessionId); msg != "" {
 		return &authpb.ListUsersResponse{
 			Success: false,
-			Message: "Invalid session",
+			Message: msg,
 		}, nil
 	}
 
@@ -298,6 +587,8 @@ func (s *AuthServiceGorm) ListUsers(ctx context.Context, req *authpb.ListUsersRe
 			Username:  user.Username,
 			Email:     user.Email,
 			CreatedAt: timestamppb.New(user.CreatedAt),
+			IsAdmin:   user.IsAdmin,
+			Disabled:  user.Disabled,
 		}
 		if user.LastLogin != nil {
 			protoUsers[i].LastLogin = timestamppb.New(*user.LastLogin)
@@ -311,6 +602,53 @@ func (s *AuthServiceGorm) ListUsers(ctx context.Context, req *authpb.ListUsersRe
 	}, nil
 }
 
+// SetUserDisabled implements the SetUserDisabled RPC method. Disabled users
+// keep their data but cannot log in, and their sessions are revoked.
+func (s *AuthServiceGorm) SetUserDisabled(ctx context.Context, req *authpb.SetUserDisabledRequest) (*authpb.SetUserDisabledResponse, error) {
+	caller, msg := s.requireAdmin(req.SessionId)
+	if msg != "" {
+		return &authpb.SetUserDisabledResponse{
+			Success: false,
+			Message: msg,
+		}, nil
+	}
+
+	if req.UserId == "" {
+		return &authpb.SetUserDisabledResponse{
+			Success: false,
+			Message: "User ID is required",
+		}, nil
+	}
+
+	if req.UserId == caller.ID && req.Disabled {
+		return &authpb.SetUserDisabledResponse{
+			Success: false,
+			Message: "You cannot disable your own account",
+		}, nil
+	}
+
+	if err := s.db.SetUserDisabled(req.UserId, req.Disabled); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &authpb.SetUserDisabledResponse{
+				Success: false,
+				Message: "User not found",
+			}, nil
+		}
+		log.Printf("Error updating disabled state for user %s: %v", req.UserId, err)
+		return &authpb.SetUserDisabledResponse{
+			Success: false,
+			Message: "Failed to update user",
+		}, nil
+	}
+
+	log.Printf("User %s set disabled=%t for user %s", caller.Username, req.Disabled, req.UserId)
+
+	return &authpb.SetUserDisabledResponse{
+		Success: true,
+		Message: "User updated successfully",
+	}, nil
+}
+
 // ValidateSessionByID is a helper method for internal use
 func (s *AuthServiceGorm) ValidateSessionByID(sessionID string) (*authpb.User, error) {
 	user, err := s.db.GetUserBySession(sessionID)
@@ -328,12 +666,10 @@ func (s *AuthServiceGorm) ValidateSessionByID(sessionID string) (*authpb.User, e
 
 // GetConnectedUsers returns all users with active sessions (Admin only)
 func (s *AuthServiceGorm) GetConnectedUsers(ctx context.Context, req *authpb.GetConnectedUsersRequest) (*authpb.GetConnectedUsersResponse, error) {
-	// Validate session
-	_, err := s.db.GetUserBySession(req.SessionId)
-	if err != nil {
+	if _, msg := s.requireAdmin(req.SessionId); msg != "" {
 		return &authpb.GetConnectedUsersResponse{
 			Success: false,
-			Message: "Invalid session",
+			Message: msg,
 		}, nil
 	}
 
@@ -366,11 +702,38 @@ func (s *AuthServiceGorm) GetConnectedUsers(ctx context.Context, req *authpb.Get
 	}, nil
 }
 
+// subscriptionBufferSize is how many updates may queue for one subscriber
+// before further updates to it are dropped
+const subscriptionBufferSize = 64
+
 // Subscription represents an active subscription to alert updates
 type Subscription struct {
 	AlertKey string
 	UserID   string
+	Username string
 	Stream   grpc.ServerStreamingServer[alertpb.AlertUpdate]
+
+	// gRPC streams do not allow concurrent sends, so updates are queued and
+	// a single forwardUpdates goroutine per subscription calls Stream.Send
+	updates chan *alertpb.AlertUpdate
+	done    chan struct{} // Closed by removeSubscription
+	failed  chan error    // Receives the Send error that stopped forwarding
+}
+
+// forwardUpdates sends queued updates in order until the subscription is
+// removed or a send fails
+func (sub *Subscription) forwardUpdates() {
+	for {
+		select {
+		case <-sub.done:
+			return
+		case update := <-sub.updates:
+			if err := sub.Stream.Send(update); err != nil {
+				sub.failed <- err
+				return
+			}
+		}
+	}
 }
 
 // AlertServiceGorm implements the AlertService gRPC service
@@ -379,12 +742,98 @@ type AlertServiceGorm struct {
 	db            *database.GormDB
 	subscriptions map[string][]*Subscription // alertKey -> []*Subscription
 	subsMutex     sync.RWMutex
+
+	heartbeatInterval time.Duration // How often idle subscription streams get a HEARTBEAT
+	maxCommentLength  int           // Longest comment AddComment accepts, in characters
+
+	permissions *PermissionChecker // nil allows every action
+	admin       config.AdminConfig
 }
 
 func NewAlertServiceGorm(db *database.GormDB) *AlertServiceGorm {
 	return &AlertServiceGorm{
-		db:            db,
-		subscriptions: make(map[string][]*Subscription),
+		db:                db,
+		subscriptions:     make(map[string][]*Subscription),
+		heartbeatInterval: config.DefaultStreamHeartbeatInterval,
+		maxCommentLength:  config.DefaultMaxCommentLength,
+	}
+}
+
+// SetHeartbeatInterval sets how often subscription streams send a HEARTBEAT
+func (s *AlertServiceGorm) SetHeartbeatInterval(interval time.Duration) {
+	s.heartbeatInterval = interval
+}
+
+// SetMaxCommentLength sets the longest comment AddComment accepts
+func (s *AlertServiceGorm) SetMaxCommentLength(length int) {
+	s.maxCommentLength = length
+}
+
+// SetAdminConfig sets who besides is_admin users may change admin settings
+func (s *AlertServiceGorm) SetAdminConfig(admin config.AdminConfig) {
+	s.admin = admin
+}
+
+// SetPermissionChecker sets the group permissions alert actions are checked
+// against; nil allows every action
+func (s *AlertServiceGorm) SetPermissionChecker(permissions *PermissionChecker) {
+	s.permissions = permissions
+}
+
+// authorize returns an empty string when user holds permission, or the
+// message to send back otherwise
+func (s *AlertServiceGorm) authorize(user *models.User, permission string) string {
+	allowed, err := s.permissions.Allowed(user, permission)
+	if err != nil {
+		log.Printf("Error checking permission %s: %v", permission, err)
+		return "Failed to check permissions"
+	}
+	if !allowed {
+		return permissionDenied(permission)
+	}
+	return ""
+}
+
+// canActForOthers reports whether user may act on other users' entries with
+// permission. Without group permissions configured users only manage their own.
+func (s *AlertServiceGorm) canActForOthers(user *models.User, permission string) bool {
+	if s.permissions == nil {
+		return false
+	}
+	allowed, err := s.permissions.Allowed(user, permission)
+	if err != nil {
+		log.Printf("Error checking permission %s: %v", permission, err)
+	}
+	return allowed
+}
+
+// SeedDefaultFilterPresets creates the configured org-wide presets as shared
+// presets owned by the system user. Presets already seeded under the same
+// name are left as they are.
+func (s *AlertServiceGorm) SeedDefaultFilterPresets(presets []config.DefaultFilterPresetConfig) {
+	if len(presets) == 0 {
+		return
+	}
+
+	seeds := make([]models.FilterPreset, 0, len(presets))
+	for _, preset := range presets {
+		filterData, err := json.Marshal(preset.FilterData)
+		if err != nil || preset.FilterData == nil {
+			filterData = []byte("{}")
+		}
+		seeds = append(seeds, models.FilterPreset{
+			Name:        preset.Name,
+			Description: preset.Description,
+			FilterData:  models.JSONB(filterData),
+		})
+	}
+
+	created, err := s.db.SeedSharedFilterPresets(seeds)
+	if err != nil {
+		log.Printf("Error seeding default filter presets: %v", err)
+	}
+	if created > 0 {
+		log.Printf("Seeded %d default filter presets", created)
 	}
 }
 
@@ -411,6 +860,13 @@ func (s *AlertServiceGorm) AddComment(ctx context.Context, req *alertpb.AddComme
 		}, nil
 	}
 
+	if length := utf8.RuneCountInString(req.Content); length > s.maxCommentLength {
+		return &alertpb.AddCommentResponse{
+			Success: false,
+			Message: fmt.Sprintf("Comment is too long: %d characters, the maximum is %d", length, s.maxCommentLength),
+		}, nil
+	}
+
 	// Validate session
 	user, err := s.db.GetUserBySession(req.SessionId)
 	if err != nil {
@@ -430,6 +886,19 @@ func (s *AlertServiceGorm) AddComment(ctx context.Context, req *alertpb.AddComme
 		}, nil
 	}
 
+	if err := s.db.CreateCommentTags(&comment.Comment); err != nil {
+		log.Printf("Error recording tags of comment %s: %v", comment.ID, err)
+	}
+
+	s.recordActivity(&models.AlertActivity{
+		AlertKey:  comment.AlertKey,
+		Type:      models.ActivityComment,
+		UserID:    comment.UserID,
+		RefID:     comment.ID,
+		Content:   comment.Content,
+		CreatedAt: comment.CreatedAt,
+	})
+
 	// Create the protobuf comment
 	protoComment := &alertpb.Comment{
 		Id:        comment.ID,
@@ -447,6 +916,7 @@ func (s *AlertServiceGorm) AddComment(ctx context.Context, req *alertpb.AddComme
 		UpdateData: &alertpb.AlertUpdate_Comment{Comment: protoComment},
 		Timestamp:  timestamppb.Now(),
 	})
+	s.notifyMentions(comment)
 
 	return &alertpb.AddCommentResponse{
 		Success: true,
@@ -464,7 +934,26 @@ func (s *AlertServiceGorm) GetComments(ctx context.Context, req *alertpb.GetComm
 		}, nil
 	}
 
-	comments, err := s.db.GetComments(req.AlertKey)
+	var comments []models.CommentWithUser
+	var total int64
+	var nextCursor string
+	var err error
+	if req.Limit > 0 {
+		before, beforeID, cursorErr := decodePageCursor(req.Before)
+		if cursorErr != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid cursor: %v", cursorErr)
+		}
+		// Fetch one extra comment to know whether there is another page
+		comments, total, err = s.db.GetCommentsPage(req.AlertKey, int(req.Limit)+1, before, beforeID)
+		if len(comments) > int(req.Limit) {
+			comments = comments[:req.Limit]
+			last := comments[len(comments)-1]
+			nextCursor = encodePageCursor(last.CreatedAt, last.ID)
+		}
+	} else {
+		comments, err = s.db.GetComments(req.AlertKey)
+		total = int64(len(comments))
+	}
 	if err != nil {
 		log.Printf("Error getting comments: %v", err)
 		return &alertpb.GetCommentsResponse{
@@ -487,8 +976,10 @@ func (s *AlertServiceGorm) GetComments(ctx context.Context, req *alertpb.GetComm
 	}
 
 	return &alertpb.GetCommentsResponse{
-		Comments: pbComments,
-		Count:    int32(len(pbComments)),
+		Comments:   pbComments,
+		Count:      int32(len(pbComments)),
+		TotalCount: int32(total),
+		NextCursor: nextCursor,
 	}, nil
 }
 
@@ -548,8 +1039,23 @@ func (s *AlertServiceGorm) DeleteComment(ctx context.Context, req *alertpb.Delet
 		}, nil
 	}
 
+	// Look up the comment first so subscribers of its alert can be notified
+	comment, err := s.db.GetCommentWithUser(req.CommentId)
+	if err != nil {
+		return &alertpb.DeleteCommentResponse{
+			Success: false,
+			Message: "Comment not found",
+		}, nil
+	}
+
+	// Other users' comments can be removed with comment:delete
+	ownerID := user.ID
+	if comment.UserID != user.ID && s.canActForOthers(user, models.PermissionCommentDelete) {
+		ownerID = comment.UserID
+	}
+
 	// Delete comment
-	if err := s.db.DeleteComment(req.CommentId, user.ID); err != nil {
+	if err := s.db.DeleteComment(req.CommentId, ownerID); err != nil {
 		log.Printf("Error deleting comment: %v", err)
 		return &alertpb.DeleteCommentResponse{
 			Success: false,
@@ -557,13 +1063,96 @@ func (s *AlertServiceGorm) DeleteComment(ctx context.Context, req *alertpb.Delet
 		}, nil
 	}
 
-	return &alertpb.DeleteCommentResponse{
-		Success: true,
-		Message: "Comment deleted successfully",
-	}, nil
-}
-
-// AddAcknowledgment implements the AddAcknowledgment RPC method
+	// A deleted comment disappears from the timeline as well
+	if err := s.db.DeleteAlertActivityByRef(models.ActivityComment, req.CommentId); err != nil {
+		log.Printf("Error removing activity for comment %s: %v", req.CommentId, err)
+	}
+	if err := s.db.DeleteMentionsByComment(req.CommentId); err != nil {
+		log.Printf("Error removing mentions of comment %s: %v", req.CommentId, err)
+	}
+	if err := s.db.DeleteCommentTagsByComment(req.CommentId); err != nil {
+		log.Printf("Error removing tags of comment %s: %v", req.CommentId, err)
+	}
+
+	// Broadcast deletion to subscribers
+	s.broadcastUpdate(comment.AlertKey, &alertpb.AlertUpdate{
+		AlertKey:   comment.AlertKey,
+		UpdateType: alertpb.UpdateType_COMMENT_DELETED,
+		UpdateData: &alertpb.AlertUpdate_DeletedCommentId{DeletedCommentId: req.CommentId},
+		Timestamp:  timestamppb.Now(),
+	})
+
+	return &alertpb.DeleteCommentResponse{
+		Success: true,
+		Message: "Comment deleted successfully",
+	}, nil
+}
+
+// GetCommentSettings implements the GetCommentSettings RPC method
+func (s *AlertServiceGorm) GetCommentSettings(ctx context.Context, req *alertpb.GetCommentSettingsRequest) (*alertpb.GetCommentSettingsResponse, error) {
+	return &alertpb.GetCommentSettingsResponse{
+		MaxCommentLength: int32(s.maxCommentLength),
+	}, nil
+}
+
+// GetCommentsByTag implements the GetCommentsByTag RPC method
+func (s *AlertServiceGorm) GetCommentsByTag(ctx context.Context, req *alertpb.GetCommentsByTagRequest) (*alertpb.GetCommentsByTagResponse, error) {
+	if req.SessionId == "" {
+		return &alertpb.GetCommentsByTagResponse{
+			Success: false,
+			Message: "Session ID is required",
+		}, nil
+	}
+
+	if models.NormalizeCommentTag(req.Tag) == "" {
+		return &alertpb.GetCommentsByTagResponse{
+			Success: false,
+			Message: "Tag is required",
+		}, nil
+	}
+
+	if _, err := s.db.GetUserBySession(req.SessionId); err != nil {
+		return &alertpb.GetCommentsByTagResponse{
+			Success: false,
+			Message: "Invalid session",
+		}, nil
+	}
+
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = 100
+	} else if limit > 500 {
+		limit = 500
+	}
+
+	comments, err := s.db.GetCommentsByTag(req.Tag, req.AlertKey, limit)
+	if err != nil {
+		log.Printf("Error getting comments tagged %s: %v", req.Tag, err)
+		return &alertpb.GetCommentsByTagResponse{
+			Success: false,
+			Message: "Failed to get comments",
+		}, nil
+	}
+
+	pbComments := make([]*alertpb.Comment, 0, len(comments))
+	for _, comment := range comments {
+		pbComments = append(pbComments, &alertpb.Comment{
+			Id:        comment.ID,
+			AlertKey:  comment.AlertKey,
+			UserId:    comment.UserID,
+			Username:  comment.Username,
+			Content:   comment.Content,
+			CreatedAt: timestamppb.New(comment.CreatedAt),
+		})
+	}
+
+	return &alertpb.GetCommentsByTagResponse{
+		Success:  true,
+		Comments: pbComments,
+	}, nil
+}
+
+// AddAcknowledgment implements the AddAcknowledgment RPC method
 func (s *AlertServiceGorm) AddAcknowledgment(ctx context.Context, req *alertpb.AddAcknowledgmentRequest) (*alertpb.AddAcknowledgmentResponse, error) {
 	if req.SessionId == "" {
 		return &alertpb.AddAcknowledgmentResponse{
@@ -587,9 +1176,23 @@ func (s *AlertServiceGorm) AddAcknowledgment(ctx context.Context, req *alertpb.A
 			Message: "Invalid session",
 		}, nil
 	}
+	if message := s.authorize(user, models.PermissionAckCreate); message != "" {
+		return &alertpb.AddAcknowledgmentResponse{
+			Success: false,
+			Message: message,
+		}, nil
+	}
+
+	expiry, ok := acknowledgmentExpiry(req.ExpiresAt, req.Renotify)
+	if !ok {
+		return &alertpb.AddAcknowledgmentResponse{
+			Success: false,
+			Message: "Acknowledgment expiry must be in the future",
+		}, nil
+	}
 
 	// Create acknowledgment
-	ack, err := s.db.CreateAcknowledgment(req.AlertKey, user.ID, req.Reason)
+	ack, err := s.db.CreateAcknowledgment(req.AlertKey, user.ID, req.Reason, expiry)
 	if err != nil {
 		log.Printf("Error creating acknowledgment: %v", err)
 		return &alertpb.AddAcknowledgmentResponse{
@@ -598,15 +1201,17 @@ func (s *AlertServiceGorm) AddAcknowledgment(ctx context.Context, req *alertpb.A
 		}, nil
 	}
 
-	// Create the protobuf acknowledgment
-	protoAck := &alertpb.Acknowledgment{
-		Id:        ack.ID,
+	s.recordActivity(&models.AlertActivity{
 		AlertKey:  ack.AlertKey,
-		UserId:    ack.UserID,
-		Username:  ack.Username,
-		Reason:    ack.Reason,
-		CreatedAt: timestamppb.New(ack.CreatedAt),
-	}
+		Type:      models.ActivityAck,
+		UserID:    ack.UserID,
+		RefID:     ack.ID,
+		Content:   ack.Reason,
+		CreatedAt: ack.CreatedAt,
+	})
+
+	// Create the protobuf acknowledgment
+	protoAck := acknowledgmentToProto(&ack.Acknowledgment, ack.Username)
 
 	// Broadcast to subscribers
 	s.broadcastUpdate(req.AlertKey, &alertpb.AlertUpdate{
@@ -623,6 +1228,109 @@ func (s *AlertServiceGorm) AddAcknowledgment(ctx context.Context, req *alertpb.A
 	}, nil
 }
 
+// BulkAcknowledge implements the BulkAcknowledge RPC method
+func (s *AlertServiceGorm) BulkAcknowledge(ctx context.Context, req *alertpb.BulkAcknowledgeRequest) (*alertpb.BulkAcknowledgeResponse, error) {
+	if req.SessionId == "" {
+		return &alertpb.BulkAcknowledgeResponse{
+			Success: false,
+			Message: "Session ID is required",
+		}, nil
+	}
+
+	if len(req.AlertKeys) == 0 {
+		return &alertpb.BulkAcknowledgeResponse{
+			Success: false,
+			Message: "At least one alert key is required",
+		}, nil
+	}
+
+	// Validate session
+	user, err := s.db.GetUserBySession(req.SessionId)
+	if err != nil {
+		return &alertpb.BulkAcknowledgeResponse{
+			Success: false,
+			Message: "Invalid session",
+		}, nil
+	}
+	if message := s.authorize(user, models.PermissionAckCreate); message != "" {
+		return &alertpb.BulkAcknowledgeResponse{
+			Success: false,
+			Message: message,
+		}, nil
+	}
+
+	expiry, ok := acknowledgmentExpiry(req.ExpiresAt, req.Renotify)
+	if !ok {
+		return &alertpb.BulkAcknowledgeResponse{
+			Success: false,
+			Message: "Acknowledgment expiry must be in the future",
+		}, nil
+	}
+
+	results := make(map[string]bool, len(req.AlertKeys))
+	alertKeys := make([]string, 0, len(req.AlertKeys))
+	for _, alertKey := range req.AlertKeys {
+		if _, seen := results[alertKey]; seen {
+			continue
+		}
+		results[alertKey] = false
+		if alertKey != "" {
+			alertKeys = append(alertKeys, alertKey)
+		}
+	}
+
+	created, err := s.db.CreateAcknowledgments(alertKeys, user.ID, req.Reason, expiry)
+	if err != nil {
+		log.Printf("Error creating acknowledgments: %v", err)
+		return &alertpb.BulkAcknowledgeResponse{
+			Success: false,
+			Message: "Failed to create acknowledgments",
+			Results: results,
+		}, nil
+	}
+
+	protoAcks := make([]*alertpb.Acknowledgment, 0, len(created))
+	for _, alertKey := range alertKeys {
+		ack, ok := created[alertKey]
+		if !ok {
+			continue
+		}
+		results[alertKey] = true
+
+		s.recordActivity(&models.AlertActivity{
+			AlertKey:  ack.AlertKey,
+			Type:      models.ActivityAck,
+			UserID:    ack.UserID,
+			RefID:     ack.ID,
+			Content:   ack.Reason,
+			CreatedAt: ack.CreatedAt,
+		})
+
+		protoAck := acknowledgmentToProto(ack, user.Username)
+		protoAcks = append(protoAcks, protoAck)
+
+		s.broadcastUpdate(alertKey, &alertpb.AlertUpdate{
+			AlertKey:   alertKey,
+			UpdateType: alertpb.UpdateType_ACKNOWLEDGMENT_ADDED,
+			UpdateData: &alertpb.AlertUpdate_Acknowledgment{Acknowledgment: protoAck},
+			Timestamp:  timestamppb.Now(),
+		})
+	}
+
+	failed := len(results) - len(protoAcks)
+	message := fmt.Sprintf("Acknowledged %d alerts", len(protoAcks))
+	if failed > 0 {
+		message = fmt.Sprintf("Acknowledged %d alerts, %d failed", len(protoAcks), failed)
+	}
+
+	return &alertpb.BulkAcknowledgeResponse{
+		Success:         failed == 0,
+		Message:         message,
+		Results:         results,
+		Acknowledgments: protoAcks,
+	}, nil
+}
+
 // GetAcknowledgments implements the GetAcknowledgments RPC method
 func (s *AlertServiceGorm) GetAcknowledgments(ctx context.Context, req *alertpb.GetAcknowledgmentsRequest) (*alertpb.GetAcknowledgmentsResponse, error) {
 	if req.AlertKey == "" {
@@ -632,7 +1340,25 @@ func (s *AlertServiceGorm) GetAcknowledgments(ctx context.Context, req *alertpb.
 		}, nil
 	}
 
-	acks, err := s.db.GetAcknowledgments(req.AlertKey)
+	var acks []models.AcknowledgmentWithUser
+	var total int64
+	var nextCursor string
+	var err error
+	if req.Limit > 0 {
+		before, beforeID, cursorErr := decodePageCursor(req.Before)
+		if cursorErr != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid cursor: %v", cursorErr)
+		}
+		acks, total, err = s.db.GetAcknowledgmentsPage(req.AlertKey, int(req.Limit)+1, before, beforeID)
+		if len(acks) > int(req.Limit) {
+			acks = acks[:req.Limit]
+			last := acks[len(acks)-1]
+			nextCursor = encodePageCursor(last.CreatedAt, last.ID)
+		}
+	} else {
+		acks, err = s.db.GetAcknowledgments(req.AlertKey)
+		total = int64(len(acks))
+	}
 	if err != nil {
 		log.Printf("Error getting acknowledgments: %v", err)
 		return &alertpb.GetAcknowledgmentsResponse{
@@ -644,49 +1370,48 @@ func (s *AlertServiceGorm) GetAcknowledgments(ctx context.Context, req *alertpb.
 	// Convert to protobuf format
 	var pbAcks []*alertpb.Acknowledgment
 	for _, ack := range acks {
-		pbAcks = append(pbAcks, &alertpb.Acknowledgment{
-			Id:        ack.ID,
-			AlertKey:  ack.AlertKey,
-			UserId:    ack.UserID,
-			Username:  ack.Username,
-			Reason:    ack.Reason,
-			CreatedAt: timestamppb.New(ack.CreatedAt),
-		})
+		pbAcks = append(pbAcks, acknowledgmentToProto(&ack.Acknowledgment, ack.Username))
 	}
 
 	return &alertpb.GetAcknowledgmentsResponse{
 		Acknowledgments: pbAcks,
 		Count:           int32(len(pbAcks)),
+		TotalCount:      int32(total),
+		NextCursor:      nextCursor,
 	}, nil
 }
 
 // GetAllAcknowledgedAlerts implements the GetAllAcknowledgedAlerts RPC method
 func (s *AlertServiceGorm) GetAllAcknowledgedAlerts(ctx context.Context, req *alertpb.GetAllAcknowledgedAlertsRequest) (*alertpb.GetAllAcknowledgedAlertsResponse, error) {
+	// An empty answer would make callers drop every acknowledgment and zero
+	// every count, so failures are returned instead
 	acknowledgedAlerts, err := s.db.GetAllAcknowledgedAlerts(req.AlertKeys)
 	if err != nil {
 		log.Printf("Error getting all acknowledged alerts: %v", err)
-		return &alertpb.GetAllAcknowledgedAlertsResponse{
-			AcknowledgedAlerts: make(map[string]*alertpb.Acknowledgment),
-			Count:              0,
-		}, nil
+		return nil, fmt.Errorf("failed to get acknowledged alerts: %w", err)
+	}
+
+	// Counts come in the same round trip so callers can sort by them
+	counts, err := s.db.GetAcknowledgmentCountsBatch(req.AlertKeys)
+	if err != nil {
+		log.Printf("Error getting acknowledgment counts batch: %v", err)
+		return nil, fmt.Errorf("failed to get acknowledgment counts: %w", err)
+	}
+	pbCounts := make(map[string]int32, len(counts))
+	for key, count := range counts {
+		pbCounts[key] = int32(count)
 	}
 
 	// Convert to protobuf format
 	pbAcknowledgedAlerts := make(map[string]*alertpb.Acknowledgment)
 	for alertKey, ack := range acknowledgedAlerts {
-		pbAcknowledgedAlerts[alertKey] = &alertpb.Acknowledgment{
-			Id:        ack.ID,
-			AlertKey:  ack.AlertKey,
-			UserId:    ack.UserID,
-			Username:  ack.Username,
-			Reason:    ack.Reason,
-			CreatedAt: timestamppb.New(ack.CreatedAt),
-		}
+		pbAcknowledgedAlerts[alertKey] = acknowledgmentToProto(&ack.Acknowledgment, ack.Username)
 	}
 
 	return &alertpb.GetAllAcknowledgedAlertsResponse{
-		AcknowledgedAlerts: pbAcknowledgedAlerts,
-		Count:              int32(len(pbAcknowledgedAlerts)),
+		AcknowledgedAlerts:   pbAcknowledgedAlerts,
+		Count:                int32(len(pbAcknowledgedAlerts)),
+		AcknowledgmentCounts: pbCounts,
 	}, nil
 }
 
@@ -715,8 +1440,18 @@ func (s *AlertServiceGorm) DeleteAcknowledgment(ctx context.Context, req *alertp
 		}, nil
 	}
 
-	// Delete acknowledgment
-	if err := s.db.DeleteAcknowledgment(req.AlertKey, user.ID); err != nil {
+	// Delete a single acknowledgment when an ID is given, otherwise all of the
+	// caller's acknowledgments on the alert
+	if req.AcknowledgmentId != "" {
+		ownerID := user.ID
+		if s.canActForOthers(user, models.PermissionAckDelete) {
+			ownerID = s.acknowledgmentOwner(req.AlertKey, req.AcknowledgmentId, user.ID)
+		}
+		err = s.db.DeleteAcknowledgmentByID(req.AcknowledgmentId, req.AlertKey, ownerID)
+	} else {
+		err = s.db.DeleteAcknowledgment(req.AlertKey, user.ID)
+	}
+	if err != nil {
 		log.Printf("Error deleting acknowledgment: %v", err)
 		return &alertpb.DeleteAcknowledgmentResponse{
 			Success: false,
@@ -724,11 +1459,25 @@ func (s *AlertServiceGorm) DeleteAcknowledgment(ctx context.Context, req *alertp
 		}, nil
 	}
 
+	s.recordActivity(&models.AlertActivity{
+		AlertKey: req.AlertKey,
+		Type:     models.ActivityAckRemoved,
+		UserID:   user.ID,
+		RefID:    req.AcknowledgmentId,
+	})
+
+	// Subscribers get the removed acknowledgment's ID, or the alert key when
+	// everything was cleared
+	deletedID := req.AcknowledgmentId
+	if deletedID == "" {
+		deletedID = req.AlertKey
+	}
+
 	// Broadcast deletion to subscribers
 	s.broadcastUpdate(req.AlertKey, &alertpb.AlertUpdate{
 		AlertKey:   req.AlertKey,
 		UpdateType: alertpb.UpdateType_ACKNOWLEDGMENT_DELETED,
-		UpdateData: &alertpb.AlertUpdate_DeletedAcknowledgmentId{DeletedAcknowledgmentId: req.AlertKey},
+		UpdateData: &alertpb.AlertUpdate_DeletedAcknowledgmentId{DeletedAcknowledgmentId: deletedID},
 		Timestamp:  timestamppb.Now(),
 	})
 
@@ -738,6 +1487,184 @@ func (s *AlertServiceGorm) DeleteAcknowledgment(ctx context.Context, req *alertp
 	}, nil
 }
 
+// acknowledgmentToProto converts a stored acknowledgment to its protobuf form
+func acknowledgmentToProto(ack *models.Acknowledgment, username string) *alertpb.Acknowledgment {
+	pbAck := &alertpb.Acknowledgment{
+		Id:        ack.ID,
+		AlertKey:  ack.AlertKey,
+		UserId:    ack.UserID,
+		Username:  username,
+		Reason:    ack.Reason,
+		CreatedAt: timestamppb.New(ack.CreatedAt),
+	}
+	if ack.ExpiresAt != nil {
+		pbAck.ExpiresAt = timestamppb.New(*ack.ExpiresAt)
+		pbAck.Renotify = ack.Renotify
+	}
+	return pbAck
+}
+
+// acknowledgmentExpiry returns the requested expiry of an acknowledgment, nil
+// when none was given; ok is false when it is not in the future
+func acknowledgmentExpiry(expiresAt *timestamppb.Timestamp, renotify bool) (*models.AcknowledgmentExpiry, bool) {
+	if expiresAt == nil {
+		return nil, true
+	}
+	until := expiresAt.AsTime()
+	if !until.After(time.Now()) {
+		return nil, false
+	}
+	return &models.AcknowledgmentExpiry{ExpiresAt: until, Renotify: renotify}, true
+}
+
+// acknowledgmentOwner returns the ID of the user who made an acknowledgment of
+// alertKey, or fallback when it cannot be found
+func (s *AlertServiceGorm) acknowledgmentOwner(alertKey, ackID, fallback string) string {
+	acks, err := s.db.GetAcknowledgments(alertKey)
+	if err != nil {
+		return fallback
+	}
+	for _, ack := range acks {
+		if ack.ID == ackID {
+			return ack.UserID
+		}
+	}
+	return fallback
+}
+
+// EscalateAlert implements the EscalateAlert RPC method
+func (s *AlertServiceGorm) EscalateAlert(ctx context.Context, req *alertpb.EscalateAlertRequest) (*alertpb.EscalateAlertResponse, error) {
+	if req.SessionId == "" {
+		return &alertpb.EscalateAlertResponse{
+			Success: false,
+			Message: "Session ID is required",
+		}, nil
+	}
+
+	if req.AlertKey == "" || req.EscalatedTo == "" {
+		return &alertpb.EscalateAlertResponse{
+			Success: false,
+			Message: "Alert key and escalation target are required",
+		}, nil
+	}
+
+	// Validate session
+	user, err := s.db.GetUserBySession(req.SessionId)
+	if err != nil {
+		return &alertpb.EscalateAlertResponse{
+			Success: false,
+			Message: "Invalid session",
+		}, nil
+	}
+	if message := s.authorize(user, models.PermissionAlertEscalate); message != "" {
+		return &alertpb.EscalateAlertResponse{
+			Success: false,
+			Message: message,
+		}, nil
+	}
+
+	// Make sure the target exists so escalations always point at someone
+	switch req.TargetType {
+	case models.EscalationTargetUser:
+		if _, err := s.db.GetUserByUsername(req.EscalatedTo); err != nil {
+			return &alertpb.EscalateAlertResponse{
+				Success: false,
+				Message: fmt.Sprintf("User %s not found", req.EscalatedTo),
+			}, nil
+		}
+	case models.EscalationTargetGroup:
+		exists, err := s.db.GroupExists(req.EscalatedTo)
+		if err != nil || !exists {
+			return &alertpb.EscalateAlertResponse{
+				Success: false,
+				Message: fmt.Sprintf("Group %s not found", req.EscalatedTo),
+			}, nil
+		}
+	default:
+		return &alertpb.EscalateAlertResponse{
+			Success: false,
+			Message: "Target type must be user or group",
+		}, nil
+	}
+
+	escalation, err := s.db.CreateEscalation(req.AlertKey, user.ID, req.EscalatedTo, req.TargetType, req.Reason)
+	if err != nil {
+		log.Printf("Error creating escalation: %v", err)
+		return &alertpb.EscalateAlertResponse{
+			Success: false,
+			Message: "Failed to create escalation",
+		}, nil
+	}
+
+	s.recordActivity(&models.AlertActivity{
+		AlertKey:  escalation.AlertKey,
+		Type:      models.ActivityEscalation,
+		UserID:    escalation.UserID,
+		RefID:     escalation.ID,
+		Content:   escalation.Reason,
+		Target:    escalation.EscalatedTo,
+		CreatedAt: escalation.CreatedAt,
+	})
+
+	protoEscalation := escalationToProto(escalation)
+
+	// Broadcast to subscribers
+	s.broadcastUpdate(req.AlertKey, &alertpb.AlertUpdate{
+		AlertKey:   req.AlertKey,
+		UpdateType: alertpb.UpdateType_ESCALATION_ADDED,
+		UpdateData: &alertpb.AlertUpdate_Escalation{Escalation: protoEscalation},
+		Timestamp:  timestamppb.Now(),
+	})
+
+	return &alertpb.EscalateAlertResponse{
+		Success:    true,
+		Message:    "Alert escalated successfully",
+		Escalation: protoEscalation,
+	}, nil
+}
+
+// GetEscalations implements the GetEscalations RPC method
+func (s *AlertServiceGorm) GetEscalations(ctx context.Context, req *alertpb.GetEscalationsRequest) (*alertpb.GetEscalationsResponse, error) {
+	if req.AlertKey == "" {
+		return &alertpb.GetEscalationsResponse{
+			Escalations: []*alertpb.Escalation{},
+			Count:       0,
+		}, nil
+	}
+
+	escalations, err := s.db.GetEscalations(req.AlertKey)
+	if err != nil {
+		log.Printf("Error getting escalations: %v", err)
+		return &alertpb.GetEscalationsResponse{
+			Escalations: []*alertpb.Escalation{},
+			Count:       0,
+		}, nil
+	}
+
+	protoEscalations := make([]*alertpb.Escalation, len(escalations))
+	for i := range escalations {
+		protoEscalations[i] = escalationToProto(&escalations[i])
+	}
+
+	return &alertpb.GetEscalationsResponse{
+		Escalations: protoEscalations,
+		Count:       int32(len(protoEscalations)),
+	}, nil
+}
+
+func escalationToProto(escalation *models.EscalationWithUser) *alertpb.Escalation {
+	return &alertpb.Escalation{
+		Id:          escalation.ID,
+		AlertKey:    escalation.AlertKey,
+		UserId:      escalation.UserID,
+		Username:    escalation.Username,
+		EscalatedTo: escalation.EscalatedTo,
+		TargetType:  escalation.TargetType,
+		Reason:      escalation.Reason,
+		CreatedAt:   timestamppb.New(escalation.CreatedAt),
+	}
+}
+
 // SubscribeToAlertUpdates implements the streaming RPC for real-time updates
 func (s *AlertServiceGorm) SubscribeToAlertUpdates(req *alertpb.SubscribeToAlertUpdatesRequest, stream grpc.ServerStreamingServer[alertpb.AlertUpdate]) error {
 	if req.SessionId == "" {
@@ -760,37 +1687,81 @@ func (s *AlertServiceGorm) SubscribeToAlertUpdates(req *alertpb.SubscribeToAlert
 	sub := &Subscription{
 		AlertKey: req.AlertKey,
 		UserID:   user.ID,
+		Username: user.Username,
 		Stream:   stream,
+		updates:  make(chan *alertpb.AlertUpdate, subscriptionBufferSize),
 	}
 
-	s.addSubscription(sub)
-	defer s.removeSubscription(sub)
-
-	// Send initial connection confirmation
-	initialUpdate := &alertpb.AlertUpdate{
-		AlertKey:   req.AlertKey,
-		UpdateType: alertpb.UpdateType_UNKNOWN_UPDATE,
-		Timestamp:  timestamppb.Now(),
-	}
-
-	if err := stream.Send(initialUpdate); err != nil {
-		log.Printf("Failed to send initial update: %v", err)
-		return err
+	// The connection confirmation carries who is viewing the alert and the
+	// heartbeat interval, and goes out ahead of any broadcast
+	s.registerSubscription(sub, &alertpb.AlertUpdate{
+		AlertKey:            req.AlertKey,
+		UpdateType:          alertpb.UpdateType_UNKNOWN_UPDATE,
+		Timestamp:           timestamppb.Now(),
+		HeartbeatIntervalMs: s.heartbeatInterval.Milliseconds(),
+	})
+	s.broadcastPresence(sub, alertpb.UpdateType_PRESENCE_JOINED)
+	defer func() {
+		s.removeSubscription(sub)
+		s.broadcastPresence(sub, alertpb.UpdateType_PRESENCE_LEFT)
+	}()
+
+	// Keep the stream alive. Heartbeats let clients and proxies tell an idle
+	// stream from a dead one, and a failed heartbeat send ends the stream.
+	heartbeat := time.NewTicker(s.heartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			log.Printf("User %s unsubscribed from alert %s", user.Username, req.AlertKey)
+			return nil
+		case err := <-sub.failed:
+			log.Printf("Failed to send update to subscriber: %v", err)
+			return err
+		case <-heartbeat.C:
+			select {
+			case sub.updates <- &alertpb.AlertUpdate{
+				AlertKey:   req.AlertKey,
+				UpdateType: alertpb.UpdateType_HEARTBEAT,
+				Timestamp:  timestamppb.Now(),
+			}:
+			default:
+				// The queue is backed up, so the stream is not idle anyway
+			}
+		}
 	}
-
-	// Keep the stream alive
-	<-stream.Context().Done()
-	log.Printf("User %s unsubscribed from alert %s", user.Username, req.AlertKey)
-	return nil
 }
 
-// addSubscription adds a new subscription to the manager
+// addSubscription adds a new subscription to the manager and starts the
+// goroutine that forwards its updates
 func (s *AlertServiceGorm) addSubscription(sub *Subscription) {
-	s.subsMutex.Lock()
-	defer s.subsMutex.Unlock()
+	s.registerSubscription(sub, nil)
+}
 
+// registerSubscription adds sub like addSubscription. When welcome is set it
+// is given the alert's viewers, sub's user included, and queued ahead of any
+// broadcast to sub.
+func (s *AlertServiceGorm) registerSubscription(sub *Subscription, welcome *alertpb.AlertUpdate) {
+	if sub.updates == nil {
+		sub.updates = make(chan *alertpb.AlertUpdate, subscriptionBufferSize)
+	}
+	sub.done = make(chan struct{})
+	sub.failed = make(chan error, 1)
+
+	s.subsMutex.Lock()
 	s.subscriptions[sub.AlertKey] = append(s.subscriptions[sub.AlertKey], sub)
-	log.Printf("Added subscription for alert %s, total: %d", sub.AlertKey, len(s.subscriptions[sub.AlertKey]))
+	total := len(s.subscriptions[sub.AlertKey])
+	if welcome != nil {
+		welcome.UpdateData = &alertpb.AlertUpdate_Presence{Presence: &alertpb.Presence{
+			Viewers: viewersOf(s.subscriptions[sub.AlertKey]),
+		}}
+		sub.updates <- welcome
+	}
+	s.subsMutex.Unlock()
+
+	go sub.forwardUpdates()
+	log.Printf("Added subscription for alert %s, total: %d", sub.AlertKey, total)
 }
 
 // removeSubscription removes a subscription from the manager
@@ -802,6 +1773,7 @@ func (s *AlertServiceGorm) removeSubscription(sub *Subscription) {
 	for i, existingSub := range subs {
 		if existingSub == sub {
 			s.subscriptions[sub.AlertKey] = append(subs[:i], subs[i+1:]...)
+			close(sub.done)
 			break
 		}
 	}
@@ -813,10 +1785,13 @@ func (s *AlertServiceGorm) removeSubscription(sub *Subscription) {
 	log.Printf("Removed subscription for alert %s", sub.AlertKey)
 }
 
-// broadcastUpdate sends an update to all subscribers of an alert
+// broadcastUpdate queues an update for all subscribers of an alert. Each
+// subscriber receives updates in the order they were queued; a subscriber
+// whose queue is full misses the update rather than blocking the caller.
 func (s *AlertServiceGorm) broadcastUpdate(alertKey string, update *alertpb.AlertUpdate) {
+	// Copy under the lock: removeSubscription edits the slice in place
 	s.subsMutex.RLock()
-	subs := s.subscriptions[alertKey]
+	subs := append([]*Subscription(nil), s.subscriptions[alertKey]...)
 	s.subsMutex.RUnlock()
 
 	if len(subs) == 0 {
@@ -825,15 +1800,12 @@ func (s *AlertServiceGorm) broadcastUpdate(alertKey string, update *alertpb.Aler
 
 	log.Printf("Broadcasting update to %d subscribers for alert %s", len(subs), alertKey)
 
-	// Send to all subscribers
 	for _, sub := range subs {
-		go func(sub *Subscription) {
-			if err := sub.Stream.Send(update); err != nil {
-				log.Printf("Failed to send update to subscriber: %v", err)
-				// Remove failed subscription
-				s.removeSubscription(sub)
-			}
-		}(sub)
+		select {
+		case sub.updates <- update:
+		default:
+			log.Printf("Dropping update for alert %s: subscriber %s is not keeping up", alertKey, sub.UserID)
+		}
 	}
 }
 
@@ -938,6 +1910,13 @@ func (s *AlertServiceGorm) SaveUserColorPreferences(ctx context.Context, req *al
 			TextDarknessFactor: float32(pbPref.TextDarknessFactor),
 		}
 
+		if err := sanitizeColorPreference(&modelPref); err != nil {
+			return &alertpb.SaveUserColorPreferencesResponse{
+				Success: false,
+				Message: fmt.Sprintf("Invalid color preference: %v", err),
+			}, nil
+		}
+
 		// Set label conditions
 		if err := modelPref.SetLabelConditions(pbPref.LabelConditions); err != nil {
 			log.Printf("Error setting label conditions: %v", err)
@@ -970,6 +1949,48 @@ func (s *AlertServiceGorm) SaveUserColorPreferences(ctx context.Context, req *al
 	}, nil
 }
 
+// tailwindColorRegex matches Tailwind palette names such as red-500
+var tailwindColorRegex = regexp.MustCompile(`^[a-z]+-[0-9]{2,3}$`)
+
+// sanitizeColorPreference checks a color preference before it is stored. The
+// color ends up in inline styles and class names in the WebUI, so custom colors
+// go through models.SanitizeColor and Tailwind colors must be a palette name.
+// Lightness factors are clamped to 0-1. Priorities below 1 are raised to 1:
+// 0 was the old UI default, and saves and imports resend those rows.
+func sanitizeColorPreference(pref *mainmodels.UserColorPreference) error {
+	if pref.Priority < 1 {
+		pref.Priority = 1
+	}
+
+	switch pref.ColorType {
+	case "", "custom", "severity":
+		if pref.ColorType == "" {
+			pref.ColorType = "custom"
+		}
+		pref.Color = models.SanitizeColor(pref.Color)
+	case "tailwind":
+		if !tailwindColorRegex.MatchString(pref.Color) {
+			return fmt.Errorf("invalid tailwind color %q: must look like red-500", pref.Color)
+		}
+	default:
+		return fmt.Errorf("unknown color type %q", pref.ColorType)
+	}
+
+	pref.BgLightnessFactor = clampFactor(pref.BgLightnessFactor)
+	pref.TextDarknessFactor = clampFactor(pref.TextDarknessFactor)
+	return nil
+}
+
+func clampFactor(factor float32) float32 {
+	switch {
+	case math.IsNaN(float64(factor)) || factor < 0:
+		return 0
+	case factor > 1:
+		return 1
+	}
+	return factor
+}
+
 func (s *AlertServiceGorm) DeleteUserColorPreference(ctx context.Context, req *alertpb.DeleteUserColorPreferenceRequest) (*alertpb.DeleteUserColorPreferenceResponse, error) {
 	if req.SessionId == "" {
 		return &alertpb.DeleteUserColorPreferenceResponse{
@@ -1038,21 +2059,43 @@ func (s *AlertServiceGorm) CreateResolvedAlert(ctx context.Context, req *alertpb
 		}, nil
 	}
 
-	// Default TTL to 24 hours if not specified
+	// A TTL saved from the settings wins over the one the WebUI replica sends,
+	// so every replica applies it as soon as it is saved. Default to 24 hours.
 	ttlHours := int(req.TtlHours)
+	if stored, err := s.db.GetResolvedAlertTTLHours(); err != nil {
+		log.Printf("Error loading the saved resolved alert TTL: %v", err)
+	} else if stored > 0 {
+		ttlHours = stored
+	}
 	if ttlHours <= 0 {
 		ttlHours = 24
 	}
 
-	// Create resolved alert in database
-	resolvedAlert, err := s.db.CreateResolvedAlert(
-		req.Fingerprint,
-		req.Source,
-		req.AlertData,
-		req.Comments,
-		req.Acknowledgments,
-		ttlHours,
-	)
+	// Create resolved alert in database. With a start time, a capture of the
+	// same firing already stored (e.g. by another WebUI replica) is reused.
+	var resolvedAlert *models.ResolvedAlert
+	var err error
+	created := true
+	if req.StartsAt != nil {
+		resolvedAlert, created, err = s.db.CreateResolvedAlertOnce(
+			req.Fingerprint,
+			req.Source,
+			req.AlertData,
+			req.Comments,
+			req.Acknowledgments,
+			ttlHours,
+			req.StartsAt.AsTime(),
+		)
+	} else {
+		resolvedAlert, err = s.db.CreateResolvedAlert(
+			req.Fingerprint,
+			req.Source,
+			req.AlertData,
+			req.Comments,
+			req.Acknowledgments,
+			ttlHours,
+		)
+	}
 	if err != nil {
 		log.Printf("Error creating resolved alert: %v", err)
 		return &alertpb.CreateResolvedAlertResponse{
@@ -1062,17 +2105,15 @@ func (s *AlertServiceGorm) CreateResolvedAlert(ctx context.Context, req *alertpb
 	}
 
 	// Convert to protobuf message
-	pbResolvedAlert := &alertpb.ResolvedAlertInfo{
-		Id:              resolvedAlert.ID,
-		Fingerprint:     resolvedAlert.Fingerprint,
-		AlertData:       []byte(resolvedAlert.AlertData),
-		Comments:        []byte(resolvedAlert.Comments),
-		Acknowledgments: []byte(resolvedAlert.Acknowledgments),
-		ResolvedAt:      timestamppb.New(resolvedAlert.ResolvedAt),
-		ExpiresAt:       timestamppb.New(resolvedAlert.ExpiresAt),
-		Source:          resolvedAlert.Source,
-		CreatedAt:       timestamppb.New(resolvedAlert.CreatedAt),
-		UpdatedAt:       timestamppb.New(resolvedAlert.UpdatedAt),
+	pbResolvedAlert := resolvedAlertToProto(resolvedAlert)
+
+	if !created {
+		return &alertpb.CreateResolvedAlertResponse{
+			Success:         true,
+			ResolvedAlert:   pbResolvedAlert,
+			Message:         "Resolved alert already captured",
+			AlreadyCaptured: true,
+		}, nil
 	}
 
 	// Broadcast resolved alert update to subscribers
@@ -1100,37 +2141,47 @@ func (s *AlertServiceGorm) GetResolvedAlerts(ctx context.Context, req *alertpb.G
 		limit = 100
 	}
 
-	resolvedAlerts, err := s.db.GetResolvedAlerts(limit, offset)
-	if err != nil {
-		log.Printf("Error fetching resolved alerts: %v", err)
+	filter := database.ResolvedAlertFilter{
+		Source:            req.Source,
+		FingerprintPrefix: req.FingerprintPrefix,
+	}
+	if req.ResolvedAfter != nil {
+		filter.ResolvedAfter = req.ResolvedAfter.AsTime()
+	}
+	if req.ResolvedBefore != nil {
+		filter.ResolvedBefore = req.ResolvedBefore.AsTime()
+	}
+	switch req.SortOrder {
+	case "", "desc":
+	case "asc":
+		filter.Ascending = true
+	default:
 		return &alertpb.GetResolvedAlertsResponse{
 			Success: false,
-			Message: "Failed to fetch resolved alerts",
+			Message: "Sort order must be \"asc\" or \"desc\"",
+		}, nil
+	}
+	if !filter.ResolvedAfter.IsZero() && !filter.ResolvedBefore.IsZero() && !filter.ResolvedAfter.Before(filter.ResolvedBefore) {
+		return &alertpb.GetResolvedAlertsResponse{
+			Success: false,
+			Message: "resolved_after must be before resolved_before",
 		}, nil
 	}
 
-	// Get total count
-	totalCount, err := s.db.GetResolvedAlertsCount()
+	// The total counts every match of the filter, not just this page
+	resolvedAlerts, totalCount, err := s.db.SearchResolvedAlerts(filter, limit, offset)
 	if err != nil {
-		log.Printf("Error getting resolved alerts count: %v", err)
-		totalCount = int64(len(resolvedAlerts))
+		log.Printf("Error fetching resolved alerts: %v", err)
+		return &alertpb.GetResolvedAlertsResponse{
+			Success: false,
+			Message: "Failed to fetch resolved alerts",
+		}, nil
 	}
 
 	// Convert to protobuf messages
 	pbResolvedAlerts := make([]*alertpb.ResolvedAlertInfo, len(resolvedAlerts))
-	for i, resolvedAlert := range resolvedAlerts {
-		pbResolvedAlerts[i] = &alertpb.ResolvedAlertInfo{
-			Id:              resolvedAlert.ID,
-			Fingerprint:     resolvedAlert.Fingerprint,
-			AlertData:       []byte(resolvedAlert.AlertData),
-			Comments:        []byte(resolvedAlert.Comments),
-			Acknowledgments: []byte(resolvedAlert.Acknowledgments),
-			ResolvedAt:      timestamppb.New(resolvedAlert.ResolvedAt),
-			ExpiresAt:       timestamppb.New(resolvedAlert.ExpiresAt),
-			Source:          resolvedAlert.Source,
-			CreatedAt:       timestamppb.New(resolvedAlert.CreatedAt),
-			UpdatedAt:       timestamppb.New(resolvedAlert.UpdatedAt),
-		}
+	for i := range resolvedAlerts {
+		pbResolvedAlerts[i] = resolvedAlertToProto(&resolvedAlerts[i])
 	}
 
 	return &alertpb.GetResolvedAlertsResponse{
@@ -1161,7 +2212,7 @@ func (s *AlertServiceGorm) RemoveAllResolvedAlerts(ctx context.Context, req *ale
 
 	log.Printf("RemoveAllResolvedAlerts: User %s (ID: %s) requested removal of all resolved alerts", user.Username, user.ID)
 
-	removedCount, err := s.db.RemoveAllResolvedAlerts()
+	removedCount, clearedAt, err := s.db.RemoveAllResolvedAlerts()
 	if err != nil {
 		log.Printf("Error removing all resolved alerts: %v", err)
 		return &alertpb.RemoveAllResolvedAlertsResponse{
@@ -1176,27 +2227,265 @@ func (s *AlertServiceGorm) RemoveAllResolvedAlerts(ctx context.Context, req *ale
 		Success:      true,
 		RemovedCount: int32(removedCount),
 		Message:      fmt.Sprintf("Successfully removed %d resolved alerts", removedCount),
+		ClearedAt:    timestamppb.New(clearedAt),
+		UndoUntil:    timestamppb.New(clearedAt.Add(database.ClearUndoWindow)),
+	}, nil
+}
+
+// GetResolvedAlertSettings implements the GetResolvedAlertSettings RPC method
+func (s *AlertServiceGorm) GetResolvedAlertSettings(ctx context.Context, req *alertpb.GetResolvedAlertSettingsRequest) (*alertpb.GetResolvedAlertSettingsResponse, error) {
+	ttlHours, err := s.db.GetResolvedAlertTTLHours()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get resolved alert settings: %w", err)
+	}
+	return &alertpb.GetResolvedAlertSettingsResponse{
+		TtlHours: int32(ttlHours),
+	}, nil
+}
+
+// SaveResolvedAlertSettings implements the SaveResolvedAlertSettings RPC method
+func (s *AlertServiceGorm) SaveResolvedAlertSettings(ctx context.Context, req *alertpb.SaveResolvedAlertSettingsRequest) (*alertpb.SaveResolvedAlertSettingsResponse, error) {
+	if req.SessionId == "" {
+		return &alertpb.SaveResolvedAlertSettingsResponse{
+			Success: false,
+			Message: "Session ID is required",
+		}, nil
+	}
+
+	if req.TtlHours < 1 || req.TtlHours > models.MaxResolvedAlertTTLHours {
+		return &alertpb.SaveResolvedAlertSettingsResponse{
+			Success: false,
+			Message: fmt.Sprintf("TTL must be between 1 and %d hours", models.MaxResolvedAlertTTLHours),
+		}, nil
+	}
+
+	user, err := s.db.GetUserBySession(req.SessionId)
+	if err != nil {
+		return &alertpb.SaveResolvedAlertSettingsResponse{
+			Success: false,
+			Message: "Invalid session",
+		}, nil
+	}
+	if !isAdminUser(s.admin, user) {
+		return &alertpb.SaveResolvedAlertSettingsResponse{
+			Success: false,
+			Message: "Admin access required",
+		}, nil
+	}
+
+	if err := s.db.SetResolvedAlertTTLHours(int(req.TtlHours), user.ID); err != nil {
+		log.Printf("Error saving resolved alert TTL: %v", err)
+		return &alertpb.SaveResolvedAlertSettingsResponse{
+			Success: false,
+			Message: "Failed to save resolved alert settings",
+		}, nil
+	}
+
+	log.Printf("SaveResolvedAlertSettings: User %s (ID: %s) set the resolved alert TTL to %dh", user.Username, user.ID, req.TtlHours)
+
+	return &alertpb.SaveResolvedAlertSettingsResponse{
+		Success: true,
+		Message: "Resolved alert settings saved",
+	}, nil
+}
+
+// UndoRemoveAllResolvedAlerts implements the UndoRemoveAllResolvedAlerts RPC method
+func (s *AlertServiceGorm) UndoRemoveAllResolvedAlerts(ctx context.Context, req *alertpb.UndoClearRequest) (*alertpb.UndoClearResponse, error) {
+	if req.SessionId == "" {
+		return &alertpb.UndoClearResponse{
+			Success: false,
+			Message: "Session ID is required",
+		}, nil
+	}
+	if req.ClearedAt == nil {
+		return &alertpb.UndoClearResponse{
+			Success: false,
+			Message: "Cleared at is required",
+		}, nil
+	}
+
+	user, err := s.db.GetUserBySession(req.SessionId)
+	if err != nil {
+		return &alertpb.UndoClearResponse{
+			Success: false,
+			Message: "Invalid session",
+		}, nil
+	}
+
+	restoredCount, err := s.db.UndoRemoveAllResolvedAlerts(req.ClearedAt.AsTime())
+	if err != nil {
+		return undoClearFailure("resolved alerts", err), nil
+	}
+
+	log.Printf("UndoRemoveAllResolvedAlerts: User %s (ID: %s) restored %d resolved alerts", user.Username, user.ID, restoredCount)
+
+	return &alertpb.UndoClearResponse{
+		Success:       true,
+		RestoredCount: int32(restoredCount),
+		Message:       fmt.Sprintf("Restored %d resolved alerts", restoredCount),
 	}, nil
 }
 
+// undoClearFailure is the response to an undo that could not be applied
+func undoClearFailure(what string, err error) *alertpb.UndoClearResponse {
+	if errors.Is(err, database.ErrUndoExpired) {
+		return &alertpb.UndoClearResponse{
+			Success: false,
+			Message: "The removal can no longer be undone",
+		}
+	}
+	log.Printf("Error restoring cleared %s: %v", what, err)
+	return &alertpb.UndoClearResponse{
+		Success: false,
+		Message: fmt.Sprintf("Failed to restore %s", what),
+	}
+}
+
 // GetResolvedAlert implements the GetResolvedAlert RPC method
 func (s *AlertServiceGorm) GetResolvedAlert(ctx context.Context, req *alertpb.GetResolvedAlertRequest) (*alertpb.GetResolvedAlertResponse, error) {
 	if req.Fingerprint == "" {
 		return &alertpb.GetResolvedAlertResponse{
 			Success: false,
-			Message: "Fingerprint is required",
+			Message: "Fingerprint is required",
+		}, nil
+	}
+
+	resolvedAlert, err := s.db.GetResolvedAlert(req.Fingerprint)
+	if err != nil {
+		return &alertpb.GetResolvedAlertResponse{
+			Success: false,
+			Message: "Resolved alert not found",
+		}, nil
+	}
+
+	pbResolvedAlert := resolvedAlertToProto(resolvedAlert)
+
+	return &alertpb.GetResolvedAlertResponse{
+		Success:       true,
+		ResolvedAlert: pbResolvedAlert,
+		Message:       "Resolved alert found",
+	}, nil
+}
+
+// RestoreResolvedAlert implements the RestoreResolvedAlert RPC method
+func (s *AlertServiceGorm) RestoreResolvedAlert(ctx context.Context, req *alertpb.RestoreResolvedAlertRequest) (*alertpb.RestoreResolvedAlertResponse, error) {
+	if req.Fingerprint == "" {
+		return &alertpb.RestoreResolvedAlertResponse{
+			Success: false,
+			Message: "Fingerprint is required",
+		}, nil
+	}
+
+	user, err := s.db.GetUserBySession(req.SessionId)
+	if err != nil {
+		return &alertpb.RestoreResolvedAlertResponse{
+			Success: false,
+			Message: "Invalid session",
+		}, nil
+	}
+
+	resolvedAlert, err := s.db.GetResolvedAlert(req.Fingerprint)
+	if err != nil {
+		return &alertpb.RestoreResolvedAlertResponse{
+			Success: false,
+			Message: "Resolved alert not found",
+		}, nil
+	}
+	if resolvedAlert.RestoredAt != nil {
+		return &alertpb.RestoreResolvedAlertResponse{
+			Success: false,
+			Message: "Previous discussion was already restored",
+		}, nil
+	}
+
+	// The stored JSON holds the proto messages as the WebUI fetched them
+	var storedComments []*alertpb.Comment
+	var storedAcks []*alertpb.Acknowledgment
+	if len(resolvedAlert.Comments) > 0 {
+		if err := json.Unmarshal(resolvedAlert.Comments, &storedComments); err != nil {
+			log.Printf("Error decoding stored comments for %s: %v", req.Fingerprint, err)
+			return &alertpb.RestoreResolvedAlertResponse{
+				Success: false,
+				Message: "Stored comments could not be read",
+			}, nil
+		}
+	}
+	if len(resolvedAlert.Acknowledgments) > 0 {
+		if err := json.Unmarshal(resolvedAlert.Acknowledgments, &storedAcks); err != nil {
+			log.Printf("Error decoding stored acknowledgments for %s: %v", req.Fingerprint, err)
+			return &alertpb.RestoreResolvedAlertResponse{
+				Success: false,
+				Message: "Stored acknowledgments could not be read",
+			}, nil
+		}
+	}
+
+	comments := make([]models.Comment, 0, len(storedComments))
+	for _, c := range storedComments {
+		comments = append(comments, models.Comment{
+			ID:        c.Id,
+			AlertKey:  req.Fingerprint,
+			UserID:    c.UserId,
+			Content:   c.Content,
+			CreatedAt: c.CreatedAt.AsTime(),
+		})
+	}
+	acks := make([]models.Acknowledgment, 0, len(storedAcks))
+	for _, a := range storedAcks {
+		acks = append(acks, models.Acknowledgment{
+			ID:        a.Id,
+			AlertKey:  req.Fingerprint,
+			UserID:    a.UserId,
+			Reason:    a.Reason,
+			CreatedAt: a.CreatedAt.AsTime(),
+		})
+	}
+
+	restoredComments, restoredAcks, err := s.db.RestoreResolvedAlert(resolvedAlert.ID, comments, acks)
+	if errors.Is(err, database.ErrAlreadyRestored) {
+		return &alertpb.RestoreResolvedAlertResponse{
+			Success: false,
+			Message: "Previous discussion was already restored",
 		}, nil
 	}
-
-	resolvedAlert, err := s.db.GetResolvedAlert(req.Fingerprint)
 	if err != nil {
-		return &alertpb.GetResolvedAlertResponse{
+		log.Printf("Error restoring resolved alert %s: %v", req.Fingerprint, err)
+		return &alertpb.RestoreResolvedAlertResponse{
 			Success: false,
-			Message: "Resolved alert not found",
+			Message: "Failed to restore previous discussion",
 		}, nil
 	}
 
-	pbResolvedAlert := &alertpb.ResolvedAlertInfo{
+	log.Printf("User %s restored %d comments and %d acknowledgments for alert %s",
+		user.Username, restoredComments, restoredAcks, req.Fingerprint)
+
+	// One update per kind is enough for open alert views to reload
+	if restoredComments > 0 {
+		s.broadcastUpdate(req.Fingerprint, &alertpb.AlertUpdate{
+			AlertKey:   req.Fingerprint,
+			UpdateType: alertpb.UpdateType_COMMENT_ADDED,
+			Timestamp:  timestamppb.Now(),
+		})
+	}
+	if restoredAcks > 0 {
+		s.broadcastUpdate(req.Fingerprint, &alertpb.AlertUpdate{
+			AlertKey:   req.Fingerprint,
+			UpdateType: alertpb.UpdateType_ACKNOWLEDGMENT_ADDED,
+			Timestamp:  timestamppb.Now(),
+		})
+	}
+
+	return &alertpb.RestoreResolvedAlertResponse{
+		Success:                 true,
+		Message:                 fmt.Sprintf("Restored %d comments and %d acknowledgments", restoredComments, restoredAcks),
+		RestoredComments:        int32(restoredComments),
+		RestoredAcknowledgments: int32(restoredAcks),
+	}, nil
+}
+
+// resolvedAlertToProto converts a stored resolved alert to its proto form
+func resolvedAlertToProto(resolvedAlert *models.ResolvedAlert) *alertpb.ResolvedAlertInfo {
+	info := &alertpb.ResolvedAlertInfo{
 		Id:              resolvedAlert.ID,
 		Fingerprint:     resolvedAlert.Fingerprint,
 		AlertData:       []byte(resolvedAlert.AlertData),
@@ -1208,12 +2497,10 @@ func (s *AlertServiceGorm) GetResolvedAlert(ctx context.Context, req *alertpb.Ge
 		CreatedAt:       timestamppb.New(resolvedAlert.CreatedAt),
 		UpdatedAt:       timestamppb.New(resolvedAlert.UpdatedAt),
 	}
-
-	return &alertpb.GetResolvedAlertResponse{
-		Success:       true,
-		ResolvedAlert: pbResolvedAlert,
-		Message:       "Resolved alert found",
-	}, nil
+	if resolvedAlert.RestoredAt != nil {
+		info.RestoredAt = timestamppb.New(*resolvedAlert.RestoredAt)
+	}
+	return info
 }
 
 // StreamResolvedAlertUpdates implements the StreamResolvedAlertUpdates RPC method
@@ -1233,23 +2520,45 @@ func (s *AlertServiceGorm) StreamResolvedAlertUpdates(req *alertpb.StreamResolve
 		SessionID: req.SessionId,
 		Stream:    stream,
 		Done:      make(chan bool),
+		updates:   make(chan *alertpb.ResolvedAlertUpdate, subscriptionBufferSize),
 	}
 
 	// Add subscription
 	s.addResolvedAlertSubscription(sub)
 	defer s.removeResolvedAlertSubscription(sub)
 
-	// Wait for stream to close
-	<-sub.Done
-
-	return nil
+	// Only this goroutine sends on the stream, in the order updates were
+	// queued, until the client goes away or the subscription is removed
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case <-sub.Done:
+			return nil
+		case update := <-sub.updates:
+			if err := stream.Send(update); err != nil {
+				log.Printf("Error sending resolved alert update to subscriber %s: %v", sub.SessionID, err)
+				return err
+			}
+		}
+	}
 }
 
 // Helper methods for resolved alert subscriptions
 type ResolvedAlertSubscription struct {
 	SessionID string
 	Stream    grpc.ServerStreamingServer[alertpb.ResolvedAlertUpdate]
-	Done      chan bool
+	Done      chan bool // Closed once, by stop
+
+	// gRPC streams do not allow concurrent sends, so broadcasts are queued
+	// here and sent by StreamResolvedAlertUpdates
+	updates  chan *alertpb.ResolvedAlertUpdate
+	stopOnce sync.Once
+}
+
+// stop ends the subscription's stream; it is safe to call more than once
+func (sub *ResolvedAlertSubscription) stop() {
+	sub.stopOnce.Do(func() { close(sub.Done) })
 }
 
 // Add resolved alert subscription tracking
@@ -1276,27 +2585,24 @@ func (s *AlertServiceGorm) removeResolvedAlertSubscription(sub *ResolvedAlertSub
 			break
 		}
 	}
+	sub.stop()
 }
 
+// broadcastResolvedAlertUpdate queues an update for every resolved alert
+// subscriber. A subscriber whose queue is full misses the update rather than
+// blocking the caller.
 func (s *AlertServiceGorm) broadcastResolvedAlertUpdate(fingerprint string, update *alertpb.ResolvedAlertUpdate) {
+	// Copy under the lock: removeResolvedAlertSubscription edits the slice in place
 	resolvedAlertSubscriptionsMutex.RLock()
-	defer resolvedAlertSubscriptionsMutex.RUnlock()
+	subs := append([]*ResolvedAlertSubscription(nil), resolvedAlertSubscriptions["global"]...)
+	resolvedAlertSubscriptionsMutex.RUnlock()
 
-	subs := resolvedAlertSubscriptions["global"]
 	for _, sub := range subs {
-		go func(s *ResolvedAlertSubscription) {
-			defer func() {
-				if r := recover(); r != nil {
-					log.Printf("Recovered from panic in resolved alert broadcast: %v", r)
-					close(s.Done)
-				}
-			}()
-
-			if err := s.Stream.Send(update); err != nil {
-				log.Printf("Error sending resolved alert update to subscriber %s: %v", s.SessionID, err)
-				close(s.Done)
-			}
-		}(sub)
+		select {
+		case sub.updates <- update:
+		default:
+			log.Printf("Dropping resolved alert update for %s: subscriber %s is not keeping up", fingerprint, sub.SessionID)
+		}
 	}
 }
 
@@ -1509,6 +2815,7 @@ func (s *AuthServiceGorm) OAuthCallback(ctx context.Context, req *authpb.OAuthCa
 	}
 
 	// Create or update OAuth user
+	bootstrap := s.bootstrappingAdmin()
 	user, err := s.oauthService.CreateOrUpdateOAuthUser(req.Provider, userInfo)
 	if err != nil {
 		log.Printf("Failed to create/update OAuth user: %v", err)
@@ -1519,6 +2826,16 @@ func (s *AuthServiceGorm) OAuthCallback(ctx context.Context, req *authpb.OAuthCa
 		}, nil
 	}
 
+	if user.Disabled {
+		return &authpb.LoginResponse{
+			Success: false,
+			Message: "Account is disabled",
+		}, nil
+	}
+	if bootstrap {
+		s.promoteBootstrapAdmin(user)
+	}
+
 	// Generate session ID
 	sessionID, err := generateSessionID()
 	if err != nil {
@@ -1530,7 +2847,7 @@ func (s *AuthServiceGorm) OAuthCallback(ctx context.Context, req *authpb.OAuthCa
 	}
 
 	// Create session
-	expiresAt := time.Now().Add(7 * 24 * time.Hour)
+	expiresAt := time.Now().Add(s.sessionDuration)
 	if err := s.db.CreateSession(user.ID, sessionID, expiresAt); err != nil {
 		log.Printf("Error creating session for OAuth user: %v", err)
 		return &authpb.LoginResponse{
@@ -1649,50 +2966,23 @@ func (s *AuthServiceGorm) SyncUserGroups(ctx context.Context, req *authpb.SyncUs
 		}, nil
 	}
 
-	// Get the user's OAuth token for group sync
-	oauthToken, err := s.db.GetOAuthToken(req.UserId, req.Provider)
-	if err != nil {
-		log.Printf("Failed to get OAuth token for user %s: %v", req.UserId, err)
-		return &authpb.SyncUserGroupsResponse{
-			Success: false,
-			Error:   "OAuth token not found or expired",
-		}, nil
-	}
-
-	// Convert stored token to oauth2.Token format
-	token := &oauth2.Token{
-		AccessToken:  oauthToken.AccessToken,
-		RefreshToken: oauthToken.RefreshToken,
-		TokenType:    oauthToken.TokenType,
-		Expiry:       *oauthToken.ExpiresAt,
-	}
-
-	// Get user info with groups from OAuth provider
-	userInfo, err := s.oauthService.GetUserInfo(req.Provider, token)
+	groupsSynced, err := s.oauthService.SyncUserGroups(req.UserId, req.Provider)
 	if err != nil {
-		log.Printf("Failed to get user info for group sync: %v", err)
+		log.Printf("Failed to sync groups for user %s: %v", req.UserId, err)
+		message := "Failed to sync groups from provider"
+		if errors.Is(err, ErrOAuthTokenUnavailable) {
+			message = "OAuth token not found or expired"
+		}
 		return &authpb.SyncUserGroupsResponse{
 			Success: false,
-			Error:   "Failed to retrieve user information from provider",
+			Error:   message,
 		}, nil
 	}
 
-	// Sync groups to database
-	if len(userInfo.Groups) > 0 {
-		err = s.db.SyncUserGroups(req.UserId, req.Provider, userInfo.Groups)
-		if err != nil {
-			log.Printf("Failed to sync user groups: %v", err)
-			return &authpb.SyncUserGroupsResponse{
-				Success: false,
-				Error:   "Failed to update user groups",
-			}, nil
-		}
-	}
-
-	log.Printf("Successfully synced %d groups for user %s from provider %s", len(userInfo.Groups), req.UserId, req.Provider)
+	log.Printf("Successfully synced %d groups for user %s from provider %s", groupsSynced, req.UserId, req.Provider)
 	return &authpb.SyncUserGroupsResponse{
 		Success:      true,
-		GroupsSynced: int32(len(userInfo.Groups)),
+		GroupsSynced: int32(groupsSynced),
 	}, nil
 }
 
@@ -1732,17 +3022,8 @@ func (s *AlertServiceGorm) GetUserHiddenAlerts(ctx context.Context, req *alertpb
 
 	// Convert to protobuf format
 	var pbHiddenAlerts []*alertpb.UserHiddenAlert
-	for _, hiddenAlert := range hiddenAlerts {
-		pbHiddenAlerts = append(pbHiddenAlerts, &alertpb.UserHiddenAlert{
-			Id:          hiddenAlert.ID,
-			UserId:      hiddenAlert.UserID,
-			Fingerprint: hiddenAlert.Fingerprint,
-			AlertName:   hiddenAlert.AlertName,
-			Instance:    hiddenAlert.Instance,
-			Reason:      hiddenAlert.Reason,
-			CreatedAt:   timestamppb.New(hiddenAlert.CreatedAt),
-			UpdatedAt:   timestamppb.New(hiddenAlert.UpdatedAt),
-		})
+	for i := range hiddenAlerts {
+		pbHiddenAlerts = append(pbHiddenAlerts, hiddenAlertToProto(&hiddenAlerts[i]))
 	}
 
 	return &alertpb.GetUserHiddenAlertsResponse{
@@ -1777,8 +3058,24 @@ func (s *AlertServiceGorm) HideAlert(ctx context.Context, req *alertpb.HideAlert
 		}, nil
 	}
 
-	// Create hidden alert in database
-	hiddenAlert, err := s.db.CreateUserHiddenAlert(user.ID, req.Fingerprint, req.AlertName, req.Instance, req.Reason)
+	// A snooze hides the alert until snooze_until; without one it stays hidden
+	var hiddenAlert *models.UserHiddenAlert
+	activityType := models.ActivityHide
+	message := "Alert hidden successfully"
+	if req.SnoozeUntil != nil {
+		until := req.SnoozeUntil.AsTime()
+		if !until.After(time.Now()) {
+			return &alertpb.HideAlertResponse{
+				Success: false,
+				Message: "Snooze end must be in the future",
+			}, nil
+		}
+		hiddenAlert, err = s.db.SnoozeAlert(user.ID, req.Fingerprint, req.AlertName, req.Instance, req.Reason, until)
+		activityType = models.ActivitySnooze
+		message = "Alert snoozed successfully"
+	} else {
+		hiddenAlert, err = s.db.CreateUserHiddenAlert(user.ID, req.Fingerprint, req.AlertName, req.Instance, req.Reason)
+	}
 	if err != nil {
 		log.Printf("Error creating hidden alert for user %s: %v", user.ID, err)
 		return &alertpb.HideAlertResponse{
@@ -1787,6 +3084,24 @@ func (s *AlertServiceGorm) HideAlert(ctx context.Context, req *alertpb.HideAlert
 		}, nil
 	}
 
+	s.recordActivity(&models.AlertActivity{
+		AlertKey:  hiddenAlert.Fingerprint,
+		Type:      activityType,
+		UserID:    hiddenAlert.UserID,
+		RefID:     hiddenAlert.ID,
+		Content:   hiddenAlert.Reason,
+		CreatedAt: hiddenAlert.UpdatedAt,
+	})
+
+	return &alertpb.HideAlertResponse{
+		Success:     true,
+		HiddenAlert: hiddenAlertToProto(hiddenAlert),
+		Message:     message,
+	}, nil
+}
+
+// hiddenAlertToProto converts a hidden or snoozed alert to its protobuf form
+func hiddenAlertToProto(hiddenAlert *models.UserHiddenAlert) *alertpb.UserHiddenAlert {
 	pbHiddenAlert := &alertpb.UserHiddenAlert{
 		Id:          hiddenAlert.ID,
 		UserId:      hiddenAlert.UserID,
@@ -1797,12 +3112,10 @@ func (s *AlertServiceGorm) HideAlert(ctx context.Context, req *alertpb.HideAlert
 		CreatedAt:   timestamppb.New(hiddenAlert.CreatedAt),
 		UpdatedAt:   timestamppb.New(hiddenAlert.UpdatedAt),
 	}
-
-	return &alertpb.HideAlertResponse{
-		Success:     true,
-		HiddenAlert: pbHiddenAlert,
-		Message:     "Alert hidden successfully",
-	}, nil
+	if hiddenAlert.ExpiresAt != nil {
+		pbHiddenAlert.ExpiresAt = timestamppb.New(*hiddenAlert.ExpiresAt)
+	}
+	return pbHiddenAlert
 }
 
 // UnhideAlert implements the UnhideAlert RPC method
@@ -1814,7 +3127,17 @@ func (s *AlertServiceGorm) UnhideAlert(ctx context.Context, req *alertpb.UnhideA
 		}, nil
 	}
 
-	if req.Fingerprint == "" {
+	// Fingerprint and Fingerprints are combined so a batch is one delete
+	var fingerprints []string
+	if req.Fingerprint != "" {
+		fingerprints = append(fingerprints, req.Fingerprint)
+	}
+	for _, fingerprint := range req.Fingerprints {
+		if fingerprint != "" {
+			fingerprints = append(fingerprints, fingerprint)
+		}
+	}
+	if len(fingerprints) == 0 {
 		return &alertpb.UnhideAlertResponse{
 			Success: false,
 			Message: "Fingerprint is required",
@@ -1830,10 +3153,10 @@ func (s *AlertServiceGorm) UnhideAlert(ctx context.Context, req *alertpb.UnhideA
 		}, nil
 	}
 
-	// Delete hidden alert from database
-	err = s.db.RemoveUserHiddenAlert(user.ID, req.Fingerprint)
+	// Delete hidden alerts from database
+	unhidden, err := s.db.RemoveHiddenAlerts(user.ID, fingerprints)
 	if err != nil {
-		log.Printf("Error removing hidden alert for user %s: %v", user.ID, err)
+		log.Printf("Error removing hidden alerts for user %s: %v", user.ID, err)
 		return &alertpb.UnhideAlertResponse{
 			Success: false,
 			Message: "Failed to unhide alert",
@@ -1841,8 +3164,9 @@ func (s *AlertServiceGorm) UnhideAlert(ctx context.Context, req *alertpb.UnhideA
 	}
 
 	return &alertpb.UnhideAlertResponse{
-		Success: true,
-		Message: "Alert unhidden successfully",
+		Success:       true,
+		Message:       fmt.Sprintf("Unhid %d alerts", unhidden),
+		UnhiddenCount: int32(unhidden),
 	}, nil
 }
 
@@ -1864,10 +3188,16 @@ func (s *AlertServiceGorm) ClearAllHiddenAlerts(ctx context.Context, req *alertp
 		}, nil
 	}
 
+	// Use impersonated user ID if provided, otherwise use session user
+	targetUserID := user.ID
+	if req.ImpersonateUserId != "" {
+		targetUserID = req.ImpersonateUserId
+	}
+
 	// Clear all hidden alerts for user
-	clearedCount, err := s.db.ClearUserHiddenAlerts(user.ID)
+	clearedCount, clearedAt, err := s.db.ClearUserHiddenAlerts(targetUserID)
 	if err != nil {
-		log.Printf("Error clearing hidden alerts for user %s: %v", user.ID, err)
+		log.Printf("Error clearing hidden alerts for user %s: %v", targetUserID, err)
 		return &alertpb.ClearAllHiddenAlertsResponse{
 			Success: false,
 			Message: "Failed to clear hidden alerts",
@@ -1878,6 +3208,50 @@ func (s *AlertServiceGorm) ClearAllHiddenAlerts(ctx context.Context, req *alertp
 		Success:      true,
 		ClearedCount: int32(clearedCount),
 		Message:      fmt.Sprintf("Cleared %d hidden alerts", clearedCount),
+		ClearedAt:    timestamppb.New(clearedAt),
+		UndoUntil:    timestamppb.New(clearedAt.Add(database.ClearUndoWindow)),
+	}, nil
+}
+
+// UndoClearAllHiddenAlerts implements the UndoClearAllHiddenAlerts RPC method
+func (s *AlertServiceGorm) UndoClearAllHiddenAlerts(ctx context.Context, req *alertpb.UndoClearRequest) (*alertpb.UndoClearResponse, error) {
+	if req.SessionId == "" {
+		return &alertpb.UndoClearResponse{
+			Success: false,
+			Message: "Session ID is required",
+		}, nil
+	}
+	if req.ClearedAt == nil {
+		return &alertpb.UndoClearResponse{
+			Success: false,
+			Message: "Cleared at is required",
+		}, nil
+	}
+
+	// Validate session and get user
+	user, err := s.db.GetUserBySession(req.SessionId)
+	if err != nil {
+		return &alertpb.UndoClearResponse{
+			Success: false,
+			Message: "Invalid session",
+		}, nil
+	}
+
+	// Use impersonated user ID if provided, otherwise use session user
+	targetUserID := user.ID
+	if req.ImpersonateUserId != "" {
+		targetUserID = req.ImpersonateUserId
+	}
+
+	restoredCount, err := s.db.UndoClearUserHiddenAlerts(targetUserID, req.ClearedAt.AsTime())
+	if err != nil {
+		return undoClearFailure("hidden alerts", err), nil
+	}
+
+	return &alertpb.UndoClearResponse{
+		Success:       true,
+		RestoredCount: int32(restoredCount),
+		Message:       fmt.Sprintf("Restored %d hidden alerts", restoredCount),
 	}, nil
 }
 
@@ -2283,6 +3657,7 @@ func (s *AlertServiceGorm) GetNotificationPreferences(ctx context.Context, req *
 		SoundNotificationsEnabled:   prefs.SoundNotificationsEnabled,
 		CreatedAt:                   timestamppb.New(prefs.CreatedAt),
 		UpdatedAt:                   timestamppb.New(prefs.UpdatedAt),
+		Dnd:                         dndScheduleToProto(prefs),
 	}
 
 	return &alertpb.GetNotificationPreferencesResponse{
@@ -2327,6 +3702,19 @@ func (s *AlertServiceGorm) SaveNotificationPreferences(ctx context.Context, req
 		SoundNotificationsEnabled:   req.SoundNotificationsEnabled,
 	}
 
+	// Clients that don't send a schedule keep the stored one
+	if req.Dnd != nil {
+		applyDNDSchedule(pref, req.Dnd)
+	} else if current, err := s.db.GetUserNotificationPreference(user.ID); err == nil {
+		copyDNDSchedule(pref, current)
+	}
+	if err := pref.ValidateDND(); err != nil {
+		return &alertpb.SaveNotificationPreferencesResponse{
+			Success: false,
+			Message: fmt.Sprintf("Invalid do-not-disturb schedule: %v", err),
+		}, nil
+	}
+
 	// Save to database
 	err = s.db.SaveUserNotificationPreference(pref)
 	if err != nil {
@@ -2350,6 +3738,7 @@ func (s *AlertServiceGorm) SaveNotificationPreferences(ctx context.Context, req
 				BrowserNotificationsEnabled: req.BrowserNotificationsEnabled,
 				EnabledSeverities:           validSeverities,
 				SoundNotificationsEnabled:   req.SoundNotificationsEnabled,
+				Dnd:                         dndScheduleToProto(pref),
 			},
 			Message: "Notification preferences saved successfully",
 		}, nil
@@ -2364,6 +3753,7 @@ func (s *AlertServiceGorm) SaveNotificationPreferences(ctx context.Context, req
 		SoundNotificationsEnabled:   savedPrefs.SoundNotificationsEnabled,
 		CreatedAt:                   timestamppb.New(savedPrefs.CreatedAt),
 		UpdatedAt:                   timestamppb.New(savedPrefs.UpdatedAt),
+		Dnd:                         dndScheduleToProto(savedPrefs),
 	}
 
 	return &alertpb.SaveNotificationPreferencesResponse{
@@ -2373,6 +3763,46 @@ func (s *AlertServiceGorm) SaveNotificationPreferences(ctx context.Context, req
 	}, nil
 }
 
+// dndScheduleToProto converts a preference's do-not-disturb schedule
+func dndScheduleToProto(pref *models.NotificationPreference) *alertpb.DoNotDisturbSchedule {
+	days := make([]int32, len(pref.DNDDays))
+	for i, day := range pref.DNDDays {
+		days[i] = int32(day)
+	}
+	return &alertpb.DoNotDisturbSchedule{
+		Enabled:       pref.DNDEnabled,
+		Days:          days,
+		StartTime:     pref.DNDStartTime,
+		EndTime:       pref.DNDEndTime,
+		Timezone:      pref.DNDTimezone,
+		AllowCritical: pref.DNDAllowCritical,
+	}
+}
+
+// applyDNDSchedule sets a preference's do-not-disturb schedule from the RPC
+func applyDNDSchedule(pref *models.NotificationPreference, dnd *alertpb.DoNotDisturbSchedule) {
+	days := make(models.WeekdayList, len(dnd.Days))
+	for i, day := range dnd.Days {
+		days[i] = int(day)
+	}
+	pref.DNDEnabled = dnd.Enabled
+	pref.DNDDays = days
+	pref.DNDStartTime = strings.TrimSpace(dnd.StartTime)
+	pref.DNDEndTime = strings.TrimSpace(dnd.EndTime)
+	pref.DNDTimezone = strings.TrimSpace(dnd.Timezone)
+	pref.DNDAllowCritical = dnd.AllowCritical
+}
+
+// copyDNDSchedule copies the do-not-disturb schedule from one preference to another
+func copyDNDSchedule(dst, src *models.NotificationPreference) {
+	dst.DNDEnabled = src.DNDEnabled
+	dst.DNDDays = src.DNDDays
+	dst.DNDStartTime = src.DNDStartTime
+	dst.DNDEndTime = src.DNDEndTime
+	dst.DNDTimezone = src.DNDTimezone
+	dst.DNDAllowCritical = src.DNDAllowCritical
+}
+
 // GetFilterPresets implements the GetFilterPresets RPC method
 func (s *AlertServiceGorm) GetFilterPresets(ctx context.Context, req *alertpb.GetFilterPresetsRequest) (*alertpb.GetFilterPresetsResponse, error) {
 	if req.SessionId == "" {
@@ -3140,6 +4570,265 @@ func (s *AlertServiceGorm) SaveUserColumnPreferences(ctx context.Context, req *a
 	}, nil
 }
 
+// ExportPreferences implements the ExportPreferences RPC method. It returns
+// the session user's dashboard settings as a JSON bundle.
+func (s *AlertServiceGorm) ExportPreferences(ctx context.Context, req *alertpb.ExportPreferencesRequest) (*alertpb.ExportPreferencesResponse, error) {
+	if req.SessionId == "" {
+		return &alertpb.ExportPreferencesResponse{
+			Success: false,
+			Message: "Session ID is required",
+		}, nil
+	}
+
+	user, err := s.db.GetUserBySession(req.SessionId)
+	if err != nil {
+		return &alertpb.ExportPreferencesResponse{
+			Success: false,
+			Message: "Invalid session",
+		}, nil
+	}
+
+	bundle, err := s.db.ExportPreferences(user.ID)
+	if err != nil {
+		log.Printf("Error exporting preferences of user %s: %v", user.ID, err)
+		return &alertpb.ExportPreferencesResponse{
+			Success: false,
+			Message: "Failed to export preferences",
+		}, nil
+	}
+
+	data, err := json.MarshalIndent(bundle, "", "  ")
+	if err != nil {
+		log.Printf("Error encoding preferences of user %s: %v", user.ID, err)
+		return &alertpb.ExportPreferencesResponse{
+			Success: false,
+			Message: "Failed to export preferences",
+		}, nil
+	}
+
+	return &alertpb.ExportPreferencesResponse{
+		Success: true,
+		Message: "Preferences exported",
+		Bundle:  data,
+	}, nil
+}
+
+// ImportPreferences implements the ImportPreferences RPC method. The whole
+// bundle is validated before anything is written, then each entry is upserted
+// into the session user's settings.
+func (s *AlertServiceGorm) ImportPreferences(ctx context.Context, req *alertpb.ImportPreferencesRequest) (*alertpb.ImportPreferencesResponse, error) {
+	if req.SessionId == "" {
+		return &alertpb.ImportPreferencesResponse{
+			Success: false,
+			Message: "Session ID is required",
+		}, nil
+	}
+
+	user, err := s.db.GetUserBySession(req.SessionId)
+	if err != nil {
+		return &alertpb.ImportPreferencesResponse{
+			Success: false,
+			Message: "Invalid session",
+		}, nil
+	}
+
+	var bundle models.PreferencesBundle
+	if err := json.Unmarshal(req.Bundle, &bundle); err != nil {
+		return &alertpb.ImportPreferencesResponse{
+			Success: false,
+			Message: fmt.Sprintf("Invalid preferences bundle: %v", err),
+		}, nil
+	}
+	if err := prepareImportedPreferences(user.ID, &bundle); err != nil {
+		return &alertpb.ImportPreferencesResponse{
+			Success: false,
+			Message: fmt.Sprintf("Invalid preferences bundle: %v", err),
+		}, nil
+	}
+
+	result, err := s.db.ImportPreferences(user.ID, &bundle)
+	if err != nil {
+		log.Printf("Error importing preferences for user %s: %v", user.ID, err)
+		return &alertpb.ImportPreferencesResponse{
+			Success: false,
+			Message: "Failed to import preferences",
+		}, nil
+	}
+
+	log.Printf("Imported preferences for user %s: %d created, %d updated", user.ID, result.Created, result.Updated)
+
+	return &alertpb.ImportPreferencesResponse{
+		Success: true,
+		Message: "Preferences imported",
+		Created: int32(result.Created),
+		Updated: int32(result.Updated),
+	}, nil
+}
+
+// prepareImportedPreferences validates every section of an imported bundle
+// with the rules of the matching Save RPCs and rewrites the entries so they
+// belong to userID. IDs and timestamps from the file are dropped.
+func prepareImportedPreferences(userID string, bundle *models.PreferencesBundle) error {
+	if bundle.Version < 1 || bundle.Version > models.PreferencesBundleVersion {
+		return fmt.Errorf("unsupported version %d", bundle.Version)
+	}
+
+	for i := range bundle.ColorPreferences {
+		pref := &bundle.ColorPreferences[i]
+		if _, err := pref.GetLabelConditions(); err != nil {
+			return fmt.Errorf("color preference %d: invalid label conditions", i+1)
+		}
+		if err := sanitizeColorPreference(pref); err != nil {
+			return fmt.Errorf("color preference %d: %w", i+1, err)
+		}
+		*pref = mainmodels.UserColorPreference{
+			UserID:             userID,
+			LabelConditions:    pref.LabelConditions,
+			Color:              pref.Color,
+			ColorType:          pref.ColorType,
+			Priority:           pref.Priority,
+			BgLightnessFactor:  pref.BgLightnessFactor,
+			TextDarknessFactor: pref.TextDarknessFactor,
+		}
+	}
+
+	for i := range bundle.FilterPresets {
+		preset := &bundle.FilterPresets[i]
+		if preset.Name == "" {
+			return fmt.Errorf("filter preset %d: name is required", i+1)
+		}
+		if !json.Valid(preset.FilterData) {
+			return fmt.Errorf("filter preset %q: filter data is not valid JSON", preset.Name)
+		}
+		if len(preset.ColumnConfigs) > 0 && !json.Valid(preset.ColumnConfigs) {
+			return fmt.Errorf("filter preset %q: column configs are not valid JSON", preset.Name)
+		}
+		*preset = models.FilterPreset{
+			UserID:        userID,
+			Name:          preset.Name,
+			Description:   preset.Description,
+			IsShared:      preset.IsShared,
+			FilterData:    preset.FilterData,
+			ColumnConfigs: preset.ColumnConfigs,
+		}
+	}
+
+	for i := range bundle.AnnotationButtonConfigs {
+		button := &bundle.AnnotationButtonConfigs[i]
+		if err := button.Validate(); err != nil {
+			return fmt.Errorf("annotation button %d: %w", i+1, err)
+		}
+		*button = models.AnnotationButtonConfig{
+			UserID:         userID,
+			Label:          button.Label,
+			AnnotationKeys: button.AnnotationKeys,
+			Color:          button.Color,
+			Icon:           button.Icon,
+			DisplayOrder:   button.DisplayOrder,
+			Enabled:        button.Enabled,
+			ButtonType:     button.ButtonType,
+		}
+	}
+
+	for i := range bundle.HiddenRules {
+		rule := &bundle.HiddenRules[i]
+		if rule.LabelKey == "" {
+			return fmt.Errorf("hidden rule %d: label key is required", i+1)
+		}
+		if rule.IsRegex {
+			if _, err := regexp.Compile(rule.LabelValue); err != nil {
+				return fmt.Errorf("hidden rule %d: invalid regex: %w", i+1, err)
+			}
+		}
+		*rule = models.UserHiddenRule{
+			UserID:      userID,
+			Name:        rule.Name,
+			Description: rule.Description,
+			LabelKey:    rule.LabelKey,
+			LabelValue:  rule.LabelValue,
+			IsRegex:     rule.IsRegex,
+			IsEnabled:   rule.IsEnabled,
+			Priority:    rule.Priority,
+		}
+	}
+
+	if pref := bundle.NotificationPreference; pref != nil {
+		validValues := map[string]bool{"critical": true, "warning": true, "info": true, "information": true}
+		severities := models.SeverityList{}
+		for _, severity := range pref.EnabledSeverities {
+			if validValues[severity] {
+				severities = append(severities, severity)
+			}
+		}
+		if err := pref.ValidateDND(); err != nil {
+			return fmt.Errorf("notification preference: invalid do-not-disturb schedule: %w", err)
+		}
+		imported := &models.NotificationPreference{
+			UserID:                      userID,
+			BrowserNotificationsEnabled: pref.BrowserNotificationsEnabled,
+			EnabledSeverities:           severities,
+			SoundNotificationsEnabled:   pref.SoundNotificationsEnabled,
+		}
+		copyDNDSchedule(imported, pref)
+		bundle.NotificationPreference = imported
+	}
+
+	return nil
+}
+
+// CheckPermission implements the CheckPermission RPC method, letting the WebUI
+// check group permissions for actions it performs itself, like silences
+func (s *AlertServiceGorm) CheckPermission(ctx context.Context, req *alertpb.CheckPermissionRequest) (*alertpb.CheckPermissionResponse, error) {
+	if req.SessionId == "" {
+		return &alertpb.CheckPermissionResponse{
+			Success: false,
+			Message: "Session ID is required",
+		}, nil
+	}
+
+	if !models.IsKnownPermission(req.Permission) {
+		return &alertpb.CheckPermissionResponse{
+			Success: false,
+			Message: fmt.Sprintf("Unknown permission %q", req.Permission),
+		}, nil
+	}
+
+	user, err := s.db.GetUserBySession(req.SessionId)
+	if err != nil {
+		return &alertpb.CheckPermissionResponse{
+			Success: false,
+			Message: "Invalid session",
+		}, nil
+	}
+
+	if message := s.authorize(user, req.Permission); message != "" {
+		return &alertpb.CheckPermissionResponse{
+			Success: true,
+			Allowed: false,
+			Message: message,
+		}, nil
+	}
+
+	return &alertpb.CheckPermissionResponse{
+		Success: true,
+		Allowed: true,
+	}, nil
+}
+
+// HealthCheck implements the HealthCheck RPC method. It needs no session so
+// probes and the WebUI can call it before anyone logs in.
+func (s *AlertServiceGorm) HealthCheck(ctx context.Context, req *alertpb.HealthCheckRequest) (*alertpb.HealthCheckResponse, error) {
+	readiness := s.db.CheckReadiness()
+
+	return &alertpb.HealthCheckResponse{
+		Status:       readiness.Status,
+		DatabaseType: readiness.DatabaseType,
+		DatabaseUp:   readiness.DatabaseUp,
+		Migrated:     readiness.Migrated,
+		Message:      readiness.Error,
+	}, nil
+}
+
 func generateUUID() string {
 	bytes := make([]byte, 16)
 	rand.Read(bytes)
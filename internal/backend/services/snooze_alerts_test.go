@@ -0,0 +1,96 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"notificator/internal/backend/models"
+	alertpb "notificator/internal/backend/proto/alert"
+)
+
+func TestHideAlert_SnoozeExpires(t *testing.T) {
+	svc := newTestServiceWithSession(t)
+	ctx := context.Background()
+
+	until := time.Now().Add(time.Hour)
+	resp, err := svc.HideAlert(ctx, &alertpb.HideAlertRequest{SessionId: "session-1", Fingerprint: "fp-1", SnoozeUntil: timestamppb.New(until)})
+	if err != nil || !resp.Success {
+		t.Fatalf("snooze failed: %v %v", err, resp)
+	}
+	if resp.HiddenAlert.ExpiresAt == nil || !resp.HiddenAlert.ExpiresAt.AsTime().Equal(until.UTC()) {
+		t.Errorf("expected the snooze to end at %s, got %v", until, resp.HiddenAlert.ExpiresAt)
+	}
+
+	// Snoozing again moves the end instead of adding a second row
+	resnoozed, err := svc.HideAlert(ctx, &alertpb.HideAlertRequest{SessionId: "session-1", Fingerprint: "fp-1", SnoozeUntil: timestamppb.New(until.Add(time.Hour))})
+	if err != nil || !resnoozed.Success || resnoozed.HiddenAlert.Id != resp.HiddenAlert.Id {
+		t.Fatalf("expected the snooze to be extended in place: %v %v", err, resnoozed)
+	}
+	if _, err := svc.HideAlert(ctx, &alertpb.HideAlertRequest{SessionId: "session-1", Fingerprint: "fp-2"}); err != nil {
+		t.Fatalf("hide failed: %v", err)
+	}
+
+	past, err := svc.HideAlert(ctx, &alertpb.HideAlertRequest{SessionId: "session-1", Fingerprint: "fp-3", SnoozeUntil: timestamppb.New(time.Now().Add(-time.Minute))})
+	if err != nil || past.Success {
+		t.Errorf("expected a snooze ending in the past to be rejected, got %v %v", err, past)
+	}
+
+	hidden, err := svc.GetUserHiddenAlerts(ctx, &alertpb.GetUserHiddenAlertsRequest{SessionId: "session-1"})
+	if err != nil || len(hidden.HiddenAlerts) != 2 {
+		t.Fatalf("expected the snoozed and the hidden alert, got %v %v", err, hidden)
+	}
+
+	// Once the snooze has ended the alert is no longer hidden, and the
+	// cleanup removes it
+	svc.db.GetDB().Model(&models.UserHiddenAlert{}).Where("fingerprint = ?", "fp-1").Update("expires_at", time.Now().Add(-time.Second))
+	hidden, err = svc.GetUserHiddenAlerts(ctx, &alertpb.GetUserHiddenAlertsRequest{SessionId: "session-1"})
+	if err != nil || len(hidden.HiddenAlerts) != 1 || hidden.HiddenAlerts[0].Fingerprint != "fp-2" {
+		t.Fatalf("expected only the hidden alert after the snooze ended, got %v %v", err, hidden)
+	}
+	if purged, err := svc.db.CleanupExpiredSnoozes(); err != nil || purged != 1 {
+		t.Errorf("expected one expired snooze purged, got %d (%v)", purged, err)
+	}
+}
+
+func TestUndoClearAllHiddenAlerts_KeepsAlertsHiddenAgain(t *testing.T) {
+	svc := newTestServiceWithSession(t)
+	ctx := context.Background()
+
+	for _, fingerprint := range []string{"fp-1", "fp-2"} {
+		if _, err := svc.HideAlert(ctx, &alertpb.HideAlertRequest{SessionId: "session-1", Fingerprint: fingerprint, Reason: "first"}); err != nil {
+			t.Fatalf("hide failed: %v", err)
+		}
+	}
+
+	cleared, err := svc.ClearAllHiddenAlerts(ctx, &alertpb.ClearAllHiddenAlertsRequest{SessionId: "session-1"})
+	if err != nil || !cleared.Success || cleared.ClearedCount != 2 {
+		t.Fatalf("clear failed: %v %v", err, cleared)
+	}
+	hidden, err := svc.GetUserHiddenAlerts(ctx, &alertpb.GetUserHiddenAlertsRequest{SessionId: "session-1"})
+	if err != nil || len(hidden.HiddenAlerts) != 0 {
+		t.Fatalf("expected no hidden alert after the clear, got %v %v", err, hidden)
+	}
+
+	// fp-2 is hidden again before the undo: the new row wins
+	if _, err := svc.HideAlert(ctx, &alertpb.HideAlertRequest{SessionId: "session-1", Fingerprint: "fp-2", Reason: "second"}); err != nil {
+		t.Fatalf("hide failed: %v", err)
+	}
+
+	resp, err := svc.UndoClearAllHiddenAlerts(ctx, &alertpb.UndoClearRequest{SessionId: "session-1", ClearedAt: cleared.ClearedAt})
+	if err != nil || !resp.Success || resp.RestoredCount != 1 {
+		t.Fatalf("expected fp-1 restored, got %v %v", err, resp)
+	}
+
+	hidden, err = svc.GetUserHiddenAlerts(ctx, &alertpb.GetUserHiddenAlertsRequest{SessionId: "session-1"})
+	if err != nil || len(hidden.HiddenAlerts) != 2 {
+		t.Fatalf("expected both alerts hidden after the undo, got %v %v", err, hidden)
+	}
+	for _, alert := range hidden.HiddenAlerts {
+		if alert.Fingerprint == "fp-2" && alert.Reason != "second" {
+			t.Errorf("expected fp-2 to keep the reason it was hidden again with, got %q", alert.Reason)
+		}
+	}
+}
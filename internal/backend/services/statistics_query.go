@@ -1026,7 +1026,7 @@ func (sqs *StatisticsQueryService) getHiddenFingerprints(userID string, allFinge
 	// Get directly hidden alerts for this user
 	var directHidden []string
 	err := sqs.db.GetDB().Table("user_hidden_alerts").
-		Where("user_id = ?", userID).
+		Where("user_id = ? AND deleted_at IS NULL", userID).
 		Where("fingerprint IN ?", allFingerprints).
 		Pluck("fingerprint", &directHidden).Error
 
@@ -0,0 +1,51 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"notificator/config"
+	"notificator/internal/backend/database"
+	"notificator/internal/backend/models"
+)
+
+// newTestDB creates a migrated SQLite database in the test's temp directory
+func newTestDB(t *testing.T) *database.GormDB {
+	t.Helper()
+
+	db, err := database.NewGormDB("sqlite", config.DatabaseConfig{SQLitePath: t.TempDir() + "/test.db"})
+	if err != nil {
+		t.Fatalf("failed to create test database: %v", err)
+	}
+	if err := db.AutoMigrate(); err != nil {
+		t.Fatalf("failed to migrate test database: %v", err)
+	}
+	return db
+}
+
+// createTestUser creates a user and, unless sessionID is empty, a session
+// for them that expires in an hour
+func createTestUser(t *testing.T, db *database.GormDB, username, sessionID string) *models.User {
+	t.Helper()
+
+	user, err := db.CreateUser(username, username+"@example.com", "hash")
+	if err != nil {
+		t.Fatalf("failed to create user: %v", err)
+	}
+	if sessionID != "" {
+		if err := db.CreateSession(user.ID, sessionID, time.Now().Add(time.Hour)); err != nil {
+			t.Fatalf("failed to create session: %v", err)
+		}
+	}
+	return user
+}
+
+// newTestServiceWithSession creates a service whose database holds the user
+// alice with the session "session-1"
+func newTestServiceWithSession(t *testing.T) *AlertServiceGorm {
+	t.Helper()
+
+	db := newTestDB(t)
+	createTestUser(t, db, "alice", "session-1")
+	return NewAlertServiceGorm(db)
+}
@@ -0,0 +1,40 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	alertpb "notificator/internal/backend/proto/alert"
+)
+
+func TestUnhideAlert_BatchesFingerprints(t *testing.T) {
+	svc := newTestServiceWithSession(t)
+	ctx := context.Background()
+
+	for _, fp := range []string{"fp-1", "fp-2", "fp-3"} {
+		resp, err := svc.HideAlert(ctx, &alertpb.HideAlertRequest{SessionId: "session-1", Fingerprint: fp, AlertName: "Disk full"})
+		if err != nil || !resp.Success {
+			t.Fatalf("failed to hide %s: %v %v", fp, err, resp)
+		}
+	}
+
+	resp, err := svc.UnhideAlert(ctx, &alertpb.UnhideAlertRequest{SessionId: "session-1", Fingerprint: "fp-1", Fingerprints: []string{"fp-2", "unknown"}})
+	if err != nil || !resp.Success {
+		t.Fatalf("batch unhide failed: %v %v", err, resp)
+	}
+	if resp.UnhiddenCount != 2 {
+		t.Errorf("expected 2 alerts unhidden, got %d", resp.UnhiddenCount)
+	}
+
+	hidden, err := svc.GetUserHiddenAlerts(ctx, &alertpb.GetUserHiddenAlertsRequest{SessionId: "session-1"})
+	if err != nil || !hidden.Success {
+		t.Fatalf("failed to list hidden alerts: %v %v", err, hidden)
+	}
+	if len(hidden.HiddenAlerts) != 1 || hidden.HiddenAlerts[0].Fingerprint != "fp-3" {
+		t.Errorf("expected only fp-3 to stay hidden, got %+v", hidden.HiddenAlerts)
+	}
+
+	if empty, _ := svc.UnhideAlert(ctx, &alertpb.UnhideAlertRequest{SessionId: "session-1"}); empty.Success {
+		t.Errorf("expected a request without fingerprints to be rejected")
+	}
+}
@@ -73,14 +73,35 @@ func (a *Alert) GetInstance() string {
 	return "unknown"
 }
 
+// DefaultSummaryAnnotations is the summary lookup order used when none is configured
+var DefaultSummaryAnnotations = []string{"summary"}
+
 // GetSummary returns the summary annotation
 func (a *Alert) GetSummary() string {
-	if summary, exists := a.Annotations["summary"]; exists {
+	return a.GetSummaryFrom(DefaultSummaryAnnotations)
+}
+
+// GetSummaryFrom returns the first non-empty annotation among keys, trying
+// them in order, so alerts that carry their text in "message" or
+// "description" still get a summary.
+func (a *Alert) GetSummaryFrom(keys []string) string {
+	if summary, ok := ResolveSummary(a.Annotations, keys); ok {
 		return summary
 	}
 	return "No summary available"
 }
 
+// ResolveSummary returns the value of the first annotation in keys that is
+// present and not blank.
+func ResolveSummary(annotations map[string]string, keys []string) (string, bool) {
+	for _, key := range keys {
+		if value, exists := annotations[key]; exists && strings.TrimSpace(value) != "" {
+			return value, true
+		}
+	}
+	return "", false
+}
+
 // GetTeam returns the team label value
 func (a *Alert) GetTeam() string {
 	if team, exists := a.Labels["team"]; exists {
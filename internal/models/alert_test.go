@@ -0,0 +1,92 @@
+package models
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestGetSummaryFrom(t *testing.T) {
+	keys := []string{"summary", "message", "description"}
+
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		want        string
+	}{
+		{
+			name:        "first key wins",
+			annotations: map[string]string{"summary": "Disk full", "message": "ignored"},
+			want:        "Disk full",
+		},
+		{
+			name:        "falls back to the next key",
+			annotations: map[string]string{"message": "Queue is backing up", "description": "ignored"},
+			want:        "Queue is backing up",
+		},
+		{
+			name:        "blank values are skipped",
+			annotations: map[string]string{"summary": "  ", "message": "", "description": "Latency above SLO"},
+			want:        "Latency above SLO",
+		},
+		{
+			name:        "no configured key present",
+			annotations: map[string]string{"runbook_url": "https://runbooks.example.com"},
+			want:        "No summary available",
+		},
+		{
+			name:        "nil annotations",
+			annotations: nil,
+			want:        "No summary available",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			alert := &Alert{Annotations: tt.annotations}
+			if got := alert.GetSummaryFrom(keys); got != tt.want {
+				t.Errorf("GetSummaryFrom() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetSummaryDefaultsToSummaryAnnotation(t *testing.T) {
+	alert := &Alert{Annotations: map[string]string{"message": "only a message"}}
+	if got := alert.GetSummary(); got != "No summary available" {
+		t.Errorf("GetSummary() should only read the summary annotation by default, got %q", got)
+	}
+}
+
+func TestAlertJSONRoundTrip(t *testing.T) {
+	alert := Alert{
+		Labels:       map[string]string{"alertname": "DiskFull", "severity": "critical"},
+		Annotations:  map[string]string{"summary": "Disk is 95% full"},
+		StartsAt:     time.Date(2024, 3, 1, 12, 30, 0, 0, time.UTC),
+		EndsAt:       time.Date(2024, 3, 1, 13, 0, 0, 0, time.UTC),
+		GeneratorURL: "http://prometheus/graph?g0.expr=disk",
+		Status: AlertStatus{
+			State:       "suppressed",
+			SilencedBy:  []string{"silence-1"},
+			InhibitedBy: []string{},
+		},
+	}
+
+	data, err := json.Marshal(alert)
+	if err != nil {
+		t.Fatalf("marshal failed: %v", err)
+	}
+	if !strings.Contains(string(data), `"startsAt":"2024-03-01T12:30:00Z"`) {
+		t.Errorf("expected RFC3339 timestamps, got %s", data)
+	}
+
+	var decoded Alert
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("unmarshal failed: %v", err)
+	}
+	if !reflect.DeepEqual(decoded, alert) {
+		t.Errorf("round trip mismatch:\n got %+v\nwant %+v", decoded, alert)
+	}
+}
@@ -0,0 +1,64 @@
+package models
+
+import (
+	"math"
+	"strings"
+	"time"
+)
+
+// PriorityWeights tunes how PriorityScore combines the signals of an alert.
+type PriorityWeights struct {
+	// Severity maps a lower-cased severity to its base score
+	Severity map[string]float64
+	// PerHour is added for every hour the alert has been firing
+	PerHour float64
+	// MaxHours caps the duration contribution (0 means no cap)
+	MaxHours float64
+	// Acknowledged is subtracted once someone has acknowledged the alert
+	Acknowledged float64
+	// Labels adds a bonus when a label has a given value, keyed by label name then value
+	Labels map[string]map[string]float64
+}
+
+// DefaultPriorityWeights keeps severity dominant while letting a long-running
+// warning overtake a fresh one, and sinks acknowledged alerts below new ones.
+func DefaultPriorityWeights() PriorityWeights {
+	return PriorityWeights{
+		Severity: map[string]float64{
+			"critical": 100,
+			"warning":  50,
+			"info":     10,
+		},
+		PerHour:      2,
+		MaxHours:     24,
+		Acknowledged: 60,
+	}
+}
+
+// PriorityScore rates how urgently an alert needs attention; higher is more
+// urgent. It only depends on its arguments, so the same alert always scores
+// the same at a given time.
+func PriorityScore(alert *Alert, acknowledged bool, now time.Time, weights PriorityWeights) float64 {
+	score := weights.Severity[strings.ToLower(alert.GetSeverity())]
+
+	if !alert.StartsAt.IsZero() && now.After(alert.StartsAt) {
+		hours := now.Sub(alert.StartsAt).Hours()
+		if weights.MaxHours > 0 && hours > weights.MaxHours {
+			hours = weights.MaxHours
+		}
+		score += hours * weights.PerHour
+	}
+
+	if acknowledged {
+		score -= weights.Acknowledged
+	}
+
+	for label, values := range weights.Labels {
+		if value, exists := alert.Labels[label]; exists {
+			score += values[value]
+		}
+	}
+
+	// One decimal is enough to rank and keeps the displayed score readable
+	return math.Round(score*10) / 10
+}
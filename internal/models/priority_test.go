@@ -0,0 +1,91 @@
+package models
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPriorityScore(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	weights := DefaultPriorityWeights()
+
+	alertFiringFor := func(severity string, d time.Duration, labels map[string]string) *Alert {
+		alertLabels := map[string]string{"alertname": "Test", "severity": severity}
+		for k, v := range labels {
+			alertLabels[k] = v
+		}
+		return &Alert{Labels: alertLabels, StartsAt: now.Add(-d)}
+	}
+
+	tests := []struct {
+		name         string
+		alert        *Alert
+		acknowledged bool
+		weights      PriorityWeights
+		want         float64
+	}{
+		{
+			name:    "fresh critical scores its severity weight",
+			alert:   alertFiringFor("critical", 5*time.Second, nil),
+			weights: weights,
+			want:    100,
+		},
+		{
+			name:    "duration adds per hour",
+			alert:   alertFiringFor("warning", 6*time.Hour, nil),
+			weights: weights,
+			want:    62,
+		},
+		{
+			name:    "duration is capped",
+			alert:   alertFiringFor("warning", 72*time.Hour, nil),
+			weights: weights,
+			want:    98,
+		},
+		{
+			name:    "severity is case-insensitive",
+			alert:   alertFiringFor("CRITICAL", 0, nil),
+			weights: weights,
+			want:    100,
+		},
+		{
+			name:         "acknowledged alerts sink",
+			alert:        alertFiringFor("critical", 0, nil),
+			acknowledged: true,
+			weights:      weights,
+			want:         40,
+		},
+		{
+			name:    "unknown severity has no base score",
+			alert:   alertFiringFor("debug", 0, nil),
+			weights: weights,
+			want:    0,
+		},
+		{
+			name:  "label values add a bonus",
+			alert: alertFiringFor("warning", 0, map[string]string{"env": "production"}),
+			weights: PriorityWeights{
+				Severity: weights.Severity,
+				Labels:   map[string]map[string]float64{"env": {"production": 30, "staging": 5}},
+			},
+			want: 80,
+		},
+		{
+			name:  "long-running warning outranks fresh critical with heavier duration weight",
+			alert: alertFiringFor("warning", 6*time.Hour, nil),
+			weights: PriorityWeights{
+				Severity: weights.Severity,
+				PerHour:  10,
+			},
+			want: 110,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := PriorityScore(tt.alert, tt.acknowledged, now, tt.weights); got != tt.want {
+				t.Errorf("PriorityScore() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
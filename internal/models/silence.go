@@ -1,6 +1,8 @@
 package models
 
 import (
+	"fmt"
+	"regexp"
 	"strings"
 	"time"
 )
@@ -22,7 +24,20 @@ type SilenceMatcher struct {
 	Name    string `json:"name"`
 	Value   string `json:"value"`
 	IsRegex bool   `json:"isRegex"`
-	IsEqual bool   `json:"isEqual"`
+	// IsEqual is nil when an older Alertmanager omits it, which means an
+	// equality matcher; use Equal to read it
+	IsEqual *bool `json:"isEqual,omitempty"`
+}
+
+// Equal reports whether the matcher selects labels that match (= and =~)
+// rather than labels that don't (!= and !~)
+func (m SilenceMatcher) Equal() bool {
+	return m.IsEqual == nil || *m.IsEqual
+}
+
+// BoolPtr returns a pointer to b, for SilenceMatcher.IsEqual
+func BoolPtr(b bool) *bool {
+	return &b
 }
 
 // SilenceStatus represents the status of a silence
@@ -71,7 +86,7 @@ func (s *Silence) GetMatchersString() string {
 	var result []string
 	for _, matcher := range s.Matchers {
 		operator := "="
-		if !matcher.IsEqual {
+		if !matcher.Equal() {
 			operator = "!="
 		}
 		if matcher.IsRegex {
@@ -82,3 +97,91 @@ func (s *Silence) GetMatchersString() string {
 
 	return strings.Join(result, ", ")
 }
+
+// Matches reports whether the matcher selects the given label set, following
+// Alertmanager semantics: a missing label has the empty value and regular
+// expressions are anchored at both ends.
+func (m SilenceMatcher) Matches(labels map[string]string) bool {
+	value := labels[m.Name]
+
+	var matched bool
+	if m.IsRegex {
+		re, err := regexp.Compile("^(?:" + m.Value + ")$")
+		if err != nil {
+			return false
+		}
+		matched = re.MatchString(value)
+	} else {
+		matched = value == m.Value
+	}
+
+	if m.Equal() {
+		return matched
+	}
+	return !matched
+}
+
+// MatchesLabels reports whether every matcher of the silence selects the
+// given label set, i.e. whether the silence would mute an alert with them.
+func (s *Silence) MatchesLabels(labels map[string]string) bool {
+	if len(s.Matchers) == 0 {
+		return false
+	}
+	for _, matcher := range s.Matchers {
+		if !matcher.Matches(labels) {
+			return false
+		}
+	}
+	return true
+}
+
+var labelNamePattern = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+// ParseSilenceMatcher parses a matcher written in Alertmanager syntax:
+// name=value, name!=value, name=~regex or name!~regex. The value may be
+// wrapped in double quotes. Regular expressions are checked to compile.
+func ParseSilenceMatcher(expr string) (SilenceMatcher, error) {
+	expr = strings.TrimSpace(expr)
+
+	i := strings.IndexAny(expr, "=!")
+	if i < 0 {
+		return SilenceMatcher{}, fmt.Errorf("matcher %q has no operator (=, !=, =~ or !~)", expr)
+	}
+
+	name := strings.TrimSpace(expr[:i])
+	if !labelNamePattern.MatchString(name) {
+		return SilenceMatcher{}, fmt.Errorf("matcher %q has an invalid label name", expr)
+	}
+
+	matcher := SilenceMatcher{Name: name, IsEqual: BoolPtr(false)}
+	rest := expr[i:]
+	switch {
+	case strings.HasPrefix(rest, "=~"):
+		matcher.IsEqual, matcher.IsRegex = BoolPtr(true), true
+		rest = rest[2:]
+	case strings.HasPrefix(rest, "!~"):
+		matcher.IsRegex = true
+		rest = rest[2:]
+	case strings.HasPrefix(rest, "!="):
+		rest = rest[2:]
+	case strings.HasPrefix(rest, "="):
+		matcher.IsEqual = BoolPtr(true)
+		rest = rest[1:]
+	default:
+		return SilenceMatcher{}, fmt.Errorf("matcher %q has no operator (=, !=, =~ or !~)", expr)
+	}
+
+	value := strings.TrimSpace(rest)
+	if len(value) >= 2 && strings.HasPrefix(value, `"`) && strings.HasSuffix(value, `"`) {
+		value = value[1 : len(value)-1]
+	}
+	matcher.Value = value
+
+	if matcher.IsRegex {
+		if _, err := regexp.Compile("^(?:" + value + ")$"); err != nil {
+			return SilenceMatcher{}, fmt.Errorf("matcher %q has an invalid regex: %w", expr, err)
+		}
+	}
+
+	return matcher, nil
+}
@@ -0,0 +1,85 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestSilenceMatchesLabels(t *testing.T) {
+	labels := map[string]string{"alertname": "DiskFull", "namespace": "payments", "severity": "critical"}
+
+	tests := []struct {
+		name     string
+		matchers []SilenceMatcher
+		want     bool
+	}{
+		{"equal", []SilenceMatcher{{Name: "namespace", Value: "payments", IsEqual: BoolPtr(true)}}, true},
+		{"equal mismatch", []SilenceMatcher{{Name: "namespace", Value: "billing", IsEqual: BoolPtr(true)}}, false},
+		{"not equal", []SilenceMatcher{{Name: "namespace", Value: "billing", IsEqual: BoolPtr(false)}}, true},
+		{"regex is anchored", []SilenceMatcher{{Name: "namespace", Value: "pay", IsRegex: true, IsEqual: BoolPtr(true)}}, false},
+		{"regex", []SilenceMatcher{{Name: "namespace", Value: "pay.*|billing", IsRegex: true, IsEqual: BoolPtr(true)}}, true},
+		{"negative regex", []SilenceMatcher{{Name: "severity", Value: "warn.*", IsRegex: true, IsEqual: BoolPtr(false)}}, true},
+		{"missing label equals empty", []SilenceMatcher{{Name: "team", Value: "", IsEqual: BoolPtr(true)}}, true},
+		{"all matchers must match", []SilenceMatcher{
+			{Name: "namespace", Value: "payments", IsEqual: BoolPtr(true)},
+			{Name: "severity", Value: "warning", IsEqual: BoolPtr(true)},
+		}, false},
+		{"invalid regex never matches", []SilenceMatcher{{Name: "namespace", Value: "(", IsRegex: true, IsEqual: BoolPtr(true)}}, false},
+		{"no matchers", nil, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			silence := Silence{Matchers: tt.matchers}
+			if got := silence.MatchesLabels(labels); got != tt.want {
+				t.Errorf("MatchesLabels() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseSilenceMatcher(t *testing.T) {
+	tests := []struct {
+		expr    string
+		want    SilenceMatcher
+		wantErr bool
+	}{
+		{expr: "namespace=prod", want: SilenceMatcher{Name: "namespace", Value: "prod", IsEqual: BoolPtr(true)}},
+		{expr: "severity!=info", want: SilenceMatcher{Name: "severity", Value: "info", IsEqual: BoolPtr(false)}},
+		{expr: "instance=~web-.*", want: SilenceMatcher{Name: "instance", Value: "web-.*", IsEqual: BoolPtr(true), IsRegex: true}},
+		{expr: "job!~node|blackbox", want: SilenceMatcher{Name: "job", Value: "node|blackbox", IsRegex: true, IsEqual: BoolPtr(false)}},
+		{expr: ` team = "core platform" `, want: SilenceMatcher{Name: "team", Value: "core platform", IsEqual: BoolPtr(true)}},
+		{expr: "pod=", want: SilenceMatcher{Name: "pod", Value: "", IsEqual: BoolPtr(true)}},
+		{expr: "no-operator", wantErr: true},
+		{expr: "=value", wantErr: true},
+		{expr: "1bad=value", wantErr: true},
+		{expr: "instance=~web-[", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseSilenceMatcher(tt.expr)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("ParseSilenceMatcher(%q) expected an error, got %+v", tt.expr, got)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("ParseSilenceMatcher(%q) unexpected error: %v", tt.expr, err)
+			continue
+		}
+		if got.Name != tt.want.Name || got.Value != tt.want.Value || got.IsRegex != tt.want.IsRegex || got.IsEqual == nil || *got.IsEqual != *tt.want.IsEqual {
+			t.Errorf("ParseSilenceMatcher(%q) = %+v, want %+v", tt.expr, got, tt.want)
+		}
+	}
+}
+
+func TestSilenceMatcher_OmittedIsEqualMeansEqual(t *testing.T) {
+	var matcher SilenceMatcher
+	if err := json.Unmarshal([]byte(`{"name":"namespace","value":"payments","isRegex":false}`), &matcher); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !matcher.Equal() || !matcher.Matches(map[string]string{"namespace": "payments"}) {
+		t.Errorf("expected a matcher without isEqual to be an equality matcher, got %+v", matcher)
+	}
+}
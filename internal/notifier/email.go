@@ -0,0 +1,226 @@
+package notifier
+
+import (
+	"crypto/tls"
+	"fmt"
+	"log"
+	"mime"
+	"net"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"notificator/internal/models"
+)
+
+// EmailConfig holds the SMTP settings for digest emails
+type EmailConfig struct {
+	Enabled bool   `json:"enabled"`
+	Host    string `json:"host"`
+	Port    int    `json:"port"`
+	// TLS connects over implicit TLS (usually port 465); otherwise STARTTLS is
+	// used when the server offers it
+	TLS      bool     `json:"tls"`
+	Username string   `json:"username"`
+	Password string   `json:"password"`
+	From     string   `json:"from"`
+	To       []string `json:"to"`
+	// BatchWindowSeconds is how long to collect alerts before sending one
+	// email (default 60)
+	BatchWindowSeconds int `json:"batch_window_seconds"`
+}
+
+// EmailNotifier sends digest emails of new critical alerts. Alerts queued
+// within the batch window go out together in a single email.
+type EmailNotifier struct {
+	config EmailConfig
+	window time.Duration
+
+	mutex   sync.Mutex
+	pending []models.Alert
+	timer   *time.Timer
+
+	// send delivers a message; replaced in tests
+	send func(subject, body string) error
+}
+
+// NewEmailNotifier creates an email notifier from its configuration
+func NewEmailNotifier(config EmailConfig) *EmailNotifier {
+	if config.Port == 0 {
+		config.Port = 587
+	}
+	if config.BatchWindowSeconds <= 0 {
+		config.BatchWindowSeconds = 60
+	}
+
+	e := &EmailNotifier{
+		config: config,
+		window: time.Duration(config.BatchWindowSeconds) * time.Second,
+	}
+	e.send = e.sendSMTP
+	return e
+}
+
+// Accepts reports whether the alert belongs in a digest
+func (e *EmailNotifier) Accepts(alert models.Alert) bool {
+	return alert.GetSeverity() == "critical"
+}
+
+// Queue adds alerts to the current batch, starting the batch window if none
+// is running
+func (e *EmailNotifier) Queue(alerts []models.Alert) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	for _, alert := range alerts {
+		if e.Accepts(alert) {
+			e.pending = append(e.pending, alert)
+		}
+	}
+
+	if len(e.pending) > 0 && e.timer == nil {
+		e.timer = time.AfterFunc(e.window, func() {
+			if err := e.Flush(); err != nil {
+				log.Printf("Failed to send alert digest email: %v", err)
+			}
+		})
+	}
+}
+
+// Send emails subject and body to the configured recipients right away,
+// outside the digest
+func (e *EmailNotifier) Send(subject, body string) error {
+	return e.send(subject, body)
+}
+
+// Flush sends the pending batch right away
+func (e *EmailNotifier) Flush() error {
+	e.mutex.Lock()
+	alerts := e.pending
+	e.pending = nil
+	if e.timer != nil {
+		e.timer.Stop()
+		e.timer = nil
+	}
+	e.mutex.Unlock()
+
+	if len(alerts) == 0 {
+		return nil
+	}
+
+	subject, body := buildDigest(alerts)
+	return e.send(subject, body)
+}
+
+func buildDigest(alerts []models.Alert) (string, string) {
+	subject := fmt.Sprintf("[Notificator] %s is firing", alerts[0].GetAlertName())
+	if len(alerts) > 1 {
+		subject = fmt.Sprintf("[Notificator] %d critical alerts firing", len(alerts))
+	}
+
+	var body strings.Builder
+	for i, alert := range alerts {
+		if i > 0 {
+			body.WriteString("\r\n")
+		}
+		fmt.Fprintf(&body, "%s (%s)\r\n", alert.GetAlertName(), alert.GetSeverity())
+		if instance := alert.GetInstance(); instance != "" {
+			fmt.Fprintf(&body, "Instance: %s\r\n", instance)
+		}
+		if summary := alert.GetSummary(); summary != "" {
+			fmt.Fprintf(&body, "Summary: %s\r\n", summary)
+		}
+		fmt.Fprintf(&body, "Started: %s\r\n", alert.StartsAt.Format(time.RFC1123))
+		if alert.GeneratorURL != "" {
+			fmt.Fprintf(&body, "Source: %s\r\n", alert.GeneratorURL)
+		}
+	}
+
+	return subject, body.String()
+}
+
+// encodeSubject makes subject safe for the Subject header: label values can
+// hold CR/LF, which would inject headers, and non-ASCII text must be RFC 2047
+// encoded
+func encodeSubject(subject string) string {
+	subject = strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) {
+			return -1
+		}
+		return r
+	}, subject)
+	return mime.QEncoding.Encode("utf-8", subject)
+}
+
+func (e *EmailNotifier) sendSMTP(subject, body string) error {
+	if len(e.config.To) == 0 {
+		return fmt.Errorf("no email recipients configured")
+	}
+
+	addr := net.JoinHostPort(e.config.Host, strconv.Itoa(e.config.Port))
+	tlsConfig := &tls.Config{ServerName: e.config.Host}
+	dialer := &net.Dialer{Timeout: 10 * time.Second}
+
+	var conn net.Conn
+	var err error
+	if e.config.TLS {
+		conn, err = tls.DialWithDialer(dialer, "tcp", addr, tlsConfig)
+	} else {
+		conn, err = dialer.Dial("tcp", addr)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to connect to SMTP server %s: %w", addr, err)
+	}
+
+	client, err := smtp.NewClient(conn, e.config.Host)
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("failed to start SMTP session with %s: %w", addr, err)
+	}
+	defer client.Close()
+
+	if !e.config.TLS {
+		if ok, _ := client.Extension("STARTTLS"); ok {
+			if err := client.StartTLS(tlsConfig); err != nil {
+				return fmt.Errorf("STARTTLS with %s failed: %w", addr, err)
+			}
+		}
+	}
+
+	if e.config.Username != "" {
+		if ok, _ := client.Extension("AUTH"); !ok {
+			return fmt.Errorf("SMTP server %s does not support authentication", addr)
+		}
+		auth := smtp.PlainAuth("", e.config.Username, e.config.Password, e.config.Host)
+		if err := client.Auth(auth); err != nil {
+			return fmt.Errorf("SMTP authentication as %q failed: %w", e.config.Username, err)
+		}
+	}
+
+	if err := client.Mail(e.config.From); err != nil {
+		return fmt.Errorf("SMTP server rejected sender %q: %w", e.config.From, err)
+	}
+	for _, to := range e.config.To {
+		if err := client.Rcpt(to); err != nil {
+			return fmt.Errorf("SMTP server rejected recipient %q: %w", to, err)
+		}
+	}
+
+	w, err := client.Data()
+	if err != nil {
+		return fmt.Errorf("failed to start email body: %w", err)
+	}
+	message := fmt.Sprintf("From: %s\r\nTo: %s\r\nSubject: %s\r\nDate: %s\r\nContent-Type: text/plain; charset=UTF-8\r\n\r\n%s",
+		e.config.From, strings.Join(e.config.To, ", "), encodeSubject(subject), time.Now().Format(time.RFC1123Z), body)
+	if _, err := w.Write([]byte(message)); err != nil {
+		return fmt.Errorf("failed to write email body: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("failed to send email: %w", err)
+	}
+
+	return client.Quit()
+}
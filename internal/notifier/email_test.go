@@ -0,0 +1,146 @@
+package notifier
+
+import (
+	"bufio"
+	"mime"
+	"net"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"notificator/internal/models"
+)
+
+func criticalAlert(name string) models.Alert {
+	return models.Alert{
+		Labels:   map[string]string{"alertname": name, "severity": "critical"},
+		StartsAt: time.Now(),
+		Status:   models.AlertStatus{State: "active"},
+	}
+}
+
+func TestEmailNotifier_BatchesAlertsIntoOneEmail(t *testing.T) {
+	email := NewEmailNotifier(EmailConfig{Enabled: true, Host: "localhost", BatchWindowSeconds: 1})
+
+	type sent struct{ subject, body string }
+	sends := make(chan sent, 2)
+	email.send = func(subject, body string) error {
+		sends <- sent{subject, body}
+		return nil
+	}
+
+	warning := criticalAlert("HighLatency")
+	warning.Labels["severity"] = "warning"
+
+	email.Queue([]models.Alert{criticalAlert("DiskFull"), warning})
+	email.Queue([]models.Alert{criticalAlert("NodeDown")})
+
+	select {
+	case got := <-sends:
+		if got.subject != "[Notificator] 2 critical alerts firing" {
+			t.Errorf("unexpected subject %q", got.subject)
+		}
+		if !strings.Contains(got.body, "DiskFull") || !strings.Contains(got.body, "NodeDown") {
+			t.Errorf("expected both critical alerts in the digest, got %q", got.body)
+		}
+		if strings.Contains(got.body, "HighLatency") {
+			t.Error("expected the warning alert to be left out")
+		}
+	case <-time.After(3 * time.Second):
+		t.Fatal("expected a digest email after the batch window")
+	}
+
+	select {
+	case <-sends:
+		t.Error("expected a single email for the batch")
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestEncodeSubject_StripsLineBreaksAndEncodesNonASCII(t *testing.T) {
+	alert := criticalAlert("DiskFull\r\nBcc: attacker@example.com")
+	subject, _ := buildDigest([]models.Alert{alert})
+
+	encoded := encodeSubject(subject)
+	if strings.ContainsAny(encoded, "\r\n") {
+		t.Errorf("expected CR/LF to be stripped, got %q", encoded)
+	}
+	if encoded != "[Notificator] DiskFullBcc: attacker@example.com is firing" {
+		t.Errorf("unexpected subject %q", encoded)
+	}
+
+	encoded = encodeSubject("[Notificator] Disque plein sur nœud-1 is firing")
+	if !strings.HasPrefix(encoded, "=?utf-8?q?") {
+		t.Errorf("expected a non-ASCII subject to be RFC 2047 encoded, got %q", encoded)
+	}
+	if decoded, err := new(mime.WordDecoder).DecodeHeader(encoded); err != nil || decoded != "[Notificator] Disque plein sur nœud-1 is firing" {
+		t.Errorf("expected the encoded subject to decode back, got %q (err=%v)", decoded, err)
+	}
+}
+
+// fakeSMTPServer accepts one connection and rejects any AUTH attempt
+func fakeSMTPServer(t *testing.T) (string, string) {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	t.Cleanup(func() { listener.Close() })
+
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		reader := bufio.NewReader(conn)
+		conn.Write([]byte("220 fake ESMTP\r\n"))
+		for {
+			line, err := reader.ReadString('\n')
+			if err != nil {
+				return
+			}
+			switch {
+			case strings.HasPrefix(line, "EHLO"):
+				conn.Write([]byte("250-fake\r\n250 AUTH PLAIN\r\n"))
+			case strings.HasPrefix(line, "AUTH"):
+				conn.Write([]byte("535 5.7.8 Authentication credentials invalid\r\n"))
+			case strings.HasPrefix(line, "QUIT"):
+				conn.Write([]byte("221 bye\r\n"))
+				return
+			default:
+				conn.Write([]byte("250 ok\r\n"))
+			}
+		}
+	}()
+
+	host, port, _ := net.SplitHostPort(listener.Addr().String())
+	return host, port
+}
+
+func TestEmailNotifier_ReportsAuthFailure(t *testing.T) {
+	host, port := fakeSMTPServer(t)
+	portNumber, _ := strconv.Atoi(port)
+
+	email := NewEmailNotifier(EmailConfig{
+		Enabled:  true,
+		Host:     host,
+		Port:     portNumber,
+		Username: "alerts",
+		Password: "wrong",
+		From:     "alerts@example.com",
+		To:       []string{"oncall@example.com"},
+	})
+
+	email.Queue([]models.Alert{criticalAlert("DiskFull")})
+	err := email.Flush()
+	if err == nil {
+		t.Fatal("expected an error when SMTP authentication fails")
+	}
+	if !strings.Contains(err.Error(), `SMTP authentication as "alerts" failed`) {
+		t.Errorf("expected a clear authentication error, got %v", err)
+	}
+}
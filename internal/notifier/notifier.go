@@ -18,16 +18,25 @@ import (
 
 // NotificationConfig holds notification settings
 type NotificationConfig struct {
-	Enabled           bool            `json:"enabled"`
-	SoundEnabled      bool            `json:"sound_enabled"`
-	SoundPath         string          `json:"sound_path"`
-	AudioOutputDevice string          `json:"audio_output_device"`
-	ShowSystem        bool            `json:"show_system"`
-	CriticalOnly      bool            `json:"critical_only"`
-	MaxNotifications  int             `json:"max_notifications"`
-	CooldownSeconds   int             `json:"cooldown_seconds"`
-	SeverityRules     map[string]bool `json:"severity_rules"`
-	RespectFilters    bool            `json:"respect_filters"`
+	Enabled      bool   `json:"enabled"`
+	SoundEnabled bool   `json:"sound_enabled"`
+	SoundPath    string `json:"sound_path"`
+	// SeveritySounds maps a severity to its own sound file; severities not
+	// listed use SoundPath
+	SeveritySounds    map[string]string `json:"severity_sounds"`
+	AudioOutputDevice string            `json:"audio_output_device"`
+	ShowSystem        bool              `json:"show_system"`
+	CriticalOnly      bool              `json:"critical_only"`
+	MaxNotifications  int               `json:"max_notifications"`
+	CooldownSeconds   int               `json:"cooldown_seconds"`
+	// FingerprintCooldownSeconds suppresses repeat notifications for the same
+	// alert fingerprint while it keeps firing; cleared when the alert resolves
+	FingerprintCooldownSeconds int             `json:"fingerprint_cooldown_seconds"`
+	SeverityRules              map[string]bool `json:"severity_rules"`
+	RespectFilters             bool            `json:"respect_filters"`
+	Slack                      SlackConfig     `json:"slack"`
+	Email                      EmailConfig     `json:"email"`
+	PagerDuty                  PagerDutyConfig `json:"pagerduty"`
 }
 
 // FilterState represents the current UI filter state
@@ -45,8 +54,13 @@ type Notifier struct {
 	config            NotificationConfig
 	app               fyne.App
 	lastNotifications map[string]time.Time
-	mutex             sync.RWMutex
-	soundPlayer       SoundPlayer
+	// notifiedFingerprints holds when each still-firing alert was last notified
+	notifiedFingerprints map[string]time.Time
+	mutex                sync.RWMutex
+	soundPlayer          SoundPlayer
+	slack                *SlackNotifier
+	email                *EmailNotifier
+	pagerDuty            *PagerDutyNotifier
 
 	currentFilters *FilterState
 	filterMutex    sync.RWMutex
@@ -70,6 +84,9 @@ func NewNotifier(config NotificationConfig, app fyne.App) *Notifier {
 	if config.CooldownSeconds == 0 {
 		config.CooldownSeconds = 300 // 5 minutes default cooldown
 	}
+	if config.FingerprintCooldownSeconds == 0 {
+		config.FingerprintCooldownSeconds = 3600 // 1 hour default per-alert cooldown
+	}
 	if config.SeverityRules == nil {
 		config.SeverityRules = map[string]bool{
 			"critical": true,
@@ -92,12 +109,32 @@ func NewNotifier(config NotificationConfig, app fyne.App) *Notifier {
 		soundPlayer = &DefaultSoundPlayer{}
 	}
 
+	var slack *SlackNotifier
+	if config.Slack.Enabled && config.Slack.WebhookURL != "" {
+		slack = NewSlackNotifier(config.Slack)
+	}
+
+	var email *EmailNotifier
+	if config.Email.Enabled && config.Email.Host != "" {
+		email = NewEmailNotifier(config.Email)
+	}
+
+	// PagerDuty is skipped entirely without a routing key
+	var pagerDuty *PagerDutyNotifier
+	if config.PagerDuty.Enabled && config.PagerDuty.RoutingKey != "" {
+		pagerDuty = NewPagerDutyNotifier(config.PagerDuty)
+	}
+
 	return &Notifier{
-		config:            config,
-		app:               app,
-		lastNotifications: make(map[string]time.Time),
-		soundPlayer:       soundPlayer,
-		currentFilters:    &FilterState{}, // Initialize with empty filters
+		config:               config,
+		app:                  app,
+		lastNotifications:    make(map[string]time.Time),
+		notifiedFingerprints: make(map[string]time.Time),
+		soundPlayer:          soundPlayer,
+		slack:                slack,
+		email:                email,
+		pagerDuty:            pagerDuty,
+		currentFilters:       &FilterState{}, // Initialize with empty filters
 	}
 }
 
@@ -108,6 +145,19 @@ func (n *Notifier) UpdateFilters(filters FilterState) {
 	n.currentFilters = &filters
 }
 
+// severityEnabled reports whether alerts of this severity may notify
+func (n *Notifier) severityEnabled(severity string) bool {
+	if enabled, exists := n.config.SeverityRules[severity]; !enabled || !exists {
+		return false
+	}
+	return !n.config.CriticalOnly || severity == "critical"
+}
+
+// soundEnabled reports whether notifications play a sound
+func (n *Notifier) soundEnabled() bool {
+	return n.config.SoundEnabled
+}
+
 // GetCurrentFilters returns a copy of the current filter state
 func (n *Notifier) GetCurrentFilters() FilterState {
 	n.filterMutex.RLock()
@@ -187,6 +237,12 @@ func (n *Notifier) ProcessAlerts(newAlerts []models.Alert, previousAlerts []mode
 		return
 	}
 
+	// PagerDuty follows every alert's lifecycle regardless of UI filters and
+	// cooldowns, so it sees the full list
+	if n.pagerDuty != nil {
+		n.pagerDuty.Enqueue(newAlerts)
+	}
+
 	// Create maps for efficient lookup
 	prevAlertsMap := make(map[string]models.Alert)
 	for _, alert := range previousAlerts {
@@ -194,6 +250,8 @@ func (n *Notifier) ProcessAlerts(newAlerts []models.Alert, previousAlerts []mode
 		prevAlertsMap[key] = alert
 	}
 
+	n.forgetResolvedFingerprints(newAlerts)
+
 	// Check for new or escalated alerts
 	var notifiableAlerts []models.Alert
 
@@ -209,6 +267,10 @@ func (n *Notifier) ProcessAlerts(newAlerts []models.Alert, previousAlerts []mode
 			continue
 		}
 
+		if n.recentlyNotified(alert.GetFingerprint()) {
+			continue
+		}
+
 		// Check if this is a new alert or status change
 		if prevAlert, exists := prevAlertsMap[key]; exists {
 			// Check if alert escalated (e.g., warning -> critical)
@@ -223,10 +285,44 @@ func (n *Notifier) ProcessAlerts(newAlerts []models.Alert, previousAlerts []mode
 		}
 	}
 
+	// Digest emails take every qualifying alert, not just the first MaxNotifications
+	if n.email != nil {
+		n.email.Queue(notifiableAlerts)
+	}
+
 	// Send notifications for qualifying alerts
 	n.sendNotifications(notifiableAlerts)
 }
 
+// forgetResolvedFingerprints clears the per-fingerprint cooldown of alerts that
+// resolved or are no longer reported, so they notify again if they re-fire
+func (n *Notifier) forgetResolvedFingerprints(alerts []models.Alert) {
+	firing := make(map[string]bool, len(alerts))
+	for _, alert := range alerts {
+		if alert.Status.State != "resolved" {
+			firing[alert.GetFingerprint()] = true
+		}
+	}
+
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	for fingerprint := range n.notifiedFingerprints {
+		if !firing[fingerprint] {
+			delete(n.notifiedFingerprints, fingerprint)
+		}
+	}
+}
+
+// recentlyNotified reports whether the alert with this fingerprint was
+// notified within the per-fingerprint cooldown
+func (n *Notifier) recentlyNotified(fingerprint string) bool {
+	n.mutex.RLock()
+	lastNotif, exists := n.notifiedFingerprints[fingerprint]
+	n.mutex.RUnlock()
+
+	return exists && time.Since(lastNotif) < time.Duration(n.config.FingerprintCooldownSeconds)*time.Second
+}
+
 // shouldNotify determines if an alert should trigger a notification
 func (n *Notifier) shouldNotify(alert models.Alert) bool {
 	// Don't notify for silenced alerts
@@ -240,12 +336,7 @@ func (n *Notifier) shouldNotify(alert models.Alert) bool {
 	}
 
 	// Check severity rules
-	if enabled, exists := n.config.SeverityRules[alert.GetSeverity()]; !enabled || !exists {
-		return false
-	}
-
-	// Critical only mode
-	if n.config.CriticalOnly && alert.GetSeverity() != "critical" {
+	if !n.severityEnabled(alert.GetSeverity()) {
 		return false
 	}
 
@@ -262,15 +353,16 @@ func (n *Notifier) shouldNotify(alert models.Alert) bool {
 	return true
 }
 
+// severityOrder ranks severities from least to most urgent
+var severityOrder = map[string]int{
+	"info":             1,
+	"warning":          2,
+	"critical-daytime": 3,
+	"critical":         4,
+}
+
 // isEscalation checks if an alert has escalated in severity
 func (n *Notifier) isEscalation(oldAlert, newAlert models.Alert) bool {
-	severityOrder := map[string]int{
-		"info":             1,
-		"warning":          2,
-		"critical-daytime": 3,
-		"critical":         4,
-	}
-
 	oldSev := severityOrder[oldAlert.GetSeverity()]
 	newSev := severityOrder[newAlert.GetSeverity()]
 
@@ -283,6 +375,22 @@ func (n *Notifier) sendNotifications(alerts []models.Alert) {
 		return
 	}
 
+	// Record the notifications before sending so an overlapping refresh
+	// can't notify the same alert twice
+	now := time.Now()
+	n.mutex.Lock()
+	for _, alert := range alerts {
+		n.lastNotifications[n.getAlertKey(alert)] = now
+		n.notifiedFingerprints[alert.GetFingerprint()] = now
+	}
+	n.mutex.Unlock()
+
+	// Like the email digest, Slack gets every qualifying alert: the next
+	// refresh treats them as known, so a capped one would never be posted
+	if n.slack != nil {
+		go n.postToSlack(alerts)
+	}
+
 	// Limit number of simultaneous notifications
 	if len(alerts) > n.config.MaxNotifications {
 		alerts = alerts[:n.config.MaxNotifications]
@@ -293,22 +401,27 @@ func (n *Notifier) sendNotifications(alerts []models.Alert) {
 	}
 }
 
+// postToSlack posts the alerts that reach the Slack threshold, one at a time
+func (n *Notifier) postToSlack(alerts []models.Alert) {
+	for _, alert := range alerts {
+		if !n.slack.Accepts(alert) {
+			continue
+		}
+		if err := n.slack.Notify(alert); err != nil {
+			log.Printf("Failed to send Slack notification for %s: %v", alert.GetAlertName(), err)
+		}
+	}
+}
+
 // sendSingleNotification sends a notification for a single alert
 func (n *Notifier) sendSingleNotification(alert models.Alert) {
-	key := n.getAlertKey(alert)
-
-	// Update last notification time
-	n.mutex.Lock()
-	n.lastNotifications[key] = time.Now()
-	n.mutex.Unlock()
-
 	// Send system notification
 	if n.config.ShowSystem {
 		n.sendSystemNotification(alert)
 	}
 
 	// Play sound
-	if n.config.SoundEnabled {
+	if n.soundEnabled() {
 		n.playAlertSound(alert)
 	}
 
@@ -322,6 +435,11 @@ func (n *Notifier) sendSingleNotification(alert models.Alert) {
 
 // sendSystemNotification sends an enhanced system notification with rich visual indicators
 func (n *Notifier) sendSystemNotification(alert models.Alert) {
+	// The server-side notifier has no desktop app to notify through
+	if n.app == nil {
+		return
+	}
+
 	var title string
 
 	// Enhanced title with severity context and visual indicators
@@ -394,8 +512,8 @@ func (n *Notifier) sendSystemNotification(alert models.Alert) {
 
 // playAlertSound plays a sound for the alert
 func (n *Notifier) playAlertSound(alert models.Alert) {
-	if n.config.SoundPath != "" {
-		if err := n.soundPlayer.PlaySound(n.config.SoundPath); err != nil {
+	if soundPath := n.soundPathFor(alert.GetSeverity()); soundPath != "" {
+		if err := n.soundPlayer.PlaySound(soundPath); err != nil {
 			log.Printf("Failed to play custom sound: %v", err)
 			// Fallback to default sound
 			n.soundPlayer.PlayDefaultSound(alert.GetSeverity())
@@ -408,6 +526,15 @@ func (n *Notifier) playAlertSound(alert models.Alert) {
 	}
 }
 
+// soundPathFor returns the sound configured for a severity, falling back to
+// the default sound path
+func (n *Notifier) soundPathFor(severity string) string {
+	if path := n.config.SeveritySounds[severity]; path != "" {
+		return path
+	}
+	return n.config.SoundPath
+}
+
 // getAlertKey creates a unique key for an alert
 func (n *Notifier) getAlertKey(alert models.Alert) string {
 	return fmt.Sprintf("%s_%s_%s",
@@ -537,14 +664,15 @@ func GetDefaultSoundPath() string {
 // CreateDefaultNotificationConfig returns a default notification configuration
 func CreateDefaultNotificationConfig() NotificationConfig {
 	return NotificationConfig{
-		Enabled:           true,
-		SoundEnabled:      true,
-		SoundPath:         GetDefaultSoundPath(),
-		AudioOutputDevice: "default",
-		ShowSystem:        true,
-		CriticalOnly:      false,
-		MaxNotifications:  5,
-		CooldownSeconds:   300, // 5 minutes
+		Enabled:                    true,
+		SoundEnabled:               true,
+		SoundPath:                  GetDefaultSoundPath(),
+		AudioOutputDevice:          "default",
+		ShowSystem:                 true,
+		CriticalOnly:               false,
+		MaxNotifications:           5,
+		CooldownSeconds:            300,  // 5 minutes
+		FingerprintCooldownSeconds: 3600, // 1 hour
 		SeverityRules: map[string]bool{
 			"critical": true,
 			"warning":  true,
@@ -0,0 +1,108 @@
+package notifier
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"notificator/internal/models"
+)
+
+func newTestNotifier() *Notifier {
+	config := CreateDefaultNotificationConfig()
+	config.ShowSystem = false
+	config.SoundEnabled = false
+	config.RespectFilters = false
+	return NewNotifier(config, nil)
+}
+
+func firingAlert(name string) models.Alert {
+	return models.Alert{
+		Labels:   map[string]string{"alertname": name, "severity": "critical"},
+		StartsAt: time.Now(),
+		Status:   models.AlertStatus{State: "active"},
+	}
+}
+
+func (n *Notifier) notifiedAt(alert models.Alert) (time.Time, bool) {
+	n.mutex.RLock()
+	defer n.mutex.RUnlock()
+	at, ok := n.notifiedFingerprints[alert.GetFingerprint()]
+	return at, ok
+}
+
+func TestProcessAlerts_SuppressesRepeatsPerFingerprint(t *testing.T) {
+	n := newTestNotifier()
+	alert := firingAlert("DiskFull")
+
+	n.ProcessAlerts([]models.Alert{alert}, nil)
+	first, ok := n.notifiedAt(alert)
+	if !ok {
+		t.Fatal("expected the new alert to be notified")
+	}
+
+	// Expire the global cooldown; the per-fingerprint one must still hold
+	n.mutex.Lock()
+	n.lastNotifications = make(map[string]time.Time)
+	n.mutex.Unlock()
+
+	n.ProcessAlerts([]models.Alert{alert}, nil)
+	if again, _ := n.notifiedAt(alert); !again.Equal(first) {
+		t.Error("expected the still-firing alert not to be notified again within the cooldown")
+	}
+}
+
+func TestProcessAlerts_ResolveClearsFingerprintCooldown(t *testing.T) {
+	n := newTestNotifier()
+	alert := firingAlert("DiskFull")
+
+	n.ProcessAlerts([]models.Alert{alert}, nil)
+
+	resolved := alert
+	resolved.Status = models.AlertStatus{State: "resolved"}
+	n.ProcessAlerts([]models.Alert{resolved}, []models.Alert{alert})
+	if _, ok := n.notifiedAt(alert); ok {
+		t.Fatal("expected the cooldown entry to be cleared once the alert resolved")
+	}
+
+	n.mutex.Lock()
+	n.lastNotifications = make(map[string]time.Time)
+	n.mutex.Unlock()
+
+	n.ProcessAlerts([]models.Alert{alert}, nil)
+	if _, ok := n.notifiedAt(alert); !ok {
+		t.Error("expected a re-fired alert to notify again")
+	}
+}
+
+func TestProcessAlerts_PostsEveryAlertToSlack(t *testing.T) {
+	posts := make(chan struct{}, 10)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		posts <- struct{}{}
+	}))
+	defer server.Close()
+
+	config := CreateDefaultNotificationConfig()
+	config.ShowSystem = false
+	config.SoundEnabled = false
+	config.RespectFilters = false
+	config.MaxNotifications = 2
+	config.Slack = SlackConfig{Enabled: true, WebhookURL: server.URL}
+	n := NewNotifier(config, nil)
+
+	var alerts []models.Alert
+	for i := 0; i < 4; i++ {
+		alerts = append(alerts, firingAlert(fmt.Sprintf("DiskFull%d", i)))
+	}
+	n.ProcessAlerts(alerts, nil)
+
+	for i := 0; i < len(alerts); i++ {
+		select {
+		case <-posts:
+		case <-time.After(5 * time.Second):
+			t.Fatalf("expected every alert past MaxNotifications to reach Slack, got %d posts", i)
+		}
+	}
+}
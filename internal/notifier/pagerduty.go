@@ -0,0 +1,255 @@
+package notifier
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"sync"
+	"time"
+
+	"notificator/internal/models"
+)
+
+const defaultPagerDutyEventsURL = "https://events.pagerduty.com/v2/enqueue"
+
+// PagerDutyConfig holds the PagerDuty Events API v2 settings
+type PagerDutyConfig struct {
+	Enabled    bool   `json:"enabled"`
+	RoutingKey string `json:"routing_key"`
+	// SeverityMap maps alert severities to PagerDuty severities (critical,
+	// error, warning, info); unmapped severities are sent as critical
+	SeverityMap map[string]string `json:"severity_map"`
+	// EventsURL overrides the Events API endpoint
+	EventsURL string `json:"events_url"`
+}
+
+// PagerDutyNotifier opens a PagerDuty incident when a critical alert fires and
+// resolves it once the alert clears. Incidents are keyed by the alert's
+// fingerprint.
+type PagerDutyNotifier struct {
+	routingKey  string
+	severityMap map[string]string
+	eventsURL   string
+	client      *http.Client
+
+	// open holds the alerts we have triggered an incident for; only Process
+	// touches it. It starts empty, so the first list rebuilds it (see
+	// adoptOpenIncidents). unconfirmed marks adopted entries we never
+	// triggered, which are triggered once their alert is unsilenced.
+	open        map[string]models.Alert
+	unconfirmed map[string]bool
+	adopted     bool
+
+	// pending is the latest alert list handed to Enqueue that the worker has
+	// not processed yet, guarded by mutex
+	mutex      sync.Mutex
+	pending    []models.Alert
+	hasPending bool
+	wake       chan struct{}
+	workerOnce sync.Once
+}
+
+type pagerDutyEvent struct {
+	RoutingKey  string            `json:"routing_key"`
+	EventAction string            `json:"event_action"`
+	DedupKey    string            `json:"dedup_key"`
+	Payload     *pagerDutyPayload `json:"payload,omitempty"`
+	Links       []pagerDutyLink   `json:"links,omitempty"`
+}
+
+type pagerDutyPayload struct {
+	Summary       string            `json:"summary"`
+	Source        string            `json:"source"`
+	Severity      string            `json:"severity"`
+	Timestamp     string            `json:"timestamp"`
+	Component     string            `json:"component,omitempty"`
+	Group         string            `json:"group,omitempty"`
+	CustomDetails map[string]string `json:"custom_details,omitempty"`
+}
+
+type pagerDutyLink struct {
+	Href string `json:"href"`
+	Text string `json:"text"`
+}
+
+// NewPagerDutyNotifier creates a PagerDuty notifier from its configuration
+func NewPagerDutyNotifier(config PagerDutyConfig) *PagerDutyNotifier {
+	severityMap := config.SeverityMap
+	if severityMap == nil {
+		severityMap = map[string]string{
+			"critical":         "critical",
+			"critical-daytime": "error",
+			"warning":          "warning",
+			"info":             "info",
+		}
+	}
+
+	eventsURL := config.EventsURL
+	if eventsURL == "" {
+		eventsURL = defaultPagerDutyEventsURL
+	}
+
+	return &PagerDutyNotifier{
+		routingKey:  config.RoutingKey,
+		severityMap: severityMap,
+		eventsURL:   eventsURL,
+		client:      &http.Client{Timeout: 10 * time.Second},
+		open:        make(map[string]models.Alert),
+		unconfirmed: make(map[string]bool),
+		wake:        make(chan struct{}, 1),
+	}
+}
+
+// Enqueue hands the alert list to a single background worker without waiting
+// for PagerDuty. Lists are processed in order; when the worker falls behind,
+// only the latest list is kept since it supersedes the older ones.
+func (p *PagerDutyNotifier) Enqueue(alerts []models.Alert) {
+	p.workerOnce.Do(func() { go p.run() })
+
+	p.mutex.Lock()
+	p.pending = alerts
+	p.hasPending = true
+	p.mutex.Unlock()
+
+	select {
+	case p.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (p *PagerDutyNotifier) run() {
+	for range p.wake {
+		p.mutex.Lock()
+		alerts, ok := p.pending, p.hasPending
+		p.pending, p.hasPending = nil, false
+		p.mutex.Unlock()
+
+		if ok {
+			p.Process(alerts)
+		}
+	}
+}
+
+// Process triggers incidents for newly firing critical alerts and resolves the
+// ones whose alert is no longer firing. Failed events are retried on the next
+// call. Calls must not overlap; use Enqueue from concurrent callers.
+func (p *PagerDutyNotifier) Process(alerts []models.Alert) {
+	if !p.adopted {
+		p.adoptOpenIncidents(alerts)
+		p.adopted = true
+	}
+
+	firing := make(map[string]bool, len(alerts))
+	for _, alert := range alerts {
+		if alert.Status.State == "resolved" {
+			continue
+		}
+		fingerprint := alert.GetFingerprint()
+		firing[fingerprint] = true
+
+		if _, open := p.open[fingerprint]; (open && !p.unconfirmed[fingerprint]) || alert.IsSilenced() || alert.GetSeverity() != "critical" {
+			continue
+		}
+		if err := p.send(p.triggerEvent(alert)); err != nil {
+			log.Printf("Failed to trigger PagerDuty incident for %s: %v", alert.GetAlertName(), err)
+			continue
+		}
+		p.open[fingerprint] = alert
+		delete(p.unconfirmed, fingerprint)
+	}
+
+	for fingerprint, alert := range p.open {
+		if firing[fingerprint] {
+			continue
+		}
+		event := pagerDutyEvent{RoutingKey: p.routingKey, EventAction: "resolve", DedupKey: fingerprint}
+		if err := p.send(event); err != nil {
+			log.Printf("Failed to resolve PagerDuty incident for %s: %v", alert.GetAlertName(), err)
+			continue
+		}
+		delete(p.open, fingerprint)
+		delete(p.unconfirmed, fingerprint)
+	}
+}
+
+// adoptOpenIncidents recovers the incidents a previous run may have left open.
+// Unsilenced firing critical alerts need nothing: Process triggers them again
+// and PagerDuty folds the event into the open incident with the same dedup key.
+// Silenced and resolved critical alerts are adopted into open so Process
+// resolves them once they are not firing; PagerDuty ignores a resolve for a
+// dedup key without an open incident. Alerts that disappeared while we were
+// down can't be recovered.
+func (p *PagerDutyNotifier) adoptOpenIncidents(alerts []models.Alert) {
+	for _, alert := range alerts {
+		if alert.GetSeverity() != "critical" {
+			continue
+		}
+		if alert.Status.State == "resolved" || alert.IsSilenced() {
+			p.open[alert.GetFingerprint()] = alert
+			p.unconfirmed[alert.GetFingerprint()] = true
+		}
+	}
+}
+
+func (p *PagerDutyNotifier) triggerEvent(alert models.Alert) pagerDutyEvent {
+	severity := p.severityMap[alert.GetSeverity()]
+	if severity == "" {
+		severity = "critical"
+	}
+
+	summary := alert.GetAlertName()
+	if s, ok := models.ResolveSummary(alert.Annotations, models.DefaultSummaryAnnotations); ok {
+		summary += ": " + s
+	}
+	// PagerDuty truncates summaries at 1024 characters
+	if runes := []rune(summary); len(runes) > 1024 {
+		summary = string(runes[:1024])
+	}
+
+	// PagerDuty requires a source; GetSource falls back to "unknown"
+	source := alert.Labels["instance"]
+	if source == "" {
+		source = alert.GetSource()
+	}
+
+	event := pagerDutyEvent{
+		RoutingKey:  p.routingKey,
+		EventAction: "trigger",
+		DedupKey:    alert.GetFingerprint(),
+		Payload: &pagerDutyPayload{
+			Summary:       summary,
+			Source:        source,
+			Severity:      severity,
+			Timestamp:     alert.StartsAt.Format(time.RFC3339),
+			Component:     alert.Labels["job"],
+			Group:         alert.Labels["team"],
+			CustomDetails: alert.Labels,
+		},
+	}
+	if alert.GeneratorURL != "" {
+		event.Links = []pagerDutyLink{{Href: alert.GeneratorURL, Text: "Source"}}
+	}
+
+	return event
+}
+
+func (p *PagerDutyNotifier) send(event pagerDutyEvent) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to encode PagerDuty event: %w", err)
+	}
+
+	resp, err := p.client.Post(p.eventsURL, "application/json", bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("failed to send PagerDuty event: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusAccepted {
+		return fmt.Errorf("PagerDuty returned status %d", resp.StatusCode)
+	}
+
+	return nil
+}
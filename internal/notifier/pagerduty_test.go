@@ -0,0 +1,168 @@
+package notifier
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"notificator/internal/models"
+)
+
+func TestPagerDutyNotifier_TriggersAndResolves(t *testing.T) {
+	var events []pagerDutyEvent
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var event pagerDutyEvent
+		if err := json.NewDecoder(r.Body).Decode(&event); err != nil {
+			t.Errorf("failed to decode event: %v", err)
+		}
+		events = append(events, event)
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	pagerDuty := NewPagerDutyNotifier(PagerDutyConfig{Enabled: true, RoutingKey: "key", EventsURL: server.URL})
+
+	critical := criticalAlert("DiskFull")
+	warning := criticalAlert("HighLatency")
+	warning.Labels["severity"] = "warning"
+
+	pagerDuty.Process([]models.Alert{critical, warning})
+	pagerDuty.Process([]models.Alert{critical, warning})
+
+	if len(events) != 1 {
+		t.Fatalf("expected one trigger event, got %d", len(events))
+	}
+	trigger := events[0]
+	if trigger.EventAction != "trigger" || trigger.DedupKey != critical.GetFingerprint() || trigger.RoutingKey != "key" {
+		t.Errorf("unexpected trigger event %+v", trigger)
+	}
+	if trigger.Payload == nil || trigger.Payload.Severity != "critical" || trigger.Payload.Summary != "DiskFull" {
+		t.Errorf("unexpected trigger payload %+v", trigger.Payload)
+	}
+
+	pagerDuty.Process([]models.Alert{warning})
+
+	if len(events) != 2 {
+		t.Fatalf("expected a resolve event once the alert cleared, got %d events", len(events))
+	}
+	resolve := events[1]
+	if resolve.EventAction != "resolve" || resolve.DedupKey != critical.GetFingerprint() || resolve.Payload != nil {
+		t.Errorf("unexpected resolve event %+v", resolve)
+	}
+}
+
+func TestPagerDutyNotifier_FirstListRebuildsOpenIncidents(t *testing.T) {
+	var events []pagerDutyEvent
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var event pagerDutyEvent
+		if err := json.NewDecoder(r.Body).Decode(&event); err != nil {
+			t.Errorf("failed to decode event: %v", err)
+		}
+		events = append(events, event)
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	pagerDuty := NewPagerDutyNotifier(PagerDutyConfig{Enabled: true, RoutingKey: "key", EventsURL: server.URL})
+
+	// Incidents a previous run opened for these alerts must not be orphaned
+	resolved := criticalAlert("DiskFull")
+	resolved.Status.State = "resolved"
+	silenced := criticalAlert("NodeDown")
+	silenced.Status.SilencedBy = []string{"silence-1"}
+
+	pagerDuty.Process([]models.Alert{resolved, silenced})
+
+	if len(events) != 1 || events[0].EventAction != "resolve" || events[0].DedupKey != resolved.GetFingerprint() {
+		t.Fatalf("expected only a resolve for the resolved alert, got %+v", events)
+	}
+
+	pagerDuty.Process(nil)
+
+	if len(events) != 2 || events[1].EventAction != "resolve" || events[1].DedupKey != silenced.GetFingerprint() {
+		t.Fatalf("expected the silenced alert to be resolved once it cleared, got %+v", events)
+	}
+
+	// An adopted alert whose silence expires is triggered like any other
+	pagerDuty = NewPagerDutyNotifier(PagerDutyConfig{Enabled: true, RoutingKey: "key", EventsURL: server.URL})
+	events = nil
+	pagerDuty.Process([]models.Alert{silenced})
+	pagerDuty.Process([]models.Alert{criticalAlert("NodeDown")})
+
+	if len(events) != 1 || events[0].EventAction != "trigger" {
+		t.Errorf("expected a trigger once the adopted alert was unsilenced, got %+v", events)
+	}
+}
+
+func TestPagerDutyNotifier_RetriesFailedTrigger(t *testing.T) {
+	status := http.StatusInternalServerError
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	pagerDuty := NewPagerDutyNotifier(PagerDutyConfig{Enabled: true, RoutingKey: "key", EventsURL: server.URL})
+	alerts := []models.Alert{criticalAlert("DiskFull")}
+
+	pagerDuty.Process(alerts)
+	status = http.StatusAccepted
+	pagerDuty.Process(alerts)
+	pagerDuty.Process(alerts)
+
+	if calls != 2 {
+		t.Errorf("expected the failed trigger to be retried once, got %d calls", calls)
+	}
+}
+
+func TestPagerDutyNotifier_EnqueueProcessesInOrder(t *testing.T) {
+	events := make(chan pagerDutyEvent, 4)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var event pagerDutyEvent
+		if err := json.NewDecoder(r.Body).Decode(&event); err != nil {
+			t.Errorf("failed to decode event: %v", err)
+		}
+		events <- event
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	pagerDuty := NewPagerDutyNotifier(PagerDutyConfig{Enabled: true, RoutingKey: "key", EventsURL: server.URL})
+	next := func() pagerDutyEvent {
+		select {
+		case event := <-events:
+			return event
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for a PagerDuty event")
+			return pagerDutyEvent{}
+		}
+	}
+
+	pagerDuty.Enqueue([]models.Alert{criticalAlert("DiskFull")})
+	if event := next(); event.EventAction != "trigger" {
+		t.Fatalf("expected the trigger first, got %q", event.EventAction)
+	}
+	pagerDuty.Enqueue(nil)
+	if event := next(); event.EventAction != "resolve" {
+		t.Fatalf("expected the resolve after the trigger, got %q", event.EventAction)
+	}
+}
+
+func TestPagerDutyNotifier_TruncatesSummaryOnRuneBoundary(t *testing.T) {
+	pagerDuty := NewPagerDutyNotifier(PagerDutyConfig{Enabled: true, RoutingKey: "key"})
+	alert := criticalAlert("DiskFull")
+	alert.Annotations = map[string]string{"summary": strings.Repeat("é", 2000)}
+
+	summary := pagerDuty.triggerEvent(alert).Payload.Summary
+	if !utf8.ValidString(summary) {
+		t.Error("truncated summary must remain valid UTF-8")
+	}
+	if n := utf8.RuneCountInString(summary); n != 1024 {
+		t.Errorf("expected the summary cut to 1024 characters, got %d", n)
+	}
+}
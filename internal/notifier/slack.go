@@ -0,0 +1,146 @@
+package notifier
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"notificator/internal/models"
+)
+
+// SlackConfig holds the Slack webhook settings
+type SlackConfig struct {
+	Enabled    bool   `json:"enabled"`
+	WebhookURL string `json:"webhook_url"`
+	// Channel overrides the webhook's default channel when set
+	Channel string `json:"channel"`
+	// MinSeverity is the lowest severity posted to Slack (default "critical")
+	MinSeverity string `json:"min_severity"`
+}
+
+// SlackNotifier posts alerts to a Slack incoming webhook
+type SlackNotifier struct {
+	webhookURL  string
+	channel     string
+	minSeverity string
+	client      *http.Client
+}
+
+type slackMessage struct {
+	Channel     string            `json:"channel,omitempty"`
+	Text        string            `json:"text"`
+	Attachments []slackAttachment `json:"attachments,omitempty"`
+}
+
+type slackAttachment struct {
+	Color     string       `json:"color"`
+	Title     string       `json:"title"`
+	TitleLink string       `json:"title_link,omitempty"`
+	Text      string       `json:"text,omitempty"`
+	Fields    []slackField `json:"fields"`
+	Timestamp int64        `json:"ts"`
+}
+
+type slackField struct {
+	Title string `json:"title"`
+	Value string `json:"value"`
+	Short bool   `json:"short"`
+}
+
+// NewSlackNotifier creates a Slack notifier from its configuration
+func NewSlackNotifier(config SlackConfig) *SlackNotifier {
+	minSeverity := config.MinSeverity
+	if minSeverity == "" {
+		minSeverity = "critical"
+	}
+
+	return &SlackNotifier{
+		webhookURL:  config.WebhookURL,
+		channel:     config.Channel,
+		minSeverity: minSeverity,
+		client:      &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// Accepts reports whether the alert's severity reaches the configured threshold
+func (s *SlackNotifier) Accepts(alert models.Alert) bool {
+	return severityOrder[alert.GetSeverity()] >= severityOrder[s.minSeverity]
+}
+
+// Notify posts a message describing the alert to the webhook
+func (s *SlackNotifier) Notify(alert models.Alert) error {
+	return s.post(s.buildMessage(alert))
+}
+
+// PostText posts a plain mrkdwn message to the webhook. Untrusted parts of
+// text must go through EscapeText first.
+func (s *SlackNotifier) PostText(text string) error {
+	return s.post(slackMessage{Channel: s.channel, Text: text})
+}
+
+// EscapeText escapes the characters Slack treats as control sequences, so
+// text like "<!channel>" is shown rather than acted upon
+func EscapeText(text string) string {
+	return slackEscaper.Replace(text)
+}
+
+var slackEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+
+func (s *SlackNotifier) post(message slackMessage) error {
+	payload, err := json.Marshal(message)
+	if err != nil {
+		return fmt.Errorf("failed to encode Slack message: %w", err)
+	}
+
+	resp, err := s.client.Post(s.webhookURL, "application/json", bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("failed to post to Slack: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("slack webhook returned status %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+func (s *SlackNotifier) buildMessage(alert models.Alert) slackMessage {
+	severity := alert.GetSeverity()
+
+	color := "#439FE0"
+	switch severity {
+	case "critical", "critical-daytime":
+		color = "danger"
+	case "warning":
+		color = "warning"
+	}
+
+	fields := []slackField{
+		{Title: "Severity", Value: severity, Short: true},
+	}
+	if instance := alert.GetInstance(); instance != "" {
+		fields = append(fields, slackField{Title: "Instance", Value: instance, Short: true})
+	}
+	if team := alert.GetTeam(); team != "" {
+		fields = append(fields, slackField{Title: "Team", Value: team, Short: true})
+	}
+
+	return slackMessage{
+		Channel: s.channel,
+		Text:    fmt.Sprintf("[%s] %s is firing", EscapeText(severity), EscapeText(alert.GetAlertName())),
+		Attachments: []slackAttachment{
+			{
+				Color:     color,
+				Title:     alert.GetAlertName(),
+				TitleLink: alert.GeneratorURL,
+				Text:      alert.GetSummary(),
+				Fields:    fields,
+				Timestamp: alert.StartsAt.Unix(),
+			},
+		},
+	}
+}
@@ -0,0 +1,126 @@
+package notifier
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"notificator/internal/models"
+)
+
+func TestSlackNotifier_PostsPayload(t *testing.T) {
+	received := make(chan map[string]interface{}, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if ct := r.Header.Get("Content-Type"); ct != "application/json" {
+			t.Errorf("expected application/json, got %q", ct)
+		}
+		var payload map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Errorf("failed to decode payload: %v", err)
+		}
+		received <- payload
+	}))
+	defer server.Close()
+
+	slack := NewSlackNotifier(SlackConfig{Enabled: true, WebhookURL: server.URL, Channel: "#oncall"})
+	alert := models.Alert{
+		Labels:       map[string]string{"alertname": "DiskFull", "severity": "critical", "instance": "db-1"},
+		Annotations:  map[string]string{"summary": "Disk is 95% full"},
+		GeneratorURL: "http://prometheus/graph?g0.expr=disk",
+		StartsAt:     time.Unix(1700000000, 0),
+		Status:       models.AlertStatus{State: "active"},
+	}
+
+	if err := slack.Notify(alert); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	payload := <-received
+	if payload["channel"] != "#oncall" {
+		t.Errorf("expected channel #oncall, got %v", payload["channel"])
+	}
+	if payload["text"] != "[critical] DiskFull is firing" {
+		t.Errorf("unexpected text %v", payload["text"])
+	}
+
+	attachments, ok := payload["attachments"].([]interface{})
+	if !ok || len(attachments) != 1 {
+		t.Fatalf("expected one attachment, got %v", payload["attachments"])
+	}
+	attachment := attachments[0].(map[string]interface{})
+	if attachment["title"] != "DiskFull" || attachment["title_link"] != alert.GeneratorURL {
+		t.Errorf("unexpected title/link: %v / %v", attachment["title"], attachment["title_link"])
+	}
+	if attachment["text"] != "Disk is 95% full" {
+		t.Errorf("expected the summary as text, got %v", attachment["text"])
+	}
+	if attachment["color"] != "danger" {
+		t.Errorf("expected danger color for critical, got %v", attachment["color"])
+	}
+	if attachment["ts"] != float64(1700000000) {
+		t.Errorf("expected the start time as ts, got %v", attachment["ts"])
+	}
+
+	fields := attachment["fields"].([]interface{})
+	first := fields[0].(map[string]interface{})
+	if first["title"] != "Severity" || first["value"] != "critical" {
+		t.Errorf("expected the severity field first, got %v", first)
+	}
+}
+
+func TestSlackNotifier_Accepts(t *testing.T) {
+	slack := NewSlackNotifier(SlackConfig{MinSeverity: "warning"})
+
+	tests := map[string]bool{
+		"info":     false,
+		"warning":  true,
+		"critical": true,
+	}
+	for severity, want := range tests {
+		alert := models.Alert{Labels: map[string]string{"severity": severity}}
+		if got := slack.Accepts(alert); got != want {
+			t.Errorf("Accepts(%s) = %v, want %v", severity, got, want)
+		}
+	}
+}
+
+func TestSlackNotifier_ReportsWebhookErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	slack := NewSlackNotifier(SlackConfig{WebhookURL: server.URL})
+	alert := models.Alert{Labels: map[string]string{"alertname": "DiskFull", "severity": "critical"}}
+
+	if err := slack.Notify(alert); err == nil {
+		t.Error("expected an error for a non-200 response")
+	}
+}
+
+func TestSlackNotifier_PostTextEscapesUntrustedText(t *testing.T) {
+	received := make(chan map[string]interface{}, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Errorf("failed to decode payload: %v", err)
+		}
+		received <- payload
+	}))
+	defer server.Close()
+
+	slack := NewSlackNotifier(SlackConfig{Enabled: true, WebhookURL: server.URL})
+	if err := slack.PostText("note: " + EscapeText("<!channel> R&D")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	payload := <-received
+	if payload["text"] != "note: &lt;!channel&gt; R&amp;D" {
+		t.Errorf("expected escaped text, got %v", payload["text"])
+	}
+	if _, ok := payload["attachments"]; ok {
+		t.Errorf("expected no attachments, got %v", payload["attachments"])
+	}
+}
@@ -0,0 +1,38 @@
+package client
+
+import (
+	"context"
+	"log"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"notificator/internal/backend/apiversion"
+)
+
+// versionUnaryInterceptor sends the client API version with every call and
+// records the version the backend answers with.
+func (c *BackendClient) versionUnaryInterceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	var header metadata.MD
+	opts = append(opts, grpc.Header(&header))
+
+	err := invoker(apiversion.AppendToOutgoingContext(ctx), method, req, reply, cc, opts...)
+
+	if version, parseErr := apiversion.FromMetadata(header); parseErr == nil && version > 0 {
+		if previous := c.serverAPIVersion.Swap(int32(version)); previous != int32(version) && version < apiversion.Current {
+			log.Printf("⚠️  Backend speaks API v%d, older than this client (v%d); newer fields may be ignored", version, apiversion.Current)
+		}
+	}
+
+	return err
+}
+
+func versionStreamInterceptor(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	return streamer(apiversion.AppendToOutgoingContext(ctx), desc, cc, method, opts...)
+}
+
+// ServerAPIVersion returns the API version negotiated with the backend, or 0
+// when no call has completed yet or the backend predates versioning.
+func (c *BackendClient) ServerAPIVersion() int {
+	return int(c.serverAPIVersion.Load())
+}
@@ -2,12 +2,18 @@ package client
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"encoding/json"
 	"fmt"
+	"os"
+	"sync/atomic"
 	"time"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	alertpb "notificator/internal/backend/proto/alert"
@@ -21,6 +27,14 @@ type BackendClient struct {
 	alertClient      alertpb.AlertServiceClient
 	statisticsClient alertpb.StatisticsServiceClient
 	address          string
+	tls              *TLSOptions  // Nil dials plaintext
+	serverAPIVersion atomic.Int32 // API version advertised by the backend, 0 until the first call
+}
+
+// TLSOptions configures a TLS connection to the backend
+type TLSOptions struct {
+	CAFile             string // PEM CA bundle; system roots when empty
+	InsecureSkipVerify bool   // Accept any server certificate (self-signed dev setups only)
 }
 
 type AuthResult struct {
@@ -39,6 +53,10 @@ type User struct {
 	OAuthProvider *string `json:"oauth_provider,omitempty"`
 	OAuthID       *string `json:"oauth_id,omitempty"`
 	Timezone      *string `json:"timezone,omitempty"`
+	IsAdmin       bool    `json:"is_admin"`
+	Disabled      bool    `json:"disabled"`
+	// SessionExpiresAt is set by ValidateSession when the backend reports it
+	SessionExpiresAt *time.Time `json:"session_expires_at,omitempty"`
 }
 
 // IsOAuthUser returns true if the user was created via OAuth
@@ -52,8 +70,46 @@ func NewBackendClient(address string) *BackendClient {
 	}
 }
 
+// SetTLS makes Connect dial the backend over TLS. Call it before Connect.
+func (c *BackendClient) SetTLS(opts *TLSOptions) {
+	c.tls = opts
+}
+
+func (c *BackendClient) transportCredentials() (credentials.TransportCredentials, error) {
+	if c.tls == nil {
+		return insecure.NewCredentials(), nil
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: c.tls.InsecureSkipVerify,
+	}
+	if c.tls.CAFile != "" {
+		pem, err := os.ReadFile(c.tls.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA file: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in CA file %s", c.tls.CAFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	return credentials.NewTLS(tlsConfig), nil
+}
+
 func (c *BackendClient) Connect() error {
-	conn, err := grpc.NewClient(c.address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	creds, err := c.transportCredentials()
+	if err != nil {
+		return fmt.Errorf("failed to configure backend TLS: %w", err)
+	}
+
+	conn, err := grpc.NewClient(c.address,
+		grpc.WithTransportCredentials(creds),
+		grpc.WithChainUnaryInterceptor(c.versionUnaryInterceptor),
+		grpc.WithChainStreamInterceptor(versionStreamInterceptor),
+	)
 	if err != nil {
 		return fmt.Errorf("failed to connect to backend: %w", err)
 	}
@@ -70,6 +126,7 @@ func (c *BackendClient) IsConnected() bool {
 	return c.conn != nil && c.authClient != nil && c.statisticsClient != nil
 }
 
+// HealthCheck asks the backend whether its database is reachable and migrated
 func (c *BackendClient) HealthCheck() error {
 	if !c.IsConnected() {
 		return fmt.Errorf("not connected to backend")
@@ -78,42 +135,19 @@ func (c *BackendClient) HealthCheck() error {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
-	// Try a simple call to check if backend is responsive
-	_, err := c.authClient.GetProfile(ctx, &authpb.GetProfileRequest{
-		SessionId: "health-check", // This will fail auth but proves connectivity
-	})
-
-	// We expect an auth error, not a connection error
-	if err != nil && !isAuthError(err) {
+	resp, err := c.alertClient.HealthCheck(ctx, &alertpb.HealthCheckRequest{})
+	if err != nil {
 		return fmt.Errorf("backend health check failed: %w", err)
 	}
 
-	return nil
-}
-
-func isAuthError(err error) bool {
-	// Check if error is related to authentication rather than connectivity
-	errStr := err.Error()
-	return contains(errStr, "invalid session") ||
-		contains(errStr, "unauthorized") ||
-		contains(errStr, "session not found") ||
-		contains(errStr, "authentication")
-}
-
-func contains(s, substr string) bool {
-	return len(s) >= len(substr) &&
-		(s == substr || (len(s) > len(substr) &&
-			(s[:len(substr)] == substr || s[len(s)-len(substr):] == substr ||
-				hasSubstring(s, substr))))
-}
-
-func hasSubstring(s, substr string) bool {
-	for i := 0; i <= len(s)-len(substr); i++ {
-		if s[i:i+len(substr)] == substr {
-			return true
+	if resp.Status != "ready" {
+		if resp.Message != "" {
+			return fmt.Errorf("backend is %s (%s database): %s", resp.Status, resp.DatabaseType, resp.Message)
 		}
+		return fmt.Errorf("backend is %s (%s database, migrated: %t)", resp.Status, resp.DatabaseType, resp.Migrated)
 	}
-	return false
+
+	return nil
 }
 
 func (c *BackendClient) Close() error {
@@ -123,13 +157,18 @@ func (c *BackendClient) Close() error {
 	return nil
 }
 
-func (c *BackendClient) Login(username, password string) (*AuthResult, error) {
+// Login authenticates against the backend. clientIP is the browser's address,
+// forwarded so the backend can throttle failed logins per end user.
+func (c *BackendClient) Login(username, password, clientIP string) (*AuthResult, error) {
 	if c.authClient == nil {
 		return nil, fmt.Errorf("not connected to backend")
 	}
 
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
+	if clientIP != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "x-forwarded-for", clientIP)
+	}
 
 	req := &authpb.LoginRequest{
 		Username: username,
@@ -144,6 +183,13 @@ func (c *BackendClient) Login(username, password string) (*AuthResult, error) {
 		}, nil
 	}
 
+	if !resp.Success || resp.User == nil {
+		return &AuthResult{
+			Success: false,
+			Error:   resp.Message,
+		}, nil
+	}
+
 	return &AuthResult{
 		Success:   true,
 		SessionID: resp.SessionId,
@@ -231,6 +277,7 @@ func (c *BackendClient) ValidateSession(sessionID string) (*User, error) {
 		ID:       resp.User.Id,
 		Username: resp.User.Username,
 		Email:    resp.User.Email,
+		IsAdmin:  resp.User.IsAdmin,
 	}
 
 	if resp.User.OauthProvider != "" {
@@ -239,10 +286,109 @@ func (c *BackendClient) ValidateSession(sessionID string) (*User, error) {
 	if resp.User.OauthId != "" {
 		user.OAuthID = &resp.User.OauthId
 	}
+	if resp.ExpiresAt != nil {
+		expiresAt := resp.ExpiresAt.AsTime()
+		user.SessionExpiresAt = &expiresAt
+	}
 
 	return user, nil
 }
 
+// RefreshSession extends the session's expiry on the backend and returns the new expiry
+func (c *BackendClient) RefreshSession(sessionID string) (time.Time, error) {
+	if c.authClient == nil {
+		return time.Time{}, fmt.Errorf("not connected to backend")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	resp, err := c.authClient.RefreshSession(ctx, &authpb.RefreshSessionRequest{
+		SessionId: sessionID,
+	})
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if !resp.Success {
+		return time.Time{}, fmt.Errorf("failed to refresh session: %s", resp.Message)
+	}
+
+	return resp.ExpiresAt.AsTime(), nil
+}
+
+// ChangePassword updates the local password of the session's user. The
+// returned error carries the backend's message when the change is rejected.
+func (c *BackendClient) ChangePassword(sessionID, oldPassword, newPassword string) error {
+	if c.authClient == nil {
+		return fmt.Errorf("not connected to backend")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	resp, err := c.authClient.ChangePassword(ctx, &authpb.ChangePasswordRequest{
+		SessionId:   sessionID,
+		OldPassword: oldPassword,
+		NewPassword: newPassword,
+	})
+	if err != nil {
+		return err
+	}
+
+	if !resp.Success {
+		return fmt.Errorf("%s", resp.Message)
+	}
+
+	return nil
+}
+
+// DeleteAccount permanently removes the session's user and all of their data
+func (c *BackendClient) DeleteAccount(sessionID string) error {
+	if c.authClient == nil {
+		return fmt.Errorf("not connected to backend")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	resp, err := c.authClient.DeleteAccount(ctx, &authpb.DeleteAccountRequest{
+		SessionId: sessionID,
+	})
+	if err != nil {
+		return err
+	}
+
+	if !resp.Success {
+		return fmt.Errorf("failed to delete account: %s", resp.Message)
+	}
+
+	return nil
+}
+
+// ExportUserData returns a JSON document with all data stored for the session's user
+func (c *BackendClient) ExportUserData(sessionID string) ([]byte, error) {
+	if c.authClient == nil {
+		return nil, fmt.Errorf("not connected to backend")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	resp, err := c.authClient.ExportUserData(ctx, &authpb.ExportUserDataRequest{
+		SessionId: sessionID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if !resp.Success {
+		return nil, fmt.Errorf("failed to export user data: %s", resp.Message)
+	}
+
+	return resp.Data, nil
+}
+
 func (c *BackendClient) GetProfile(sessionID string) (*User, error) {
 	if c.authClient == nil {
 		return nil, fmt.Errorf("not connected to backend")
@@ -278,8 +424,10 @@ func (c *BackendClient) GetProfile(sessionID string) (*User, error) {
 
 // Alert acknowledgment and resolution methods
 
-// AddAcknowledgment acknowledges an alert
-func (c *BackendClient) AddAcknowledgment(sessionID, alertKey, reason string) error {
+// AddAcknowledgment acknowledges an alert. A zero expiresAt keeps the
+// acknowledgment until it is removed; renotify asks for the alert to be
+// notified again once it lapses.
+func (c *BackendClient) AddAcknowledgment(sessionID, alertKey, reason string, expiresAt time.Time, renotify bool) error {
 	if c.alertClient == nil {
 		return fmt.Errorf("not connected to backend")
 	}
@@ -292,9 +440,52 @@ func (c *BackendClient) AddAcknowledgment(sessionID, alertKey, reason string) er
 		AlertKey:  alertKey,
 		Reason:    reason,
 	}
+	if !expiresAt.IsZero() {
+		req.ExpiresAt = timestamppb.New(expiresAt)
+		req.Renotify = renotify
+	}
 
-	_, err := c.alertClient.AddAcknowledgment(ctx, req)
-	return err
+	resp, err := c.alertClient.AddAcknowledgment(ctx, req)
+	if err != nil {
+		return err
+	}
+	if !resp.Success {
+		return fmt.Errorf("failed to acknowledge alert: %s", resp.Message)
+	}
+	return nil
+}
+
+// BulkAcknowledge acknowledges several alerts with one reason in a single
+// backend transaction and returns whether each alert key was acknowledged.
+// expiresAt and renotify work as for AddAcknowledgment.
+func (c *BackendClient) BulkAcknowledge(sessionID string, alertKeys []string, reason string, expiresAt time.Time, renotify bool) (map[string]bool, error) {
+	if c.alertClient == nil {
+		return nil, fmt.Errorf("not connected to backend")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	req := &alertpb.BulkAcknowledgeRequest{
+		SessionId: sessionID,
+		AlertKeys: alertKeys,
+		Reason:    reason,
+	}
+	if !expiresAt.IsZero() {
+		req.ExpiresAt = timestamppb.New(expiresAt)
+		req.Renotify = renotify
+	}
+
+	resp, err := c.alertClient.BulkAcknowledge(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	// Partial failures are reported per key, so only a missing result map is an error
+	if resp.Results == nil {
+		return nil, fmt.Errorf("failed to acknowledge alerts: %s", resp.Message)
+	}
+
+	return resp.Results, nil
 }
 
 // DeleteAcknowledgment removes acknowledgment from an alert
@@ -315,6 +506,58 @@ func (c *BackendClient) DeleteAcknowledgment(sessionID, alertKey string) error {
 	return err
 }
 
+// DeleteAcknowledgmentByID removes a single acknowledgment, which must belong
+// to the session's user unless their groups grant ack:delete
+func (c *BackendClient) DeleteAcknowledgmentByID(sessionID, alertKey, acknowledgmentID string) error {
+	if c.alertClient == nil {
+		return fmt.Errorf("not connected to backend")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	req := &alertpb.DeleteAcknowledgmentRequest{
+		SessionId:        sessionID,
+		AlertKey:         alertKey,
+		AcknowledgmentId: acknowledgmentID,
+	}
+
+	resp, err := c.alertClient.DeleteAcknowledgment(ctx, req)
+	if err != nil {
+		return err
+	}
+	if !resp.Success {
+		return fmt.Errorf("failed to delete acknowledgment: %s", resp.Message)
+	}
+
+	return nil
+}
+
+// GetAcknowledgmentsPage retrieves up to limit acknowledgments for an alert,
+// newest first, and the alert's total count. Pass the returned cursor as
+// before to get the next page; an empty cursor means there are no more.
+func (c *BackendClient) GetAcknowledgmentsPage(alertKey string, limit int, before string) ([]*alertpb.Acknowledgment, int, string, error) {
+	if c.alertClient == nil {
+		return nil, 0, "", fmt.Errorf("not connected to backend")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	req := &alertpb.GetAcknowledgmentsRequest{
+		AlertKey: alertKey,
+		Limit:    int32(limit),
+		Before:   before,
+	}
+
+	resp, err := c.alertClient.GetAcknowledgments(ctx, req)
+	if err != nil {
+		return nil, 0, "", err
+	}
+
+	return resp.Acknowledgments, int(resp.TotalCount), resp.NextCursor, nil
+}
+
 // GetAcknowledgments retrieves acknowledgments for an alert
 func (c *BackendClient) GetAcknowledgments(alertKey string) ([]*alertpb.Acknowledgment, error) {
 	if c.alertClient == nil {
@@ -336,9 +579,62 @@ func (c *BackendClient) GetAcknowledgments(alertKey string) ([]*alertpb.Acknowle
 	return resp.Acknowledgments, nil
 }
 
-// GetAllAcknowledgedAlerts retrieves the latest acknowledgment for each of the
-// given alert keys from the backend
-func (c *BackendClient) GetAllAcknowledgedAlerts(alertKeys []string) (map[string]*alertpb.Acknowledgment, error) {
+// EscalateAlert hands an alert over to a user or group
+func (c *BackendClient) EscalateAlert(sessionID, alertKey, escalatedTo, targetType, reason string) (*alertpb.Escalation, error) {
+	if c.alertClient == nil {
+		return nil, fmt.Errorf("not connected to backend")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	req := &alertpb.EscalateAlertRequest{
+		SessionId:   sessionID,
+		AlertKey:    alertKey,
+		EscalatedTo: escalatedTo,
+		TargetType:  targetType,
+		Reason:      reason,
+	}
+
+	resp, err := c.alertClient.EscalateAlert(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if !resp.Success {
+		return nil, fmt.Errorf("%s", resp.Message)
+	}
+
+	return resp.Escalation, nil
+}
+
+// CheckPermission reports whether the session's user holds a group
+// permission. A refusal comes with the backend's message.
+func (c *BackendClient) CheckPermission(sessionID, permission string) (bool, string, error) {
+	if c.alertClient == nil {
+		return false, "", fmt.Errorf("not connected to backend")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := c.alertClient.CheckPermission(ctx, &alertpb.CheckPermissionRequest{
+		SessionId:  sessionID,
+		Permission: permission,
+	})
+	if err != nil {
+		return false, "", err
+	}
+
+	if !resp.Success {
+		return false, "", fmt.Errorf("failed to check permission: %s", resp.Message)
+	}
+
+	return resp.Allowed, resp.Message, nil
+}
+
+// GetEscalations retrieves escalations for an alert, newest first
+func (c *BackendClient) GetEscalations(alertKey string) ([]*alertpb.Escalation, error) {
 	if c.alertClient == nil {
 		return nil, fmt.Errorf("not connected to backend")
 	}
@@ -346,14 +642,135 @@ func (c *BackendClient) GetAllAcknowledgedAlerts(alertKeys []string) (map[string
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
+	req := &alertpb.GetEscalationsRequest{
+		AlertKey: alertKey,
+	}
+
+	resp, err := c.alertClient.GetEscalations(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Escalations, nil
+}
+
+// GetAlertActivity retrieves one page of an alert's timeline, newest first.
+// Pass the returned cursor as before to get the next page; an empty cursor
+// means there are no more entries.
+func (c *BackendClient) GetAlertActivity(alertKey string, limit int, before string) ([]*alertpb.AlertActivity, string, error) {
+	if c.alertClient == nil {
+		return nil, "", fmt.Errorf("not connected to backend")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	req := &alertpb.GetAlertActivityRequest{
+		AlertKey: alertKey,
+		Limit:    int32(limit),
+		Before:   before,
+	}
+
+	resp, err := c.alertClient.GetAlertActivity(ctx, req)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return resp.Activities, resp.NextCursor, nil
+}
+
+// GetAllAcknowledgedAlerts retrieves the latest acknowledgment and the number
+// of acknowledgments for each of the given alert keys from the backend
+func (c *BackendClient) GetAllAcknowledgedAlerts(alertKeys []string) (map[string]*alertpb.Acknowledgment, map[string]int, error) {
+	if c.alertClient == nil {
+		return nil, nil, fmt.Errorf("not connected to backend")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
 	req := &alertpb.GetAllAcknowledgedAlertsRequest{AlertKeys: alertKeys}
 
 	resp, err := c.alertClient.GetAllAcknowledgedAlerts(ctx, req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	counts := make(map[string]int, len(resp.AcknowledgmentCounts))
+	for key, count := range resp.AcknowledgmentCounts {
+		counts[key] = int(count)
+	}
+
+	return resp.AcknowledgedAlerts, counts, nil
+}
+
+// GetCommentsPage retrieves up to limit comments for an alert, newest first,
+// the alert's total comment count and the cursor of the next older page
+func (c *BackendClient) GetCommentsPage(alertKey string, limit int, before string) ([]*alertpb.Comment, int, string, error) {
+	if c.alertClient == nil {
+		return nil, 0, "", fmt.Errorf("not connected to backend")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	req := &alertpb.GetCommentsRequest{
+		AlertKey: alertKey,
+		Limit:    int32(limit),
+		Before:   before,
+	}
+
+	resp, err := c.alertClient.GetComments(ctx, req)
+	if err != nil {
+		return nil, 0, "", err
+	}
+
+	return resp.Comments, int(resp.TotalCount), resp.NextCursor, nil
+}
+
+// GetMaxCommentLength returns the longest comment the backend accepts, in
+// characters
+func (c *BackendClient) GetMaxCommentLength() (int, error) {
+	if c.alertClient == nil {
+		return 0, fmt.Errorf("not connected to backend")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := c.alertClient.GetCommentSettings(ctx, &alertpb.GetCommentSettingsRequest{})
+	if err != nil {
+		return 0, err
+	}
+
+	return int(resp.MaxCommentLength), nil
+}
+
+// GetCommentsByTag retrieves up to limit comments carrying tag, newest first,
+// on one alert or, with an empty alertKey, on every alert
+func (c *BackendClient) GetCommentsByTag(sessionID, tag, alertKey string, limit int) ([]*alertpb.Comment, error) {
+	if c.alertClient == nil {
+		return nil, fmt.Errorf("not connected to backend")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	resp, err := c.alertClient.GetCommentsByTag(ctx, &alertpb.GetCommentsByTagRequest{
+		SessionId: sessionID,
+		Tag:       tag,
+		AlertKey:  alertKey,
+		Limit:     int32(limit),
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	return resp.AcknowledgedAlerts, nil
+	if !resp.Success {
+		return nil, fmt.Errorf("failed to get comments: %s", resp.Message)
+	}
+
+	return resp.Comments, nil
 }
 
 // GetComments retrieves comments for an alert
@@ -374,7 +791,32 @@ func (c *BackendClient) GetComments(alertKey string) ([]*alertpb.Comment, error)
 		return nil, err
 	}
 
-	return resp.Comments, nil
+	return resp.Comments, nil
+}
+
+// SubscribeToAlertUpdates opens a stream of comment and acknowledgment changes
+// for an alert. The stream lives until ctx is cancelled.
+func (c *BackendClient) SubscribeToAlertUpdates(ctx context.Context, sessionID, alertKey string) (alertpb.AlertService_SubscribeToAlertUpdatesClient, error) {
+	if c.alertClient == nil {
+		return nil, fmt.Errorf("not connected to backend")
+	}
+
+	req := &alertpb.SubscribeToAlertUpdatesRequest{
+		SessionId: sessionID,
+		AlertKey:  alertKey,
+	}
+
+	return c.alertClient.SubscribeToAlertUpdates(ctx, req)
+}
+
+// StreamResolvedAlertUpdates opens a stream of resolved alerts being stored
+// or expiring. The stream lives until ctx is cancelled.
+func (c *BackendClient) StreamResolvedAlertUpdates(ctx context.Context, sessionID string) (alertpb.AlertService_StreamResolvedAlertUpdatesClient, error) {
+	if c.alertClient == nil {
+		return nil, fmt.Errorf("not connected to backend")
+	}
+
+	return c.alertClient.StreamResolvedAlertUpdates(ctx, &alertpb.StreamResolvedAlertUpdatesRequest{SessionId: sessionID})
 }
 
 // GetCommentCountsBatch retrieves comment counts for multiple alerts in a single query.
@@ -444,14 +886,24 @@ func (c *BackendClient) DeleteComment(sessionID, commentID string) error {
 		CommentId: commentID,
 	}
 
-	_, err := c.alertClient.DeleteComment(ctx, req)
-	return err
+	resp, err := c.alertClient.DeleteComment(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	if !resp.Success {
+		return fmt.Errorf("failed to delete comment: %s", resp.Message)
+	}
+
+	return nil
 }
 
-// CreateResolvedAlert stores a resolved alert in the backend
-func (c *BackendClient) CreateResolvedAlert(fingerprint, source string, alertData, comments, acknowledgments []byte, ttlHours int) error {
+// CreateResolvedAlert stores a resolved alert in the backend. startsAt
+// identifies the firing, so capturing the same firing again stores nothing;
+// the returned bool is false in that case.
+func (c *BackendClient) CreateResolvedAlert(fingerprint, source string, alertData, comments, acknowledgments []byte, ttlHours int, startsAt time.Time) (bool, error) {
 	if c.alertClient == nil {
-		return fmt.Errorf("not connected to backend")
+		return false, fmt.Errorf("not connected to backend")
 	}
 
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
@@ -464,44 +916,70 @@ func (c *BackendClient) CreateResolvedAlert(fingerprint, source string, alertDat
 		Comments:        comments,
 		Acknowledgments: acknowledgments,
 		TtlHours:        int32(ttlHours),
+		StartsAt:        timestamppb.New(startsAt),
 	}
 
 	resp, err := c.alertClient.CreateResolvedAlert(ctx, req)
 	if err != nil {
-		return err
+		return false, err
 	}
 
 	if !resp.Success {
-		return fmt.Errorf("failed to create resolved alert: %s", resp.Message)
+		return false, fmt.Errorf("failed to create resolved alert: %s", resp.Message)
 	}
 
-	return nil
+	return !resp.AlreadyCaptured, nil
 }
 
 // GetResolvedAlerts retrieves resolved alerts from the backend
 func (c *BackendClient) GetResolvedAlerts(limit, offset int) ([]*alertpb.ResolvedAlertInfo, error) {
+	alerts, _, err := c.SearchResolvedAlerts(ResolvedAlertsQuery{}, limit, offset)
+	return alerts, err
+}
+
+// ResolvedAlertsQuery filters SearchResolvedAlerts; zero fields match everything
+type ResolvedAlertsQuery struct {
+	Source            string
+	FingerprintPrefix string
+	ResolvedAfter     time.Time // Inclusive
+	ResolvedBefore    time.Time // Exclusive
+	SortOrder         string    // "desc" (default) or "asc" by resolution time
+}
+
+// SearchResolvedAlerts retrieves one page of resolved alerts matching query,
+// along with the number of matches across all pages
+func (c *BackendClient) SearchResolvedAlerts(query ResolvedAlertsQuery, limit, offset int) ([]*alertpb.ResolvedAlertInfo, int, error) {
 	if c.alertClient == nil {
-		return nil, fmt.Errorf("not connected to backend")
+		return nil, 0, fmt.Errorf("not connected to backend")
 	}
 
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
 	req := &alertpb.GetResolvedAlertsRequest{
-		Limit:  int32(limit),
-		Offset: int32(offset),
+		Limit:             int32(limit),
+		Offset:            int32(offset),
+		Source:            query.Source,
+		FingerprintPrefix: query.FingerprintPrefix,
+		SortOrder:         query.SortOrder,
+	}
+	if !query.ResolvedAfter.IsZero() {
+		req.ResolvedAfter = timestamppb.New(query.ResolvedAfter)
+	}
+	if !query.ResolvedBefore.IsZero() {
+		req.ResolvedBefore = timestamppb.New(query.ResolvedBefore)
 	}
 
 	resp, err := c.alertClient.GetResolvedAlerts(ctx, req)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
 	if !resp.Success {
-		return nil, fmt.Errorf("failed to get resolved alerts: %s", resp.Message)
+		return nil, 0, fmt.Errorf("failed to get resolved alerts: %s", resp.Message)
 	}
 
-	return resp.ResolvedAlerts, nil
+	return resp.ResolvedAlerts, int(resp.TotalCount), nil
 }
 
 // GetResolvedAlert retrieves a specific resolved alert by fingerprint
@@ -529,10 +1007,38 @@ func (c *BackendClient) GetResolvedAlert(fingerprint string) (*alertpb.ResolvedA
 	return resp.ResolvedAlert, nil
 }
 
-// RemoveAllResolvedAlerts removes all resolved alerts from the backend
-func (c *BackendClient) RemoveAllResolvedAlerts(sessionID string) error {
+// RestoreResolvedAlert copies the comments and acknowledgments stored with the
+// latest resolved capture of fingerprint back onto the live alert, returning
+// how many of each were restored
+func (c *BackendClient) RestoreResolvedAlert(sessionID, fingerprint string) (int, int, error) {
+	if c.alertClient == nil {
+		return 0, 0, fmt.Errorf("not connected to backend")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	resp, err := c.alertClient.RestoreResolvedAlert(ctx, &alertpb.RestoreResolvedAlertRequest{
+		SessionId:   sessionID,
+		Fingerprint: fingerprint,
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if !resp.Success {
+		return 0, 0, fmt.Errorf("failed to restore resolved alert: %s", resp.Message)
+	}
+
+	return int(resp.RestoredComments), int(resp.RestoredAcknowledgments), nil
+}
+
+// RemoveAllResolvedAlerts removes all resolved alerts from the backend. The
+// removal can be undone until undoUntil by passing clearedAt to
+// UndoRemoveAllResolvedAlerts.
+func (c *BackendClient) RemoveAllResolvedAlerts(sessionID string) (clearedAt, undoUntil time.Time, err error) {
 	if !c.IsConnected() {
-		return fmt.Errorf("not connected to backend")
+		return time.Time{}, time.Time{}, fmt.Errorf("not connected to backend")
 	}
 
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
@@ -544,16 +1050,86 @@ func (c *BackendClient) RemoveAllResolvedAlerts(sessionID string) error {
 
 	resp, err := c.alertClient.RemoveAllResolvedAlerts(ctx, req)
 	if err != nil {
-		return fmt.Errorf("failed to remove all resolved alerts: %w", err)
+		return time.Time{}, time.Time{}, fmt.Errorf("failed to remove all resolved alerts: %w", err)
+	}
+
+	if !resp.Success {
+		return time.Time{}, time.Time{}, fmt.Errorf("failed to remove all resolved alerts: %s", resp.Message)
+	}
+
+	return resp.ClearedAt.AsTime(), resp.UndoUntil.AsTime(), nil
+}
+
+// GetResolvedAlertTTLHours returns the resolved alert TTL saved from the
+// WebUI, or 0 when none was saved
+func (c *BackendClient) GetResolvedAlertTTLHours() (int, error) {
+	if c.alertClient == nil {
+		return 0, fmt.Errorf("not connected to backend")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := c.alertClient.GetResolvedAlertSettings(ctx, &alertpb.GetResolvedAlertSettingsRequest{})
+	if err != nil {
+		return 0, err
+	}
+
+	return int(resp.TtlHours), nil
+}
+
+// SaveResolvedAlertTTLHours saves the TTL of the resolved alerts stored from
+// now on
+func (c *BackendClient) SaveResolvedAlertTTLHours(sessionID string, hours int) error {
+	if c.alertClient == nil {
+		return fmt.Errorf("not connected to backend")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	resp, err := c.alertClient.SaveResolvedAlertSettings(ctx, &alertpb.SaveResolvedAlertSettingsRequest{
+		SessionId: sessionID,
+		TtlHours:  int32(hours),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to save resolved alert settings: %w", err)
 	}
 
 	if !resp.Success {
-		return fmt.Errorf("failed to remove all resolved alerts: %s", resp.Message)
+		return fmt.Errorf("failed to save resolved alert settings: %s", resp.Message)
 	}
 
 	return nil
 }
 
+// UndoRemoveAllResolvedAlerts brings back the resolved alerts removed at
+// clearedAt and returns how many were restored
+func (c *BackendClient) UndoRemoveAllResolvedAlerts(sessionID string, clearedAt time.Time) (int, error) {
+	if !c.IsConnected() {
+		return 0, fmt.Errorf("not connected to backend")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	req := &alertpb.UndoClearRequest{
+		SessionId: sessionID,
+		ClearedAt: timestamppb.New(clearedAt),
+	}
+
+	resp, err := c.alertClient.UndoRemoveAllResolvedAlerts(ctx, req)
+	if err != nil {
+		return 0, fmt.Errorf("failed to restore resolved alerts: %w", err)
+	}
+
+	if !resp.Success {
+		return 0, fmt.Errorf("failed to restore resolved alerts: %s", resp.Message)
+	}
+
+	return int(resp.RestoredCount), nil
+}
+
 // Color Preference methods
 
 // GetUserColorPreferences retrieves user color preferences from the backend
@@ -888,12 +1464,72 @@ func (c *BackendClient) ListUsers(sessionID string, limit, offset int) ([]*User,
 			ID:       u.Id,
 			Username: u.Username,
 			Email:    u.Email,
+			IsAdmin:  u.IsAdmin,
+			Disabled: u.Disabled,
 		}
 	}
 
 	return users, resp.TotalCount, nil
 }
 
+// SearchUsers finds users whose username starts with query
+func (c *BackendClient) SearchUsers(query string, limit int) ([]*User, error) {
+	if c.authClient == nil {
+		return nil, fmt.Errorf("not connected to backend")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	req := &authpb.SearchUsersRequest{
+		Query: query,
+		Limit: int32(limit),
+	}
+
+	resp, err := c.authClient.SearchUsers(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	users := make([]*User, len(resp.Users))
+	for i, u := range resp.Users {
+		users[i] = &User{
+			ID:       u.Id,
+			Username: u.Username,
+			Email:    u.Email,
+		}
+	}
+
+	return users, nil
+}
+
+// SetUserDisabled disables or re-enables a user account (admin only)
+func (c *BackendClient) SetUserDisabled(sessionID, userID string, disabled bool) error {
+	if c.authClient == nil {
+		return fmt.Errorf("not connected to backend")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	req := &authpb.SetUserDisabledRequest{
+		SessionId: sessionID,
+		UserId:    userID,
+		Disabled:  disabled,
+	}
+
+	resp, err := c.authClient.SetUserDisabled(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	if !resp.Success {
+		return fmt.Errorf("failed to update user: %s", resp.Message)
+	}
+
+	return nil
+}
+
 // ConnectedUser represents a user with active sessions
 type ConnectedUser struct {
 	UserID       string    `json:"user_id"`
@@ -1001,6 +1637,39 @@ func (c *BackendClient) HideAlert(sessionID, fingerprint, alertName, instance, r
 	return nil
 }
 
+// SnoozeAlert hides a specific alert for a user until the given time
+func (c *BackendClient) SnoozeAlert(sessionID, fingerprint, alertName, instance, reason string, until time.Time, impersonateUserID ...string) error {
+	if c.alertClient == nil {
+		return fmt.Errorf("not connected to backend")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	req := &alertpb.HideAlertRequest{
+		SessionId:   sessionID,
+		Fingerprint: fingerprint,
+		AlertName:   alertName,
+		Instance:    instance,
+		Reason:      reason,
+		SnoozeUntil: timestamppb.New(until),
+	}
+	if len(impersonateUserID) > 0 && impersonateUserID[0] != "" {
+		req.ImpersonateUserId = impersonateUserID[0]
+	}
+
+	resp, err := c.alertClient.HideAlert(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	if !resp.Success {
+		return fmt.Errorf("failed to snooze alert: %s", resp.Message)
+	}
+
+	return nil
+}
+
 // UnhideAlert unhides a specific alert for a user
 func (c *BackendClient) UnhideAlert(sessionID, fingerprint string, impersonateUserID ...string) error {
 	if c.alertClient == nil {
@@ -1030,10 +1699,41 @@ func (c *BackendClient) UnhideAlert(sessionID, fingerprint string, impersonateUs
 	return nil
 }
 
-// ClearAllHiddenAlerts removes all hidden alerts for a user
-func (c *BackendClient) ClearAllHiddenAlerts(sessionID string, impersonateUserID ...string) error {
+// UnhideAlerts unhides several alerts for a user in one call and returns how
+// many were hidden
+func (c *BackendClient) UnhideAlerts(sessionID string, fingerprints []string, impersonateUserID ...string) (int, error) {
 	if c.alertClient == nil {
-		return fmt.Errorf("not connected to backend")
+		return 0, fmt.Errorf("not connected to backend")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	req := &alertpb.UnhideAlertRequest{
+		SessionId:    sessionID,
+		Fingerprints: fingerprints,
+	}
+	if len(impersonateUserID) > 0 && impersonateUserID[0] != "" {
+		req.ImpersonateUserId = impersonateUserID[0]
+	}
+
+	resp, err := c.alertClient.UnhideAlert(ctx, req)
+	if err != nil {
+		return 0, err
+	}
+
+	if !resp.Success {
+		return 0, fmt.Errorf("failed to unhide alerts: %s", resp.Message)
+	}
+
+	return int(resp.UnhiddenCount), nil
+}
+
+// ClearAllHiddenAlerts removes all hidden alerts for a user. The clear can
+// be undone until undoUntil by passing clearedAt to UndoClearAllHiddenAlerts.
+func (c *BackendClient) ClearAllHiddenAlerts(sessionID string, impersonateUserID ...string) (clearedAt, undoUntil time.Time, err error) {
+	if c.alertClient == nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("not connected to backend")
 	}
 
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
@@ -1048,14 +1748,44 @@ func (c *BackendClient) ClearAllHiddenAlerts(sessionID string, impersonateUserID
 
 	resp, err := c.alertClient.ClearAllHiddenAlerts(ctx, req)
 	if err != nil {
-		return err
+		return time.Time{}, time.Time{}, err
 	}
 
 	if !resp.Success {
-		return fmt.Errorf("failed to clear hidden alerts: %s", resp.Message)
+		return time.Time{}, time.Time{}, fmt.Errorf("failed to clear hidden alerts: %s", resp.Message)
 	}
 
-	return nil
+	return resp.ClearedAt.AsTime(), resp.UndoUntil.AsTime(), nil
+}
+
+// UndoClearAllHiddenAlerts brings back the hidden alerts cleared at clearedAt
+// and returns how many were restored
+func (c *BackendClient) UndoClearAllHiddenAlerts(sessionID string, clearedAt time.Time, impersonateUserID ...string) (int, error) {
+	if c.alertClient == nil {
+		return 0, fmt.Errorf("not connected to backend")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	req := &alertpb.UndoClearRequest{
+		SessionId: sessionID,
+		ClearedAt: timestamppb.New(clearedAt),
+	}
+	if len(impersonateUserID) > 0 && impersonateUserID[0] != "" {
+		req.ImpersonateUserId = impersonateUserID[0]
+	}
+
+	resp, err := c.alertClient.UndoClearAllHiddenAlerts(ctx, req)
+	if err != nil {
+		return 0, err
+	}
+
+	if !resp.Success {
+		return 0, fmt.Errorf("failed to restore hidden alerts: %s", resp.Message)
+	}
+
+	return int(resp.RestoredCount), nil
 }
 
 // GetUserHiddenRules retrieves hidden rules for a user
@@ -1279,6 +2009,19 @@ type NotificationPreferences struct {
 	BrowserNotificationsEnabled bool     `json:"browser_notifications_enabled"`
 	EnabledSeverities           []string `json:"enabled_severities"`
 	SoundNotificationsEnabled   bool     `json:"sound_notifications_enabled"`
+	// DND is the do-not-disturb schedule; saving with nil keeps the stored one
+	DND *DNDSchedule `json:"dnd,omitempty"`
+}
+
+// DNDSchedule mutes notifications on Days (0 = Sunday) from StartTime to
+// EndTime ("HH:MM" in Timezone)
+type DNDSchedule struct {
+	Enabled       bool   `json:"enabled"`
+	Days          []int  `json:"days"`
+	StartTime     string `json:"start_time"`
+	EndTime       string `json:"end_time"`
+	Timezone      string `json:"timezone"`
+	AllowCritical bool   `json:"allow_critical"`
 }
 
 // GetNotificationPreferences retrieves notification preferences for the authenticated user
@@ -1312,11 +2055,25 @@ func (c *BackendClient) GetNotificationPreferences(sessionID string) (*Notificat
 		}, nil
 	}
 
-	return &NotificationPreferences{
+	prefs := &NotificationPreferences{
 		BrowserNotificationsEnabled: resp.Preferences.BrowserNotificationsEnabled,
 		EnabledSeverities:           resp.Preferences.EnabledSeverities,
 		SoundNotificationsEnabled:   resp.Preferences.SoundNotificationsEnabled,
-	}, nil
+	}
+	if dnd := resp.Preferences.Dnd; dnd != nil {
+		prefs.DND = &DNDSchedule{
+			Enabled:       dnd.Enabled,
+			Days:          make([]int, len(dnd.Days)),
+			StartTime:     dnd.StartTime,
+			EndTime:       dnd.EndTime,
+			Timezone:      dnd.Timezone,
+			AllowCritical: dnd.AllowCritical,
+		}
+		for i, day := range dnd.Days {
+			prefs.DND.Days[i] = int(day)
+		}
+	}
+	return prefs, nil
 }
 
 // SaveNotificationPreferences saves notification preferences for the authenticated user
@@ -1334,6 +2091,19 @@ func (c *BackendClient) SaveNotificationPreferences(sessionID string, prefs *Not
 		EnabledSeverities:           prefs.EnabledSeverities,
 		SoundNotificationsEnabled:   prefs.SoundNotificationsEnabled,
 	}
+	if prefs.DND != nil {
+		req.Dnd = &alertpb.DoNotDisturbSchedule{
+			Enabled:       prefs.DND.Enabled,
+			Days:          make([]int32, len(prefs.DND.Days)),
+			StartTime:     prefs.DND.StartTime,
+			EndTime:       prefs.DND.EndTime,
+			Timezone:      prefs.DND.Timezone,
+			AllowCritical: prefs.DND.AllowCritical,
+		}
+		for i, day := range prefs.DND.Days {
+			req.Dnd.Days[i] = int32(day)
+		}
+	}
 
 	resp, err := c.alertClient.SaveNotificationPreferences(ctx, req)
 	if err != nil {
@@ -2077,6 +2847,56 @@ func (c *BackendClient) SaveUserColumnPreferences(sessionID, userID string, colu
 	return nil
 }
 
+// ==================== Preferences Bundle ====================
+
+// ExportPreferences returns the session user's dashboard settings as a JSON bundle
+func (c *BackendClient) ExportPreferences(sessionID string) ([]byte, error) {
+	if c.alertClient == nil {
+		return nil, fmt.Errorf("not connected to backend")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	resp, err := c.alertClient.ExportPreferences(ctx, &alertpb.ExportPreferencesRequest{
+		SessionId: sessionID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if !resp.Success {
+		return nil, fmt.Errorf("failed to export preferences: %s", resp.Message)
+	}
+
+	return resp.Bundle, nil
+}
+
+// ImportPreferences loads a bundle from ExportPreferences into the session
+// user's settings and returns how many entries were created and updated
+func (c *BackendClient) ImportPreferences(sessionID string, bundle []byte) (int, int, error) {
+	if c.alertClient == nil {
+		return 0, 0, fmt.Errorf("not connected to backend")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	resp, err := c.alertClient.ImportPreferences(ctx, &alertpb.ImportPreferencesRequest{
+		SessionId: sessionID,
+		Bundle:    bundle,
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if !resp.Success {
+		return 0, 0, fmt.Errorf("failed to import preferences: %s", resp.Message)
+	}
+
+	return int(resp.Created), int(resp.Updated), nil
+}
+
 // ==================== Statistics Views ====================
 
 // GetStatisticsViews gets all statistics views for the current user
@@ -0,0 +1,63 @@
+package handlers
+
+import (
+	"log"
+	"net/http"
+	"strconv"
+
+	webuimodels "notificator/internal/webui/models"
+
+	"github.com/gin-gonic/gin"
+)
+
+// GetAlertActivity returns one page of an alert's timeline: comments,
+// acknowledgments, removed acknowledgments, escalations and hides, newest
+// first. ?before= takes the nextCursor of the previous page.
+func GetAlertActivity(c *gin.Context) {
+	fingerprint := c.Param("fingerprint")
+	if fingerprint == "" {
+		c.JSON(http.StatusBadRequest, webuimodels.ErrorResponse("Alert fingerprint is required"))
+		return
+	}
+
+	limit := 50
+	if raw := c.Query("limit"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil || parsed <= 0 {
+			c.JSON(http.StatusBadRequest, webuimodels.ErrorResponse("Limit must be a positive integer"))
+			return
+		}
+		limit = parsed
+	}
+
+	if backendClient == nil || !backendClient.IsConnected() {
+		c.JSON(http.StatusServiceUnavailable, webuimodels.ErrorResponse("Backend service not available"))
+		return
+	}
+
+	activities, nextCursor, err := backendClient.GetAlertActivity(fingerprint, limit, c.Query("before"))
+	if err != nil {
+		log.Printf("Failed to get activity for alert %s: %v", fingerprint, err)
+		c.JSON(http.StatusInternalServerError, webuimodels.ErrorResponse("Failed to retrieve alert activity"))
+		return
+	}
+
+	entries := make([]webuimodels.AlertActivity, 0, len(activities))
+	for _, activity := range activities {
+		entries = append(entries, webuimodels.AlertActivity{
+			ID:        activity.Id,
+			Type:      activity.Type,
+			Username:  activity.Username,
+			UserID:    activity.UserId,
+			Content:   activity.Content,
+			Target:    activity.Target,
+			RefID:     activity.RefId,
+			CreatedAt: activity.CreatedAt.AsTime(),
+		})
+	}
+
+	c.JSON(http.StatusOK, webuimodels.SuccessResponse(gin.H{
+		"activities": entries,
+		"nextCursor": nextCursor,
+	}))
+}
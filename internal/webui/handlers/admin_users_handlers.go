@@ -0,0 +1,103 @@
+package handlers
+
+import (
+	"log"
+	"net/http"
+	"strconv"
+
+	"notificator/internal/webui/middleware"
+	"notificator/internal/webui/models"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ListUsersForAdmin returns a page of users with their admin and disabled flags
+// GET /api/admin/users?limit=&offset=
+func ListUsersForAdmin(c *gin.Context) {
+	if !isAdmin(c) {
+		c.JSON(http.StatusForbidden, models.ErrorResponse("Admin access required"))
+		return
+	}
+
+	limit, offset := 100, 0
+	if raw := c.Query("limit"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil || parsed <= 0 {
+			c.JSON(http.StatusBadRequest, models.ErrorResponse("Limit must be a positive integer"))
+			return
+		}
+		limit = parsed
+	}
+	if raw := c.Query("offset"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil || parsed < 0 {
+			c.JSON(http.StatusBadRequest, models.ErrorResponse("Offset must be a non-negative integer"))
+			return
+		}
+		offset = parsed
+	}
+
+	if backendClient == nil || !backendClient.IsConnected() {
+		c.JSON(http.StatusServiceUnavailable, models.ErrorResponse("Backend service not available"))
+		return
+	}
+
+	sessionID := middleware.GetSessionIDFromContext(c)
+	users, totalCount, err := backendClient.ListUsers(sessionID, limit, offset)
+	if err != nil {
+		log.Printf("Error listing users: %v", err)
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse("Failed to list users"))
+		return
+	}
+
+	result := make([]gin.H, len(users))
+	for i, u := range users {
+		result[i] = gin.H{
+			"id":       u.ID,
+			"username": u.Username,
+			"email":    u.Email,
+			"is_admin": u.IsAdmin,
+			"disabled": u.Disabled,
+		}
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":     true,
+		"users":       result,
+		"total_count": totalCount,
+	})
+}
+
+// SetUserDisabled disables or re-enables a user account
+// PUT /api/admin/users/:id/disabled
+func SetUserDisabled(c *gin.Context) {
+	if !isAdmin(c) {
+		c.JSON(http.StatusForbidden, models.ErrorResponse("Admin access required"))
+		return
+	}
+
+	var req struct {
+		Disabled *bool `json:"disabled" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse("Invalid request: disabled is required"))
+		return
+	}
+
+	if backendClient == nil || !backendClient.IsConnected() {
+		c.JSON(http.StatusServiceUnavailable, models.ErrorResponse("Backend service not available"))
+		return
+	}
+
+	sessionID := middleware.GetSessionIDFromContext(c)
+	if err := backendClient.SetUserDisabled(sessionID, c.Param("id"), *req.Disabled); err != nil {
+		log.Printf("Error updating user %s: %v", c.Param("id"), err)
+		c.JSON(http.StatusBadRequest, models.ErrorResponse(err.Error()))
+		return
+	}
+
+	c.JSON(http.StatusOK, models.SuccessResponse(gin.H{
+		"id":       c.Param("id"),
+		"disabled": *req.Disabled,
+	}))
+}
@@ -0,0 +1,160 @@
+package handlers
+
+import (
+	"log"
+	"net/http"
+	"regexp"
+
+	"notificator/internal/webui/middleware"
+	webuimodels "notificator/internal/webui/models"
+	"notificator/internal/webui/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+// AlertExplanation says why the current user sees an alert the way they do
+type AlertExplanation struct {
+	Fingerprint  string                      `json:"fingerprint"`
+	Severity     SeverityExplanation         `json:"severity"`
+	Color        *services.ColorExplanation  `json:"color,omitempty"`
+	Hidden       *services.HiddenExplanation `json:"hidden,omitempty"`
+	Presets      []PresetExplanation         `json:"presets"`
+	PresetsError string                      `json:"presetsError,omitempty"`
+}
+
+// SeverityExplanation is the alert's severity label and what the dashboard
+// derives from it
+type SeverityExplanation struct {
+	Label         string  `json:"label"`
+	Computed      string  `json:"computed"`
+	BadgeColor    string  `json:"badgeColor,omitempty"`
+	PriorityScore float64 `json:"priorityScore"`
+}
+
+// PresetExplanation says whether a filter preset shows the alert, and if not,
+// the first of its filters the alert fails
+type PresetExplanation struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	IsShared bool   `json:"isShared"`
+	Includes bool   `json:"includes"`
+	Reason   string `json:"reason,omitempty"`
+}
+
+// ExplainAlert handles GET /api/v1/dashboard/alert/:fingerprint/explain. It
+// runs the alert through the same evaluation the dashboard uses for the
+// current user: color preferences, hidden alerts and rules, saved filter
+// presets, and severity mapping.
+func ExplainAlert(c *gin.Context) {
+	if alertCache == nil {
+		c.JSON(http.StatusServiceUnavailable, webuimodels.ErrorResponse("Dashboard cache not ready"))
+		return
+	}
+
+	sessionID := middleware.GetSessionID(c)
+	if sessionID == "" {
+		c.JSON(http.StatusUnauthorized, webuimodels.ErrorResponse("Authentication required"))
+		return
+	}
+
+	alert := alertCache.GetAlertByFingerprint(c.Param("fingerprint"))
+	if alert == nil {
+		c.JSON(http.StatusNotFound, webuimodels.ErrorResponse("Alert not found"))
+		return
+	}
+
+	explanation := AlertExplanation{
+		Fingerprint: alert.Fingerprint,
+		Severity: SeverityExplanation{
+			Label:         alert.Labels["severity"],
+			Computed:      alert.Severity,
+			BadgeColor:    severityColors()[alert.Severity],
+			PriorityScore: alert.PriorityScore,
+		},
+		Presets: []PresetExplanation{},
+	}
+
+	if colorService != nil {
+		explanation.Color = colorService.ExplainAlertColors(convertDashboardToModel(alert), sessionID)
+	}
+
+	if hiddenAlertsService != nil {
+		hidden := hiddenAlertsService.ExplainHidden(sessionID, alert)
+		explanation.Hidden = &hidden
+	}
+
+	if filterPresetBackendClient != nil {
+		presets, err := filterPresetBackendClient.GetFilterPresets(sessionID, true, middleware.GetImpersonatedUserID(c))
+		if err != nil {
+			log.Printf("Failed to get filter presets: %v", err)
+			explanation.PresetsError = "Failed to load filter presets"
+		}
+		labelKeys := searchLabelKeys(alertCache.Snapshot().Alerts())
+		for _, preset := range presets {
+			reason := presetExcludesAlert(alert, preset.FilterData, labelKeys, sessionID)
+			explanation.Presets = append(explanation.Presets, PresetExplanation{
+				ID:       preset.ID,
+				Name:     preset.Name,
+				IsShared: preset.IsShared,
+				Includes: reason == "",
+				Reason:   reason,
+			})
+		}
+	}
+
+	c.JSON(http.StatusOK, webuimodels.SuccessResponse(explanation))
+}
+
+// presetExcludesAlert says why applying the preset would not show the alert,
+// or returns "" when it would
+func presetExcludesAlert(alert *webuimodels.DashboardAlert, data webuimodels.FilterPresetData, labelKeys map[string]bool, sessionID string) string {
+	filters := presetDashboardFilters(data)
+
+	if reason := displayModeExcludes(alert, filters.DisplayMode); reason != "" {
+		return reason
+	}
+
+	var compiledFilterRules map[int]*regexp.Regexp
+	if len(filters.FilterHiddenRules) > 0 && hiddenAlertsService != nil {
+		compiledFilterRules = hiddenAlertsService.CompileFilterRules(filters.FilterHiddenRules)
+	}
+	return dashboardFilterMiss(alert, filters, parseSearchQuery(filters.Search, labelKeys), compiledFilterRules, sessionID)
+}
+
+// presetDashboardFilters converts a saved preset into the filters the
+// dashboard sends once the preset is applied. Like applyFilterPreset in the
+// browser it ignores the saved acknowledged and comments choices.
+func presetDashboardFilters(data webuimodels.FilterPresetData) webuimodels.DashboardFilters {
+	return webuimodels.DashboardFilters{
+		Search:             data.Search,
+		Alertmanagers:      data.Alertmanagers,
+		Severities:         data.Severities,
+		Statuses:           data.Statuses,
+		Teams:              data.Teams,
+		AlertNames:         data.AlertNames,
+		DisplayMode:        webuimodels.DashboardDisplayMode(data.DisplayMode),
+		FilterHiddenAlerts: data.HiddenAlerts,
+		FilterHiddenRules:  data.HiddenRules,
+	}
+}
+
+// displayModeExcludes mirrors the alert selection of getFilteredAndSortedAlerts.
+// A preset without a display mode keeps the current one, so it excludes nothing.
+func displayModeExcludes(alert *webuimodels.DashboardAlert, mode webuimodels.DashboardDisplayMode) string {
+	switch mode {
+	case "", webuimodels.DisplayModeFull:
+	case webuimodels.DisplayModeResolved:
+		if !alert.IsResolved {
+			return "shows resolved alerts only"
+		}
+	case webuimodels.DisplayModeAcknowledge:
+		if !alert.IsAcknowledged || alert.IsResolved {
+			return "shows acknowledged alerts only"
+		}
+	default:
+		if alert.IsAcknowledged || alert.IsResolved {
+			return "shows unacknowledged firing alerts only"
+		}
+	}
+	return ""
+}
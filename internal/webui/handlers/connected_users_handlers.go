@@ -13,7 +13,7 @@ import (
 // GetConnectedUsers returns list of connected users (admin only)
 // GET /api/admin/connected-users
 func GetConnectedUsers(c *gin.Context) {
-	if !canImpersonate(c) {
+	if !isAdmin(c) {
 		c.JSON(http.StatusForbidden, models.ErrorResponse("Admin access required"))
 		return
 	}
@@ -1,7 +1,9 @@
 package handlers
 
 import (
+	"cmp"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"net/http"
@@ -11,9 +13,14 @@ import (
 	"strings"
 	"sync"
 	"time"
+	"unicode/utf8"
 
+	"notificator/config"
+	"notificator/internal/alertmanager"
+	backendmodels "notificator/internal/backend/models"
 	alertpb "notificator/internal/backend/proto/alert"
 	"notificator/internal/models"
+	"notificator/internal/webui/client"
 	"notificator/internal/webui/middleware"
 	webuimodels "notificator/internal/webui/models"
 	"notificator/internal/webui/services"
@@ -133,6 +140,10 @@ func GetDashboardData(c *gin.Context) {
 		hiddenAlertsService.LoadUserData(sessionID)
 	}
 
+	// Render the list and the metadata from the same snapshot so counts always
+	// match the alerts shown, even if a refresh lands mid-request
+	activeAlerts := alertCache.Snapshot().Alerts()
+
 	// Get alerts based on display mode
 	var allAlerts []*webuimodels.DashboardAlert
 
@@ -144,10 +155,9 @@ func GetDashboardData(c *gin.Context) {
 			allAlerts = alertCache.GetResolvedAlerts()
 		}
 	case webuimodels.DisplayModeAcknowledge:
-		allAlerts = getAcknowledgedAlerts()
+		allAlerts = getAcknowledgedAlerts(activeAlerts)
 	case webuimodels.DisplayModeHidden:
 		// For hidden mode, we need all alerts to filter the hidden ones
-		activeAlerts := alertCache.GetAllAlerts()
 		var resolvedAlerts []*webuimodels.DashboardAlert
 		if filters.ResolvedAlertsLimit > 0 {
 			resolvedAlerts = alertCache.GetResolvedAlertsWithLimit(filters.ResolvedAlertsLimit)
@@ -157,7 +167,6 @@ func GetDashboardData(c *gin.Context) {
 		allAlerts = append(activeAlerts, resolvedAlerts...)
 	case webuimodels.DisplayModeFull:
 		// Combine active and resolved alerts
-		activeAlerts := alertCache.GetAllAlerts()
 		var resolvedAlerts []*webuimodels.DashboardAlert
 		if filters.ResolvedAlertsLimit > 0 {
 			resolvedAlerts = alertCache.GetResolvedAlertsWithLimit(filters.ResolvedAlertsLimit)
@@ -166,7 +175,7 @@ func GetDashboardData(c *gin.Context) {
 		}
 		allAlerts = append(activeAlerts, resolvedAlerts...)
 	default: // DisplayModeClassic
-		allAlerts = getStandardAlerts()
+		allAlerts = getStandardAlerts(activeAlerts)
 	}
 
 	// Apply filters
@@ -198,7 +207,7 @@ func GetDashboardData(c *gin.Context) {
 	// so it can properly count acknowledged alerts in its special logic
 	var metadataAllAlerts []*webuimodels.DashboardAlert
 	if filters.DisplayMode == webuimodels.DisplayModeClassic {
-		metadataAllAlerts = alertCache.GetAllAlerts()
+		metadataAllAlerts = activeAlerts
 	} else {
 		metadataAllAlerts = allAlerts
 	}
@@ -264,11 +273,44 @@ func parseDashboardFilters(c *gin.Context) webuimodels.DashboardFilters {
 	return filters
 }
 
-func parseDashboardSorting(c *gin.Context) webuimodels.DashboardSorting {
+// maxSortKeys caps how many columns a multi-column sort may use
+const maxSortKeys = 4
+
+// defaultDashboardSorting is used until the user picks a sort: the most severe
+// alerts first and, within a severity, the most recently started
+func defaultDashboardSorting() webuimodels.DashboardSorting {
 	return webuimodels.DashboardSorting{
-		Field:     c.DefaultQuery("sortField", "duration"),
+		Field:     "severity",
+		Direction: "desc",
+		ThenBy:    []webuimodels.DashboardSortKey{{Field: "startsAt", Direction: "desc"}},
+	}
+}
+
+func parseDashboardSorting(c *gin.Context) webuimodels.DashboardSorting {
+	if c.Query("sortField") == "" {
+		return defaultDashboardSorting()
+	}
+
+	sorting := webuimodels.DashboardSorting{
+		Field:     c.Query("sortField"),
 		Direction: c.DefaultQuery("sortDirection", "desc"),
 	}
+
+	// Secondary keys come as "severity:desc,duration:asc"
+	if then := c.Query("sortThen"); then != "" {
+		for _, part := range strings.Split(then, ",") {
+			field, direction, _ := strings.Cut(strings.TrimSpace(part), ":")
+			if field == "" || field == sorting.Field || len(sorting.ThenBy) >= maxSortKeys-1 {
+				continue
+			}
+			if direction != "desc" {
+				direction = "asc"
+			}
+			sorting.ThenBy = append(sorting.ThenBy, webuimodels.DashboardSortKey{Field: field, Direction: direction})
+		}
+	}
+
+	return sorting
 }
 
 func parsePagination(c *gin.Context) webuimodels.Pagination {
@@ -315,6 +357,27 @@ func getCurrentUserID(c *gin.Context) string {
 	return "default-user"
 }
 
+// requirePermission checks a group permission with the backend for actions
+// the WebUI performs itself and answers 403 when it is missing. Without a
+// backend there are no groups, so everything is allowed.
+func requirePermission(c *gin.Context, permission string) bool {
+	if backendClient == nil || !backendClient.IsConnected() {
+		return true
+	}
+
+	allowed, message, err := backendClient.CheckPermission(middleware.GetSessionID(c), permission)
+	if err != nil {
+		log.Printf("Failed to check permission %s: %v", permission, err)
+		c.JSON(http.StatusInternalServerError, webuimodels.ErrorResponse("Failed to check permissions"))
+		return false
+	}
+	if !allowed {
+		c.JSON(http.StatusForbidden, webuimodels.ErrorResponse(message))
+		return false
+	}
+	return true
+}
+
 func getUserSettings(userID string) *webuimodels.DashboardSettings {
 	userSettingsMu.RLock()
 	settings, exists := userSettings[userID]
@@ -331,10 +394,7 @@ func getUserSettings(userID string) *webuimodels.DashboardSettings {
 			DisplayMode: webuimodels.DisplayModeClassic,
 			ViewMode:    webuimodels.ViewModeList,
 		},
-		DefaultSorting: webuimodels.DashboardSorting{
-			Field:     "duration",
-			Direction: "desc",
-		},
+		DefaultSorting: defaultDashboardSorting(),
 	}
 
 	userSettingsMu.Lock()
@@ -343,11 +403,10 @@ func getUserSettings(userID string) *webuimodels.DashboardSettings {
 	return defaultSettings
 }
 
-func getStandardAlerts() []*webuimodels.DashboardAlert {
-	allAlerts := alertCache.GetAllAlerts()
+func getStandardAlerts(activeAlerts []*webuimodels.DashboardAlert) []*webuimodels.DashboardAlert {
 	var standardAlerts []*webuimodels.DashboardAlert
 
-	for _, alert := range allAlerts {
+	for _, alert := range activeAlerts {
 		if !alert.IsAcknowledged && !alert.IsResolved {
 			standardAlerts = append(standardAlerts, alert)
 		}
@@ -356,11 +415,10 @@ func getStandardAlerts() []*webuimodels.DashboardAlert {
 	return standardAlerts
 }
 
-func getAcknowledgedAlerts() []*webuimodels.DashboardAlert {
-	allAlerts := alertCache.GetAllAlerts()
+func getAcknowledgedAlerts(activeAlerts []*webuimodels.DashboardAlert) []*webuimodels.DashboardAlert {
 	var acknowledgedAlerts []*webuimodels.DashboardAlert
 
-	for _, alert := range allAlerts {
+	for _, alert := range activeAlerts {
 		if alert.IsAcknowledged {
 			acknowledgedAlerts = append(acknowledgedAlerts, alert)
 		}
@@ -372,6 +430,9 @@ func getAcknowledgedAlerts() []*webuimodels.DashboardAlert {
 func applyDashboardFilters(alerts []*webuimodels.DashboardAlert, filters webuimodels.DashboardFilters, sessionID string) []*webuimodels.DashboardAlert {
 	var filtered []*webuimodels.DashboardAlert
 
+	// Parse label matchers out of the search once for the whole list
+	search := parseSearchQuery(filters.Search, searchLabelKeys(alerts))
+
 	// Pre-compile filter-specific hidden rules for performance
 	var compiledFilterRules map[int]*regexp.Regexp
 	if len(filters.FilterHiddenRules) > 0 && hiddenAlertsService != nil {
@@ -379,83 +440,156 @@ func applyDashboardFilters(alerts []*webuimodels.DashboardAlert, filters webuimo
 	}
 
 	for _, alert := range alerts {
-		// Handle hidden alerts based on display mode
-		// Check both global hidden and filter-specific hidden (additive)
-		isGlobalHidden := hiddenAlertsService != nil && hiddenAlertsService.IsAlertHidden(sessionID, alert)
-		isFilterHidden := false
-		if hiddenAlertsService != nil && (len(filters.FilterHiddenAlerts) > 0 || len(filters.FilterHiddenRules) > 0) {
-			isFilterHidden = hiddenAlertsService.IsAlertHiddenByFilter(
-				alert,
-				filters.FilterHiddenAlerts,
-				filters.FilterHiddenRules,
-				compiledFilterRules,
-			)
-		}
-		isHidden := isGlobalHidden || isFilterHidden
-
-		if filters.DisplayMode == webuimodels.DisplayModeHidden {
-			// For hidden mode, only show hidden alerts
-			if !isHidden {
-				continue
-			}
-		} else {
-			// For all other modes, skip hidden alerts
-			if isHidden {
-				continue
-			}
+		if dashboardFilterMiss(alert, filters, search, compiledFilterRules, sessionID) == "" {
+			filtered = append(filtered, alert)
 		}
+	}
 
-		// Apply search filter
-		if filters.Search != "" && !matchesSearch(alert, filters.Search) {
-			continue
-		}
+	return filtered
+}
 
-		// Apply alertmanager filter
-		if len(filters.Alertmanagers) > 0 && !contains(filters.Alertmanagers, alert.Source) {
-			continue
-		}
+// dashboardFilterMiss names the first dashboard filter the alert fails, or
+// returns "" when the alert passes them all
+func dashboardFilterMiss(alert *webuimodels.DashboardAlert, filters webuimodels.DashboardFilters, search searchQuery, compiledFilterRules map[int]*regexp.Regexp, sessionID string) string {
+	// Handle hidden alerts based on display mode
+	// Check both global hidden and filter-specific hidden (additive)
+	isGlobalHidden := hiddenAlertsService != nil && hiddenAlertsService.IsAlertHidden(sessionID, alert)
+	isFilterHidden := false
+	if hiddenAlertsService != nil && (len(filters.FilterHiddenAlerts) > 0 || len(filters.FilterHiddenRules) > 0) {
+		isFilterHidden = hiddenAlertsService.IsAlertHiddenByFilter(
+			alert,
+			filters.FilterHiddenAlerts,
+			filters.FilterHiddenRules,
+			compiledFilterRules,
+		)
+	}
 
-		// Apply severity filter
-		if len(filters.Severities) > 0 && !contains(filters.Severities, alert.Severity) {
-			continue
+	if filters.DisplayMode == webuimodels.DisplayModeHidden {
+		// For hidden mode, only show hidden alerts
+		if !isGlobalHidden && !isFilterHidden {
+			return "not hidden"
 		}
-
-		// Apply status filter
-		if len(filters.Statuses) > 0 && !contains(filters.Statuses, alert.Status.State) {
-			continue
+	} else {
+		// For all other modes, skip hidden alerts
+		if isGlobalHidden {
+			return "hidden"
+		}
+		if isFilterHidden {
+			return "hidden by the filter"
 		}
+	}
 
-		// Apply team filter
-		if len(filters.Teams) > 0 && !contains(filters.Teams, alert.Team) {
-			continue
+	// Apply search filter
+	if filters.Search != "" && !matchesSearch(alert, search) {
+		return "search does not match"
+	}
+
+	// Apply alertmanager filter
+	if len(filters.Alertmanagers) > 0 && !contains(filters.Alertmanagers, alert.Source) {
+		return "alertmanager not selected"
+	}
+
+	// Apply severity filter
+	if len(filters.Severities) > 0 && !contains(filters.Severities, alert.Severity) {
+		return "severity not selected"
+	}
+
+	// Apply status filter
+	if len(filters.Statuses) > 0 && !contains(filters.Statuses, alert.Status.State) {
+		return "status not selected"
+	}
+
+	// Apply team filter
+	if len(filters.Teams) > 0 && !contains(filters.Teams, alert.Team) {
+		return "team not selected"
+	}
+
+	// Apply alert name filter
+	if len(filters.AlertNames) > 0 && !contains(filters.AlertNames, alert.AlertName) {
+		return "alert name not selected"
+	}
+
+	// Apply acknowledgment filter
+	if filters.Acknowledged != nil && alert.IsAcknowledged != *filters.Acknowledged {
+		if alert.IsAcknowledged {
+			return "acknowledged"
 		}
+		return "not acknowledged"
+	}
 
-		// Apply alert name filter
-		if len(filters.AlertNames) > 0 && !contains(filters.AlertNames, alert.AlertName) {
-			continue
+	// Apply comments filter
+	if filters.HasComments != nil {
+		hasComments := alert.CommentCount > 0
+		if hasComments != *filters.HasComments {
+			if hasComments {
+				return "has comments"
+			}
+			return "has no comments"
 		}
+	}
 
-		// Apply acknowledgment filter
-		if filters.Acknowledged != nil && alert.IsAcknowledged != *filters.Acknowledged {
-			continue
+	return ""
+}
+
+// searchQuery is a dashboard search split into label matchers and free text
+type searchQuery struct {
+	matchers []models.SilenceMatcher
+	text     string
+}
+
+// parseSearchQuery extracts label matchers (key=value, key!=value, key=~regex,
+// key!~regex, and key:value as shorthand for key=value) from a search. The
+// key:value shorthand only applies to a key in labelKeys, so words such as
+// node1:9100 stay a substring search. All other words, including matchers
+// that fail to parse such as a malformed regex, are kept as free text.
+func parseSearchQuery(search string, labelKeys map[string]bool) searchQuery {
+	var query searchQuery
+	var words []string
+
+	for _, token := range strings.Fields(search) {
+		expr := token
+		if !strings.ContainsAny(token, "=!") {
+			if key, value, ok := strings.Cut(token, ":"); ok && labelKeys[key] {
+				expr = key + "=" + value
+			}
 		}
 
-		// Apply comments filter
-		if filters.HasComments != nil {
-			hasComments := alert.CommentCount > 0
-			if hasComments != *filters.HasComments {
+		if strings.ContainsAny(expr, "=!") {
+			if matcher, err := models.ParseSilenceMatcher(expr); err == nil {
+				query.matchers = append(query.matchers, matcher)
 				continue
 			}
 		}
-
-		filtered = append(filtered, alert)
+		words = append(words, token)
 	}
 
-	return filtered
+	query.text = strings.Join(words, " ")
+	return query
+}
+
+// searchLabelKeys returns the label keys the key:value search shorthand
+// accepts: alertname, severity, team and every label of the alerts
+func searchLabelKeys(alerts []*webuimodels.DashboardAlert) map[string]bool {
+	keys := map[string]bool{"alertname": true, "severity": true, "team": true}
+	for _, alert := range alerts {
+		for key := range alert.Labels {
+			keys[key] = true
+		}
+	}
+	return keys
 }
 
-func matchesSearch(alert *webuimodels.DashboardAlert, search string) bool {
-	searchLower := strings.ToLower(search)
+func matchesSearch(alert *webuimodels.DashboardAlert, search searchQuery) bool {
+	for _, matcher := range search.matchers {
+		if !matcher.Matches(alert.Labels) {
+			return false
+		}
+	}
+	if search.text == "" {
+		return true
+	}
+
+	searchLower := strings.ToLower(search.text)
 
 	searchFields := []string{
 		alert.AlertName,
@@ -508,44 +642,67 @@ func applyPagination(alerts []*webuimodels.DashboardAlert, pagination webuimodel
 	return alerts[offset:end]
 }
 
+// applySorting sorts by every key of sorting in turn; the sort is stable so
+// alerts equal on all keys keep their order between refreshes
 func applySorting(alerts []*webuimodels.DashboardAlert, sorting webuimodels.DashboardSorting) []*webuimodels.DashboardAlert {
 	sorted := make([]*webuimodels.DashboardAlert, len(alerts))
 	copy(sorted, alerts)
 
-	sort.Slice(sorted, func(i, j int) bool {
-		var less bool
-
-		switch sorting.Field {
-		case "alertName":
-			less = sorted[i].AlertName < sorted[j].AlertName
-		case "severity":
-			less = getSeverityPriority(sorted[i].Severity) < getSeverityPriority(sorted[j].Severity)
-		case "status":
-			less = getStatusPriority(sorted[i].Status.State) < getStatusPriority(sorted[j].Status.State)
-		case "instance":
-			less = sorted[i].Instance < sorted[j].Instance
-		case "team":
-			less = sorted[i].Team < sorted[j].Team
-		case "duration":
-			less = sorted[i].Duration < sorted[j].Duration
-		case "source":
-			less = sorted[i].Source < sorted[j].Source
-		case "startsAt":
-			less = sorted[i].StartsAt.Before(sorted[j].StartsAt)
-		default:
-			// Default to duration
-			less = sorted[i].Duration < sorted[j].Duration
-		}
-
-		if sorting.Direction == "desc" {
-			return !less
+	keys := sorting.Keys()
+	sort.SliceStable(sorted, func(i, j int) bool {
+		for _, key := range keys {
+			order := compareAlerts(sorted[i], sorted[j], key.Field)
+			if key.Direction == "desc" {
+				order = -order
+			}
+			if order != 0 {
+				return order < 0
+			}
 		}
-		return less
+		return false
 	})
 
 	return sorted
 }
 
+// compareAlerts orders a and b on a single sort field, returning -1, 0 or 1
+func compareAlerts(a, b *webuimodels.DashboardAlert, field string) int {
+	switch field {
+	case "alertName":
+		return strings.Compare(a.AlertName, b.AlertName)
+	case "severity":
+		return cmp.Compare(getSeverityPriority(a.Severity), getSeverityPriority(b.Severity))
+	case "status":
+		return cmp.Compare(getStatusPriority(a.Status.State), getStatusPriority(b.Status.State))
+	case "instance":
+		return strings.Compare(a.Instance, b.Instance)
+	case "team":
+		return strings.Compare(a.Team, b.Team)
+	case "source":
+		return strings.Compare(a.Source, b.Source)
+	case "startsAt":
+		return a.StartsAt.Compare(b.StartsAt)
+	case "priorityScore":
+		return cmp.Compare(a.PriorityScore, b.PriorityScore)
+	case "commentCount":
+		return cmp.Compare(a.CommentCount, b.CommentCount)
+	case "acknowledgmentCount":
+		return cmp.Compare(a.AcknowledgmentCount, b.AcknowledgmentCount)
+	case "isAcknowledged":
+		return cmp.Compare(boolRank(a.IsAcknowledged), boolRank(b.IsAcknowledged))
+	default:
+		// Default to duration
+		return cmp.Compare(a.Duration, b.Duration)
+	}
+}
+
+func boolRank(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
+
 func getSeverityPriority(severity string) int {
 	switch strings.ToLower(severity) {
 	case "critical":
@@ -761,15 +918,23 @@ func buildDashboardMetadata(allAlerts, filteredAlerts []*webuimodels.DashboardAl
 	sort.Strings(availableFilters.AlertNames)
 
 	return webuimodels.DashboardMetadata{
-		TotalAlerts:      len(filteredAlerts), // Now respects filtering
-		FilteredCount:    len(filteredAlerts), // Keep for backward compatibility
-		LastUpdate:       time.Now(),
-		NextUpdate:       time.Now().Add(5 * time.Second), // Based on refresh interval
-		Counters:         counters,
-		AvailableFilters: availableFilters,
-		// AlertmanagerStatus would be populated based on health check
-		AlertmanagerStatus: make(map[string]bool),
+		TotalAlerts:        len(filteredAlerts), // Now respects filtering
+		FilteredCount:      len(filteredAlerts), // Keep for backward compatibility
+		LastUpdate:         time.Now(),
+		NextUpdate:         time.Now().Add(5 * time.Second), // Based on refresh interval
+		Counters:           counters,
+		AvailableFilters:   availableFilters,
+		AlertmanagerStatus: alertmanagerHealthMap(),
+		SeverityColors:     severityColors(),
+	}
+}
+
+// severityColors is the configured severity to badge color mapping
+func severityColors() map[string]string {
+	if appConfig == nil {
+		return nil
 	}
+	return appConfig.WebUI.SeverityColors
 }
 
 func BulkActionAlerts(c *gin.Context) {
@@ -779,6 +944,17 @@ func BulkActionAlerts(c *gin.Context) {
 		return
 	}
 
+	switch request.Action {
+	case "silence":
+		if !requirePermission(c, backendmodels.PermissionSilenceCreate) {
+			return
+		}
+	case "unsilence":
+		if !requirePermission(c, backendmodels.PermissionSilenceExpire) {
+			return
+		}
+	}
+
 	userID := getCurrentUserID(c)
 	response := webuimodels.BulkActionResponse{
 		Success: true,
@@ -803,7 +979,41 @@ func BulkActionAlerts(c *gin.Context) {
 			return
 		}
 
+		var extraMatchers []models.SilenceMatcher
+		for _, expr := range request.SilenceMatchers {
+			if strings.TrimSpace(expr) == "" {
+				continue
+			}
+			matcher, err := models.ParseSilenceMatcher(expr)
+			if err != nil {
+				c.JSON(http.StatusBadRequest, webuimodels.ErrorResponse(fmt.Sprintf("Invalid matcher: %v", err)))
+				return
+			}
+			extraMatchers = append(extraMatchers, matcher)
+		}
+
 		c.Set("silenceDuration", silenceDuration)
+		c.Set("silenceLabels", request.SilenceLabels)
+		c.Set("silenceMatchers", extraMatchers)
+	}
+
+	// Acknowledgments given a duration lapse instead of lasting until removed
+	if request.Action == "acknowledge" && request.AckDuration != "" {
+		ackDuration, err := validateCustomDuration(request.AckDuration)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, webuimodels.ErrorResponse(fmt.Sprintf("Invalid acknowledgment duration: %v", err)))
+			return
+		}
+		c.Set("ackExpiry", &backendmodels.AcknowledgmentExpiry{
+			ExpiresAt: time.Now().Add(ackDuration),
+			Renotify:  request.AckRenotify,
+		})
+	}
+
+	// Acknowledge several alerts in one backend transaction
+	if request.Action == "acknowledge" && len(request.AlertFingerprints) > 1 && backendClient != nil && backendClient.IsConnected() {
+		bulkAcknowledgeAlerts(c, request.AlertFingerprints, request.Comment, userID, &response)
+		request.AlertFingerprints = nil
 	}
 
 	// Process individual alerts
@@ -833,6 +1043,108 @@ func BulkActionAlerts(c *gin.Context) {
 	c.JSON(http.StatusOK, webuimodels.SuccessResponse(response))
 }
 
+// bulkAcknowledgeAlerts acknowledges all fingerprints with a single backend
+// call and reports each failed alert in response
+func bulkAcknowledgeAlerts(c *gin.Context, fingerprints []string, comment, userID string, response *webuimodels.BulkActionResponse) {
+	alerts := make(map[string]*webuimodels.DashboardAlert, len(fingerprints))
+	keys := make([]string, 0, len(fingerprints))
+	for _, fingerprint := range fingerprints {
+		alert, exists := alertCache.GetAlert(fingerprint)
+		if !exists {
+			response.FailedCount++
+			response.Errors = append(response.Errors, fmt.Sprintf("alert not found: %s", fingerprint))
+			continue
+		}
+		alerts[fingerprint] = alert
+		keys = append(keys, fingerprint)
+	}
+	if len(keys) == 0 {
+		return
+	}
+
+	reason := acknowledgmentReason(comment)
+	expiresAt, renotify := ackExpiryFromContext(c)
+	results, err := backendClient.BulkAcknowledge(middleware.GetSessionID(c), keys, reason, expiresAt, renotify)
+	if err != nil {
+		response.FailedCount += len(keys)
+		response.Errors = append(response.Errors, fmt.Sprintf("failed to store acknowledgments in backend: %v", err))
+		return
+	}
+
+	for _, fingerprint := range keys {
+		if !results[fingerprint] {
+			response.FailedCount++
+			response.Errors = append(response.Errors, fmt.Sprintf("failed to acknowledge alert: %s", fingerprint))
+			continue
+		}
+		markAlertAcknowledged(c, alerts[fingerprint], reason, userID)
+		response.ProcessedCount++
+	}
+}
+
+// ackExpiryFromContext returns when acknowledgments of this request lapse, as
+// parsed by BulkActionAlerts; a zero time means they last until removed
+func ackExpiryFromContext(c *gin.Context) (time.Time, bool) {
+	if value, ok := c.Get("ackExpiry"); ok {
+		if expiry, ok := value.(*backendmodels.AcknowledgmentExpiry); ok {
+			return expiry.ExpiresAt, expiry.Renotify
+		}
+	}
+	return time.Time{}, false
+}
+
+func acknowledgmentReason(comment string) string {
+	if comment == "" {
+		return "Acknowledged from dashboard"
+	}
+	return comment
+}
+
+// markAlertAcknowledged runs everything that follows a stored acknowledgment:
+// the audit comment, the cache update and the acknowledgment statistics
+func markAlertAcknowledged(c *gin.Context, alert *webuimodels.DashboardAlert, reason, userID string) {
+	fingerprint := alert.Fingerprint
+	connected := backendClient != nil && backendClient.IsConnected()
+
+	if connected {
+		// Also add the acknowledgment reason as a comment for audit trail
+		commentContent := fmt.Sprintf("🔔 Alert acknowledged: %s", reason)
+		if err := backendClient.AddComment(middleware.GetSessionID(c), fingerprint, commentContent); err != nil {
+			// Log the error but don't fail the acknowledgment if comment fails
+			fmt.Printf("Warning: failed to add acknowledgment comment: %v\n", err)
+		}
+	}
+
+	expiresAt, renotify := ackExpiryFromContext(c)
+
+	// Update local cache
+	if updated, ok := alertCache.MutateAlert(fingerprint, func(cached *webuimodels.DashboardAlert) {
+		cached.IsAcknowledged = true
+		cached.AcknowledgedBy = userID
+		cached.AcknowledgedAt = time.Now()
+		cached.AcknowledgmentExpiresAt = nil
+		if !expiresAt.IsZero() {
+			cached.AcknowledgmentExpiresAt = &expiresAt
+		}
+		cached.AcknowledgmentRenotify = renotify
+		cached.AcknowledgmentLapsedAt = nil
+		cached.AcknowledgmentCount++
+		// Always increment comment count since we add an acknowledgment comment
+		cached.CommentCount++
+	}); ok {
+		alert = updated
+	}
+
+	// Capture acknowledgment statistics
+	if connected {
+		go func(ackAlert *webuimodels.DashboardAlert) {
+			if err := backendClient.UpdateAlertAcknowledged(ackAlert); err != nil {
+				fmt.Printf("Failed to capture alert acknowledged statistics for %s: %v", ackAlert.Fingerprint, err)
+			}
+		}(alert)
+	}
+}
+
 func processAlertAction(c *gin.Context, fingerprint, action, comment, userID string) error {
 	alert, exists := alertCache.GetAlert(fingerprint)
 	if !exists {
@@ -844,38 +1156,13 @@ func processAlertAction(c *gin.Context, fingerprint, action, comment, userID str
 		// Store acknowledgment in backend
 		if backendClient != nil && backendClient.IsConnected() {
 			sessionID := middleware.GetSessionID(c)
-			reason := comment
-			if reason == "" {
-				reason = "Acknowledged from dashboard"
-			}
-
-			if err := backendClient.AddAcknowledgment(sessionID, fingerprint, reason); err != nil {
+			expiresAt, renotify := ackExpiryFromContext(c)
+			if err := backendClient.AddAcknowledgment(sessionID, fingerprint, acknowledgmentReason(comment), expiresAt, renotify); err != nil {
 				return fmt.Errorf("failed to store acknowledgment in backend: %w", err)
 			}
-
-			// Also add the acknowledgment reason as a comment for audit trail
-			commentContent := fmt.Sprintf("🔔 Alert acknowledged: %s", reason)
-			if err := backendClient.AddComment(sessionID, fingerprint, commentContent); err != nil {
-				// Log the error but don't fail the acknowledgment if comment fails
-				fmt.Printf("Warning: failed to add acknowledgment comment: %v\n", err)
-			}
 		}
 
-		// Update local cache
-		alert.IsAcknowledged = true
-		alert.AcknowledgedBy = userID
-		alert.AcknowledgedAt = time.Now()
-		// Always increment comment count since we add an acknowledgment comment
-		alert.CommentCount++
-
-		// Capture acknowledgment statistics
-		if backendClient != nil && backendClient.IsConnected() {
-			go func(ackAlert *webuimodels.DashboardAlert) {
-				if err := backendClient.UpdateAlertAcknowledged(ackAlert); err != nil {
-					fmt.Printf("Failed to capture alert acknowledged statistics for %s: %v", ackAlert.Fingerprint, err)
-				}
-			}(alert)
-		}
+		markAlertAcknowledged(c, alert, acknowledgmentReason(comment), userID)
 
 	case "unacknowledge":
 		// Remove acknowledgment from backend
@@ -898,18 +1185,24 @@ func processAlertAction(c *gin.Context, fingerprint, action, comment, userID str
 		}
 
 		// Update local cache
-		alert.IsAcknowledged = false
-		alert.AcknowledgedBy = ""
-		alert.AcknowledgedAt = time.Time{}
-		// Increment comment count for unacknowledgment comment
-		alert.CommentCount++
+		alertCache.MutateAlert(fingerprint, func(cached *webuimodels.DashboardAlert) {
+			cached.IsAcknowledged = false
+			cached.AcknowledgedBy = ""
+			cached.AcknowledgedAt = time.Time{}
+			cached.AcknowledgmentExpiresAt = nil
+			cached.AcknowledgmentRenotify = false
+			// Increment comment count for unacknowledgment comment
+			cached.CommentCount++
+		})
 
 	case "resolve":
 		// Mark alert as resolved (this is more of a UI state than backend)
-		alert.Status.State = "resolved"
-		alert.EndsAt = time.Now()
-		alert.IsResolved = true
-		alert.ResolvedAt = time.Now()
+		alertCache.MutateAlert(fingerprint, func(cached *webuimodels.DashboardAlert) {
+			cached.Status.State = "resolved"
+			cached.EndsAt = time.Now()
+			cached.IsResolved = true
+			cached.ResolvedAt = time.Now()
+		})
 
 		// Add a comment about resolution for audit trail
 		if backendClient != nil && backendClient.IsConnected() {
@@ -924,7 +1217,9 @@ func processAlertAction(c *gin.Context, fingerprint, action, comment, userID str
 				fmt.Printf("Warning: failed to add resolution comment: %v\n", err)
 			} else {
 				// Increment comment count if comment was added successfully
-				alert.CommentCount++
+				alertCache.MutateAlert(fingerprint, func(cached *webuimodels.DashboardAlert) {
+					cached.CommentCount++
+				})
 			}
 		}
 
@@ -1007,7 +1302,7 @@ func GetDashboardSettings(c *gin.Context) {
 	c.JSON(http.StatusOK, webuimodels.SuccessResponse(settings))
 }
 
-func getFilteredAndSortedAlerts(filters webuimodels.DashboardFilters, sorting webuimodels.DashboardSorting, userID string, sessionID string) []*webuimodels.DashboardAlert {
+func getFilteredAndSortedAlerts(activeAlerts []*webuimodels.DashboardAlert, filters webuimodels.DashboardFilters, sorting webuimodels.DashboardSorting, userID string, sessionID string) []*webuimodels.DashboardAlert {
 	// Get alerts based on display mode
 	var allAlerts []*webuimodels.DashboardAlert
 
@@ -1019,10 +1314,9 @@ func getFilteredAndSortedAlerts(filters webuimodels.DashboardFilters, sorting we
 			allAlerts = alertCache.GetResolvedAlerts()
 		}
 	case webuimodels.DisplayModeAcknowledge:
-		allAlerts = getAcknowledgedAlerts()
+		allAlerts = getAcknowledgedAlerts(activeAlerts)
 	case webuimodels.DisplayModeFull:
 		// Combine active and resolved alerts
-		activeAlerts := alertCache.GetAllAlerts()
 		var resolvedAlerts []*webuimodels.DashboardAlert
 		if filters.ResolvedAlertsLimit > 0 {
 			resolvedAlerts = alertCache.GetResolvedAlertsWithLimit(filters.ResolvedAlertsLimit)
@@ -1031,7 +1325,7 @@ func getFilteredAndSortedAlerts(filters webuimodels.DashboardFilters, sorting we
 		}
 		allAlerts = append(activeAlerts, resolvedAlerts...)
 	default: // DisplayModeClassic
-		allAlerts = getStandardAlerts()
+		allAlerts = getStandardAlerts(activeAlerts)
 	}
 
 	// Apply filters
@@ -1043,7 +1337,7 @@ func getFilteredAndSortedAlerts(filters webuimodels.DashboardFilters, sorting we
 	return sortedAlerts
 }
 
-func getDashboardMetadata(alerts []*webuimodels.DashboardAlert, filters webuimodels.DashboardFilters, userID string, sessionID string) webuimodels.DashboardMetadata {
+func getDashboardMetadata(activeAlerts, alerts []*webuimodels.DashboardAlert, filters webuimodels.DashboardFilters, userID string, sessionID string) webuimodels.DashboardMetadata {
 	// Get all alerts for total counts
 	var allAlerts []*webuimodels.DashboardAlert
 
@@ -1055,9 +1349,8 @@ func getDashboardMetadata(alerts []*webuimodels.DashboardAlert, filters webuimod
 			allAlerts = alertCache.GetResolvedAlerts()
 		}
 	case webuimodels.DisplayModeAcknowledge:
-		allAlerts = getAcknowledgedAlerts()
+		allAlerts = getAcknowledgedAlerts(activeAlerts)
 	case webuimodels.DisplayModeFull:
-		activeAlerts := alertCache.GetAllAlerts()
 		var resolvedAlerts []*webuimodels.DashboardAlert
 		if filters.ResolvedAlertsLimit > 0 {
 			resolvedAlerts = alertCache.GetResolvedAlertsWithLimit(filters.ResolvedAlertsLimit)
@@ -1068,7 +1361,7 @@ func getDashboardMetadata(alerts []*webuimodels.DashboardAlert, filters webuimod
 	default: // DisplayModeClassic
 		// For classic mode, use all alerts for metadata counting (not just standard alerts)
 		// This ensures we can count acknowledged alerts properly in the statistics
-		allAlerts = alertCache.GetAllAlerts()
+		allAlerts = activeAlerts
 	}
 
 	return buildDashboardMetadata(allAlerts, alerts, filters, userID, sessionID)
@@ -1098,8 +1391,9 @@ func PostDashboardIncremental(c *gin.Context) {
 		return
 	}
 
-	// Get current alerts
-	currentAlerts := getFilteredAndSortedAlerts(filters, sorting, userID, sessionID)
+	// Get current alerts; the diff and the metadata share one snapshot
+	activeAlerts := alertCache.Snapshot().Alerts()
+	currentAlerts := getFilteredAndSortedAlerts(activeAlerts, filters, sorting, userID, sessionID)
 
 	// Get client's current alert fingerprints from POST body
 	var req webuimodels.DashboardIncrementalRequest
@@ -1116,7 +1410,23 @@ func PostDashboardIncremental(c *gin.Context) {
 	}
 
 	// Process incremental update
-	processIncremental(c, currentAlerts, clientFingerprints, settings, userID, sessionID, lastUpdate)
+	processIncremental(c, activeAlerts, currentAlerts, clientFingerprints, settings, userID, sessionID, lastUpdate)
+}
+
+// ForceRefreshDashboard re-fetches every Alertmanager right away, for the
+// dashboard's Force Refresh which then reloads its view in full
+// POST /api/v1/dashboard/refresh
+func ForceRefreshDashboard(c *gin.Context) {
+	if alertCache == nil {
+		c.JSON(http.StatusServiceUnavailable, webuimodels.ErrorResponse("Alert cache not initialized"))
+		return
+	}
+
+	alertCache.ForceRefresh()
+
+	c.JSON(http.StatusOK, webuimodels.SuccessResponse(gin.H{
+		"message": "Alerts refreshed",
+	}))
 }
 
 func GetDashboardIncremental(c *gin.Context) {
@@ -1148,8 +1458,9 @@ func GetDashboardIncremental(c *gin.Context) {
 		return
 	}
 
-	// Get current alerts
-	currentAlerts := getFilteredAndSortedAlerts(filters, sorting, userID, sessionID)
+	// Get current alerts; the diff and the metadata share one snapshot
+	activeAlerts := alertCache.Snapshot().Alerts()
+	currentAlerts := getFilteredAndSortedAlerts(activeAlerts, filters, sorting, userID, sessionID)
 
 	// Get client's current alert fingerprints from query parameter
 	clientFingerprintsStr := c.Query("clientAlerts")
@@ -1163,10 +1474,10 @@ func GetDashboardIncremental(c *gin.Context) {
 	}
 
 	// Process incremental update
-	processIncremental(c, currentAlerts, clientFingerprints, settings, userID, sessionID, lastUpdate)
+	processIncremental(c, activeAlerts, currentAlerts, clientFingerprints, settings, userID, sessionID, lastUpdate)
 }
 
-func processIncremental(c *gin.Context, currentAlerts []*webuimodels.DashboardAlert, clientFingerprints map[string]bool, settings *webuimodels.DashboardSettings, userID string, sessionID string, lastUpdate int64) {
+func processIncremental(c *gin.Context, activeAlerts, currentAlerts []*webuimodels.DashboardAlert, clientFingerprints map[string]bool, settings *webuimodels.DashboardSettings, userID string, sessionID string, lastUpdate int64) {
 	// Parse filters from query parameters for metadata
 	filters := parseDashboardFilters(c)
 
@@ -1203,7 +1514,7 @@ func processIncremental(c *gin.Context, currentAlerts []*webuimodels.DashboardAl
 	}
 
 	// Get updated metadata
-	metadata := getDashboardMetadata(currentAlerts, filters, userID, sessionID)
+	metadata := getDashboardMetadata(activeAlerts, currentAlerts, filters, userID, sessionID)
 
 	// Get colors for new and updated alerts (combined; helper returns nil if none)
 	alertsForColors := make([]*webuimodels.DashboardAlert, 0, len(newAlerts)+len(updatedAlerts))
@@ -1226,6 +1537,74 @@ func processIncremental(c *gin.Context, currentAlerts []*webuimodels.DashboardAl
 	c.JSON(http.StatusOK, webuimodels.SuccessResponse(incrementalUpdate))
 }
 
+// fetchAlertSilences loads the silences listed in the alert's silencedBy from
+// the Alertmanager the alert came from.
+func fetchAlertSilences(alert *webuimodels.DashboardAlert) []webuimodels.Silence {
+	silences := []webuimodels.Silence{}
+	if alertmanagerClient == nil {
+		return silences
+	}
+
+	for _, silenceID := range alert.Status.SilencedBy {
+		silence, err := alertmanagerClient.FetchSilenceFromAlertmanager(alert.Source, silenceID)
+		if err != nil {
+			log.Printf("Failed to fetch silence %s from %s: %v", silenceID, alert.Source, err)
+			continue
+		}
+
+		silences = append(silences, convertToWebSilence(silence, alert.Source))
+	}
+
+	return silences
+}
+
+// resolveInhibitingAlerts looks up the alerts listed in the alert's inhibitedBy,
+// which Alertmanager reports by fingerprint, in the alert cache
+func resolveInhibitingAlerts(alert *webuimodels.DashboardAlert) []webuimodels.InhibitingAlert {
+	if len(alert.Status.InhibitedBy) == 0 {
+		return nil
+	}
+
+	snapshot := alertCache.Snapshot()
+	inhibitors := make([]webuimodels.InhibitingAlert, 0, len(alert.Status.InhibitedBy))
+	for _, fingerprint := range alert.Status.InhibitedBy {
+		inhibitor := webuimodels.InhibitingAlert{Fingerprint: fingerprint}
+		if source, ok := snapshot.Alert(fingerprint); ok {
+			inhibitor.Found = true
+			inhibitor.AlertName = source.AlertName
+			inhibitor.Severity = source.Severity
+			inhibitor.Instance = source.Instance
+			inhibitor.State = source.Status.State
+		}
+		inhibitors = append(inhibitors, inhibitor)
+	}
+	return inhibitors
+}
+
+func convertToWebSilence(silence *models.Silence, source string) webuimodels.Silence {
+	matchers := make([]webuimodels.SilenceMatcher, len(silence.Matchers))
+	for i, matcher := range silence.Matchers {
+		matchers[i] = webuimodels.SilenceMatcher{
+			Name:    matcher.Name,
+			Value:   matcher.Value,
+			IsRegex: matcher.IsRegex,
+			IsEqual: models.BoolPtr(matcher.Equal()),
+		}
+	}
+
+	return webuimodels.Silence{
+		ID:        silence.ID,
+		CreatedBy: silence.CreatedBy,
+		Comment:   silence.Comment,
+		StartsAt:  silence.StartsAt,
+		EndsAt:    silence.EndsAt,
+		UpdatedAt: silence.UpdatedAt,
+		Matchers:  matchers,
+		Status:    webuimodels.SilenceStatus{State: silence.Status.State},
+		Source:    source,
+	}
+}
+
 func GetAlertDetails(c *gin.Context) {
 	fingerprint := c.Param("fingerprint")
 	if fingerprint == "" {
@@ -1247,45 +1626,40 @@ func GetAlertDetails(c *gin.Context) {
 
 	// Get acknowledgments if backend is available
 	if backendClient != nil && backendClient.IsConnected() {
-		acknowledgments, err := backendClient.GetAcknowledgments(fingerprint)
+		acknowledgments, total, cursor, err := backendClient.GetAcknowledgmentsPage(fingerprint, alertDetailsPageSize, "")
 		if err == nil {
-			// Convert backend acknowledgments to webui models
-			details.Acknowledgments = make([]webuimodels.Acknowledgment, len(acknowledgments))
-			for i, ack := range acknowledgments {
-				details.Acknowledgments[i] = webuimodels.Acknowledgment{
-					ID:        ack.Id,
-					Username:  ack.Username,
-					UserID:    ack.UserId,
-					Reason:    ack.Reason,
-					CreatedAt: ack.CreatedAt.AsTime(),
-					UpdatedAt: ack.CreatedAt.AsTime(), // Use CreatedAt if UpdatedAt not available
-				}
-			}
+			details.Acknowledgments = convertAcknowledgments(acknowledgments)
+			details.AcknowledgmentsTotal = total
+			details.AcknowledgmentsCursor = cursor
 		}
 
-		// Get comments if backend is available
-		comments, err := backendClient.GetComments(fingerprint)
+		// Get the most recent comments if backend is available; older ones are
+		// fetched on demand from /alert/:fingerprint/comments
+		comments, total, cursor, err := backendClient.GetCommentsPage(fingerprint, alertDetailsPageSize, "")
 		if err == nil {
-			// Convert backend comments to webui models
-			details.Comments = make([]webuimodels.Comment, len(comments))
-			for i, comment := range comments {
-				details.Comments[i] = webuimodels.Comment{
-					ID:        comment.Id,
-					Username:  comment.Username,
-					UserID:    comment.UserId,
-					Content:   comment.Content,
-					CreatedAt: comment.CreatedAt.AsTime(),
-					UpdatedAt: comment.CreatedAt.AsTime(), // Use CreatedAt if UpdatedAt not available
-				}
-			}
+			details.Comments = convertCommentsOldestFirst(comments)
+			details.CommentsTotal = total
+			details.CommentsCursor = cursor
 		} else {
 			details.Comments = []webuimodels.Comment{}
 		}
 
-		// Note: Silences would need to be implemented in backend client
-		// For now, initialize empty slice
-		details.Silences = []webuimodels.Silence{}
+		if escalations, err := backendClient.GetEscalations(fingerprint); err == nil {
+			details.Escalations = make([]webuimodels.Escalation, len(escalations))
+			for i, escalation := range escalations {
+				details.Escalations[i] = convertEscalation(escalation)
+			}
+		}
+
+		if !alert.IsResolved {
+			details.PreviousDiscussion = findPreviousDiscussion(fingerprint)
+		}
 	}
+	details.MaxCommentLength = maxCommentLength()
+
+	details.Silences = fetchAlertSilences(alert)
+	details.InhibitedBy = resolveInhibitingAlerts(alert)
+	details.RelatedAlerts = services.RelatedAlerts(alert, alertCache.Snapshot().Alerts())
 
 	// Get additional metadata
 	if alert.GeneratorURL != "" {
@@ -1376,22 +1750,23 @@ func AddAlertComment(c *gin.Context) {
 	}
 
 	// Validate content length
-	if len(strings.TrimSpace(request.Content)) == 0 {
+	content := strings.TrimSpace(request.Content)
+	if len(content) == 0 {
 		c.JSON(http.StatusBadRequest, webuimodels.ErrorResponse("Comment content cannot be empty"))
 		return
 	}
 
-	if len(request.Content) > 1000 {
-		c.JSON(http.StatusBadRequest, webuimodels.ErrorResponse("Comment content cannot exceed 1000 characters"))
-		return
-	}
-
 	// Check if backend is available
 	if backendClient == nil || !backendClient.IsConnected() {
 		c.JSON(http.StatusServiceUnavailable, webuimodels.ErrorResponse("Backend service not available"))
 		return
 	}
 
+	if limit := maxCommentLength(); utf8.RuneCountInString(content) > limit {
+		c.JSON(http.StatusBadRequest, webuimodels.ErrorResponse(fmt.Sprintf("Comment content cannot exceed %d characters", limit)))
+		return
+	}
+
 	sessionID := middleware.GetSessionID(c)
 	if sessionID == "" {
 		c.JSON(http.StatusUnauthorized, webuimodels.ErrorResponse("Authentication required"))
@@ -1406,21 +1781,53 @@ func AddAlertComment(c *gin.Context) {
 	}
 
 	// Add comment via backend
-	err := backendClient.AddComment(sessionID, fingerprint, strings.TrimSpace(request.Content))
+	err := backendClient.AddComment(sessionID, fingerprint, content)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, webuimodels.ErrorResponse("Failed to add comment: "+err.Error()))
 		return
 	}
 
 	// Update comment count in alert cache
-	alert.CommentCount++
-	alert.LastCommentAt = time.Now()
+	alertCache.MutateAlert(fingerprint, func(cached *webuimodels.DashboardAlert) {
+		cached.CommentCount++
+		cached.LastCommentAt = time.Now()
+	})
 
 	c.JSON(http.StatusOK, webuimodels.SuccessResponse(gin.H{
 		"message": "Comment added successfully",
 	}))
 }
 
+// commentLengthTTL is how long the backend's comment length limit is reused
+// before asking again; it only changes when the backend is reconfigured
+const commentLengthTTL = 5 * time.Minute
+
+var (
+	cachedCommentLength   int
+	commentLengthCachedAt time.Time
+	commentLengthMu       sync.Mutex
+)
+
+// maxCommentLength is the backend's comment length limit, or the default
+// one when it can't be asked. The limit is cached for commentLengthTTL so
+// every alert details and comment request doesn't cost an RPC.
+func maxCommentLength() int {
+	commentLengthMu.Lock()
+	defer commentLengthMu.Unlock()
+
+	if cachedCommentLength > 0 && time.Since(commentLengthCachedAt) < commentLengthTTL {
+		return cachedCommentLength
+	}
+	if backendClient != nil && backendClient.IsConnected() {
+		if length, err := backendClient.GetMaxCommentLength(); err == nil && length > 0 {
+			cachedCommentLength = length
+			commentLengthCachedAt = time.Now()
+			return length
+		}
+	}
+	return config.DefaultMaxCommentLength
+}
+
 func DeleteAlertComment(c *gin.Context) {
 	fingerprint := c.Param("fingerprint")
 	commentID := c.Param("commentId")
@@ -1484,25 +1891,236 @@ func DeleteAlertComment(c *gin.Context) {
 		return
 	}
 
-	// Update comment count in alert cache (decrement if > 0)
-	if alert.CommentCount > 0 {
-		alert.CommentCount--
-	}
+	// Update comment count in alert cache (decrement if > 0). Bumping UpdatedAt
+	// lets other clients' incremental refreshes pick up the new count.
+	alertCache.MutateAlert(fingerprint, func(cached *webuimodels.DashboardAlert) {
+		if cached.CommentCount > 0 {
+			cached.CommentCount--
+		}
+		cached.UpdatedAt = time.Now()
+	})
 
 	c.JSON(http.StatusOK, webuimodels.SuccessResponse(gin.H{
 		"message": "Comment deleted successfully",
 	}))
 }
 
-func GetUserColorPreferences(c *gin.Context) {
-	if backendClient == nil || !backendClient.IsConnected() {
-		c.JSON(http.StatusServiceUnavailable, webuimodels.ErrorResponse("Backend service not available"))
+// alertDetailsPageSize is how many comments and acknowledgments an alert's
+// details include, and the page size for loading older ones
+const alertDetailsPageSize = 20
+
+// commentsByTagLimit caps how many of an alert's comments a tag filter lists
+const commentsByTagLimit = 200
+
+// GetAlertComments returns a page of an alert's comments. ?before= is the
+// nextCursor of the previous page (or commentsCursor of the alert details);
+// the page is ordered oldest first so it can be prepended to the loaded ones.
+func GetAlertComments(c *gin.Context) {
+	fingerprint := c.Param("fingerprint")
+	if fingerprint == "" {
+		c.JSON(http.StatusBadRequest, webuimodels.ErrorResponse("Alert fingerprint is required"))
 		return
 	}
 
-	sessionID := middleware.GetSessionID(c)
-	if sessionID == "" {
-		c.JSON(http.StatusUnauthorized, webuimodels.ErrorResponse("Authentication required"))
+	limit, ok := parsePageLimit(c)
+	if !ok {
+		return
+	}
+
+	if backendClient == nil || !backendClient.IsConnected() {
+		c.JSON(http.StatusServiceUnavailable, webuimodels.ErrorResponse("Backend service not available"))
+		return
+	}
+
+	// ?tag= lists the alert's comments carrying that #tag instead of a page
+	if tag := c.Query("tag"); tag != "" {
+		comments, err := backendClient.GetCommentsByTag(middleware.GetSessionID(c), tag, fingerprint, commentsByTagLimit)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, webuimodels.ErrorResponse("Failed to fetch comments: "+err.Error()))
+			return
+		}
+		c.JSON(http.StatusOK, webuimodels.SuccessResponse(gin.H{
+			"comments": convertCommentsOldestFirst(comments),
+			"total":    len(comments),
+		}))
+		return
+	}
+
+	comments, total, nextCursor, err := backendClient.GetCommentsPage(fingerprint, limit, c.Query("before"))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, webuimodels.ErrorResponse("Failed to fetch comments: "+err.Error()))
+		return
+	}
+
+	c.JSON(http.StatusOK, webuimodels.SuccessResponse(gin.H{
+		"comments":   convertCommentsOldestFirst(comments),
+		"total":      total,
+		"nextCursor": nextCursor,
+	}))
+}
+
+// GetAlertAcknowledgments returns a page of an alert's acknowledgments, newest
+// first. ?before= is the nextCursor of the previous page.
+func GetAlertAcknowledgments(c *gin.Context) {
+	fingerprint := c.Param("fingerprint")
+	if fingerprint == "" {
+		c.JSON(http.StatusBadRequest, webuimodels.ErrorResponse("Alert fingerprint is required"))
+		return
+	}
+
+	limit, ok := parsePageLimit(c)
+	if !ok {
+		return
+	}
+
+	if backendClient == nil || !backendClient.IsConnected() {
+		c.JSON(http.StatusServiceUnavailable, webuimodels.ErrorResponse("Backend service not available"))
+		return
+	}
+
+	acknowledgments, total, nextCursor, err := backendClient.GetAcknowledgmentsPage(fingerprint, limit, c.Query("before"))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, webuimodels.ErrorResponse("Failed to fetch acknowledgments: "+err.Error()))
+		return
+	}
+
+	c.JSON(http.StatusOK, webuimodels.SuccessResponse(gin.H{
+		"acknowledgments": convertAcknowledgments(acknowledgments),
+		"total":           total,
+		"nextCursor":      nextCursor,
+	}))
+}
+
+// parsePageLimit reads ?limit= (default alertDetailsPageSize, max 100),
+// writing a 400 response and returning false if it is invalid
+func parsePageLimit(c *gin.Context) (int, bool) {
+	limit := alertDetailsPageSize
+	if raw := c.Query("limit"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil || parsed <= 0 || parsed > 100 {
+			c.JSON(http.StatusBadRequest, webuimodels.ErrorResponse("Limit must be between 1 and 100"))
+			return 0, false
+		}
+		limit = parsed
+	}
+
+	return limit, true
+}
+
+// convertCommentsOldestFirst converts a newest-first page of backend comments
+// to webui models in display order
+func convertCommentsOldestFirst(comments []*alertpb.Comment) []webuimodels.Comment {
+	result := make([]webuimodels.Comment, len(comments))
+	for i, comment := range comments {
+		result[len(comments)-1-i] = webuimodels.Comment{
+			ID:        comment.Id,
+			Username:  comment.Username,
+			UserID:    comment.UserId,
+			Content:   comment.Content,
+			CreatedAt: comment.CreatedAt.AsTime(),
+			UpdatedAt: comment.CreatedAt.AsTime(), // Use CreatedAt if UpdatedAt not available
+		}
+	}
+	return result
+}
+
+// ackExpiresAt returns when ack lapses, or nil when it lasts until removed
+func ackExpiresAt(ack *alertpb.Acknowledgment) *time.Time {
+	if ack.ExpiresAt == nil {
+		return nil
+	}
+	expiresAt := ack.ExpiresAt.AsTime()
+	return &expiresAt
+}
+
+func convertAcknowledgments(acknowledgments []*alertpb.Acknowledgment) []webuimodels.Acknowledgment {
+	result := make([]webuimodels.Acknowledgment, len(acknowledgments))
+	for i, ack := range acknowledgments {
+		result[i] = webuimodels.Acknowledgment{
+			ID:        ack.Id,
+			Username:  ack.Username,
+			UserID:    ack.UserId,
+			Reason:    ack.Reason,
+			CreatedAt: ack.CreatedAt.AsTime(),
+			UpdatedAt: ack.CreatedAt.AsTime(), // Use CreatedAt if UpdatedAt not available
+			ExpiresAt: ackExpiresAt(ack),
+		}
+	}
+	return result
+}
+
+// DeleteAlertAcknowledgment removes one of the current user's acknowledgments.
+// The alert stays acknowledged while anyone else's acknowledgment remains.
+func DeleteAlertAcknowledgment(c *gin.Context) {
+	fingerprint := c.Param("fingerprint")
+	ackID := c.Param("ackId")
+
+	if fingerprint == "" {
+		c.JSON(http.StatusBadRequest, webuimodels.ErrorResponse("Alert fingerprint is required"))
+		return
+	}
+
+	if ackID == "" {
+		c.JSON(http.StatusBadRequest, webuimodels.ErrorResponse("Acknowledgment ID is required"))
+		return
+	}
+
+	if backendClient == nil || !backendClient.IsConnected() {
+		c.JSON(http.StatusServiceUnavailable, webuimodels.ErrorResponse("Backend service not available"))
+		return
+	}
+
+	sessionID := middleware.GetSessionID(c)
+	if sessionID == "" {
+		c.JSON(http.StatusUnauthorized, webuimodels.ErrorResponse("Authentication required"))
+		return
+	}
+
+	if alertCache.GetAlertByFingerprint(fingerprint) == nil {
+		c.JSON(http.StatusNotFound, webuimodels.ErrorResponse("Alert not found"))
+		return
+	}
+
+	// The backend only deletes the acknowledgment if it belongs to this session's user
+	if err := backendClient.DeleteAcknowledgmentByID(sessionID, fingerprint, ackID); err != nil {
+		c.JSON(http.StatusForbidden, webuimodels.ErrorResponse(err.Error()))
+		return
+	}
+
+	remaining, err := backendClient.GetAcknowledgments(fingerprint)
+	if err != nil {
+		log.Printf("Failed to reload acknowledgments for %s: %v", fingerprint, err)
+	} else {
+		alertCache.MutateAlert(fingerprint, func(cached *webuimodels.DashboardAlert) {
+			cached.AcknowledgmentCount = len(remaining)
+			if len(remaining) == 0 {
+				cached.IsAcknowledged = false
+				cached.AcknowledgedBy = ""
+				cached.AcknowledgedAt = time.Time{}
+			} else {
+				// Acknowledgments come back newest first
+				cached.AcknowledgedBy = remaining[0].Username
+				cached.AcknowledgedAt = remaining[0].CreatedAt.AsTime()
+			}
+			cached.UpdatedAt = time.Now()
+		})
+	}
+
+	c.JSON(http.StatusOK, webuimodels.SuccessResponse(gin.H{
+		"message":   "Acknowledgment removed successfully",
+		"remaining": len(remaining),
+	}))
+}
+
+func GetUserColorPreferences(c *gin.Context) {
+	if backendClient == nil || !backendClient.IsConnected() {
+		c.JSON(http.StatusServiceUnavailable, webuimodels.ErrorResponse("Backend service not available"))
+		return
+	}
+
+	sessionID := middleware.GetSessionID(c)
+	if sessionID == "" {
+		c.JSON(http.StatusUnauthorized, webuimodels.ErrorResponse("Authentication required"))
 		return
 	}
 
@@ -1581,6 +2199,9 @@ func SaveUserColorPreferences(c *gin.Context) {
 	if colorService != nil && sessionID != "" {
 		colorService.InvalidateUserCache(sessionID)
 	}
+	if alertCache != nil && sessionID != "" {
+		alertCache.InvalidateColorCache(sessionID)
+	}
 
 	c.JSON(http.StatusOK, webuimodels.SuccessResponse(gin.H{
 		"message": "Color preferences saved successfully",
@@ -1728,6 +2349,7 @@ func GetAlertColors(c *gin.Context) {
 	pagination := parsePagination(c)
 
 	// Get alerts based on display mode (same logic as dashboard data)
+	activeAlerts := alertCache.Snapshot().Alerts()
 	var allAlerts []*webuimodels.DashboardAlert
 
 	switch filters.DisplayMode {
@@ -1738,10 +2360,9 @@ func GetAlertColors(c *gin.Context) {
 			allAlerts = alertCache.GetResolvedAlerts()
 		}
 	case webuimodels.DisplayModeAcknowledge:
-		allAlerts = getAcknowledgedAlerts()
+		allAlerts = getAcknowledgedAlerts(activeAlerts)
 	case webuimodels.DisplayModeFull:
 		// Combine active and resolved alerts
-		activeAlerts := alertCache.GetAllAlerts()
 		var resolvedAlerts []*webuimodels.DashboardAlert
 		if filters.ResolvedAlertsLimit > 0 {
 			resolvedAlerts = alertCache.GetResolvedAlertsWithLimit(filters.ResolvedAlertsLimit)
@@ -1750,7 +2371,7 @@ func GetAlertColors(c *gin.Context) {
 		}
 		allAlerts = append(activeAlerts, resolvedAlerts...)
 	default: // DisplayModeClassic
-		allAlerts = getStandardAlerts()
+		allAlerts = getStandardAlerts(activeAlerts)
 	}
 
 	// Apply filters (same as dashboard data)
@@ -1863,7 +2484,7 @@ func GetBulkAlertColors(c *gin.Context) {
 }
 
 func RemoveAllResolvedAlerts(c *gin.Context) {
-	if !canImpersonate(c) {
+	if !isAdmin(c) {
 		c.JSON(http.StatusForbidden, webuimodels.ErrorResponse("You are not allowed to remove resolved alerts"))
 		return
 	}
@@ -1876,13 +2497,234 @@ func RemoveAllResolvedAlerts(c *gin.Context) {
 	sessionID := middleware.GetSessionIDFromContext(c)
 
 	// Call alert cache to remove all resolved alerts
-	if err := alertCache.RemoveAllResolvedAlerts(sessionID); err != nil {
+	clearedAt, undoUntil, err := alertCache.RemoveAllResolvedAlerts(sessionID)
+	if err != nil {
 		c.JSON(http.StatusInternalServerError, webuimodels.ErrorResponse(fmt.Sprintf("Failed to remove resolved alerts: %v", err)))
 		return
 	}
 
 	c.JSON(http.StatusOK, webuimodels.SuccessResponse(gin.H{
-		"message": "All resolved alerts have been removed successfully",
+		"message":   "All resolved alerts have been removed successfully",
+		"clearedAt": clearedAt,
+		"undoUntil": undoUntil,
+	}))
+}
+
+// GetResolvedAlertSettings returns how long the resolved alerts stored from
+// now on are kept
+func GetResolvedAlertSettings(c *gin.Context) {
+	if alertCache == nil {
+		c.JSON(http.StatusInternalServerError, webuimodels.ErrorResponse("Alert cache not available"))
+		return
+	}
+
+	// Another replica may have saved a new TTL since this one loaded it
+	if backendClient != nil && backendClient.IsConnected() {
+		if ttlHours, err := backendClient.GetResolvedAlertTTLHours(); err != nil {
+			log.Printf("Failed to load the resolved alert TTL: %v", err)
+		} else {
+			alertCache.SetResolvedAlertTTLHours(ttlHours)
+		}
+	}
+
+	c.JSON(http.StatusOK, webuimodels.SuccessResponse(gin.H{
+		"ttlHours":    alertCache.ResolvedAlertTTLHours(),
+		"maxTtlHours": backendmodels.MaxResolvedAlertTTLHours,
+	}))
+}
+
+// SaveResolvedAlertSettings changes how long the resolved alerts stored from
+// now on are kept. Alerts already stored keep their expiry.
+func SaveResolvedAlertSettings(c *gin.Context) {
+	if !isAdmin(c) {
+		c.JSON(http.StatusForbidden, webuimodels.ErrorResponse("You are not allowed to change resolved alert settings"))
+		return
+	}
+
+	if alertCache == nil {
+		c.JSON(http.StatusInternalServerError, webuimodels.ErrorResponse("Alert cache not available"))
+		return
+	}
+
+	var req struct {
+		TTLHours int `json:"ttlHours" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, webuimodels.ErrorResponse("ttlHours is required"))
+		return
+	}
+	if req.TTLHours < 1 || req.TTLHours > backendmodels.MaxResolvedAlertTTLHours {
+		c.JSON(http.StatusBadRequest, webuimodels.ErrorResponse(fmt.Sprintf("ttlHours must be between 1 and %d", backendmodels.MaxResolvedAlertTTLHours)))
+		return
+	}
+
+	sessionID := middleware.GetSessionIDFromContext(c)
+	if err := backendClient.SaveResolvedAlertTTLHours(sessionID, req.TTLHours); err != nil {
+		c.JSON(http.StatusInternalServerError, webuimodels.ErrorResponse(err.Error()))
+		return
+	}
+	alertCache.SetResolvedAlertTTLHours(req.TTLHours)
+
+	c.JSON(http.StatusOK, webuimodels.SuccessResponse(gin.H{
+		"ttlHours": req.TTLHours,
+	}))
+}
+
+// UndoRemoveAllResolvedAlerts brings back the resolved alerts removed by
+// RemoveAllResolvedAlerts while its undo window is open
+func UndoRemoveAllResolvedAlerts(c *gin.Context) {
+	if !isAdmin(c) {
+		c.JSON(http.StatusForbidden, webuimodels.ErrorResponse("You are not allowed to restore resolved alerts"))
+		return
+	}
+
+	if alertCache == nil {
+		c.JSON(http.StatusInternalServerError, webuimodels.ErrorResponse("Alert cache not available"))
+		return
+	}
+
+	var req struct {
+		ClearedAt time.Time `json:"clearedAt" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, webuimodels.ErrorResponse("clearedAt is required"))
+		return
+	}
+
+	sessionID := middleware.GetSessionIDFromContext(c)
+
+	restored, err := alertCache.UndoRemoveAllResolvedAlerts(sessionID, req.ClearedAt)
+	if err != nil {
+		c.JSON(http.StatusConflict, webuimodels.ErrorResponse(err.Error()))
+		return
+	}
+
+	c.JSON(http.StatusOK, webuimodels.SuccessResponse(gin.H{
+		"restored": restored,
+	}))
+}
+
+// findPreviousDiscussion reports the unrestored comments and acknowledgments
+// of the latest resolution of a fingerprint, or nil when there are none
+func findPreviousDiscussion(fingerprint string) *webuimodels.PreviousDiscussion {
+	resolved, err := backendClient.GetResolvedAlert(fingerprint)
+	if err != nil || resolved.RestoredAt != nil {
+		return nil
+	}
+
+	var comments, acks []json.RawMessage
+	if len(resolved.Comments) > 0 {
+		if err := json.Unmarshal(resolved.Comments, &comments); err != nil {
+			return nil
+		}
+	}
+	if len(resolved.Acknowledgments) > 0 {
+		if err := json.Unmarshal(resolved.Acknowledgments, &acks); err != nil {
+			return nil
+		}
+	}
+	if len(comments) == 0 && len(acks) == 0 {
+		return nil
+	}
+
+	return &webuimodels.PreviousDiscussion{
+		ResolvedAt:      resolved.ResolvedAt.AsTime(),
+		Comments:        len(comments),
+		Acknowledgments: len(acks),
+	}
+}
+
+// RestoreAlertDiscussion copies the comments and acknowledgments of the
+// alert's previous resolution back onto it
+// POST /api/v1/dashboard/alert/:fingerprint/restore-discussion
+func RestoreAlertDiscussion(c *gin.Context) {
+	fingerprint := c.Param("fingerprint")
+	if fingerprint == "" {
+		c.JSON(http.StatusBadRequest, webuimodels.ErrorResponse("Alert fingerprint is required"))
+		return
+	}
+
+	if backendClient == nil || !backendClient.IsConnected() {
+		c.JSON(http.StatusServiceUnavailable, webuimodels.ErrorResponse("Backend service not available"))
+		return
+	}
+
+	sessionID := middleware.GetSessionIDFromContext(c)
+	comments, acks, err := backendClient.RestoreResolvedAlert(sessionID, fingerprint)
+	if err != nil {
+		log.Printf("Error restoring discussion for %s: %v", fingerprint, err)
+		c.JSON(http.StatusBadRequest, webuimodels.ErrorResponse(err.Error()))
+		return
+	}
+
+	c.JSON(http.StatusOK, webuimodels.SuccessResponse(gin.H{
+		"restoredComments":        comments,
+		"restoredAcknowledgments": acks,
+	}))
+}
+
+// SearchResolvedAlerts returns a page of stored resolved alerts matching the
+// query filters, with the total number of matches
+// GET /api/v1/dashboard/resolved-alerts?source=&fingerprint=&resolved_after=&resolved_before=&sort=&limit=&offset=
+func SearchResolvedAlerts(c *gin.Context) {
+	if alertCache == nil {
+		c.JSON(http.StatusInternalServerError, webuimodels.ErrorResponse("Alert cache not available"))
+		return
+	}
+
+	query := client.ResolvedAlertsQuery{
+		Source:            c.Query("source"),
+		FingerprintPrefix: c.Query("fingerprint"),
+		SortOrder:         c.DefaultQuery("sort", "desc"),
+	}
+	if query.SortOrder != "asc" && query.SortOrder != "desc" {
+		c.JSON(http.StatusBadRequest, webuimodels.ErrorResponse("Sort must be asc or desc"))
+		return
+	}
+	for param, target := range map[string]*time.Time{
+		"resolved_after":  &query.ResolvedAfter,
+		"resolved_before": &query.ResolvedBefore,
+	} {
+		if raw := c.Query(param); raw != "" {
+			parsed, err := time.Parse(time.RFC3339, raw)
+			if err != nil {
+				c.JSON(http.StatusBadRequest, webuimodels.ErrorResponse(fmt.Sprintf("%s must be an RFC 3339 time", param)))
+				return
+			}
+			*target = parsed
+		}
+	}
+
+	limit, offset := 50, 0
+	if raw := c.Query("limit"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil || parsed <= 0 {
+			c.JSON(http.StatusBadRequest, webuimodels.ErrorResponse("Limit must be a positive integer"))
+			return
+		}
+		limit = parsed
+	}
+	if raw := c.Query("offset"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil || parsed < 0 {
+			c.JSON(http.StatusBadRequest, webuimodels.ErrorResponse("Offset must be a non-negative integer"))
+			return
+		}
+		offset = parsed
+	}
+
+	alerts, total, err := alertCache.SearchResolvedAlerts(query, limit, offset)
+	if err != nil {
+		log.Printf("Error searching resolved alerts: %v", err)
+		c.JSON(http.StatusBadGateway, webuimodels.ErrorResponse(fmt.Sprintf("Failed to search resolved alerts: %v", err)))
+		return
+	}
+
+	c.JSON(http.StatusOK, webuimodels.SuccessResponse(gin.H{
+		"alerts":      alerts,
+		"total_count": total,
+		"limit":       limit,
+		"offset":      offset,
 	}))
 }
 
@@ -1908,6 +2750,28 @@ func processSilenceAction(c *gin.Context, fingerprint, comment, userID string) e
 		return fmt.Errorf("invalid silence duration format")
 	}
 
+	// Custom matchers replace the label matcher of the same name
+	var extraMatchers []models.SilenceMatcher
+	if value, ok := c.Get("silenceMatchers"); ok {
+		extraMatchers, _ = value.([]models.SilenceMatcher)
+	}
+	customNames := make(map[string]bool, len(extraMatchers))
+	for _, matcher := range extraMatchers {
+		customNames[matcher.Name] = true
+	}
+
+	// Only the chosen label keys become matchers when the form picked some
+	var selectedLabels map[string]bool
+	if labels, ok := c.Get("silenceLabels"); ok {
+		// A nil list (field omitted) keeps every label
+		if keys, ok := labels.([]string); ok && keys != nil {
+			selectedLabels = make(map[string]bool, len(keys))
+			for _, key := range keys {
+				selectedLabels[key] = true
+			}
+		}
+	}
+
 	// Create silence matchers from alert labels
 	var matchers []models.SilenceMatcher
 	for key, value := range alert.Labels {
@@ -1915,15 +2779,20 @@ func processSilenceAction(c *gin.Context, fingerprint, comment, userID string) e
 		if key == "__name__" || key == "__tmp_" {
 			continue
 		}
+		if (selectedLabels != nil && !selectedLabels[key]) || customNames[key] {
+			continue
+		}
 
 		matchers = append(matchers, models.SilenceMatcher{
 			Name:    key,
 			Value:   value,
 			IsRegex: false,
-			IsEqual: true,
+			IsEqual: models.BoolPtr(true),
 		})
 	}
 
+	matchers = append(matchers, extraMatchers...)
+
 	if len(matchers) == 0 {
 		return fmt.Errorf("no suitable labels found for creating silence")
 	}
@@ -1984,7 +2853,9 @@ func processSilenceAction(c *gin.Context, fingerprint, comment, userID string) e
 			fmt.Printf("Warning: failed to add silence comment: %v\n", err)
 		} else {
 			// Increment comment count in cache only if comment was added successfully
-			alert.CommentCount++
+			alertCache.MutateAlert(fingerprint, func(cached *webuimodels.DashboardAlert) {
+				cached.CommentCount++
+			})
 		}
 	}
 
@@ -2009,7 +2880,7 @@ func processUnsilenceAction(c *gin.Context, fingerprint, userID string) error {
 
 	// Get all alertmanager clients and delete silences from each
 	allClients := alertmanagerClient.GetAllClients()
-	var errors []error
+	var deleteErrors []error
 
 	successCount := 0
 
@@ -2018,8 +2889,8 @@ func processUnsilenceAction(c *gin.Context, fingerprint, userID string) error {
 			err := client.DeleteSilence(silenceID)
 			if err != nil {
 				// If silence not found, it might already be deleted or on different AM
-				if !strings.Contains(err.Error(), "not found") {
-					errors = append(errors, fmt.Errorf("failed to delete silence %s on %s: %w", silenceID, name, err))
+				if !errors.Is(err, alertmanager.ErrSilenceNotFound) && !errors.Is(err, alertmanager.ErrSilenceAlreadyExpired) {
+					deleteErrors = append(deleteErrors, fmt.Errorf("failed to delete silence %s on %s: %w", silenceID, name, err))
 				}
 				continue
 			}
@@ -2032,18 +2903,330 @@ func processUnsilenceAction(c *gin.Context, fingerprint, userID string) error {
 	}
 
 	// If no silences were successfully deleted, return error
-	if successCount == 0 && len(errors) > 0 {
-		return fmt.Errorf("failed to delete any silences: %v", errors)
+	if successCount == 0 && len(deleteErrors) > 0 {
+		return fmt.Errorf("failed to delete any silences: %v", deleteErrors)
 	}
 
 	// If some failed but not all, log warnings but continue
-	if len(errors) > 0 {
-		fmt.Printf("Warning: failed to delete some silences: %v\n", errors)
+	if len(deleteErrors) > 0 {
+		fmt.Printf("Warning: failed to delete some silences: %v\n", deleteErrors)
 	}
 
 	return nil
 }
 
+// ListSilences returns the silences of every configured Alertmanager, each
+// tagged with its source. An optional comma-separated ?state= filter keeps
+// only the given states (active, pending, expired).
+func ListSilences(c *gin.Context) {
+	if alertmanagerClient == nil {
+		c.JSON(http.StatusServiceUnavailable, webuimodels.ErrorResponse("Alertmanager client not available"))
+		return
+	}
+
+	silences, err := alertmanagerClient.FetchAllSilences()
+	if err != nil {
+		c.JSON(http.StatusBadGateway, webuimodels.ErrorResponse("Failed to fetch silences: "+err.Error()))
+		return
+	}
+
+	var states map[string]bool
+	if stateParam := c.Query("state"); stateParam != "" {
+		states = make(map[string]bool)
+		for _, state := range parseStringArray(stateParam) {
+			states[state] = true
+		}
+	}
+
+	result := make([]webuimodels.Silence, 0, len(silences))
+	for _, s := range silences {
+		if states != nil && !states[s.Silence.Status.State] {
+			continue
+		}
+		result = append(result, convertToWebSilence(&s.Silence, s.Source))
+	}
+
+	// Group by Alertmanager, soonest-ending first within each
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Source != result[j].Source {
+			return result[i].Source < result[j].Source
+		}
+		return result[i].EndsAt.Before(result[j].EndsAt)
+	})
+
+	c.JSON(http.StatusOK, webuimodels.SuccessResponse(gin.H{
+		"silences": result,
+		"total":    len(result),
+	}))
+}
+
+// ExpireSilence expires a single silence. The owning Alertmanager is taken from
+// the "alertmanager" query parameter; without it every configured Alertmanager is tried.
+func ExpireSilence(c *gin.Context) {
+	if alertmanagerClient == nil {
+		c.JSON(http.StatusServiceUnavailable, webuimodels.ErrorResponse("Alertmanager client not available"))
+		return
+	}
+
+	silenceID := c.Param("id")
+	if silenceID == "" {
+		c.JSON(http.StatusBadRequest, webuimodels.ErrorResponse("Silence ID is required"))
+		return
+	}
+
+	if !requirePermission(c, backendmodels.PermissionSilenceExpire) {
+		return
+	}
+
+	var err error
+	if amName := c.Query("alertmanager"); amName != "" {
+		err = alertmanagerClient.DeleteSilenceFromAlertmanager(amName, silenceID)
+	} else {
+		err = fmt.Errorf("%w: silence with ID %s", alertmanager.ErrSilenceNotFound, silenceID)
+		for _, client := range alertmanagerClient.GetAllClients() {
+			if err = client.DeleteSilence(silenceID); !errors.Is(err, alertmanager.ErrSilenceNotFound) {
+				break
+			}
+		}
+	}
+
+	switch {
+	case err == nil:
+		log.Printf("User %s expired silence %s", getCurrentUserID(c), silenceID)
+		c.JSON(http.StatusOK, webuimodels.SuccessResponse(gin.H{
+			"message": "Silence expired successfully",
+		}))
+	case errors.Is(err, alertmanager.ErrSilenceAlreadyExpired):
+		c.JSON(http.StatusConflict, webuimodels.ErrorResponse("Silence is already expired"))
+	case errors.Is(err, alertmanager.ErrSilenceNotFound):
+		c.JSON(http.StatusNotFound, webuimodels.ErrorResponse("Silence not found"))
+	default:
+		c.JSON(http.StatusBadGateway, webuimodels.ErrorResponse("Failed to expire silence: "+err.Error()))
+	}
+}
+
+// UpdateSilence edits an existing silence (matchers, end time and comment).
+// Alertmanager may replace the silence with a new ID when the change cannot be
+// applied in place; the returned silence always carries the current ID.
+func UpdateSilence(c *gin.Context) {
+	if alertmanagerClient == nil {
+		c.JSON(http.StatusServiceUnavailable, webuimodels.ErrorResponse("Alertmanager client not available"))
+		return
+	}
+
+	silenceID := c.Param("id")
+	if silenceID == "" {
+		c.JSON(http.StatusBadRequest, webuimodels.ErrorResponse("Silence ID is required"))
+		return
+	}
+
+	var req webuimodels.UpdateSilenceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, webuimodels.ErrorResponse("Invalid request: "+err.Error()))
+		return
+	}
+
+	if !requirePermission(c, backendmodels.PermissionSilenceCreate) {
+		return
+	}
+
+	req.Comment = strings.TrimSpace(req.Comment)
+	if req.Comment == "" {
+		c.JSON(http.StatusBadRequest, webuimodels.ErrorResponse("Silence comment is required"))
+		return
+	}
+
+	matchers, err := parseSilenceMatchers(req.Matchers)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, webuimodels.ErrorResponse(err.Error()))
+		return
+	}
+
+	if !req.EndsAt.After(time.Now()) {
+		c.JSON(http.StatusBadRequest, webuimodels.ErrorResponse("End time must be in the future"))
+		return
+	}
+
+	// Locate the silence to keep its start time and owning Alertmanager
+	amName := c.Query("alertmanager")
+	var existing *models.Silence
+	if amName != "" {
+		existing, err = alertmanagerClient.FetchSilenceFromAlertmanager(amName, silenceID)
+	} else {
+		err = fmt.Errorf("silence with ID %s not found", silenceID)
+		for name, client := range alertmanagerClient.GetAllClients() {
+			if existing, err = client.FetchSilence(silenceID); err == nil {
+				amName = name
+				break
+			}
+		}
+	}
+	if err != nil {
+		c.JSON(http.StatusNotFound, webuimodels.ErrorResponse("Silence not found"))
+		return
+	}
+
+	startsAt := existing.StartsAt
+	if existing.Status.State == "expired" || !req.EndsAt.After(startsAt) {
+		// Expired silences cannot be extended in place; Alertmanager recreates them from now
+		startsAt = time.Now()
+	}
+
+	updated, err := alertmanagerClient.UpdateSilenceOnAlertmanager(amName, silenceID, models.Silence{
+		Matchers:  matchers,
+		StartsAt:  startsAt,
+		EndsAt:    req.EndsAt,
+		CreatedBy: getCurrentUserID(c),
+		Comment:   req.Comment,
+	})
+	switch {
+	case err == nil:
+	case errors.Is(err, alertmanager.ErrSilenceNotFound):
+		c.JSON(http.StatusNotFound, webuimodels.ErrorResponse("Silence not found"))
+		return
+	default:
+		c.JSON(http.StatusBadGateway, webuimodels.ErrorResponse("Failed to update silence: "+err.Error()))
+		return
+	}
+
+	log.Printf("User %s updated silence %s on %s (now %s)", getCurrentUserID(c), silenceID, amName, updated.ID)
+
+	updated.Status.State = "active"
+	if updated.StartsAt.After(time.Now()) {
+		updated.Status.State = "pending"
+	}
+	c.JSON(http.StatusOK, webuimodels.SuccessResponse(convertToWebSilence(updated, amName)))
+}
+
+// parseSilenceMatchers validates matchers submitted by the dashboard
+func parseSilenceMatchers(input []webuimodels.SilenceMatcher) ([]models.SilenceMatcher, error) {
+	var matchers []models.SilenceMatcher
+	for _, matcher := range input {
+		name := strings.TrimSpace(matcher.Name)
+		if name == "" {
+			return nil, fmt.Errorf("Matcher names cannot be empty")
+		}
+		if matcher.IsRegex {
+			if _, err := regexp.Compile("^(?:" + matcher.Value + ")$"); err != nil {
+				return nil, fmt.Errorf("Matcher %s has an invalid regex: %v", name, err)
+			}
+		}
+		matchers = append(matchers, models.SilenceMatcher{
+			Name:    name,
+			Value:   matcher.Value,
+			IsRegex: matcher.IsRegex,
+			IsEqual: models.BoolPtr(matcher.Equal()),
+		})
+	}
+	if len(matchers) == 0 {
+		return nil, fmt.Errorf("At least one matcher is required")
+	}
+	return matchers, nil
+}
+
+// SuggestSilences proposes silence matchers shared by the given alerts, with
+// a preview of how many active alerts each one would mute
+func SuggestSilences(c *gin.Context) {
+	if alertCache == nil {
+		c.JSON(http.StatusServiceUnavailable, webuimodels.ErrorResponse("Alert cache not available"))
+		return
+	}
+
+	var req webuimodels.SilenceSuggestionsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, webuimodels.ErrorResponse("Invalid request: "+err.Error()))
+		return
+	}
+
+	// Preview against the same snapshot the selection is read from
+	snapshot := alertCache.Snapshot()
+	var selected []*webuimodels.DashboardAlert
+	for _, fingerprint := range req.Fingerprints {
+		if alert, exists := snapshot.Alert(fingerprint); exists {
+			selected = append(selected, alert)
+		}
+	}
+	if len(selected) == 0 {
+		c.JSON(http.StatusBadRequest, webuimodels.ErrorResponse("None of the given alerts are active"))
+		return
+	}
+
+	suggestions := services.SuggestSilenceMatchers(selected, snapshot.Alerts())
+	if suggestions == nil {
+		suggestions = []webuimodels.SilenceSuggestion{}
+	}
+
+	c.JSON(http.StatusOK, webuimodels.SuccessResponse(suggestions))
+}
+
+// CreateSilence creates a silence from explicit matchers on every Alertmanager
+func CreateSilence(c *gin.Context) {
+	if alertmanagerClient == nil {
+		c.JSON(http.StatusServiceUnavailable, webuimodels.ErrorResponse("Alertmanager client not available"))
+		return
+	}
+
+	var req webuimodels.CreateSilenceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, webuimodels.ErrorResponse("Invalid request: "+err.Error()))
+		return
+	}
+
+	if !requirePermission(c, backendmodels.PermissionSilenceCreate) {
+		return
+	}
+
+	req.Comment = strings.TrimSpace(req.Comment)
+	if req.Comment == "" {
+		c.JSON(http.StatusBadRequest, webuimodels.ErrorResponse("Silence comment is required"))
+		return
+	}
+
+	matchers, err := parseSilenceMatchers(req.Matchers)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, webuimodels.ErrorResponse(err.Error()))
+		return
+	}
+
+	now := time.Now()
+	if !req.EndsAt.After(now) {
+		c.JSON(http.StatusBadRequest, webuimodels.ErrorResponse("End time must be in the future"))
+		return
+	}
+
+	silence := models.Silence{
+		Matchers:  matchers,
+		StartsAt:  now,
+		EndsAt:    req.EndsAt,
+		CreatedBy: getCurrentUserID(c),
+		Comment:   req.Comment,
+	}
+
+	var created []webuimodels.Silence
+	var failures []string
+	for name, client := range alertmanagerClient.GetAllClients() {
+		result, err := client.CreateSilence(silence)
+		if err != nil {
+			failures = append(failures, fmt.Sprintf("%s: %v", name, err))
+			continue
+		}
+		result.Status.State = "active"
+		created = append(created, convertToWebSilence(result, name))
+	}
+
+	if len(created) == 0 {
+		c.JSON(http.StatusBadGateway, webuimodels.ErrorResponse("Failed to create silence: "+strings.Join(failures, "; ")))
+		return
+	}
+	if len(failures) > 0 {
+		log.Printf("Warning: failed to create silence on some alertmanagers: %s", strings.Join(failures, "; "))
+	}
+
+	log.Printf("User %s created silence %s on %d alertmanager(s)", getCurrentUserID(c), silence.GetMatchersString(), len(created))
+
+	c.JSON(http.StatusCreated, webuimodels.SuccessResponse(created))
+}
+
 // GetAnnotationButtonConfigs retrieves annotation button configurations for the current user
 func GetAnnotationButtonConfigs(c *gin.Context) {
 	if backendClient == nil || !backendClient.IsConnected() {
@@ -0,0 +1,110 @@
+package handlers
+
+import (
+	"log"
+	"net/http"
+	"strings"
+
+	alertpb "notificator/internal/backend/proto/alert"
+	"notificator/internal/webui/middleware"
+	webuimodels "notificator/internal/webui/models"
+
+	"github.com/gin-gonic/gin"
+)
+
+// EscalateAlert hands an alert over to a user or group. The backend records
+// the escalation and pushes it to everyone watching the alert.
+func EscalateAlert(c *gin.Context) {
+	fingerprint := c.Param("fingerprint")
+	if fingerprint == "" {
+		c.JSON(http.StatusBadRequest, webuimodels.ErrorResponse("Alert fingerprint is required"))
+		return
+	}
+
+	var request webuimodels.EscalateAlertRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, webuimodels.ErrorResponse("Invalid request format"))
+		return
+	}
+
+	request.EscalatedTo = strings.TrimSpace(request.EscalatedTo)
+	if request.EscalatedTo == "" {
+		c.JSON(http.StatusBadRequest, webuimodels.ErrorResponse("Escalation target is required"))
+		return
+	}
+	if request.TargetType != "user" && request.TargetType != "group" {
+		c.JSON(http.StatusBadRequest, webuimodels.ErrorResponse("Target type must be user or group"))
+		return
+	}
+	if len(request.Reason) > 1000 {
+		c.JSON(http.StatusBadRequest, webuimodels.ErrorResponse("Reason cannot exceed 1000 characters"))
+		return
+	}
+
+	if backendClient == nil || !backendClient.IsConnected() {
+		c.JSON(http.StatusServiceUnavailable, webuimodels.ErrorResponse("Backend service not available"))
+		return
+	}
+
+	sessionID := middleware.GetSessionID(c)
+	if sessionID == "" {
+		c.JSON(http.StatusUnauthorized, webuimodels.ErrorResponse("Authentication required"))
+		return
+	}
+
+	if alertCache.GetAlertByFingerprint(fingerprint) == nil {
+		c.JSON(http.StatusNotFound, webuimodels.ErrorResponse("Alert not found"))
+		return
+	}
+
+	escalation, err := backendClient.EscalateAlert(sessionID, fingerprint, request.EscalatedTo, request.TargetType, strings.TrimSpace(request.Reason))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, webuimodels.ErrorResponse("Failed to escalate alert: "+err.Error()))
+		return
+	}
+
+	c.JSON(http.StatusOK, webuimodels.SuccessResponse(convertEscalation(escalation)))
+}
+
+// SearchUsers returns users whose username starts with ?q=, for pickers
+func SearchUsers(c *gin.Context) {
+	query := strings.TrimSpace(c.Query("q"))
+	if query == "" {
+		c.JSON(http.StatusOK, webuimodels.SuccessResponse(gin.H{"users": []gin.H{}}))
+		return
+	}
+
+	if backendClient == nil || !backendClient.IsConnected() {
+		c.JSON(http.StatusServiceUnavailable, webuimodels.ErrorResponse("Backend service not available"))
+		return
+	}
+
+	users, err := backendClient.SearchUsers(query, 10)
+	if err != nil {
+		log.Printf("Failed to search users: %v", err)
+		c.JSON(http.StatusInternalServerError, webuimodels.ErrorResponse("Failed to search users"))
+		return
+	}
+
+	results := make([]gin.H, 0, len(users))
+	for _, user := range users {
+		results = append(results, gin.H{
+			"id":       user.ID,
+			"username": user.Username,
+		})
+	}
+
+	c.JSON(http.StatusOK, webuimodels.SuccessResponse(gin.H{"users": results}))
+}
+
+func convertEscalation(escalation *alertpb.Escalation) webuimodels.Escalation {
+	return webuimodels.Escalation{
+		ID:          escalation.Id,
+		Username:    escalation.Username,
+		UserID:      escalation.UserId,
+		EscalatedTo: escalation.EscalatedTo,
+		TargetType:  escalation.TargetType,
+		Reason:      escalation.Reason,
+		CreatedAt:   escalation.CreatedAt.AsTime(),
+	}
+}
@@ -0,0 +1,219 @@
+package handlers
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"notificator/internal/webui/middleware"
+	webuimodels "notificator/internal/webui/models"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ExportGroupedView exports the current grouped view as a hierarchical report.
+// It honours the same filters and groupBy label as the dashboard, but ignores
+// pagination so the report covers the whole filtered view.
+// Supported formats: json (default) and markdown.
+func ExportGroupedView(c *gin.Context) {
+	if alertCache == nil {
+		c.JSON(http.StatusServiceUnavailable, webuimodels.ErrorResponse("Dashboard cache not ready"))
+		return
+	}
+
+	format := strings.ToLower(c.DefaultQuery("format", "json"))
+	if format != "json" && format != "markdown" && format != "md" {
+		c.JSON(http.StatusBadRequest, webuimodels.ErrorResponse("Unsupported export format: "+format))
+		return
+	}
+
+	userID := getCurrentUserID(c)
+	sessionID := middleware.GetSessionID(c)
+
+	if hiddenAlertsService != nil && sessionID != "" {
+		hiddenAlertsService.LoadUserData(sessionID)
+	}
+
+	filters := parseDashboardFilters(c)
+	sorting := parseDashboardSorting(c)
+	groupBy := c.DefaultQuery("groupBy", "alertname")
+
+	alerts := getFilteredAndSortedAlerts(alertCache.Snapshot().Alerts(), filters, sorting, userID, sessionID)
+	report := buildGroupedExportReport(groupAlertsByLabel(alerts, groupBy), groupBy, filters.DisplayMode)
+
+	filename := fmt.Sprintf("notificator-groups-%s", report.GeneratedAt.Format("20060102-150405"))
+
+	if format == "json" {
+		c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%s.json", filename))
+		c.IndentedJSON(http.StatusOK, report)
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%s.md", filename))
+	c.Data(http.StatusOK, "text/markdown; charset=utf-8", []byte(renderGroupedExportMarkdown(report)))
+}
+
+// ExportAlertsCSV exports the current alert table as CSV. Like the grouped
+// export it uses the dashboard's filters and sorting over the whole filtered
+// set, ignoring pagination.
+func ExportAlertsCSV(c *gin.Context) {
+	if alertCache == nil {
+		c.JSON(http.StatusServiceUnavailable, webuimodels.ErrorResponse("Dashboard cache not ready"))
+		return
+	}
+
+	userID := getCurrentUserID(c)
+	sessionID := middleware.GetSessionID(c)
+
+	if hiddenAlertsService != nil && sessionID != "" {
+		hiddenAlertsService.LoadUserData(sessionID)
+	}
+
+	filters := parseDashboardFilters(c)
+	sorting := parseDashboardSorting(c)
+	alerts := getFilteredAndSortedAlerts(alertCache.Snapshot().Alerts(), filters, sorting, userID, sessionID)
+
+	filename := fmt.Sprintf("notificator-alerts-%s.csv", time.Now().UTC().Format("20060102-150405"))
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%s", filename))
+	c.Header("Content-Type", "text/csv; charset=utf-8")
+	c.Status(http.StatusOK)
+
+	if err := exportAlertsCSV(alerts, c.Writer); err != nil {
+		c.Error(err)
+	}
+}
+
+// ExportAlertJSON downloads a single alert as a models.Alert JSON document
+// (labels, annotations, status, RFC3339 timestamps and generator URL), so it
+// can be attached to tickets and decoded back into a models.Alert
+func ExportAlertJSON(c *gin.Context) {
+	if alertCache == nil {
+		c.JSON(http.StatusServiceUnavailable, webuimodels.ErrorResponse("Dashboard cache not ready"))
+		return
+	}
+
+	fingerprint := c.Param("fingerprint")
+	alert := alertCache.GetAlertByFingerprint(fingerprint)
+	if alert == nil {
+		c.JSON(http.StatusNotFound, webuimodels.ErrorResponse("Alert not found"))
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=alert-%s.json", fingerprint))
+	c.IndentedJSON(http.StatusOK, convertDashboardToModel(alert))
+}
+
+// exportAlertsCSV writes one row per alert; encoding/csv quotes fields that
+// contain commas, quotes or newlines
+func exportAlertsCSV(alerts []*webuimodels.DashboardAlert, w io.Writer) error {
+	writer := csv.NewWriter(w)
+
+	header := []string{"alertname", "severity", "status", "team", "instance", "started", "duration", "summary"}
+	if err := writer.Write(header); err != nil {
+		return err
+	}
+
+	for _, alert := range alerts {
+		row := []string{
+			alert.AlertName,
+			alert.Severity,
+			alert.Status.State,
+			alert.Team,
+			alert.Instance,
+			alert.StartsAt.UTC().Format(time.RFC3339),
+			formatDuration(time.Duration(alert.Duration) * time.Second),
+			alert.Summary,
+		}
+		if err := writer.Write(row); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+func buildGroupedExportReport(groups []webuimodels.AlertGroup, groupBy string, displayMode webuimodels.DashboardDisplayMode) webuimodels.GroupedExportReport {
+	report := webuimodels.GroupedExportReport{
+		GeneratedAt: time.Now().UTC(),
+		GroupBy:     groupBy,
+		DisplayMode: displayMode,
+		TotalGroups: len(groups),
+		Groups:      make([]webuimodels.GroupedExportGroup, 0, len(groups)),
+	}
+
+	for _, group := range groups {
+		severityCounts := make(map[string]int)
+		for _, alert := range group.Alerts {
+			severity := alert.Severity
+			if severity == "" {
+				severity = "unknown"
+			}
+			severityCounts[severity]++
+		}
+
+		report.TotalAlerts += group.Count
+		report.Groups = append(report.Groups, webuimodels.GroupedExportGroup{
+			GroupName:      group.GroupName,
+			Count:          group.Count,
+			WorstSeverity:  group.WorstSeverity,
+			SeverityCounts: severityCounts,
+			Alerts:         group.Alerts,
+		})
+	}
+
+	return report
+}
+
+func renderGroupedExportMarkdown(report webuimodels.GroupedExportReport) string {
+	var b strings.Builder
+
+	b.WriteString("# Notificator Alert Report\n\n")
+	fmt.Fprintf(&b, "- Generated: %s\n", report.GeneratedAt.Format(time.RFC3339))
+	fmt.Fprintf(&b, "- Grouped by: `%s`\n", report.GroupBy)
+	fmt.Fprintf(&b, "- Total: %d alerts in %d groups\n", report.TotalAlerts, report.TotalGroups)
+
+	for _, group := range report.Groups {
+		fmt.Fprintf(&b, "\n## %s (%d)\n\n", group.GroupName, group.Count)
+		fmt.Fprintf(&b, "Severity: %s\n\n", formatSeverityCounts(group.SeverityCounts))
+
+		for _, alert := range group.Alerts {
+			fmt.Fprintf(&b, "- **[%s]** %s", alert.Severity, alert.AlertName)
+			if alert.Instance != "" {
+				fmt.Fprintf(&b, " on `%s`", alert.Instance)
+			}
+			fmt.Fprintf(&b, " (%s, since %s)", alert.Status.State, alert.StartsAt.UTC().Format(time.RFC3339))
+			if alert.Summary != "" {
+				fmt.Fprintf(&b, " — %s", strings.ReplaceAll(alert.Summary, "\n", " "))
+			}
+			b.WriteString("\n")
+		}
+	}
+
+	return b.String()
+}
+
+// formatSeverityCounts renders severity counts from the most to the least severe
+func formatSeverityCounts(counts map[string]int) string {
+	severities := make([]string, 0, len(counts))
+	for severity := range counts {
+		severities = append(severities, severity)
+	}
+	sort.Slice(severities, func(i, j int) bool {
+		pi, pj := getSeverityPriority(severities[i]), getSeverityPriority(severities[j])
+		if pi != pj {
+			return pi > pj
+		}
+		return severities[i] < severities[j]
+	})
+
+	parts := make([]string, 0, len(severities))
+	for _, severity := range severities {
+		parts = append(parts, fmt.Sprintf("%s: %d", severity, counts[severity]))
+	}
+	return strings.Join(parts, ", ")
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"net/url"
+	"strconv"
 	"strings"
 	"time"
 
@@ -111,7 +113,7 @@ func Login(c *gin.Context) {
 		return
 	}
 
-	result, err := backendClient.Login(username, password)
+	result, err := backendClient.Login(username, password, c.ClientIP())
 	if err != nil {
 		c.JSON(http.StatusServiceUnavailable, models.ErrorResponse("Authentication service unavailable. Please check if the backend server is running."))
 		return
@@ -275,7 +277,7 @@ func GetAlerts(c *gin.Context) {
 	}
 
 	alertsWithSource, err := alertmanagerClient.FetchAllAlerts()
-	if err != nil {
+	if err != nil && len(alertsWithSource) == 0 {
 		c.JSON(http.StatusOK, models.SuccessResponse(getMockAlerts(search, severityFilter, statusFilter)))
 		return
 	}
@@ -323,6 +325,55 @@ func GetAlerts(c *gin.Context) {
 	c.JSON(http.StatusOK, models.SuccessResponse(filteredAlerts))
 }
 
+// ListAlerts serves the aggregated alert list from the alert cache as JSON, so
+// external dashboards can build on notificator without talking to the
+// Alertmanagers. severity and status accept comma-separated values; "firing"
+// is accepted for Alertmanager's "active" state.
+func ListAlerts(c *gin.Context) {
+	if alertCache == nil {
+		c.JSON(http.StatusServiceUnavailable, models.ErrorResponse("Alert cache not available"))
+		return
+	}
+
+	severities := parseListQuery(c.Query("severity"))
+	statuses := parseListQuery(c.Query("status"))
+	if statuses["firing"] {
+		statuses["active"] = true
+	}
+	if statuses["suppressed"] {
+		statuses["silenced"] = true
+	}
+
+	snapshot := alertCache.Snapshot()
+	alerts := make([]*models.DashboardAlert, 0, snapshot.Len())
+	for _, alert := range snapshot.Alerts() {
+		if len(severities) > 0 && !severities[strings.ToLower(alert.Severity)] {
+			continue
+		}
+		if len(statuses) > 0 && !statuses[strings.ToLower(alert.Status.State)] {
+			continue
+		}
+		alerts = append(alerts, alert)
+	}
+
+	c.JSON(http.StatusOK, models.SuccessResponse(gin.H{
+		"alerts":      alerts,
+		"count":       len(alerts),
+		"lastUpdated": snapshot.BuiltAt.Format(time.RFC3339),
+	}))
+}
+
+// parseListQuery turns "a,b" into a lower-cased set
+func parseListQuery(value string) map[string]bool {
+	set := make(map[string]bool)
+	for _, part := range strings.Split(value, ",") {
+		if part = strings.ToLower(strings.TrimSpace(part)); part != "" {
+			set[part] = true
+		}
+	}
+	return set
+}
+
 func HealthCheck(c *gin.Context) {
 	c.JSON(http.StatusOK, models.SuccessResponse(gin.H{
 		"status":  "ok",
@@ -385,6 +436,94 @@ func AlertmanagerHealthCheck(c *gin.Context) {
 	}))
 }
 
+// AlertmanagerDiagnostics runs a connectivity test against every configured
+// Alertmanager and returns per-source details (latency, status, auth/headers applied).
+func AlertmanagerDiagnostics(c *gin.Context) {
+	if alertmanagerClient == nil {
+		c.JSON(http.StatusServiceUnavailable, models.ErrorResponse("Alertmanager client not initialized"))
+		return
+	}
+
+	attempts := 1
+	if attemptsStr := c.Query("attempts"); attemptsStr != "" {
+		if val, err := strconv.Atoi(attemptsStr); err == nil && val > 0 && val <= 5 {
+			attempts = val
+		}
+	}
+
+	diagnostics := alertmanagerClient.DiagnoseAllConnections(attempts)
+
+	healthy := 0
+	for _, diag := range diagnostics {
+		if diag.Healthy() {
+			healthy++
+		}
+	}
+
+	c.JSON(http.StatusOK, models.SuccessResponse(gin.H{
+		"instances": diagnostics,
+		"healthy":   healthy,
+		"total":     len(diagnostics),
+	}))
+}
+
+// GetAlertmanagerStatus returns each Alertmanager's recorded fetch health and
+// alert counts. Unlike the health check and diagnostics it makes no requests,
+// so the status page can poll it.
+// GET /api/v1/alertmanagers/status
+func GetAlertmanagerStatus(c *gin.Context) {
+	if alertmanagerClient == nil {
+		c.JSON(http.StatusServiceUnavailable, models.ErrorResponse("Alertmanager client not initialized"))
+		return
+	}
+
+	var alerts []*models.DashboardAlert
+	if alertCache != nil {
+		alerts = alertCache.Snapshot().Alerts()
+	}
+	statuses := services.AlertmanagerStatuses(alertmanagerClient.SourceHealth(), alerts)
+
+	unhealthy := 0
+	for _, status := range statuses {
+		if status.State == services.SourceStateUnhealthy {
+			unhealthy++
+		}
+	}
+
+	c.JSON(http.StatusOK, models.SuccessResponse(gin.H{
+		"instances": statuses,
+		"unhealthy": unhealthy,
+		"total":     len(statuses),
+	}))
+}
+
+// alertmanagerHealthMap is the last fetch outcome of every Alertmanager that
+// has been fetched, for the dashboard metadata
+func alertmanagerHealthMap() map[string]bool {
+	if alertmanagerClient == nil {
+		return make(map[string]bool)
+	}
+	return services.SourceHealthMap(alertmanagerClient.SourceHealth())
+}
+
+// StatusPage serves the Alertmanager status page
+func StatusPage(c *gin.Context) {
+	user := middleware.GetCurrentUserFromContext(c)
+	if user == nil {
+		c.Redirect(http.StatusFound, "/login")
+		return
+	}
+
+	c.Header("Content-Type", "text/html")
+	pages.Status(pages.StatusPageData{
+		User: pages.ProfileUser{
+			ID:       user.ID,
+			Username: user.Username,
+			Email:    user.Email,
+		},
+	}).Render(c.Request.Context(), c.Writer)
+}
+
 func IndexPage(c *gin.Context) {
 	c.Header("Content-Type", "text/html")
 	pages.Index().Render(context.Background(), c.Writer)
@@ -430,6 +569,12 @@ func DashboardPage(c *gin.Context) {
 	pages.NewDashboard().Render(context.Background(), c.Writer)
 }
 
+// AlertLinkPage resolves a shared /alert/:fingerprint link to the dashboard
+// with the alert's details open; resolved alerts fall back to their stored data
+func AlertLinkPage(c *gin.Context) {
+	c.Redirect(http.StatusFound, "/dashboard/alert/"+url.PathEscape(c.Param("fingerprint")))
+}
+
 func generateFingerprint(labels map[string]string) string {
 	fingerprint := ""
 	for key, value := range labels {
@@ -1,7 +1,9 @@
 package handlers
 
 import (
+	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"notificator/internal/backend/models"
@@ -32,7 +34,12 @@ func GetUserHiddenAlerts(c *gin.Context) {
 	}))
 }
 
-// HideAlert hides a specific alert for the current user
+// maxSnoozeDuration is the longest an alert can be snoozed for; hiding is the
+// way to get rid of an alert for longer
+const maxSnoozeDuration = 30 * 24 * time.Hour
+
+// HideAlert hides a specific alert for the current user, or snoozes it when
+// the request carries a snooze duration such as "15m" or "4h"
 func HideAlert(c *gin.Context) {
 	sessionID := middleware.GetSessionID(c)
 	if sessionID == "" {
@@ -48,6 +55,8 @@ func HideAlert(c *gin.Context) {
 		AlertName   string `json:"alertName"`
 		Instance    string `json:"instance"`
 		Reason      string `json:"reason"`
+		// SnoozeDuration hides the alert only for this long
+		SnoozeDuration string `json:"snoozeDuration"`
 	}
 
 	if err := c.ShouldBindJSON(&request); err != nil {
@@ -55,26 +64,43 @@ func HideAlert(c *gin.Context) {
 		return
 	}
 
-	// Get the alert from cache to get full details
-	alert, exists := alertCache.GetAlert(request.Fingerprint)
-	if exists {
-		err := hiddenAlertsService.HideAlert(sessionID, alert, request.Reason, impersonateUserID)
-		if err != nil {
-			c.JSON(http.StatusInternalServerError, webuimodels.ErrorResponse("Failed to hide alert"))
+	var snoozeDuration time.Duration
+	if request.SnoozeDuration != "" {
+		duration, err := time.ParseDuration(request.SnoozeDuration)
+		if err != nil || duration <= 0 || duration > maxSnoozeDuration {
+			c.JSON(http.StatusBadRequest, webuimodels.ErrorResponse("Snooze duration must be positive and at most 30 days"))
 			return
 		}
-	} else {
+		snoozeDuration = duration
+	}
+
+	// Get the alert from cache to get full details
+	alert, exists := alertCache.GetAlert(request.Fingerprint)
+	if !exists {
 		// If alert not in cache, create a minimal alert object
-		alert := &webuimodels.DashboardAlert{
+		alert = &webuimodels.DashboardAlert{
 			Fingerprint: request.Fingerprint,
 			AlertName:   request.AlertName,
 			Instance:    request.Instance,
 		}
-		err := hiddenAlertsService.HideAlert(sessionID, alert, request.Reason, impersonateUserID)
-		if err != nil {
-			c.JSON(http.StatusInternalServerError, webuimodels.ErrorResponse("Failed to hide alert"))
+	}
+
+	if snoozeDuration > 0 {
+		snoozedUntil := time.Now().Add(snoozeDuration)
+		if err := hiddenAlertsService.SnoozeAlert(sessionID, alert, request.Reason, snoozedUntil, impersonateUserID); err != nil {
+			c.JSON(http.StatusInternalServerError, webuimodels.ErrorResponse("Failed to snooze alert"))
 			return
 		}
+		c.JSON(http.StatusOK, webuimodels.SuccessResponse(gin.H{
+			"message":      "Alert snoozed successfully",
+			"snoozedUntil": snoozedUntil,
+		}))
+		return
+	}
+
+	if err := hiddenAlertsService.HideAlert(sessionID, alert, request.Reason, impersonateUserID); err != nil {
+		c.JSON(http.StatusInternalServerError, webuimodels.ErrorResponse("Failed to hide alert"))
+		return
 	}
 
 	c.JSON(http.StatusOK, webuimodels.SuccessResponse(gin.H{
@@ -110,6 +136,37 @@ func UnhideAlert(c *gin.Context) {
 	}))
 }
 
+// UnhideAlerts unhides the alerts listed in the request body in one batch
+func UnhideAlerts(c *gin.Context) {
+	sessionID := middleware.GetSessionID(c)
+	if sessionID == "" {
+		c.JSON(http.StatusUnauthorized, webuimodels.ErrorResponse("Unauthorized"))
+		return
+	}
+
+	// Get impersonated user ID if impersonating
+	impersonateUserID := middleware.GetImpersonatedUserID(c)
+
+	var req struct {
+		Fingerprints []string `json:"fingerprints" binding:"required,min=1"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, webuimodels.ErrorResponse("At least one fingerprint is required"))
+		return
+	}
+
+	unhidden, err := hiddenAlertsService.UnhideAlerts(sessionID, req.Fingerprints, impersonateUserID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, webuimodels.ErrorResponse("Failed to unhide alerts"))
+		return
+	}
+
+	c.JSON(http.StatusOK, webuimodels.SuccessResponse(gin.H{
+		"message":  fmt.Sprintf("Unhid %d alerts", unhidden),
+		"unhidden": unhidden,
+	}))
+}
+
 // GetUserHiddenRules returns the list of hidden rules for the current user
 func GetUserHiddenRules(c *gin.Context) {
 	sessionID := middleware.GetSessionID(c)
@@ -127,9 +184,16 @@ func GetUserHiddenRules(c *gin.Context) {
 		return
 	}
 
+	// How many active alerts each rule hides right now, keyed by rule ID
+	var activeAlerts []*webuimodels.DashboardAlert
+	if alertCache != nil {
+		activeAlerts = alertCache.Snapshot().Alerts()
+	}
+
 	c.JSON(http.StatusOK, webuimodels.SuccessResponse(gin.H{
-		"rules": rules,
-		"count": len(rules),
+		"rules":        rules,
+		"count":        len(rules),
+		"match_counts": hiddenAlertsService.CountHiddenRuleMatches(rules, activeAlerts),
 	}))
 }
 
@@ -241,13 +305,46 @@ func ClearAllHiddenAlerts(c *gin.Context) {
 	// Get impersonated user ID if impersonating
 	impersonateUserID := middleware.GetImpersonatedUserID(c)
 
-	err := hiddenAlertsService.ClearAllHiddenAlerts(sessionID, impersonateUserID)
+	clearedAt, undoUntil, err := hiddenAlertsService.ClearAllHiddenAlerts(sessionID, impersonateUserID)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, webuimodels.ErrorResponse("Failed to clear hidden alerts"))
 		return
 	}
 
 	c.JSON(http.StatusOK, webuimodels.SuccessResponse(gin.H{
-		"message": "All hidden alerts cleared successfully",
+		"message":   "All hidden alerts cleared successfully",
+		"clearedAt": clearedAt,
+		"undoUntil": undoUntil,
+	}))
+}
+
+// UndoClearAllHiddenAlerts brings back the hidden alerts removed by
+// ClearAllHiddenAlerts while its undo window is open
+func UndoClearAllHiddenAlerts(c *gin.Context) {
+	sessionID := middleware.GetSessionID(c)
+	if sessionID == "" {
+		c.JSON(http.StatusUnauthorized, webuimodels.ErrorResponse("Unauthorized"))
+		return
+	}
+
+	// Get impersonated user ID if impersonating
+	impersonateUserID := middleware.GetImpersonatedUserID(c)
+
+	var req struct {
+		ClearedAt time.Time `json:"clearedAt" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, webuimodels.ErrorResponse("clearedAt is required"))
+		return
+	}
+
+	restored, err := hiddenAlertsService.UndoClearAllHiddenAlerts(sessionID, req.ClearedAt, impersonateUserID)
+	if err != nil {
+		c.JSON(http.StatusConflict, webuimodels.ErrorResponse(err.Error()))
+		return
+	}
+
+	c.JSON(http.StatusOK, webuimodels.SuccessResponse(gin.H{
+		"restored": restored,
 	}))
 }
\ No newline at end of file
@@ -19,7 +19,9 @@ func SetAppConfig(cfg *config.Config) {
 	appConfig = cfg
 }
 
-// canImpersonate checks if the current user is allowed to impersonate
+// canImpersonate checks if the current user is allowed to impersonate. Only
+// users listed by username or email in admin.impersonation_allowed_users may;
+// being an admin is not enough.
 func canImpersonate(c *gin.Context) bool {
 	if appConfig == nil {
 		return false
@@ -30,10 +32,16 @@ func canImpersonate(c *gin.Context) bool {
 		return false
 	}
 
-	// Check by username or email
 	return appConfig.Admin.CanImpersonate(user.Username) || appConfig.Admin.CanImpersonate(user.Email)
 }
 
+// isAdmin checks if the current user may use the admin pages and actions:
+// backend admins and users allowed to impersonate
+func isAdmin(c *gin.Context) bool {
+	user := middleware.GetCurrentUserFromContext(c)
+	return user != nil && (user.IsAdmin || canImpersonate(c))
+}
+
 // StartImpersonation starts impersonating a user
 // POST /api/impersonate/start
 func StartImpersonation(c *gin.Context) {
@@ -182,6 +190,7 @@ func GetImpersonationStatus(c *gin.Context) {
 	response := gin.H{
 		"is_impersonating": isImpersonating,
 		"can_impersonate":  canImpersonate(c),
+		"is_admin":         isAdmin(c),
 	}
 
 	if isImpersonating {
@@ -37,6 +37,7 @@ func GetNotificationPreferences(c *gin.Context) {
 		"browser_notifications_enabled": prefs.BrowserNotificationsEnabled,
 		"enabled_severities":            prefs.EnabledSeverities,
 		"sound_notifications_enabled":   prefs.SoundNotificationsEnabled,
+		"dnd":                           prefs.DND,
 	}))
 }
 
@@ -53,6 +54,8 @@ func SaveNotificationPreferences(c *gin.Context) {
 		BrowserNotificationsEnabled bool     `json:"browser_notifications_enabled"`
 		EnabledSeverities           []string `json:"enabled_severities"`
 		SoundNotificationsEnabled   bool     `json:"sound_notifications_enabled"`
+		// Omitted by callers that only toggle the settings above
+		DND *client.DNDSchedule `json:"dnd"`
 	}
 
 	if err := c.ShouldBindJSON(&request); err != nil {
@@ -71,13 +74,14 @@ func SaveNotificationPreferences(c *gin.Context) {
 		BrowserNotificationsEnabled: request.BrowserNotificationsEnabled,
 		EnabledSeverities:           request.EnabledSeverities,
 		SoundNotificationsEnabled:   request.SoundNotificationsEnabled,
+		DND:                         request.DND,
 	}
 
-	// Save to backend
+	// Save to backend; the backend's message explains rejected schedules
 	err := backendClient.SaveNotificationPreferences(sessionID, prefs)
 	if err != nil {
 		log.Printf("Failed to save notification preferences: %v", err)
-		c.JSON(http.StatusInternalServerError, webuimodels.ErrorResponse("Failed to save notification preferences"))
+		c.JSON(http.StatusBadRequest, webuimodels.ErrorResponse(err.Error()))
 		return
 	}
 
@@ -88,5 +92,6 @@ func SaveNotificationPreferences(c *gin.Context) {
 		"browser_notifications_enabled": prefs.BrowserNotificationsEnabled,
 		"enabled_severities":            prefs.EnabledSeverities,
 		"sound_notifications_enabled":   prefs.SoundNotificationsEnabled,
+		"dnd":                           prefs.DND,
 	}))
 }
@@ -0,0 +1,88 @@
+package handlers
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"notificator/internal/webui/middleware"
+	webuimodels "notificator/internal/webui/models"
+)
+
+// maxPreferencesBundleSize bounds the body accepted by ImportPreferences
+const maxPreferencesBundleSize = 1 << 20
+
+// ExportPreferences downloads the user's color preferences, filter presets,
+// annotation buttons, hidden rules and notification preferences as one JSON file
+func ExportPreferences(c *gin.Context) {
+	sessionID := middleware.GetSessionID(c)
+	if sessionID == "" {
+		c.JSON(http.StatusUnauthorized, webuimodels.ErrorResponse("User not authenticated"))
+		return
+	}
+
+	if backendClient == nil || !backendClient.IsConnected() {
+		c.JSON(http.StatusServiceUnavailable, webuimodels.ErrorResponse("Backend service not available"))
+		return
+	}
+
+	bundle, err := backendClient.ExportPreferences(sessionID)
+	if err != nil {
+		log.Printf("Failed to export preferences: %v", err)
+		c.JSON(http.StatusInternalServerError, webuimodels.ErrorResponse("Failed to export preferences"))
+		return
+	}
+
+	name := "user"
+	if user := middleware.GetCurrentUserFromContext(c); user != nil {
+		name = user.Username
+	}
+	filename := fmt.Sprintf("notificator-%s-preferences-%s.json", name, time.Now().Format("20060102-150405"))
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	c.Data(http.StatusOK, "application/json", bundle)
+}
+
+// ImportPreferences loads a bundle produced by ExportPreferences, sent as the
+// raw request body, into the user's settings
+func ImportPreferences(c *gin.Context) {
+	sessionID := middleware.GetSessionID(c)
+	if sessionID == "" {
+		c.JSON(http.StatusUnauthorized, webuimodels.ErrorResponse("User not authenticated"))
+		return
+	}
+
+	bundle, err := io.ReadAll(http.MaxBytesReader(c.Writer, c.Request.Body, maxPreferencesBundleSize))
+	if err != nil {
+		c.JSON(http.StatusRequestEntityTooLarge, webuimodels.ErrorResponse("Preferences file is too large"))
+		return
+	}
+
+	if backendClient == nil || !backendClient.IsConnected() {
+		c.JSON(http.StatusServiceUnavailable, webuimodels.ErrorResponse("Backend service not available"))
+		return
+	}
+
+	created, updated, err := backendClient.ImportPreferences(sessionID, bundle)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, webuimodels.ErrorResponse(err.Error()))
+		return
+	}
+
+	// Imported color preferences apply to the next color computation
+	if colorService != nil {
+		colorService.InvalidateUserCache(sessionID)
+	}
+	if alertCache != nil {
+		alertCache.InvalidateColorCache(sessionID)
+	}
+
+	c.JSON(http.StatusOK, webuimodels.SuccessResponse(gin.H{
+		"message": fmt.Sprintf("Imported preferences: %d added, %d updated", created, updated),
+		"created": created,
+		"updated": updated,
+	}))
+}
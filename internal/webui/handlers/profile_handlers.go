@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"fmt"
 	"net/http"
 	"strings"
 	"time"
@@ -151,3 +152,97 @@ func GetTimezone(c *gin.Context) {
 		"timezone": timezone,
 	}))
 }
+
+// ChangePassword changes the current user's local password
+func ChangePassword(c *gin.Context) {
+	user := middleware.GetCurrentUserFromContext(c)
+	if user == nil {
+		c.JSON(http.StatusUnauthorized, models.ErrorResponse("Not authenticated"))
+		return
+	}
+
+	if user.IsOAuthUser() {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse("This account signs in with OAuth and has no password to change"))
+		return
+	}
+
+	var req struct {
+		OldPassword string `json:"old_password" binding:"required"`
+		NewPassword string `json:"new_password" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse("Current and new passwords are required"))
+		return
+	}
+
+	if backendClient == nil || !backendClient.IsConnected() {
+		c.JSON(http.StatusServiceUnavailable, models.ErrorResponse("Backend service not available"))
+		return
+	}
+
+	if err := backendClient.ChangePassword(middleware.GetSessionIDFromContext(c), req.OldPassword, req.NewPassword); err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse(err.Error()))
+		return
+	}
+
+	c.JSON(http.StatusOK, models.SuccessResponse(gin.H{
+		"message": "Password changed successfully",
+	}))
+}
+
+// ExportUserData downloads everything stored about the current user as JSON
+func ExportUserData(c *gin.Context) {
+	user := middleware.GetCurrentUserFromContext(c)
+	if user == nil {
+		c.JSON(http.StatusUnauthorized, models.ErrorResponse("Not authenticated"))
+		return
+	}
+
+	if backendClient == nil || !backendClient.IsConnected() {
+		c.JSON(http.StatusServiceUnavailable, models.ErrorResponse("Backend service not available"))
+		return
+	}
+
+	data, err := backendClient.ExportUserData(middleware.GetSessionIDFromContext(c))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse("Failed to export user data"))
+		return
+	}
+
+	filename := fmt.Sprintf("notificator-%s-data-%s.json", user.Username, time.Now().Format("20060102-150405"))
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	c.Data(http.StatusOK, "application/json", data)
+}
+
+// DeleteAccount permanently deletes the current user's account and data,
+// then signs them out
+func DeleteAccount(c *gin.Context) {
+	user := middleware.GetCurrentUserFromContext(c)
+	if user == nil {
+		c.JSON(http.StatusUnauthorized, models.ErrorResponse("Not authenticated"))
+		return
+	}
+
+	// The session belongs to the admin while impersonating
+	if middleware.IsImpersonating(c) {
+		c.JSON(http.StatusForbidden, models.ErrorResponse("Stop impersonating before deleting an account"))
+		return
+	}
+
+	if backendClient == nil || !backendClient.IsConnected() {
+		c.JSON(http.StatusServiceUnavailable, models.ErrorResponse("Backend service not available"))
+		return
+	}
+
+	if err := backendClient.DeleteAccount(middleware.GetSessionIDFromContext(c)); err != nil {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse("Failed to delete account"))
+		return
+	}
+
+	middleware.ClearSession(c)
+
+	c.JSON(http.StatusOK, models.SuccessResponse(gin.H{
+		"message":  "Account deleted",
+		"redirect": "/",
+	}))
+}
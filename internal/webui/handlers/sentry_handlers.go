@@ -105,9 +105,10 @@ func GetUserSentryConfig(c *gin.Context) {
 		}
 		
 		response := gin.H{
-			"has_token":   false,
-			"base_url":    defaultBaseURL,
-			"auth_status": "none",
+			"has_token":      false,
+			"base_url":       defaultBaseURL,
+			"auth_status":    "none",
+			"project_labels": sentryProjectLabels(),
 		}
 		c.JSON(http.StatusOK, response)
 		return
@@ -132,11 +133,21 @@ func GetUserSentryConfig(c *gin.Context) {
 			}
 			return "none"
 		}(),
+		"project_labels": sentryProjectLabels(),
 	}
 
 	c.JSON(http.StatusOK, response)
 }
 
+// sentryProjectLabels lists the labels that link an alert to a Sentry project,
+// empty unless an organization is configured for the lookup
+func sentryProjectLabels() []string {
+	if sentryService == nil || sentryService.GetConfig() == nil || sentryService.GetConfig().Organization == "" {
+		return []string{}
+	}
+	return sentryService.GetConfig().ProjectLabels
+}
+
 // SaveUserSentryToken saves the user's Sentry personal access token
 func SaveUserSentryToken(c *gin.Context) {
 	user := middleware.GetCurrentUserFromContext(c)
@@ -0,0 +1,174 @@
+package handlers
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"notificator/config"
+	"notificator/internal/notifier"
+	"notificator/internal/webui/middleware"
+	webuimodels "notificator/internal/webui/models"
+
+	"github.com/gin-gonic/gin"
+)
+
+// shareSlackConfig returns the Slack webhook alerts can be shared to, or nil
+// when notifications.slack or webui.external_url, which the shared links
+// point to, is not configured
+func shareSlackConfig() *config.SlackConfig {
+	if appConfig == nil || appConfig.WebUI.ExternalURL == "" {
+		return nil
+	}
+	slack := appConfig.Notifications.Slack
+	if !slack.Enabled || slack.WebhookURL == "" {
+		return nil
+	}
+	return &slack
+}
+
+// shareEmailConfig returns the SMTP settings alerts can be emailed with, or
+// nil when notifications.email (with recipients) or webui.external_url is not
+// configured
+func shareEmailConfig() *config.EmailConfig {
+	if appConfig == nil || appConfig.WebUI.ExternalURL == "" {
+		return nil
+	}
+	email := appConfig.Notifications.Email
+	if !email.Enabled || email.Host == "" || len(email.To) == 0 {
+		return nil
+	}
+	return &email
+}
+
+// GetShareTargets lists where alerts can be shared besides the clipboard
+// GET /api/v1/dashboard/share/targets
+func GetShareTargets(c *gin.Context) {
+	targets := gin.H{
+		"comments": backendClient != nil && backendClient.IsConnected(),
+		"slack":    false,
+		"email":    shareEmailConfig() != nil,
+	}
+	if slack := shareSlackConfig(); slack != nil {
+		targets["slack"] = true
+		targets["slackChannel"] = slack.Channel
+	}
+
+	c.JSON(http.StatusOK, webuimodels.SuccessResponse(targets))
+}
+
+// ShareAlertToSlack posts an alert's link, with an optional note, to the
+// configured Slack webhook
+// POST /api/v1/dashboard/alert/:fingerprint/share/slack
+func ShareAlertToSlack(c *gin.Context) {
+	if alertCache == nil {
+		c.JSON(http.StatusServiceUnavailable, webuimodels.ErrorResponse("Dashboard cache not ready"))
+		return
+	}
+
+	fingerprint := c.Param("fingerprint")
+
+	var request struct {
+		Note string `json:"note"`
+	}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, webuimodels.ErrorResponse("Invalid request format: "+err.Error()))
+		return
+	}
+
+	slack := shareSlackConfig()
+	if slack == nil {
+		c.JSON(http.StatusServiceUnavailable, webuimodels.ErrorResponse("Slack sharing is not configured"))
+		return
+	}
+
+	// Resolved alerts can be shared too
+	alert, ok := alertCache.GetAlert(fingerprint)
+	if !ok {
+		c.JSON(http.StatusNotFound, webuimodels.ErrorResponse("Alert not found"))
+		return
+	}
+
+	sharedBy := shareAuthor(c)
+	text := fmt.Sprintf("%s shared [%s] %s: %s",
+		notifier.EscapeText(sharedBy), notifier.EscapeText(alert.Severity), notifier.EscapeText(alert.AlertName), alertShareURL(fingerprint))
+	if note := strings.TrimSpace(request.Note); note != "" {
+		text += "\n> " + strings.ReplaceAll(notifier.EscapeText(note), "\n", "\n> ")
+	}
+
+	slackNotifier := notifier.NewSlackNotifier(notifier.SlackConfig{WebhookURL: slack.WebhookURL, Channel: slack.Channel})
+	if err := slackNotifier.PostText(text); err != nil {
+		log.Printf("Failed to share alert %s to Slack: %v", fingerprint, err)
+		c.JSON(http.StatusBadGateway, webuimodels.ErrorResponse("Failed to post to Slack"))
+		return
+	}
+
+	c.JSON(http.StatusOK, webuimodels.SuccessResponse(gin.H{
+		"message": "Alert shared to Slack",
+	}))
+}
+
+// ShareAlertByEmail emails an alert's link, with an optional note, to the
+// notifications.email recipients through the SMTP notifier
+// POST /api/v1/dashboard/alert/:fingerprint/share/email
+func ShareAlertByEmail(c *gin.Context) {
+	if alertCache == nil {
+		c.JSON(http.StatusServiceUnavailable, webuimodels.ErrorResponse("Dashboard cache not ready"))
+		return
+	}
+
+	fingerprint := c.Param("fingerprint")
+
+	var request struct {
+		Note string `json:"note"`
+	}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, webuimodels.ErrorResponse("Invalid request format: "+err.Error()))
+		return
+	}
+
+	email := shareEmailConfig()
+	if email == nil {
+		c.JSON(http.StatusServiceUnavailable, webuimodels.ErrorResponse("Email sharing is not configured"))
+		return
+	}
+
+	// Resolved alerts can be shared too
+	alert, ok := alertCache.GetAlert(fingerprint)
+	if !ok {
+		c.JSON(http.StatusNotFound, webuimodels.ErrorResponse("Alert not found"))
+		return
+	}
+
+	sharedBy := shareAuthor(c)
+	subject := fmt.Sprintf("[Notificator] %s shared %s", sharedBy, alert.AlertName)
+	body := fmt.Sprintf("%s shared [%s] %s:\r\n%s\r\n", sharedBy, alert.Severity, alert.AlertName, alertShareURL(fingerprint))
+	if note := strings.TrimSpace(request.Note); note != "" {
+		body += "\r\n" + note + "\r\n"
+	}
+
+	if err := notifier.NewEmailNotifier(notifier.EmailConfig(*email)).Send(subject, body); err != nil {
+		log.Printf("Failed to share alert %s by email: %v", fingerprint, err)
+		c.JSON(http.StatusBadGateway, webuimodels.ErrorResponse("Failed to send the email"))
+		return
+	}
+
+	c.JSON(http.StatusOK, webuimodels.SuccessResponse(gin.H{
+		"message": "Alert shared by email",
+	}))
+}
+
+// shareAuthor is the username shown as the sharer of an alert
+func shareAuthor(c *gin.Context) string {
+	if user := middleware.GetCurrentUserFromContext(c); user != nil {
+		return user.Username
+	}
+	return "Someone"
+}
+
+// alertShareURL is the absolute /alert/:fingerprint link under webui.external_url
+func alertShareURL(fingerprint string) string {
+	return strings.TrimRight(appConfig.WebUI.ExternalURL, "/") + "/alert/" + url.PathEscape(fingerprint)
+}
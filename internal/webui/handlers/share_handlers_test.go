@@ -0,0 +1,30 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestShareAlert_WithoutCacheIsUnavailable(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	previous := alertCache
+	alertCache = nil
+	t.Cleanup(func() { alertCache = previous })
+
+	router := gin.New()
+	router.POST("/alert/:fingerprint/share/slack", ShareAlertToSlack)
+	router.POST("/alert/:fingerprint/share/email", ShareAlertByEmail)
+
+	for _, target := range []string{"slack", "email"} {
+		recorder := httptest.NewRecorder()
+		router.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/alert/fp-1/share/"+target, strings.NewReader(`{}`)))
+		if recorder.Code != http.StatusServiceUnavailable {
+			t.Errorf("%s: expected 503 before the cache is ready, got %d", target, recorder.Code)
+		}
+	}
+}
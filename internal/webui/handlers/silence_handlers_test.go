@@ -0,0 +1,55 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"notificator/config"
+	"notificator/internal/alertmanager"
+	"notificator/internal/models"
+	"notificator/internal/webui/middleware"
+)
+
+func TestCreateSilence_MissingIsEqualCreatesEqualityMatcher(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	received := make(chan models.Silence, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var silence models.Silence
+		if err := json.NewDecoder(r.Body).Decode(&silence); err != nil {
+			t.Errorf("failed to decode silence: %v", err)
+		}
+		received <- silence
+		w.Write([]byte(`{"silenceID":"new-id"}`))
+	}))
+	defer server.Close()
+
+	previous := alertmanagerClient
+	SetAlertmanagerClient(alertmanager.NewMultiClient(&config.Config{
+		Alertmanagers: []config.AlertmanagerConfig{{Name: "test", URL: server.URL}},
+	}))
+	t.Cleanup(func() { SetAlertmanagerClient(previous) })
+
+	router := gin.New()
+	router.Use(middleware.SessionMiddleware("test-secret", time.Hour))
+	router.POST("/silences", CreateSilence)
+
+	body := `{"matchers":[{"name":"alertname","value":"DiskFull","isRegex":false}],` +
+		`"endsAt":"` + time.Now().Add(time.Hour).Format(time.RFC3339) + `","comment":"maintenance"}`
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/silences", strings.NewReader(body)))
+
+	if recorder.Code != http.StatusCreated {
+		t.Fatalf("expected 201, got %d: %s", recorder.Code, recorder.Body.String())
+	}
+	silence := <-received
+	if len(silence.Matchers) != 1 || silence.Matchers[0].IsEqual == nil || !*silence.Matchers[0].IsEqual {
+		t.Errorf("expected a matcher without isEqual to be sent as an equality matcher, got %+v", silence.Matchers)
+	}
+}
@@ -1,12 +1,17 @@
 package handlers
 
 import (
+	"context"
 	"encoding/json"
 	"io"
 	"log"
 	"net/http"
 	"time"
 
+	alertpb "notificator/internal/backend/proto/alert"
+	"notificator/internal/webui/middleware"
+	webuimodels "notificator/internal/webui/models"
+
 	"github.com/gin-gonic/gin"
 )
 
@@ -79,6 +84,82 @@ func SSEStream(c *gin.Context) {
 	})
 }
 
+// ResolvedAlertsStream relays the backend's resolved alert updates as
+// Server-Sent Events, so the resolved alerts archive refreshes live.
+//
+// Events sent:
+// - "resolved": {"fingerprint": "...", "type": "RESOLVED_ALERT_CREATED"}
+// - "ping": Heartbeat to keep connection alive (every 30 seconds)
+func ResolvedAlertsStream(c *gin.Context) {
+	if backendClient == nil || !backendClient.IsConnected() {
+		c.JSON(http.StatusServiceUnavailable, webuimodels.ErrorResponse("Backend not available"))
+		return
+	}
+
+	ctx, cancel := context.WithCancel(c.Request.Context())
+	defer cancel()
+
+	stream, err := backendClient.StreamResolvedAlertUpdates(ctx, middleware.GetSessionIDFromContext(c))
+	if err != nil {
+		log.Printf("SSE: failed to subscribe to resolved alert updates: %v", err)
+		c.JSON(http.StatusBadGateway, webuimodels.ErrorResponse("Failed to subscribe to resolved alert updates"))
+		return
+	}
+
+	// Recv blocks, so it runs apart from the loop that also sends heartbeats
+	updates := make(chan *alertpb.ResolvedAlertUpdate)
+	go func() {
+		defer close(updates)
+		for {
+			update, err := stream.Recv()
+			if err != nil {
+				if err != io.EOF && ctx.Err() == nil {
+					log.Printf("SSE: resolved alert update stream ended: %v", err)
+				}
+				return
+			}
+			select {
+			case updates <- update:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Header("X-Accel-Buffering", "no")
+
+	heartbeat := time.NewTicker(30 * time.Second)
+	defer heartbeat.Stop()
+
+	c.Stream(func(w io.Writer) bool {
+		select {
+		case update, ok := <-updates:
+			if !ok {
+				return false
+			}
+			data, err := json.Marshal(gin.H{
+				"fingerprint": update.GetFingerprint(),
+				"type":        update.GetUpdateType().String(),
+			})
+			if err != nil {
+				return true
+			}
+			c.SSEvent("resolved", string(data))
+			return true
+
+		case <-heartbeat.C:
+			c.SSEvent("ping", `{"type":"heartbeat"}`)
+			return true
+
+		case <-ctx.Done():
+			return false
+		}
+	})
+}
+
 // SSEStatus returns the current status of the SSE system including subscriber count.
 // This is useful for monitoring and debugging.
 func SSEStatus(c *gin.Context) {
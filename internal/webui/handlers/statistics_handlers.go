@@ -664,6 +664,8 @@ func GetResolvedAlertDetails(c *gin.Context) {
 		details.Duration = endTime.Sub(details.StartedAt)
 	}
 
+	details.MaxCommentLength = maxCommentLength()
+
 	// Get comments if available
 	comments, err := backendClient.GetComments(fingerprint)
 	if err == nil && len(comments) > 0 {
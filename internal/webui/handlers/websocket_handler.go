@@ -0,0 +1,261 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"sync/atomic"
+	"time"
+
+	"notificator/config"
+	alertpb "notificator/internal/backend/proto/alert"
+	"notificator/internal/webui/middleware"
+	webuimodels "notificator/internal/webui/models"
+
+	"github.com/gin-gonic/gin"
+	"golang.org/x/net/websocket"
+)
+
+// AlertUpdateFrame is the JSON frame pushed to WebSocket clients for every
+// AlertUpdate received from the backend stream.
+type AlertUpdateFrame struct {
+	AlertKey                string                      `json:"alertKey"`
+	Type                    string                      `json:"type"`
+	Comment                 *webuimodels.Comment        `json:"comment,omitempty"`
+	Acknowledgment          *webuimodels.Acknowledgment `json:"acknowledgment,omitempty"`
+	Escalation              *webuimodels.Escalation     `json:"escalation,omitempty"`
+	Mention                 *MentionFrame               `json:"mention,omitempty"`
+	Presence                *PresenceFrame              `json:"presence,omitempty"`
+	DeletedCommentID        string                      `json:"deletedCommentId,omitempty"`
+	DeletedAcknowledgmentID string                      `json:"deletedAcknowledgmentId,omitempty"`
+	Timestamp               time.Time                   `json:"timestamp"`
+}
+
+// MentionFrame tells the browser its user was mentioned in a comment, on
+// AlertKey which may not be the alert the socket follows
+type MentionFrame struct {
+	CommentID   string    `json:"commentId"`
+	AlertKey    string    `json:"alertKey"`
+	AlertName   string    `json:"alertName"`
+	MentionedBy string    `json:"mentionedBy"`
+	Content     string    `json:"content"`
+	CreatedAt   time.Time `json:"createdAt"`
+}
+
+// PresenceFrame lists who has the alert open. User is who joined or left,
+// and is unset on the subscription's first frame.
+type PresenceFrame struct {
+	User    *Viewer  `json:"user,omitempty"`
+	Viewers []Viewer `json:"viewers"`
+}
+
+// Viewer is a user with the alert open
+type Viewer struct {
+	UserID   string `json:"userId"`
+	Username string `json:"username"`
+}
+
+// AlertUpdatesWebSocket upgrades the request to a WebSocket and forwards the
+// backend's comment and acknowledgment updates for one alert as JSON frames.
+// The gRPC stream is cancelled as soon as the browser goes away.
+func AlertUpdatesWebSocket(c *gin.Context) {
+	if backendClient == nil || !backendClient.IsConnected() {
+		c.JSON(http.StatusServiceUnavailable, webuimodels.ErrorResponse("Backend not available"))
+		return
+	}
+
+	alertKey := c.Param("alertKey")
+	if alertKey == "" {
+		c.JSON(http.StatusBadRequest, webuimodels.ErrorResponse("Alert key is required"))
+		return
+	}
+
+	sessionID := middleware.GetSessionID(c)
+
+	server := websocket.Server{
+		Handshake: checkSameOrigin,
+		Handler: func(ws *websocket.Conn) {
+			defer ws.Close()
+			streamAlertUpdates(c.Request.Context(), ws, sessionID, alertKey)
+		},
+	}
+	server.ServeHTTP(c.Writer, c.Request)
+}
+
+// checkSameOrigin rejects cross-site upgrades, since the session cookie would
+// otherwise be sent along by any page the user has open.
+func checkSameOrigin(config *websocket.Config, req *http.Request) error {
+	origin := req.Header.Get("Origin")
+	if origin == "" {
+		return nil
+	}
+
+	u, err := url.Parse(origin)
+	if err != nil {
+		return fmt.Errorf("invalid origin: %w", err)
+	}
+	if u.Host != req.Host {
+		return fmt.Errorf("origin %s not allowed", origin)
+	}
+
+	config.Origin = u
+	return nil
+}
+
+// heartbeatMissesBeforeStale is how many backend heartbeat intervals may pass
+// without any update before the stream is considered dead
+const heartbeatMissesBeforeStale = 3
+
+// alertStreamIdleTimeout returns how long a backend stream may stay silent
+// before it is treated as dead and resubscribed, until the stream's connection
+// confirmation tells the backend's actual heartbeat interval
+func alertStreamIdleTimeout() time.Duration {
+	interval := config.DefaultStreamHeartbeatInterval
+	if appConfig != nil {
+		if configured, err := appConfig.Backend.GetStreamHeartbeatInterval(); err == nil {
+			interval = configured
+		}
+	}
+	return heartbeatMissesBeforeStale * interval
+}
+
+func streamAlertUpdates(parent context.Context, ws *websocket.Conn, sessionID, alertKey string) {
+	ctx, cancel := context.WithCancel(parent)
+	defer cancel()
+
+	// The client never sends anything we need; reading only tells us when it
+	// disconnects so the backend stream can be torn down.
+	go func() {
+		defer cancel()
+		var discard []byte
+		for {
+			if err := websocket.Message.Receive(ws, &discard); err != nil {
+				return
+			}
+		}
+	}()
+
+	log.Printf("WebSocket client subscribed to alert %s", alertKey)
+
+	for forwardAlertUpdates(ctx, ws, sessionID, alertKey) && ctx.Err() == nil {
+		log.Printf("WebSocket: no heartbeat from backend for alert %s, resubscribing", alertKey)
+	}
+
+	log.Printf("WebSocket client unsubscribed from alert %s", alertKey)
+}
+
+// forwardAlertUpdates relays one backend subscription to the WebSocket until
+// either side ends it. It reports true when the stream was abandoned because
+// the backend went silent for longer than alertStreamIdleTimeout.
+func forwardAlertUpdates(ctx context.Context, ws *websocket.Conn, sessionID, alertKey string) bool {
+	streamCtx, streamCancel := context.WithCancel(ctx)
+	defer streamCancel()
+
+	stream, err := backendClient.SubscribeToAlertUpdates(streamCtx, sessionID, alertKey)
+	if err != nil {
+		log.Printf("WebSocket: failed to subscribe to updates for %s: %v", alertKey, err)
+		return false
+	}
+
+	timeout := alertStreamIdleTimeout()
+	var stale atomic.Bool
+	watchdog := time.AfterFunc(timeout, func() {
+		stale.Store(true)
+		streamCancel()
+	})
+	defer watchdog.Stop()
+
+	for {
+		update, err := stream.Recv()
+		if err != nil {
+			if stale.Load() {
+				return true
+			}
+			if err != io.EOF && ctx.Err() == nil {
+				log.Printf("WebSocket: alert update stream for %s ended: %v", alertKey, err)
+			}
+			return false
+		}
+		// Follow the backend's heartbeat interval, which may differ from this
+		// WebUI's copy of backend.stream_heartbeat_interval
+		if interval := update.GetHeartbeatIntervalMs(); interval > 0 {
+			timeout = heartbeatMissesBeforeStale * time.Duration(interval) * time.Millisecond
+		}
+		watchdog.Reset(timeout)
+
+		// Heartbeats are forwarded too, which keeps idle proxies between the
+		// browser and the WebUI from closing the socket
+		if err := websocket.JSON.Send(ws, convertAlertUpdate(update)); err != nil {
+			return false
+		}
+	}
+}
+
+func convertAlertUpdate(update *alertpb.AlertUpdate) AlertUpdateFrame {
+	frame := AlertUpdateFrame{
+		AlertKey:                update.GetAlertKey(),
+		Type:                    update.GetUpdateType().String(),
+		DeletedCommentID:        update.GetDeletedCommentId(),
+		DeletedAcknowledgmentID: update.GetDeletedAcknowledgmentId(),
+		Timestamp:               update.GetTimestamp().AsTime(),
+	}
+
+	if comment := update.GetComment(); comment != nil {
+		frame.Comment = &webuimodels.Comment{
+			ID:        comment.Id,
+			Username:  comment.Username,
+			UserID:    comment.UserId,
+			Content:   comment.Content,
+			CreatedAt: comment.CreatedAt.AsTime(),
+			UpdatedAt: comment.CreatedAt.AsTime(),
+		}
+	}
+
+	if ack := update.GetAcknowledgment(); ack != nil {
+		frame.Acknowledgment = &webuimodels.Acknowledgment{
+			ID:        ack.Id,
+			Username:  ack.Username,
+			UserID:    ack.UserId,
+			Reason:    ack.Reason,
+			CreatedAt: ack.CreatedAt.AsTime(),
+			UpdatedAt: ack.CreatedAt.AsTime(),
+			ExpiresAt: ackExpiresAt(ack),
+		}
+	}
+
+	if escalation := update.GetEscalation(); escalation != nil {
+		converted := convertEscalation(escalation)
+		frame.Escalation = &converted
+	}
+
+	if mention := update.GetMention(); mention != nil {
+		frame.Mention = &MentionFrame{
+			CommentID:   mention.CommentId,
+			AlertKey:    mention.AlertKey,
+			AlertName:   mention.AlertKey,
+			MentionedBy: mention.MentionedBy,
+			Content:     mention.Content,
+			CreatedAt:   mention.CreatedAt.AsTime(),
+		}
+		if alertCache != nil {
+			if alert, exists := alertCache.GetAlert(mention.AlertKey); exists && alert.AlertName != "" {
+				frame.Mention.AlertName = alert.AlertName
+			}
+		}
+	}
+
+	if presence := update.GetPresence(); presence != nil {
+		frame.Presence = &PresenceFrame{Viewers: make([]Viewer, 0, len(presence.Viewers))}
+		if user := presence.User; user != nil {
+			frame.Presence.User = &Viewer{UserID: user.UserId, Username: user.Username}
+		}
+		for _, viewer := range presence.Viewers {
+			frame.Presence.Viewers = append(frame.Presence.Viewers, Viewer{UserID: viewer.UserId, Username: viewer.Username})
+		}
+	}
+
+	return frame
+}
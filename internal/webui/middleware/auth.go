@@ -1,13 +1,19 @@
 package middleware
 
 import (
+	"log"
 	"net/http"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"notificator/internal/webui/client"
 	"notificator/internal/webui/models"
 )
 
+// sessionRefreshThreshold is how close to expiry a session must be before
+// an authenticated request extends it
+const sessionRefreshThreshold = time.Hour
+
 type AuthMiddleware struct {
 	backendClient *client.BackendClient
 }
@@ -44,6 +50,8 @@ func (am *AuthMiddleware) RequireAuth() gin.HandlerFunc {
 			return
 		}
 
+		am.refreshIfExpiring(c, sessionID, user)
+
 		// Set user in context for handlers to use
 		c.Set("user", user)
 		c.Set("session_id", sessionID)
@@ -100,6 +108,8 @@ func (am *AuthMiddleware) RedirectIfNotAuth(redirectTo string) gin.HandlerFunc {
 			return
 		}
 
+		am.refreshIfExpiring(c, sessionID, user)
+
 		// Set user in context
 		c.Set("user", user)
 		c.Set("session_id", sessionID)
@@ -133,6 +143,26 @@ func (am *AuthMiddleware) RedirectIfAuth(redirectTo string) gin.HandlerFunc {
 	}
 }
 
+// refreshIfExpiring extends the backend session and the session cookie when
+// they are about to expire so users active on a long-lived page are not logged
+// out mid-use
+func (am *AuthMiddleware) refreshIfExpiring(c *gin.Context, sessionID string, user *client.User) {
+	if user == nil || user.SessionExpiresAt == nil || time.Until(*user.SessionExpiresAt) > sessionRefreshThreshold {
+		return
+	}
+
+	expiresAt, err := am.backendClient.RefreshSession(sessionID)
+	if err != nil {
+		log.Printf("Failed to refresh session for user %s: %v", user.Username, err)
+		return
+	}
+	user.SessionExpiresAt = &expiresAt
+
+	if err := ExtendSessionCookie(c, expiresAt); err != nil {
+		log.Printf("Failed to extend session cookie for user %s: %v", user.Username, err)
+	}
+}
+
 // Helper function to get current user from context
 func GetCurrentUserFromContext(c *gin.Context) *client.User {
 	if user, exists := c.Get("user"); exists {
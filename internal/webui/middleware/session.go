@@ -17,16 +17,31 @@ const (
 	ImpersonationStartedAt    = "impersonation_started_at"
 )
 
-func SessionMiddleware(secret string) gin.HandlerFunc {
+// SessionMiddleware stores the session in a signed cookie. maxAge should match
+// the backend session duration so the cookie does not outlive or cut short the
+// session it carries.
+func SessionMiddleware(secret string, maxAge time.Duration) gin.HandlerFunc {
 	store := cookie.NewStore([]byte(secret))
-	store.Options(sessions.Options{
+	store.Options(cookieOptions(int(maxAge.Seconds())))
+	return sessions.Sessions(SessionName, store)
+}
+
+func cookieOptions(maxAge int) sessions.Options {
+	return sessions.Options{
 		Path:     "/",
-		MaxAge:   86400 * 7, // 7 days
+		MaxAge:   maxAge,
 		HttpOnly: true,
 		Secure:   false, // Set to true in production with HTTPS
 		SameSite: 0,     // Default SameSite behavior
-	})
-	return sessions.Sessions(SessionName, store)
+	}
+}
+
+// ExtendSessionCookie re-issues the session cookie so it lives until the
+// backend session expires
+func ExtendSessionCookie(c *gin.Context, expiresAt time.Time) error {
+	session := sessions.Default(c)
+	session.Options(cookieOptions(int(time.Until(expiresAt).Seconds())))
+	return session.Save()
 }
 
 func SetSessionValue(c *gin.Context, key string, value interface{}) error {
@@ -25,6 +25,14 @@ type DashboardAlert struct {
 	AcknowledgedBy    string    `json:"acknowledgedBy,omitempty"`
 	AcknowledgedAt    time.Time `json:"acknowledgedAt,omitempty"`
 	AcknowledgeReason string    `json:"acknowledgeReason,omitempty"`
+	// Number of acknowledgments, loaded in bulk with the latest one
+	AcknowledgmentCount int `json:"acknowledgmentCount"`
+	// When the latest acknowledgment lapses; nil when it lasts until removed
+	AcknowledgmentExpiresAt *time.Time `json:"acknowledgmentExpiresAt,omitempty"`
+	AcknowledgmentRenotify  bool       `json:"-"`
+	// Set when an acknowledgment that asked to be renotified lapsed, so
+	// browsers notify about the alert again
+	AcknowledgmentLapsedAt *time.Time `json:"acknowledgmentLapsedAt,omitempty"`
 
 	// Comments and interactions
 	CommentCount  int       `json:"commentCount"`
@@ -47,9 +55,15 @@ type DashboardAlert struct {
 	Instance   string `json:"instance"`
 	Summary    string `json:"summary"`
 
+	// PriorityScore ranks urgency for the Priority column (see models.PriorityScore)
+	PriorityScore float64 `json:"priorityScore"`
+
 	// Timestamps for tracking
-	UpdatedAt  time.Time `json:"updatedAt"`
-	ResolvedAt time.Time `json:"resolvedAt,omitempty"`
+	FirstSeenAt time.Time `json:"firstSeenAt,omitempty"` // When the cache first saw this fingerprint
+	UpdatedAt   time.Time `json:"updatedAt"`
+	ResolvedAt  time.Time `json:"resolvedAt,omitempty"`
+	// RetainedUntil is when the backend drops a stored resolved alert
+	RetainedUntil time.Time `json:"retainedUntil,omitempty"`
 }
 
 // AlertStatus represents the enhanced status of an alert
@@ -101,6 +115,20 @@ type DashboardFilters struct {
 type DashboardSorting struct {
 	Field     string `json:"field"`     // Column to sort by
 	Direction string `json:"direction"` // "asc" or "desc"
+
+	// Further keys breaking ties of the primary one, in order (shift-click on headers)
+	ThenBy []DashboardSortKey `json:"thenBy,omitempty"`
+}
+
+// DashboardSortKey is one secondary key of a multi-column sort
+type DashboardSortKey struct {
+	Field     string `json:"field"`
+	Direction string `json:"direction"`
+}
+
+// Keys returns the primary key followed by the secondary ones
+func (s DashboardSorting) Keys() []DashboardSortKey {
+	return append([]DashboardSortKey{{Field: s.Field, Direction: s.Direction}}, s.ThenBy...)
 }
 
 // Pagination represents pagination configuration
@@ -112,7 +140,7 @@ type Pagination struct {
 // DashboardSettings represents user-specific dashboard settings
 type DashboardSettings struct {
 	UserID            string           `json:"userId"`
-	Theme             string           `json:"theme"` // "light" or "dark"
+	Theme             string           `json:"theme"` // "light", "dark" or "auto" (follows the OS)
 	CriticalSoundPath string           `json:"criticalSoundPath"`
 	WarningSoundPath  string           `json:"warningSoundPath"`
 	RefreshInterval   int              `json:"refreshInterval"` // Seconds between auto-refresh
@@ -155,6 +183,25 @@ type AlertGroup struct {
 	WorstSeverity string           `json:"worstSeverity"`
 }
 
+// GroupedExportReport is a hierarchical export of the grouped dashboard view
+type GroupedExportReport struct {
+	GeneratedAt time.Time            `json:"generatedAt"`
+	GroupBy     string               `json:"groupBy"`
+	DisplayMode DashboardDisplayMode `json:"displayMode"`
+	TotalAlerts int                  `json:"totalAlerts"`
+	TotalGroups int                  `json:"totalGroups"`
+	Groups      []GroupedExportGroup `json:"groups"`
+}
+
+// GroupedExportGroup is a single group of a GroupedExportReport
+type GroupedExportGroup struct {
+	GroupName      string           `json:"groupName"`
+	Count          int              `json:"count"`
+	WorstSeverity  string           `json:"worstSeverity"`
+	SeverityCounts map[string]int   `json:"severityCounts"`
+	Alerts         []DashboardAlert `json:"alerts"`
+}
+
 // DashboardMetadata provides additional information about the dashboard state
 type DashboardMetadata struct {
 	TotalAlerts        int                       `json:"totalAlerts"`
@@ -163,10 +210,32 @@ type DashboardMetadata struct {
 	LastUpdate         time.Time                 `json:"lastUpdate"`
 	NextUpdate         time.Time                 `json:"nextUpdate"`
 	AlertmanagerStatus map[string]bool           `json:"alertmanagerStatus"`
+	SeverityColors     map[string]string         `json:"severityColors,omitempty"` // webui.severity_colors
 	Counters           DashboardCounters         `json:"counters"`
 	AvailableFilters   DashboardAvailableFilters `json:"availableFilters"`
 }
 
+// AlertmanagerSourceStatus is one Alertmanager's fetch health together with
+// the alerts the cache holds for it. While a source is unhealthy its cached
+// alerts are kept as they were last fetched, so CachedAlerts may be stale.
+type AlertmanagerSourceStatus struct {
+	Name                string     `json:"name"`
+	URL                 string     `json:"url"`
+	State               string     `json:"state"` // healthy, unhealthy or unknown (not fetched yet)
+	SuccessCount        int        `json:"successCount"`
+	FailureCount        int        `json:"failureCount"`
+	ConsecutiveFailures int        `json:"consecutiveFailures"`
+	LastSuccess         *time.Time `json:"lastSuccess,omitempty"`
+	LastFailure         *time.Time `json:"lastFailure,omitempty"`
+	LastError           string     `json:"lastError,omitempty"`
+	LatencyMs           int64      `json:"latencyMs"`
+	FetchedAlerts       int        `json:"fetchedAlerts"` // returned by the last successful fetch
+	CachedAlerts        int        `json:"cachedAlerts"`  // active alerts currently shown from this source
+	CircuitOpen         bool       `json:"circuitOpen"`   // fetches skip the source until RetryAt
+	RetryAt             *time.Time `json:"retryAt,omitempty"`
+	SkippedCount        int        `json:"skippedCount"`
+}
+
 // DashboardCounters provides count statistics
 type DashboardCounters struct {
 	Critical     int `json:"critical"`
@@ -196,9 +265,13 @@ type BulkActionRequest struct {
 	GroupNames            []string      `json:"groupNames,omitempty"`            // For group actions
 	Action                string        `json:"action"`                          // "acknowledge", "hide", "unhide", "silence"
 	Comment               string        `json:"comment,omitempty"`               // Optional comment for acknowledgment
+	AckDuration           string        `json:"ackDuration,omitempty"`           // How long an acknowledgment lasts (e.g., "2h"); until removed when empty
+	AckRenotify           bool          `json:"ackRenotify,omitempty"`           // Notify again when an expiring acknowledgment lapses
 	SilenceDuration       time.Duration `json:"silenceDuration,omitempty"`       // Duration for silence action (backward compatibility)
 	SilenceDurationType   string        `json:"silenceDurationType,omitempty"`   // "preset" or "custom"
 	CustomSilenceDuration string        `json:"customSilenceDuration,omitempty"` // Custom duration string (e.g., "1h30m")
+	SilenceLabels         []string      `json:"silenceLabels,omitempty"`         // Label keys to match on; all labels when omitted
+	SilenceMatchers       []string      `json:"silenceMatchers,omitempty"`       // Extra matchers in Alertmanager syntax, e.g. "instance=~web-.*"
 }
 
 // BulkActionResponse represents the response to a bulk action
@@ -211,14 +284,37 @@ type BulkActionResponse struct {
 
 // AlertDetails represents detailed information about an alert for the modal
 type AlertDetails struct {
-	Alert           *DashboardAlert  `json:"alert"`
-	Acknowledgments []Acknowledgment `json:"acknowledgments,omitempty"`
-	Comments        []Comment        `json:"comments,omitempty"`
-	Silences        []Silence        `json:"silences,omitempty"`
-	GeneratorURL    string           `json:"generatorURL,omitempty"`
-	StartedAt       time.Time        `json:"startedAt"`
-	EndedAt         *time.Time       `json:"endedAt,omitempty"`
-	Duration        time.Duration    `json:"duration"`
+	Alert           *DashboardAlert   `json:"alert"`
+	Acknowledgments []Acknowledgment  `json:"acknowledgments,omitempty"`
+	Comments        []Comment         `json:"comments,omitempty"`
+	Escalations     []Escalation      `json:"escalations,omitempty"`
+	Silences        []Silence         `json:"silences,omitempty"`
+	InhibitedBy     []InhibitingAlert `json:"inhibitedBy,omitempty"`
+	RelatedAlerts   []RelatedAlert    `json:"relatedAlerts,omitempty"`
+	GeneratorURL    string            `json:"generatorURL,omitempty"`
+	StartedAt       time.Time         `json:"startedAt"`
+	EndedAt         *time.Time        `json:"endedAt,omitempty"`
+	Duration        time.Duration     `json:"duration"`
+	// Only the most recent comments and acknowledgments are included; these
+	// are the totals for the alert
+	CommentsTotal        int `json:"commentsTotal"`
+	AcknowledgmentsTotal int `json:"acknowledgmentsTotal"`
+	// Cursors of the next older pages; empty once everything is included
+	CommentsCursor        string `json:"commentsCursor"`
+	AcknowledgmentsCursor string `json:"acknowledgmentsCursor"`
+	// Longest comment the backend accepts, in characters
+	MaxCommentLength int `json:"maxCommentLength"`
+	// Set when the alert fired again after a resolution whose discussion has
+	// not been restored yet
+	PreviousDiscussion *PreviousDiscussion `json:"previousDiscussion,omitempty"`
+}
+
+// PreviousDiscussion summarizes the comments and acknowledgments stored with
+// an earlier resolution of a recurring alert
+type PreviousDiscussion struct {
+	ResolvedAt      time.Time `json:"resolvedAt"`
+	Comments        int       `json:"comments"`
+	Acknowledgments int       `json:"acknowledgments"`
 }
 
 // Acknowledgment represents an alert acknowledgment
@@ -229,6 +325,8 @@ type Acknowledgment struct {
 	Reason    string    `json:"reason"`
 	CreatedAt time.Time `json:"createdAt"`
 	UpdatedAt time.Time `json:"updatedAt"`
+	// ExpiresAt is when the acknowledgment lapses; nil when it lasts until removed
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
 }
 
 // Comment represents an alert comment
@@ -241,6 +339,58 @@ type Comment struct {
 	UpdatedAt time.Time `json:"updatedAt"`
 }
 
+// Escalation records an alert being handed over to a user or group
+type Escalation struct {
+	ID          string    `json:"id"`
+	Username    string    `json:"username"`
+	UserID      string    `json:"userId"`
+	EscalatedTo string    `json:"escalatedTo"`
+	TargetType  string    `json:"targetType"`
+	Reason      string    `json:"reason"`
+	CreatedAt   time.Time `json:"createdAt"`
+}
+
+// AlertActivity is one entry of an alert's timeline
+type AlertActivity struct {
+	ID        string    `json:"id"`
+	Type      string    `json:"type"`
+	Username  string    `json:"username"`
+	UserID    string    `json:"userId"`
+	Content   string    `json:"content"`
+	Target    string    `json:"target"`
+	RefID     string    `json:"refId"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// EscalateAlertRequest escalates an alert to a user or group
+type EscalateAlertRequest struct {
+	EscalatedTo string `json:"escalatedTo"`
+	TargetType  string `json:"targetType"`
+	Reason      string `json:"reason"`
+}
+
+// InhibitingAlert is an alert whose inhibition rule suppresses the one shown
+type InhibitingAlert struct {
+	Fingerprint string `json:"fingerprint"`
+	// Found is false once the inhibiting alert is no longer active
+	Found     bool   `json:"found"`
+	AlertName string `json:"alertName,omitempty"`
+	Severity  string `json:"severity,omitempty"`
+	Instance  string `json:"instance,omitempty"`
+	State     string `json:"state,omitempty"`
+}
+
+// RelatedAlert is a firing alert sharing key labels with the one shown
+type RelatedAlert struct {
+	Fingerprint string `json:"fingerprint"`
+	AlertName   string `json:"alertName"`
+	Severity    string `json:"severity"`
+	Instance    string `json:"instance,omitempty"`
+	State       string `json:"state"`
+	// Names of the labels both alerts have with the same value
+	SharedLabels []string `json:"sharedLabels"`
+}
+
 // Silence represents a silence affecting an alert
 type Silence struct {
 	ID        string           `json:"id"`
@@ -251,6 +401,7 @@ type Silence struct {
 	UpdatedAt time.Time        `json:"updatedAt"`
 	Matchers  []SilenceMatcher `json:"matchers"`
 	Status    SilenceStatus    `json:"status"`
+	Source    string           `json:"source"` // Alertmanager owning the silence
 }
 
 // SilenceMatcher represents a silence matcher
@@ -258,6 +409,43 @@ type SilenceMatcher struct {
 	Name    string `json:"name"`
 	Value   string `json:"value"`
 	IsRegex bool   `json:"isRegex"`
+	// IsEqual is nil when a request omits it, which means an equality
+	// matcher; use Equal to read it
+	IsEqual *bool `json:"isEqual"`
+}
+
+// Equal reports whether the matcher selects labels that match (= and =~)
+// rather than labels that don't (!= and !~)
+func (m SilenceMatcher) Equal() bool {
+	return m.IsEqual == nil || *m.IsEqual
+}
+
+// UpdateSilenceRequest represents an edit of an existing silence
+type UpdateSilenceRequest struct {
+	Matchers []SilenceMatcher `json:"matchers"`
+	EndsAt   time.Time        `json:"endsAt"`
+	Comment  string           `json:"comment"`
+}
+
+// CreateSilenceRequest creates a silence from explicit matchers
+type CreateSilenceRequest struct {
+	Matchers []SilenceMatcher `json:"matchers"`
+	EndsAt   time.Time        `json:"endsAt"`
+	Comment  string           `json:"comment"`
+}
+
+// SilenceSuggestionsRequest lists the alerts to derive silence matchers from
+type SilenceSuggestionsRequest struct {
+	Fingerprints []string `json:"fingerprints"`
+}
+
+// SilenceSuggestion is a candidate set of matchers shared by the requested alerts
+type SilenceSuggestion struct {
+	Matchers      []SilenceMatcher `json:"matchers"`
+	Description   string           `json:"description"`
+	SelectedCount int              `json:"selectedCount"` // Requested alerts, all of which match
+	MatchCount    int              `json:"matchCount"`    // Active alerts the silence would mute
+	OutsideCount  int              `json:"outsideCount"`  // Matched alerts that were not requested
 }
 
 // SilenceStatus represents the status of a silence
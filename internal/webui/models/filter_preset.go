@@ -36,6 +36,8 @@ type FilterPresetData struct {
 	// Sorting
 	SortBy        string `json:"sort_by,omitempty"`        // "alertname", "severity", "duration", etc.
 	SortDirection string `json:"sort_direction,omitempty"` // "asc", "desc"
+	// Secondary sort keys, in order
+	SortThen []DashboardSortKey `json:"sort_then,omitempty"`
 
 	// Pagination
 	ItemsPerPage int `json:"items_per_page,omitempty"` // 10, 20, 50, 100, 500
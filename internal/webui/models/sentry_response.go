@@ -21,6 +21,7 @@ type SentryAuthStatus struct {
 	HasOAuthToken bool   `json:"has_oauth_token"`
 	HasAPIToken   bool   `json:"has_api_token"`
 	AuthMethod    string `json:"auth_method"` // "oauth", "api_token", "global", "none"
+	TokenRejected bool   `json:"token_rejected,omitempty"` // Sentry answered 401/403 for the token in use
 }
 
 // SentryStats represents project statistics from Sentry
@@ -10,6 +10,8 @@ import (
 
 	"notificator/config"
 	"notificator/internal/alertmanager"
+	"notificator/internal/models"
+	"notificator/internal/notifier"
 	"notificator/internal/webui/client"
 	"notificator/internal/webui/handlers"
 	"notificator/internal/webui/middleware"
@@ -44,12 +46,41 @@ func SetupRouter(backendAddress string) *gin.Engine {
 	amClient := alertmanager.NewMultiClient(cfg)
 	handlers.SetAlertmanagerClient(amClient)
 
+	// Report per-source connectivity without delaying startup
+	go func() {
+		for name, diag := range amClient.DiagnoseAllConnections(1) {
+			if diag.Healthy() {
+				log.Printf("Alertmanager %s: reachable at %s (status=%d, latency=%dms, auth=%s, headers=%v)",
+					name, diag.URL, diag.StatusCode, diag.LatencyMs, diag.AuthApplied, diag.HeadersApplied)
+			} else {
+				log.Printf("Alertmanager %s: connection check failed for %s (status=%d, latency=%dms, auth=%s, headers=%v): %v",
+					name, diag.URL, diag.StatusCode, diag.LatencyMs, diag.AuthApplied, diag.HeadersApplied, diag.Err)
+			}
+		}
+	}()
+
 	// Initialize backend client
 	backendClient := client.NewBackendClient(backendAddress)
+	if tlsCfg := cfg.Backend.ClientTLS; tlsCfg.Enabled {
+		if tlsCfg.InsecureSkipVerify {
+			log.Printf("Warning: backend TLS certificate verification is disabled")
+		}
+		backendClient.SetTLS(&client.TLSOptions{
+			CAFile:             tlsCfg.CAFile,
+			InsecureSkipVerify: tlsCfg.InsecureSkipVerify,
+		})
+	}
 	err = backendClient.Connect()
 	if err != nil {
 		// For now, continue without backend - will show connection errors
-		log.Fatalf("Backend is mandatory on webui %w", err)
+		log.Fatalf("Backend is mandatory on webui %v", err)
+	}
+
+	// The gRPC connection is lazy, so probe it once to surface a wrong address early
+	if err := backendClient.HealthCheck(); err != nil {
+		log.Printf("ERROR: backend at %s is not reachable: %v. Check --backend / BACKEND_ADDRESS; requests will fail until it is up.", backendAddress, err)
+	} else {
+		log.Printf("Backend at %s is reachable", backendAddress)
 	}
 
 	// Set backend client for handlers
@@ -93,6 +124,24 @@ func SetupRouter(backendAddress string) *gin.Engine {
 
 	// Initialize alert cache for new dashboard
 	alertCache := services.NewAlertCache(amClient, backendClient, cfg.ResolvedAlerts.RetentionDays, cfg.Polling.SyncInterval)
+	alertCache.SetSummaryAnnotations(cfg.WebUI.SummaryAnnotations)
+	alertCache.SetResolvedAlertCapture(cfg.ResolvedAlerts.Enabled)
+	// A TTL saved from the settings overrides resolved_alerts.retention_days
+	if ttlHours, err := backendClient.GetResolvedAlertTTLHours(); err != nil {
+		log.Printf("Failed to load the resolved alert TTL, keeping %d days: %v", cfg.ResolvedAlerts.RetentionDays, err)
+	} else {
+		alertCache.SetResolvedAlertTTLHours(ttlHours)
+	}
+	alertCache.SetPriorityWeights(models.PriorityWeights{
+		Severity:     cfg.WebUI.Priority.SeverityWeights,
+		PerHour:      cfg.WebUI.Priority.PerHour,
+		MaxHours:     cfg.WebUI.Priority.MaxHours,
+		Acknowledged: cfg.WebUI.Priority.AcknowledgedPenalty,
+		Labels:       cfg.WebUI.Priority.LabelWeights,
+	})
+	if alertNotifier := newAlertNotifier(cfg.Notifications); alertNotifier != nil {
+		alertCache.SetAlertNotifier(alertNotifier)
+	}
 	handlers.SetAlertCache(alertCache)
 	log.Printf("Alert cache initialized with sync interval: %v", cfg.Polling.SyncInterval)
 	alertCache.Start()
@@ -127,11 +176,27 @@ func SetupRouter(backendAddress string) *gin.Engine {
 		log.Println("✅ Using configured session secret from NOTIFICATOR_SESSION_SECRET")
 	}
 
+	// Only trust X-Forwarded-For from known proxies so c.ClientIP(), which the
+	// backend throttles logins by, cannot be spoofed
+	trustedProxies := cfg.WebUI.TrustedProxies
+	if len(trustedProxies) == 0 {
+		trustedProxies = config.DefaultTrustedProxies
+	}
+	if err := r.SetTrustedProxies(trustedProxies); err != nil {
+		log.Printf("⚠️  Invalid webui.trusted_proxies, ignoring X-Forwarded-For: %v", err)
+		r.SetTrustedProxies(nil)
+	}
+
 	// Middleware
 	r.Use(middleware.CORSMiddleware())
 	r.Use(middleware.LoggingMiddleware())
 	r.Use(gin.Recovery())
-	r.Use(middleware.SessionMiddleware(sessionSecret))
+	sessionDuration, err := cfg.Backend.GetSessionDuration()
+	if err != nil {
+		log.Printf("⚠️  %v, using the default session duration of %s", err, config.DefaultSessionDuration)
+		sessionDuration = config.DefaultSessionDuration
+	}
+	r.Use(middleware.SessionMiddleware(sessionSecret, sessionDuration))
 
 	// Static files - handle both development and container environments
 	var staticPath string
@@ -199,6 +264,9 @@ func SetupRouter(backendAddress string) *gin.Engine {
 		{
 			profile.GET("/timezone", handlers.GetTimezone)
 			profile.PUT("/timezone", handlers.UpdateTimezone)
+			profile.POST("/password", handlers.ChangePassword)
+			profile.GET("/export", handlers.ExportUserData)
+			profile.DELETE("/account", handlers.DeleteAccount)
 		}
 
 		// Protected OAuth routes
@@ -208,6 +276,12 @@ func SetupRouter(backendAddress string) *gin.Engine {
 			oauthProtected.GET("/groups", handlers.GetUserGroups)
 			oauthProtected.POST("/sync-groups", handlers.SyncUserGroups)
 		}
+
+		users := api.Group("/users")
+		users.Use(authMiddleware.RequireAuth())
+		{
+			users.GET("/search", handlers.SearchUsers)
+		}
 	}
 
 	// Impersonation API routes (separate from v1 to avoid conflicts)
@@ -220,11 +294,19 @@ func SetupRouter(backendAddress string) *gin.Engine {
 		impersonate.GET("/status", handlers.GetImpersonationStatus)
 	}
 
+	// Read-only JSON alert list for external dashboards
+	r.GET("/api/alerts", authMiddleware.RequireAuth(), handlers.ListAlerts)
+
+	// Live comment/acknowledgment updates for a single alert
+	r.GET("/ws/alerts/:alertKey", authMiddleware.RequireAuth(), handlers.AlertUpdatesWebSocket)
+
 	// Admin API routes (for users who can impersonate)
 	admin := r.Group("/api/admin")
 	admin.Use(authMiddleware.RequireAuth())
 	{
 		admin.GET("/connected-users", handlers.GetConnectedUsers)
+		admin.GET("/users", handlers.ListUsersForAdmin)
+		admin.PUT("/users/:id/disabled", handlers.SetUserDisabled)
 	}
 
 	// Continue with more v1 API routes (reusing api variable)
@@ -237,13 +319,25 @@ func SetupRouter(backendAddress string) *gin.Engine {
 			// Note: Individual alert endpoint removed - use dashboard API instead
 		}
 
+		// Alertmanager connectivity diagnostics
+		alertmanagers := api.Group("/alertmanagers")
+		alertmanagers.Use(authMiddleware.RequireAuth())
+		{
+			alertmanagers.GET("/diagnostics", handlers.AlertmanagerDiagnostics)
+			alertmanagers.GET("/status", handlers.GetAlertmanagerStatus)
+		}
+
 		// New dashboard API routes
 		dashboard := api.Group("/dashboard")
 		dashboard.Use(authMiddleware.RequireAuth())
 		{
 			dashboard.GET("/data", handlers.GetDashboardData)
+			dashboard.GET("/export/groups", handlers.ExportGroupedView)
+			dashboard.GET("/export/alerts.csv", handlers.ExportAlertsCSV)
+			dashboard.GET("/alert/:fingerprint/export", handlers.ExportAlertJSON)
 			dashboard.GET("/incremental", handlers.GetDashboardIncremental)
 			dashboard.POST("/incremental", handlers.PostDashboardIncremental)
+			dashboard.POST("/refresh", handlers.ForceRefreshDashboard)
 			dashboard.GET("/stream", handlers.SSEStream)       // SSE endpoint for real-time updates
 			dashboard.GET("/stream/status", handlers.SSEStatus) // SSE status endpoint
 			dashboard.POST("/bulk-action", handlers.BulkActionAlerts)
@@ -251,8 +345,23 @@ func SetupRouter(backendAddress string) *gin.Engine {
 			dashboard.POST("/settings", handlers.SaveDashboardSettings)
 			dashboard.GET("/alert/:fingerprint", handlers.GetAlertDetails)
 			dashboard.GET("/alert/:fingerprint/history", handlers.HandleGetAlertHistory)
+			dashboard.GET("/alert/:fingerprint/activity", handlers.GetAlertActivity)
+			dashboard.GET("/alert/:fingerprint/explain", handlers.ExplainAlert)
+			dashboard.GET("/alert/:fingerprint/comments", handlers.GetAlertComments)
 			dashboard.POST("/alert/:fingerprint/comments", handlers.AddAlertComment)
 			dashboard.DELETE("/alert/:fingerprint/comments/:commentId", handlers.DeleteAlertComment)
+			dashboard.GET("/alert/:fingerprint/acknowledgments", handlers.GetAlertAcknowledgments)
+			dashboard.DELETE("/alert/:fingerprint/acknowledgments/:ackId", handlers.DeleteAlertAcknowledgment)
+			dashboard.POST("/alert/:fingerprint/escalate", handlers.EscalateAlert)
+			dashboard.POST("/alert/:fingerprint/restore-discussion", handlers.RestoreAlertDiscussion)
+			dashboard.POST("/alert/:fingerprint/share/slack", handlers.ShareAlertToSlack)
+			dashboard.POST("/alert/:fingerprint/share/email", handlers.ShareAlertByEmail)
+			dashboard.GET("/share/targets", handlers.GetShareTargets)
+			dashboard.GET("/silences", handlers.ListSilences)
+			dashboard.POST("/silences", handlers.CreateSilence)
+			dashboard.POST("/silences/suggestions", handlers.SuggestSilences)
+			dashboard.PUT("/silences/:id", handlers.UpdateSilence)
+			dashboard.DELETE("/silences/:id", handlers.ExpireSilence)
 			dashboard.POST("/alerts/bulk-status", handlers.GetBulkAlertStatus)
 			dashboard.POST("/alerts/bulk-colors", handlers.GetBulkAlertColors)
 			dashboard.GET("/color-preferences", handlers.GetUserColorPreferences)
@@ -264,13 +373,22 @@ func SetupRouter(backendAddress string) *gin.Engine {
 			dashboard.GET("/column-preferences", handlers.GetUserColumnPreferences)
 			dashboard.PUT("/column-preferences", handlers.SaveUserColumnPreferences)
 			dashboard.PATCH("/column-preferences/width", handlers.UpdateColumnWidth)
+			dashboard.GET("/preferences/export", handlers.ExportPreferences)
+			dashboard.POST("/preferences/import", handlers.ImportPreferences)
 			dashboard.DELETE("/remove-resolved-alerts", handlers.RemoveAllResolvedAlerts)
+			dashboard.POST("/remove-resolved-alerts/undo", handlers.UndoRemoveAllResolvedAlerts)
+			dashboard.GET("/resolved-alerts", handlers.SearchResolvedAlerts)
+			dashboard.GET("/resolved-alerts/stream", handlers.ResolvedAlertsStream)
+			dashboard.GET("/resolved-alerts/settings", handlers.GetResolvedAlertSettings)
+			dashboard.PUT("/resolved-alerts/settings", handlers.SaveResolvedAlertSettings)
 
 			// Hidden alerts routes
 			dashboard.GET("/hidden-alerts", handlers.GetUserHiddenAlerts)
 			dashboard.POST("/hidden-alerts", handlers.HideAlert)
+			dashboard.POST("/hidden-alerts/unhide", handlers.UnhideAlerts)
 			dashboard.DELETE("/hidden-alerts/:fingerprint", handlers.UnhideAlert)
 			dashboard.DELETE("/hidden-alerts", handlers.ClearAllHiddenAlerts)
+			dashboard.POST("/hidden-alerts/undo", handlers.UndoClearAllHiddenAlerts)
 
 			// Hidden rules routes
 			dashboard.GET("/hidden-rules", handlers.GetUserHiddenRules)
@@ -354,8 +472,10 @@ func SetupRouter(backendAddress string) *gin.Engine {
 	{
 		protectedPages.GET("/dashboard", handlers.DashboardPage)
 		protectedPages.GET("/dashboard/alert/:id", handlers.DashboardPage) // Show dashboard with modal
+		protectedPages.GET("/alert/:fingerprint", handlers.AlertLinkPage)  // Shareable alert link
 		protectedPages.GET("/profile", handlers.ProfilePage)
 		protectedPages.GET("/statistics", handlers.StatisticsDashboardPage)
+		protectedPages.GET("/status", handlers.StatusPage)
 	}
 
 	return r
@@ -371,3 +491,25 @@ func generateRandomSecret(length int) string {
 	}
 	return base64.URLEncoding.EncodeToString(bytes)
 }
+
+// newAlertNotifier builds the notifier posting alerts to Slack, email and
+// PagerDuty, or returns nil when none of them is enabled. Desktop
+// notifications and sounds are left to the browser.
+func newAlertNotifier(cfg config.NotificationConfig) *notifier.Notifier {
+	if !cfg.Enabled || (!cfg.Slack.Enabled && !cfg.Email.Enabled && !cfg.PagerDuty.Enabled) {
+		return nil
+	}
+
+	log.Printf("Alert notifications enabled: slack=%v email=%v pagerduty=%v", cfg.Slack.Enabled, cfg.Email.Enabled, cfg.PagerDuty.Enabled)
+	return notifier.NewNotifier(notifier.NotificationConfig{
+		Enabled:                    true,
+		CriticalOnly:               cfg.CriticalOnly,
+		MaxNotifications:           cfg.MaxNotifications,
+		CooldownSeconds:            cfg.CooldownSeconds,
+		FingerprintCooldownSeconds: cfg.FingerprintCooldownSeconds,
+		SeverityRules:              cfg.SeverityRules,
+		Slack:                      notifier.SlackConfig(cfg.Slack),
+		Email:                      notifier.EmailConfig(cfg.Email),
+		PagerDuty:                  notifier.PagerDutyConfig(cfg.PagerDuty),
+	}, nil)
+}
@@ -3,13 +3,18 @@ package services
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
+	"maps"
+	"slices"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"notificator/internal/alertmanager"
+	alertpb "notificator/internal/backend/proto/alert"
 	"notificator/internal/models"
 	"notificator/internal/webui/client"
 	webuimodels "notificator/internal/webui/models"
@@ -38,6 +43,12 @@ type alertFetcher interface {
 	FetchAllAlertsDetailed() ([]alertmanager.AlertWithSource, map[string]error)
 }
 
+// AlertNotifier is told about every refresh so it can notify on new and
+// escalated alerts. notifier.Notifier implements it.
+type AlertNotifier interface {
+	ProcessAlerts(newAlerts []models.Alert, previousAlerts []models.Alert)
+}
+
 // maxBackendWorkers bounds concurrent backend calls spawned by a refresh cycle,
 // so a large diff cannot stampede the backend with thousands of gRPC calls.
 const maxBackendWorkers = 8
@@ -56,12 +67,28 @@ type AlertCache struct {
 	cachedColors map[string]map[string]*AlertColorResult // userID -> fingerprint -> color result
 
 	// Configuration
-	refreshInterval       time.Duration
-	resolvedRetentionDays int // Days to keep resolved alerts
+	refreshInterval    time.Duration
+	resolvedTTLHours   atomic.Int64 // How long the backend keeps the resolved alerts stored from now on
+	captureResolved    bool         // Store disappearing alerts in the backend's resolved alerts
+	summaryAnnotations []string     // Annotation keys tried in order for the summary
+	priorityWeights    models.PriorityWeights
 
 	// Change tracking
 	newAlerts           []string // fingerprints of new alerts since last fetch
 	resolvedAlertsSince []string // fingerprints of recently resolved alerts
+	primed              bool     // true once the initial fetch has populated the cache
+
+	// notifier sees the raw alerts of each fetch; previousAlerts is what it
+	// was handed last time and unbaselinedSources holds the sources that have
+	// failed every fetch so far. All three are guarded by refreshMu.
+	notifier           AlertNotifier
+	previousAlerts     []alertmanager.AlertWithSource
+	unbaselinedSources map[string]bool
+
+	// Readers render from the latest published snapshot instead of ac.alerts;
+	// writers republish it under ac.mu after every change.
+	snapshot        atomic.Pointer[AlertSnapshot]
+	snapshotVersion uint64 // guarded by mu
 
 	// SSE pub/sub - subscribers for real-time updates
 	subscribers map[chan *webuimodels.DashboardIncrementalUpdate]bool
@@ -72,6 +99,7 @@ type AlertCache struct {
 	cancel        context.CancelFunc
 	refreshTicker *time.Ticker
 	tickerMu      sync.Mutex // guards refreshTicker pointer only
+	refreshMu     sync.Mutex // serializes the ticker's refreshes with ForceRefresh
 }
 
 func NewAlertCache(amClient *alertmanager.MultiClient, backendClient *client.BackendClient, resolvedRetentionDays int, syncInterval time.Duration) *AlertCache {
@@ -94,22 +122,98 @@ func NewAlertCache(amClient *alertmanager.MultiClient, backendClient *client.Bac
 		fetcher = amClient
 	}
 
-	return &AlertCache{
-		alerts:                make(map[string]*webuimodels.DashboardAlert),
-		userHiddenAlerts:      make(map[string]map[string]bool),
-		alertmanagerClient:    fetcher,
-		backendClient:         backendClient,
-		colorService:          NewColorService(backendClient),
-		cachedColors:          make(map[string]map[string]*AlertColorResult),
-		backendSem:            make(chan struct{}, maxBackendWorkers),
-		refreshInterval:       syncInterval,
-		resolvedRetentionDays: resolvedRetentionDays,
-		newAlerts:             make([]string, 0),
-		resolvedAlertsSince:   make([]string, 0),
-		subscribers:           make(map[chan *webuimodels.DashboardIncrementalUpdate]bool),
-		ctx:                   ctx,
-		cancel:                cancel,
+	ac := &AlertCache{
+		alerts:              make(map[string]*webuimodels.DashboardAlert),
+		userHiddenAlerts:    make(map[string]map[string]bool),
+		alertmanagerClient:  fetcher,
+		backendClient:       backendClient,
+		colorService:        NewColorService(backendClient),
+		cachedColors:        make(map[string]map[string]*AlertColorResult),
+		backendSem:          make(chan struct{}, maxBackendWorkers),
+		refreshInterval:     syncInterval,
+		captureResolved:     true,
+		summaryAnnotations:  models.DefaultSummaryAnnotations,
+		priorityWeights:     models.DefaultPriorityWeights(),
+		newAlerts:           make([]string, 0),
+		resolvedAlertsSince: make([]string, 0),
+		subscribers:         make(map[chan *webuimodels.DashboardIncrementalUpdate]bool),
+		ctx:                 ctx,
+		cancel:              cancel,
+	}
+	ac.snapshot.Store(buildAlertSnapshot(ac.alerts, 0))
+	ac.resolvedTTLHours.Store(int64(resolvedRetentionDays) * 24)
+
+	return ac
+}
+
+// SetResolvedAlertTTLHours sets how long the backend keeps the resolved
+// alerts stored from now on. Alerts already stored keep their expiry.
+func (ac *AlertCache) SetResolvedAlertTTLHours(hours int) {
+	if hours > 0 {
+		ac.resolvedTTLHours.Store(int64(hours))
+	}
+}
+
+// ResolvedAlertTTLHours returns how long the backend keeps the resolved
+// alerts stored from now on
+func (ac *AlertCache) ResolvedAlertTTLHours() int {
+	return int(ac.resolvedTTLHours.Load())
+}
+
+// SetResolvedAlertCapture turns storing resolved alerts, with their comments
+// and acknowledgments, in the backend on or off. It must be called before Start.
+func (ac *AlertCache) SetResolvedAlertCapture(enabled bool) {
+	ac.captureResolved = enabled
+}
+
+// SetSummaryAnnotations configures which annotations are used as the alert
+// summary, tried in order. It must be called before Start.
+func (ac *AlertCache) SetSummaryAnnotations(keys []string) {
+	if len(keys) == 0 {
+		keys = models.DefaultSummaryAnnotations
+	}
+	ac.summaryAnnotations = keys
+}
+
+// SetAlertNotifier makes every refresh feed the notifier. It must be
+// called before Start.
+func (ac *AlertCache) SetAlertNotifier(notifier AlertNotifier) {
+	ac.notifier = notifier
+}
+
+// SetPriorityWeights configures the weights of the alert priority score.
+func (ac *AlertCache) SetPriorityWeights(weights models.PriorityWeights) {
+	ac.mu.Lock()
+	defer ac.mu.Unlock()
+
+	ac.priorityWeights = weights
+	ac.publishSnapshotLocked()
+}
+
+// publishSnapshotLocked rebuilds the snapshot from ac.alerts and swaps it in.
+// Callers must hold ac.mu for writing.
+func (ac *AlertCache) publishSnapshotLocked() *AlertSnapshot {
+	// Scores depend on duration and acknowledgement, so refresh them with
+	// every snapshot rather than only when an alert is fetched
+	now := time.Now()
+	for _, alert := range ac.alerts {
+		alert.PriorityScore = models.PriorityScore(&models.Alert{
+			Labels:   alert.Labels,
+			StartsAt: alert.StartsAt,
+		}, alert.IsAcknowledged, now, ac.priorityWeights)
 	}
+
+	ac.snapshotVersion++
+	snapshot := buildAlertSnapshot(ac.alerts, ac.snapshotVersion)
+	ac.snapshot.Store(snapshot)
+	return snapshot
+}
+
+// Snapshot returns the latest published snapshot of the active alerts.
+// Use a single snapshot for everything a request renders (list, groups and
+// counts) so they all describe the same refresh cycle.
+func (ac *AlertCache) Snapshot() *AlertSnapshot {
+	return ac.snapshot.Load()
 }
 
 func (ac *AlertCache) Start() {
@@ -170,13 +274,25 @@ func (ac *AlertCache) runBounded(fn func()) {
 	}()
 }
 
+// ForceRefresh fetches every Alertmanager now instead of waiting for the next
+// tick, so a client reloading right after sees the current state
+func (ac *AlertCache) ForceRefresh() {
+	ac.refreshAlerts()
+}
+
 func (ac *AlertCache) refreshAlerts() {
 	if ac.alertmanagerClient == nil {
 		return
 	}
+	ac.refreshMu.Lock()
+	defer ac.refreshMu.Unlock()
 
 	alertsWithSource, fetchErrors := ac.alertmanagerClient.FetchAllAlertsDetailed()
 	for source, fetchErr := range fetchErrors {
+		// Skipped sources were already logged when their circuit breaker opened
+		if errors.Is(fetchErr, alertmanager.ErrCircuitOpen) {
+			continue
+		}
 		log.Printf("Alert cache refresh: failed to fetch alerts from %s, keeping its cached alerts untouched: %v", source, fetchErr)
 	}
 	if len(alertsWithSource) == 0 && len(fetchErrors) > 0 {
@@ -186,6 +302,8 @@ func (ac *AlertCache) refreshAlerts() {
 
 	log.Printf("Alert cache refresh: fetched %d alerts from Alertmanager", len(alertsWithSource))
 
+	ac.notifyAlerts(alertsWithSource, fetchErrors)
+
 	ac.mu.Lock()
 
 	ac.newAlerts = make([]string, 0)
@@ -204,15 +322,26 @@ func (ac *AlertCache) refreshAlerts() {
 		currentFingerprints[fingerprint] = true
 
 		if existingAlert, exists := ac.alerts[fingerprint]; !exists {
+			// Alerts found by the initial fetch were not "seen appearing", so
+			// they fall back to their start time instead of being highlighted as new.
+			if ac.primed {
+				dashAlert.FirstSeenAt = time.Now()
+			} else {
+				dashAlert.FirstSeenAt = dashAlert.StartsAt
+			}
 			ac.alerts[fingerprint] = dashAlert
 			ac.newAlerts = append(ac.newAlerts, fingerprint)
-			newAlertsForSSE = append(newAlertsForSSE, dashAlert)
+
+			// SSE subscribers and the statistics goroutine get their own copy; the
+			// cached alert keeps being mutated under ac.mu.
+			firedAlert := *dashAlert
+			newAlertsForSSE = append(newAlertsForSSE, &firedAlert)
 
 			// Capture alert fired event for statistics
 			ac.runBounded(func() {
 				if ac.backendClient != nil && ac.backendClient.IsConnected() {
-					if err := ac.backendClient.CaptureAlertFired(dashAlert); err != nil {
-						log.Printf("Failed to capture alert fired statistics for %s: %v", dashAlert.Fingerprint, err)
+					if err := ac.backendClient.CaptureAlertFired(&firedAlert); err != nil {
+						log.Printf("Failed to capture alert fired statistics for %s: %v", firedAlert.Fingerprint, err)
 					}
 				}
 			})
@@ -243,20 +372,23 @@ func (ac *AlertCache) refreshAlerts() {
 			alert.Status.State = "resolved"
 			alert.EndsAt = alert.ResolvedAt
 
+			// Copy the struct before spawning the goroutines so they operate on a snapshot,
+			// not the cache-resident pointer which may be mutated by concurrent writers.
+			alertCopy := *alert
+
 			// Update alert resolved event for statistics
 			ac.runBounded(func() {
 				if ac.backendClient != nil && ac.backendClient.IsConnected() {
-					if err := ac.backendClient.UpdateAlertResolved(alert); err != nil {
-						log.Printf("Failed to update alert resolved statistics for %s: %v", alert.Fingerprint, err)
+					if err := ac.backendClient.UpdateAlertResolved(&alertCopy); err != nil {
+						log.Printf("Failed to update alert resolved statistics for %s: %v", alertCopy.Fingerprint, err)
 					}
 				}
 			})
 
 			// Capture complete alert data with comments and acknowledgments for backend storage.
-			// Copy the struct before spawning the goroutine so it operates on a snapshot,
-			// not the cache-resident pointer which may be mutated by concurrent writers.
-			alertCopy := *alert
-			ac.runBounded(func() { ac.storeResolvedAlertInBackend(&alertCopy) })
+			if ac.captureResolved {
+				ac.runBounded(func() { ac.storeResolvedAlertInBackend(&alertCopy) })
+			}
 
 			delete(ac.alerts, fingerprint)
 
@@ -266,9 +398,11 @@ func (ac *AlertCache) refreshAlerts() {
 		}
 	}
 
+	ac.primed = true
+	snapshot := ac.publishSnapshotLocked()
 	ac.mu.Unlock()
 
-	log.Printf("Alert cache refresh complete: %d active alerts, %d newly resolved", len(ac.alerts), resolvedCount)
+	log.Printf("Alert cache refresh complete: %d active alerts, %d newly resolved", snapshot.Len(), resolvedCount)
 
 	ac.loadBackendData()
 
@@ -287,6 +421,57 @@ func (ac *AlertCache) refreshAlerts() {
 	}
 }
 
+// notifyAlerts hands the fetched alerts to the notifier. Like the cache, it
+// carries forward the previous alerts of a failed source so they don't look
+// resolved. The first fetch of each source only sets its baseline, so a
+// restart or a recovered outage doesn't notify everything already firing.
+// Callers must hold refreshMu.
+func (ac *AlertCache) notifyAlerts(alertsWithSource []alertmanager.AlertWithSource, fetchErrors map[string]error) {
+	if ac.notifier == nil {
+		return
+	}
+
+	current := append([]alertmanager.AlertWithSource(nil), alertsWithSource...)
+	for _, previous := range ac.previousAlerts {
+		if _, sourceFailed := fetchErrors[previous.Source]; sourceFailed {
+			current = append(current, previous)
+		}
+	}
+
+	previous := ac.previousAlerts
+	if previous == nil {
+		previous = current
+		ac.unbaselinedSources = make(map[string]bool)
+		for source := range fetchErrors {
+			ac.unbaselinedSources[source] = true
+		}
+	} else {
+		for source := range ac.unbaselinedSources {
+			if _, sourceFailed := fetchErrors[source]; sourceFailed {
+				continue
+			}
+			for _, alertWithSource := range alertsWithSource {
+				if alertWithSource.Source == source {
+					previous = append(previous, alertWithSource)
+				}
+			}
+			delete(ac.unbaselinedSources, source)
+		}
+	}
+	ac.previousAlerts = current
+
+	ac.notifier.ProcessAlerts(alertsOf(current), alertsOf(previous))
+}
+
+// alertsOf strips the sources from alerts
+func alertsOf(alertsWithSource []alertmanager.AlertWithSource) []models.Alert {
+	alerts := make([]models.Alert, 0, len(alertsWithSource))
+	for _, alertWithSource := range alertsWithSource {
+		alerts = append(alerts, alertWithSource.Alert)
+	}
+	return alerts
+}
+
 func (ac *AlertCache) convertToDashboardAlert(alert models.Alert, source string) *webuimodels.DashboardAlert {
 	transformedLabels := make(map[string]string)
 	for key, value := range alert.Labels {
@@ -330,7 +515,7 @@ func (ac *AlertCache) convertToDashboardAlert(alert models.Alert, source string)
 		Severity:   transformSeverity(alert.GetSeverity()),
 		Instance:   alert.GetInstance(),
 		Team:       alert.GetTeam(),
-		Summary:    alert.GetSummary(),
+		Summary:    alert.GetSummaryFrom(ac.summaryAnnotations),
 		IsResolved: transformedStatus == "resolved",
 	}
 
@@ -349,13 +534,6 @@ func (ac *AlertCache) convertToDashboardAlert(alert models.Alert, source string)
 	return dashAlert
 }
 
-// func (ac *AlertCache) alertHasChanged(old, new *webuimodels.DashboardAlert) bool {
-// 	return old.Status.State != new.Status.State ||
-// 		len(old.Status.SilencedBy) != len(new.Status.SilencedBy) ||
-// 		len(old.Status.InhibitedBy) != len(new.Status.InhibitedBy) ||
-// 		!old.EndsAt.Equal(new.EndsAt)
-// }
-
 func (ac *AlertCache) updateExistingAlert(existing, new *webuimodels.DashboardAlert) {
 	// Check if alert has meaningfully changed before updating UpdatedAt
 	if ac.hasAlertChanged(existing, new) {
@@ -368,23 +546,42 @@ func (ac *AlertCache) updateExistingAlert(existing, new *webuimodels.DashboardAl
 	existing.Duration = new.Duration
 	existing.IsResolved = new.IsResolved
 
+	existing.Labels = new.Labels
 	existing.Annotations = new.Annotations
+	existing.Summary = new.Summary
 }
 
 // hasAlertChanged compares two alerts to determine if there are meaningful changes
 // that warrant updating the UpdatedAt timestamp.
-// It compares: Status, IsAcknowledged, CommentCount, Summary
+// It compares: Status (state, silences, inhibitors), all labels and annotations,
+// IsAcknowledged, AcknowledgmentCount, CommentCount, Summary
 func (ac *AlertCache) hasAlertChanged(existing, new *webuimodels.DashboardAlert) bool {
 	// Check Status.State change
 	if existing.Status.State != new.Status.State {
 		return true
 	}
 
+	// Check which silences and alerts suppress it
+	if !slices.Equal(existing.Status.SilencedBy, new.Status.SilencedBy) ||
+		!slices.Equal(existing.Status.InhibitedBy, new.Status.InhibitedBy) {
+		return true
+	}
+
+	// Check every label and annotation, not only the ones the summary uses
+	if !maps.Equal(existing.Labels, new.Labels) || !maps.Equal(existing.Annotations, new.Annotations) {
+		return true
+	}
+
 	// Check IsAcknowledged change
 	if existing.IsAcknowledged != new.IsAcknowledged {
 		return true
 	}
 
+	// Check AcknowledgmentCount change
+	if existing.AcknowledgmentCount != new.AcknowledgmentCount {
+		return true
+	}
+
 	// Check CommentCount change
 	if existing.CommentCount != new.CommentCount {
 		return true
@@ -417,6 +614,7 @@ func (ac *AlertCache) UpdateAlert(alert *webuimodels.DashboardAlert) {
 		// Update other fields
 		existingAlert.Status = alert.Status
 		existingAlert.IsAcknowledged = alert.IsAcknowledged
+		existingAlert.AcknowledgmentCount = alert.AcknowledgmentCount
 		existingAlert.CommentCount = alert.CommentCount
 		existingAlert.Summary = alert.Summary
 		existingAlert.EndsAt = alert.EndsAt
@@ -428,6 +626,7 @@ func (ac *AlertCache) UpdateAlert(alert *webuimodels.DashboardAlert) {
 		alert.UpdatedAt = time.Now()
 		ac.alerts[alert.Fingerprint] = alert
 	}
+	ac.publishSnapshotLocked()
 }
 
 func (ac *AlertCache) loadBackendData() {
@@ -464,7 +663,10 @@ func (ac *AlertCache) loadAcknowledgmentsEfficiently() {
 	}
 
 	// Step 2: call gRPC with NO lock held
-	acknowledgedAlerts, err := ac.backendClient.GetAllAcknowledgedAlerts(fingerprints)
+	requestedAt := time.Now()
+	// On failure keep the current acknowledgments and counts rather than
+	// clearing them until the next refresh
+	acknowledgedAlerts, counts, err := ac.backendClient.GetAllAcknowledgedAlerts(fingerprints)
 	if err != nil {
 		log.Printf("Failed to load acknowledged alerts from backend: %v", err)
 		return
@@ -472,13 +674,42 @@ func (ac *AlertCache) loadAcknowledgmentsEfficiently() {
 
 	log.Printf("Received %d acknowledged alerts from backend", len(acknowledgedAlerts))
 
+	now := time.Now()
 	ac.mu.Lock()
+	for fingerprint, alert := range ac.alerts {
+		if count := counts[fingerprint]; alert.AcknowledgmentCount != count {
+			alert.AcknowledgmentCount = count
+			alert.UpdatedAt = now
+		}
+
+		// The acknowledgment was removed or lapsed since the last load; ones
+		// made after the request went out are not in its answer yet
+		if _, acknowledged := acknowledgedAlerts[fingerprint]; !acknowledged && alert.IsAcknowledged && alert.AcknowledgedAt.Before(requestedAt) {
+			if alert.AcknowledgmentRenotify && alert.AcknowledgmentExpiresAt != nil && !alert.AcknowledgmentExpiresAt.After(now) {
+				lapsedAt := now
+				alert.AcknowledgmentLapsedAt = &lapsedAt
+			}
+			alert.IsAcknowledged = false
+			alert.AcknowledgedBy = ""
+			alert.AcknowledgedAt = time.Time{}
+			alert.AcknowledgeReason = ""
+			alert.AcknowledgmentExpiresAt = nil
+			alert.AcknowledgmentRenotify = false
+			alert.UpdatedAt = now
+		}
+	}
 	for fingerprint, acknowledgment := range acknowledgedAlerts {
 		if alert, exists := ac.alerts[fingerprint]; exists {
 			alert.IsAcknowledged = true
 			alert.AcknowledgedBy = acknowledgment.Username
 			alert.AcknowledgedAt = acknowledgment.CreatedAt.AsTime()
 			alert.AcknowledgeReason = acknowledgment.Reason
+			alert.AcknowledgmentExpiresAt = nil
+			if acknowledgment.ExpiresAt != nil {
+				expiresAt := acknowledgment.ExpiresAt.AsTime()
+				alert.AcknowledgmentExpiresAt = &expiresAt
+			}
+			alert.AcknowledgmentRenotify = acknowledgment.Renotify
 
 			// Note: Comment counts are loaded separately by loadCommentCountsEfficiently()
 			// Note: We don't capture statistics here because this is loading historical
@@ -486,6 +717,7 @@ func (ac *AlertCache) loadAcknowledgmentsEfficiently() {
 			// acknowledged in real-time to avoid negative MTTR calculations.
 		}
 	}
+	ac.publishSnapshotLocked()
 	ac.mu.Unlock()
 
 	log.Printf("Successfully updated %d alerts with acknowledgment data", len(acknowledgedAlerts))
@@ -517,39 +749,56 @@ func (ac *AlertCache) loadCommentCountsEfficiently() {
 		for _, alert := range ac.alerts {
 			alert.CommentCount = 0
 		}
+		ac.publishSnapshotLocked()
 		ac.mu.Unlock()
 		return
 	}
 
 	// Step 3: write results back under Lock
+	// Counts can change from other webui instances (e.g. a deleted comment), so
+	// bump UpdatedAt on change for incremental refreshes to notice.
 	alertsWithComments := 0
+	now := time.Now()
 	ac.mu.Lock()
 	for fingerprint, alert := range ac.alerts {
-		if count, exists := counts[fingerprint]; exists {
+		count := counts[fingerprint]
+		if alert.CommentCount != count {
 			alert.CommentCount = count
-			if count > 0 {
-				alertsWithComments++
-			}
-		} else {
-			alert.CommentCount = 0
+			alert.UpdatedAt = now
+		}
+		if count > 0 {
+			alertsWithComments++
 		}
 	}
 	totalAlerts := len(ac.alerts)
+	ac.publishSnapshotLocked()
 	ac.mu.Unlock()
 
 	log.Printf("Successfully loaded comment counts for %d alerts (%d with comments) using batch query", totalAlerts, alertsWithComments)
 }
 
+// GetAllAlerts returns copies of the active alerts from the latest snapshot,
+// so callers can read them freely while refreshes run; use MutateAlert to
+// change the cached alert.
 func (ac *AlertCache) GetAllAlerts() []*webuimodels.DashboardAlert {
-	ac.mu.RLock()
-	defer ac.mu.RUnlock()
+	return ac.Snapshot().Alerts()
+}
 
-	alerts := make([]*webuimodels.DashboardAlert, 0, len(ac.alerts))
-	for _, alert := range ac.alerts {
-		alerts = append(alerts, alert)
+// MutateAlert applies fn to the cached alert under the write lock and returns
+// a snapshot of the result. It returns false if the alert is not cached.
+func (ac *AlertCache) MutateAlert(fingerprint string, fn func(alert *webuimodels.DashboardAlert)) (*webuimodels.DashboardAlert, bool) {
+	ac.mu.Lock()
+	defer ac.mu.Unlock()
+
+	alert, exists := ac.alerts[fingerprint]
+	if !exists {
+		return nil, false
 	}
 
-	return alerts
+	fn(alert)
+	ac.publishSnapshotLocked()
+	snapshot := *alert
+	return &snapshot, true
 }
 
 func (ac *AlertCache) GetResolvedAlerts() []*webuimodels.DashboardAlert {
@@ -575,6 +824,29 @@ func (ac *AlertCache) GetResolvedAlertsWithPagination(limit, offset int) []*webu
 		return []*webuimodels.DashboardAlert{}
 	}
 
+	alerts := resolvedInfosToDashboardAlerts(resolvedAlertInfos)
+	log.Printf("Fetched %d resolved alerts from backend", len(alerts))
+	return alerts
+}
+
+// SearchResolvedAlerts returns one page of stored resolved alerts matching
+// query, along with the number of matches across all pages
+func (ac *AlertCache) SearchResolvedAlerts(query client.ResolvedAlertsQuery, limit, offset int) ([]*webuimodels.DashboardAlert, int, error) {
+	if ac.backendClient == nil || !ac.backendClient.IsConnected() {
+		return nil, 0, fmt.Errorf("backend client not available")
+	}
+
+	resolvedAlertInfos, total, err := ac.backendClient.SearchResolvedAlerts(query, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return resolvedInfosToDashboardAlerts(resolvedAlertInfos), total, nil
+}
+
+// resolvedInfosToDashboardAlerts decodes stored resolved alerts, skipping
+// any whose alert data cannot be read
+func resolvedInfosToDashboardAlerts(resolvedAlertInfos []*alertpb.ResolvedAlertInfo) []*webuimodels.DashboardAlert {
 	alerts := make([]*webuimodels.DashboardAlert, 0, len(resolvedAlertInfos))
 	for _, resolvedInfo := range resolvedAlertInfos {
 		var dashAlert webuimodels.DashboardAlert
@@ -584,26 +856,44 @@ func (ac *AlertCache) GetResolvedAlertsWithPagination(limit, offset int) []*webu
 		}
 
 		dashAlert.ResolvedAt = resolvedInfo.ResolvedAt.AsTime()
+		if resolvedInfo.ExpiresAt != nil {
+			dashAlert.RetainedUntil = resolvedInfo.ExpiresAt.AsTime()
+		}
 		dashAlert.IsResolved = true
 		dashAlert.Status.State = "resolved"
 
+		// The discussion stored on resolution is what the alert had at the end
+		if count, ok := storedEntryCount(resolvedInfo.Comments); ok {
+			dashAlert.CommentCount = count
+		}
+		if count, ok := storedEntryCount(resolvedInfo.Acknowledgments); ok {
+			dashAlert.AcknowledgmentCount = count
+		}
+
 		alerts = append(alerts, &dashAlert)
 	}
 
-	log.Printf("Fetched %d resolved alerts from backend", len(alerts))
 	return alerts
 }
 
-func (ac *AlertCache) GetAlert(fingerprint string) (*webuimodels.DashboardAlert, bool) {
-	ac.mu.RLock()
+// storedEntryCount is the length of a stored JSON array, or false when none
+// was stored or it cannot be read
+func storedEntryCount(data []byte) (int, bool) {
+	if len(data) == 0 {
+		return 0, false
+	}
+	var entries []json.RawMessage
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return 0, false
+	}
+	return len(entries), true
+}
 
-	if alert, exists := ac.alerts[fingerprint]; exists {
-		ac.mu.RUnlock()
+func (ac *AlertCache) GetAlert(fingerprint string) (*webuimodels.DashboardAlert, bool) {
+	if alert, exists := ac.Snapshot().Alert(fingerprint); exists {
 		return alert, true
 	}
 
-	ac.mu.RUnlock()
-
 	if ac.backendClient != nil && ac.backendClient.IsConnected() {
 		if resolvedInfo, err := ac.backendClient.GetResolvedAlert(fingerprint); err == nil {
 			var dashAlert webuimodels.DashboardAlert
@@ -658,6 +948,7 @@ func (ac *AlertCache) SetAlertHidden(userID, fingerprint string, hidden bool) {
 			alert.HiddenBy = userID
 			alert.HiddenAt = time.Now()
 		}
+		ac.publishSnapshotLocked()
 	}
 }
 
@@ -901,37 +1192,54 @@ func (ac *AlertCache) storeResolvedAlertInBackend(alert *webuimodels.DashboardAl
 		}
 	}
 
-	// Convert days to hours for backend API
-	ttlHours := ac.resolvedRetentionDays * 24
+	ttlHours := ac.ResolvedAlertTTLHours()
 
-	if err := ac.backendClient.CreateResolvedAlert(
+	created, err := ac.backendClient.CreateResolvedAlert(
 		alert.Fingerprint,
 		alert.Source,
 		alertData,
 		comments,
 		acknowledgments,
 		ttlHours,
-	); err != nil {
+		alert.StartsAt,
+	)
+	switch {
+	case err != nil:
 		log.Printf("Error storing resolved alert %s in backend: %v", alert.Fingerprint, err)
-	} else {
+	case !created:
+		log.Printf("Resolved alert %s was already stored in backend", alert.Fingerprint)
+	default:
 		log.Printf("Successfully stored resolved alert %s in backend", alert.Fingerprint)
 	}
 }
 
-func (ac *AlertCache) RemoveAllResolvedAlerts(sessionID string) error {
+// RemoveAllResolvedAlerts removes all resolved alerts from the backend and
+// returns the time identifying the removal for UndoRemoveAllResolvedAlerts,
+// and until when it can be undone
+func (ac *AlertCache) RemoveAllResolvedAlerts(sessionID string) (clearedAt, undoUntil time.Time, err error) {
 	if ac.backendClient == nil || !ac.backendClient.IsConnected() {
-		return fmt.Errorf("backend client not available")
+		return time.Time{}, time.Time{}, fmt.Errorf("backend client not available")
 	}
 
 	log.Printf("Removing all resolved alerts from backend")
 
-	if err := ac.backendClient.RemoveAllResolvedAlerts(sessionID); err != nil {
+	clearedAt, undoUntil, err = ac.backendClient.RemoveAllResolvedAlerts(sessionID)
+	if err != nil {
 		log.Printf("Error removing all resolved alerts from backend: %v", err)
-		return err
+		return time.Time{}, time.Time{}, err
 	}
 
 	log.Printf("Successfully removed all resolved alerts from backend")
-	return nil
+	return clearedAt, undoUntil, nil
+}
+
+// UndoRemoveAllResolvedAlerts brings back the resolved alerts removed at
+// clearedAt and returns how many were restored
+func (ac *AlertCache) UndoRemoveAllResolvedAlerts(sessionID string, clearedAt time.Time) (int, error) {
+	if ac.backendClient == nil || !ac.backendClient.IsConnected() {
+		return 0, fmt.Errorf("backend client not available")
+	}
+	return ac.backendClient.UndoRemoveAllResolvedAlerts(sessionID, clearedAt)
 }
 
 // Subscribe creates a new channel and registers it for receiving incremental updates.
@@ -2,12 +2,17 @@ package services
 
 import (
 	"errors"
+	"fmt"
+	"sync"
 	"testing"
 	"time"
 
 	"notificator/internal/alertmanager"
+	alertpb "notificator/internal/backend/proto/alert"
 	"notificator/internal/models"
 	webuimodels "notificator/internal/webui/models"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func TestAlertCache_UpdatedAtTracking(t *testing.T) {
@@ -646,6 +651,21 @@ func TestAlertCache_HasAlertChanged(t *testing.T) {
 			t.Error("hasAlertChanged should return true when Summary changes")
 		}
 	})
+
+	t.Run("Returns true when any label, annotation or suppression changes", func(t *testing.T) {
+		for name, change := range map[string]func(a *webuimodels.DashboardAlert){
+			"label":       func(a *webuimodels.DashboardAlert) { a.Labels = map[string]string{"pod": "api-2"} },
+			"annotation":  func(a *webuimodels.DashboardAlert) { a.Annotations = map[string]string{"runbook_url": "runbooks/new"} },
+			"silencedBy":  func(a *webuimodels.DashboardAlert) { a.Status.SilencedBy = []string{"silence-1"} },
+			"inhibitedBy": func(a *webuimodels.DashboardAlert) { a.Status.InhibitedBy = []string{"fp-2"} },
+		} {
+			newAlert := *baseAlert
+			change(&newAlert)
+			if !cache.hasAlertChanged(baseAlert, &newAlert) {
+				t.Errorf("hasAlertChanged should return true when the %s changes", name)
+			}
+		}
+	})
 }
 
 func TestAlertCache_SSEPubSub(t *testing.T) {
@@ -907,3 +927,315 @@ func TestAlertCache_RefreshWithPartialFetchFailure(t *testing.T) {
 		}
 	})
 }
+
+// recordingNotifier records what each refresh hands to the notifier.
+type recordingNotifier struct {
+	calls [][2][]models.Alert
+}
+
+func (r *recordingNotifier) ProcessAlerts(newAlerts []models.Alert, previousAlerts []models.Alert) {
+	r.calls = append(r.calls, [2][]models.Alert{newAlerts, previousAlerts})
+}
+
+func TestAlertCache_RefreshFeedsNotifier(t *testing.T) {
+	newAlert := func(name, source string) alertmanager.AlertWithSource {
+		return alertmanager.AlertWithSource{
+			Alert: models.Alert{
+				Labels:   map[string]string{"alertname": name, "severity": "critical"},
+				Status:   models.AlertStatus{State: "firing"},
+				StartsAt: time.Now().Add(-time.Hour),
+			},
+			Source: source,
+		}
+	}
+
+	recorder := &recordingNotifier{}
+	cache := NewAlertCache(nil, nil, 90, 10*time.Second)
+	cache.SetAlertNotifier(recorder)
+	fetcher := &fakeAlertFetcher{alerts: []alertmanager.AlertWithSource{newAlert("Existing", "prod")}}
+	cache.alertmanagerClient = fetcher
+
+	cache.refreshAlerts()
+	if len(recorder.calls) != 1 {
+		t.Fatalf("expected the first refresh to reach the notifier, got %d calls", len(recorder.calls))
+	}
+	if len(recorder.calls[0][1]) != 1 {
+		t.Error("the first refresh should use the current alerts as the baseline so nothing already firing notifies")
+	}
+
+	fetcher.alerts = []alertmanager.AlertWithSource{newAlert("Existing", "prod"), newAlert("Fresh", "staging")}
+	cache.refreshAlerts()
+	if len(recorder.calls) != 2 {
+		t.Fatalf("expected a second notifier call, got %d", len(recorder.calls))
+	}
+	if got := recorder.calls[1]; len(got[0]) != 2 || len(got[1]) != 1 {
+		t.Errorf("expected 2 current and 1 previous alerts, got %d and %d", len(got[0]), len(got[1]))
+	}
+
+	fetcher.alerts = []alertmanager.AlertWithSource{newAlert("Fresh", "staging"), newAlert("Storm", "staging")}
+	fetcher.fetchErrors = map[string]error{"prod": errors.New("connection refused")}
+	cache.refreshAlerts()
+	if len(recorder.calls) != 3 {
+		t.Fatalf("a partial fetch must still notify the healthy sources, got %d calls", len(recorder.calls))
+	}
+	if got := recorder.calls[2]; len(got[0]) != 3 || len(got[1]) != 2 {
+		t.Errorf("expected the failed source's alert carried forward (3 current, 2 previous), got %d and %d", len(got[0]), len(got[1]))
+	}
+}
+
+func TestAlertCache_NotifierBaselinesSourceFailingAtStartup(t *testing.T) {
+	newAlert := func(name, source string) alertmanager.AlertWithSource {
+		return alertmanager.AlertWithSource{
+			Alert: models.Alert{
+				Labels:   map[string]string{"alertname": name, "severity": "critical"},
+				Status:   models.AlertStatus{State: "firing"},
+				StartsAt: time.Now().Add(-time.Hour),
+			},
+			Source: source,
+		}
+	}
+
+	recorder := &recordingNotifier{}
+	cache := NewAlertCache(nil, nil, 90, 10*time.Second)
+	cache.SetAlertNotifier(recorder)
+	fetcher := &fakeAlertFetcher{
+		alerts:      []alertmanager.AlertWithSource{newAlert("Existing", "prod")},
+		fetchErrors: map[string]error{"staging": errors.New("connection refused")},
+	}
+	cache.alertmanagerClient = fetcher
+	cache.refreshAlerts()
+
+	fetcher.alerts = []alertmanager.AlertWithSource{newAlert("Existing", "prod"), newAlert("Old", "staging")}
+	fetcher.fetchErrors = nil
+	cache.refreshAlerts()
+	if got := recorder.calls[1]; len(got[0]) != 2 || len(got[1]) != 2 {
+		t.Errorf("a source's first answer must only set its baseline, got %d current and %d previous", len(got[0]), len(got[1]))
+	}
+
+	fetcher.alerts = append(fetcher.alerts, newAlert("Fresh", "staging"))
+	cache.refreshAlerts()
+	if got := recorder.calls[2]; len(got[0]) != 3 || len(got[1]) != 2 {
+		t.Errorf("expected 3 current and 2 previous alerts, got %d and %d", len(got[0]), len(got[1]))
+	}
+}
+
+// Run with -race: readers and handler-style mutations must not race with refreshes.
+func TestAlertCache_ConcurrentRefreshAndReads(t *testing.T) {
+	alerts := make([]alertmanager.AlertWithSource, 0, 20)
+	for i := 0; i < 20; i++ {
+		alerts = append(alerts, alertmanager.AlertWithSource{
+			Alert: models.Alert{
+				Labels:   map[string]string{"alertname": fmt.Sprintf("Alert%d", i), "severity": "warning"},
+				Status:   models.AlertStatus{State: "firing"},
+				StartsAt: time.Now().Add(-time.Hour),
+			},
+			Source: "prod",
+		})
+	}
+
+	cache := NewAlertCache(nil, nil, 90, 10*time.Second)
+	cache.alertmanagerClient = &fakeAlertFetcher{alerts: alerts}
+	cache.refreshAlerts()
+
+	var wg sync.WaitGroup
+	stop := make(chan struct{})
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 50; i++ {
+			cache.refreshAlerts()
+		}
+		close(stop)
+	}()
+
+	for r := 0; r < 4; r++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-stop:
+					return
+				default:
+				}
+				for _, alert := range cache.GetAllAlerts() {
+					// Filtering and sorting paths read these fields without holding the lock.
+					_ = alert.Severity + alert.Status.State + alert.Labels["alertname"]
+					_ = alert.IsAcknowledged || alert.CommentCount > 0
+					cache.MutateAlert(alert.Fingerprint, func(cached *webuimodels.DashboardAlert) {
+						cached.CommentCount++
+					})
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if got := len(cache.GetAllAlerts()); got != len(alerts) {
+		t.Errorf("expected %d cached alerts, got %d", len(alerts), got)
+	}
+}
+
+func TestAlertCache_SnapshotsAreIsolated(t *testing.T) {
+	cache := NewAlertCache(nil, nil, 90, 10*time.Second)
+	cache.UpdateAlert(&webuimodels.DashboardAlert{Fingerprint: "snapshot", CommentCount: 1})
+
+	snapshot, _ := cache.GetAlert("snapshot")
+	snapshot.CommentCount = 42
+
+	cached, _ := cache.GetAlert("snapshot")
+	if cached.CommentCount != 1 {
+		t.Errorf("mutating a snapshot must not affect the cache, got CommentCount %d", cached.CommentCount)
+	}
+
+	updated, ok := cache.MutateAlert("snapshot", func(alert *webuimodels.DashboardAlert) {
+		alert.CommentCount++
+	})
+	if !ok || updated.CommentCount != 2 {
+		t.Fatalf("expected MutateAlert to return CommentCount 2, got %v (ok=%v)", updated, ok)
+	}
+	if cached, _ := cache.GetAlert("snapshot"); cached.CommentCount != 2 {
+		t.Errorf("expected MutateAlert to update the cache, got CommentCount %d", cached.CommentCount)
+	}
+
+	if _, ok := cache.MutateAlert("missing", func(*webuimodels.DashboardAlert) {}); ok {
+		t.Error("MutateAlert should report false for unknown fingerprints")
+	}
+}
+
+func TestAlertCache_RefreshPicksUpAnnotationChanges(t *testing.T) {
+	alert := alertmanager.AlertWithSource{
+		Alert: models.Alert{
+			Labels:      map[string]string{"alertname": "DiskFull"},
+			Annotations: map[string]string{"summary": "Disk full", "runbook_url": "https://runbooks/old"},
+			Status:      models.AlertStatus{State: "firing"},
+			StartsAt:    time.Now().Add(-time.Hour),
+		},
+		Source: "prod",
+	}
+
+	cache := NewAlertCache(nil, nil, 90, 10*time.Second)
+	fetcher := &fakeAlertFetcher{alerts: []alertmanager.AlertWithSource{alert}}
+	cache.alertmanagerClient = fetcher
+	cache.refreshAlerts()
+
+	ch := cache.Subscribe()
+	defer cache.Unsubscribe(ch)
+
+	alert.Alert.Annotations = map[string]string{"summary": "Disk full", "runbook_url": "https://runbooks/new"}
+	fetcher.alerts = []alertmanager.AlertWithSource{alert}
+	cache.ForceRefresh()
+
+	select {
+	case update := <-ch:
+		if len(update.UpdatedAlerts) != 1 || update.UpdatedAlerts[0].Annotations["runbook_url"] != "https://runbooks/new" {
+			t.Fatalf("expected the annotation change pushed as an update, got %+v", update)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected an update for the changed annotation")
+	}
+
+	// Once applied, the same annotations are no longer a change
+	cache.ForceRefresh()
+	select {
+	case update := <-ch:
+		t.Errorf("expected no update for an unchanged alert, got %+v", update)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+// The polling path sends alerts whose UpdatedAt is past the client's last
+// update, so an annotation-only change must move it
+func TestAlertCache_AnnotationOnlyChangeBumpsUpdatedAt(t *testing.T) {
+	alertSet := func(summary string) []alertmanager.AlertWithSource {
+		return []alertmanager.AlertWithSource{{
+			Alert: models.Alert{
+				Labels:      map[string]string{"alertname": "HighLatency", "severity": "warning", "instance": "api-1"},
+				Annotations: map[string]string{"summary": summary},
+				Status:      models.AlertStatus{State: "firing"},
+				StartsAt:    time.Now().Add(-time.Hour),
+			},
+			Source: "prod",
+		}}
+	}
+
+	cache := NewAlertCache(nil, nil, 90, 10*time.Second)
+	fetcher := &fakeAlertFetcher{alerts: alertSet("p99 above 500ms")}
+	cache.alertmanagerClient = fetcher
+	cache.refreshAlerts()
+
+	fingerprint := cache.convertToDashboardAlert(fetcher.alerts[0].Alert, "prod").Fingerprint
+	before, _ := cache.GetAlert(fingerprint)
+
+	time.Sleep(5 * time.Millisecond)
+	fetcher.alerts = alertSet("p99 above 2s")
+	cache.refreshAlerts()
+
+	after, _ := cache.GetAlert(fingerprint)
+	if !after.UpdatedAt.After(before.UpdatedAt) {
+		t.Fatalf("expected UpdatedAt to move when only an annotation changed, got %s then %s", before.UpdatedAt, after.UpdatedAt)
+	}
+	if after.Summary != "p99 above 2s" || after.Annotations["summary"] != "p99 above 2s" {
+		t.Errorf("expected the new annotation cached, got %q / %v", after.Summary, after.Annotations)
+	}
+
+	cache.refreshAlerts()
+	if again, _ := cache.GetAlert(fingerprint); !again.UpdatedAt.Equal(after.UpdatedAt) {
+		t.Errorf("expected an unchanged alert to keep its UpdatedAt, got %s then %s", after.UpdatedAt, again.UpdatedAt)
+	}
+}
+
+func TestResolvedInfosToDashboardAlerts_CountsStoredDiscussion(t *testing.T) {
+	resolvedAt := time.Now().Add(-time.Hour).Truncate(time.Second)
+	alerts := resolvedInfosToDashboardAlerts([]*alertpb.ResolvedAlertInfo{
+		{
+			Fingerprint:     "discussed",
+			AlertData:       []byte(`{"fingerprint":"discussed","commentCount":7}`),
+			Comments:        []byte(`[{"id":"c1"},{"id":"c2"}]`),
+			Acknowledgments: []byte(`[{"id":"a1"}]`),
+			ResolvedAt:      timestamppb.New(resolvedAt),
+			ExpiresAt:       timestamppb.New(resolvedAt.Add(48 * time.Hour)),
+		},
+		{
+			Fingerprint: "quiet",
+			AlertData:   []byte(`{"fingerprint":"quiet","commentCount":3}`),
+			ResolvedAt:  timestamppb.New(resolvedAt),
+		},
+		{Fingerprint: "broken", AlertData: []byte(`not json`)},
+	})
+
+	if len(alerts) != 2 {
+		t.Fatalf("expected the unreadable alert to be skipped, got %d alerts", len(alerts))
+	}
+	if alerts[0].CommentCount != 2 || alerts[0].AcknowledgmentCount != 1 {
+		t.Errorf("expected counts from the stored discussion, got %d comments and %d acks", alerts[0].CommentCount, alerts[0].AcknowledgmentCount)
+	}
+	if alerts[1].CommentCount != 3 {
+		t.Errorf("expected the serialized count without a stored discussion, got %d", alerts[1].CommentCount)
+	}
+	if !alerts[0].IsResolved || !alerts[0].ResolvedAt.Equal(resolvedAt) {
+		t.Errorf("expected the alert marked resolved at %v, got %+v", resolvedAt, alerts[0])
+	}
+	if !alerts[0].RetainedUntil.Equal(resolvedAt.Add(48 * time.Hour)) {
+		t.Errorf("expected the stored expiry, got %v", alerts[0].RetainedUntil)
+	}
+}
+
+func TestAlertCache_SetResolvedAlertTTLHours(t *testing.T) {
+	cache := NewAlertCache(nil, nil, 90, 10*time.Second)
+
+	if got := cache.ResolvedAlertTTLHours(); got != 90*24 {
+		t.Errorf("expected the configured retention in hours, got %d", got)
+	}
+	cache.SetResolvedAlertTTLHours(36)
+	if got := cache.ResolvedAlertTTLHours(); got != 36 {
+		t.Errorf("expected the saved TTL, got %d", got)
+	}
+	// Nothing saved yet on the backend
+	cache.SetResolvedAlertTTLHours(0)
+	if got := cache.ResolvedAlertTTLHours(); got != 36 {
+		t.Errorf("expected a zero TTL to be ignored, got %d", got)
+	}
+}
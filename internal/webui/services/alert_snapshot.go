@@ -0,0 +1,83 @@
+package services
+
+import (
+	"sort"
+	"time"
+
+	webuimodels "notificator/internal/webui/models"
+)
+
+// AlertSnapshot is an immutable, point-in-time view of the active alerts.
+// The cache builds a new snapshot after every change and publishes it
+// atomically, so a request that renders from one snapshot never mixes alerts
+// and counts from two different refresh cycles.
+type AlertSnapshot struct {
+	Version uint64
+	BuiltAt time.Time
+
+	// Derived counts, computed once per snapshot instead of once per request
+	SeverityCounts    map[string]int
+	StateCounts       map[string]int
+	AcknowledgedCount int
+
+	alerts []*webuimodels.DashboardAlert // sorted by fingerprint, never mutated
+	index  map[string]*webuimodels.DashboardAlert
+}
+
+// buildAlertSnapshot copies the given alerts into a new snapshot.
+// Callers must hold the lock protecting the source map.
+func buildAlertSnapshot(source map[string]*webuimodels.DashboardAlert, version uint64) *AlertSnapshot {
+	snapshot := &AlertSnapshot{
+		Version:        version,
+		BuiltAt:        time.Now(),
+		SeverityCounts: make(map[string]int),
+		StateCounts:    make(map[string]int),
+		alerts:         make([]*webuimodels.DashboardAlert, 0, len(source)),
+		index:          make(map[string]*webuimodels.DashboardAlert, len(source)),
+	}
+
+	for fingerprint, alert := range source {
+		alertCopy := *alert
+		snapshot.alerts = append(snapshot.alerts, &alertCopy)
+		snapshot.index[fingerprint] = &alertCopy
+
+		snapshot.SeverityCounts[alertCopy.Severity]++
+		snapshot.StateCounts[alertCopy.Status.State]++
+		if alertCopy.IsAcknowledged {
+			snapshot.AcknowledgedCount++
+		}
+	}
+
+	// Stable order so consecutive snapshots render identically when nothing changed
+	sort.Slice(snapshot.alerts, func(i, j int) bool {
+		return snapshot.alerts[i].Fingerprint < snapshot.alerts[j].Fingerprint
+	})
+
+	return snapshot
+}
+
+// Len returns the number of active alerts in the snapshot.
+func (s *AlertSnapshot) Len() int {
+	return len(s.alerts)
+}
+
+// Alerts returns copies of the snapshot's alerts, so callers may annotate them
+// while filtering or rendering without affecting other readers.
+func (s *AlertSnapshot) Alerts() []*webuimodels.DashboardAlert {
+	alerts := make([]*webuimodels.DashboardAlert, 0, len(s.alerts))
+	for _, alert := range s.alerts {
+		alertCopy := *alert
+		alerts = append(alerts, &alertCopy)
+	}
+	return alerts
+}
+
+// Alert returns a copy of the alert with the given fingerprint.
+func (s *AlertSnapshot) Alert(fingerprint string) (*webuimodels.DashboardAlert, bool) {
+	alert, exists := s.index[fingerprint]
+	if !exists {
+		return nil, false
+	}
+	alertCopy := *alert
+	return &alertCopy, true
+}
@@ -0,0 +1,223 @@
+package services
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"notificator/internal/alertmanager"
+	"notificator/internal/models"
+	webuimodels "notificator/internal/webui/models"
+)
+
+func newSnapshotTestAlerts(count int) []alertmanager.AlertWithSource {
+	severities := []string{"critical", "warning", "info"}
+	alerts := make([]alertmanager.AlertWithSource, 0, count)
+	for i := 0; i < count; i++ {
+		alerts = append(alerts, alertmanager.AlertWithSource{
+			Alert: models.Alert{
+				Labels: map[string]string{
+					"alertname": fmt.Sprintf("Alert%d", i),
+					"severity":  severities[i%len(severities)],
+				},
+				Status:   models.AlertStatus{State: "firing"},
+				StartsAt: time.Now().Add(-time.Hour),
+			},
+			Source: "prod",
+		})
+	}
+	return alerts
+}
+
+func TestAlertCache_SnapshotPublishing(t *testing.T) {
+	cache := NewAlertCache(nil, nil, 90, 10*time.Second)
+
+	initial := cache.Snapshot()
+	if initial == nil || initial.Len() != 0 {
+		t.Fatalf("expected an empty initial snapshot, got %+v", initial)
+	}
+
+	fetcher := &fakeAlertFetcher{alerts: newSnapshotTestAlerts(6)}
+	cache.alertmanagerClient = fetcher
+	cache.refreshAlerts()
+
+	snapshot := cache.Snapshot()
+	if snapshot.Version <= initial.Version {
+		t.Errorf("expected refresh to publish a newer snapshot, got version %d after %d", snapshot.Version, initial.Version)
+	}
+	if snapshot.Len() != 6 {
+		t.Fatalf("expected 6 alerts in snapshot, got %d", snapshot.Len())
+	}
+	if snapshot.SeverityCounts["critical"] != 2 || snapshot.SeverityCounts["warning"] != 2 || snapshot.SeverityCounts["info"] != 2 {
+		t.Errorf("unexpected severity counts: %v", snapshot.SeverityCounts)
+	}
+	if snapshot.StateCounts["active"]+snapshot.StateCounts["firing"] != 6 {
+		t.Errorf("unexpected state counts: %v", snapshot.StateCounts)
+	}
+
+	alerts := snapshot.Alerts()
+	for i := 1; i < len(alerts); i++ {
+		if alerts[i-1].Fingerprint >= alerts[i].Fingerprint {
+			t.Fatal("snapshot alerts should be ordered by fingerprint")
+		}
+	}
+
+	t.Run("Published snapshots are never modified", func(t *testing.T) {
+		fingerprint := alerts[0].Fingerprint
+		cache.MutateAlert(fingerprint, func(alert *webuimodels.DashboardAlert) {
+			alert.IsAcknowledged = true
+		})
+
+		if snapshot.AcknowledgedCount != 0 {
+			t.Error("an already published snapshot must not see later mutations")
+		}
+		if old, _ := snapshot.Alert(fingerprint); old.IsAcknowledged {
+			t.Error("an already published snapshot must not see later mutations")
+		}
+		if cache.Snapshot().AcknowledgedCount != 1 {
+			t.Errorf("expected the new snapshot to count 1 acknowledged alert, got %d", cache.Snapshot().AcknowledgedCount)
+		}
+	})
+
+	t.Run("Callers get their own copies", func(t *testing.T) {
+		first := snapshot.Alerts()
+		first[0].Summary = "changed by caller"
+		if second := snapshot.Alerts(); second[0].Summary == "changed by caller" {
+			t.Error("Alerts() must return copies")
+		}
+	})
+}
+
+// Run with -race: counts and alerts read from one snapshot must stay consistent
+// while refreshes publish new ones.
+func TestAlertCache_SnapshotConsistentUnderRefresh(t *testing.T) {
+	small := newSnapshotTestAlerts(5)
+	large := newSnapshotTestAlerts(50)
+
+	cache := NewAlertCache(nil, nil, 90, 10*time.Second)
+	fetcher := &fakeAlertFetcher{alerts: small}
+	cache.alertmanagerClient = fetcher
+	cache.refreshAlerts()
+
+	var wg sync.WaitGroup
+	stop := make(chan struct{})
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		defer close(stop)
+		for i := 0; i < 40; i++ {
+			if i%2 == 0 {
+				fetcher.alerts = large
+			} else {
+				fetcher.alerts = small
+			}
+			cache.refreshAlerts()
+		}
+	}()
+
+	for r := 0; r < 4; r++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-stop:
+					return
+				default:
+				}
+				snapshot := cache.Snapshot()
+				total := 0
+				for _, count := range snapshot.SeverityCounts {
+					total += count
+				}
+				if total != snapshot.Len() || len(snapshot.Alerts()) != snapshot.Len() {
+					t.Errorf("snapshot %d is inconsistent: %d counted, %d alerts", snapshot.Version, total, snapshot.Len())
+					return
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+}
+
+func BenchmarkBuildAlertSnapshot(b *testing.B) {
+	cache := NewAlertCache(nil, nil, 90, 10*time.Second)
+	cache.alertmanagerClient = &fakeAlertFetcher{alerts: newSnapshotTestAlerts(1000)}
+	cache.refreshAlerts()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		buildAlertSnapshot(cache.alerts, uint64(i))
+	}
+}
+
+func BenchmarkAlertCache_GetAllAlerts(b *testing.B) {
+	cache := NewAlertCache(nil, nil, 90, 10*time.Second)
+	cache.alertmanagerClient = &fakeAlertFetcher{alerts: newSnapshotTestAlerts(1000)}
+	cache.refreshAlerts()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			cache.GetAllAlerts()
+		}
+	})
+}
+
+func BenchmarkAlertCache_SnapshotRead(b *testing.B) {
+	cache := NewAlertCache(nil, nil, 90, 10*time.Second)
+	cache.alertmanagerClient = &fakeAlertFetcher{alerts: newSnapshotTestAlerts(1000)}
+	cache.refreshAlerts()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			snapshot := cache.Snapshot()
+			_ = snapshot.SeverityCounts["critical"]
+			_, _ = snapshot.Alert("missing")
+		}
+	})
+}
+
+func TestAlertCache_PriorityScores(t *testing.T) {
+	cache := NewAlertCache(nil, nil, 90, 10*time.Second)
+	cache.alertmanagerClient = &fakeAlertFetcher{alerts: newSnapshotTestAlerts(3)}
+	cache.refreshAlerts()
+
+	scores := make(map[string]float64)
+	for _, alert := range cache.Snapshot().Alerts() {
+		if alert.PriorityScore <= 0 {
+			t.Errorf("expected %s to have a priority score, got %v", alert.AlertName, alert.PriorityScore)
+		}
+		scores[alert.Severity] = alert.PriorityScore
+	}
+	if !(scores["critical"] > scores["warning"] && scores["warning"] > scores["info"]) {
+		t.Errorf("expected scores to follow severity weights, got %v", scores)
+	}
+
+	var critical *webuimodels.DashboardAlert
+	for _, alert := range cache.Snapshot().Alerts() {
+		if alert.Severity == "critical" {
+			critical = alert
+		}
+	}
+	cache.MutateAlert(critical.Fingerprint, func(alert *webuimodels.DashboardAlert) {
+		alert.IsAcknowledged = true
+	})
+	if acked, _ := cache.Snapshot().Alert(critical.Fingerprint); acked.PriorityScore >= critical.PriorityScore {
+		t.Errorf("expected acknowledging to lower the score, got %v (was %v)", acked.PriorityScore, critical.PriorityScore)
+	}
+
+	cache.SetPriorityWeights(models.PriorityWeights{Severity: map[string]float64{"info": 500}})
+	for _, alert := range cache.Snapshot().Alerts() {
+		if alert.Severity == "info" && alert.PriorityScore != 500 {
+			t.Errorf("expected new weights to apply immediately, got %v", alert.PriorityScore)
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package services
+
+import (
+	"notificator/internal/alertmanager"
+	webuimodels "notificator/internal/webui/models"
+)
+
+// Alertmanager source states reported by AlertmanagerStatuses
+const (
+	SourceStateHealthy   = "healthy"
+	SourceStateUnhealthy = "unhealthy"
+	SourceStateUnknown   = "unknown"
+)
+
+// AlertmanagerStatuses joins each source's fetch history with the number of
+// active alerts the cache holds for it, keeping the order of health
+func AlertmanagerStatuses(health []alertmanager.SourceHealth, alerts []*webuimodels.DashboardAlert) []webuimodels.AlertmanagerSourceStatus {
+	cached := make(map[string]int)
+	for _, alert := range alerts {
+		if !alert.IsResolved {
+			cached[alert.Source]++
+		}
+	}
+
+	statuses := make([]webuimodels.AlertmanagerSourceStatus, 0, len(health))
+	for _, h := range health {
+		statuses = append(statuses, webuimodels.AlertmanagerSourceStatus{
+			Name:                h.Name,
+			URL:                 h.URL,
+			State:               sourceState(h),
+			SuccessCount:        h.SuccessCount,
+			FailureCount:        h.FailureCount,
+			ConsecutiveFailures: h.ConsecutiveFailures,
+			LastSuccess:         h.LastSuccess,
+			LastFailure:         h.LastFailure,
+			LastError:           h.LastError,
+			LatencyMs:           h.LatencyMs,
+			FetchedAlerts:       h.AlertCount,
+			CachedAlerts:        cached[h.Name],
+			CircuitOpen:         h.CircuitOpen,
+			RetryAt:             h.RetryAt,
+			SkippedCount:        h.SkippedCount,
+		})
+	}
+	return statuses
+}
+
+// SourceHealthMap reports, for every source fetched at least once, whether
+// its last fetch succeeded
+func SourceHealthMap(health []alertmanager.SourceHealth) map[string]bool {
+	status := make(map[string]bool, len(health))
+	for _, h := range health {
+		if h.Checked() {
+			status[h.Name] = h.Healthy
+		}
+	}
+	return status
+}
+
+func sourceState(h alertmanager.SourceHealth) string {
+	switch {
+	case !h.Checked():
+		return SourceStateUnknown
+	case h.Healthy:
+		return SourceStateHealthy
+	default:
+		return SourceStateUnhealthy
+	}
+}
@@ -0,0 +1,49 @@
+package services
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"notificator/internal/alertmanager"
+	webuimodels "notificator/internal/webui/models"
+)
+
+func TestAlertmanagerStatuses(t *testing.T) {
+	lastSuccess := time.Now().Add(-time.Minute)
+	retryAt := time.Now().Add(time.Minute)
+	health := []alertmanager.SourceHealth{
+		{Name: "down", Healthy: false, SuccessCount: 3, FailureCount: 3, ConsecutiveFailures: 3, LastSuccess: &lastSuccess, LastError: "connection refused", AlertCount: 2, CircuitOpen: true, RetryAt: &retryAt, SkippedCount: 4},
+		{Name: "new"},
+		{Name: "prod", Healthy: true, SuccessCount: 5, LatencyMs: 42, AlertCount: 1},
+	}
+	alerts := []*webuimodels.DashboardAlert{
+		{Fingerprint: "a", Source: "down"},
+		{Fingerprint: "b", Source: "down"},
+		{Fingerprint: "c", Source: "prod"},
+		{Fingerprint: "d", Source: "prod", IsResolved: true},
+	}
+
+	statuses := AlertmanagerStatuses(health, alerts)
+	if len(statuses) != 3 {
+		t.Fatalf("expected a status per source, got %+v", statuses)
+	}
+
+	down, unknown, prod := statuses[0], statuses[1], statuses[2]
+	if down.State != SourceStateUnhealthy || down.CachedAlerts != 2 || down.LastError != "connection refused" || down.LastSuccess != &lastSuccess {
+		t.Errorf("expected the failing source unhealthy with its stale alerts, got %+v", down)
+	}
+	if !down.CircuitOpen || down.RetryAt != &retryAt || down.SkippedCount != 4 {
+		t.Errorf("expected the breaker state passed through, got %+v", down)
+	}
+	if unknown.State != SourceStateUnknown || unknown.CachedAlerts != 0 {
+		t.Errorf("expected a source never fetched to be unknown, got %+v", unknown)
+	}
+	if prod.State != SourceStateHealthy || prod.CachedAlerts != 1 || prod.FetchedAlerts != 1 || prod.LatencyMs != 42 {
+		t.Errorf("expected resolved alerts left out of the healthy source's count, got %+v", prod)
+	}
+
+	if got, want := SourceHealthMap(health), map[string]bool{"down": false, "prod": true}; !reflect.DeepEqual(got, want) {
+		t.Errorf("SourceHealthMap() = %v, want %v", got, want)
+	}
+}
@@ -30,6 +30,7 @@ type ColorPreferenceCache struct {
 }
 
 type ColorMatch struct {
+	PreferenceID       string    `json:"preferenceId"`
 	Color              string    `json:"color"`
 	ColorType          string    `json:"colorType"`
 	Priority           int       `json:"priority"`
@@ -197,6 +198,7 @@ func (cs *ColorService) buildLookupMap(preferences []webuimodels.UserColorPrefer
 
 		if _, exists := lookupMap[lookupKey]; !exists {
 			lookupMap[lookupKey] = &ColorMatch{
+				PreferenceID:       pref.ID,
 				Color:              pref.Color,
 				ColorType:          pref.ColorType,
 				Priority:           pref.Priority,
@@ -244,31 +246,7 @@ func (cs *ColorService) findColorMatch(alert *models.Alert, cache *ColorPreferen
 	bestPriority := -1
 
 	for _, pref := range cache.Preferences {
-		matchCount := 0
-		allMatch := true
-
-		for labelKey, expectedValue := range pref.LabelConditions {
-			if alertValue, exists := alert.Labels[labelKey]; exists {
-				if labelKey == "severity" {
-					normalizedExpected := cs.normalizeSeverity(expectedValue)
-					normalizedAlert := cs.normalizeSeverity(alertValue)
-					if normalizedAlert == normalizedExpected {
-						matchCount++
-					} else {
-						allMatch = false
-						break
-					}
-				} else if alertValue == expectedValue {
-					matchCount++
-				} else {
-					allMatch = false
-					break
-				}
-			} else {
-				allMatch = false
-				break
-			}
-		}
+		matchCount, allMatch := cs.matchPreference(pref, alert)
 
 		if allMatch {
 			isBetterMatch := false
@@ -287,6 +265,7 @@ func (cs *ColorService) findColorMatch(alert *models.Alert, cache *ColorPreferen
 
 			if isBetterMatch {
 				bestMatch = &ColorMatch{
+					PreferenceID:       pref.ID,
 					Color:              pref.Color,
 					ColorType:          pref.ColorType,
 					Priority:           pref.Priority,
@@ -309,6 +288,98 @@ func (cs *ColorService) findColorMatch(alert *models.Alert, cache *ColorPreferen
 	return bestMatch
 }
 
+// matchPreference reports whether all of a preference's label conditions hold
+// for the alert, and how many conditions there are. Severities are compared
+// normalized.
+func (cs *ColorService) matchPreference(pref webuimodels.UserColorPreference, alert *models.Alert) (int, bool) {
+	matchCount := 0
+	for labelKey, expectedValue := range pref.LabelConditions {
+		alertValue, exists := alert.Labels[labelKey]
+		if !exists {
+			return 0, false
+		}
+		if labelKey == "severity" {
+			if cs.normalizeSeverity(alertValue) != cs.normalizeSeverity(expectedValue) {
+				return 0, false
+			}
+		} else if alertValue != expectedValue {
+			return 0, false
+		}
+		matchCount++
+	}
+	return matchCount, true
+}
+
+// ColorCandidate is a color preference whose conditions all hold for an alert
+type ColorCandidate struct {
+	PreferenceID    string            `json:"preferenceId"`
+	LabelConditions map[string]string `json:"labelConditions"`
+	Color           string            `json:"color"`
+	ColorType       string            `json:"colorType"`
+	Priority        int               `json:"priority"`
+	MatchedLabels   int               `json:"matchedLabels"`
+	CreatedAt       time.Time         `json:"createdAt"`
+	Applied         bool              `json:"applied"`
+}
+
+// ColorExplanation is how an alert got its colors: the colors themselves and
+// every matching preference, the applied one first
+type ColorExplanation struct {
+	Result     *AlertColorResult `json:"result"`
+	Candidates []ColorCandidate  `json:"candidates"`
+}
+
+// ExplainAlertColors lists the user's color preferences matching the alert,
+// ranked the way findColorMatch chooses between them: most matched labels,
+// then highest priority, then oldest. Without a match the alert keeps its
+// severity colors.
+func (cs *ColorService) ExplainAlertColors(alert *models.Alert, sessionID string) *ColorExplanation {
+	explanation := &ColorExplanation{Candidates: []ColorCandidate{}}
+
+	cache, err := cs.getUserColorCache(sessionID)
+	if err != nil {
+		explanation.Result = cs.getDefaultSeverityColors(alert)
+		return explanation
+	}
+
+	match := cs.findColorMatch(alert, cache)
+	if match == nil {
+		explanation.Result = cs.getDefaultSeverityColors(alert)
+	} else {
+		explanation.Result = cs.applyCustomColor(match, alert)
+	}
+
+	for _, pref := range cache.Preferences {
+		matchCount, allMatch := cs.matchPreference(pref, alert)
+		if !allMatch {
+			continue
+		}
+		explanation.Candidates = append(explanation.Candidates, ColorCandidate{
+			PreferenceID:    pref.ID,
+			LabelConditions: pref.LabelConditions,
+			Color:           pref.Color,
+			ColorType:       pref.ColorType,
+			Priority:        pref.Priority,
+			MatchedLabels:   matchCount,
+			CreatedAt:       pref.CreatedAt,
+			Applied:         match != nil && match.PreferenceID == pref.ID,
+		})
+	}
+
+	sort.SliceStable(explanation.Candidates, func(i, j int) bool {
+		a, b := explanation.Candidates[i], explanation.Candidates[j]
+		if a.MatchedLabels != b.MatchedLabels {
+			return a.MatchedLabels > b.MatchedLabels
+		}
+		if a.Priority != b.Priority {
+			return a.Priority > b.Priority
+		}
+		return a.CreatedAt.Before(b.CreatedAt)
+	})
+
+	return explanation
+}
+
 func (cs *ColorService) getDefaultSeverityColors(alert *models.Alert) *AlertColorResult {
 	severity := alert.GetSeverity()
 
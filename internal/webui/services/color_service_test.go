@@ -0,0 +1,51 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"notificator/internal/models"
+	webuimodels "notificator/internal/webui/models"
+)
+
+func TestExplainAlertColors_RanksLikeFindColorMatch(t *testing.T) {
+	cs := NewColorService(nil)
+	now := time.Now()
+	preferences := []webuimodels.UserColorPreference{
+		{ID: "severity", LabelConditions: map[string]string{"severity": "Critical"}, Color: "#dc2626", ColorType: "custom", Priority: 5, CreatedAt: now},
+		{ID: "team-new", LabelConditions: map[string]string{"team": "infra", "severity": "critical"}, Color: "#2563eb", ColorType: "custom", Priority: 1, CreatedAt: now},
+		{ID: "team-old", LabelConditions: map[string]string{"team": "infra", "severity": "critical"}, Color: "#16a34a", ColorType: "custom", Priority: 1, CreatedAt: now.Add(-time.Hour)},
+		{ID: "everything", LabelConditions: map[string]string{}, Color: "#6b7280", ColorType: "custom", Priority: 9, CreatedAt: now},
+		{ID: "other-team", LabelConditions: map[string]string{"team": "web"}, Color: "#d97706", ColorType: "custom", Priority: 9, CreatedAt: now},
+	}
+	cs.colorCache["sess"] = &ColorPreferenceCache{
+		Preferences: preferences,
+		LookupMap:   cs.buildLookupMap(preferences),
+		CachedAt:    now,
+		TTL:         time.Hour,
+	}
+
+	alert := &models.Alert{Labels: map[string]string{"alertname": "DiskFull", "team": "infra", "severity": "critical"}}
+	explanation := cs.ExplainAlertColors(alert, "sess")
+
+	var order []string
+	for _, candidate := range explanation.Candidates {
+		order = append(order, candidate.PreferenceID)
+	}
+	want := []string{"team-old", "team-new", "severity", "everything"}
+	if len(order) != len(want) {
+		t.Fatalf("expected candidates %v, got %v", want, order)
+	}
+	for i := range want {
+		if order[i] != want[i] {
+			t.Fatalf("expected candidates %v, got %v", want, order)
+		}
+	}
+
+	if !explanation.Candidates[0].Applied || explanation.Candidates[1].Applied {
+		t.Errorf("expected only team-old to be applied, got %+v", explanation.Candidates)
+	}
+	if explanation.Result.ColorSource != "user" || explanation.Result.BorderColor != "#16a34a" {
+		t.Errorf("expected team-old's color, got %+v", explanation.Result)
+	}
+}
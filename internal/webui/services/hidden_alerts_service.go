@@ -22,6 +22,7 @@ type HiddenAlertsService struct {
 	backendClient       *client.BackendClient
 	mu                  sync.RWMutex
 	userHiddenAlerts    map[string]map[string]bool             // userID -> fingerprint -> hidden
+	userSnoozedAlerts   map[string]map[string]time.Time        // userID -> fingerprint -> snoozed until
 	userHiddenRules     map[string][]models.UserHiddenRule     // userID -> rules
 	compiledRegexRules  map[string]map[string]*regexp.Regexp   // userID -> ruleID -> compiled regex
 	lastAccess          map[string]time.Time                   // userID -> last LoadUserData call
@@ -32,6 +33,7 @@ func NewHiddenAlertsService(backendClient *client.BackendClient) *HiddenAlertsSe
 	service := &HiddenAlertsService{
 		backendClient:      backendClient,
 		userHiddenAlerts:   make(map[string]map[string]bool),
+		userSnoozedAlerts:  make(map[string]map[string]time.Time),
 		userHiddenRules:    make(map[string][]models.UserHiddenRule),
 		compiledRegexRules: make(map[string]map[string]*regexp.Regexp),
 		lastAccess:         make(map[string]time.Time),
@@ -95,10 +97,16 @@ func (s *HiddenAlertsService) LoadUserData(sessionID string) error {
 	// (or stale regexes for deleted rules) do not accumulate.
 	if hiddenAlertsErr == nil {
 		freshAlerts := make(map[string]bool, len(hiddenAlerts))
+		freshSnoozes := make(map[string]time.Time)
 		for _, alert := range hiddenAlerts {
-			freshAlerts[alert.Fingerprint] = true
+			if alert.IsSnoozed() {
+				freshSnoozes[alert.Fingerprint] = *alert.ExpiresAt
+			} else {
+				freshAlerts[alert.Fingerprint] = true
+			}
 		}
 		s.userHiddenAlerts[sessionID] = freshAlerts
+		s.userSnoozedAlerts[sessionID] = freshSnoozes
 	} else if s.userHiddenAlerts[sessionID] == nil {
 		s.userHiddenAlerts[sessionID] = make(map[string]bool)
 	}
@@ -129,6 +137,7 @@ func (s *HiddenAlertsService) sweepIdleSessionsLocked() {
 	for sessionID, last := range s.lastAccess {
 		if time.Since(last) >= sessionIdleTTL {
 			delete(s.userHiddenAlerts, sessionID)
+			delete(s.userSnoozedAlerts, sessionID)
 			delete(s.userHiddenRules, sessionID)
 			delete(s.compiledRegexRules, sessionID)
 			delete(s.lastAccess, sessionID)
@@ -157,37 +166,103 @@ func (s *HiddenAlertsService) IsAlertHidden(sessionID string, alert *webuimodels
 			return true
 		}
 	}
+
+	// Snoozed alerts stay hidden until their snooze ends
+	if until, snoozed := s.userSnoozedAlerts[sessionID][alert.Fingerprint]; snoozed && time.Now().Before(until) {
+		return true
+	}
 	
 	// Check hidden rules
 	rules := s.userHiddenRules[sessionID]
 	for _, rule := range rules {
-		if !rule.IsEnabled {
-			continue
+		if hiddenRuleMatches(&rule, s.compiledRegexRules[sessionID][rule.ID], alert) {
+			return true
 		}
-		
-		// Check if the alert has the label
-		labelValue, exists := alert.Labels[rule.LabelKey]
-		if !exists {
-			continue
+	}
+	
+	return false
+}
+
+// HiddenExplanation says why an alert is hidden for a user, if it is
+type HiddenExplanation struct {
+	Hidden        bool                    `json:"hidden"`
+	HiddenByUser  bool                    `json:"hiddenByUser"`
+	SnoozedUntil  *time.Time              `json:"snoozedUntil,omitempty"`
+	MatchingRules []models.UserHiddenRule `json:"matchingRules"`
+}
+
+// ExplainHidden is IsAlertHidden listing every reason rather than stopping at
+// the first: a hide or snooze of the alert itself and each matching rule
+func (s *HiddenAlertsService) ExplainHidden(sessionID string, alert *webuimodels.DashboardAlert) HiddenExplanation {
+	s.mu.RLock()
+	loaded := s.userHiddenAlerts[sessionID] != nil
+	s.mu.RUnlock()
+
+	if !loaded {
+		_ = s.LoadUserData(sessionID)
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	explanation := HiddenExplanation{MatchingRules: []models.UserHiddenRule{}}
+	explanation.HiddenByUser = s.userHiddenAlerts[sessionID][alert.Fingerprint]
+	if until, snoozed := s.userSnoozedAlerts[sessionID][alert.Fingerprint]; snoozed && time.Now().Before(until) {
+		explanation.SnoozedUntil = &until
+	}
+	for _, rule := range s.userHiddenRules[sessionID] {
+		if hiddenRuleMatches(&rule, s.compiledRegexRules[sessionID][rule.ID], alert) {
+			explanation.MatchingRules = append(explanation.MatchingRules, rule)
 		}
-		
-		// Check if the label value matches
-		if rule.IsRegex {
-			// Use compiled regex
-			if regex, ok := s.compiledRegexRules[sessionID][rule.ID]; ok {
-				if regex.MatchString(labelValue) {
-					return true
-				}
+	}
+
+	explanation.Hidden = explanation.HiddenByUser || explanation.SnoozedUntil != nil || len(explanation.MatchingRules) > 0
+	return explanation
+}
+
+// hiddenRuleMatches reports whether an enabled rule hides the alert. Regex rules
+// match only through their compiled pattern, so a rule whose pattern failed to
+// compile hides nothing.
+func hiddenRuleMatches(rule *models.UserHiddenRule, regex *regexp.Regexp, alert *webuimodels.DashboardAlert) bool {
+	if !rule.IsEnabled || alert == nil {
+		return false
+	}
+
+	// Check if the alert has the label
+	labelValue, exists := alert.Labels[rule.LabelKey]
+	if !exists {
+		return false
+	}
+
+	if rule.IsRegex {
+		return regex != nil && regex.MatchString(labelValue)
+	}
+	// Exact match or empty value (match all)
+	return rule.LabelValue == "" || rule.LabelValue == labelValue
+}
+
+// CountHiddenRuleMatches returns, for each enabled rule, how many of the given
+// alerts it hides. Regexes are compiled once for the whole pass.
+func (s *HiddenAlertsService) CountHiddenRuleMatches(rules []models.UserHiddenRule, alerts []*webuimodels.DashboardAlert) map[string]int {
+	counts := make(map[string]int, len(rules))
+	compiled := make(map[string]*regexp.Regexp)
+	for _, rule := range rules {
+		counts[rule.ID] = 0
+		if rule.IsEnabled && rule.IsRegex && rule.LabelValue != "" {
+			if regex, err := regexp.Compile(rule.LabelValue); err == nil {
+				compiled[rule.ID] = regex
 			}
-		} else {
-			// Exact match or empty value (match all)
-			if rule.LabelValue == "" || rule.LabelValue == labelValue {
-				return true
+		}
+	}
+
+	for _, alert := range alerts {
+		for i := range rules {
+			if hiddenRuleMatches(&rules[i], compiled[rules[i].ID], alert) {
+				counts[rules[i].ID]++
 			}
 		}
 	}
-	
-	return false
+	return counts
 }
 
 // IsAlertHiddenByFilter checks if an alert is hidden by filter-specific hidden alerts/rules
@@ -279,6 +354,27 @@ func (s *HiddenAlertsService) HideAlert(sessionID string, alert *webuimodels.Das
 	return nil
 }
 
+// SnoozeAlert hides a specific alert for a user until the given time
+func (s *HiddenAlertsService) SnoozeAlert(sessionID string, alert *webuimodels.DashboardAlert, reason string, until time.Time, impersonateUserID ...string) error {
+	err := s.backendClient.SnoozeAlert(sessionID, alert.Fingerprint, alert.AlertName, alert.Instance, reason, until, impersonateUserID...)
+	if err != nil {
+		return fmt.Errorf("failed to snooze alert in backend: %w", err)
+	}
+
+	// Update the cache; a snooze replaces a permanent hide of the same alert
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.userSnoozedAlerts[sessionID] == nil {
+		s.userSnoozedAlerts[sessionID] = make(map[string]time.Time)
+	}
+	s.userSnoozedAlerts[sessionID][alert.Fingerprint] = until
+	if s.userHiddenAlerts[sessionID] != nil {
+		delete(s.userHiddenAlerts[sessionID], alert.Fingerprint)
+	}
+
+	return nil
+}
+
 // UnhideAlert unhides a specific alert for a user
 func (s *HiddenAlertsService) UnhideAlert(sessionID, fingerprint string, impersonateUserID ...string) error {
 	err := s.backendClient.UnhideAlert(sessionID, fingerprint, impersonateUserID...)
@@ -292,10 +388,33 @@ func (s *HiddenAlertsService) UnhideAlert(sessionID, fingerprint string, imperso
 	if s.userHiddenAlerts[sessionID] != nil {
 		delete(s.userHiddenAlerts[sessionID], fingerprint)
 	}
+	delete(s.userSnoozedAlerts[sessionID], fingerprint)
 	
 	return nil
 }
 
+// UnhideAlerts unhides several alerts for a user with a single backend call
+func (s *HiddenAlertsService) UnhideAlerts(sessionID string, fingerprints []string, impersonateUserID ...string) (int, error) {
+	unhidden, err := s.backendClient.UnhideAlerts(sessionID, fingerprints, impersonateUserID...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to unhide alerts in backend: %w", err)
+	}
+
+	// Update the cache
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.userHiddenAlerts[sessionID] != nil {
+		for _, fingerprint := range fingerprints {
+			delete(s.userHiddenAlerts[sessionID], fingerprint)
+		}
+	}
+	for _, fingerprint := range fingerprints {
+		delete(s.userSnoozedAlerts[sessionID], fingerprint)
+	}
+
+	return unhidden, nil
+}
+
 // GetUserHiddenAlerts gets all hidden alerts for a user
 func (s *HiddenAlertsService) GetUserHiddenAlerts(sessionID string, impersonateUserID ...string) ([]models.UserHiddenAlert, error) {
 	pbHiddenAlerts, err := s.backendClient.GetUserHiddenAlerts(sessionID, impersonateUserID...)
@@ -306,7 +425,7 @@ func (s *HiddenAlertsService) GetUserHiddenAlerts(sessionID string, impersonateU
 	// Convert protobuf models to regular models
 	var hiddenAlerts []models.UserHiddenAlert
 	for _, pbAlert := range pbHiddenAlerts {
-		hiddenAlerts = append(hiddenAlerts, models.UserHiddenAlert{
+		hiddenAlert := models.UserHiddenAlert{
 			ID:          pbAlert.Id,
 			UserID:      pbAlert.UserId,
 			Fingerprint: pbAlert.Fingerprint,
@@ -315,7 +434,12 @@ func (s *HiddenAlertsService) GetUserHiddenAlerts(sessionID string, impersonateU
 			Reason:      pbAlert.Reason,
 			CreatedAt:   pbAlert.CreatedAt.AsTime(),
 			UpdatedAt:   pbAlert.UpdatedAt.AsTime(),
-		})
+		}
+		if pbAlert.ExpiresAt != nil {
+			expiresAt := pbAlert.ExpiresAt.AsTime()
+			hiddenAlert.ExpiresAt = &expiresAt
+		}
+		hiddenAlerts = append(hiddenAlerts, hiddenAlert)
 	}
 	
 	return hiddenAlerts, nil
@@ -374,6 +498,7 @@ func (s *HiddenAlertsService) InvalidateCache(sessionID string) {
 	defer s.mu.Unlock()
 	
 	delete(s.userHiddenAlerts, sessionID)
+	delete(s.userSnoozedAlerts, sessionID)
 	delete(s.userHiddenRules, sessionID)
 	delete(s.compiledRegexRules, sessionID)
 	delete(s.lastAccess, sessionID)
@@ -407,11 +532,13 @@ func (s *HiddenAlertsService) GetUserHiddenRules(sessionID string, impersonateUs
 	return rules, nil
 }
 
-// ClearAllHiddenAlerts removes all hidden alerts for a user
-func (s *HiddenAlertsService) ClearAllHiddenAlerts(sessionID string, impersonateUserID ...string) error {
-	err := s.backendClient.ClearAllHiddenAlerts(sessionID, impersonateUserID...)
+// ClearAllHiddenAlerts removes all hidden alerts for a user and returns the
+// time identifying the clear for UndoClearAllHiddenAlerts, and until when it
+// can be undone
+func (s *HiddenAlertsService) ClearAllHiddenAlerts(sessionID string, impersonateUserID ...string) (clearedAt, undoUntil time.Time, err error) {
+	clearedAt, undoUntil, err = s.backendClient.ClearAllHiddenAlerts(sessionID, impersonateUserID...)
 	if err != nil {
-		return fmt.Errorf("failed to clear hidden alerts in backend: %w", err)
+		return time.Time{}, time.Time{}, fmt.Errorf("failed to clear hidden alerts in backend: %w", err)
 	}
 	
 	// Clear the cache
@@ -420,8 +547,23 @@ func (s *HiddenAlertsService) ClearAllHiddenAlerts(sessionID string, impersonate
 	if s.userHiddenAlerts[sessionID] != nil {
 		s.userHiddenAlerts[sessionID] = make(map[string]bool)
 	}
+	delete(s.userSnoozedAlerts, sessionID)
 	
-	return nil
+	return clearedAt, undoUntil, nil
+}
+
+// UndoClearAllHiddenAlerts brings back the hidden alerts cleared at clearedAt
+// and returns how many were restored
+func (s *HiddenAlertsService) UndoClearAllHiddenAlerts(sessionID string, clearedAt time.Time, impersonateUserID ...string) (int, error) {
+	restored, err := s.backendClient.UndoClearAllHiddenAlerts(sessionID, clearedAt, impersonateUserID...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to restore hidden alerts in backend: %w", err)
+	}
+
+	// Reloaded from the backend on the next access
+	s.InvalidateCache(sessionID)
+
+	return restored, nil
 }
 
 // FilterHiddenAlerts filters out hidden alerts from a list
@@ -0,0 +1,71 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"notificator/internal/backend/models"
+	webuimodels "notificator/internal/webui/models"
+)
+
+func TestCountHiddenRuleMatches(t *testing.T) {
+	s := NewHiddenAlertsService(nil)
+	alerts := []*webuimodels.DashboardAlert{
+		{Fingerprint: "a", Labels: map[string]string{"instance": "test-1", "team": "infra"}},
+		{Fingerprint: "b", Labels: map[string]string{"instance": "test-2"}},
+		{Fingerprint: "c", Labels: map[string]string{"instance": "prod-1", "team": "web"}},
+	}
+	rules := []models.UserHiddenRule{
+		{ID: "regex", LabelKey: "instance", LabelValue: "^test-", IsRegex: true, IsEnabled: true},
+		{ID: "exact", LabelKey: "team", LabelValue: "web", IsEnabled: true},
+		{ID: "any-value", LabelKey: "team", IsEnabled: true},
+		{ID: "disabled", LabelKey: "instance", IsEnabled: false},
+		{ID: "bad-regex", LabelKey: "instance", LabelValue: "(", IsRegex: true, IsEnabled: true},
+	}
+
+	counts := s.CountHiddenRuleMatches(rules, alerts)
+	for id, want := range map[string]int{"regex": 2, "exact": 1, "any-value": 2, "disabled": 0, "bad-regex": 0} {
+		if counts[id] != want {
+			t.Errorf("rule %s: expected %d matches, got %d", id, want, counts[id])
+		}
+	}
+}
+
+func TestIsAlertHidden_SnoozeEnds(t *testing.T) {
+	s := NewHiddenAlertsService(nil)
+	s.userHiddenAlerts["sess"] = map[string]bool{}
+	s.userSnoozedAlerts["sess"] = map[string]time.Time{
+		"snoozed": time.Now().Add(time.Hour),
+		"ended":   time.Now().Add(-time.Second),
+	}
+
+	for fingerprint, want := range map[string]bool{"snoozed": true, "ended": false, "other": false} {
+		if got := s.IsAlertHidden("sess", &webuimodels.DashboardAlert{Fingerprint: fingerprint}); got != want {
+			t.Errorf("%s: expected hidden=%t, got %t", fingerprint, want, got)
+		}
+	}
+}
+
+func TestExplainHidden_ListsEveryReason(t *testing.T) {
+	s := NewHiddenAlertsService(nil)
+	s.userHiddenAlerts["sess"] = map[string]bool{"a": true}
+	s.userSnoozedAlerts["sess"] = map[string]time.Time{"a": time.Now().Add(time.Hour)}
+	s.userHiddenRules["sess"] = []models.UserHiddenRule{
+		{ID: "team", LabelKey: "team", LabelValue: "infra", IsEnabled: true},
+		{ID: "any-team", LabelKey: "team", IsEnabled: true},
+		{ID: "other", LabelKey: "team", LabelValue: "web", IsEnabled: true},
+	}
+
+	explanation := s.ExplainHidden("sess", &webuimodels.DashboardAlert{Fingerprint: "a", Labels: map[string]string{"team": "infra"}})
+	if !explanation.Hidden || !explanation.HiddenByUser || explanation.SnoozedUntil == nil {
+		t.Errorf("expected the alert hidden, hidden by the user and snoozed, got %+v", explanation)
+	}
+	if len(explanation.MatchingRules) != 2 || explanation.MatchingRules[0].ID != "team" || explanation.MatchingRules[1].ID != "any-team" {
+		t.Errorf("expected rules team and any-team to match, got %+v", explanation.MatchingRules)
+	}
+
+	explanation = s.ExplainHidden("sess", &webuimodels.DashboardAlert{Fingerprint: "b"})
+	if explanation.Hidden || len(explanation.MatchingRules) != 0 {
+		t.Errorf("expected an unlabelled alert to be visible, got %+v", explanation)
+	}
+}
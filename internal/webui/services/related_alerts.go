@@ -0,0 +1,79 @@
+package services
+
+import (
+	"sort"
+	"strings"
+
+	webuimodels "notificator/internal/webui/models"
+)
+
+// maxRelatedAlerts keeps the details panel to the closest matches
+const maxRelatedAlerts = 10
+
+// relatedKeyLabels are the labels an alert must share with another before
+// the two are considered related; sharing only a severity or a region is not
+// enough to point at the same problem
+var relatedKeyLabels = []string{"instance", "job", "team"}
+
+// RelatedAlerts lists the firing alerts sharing at least one key label
+// (instance, job or team) with alert. Alerts sharing the most labels come
+// first; alertname and internal "__" labels are not counted.
+func RelatedAlerts(alert *webuimodels.DashboardAlert, active []*webuimodels.DashboardAlert) []webuimodels.RelatedAlert {
+	if alert == nil || len(alert.Labels) == 0 {
+		return nil
+	}
+
+	var related []webuimodels.RelatedAlert
+	for _, other := range active {
+		if other.Fingerprint == alert.Fingerprint || other.IsResolved || other.Status.State != "firing" {
+			continue
+		}
+		if !sharesKeyLabel(alert.Labels, other.Labels) {
+			continue
+		}
+
+		var shared []string
+		for name, value := range alert.Labels {
+			if name == "alertname" || strings.HasPrefix(name, "__") {
+				continue
+			}
+			if otherValue, ok := other.Labels[name]; ok && otherValue == value {
+				shared = append(shared, name)
+			}
+		}
+		sort.Strings(shared)
+
+		related = append(related, webuimodels.RelatedAlert{
+			Fingerprint:  other.Fingerprint,
+			AlertName:    other.AlertName,
+			Severity:     other.Severity,
+			Instance:     other.Instance,
+			State:        other.Status.State,
+			SharedLabels: shared,
+		})
+	}
+
+	sort.Slice(related, func(i, j int) bool {
+		if len(related[i].SharedLabels) != len(related[j].SharedLabels) {
+			return len(related[i].SharedLabels) > len(related[j].SharedLabels)
+		}
+		if related[i].AlertName != related[j].AlertName {
+			return related[i].AlertName < related[j].AlertName
+		}
+		return related[i].Fingerprint < related[j].Fingerprint
+	})
+
+	if len(related) > maxRelatedAlerts {
+		related = related[:maxRelatedAlerts]
+	}
+	return related
+}
+
+func sharesKeyLabel(a, b map[string]string) bool {
+	for _, name := range relatedKeyLabels {
+		if value, ok := a[name]; ok && value != "" && b[name] == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,35 @@
+package services
+
+import (
+	"reflect"
+	"testing"
+
+	webuimodels "notificator/internal/webui/models"
+)
+
+func relatedTestAlert(fingerprint, state string, labels map[string]string) *webuimodels.DashboardAlert {
+	alert := &webuimodels.DashboardAlert{Fingerprint: fingerprint, AlertName: labels["alertname"], Labels: labels}
+	alert.Status.State = state
+	return alert
+}
+
+func TestRelatedAlerts(t *testing.T) {
+	alert := relatedTestAlert("a", "firing", map[string]string{"alertname": "HighCPU", "instance": "web-1", "job": "node", "env": "prod"})
+	sameInstance := relatedTestAlert("b", "firing", map[string]string{"alertname": "HighMemory", "instance": "web-1", "job": "node", "env": "prod"})
+	sameJob := relatedTestAlert("c", "firing", map[string]string{"alertname": "HighCPU", "instance": "web-2", "job": "node"})
+	onlyEnv := relatedTestAlert("d", "firing", map[string]string{"alertname": "DiskFull", "env": "prod"})
+	silenced := relatedTestAlert("e", "silenced", map[string]string{"alertname": "HighLoad", "instance": "web-1"})
+	resolved := relatedTestAlert("f", "firing", map[string]string{"alertname": "HighLoad", "instance": "web-1"})
+	resolved.IsResolved = true
+
+	related := RelatedAlerts(alert, []*webuimodels.DashboardAlert{onlyEnv, sameJob, alert, silenced, resolved, sameInstance})
+	if len(related) != 2 {
+		t.Fatalf("expected only the firing alerts sharing a key label, got %+v", related)
+	}
+	if related[0].Fingerprint != "b" || related[1].Fingerprint != "c" {
+		t.Errorf("expected alerts sharing more labels first, got %+v", related)
+	}
+	if want := []string{"env", "instance", "job"}; !reflect.DeepEqual(related[0].SharedLabels, want) {
+		t.Errorf("expected shared labels %v without alertname, got %v", want, related[0].SharedLabels)
+	}
+}
@@ -2,6 +2,7 @@ package services
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"log"
@@ -9,6 +10,7 @@ import (
 	"net/url"
 	"regexp"
 	"strconv"
+	"strings"
 	"time"
 
 	"notificator/config"
@@ -36,6 +38,12 @@ type SentryAuthResult struct {
 	AuthMethod string `json:"auth_method"` // "personal_token", "global", "none"
 }
 
+// errSentryUnauthorized is wrapped by API calls that Sentry refused with 401 or 403
+var errSentryUnauthorized = errors.New("sentry rejected the token")
+
+// sentrySlugRegex matches Sentry project slugs, so label values can be put in API paths
+var sentrySlugRegex = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]*$`)
+
 // NewSentryService creates a new Sentry service instance
 func NewSentryService(cfg *config.SentryConfig, backendClient *client.BackendClient) *SentryService {
 	return &SentryService{
@@ -65,22 +73,29 @@ func (s *SentryService) GetSentryDataForAlert(alert *models.Alert, userID, sessi
 		// Fallback to checking labels if annotation doesn't exist
 		sentryURL, exists = alert.Labels["sentry"]
 	}
-	if !exists {
-		log.Printf("No sentry URL found in alert annotations or labels")
-		return &webuimodels.SentryData{HasSentryLabel: false}
-	}
-	
-	log.Printf("Found Sentry URL: %s", sentryURL)
 
-	// Parse Sentry URL to get project info
-	projectInfo, err := s.parseSentryURL(sentryURL)
-	if err != nil {
-		log.Printf("Failed to parse Sentry URL %s: %v", sentryURL, err)
-		return &webuimodels.SentryData{
-			HasSentryLabel: true,
-			SentryURL:      sentryURL,
-			Error:          "Invalid Sentry URL format",
+	var projectInfo *SentryProjectInfo
+	if exists {
+		log.Printf("Found Sentry URL: %s", sentryURL)
+
+		// Parse Sentry URL to get project info
+		var err error
+		projectInfo, err = s.parseSentryURL(sentryURL)
+		if err != nil {
+			log.Printf("Failed to parse Sentry URL %s: %v", sentryURL, err)
+			return &webuimodels.SentryData{
+				HasSentryLabel: true,
+				SentryURL:      sentryURL,
+				Error:          "Invalid Sentry URL format",
+			}
 		}
+	} else if projectInfo = s.projectFromLabels(alert.Labels); projectInfo != nil {
+		sentryURL = fmt.Sprintf("%s/organizations/%s/projects/%s/",
+			projectInfo.BaseURL, projectInfo.Organization, projectInfo.ProjectSlug)
+		log.Printf("Matched Sentry project %s from alert labels", projectInfo.ProjectSlug)
+	} else {
+		log.Printf("No sentry URL or project label found in alert")
+		return &webuimodels.SentryData{HasSentryLabel: false}
 	}
 
 	// Get authentication for user
@@ -103,6 +118,9 @@ func (s *SentryService) GetSentryDataForAlert(alert *models.Alert, userID, sessi
 		numericID, err := s.fetchProjectID(projectInfo.BaseURL, projectInfo.Organization, projectInfo.ProjectSlug, auth.Token)
 		if err != nil {
 			log.Printf("Failed to resolve project ID for %s: %v", projectInfo.ProjectSlug, err)
+			if errors.Is(err, errSentryUnauthorized) {
+				return tokenRejectedData(sentryURL, auth)
+			}
 			return &webuimodels.SentryData{
 				HasSentryLabel: true,
 				SentryURL:      sentryURL,
@@ -125,6 +143,9 @@ func (s *SentryService) GetSentryDataForAlert(alert *models.Alert, userID, sessi
 
 	// First fetch issues (needed for stats calculation)
 	issues, err := s.fetchIssues(projectInfo, auth.Token, startTime, endTime)
+	if errors.Is(err, errSentryUnauthorized) {
+		return tokenRejectedData(sentryURL, auth)
+	}
 	if err != nil {
 		log.Printf("Failed to fetch Sentry issues: %v", err)
 		// Don't fail completely if issues can't be fetched, just continue without them
@@ -175,6 +196,45 @@ func (s *SentryService) GetSentryDataForAlert(alert *models.Alert, userID, sessi
 	}
 }
 
+// projectFromLabels matches an alert without a sentry URL to a project in the
+// configured organization, using the first configured project label it carries
+func (s *SentryService) projectFromLabels(labels map[string]string) *SentryProjectInfo {
+	if s.config.Organization == "" {
+		return nil
+	}
+	for _, key := range s.config.ProjectLabels {
+		slug := strings.ToLower(strings.TrimSpace(labels[key]))
+		if slug == "" || !sentrySlugRegex.MatchString(slug) {
+			continue
+		}
+		return &SentryProjectInfo{
+			BaseURL:      strings.TrimSuffix(s.config.BaseURL, "/"),
+			Organization: s.config.Organization,
+			ProjectSlug:  slug,
+			ProjectID:    slug, // Resolved to the numeric ID once a token is known
+		}
+	}
+	return nil
+}
+
+// tokenRejectedData reports a token Sentry refused, pointing at who can fix it
+func tokenRejectedData(sentryURL string, auth SentryAuthResult) *webuimodels.SentryData {
+	message := "Sentry rejected your personal token. Update it in Settings → Sentry."
+	if auth.AuthMethod == "global" {
+		message = "Sentry rejected the shared token. Add a personal token in Settings → Sentry or ask an administrator to update it."
+	}
+	return &webuimodels.SentryData{
+		HasSentryLabel: true,
+		SentryURL:      sentryURL,
+		AuthStatus: webuimodels.SentryAuthStatus{
+			HasAPIToken:   auth.AuthMethod == "personal_token",
+			AuthMethod:    auth.AuthMethod,
+			TokenRejected: true,
+		},
+		Error: message,
+	}
+}
+
 // getAuthForUser determines the best authentication method for a user
 func (s *SentryService) getAuthForUser(userID, sessionID string) SentryAuthResult {
 	log.Printf("Getting authentication for user %s with session %s", userID, sessionID)
@@ -286,6 +346,9 @@ func (s *SentryService) fetchProjectID(baseURL, organization, projectSlug, token
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden {
+		return "", fmt.Errorf("%w: project API returned status %d", errSentryUnauthorized, resp.StatusCode)
+	}
 	if resp.StatusCode != http.StatusOK {
 		body, _ := io.ReadAll(resp.Body)
 		log.Printf("Project API request failed with status %d, Response: %s", resp.StatusCode, string(body))
@@ -603,6 +666,9 @@ func (s *SentryService) fetchIssues(projectInfo *SentryProjectInfo, token string
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden {
+		return nil, fmt.Errorf("%w: issues API returned status %d", errSentryUnauthorized, resp.StatusCode)
+	}
 	if resp.StatusCode != http.StatusOK {
 		// Read response body for better error information
 		body, _ := io.ReadAll(resp.Body)
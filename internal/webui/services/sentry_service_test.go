@@ -0,0 +1,61 @@
+package services
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"notificator/config"
+	"notificator/internal/models"
+)
+
+func TestSentryProjectFromLabels(t *testing.T) {
+	s := NewSentryService(&config.SentryConfig{
+		Enabled:       true,
+		BaseURL:       "https://sentry.example.com/",
+		Organization:  "acme",
+		ProjectLabels: []string{"sentry_project", "service"},
+	}, nil)
+
+	info := s.projectFromLabels(map[string]string{"service": "Checkout-API", "team": "web"})
+	if info == nil || info.ProjectSlug != "checkout-api" || info.Organization != "acme" || info.BaseURL != "https://sentry.example.com" {
+		t.Fatalf("expected the service label to select checkout-api, got %+v", info)
+	}
+
+	info = s.projectFromLabels(map[string]string{"sentry_project": "payments", "service": "checkout-api"})
+	if info == nil || info.ProjectSlug != "payments" {
+		t.Errorf("expected the first configured label to win, got %+v", info)
+	}
+
+	if info := s.projectFromLabels(map[string]string{"service": "../admin"}); info != nil {
+		t.Errorf("expected a value that is not a slug to be ignored, got %+v", info)
+	}
+
+	s.config.Organization = ""
+	if info := s.projectFromLabels(map[string]string{"service": "checkout-api"}); info != nil {
+		t.Errorf("expected no lookup without an organization, got %+v", info)
+	}
+}
+
+func TestGetSentryDataForAlert_ReportsRejectedToken(t *testing.T) {
+	sentry := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer sentry.Close()
+
+	s := NewSentryService(&config.SentryConfig{
+		Enabled:       true,
+		BaseURL:       sentry.URL,
+		GlobalToken:   "expired",
+		Organization:  "acme",
+		ProjectLabels: []string{"service"},
+	}, nil)
+
+	data := s.GetSentryDataForAlert(&models.Alert{Labels: map[string]string{"service": "checkout-api"}}, "user-1", "session-1")
+	if !data.HasSentryLabel || data.SentryURL != sentry.URL+"/organizations/acme/projects/checkout-api/" {
+		t.Fatalf("expected the alert to be linked through its label, got %+v", data)
+	}
+	if !data.AuthStatus.TokenRejected || data.AuthStatus.AuthMethod != "global" || data.Error == "" {
+		t.Errorf("expected a rejected global token to be reported, got %+v", data)
+	}
+}
@@ -0,0 +1,123 @@
+package services
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"notificator/internal/models"
+	webuimodels "notificator/internal/webui/models"
+)
+
+// maxSilenceSuggestions keeps the list short enough to scan during an incident
+const maxSilenceSuggestions = 8
+
+// SuggestSilenceMatchers proposes silences covering every selected alert by
+// looking for labels whose value is shared across the whole selection. Each
+// suggestion is previewed against the active alerts, so callers can see how
+// many alerts outside the selection it would also mute. Tighter suggestions
+// come first.
+func SuggestSilenceMatchers(selected, active []*webuimodels.DashboardAlert) []webuimodels.SilenceSuggestion {
+	shared := sharedLabels(selected)
+	if len(shared) == 0 {
+		return nil
+	}
+
+	names := make([]string, 0, len(shared))
+	for name := range shared {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var candidates [][]string
+	for _, name := range names {
+		candidates = append(candidates, []string{name})
+	}
+	// alertname on its own is often too broad, so also offer it scoped by each other shared label
+	if _, ok := shared["alertname"]; ok {
+		for _, name := range names {
+			if name != "alertname" {
+				candidates = append(candidates, []string{"alertname", name})
+			}
+		}
+	}
+	if len(names) > 2 {
+		candidates = append(candidates, names)
+	}
+
+	selectedFingerprints := make(map[string]bool, len(selected))
+	for _, alert := range selected {
+		selectedFingerprints[alert.Fingerprint] = true
+	}
+
+	suggestions := make([]webuimodels.SilenceSuggestion, 0, len(candidates))
+	for _, labelNames := range candidates {
+		silence := models.Silence{}
+		var parts []string
+		for _, name := range labelNames {
+			silence.Matchers = append(silence.Matchers, models.SilenceMatcher{Name: name, Value: shared[name], IsEqual: models.BoolPtr(true)})
+			parts = append(parts, fmt.Sprintf("%s=%q", name, shared[name]))
+		}
+
+		suggestion := webuimodels.SilenceSuggestion{
+			Description:   fmt.Sprintf("All %d alert(s) share %s", len(selected), strings.Join(parts, ", ")),
+			SelectedCount: len(selected),
+		}
+		for _, matcher := range silence.Matchers {
+			suggestion.Matchers = append(suggestion.Matchers, webuimodels.SilenceMatcher{
+				Name:    matcher.Name,
+				Value:   matcher.Value,
+				IsEqual: models.BoolPtr(true),
+			})
+		}
+
+		for _, alert := range active {
+			if silence.MatchesLabels(alert.Labels) {
+				suggestion.MatchCount++
+				if !selectedFingerprints[alert.Fingerprint] {
+					suggestion.OutsideCount++
+				}
+			}
+		}
+
+		suggestions = append(suggestions, suggestion)
+	}
+
+	sort.SliceStable(suggestions, func(i, j int) bool {
+		if suggestions[i].OutsideCount != suggestions[j].OutsideCount {
+			return suggestions[i].OutsideCount < suggestions[j].OutsideCount
+		}
+		// Among equally tight suggestions prefer the simpler one
+		return len(suggestions[i].Matchers) < len(suggestions[j].Matchers)
+	})
+
+	if len(suggestions) > maxSilenceSuggestions {
+		suggestions = suggestions[:maxSilenceSuggestions]
+	}
+	return suggestions
+}
+
+// sharedLabels returns the labels that have the same value on every alert.
+// Internal labels (prefixed with "__") are never suggested.
+func sharedLabels(alerts []*webuimodels.DashboardAlert) map[string]string {
+	if len(alerts) == 0 {
+		return nil
+	}
+
+	shared := make(map[string]string)
+	for name, value := range alerts[0].Labels {
+		if !strings.HasPrefix(name, "__") {
+			shared[name] = value
+		}
+	}
+
+	for _, alert := range alerts[1:] {
+		for name, value := range shared {
+			if alert.Labels[name] != value {
+				delete(shared, name)
+			}
+		}
+	}
+
+	return shared
+}
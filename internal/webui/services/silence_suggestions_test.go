@@ -0,0 +1,72 @@
+package services
+
+import (
+	"testing"
+
+	webuimodels "notificator/internal/webui/models"
+)
+
+func suggestionTestAlert(fingerprint string, labels map[string]string) *webuimodels.DashboardAlert {
+	return &webuimodels.DashboardAlert{Fingerprint: fingerprint, Labels: labels}
+}
+
+func TestSuggestSilenceMatchers(t *testing.T) {
+	podA := suggestionTestAlert("a", map[string]string{"alertname": "PodCrashLooping", "namespace": "payments", "pod": "api-1", "__tmp": "x"})
+	podB := suggestionTestAlert("b", map[string]string{"alertname": "PodCrashLooping", "namespace": "payments", "pod": "api-2", "__tmp": "x"})
+	otherNamespace := suggestionTestAlert("c", map[string]string{"alertname": "PodCrashLooping", "namespace": "billing", "pod": "api-3"})
+	sameNamespace := suggestionTestAlert("d", map[string]string{"alertname": "DiskFull", "namespace": "payments"})
+	active := []*webuimodels.DashboardAlert{podA, podB, otherNamespace, sameNamespace}
+
+	suggestions := SuggestSilenceMatchers([]*webuimodels.DashboardAlert{podA, podB}, active)
+	if len(suggestions) == 0 {
+		t.Fatal("expected suggestions for alerts sharing labels")
+	}
+
+	for _, suggestion := range suggestions {
+		for _, matcher := range suggestion.Matchers {
+			if matcher.Name == "pod" {
+				t.Errorf("pod differs across the selection and must not be suggested: %+v", suggestion)
+			}
+			if matcher.Name == "__tmp" {
+				t.Errorf("internal labels must not be suggested: %+v", suggestion)
+			}
+		}
+		if suggestion.SelectedCount != 2 {
+			t.Errorf("expected SelectedCount 2, got %d", suggestion.SelectedCount)
+		}
+	}
+
+	best := suggestions[0]
+	if len(best.Matchers) != 2 || best.Matchers[0].Name != "alertname" || best.Matchers[1].Name != "namespace" {
+		t.Fatalf("expected the tightest suggestion (alertname + namespace) first, got %+v", best.Matchers)
+	}
+	if best.MatchCount != 2 || best.OutsideCount != 0 {
+		t.Errorf("expected alertname+namespace to match only the selection, got %d matches, %d outside", best.MatchCount, best.OutsideCount)
+	}
+
+	counts := make(map[string]int)
+	for _, suggestion := range suggestions {
+		if len(suggestion.Matchers) == 1 {
+			counts[suggestion.Matchers[0].Name] = suggestion.MatchCount
+		}
+	}
+	if counts["alertname"] != 3 {
+		t.Errorf("expected alertname alone to preview 3 matching alerts, got %d", counts["alertname"])
+	}
+	if counts["namespace"] != 3 {
+		t.Errorf("expected namespace alone to preview 3 matching alerts, got %d", counts["namespace"])
+	}
+
+	t.Run("Nothing shared", func(t *testing.T) {
+		unrelated := suggestionTestAlert("e", map[string]string{"job": "node"})
+		if got := SuggestSilenceMatchers([]*webuimodels.DashboardAlert{podA, unrelated}, active); len(got) != 0 {
+			t.Errorf("expected no suggestions, got %+v", got)
+		}
+	})
+
+	t.Run("Empty selection", func(t *testing.T) {
+		if got := SuggestSilenceMatchers(nil, active); got != nil {
+			t.Errorf("expected no suggestions, got %+v", got)
+		}
+	})
+}
@@ -30,7 +30,7 @@ func ColumnConfigModal() templ.Component {
 			templ_7745c5c3_Var1 = templ.NopComponent
 		}
 		ctx = templ.ClearChildren(ctx)
-		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 1, "<div x-show=\"showColumnConfigModal\" class=\"fixed inset-0 z-50 overflow-y-auto\" x-transition style=\"display: none;\"><div class=\"flex items-end justify-center min-h-screen pt-4 px-4 pb-20 text-center sm:block sm:p-0\"><!-- Background overlay --><div class=\"fixed inset-0 bg-gray-500/75 dark:bg-black/60 backdrop-blur-sm transition-opacity z-0\" @click=\"showColumnConfigModal = false\"></div><!-- Modal panel --><div class=\"inline-block align-bottom bg-white dark:bg-dark-bg-secondary rounded-xl text-left overflow-hidden shadow-2xl transform transition-all sm:my-8 sm:align-middle sm:max-w-4xl sm:w-full relative z-10 border border-gray-200/50 dark:border-dark-border-subtle/50\" @click.stop><!-- Header --><div class=\"flex justify-between items-center px-6 py-4 border-b border-gray-200 dark:border-dark-border-subtle bg-gradient-to-r from-gray-50 to-white dark:from-dark-bg-secondary dark:to-dark-bg-tertiary\"><div><h3 class=\"text-lg font-semibold text-gray-900 dark:text-white\">Manage Table Columns</h3><p class=\"text-sm text-gray-500 dark:text-gray-400 mt-0.5\">Customize which columns appear in your dashboard table</p></div><div class=\"flex items-center gap-3\"><button @click=\"resetColumnsToDefaults()\" title=\"Reset all columns to default configuration\" class=\"px-3 py-1.5 text-sm bg-gray-100 dark:bg-dark-bg-tertiary hover:bg-gray-200 dark:hover:bg-dark-bg-elevated text-gray-700 dark:text-gray-300 rounded-lg transition-colors\">Reset to Defaults</button> <button @click=\"showColumnConfigModal = false\" class=\"p-2 rounded-lg hover:bg-gray-100 dark:hover:bg-dark-bg-tertiary transition-colors group\"><svg class=\"w-5 h-5 text-gray-400 group-hover:text-gray-600 dark:group-hover:text-gray-300\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M6 18L18 6M6 6l12 12\"></path></svg></button></div></div><div class=\"px-6 py-4\"><div class=\"w-full\"><!-- Help Banner --><div class=\"bg-blue-50 dark:bg-blue-900/20 border border-blue-200 dark:border-blue-800 rounded-lg p-3 mb-4\"><div class=\"flex items-start gap-2\"><svg class=\"w-5 h-5 text-blue-600 dark:text-blue-400 flex-shrink-0 mt-0.5\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M13 16h-1v-4h-1m1-4h.01M21 12a9 9 0 11-18 0 9 9 0 0118 0z\"></path></svg><div class=\"text-xs text-blue-800 dark:text-blue-200\"><strong>Quick Tips:</strong><ul class=\"list-disc list-inside mt-1 space-y-0.5\"><li>Drag the <svg class=\"w-3 h-3 inline\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M4 8h16M4 16h16\"></path></svg> handle to reorder columns</li><li>Uncheck the checkbox to hide a column</li><li>Essential columns (orange badge) cannot be deleted but can be hidden</li><li>Custom columns are saved with your filter presets</li></ul></div></div></div><!-- Content --><div class=\"max-h-96 overflow-y-auto\"><!-- Column List with Drag & Drop --><div class=\"space-y-2\"><div class=\"text-xs font-medium text-gray-500 dark:text-gray-400 uppercase tracking-wider mb-2\">Active Columns</div><div class=\"space-y-2\" x-data=\"{ draggingColumn: null }\"><template x-for=\"(column, index) in columns\" :key=\"column.id\"><div draggable=\"true\" @dragstart=\"startColumnDrag($event, column, index)\" @dragend=\"endColumnDrag($event)\" @dragover.prevent=\"handleColumnDragOver($event, index)\" @drop.prevent=\"handleColumnDrop($event, index)\" :class=\"{ 'opacity-50': draggingColumn === column.id }\" class=\"flex items-center gap-3 p-3 bg-white dark:bg-dark-bg-tertiary rounded-lg border border-gray-200 dark:border-dark-border-DEFAULT hover:border-gray-300 dark:hover:border-dark-border-hover transition-colors\"><!-- Drag Handle --><div class=\"flex-shrink-0 text-gray-400 dark:text-gray-500 cursor-grab active:cursor-grabbing\"><svg class=\"w-5 h-5\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M4 8h16M4 16h16\"></path></svg></div><!-- Visibility Toggle --><label class=\"flex items-center cursor-pointer flex-shrink-0\"><input type=\"checkbox\" x-model=\"column.visible\" @change=\"updateVisibleColumns()\" class=\"h-4 w-4 text-blue-600 rounded focus:ring-blue-500 border-gray-300 dark:border-dark-border-DEFAULT\"></label><!-- Column Info --><div class=\"flex-1 min-w-0\"><div class=\"flex items-center gap-2\"><span class=\"text-sm font-medium text-gray-900 dark:text-white\" x-text=\"column.label || column.id\"></span> <span :class=\"{\n\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t'bg-blue-100 text-blue-800 dark:bg-blue-900 dark:text-blue-200': column.field_type === 'system',\n\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t'bg-green-100 text-green-800 dark:bg-green-900 dark:text-green-200': column.field_type === 'label',\n\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t'bg-purple-100 text-purple-800 dark:bg-purple-900 dark:text-purple-200': column.field_type === 'annotation'\n\t\t\t\t\t\t\t\t\t\t\t\t\t\t}\" class=\"px-2 py-0.5 text-xs rounded-full\" x-text=\"column.field_type || 'system'\"></span><template x-if=\"column.critical\"><span class=\"text-xs text-orange-600 dark:text-orange-400 font-medium\">(essential)</span></template></div><div class=\"text-xs text-gray-500 dark:text-gray-400 mt-0.5\"><span x-text=\"column.field_path\"></span> <span class=\"mx-1\">•</span> <span x-text=\"column.formatter\"></span> <span class=\"mx-1\">•</span> <span x-text=\"column.width + 'px'\"></span></div></div><!-- Width Control --><div class=\"flex items-center gap-2 flex-shrink-0\"><label class=\"text-xs text-gray-500 dark:text-gray-400\" title=\"Column width in pixels (50-800)\">Width:</label> <input type=\"number\" x-model.number=\"column.width\" min=\"50\" max=\"800\" step=\"10\" class=\"w-20 px-2 py-1 text-sm border border-gray-300 dark:border-dark-border-DEFAULT rounded bg-white dark:bg-dark-bg-secondary text-gray-900 dark:text-white focus:ring-1 focus:ring-blue-500\" title=\"Adjust column width (50-800 pixels)\"></div><!-- Edit/Delete Actions --><div class=\"flex items-center gap-1 flex-shrink-0\"><template x-if=\"column.field_type !== 'system'\"><button @click=\"editColumn(column)\" class=\"p-1.5 text-gray-500 hover:text-blue-600 dark:text-gray-400 dark:hover:text-blue-400 rounded transition-colors\" title=\"Edit custom column settings\"><svg class=\"w-4 h-4\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M11 5H6a2 2 0 00-2 2v11a2 2 0 002 2h11a2 2 0 002-2v-5m-1.414-9.414a2 2 0 112.828 2.828L11.828 15H9v-2.828l8.586-8.586z\"></path></svg></button></template><template x-if=\"!column.critical && column.field_type !== 'system'\"><button @click=\"deleteColumn(column.id)\" class=\"p-1.5 text-gray-500 hover:text-red-600 dark:text-gray-400 dark:hover:text-red-400 rounded transition-colors\" title=\"Delete custom column (cannot be undone)\"><svg class=\"w-4 h-4\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M19 7l-.867 12.142A2 2 0 0116.138 21H7.862a2 2 0 01-1.995-1.858L5 7m5 4v6m4-6v6m1-10V4a1 1 0 00-1-1h-4a1 1 0 00-1 1v3M4 7h16\"></path></svg></button></template><template x-if=\"column.critical\"><div class=\"text-xs text-gray-400 dark:text-gray-500 px-2\" title=\"Essential columns cannot be deleted\"><svg class=\"w-4 h-4\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M12 15v2m-6 4h12a2 2 0 002-2v-6a2 2 0 00-2-2H6a2 2 0 00-2 2v6a2 2 0 002 2zm10-10V7a4 4 0 00-8 0v4h8z\"></path></svg></div></template></div></div></template></div></div><!-- Add Custom Column Button --><div class=\"pt-4 border-t border-gray-200 dark:border-dark-border-DEFAULT mt-4\"><button @click=\"showAddColumnDialog = true\" class=\"w-full px-4 py-3 text-sm font-medium text-blue-600 dark:text-blue-400 bg-blue-50 dark:bg-blue-900/20 hover:bg-blue-100 dark:hover:bg-blue-900/30 rounded-lg transition-colors flex items-center justify-center gap-2\"><svg class=\"w-5 h-5\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M12 4v16m8-8H4\"></path></svg> Add Custom Column</button></div></div></div></div><!-- Footer --><div class=\"bg-gray-50 dark:bg-dark-bg-tertiary px-4 py-3 sm:px-6 sm:flex sm:flex-row-reverse\"><button @click=\"await saveUserColumnPreferences(); showColumnConfigModal = false\" class=\"w-full inline-flex justify-center rounded-md border border-transparent shadow-sm px-4 py-2 bg-blue-600 text-base font-medium text-white hover:bg-blue-700 focus:outline-none focus:ring-2 focus:ring-offset-2 focus:ring-blue-500 sm:ml-3 sm:w-auto sm:text-sm\">Done</button></div></div></div><!-- Add/Edit Column Dialog (nested modal with higher z-index) --><div x-show=\"showAddColumnDialog\" class=\"fixed inset-0 z-[60] overflow-y-auto\" style=\"display: none;\" x-transition><div class=\"flex items-center justify-center min-h-screen px-4\"><div class=\"fixed inset-0 bg-gray-500/75 transition-opacity z-0\" @click=\"showAddColumnDialog = false; editingColumn = null\"></div><div class=\"relative bg-white dark:bg-dark-bg-secondary rounded-lg shadow-xl max-w-md w-full p-6 z-10\"><h4 class=\"text-lg font-medium text-gray-900 dark:text-white mb-4\"><span x-text=\"editingColumn ? 'Edit Column' : 'Add Custom Column'\"></span></h4><div class=\"space-y-4\"><!-- Column Label --><div><label class=\"block text-sm font-medium text-gray-700 dark:text-gray-300 mb-1\">Column Label <span class=\"text-red-500\">*</span></label> <input type=\"text\" x-model=\"newColumnConfig.label\" placeholder=\"e.g., Environment\" class=\"w-full px-3 py-2 border border-gray-300 dark:border-dark-border-DEFAULT rounded-md bg-white dark:bg-dark-bg-tertiary text-gray-900 dark:text-white focus:ring-blue-500 focus:border-blue-500\"></div><!-- Field Type --><div><label class=\"block text-sm font-medium text-gray-700 dark:text-gray-300 mb-1\">Field Source <span class=\"text-red-500\">*</span></label> <select x-model=\"newColumnConfig.field_type\" @change=\"updateFieldPathPlaceholder()\" class=\"w-full px-3 py-2 border border-gray-300 dark:border-dark-border-DEFAULT rounded-md bg-white dark:bg-dark-bg-tertiary text-gray-900 dark:text-white focus:ring-blue-500 focus:border-blue-500\"><option value=\"label\">Label (from alert labels)</option> <option value=\"annotation\">Annotation (from alert annotations)</option></select><p class=\"text-xs text-gray-500 dark:text-gray-400 mt-1\"><span x-show=\"newColumnConfig.field_type === 'label'\">Extracts value from alert labels like <code class=\"bg-gray-100 dark:bg-gray-800 px-1 rounded\">labels.environment</code></span> <span x-show=\"newColumnConfig.field_type === 'annotation'\">Extracts value from annotations like <code class=\"bg-gray-100 dark:bg-gray-800 px-1 rounded\">annotations.runbook_url</code></span></p></div><!-- Field Path --><div><label class=\"block text-sm font-medium text-gray-700 dark:text-gray-300 mb-1\">Field Name <span class=\"text-red-500\">*</span></label> <input type=\"text\" x-model=\"newColumnConfig.field_name\" :placeholder=\"newColumnConfig.field_type === 'label' ? 'environment' : 'runbook_url'\" class=\"w-full px-3 py-2 border border-gray-300 dark:border-dark-border-DEFAULT rounded-md bg-white dark:bg-dark-bg-tertiary text-gray-900 dark:text-white focus:ring-blue-500 focus:border-blue-500\"><p class=\"text-xs text-gray-500 dark:text-gray-400 mt-1\">The field name without prefix (e.g., \"environment\" not \"labels.environment\")</p></div><!-- Formatter --><div><label class=\"block text-sm font-medium text-gray-700 dark:text-gray-300 mb-1\">Display Format</label> <select x-model=\"newColumnConfig.formatter\" class=\"w-full px-3 py-2 border border-gray-300 dark:border-dark-border-DEFAULT rounded-md bg-white dark:bg-dark-bg-tertiary text-gray-900 dark:text-white focus:ring-blue-500 focus:border-blue-500\"><option value=\"text\">Plain Text</option> <option value=\"badge\">Badge/Pill</option> <option value=\"duration\">Duration (seconds to human)</option> <option value=\"timestamp\">Timestamp</option></select></div><!-- Width --><div><label class=\"block text-sm font-medium text-gray-700 dark:text-gray-300 mb-1\">Column Width (px)</label> <input type=\"number\" x-model.number=\"newColumnConfig.width\" min=\"50\" max=\"800\" step=\"10\" class=\"w-full px-3 py-2 border border-gray-300 dark:border-dark-border-DEFAULT rounded-md bg-white dark:bg-dark-bg-tertiary text-gray-900 dark:text-white focus:ring-blue-500 focus:border-blue-500\"></div></div><!-- Actions --><div class=\"flex gap-3 mt-6\"><button @click=\"saveCustomColumn()\" class=\"flex-1 px-4 py-2 text-sm font-medium text-white bg-blue-600 hover:bg-blue-700 rounded-md transition-colors\"><span x-text=\"editingColumn ? 'Save Changes' : 'Add Column'\"></span></button> <button @click=\"showAddColumnDialog = false; editingColumn = null; resetNewColumnConfig()\" class=\"px-4 py-2 text-sm font-medium text-gray-700 dark:text-gray-300 bg-gray-100 dark:bg-dark-bg-tertiary hover:bg-gray-200 dark:hover:bg-dark-bg-tertiary rounded-md transition-colors\">Cancel</button></div></div></div></div></div>")
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 1, "<div x-show=\"showColumnConfigModal\" class=\"fixed inset-0 z-50 overflow-y-auto\" x-transition style=\"display: none;\"><div class=\"flex items-end justify-center min-h-screen pt-4 px-4 pb-20 text-center sm:block sm:p-0\"><!-- Background overlay --><div class=\"fixed inset-0 bg-gray-500/75 dark:bg-black/60 backdrop-blur-sm transition-opacity z-0\" @click=\"showColumnConfigModal = false\"></div><!-- Modal panel --><div class=\"inline-block align-bottom bg-white dark:bg-dark-bg-secondary rounded-xl text-left overflow-hidden shadow-2xl transform transition-all sm:my-8 sm:align-middle sm:max-w-4xl sm:w-full relative z-10 border border-gray-200/50 dark:border-dark-border-subtle/50\" @click.stop><!-- Header --><div class=\"flex justify-between items-center px-6 py-4 border-b border-gray-200 dark:border-dark-border-subtle bg-gradient-to-r from-gray-50 to-white dark:from-dark-bg-secondary dark:to-dark-bg-tertiary\"><div><h3 class=\"text-lg font-semibold text-gray-900 dark:text-white\">Manage Table Columns</h3><p class=\"text-sm text-gray-500 dark:text-gray-400 mt-0.5\">Customize which columns appear in your dashboard table</p></div><div class=\"flex items-center gap-3\"><button @click=\"resetColumnsToDefaults()\" title=\"Reset all columns to default configuration\" class=\"px-3 py-1.5 text-sm bg-gray-100 dark:bg-dark-bg-tertiary hover:bg-gray-200 dark:hover:bg-dark-bg-elevated text-gray-700 dark:text-gray-300 rounded-lg transition-colors\">Reset to Defaults</button> <button @click=\"showColumnConfigModal = false\" class=\"p-2 rounded-lg hover:bg-gray-100 dark:hover:bg-dark-bg-tertiary transition-colors group\"><svg class=\"w-5 h-5 text-gray-400 group-hover:text-gray-600 dark:group-hover:text-gray-300\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M6 18L18 6M6 6l12 12\"></path></svg></button></div></div><div class=\"px-6 py-4\"><div class=\"w-full\"><!-- Help Banner --><div class=\"bg-blue-50 dark:bg-blue-900/20 border border-blue-200 dark:border-blue-800 rounded-lg p-3 mb-4\"><div class=\"flex items-start gap-2\"><svg class=\"w-5 h-5 text-blue-600 dark:text-blue-400 flex-shrink-0 mt-0.5\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M13 16h-1v-4h-1m1-4h.01M21 12a9 9 0 11-18 0 9 9 0 0118 0z\"></path></svg><div class=\"text-xs text-blue-800 dark:text-blue-200\"><strong>Quick Tips:</strong><ul class=\"list-disc list-inside mt-1 space-y-0.5\"><li>Drag the <svg class=\"w-3 h-3 inline\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M4 8h16M4 16h16\"></path></svg> handle to reorder columns</li><li>Uncheck the checkbox to hide a column</li><li>Essential columns (orange badge) cannot be deleted but can be hidden</li><li>Custom columns are saved with your filter presets</li></ul></div></div></div><!-- Content --><div class=\"max-h-96 overflow-y-auto\"><!-- Column List with Drag & Drop --><div class=\"space-y-2\"><div class=\"text-xs font-medium text-gray-500 dark:text-gray-400 uppercase tracking-wider mb-2\">Active Columns</div><div class=\"space-y-2\" x-data=\"{ draggingColumn: null }\"><template x-for=\"(column, index) in columns\" :key=\"column.id\"><div draggable=\"true\" @dragstart=\"startColumnDrag($event, column, index)\" @dragend=\"endColumnDrag($event)\" @dragover.prevent=\"handleColumnDragOver($event, index)\" @drop.prevent=\"handleColumnDrop($event, index)\" :class=\"{ 'opacity-50': draggingColumn === column.id }\" class=\"flex items-center gap-3 p-3 bg-white dark:bg-dark-bg-tertiary rounded-lg border border-gray-200 dark:border-dark-border-DEFAULT hover:border-gray-300 dark:hover:border-dark-border-hover transition-colors\"><!-- Drag Handle --><div class=\"flex-shrink-0 text-gray-400 dark:text-gray-500 cursor-grab active:cursor-grabbing\"><svg class=\"w-5 h-5\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M4 8h16M4 16h16\"></path></svg></div><!-- Visibility Toggle --><label class=\"flex items-center cursor-pointer flex-shrink-0\"><input type=\"checkbox\" x-model=\"column.visible\" :disabled=\"column.critical\" @change=\"updateVisibleColumns(); persistColumnLayout()\" class=\"h-4 w-4 text-blue-600 rounded focus:ring-blue-500 border-gray-300 dark:border-dark-border-DEFAULT\"></label><!-- Column Info --><div class=\"flex-1 min-w-0\"><div class=\"flex items-center gap-2\"><span class=\"text-sm font-medium text-gray-900 dark:text-white\" x-text=\"column.label || column.id\"></span> <span :class=\"{\n\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t'bg-blue-100 text-blue-800 dark:bg-blue-900 dark:text-blue-200': column.field_type === 'system',\n\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t'bg-green-100 text-green-800 dark:bg-green-900 dark:text-green-200': column.field_type === 'label',\n\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t'bg-purple-100 text-purple-800 dark:bg-purple-900 dark:text-purple-200': column.field_type === 'annotation'\n\t\t\t\t\t\t\t\t\t\t\t\t\t\t}\" class=\"px-2 py-0.5 text-xs rounded-full\" x-text=\"column.field_type || 'system'\"></span><template x-if=\"column.critical\"><span class=\"text-xs text-orange-600 dark:text-orange-400 font-medium\">(essential)</span></template></div><div class=\"text-xs text-gray-500 dark:text-gray-400 mt-0.5\"><span x-text=\"column.field_path\"></span> <span class=\"mx-1\">•</span> <span x-text=\"column.formatter\"></span> <span class=\"mx-1\">•</span> <span x-text=\"column.width + 'px'\"></span></div></div><!-- Width Control --><div class=\"flex items-center gap-2 flex-shrink-0\"><label class=\"text-xs text-gray-500 dark:text-gray-400\" title=\"Column width in pixels (50-800)\">Width:</label> <input type=\"number\" x-model.number=\"column.width\" min=\"50\" max=\"800\" step=\"10\" class=\"w-20 px-2 py-1 text-sm border border-gray-300 dark:border-dark-border-DEFAULT rounded bg-white dark:bg-dark-bg-secondary text-gray-900 dark:text-white focus:ring-1 focus:ring-blue-500\" title=\"Adjust column width (50-800 pixels)\"></div><!-- Edit/Delete Actions --><div class=\"flex items-center gap-1 flex-shrink-0\"><template x-if=\"column.field_type !== 'system'\"><button @click=\"editColumn(column)\" class=\"p-1.5 text-gray-500 hover:text-blue-600 dark:text-gray-400 dark:hover:text-blue-400 rounded transition-colors\" title=\"Edit custom column settings\"><svg class=\"w-4 h-4\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M11 5H6a2 2 0 00-2 2v11a2 2 0 002 2h11a2 2 0 002-2v-5m-1.414-9.414a2 2 0 112.828 2.828L11.828 15H9v-2.828l8.586-8.586z\"></path></svg></button></template><template x-if=\"!column.critical && column.field_type !== 'system'\"><button @click=\"deleteColumn(column.id)\" class=\"p-1.5 text-gray-500 hover:text-red-600 dark:text-gray-400 dark:hover:text-red-400 rounded transition-colors\" title=\"Delete custom column (cannot be undone)\"><svg class=\"w-4 h-4\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M19 7l-.867 12.142A2 2 0 0116.138 21H7.862a2 2 0 01-1.995-1.858L5 7m5 4v6m4-6v6m1-10V4a1 1 0 00-1-1h-4a1 1 0 00-1 1v3M4 7h16\"></path></svg></button></template><template x-if=\"column.critical\"><div class=\"text-xs text-gray-400 dark:text-gray-500 px-2\" title=\"Essential columns cannot be deleted\"><svg class=\"w-4 h-4\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M12 15v2m-6 4h12a2 2 0 002-2v-6a2 2 0 00-2-2H6a2 2 0 00-2 2v6a2 2 0 002 2zm10-10V7a4 4 0 00-8 0v4h8z\"></path></svg></div></template></div></div></template></div></div><!-- Add Custom Column Button --><div class=\"pt-4 border-t border-gray-200 dark:border-dark-border-DEFAULT mt-4\"><button @click=\"showAddColumnDialog = true\" class=\"w-full px-4 py-3 text-sm font-medium text-blue-600 dark:text-blue-400 bg-blue-50 dark:bg-blue-900/20 hover:bg-blue-100 dark:hover:bg-blue-900/30 rounded-lg transition-colors flex items-center justify-center gap-2\"><svg class=\"w-5 h-5\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M12 4v16m8-8H4\"></path></svg> Add Custom Column</button></div></div></div></div><!-- Footer --><div class=\"bg-gray-50 dark:bg-dark-bg-tertiary px-4 py-3 sm:px-6 sm:flex sm:flex-row-reverse\"><button @click=\"await saveUserColumnPreferences(); showColumnConfigModal = false\" class=\"w-full inline-flex justify-center rounded-md border border-transparent shadow-sm px-4 py-2 bg-blue-600 text-base font-medium text-white hover:bg-blue-700 focus:outline-none focus:ring-2 focus:ring-offset-2 focus:ring-blue-500 sm:ml-3 sm:w-auto sm:text-sm\">Done</button></div></div></div><!-- Add/Edit Column Dialog (nested modal with higher z-index) --><div x-show=\"showAddColumnDialog\" class=\"fixed inset-0 z-[60] overflow-y-auto\" style=\"display: none;\" x-transition><div class=\"flex items-center justify-center min-h-screen px-4\"><div class=\"fixed inset-0 bg-gray-500/75 transition-opacity z-0\" @click=\"showAddColumnDialog = false; editingColumn = null\"></div><div class=\"relative bg-white dark:bg-dark-bg-secondary rounded-lg shadow-xl max-w-md w-full p-6 z-10\"><h4 class=\"text-lg font-medium text-gray-900 dark:text-white mb-4\"><span x-text=\"editingColumn ? 'Edit Column' : 'Add Custom Column'\"></span></h4><div class=\"space-y-4\"><!-- Column Label --><div><label class=\"block text-sm font-medium text-gray-700 dark:text-gray-300 mb-1\">Column Label <span class=\"text-red-500\">*</span></label> <input type=\"text\" x-model=\"newColumnConfig.label\" placeholder=\"e.g., Environment\" class=\"w-full px-3 py-2 border border-gray-300 dark:border-dark-border-DEFAULT rounded-md bg-white dark:bg-dark-bg-tertiary text-gray-900 dark:text-white focus:ring-blue-500 focus:border-blue-500\"></div><!-- Field Type --><div><label class=\"block text-sm font-medium text-gray-700 dark:text-gray-300 mb-1\">Field Source <span class=\"text-red-500\">*</span></label> <select x-model=\"newColumnConfig.field_type\" @change=\"updateFieldPathPlaceholder()\" class=\"w-full px-3 py-2 border border-gray-300 dark:border-dark-border-DEFAULT rounded-md bg-white dark:bg-dark-bg-tertiary text-gray-900 dark:text-white focus:ring-blue-500 focus:border-blue-500\"><option value=\"label\">Label (from alert labels)</option> <option value=\"annotation\">Annotation (from alert annotations)</option></select><p class=\"text-xs text-gray-500 dark:text-gray-400 mt-1\"><span x-show=\"newColumnConfig.field_type === 'label'\">Extracts value from alert labels like <code class=\"bg-gray-100 dark:bg-gray-800 px-1 rounded\">labels.environment</code></span> <span x-show=\"newColumnConfig.field_type === 'annotation'\">Extracts value from annotations like <code class=\"bg-gray-100 dark:bg-gray-800 px-1 rounded\">annotations.runbook_url</code></span></p></div><!-- Field Path --><div><label class=\"block text-sm font-medium text-gray-700 dark:text-gray-300 mb-1\">Field Name <span class=\"text-red-500\">*</span></label> <input type=\"text\" x-model=\"newColumnConfig.field_name\" :placeholder=\"newColumnConfig.field_type === 'label' ? 'environment' : 'runbook_url'\" class=\"w-full px-3 py-2 border border-gray-300 dark:border-dark-border-DEFAULT rounded-md bg-white dark:bg-dark-bg-tertiary text-gray-900 dark:text-white focus:ring-blue-500 focus:border-blue-500\"><p class=\"text-xs text-gray-500 dark:text-gray-400 mt-1\">The field name without prefix (e.g., \"environment\" not \"labels.environment\")</p></div><!-- Formatter --><div><label class=\"block text-sm font-medium text-gray-700 dark:text-gray-300 mb-1\">Display Format</label> <select x-model=\"newColumnConfig.formatter\" class=\"w-full px-3 py-2 border border-gray-300 dark:border-dark-border-DEFAULT rounded-md bg-white dark:bg-dark-bg-tertiary text-gray-900 dark:text-white focus:ring-blue-500 focus:border-blue-500\"><option value=\"text\">Plain Text</option> <option value=\"badge\">Badge/Pill</option> <option value=\"duration\">Duration (seconds to human)</option> <option value=\"timestamp\">Timestamp</option></select></div><!-- Width --><div><label class=\"block text-sm font-medium text-gray-700 dark:text-gray-300 mb-1\">Column Width (px)</label> <input type=\"number\" x-model.number=\"newColumnConfig.width\" min=\"50\" max=\"800\" step=\"10\" class=\"w-full px-3 py-2 border border-gray-300 dark:border-dark-border-DEFAULT rounded-md bg-white dark:bg-dark-bg-tertiary text-gray-900 dark:text-white focus:ring-blue-500 focus:border-blue-500\"></div></div><!-- Actions --><div class=\"flex gap-3 mt-6\"><button @click=\"saveCustomColumn()\" class=\"flex-1 px-4 py-2 text-sm font-medium text-white bg-blue-600 hover:bg-blue-700 rounded-md transition-colors\"><span x-text=\"editingColumn ? 'Save Changes' : 'Add Column'\"></span></button> <button @click=\"showAddColumnDialog = false; editingColumn = null; resetNewColumnConfig()\" class=\"px-4 py-2 text-sm font-medium text-gray-700 dark:text-gray-300 bg-gray-100 dark:bg-dark-bg-tertiary hover:bg-gray-200 dark:hover:bg-dark-bg-tertiary rounded-md transition-colors\">Cancel</button></div></div></div></div></div>")
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
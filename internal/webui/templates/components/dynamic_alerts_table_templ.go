@@ -30,7 +30,7 @@ func DynamicAlertsTable() templ.Component {
 			templ_7745c5c3_Var1 = templ.NopComponent
 		}
 		ctx = templ.ClearChildren(ctx)
-		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 1, "<!-- Loading State --><div x-show=\"loading\" class=\"p-8\"><div class=\"animate-pulse space-y-4\"><template x-for=\"i in 5\" :key=\"'loading-' + i\"><div class=\"h-16 bg-gray-200 dark:bg-dark-bg-tertiary rounded\"></div></template></div></div><!-- Empty State --><div x-show=\"!loading && alerts.length === 0\" class=\"text-center py-12\"><svg class=\"mx-auto h-12 w-12 text-gray-400\" viewBox=\"0 0 24 24\" stroke-width=\"1.5\" stroke=\"currentColor\" fill=\"none\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" d=\"M19.5 14.25v-2.625a3.375 3.375 0 0 0-3.375-3.375h-1.5A1.125 1.125 0 0 1 13.5 7.125v-1.5a3.375 3.375 0 0 0-3.375-3.375H8.25m2.25 0H5.625c-.621 0-1.125.504-1.125 1.125v17.25c0 .621.504 1.125 1.125 1.125h12.75c.621 0 1.125-.504 1.125-1.125V11.25a9 9 0 0 0-9-9Z\"></path></svg><h3 class=\"mt-2 text-sm font-medium text-gray-900 dark:text-white\">No alerts found</h3><p class=\"mt-1 text-sm text-gray-500 dark:text-gray-400\">Try adjusting your search or filter criteria.</p></div><!-- Dynamic Table View --><div x-show=\"!loading && alerts.length > 0\" class=\"alert-table-container\"><table class=\"alert-table\"><thead class=\"bg-gradient-to-b from-gray-50 to-gray-100/50 dark:from-gray-800 dark:to-gray-850 border-b border-gray-200 dark:border-gray-700\"><tr><!-- Dynamic Headers --><template x-for=\"column in visibleColumns\" :key=\"column.id\"><th class=\"px-6 py-3.5 text-left text-xs font-semibold text-gray-700 dark:text-gray-300 uppercase tracking-wider relative transition-colors duration-150\" :class=\"{\n\t\t\t\t\t\t\t\t'cursor-pointer select-none hover:bg-gray-100/50 dark:hover:bg-gray-800/50': column.sortable,\n\t\t\t\t\t\t\t\t'bg-gray-100/50 dark:bg-gray-800/50': column.sortable && sortField === column.field_path\n\t\t\t\t\t\t\t}\" :style=\"`width: ${column.width}px; min-width: ${column.width}px;`\" @click=\"column.sortable && sortByColumn(column)\"><div class=\"flex items-center justify-between gap-2\"><!-- Column Label --><span class=\"truncate\" x-text=\"column.label\"></span><!-- Sort Indicator --><template x-if=\"column.sortable\"><svg class=\"w-4 h-4 flex-shrink-0 transition-all duration-200\" :class=\"{\n\t\t\t\t\t\t\t\t\t\t\t'text-blue-600 dark:text-blue-400': sortField === column.field_path,\n\t\t\t\t\t\t\t\t\t\t\t'text-gray-400 opacity-0 group-hover:opacity-100': sortField !== column.field_path,\n\t\t\t\t\t\t\t\t\t\t\t'transform rotate-180': sortField === column.field_path && sortDirection === 'desc'\n\t\t\t\t\t\t\t\t\t\t}\" viewBox=\"0 0 24 24\" stroke-width=\"2\" stroke=\"currentColor\" fill=\"none\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" d=\"M4.5 15.75l7.5-7.5 7.5 7.5\"></path></svg></template><!-- Resize Handle --><template x-if=\"column.resizable\"><div class=\"absolute right-0 top-0 bottom-0 w-1 bg-transparent hover:bg-blue-500 cursor-col-resize transition-colors duration-150\" @mousedown=\"startColumnResize($event, column)\" @click.stop></div></template></div></th></template></tr></thead> <tbody class=\"bg-white dark:bg-dark-bg-secondary divide-y divide-gray-100 dark:divide-gray-800\"><template x-for=\"(alert, index) in alerts\" :key=\"alert.fingerprint\"><!-- Row click opens alert details modal --><tr class=\"group cursor-pointer transition-colors duration-100 border-l-[3px]\" @click=\"if (!$event.target.closest('input[type=checkbox]') && !$event.target.closest('button')) showAlertDetails(alert.fingerprint)\" :class=\"{\n\t\t\t\t\t\t\t'bg-blue-50/50 dark:bg-blue-900/20 hover:bg-blue-100/60 dark:hover:bg-blue-900/30': selectedAlerts.includes(alert.fingerprint),\n\t\t\t\t\t\t\t'hover:bg-gray-50 dark:hover:bg-dark-bg-tertiary': !selectedAlerts.includes(alert.fingerprint)\n\t\t\t\t\t\t}\" :style=\"`background-color: ${selectedAlerts.includes(alert.fingerprint) ? '' : getAlertColor(alert, 'backgroundColor')}; border-left-color: ${getAlertColor(alert, 'borderColor')};`\"><!-- Dynamic Cells --><template x-for=\"column in visibleColumns\" :key=\"column.id\"><td class=\"px-6 py-4 align-middle overflow-hidden\" :style=\"`width: ${column.width}px; min-width: ${column.width}px; max-width: ${column.width}px;`\" x-html=\"renderCell(alert, column)\"></td></template></tr></template></tbody></table></div><!-- Table Info Footer --><div x-show=\"!loading && alerts.length > 0\" class=\"px-6 py-4 bg-gray-50 dark:bg-dark-bg-secondary border-t border-gray-200 dark:border-dark-border-subtle\"><div class=\"flex items-center justify-between text-sm text-gray-700 dark:text-gray-300\"><div>Showing <span class=\"font-medium\" x-text=\"alerts.length\"></span> alert<span x-show=\"alerts.length !== 1\">s</span> <span x-show=\"selectedAlerts.length > 0\">(<span class=\"font-medium\" x-text=\"selectedAlerts.length\"></span> selected)</span></div><div x-show=\"visibleColumns.length !== columns.length\" class=\"text-gray-500 dark:text-gray-400\"><span x-text=\"visibleColumns.length\"></span> of <span x-text=\"columns.length\"></span> columns visible</div></div></div>")
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 1, "<!-- Loading State --><div x-show=\"loading\" class=\"p-8\"><div class=\"animate-pulse space-y-4\"><template x-for=\"i in 5\" :key=\"'loading-' + i\"><div class=\"h-16 bg-gray-200 dark:bg-dark-bg-tertiary rounded\"></div></template></div></div><!-- Empty State --><div x-show=\"!loading && alerts.length === 0\" class=\"text-center py-12\"><svg class=\"mx-auto h-12 w-12 text-gray-400\" viewBox=\"0 0 24 24\" stroke-width=\"1.5\" stroke=\"currentColor\" fill=\"none\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" d=\"M19.5 14.25v-2.625a3.375 3.375 0 0 0-3.375-3.375h-1.5A1.125 1.125 0 0 1 13.5 7.125v-1.5a3.375 3.375 0 0 0-3.375-3.375H8.25m2.25 0H5.625c-.621 0-1.125.504-1.125 1.125v17.25c0 .621.504 1.125 1.125 1.125h12.75c.621 0 1.125-.504 1.125-1.125V11.25a9 9 0 0 0-9-9Z\"></path></svg><h3 class=\"mt-2 text-sm font-medium text-gray-900 dark:text-white\">No alerts found</h3><p class=\"mt-1 text-sm text-gray-500 dark:text-gray-400\">Try adjusting your search or filter criteria.</p></div><!-- Dynamic Table View --><div x-show=\"!loading && alerts.length > 0\" class=\"alert-table-container\"><table class=\"alert-table\"><thead class=\"bg-gradient-to-b from-gray-50 to-gray-100/50 dark:from-gray-800 dark:to-gray-850 border-b border-gray-200 dark:border-gray-700\"><tr><!-- Dynamic Headers --><template x-for=\"column in visibleColumns\" :key=\"column.id\"><th class=\"group px-6 py-3.5 text-left text-xs font-semibold text-gray-700 dark:text-gray-300 uppercase tracking-wider relative transition-colors duration-150\" :class=\"{\n\t\t\t\t\t\t\t\t'cursor-pointer select-none hover:bg-gray-100/50 dark:hover:bg-gray-800/50': column.sortable,\n\t\t\t\t\t\t\t\t'bg-gray-100/50 dark:bg-gray-800/50': column.sortable && sortField === column.field_path\n\t\t\t\t\t\t\t}\" :style=\"`width: ${columnWidth(column)}px; min-width: ${columnWidth(column)}px;`\" @click=\"column.sortable && sortByColumn(column, $event)\" :title=\"column.sortable ? 'Click to sort, Shift+click to add as a further sort key' : ''\"><div class=\"flex items-center justify-between gap-2\"><!-- Column Label --><span class=\"truncate\" x-text=\"column.label\"></span><!-- Hide Column (essential columns cannot be hidden) --><template x-if=\"!column.critical\"><button type=\"button\" @click.stop=\"hideColumn(column)\" class=\"ml-auto flex-shrink-0 text-gray-400 hover:text-gray-600 opacity-0 group-hover:opacity-100 transition-opacity\" title=\"Hide column\"><svg class=\"w-3.5 h-3.5\" viewBox=\"0 0 24 24\" stroke-width=\"2\" stroke=\"currentColor\" fill=\"none\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" d=\"M6 18 18 6M6 6l12 12\"></path></svg></button></template><!-- Sort Indicator (with the key's position when sorting on several columns) --><template x-if=\"column.sortable\"><span class=\"flex items-center flex-shrink-0\"><svg class=\"w-4 h-4 flex-shrink-0 transition-all duration-200\" :class=\"{\n\t\t\t\t\t\t\t\t\t\t\t\t'text-blue-600 dark:text-blue-400': sortRank(column.field_path) > 0,\n\t\t\t\t\t\t\t\t\t\t\t\t'text-gray-400 opacity-0 group-hover:opacity-100': sortRank(column.field_path) === 0,\n\t\t\t\t\t\t\t\t\t\t\t\t'transform rotate-180': sortDirectionOf(column.field_path) === 'desc'\n\t\t\t\t\t\t\t\t\t\t\t}\" viewBox=\"0 0 24 24\" stroke-width=\"2\" stroke=\"currentColor\" fill=\"none\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" d=\"M4.5 15.75l7.5-7.5 7.5 7.5\"></path></svg> <span x-show=\"sortThen.length > 0 && sortRank(column.field_path) > 0\" class=\"text-xs text-blue-600 dark:text-blue-400\" x-text=\"sortRank(column.field_path)\"></span></span></template><!-- Resize Handle --><template x-if=\"column.resizable\"><div class=\"absolute right-0 top-0 bottom-0 w-1 bg-transparent hover:bg-blue-500 cursor-col-resize transition-colors duration-150\" @mousedown=\"startColumnResize($event, column)\" @click.stop></div></template></div></th></template></tr></thead> <tbody class=\"bg-white dark:bg-dark-bg-secondary divide-y divide-gray-100 dark:divide-gray-800\" @mouseover=\"showCellTooltip($event)\" @mouseout=\"hideCellTooltip()\" @scroll.window=\"hideCellTooltip()\"><template x-for=\"(alert, index) in alerts\" :key=\"alert.fingerprint\"><!-- Row click opens alert details modal --><tr class=\"group cursor-pointer transition-colors duration-100 border-l-[3px]\" @click=\"if (!$event.target.closest('input[type=checkbox]') && !$event.target.closest('button')) showAlertDetails(alert.fingerprint)\" :class=\"{\n\t\t\t\t\t\t\t'bg-blue-50/50 dark:bg-blue-900/20 hover:bg-blue-100/60 dark:hover:bg-blue-900/30': selectedAlerts.includes(alert.fingerprint),\n\t\t\t\t\t\t\t'hover:bg-gray-50 dark:hover:bg-dark-bg-tertiary': !selectedAlerts.includes(alert.fingerprint)\n\t\t\t\t\t\t}\" :style=\"`background-color: ${selectedAlerts.includes(alert.fingerprint) ? '' : getAlertColor(alert, 'backgroundColor')}; border-left-color: ${getAlertColor(alert, 'borderColor')};${getNewAlertHighlightStyle(alert)}`\"><!-- Dynamic Cells --><template x-for=\"column in visibleColumns\" :key=\"column.id\"><td class=\"py-4 align-middle overflow-hidden\" :class=\"column.formatter === 'actions' ? 'px-3' : 'px-6'\" :style=\"`width: ${columnWidth(column)}px; min-width: ${columnWidth(column)}px; max-width: ${columnWidth(column)}px;`\" x-html=\"renderCell(alert, column)\"></td></template></tr></template></tbody></table></div><!-- Full text of a truncated cell --><div x-show=\"cellTooltip.show\" x-cloak class=\"fixed z-50 max-w-md px-3 py-1.5 rounded-md shadow-lg bg-gray-900 dark:bg-gray-700 text-xs text-white whitespace-pre-wrap break-words pointer-events-none\" :style=\"`left: ${cellTooltip.x}px; top: ${cellTooltip.y}px;`\" x-text=\"cellTooltip.text\"></div><!-- Table Info Footer --><div x-show=\"!loading && alerts.length > 0\" class=\"px-6 py-4 bg-gray-50 dark:bg-dark-bg-secondary border-t border-gray-200 dark:border-dark-border-subtle\"><div class=\"flex items-center justify-between text-sm text-gray-700 dark:text-gray-300\"><div>Showing <span class=\"font-medium\" x-text=\"alerts.length\"></span> alert<span x-show=\"alerts.length !== 1\">s</span> <span x-show=\"selectedAlerts.length > 0\">(<span class=\"font-medium\" x-text=\"selectedAlerts.length\"></span> selected)</span></div><div x-show=\"visibleColumns.length !== columns.length\" class=\"text-gray-500 dark:text-gray-400\"><span x-text=\"visibleColumns.length\"></span> of <span x-text=\"columns.length\"></span> columns visible</div></div></div>")
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
@@ -30,7 +30,7 @@ func SettingsModal() templ.Component {
 			templ_7745c5c3_Var1 = templ.NopComponent
 		}
 		ctx = templ.ClearChildren(ctx)
-		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 1, "<div x-show=\"showSettings\" x-data=\"settingsModalData()\" class=\"fixed inset-0 z-50 overflow-y-auto\" x-transition style=\"display: none;\"><div class=\"flex items-end justify-center min-h-screen pt-4 px-4 pb-20 text-center sm:block sm:p-0\"><div class=\"fixed inset-0 bg-gray-500/75 dark:bg-black/60 backdrop-blur-sm transition-opacity z-0\" @click=\"showSettings = false\"></div><div class=\"inline-block align-bottom bg-white dark:bg-dark-bg-secondary rounded-xl text-left overflow-hidden shadow-2xl transform transition-all sm:my-8 sm:align-middle sm:max-w-4xl sm:w-full max-h-[90vh] relative z-10 border border-gray-200/50 dark:border-dark-border-subtle/50\"><!-- Header with close button --><div class=\"flex items-center justify-between px-6 py-4 border-b border-gray-200 dark:border-dark-border-subtle bg-gradient-to-r from-gray-50 to-white dark:from-dark-bg-secondary dark:to-dark-bg-tertiary\"><h3 class=\"text-lg font-semibold text-gray-900 dark:text-white\">Dashboard Settings</h3><button @click=\"showSettings = false\" class=\"p-2 rounded-lg hover:bg-gray-100 dark:hover:bg-dark-bg-tertiary transition-colors group\"><svg class=\"w-5 h-5 text-gray-400 group-hover:text-gray-600 dark:group-hover:text-gray-300\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M6 18L18 6M6 6l12 12\"></path></svg></button></div><div class=\"px-6 py-4\"><div class=\"w-full\"><!-- Tab Navigation --><div class=\"mb-6\"><nav class=\"flex space-x-1 p-1 bg-gray-100 dark:bg-dark-bg-tertiary rounded-lg overflow-x-auto\"><button @click=\"activeTab = 'general'\" :class=\"activeTab === 'general' ? 'bg-white dark:bg-dark-bg-secondary text-blue-600 dark:text-blue-400 shadow-sm' : 'text-gray-600 dark:text-gray-400 hover:text-gray-900 dark:hover:text-white hover:bg-white/50 dark:hover:bg-dark-bg-secondary/50'\" class=\"whitespace-nowrap px-4 py-2 rounded-md font-medium text-sm transition-all duration-200\">General</button> <button @click=\"activeTab = 'colors'\" :class=\"activeTab === 'colors' ? 'bg-white dark:bg-dark-bg-secondary text-blue-600 dark:text-blue-400 shadow-sm' : 'text-gray-600 dark:text-gray-400 hover:text-gray-900 dark:hover:text-white hover:bg-white/50 dark:hover:bg-dark-bg-secondary/50'\" class=\"whitespace-nowrap px-4 py-2 rounded-md font-medium text-sm transition-all duration-200\">Colors</button> <button @click=\"activeTab = 'hidden'\" :class=\"activeTab === 'hidden' ? 'bg-white dark:bg-dark-bg-secondary text-blue-600 dark:text-blue-400 shadow-sm' : 'text-gray-600 dark:text-gray-400 hover:text-gray-900 dark:hover:text-white hover:bg-white/50 dark:hover:bg-dark-bg-secondary/50'\" class=\"whitespace-nowrap px-4 py-2 rounded-md font-medium text-sm transition-all duration-200\">Hidden</button> <button @click=\"activeTab = 'sentry'\" :class=\"activeTab === 'sentry' ? 'bg-white dark:bg-dark-bg-secondary text-blue-600 dark:text-blue-400 shadow-sm' : 'text-gray-600 dark:text-gray-400 hover:text-gray-900 dark:hover:text-white hover:bg-white/50 dark:hover:bg-dark-bg-secondary/50'\" class=\"whitespace-nowrap px-4 py-2 rounded-md font-medium text-sm transition-all duration-200\">Sentry</button> <button @click=\"activeTab = 'notifications'\" :class=\"activeTab === 'notifications' ? 'bg-white dark:bg-dark-bg-secondary text-blue-600 dark:text-blue-400 shadow-sm' : 'text-gray-600 dark:text-gray-400 hover:text-gray-900 dark:hover:text-white hover:bg-white/50 dark:hover:bg-dark-bg-secondary/50'\" class=\"whitespace-nowrap px-4 py-2 rounded-md font-medium text-sm transition-all duration-200\">Notifications</button> <button @click=\"setActiveTab('annotation-buttons')\" :class=\"activeTab === 'annotation-buttons' ? 'bg-white dark:bg-dark-bg-secondary text-blue-600 dark:text-blue-400 shadow-sm' : 'text-gray-600 dark:text-gray-400 hover:text-gray-900 dark:hover:text-white hover:bg-white/50 dark:hover:bg-dark-bg-secondary/50'\" class=\"whitespace-nowrap px-4 py-2 rounded-md font-medium text-sm transition-all duration-200\">Buttons</button></nav></div><!-- Tab Content --><div class=\"max-h-96 overflow-y-auto\"><!-- General Settings Tab --><div x-show=\"activeTab === 'general'\" class=\"space-y-6\"><!-- Theme --><div><label class=\"text-sm font-medium text-gray-700 dark:text-gray-300\">Theme</label><div class=\"mt-2 space-x-4\"><label for=\"settings-theme-light\" class=\"inline-flex items-center\"><input type=\"radio\" id=\"settings-theme-light\" name=\"settings-theme\" x-model=\"settings.theme\" value=\"light\" class=\"form-radio text-blue-600\"> <span class=\"ml-2 text-sm text-gray-700 dark:text-gray-300\">Light</span></label> <label for=\"settings-theme-dark\" class=\"inline-flex items-center\"><input type=\"radio\" id=\"settings-theme-dark\" name=\"settings-theme\" x-model=\"settings.theme\" value=\"dark\" class=\"form-radio text-blue-600\"> <span class=\"ml-2 text-sm text-gray-700 dark:text-gray-300\">Dark</span></label></div></div><!-- Resolved Alerts Display Limit --><div><label for=\"settings-resolved-limit\" class=\"block text-sm font-medium text-gray-700 dark:text-gray-300\">Resolved Alerts Display Limit</label><div class=\"mt-1\"><input type=\"number\" id=\"settings-resolved-limit\" name=\"settings-resolved-limit\" x-model=\"settings.resolvedAlertsLimit\" min=\"10\" max=\"1000\" class=\"block w-full border-gray-300 dark:border-dark-border-DEFAULT rounded-md shadow-sm focus:ring-blue-500 focus:border-blue-500 dark:bg-dark-bg-tertiary dark:text-white\"></div><p class=\"text-xs text-gray-500 dark:text-gray-400 mt-1\">Maximum number of resolved alerts to display in the dashboard (stored locally)</p></div><!-- Refresh Interval --><div><label for=\"settings-refresh-interval\" class=\"block text-sm font-medium text-gray-700 dark:text-gray-300\">Refresh Interval (seconds)</label><div class=\"mt-1\"><select id=\"settings-refresh-interval\" name=\"settings-refresh-interval\" x-model=\"settings.refreshInterval\" class=\"block w-full border-gray-300 dark:border-dark-border-DEFAULT rounded-md shadow-sm focus:ring-blue-500 focus:border-blue-500 dark:bg-dark-bg-tertiary dark:text-white\"><option value=\"5\">5 seconds</option> <option value=\"10\">10 seconds</option> <option value=\"30\">30 seconds</option> <option value=\"60\">1 minute</option></select></div></div><!-- On-Call Schedule --><div class=\"border-t border-gray-200 dark:border-gray-700 pt-4\"><label class=\"block text-sm font-medium text-gray-700 dark:text-gray-300 mb-2\">On-Call Schedule</label><p class=\"text-xs text-gray-500 dark:text-gray-400 mb-3\">Configure your on-call hours for quick filtering in Statistics.</p><div class=\"space-y-3\"><!-- Weekday Hours --><div class=\"flex items-center space-x-3\"><label for=\"settings-oncall-start\" class=\"text-sm text-gray-600 dark:text-gray-400 w-28\">Weekday hours:</label> <input type=\"time\" id=\"settings-oncall-start\" name=\"settings-oncall-start\" x-model=\"settings.onCallSchedule.weekdayStart\" class=\"px-2 py-1 text-sm border-gray-300 dark:border-dark-border-DEFAULT rounded-md shadow-sm focus:ring-blue-500 focus:border-blue-500 dark:bg-dark-bg-tertiary dark:text-white\"> <span class=\"text-sm text-gray-500 dark:text-gray-400\">to</span> <input type=\"time\" id=\"settings-oncall-end\" name=\"settings-oncall-end\" x-model=\"settings.onCallSchedule.weekdayEnd\" class=\"px-2 py-1 text-sm border-gray-300 dark:border-dark-border-DEFAULT rounded-md shadow-sm focus:ring-blue-500 focus:border-blue-500 dark:bg-dark-bg-tertiary dark:text-white\"></div><!-- Weekend Toggle --><label for=\"settings-oncall-weekends\" class=\"flex items-center cursor-pointer\"><input type=\"checkbox\" id=\"settings-oncall-weekends\" name=\"settings-oncall-weekends\" x-model=\"settings.onCallSchedule.includeWeekends\" class=\"h-4 w-4 text-blue-600 focus:ring-blue-500 border-gray-300 rounded\"> <span class=\"ml-2 text-sm text-gray-700 dark:text-gray-300\">Include full weekends as on-call</span></label></div><p class=\"text-xs text-gray-500 dark:text-gray-400 mt-2\">Default: 18:00 - 08:00 weekdays + full weekends</p></div><!-- Remove All Resolved Alerts (admin only) --><div x-data=\"{ canAdmin: false }\" x-init=\"if (window.impersonationState?.initialized) { canAdmin = window.impersonationState.canImpersonate } else { window.addEventListener('impersonationStateReady', () => { canAdmin = window.impersonationState.canImpersonate }, { once: true }) }\"><template x-if=\"canAdmin\"><div><label class=\"block text-sm font-medium text-gray-700 dark:text-gray-300 mb-2\">Alert Management</label><div class=\"flex items-center space-x-3\"><button @click=\"confirmRemoveResolvedAlerts()\" :disabled=\"isRemovingResolvedAlerts\" class=\"px-4 py-2 text-sm font-medium text-white bg-red-600 border border-transparent rounded-md shadow-sm hover:bg-red-700 focus:outline-none focus:ring-2 focus:ring-offset-2 focus:ring-red-500 disabled:opacity-50 disabled:cursor-not-allowed dark:focus:ring-offset-dark-bg-primary\"><span x-show=\"!isRemovingResolvedAlerts\">🗑️ Remove All Resolved Alerts</span> <span x-show=\"isRemovingResolvedAlerts\" class=\"flex items-center\"><svg class=\"animate-spin -ml-1 mr-2 h-4 w-4 text-white\" xmlns=\"http://www.w3.org/2000/svg\" fill=\"none\" viewBox=\"0 0 24 24\"><circle class=\"opacity-25\" cx=\"12\" cy=\"12\" r=\"10\" stroke=\"currentColor\" stroke-width=\"4\"></circle> <path class=\"opacity-75\" fill=\"currentColor\" d=\"M4 12a8 8 0 018-8V0C5.373 0 0 5.373 0 12h4zm2 5.291A7.962 7.962 0 014 12H0c0 3.042 1.135 5.824 3 7.938l3-2.647z\"></path></svg> Removing...</span></button></div><p class=\"text-xs text-gray-500 dark:text-gray-400 mt-1\">Permanently removes all resolved alerts from the backend storage. This action cannot be undone.</p></div></template></div></div><!-- Color Preferences Tab --><div x-show=\"activeTab === 'colors'\" class=\"space-y-6\"><div class=\"flex items-center justify-between mb-4\"><div><h4 class=\"text-sm font-medium text-gray-900 dark:text-white\">Alert Color Rules</h4><p class=\"text-xs text-gray-500 dark:text-gray-400 mt-1\">Define custom colors for alerts based on their labels. Higher priority rules override lower ones.</p></div><button @click=\"addColorPreference()\" class=\"inline-flex items-center px-3 py-1.5 border border-transparent text-xs font-medium rounded text-white bg-blue-600 hover:bg-blue-700 focus:outline-none focus:ring-2 focus:ring-offset-2 focus:ring-blue-500\"><svg class=\"w-3 h-3 mr-1\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M12 4v16m8-8H4\"></path></svg> Add Rule</button></div><!-- Color Preferences List --><div class=\"space-y-3\"><template x-for=\"(preference, index) in colorPreferences\" x-key=\"preference.id || 'temp-' + index\"><div class=\"bg-gray-50 dark:bg-dark-bg-tertiary p-4 rounded-lg border border-gray-200 dark:border-dark-border-DEFAULT\"><div class=\"flex items-start justify-between mb-3\"><div class=\"flex-1\"><div class=\"flex items-center space-x-2 mb-2\"><span class=\"text-xs font-medium text-gray-500 dark:text-gray-400\">Priority:</span> <input type=\"number\" x-model.number=\"preference.priority\" min=\"0\" max=\"100\" class=\"w-16 text-xs px-2 py-1 border-gray-300 dark:border-dark-border-DEFAULT rounded focus:ring-blue-500 focus:border-blue-500 dark:bg-dark-bg-secondary dark:text-white\"></div><div class=\"grid grid-cols-2 gap-2 mb-2\"><div><label class=\"block text-xs font-medium text-gray-700 dark:text-gray-300 mb-1\">Color</label><div class=\"flex items-center space-x-2\"><input type=\"color\" x-model=\"preference.color\" class=\"h-8 w-12 border border-gray-300 dark:border-dark-border-DEFAULT rounded cursor-pointer\"> <input type=\"text\" x-model=\"preference.color\" class=\"flex-1 text-xs px-2 py-1 border-gray-300 dark:border-dark-border-DEFAULT rounded focus:ring-blue-500 focus:border-blue-500 dark:bg-dark-bg-secondary dark:text-white\" placeholder=\"#FF5733 or red-500\"></div></div><div><label class=\"block text-xs font-medium text-gray-700 dark:text-gray-300 mb-1\">Type</label> <select x-model=\"preference.colorType\" class=\"w-full text-xs px-2 py-1 border-gray-300 dark:border-dark-border-DEFAULT rounded focus:ring-blue-500 focus:border-blue-500 dark:bg-dark-bg-secondary dark:text-white\"><option value=\"custom\">Custom Color (hex like #FF5733)</option> <option value=\"tailwind\">Tailwind Class (like red-500)</option> <option value=\"severity\">Default Severity Colors</option></select><!-- Type explanations --><div class=\"mt-1 text-xs text-gray-500 dark:text-gray-400\"><div x-show=\"preference.colorType === 'custom'\">Use hex colors like #FF5733 or named colors</div><div x-show=\"preference.colorType === 'tailwind'\">Use Tailwind classes like red-500, blue-600, amber-400</div><div x-show=\"preference.colorType === 'severity'\">Use system default colors based on severity</div></div></div></div><!-- Lightness Factor Controls (only for custom colors) --><div x-show=\"preference.colorType === 'custom'\" class=\"grid grid-cols-2 gap-2 mt-2\"><div><label class=\"block text-xs font-medium text-gray-700 dark:text-gray-300 mb-1\">Background Lightness: <span x-text=\"Math.round((preference.bgLightnessFactor || 0.9) * 100) + '%'\"></span></label> <input type=\"range\" :value=\"preference.bgLightnessFactor || 0.9\" @input=\"preference.bgLightnessFactor = parseFloat($event.target.value)\" min=\"0.1\" max=\"1.0\" step=\"0.1\" class=\"w-full h-2 bg-gray-200 rounded-lg appearance-none cursor-pointer dark:bg-gray-700\"></div><div><label class=\"block text-xs font-medium text-gray-700 dark:text-gray-300 mb-1\">Text Darkness: <span x-text=\"Math.round((preference.textDarknessFactor || 0.3) * 100) + '%'\"></span></label> <input type=\"range\" :value=\"preference.textDarknessFactor || 0.3\" @input=\"preference.textDarknessFactor = parseFloat($event.target.value)\" min=\"0.1\" max=\"1.0\" step=\"0.1\" class=\"w-full h-2 bg-gray-200 rounded-lg appearance-none cursor-pointer dark:bg-gray-700\"></div></div><!-- Color Preview --><div x-show=\"preference.color\" class=\"mt-2\"><label class=\"block text-xs font-medium text-gray-700 dark:text-gray-300 mb-1\">Preview:</label><div :style=\"getPreviewStyle(preference)\" class=\"text-center text-xs\">Sample Alert</div></div></div><button @click=\"removeColorPreference(index)\" class=\"ml-2 text-red-600 hover:text-red-800 dark:text-red-400 dark:hover:text-red-300\"><svg class=\"w-4 h-4\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M19 7l-.867 12.142A2 2 0 0116.138 21H7.862a2 2 0 01-1.995-1.858L5 7m5 4v6m4-6v6m1-10V4a1 1 0 00-1-1h-4a1 1 0 00-1 1v3M4 7h16\"></path></svg></button></div><!-- Label Conditions --><div class=\"space-y-2\"><div class=\"flex items-center justify-between\"><label class=\"text-xs font-medium text-gray-700 dark:text-gray-300\">When alert labels match:</label> <button @click=\"addLabelCondition(preference)\" class=\"text-xs text-blue-600 dark:text-blue-400 hover:text-blue-500\">+ Add Condition</button></div><div class=\"space-y-1\"><template x-for=\"(value, key) in preference.labelConditions\" x-key=\"key + '-' + value\"><div class=\"flex items-center space-x-2\"><!-- Label Key Input with Autocomplete --><div class=\"flex-1 relative\"><input type=\"text\" :value=\"key\" @input=\"debouncedUpdateLabelConditionKey(preference, key, $event.target.value)\" @focus=\"ensureAvailableLabels()\" :list=\"'label-keys-' + preference.id + '-' + key\" placeholder=\"Label name (e.g., severity)\" class=\"w-full text-xs px-2 py-1 border-gray-300 dark:border-dark-border-DEFAULT rounded focus:ring-blue-500 focus:border-blue-500 dark:bg-dark-bg-secondary dark:text-white\"> <datalist :id=\"'label-keys-' + preference.id + '-' + key\"><template x-for=\"labelKey in Object.keys(availableLabels || {})\" :key=\"labelKey\"><option :value=\"labelKey\" x-text=\"labelKey\"></option></template></datalist></div><span class=\"text-xs text-gray-500\">=</span><!-- Label Value Input with Autocomplete --><div class=\"flex-1 relative\"><input type=\"text\" x-model=\"preference.labelConditions[key]\" @focus=\"ensureAvailableLabels()\" :list=\"'label-values-' + preference.id + '-' + key\" placeholder=\"Value (e.g., critical)\" class=\"w-full text-xs px-2 py-1 border-gray-300 dark:border-dark-border-DEFAULT rounded focus:ring-blue-500 focus:border-blue-500 dark:bg-dark-bg-secondary dark:text-white\"> <datalist :id=\"'label-values-' + preference.id + '-' + key\"><template x-for=\"labelValue in (availableLabels && availableLabels[key]) ? availableLabels[key] : []\" :key=\"labelValue\"><option :value=\"labelValue\" x-text=\"labelValue\"></option></template></datalist></div><button @click=\"removeLabelCondition(preference, key)\" class=\"text-red-600 hover:text-red-800 dark:text-red-400 dark:hover:text-red-300\"><svg class=\"w-3 h-3\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M6 18L18 6M6 6l12 12\"></path></svg></button></div></template><div x-show=\"!preference.labelConditions || Object.keys(preference.labelConditions).length === 0\" class=\"text-xs text-gray-500 dark:text-gray-400 italic\">No conditions defined. This rule will match all alerts.</div></div></div></div></template><div x-show=\"colorPreferences.length === 0\" class=\"text-center py-8\"><svg class=\"mx-auto h-12 w-12 text-gray-400\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M7 21a4 4 0 01-4-4V5a2 2 0 012-2h4a2 2 0 012 2v12a4 4 0 01-4 4zM21 5a2 2 0 00-2-2h-4a2 2 0 00-2 2v12a4 4 0 004 4 4 4 0 004-4V5z\"></path></svg><h4 class=\"mt-2 text-sm font-medium text-gray-900 dark:text-white\">No color rules defined</h4><p class=\"mt-1 text-sm text-gray-500 dark:text-gray-400\">Get started by adding your first color preference rule.</p></div></div></div><!-- Hidden Alerts Tab --><div x-show=\"activeTab === 'hidden'\" class=\"space-y-6\"><div class=\"flex items-center justify-between mb-4\"><div><h4 class=\"text-sm font-medium text-gray-900 dark:text-white\">Hidden Alerts Management</h4><p class=\"text-xs text-gray-500 dark:text-gray-400 mt-1\">Manage your hidden alerts and create rules to automatically hide alerts based on labels.</p></div></div><!-- Hidden Alerts List Section --><div class=\"mb-6\"><div class=\"flex items-center justify-between mb-3\"><h5 class=\"text-sm font-medium text-gray-800 dark:text-gray-200\">Hidden Alerts</h5><button @click=\"clearAllHiddenAlerts()\" x-show=\"hiddenAlerts && hiddenAlerts.length > 0\" class=\"text-xs text-red-600 dark:text-red-400 hover:text-red-800 dark:hover:text-red-300\">Clear All</button></div><div x-show=\"hiddenAlerts && hiddenAlerts.length > 0\" class=\"space-y-2\"><template x-for=\"(alert, index) in hiddenAlerts\" :key=\"alert.fingerprint || alert.id || ('hidden-alert-' + index)\"><div class=\"flex items-center justify-between p-3 bg-gray-50 dark:bg-dark-bg-tertiary rounded-lg\"><div class=\"flex-1 min-w-0\"><p class=\"text-sm font-medium text-gray-900 dark:text-white truncate\" x-text=\"alert.alertName || 'Unknown Alert'\"></p><p class=\"text-xs text-gray-500 dark:text-gray-400 truncate\" x-text=\"alert.instance || 'N/A'\"></p><p x-show=\"alert.reason\" class=\"text-xs text-gray-500 dark:text-gray-400 mt-1\" x-text=\"'Reason: ' + alert.reason\"></p><p class=\"text-xs text-gray-400 dark:text-gray-500\" x-text=\"'Hidden: ' + new Date(alert.createdAt).toLocaleDateString()\"></p></div><button @click=\"unhideSpecificAlert(alert.fingerprint)\" class=\"ml-3 text-green-600 hover:text-green-800 dark:text-green-400 dark:hover:text-green-300\"><svg class=\"w-4 h-4\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M15 12a3 3 0 11-6 0 3 3 0 016 0z\"></path> <path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M2.458 12C3.732 7.943 7.523 5 12 5c4.478 0 8.268 2.943 9.542 7-1.274 4.057-5.064 7-9.542 7-4.477 0-8.268-2.943-9.542-7z\"></path></svg></button></div></template></div><div x-show=\"!hiddenAlerts || hiddenAlerts.length === 0\" class=\"text-center py-6\"><svg class=\"mx-auto h-8 w-8 text-gray-400\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M13.875 18.825A10.05 10.05 0 0112 19c-4.478 0-8.268-2.943-9.543-7a9.97 9.97 0 011.563-3.029m5.858.908a3 3 0 114.243 4.243M9.878 9.878l4.242 4.242M9.878 9.878L3.9 3.9m5.978 5.978L3.9 3.9m15.2 15.2l-6.078-6.078m0 0L15.1 9.1\"></path></svg><p class=\"mt-2 text-sm text-gray-500 dark:text-gray-400\">No hidden alerts</p></div></div><!-- Hidden Rules Section --><div><div class=\"flex items-center justify-between mb-3\"><h5 class=\"text-sm font-medium text-gray-800 dark:text-gray-200\">Hidden Rules</h5><button @click=\"addHiddenRule()\" class=\"inline-flex items-center px-2 py-1 text-xs font-medium rounded text-white bg-blue-600 hover:bg-blue-700 focus:outline-none focus:ring-2 focus:ring-offset-2 focus:ring-blue-500\"><svg class=\"w-3 h-3 mr-1\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M12 4v16m8-8H4\"></path></svg> Add Rule</button></div><div x-show=\"hiddenRules && hiddenRules.length > 0\" class=\"space-y-2\"><template x-for=\"(rule, index) in hiddenRules\" :key=\"rule.id || index\"><div class=\"flex items-center justify-between p-3 bg-gray-50 dark:bg-dark-bg-tertiary rounded-lg\"><div class=\"flex-1 min-w-0\"><p class=\"text-sm font-medium text-gray-900 dark:text-white\" x-text=\"rule.name || 'Unnamed Rule'\"></p><p class=\"text-xs text-gray-500 dark:text-gray-400\" x-text=\"rule.labelKey + ' = ' + (rule.labelValue || '*')\"></p><p x-show=\"rule.description\" class=\"text-xs text-gray-500 dark:text-gray-400 mt-1\" x-text=\"rule.description\"></p></div><div class=\"flex items-center ml-3\"><button @click=\"removeHiddenRule(rule.id)\" class=\"text-red-600 hover:text-red-800 dark:text-red-400 dark:hover:text-red-300\" title=\"Delete Rule\"><svg class=\"w-4 h-4\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M19 7l-.867 12.142A2 2 0 0116.138 21H7.862a2 2 0 01-1.995-1.858L5 7m5 4v6m4-6v6m1-10V4a1 1 0 00-1-1h-4a1 1 0 00-1 1v3M4 7h16\"></path></svg></button></div></div></template></div><div x-show=\"!hiddenRules || hiddenRules.length === 0\" class=\"text-center py-6\"><svg class=\"mx-auto h-8 w-8 text-gray-400\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M12 6V4m0 2a2 2 0 100 4m0-4a2 2 0 110 4m-6 8a2 2 0 100-4m0 4a2 2 0 100 4m0-4v2m0-6V4m6 6v10m6-2a2 2 0 100-4m0 4a2 2 0 100 4m0-4v2m0-6V4\"></path></svg><p class=\"mt-2 text-sm text-gray-500 dark:text-gray-400\">No hidden rules defined</p><p class=\"text-xs text-gray-400 dark:text-gray-500\">Rules automatically hide alerts based on labels</p></div></div></div><!-- Sentry Integration Tab --><div x-show=\"activeTab === 'sentry'\" class=\"space-y-6\"><div><h4 class=\"text-sm font-medium text-gray-900 dark:text-white\">Sentry Integration</h4><p class=\"text-xs text-gray-500 dark:text-gray-400 mt-1\">Configure your Sentry personal access token to view metrics and issues in alert details.</p></div><!-- Sentry Instance Info --><div class=\"bg-blue-50 dark:bg-blue-900/20 p-3 rounded-lg\"><div class=\"flex items-center\"><svg class=\"w-5 h-5 text-blue-600 dark:text-blue-400 mr-2\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M13.828 10.172a4 4 0 00-5.656 0l-4 4a4 4 0 105.656 5.656l1.102-1.101m-.758-4.899a4 4 0 005.656 0l4-4a4 4 0 00-5.656-5.656l-1.1 1.1\"></path></svg><div><p class=\"text-sm font-medium text-blue-800 dark:text-blue-200\">Sentry Instance: https://your-sentry-instance.com</p></div></div></div><!-- Token Configuration --><div class=\"space-y-4\"><div><label class=\"block text-sm font-medium text-gray-700 dark:text-gray-300 mb-2\">Personal Access Token</label><div class=\"flex space-x-2\"><input type=\"password\" x-model=\"sentryForm.token\" placeholder=\"Enter your Sentry personal access token\" class=\"flex-1 border-gray-300 dark:border-dark-border-DEFAULT rounded-md shadow-sm focus:ring-blue-500 focus:border-blue-500 dark:bg-dark-bg-tertiary dark:text-white\"> <button @click=\"testSentryConnection()\" :disabled=\"!sentryForm.token.trim() || sentryConfig.connectionTesting\" class=\"px-3 py-2 bg-green-600 text-white rounded-md hover:bg-green-700 disabled:opacity-50 disabled:cursor-not-allowed flex items-center space-x-1\" title=\"Test connection with this token before saving\"><svg x-show=\"!sentryConfig.connectionTesting\" class=\"w-4 h-4\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M9 12l2 2 4-4m6 2a9 9 0 11-18 0 9 9 0 0118 0z\"></path></svg> <svg x-show=\"sentryConfig.connectionTesting\" class=\"w-4 h-4 animate-spin\" fill=\"none\" viewBox=\"0 0 24 24\"><circle class=\"opacity-25\" cx=\"12\" cy=\"12\" r=\"10\" stroke=\"currentColor\" stroke-width=\"4\"></circle> <path class=\"opacity-75\" fill=\"currentColor\" d=\"M4 12a8 8 0 018-8V0C5.373 0 0 5.373 0 12h4zm2 5.291A7.962 7.962 0 014 12H0c0 3.042 1.135 5.824 3 7.938l3-2.647z\"></path></svg> <span x-show=\"!sentryConfig.connectionTesting\">Test</span> <span x-show=\"sentryConfig.connectionTesting\">Testing...</span></button> <button @click=\"saveSentryToken()\" :disabled=\"!sentryForm.token.trim() || sentrySaving\" class=\"px-3 py-2 bg-blue-600 text-white rounded-md hover:bg-blue-700 disabled:opacity-50 disabled:cursor-not-allowed flex items-center space-x-1\" title=\"Save this token to your account\"><svg x-show=\"!sentrySaving\" class=\"w-4 h-4\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M8 7H5a2 2 0 00-2 2v9a2 2 0 002 2h14a2 2 0 002-2V9a2 2 0 00-2-2h-3m-1 4l-3-3m0 0l-3 3m3-3v12\"></path></svg> <svg x-show=\"sentrySaving\" class=\"w-4 h-4 animate-spin\" fill=\"none\" viewBox=\"0 0 24 24\"><circle class=\"opacity-25\" cx=\"12\" cy=\"12\" r=\"10\" stroke=\"currentColor\" stroke-width=\"4\"></circle> <path class=\"opacity-75\" fill=\"currentColor\" d=\"M4 12a8 8 0 018-8V0C5.373 0 0 5.373 0 12h4zm2 5.291A7.962 7.962 0 014 12H0c0 3.042 1.135 5.824 3 7.938l3-2.647z\"></path></svg> <span x-show=\"!sentrySaving\">Save</span> <span x-show=\"sentrySaving\">Saving...</span></button></div><div x-show=\"sentryConfig.hasToken\" class=\"mt-2\"><p class=\"text-xs text-green-600 dark:text-green-400 flex items-center\"><svg class=\"w-4 h-4 mr-1\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M5 13l4 4L19 7\"></path></svg> Token configured</p><button @click=\"removeSentryToken()\" class=\"text-xs text-red-600 hover:text-red-800 dark:text-red-400 mt-1\">Remove token</button></div><div x-show=\"sentryConfig.testResult\" class=\"mt-2\"><p x-show=\"sentryConfig.testResult && sentryConfig.testResult.success\" class=\"text-xs text-green-600 dark:text-green-400\" x-text=\"sentryConfig.testResult ? sentryConfig.testResult.message : ''\"></p><p x-show=\"sentryConfig.testResult && !sentryConfig.testResult.success\" class=\"text-xs text-red-600 dark:text-red-400\" x-text=\"sentryConfig.testResult ? sentryConfig.testResult.message : ''\"></p></div></div><!-- Help Section --><div class=\"bg-gray-50 dark:bg-gray-800/50 p-4 rounded-lg\"><h5 class=\"text-sm font-medium text-gray-900 dark:text-white mb-2\">How to get your Sentry token:</h5><ol class=\"text-sm text-gray-700 dark:text-gray-300 space-y-1 list-decimal list-inside\"><li>Go to <strong>Sentry Settings → Account → Auth Tokens</strong></li><li>Click <strong>\"Create New Token\"</strong></li><li>Name: \"Notificator Integration\"</li><li>Select scopes: <code class=\"bg-gray-200 dark:bg-gray-700 px-1 rounded text-xs\">project:read</code>, <code class=\"bg-gray-200 dark:bg-gray-700 px-1 rounded text-xs\">event:read</code>, <code class=\"bg-gray-200 dark:bg-gray-700 px-1 rounded text-xs\">org:read</code></li><li>Copy the generated token and paste it above</li></ol><div class=\"mt-4 p-3 bg-blue-50 dark:bg-blue-900/20 border border-blue-200 dark:border-blue-800 rounded-md\"><p class=\"text-xs text-blue-700 dark:text-blue-300\"><strong>Note:</strong> The integration displays project issues, events, and basic statistics using Sentry's documented API endpoints.  Some advanced metrics may not be available depending on your Sentry instance and plan.</p></div><a href=\"https://your-sentry-instance.com/settings/account/api/auth-tokens/\" target=\"_blank\" class=\"inline-flex items-center mt-2 text-sm text-blue-600 hover:text-blue-500 dark:text-blue-400\">Open Sentry Auth Tokens <svg class=\"w-4 h-4 ml-1\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M10 6H6a2 2 0 00-2 2v10a2 2 0 002 2h10a2 2 0 002-2v-4M14 4h6m0 0v6m0-6L10 14\"></path></svg></a></div></div></div>")
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 1, "<div x-show=\"showSettings\" x-data=\"settingsModalData()\" class=\"fixed inset-0 z-50 overflow-y-auto\" x-transition style=\"display: none;\"><div class=\"flex items-end justify-center min-h-screen pt-4 px-4 pb-20 text-center sm:block sm:p-0\"><div class=\"fixed inset-0 bg-gray-500/75 dark:bg-black/60 backdrop-blur-sm transition-opacity z-0\" @click=\"showSettings = false\"></div><div class=\"inline-block align-bottom bg-white dark:bg-dark-bg-secondary rounded-xl text-left overflow-hidden shadow-2xl transform transition-all sm:my-8 sm:align-middle sm:max-w-4xl sm:w-full max-h-[90vh] relative z-10 border border-gray-200/50 dark:border-dark-border-subtle/50\"><!-- Header with close button --><div class=\"flex items-center justify-between px-6 py-4 border-b border-gray-200 dark:border-dark-border-subtle bg-gradient-to-r from-gray-50 to-white dark:from-dark-bg-secondary dark:to-dark-bg-tertiary\"><h3 class=\"text-lg font-semibold text-gray-900 dark:text-white\">Dashboard Settings</h3><button @click=\"showSettings = false\" class=\"p-2 rounded-lg hover:bg-gray-100 dark:hover:bg-dark-bg-tertiary transition-colors group\"><svg class=\"w-5 h-5 text-gray-400 group-hover:text-gray-600 dark:group-hover:text-gray-300\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M6 18L18 6M6 6l12 12\"></path></svg></button></div><div class=\"px-6 py-4\"><div class=\"w-full\"><!-- Tab Navigation --><div class=\"mb-6\"><nav class=\"flex space-x-1 p-1 bg-gray-100 dark:bg-dark-bg-tertiary rounded-lg overflow-x-auto\"><button @click=\"activeTab = 'general'\" :class=\"activeTab === 'general' ? 'bg-white dark:bg-dark-bg-secondary text-blue-600 dark:text-blue-400 shadow-sm' : 'text-gray-600 dark:text-gray-400 hover:text-gray-900 dark:hover:text-white hover:bg-white/50 dark:hover:bg-dark-bg-secondary/50'\" class=\"whitespace-nowrap px-4 py-2 rounded-md font-medium text-sm transition-all duration-200\">General</button> <button @click=\"activeTab = 'colors'\" :class=\"activeTab === 'colors' ? 'bg-white dark:bg-dark-bg-secondary text-blue-600 dark:text-blue-400 shadow-sm' : 'text-gray-600 dark:text-gray-400 hover:text-gray-900 dark:hover:text-white hover:bg-white/50 dark:hover:bg-dark-bg-secondary/50'\" class=\"whitespace-nowrap px-4 py-2 rounded-md font-medium text-sm transition-all duration-200\">Colors</button> <button @click=\"setActiveTab('hidden')\" :class=\"activeTab === 'hidden' ? 'bg-white dark:bg-dark-bg-secondary text-blue-600 dark:text-blue-400 shadow-sm' : 'text-gray-600 dark:text-gray-400 hover:text-gray-900 dark:hover:text-white hover:bg-white/50 dark:hover:bg-dark-bg-secondary/50'\" class=\"whitespace-nowrap px-4 py-2 rounded-md font-medium text-sm transition-all duration-200\">Hidden <span x-show=\"hiddenAlerts && hiddenAlerts.length > 0\" class=\"ml-1 inline-flex items-center justify-center px-1.5 py-0.5 text-xs rounded-full bg-gray-200 dark:bg-dark-bg-tertiary text-gray-700 dark:text-gray-300\" x-text=\"hiddenAlerts.length\"></span></button> <button @click=\"activeTab = 'sentry'\" :class=\"activeTab === 'sentry' ? 'bg-white dark:bg-dark-bg-secondary text-blue-600 dark:text-blue-400 shadow-sm' : 'text-gray-600 dark:text-gray-400 hover:text-gray-900 dark:hover:text-white hover:bg-white/50 dark:hover:bg-dark-bg-secondary/50'\" class=\"whitespace-nowrap px-4 py-2 rounded-md font-medium text-sm transition-all duration-200\">Sentry</button> <button @click=\"activeTab = 'notifications'\" :class=\"activeTab === 'notifications' ? 'bg-white dark:bg-dark-bg-secondary text-blue-600 dark:text-blue-400 shadow-sm' : 'text-gray-600 dark:text-gray-400 hover:text-gray-900 dark:hover:text-white hover:bg-white/50 dark:hover:bg-dark-bg-secondary/50'\" class=\"whitespace-nowrap px-4 py-2 rounded-md font-medium text-sm transition-all duration-200\">Notifications</button> <button @click=\"setActiveTab('annotation-buttons')\" :class=\"activeTab === 'annotation-buttons' ? 'bg-white dark:bg-dark-bg-secondary text-blue-600 dark:text-blue-400 shadow-sm' : 'text-gray-600 dark:text-gray-400 hover:text-gray-900 dark:hover:text-white hover:bg-white/50 dark:hover:bg-dark-bg-secondary/50'\" class=\"whitespace-nowrap px-4 py-2 rounded-md font-medium text-sm transition-all duration-200\">Buttons</button></nav></div><!-- Tab Content --><div class=\"max-h-96 overflow-y-auto\"><!-- General Settings Tab --><div x-show=\"activeTab === 'general'\" class=\"space-y-6\"><!-- Theme --><div><label class=\"text-sm font-medium text-gray-700 dark:text-gray-300\">Theme</label><div class=\"mt-2 space-x-4\"><label for=\"settings-theme-light\" class=\"inline-flex items-center\"><input type=\"radio\" id=\"settings-theme-light\" name=\"settings-theme\" x-model=\"settings.theme\" value=\"light\" class=\"form-radio text-blue-600\"> <span class=\"ml-2 text-sm text-gray-700 dark:text-gray-300\">Light</span></label> <label for=\"settings-theme-dark\" class=\"inline-flex items-center\"><input type=\"radio\" id=\"settings-theme-dark\" name=\"settings-theme\" x-model=\"settings.theme\" value=\"dark\" class=\"form-radio text-blue-600\"> <span class=\"ml-2 text-sm text-gray-700 dark:text-gray-300\">Dark</span></label> <label for=\"settings-theme-auto\" class=\"inline-flex items-center\"><input type=\"radio\" id=\"settings-theme-auto\" name=\"settings-theme\" x-model=\"settings.theme\" value=\"auto\" class=\"form-radio text-blue-600\"> <span class=\"ml-2 text-sm text-gray-700 dark:text-gray-300\">Follow system</span></label></div></div><!-- Resolved Alerts Display Limit --><div><label for=\"settings-resolved-limit\" class=\"block text-sm font-medium text-gray-700 dark:text-gray-300\">Resolved Alerts Display Limit</label><div class=\"mt-1\"><input type=\"number\" id=\"settings-resolved-limit\" name=\"settings-resolved-limit\" x-model=\"settings.resolvedAlertsLimit\" min=\"10\" max=\"1000\" class=\"block w-full border-gray-300 dark:border-dark-border-DEFAULT rounded-md shadow-sm focus:ring-blue-500 focus:border-blue-500 dark:bg-dark-bg-tertiary dark:text-white\"></div><p class=\"text-xs text-gray-500 dark:text-gray-400 mt-1\">Maximum number of resolved alerts to display in the dashboard (stored locally)</p></div><!-- Refresh Interval --><div><label for=\"settings-refresh-interval\" class=\"block text-sm font-medium text-gray-700 dark:text-gray-300\">Refresh Interval (seconds)</label><div class=\"mt-1\"><select id=\"settings-refresh-interval\" name=\"settings-refresh-interval\" x-model=\"settings.refreshInterval\" class=\"block w-full border-gray-300 dark:border-dark-border-DEFAULT rounded-md shadow-sm focus:ring-blue-500 focus:border-blue-500 dark:bg-dark-bg-tertiary dark:text-white\"><option value=\"5\">5 seconds</option> <option value=\"10\">10 seconds</option> <option value=\"30\">30 seconds</option> <option value=\"60\">1 minute</option></select></div></div><!-- Adaptive Polling Bounds --><div><label for=\"settings-adaptive-min-interval\" class=\"block text-sm font-medium text-gray-700 dark:text-gray-300\">Polling Bounds</label><div class=\"mt-1 flex items-center space-x-3\"><input type=\"number\" id=\"settings-adaptive-min-interval\" name=\"settings-adaptive-min-interval\" x-model.number=\"settings.adaptiveMinInterval\" min=\"1\" max=\"60\" class=\"block w-24 border-gray-300 dark:border-dark-border-DEFAULT rounded-md shadow-sm focus:ring-blue-500 focus:border-blue-500 dark:bg-dark-bg-tertiary dark:text-white\"> <span class=\"text-sm text-gray-500 dark:text-gray-400\">to</span> <input type=\"number\" id=\"settings-adaptive-max-interval\" name=\"settings-adaptive-max-interval\" x-model.number=\"settings.adaptiveMaxInterval\" min=\"5\" max=\"600\" class=\"block w-24 border-gray-300 dark:border-dark-border-DEFAULT rounded-md shadow-sm focus:ring-blue-500 focus:border-blue-500 dark:bg-dark-bg-tertiary dark:text-white\"> <span class=\"text-sm text-gray-500 dark:text-gray-400\">seconds</span></div><p class=\"text-xs text-gray-500 dark:text-gray-400 mt-1\">When live updates are unavailable, polling speeds up to the minimum as critical alerts appear and slows to the maximum while alerts are stable and you are idle</p></div><!-- New Alert Highlight --><div><label for=\"settings-new-alert-highlight\" class=\"block text-sm font-medium text-gray-700 dark:text-gray-300\">New Alert Highlight</label><div class=\"mt-1 flex items-center space-x-3\"><input type=\"number\" id=\"settings-new-alert-highlight\" name=\"settings-new-alert-highlight\" x-model.number=\"settings.newAlertHighlightSeconds\" min=\"0\" max=\"3600\" class=\"block w-28 border-gray-300 dark:border-dark-border-DEFAULT rounded-md shadow-sm focus:ring-blue-500 focus:border-blue-500 dark:bg-dark-bg-tertiary dark:text-white\"> <span class=\"text-sm text-gray-500 dark:text-gray-400\">seconds</span> <select id=\"settings-new-alert-highlight-style\" name=\"settings-new-alert-highlight-style\" x-model=\"settings.newAlertHighlightStyle\" class=\"block border-gray-300 dark:border-dark-border-DEFAULT rounded-md shadow-sm focus:ring-blue-500 focus:border-blue-500 dark:bg-dark-bg-tertiary dark:text-white\"><option value=\"border\">Left border</option> <option value=\"background\">Background</option> <option value=\"none\">None</option></select></div><p class=\"text-xs text-gray-500 dark:text-gray-400 mt-1\">How long newly appeared alerts stay highlighted in the table (0 disables it)</p></div><!-- On-Call Schedule --><div class=\"border-t border-gray-200 dark:border-gray-700 pt-4\"><label class=\"block text-sm font-medium text-gray-700 dark:text-gray-300 mb-2\">On-Call Schedule</label><p class=\"text-xs text-gray-500 dark:text-gray-400 mb-3\">Configure your on-call hours for quick filtering in Statistics.</p><div class=\"space-y-3\"><!-- Weekday Hours --><div class=\"flex items-center space-x-3\"><label for=\"settings-oncall-start\" class=\"text-sm text-gray-600 dark:text-gray-400 w-28\">Weekday hours:</label> <input type=\"time\" id=\"settings-oncall-start\" name=\"settings-oncall-start\" x-model=\"settings.onCallSchedule.weekdayStart\" class=\"px-2 py-1 text-sm border-gray-300 dark:border-dark-border-DEFAULT rounded-md shadow-sm focus:ring-blue-500 focus:border-blue-500 dark:bg-dark-bg-tertiary dark:text-white\"> <span class=\"text-sm text-gray-500 dark:text-gray-400\">to</span> <input type=\"time\" id=\"settings-oncall-end\" name=\"settings-oncall-end\" x-model=\"settings.onCallSchedule.weekdayEnd\" class=\"px-2 py-1 text-sm border-gray-300 dark:border-dark-border-DEFAULT rounded-md shadow-sm focus:ring-blue-500 focus:border-blue-500 dark:bg-dark-bg-tertiary dark:text-white\"></div><!-- Weekend Toggle --><label for=\"settings-oncall-weekends\" class=\"flex items-center cursor-pointer\"><input type=\"checkbox\" id=\"settings-oncall-weekends\" name=\"settings-oncall-weekends\" x-model=\"settings.onCallSchedule.includeWeekends\" class=\"h-4 w-4 text-blue-600 focus:ring-blue-500 border-gray-300 rounded\"> <span class=\"ml-2 text-sm text-gray-700 dark:text-gray-300\">Include full weekends as on-call</span></label></div><p class=\"text-xs text-gray-500 dark:text-gray-400 mt-2\">Default: 18:00 - 08:00 weekdays + full weekends</p></div><!-- Preferences Backup --><div class=\"border-t border-gray-200 dark:border-gray-700 pt-4\"><label class=\"block text-sm font-medium text-gray-700 dark:text-gray-300 mb-2\">Preferences Backup</label><p class=\"text-xs text-gray-500 dark:text-gray-400 mb-3\">Save your color rules, filter presets, annotation buttons, hidden rules and notification settings to a file, or load them from one. Entries that already exist are overwritten.</p><div class=\"flex items-center space-x-3\"><a href=\"/api/v1/dashboard/preferences/export\" class=\"inline-flex items-center px-3 py-1.5 border border-gray-300 dark:border-dark-border-DEFAULT text-xs font-medium rounded text-gray-700 dark:text-gray-200 bg-white dark:bg-dark-bg-tertiary hover:bg-gray-50 dark:hover:bg-dark-bg-secondary focus:outline-none focus:ring-2 focus:ring-offset-2 focus:ring-blue-500\">Export to file</a> <label class=\"inline-flex items-center px-3 py-1.5 border border-gray-300 dark:border-dark-border-DEFAULT text-xs font-medium rounded text-gray-700 dark:text-gray-200 bg-white dark:bg-dark-bg-tertiary hover:bg-gray-50 dark:hover:bg-dark-bg-secondary cursor-pointer\" :class=\"preferencesImporting ? 'opacity-50 pointer-events-none' : ''\"><input type=\"file\" accept=\"application/json,.json\" class=\"hidden\" @change=\"importPreferencesBundle($event)\"> <span x-text=\"preferencesImporting ? 'Importing...' : 'Import from file'\"></span></label></div><p x-show=\"preferencesImportMessage\" x-text=\"preferencesImportMessage\" :class=\"preferencesImportFailed ? 'text-red-600 dark:text-red-400' : 'text-green-600 dark:text-green-400'\" class=\"text-xs mt-2\"></p></div><!-- Remove All Resolved Alerts (admin only) --><div x-data=\"{ canAdmin: false }\" x-init=\"if (window.impersonationState?.initialized) { canAdmin = window.impersonationState.isAdmin } else { window.addEventListener('impersonationStateReady', () => { canAdmin = window.impersonationState.isAdmin }, { once: true }) }\"><template x-if=\"canAdmin\"><div><label class=\"block text-sm font-medium text-gray-700 dark:text-gray-300 mb-2\">Alert Management</label><div class=\"flex items-center space-x-3\"><button @click=\"confirmRemoveResolvedAlerts()\" :disabled=\"isRemovingResolvedAlerts\" class=\"px-4 py-2 text-sm font-medium text-white bg-red-600 border border-transparent rounded-md shadow-sm hover:bg-red-700 focus:outline-none focus:ring-2 focus:ring-offset-2 focus:ring-red-500 disabled:opacity-50 disabled:cursor-not-allowed dark:focus:ring-offset-dark-bg-primary\"><span x-show=\"!isRemovingResolvedAlerts\">🗑️ Remove All Resolved Alerts</span> <span x-show=\"isRemovingResolvedAlerts\" class=\"flex items-center\"><svg class=\"animate-spin -ml-1 mr-2 h-4 w-4 text-white\" xmlns=\"http://www.w3.org/2000/svg\" fill=\"none\" viewBox=\"0 0 24 24\"><circle class=\"opacity-25\" cx=\"12\" cy=\"12\" r=\"10\" stroke=\"currentColor\" stroke-width=\"4\"></circle> <path class=\"opacity-75\" fill=\"currentColor\" d=\"M4 12a8 8 0 018-8V0C5.373 0 0 5.373 0 12h4zm2 5.291A7.962 7.962 0 014 12H0c0 3.042 1.135 5.824 3 7.938l3-2.647z\"></path></svg> Removing...</span></button></div><p class=\"text-xs text-gray-500 dark:text-gray-400 mt-1\">Removes all resolved alerts from the backend storage. It can be undone for a few seconds, then it is permanent.</p><label for=\"settings-resolved-ttl\" class=\"block text-sm font-medium text-gray-700 dark:text-gray-300 mt-4 mb-2\">Keep resolved alerts for</label><div class=\"flex items-center space-x-2\"><input id=\"settings-resolved-ttl\" type=\"number\" min=\"1\" x-model.number=\"resolvedTtl.value\" class=\"w-24 px-3 py-2 text-sm border border-gray-300 dark:border-gray-600 rounded-md bg-white dark:bg-dark-bg-tertiary text-gray-900 dark:text-white focus:outline-none focus:ring-2 focus:ring-blue-500\"> <select x-model=\"resolvedTtl.unit\" class=\"px-3 py-2 text-sm border border-gray-300 dark:border-gray-600 rounded-md bg-white dark:bg-dark-bg-tertiary text-gray-900 dark:text-white focus:outline-none focus:ring-2 focus:ring-blue-500\"><option value=\"hours\">hours</option> <option value=\"days\">days</option></select> <button @click=\"saveResolvedAlertTTL()\" :disabled=\"resolvedTtl.saving\" class=\"px-3 py-2 text-sm font-medium text-white bg-blue-600 rounded-md hover:bg-blue-700 disabled:opacity-50\">Save</button></div><p class=\"text-xs text-gray-500 dark:text-gray-400 mt-1\">Applies to alerts resolved from now on; stored ones keep their expiry, shown in the resolved alerts archive.</p><p x-show=\"resolvedTtl.message\" x-text=\"resolvedTtl.message\" :class=\"resolvedTtl.failed ? 'text-red-600 dark:text-red-400' : 'text-green-600 dark:text-green-400'\" class=\"text-xs mt-1\"></p></div></template></div></div><!-- Color Preferences Tab --><div x-show=\"activeTab === 'colors'\" class=\"space-y-6\"><div class=\"flex items-center justify-between mb-4\"><div><h4 class=\"text-sm font-medium text-gray-900 dark:text-white\">Alert Color Rules</h4><p class=\"text-xs text-gray-500 dark:text-gray-400 mt-1\">Define custom colors for alerts based on their labels. Higher priority rules override lower ones.</p></div><button @click=\"addColorPreference()\" class=\"inline-flex items-center px-3 py-1.5 border border-transparent text-xs font-medium rounded text-white bg-blue-600 hover:bg-blue-700 focus:outline-none focus:ring-2 focus:ring-offset-2 focus:ring-blue-500\"><svg class=\"w-3 h-3 mr-1\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M12 4v16m8-8H4\"></path></svg> Add Rule</button></div><!-- Color Preferences List --><div class=\"space-y-3\"><template x-for=\"(preference, index) in colorPreferences\" x-key=\"preference.id || 'temp-' + index\"><div class=\"bg-gray-50 dark:bg-dark-bg-tertiary p-4 rounded-lg border border-gray-200 dark:border-dark-border-DEFAULT\"><div class=\"flex items-start justify-between mb-3\"><div class=\"flex-1\"><div class=\"flex items-center space-x-2 mb-2\"><span class=\"text-xs font-medium text-gray-500 dark:text-gray-400\">Priority:</span> <input type=\"number\" x-model.number=\"preference.priority\" min=\"1\" max=\"100\" class=\"w-16 text-xs px-2 py-1 border-gray-300 dark:border-dark-border-DEFAULT rounded focus:ring-blue-500 focus:border-blue-500 dark:bg-dark-bg-secondary dark:text-white\"></div><div class=\"grid grid-cols-2 gap-2 mb-2\"><div><label class=\"block text-xs font-medium text-gray-700 dark:text-gray-300 mb-1\">Color</label><div class=\"flex items-center space-x-2\"><input type=\"color\" x-model=\"preference.color\" class=\"h-8 w-12 border border-gray-300 dark:border-dark-border-DEFAULT rounded cursor-pointer\"> <input type=\"text\" x-model=\"preference.color\" class=\"flex-1 text-xs px-2 py-1 border-gray-300 dark:border-dark-border-DEFAULT rounded focus:ring-blue-500 focus:border-blue-500 dark:bg-dark-bg-secondary dark:text-white\" placeholder=\"#FF5733 or red-500\"></div></div><div><label class=\"block text-xs font-medium text-gray-700 dark:text-gray-300 mb-1\">Type</label> <select x-model=\"preference.colorType\" class=\"w-full text-xs px-2 py-1 border-gray-300 dark:border-dark-border-DEFAULT rounded focus:ring-blue-500 focus:border-blue-500 dark:bg-dark-bg-secondary dark:text-white\"><option value=\"custom\">Custom Color (hex like #FF5733)</option> <option value=\"tailwind\">Tailwind Class (like red-500)</option> <option value=\"severity\">Default Severity Colors</option></select><!-- Type explanations --><div class=\"mt-1 text-xs text-gray-500 dark:text-gray-400\"><div x-show=\"preference.colorType === 'custom'\">Use hex colors like #FF5733</div><div x-show=\"preference.colorType === 'tailwind'\">Use Tailwind classes like red-500, blue-600, amber-400</div><div x-show=\"preference.colorType === 'severity'\">Use system default colors based on severity</div></div></div></div><!-- Lightness Factor Controls (only for custom colors) --><div x-show=\"preference.colorType === 'custom'\" class=\"grid grid-cols-2 gap-2 mt-2\"><div><label class=\"block text-xs font-medium text-gray-700 dark:text-gray-300 mb-1\">Background Lightness: <span x-text=\"Math.round((preference.bgLightnessFactor || 0.9) * 100) + '%'\"></span></label> <input type=\"range\" :value=\"preference.bgLightnessFactor || 0.9\" @input=\"preference.bgLightnessFactor = parseFloat($event.target.value)\" min=\"0.1\" max=\"1.0\" step=\"0.1\" class=\"w-full h-2 bg-gray-200 rounded-lg appearance-none cursor-pointer dark:bg-gray-700\"></div><div><label class=\"block text-xs font-medium text-gray-700 dark:text-gray-300 mb-1\">Text Darkness: <span x-text=\"Math.round((preference.textDarknessFactor || 0.3) * 100) + '%'\"></span></label> <input type=\"range\" :value=\"preference.textDarknessFactor || 0.3\" @input=\"preference.textDarknessFactor = parseFloat($event.target.value)\" min=\"0.1\" max=\"1.0\" step=\"0.1\" class=\"w-full h-2 bg-gray-200 rounded-lg appearance-none cursor-pointer dark:bg-gray-700\"></div></div><!-- Color Preview --><div x-show=\"preference.color\" class=\"mt-2\"><label class=\"block text-xs font-medium text-gray-700 dark:text-gray-300 mb-1\">Preview:</label><div :style=\"getPreviewStyle(preference)\" class=\"text-center text-xs\">Sample Alert</div></div></div><button @click=\"removeColorPreference(index)\" class=\"ml-2 text-red-600 hover:text-red-800 dark:text-red-400 dark:hover:text-red-300\"><svg class=\"w-4 h-4\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M19 7l-.867 12.142A2 2 0 0116.138 21H7.862a2 2 0 01-1.995-1.858L5 7m5 4v6m4-6v6m1-10V4a1 1 0 00-1-1h-4a1 1 0 00-1 1v3M4 7h16\"></path></svg></button></div><!-- Label Conditions --><div class=\"space-y-2\"><div class=\"flex items-center justify-between\"><label class=\"text-xs font-medium text-gray-700 dark:text-gray-300\">When alert labels match:</label> <button @click=\"addLabelCondition(preference)\" class=\"text-xs text-blue-600 dark:text-blue-400 hover:text-blue-500\">+ Add Condition</button></div><div class=\"space-y-1\"><template x-for=\"(value, key) in preference.labelConditions\" x-key=\"key + '-' + value\"><div class=\"flex items-center space-x-2\"><!-- Label Key Input with Autocomplete --><div class=\"flex-1 relative\"><input type=\"text\" :value=\"key\" @input=\"debouncedUpdateLabelConditionKey(preference, key, $event.target.value)\" @focus=\"ensureAvailableLabels()\" :list=\"'label-keys-' + preference.id + '-' + key\" placeholder=\"Label name (e.g., severity)\" class=\"w-full text-xs px-2 py-1 border-gray-300 dark:border-dark-border-DEFAULT rounded focus:ring-blue-500 focus:border-blue-500 dark:bg-dark-bg-secondary dark:text-white\"> <datalist :id=\"'label-keys-' + preference.id + '-' + key\"><template x-for=\"labelKey in Object.keys(availableLabels || {})\" :key=\"labelKey\"><option :value=\"labelKey\" x-text=\"labelKey\"></option></template></datalist></div><span class=\"text-xs text-gray-500\">=</span><!-- Label Value Input with Autocomplete --><div class=\"flex-1 relative\"><input type=\"text\" x-model=\"preference.labelConditions[key]\" @focus=\"ensureAvailableLabels()\" :list=\"'label-values-' + preference.id + '-' + key\" placeholder=\"Value (e.g., critical)\" class=\"w-full text-xs px-2 py-1 border-gray-300 dark:border-dark-border-DEFAULT rounded focus:ring-blue-500 focus:border-blue-500 dark:bg-dark-bg-secondary dark:text-white\"> <datalist :id=\"'label-values-' + preference.id + '-' + key\"><template x-for=\"labelValue in (availableLabels && availableLabels[key]) ? availableLabels[key] : []\" :key=\"labelValue\"><option :value=\"labelValue\" x-text=\"labelValue\"></option></template></datalist></div><button @click=\"removeLabelCondition(preference, key)\" class=\"text-red-600 hover:text-red-800 dark:text-red-400 dark:hover:text-red-300\"><svg class=\"w-3 h-3\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M6 18L18 6M6 6l12 12\"></path></svg></button></div></template><div x-show=\"!preference.labelConditions || Object.keys(preference.labelConditions).length === 0\" class=\"text-xs text-gray-500 dark:text-gray-400 italic\">No conditions defined. This rule will match all alerts.</div></div></div></div></template><div x-show=\"colorPreferences.length === 0\" class=\"text-center py-8\"><svg class=\"mx-auto h-12 w-12 text-gray-400\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M7 21a4 4 0 01-4-4V5a2 2 0 012-2h4a2 2 0 012 2v12a4 4 0 01-4 4zM21 5a2 2 0 00-2-2h-4a2 2 0 00-2 2v12a4 4 0 004 4 4 4 0 004-4V5z\"></path></svg><h4 class=\"mt-2 text-sm font-medium text-gray-900 dark:text-white\">No color rules defined</h4><p class=\"mt-1 text-sm text-gray-500 dark:text-gray-400\">Get started by adding your first color preference rule.</p></div></div></div><!-- Hidden Alerts Tab --><div x-show=\"activeTab === 'hidden'\" class=\"space-y-6\"><div class=\"flex items-center justify-between mb-4\"><div><h4 class=\"text-sm font-medium text-gray-900 dark:text-white\">Hidden Alerts Management</h4><p class=\"text-xs text-gray-500 dark:text-gray-400 mt-1\">Manage your hidden alerts and create rules to automatically hide alerts based on labels.</p></div></div><!-- Hidden Alerts List Section --><div class=\"mb-6\"><div class=\"flex items-center justify-between mb-3\"><div class=\"flex items-center space-x-2\"><input type=\"checkbox\" x-show=\"hiddenAlerts && hiddenAlerts.length > 0\" :checked=\"allHiddenAlertsSelected()\" @change=\"toggleAllHiddenAlerts()\" title=\"Select all\" class=\"rounded text-blue-600\"><h5 class=\"text-sm font-medium text-gray-800 dark:text-gray-200\">Hidden Alerts <span class=\"text-gray-500 dark:text-gray-400\" x-text=\"'(' + (hiddenAlerts ? hiddenAlerts.length : 0) + ')'\"></span></h5></div><div class=\"flex items-center space-x-3\" x-show=\"hiddenAlerts && hiddenAlerts.length > 0\"><button @click=\"unhideSelectedHiddenAlerts()\" x-show=\"selectedHiddenFingerprints.length > 0\" :disabled=\"hiddenAlertsUnhiding\" class=\"text-xs text-green-600 dark:text-green-400 hover:text-green-800 dark:hover:text-green-300 disabled:opacity-50\" x-text=\"'Unhide ' + selectedHiddenFingerprints.length + ' selected'\"></button> <button @click=\"clearAllHiddenAlerts()\" class=\"text-xs text-red-600 dark:text-red-400 hover:text-red-800 dark:hover:text-red-300\">Clear All</button></div></div><div x-show=\"hiddenAlerts && hiddenAlerts.length > 0\" class=\"space-y-2\"><template x-for=\"(alert, index) in hiddenAlerts\" :key=\"alert.fingerprint || alert.id || ('hidden-alert-' + index)\"><div class=\"flex items-center justify-between p-3 bg-gray-50 dark:bg-dark-bg-tertiary rounded-lg\"><input type=\"checkbox\" :checked=\"selectedHiddenFingerprints.includes(alert.fingerprint)\" @change=\"toggleHiddenAlertSelection(alert.fingerprint)\" class=\"mr-3 rounded text-blue-600\"><div class=\"flex-1 min-w-0\"><p class=\"text-sm font-medium text-gray-900 dark:text-white truncate\" x-text=\"alert.alert_name || 'Unknown Alert'\"></p><p class=\"text-xs text-gray-500 dark:text-gray-400 truncate\" x-text=\"alert.instance || 'N/A'\"></p><p class=\"text-xs text-gray-400 dark:text-gray-500 font-mono truncate\" x-text=\"alert.fingerprint\"></p><p x-show=\"alert.reason\" class=\"text-xs text-gray-500 dark:text-gray-400 mt-1\" x-text=\"'Reason: ' + alert.reason\"></p><p class=\"text-xs text-gray-400 dark:text-gray-500\" x-text=\"'Hidden: ' + formatTimestamp(alert.created_at)\"></p><p x-show=\"alert.expires_at\" class=\"text-xs text-indigo-600 dark:text-indigo-400\" x-text=\"isHiddenAlertActive(alert) ? 'Snoozed, ' + snoozeRemaining(alert) + ' left' : 'Snooze ended'\"></p></div><button @click=\"unhideSpecificAlert(alert.fingerprint)\" class=\"ml-3 text-green-600 hover:text-green-800 dark:text-green-400 dark:hover:text-green-300\"><svg class=\"w-4 h-4\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M15 12a3 3 0 11-6 0 3 3 0 016 0z\"></path> <path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M2.458 12C3.732 7.943 7.523 5 12 5c4.478 0 8.268 2.943 9.542 7-1.274 4.057-5.064 7-9.542 7-4.477 0-8.268-2.943-9.542-7z\"></path></svg></button></div></template></div><div x-show=\"!hiddenAlerts || hiddenAlerts.length === 0\" class=\"text-center py-6\"><svg class=\"mx-auto h-8 w-8 text-gray-400\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M13.875 18.825A10.05 10.05 0 0112 19c-4.478 0-8.268-2.943-9.543-7a9.97 9.97 0 011.563-3.029m5.858.908a3 3 0 114.243 4.243M9.878 9.878l4.242 4.242M9.878 9.878L3.9 3.9m5.978 5.978L3.9 3.9m15.2 15.2l-6.078-6.078m0 0L15.1 9.1\"></path></svg><p class=\"mt-2 text-sm text-gray-500 dark:text-gray-400\">No hidden alerts</p></div></div><!-- Hidden Rules Section --><div><div class=\"flex items-center justify-between mb-3\"><h5 class=\"text-sm font-medium text-gray-800 dark:text-gray-200\">Hidden Rules</h5><button @click=\"addHiddenRule()\" class=\"inline-flex items-center px-2 py-1 text-xs font-medium rounded text-white bg-blue-600 hover:bg-blue-700 focus:outline-none focus:ring-2 focus:ring-offset-2 focus:ring-blue-500\"><svg class=\"w-3 h-3 mr-1\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M12 4v16m8-8H4\"></path></svg> Add Rule</button></div><div x-show=\"hiddenRules && hiddenRules.length > 0\" class=\"space-y-2\"><template x-for=\"(rule, index) in hiddenRules\" :key=\"rule.id || index\"><div class=\"flex items-center justify-between p-3 bg-gray-50 dark:bg-dark-bg-tertiary rounded-lg\"><div class=\"flex-1 min-w-0\" :class=\"{ 'opacity-60': !rule.enabled }\"><p class=\"text-sm font-medium text-gray-900 dark:text-white\" x-text=\"rule.name || 'Unnamed Rule'\"></p><p class=\"text-xs text-gray-500 dark:text-gray-400 font-mono\" x-text=\"rule.labelKey + (rule.isRegex ? ' =~ ' : ' = ') + (rule.labelValue || '*')\"></p><p x-show=\"rule.description\" class=\"text-xs text-gray-500 dark:text-gray-400 mt-1\" x-text=\"rule.description\"></p></div><div class=\"flex items-center ml-3 space-x-3\"><span class=\"text-xs whitespace-nowrap\" :class=\"rule.enabled && hiddenRuleMatchCounts[rule.id] ? 'text-blue-600 dark:text-blue-400' : 'text-gray-400 dark:text-gray-500'\" x-text=\"hiddenRuleMatchText(rule)\"></span> <label class=\"inline-flex items-center cursor-pointer\" :title=\"rule.enabled ? 'Disable Rule' : 'Enable Rule'\"><input type=\"checkbox\" :checked=\"rule.enabled\" @change=\"toggleHiddenRule(rule.id)\" class=\"rounded text-blue-600\"></label> <button @click=\"removeHiddenRule(rule.id)\" class=\"text-red-600 hover:text-red-800 dark:text-red-400 dark:hover:text-red-300\" title=\"Delete Rule\"><svg class=\"w-4 h-4\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M19 7l-.867 12.142A2 2 0 0116.138 21H7.862a2 2 0 01-1.995-1.858L5 7m5 4v6m4-6v6m1-10V4a1 1 0 00-1-1h-4a1 1 0 00-1 1v3M4 7h16\"></path></svg></button></div></div></template></div><div x-show=\"!hiddenRules || hiddenRules.length === 0\" class=\"text-center py-6\"><svg class=\"mx-auto h-8 w-8 text-gray-400\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M12 6V4m0 2a2 2 0 100 4m0-4a2 2 0 110 4m-6 8a2 2 0 100-4m0 4a2 2 0 100 4m0-4v2m0-6V4m6 6v10m6-2a2 2 0 100-4m0 4a2 2 0 100 4m0-4v2m0-6V4\"></path></svg><p class=\"mt-2 text-sm text-gray-500 dark:text-gray-400\">No hidden rules defined</p><p class=\"text-xs text-gray-400 dark:text-gray-500\">Rules automatically hide alerts based on labels</p></div></div></div><!-- Sentry Integration Tab --><div x-show=\"activeTab === 'sentry'\" class=\"space-y-6\"><div><h4 class=\"text-sm font-medium text-gray-900 dark:text-white\">Sentry Integration</h4><p class=\"text-xs text-gray-500 dark:text-gray-400 mt-1\">Configure your Sentry personal access token to view metrics and issues in alert details.</p></div><!-- Sentry Instance Info --><div class=\"bg-blue-50 dark:bg-blue-900/20 p-3 rounded-lg\"><div class=\"flex items-center\"><svg class=\"w-5 h-5 text-blue-600 dark:text-blue-400 mr-2\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M13.828 10.172a4 4 0 00-5.656 0l-4 4a4 4 0 105.656 5.656l1.102-1.101m-.758-4.899a4 4 0 005.656 0l4-4a4 4 0 00-5.656-5.656l-1.1 1.1\"></path></svg><div><p class=\"text-sm font-medium text-blue-800 dark:text-blue-200\">Sentry Instance: https://your-sentry-instance.com</p></div></div></div><!-- Token Configuration --><div class=\"space-y-4\"><div><label class=\"block text-sm font-medium text-gray-700 dark:text-gray-300 mb-2\">Personal Access Token</label><div class=\"flex space-x-2\"><input type=\"password\" x-model=\"sentryForm.token\" placeholder=\"Enter your Sentry personal access token\" class=\"flex-1 border-gray-300 dark:border-dark-border-DEFAULT rounded-md shadow-sm focus:ring-blue-500 focus:border-blue-500 dark:bg-dark-bg-tertiary dark:text-white\"> <button @click=\"testSentryConnection()\" :disabled=\"!sentryForm.token.trim() || sentryConfig.connectionTesting\" class=\"px-3 py-2 bg-green-600 text-white rounded-md hover:bg-green-700 disabled:opacity-50 disabled:cursor-not-allowed flex items-center space-x-1\" title=\"Test connection with this token before saving\"><svg x-show=\"!sentryConfig.connectionTesting\" class=\"w-4 h-4\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M9 12l2 2 4-4m6 2a9 9 0 11-18 0 9 9 0 0118 0z\"></path></svg> <svg x-show=\"sentryConfig.connectionTesting\" class=\"w-4 h-4 animate-spin\" fill=\"none\" viewBox=\"0 0 24 24\"><circle class=\"opacity-25\" cx=\"12\" cy=\"12\" r=\"10\" stroke=\"currentColor\" stroke-width=\"4\"></circle> <path class=\"opacity-75\" fill=\"currentColor\" d=\"M4 12a8 8 0 018-8V0C5.373 0 0 5.373 0 12h4zm2 5.291A7.962 7.962 0 014 12H0c0 3.042 1.135 5.824 3 7.938l3-2.647z\"></path></svg> <span x-show=\"!sentryConfig.connectionTesting\">Test</span> <span x-show=\"sentryConfig.connectionTesting\">Testing...</span></button> <button @click=\"saveSentryToken()\" :disabled=\"!sentryForm.token.trim() || sentrySaving\" class=\"px-3 py-2 bg-blue-600 text-white rounded-md hover:bg-blue-700 disabled:opacity-50 disabled:cursor-not-allowed flex items-center space-x-1\" title=\"Save this token to your account\"><svg x-show=\"!sentrySaving\" class=\"w-4 h-4\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M8 7H5a2 2 0 00-2 2v9a2 2 0 002 2h14a2 2 0 002-2V9a2 2 0 00-2-2h-3m-1 4l-3-3m0 0l-3 3m3-3v12\"></path></svg> <svg x-show=\"sentrySaving\" class=\"w-4 h-4 animate-spin\" fill=\"none\" viewBox=\"0 0 24 24\"><circle class=\"opacity-25\" cx=\"12\" cy=\"12\" r=\"10\" stroke=\"currentColor\" stroke-width=\"4\"></circle> <path class=\"opacity-75\" fill=\"currentColor\" d=\"M4 12a8 8 0 018-8V0C5.373 0 0 5.373 0 12h4zm2 5.291A7.962 7.962 0 014 12H0c0 3.042 1.135 5.824 3 7.938l3-2.647z\"></path></svg> <span x-show=\"!sentrySaving\">Save</span> <span x-show=\"sentrySaving\">Saving...</span></button></div><div x-show=\"sentryConfig.hasToken\" class=\"mt-2\"><p class=\"text-xs text-green-600 dark:text-green-400 flex items-center\"><svg class=\"w-4 h-4 mr-1\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M5 13l4 4L19 7\"></path></svg> Token configured</p><button @click=\"removeSentryToken()\" class=\"text-xs text-red-600 hover:text-red-800 dark:text-red-400 mt-1\">Remove token</button></div><div x-show=\"sentryConfig.testResult\" class=\"mt-2\"><p x-show=\"sentryConfig.testResult && sentryConfig.testResult.success\" class=\"text-xs text-green-600 dark:text-green-400\" x-text=\"sentryConfig.testResult ? sentryConfig.testResult.message : ''\"></p><p x-show=\"sentryConfig.testResult && !sentryConfig.testResult.success\" class=\"text-xs text-red-600 dark:text-red-400\" x-text=\"sentryConfig.testResult ? sentryConfig.testResult.message : ''\"></p></div></div><!-- Help Section --><div class=\"bg-gray-50 dark:bg-gray-800/50 p-4 rounded-lg\"><h5 class=\"text-sm font-medium text-gray-900 dark:text-white mb-2\">How to get your Sentry token:</h5><ol class=\"text-sm text-gray-700 dark:text-gray-300 space-y-1 list-decimal list-inside\"><li>Go to <strong>Sentry Settings → Account → Auth Tokens</strong></li><li>Click <strong>\"Create New Token\"</strong></li><li>Name: \"Notificator Integration\"</li><li>Select scopes: <code class=\"bg-gray-200 dark:bg-gray-700 px-1 rounded text-xs\">project:read</code>, <code class=\"bg-gray-200 dark:bg-gray-700 px-1 rounded text-xs\">event:read</code>, <code class=\"bg-gray-200 dark:bg-gray-700 px-1 rounded text-xs\">org:read</code></li><li>Copy the generated token and paste it above</li></ol><div class=\"mt-4 p-3 bg-blue-50 dark:bg-blue-900/20 border border-blue-200 dark:border-blue-800 rounded-md\"><p class=\"text-xs text-blue-700 dark:text-blue-300\"><strong>Note:</strong> The integration displays project issues, events, and basic statistics using Sentry's documented API endpoints.  Some advanced metrics may not be available depending on your Sentry instance and plan.</p></div><a href=\"https://your-sentry-instance.com/settings/account/api/auth-tokens/\" target=\"_blank\" class=\"inline-flex items-center mt-2 text-sm text-blue-600 hover:text-blue-500 dark:text-blue-400\">Open Sentry Auth Tokens <svg class=\"w-4 h-4 ml-1\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M10 6H6a2 2 0 00-2 2v10a2 2 0 002 2h10a2 2 0 002-2v-4M14 4h6m0 0v6m0-6L10 14\"></path></svg></a></div></div></div>")
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
@@ -38,7 +38,7 @@ func SettingsModal() templ.Component {
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
-		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 2, "<!-- Annotation Buttons Tab --><div x-show=\"activeTab === 'annotation-buttons'\" class=\"space-y-6\"><div class=\"flex items-center justify-between mb-4\"><div><h4 class=\"text-sm font-medium text-gray-900 dark:text-white\">Annotation Button Configuration</h4><p class=\"text-xs text-gray-500 dark:text-gray-400 mt-1\">Configure which annotation keys display as buttons in alert modals.</p></div><button @click=\"addAnnotationButton()\" class=\"inline-flex items-center px-3 py-1.5 border border-transparent text-xs font-medium rounded-md text-white bg-blue-600 hover:bg-blue-700\"><svg class=\"w-4 h-4 mr-1\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M12 4v16m8-8H4\"></path></svg> Add Button</button></div><div x-show=\"annotationButtonsLoading\" class=\"text-center py-8\"><div class=\"inline-block animate-spin rounded-full h-8 w-8 border-b-2 border-blue-600\"></div></div><div x-show=\"!annotationButtonsLoading\" class=\"space-y-3\"><template x-if=\"annotationButtonConfigs.length === 0\"><div class=\"text-center py-8 text-gray-500 dark:text-gray-400 text-sm\">No buttons configured. Click \"Add Button\" to create one.</div></template><template x-for=\"(button, index) in annotationButtonConfigs\" :key=\"button.id\"><div class=\"bg-white dark:bg-dark-bg-tertiary border border-gray-200 dark:border-dark-border-DEFAULT rounded-lg p-4 space-y-3\"><div class=\"flex items-center justify-between\"><div class=\"flex items-center space-x-3\"><input type=\"text\" x-model=\"button.label\" placeholder=\"Button Label\" class=\"border border-gray-300 dark:border-dark-border-DEFAULT rounded px-2 py-1 text-sm bg-white dark:bg-dark-bg-secondary text-gray-900 dark:text-gray-100\"> <input type=\"color\" x-model=\"button.color\" class=\"w-10 h-8 border rounded cursor-pointer\"> <label class=\"inline-flex items-center text-sm\"><input type=\"checkbox\" x-model=\"button.enabled\" class=\"rounded text-blue-600\"> <span class=\"ml-2 text-gray-700 dark:text-gray-300\">Enabled</span></label></div><div class=\"flex items-center space-x-2\"><button @click=\"moveButtonUp(index)\" :disabled=\"index === 0\" class=\"p-1 text-gray-600 dark:text-gray-400 disabled:opacity-30\"><svg class=\"w-4 h-4\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M5 15l7-7 7 7\"></path></svg></button> <button @click=\"moveButtonDown(index)\" :disabled=\"index === annotationButtonConfigs.length - 1\" class=\"p-1 text-gray-600 dark:text-gray-400 disabled:opacity-30\"><svg class=\"w-4 h-4\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M19 9l-7 7-7-7\"></path></svg></button> <button @click=\"removeAnnotationButton(index)\" class=\"p-1 text-red-600 dark:text-red-400\"><svg class=\"w-4 h-4\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M19 7l-.867 12.142A2 2 0 0116.138 21H7.862a2 2 0 01-1.995-1.858L5 7m5 4v6m4-6v6m1-10V4a1 1 0 00-1-1h-4a1 1 0 00-1 1v3M4 7h16\"></path></svg></button></div></div><div class=\"space-y-2\"><label class=\"text-xs font-medium text-gray-700 dark:text-gray-300\">Annotation Keys:</label><div class=\"space-y-2\"><template x-for=\"(key, keyIndex) in button.annotation_keys\" :key=\"keyIndex\"><div class=\"flex items-center space-x-2\"><input type=\"text\" x-model=\"button.annotation_keys[keyIndex]\" placeholder=\"e.g., documentation, runbook_url\" class=\"flex-1 border border-gray-300 dark:border-dark-border-DEFAULT rounded px-2 py-1 text-sm bg-white dark:bg-dark-bg-secondary text-gray-900 dark:text-gray-100\"> <button @click=\"removeAnnotationKey(button, keyIndex)\" x-show=\"button.annotation_keys.length > 1\" class=\"text-red-600 dark:text-red-400 p-1\"><svg class=\"w-4 h-4\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M6 18L18 6M6 6l12 12\"></path></svg></button></div></template><button @click=\"addAnnotationKey(button)\" class=\"text-xs text-blue-600 dark:text-blue-400\">+ Add another key</button></div></div></div></template></div></div></div></div></div><div class=\"bg-gray-50 dark:bg-dark-bg-tertiary px-4 py-3 sm:px-6 sm:flex sm:flex-row-reverse\"><button type=\"button\" @click=\"saveSettings()\" :disabled=\"settingsSaving\" class=\"w-full inline-flex justify-center items-center rounded-md border border-transparent shadow-sm px-4 py-2 text-base font-medium text-white sm:ml-3 sm:w-auto sm:text-sm transition-colors duration-200\" :class=\"{\n\t\t\t\t\t\t\t\t'bg-blue-600 hover:bg-blue-700 focus:ring-blue-500': !settingsSaving,\n\t\t\t\t\t\t\t\t'bg-gray-400 cursor-not-allowed': settingsSaving\n\t\t\t\t\t\t\t}\"><svg x-show=\"settingsSaving\" class=\"animate-spin -ml-1 mr-2 h-4 w-4 text-white\" fill=\"none\" viewBox=\"0 0 24 24\"><circle class=\"opacity-25\" cx=\"12\" cy=\"12\" r=\"10\" stroke=\"currentColor\" stroke-width=\"4\"></circle> <path class=\"opacity-75\" fill=\"currentColor\" d=\"M4 12a8 8 0 018-8V0C5.373 0 0 5.373 0 12h4zm2 5.291A7.962 7.962 0 014 12H0c0 3.042 1.135 5.824 3 7.938l3-2.647z\"></path></svg> <span x-show=\"!settingsSaving\">💾 Save All Settings</span> <span x-show=\"settingsSaving\">Saving...</span></button> <button type=\"button\" @click=\"showSettings = false\" :disabled=\"settingsSaving\" class=\"mt-3 w-full inline-flex justify-center rounded-md border border-gray-300 dark:border-dark-border-DEFAULT shadow-sm px-4 py-2 bg-white dark:bg-dark-bg-secondary text-base font-medium text-gray-700 dark:text-gray-300 hover:bg-gray-50 dark:hover:bg-dark-bg-tertiary focus:outline-none focus:ring-2 focus:ring-offset-2 focus:ring-indigo-500 sm:mt-0 sm:ml-3 sm:w-auto sm:text-sm\" :class=\"{ 'opacity-50 cursor-not-allowed': settingsSaving }\">Cancel</button></div></div></div><!-- Hidden Rule Modal --><div x-show=\"showHiddenRuleModal\" x-transition:enter=\"ease-out duration-300\" x-transition:enter-start=\"opacity-0\" x-transition:enter-end=\"opacity-100\" x-transition:leave=\"ease-in duration-200\" x-transition:leave-start=\"opacity-100\" x-transition:leave-end=\"opacity-0\" class=\"fixed inset-0 z-70 overflow-y-auto\" @click.away=\"cancelHiddenRule()\" style=\"display: none;\"><div class=\"flex items-end justify-center min-h-screen pt-4 px-4 pb-20 text-center sm:block sm:p-0\"><span class=\"hidden sm:inline-block sm:align-middle sm:h-screen\">&#8203;</span><div class=\"inline-block align-bottom bg-white dark:bg-dark-bg-secondary rounded-lg text-left overflow-hidden shadow-xl transform transition-all sm:my-8 sm:align-middle sm:max-w-lg sm:w-full z-10\" x-transition:enter=\"ease-out duration-300\" x-transition:enter-start=\"opacity-0 translate-y-4 sm:translate-y-0 sm:scale-95\" x-transition:enter-end=\"opacity-100 translate-y-0 sm:scale-100\" x-transition:leave=\"ease-in duration-200\" x-transition:leave-start=\"opacity-100 translate-y-0 sm:scale-100\" x-transition:leave-end=\"opacity-0 translate-y-4 sm:translate-y-0 sm:scale-95\"><div class=\"bg-white dark:bg-dark-bg-secondary px-4 pt-5 pb-4 sm:p-6 sm:pb-4\"><div class=\"sm:flex sm:items-start\"><div class=\"mx-auto flex-shrink-0 flex items-center justify-center h-12 w-12 rounded-full bg-blue-100 dark:bg-blue-900 sm:mx-0 sm:h-10 sm:w-10\"><svg class=\"h-6 w-6 text-blue-600 dark:text-blue-300\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M12 6V4m0 2a2 2 0 100 4m0-4a2 2 0 110 4m-6 8a2 2 0 100-4m0 4a2 2 0 100 4m0-4v2m0-6V4m6 6v10m6-2a2 2 0 100-4m0 4a2 2 0 100 4m0-4v2m0-6V4\"></path></svg></div><div class=\"mt-3 text-center sm:mt-0 sm:ml-4 sm:text-left w-full\"><h3 class=\"text-lg leading-6 font-medium text-gray-900 dark:text-white\">Create Hidden Rule</h3><div class=\"mt-4 space-y-4\"><!-- Rule Name --><div><label for=\"hiddenRuleName\" class=\"block text-sm font-medium text-gray-700 dark:text-gray-300 mb-1\">Rule Name <span class=\"text-red-500\">*</span></label> <input type=\"text\" id=\"hiddenRuleName\" x-model=\"hiddenRuleForm.name\" placeholder=\"e.g., 'Hide test alerts'\" class=\"w-full px-3 py-2 border border-gray-300 dark:border-dark-border-DEFAULT rounded-md shadow-sm focus:ring-blue-500 focus:border-blue-500 dark:bg-dark-bg-tertiary dark:text-white text-sm\"></div><!-- Label Key --><div><label for=\"hiddenRuleLabelKey\" class=\"block text-sm font-medium text-gray-700 dark:text-gray-300 mb-1\">Label Key <span class=\"text-red-500\">*</span></label> <input type=\"text\" id=\"hiddenRuleLabelKey\" x-model=\"hiddenRuleForm.labelKey\" @focus=\"ensureAvailableLabels()\" list=\"available-label-keys\" placeholder=\"e.g., 'service', 'team', 'severity'\" class=\"w-full px-3 py-2 border border-gray-300 dark:border-dark-border-DEFAULT rounded-md shadow-sm focus:ring-blue-500 focus:border-blue-500 dark:bg-dark-bg-tertiary dark:text-white text-sm\"> <datalist id=\"available-label-keys\"><template x-for=\"labelKey in Object.keys(availableLabels || {})\" :key=\"labelKey\"><option :value=\"labelKey\" x-text=\"labelKey\"></option></template></datalist></div><!-- Label Value --><div><label for=\"hiddenRuleLabelValue\" class=\"block text-sm font-medium text-gray-700 dark:text-gray-300 mb-1\">Label Value <span class=\"text-red-500\">*</span></label> <input type=\"text\" id=\"hiddenRuleLabelValue\" x-model=\"hiddenRuleForm.labelValue\" @focus=\"ensureAvailableLabels()\" :list=\"'available-label-values-' + hiddenRuleForm.labelKey\" placeholder=\"e.g., 'web', 'critical', 'production'\" class=\"w-full px-3 py-2 border border-gray-300 dark:border-dark-border-DEFAULT rounded-md shadow-sm focus:ring-blue-500 focus:border-blue-500 dark:bg-dark-bg-tertiary dark:text-white text-sm\"> <datalist :id=\"'available-label-values-' + hiddenRuleForm.labelKey\"><template x-for=\"value in getAvailableValuesForLabel(hiddenRuleForm.labelKey)\" :key=\"value\"><option :value=\"value\" x-text=\"value\"></option></template></datalist></div><!-- Description --><div><label for=\"hiddenRuleDescription\" class=\"block text-sm font-medium text-gray-700 dark:text-gray-300 mb-1\">Description (optional)</label> <textarea id=\"hiddenRuleDescription\" x-model=\"hiddenRuleForm.description\" rows=\"2\" placeholder=\"Optional description for this rule...\" class=\"w-full px-3 py-2 border border-gray-300 dark:border-dark-border-DEFAULT rounded-md shadow-sm focus:ring-blue-500 focus:border-blue-500 dark:bg-dark-bg-tertiary dark:text-white text-sm\"></textarea></div></div></div></div></div><div class=\"bg-gray-50 dark:bg-dark-bg-tertiary px-4 py-3 sm:px-6 sm:flex sm:flex-row-reverse\"><button type=\"button\" @click=\"saveHiddenRule()\" class=\"w-full inline-flex justify-center rounded-md border border-transparent shadow-sm px-4 py-2 bg-blue-600 text-base font-medium text-white hover:bg-blue-700 focus:outline-none focus:ring-2 focus:ring-offset-2 focus:ring-blue-500 sm:ml-3 sm:w-auto sm:text-sm\">Create Rule</button> <button type=\"button\" @click=\"cancelHiddenRule()\" class=\"mt-3 w-full inline-flex justify-center rounded-md border border-gray-300 dark:border-dark-border-DEFAULT shadow-sm px-4 py-2 bg-white dark:bg-dark-bg-secondary text-base font-medium text-gray-700 dark:text-gray-300 hover:bg-gray-50 dark:hover:bg-dark-bg-tertiary focus:outline-none focus:ring-2 focus:ring-offset-2 focus:ring-indigo-500 sm:mt-0 sm:ml-3 sm:w-auto sm:text-sm\">Cancel</button></div></div></div></div></div>")
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 2, "<!-- Annotation Buttons Tab --><div x-show=\"activeTab === 'annotation-buttons'\" class=\"space-y-6\"><div class=\"flex items-center justify-between mb-4\"><div><h4 class=\"text-sm font-medium text-gray-900 dark:text-white\">Annotation Button Configuration</h4><p class=\"text-xs text-gray-500 dark:text-gray-400 mt-1\">Configure which annotation keys display as buttons in alert modals.</p></div><button @click=\"addAnnotationButton()\" class=\"inline-flex items-center px-3 py-1.5 border border-transparent text-xs font-medium rounded-md text-white bg-blue-600 hover:bg-blue-700\"><svg class=\"w-4 h-4 mr-1\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M12 4v16m8-8H4\"></path></svg> Add Button</button></div><div x-show=\"annotationButtonsLoading\" class=\"text-center py-8\"><div class=\"inline-block animate-spin rounded-full h-8 w-8 border-b-2 border-blue-600\"></div></div><div x-show=\"!annotationButtonsLoading\" class=\"space-y-3\"><template x-if=\"annotationButtonConfigs.length === 0\"><div class=\"text-center py-8 text-gray-500 dark:text-gray-400 text-sm\">No buttons configured. Click \"Add Button\" to create one.</div></template><template x-for=\"(button, index) in annotationButtonConfigs\" :key=\"button.id\"><div class=\"bg-white dark:bg-dark-bg-tertiary border border-gray-200 dark:border-dark-border-DEFAULT rounded-lg p-4 space-y-3\"><div class=\"flex items-center justify-between\"><div class=\"flex items-center space-x-3\"><input type=\"text\" x-model=\"button.label\" placeholder=\"Button Label\" class=\"border border-gray-300 dark:border-dark-border-DEFAULT rounded px-2 py-1 text-sm bg-white dark:bg-dark-bg-secondary text-gray-900 dark:text-gray-100\"> <input type=\"color\" x-model=\"button.color\" class=\"w-10 h-8 border rounded cursor-pointer\"> <label class=\"inline-flex items-center text-sm\"><input type=\"checkbox\" x-model=\"button.enabled\" class=\"rounded text-blue-600\"> <span class=\"ml-2 text-gray-700 dark:text-gray-300\">Enabled</span></label> <select :value=\"button.button_type === 'copy' ? 'copy' : 'link'\" @change=\"setAnnotationButtonAction(button, $event.target.value)\" title=\"What the button does with the annotation value\" class=\"border border-gray-300 dark:border-dark-border-DEFAULT rounded px-2 py-1 text-sm bg-white dark:bg-dark-bg-secondary text-gray-900 dark:text-gray-100\"><option value=\"link\">Open link</option> <option value=\"copy\">Copy value</option></select></div><div class=\"flex items-center space-x-2\"><button @click=\"moveButtonUp(index)\" :disabled=\"index === 0\" class=\"p-1 text-gray-600 dark:text-gray-400 disabled:opacity-30\"><svg class=\"w-4 h-4\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M5 15l7-7 7 7\"></path></svg></button> <button @click=\"moveButtonDown(index)\" :disabled=\"index === annotationButtonConfigs.length - 1\" class=\"p-1 text-gray-600 dark:text-gray-400 disabled:opacity-30\"><svg class=\"w-4 h-4\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M19 9l-7 7-7-7\"></path></svg></button> <button @click=\"removeAnnotationButton(index)\" class=\"p-1 text-red-600 dark:text-red-400\"><svg class=\"w-4 h-4\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M19 7l-.867 12.142A2 2 0 0116.138 21H7.862a2 2 0 01-1.995-1.858L5 7m5 4v6m4-6v6m1-10V4a1 1 0 00-1-1h-4a1 1 0 00-1 1v3M4 7h16\"></path></svg></button></div></div><div class=\"space-y-2\"><label class=\"text-xs font-medium text-gray-700 dark:text-gray-300\">Annotation Keys:</label><div class=\"space-y-2\"><template x-for=\"(key, keyIndex) in button.annotation_keys\" :key=\"keyIndex\"><div class=\"flex items-center space-x-2\"><input type=\"text\" x-model=\"button.annotation_keys[keyIndex]\" placeholder=\"e.g., documentation, runbook_url\" class=\"flex-1 border border-gray-300 dark:border-dark-border-DEFAULT rounded px-2 py-1 text-sm bg-white dark:bg-dark-bg-secondary text-gray-900 dark:text-gray-100\"> <button @click=\"removeAnnotationKey(button, keyIndex)\" x-show=\"button.annotation_keys.length > 1\" class=\"text-red-600 dark:text-red-400 p-1\"><svg class=\"w-4 h-4\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M6 18L18 6M6 6l12 12\"></path></svg></button></div></template><button @click=\"addAnnotationKey(button)\" class=\"text-xs text-blue-600 dark:text-blue-400\">+ Add another key</button></div></div></div></template></div></div></div></div></div><div class=\"bg-gray-50 dark:bg-dark-bg-tertiary px-4 py-3 sm:px-6 sm:flex sm:flex-row-reverse\"><button type=\"button\" @click=\"saveSettings()\" :disabled=\"settingsSaving\" class=\"w-full inline-flex justify-center items-center rounded-md border border-transparent shadow-sm px-4 py-2 text-base font-medium text-white sm:ml-3 sm:w-auto sm:text-sm transition-colors duration-200\" :class=\"{\n\t\t\t\t\t\t\t\t'bg-blue-600 hover:bg-blue-700 focus:ring-blue-500': !settingsSaving,\n\t\t\t\t\t\t\t\t'bg-gray-400 cursor-not-allowed': settingsSaving\n\t\t\t\t\t\t\t}\"><svg x-show=\"settingsSaving\" class=\"animate-spin -ml-1 mr-2 h-4 w-4 text-white\" fill=\"none\" viewBox=\"0 0 24 24\"><circle class=\"opacity-25\" cx=\"12\" cy=\"12\" r=\"10\" stroke=\"currentColor\" stroke-width=\"4\"></circle> <path class=\"opacity-75\" fill=\"currentColor\" d=\"M4 12a8 8 0 018-8V0C5.373 0 0 5.373 0 12h4zm2 5.291A7.962 7.962 0 014 12H0c0 3.042 1.135 5.824 3 7.938l3-2.647z\"></path></svg> <span x-show=\"!settingsSaving\">💾 Save All Settings</span> <span x-show=\"settingsSaving\">Saving...</span></button> <button type=\"button\" @click=\"showSettings = false\" :disabled=\"settingsSaving\" class=\"mt-3 w-full inline-flex justify-center rounded-md border border-gray-300 dark:border-dark-border-DEFAULT shadow-sm px-4 py-2 bg-white dark:bg-dark-bg-secondary text-base font-medium text-gray-700 dark:text-gray-300 hover:bg-gray-50 dark:hover:bg-dark-bg-tertiary focus:outline-none focus:ring-2 focus:ring-offset-2 focus:ring-indigo-500 sm:mt-0 sm:ml-3 sm:w-auto sm:text-sm\" :class=\"{ 'opacity-50 cursor-not-allowed': settingsSaving }\">Cancel</button></div></div></div><!-- Hidden Rule Modal --><div x-show=\"showHiddenRuleModal\" x-transition:enter=\"ease-out duration-300\" x-transition:enter-start=\"opacity-0\" x-transition:enter-end=\"opacity-100\" x-transition:leave=\"ease-in duration-200\" x-transition:leave-start=\"opacity-100\" x-transition:leave-end=\"opacity-0\" class=\"fixed inset-0 z-70 overflow-y-auto\" @click.away=\"cancelHiddenRule()\" style=\"display: none;\"><div class=\"flex items-end justify-center min-h-screen pt-4 px-4 pb-20 text-center sm:block sm:p-0\"><span class=\"hidden sm:inline-block sm:align-middle sm:h-screen\">&#8203;</span><div class=\"inline-block align-bottom bg-white dark:bg-dark-bg-secondary rounded-lg text-left overflow-hidden shadow-xl transform transition-all sm:my-8 sm:align-middle sm:max-w-lg sm:w-full z-10\" x-transition:enter=\"ease-out duration-300\" x-transition:enter-start=\"opacity-0 translate-y-4 sm:translate-y-0 sm:scale-95\" x-transition:enter-end=\"opacity-100 translate-y-0 sm:scale-100\" x-transition:leave=\"ease-in duration-200\" x-transition:leave-start=\"opacity-100 translate-y-0 sm:scale-100\" x-transition:leave-end=\"opacity-0 translate-y-4 sm:translate-y-0 sm:scale-95\"><div class=\"bg-white dark:bg-dark-bg-secondary px-4 pt-5 pb-4 sm:p-6 sm:pb-4\"><div class=\"sm:flex sm:items-start\"><div class=\"mx-auto flex-shrink-0 flex items-center justify-center h-12 w-12 rounded-full bg-blue-100 dark:bg-blue-900 sm:mx-0 sm:h-10 sm:w-10\"><svg class=\"h-6 w-6 text-blue-600 dark:text-blue-300\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M12 6V4m0 2a2 2 0 100 4m0-4a2 2 0 110 4m-6 8a2 2 0 100-4m0 4a2 2 0 100 4m0-4v2m0-6V4m6 6v10m6-2a2 2 0 100-4m0 4a2 2 0 100 4m0-4v2m0-6V4\"></path></svg></div><div class=\"mt-3 text-center sm:mt-0 sm:ml-4 sm:text-left w-full\"><h3 class=\"text-lg leading-6 font-medium text-gray-900 dark:text-white\">Create Hidden Rule</h3><div class=\"mt-4 space-y-4\"><!-- Rule Name --><div><label for=\"hiddenRuleName\" class=\"block text-sm font-medium text-gray-700 dark:text-gray-300 mb-1\">Rule Name <span class=\"text-red-500\">*</span></label> <input type=\"text\" id=\"hiddenRuleName\" x-model=\"hiddenRuleForm.name\" placeholder=\"e.g., 'Hide test alerts'\" class=\"w-full px-3 py-2 border border-gray-300 dark:border-dark-border-DEFAULT rounded-md shadow-sm focus:ring-blue-500 focus:border-blue-500 dark:bg-dark-bg-tertiary dark:text-white text-sm\"></div><!-- Label Key --><div><label for=\"hiddenRuleLabelKey\" class=\"block text-sm font-medium text-gray-700 dark:text-gray-300 mb-1\">Label Key <span class=\"text-red-500\">*</span></label> <input type=\"text\" id=\"hiddenRuleLabelKey\" x-model=\"hiddenRuleForm.labelKey\" @focus=\"ensureAvailableLabels()\" list=\"available-label-keys\" placeholder=\"e.g., 'service', 'team', 'severity'\" class=\"w-full px-3 py-2 border border-gray-300 dark:border-dark-border-DEFAULT rounded-md shadow-sm focus:ring-blue-500 focus:border-blue-500 dark:bg-dark-bg-tertiary dark:text-white text-sm\"> <datalist id=\"available-label-keys\"><template x-for=\"labelKey in Object.keys(availableLabels || {})\" :key=\"labelKey\"><option :value=\"labelKey\" x-text=\"labelKey\"></option></template></datalist></div><!-- Label Value --><div><label for=\"hiddenRuleLabelValue\" class=\"block text-sm font-medium text-gray-700 dark:text-gray-300 mb-1\">Label Value <span class=\"text-red-500\">*</span></label> <input type=\"text\" id=\"hiddenRuleLabelValue\" x-model=\"hiddenRuleForm.labelValue\" @focus=\"ensureAvailableLabels()\" :list=\"'available-label-values-' + hiddenRuleForm.labelKey\" placeholder=\"e.g., 'web', 'critical', 'production'\" class=\"w-full px-3 py-2 border border-gray-300 dark:border-dark-border-DEFAULT rounded-md shadow-sm focus:ring-blue-500 focus:border-blue-500 dark:bg-dark-bg-tertiary dark:text-white text-sm\"> <datalist :id=\"'available-label-values-' + hiddenRuleForm.labelKey\"><template x-for=\"value in getAvailableValuesForLabel(hiddenRuleForm.labelKey)\" :key=\"value\"><option :value=\"value\" x-text=\"value\"></option></template></datalist></div><!-- Regex --><label class=\"inline-flex items-center text-sm\"><input type=\"checkbox\" x-model=\"hiddenRuleForm.isRegex\" class=\"rounded text-blue-600\"> <span class=\"ml-2 text-gray-700 dark:text-gray-300\">Treat the value as a regular expression</span></label><!-- Description --><div><label for=\"hiddenRuleDescription\" class=\"block text-sm font-medium text-gray-700 dark:text-gray-300 mb-1\">Description (optional)</label> <textarea id=\"hiddenRuleDescription\" x-model=\"hiddenRuleForm.description\" rows=\"2\" placeholder=\"Optional description for this rule...\" class=\"w-full px-3 py-2 border border-gray-300 dark:border-dark-border-DEFAULT rounded-md shadow-sm focus:ring-blue-500 focus:border-blue-500 dark:bg-dark-bg-tertiary dark:text-white text-sm\"></textarea></div><p x-show=\"hiddenRuleError\" x-text=\"hiddenRuleError\" class=\"text-sm text-red-600 dark:text-red-400\"></p></div></div></div></div><div class=\"bg-gray-50 dark:bg-dark-bg-tertiary px-4 py-3 sm:px-6 sm:flex sm:flex-row-reverse\"><button type=\"button\" @click=\"saveHiddenRule()\" class=\"w-full inline-flex justify-center rounded-md border border-transparent shadow-sm px-4 py-2 bg-blue-600 text-base font-medium text-white hover:bg-blue-700 focus:outline-none focus:ring-2 focus:ring-offset-2 focus:ring-blue-500 sm:ml-3 sm:w-auto sm:text-sm\">Create Rule</button> <button type=\"button\" @click=\"cancelHiddenRule()\" class=\"mt-3 w-full inline-flex justify-center rounded-md border border-gray-300 dark:border-dark-border-DEFAULT shadow-sm px-4 py-2 bg-white dark:bg-dark-bg-secondary text-base font-medium text-gray-700 dark:text-gray-300 hover:bg-gray-50 dark:hover:bg-dark-bg-tertiary focus:outline-none focus:ring-2 focus:ring-offset-2 focus:ring-indigo-500 sm:mt-0 sm:ml-3 sm:w-auto sm:text-sm\">Cancel</button></div></div></div></div></div>")
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
@@ -68,7 +68,7 @@ func AcknowledgmentModal() templ.Component {
 			templ_7745c5c3_Var2 = templ.NopComponent
 		}
 		ctx = templ.ClearChildren(ctx)
-		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 3, "<!-- Acknowledgment Dialog --><div x-show=\"showAckModal\" x-transition:enter=\"ease-out duration-300\" x-transition:enter-start=\"opacity-0\" x-transition:enter-end=\"opacity-100\" x-transition:leave=\"ease-in duration-200\" x-transition:leave-start=\"opacity-100\" x-transition:leave-end=\"opacity-0\" class=\"fixed inset-0 z-60 overflow-y-auto\" @click.away=\"showAckModal = false\" style=\"display: none;\"><div class=\"flex items-end justify-center min-h-screen pt-4 px-4 pb-20 text-center sm:block sm:p-0\"><!-- Backdrop --><div class=\"fixed inset-0 bg-gray-500/75 dark:bg-black/60 backdrop-blur-sm transition-opacity\" @click=\"showAckModal = false\"></div><span class=\"hidden sm:inline-block sm:align-middle sm:h-screen\">&#8203;</span><div class=\"relative inline-block align-bottom bg-white dark:bg-dark-bg-secondary rounded-xl text-left overflow-hidden shadow-2xl transform transition-all sm:my-8 sm:align-middle sm:max-w-lg sm:w-full z-10 border border-gray-200/50 dark:border-dark-border-subtle/50\" @click.stop x-transition:enter=\"ease-out duration-300\" x-transition:enter-start=\"opacity-0 translate-y-4 sm:translate-y-0 sm:scale-95\" x-transition:enter-end=\"opacity-100 translate-y-0 sm:scale-100\" x-transition:leave=\"ease-in duration-200\" x-transition:leave-start=\"opacity-100 translate-y-0 sm:scale-100\" x-transition:leave-end=\"opacity-0 translate-y-4 sm:translate-y-0 sm:scale-95\"><div class=\"bg-white dark:bg-dark-bg-secondary px-6 pt-6 pb-4\"><div class=\"sm:flex sm:items-start\"><div class=\"mx-auto flex-shrink-0 flex items-center justify-center h-12 w-12 rounded-full bg-green-100 dark:bg-green-900/50 sm:mx-0 sm:h-10 sm:w-10\"><svg class=\"h-6 w-6 text-green-600 dark:text-green-400\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M5 13l4 4L19 7\"></path></svg></div><div class=\"mt-3 text-center sm:mt-0 sm:ml-4 sm:text-left w-full\"><h3 class=\"text-lg font-semibold text-gray-900 dark:text-white\">Acknowledge Alert</h3><div class=\"mt-2\"><p class=\"text-sm text-gray-500 dark:text-gray-400 mb-4\"><span x-show=\"ackAction === 'single'\">Please provide a reason for acknowledging this alert:</span> <span x-show=\"ackAction === 'bulk'\">Please provide a reason for acknowledging <strong x-text=\"selectedAlerts.length + selectedGroups.length\"></strong> alert(s)/group(s):</span> <span x-show=\"ackAction === 'group'\">Please provide a reason for acknowledging the group \"<strong x-text=\"currentGroupName\"></strong>\":</span></p><!-- Alert/Group Information --><div x-show=\"ackAction === 'single' && currentAckAlert\" class=\"mb-4 p-3 bg-gray-50 dark:bg-dark-bg-tertiary rounded-md\"><div class=\"flex items-center space-x-2 text-sm\"><span class=\"font-medium text-gray-900 dark:text-white\">Alert:</span> <span class=\"text-gray-600 dark:text-gray-300\" x-text=\"currentAckAlert?.alertName\"></span></div><div class=\"flex items-center space-x-2 text-sm mt-1\"><span class=\"font-medium text-gray-900 dark:text-white\">Instance:</span> <span class=\"text-gray-600 dark:text-gray-300\" x-text=\"currentAckAlert?.instance\"></span></div></div><!-- Reason Input --><div class=\"mb-4\"><label for=\"ack-reason\" class=\"block text-sm font-medium text-gray-700 dark:text-gray-300 mb-2\">Acknowledgment Reason <span class=\"text-red-500\">*</span></label> <textarea id=\"ack-reason\" x-model=\"ackReason\" rows=\"4\" placeholder=\"Describe why you are acknowledging this alert and what actions you're taking...\" class=\"w-full px-3 py-2 border border-gray-300 dark:border-dark-border-DEFAULT rounded-md shadow-sm focus:outline-none focus:ring-blue-500 focus:border-blue-500 dark:bg-dark-bg-tertiary dark:text-white resize-none\" @keydown.enter.meta.prevent=\"submitAcknowledgment()\" @keydown.enter.ctrl.prevent=\"submitAcknowledgment()\"></textarea><div class=\"mt-1 text-xs text-gray-500 dark:text-gray-400\">Press Ctrl+Enter or Cmd+Enter to submit</div></div><!-- Quick Reason Templates --><div class=\"mb-4\"><label class=\"block text-sm font-medium text-gray-700 dark:text-gray-300 mb-2\">Quick Templates:</label><div class=\"flex flex-wrap gap-2\"><button @click=\"ackReason = 'Investigating the issue'\" class=\"px-3 py-1 text-xs bg-blue-100 dark:bg-blue-800 text-blue-800 dark:text-blue-200 rounded-full hover:bg-blue-200 dark:hover:bg-blue-700\">Investigating</button> <button @click=\"ackReason = 'Working on a fix'\" class=\"px-3 py-1 text-xs bg-green-100 dark:bg-green-800 text-green-800 dark:text-green-200 rounded-full hover:bg-green-200 dark:hover:bg-green-700\">Working on fix</button> <button @click=\"ackReason = 'Monitoring the situation'\" class=\"px-3 py-1 text-xs bg-yellow-100 dark:bg-yellow-800 text-yellow-800 dark:text-yellow-200 rounded-full hover:bg-yellow-200 dark:hover:bg-yellow-700\">Monitoring</button> <button @click=\"ackReason = 'False positive - expected behavior'\" class=\"px-3 py-1 text-xs bg-gray-100 dark:bg-dark-bg-secondary text-gray-800 dark:text-gray-200 rounded-full hover:bg-gray-200 dark:hover:bg-dark-bg-tertiary\">False positive</button></div></div><!-- Validation Error --><div x-show=\"ackError\" class=\"mb-4 p-3 bg-red-50 dark:bg-red-900/50 border border-red-200 dark:border-red-800 rounded-md\"><div class=\"flex\"><svg class=\"w-5 h-5 text-red-400\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M12 9v2m0 4h.01m-6.938 4h13.856c1.54 0 2.502-1.667 1.732-2.5L13.732 4c-.77-.833-1.964-.833-2.732 0L4.082 16.5c-.77.833.192 2.5 1.732 2.5z\"></path></svg><div class=\"ml-3\"><p class=\"text-sm text-red-800 dark:text-red-200\" x-text=\"ackError\"></p></div></div></div></div></div></div></div><div class=\"bg-gray-50 dark:bg-dark-bg-tertiary px-4 py-3 sm:px-6 sm:flex sm:flex-row-reverse\"><button type=\"button\" @click=\"submitAcknowledgment()\" :disabled=\"!ackReason.trim() || ackSubmitting\" class=\"w-full inline-flex justify-center items-center rounded-md border border-transparent shadow-sm px-4 py-2 text-base font-medium text-white sm:ml-3 sm:w-auto sm:text-sm transition-colors duration-200\" :class=\"{\n\t\t\t\t\t\t\t\t'bg-green-600 hover:bg-green-700 focus:ring-green-500': ackReason.trim() && !ackSubmitting,\n\t\t\t\t\t\t\t\t'bg-gray-400 cursor-not-allowed': !ackReason.trim() || ackSubmitting\n\t\t\t\t\t\t\t}\"><svg x-show=\"ackSubmitting\" class=\"animate-spin -ml-1 mr-2 h-4 w-4 text-white\" fill=\"none\" viewBox=\"0 0 24 24\"><circle class=\"opacity-25\" cx=\"12\" cy=\"12\" r=\"10\" stroke=\"currentColor\" stroke-width=\"4\"></circle> <path class=\"opacity-75\" fill=\"currentColor\" d=\"M4 12a8 8 0 018-8V0C5.373 0 0 5.373 0 12h4zm2 5.291A7.962 7.962 0 014 12H0c0 3.042 1.135 5.824 3 7.938l3-2.647z\"></path></svg> <span x-show=\"!ackSubmitting\">Acknowledge</span> <span x-show=\"ackSubmitting\">Processing...</span></button> <button type=\"button\" @click=\"cancelAcknowledgment()\" :disabled=\"ackSubmitting\" class=\"mt-3 w-full inline-flex justify-center rounded-md border border-gray-300 dark:border-dark-border-DEFAULT shadow-sm px-4 py-2 bg-white dark:bg-dark-bg-secondary text-base font-medium text-gray-700 dark:text-gray-300 hover:bg-gray-50 dark:hover:bg-dark-bg-tertiary focus:outline-none focus:ring-2 focus:ring-offset-2 focus:ring-indigo-500 sm:mt-0 sm:ml-3 sm:w-auto sm:text-sm\" :class=\"{ 'opacity-50 cursor-not-allowed': ackSubmitting }\">Cancel</button></div></div></div></div>")
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 3, "<!-- Acknowledgment Dialog --><div x-show=\"showAckModal\" x-transition:enter=\"ease-out duration-300\" x-transition:enter-start=\"opacity-0\" x-transition:enter-end=\"opacity-100\" x-transition:leave=\"ease-in duration-200\" x-transition:leave-start=\"opacity-100\" x-transition:leave-end=\"opacity-0\" class=\"fixed inset-0 z-60 overflow-y-auto\" @click.away=\"showAckModal = false\" style=\"display: none;\"><div class=\"flex items-end justify-center min-h-screen pt-4 px-4 pb-20 text-center sm:block sm:p-0\"><!-- Backdrop --><div class=\"fixed inset-0 bg-gray-500/75 dark:bg-black/60 backdrop-blur-sm transition-opacity\" @click=\"showAckModal = false\"></div><span class=\"hidden sm:inline-block sm:align-middle sm:h-screen\">&#8203;</span><div class=\"relative inline-block align-bottom bg-white dark:bg-dark-bg-secondary rounded-xl text-left overflow-hidden shadow-2xl transform transition-all sm:my-8 sm:align-middle sm:max-w-lg sm:w-full z-10 border border-gray-200/50 dark:border-dark-border-subtle/50\" @click.stop x-transition:enter=\"ease-out duration-300\" x-transition:enter-start=\"opacity-0 translate-y-4 sm:translate-y-0 sm:scale-95\" x-transition:enter-end=\"opacity-100 translate-y-0 sm:scale-100\" x-transition:leave=\"ease-in duration-200\" x-transition:leave-start=\"opacity-100 translate-y-0 sm:scale-100\" x-transition:leave-end=\"opacity-0 translate-y-4 sm:translate-y-0 sm:scale-95\"><div class=\"bg-white dark:bg-dark-bg-secondary px-6 pt-6 pb-4\"><div class=\"sm:flex sm:items-start\"><div class=\"mx-auto flex-shrink-0 flex items-center justify-center h-12 w-12 rounded-full bg-green-100 dark:bg-green-900/50 sm:mx-0 sm:h-10 sm:w-10\"><svg class=\"h-6 w-6 text-green-600 dark:text-green-400\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M5 13l4 4L19 7\"></path></svg></div><div class=\"mt-3 text-center sm:mt-0 sm:ml-4 sm:text-left w-full\"><h3 class=\"text-lg font-semibold text-gray-900 dark:text-white\">Acknowledge Alert</h3><div class=\"mt-2\"><p class=\"text-sm text-gray-500 dark:text-gray-400 mb-4\"><span x-show=\"ackAction === 'single'\">Please provide a reason for acknowledging this alert:</span> <span x-show=\"ackAction === 'bulk'\">Please provide a reason for acknowledging <strong x-text=\"selectedAlerts.length + selectedGroups.length\"></strong> alert(s)/group(s):</span> <span x-show=\"ackAction === 'group'\">Please provide a reason for acknowledging the group \"<strong x-text=\"currentGroupName\"></strong>\":</span></p><!-- Alert/Group Information --><div x-show=\"ackAction === 'single' && currentAckAlert\" class=\"mb-4 p-3 bg-gray-50 dark:bg-dark-bg-tertiary rounded-md\"><div class=\"flex items-center space-x-2 text-sm\"><span class=\"font-medium text-gray-900 dark:text-white\">Alert:</span> <span class=\"text-gray-600 dark:text-gray-300\" x-text=\"currentAckAlert?.alertName\"></span></div><div class=\"flex items-center space-x-2 text-sm mt-1\"><span class=\"font-medium text-gray-900 dark:text-white\">Instance:</span> <span class=\"text-gray-600 dark:text-gray-300\" x-text=\"currentAckAlert?.instance\"></span></div></div><!-- Reason Input --><div class=\"mb-4\"><label for=\"ack-reason\" class=\"block text-sm font-medium text-gray-700 dark:text-gray-300 mb-2\">Acknowledgment Reason <span class=\"text-red-500\">*</span></label> <textarea id=\"ack-reason\" x-model=\"ackReason\" rows=\"4\" placeholder=\"Describe why you are acknowledging this alert and what actions you're taking...\" class=\"w-full px-3 py-2 border border-gray-300 dark:border-dark-border-DEFAULT rounded-md shadow-sm focus:outline-none focus:ring-blue-500 focus:border-blue-500 dark:bg-dark-bg-tertiary dark:text-white resize-none\" @keydown.enter.meta.prevent=\"submitAcknowledgment()\" @keydown.enter.ctrl.prevent=\"submitAcknowledgment()\"></textarea><div class=\"mt-1 text-xs text-gray-500 dark:text-gray-400\">Press Ctrl+Enter or Cmd+Enter to submit</div></div><!-- Expiry: the alert needs attention again once it lapses --><div class=\"mb-4\"><label for=\"ack-duration\" class=\"block text-sm font-medium text-gray-700 dark:text-gray-300 mb-2\">Acknowledge For</label> <select id=\"ack-duration\" x-model=\"ackDuration\" class=\"w-full px-3 py-2 border border-gray-300 dark:border-dark-border-DEFAULT rounded-md shadow-sm focus:outline-none focus:ring-blue-500 focus:border-blue-500 dark:bg-dark-bg-tertiary dark:text-white\"><option value=\"\">Until removed</option> <option value=\"30m\">30 minutes</option> <option value=\"1h\">1 hour</option> <option value=\"2h\">2 hours</option> <option value=\"4h\">4 hours</option> <option value=\"8h\">8 hours</option> <option value=\"24h\">24 hours</option></select> <label x-show=\"ackDuration\" class=\"mt-2 flex items-center text-sm text-gray-700 dark:text-gray-300\"><input type=\"checkbox\" x-model=\"ackRenotify\" class=\"mr-2 rounded border-gray-300 text-blue-600 focus:ring-blue-500\"> Notify again when it lapses</label></div><!-- Quick Reason Templates --><div class=\"mb-4\"><label class=\"block text-sm font-medium text-gray-700 dark:text-gray-300 mb-2\">Quick Templates:</label><div class=\"flex flex-wrap gap-2\"><button @click=\"ackReason = 'Investigating the issue'\" class=\"px-3 py-1 text-xs bg-blue-100 dark:bg-blue-800 text-blue-800 dark:text-blue-200 rounded-full hover:bg-blue-200 dark:hover:bg-blue-700\">Investigating</button> <button @click=\"ackReason = 'Working on a fix'\" class=\"px-3 py-1 text-xs bg-green-100 dark:bg-green-800 text-green-800 dark:text-green-200 rounded-full hover:bg-green-200 dark:hover:bg-green-700\">Working on fix</button> <button @click=\"ackReason = 'Monitoring the situation'\" class=\"px-3 py-1 text-xs bg-yellow-100 dark:bg-yellow-800 text-yellow-800 dark:text-yellow-200 rounded-full hover:bg-yellow-200 dark:hover:bg-yellow-700\">Monitoring</button> <button @click=\"ackReason = 'False positive - expected behavior'\" class=\"px-3 py-1 text-xs bg-gray-100 dark:bg-dark-bg-secondary text-gray-800 dark:text-gray-200 rounded-full hover:bg-gray-200 dark:hover:bg-dark-bg-tertiary\">False positive</button></div></div><!-- Validation Error --><div x-show=\"ackError\" class=\"mb-4 p-3 bg-red-50 dark:bg-red-900/50 border border-red-200 dark:border-red-800 rounded-md\"><div class=\"flex\"><svg class=\"w-5 h-5 text-red-400\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M12 9v2m0 4h.01m-6.938 4h13.856c1.54 0 2.502-1.667 1.732-2.5L13.732 4c-.77-.833-1.964-.833-2.732 0L4.082 16.5c-.77.833.192 2.5 1.732 2.5z\"></path></svg><div class=\"ml-3\"><p class=\"text-sm text-red-800 dark:text-red-200\" x-text=\"ackError\"></p></div></div></div></div></div></div></div><div class=\"bg-gray-50 dark:bg-dark-bg-tertiary px-4 py-3 sm:px-6 sm:flex sm:flex-row-reverse\"><button type=\"button\" @click=\"submitAcknowledgment()\" :disabled=\"!ackReason.trim() || ackSubmitting\" class=\"w-full inline-flex justify-center items-center rounded-md border border-transparent shadow-sm px-4 py-2 text-base font-medium text-white sm:ml-3 sm:w-auto sm:text-sm transition-colors duration-200\" :class=\"{\n\t\t\t\t\t\t\t\t'bg-green-600 hover:bg-green-700 focus:ring-green-500': ackReason.trim() && !ackSubmitting,\n\t\t\t\t\t\t\t\t'bg-gray-400 cursor-not-allowed': !ackReason.trim() || ackSubmitting\n\t\t\t\t\t\t\t}\"><svg x-show=\"ackSubmitting\" class=\"animate-spin -ml-1 mr-2 h-4 w-4 text-white\" fill=\"none\" viewBox=\"0 0 24 24\"><circle class=\"opacity-25\" cx=\"12\" cy=\"12\" r=\"10\" stroke=\"currentColor\" stroke-width=\"4\"></circle> <path class=\"opacity-75\" fill=\"currentColor\" d=\"M4 12a8 8 0 018-8V0C5.373 0 0 5.373 0 12h4zm2 5.291A7.962 7.962 0 014 12H0c0 3.042 1.135 5.824 3 7.938l3-2.647z\"></path></svg> <span x-show=\"!ackSubmitting\">Acknowledge</span> <span x-show=\"ackSubmitting\">Processing...</span></button> <button type=\"button\" @click=\"cancelAcknowledgment()\" :disabled=\"ackSubmitting\" class=\"mt-3 w-full inline-flex justify-center rounded-md border border-gray-300 dark:border-dark-border-DEFAULT shadow-sm px-4 py-2 bg-white dark:bg-dark-bg-secondary text-base font-medium text-gray-700 dark:text-gray-300 hover:bg-gray-50 dark:hover:bg-dark-bg-tertiary focus:outline-none focus:ring-2 focus:ring-offset-2 focus:ring-indigo-500 sm:mt-0 sm:ml-3 sm:w-auto sm:text-sm\" :class=\"{ 'opacity-50 cursor-not-allowed': ackSubmitting }\">Cancel</button></div></div></div></div>")
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
@@ -76,8 +76,7 @@ func AcknowledgmentModal() templ.Component {
 	})
 }
 
-// Alert Details Modal Component
-func AlertDetailsModal() templ.Component {
+func SnoozeModal() templ.Component {
 	return templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
 		templ_7745c5c3_W, ctx := templ_7745c5c3_Input.Writer, templ_7745c5c3_Input.Context
 		if templ_7745c5c3_CtxErr := ctx.Err(); templ_7745c5c3_CtxErr != nil {
@@ -98,7 +97,182 @@ func AlertDetailsModal() templ.Component {
 			templ_7745c5c3_Var3 = templ.NopComponent
 		}
 		ctx = templ.ClearChildren(ctx)
-		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 4, "<!-- Alert Details Modal Dialog --><div x-show=\"showAlertModal\" x-transition:enter=\"ease-out duration-300\" x-transition:enter-start=\"opacity-0\" x-transition:enter-end=\"opacity-100\" x-transition:leave=\"ease-in duration-200\" x-transition:leave-start=\"opacity-100\" x-transition:leave-end=\"opacity-0\" class=\"relative z-50\" aria-labelledby=\"modal-title\" role=\"dialog\" aria-modal=\"true\" style=\"display: none;\"><!-- Background backdrop with modern blur effect --><div class=\"fixed inset-0 bg-black/60 backdrop-blur-sm transition-all duration-300\"></div><!-- Modal container --><div class=\"fixed inset-0 z-50 overflow-y-auto\"><div class=\"flex min-h-full items-center justify-center p-2 sm:p-4\"><!-- Modal panel with modern design --><div x-transition:enter=\"ease-out duration-300\" x-transition:enter-start=\"opacity-0 translate-y-8 scale-95\" x-transition:enter-end=\"opacity-100 translate-y-0 scale-100\" x-transition:leave=\"ease-in duration-200\" x-transition:leave-start=\"opacity-100 translate-y-0 scale-100\" x-transition:leave-end=\"opacity-0 translate-y-8 scale-95\" @click.away=\"closeAlertModal()\" class=\"relative transform rounded-2xl bg-white dark:bg-dark-bg-secondary shadow-2xl transition-all w-full max-w-7xl max-h-[95vh] overflow-hidden border border-gray-200/50 dark:border-dark-border-subtle/50\"><!-- Modern Loading state --><div x-show=\"!alertDetails\">")
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 4, "<!-- Snooze Dialog --><div x-show=\"showSnoozeModal\" x-transition:enter=\"ease-out duration-300\" x-transition:enter-start=\"opacity-0\" x-transition:enter-end=\"opacity-100\" x-transition:leave=\"ease-in duration-200\" x-transition:leave-start=\"opacity-100\" x-transition:leave-end=\"opacity-0\" class=\"fixed inset-0 z-60 overflow-y-auto\" style=\"display: none;\"><div class=\"flex items-end justify-center min-h-screen pt-4 px-4 pb-20 text-center sm:block sm:p-0\"><!-- Backdrop --><div class=\"fixed inset-0 bg-gray-500/75 dark:bg-black/60 backdrop-blur-sm transition-opacity\" @click=\"showSnoozeModal = false\"></div><span class=\"hidden sm:inline-block sm:align-middle sm:h-screen\">&#8203;</span><div class=\"relative inline-block align-bottom bg-white dark:bg-dark-bg-secondary rounded-xl text-left overflow-hidden shadow-2xl transform transition-all sm:my-8 sm:align-middle sm:max-w-lg sm:w-full z-10 border border-gray-200/50 dark:border-dark-border-subtle/50\" @click.stop><div class=\"bg-white dark:bg-dark-bg-secondary px-6 pt-6 pb-4\"><div class=\"sm:flex sm:items-start\"><div class=\"mx-auto flex-shrink-0 flex items-center justify-center h-12 w-12 rounded-full bg-indigo-100 dark:bg-indigo-900/50 sm:mx-0 sm:h-10 sm:w-10\"><!-- Heroicon: clock --><svg class=\"h-6 w-6 text-indigo-600 dark:text-indigo-400\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M12 6v6h4.5m4.5 0a9 9 0 1 1-18 0 9 9 0 0 1 18 0Z\"></path></svg></div><div class=\"mt-3 text-center sm:mt-0 sm:ml-4 sm:text-left w-full\"><h3 class=\"text-lg font-semibold text-gray-900 dark:text-white\">Snooze Alert</h3><p class=\"mt-2 text-sm text-gray-500 dark:text-gray-400 mb-4\"><span x-show=\"snoozeFingerprints.length === 1\">Hide this alert from your view until the snooze ends. Unlike a silence, nobody else is affected.</span> <span x-show=\"snoozeFingerprints.length > 1\">Hide <strong x-text=\"snoozeFingerprints.length\"></strong> alerts from your view until the snooze ends. Unlike a silence, nobody else is affected.</span></p><!-- Duration Picker --><div class=\"mb-4\"><label class=\"block text-sm font-medium text-gray-700 dark:text-gray-300 mb-2\">Snooze for</label><div class=\"flex flex-wrap gap-2\"><template x-for=\"option in snoozeOptions\" :key=\"option.value\"><button type=\"button\" @click=\"snoozeDuration = option.value\" class=\"px-3 py-1 text-sm rounded-full border\" :class=\"snoozeDuration === option.value\n\t\t\t\t\t\t\t\t\t\t\t\t\t? 'bg-indigo-600 text-white border-indigo-500'\n\t\t\t\t\t\t\t\t\t\t\t\t\t: 'bg-indigo-50 text-indigo-700 border-indigo-200 hover:bg-indigo-200 dark:bg-indigo-900/50 dark:text-indigo-300'\" x-text=\"option.label\"></button></template></div></div><!-- Reason Input --><div class=\"mb-4\"><label for=\"snooze-reason\" class=\"block text-sm font-medium text-gray-700 dark:text-gray-300 mb-2\">Reason</label> <input id=\"snooze-reason\" type=\"text\" x-model=\"snoozeReason\" placeholder=\"Optional\" @keydown.enter.prevent=\"submitSnooze()\" class=\"w-full px-3 py-2 border border-gray-300 dark:border-dark-border-DEFAULT rounded-md shadow-sm focus:outline-none focus:ring-blue-500 focus:border-blue-500 dark:bg-dark-bg-tertiary dark:text-white\"></div><p x-show=\"snoozeError\" class=\"mb-2 text-sm text-red-600 dark:text-red-400\" x-text=\"snoozeError\"></p></div></div></div><div class=\"bg-gray-50 dark:bg-dark-bg-tertiary px-4 py-3 sm:px-6 sm:flex sm:flex-row-reverse\"><button type=\"button\" @click=\"submitSnooze()\" :disabled=\"snoozeSubmitting\" class=\"w-full inline-flex justify-center items-center rounded-md border border-transparent shadow-sm px-4 py-2 text-base font-medium text-white bg-indigo-600 sm:ml-3 sm:w-auto sm:text-sm\" :class=\"{ 'opacity-50 cursor-not-allowed': snoozeSubmitting }\"><span x-show=\"!snoozeSubmitting\">Snooze</span> <span x-show=\"snoozeSubmitting\">Snoozing...</span></button> <button type=\"button\" @click=\"showSnoozeModal = false\" :disabled=\"snoozeSubmitting\" class=\"mt-3 w-full inline-flex justify-center rounded-md border border-gray-300 dark:border-dark-border-DEFAULT shadow-sm px-4 py-2 bg-white dark:bg-dark-bg-secondary text-base font-medium text-gray-700 dark:text-gray-300 hover:bg-gray-50 dark:hover:bg-dark-bg-tertiary focus:outline-none sm:mt-0 sm:ml-3 sm:w-auto sm:text-sm\">Cancel</button></div></div></div></div>")
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		return nil
+	})
+}
+
+func ShareAlertModal() templ.Component {
+	return templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
+		templ_7745c5c3_W, ctx := templ_7745c5c3_Input.Writer, templ_7745c5c3_Input.Context
+		if templ_7745c5c3_CtxErr := ctx.Err(); templ_7745c5c3_CtxErr != nil {
+			return templ_7745c5c3_CtxErr
+		}
+		templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templruntime.GetBuffer(templ_7745c5c3_W)
+		if !templ_7745c5c3_IsBuffer {
+			defer func() {
+				templ_7745c5c3_BufErr := templruntime.ReleaseBuffer(templ_7745c5c3_Buffer)
+				if templ_7745c5c3_Err == nil {
+					templ_7745c5c3_Err = templ_7745c5c3_BufErr
+				}
+			}()
+		}
+		ctx = templ.InitializeContext(ctx)
+		templ_7745c5c3_Var4 := templ.GetChildren(ctx)
+		if templ_7745c5c3_Var4 == nil {
+			templ_7745c5c3_Var4 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 5, "<!-- Share Alert Dialog --><div x-show=\"showShareModal\" x-transition:enter=\"ease-out duration-300\" x-transition:enter-start=\"opacity-0\" x-transition:enter-end=\"opacity-100\" x-transition:leave=\"ease-in duration-200\" x-transition:leave-start=\"opacity-100\" x-transition:leave-end=\"opacity-0\" @keydown.escape.window=\"showShareModal = false\" class=\"fixed inset-0 z-60 overflow-y-auto\" style=\"display: none;\"><div class=\"flex items-end justify-center min-h-screen pt-4 px-4 pb-20 text-center sm:block sm:p-0\"><!-- Backdrop --><div class=\"fixed inset-0 bg-gray-500/75 dark:bg-black/60 backdrop-blur-sm transition-opacity\" @click=\"showShareModal = false\"></div><span class=\"hidden sm:inline-block sm:align-middle sm:h-screen\">&#8203;</span><div class=\"relative inline-block align-bottom bg-white dark:bg-dark-bg-secondary rounded-xl text-left overflow-hidden shadow-2xl transform transition-all sm:my-8 sm:align-middle sm:max-w-lg sm:w-full z-10 border border-gray-200/50 dark:border-dark-border-subtle/50\" @click.stop><div class=\"bg-white dark:bg-dark-bg-secondary px-6 pt-6 pb-4\"><div class=\"sm:flex sm:items-start\"><div class=\"mx-auto flex-shrink-0 flex items-center justify-center h-12 w-12 rounded-full bg-indigo-100 dark:bg-indigo-900/50 sm:mx-0 sm:h-10 sm:w-10\"><!-- Heroicon: share --><svg class=\"h-6 w-6 text-indigo-600 dark:text-indigo-400\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M7.217 10.907a2.25 2.25 0 1 0 0 2.186m0-2.186c.18.324.283.696.283 1.093s-.103.77-.283 1.093m0-2.186 9.566-5.314m-9.566 7.5 9.566 5.314m0 0a2.25 2.25 0 1 0 3.935 2.186 2.25 2.25 0 0 0-3.935-2.186Zm0-12.814a2.25 2.25 0 1 0 3.933-2.185 2.25 2.25 0 0 0-3.933 2.185Z\"></path></svg></div><div class=\"mt-3 text-center sm:mt-0 sm:ml-4 sm:text-left w-full\"><h3 class=\"text-lg font-semibold text-gray-900 dark:text-white\">Share Alert</h3><p class=\"mt-2 text-sm text-gray-500 dark:text-gray-400 mb-4\">Anyone with access to Notificator can open this link.</p><!-- Link --><div class=\"mb-4 flex gap-2\"><input type=\"text\" readonly :value=\"alertDetails?.alert ? alertShareLink(alertDetails.alert.fingerprint) : ''\" @focus=\"$event.target.select()\" class=\"flex-1 min-w-0 px-3 py-2 text-sm border border-gray-300 dark:border-dark-border-DEFAULT rounded-md bg-gray-50 dark:bg-dark-bg-tertiary dark:text-white\"> <button type=\"button\" @click=\"copyShareLink()\" class=\"px-3 py-2 text-sm font-medium rounded-md text-white bg-indigo-600 hover:bg-indigo-500\" x-text=\"alertLinkCopied ? 'Copied!' : 'Copy link'\"></button></div><!-- Note --><div class=\"mb-4\"><label for=\"share-note\" class=\"block text-sm font-medium text-gray-700 dark:text-gray-300 mb-2\">Message</label> <textarea id=\"share-note\" x-model=\"shareNote\" rows=\"3\" placeholder=\"Optional, sent along with the link\" class=\"w-full px-3 py-2 border border-gray-300 dark:border-dark-border-DEFAULT rounded-md shadow-sm focus:outline-none focus:ring-blue-500 focus:border-blue-500 dark:bg-dark-bg-tertiary dark:text-white\"></textarea></div><!-- Targets --><div class=\"flex flex-wrap gap-2\"><button type=\"button\" x-show=\"shareTargets?.comments\" @click=\"shareAsComment()\" :disabled=\"shareSending\" class=\"px-3 py-1.5 text-sm rounded-md border border-gray-300 dark:border-dark-border-DEFAULT text-gray-700 dark:text-gray-300 hover:bg-gray-50 dark:hover:bg-dark-bg-tertiary\">Post as comment</button> <button type=\"button\" x-show=\"shareTargets?.slack\" @click=\"shareToSlack()\" :disabled=\"shareSending\" class=\"px-3 py-1.5 text-sm rounded-md border border-gray-300 dark:border-dark-border-DEFAULT text-gray-700 dark:text-gray-300 hover:bg-gray-50 dark:hover:bg-dark-bg-tertiary\" x-text=\"shareTargets?.slackChannel ? 'Send to Slack ' + shareTargets.slackChannel : 'Send to Slack'\"></button> <button type=\"button\" x-show=\"shareTargets?.email\" @click=\"shareByEmail()\" :disabled=\"shareSending\" class=\"px-3 py-1.5 text-sm rounded-md border border-gray-300 dark:border-dark-border-DEFAULT text-gray-700 dark:text-gray-300 hover:bg-gray-50 dark:hover:bg-dark-bg-tertiary\">Send by email</button> <a x-show=\"!shareTargets?.email\" :href=\"shareEmailHref()\" class=\"px-3 py-1.5 text-sm rounded-md border border-gray-300 dark:border-dark-border-DEFAULT text-gray-700 dark:text-gray-300 hover:bg-gray-50 dark:hover:bg-dark-bg-tertiary\">Email</a></div><p x-show=\"shareSending\" class=\"mt-3 text-sm text-gray-500 dark:text-gray-400\">Sharing...</p><p x-show=\"shareStatus\" class=\"mt-3 text-sm text-green-600 dark:text-green-400\" x-text=\"shareStatus\"></p><p x-show=\"shareError\" class=\"mt-3 text-sm text-red-600 dark:text-red-400\" x-text=\"shareError\"></p></div></div></div><div class=\"bg-gray-50 dark:bg-dark-bg-tertiary px-4 py-3 sm:px-6 sm:flex sm:flex-row-reverse\"><button type=\"button\" @click=\"showShareModal = false\" class=\"mt-3 w-full inline-flex justify-center rounded-md border border-gray-300 dark:border-dark-border-DEFAULT shadow-sm px-4 py-2 bg-white dark:bg-dark-bg-secondary text-base font-medium text-gray-700 dark:text-gray-300 hover:bg-gray-50 dark:hover:bg-dark-bg-tertiary focus:outline-none sm:mt-0 sm:ml-3 sm:w-auto sm:text-sm\">Close</button></div></div></div></div>")
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		return nil
+	})
+}
+
+func CommandPalette() templ.Component {
+	return templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
+		templ_7745c5c3_W, ctx := templ_7745c5c3_Input.Writer, templ_7745c5c3_Input.Context
+		if templ_7745c5c3_CtxErr := ctx.Err(); templ_7745c5c3_CtxErr != nil {
+			return templ_7745c5c3_CtxErr
+		}
+		templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templruntime.GetBuffer(templ_7745c5c3_W)
+		if !templ_7745c5c3_IsBuffer {
+			defer func() {
+				templ_7745c5c3_BufErr := templruntime.ReleaseBuffer(templ_7745c5c3_Buffer)
+				if templ_7745c5c3_Err == nil {
+					templ_7745c5c3_Err = templ_7745c5c3_BufErr
+				}
+			}()
+		}
+		ctx = templ.InitializeContext(ctx)
+		templ_7745c5c3_Var5 := templ.GetChildren(ctx)
+		if templ_7745c5c3_Var5 == nil {
+			templ_7745c5c3_Var5 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 6, "<!-- Command Palette (Ctrl/Cmd+K) --><div x-show=\"showCommandPalette\" class=\"fixed inset-0 z-60 overflow-y-auto\" @keydown.escape.window=\"closeCommandPalette()\" style=\"display: none;\"><div class=\"fixed inset-0 bg-gray-500/75 dark:bg-black/60 backdrop-blur-sm\" @click=\"closeCommandPalette()\"></div><div class=\"relative mx-auto max-w-lg bg-white dark:bg-dark-bg-secondary rounded-xl shadow-2xl border border-gray-200/50 dark:border-dark-border-subtle/50 overflow-hidden\" style=\"margin-top: 6rem;\" @click.stop><input id=\"command-palette-input\" type=\"text\" x-model=\"commandQuery\" @input=\"commandIndex = 0\" @keydown.arrow-down.prevent=\"moveCommandSelection(1)\" @keydown.arrow-up.prevent=\"moveCommandSelection(-1)\" @keydown.enter.prevent=\"runSelectedCommand()\" placeholder=\"Type a command...\" autocomplete=\"off\" class=\"w-full px-4 py-3 text-sm border-0 border-b border-gray-200 dark:border-dark-border-subtle focus:outline-none dark:bg-dark-bg-secondary dark:text-white\"><ul class=\"max-h-64 overflow-y-auto py-2\"><template x-for=\"(command, index) in paletteCommands()\" :key=\"command.id\"><li><button type=\"button\" @click=\"runCommand(command)\" @mouseenter=\"commandIndex = index\" class=\"w-full px-4 py-2 text-left text-sm text-gray-700 dark:text-gray-200\" :class=\"{ 'bg-blue-50 dark:bg-dark-bg-tertiary text-blue-700 dark:text-blue-300': index === commandIndex }\" x-text=\"command.title\"></button></li></template><li x-show=\"paletteCommands().length === 0\" class=\"px-4 py-2 text-sm text-gray-500 dark:text-gray-400\">No matching commands</li></ul></div></div>")
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		return nil
+	})
+}
+
+func MentionToast() templ.Component {
+	return templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
+		templ_7745c5c3_W, ctx := templ_7745c5c3_Input.Writer, templ_7745c5c3_Input.Context
+		if templ_7745c5c3_CtxErr := ctx.Err(); templ_7745c5c3_CtxErr != nil {
+			return templ_7745c5c3_CtxErr
+		}
+		templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templruntime.GetBuffer(templ_7745c5c3_W)
+		if !templ_7745c5c3_IsBuffer {
+			defer func() {
+				templ_7745c5c3_BufErr := templruntime.ReleaseBuffer(templ_7745c5c3_Buffer)
+				if templ_7745c5c3_Err == nil {
+					templ_7745c5c3_Err = templ_7745c5c3_BufErr
+				}
+			}()
+		}
+		ctx = templ.InitializeContext(ctx)
+		templ_7745c5c3_Var6 := templ.GetChildren(ctx)
+		if templ_7745c5c3_Var6 == nil {
+			templ_7745c5c3_Var6 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 7, "<!-- Mention notice --><div x-show=\"mentionNotice\" x-transition:enter=\"ease-out duration-300\" x-transition:enter-start=\"opacity-0 translate-y-2\" x-transition:enter-end=\"opacity-100 translate-y-0\" x-transition:leave=\"ease-in duration-200\" x-transition:leave-start=\"opacity-100\" x-transition:leave-end=\"opacity-0\" class=\"fixed bottom-4 right-4 max-w-sm w-full\" style=\"display: none; z-index: 70;\"><div class=\"bg-white dark:bg-dark-bg-secondary rounded-xl shadow-2xl border border-gray-200/50 dark:border-dark-border-subtle/50 p-4 flex items-start space-x-3\"><div class=\"flex-shrink-0 flex items-center justify-center h-8 w-8 rounded-full bg-blue-100 dark:bg-blue-900/50 text-blue-600 dark:text-blue-400 font-semibold\">&#64;</div><div class=\"flex-1 min-w-0\"><p class=\"text-sm font-medium text-gray-900 dark:text-white\" x-text=\"mentionNotice?.message\"></p><p class=\"mt-1 text-sm text-gray-500 dark:text-gray-400 truncate\" x-text=\"mentionNotice?.content\"></p><button type=\"button\" @click=\"openMentionedAlert()\" class=\"mt-2 text-sm font-medium text-blue-600 dark:text-blue-400 hover:underline\">View alert</button></div><button type=\"button\" @click=\"mentionNotice = null\" class=\"text-gray-400 hover:text-gray-600 dark:hover:text-gray-300\" aria-label=\"Dismiss\"><svg class=\"h-4 w-4\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M6 18L18 6M6 6l12 12\"></path></svg></button></div></div>")
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		return nil
+	})
+}
+
+func UndoToast() templ.Component {
+	return templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
+		templ_7745c5c3_W, ctx := templ_7745c5c3_Input.Writer, templ_7745c5c3_Input.Context
+		if templ_7745c5c3_CtxErr := ctx.Err(); templ_7745c5c3_CtxErr != nil {
+			return templ_7745c5c3_CtxErr
+		}
+		templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templruntime.GetBuffer(templ_7745c5c3_W)
+		if !templ_7745c5c3_IsBuffer {
+			defer func() {
+				templ_7745c5c3_BufErr := templruntime.ReleaseBuffer(templ_7745c5c3_Buffer)
+				if templ_7745c5c3_Err == nil {
+					templ_7745c5c3_Err = templ_7745c5c3_BufErr
+				}
+			}()
+		}
+		ctx = templ.InitializeContext(ctx)
+		templ_7745c5c3_Var7 := templ.GetChildren(ctx)
+		if templ_7745c5c3_Var7 == nil {
+			templ_7745c5c3_Var7 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 8, "<!-- Undo of the last \"clear all\" --><div x-show=\"undoNotice\" x-transition:enter=\"ease-out duration-300\" x-transition:enter-start=\"opacity-0 translate-y-2\" x-transition:enter-end=\"opacity-100 translate-y-0\" x-transition:leave=\"ease-in duration-200\" x-transition:leave-start=\"opacity-100\" x-transition:leave-end=\"opacity-0\" class=\"fixed bottom-6 left-6 max-w-sm w-full\" style=\"display: none; z-index: 70;\"><div class=\"bg-gray-900 dark:bg-dark-bg-tertiary text-white rounded-xl shadow-2xl p-4 flex items-center space-x-3\"><div class=\"flex-1 min-w-0\"><p class=\"text-sm font-medium\" x-text=\"undoNotice?.message\"></p><p x-show=\"undoNotice?.error\" class=\"mt-1 text-xs text-red-300\" x-text=\"undoNotice?.error\"></p></div><button type=\"button\" @click=\"undoClear()\" :disabled=\"undoNotice?.undoing\" class=\"text-sm font-semibold text-blue-400 hover:text-blue-300 disabled:opacity-50\">Undo</button> <button type=\"button\" @click=\"undoNotice = null\" class=\"text-gray-400 hover:text-gray-200\" aria-label=\"Dismiss\"><svg class=\"h-4 w-4\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M6 18L18 6M6 6l12 12\"></path></svg></button></div></div>")
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		return nil
+	})
+}
+
+func EscalationModal() templ.Component {
+	return templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
+		templ_7745c5c3_W, ctx := templ_7745c5c3_Input.Writer, templ_7745c5c3_Input.Context
+		if templ_7745c5c3_CtxErr := ctx.Err(); templ_7745c5c3_CtxErr != nil {
+			return templ_7745c5c3_CtxErr
+		}
+		templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templruntime.GetBuffer(templ_7745c5c3_W)
+		if !templ_7745c5c3_IsBuffer {
+			defer func() {
+				templ_7745c5c3_BufErr := templruntime.ReleaseBuffer(templ_7745c5c3_Buffer)
+				if templ_7745c5c3_Err == nil {
+					templ_7745c5c3_Err = templ_7745c5c3_BufErr
+				}
+			}()
+		}
+		ctx = templ.InitializeContext(ctx)
+		templ_7745c5c3_Var8 := templ.GetChildren(ctx)
+		if templ_7745c5c3_Var8 == nil {
+			templ_7745c5c3_Var8 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 9, "<!-- Escalation Dialog --><div x-show=\"showEscalateModal\" x-transition:enter=\"ease-out duration-300\" x-transition:enter-start=\"opacity-0\" x-transition:enter-end=\"opacity-100\" x-transition:leave=\"ease-in duration-200\" x-transition:leave-start=\"opacity-100\" x-transition:leave-end=\"opacity-0\" class=\"fixed inset-0 z-60 overflow-y-auto\" style=\"display: none;\"><div class=\"flex items-end justify-center min-h-screen pt-4 px-4 pb-20 text-center sm:block sm:p-0\"><!-- Backdrop --><div class=\"fixed inset-0 bg-gray-500/75 dark:bg-black/60 backdrop-blur-sm transition-opacity\" @click=\"cancelEscalation()\"></div><span class=\"hidden sm:inline-block sm:align-middle sm:h-screen\">&#8203;</span><div class=\"relative inline-block align-bottom bg-white dark:bg-dark-bg-secondary rounded-xl text-left overflow-hidden shadow-2xl transform transition-all sm:my-8 sm:align-middle sm:max-w-lg sm:w-full z-10 border border-gray-200/50 dark:border-dark-border-subtle/50\" @click.stop><div class=\"bg-white dark:bg-dark-bg-secondary px-6 pt-6 pb-4\"><div class=\"sm:flex sm:items-start\"><div class=\"mx-auto flex-shrink-0 flex items-center justify-center h-12 w-12 rounded-full bg-red-100 dark:bg-red-900/50 sm:mx-0 sm:h-10 sm:w-10\"><svg class=\"h-6 w-6 text-red-600 dark:text-red-400\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M5 10l7-7m0 0l7 7m-7-7v18\"></path></svg></div><div class=\"mt-3 text-center sm:mt-0 sm:ml-4 sm:text-left w-full\"><h3 class=\"text-lg font-semibold text-gray-900 dark:text-white\">Escalate Alert</h3><p class=\"mt-1 text-sm text-gray-500 dark:text-gray-400\" x-text=\"alertDetails?.alert?.alertName\"></p><!-- Target Type --><div class=\"mt-4 flex space-x-2\"><button @click=\"escalateTargetType = 'user'; escalateTarget = ''\" class=\"px-3 py-1 text-sm rounded-md border\" :class=\"escalateTargetType === 'user' ? 'bg-red-600 border-red-600 text-white' : 'border-gray-300 dark:border-dark-border-DEFAULT text-gray-700 dark:text-gray-300'\">User</button> <button @click=\"escalateTargetType = 'group'; escalateTarget = ''; loadEscalationGroups()\" class=\"px-3 py-1 text-sm rounded-md border\" :class=\"escalateTargetType === 'group' ? 'bg-red-600 border-red-600 text-white' : 'border-gray-300 dark:border-dark-border-DEFAULT text-gray-700 dark:text-gray-300'\">Group</button></div><!-- User Picker --><div x-show=\"escalateTargetType === 'user'\" class=\"mt-4\"><label for=\"escalate-user\" class=\"block text-sm font-medium text-gray-700 dark:text-gray-300 mb-2\">Escalate to <span class=\"text-red-500\">*</span></label> <input id=\"escalate-user\" type=\"text\" x-model=\"escalateUserQuery\" @input.debounce.300ms=\"searchEscalationUsers()\" placeholder=\"Search users by username...\" class=\"w-full px-3 py-2 border border-gray-300 dark:border-dark-border-DEFAULT rounded-md shadow-sm focus:outline-none focus:ring-red-500 focus:border-red-500 dark:bg-dark-bg-tertiary dark:text-white\"><div x-show=\"escalateUserResults.length > 0\" class=\"mt-2 max-h-40 overflow-y-auto border border-gray-200 dark:border-dark-border-subtle rounded-md\"><template x-for=\"user in escalateUserResults\" :key=\"user.id\"><button @click=\"escalateTarget = user.username; escalateUserQuery = user.username; escalateUserResults = []\" class=\"w-full text-left px-3 py-2 text-sm text-gray-700 dark:text-gray-300 hover:bg-gray-100 dark:hover:bg-dark-bg-tertiary\" x-text=\"user.username\"></button></template></div><p x-show=\"escalateTarget\" class=\"mt-1 text-xs text-gray-500 dark:text-gray-400\">Selected: <span class=\"font-medium\" x-text=\"'@' + escalateTarget\"></span></p></div><!-- Group Picker --><div x-show=\"escalateTargetType === 'group'\" class=\"mt-4\"><label for=\"escalate-group\" class=\"block text-sm font-medium text-gray-700 dark:text-gray-300 mb-2\">Escalate to <span class=\"text-red-500\">*</span></label> <select id=\"escalate-group\" x-model=\"escalateTarget\" class=\"w-full px-3 py-2 border border-gray-300 dark:border-dark-border-DEFAULT rounded-md shadow-sm focus:outline-none focus:ring-red-500 focus:border-red-500 dark:bg-dark-bg-tertiary dark:text-white\"><option value=\"\">Select a group...</option><template x-for=\"group in escalateGroups\" :key=\"group.id\"><option :value=\"group.name\" x-text=\"group.name\"></option></template></select><p x-show=\"escalateGroups.length === 0\" class=\"mt-1 text-xs text-gray-500 dark:text-gray-400\">No groups available. Groups are synced from your OAuth provider.</p></div><!-- Reason --><div class=\"mt-4\"><label for=\"escalate-reason\" class=\"block text-sm font-medium text-gray-700 dark:text-gray-300 mb-2\">Reason</label> <textarea id=\"escalate-reason\" x-model=\"escalateReason\" rows=\"3\" placeholder=\"Why does this need someone else's attention?\" class=\"w-full px-3 py-2 border border-gray-300 dark:border-dark-border-DEFAULT rounded-md shadow-sm focus:outline-none focus:ring-red-500 focus:border-red-500 dark:bg-dark-bg-tertiary dark:text-white resize-none\"></textarea></div><!-- Validation Error --><div x-show=\"escalateError\" class=\"mt-4 p-3 bg-red-50 dark:bg-red-900/50 border border-red-200 dark:border-red-800 rounded-md\"><p class=\"text-sm text-red-800 dark:text-red-200\" x-text=\"escalateError\"></p></div></div></div></div><div class=\"bg-gray-50 dark:bg-dark-bg-tertiary px-4 py-3 sm:px-6 sm:flex sm:flex-row-reverse\"><button type=\"button\" @click=\"submitEscalation()\" :disabled=\"!escalateTarget || escalateSubmitting\" class=\"w-full inline-flex justify-center items-center rounded-md border border-transparent shadow-sm px-4 py-2 text-base font-medium text-white sm:ml-3 sm:w-auto sm:text-sm transition-colors duration-200\" :class=\"escalateTarget && !escalateSubmitting ? 'bg-red-600 hover:bg-red-700' : 'bg-gray-400 cursor-not-allowed'\"><span x-show=\"!escalateSubmitting\">Escalate</span> <span x-show=\"escalateSubmitting\">Escalating...</span></button> <button type=\"button\" @click=\"cancelEscalation()\" :disabled=\"escalateSubmitting\" class=\"mt-3 w-full inline-flex justify-center rounded-md border border-gray-300 dark:border-dark-border-DEFAULT shadow-sm px-4 py-2 bg-white dark:bg-dark-bg-secondary text-base font-medium text-gray-700 dark:text-gray-300 hover:bg-gray-50 dark:hover:bg-dark-bg-tertiary sm:mt-0 sm:ml-3 sm:w-auto sm:text-sm\">Cancel</button></div></div></div></div>")
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		return nil
+	})
+}
+
+// Alert Details Modal Component
+func AlertDetailsModal() templ.Component {
+	return templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
+		templ_7745c5c3_W, ctx := templ_7745c5c3_Input.Writer, templ_7745c5c3_Input.Context
+		if templ_7745c5c3_CtxErr := ctx.Err(); templ_7745c5c3_CtxErr != nil {
+			return templ_7745c5c3_CtxErr
+		}
+		templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templruntime.GetBuffer(templ_7745c5c3_W)
+		if !templ_7745c5c3_IsBuffer {
+			defer func() {
+				templ_7745c5c3_BufErr := templruntime.ReleaseBuffer(templ_7745c5c3_Buffer)
+				if templ_7745c5c3_Err == nil {
+					templ_7745c5c3_Err = templ_7745c5c3_BufErr
+				}
+			}()
+		}
+		ctx = templ.InitializeContext(ctx)
+		templ_7745c5c3_Var9 := templ.GetChildren(ctx)
+		if templ_7745c5c3_Var9 == nil {
+			templ_7745c5c3_Var9 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 10, "<!-- Alert Details Modal Dialog --><div x-show=\"showAlertModal\" x-transition:enter=\"ease-out duration-300\" x-transition:enter-start=\"opacity-0\" x-transition:enter-end=\"opacity-100\" x-transition:leave=\"ease-in duration-200\" x-transition:leave-start=\"opacity-100\" x-transition:leave-end=\"opacity-0\" class=\"relative z-50\" aria-labelledby=\"modal-title\" role=\"dialog\" aria-modal=\"true\" style=\"display: none;\"><!-- Background backdrop with modern blur effect --><div class=\"fixed inset-0 bg-black/60 backdrop-blur-sm transition-all duration-300\"></div><!-- Modal container --><div class=\"fixed inset-0 z-50 overflow-y-auto\"><div class=\"flex min-h-full items-center justify-center p-2 sm:p-4\"><!-- Modal panel with modern design --><div x-transition:enter=\"ease-out duration-300\" x-transition:enter-start=\"opacity-0 translate-y-8 scale-95\" x-transition:enter-end=\"opacity-100 translate-y-0 scale-100\" x-transition:leave=\"ease-in duration-200\" x-transition:leave-start=\"opacity-100 translate-y-0 scale-100\" x-transition:leave-end=\"opacity-0 translate-y-8 scale-95\" @click.away=\"closeAlertModal()\" class=\"relative transform rounded-2xl bg-white dark:bg-dark-bg-secondary shadow-2xl transition-all w-full max-w-7xl max-h-[95vh] overflow-hidden border border-gray-200/50 dark:border-dark-border-subtle/50\"><!-- Modern Loading state --><div x-show=\"!alertDetails\">")
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
@@ -106,7 +280,7 @@ func AlertDetailsModal() templ.Component {
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
-		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 5, "</div><!-- Alert content (only show when alertDetails exists) --><div x-show=\"alertDetails\" class=\"flex flex-col h-full\"><!-- Modern Header with gradient background --><div class=\"relative bg-gradient-to-r from-blue-50 to-indigo-50 dark:from-gray-800 dark:to-gray-900 px-6 py-6 border-b border-gray-200/50 dark:border-dark-border-subtle/50\"><!-- Close button - positioned absolutely for modern look --><button @click=\"closeAlertModal()\" class=\"absolute top-4 right-4 p-2 rounded-full hover:bg-white/80 dark:hover:bg-black/20 transition-colors duration-200 group\"><svg class=\"w-5 h-5 text-gray-400 group-hover:text-gray-600 dark:group-hover:text-gray-300\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M6 18L18 6M6 6l12 12\"></path></svg></button><div class=\"flex items-start space-x-4 pr-12\"><!-- Enhanced Status Icon with modern design -->")
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 11, "</div><!-- Alert content (only show when alertDetails exists) --><div x-show=\"alertDetails\" class=\"flex flex-col h-full\"><!-- Modern Header with gradient background --><div class=\"relative bg-gradient-to-r from-blue-50 to-indigo-50 dark:from-gray-800 dark:to-gray-900 px-6 py-6 border-b border-gray-200/50 dark:border-dark-border-subtle/50\"><!-- Close button - positioned absolutely for modern look --><button @click=\"closeAlertModal()\" class=\"absolute top-4 right-4 p-2 rounded-full hover:bg-white/80 dark:hover:bg-black/20 transition-colors duration-200 group\"><svg class=\"w-5 h-5 text-gray-400 group-hover:text-gray-600 dark:group-hover:text-gray-300\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M6 18L18 6M6 6l12 12\"></path></svg></button><div class=\"flex items-start space-x-4 pr-12\"><!-- Enhanced Status Icon with modern design -->")
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
@@ -114,7 +288,7 @@ func AlertDetailsModal() templ.Component {
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
-		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 6, "<!-- Alert Info --><div class=\"flex-1 min-w-0\"><div class=\"flex items-start justify-between\"><div class=\"flex-1 min-w-0\"><h1 class=\"text-2xl font-bold text-gray-900 dark:text-white mb-2 break-words\" x-text=\"alertDetails?.alert?.alertName || 'Loading...'\"></h1><!-- Status and severity badges --><div class=\"flex flex-wrap items-center gap-2 mb-3\">")
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 12, "<!-- Alert Info --><div class=\"flex-1 min-w-0\"><div class=\"flex items-start justify-between\"><div class=\"flex-1 min-w-0\"><h1 class=\"text-2xl font-bold text-gray-900 dark:text-white mb-2 break-words\" x-text=\"alertDetails?.alert?.alertName || 'Loading...'\"></h1><!-- Status and severity badges --><div class=\"flex flex-wrap items-center gap-2 mb-3\">")
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
@@ -126,7 +300,7 @@ func AlertDetailsModal() templ.Component {
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
-		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 7, "<!-- Acknowledged badge --><span x-show=\"alertDetails?.alert?.isAcknowledged\" class=\"inline-flex items-center px-3 py-1 rounded-full text-sm font-medium bg-green-100 text-green-800 border border-green-200 dark:bg-green-900/50 dark:text-green-200 dark:border-green-800 shadow-sm\"><svg class=\"w-4 h-4 mr-1\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M5 13l4 4L19 7\"></path></svg> ACKNOWLEDGED</span></div>")
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 13, "<!-- Acknowledged badge --><span x-show=\"alertDetails?.alert?.isAcknowledged\" class=\"inline-flex items-center px-3 py-1 rounded-full text-sm font-medium bg-green-100 text-green-800 border border-green-200 dark:bg-green-900/50 dark:text-green-200 dark:border-green-800 shadow-sm\"><svg class=\"w-4 h-4 mr-1\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M5 13l4 4L19 7\"></path></svg> ACKNOWLEDGED</span><!-- Why am I seeing this alert: colors, hiding, presets and severity --><div class=\"relative\" x-show=\"alertDetails?.alert\" @click.outside=\"showAlertExplain = false\"><button @click=\"toggleAlertExplain()\" title=\"Why am I seeing this alert?\" class=\"inline-flex items-center px-2 py-1 rounded-full text-xs font-medium text-gray-600 dark:text-gray-300 bg-white/70 dark:bg-dark-bg-tertiary border border-gray-200 dark:border-gray-700 hover:bg-white dark:hover:bg-gray-700 transition-colors\"><!-- Heroicon: information-circle --><svg class=\"w-4 h-4 mr-1\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M13 16h-1v-4h-1m1-4h.01M21 12a9 9 0 11-18 0 9 9 0 0118 0z\"></path></svg> Why?</button><div x-show=\"showAlertExplain\" x-transition style=\"display: none;\" class=\"absolute left-0 top-full mt-2 w-80 max-h-96 overflow-y-auto z-50 bg-white dark:bg-dark-bg-secondary rounded-xl shadow-2xl border border-gray-200 dark:border-dark-border-subtle p-4 text-sm text-left\"><p x-show=\"alertExplainLoading\" class=\"text-gray-500 dark:text-gray-400\">Loading…</p><p x-show=\"alertExplain?.error\" class=\"text-red-700 dark:text-red-400\" x-text=\"alertExplain?.error\"></p><template x-if=\"alertExplain && !alertExplain.error\"><div class=\"space-y-3\"><div><h4 class=\"text-xs font-semibold uppercase tracking-wider text-gray-500 dark:text-gray-400 mb-1\">Severity</h4><p class=\"text-gray-700 dark:text-gray-300\">Label <span class=\"font-mono\" x-text=\"alertExplain.severity.label || '(none)'\"></span> is shown as <span class=\"font-medium\" x-text=\"alertExplain.severity.computed || 'unknown'\"></span>, priority score <span x-text=\"alertExplain.severity.priorityScore.toFixed(1)\"></span></p></div><div><h4 class=\"text-xs font-semibold uppercase tracking-wider text-gray-500 dark:text-gray-400 mb-1\">Color</h4><p x-show=\"!alertExplain.color?.candidates?.length\" class=\"text-gray-700 dark:text-gray-300\">No color preference matches, the severity color applies.</p><ol class=\"space-y-1\"><template x-for=\"candidate in alertExplain.color?.candidates || []\" :key=\"candidate.preferenceId\"><li class=\"flex items-center gap-2\" :class=\"candidate.applied ? 'font-medium text-gray-900 dark:text-white' : 'text-gray-500 dark:text-gray-400'\"><span class=\"w-3 h-3 rounded-full flex-shrink-0 border border-gray-300 dark:border-gray-600\" :style=\"candidate.color?.startsWith('#') ? `background-color: ${sanitizeColor(candidate.color)}` : ''\"></span> <span class=\"truncate\" x-text=\"describeColorConditions(candidate.labelConditions)\"></span> <span class=\"ml-auto whitespace-nowrap text-xs\" x-text=\"(candidate.applied ? 'applied, ' : '') + 'priority ' + candidate.priority\"></span></li></template></ol></div><div><h4 class=\"text-xs font-semibold uppercase tracking-wider text-gray-500 dark:text-gray-400 mb-1\">Hidden</h4><div class=\"space-y-1 text-gray-700 dark:text-gray-300\"><p x-show=\"!alertExplain.hidden?.hidden\">Not hidden by you or any of your hidden rules.</p><p x-show=\"alertExplain.hidden?.hiddenByUser\">You hid this alert.</p><p x-show=\"alertExplain.hidden?.snoozedUntil\" x-text=\"'Snoozed until ' + new Date(alertExplain.hidden?.snoozedUntil).toLocaleString()\"></p><template x-for=\"rule in alertExplain.hidden?.matchingRules || []\" :key=\"rule.id\"><p x-text=\"'Hidden by rule ' + describeHiddenRule(rule)\"></p></template></div></div><div><h4 class=\"text-xs font-semibold uppercase tracking-wider text-gray-500 dark:text-gray-400 mb-1\">Filter presets</h4><p x-show=\"alertExplain.presetsError\" class=\"text-red-700 dark:text-red-400\" x-text=\"alertExplain.presetsError\"></p><p x-show=\"!alertExplain.presetsError && alertExplain.presets.length === 0\" class=\"text-gray-700 dark:text-gray-300\">You have no saved filter presets.</p><ul class=\"space-y-1\"><template x-for=\"preset in alertExplain.presets\" :key=\"preset.id\"><li class=\"flex items-center gap-2 text-gray-700 dark:text-gray-300\"><span class=\"flex-shrink-0\" :class=\"preset.includes ? 'text-emerald-600 dark:text-emerald-400' : 'text-gray-400 dark:text-gray-500'\" x-text=\"preset.includes ? '✓' : '✕'\"></span> <span class=\"truncate\" x-text=\"preset.name\"></span> <span x-show=\"!preset.includes\" class=\"ml-auto whitespace-nowrap text-xs text-gray-500 dark:text-gray-400\" x-text=\"preset.reason\"></span></li></template></ul></div></div></template></div></div></div>")
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
@@ -134,7 +308,7 @@ func AlertDetailsModal() templ.Component {
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
-		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 8, "</div><!-- Action buttons --><div class=\"flex-shrink-0 ml-4\"><div class=\"flex items-center space-x-3\"><!-- Silence Button (show when not silenced) --><button @click=\"silenceCurrentAlert()\" x-show=\"alertDetails?.alert && !isAlertSilenced(alertDetails?.alert)\" class=\"inline-flex items-center px-4 py-2 bg-red-600 hover:bg-red-700 text-white text-sm font-medium rounded-lg shadow-lg shadow-red-600/25 transition-all duration-200 hover:shadow-red-600/40 hover:scale-105\"><!-- Heroicon: speaker-x-mark --><svg class=\"w-4 h-4 mr-2\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" d=\"M17.25 9.75 19.5 12m0 0 2.25 2.25M19.5 12l2.25-2.25M19.5 12l-2.25 2.25m-10.5-6 4.72-4.72a.75.75 0 0 1 1.28.53v15.88a.75.75 0 0 1-1.28.53l-4.72-4.72H4.51c-.88 0-1.59-.79-1.59-1.78V9.51c0-.88.79-1.59 1.78-1.59h1.78Z\"></path></svg> Silence</button><!-- Unsilence Button (show when silenced) --><button @click=\"unsilenceCurrentAlert()\" x-show=\"alertDetails?.alert && isAlertSilenced(alertDetails?.alert)\" class=\"inline-flex items-center px-4 py-2 bg-orange-600 hover:bg-orange-700 text-white text-sm font-medium rounded-lg shadow-lg shadow-orange-600/25 transition-all duration-200 hover:shadow-orange-600/40 hover:scale-105\"><!-- Heroicon: speaker-wave --><svg class=\"w-4 h-4 mr-2\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" d=\"M19.114 5.636a9 9 0 0 1 0 12.728M16.463 8.288a5.25 5.25 0 0 1 0 7.424M6.75 8.25l4.72-4.72a.75.75 0 0 1 1.28.53v15.88a.75.75 0 0 1-1.28.53l-4.72-4.72H4.51c-.88 0-1.59-.79-1.59-1.78V9.51c0-.88.79-1.59 1.78-1.59h1.78Z\"></path></svg> <span x-text=\"getSilenceButtonText(alertDetails?.alert)\"></span></button><!-- Dynamic Annotation Buttons --><template x-for=\"buttonConfig in annotationButtonConfigs\" :key=\"buttonConfig.id\"><template x-if=\"hasMatchingAnnotation(buttonConfig)\"><button @click=\"openAnnotationUrl(buttonConfig)\" class=\"inline-flex items-center px-4 py-2 text-white text-sm font-medium rounded-lg shadow-lg transition-all duration-200 hover:scale-105\" :style=\"`background-color: ${sanitizeColor(buttonConfig.color)}; box-shadow: 0 10px 15px -3px ${sanitizeColor(buttonConfig.color)}40`\"><!-- Generic link icon --><svg class=\"w-4 h-4 mr-2\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M10 6H6a2 2 0 00-2 2v10a2 2 0 002 2h10a2 2 0 002-2v-4M14 4h6m0 0v6m0-6L10 14\"></path></svg> <span x-text=\"buttonConfig.label\"></span></button></template></template><button @click=\"acknowledgeCurrentAlert()\" x-show=\"alertDetails?.alert && !alertDetails?.alert?.isAcknowledged\" class=\"inline-flex items-center px-4 py-2 bg-green-600 hover:bg-green-700 text-white text-sm font-medium rounded-lg shadow-lg shadow-green-600/25 transition-all duration-200 hover:shadow-green-600/40 hover:scale-105\"><svg class=\"w-4 h-4 mr-2\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M5 13l4 4L19 7\"></path></svg> Acknowledge</button><!-- Unacknowledge Button (show when acknowledged) --><button @click=\"unacknowledgeCurrentAlert()\" x-show=\"alertDetails?.alert && alertDetails?.alert?.isAcknowledged\" class=\"inline-flex items-center px-4 py-2 bg-orange-600 hover:bg-orange-700 text-white text-sm font-medium rounded-lg shadow-lg shadow-orange-600/25 transition-all duration-200 hover:shadow-orange-600/40 hover:scale-105\"><svg class=\"w-4 h-4 mr-2\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M6 18L18 6M6 6l12 12\"></path></svg> Unacknowledge</button><!-- Source Button (Generator URL) --><button @click=\"window.open(alertDetails?.alert?.generatorURL, '_blank')\" x-show=\"alertDetails?.alert?.generatorURL\" class=\"inline-flex items-center px-4 py-2 bg-purple-600 hover:bg-purple-700 text-white\n\t\t\t\t\t\t\t\t\t\t\t\ttext-sm font-medium rounded-lg shadow-lg shadow-purple-600/25 transition-all duration-200\n\t\t\t\t\t\t\t\t\t\t\t\thover:shadow-purple-600/40 hover:scale-105\"><svg class=\"w-4 h-4 mr-2\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M10 6H6a2 2 0\n\t\t\t\t\t\t\t\t\t\t\t\t00-2 2v10a2 2 0 002 2h10a2 2 0 002-2v-4M14 4h6m0 0v6m0-6L10 14\"></path></svg> Source</button><!-- Copy as Issue Button --><button @click=\"copyAlertAsIssue()\" x-show=\"alertDetails?.alert\" class=\"inline-flex items-center px-4 py-2 bg-blue-600 hover:bg-blue-700 text-white text-sm font-medium rounded-lg shadow-lg shadow-blue-600/25 transition-all duration-200 hover:shadow-blue-600/40 hover:scale-105\"><!-- Heroicon: clipboard-document --><svg class=\"w-4 h-4 mr-2\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M8 16H6a2 2 0 01-2-2V6a2 2 0 012-2h8a2 2 0 012 2v2m-6 12h8a2 2 0 002-2V8a2 2 0 00-2-2h-8a2 2 0 00-2 2v8a2 2 0 002 2z\"></path></svg> Copy as Issue</button></div></div></div></div></div></div><!-- Content Area with modern tab design --><div class=\"flex-1 flex flex-col overflow-hidden\"><!-- Modern Tab Navigation with pills design --><div class=\"px-6 py-4 bg-gray-50/50 dark:bg-gray-800/50 border-b border-gray-200/50 dark:border-dark-border-subtle/50\"><nav class=\"flex space-x-1 overflow-x-auto scrollbar-hide\">")
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 14, "</div><!-- Action buttons --><div class=\"flex-shrink-0 ml-4\"><div class=\"flex items-center space-x-3\"><!-- Silence Button (show when not silenced) --><button @click=\"silenceCurrentAlert()\" x-show=\"alertDetails?.alert && !isAlertSilenced(alertDetails?.alert)\" class=\"inline-flex items-center px-4 py-2 bg-red-600 hover:bg-red-700 text-white text-sm font-medium rounded-lg shadow-lg shadow-red-600/25 transition-all duration-200 hover:shadow-red-600/40 hover:scale-105\"><!-- Heroicon: speaker-x-mark --><svg class=\"w-4 h-4 mr-2\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" d=\"M17.25 9.75 19.5 12m0 0 2.25 2.25M19.5 12l2.25-2.25M19.5 12l-2.25 2.25m-10.5-6 4.72-4.72a.75.75 0 0 1 1.28.53v15.88a.75.75 0 0 1-1.28.53l-4.72-4.72H4.51c-.88 0-1.59-.79-1.59-1.78V9.51c0-.88.79-1.59 1.78-1.59h1.78Z\"></path></svg> Silence</button><!-- Unsilence Button (show when silenced) --><button @click=\"unsilenceCurrentAlert()\" x-show=\"alertDetails?.alert && isAlertSilenced(alertDetails?.alert)\" class=\"inline-flex items-center px-4 py-2 bg-orange-600 hover:bg-orange-700 text-white text-sm font-medium rounded-lg shadow-lg shadow-orange-600/25 transition-all duration-200 hover:shadow-orange-600/40 hover:scale-105\"><!-- Heroicon: speaker-wave --><svg class=\"w-4 h-4 mr-2\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" d=\"M19.114 5.636a9 9 0 0 1 0 12.728M16.463 8.288a5.25 5.25 0 0 1 0 7.424M6.75 8.25l4.72-4.72a.75.75 0 0 1 1.28.53v15.88a.75.75 0 0 1-1.28.53l-4.72-4.72H4.51c-.88 0-1.59-.79-1.59-1.78V9.51c0-.88.79-1.59 1.78-1.59h1.78Z\"></path></svg> <span x-text=\"getSilenceButtonText(alertDetails?.alert)\"></span></button><!-- Dynamic Annotation Buttons --><template x-for=\"buttonConfig in sortedAnnotationButtons()\" :key=\"buttonConfig.id\"><template x-if=\"hasMatchingAnnotation(buttonConfig)\"><button @click=\"runAnnotationButton(buttonConfig)\" :title=\"isCopyAnnotationButton(buttonConfig) ? 'Copy ' + getAnnotationUrl(buttonConfig) : getAnnotationUrl(buttonConfig)\" class=\"inline-flex items-center px-4 py-2 text-white text-sm font-medium rounded-lg shadow-lg transition-all duration-200 hover:scale-105\" :style=\"`background-color: ${sanitizeColor(buttonConfig.color)}; box-shadow: 0 10px 15px -3px ${sanitizeColor(buttonConfig.color)}40`\"><!-- Clipboard icon --><svg x-show=\"isCopyAnnotationButton(buttonConfig)\" class=\"w-4 h-4 mr-2\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M8 16H6a2 2 0 01-2-2V6a2 2 0 012-2h8a2 2 0 012 2v2m-6 12h8a2 2 0 002-2v-8a2 2 0 00-2-2h-8a2 2 0 00-2 2v8a2 2 0 002 2z\"></path></svg><!-- Generic link icon --><svg x-show=\"!isCopyAnnotationButton(buttonConfig)\" class=\"w-4 h-4 mr-2\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M10 6H6a2 2 0 00-2 2v10a2 2 0 002 2h10a2 2 0 002-2v-4M14 4h6m0 0v6m0-6L10 14\"></path></svg> <span x-text=\"copiedAnnotationButtonId === buttonConfig.id ? 'Copied!' : buttonConfig.label\"></span></button></template></template><button @click=\"acknowledgeCurrentAlert()\" x-show=\"alertDetails?.alert && !alertDetails?.alert?.isAcknowledged\" class=\"inline-flex items-center px-4 py-2 bg-green-600 hover:bg-green-700 text-white text-sm font-medium rounded-lg shadow-lg shadow-green-600/25 transition-all duration-200 hover:shadow-green-600/40 hover:scale-105\"><svg class=\"w-4 h-4 mr-2\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M5 13l4 4L19 7\"></path></svg> Acknowledge</button><!-- Unacknowledge Button (show when acknowledged) --><button @click=\"unacknowledgeCurrentAlert()\" x-show=\"alertDetails?.alert && alertDetails?.alert?.isAcknowledged\" class=\"inline-flex items-center px-4 py-2 bg-orange-600 hover:bg-orange-700 text-white text-sm font-medium rounded-lg shadow-lg shadow-orange-600/25 transition-all duration-200 hover:shadow-orange-600/40 hover:scale-105\"><svg class=\"w-4 h-4 mr-2\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M6 18L18 6M6 6l12 12\"></path></svg> Unacknowledge</button><!-- Escalate Button --><button @click=\"escalateCurrentAlert()\" x-show=\"alertDetails?.alert\" class=\"inline-flex items-center px-4 py-2 bg-red-600 hover:bg-red-700 text-white text-sm font-medium rounded-lg shadow-lg shadow-red-600/25 transition-all duration-200 hover:shadow-red-600/40 hover:scale-105\"><svg class=\"w-4 h-4 mr-2\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M5 10l7-7m0 0l7 7m-7-7v18\"></path></svg> Escalate</button><!-- Source Button (Generator URL) --><button @click=\"window.open(alertDetails?.alert?.generatorURL, '_blank')\" x-show=\"alertDetails?.alert?.generatorURL\" class=\"inline-flex items-center px-4 py-2 bg-purple-600 hover:bg-purple-700 text-white\n\t\t\t\t\t\t\t\t\t\t\t\ttext-sm font-medium rounded-lg shadow-lg shadow-purple-600/25 transition-all duration-200\n\t\t\t\t\t\t\t\t\t\t\t\thover:shadow-purple-600/40 hover:scale-105\"><svg class=\"w-4 h-4 mr-2\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M10 6H6a2 2 0\n\t\t\t\t\t\t\t\t\t\t\t\t00-2 2v10a2 2 0 002 2h10a2 2 0 002-2v-4M14 4h6m0 0v6m0-6L10 14\"></path></svg> Source</button><!-- Share Button (copies the alert's link) --><button @click=\"shareCurrentAlert()\" x-show=\"alertDetails?.alert\" :title=\"alertDetails?.alert ? alertShareLink(alertDetails.alert.fingerprint) : ''\" class=\"inline-flex items-center px-4 py-2 bg-indigo-600 hover:bg-indigo-500 text-white text-sm font-medium rounded-lg shadow-lg transition-all duration-200 hover:scale-105\"><!-- Heroicon: link --><svg class=\"w-4 h-4 mr-2\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M13.19 8.688a4.5 4.5 0 0 1 1.242 7.244l-4.5 4.5a4.5 4.5 0 0 1-6.364-6.364l1.757-1.757m13.35-.622 1.757-1.757a4.5 4.5 0 0 0-6.364-6.364l-4.5 4.5a4.5 4.5 0 0 0 1.242 7.244\"></path></svg> <span x-text=\"alertLinkCopied ? 'Link copied!' : 'Share'\"></span></button><!-- Copy as Issue Button --><button @click=\"copyAlertAsIssue()\" x-show=\"alertDetails?.alert\" class=\"inline-flex items-center px-4 py-2 bg-blue-600 hover:bg-blue-700 text-white text-sm font-medium rounded-lg shadow-lg shadow-blue-600/25 transition-all duration-200 hover:shadow-blue-600/40 hover:scale-105\"><!-- Heroicon: clipboard-document --><svg class=\"w-4 h-4 mr-2\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M8 16H6a2 2 0 01-2-2V6a2 2 0 012-2h8a2 2 0 012 2v2m-6 12h8a2 2 0 002-2V8a2 2 0 00-2-2h-8a2 2 0 00-2 2v8a2 2 0 002 2z\"></path></svg> Copy as Issue</button><!-- Export JSON Button --><button @click=\"exportAlertJSON()\" x-show=\"alertDetails?.alert\" class=\"inline-flex items-center px-4 py-2 bg-gray-600 hover:bg-gray-700 text-white text-sm font-medium rounded-lg shadow-lg shadow-gray-600/25 transition-all duration-200 hover:shadow-gray-600/40 hover:scale-105\"><!-- Heroicon: arrow-down-tray --><svg class=\"w-4 h-4 mr-2\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M3 16.5v2.25A2.25 2.25 0 0 0 5.25 21h13.5A2.25 2.25 0 0 0 21 18.75V16.5M16.5 12 12 16.5m0 0L7.5 12m4.5 4.5V3\"></path></svg> Export JSON</button></div></div></div></div></div></div><!-- Content Area with modern tab design --><div class=\"flex-1 flex flex-col overflow-hidden\"><!-- Modern Tab Navigation with pills design --><div class=\"px-6 py-4 bg-gray-50/50 dark:bg-gray-800/50 border-b border-gray-200/50 dark:border-dark-border-subtle/50\"><nav class=\"flex space-x-1 overflow-x-auto scrollbar-hide\">")
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
@@ -158,7 +332,7 @@ func AlertDetailsModal() templ.Component {
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
-		templ_7745c5c3_Var4 := templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
+		templ_7745c5c3_Var10 := templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
 			templ_7745c5c3_W, ctx := templ_7745c5c3_Input.Writer, templ_7745c5c3_Input.Context
 			templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templruntime.GetBuffer(templ_7745c5c3_W)
 			if !templ_7745c5c3_IsBuffer {
@@ -170,17 +344,17 @@ func AlertDetailsModal() templ.Component {
 				}()
 			}
 			ctx = templ.InitializeContext(ctx)
-			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 9, "<span x-show=\"alertDetails?.comments && alertDetails.comments.length > 0\" class=\"ml-2 inline-flex items-center px-2 py-0.5 rounded-full text-xs font-medium bg-blue-100 text-blue-800 dark:bg-blue-900 dark:text-blue-200 border border-blue-200 dark:border-blue-800\" x-text=\"alertDetails?.comments?.length || 0\"></span>")
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 15, "<span x-show=\"alertDetails?.commentsTotal > 0\" class=\"ml-2 inline-flex items-center px-2 py-0.5 rounded-full text-xs font-medium bg-blue-100 text-blue-800 dark:bg-blue-900 dark:text-blue-200 border border-blue-200 dark:border-blue-800\" x-text=\"alertDetails?.commentsTotal || 0\"></span>")
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
 			return nil
 		})
-		templ_7745c5c3_Err = AlertModalTabButton("comments", "currentAlertTab", "Comments", "M8 12h.01M12 12h.01M16 12h.01M21 12c0 4.418-4.03 8-9 8a9.863 9.863 0 01-4.255-.949L3 20l1.395-3.72C3.512 15.042 3 13.574 3 12c0-4.418 4.03-8 9-8s9 3.582 9 8z").Render(templ.WithChildren(ctx, templ_7745c5c3_Var4), templ_7745c5c3_Buffer)
+		templ_7745c5c3_Err = AlertModalTabButton("comments", "currentAlertTab", "Comments", "M8 12h.01M12 12h.01M16 12h.01M21 12c0 4.418-4.03 8-9 8a9.863 9.863 0 01-4.255-.949L3 20l1.395-3.72C3.512 15.042 3 13.574 3 12c0-4.418 4.03-8 9-8s9 3.582 9 8z").Render(templ.WithChildren(ctx, templ_7745c5c3_Var10), templ_7745c5c3_Buffer)
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
-		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 10, "<!-- History tab with special click handler --><button @click=\"currentAlertTab = 'history'; loadAlertHistory()\" :class=\"currentAlertTab === 'history' ? 'bg-white dark:bg-dark-bg-tertiary text-blue-600 dark:text-blue-400 shadow-sm border border-blue-200 dark:border-blue-800' : 'text-gray-600 dark:text-gray-400 hover:text-gray-900 dark:hover:text-gray-200 hover:bg-white/50 dark:hover:bg-dark-bg-tertiary/50'\" class=\"whitespace-nowrap px-4 py-2.5 text-sm font-medium rounded-lg transition-all duration-200 flex items-center\"><svg class=\"w-4 h-4 mr-2\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M12 8v4l3 3m6-3a9 9 0 11-18 0 9 9 0 0118 0z\"></path></svg> History <span x-show=\"alertHistory?.total_occurrences > 1\" class=\"ml-2 inline-flex items-center px-2 py-0.5 rounded-full text-xs font-medium bg-blue-100 text-blue-800 dark:bg-blue-900 dark:text-blue-200 border border-blue-200 dark:border-blue-800\" x-text=\"alertHistory?.total_occurrences || 0\"></span></button><!-- Sentry tab with special click handler and conditional display --><button @click=\"currentAlertTab = 'sentry'; loadSentryDataForTab()\" x-show=\"alertDetails?.alert?.annotations?.sentry || alertDetails?.alert?.labels?.sentry\" :class=\"currentAlertTab === 'sentry' ? 'bg-white dark:bg-dark-bg-tertiary text-blue-600 dark:text-blue-400 shadow-sm border border-blue-200 dark:border-blue-800' : 'text-gray-600 dark:text-gray-400 hover:text-gray-900 dark:hover:text-gray-200 hover:bg-white/50 dark:hover:bg-dark-bg-tertiary/50'\" class=\"whitespace-nowrap px-4 py-2.5 text-sm font-medium rounded-lg transition-all duration-200 flex items-center\"><svg class=\"w-4 h-4 mr-2\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M9 19v-6a2 2 0 00-2-2H5a2 2 0 00-2 2v6a2 2 0 002 2h2a2 2 0 002-2zm0 0V9a2 2 0 012-2h2a2 2 0 012 2v10m-6 0a2 2 0 002 2h2a2 2 0 002-2m0 0V5a2 2 0 012-2h2a2 2 0 012 2v14a2 2 0 01-2 2h-2a2 2 0 01-2-2z\"></path></svg> Sentry</button></nav></div><!-- Scrollable Tab Content --><div class=\"flex-1 overflow-y-auto\"><div class=\"p-6\"><!-- Overview Tab --><div x-show=\"currentAlertTab === 'overview'\" x-transition:enter=\"transition-opacity ease-out duration-200\" x-transition:enter-start=\"opacity-0\" x-transition:enter-end=\"opacity-100\"><!-- Quick Info Cards - using shared components --><div class=\"grid grid-cols-1 md:grid-cols-2 lg:grid-cols-3 gap-6 mb-8\">")
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 16, "<!-- History tab with special click handler --><button @click=\"currentAlertTab = 'history'; loadAlertHistory()\" :class=\"currentAlertTab === 'history' ? 'bg-white dark:bg-dark-bg-tertiary text-blue-600 dark:text-blue-400 shadow-sm border border-blue-200 dark:border-blue-800' : 'text-gray-600 dark:text-gray-400 hover:text-gray-900 dark:hover:text-gray-200 hover:bg-white/50 dark:hover:bg-dark-bg-tertiary/50'\" class=\"whitespace-nowrap px-4 py-2.5 text-sm font-medium rounded-lg transition-all duration-200 flex items-center\"><svg class=\"w-4 h-4 mr-2\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M12 8v4l3 3m6-3a9 9 0 11-18 0 9 9 0 0118 0z\"></path></svg> History <span x-show=\"alertHistory?.total_occurrences > 1\" class=\"ml-2 inline-flex items-center px-2 py-0.5 rounded-full text-xs font-medium bg-blue-100 text-blue-800 dark:bg-blue-900 dark:text-blue-200 border border-blue-200 dark:border-blue-800\" x-text=\"alertHistory?.total_occurrences || 0\"></span></button><!-- Activity tab loads the alert timeline on open --><button @click=\"currentAlertTab = 'activity'; loadAlertActivity(true)\" :class=\"currentAlertTab === 'activity' ? 'bg-white dark:bg-dark-bg-tertiary text-blue-600 dark:text-blue-400 shadow-sm border border-blue-200 dark:border-blue-800' : 'text-gray-600 dark:text-gray-400 hover:text-gray-900 dark:hover:text-gray-200 hover:bg-white/50 dark:hover:bg-dark-bg-tertiary/50'\" class=\"whitespace-nowrap px-4 py-2.5 text-sm font-medium rounded-lg transition-all duration-200 flex items-center\"><svg class=\"w-4 h-4 mr-2\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M4 6h16M4 10h16M4 14h16M4 18h16\"></path></svg> Activity</button><!-- Sentry tab with special click handler and conditional display --><button @click=\"currentAlertTab = 'sentry'; loadSentryDataForTab()\" x-show=\"alertHasSentryLink(alertDetails?.alert)\" :class=\"currentAlertTab === 'sentry' ? 'bg-white dark:bg-dark-bg-tertiary text-blue-600 dark:text-blue-400 shadow-sm border border-blue-200 dark:border-blue-800' : 'text-gray-600 dark:text-gray-400 hover:text-gray-900 dark:hover:text-gray-200 hover:bg-white/50 dark:hover:bg-dark-bg-tertiary/50'\" class=\"whitespace-nowrap px-4 py-2.5 text-sm font-medium rounded-lg transition-all duration-200 flex items-center\"><svg class=\"w-4 h-4 mr-2\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M9 19v-6a2 2 0 00-2-2H5a2 2 0 00-2 2v6a2 2 0 002 2h2a2 2 0 002-2zm0 0V9a2 2 0 012-2h2a2 2 0 012 2v10m-6 0a2 2 0 002 2h2a2 2 0 002-2m0 0V5a2 2 0 012-2h2a2 2 0 012 2v14a2 2 0 01-2 2h-2a2 2 0 01-2-2z\"></path></svg> Sentry</button></nav><!-- Live update stream status, shown while the backend is unreachable --><div x-show=\"alertUpdatesStatus === 'reconnecting'\" class=\"mt-2 flex items-center text-xs text-amber-600 dark:text-amber-400\"><svg class=\"animate-spin mr-1.5 h-3 w-3\" fill=\"none\" viewBox=\"0 0 24 24\"><circle class=\"opacity-25\" cx=\"12\" cy=\"12\" r=\"10\" stroke=\"currentColor\" stroke-width=\"4\"></circle> <path class=\"opacity-75\" fill=\"currentColor\" d=\"M4 12a8 8 0 018-8V0C5.373 0 0 5.373 0 12h4z\"></path></svg> Real-time updates lost, reconnecting…</div><!-- Users with this alert open, from the live update stream --><div x-show=\"alertUpdatesStatus === 'connected' && alertViewers.length > 0\" class=\"mt-2 flex items-center text-xs text-gray-500 dark:text-gray-400\"><span class=\"inline-flex rounded-full h-2 w-2 bg-green-500 mr-1.5\"></span> <span x-text=\"`Viewing now (${alertViewers.length}): ` + alertViewers.map(v => v.userId === currentUser?.id ? `${v.username} (you)` : v.username).join(', ')\"></span></div><!-- Offered when the alert fired again after a resolution that kept comments or acknowledgments --><div x-show=\"alertDetails?.previousDiscussion\" class=\"mt-3 flex items-center justify-between rounded-lg border border-amber-200 dark:border-amber-800 bg-amber-50 dark:bg-amber-900/20 px-4 py-2 text-sm text-amber-800 dark:text-amber-200\"><span>This alert recurred — restore previous discussion? <span class=\"text-xs opacity-75\" x-text=\"alertDetails?.previousDiscussion ? `(${alertDetails.previousDiscussion.comments} comments, ${alertDetails.previousDiscussion.acknowledgments} acknowledgments, resolved ${new Date(alertDetails.previousDiscussion.resolvedAt).toLocaleString()})` : ''\"></span></span> <button @click=\"restorePreviousDiscussion()\" :disabled=\"discussionRestoring\" class=\"ml-4 px-3 py-1 text-xs font-medium rounded-md bg-amber-600 hover:bg-amber-700 disabled:opacity-50 text-white\"><span x-text=\"discussionRestoring ? 'Restoring…' : 'Restore'\"></span></button></div></div><!-- Scrollable Tab Content --><div class=\"flex-1 overflow-y-auto\"><div class=\"p-6\"><!-- Overview Tab --><div x-show=\"currentAlertTab === 'overview'\" x-transition:enter=\"transition-opacity ease-out duration-200\" x-transition:enter-start=\"opacity-0\" x-transition:enter-end=\"opacity-100\"><!-- Quick Info Cards - using shared components --><div class=\"grid grid-cols-1 md:grid-cols-2 lg:grid-cols-3 gap-6 mb-8\">")
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
@@ -196,7 +370,7 @@ func AlertDetailsModal() templ.Component {
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
-		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 11, "</div><!-- Summary and Description Cards --><div class=\"grid grid-cols-1 gap-6\">")
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 17, "</div><!-- Silences affecting this alert --><div x-show=\"alertDetails?.silences?.length > 0\" class=\"mb-8 bg-gradient-to-br from-white to-purple-50 dark:from-dark-bg-tertiary dark:to-purple-900/20 rounded-xl p-6 shadow-sm border border-purple-200/50 dark:border-purple-800/50\"><h4 class=\"text-lg font-semibold text-gray-900 dark:text-white mb-4 flex items-center\"><svg class=\"w-5 h-5 mr-2 text-purple-500\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M5.586 15H4a1 1 0 01-1-1v-4a1 1 0 011-1h1.586l4.707-4.707C10.923 3.663 12 4.109 12 5v14c0 .891-1.077 1.337-1.707.707L5.586 15z\"></path> <path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M17 14l2-2m0 0l2-2m-2 2l-2-2m2 2l2 2\"></path></svg> Silences</h4><div class=\"space-y-3\"><template x-for=\"silence in alertDetails?.silences || []\" :key=\"silence.id\"><div class=\"flex items-start justify-between bg-white/70 dark:bg-gray-800/70 rounded-lg p-4 border border-purple-200/30 dark:border-purple-800/30\"><div class=\"min-w-0\"><div class=\"flex items-center space-x-2\"><span class=\"text-xs font-medium px-2 py-0.5 rounded-full capitalize\" :class=\"silence.status?.state === 'active' ? 'bg-purple-100 text-purple-800 dark:bg-purple-900/50 dark:text-purple-200' : 'bg-gray-100 text-gray-700 dark:bg-gray-700 dark:text-gray-300'\" x-text=\"silence.status?.state || 'unknown'\"></span> <span class=\"text-sm text-gray-700 dark:text-gray-300\" x-text=\"'by ' + (silence.createdBy || 'unknown')\"></span> <span class=\"text-xs text-gray-500 dark:text-gray-400\" x-text=\"'until ' + new Date(silence.endsAt).toLocaleString()\"></span></div><p class=\"mt-1 text-sm text-gray-600 dark:text-gray-400 break-words\" x-text=\"silence.comment\"></p></div><div class=\"ml-4 flex-shrink-0 flex items-center space-x-2\"><button @click=\"editSilence(silence)\" :disabled=\"silenceExpiring[silence.id]\" class=\"inline-flex items-center px-3 py-1.5 text-xs font-medium rounded-md text-gray-700 bg-gray-100 hover:bg-gray-200 dark:text-gray-200 dark:bg-gray-700 dark:hover:bg-gray-600 disabled:opacity-50 transition-colors\">Edit</button> <button x-show=\"silence.status?.state === 'active'\" @click=\"expireSilence(silence)\" :disabled=\"silenceExpiring[silence.id]\" class=\"inline-flex items-center px-3 py-1.5 text-xs font-medium rounded-md text-purple-700 bg-purple-100 hover:bg-purple-200 dark:text-purple-200 dark:bg-purple-900/50 dark:hover:bg-purple-900/70 disabled:opacity-50 transition-colors\"><span x-text=\"silenceExpiring[silence.id] ? 'Expiring...' : 'Expire Silence'\"></span></button></div></div></template></div></div><!-- Alerts inhibiting this alert --><div x-show=\"alertDetails?.inhibitedBy?.length > 0\" class=\"mb-8 bg-gradient-to-br from-white to-orange-50 dark:from-dark-bg-tertiary dark:to-orange-900/20 rounded-xl p-6 shadow-sm border border-orange-200/50 dark:border-orange-800/50\"><h4 class=\"text-lg font-semibold text-gray-900 dark:text-white mb-4 flex items-center\"><svg class=\"w-5 h-5 mr-2 text-orange-500\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M18.364 18.364A9 9 0 0 0 5.636 5.636m12.728 12.728A9 9 0 0 1 5.636 5.636m12.728 12.728L5.636 5.636\"></path></svg> Inhibited By</h4><div class=\"space-y-3\"><template x-for=\"inhibitor in alertDetails?.inhibitedBy || []\" :key=\"inhibitor.fingerprint\"><div class=\"flex items-start justify-between bg-white/70 dark:bg-gray-800/70 rounded-lg p-4 border border-orange-200/30 dark:border-orange-800/30\"><div class=\"min-w-0\"><template x-if=\"inhibitor.found\"><div><div class=\"flex items-center space-x-2\"><span class=\"text-xs font-medium px-2 py-0.5 rounded-full capitalize\" :class=\"getSeverityBadgeClasses(inhibitor.severity)\" :style=\"getSeverityBadgeStyle(inhibitor.severity)\" x-text=\"inhibitor.severity || 'unknown'\"></span> <span class=\"text-sm font-medium text-gray-900 dark:text-white truncate\" x-text=\"inhibitor.alertName\"></span> <span class=\"text-xs text-gray-500 dark:text-gray-400\" x-text=\"inhibitor.state\"></span></div><p x-show=\"inhibitor.instance\" class=\"mt-1 text-sm text-gray-600 dark:text-gray-400 break-words\" x-text=\"inhibitor.instance\"></p></div></template><template x-if=\"!inhibitor.found\"><p class=\"text-sm text-gray-600 dark:text-gray-400\">The inhibiting alert <span class=\"font-mono\" x-text=\"inhibitor.fingerprint\"></span> is no longer active.</p></template></div><div class=\"ml-4 flex-shrink-0\"><button @click=\"showAlertDetails(inhibitor.fingerprint)\" class=\"inline-flex items-center px-3 py-1.5 text-xs font-medium rounded-md text-orange-700 bg-orange-100 hover:bg-orange-200 dark:text-orange-200 dark:bg-orange-900/50 dark:hover:bg-orange-900/70 transition-colors\" x-text=\"inhibitor.found ? 'Open' : 'View history'\"></button></div></div></template></div></div><!-- Firing alerts sharing this alert's instance, job or team --><div x-show=\"alertDetails?.relatedAlerts?.length > 0\" class=\"mb-8 bg-gradient-to-br from-white to-blue-50 dark:from-dark-bg-tertiary dark:to-blue-900/20 rounded-xl p-6 shadow-sm border border-blue-200/50 dark:border-blue-800/50\"><h4 class=\"text-lg font-semibold text-gray-900 dark:text-white mb-4 flex items-center\"><svg class=\"w-5 h-5 mr-2 text-blue-500\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M13.828 10.172a4 4 0 0 0-5.656 0l-4 4a4 4 0 1 0 5.656 5.656l1.102-1.101m-.758-4.899a4 4 0 0 0 5.656 0l4-4a4 4 0 0 0-5.656-5.656l-1.1 1.1\"></path></svg> Related Alerts</h4><div class=\"space-y-3\"><template x-for=\"related in alertDetails?.relatedAlerts || []\" :key=\"related.fingerprint\"><button @click=\"showAlertDetails(related.fingerprint)\" class=\"w-full text-left flex items-start justify-between bg-white/70 dark:bg-gray-800/70 rounded-lg p-4 border border-blue-200/30 dark:border-blue-800/30 hover:bg-blue-50 transition-colors\"><div class=\"min-w-0\"><div class=\"flex items-center space-x-2\"><span class=\"text-xs font-medium px-2 py-0.5 rounded-full capitalize\" :class=\"getSeverityBadgeClasses(related.severity)\" :style=\"getSeverityBadgeStyle(related.severity)\" x-text=\"related.severity || 'unknown'\"></span> <span class=\"text-sm font-medium text-gray-900 dark:text-white truncate\" x-text=\"related.alertName\"></span></div><p x-show=\"related.instance\" class=\"mt-1 text-sm text-gray-600 dark:text-gray-400 break-words\" x-text=\"related.instance\"></p><div class=\"mt-2 flex flex-wrap gap-1\"><template x-for=\"label in related.sharedLabels\" :key=\"label\"><span class=\"text-xs px-2 py-0.5 rounded-full text-blue-700 bg-blue-100 dark:text-blue-200 dark:bg-blue-900/50\" :title=\"label + '=' + (alertDetails?.alert?.labels?.[label] ?? '')\" x-text=\"label\"></span></template></div></div><span class=\"ml-4 flex-shrink-0 text-xs text-gray-500 dark:text-gray-400\" x-text=\"related.sharedLabels.length + ' shared'\"></span></button></template></div></div><!-- Summary and Description Cards --><div class=\"grid grid-cols-1 gap-6\">")
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
@@ -204,7 +378,7 @@ func AlertDetailsModal() templ.Component {
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
-		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 12, "<!-- Description Section (dashboard-specific, not in shared components) --><div x-show=\"alertDetails?.alert?.description\" class=\"bg-gradient-to-br from-white to-green-50 dark:from-dark-bg-tertiary dark:to-green-900/20 rounded-xl p-6 shadow-sm border border-green-200/50 dark:border-green-800/50\"><h4 class=\"text-lg font-semibold text-gray-900 dark:text-white mb-4 flex items-center\"><svg class=\"w-5 h-5 mr-2 text-green-500\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M4 6h16M4 12h16M4 18h7\"></path></svg> Description</h4><div class=\"bg-white/70 dark:bg-gray-800/70 rounded-lg p-4 border border-green-200/30 dark:border-green-800/30\"><p class=\"text-sm text-gray-700 dark:text-gray-300 leading-relaxed\" x-text=\"alertDetails?.alert?.description || ''\"></p></div></div></div></div><!-- Details Tab --><div x-show=\"currentAlertTab === 'details'\"><div class=\"space-y-4\">")
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 18, "<!-- Description Section (dashboard-specific, not in shared components) --><div x-show=\"alertDetails?.alert?.description\" class=\"bg-gradient-to-br from-white to-green-50 dark:from-dark-bg-tertiary dark:to-green-900/20 rounded-xl p-6 shadow-sm border border-green-200/50 dark:border-green-800/50\"><h4 class=\"text-lg font-semibold text-gray-900 dark:text-white mb-4 flex items-center\"><svg class=\"w-5 h-5 mr-2 text-green-500\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M4 6h16M4 12h16M4 18h7\"></path></svg> Description</h4><div class=\"bg-white/70 dark:bg-gray-800/70 rounded-lg p-4 border border-green-200/30 dark:border-green-800/30\"><p class=\"text-sm text-gray-700 dark:text-gray-300 leading-relaxed\" x-text=\"alertDetails?.alert?.description || ''\"></p></div></div></div></div><!-- Details Tab --><div x-show=\"currentAlertTab === 'details'\"><div class=\"space-y-4\">")
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
@@ -216,7 +390,7 @@ func AlertDetailsModal() templ.Component {
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
-		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 13, "</div></div><!-- Labels Tab --><div x-show=\"currentAlertTab === 'labels'\">")
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 19, "</div></div><!-- Labels Tab --><div x-show=\"currentAlertTab === 'labels'\">")
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
@@ -224,7 +398,7 @@ func AlertDetailsModal() templ.Component {
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
-		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 14, "</div><!-- Annotations Tab --><div x-show=\"currentAlertTab === 'annotations'\">")
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 20, "</div><!-- Annotations Tab --><div x-show=\"currentAlertTab === 'annotations'\">")
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
@@ -232,7 +406,7 @@ func AlertDetailsModal() templ.Component {
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
-		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 15, "</div><!-- Acknowledgments Tab --><div x-show=\"currentAlertTab === 'acknowledgments'\"><div x-show=\"alertDetails?.acknowledgments && alertDetails.acknowledgments.length > 0\" class=\"space-y-3\"><template x-for=\"ack in (alertDetails?.acknowledgments || [])\" x-key=\"ack.id\"><div class=\"border border-gray-200 dark:border-dark-border-subtle rounded-lg p-4\"><div class=\"flex items-center justify-between mb-2\"><div class=\"flex items-center space-x-2\"><svg class=\"w-4 h-4 text-green-500\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M5 13l4 4L19 7\"></path></svg> <span class=\"text-sm font-medium text-gray-900 dark:text-white\" x-text=\"ack.username\"></span></div><span class=\"text-xs text-gray-500 dark:text-gray-400\" x-text=\"new Date(ack.createdAt).toLocaleString()\"></span></div><p class=\"text-sm text-gray-600 dark:text-gray-400\" x-text=\"ack.comment\"></p></div></template></div><div x-show=\"!alertDetails?.acknowledgments || alertDetails.acknowledgments.length === 0\" class=\"text-center py-8 text-gray-500 dark:text-gray-400\">No acknowledgments yet</div></div><!-- Comments Tab --><div x-show=\"currentAlertTab === 'comments'\" x-transition:enter=\"transition-opacity ease-out duration-200\" x-transition:enter-start=\"opacity-0\" x-transition:enter-end=\"opacity-100\"><!-- Modern Add Comment Form --><div class=\"mb-8 bg-gradient-to-r from-blue-50 to-indigo-50 dark:from-gray-800 dark:to-gray-900 rounded-xl p-6 border border-blue-200/50 dark:border-blue-800/50 shadow-sm\"><div class=\"flex items-center mb-4\"><svg class=\"w-5 h-5 mr-2 text-blue-500\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M12 6v6m0 0v6m0-6h6m-6 0H6\"></path></svg><h4 class=\"text-lg font-semibold text-gray-900 dark:text-white\">Add Comment</h4></div><div class=\"space-y-4\"><div class=\"relative\"><textarea x-model=\"newCommentContent\" rows=\"4\" placeholder=\"Share your thoughts, add notes, or provide updates about this alert...\" class=\"w-full px-4 py-3 bg-white dark:bg-dark-bg-secondary border-2 border-gray-200 dark:border-dark-border-DEFAULT rounded-xl shadow-sm focus:outline-none focus:ring-2 focus:ring-blue-500 focus:border-blue-500 dark:text-white resize-none placeholder-gray-400 dark:placeholder-gray-500 transition-all duration-200\"></textarea><div class=\"absolute bottom-3 right-3 text-xs text-gray-400 dark:text-gray-500\" x-text=\"newCommentContent.length + '/500'\"></div></div><div class=\"flex items-center justify-between\"><div class=\"flex items-center space-x-2 text-sm text-gray-500 dark:text-gray-400\"><svg class=\"w-4 h-4\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M13 16h-1v-4h-1m1-4h.01M21 12a9 9 0 11-18 0 9 9 0 0118 0z\"></path></svg> <span>Comments help track alert resolution progress</span></div><button @click=\"addComment()\" :disabled=\"!newCommentContent.trim() || commentSubmitting\" class=\"inline-flex items-center px-6 py-3 bg-blue-600 hover:bg-blue-700 disabled:bg-gray-300 disabled:hover:bg-gray-300 text-white text-sm font-medium rounded-xl shadow-lg shadow-blue-600/25 transition-all duration-200 hover:shadow-blue-600/40 hover:scale-105 disabled:scale-100 disabled:shadow-none disabled:cursor-not-allowed\"><svg x-show=\"commentSubmitting\" class=\"animate-spin -ml-1 mr-2 h-4 w-4 text-white\" fill=\"none\" viewBox=\"0 0 24 24\"><circle class=\"opacity-25\" cx=\"12\" cy=\"12\" r=\"10\" stroke=\"currentColor\" stroke-width=\"4\"></circle> <path class=\"opacity-75\" fill=\"currentColor\" d=\"M4 12a8 8 0 018-8V0C5.373 0 0 5.373 0 12h4zm2 5.291A7.962 7.962 0 014 12H0c0 3.042 1.135 5.824 3 7.938l3-2.647z\"></path></svg> <svg x-show=\"!commentSubmitting\" class=\"w-4 h-4 mr-2\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M12 19l9 2-9-18-9 18 9-2zm0 0v-8\"></path></svg> <span x-show=\"!commentSubmitting\">Post Comment</span> <span x-show=\"commentSubmitting\">Posting...</span></button></div></div></div><!-- Modern Comments List --><div x-show=\"alertDetails?.comments && alertDetails.comments.length > 0\" class=\"space-y-4\"><div class=\"flex items-center mb-4\"><svg class=\"w-5 h-5 mr-2 text-gray-500\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M8 12h.01M12 12h.01M16 12h.01M21 12c0 4.418-4.03 8-9 8a9.863 9.863 0 01-4.255-.949L3 20l1.395-3.72C3.512 15.042 3 13.574 3 12c0-4.418 4.03-8 9-8s9 3.582 9 8z\"></path></svg><h4 class=\"text-lg font-semibold text-gray-900 dark:text-white\">Comments  <span class=\"text-sm font-normal text-gray-500 dark:text-gray-400\">(<span x-text=\"alertDetails?.comments?.length || 0\"></span>)</span></h4></div><!-- Scrollable comments container --><div class=\"max-h-96 overflow-y-auto space-y-4 pr-2\"><template x-for=\"comment in (alertDetails?.comments || [])\" x-key=\"comment.id\"><div class=\"bg-white dark:bg-dark-bg-tertiary rounded-xl p-6 shadow-sm border border-gray-200/50 dark:border-dark-border-subtle/50 hover:shadow-md transition-all duration-200\"><div class=\"flex items-start justify-between\"><div class=\"flex items-start space-x-4 flex-1 min-w-0\"><!-- User Avatar --><div class=\"flex-shrink-0\"><div class=\"w-10 h-10 bg-gradient-to-br from-blue-500 to-purple-600 rounded-full flex items-center justify-center shadow-lg\"><span class=\"text-white text-sm font-semibold\" x-text=\"comment.username.charAt(0).toUpperCase()\"></span></div></div><!-- Comment Content --><div class=\"flex-1 min-w-0\"><div class=\"flex items-center space-x-3 mb-3\"><span class=\"text-base font-semibold text-gray-900 dark:text-white\" x-text=\"comment.username\"></span> <span x-show=\"comment.isSystem\" class=\"inline-flex items-center px-2 py-1 rounded-full text-xs font-medium bg-blue-100 text-blue-800 dark:bg-blue-900/50 dark:text-blue-200 border border-blue-200 dark:border-blue-800\"><svg class=\"w-3 h-3 mr-1\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M9.75 17L9 20l-1 1h8l-1-1-.75-3M3 13h18M5 17h14a2 2 0 002-2V5a2 2 0 00-2-2H5a2 2 0 00-2 2v10a2 2 0 002 2z\"></path></svg> System</span><div class=\"flex items-center text-sm text-gray-500 dark:text-gray-400\"><svg class=\"w-4 h-4 mr-1\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M12 8v4l3 3m6-3a9 9 0 11-18 0 9 9 0 0118 0z\"></path></svg> <span x-text=\"new Date(comment.createdAt).toLocaleString()\"></span></div></div><div class=\"bg-gray-50 dark:bg-gray-800/50 rounded-lg p-4 border border-gray-200/50 dark:border-gray-700/50\"><p class=\"text-sm text-gray-700 dark:text-gray-300 leading-relaxed whitespace-pre-wrap\" x-text=\"comment.content\"></p></div></div></div><!-- Delete Button --><div class=\"flex-shrink-0 ml-4\"><button x-show=\"canDeleteComment(comment)\" @click=\"deleteComment(comment.id)\" :disabled=\"commentDeleting[comment.id]\" class=\"p-2 text-gray-400 hover:text-red-600 dark:hover:text-red-400 rounded-lg hover:bg-red-50 dark:hover:bg-red-900/20 transition-colors duration-200 disabled:opacity-50 disabled:cursor-not-allowed\"><svg x-show=\"!commentDeleting[comment.id]\" class=\"w-5 h-5\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M19 7l-.867 12.142A2 2 0 0116.138 21H7.862a2 2 0 01-1.995-1.858L5 7m5 4v6m4-6v6m1-10V4a1 1 0 00-1-1h-4a1 1 0 00-1 1v3M4 7h16\"></path></svg> <svg x-show=\"commentDeleting[comment.id]\" class=\"animate-spin w-5 h-5\" fill=\"none\" viewBox=\"0 0 24 24\"><circle class=\"opacity-25\" cx=\"12\" cy=\"12\" r=\"10\" stroke=\"currentColor\" stroke-width=\"4\"></circle> <path class=\"opacity-75\" fill=\"currentColor\" d=\"M4 12a8 8 0 018-8V0C5.373 0 0 5.373 0 12h4zm2 5.291A7.962 7.962 0 014 12H0c0 3.042 1.135 5.824 3 7.938l3-2.647z\"></path></svg></button></div></div></div></template></div></div><div x-show=\"!alertDetails?.comments || alertDetails.comments.length === 0\" class=\"text-center py-8 text-gray-500 dark:text-gray-400\">No comments yet. Be the first to add one!</div></div><!-- Sentry Tab --><div x-show=\"currentAlertTab === 'sentry'\" x-transition:enter=\"transition-opacity ease-out duration-200\" x-transition:enter-start=\"opacity-0\" x-transition:enter-end=\"opacity-100\"><!-- Sentry Integration Content --><div x-data=\"{ sentryData: { project_info: null, release_info: null, issues: [] }, sentryLoading: false, sentryError: null, hasSentryToken: false }\" x-ref=\"sentryDataComponent\"><!-- Loading State --><div x-show=\"sentryLoading\" class=\"text-center py-12\"><div class=\"inline-block animate-spin rounded-full h-12 w-12 border-4 border-gray-300 border-t-blue-600\"></div><p class=\"mt-4 text-gray-600 dark:text-gray-400\">Loading Sentry data...</p></div><!-- Error State --><div x-show=\"sentryError && !sentryLoading\" class=\"text-center py-12\"><div class=\"mx-auto flex items-center justify-center h-12 w-12 rounded-full bg-red-100 dark:bg-red-900/20\"><svg class=\"h-6 w-6 text-red-600 dark:text-red-400\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M12 9v2m0 4h.01m-6.938 4h13.856c1.54 0 2.502-1.667 1.732-2.5L13.732 4c-.77-.833-1.866-.833-2.634 0L3.232 16.5c-.77.833.192 2.5 1.732 2.5z\"></path></svg></div><h3 class=\"mt-4 text-lg font-medium text-gray-900 dark:text-white\">Unable to load Sentry data</h3><p class=\"mt-2 text-sm text-gray-500 dark:text-gray-400\" x-text=\"sentryError\"></p><div x-show=\"!hasSentryToken\" class=\"mt-4\"><button @click=\"showSettings = true; activeTab = 'sentry'; showAlertModal = false\" class=\"inline-flex items-center px-4 py-2 border border-transparent text-sm font-medium rounded-md text-white bg-blue-600 hover:bg-blue-700 focus:outline-none focus:ring-2 focus:ring-offset-2 focus:ring-blue-500\">Configure Sentry Token</button></div></div><!-- Initial State (no data loaded yet) --><div x-show=\"!sentryData.project_info && !sentryLoading && !sentryError\" class=\"text-center py-12\"><div class=\"mx-auto flex items-center justify-center h-12 w-12 rounded-full bg-gray-100 dark:bg-gray-800\"><svg class=\"h-6 w-6 text-gray-600 dark:text-gray-400\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M9 19v-6a2 2 0 00-2-2H5a2 2 0 00-2 2v6a2 2 0 002 2h2a2 2 0 002-2zm0 0V9a2 2 0 012-2h2a2 2 0 012 2v10m-6 0a2 2 0 002 2h2a2 2 0 002-2m0 0V5a2 2 0 012-2h2a2 2 0 012 2v14a2 2 0 01-2 2h-2a2 2 0 01-2-2z\"></path></svg></div><h3 class=\"mt-4 text-lg font-medium text-gray-900 dark:text-white\">Sentry Integration</h3><p class=\"mt-2 text-sm text-gray-500 dark:text-gray-400\">Data will be loaded automatically when you view this tab.</p></div><!-- Success State --><div x-show=\"sentryData.project_info && !sentryLoading && !sentryError\"><!-- Project Context Header --><div class=\"bg-gradient-to-r from-indigo-50 to-purple-50 dark:from-indigo-900/20 dark:to-purple-900/20 rounded-xl p-4 mb-6 border border-indigo-200/50 dark:border-indigo-800/50\"><div class=\"flex items-center justify-between\"><div class=\"flex items-center space-x-3\"><div class=\"w-10 h-10 bg-indigo-600 rounded-lg flex items-center justify-center\"><span class=\"text-white font-semibold text-sm\" x-text=\"sentryData.project_info?.name?.charAt(0) || 'S'\"></span></div><div><h3 class=\"font-semibold text-gray-900 dark:text-white\" x-text=\"sentryData.project_info?.name || 'Sentry Project'\"></h3><p class=\"text-sm text-gray-600 dark:text-gray-400\" x-text=\"sentryData.project_info?.platform || 'Unknown platform'\"></p></div></div><div class=\"text-right\" x-show=\"sentryData.release_info\"><div class=\"inline-flex items-center px-2.5 py-1 rounded-full text-xs font-medium bg-green-100 text-green-800 dark:bg-green-900/50 dark:text-green-200\"><span x-text=\"sentryData.release_info?.version || 'No release'\"></span></div><p class=\"text-xs text-gray-500 mt-1\" x-text=\"sentryData.release_info?.date_created ? 'Deployed ' + new Date(sentryData.release_info.date_created).toLocaleDateString() : ''\"></p></div></div></div><!-- Metrics Cards --><div class=\"grid grid-cols-1 md:grid-cols-4 gap-4 mb-8\"><!-- Crash-Free Sessions --><div class=\"bg-gradient-to-br from-green-50 to-green-100 dark:from-green-900/20 dark:to-green-800/20 rounded-xl p-4 border border-green-200/50 dark:border-green-800/50 cursor-pointer hover:shadow-lg transition-shadow duration-200\" @click=\"window.open(alertDetails?.alert?.annotations?.sentry || alertDetails?.alert?.labels?.sentry, '_blank')\"><div class=\"flex items-center\"><div class=\"flex-shrink-0\"><svg class=\"h-6 w-6 text-green-600 dark:text-green-400\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M9 12l2 2 4-4m6 2a9 9 0 11-18 0 9 9 0 0118 0z\"></path></svg></div><div class=\"ml-3 w-0 flex-1\"><dl><dt class=\"text-xs font-medium text-green-700 dark:text-green-300 truncate\">Crash-Free Sessions</dt><dd class=\"text-lg font-semibold text-green-900 dark:text-green-100\" x-text=\"sentryData?.project_stats?.has_session_data ? \n\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t\tMath.round(sentryData.project_stats.crash_free_session_rate * 100) / 100 + '%' : \n\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t(sentryData?.project_stats?.available_data ? 'No session data' : 'N/A')\"></dd></dl></div></div></div><!-- Crash-Free Users --><div class=\"bg-gradient-to-br from-blue-50 to-blue-100 dark:from-blue-900/20 dark:to-blue-800/20 rounded-xl p-4 border border-blue-200/50 dark:border-blue-800/50 cursor-pointer hover:shadow-lg transition-shadow duration-200\" @click=\"window.open(alertDetails?.alert?.annotations?.sentry || alertDetails?.alert?.labels?.sentry, '_blank')\"><div class=\"flex items-center\"><div class=\"flex-shrink-0\"><svg class=\"h-6 w-6 text-blue-600 dark:text-blue-400\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M17 20h5v-2a3 3 0 00-5.356-1.857M17 20H7m10 0v-2c0-.656-.126-1.283-.356-1.857M7 20H2v-2a3 3 0 015.356-1.857M7 20v-2c0-.656.126-1.283.356-1.857m0 0a5.002 5.002 0 019.288 0M15 7a3 3 0 11-6 0 3 3 0 016 0zm6 3a2 2 0 11-4 0 2 2 0 014 0zM7 10a2 2 0 11-4 0 2 2 0 014 0z\"></path></svg></div><div class=\"ml-3 w-0 flex-1\"><dl><dt class=\"text-xs font-medium text-blue-700 dark:text-blue-300 truncate\">Crash-Free Users</dt><dd class=\"text-lg font-semibold text-blue-900 dark:text-blue-100\" x-text=\"sentryData?.project_stats?.has_session_data ? \n\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t\tMath.round(sentryData.project_stats.crash_free_user_rate * 100) / 100 + '%' : \n\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t(sentryData?.project_stats?.available_data ? 'No session data' : 'N/A')\"></dd></dl></div></div></div><!-- Issues Count --><div class=\"bg-gradient-to-br from-orange-50 to-orange-100 dark:from-orange-900/20 dark:to-orange-800/20 rounded-xl p-4 border border-orange-200/50 dark:border-orange-800/50 cursor-pointer hover:shadow-lg transition-shadow duration-200\" @click=\"window.open(alertDetails?.alert?.annotations?.sentry || alertDetails?.alert?.labels?.sentry, '_blank')\"><div class=\"flex items-center\"><div class=\"flex-shrink-0\"><svg class=\"h-6 w-6 text-orange-600 dark:text-orange-400\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M12 8v4m0 4h.01M21 12a9 9 0 11-18 0 9 9 0 0118 0z\"></path></svg></div><div class=\"ml-3 w-0 flex-1\"><dl><dt class=\"text-xs font-medium text-orange-700 dark:text-orange-300 truncate\">Issues</dt><dd class=\"text-lg font-semibold text-orange-900 dark:text-orange-100\" x-text=\"sentryData.issues?.length || 0\"></dd></dl></div></div></div><!-- Apdex Score --><div class=\"bg-gradient-to-br from-purple-50 to-purple-100 dark:from-purple-900/20 dark:to-purple-800/20 rounded-xl p-4 border border-purple-200/50 dark:border-purple-800/50 cursor-pointer hover:shadow-lg transition-shadow duration-200\" @click=\"window.open(alertDetails?.alert?.annotations?.sentry || alertDetails?.alert?.labels?.sentry, '_blank')\"><div class=\"flex items-center\"><div class=\"flex-shrink-0\"><svg class=\"h-6 w-6 text-purple-600 dark:text-purple-400\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M13 10V3L4 14h7v7l9-11h-7z\"></path></svg></div><div class=\"ml-3 w-0 flex-1\"><dl><dt class=\"text-xs font-medium text-purple-700 dark:text-purple-300 truncate\">Apdex Score</dt><dd class=\"text-lg font-semibold text-purple-900 dark:text-purple-100\" x-text=\"sentryData?.project_stats?.has_performance_data ? sentryData.project_stats.apdex_score.toFixed(2) : 'N/A'\"></dd></dl></div></div></div></div><!-- Session Tracking Info --><div x-show=\"sentryData?.project_stats?.available_data && !sentryData.project_stats.has_session_data\" class=\"mb-6\"><div class=\"bg-blue-50 dark:bg-blue-900/20 border border-blue-200 dark:border-blue-800 rounded-lg p-4\"><div class=\"flex items-start\"><div class=\"flex-shrink-0\"><svg class=\"h-5 w-5 text-blue-600 dark:text-blue-400 mt-0.5\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M13 16h-1v-4h-1m1-4h.01M21 12a9 9 0 11-18 0 9 9 0 0118 0z\"></path></svg></div><div class=\"ml-3\"><h3 class=\"text-sm font-medium text-blue-800 dark:text-blue-200\">Session Tracking Not Available</h3><div class=\"mt-1 text-sm text-blue-700 dark:text-blue-300\"><p>Crash-free session metrics require session tracking to be enabled in your Sentry SDK. Without session tracking, these metrics will show \"No session data\".</p><p class=\"mt-1\"><a href=\"https://docs.sentry.io/platforms/javascript/configuration/releases/#release-health\" target=\"_blank\" class=\"font-medium underline hover:no-underline\">Learn how to enable session tracking →</a></p></div></div></div></div></div><!-- Data Status Indicator --><div x-show=\"!sentryData?.project_stats?.available_data && sentryData?.project_stats\" class=\"mb-6\"><div class=\"bg-yellow-50 dark:bg-yellow-900/20 border border-yellow-200 dark:border-yellow-800 rounded-lg p-3\"><div class=\"flex\"><div class=\"flex-shrink-0\"><svg class=\"h-5 w-5 text-yellow-400\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M12 9v2m0 4h.01M21 12a9 9 0 11-18 0 9 9 0 0118 0z\"></path></svg></div><div class=\"ml-3\"><p class=\"text-sm text-yellow-700 dark:text-yellow-300\">Event statistics are not available. This may be due to API limitations or the time range selected.</p></div></div></div></div><!-- Issues List --><div x-show=\"sentryData.issues && sentryData.issues.length > 0\"><h4 class=\"text-lg font-semibold text-gray-900 dark:text-white mb-4 flex items-center\"><svg class=\"w-5 h-5 mr-2 text-red-600 dark:text-red-400\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M12 8v4m0 4h.01M21 12a9 9 0 11-18 0 9 9 0 0118 0z\"></path></svg> Recent Issues</h4><div class=\"max-h-96 overflow-y-auto space-y-4 pr-2\"><template x-for=\"issue in sentryData.issues\" x-key=\"issue.id\"><div class=\"bg-white dark:bg-dark-bg-tertiary rounded-xl p-6 shadow-sm border border-red-200/50 dark:border-red-800/50 hover:shadow-md transition-all duration-200\"><div class=\"flex items-start justify-between\"><div class=\"flex-1 min-w-0\"><div class=\"flex items-start justify-between mb-2\"><div class=\"flex items-center space-x-2\"><!-- Level badge --><span class=\"inline-flex items-center px-2.5 py-0.5 rounded-full text-xs font-medium\" :class=\"issue.level === 'error' ? 'bg-red-100 text-red-800 dark:bg-red-900/50 dark:text-red-200' : 'bg-yellow-100 text-yellow-800 dark:bg-yellow-900/50 dark:text-yellow-200'\" x-text=\"issue.level\"></span><!-- Environment badge --><span x-show=\"issue.environment\" class=\"inline-flex items-center px-2 py-0.5 rounded-full text-xs font-medium bg-blue-100 text-blue-800 dark:bg-blue-900/50 dark:text-blue-200\" x-text=\"issue.environment\"></span><!-- Platform badge --><span x-show=\"issue.platform\" class=\"inline-flex items-center px-2 py-0.5 rounded-full text-xs font-medium bg-gray-100 text-gray-800 dark:bg-gray-800 dark:text-gray-200\" x-text=\"issue.platform\"></span><!-- Short ID --><span class=\"text-sm text-gray-500 dark:text-gray-400\" x-text=\"issue.short_id\"></span></div><!-- Status and assignment info --><div class=\"flex items-center space-x-2 text-right\"><span x-show=\"issue.assigned_to\" class=\"text-xs text-blue-600 dark:text-blue-400\" x-text=\"'Assigned: ' + issue.assigned_to.name\"></span> <span class=\"inline-flex items-center px-2 py-0.5 rounded-full text-xs font-medium\" :class=\"issue.status === 'resolved' ? 'bg-green-100 text-green-800 dark:bg-green-900/50 dark:text-green-200' : \n\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t issue.status === 'ignored' ? 'bg-gray-100 text-gray-800 dark:bg-gray-800 dark:text-gray-200' : \n\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t 'bg-orange-100 text-orange-800 dark:bg-orange-900/50 dark:text-orange-200'\" x-text=\"issue.status\"></span></div></div><h5 class=\"text-base font-medium text-gray-900 dark:text-white truncate mb-2\" x-text=\"issue.title\"></h5><div class=\"flex items-center space-x-4 text-sm text-gray-500 dark:text-gray-400\"><span x-text=\"issue.event_count + ' events'\"></span> <span x-text=\"issue.user_count + ' users'\"></span> <span x-text=\"'Last seen: ' + new Date(issue.last_seen).toLocaleString()\"></span></div><!-- Culprit/location info --><div x-show=\"issue.culprit\" class=\"mt-2\"><p class=\"text-sm text-gray-600 dark:text-gray-400 font-mono text-xs truncate\" x-text=\"issue.culprit\"></p></div></div><div class=\"flex-shrink-0 ml-4\"><a :href=\"issue.url\" target=\"_blank\" class=\"inline-flex items-center px-3 py-1 border border-transparent text-sm leading-4 font-medium rounded-md text-blue-700 bg-blue-100 hover:bg-blue-200 dark:bg-blue-900/50 dark:text-blue-200 dark:hover:bg-blue-800/50 transition-colors\">View in Sentry <svg class=\"ml-1 w-4 h-4\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M10 6H6a2 2 0 00-2 2v10a2 2 0 002 2h10a2 2 0 002-2v-4M14 4h6m0 0v6m0-6L10 14\"></path></svg></a></div></div></div></template></div></div><div x-show=\"!sentryData.issues || sentryData.issues.length === 0\" class=\"text-center py-8 text-gray-500 dark:text-gray-400\"><svg class=\"mx-auto h-12 w-12 text-gray-400\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M9 12l2 2 4-4m6 2a9 9 0 11-18 0 9 9 0 0118 0z\"></path></svg><h3 class=\"mt-2 text-sm font-medium text-gray-900 dark:text-white\">No issues found</h3><p class=\"mt-1 text-sm text-gray-500\">No issues were found in the selected time range.</p></div></div></div></div><!-- History Tab --><div x-show=\"currentAlertTab === 'history'\" x-transition:enter=\"transition-opacity ease-out duration-200\" x-transition:enter-start=\"opacity-0\" x-transition:enter-end=\"opacity-100\"><!-- Loading State --><div x-show=\"historyLoading\" class=\"flex justify-center items-center py-12\"><div class=\"inline-block animate-spin rounded-full h-12 w-12 border-4 border-gray-300 border-t-blue-600\"></div></div><!-- History Timeline --><div x-show=\"!historyLoading && alertHistory?.history\" class=\"space-y-6\"><h3 class=\"text-lg font-semibold text-gray-900 dark:text-white\">Alert Occurrence Timeline</h3><!-- Timeline --><div class=\"relative max-h-96 overflow-y-auto pr-2\"><div class=\"absolute left-8 top-0 bottom-0 w-0.5 bg-gray-200 dark:bg-gray-700\"></div><template x-for=\"(event, index) in alertHistory?.history\" :key=\"event.id\"><div class=\"relative flex items-start mb-6 pl-16\"><!-- Timeline Dot --><div class=\"absolute left-6 w-4 h-4 rounded-full\" :class=\"event.resolved_at ? 'bg-green-500' : 'bg-yellow-500'\"></div><!-- Event Card --><div class=\"flex-1 bg-white dark:bg-dark-bg-tertiary rounded-lg p-4 shadow-sm border border-gray-200 dark:border-dark-border-subtle\"><div class=\"flex justify-between items-start mb-2\"><div><span class=\"text-xs font-medium text-gray-500 dark:text-gray-400\">Occurrence #<span x-text=\"alertHistory.total_occurrences - index\"></span></span><div class=\"text-sm text-gray-900 dark:text-white mt-1\"><strong>Fired:</strong> <span x-text=\"formatDateTime(event.fired_at)\"></span></div><div x-show=\"event.resolved_at\" class=\"text-sm text-green-600 dark:text-green-400 mt-1\"><strong>Resolved:</strong> <span x-text=\"formatDateTime(event.resolved_at)\"></span></div></div><div class=\"text-right\" x-show=\"event.duration_seconds\"><span class=\"text-xs text-gray-500 dark:text-gray-400\">Duration:</span><div class=\"text-sm font-semibold text-gray-900 dark:text-white\" x-text=\"formatDuration(event.duration_seconds)\"></div></div></div><!-- Acknowledgment Info --><div x-show=\"event.acknowledged_at\" class=\"mt-2 flex items-center text-xs text-blue-600 dark:text-blue-400\"><svg class=\"w-3 h-3 mr-1\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M5 13l4 4L19 7\"></path></svg> Acknowledged: <span x-text=\"formatDateTime(event.acknowledged_at)\" class=\"ml-1\"></span></div></div></div></template></div><!-- Empty State --><div x-show=\"!alertHistory?.history || alertHistory.history.length === 0\" class=\"text-center py-12 text-gray-500 dark:text-gray-400\">No history data available for this alert.</div></div></div></div></div></div></div><!-- End alertDetails content wrapper --></div></div></div></div>")
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 21, "</div><!-- Acknowledgments Tab --><div x-show=\"currentAlertTab === 'acknowledgments'\"><div x-show=\"alertDetails?.acknowledgments && alertDetails.acknowledgments.length > 0\" class=\"space-y-3\"><!-- Remove All clears every acknowledgment of yours on this alert --><div x-show=\"(alertDetails?.acknowledgments || []).some(a => canRemoveAcknowledgment(a))\" class=\"flex justify-end\"><button @click=\"unacknowledgeCurrentAlert()\" class=\"px-3 py-1.5 text-xs font-medium text-red-600 dark:text-red-400 hover:bg-red-50 dark:hover:bg-red-900/20 rounded-lg transition-colors\">Remove All</button></div><template x-for=\"ack in (alertDetails?.acknowledgments || [])\" :key=\"ack.id\"><div class=\"border border-gray-200 dark:border-dark-border-subtle rounded-lg p-4\"><div class=\"flex items-center justify-between mb-2\"><div class=\"flex items-center space-x-2\"><svg class=\"w-4 h-4 text-green-500\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M5 13l4 4L19 7\"></path></svg> <span class=\"text-sm font-medium text-gray-900 dark:text-white\" x-text=\"ack.username\"></span> <span x-show=\"ack.expiresAt\" class=\"text-xs px-2 py-0.5 rounded-full bg-yellow-100 dark:bg-yellow-800 text-yellow-800 dark:text-yellow-200\" :title=\"ack.expiresAt ? 'Expires ' + new Date(ack.expiresAt).toLocaleString() : ''\" x-text=\"ack.expiresAt ? ackTimeRemaining(ack.expiresAt) : ''\"></span></div><div class=\"flex items-center space-x-3\"><span class=\"text-xs text-gray-500 dark:text-gray-400\" x-text=\"new Date(ack.createdAt).toLocaleString()\"></span> <button x-show=\"canRemoveAcknowledgment(ack)\" @click=\"removeAcknowledgment(ack.id)\" :disabled=\"ackRemoving[ack.id]\" class=\"text-xs font-medium text-red-600 dark:text-red-400 hover:underline disabled:opacity-50\"><span x-text=\"ackRemoving[ack.id] ? 'Removing...' : 'Remove'\"></span></button></div></div><p class=\"text-sm text-gray-600 dark:text-gray-400\" x-text=\"ack.reason\"></p></div></template><div x-show=\"alertDetails?.acknowledgmentsCursor\" class=\"flex justify-center\"><button @click=\"loadOlderAcknowledgments()\" :disabled=\"olderAcknowledgmentsLoading\" class=\"px-4 py-2 text-sm font-medium text-blue-600 dark:text-blue-400 hover:bg-blue-50 dark:hover:bg-blue-900/20 rounded-lg disabled:opacity-50\"><span x-text=\"olderAcknowledgmentsLoading ? 'Loading...' : 'Load older'\"></span></button></div></div><div x-show=\"!alertDetails?.acknowledgments || alertDetails.acknowledgments.length === 0\" class=\"text-center py-8 text-gray-500 dark:text-gray-400\">No acknowledgments yet</div><!-- Escalations --><div x-show=\"alertDetails?.escalations && alertDetails.escalations.length > 0\" class=\"mt-6 space-y-3\"><h4 class=\"text-sm font-semibold text-gray-900 dark:text-white\">Escalations</h4><template x-for=\"escalation in (alertDetails?.escalations || [])\" :key=\"escalation.id\"><div class=\"border border-red-200 dark:border-red-900/50 rounded-lg p-4\"><div class=\"flex items-center justify-between mb-2\"><div class=\"flex items-center space-x-2 text-sm\"><svg class=\"w-4 h-4 text-red-500\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M5 10l7-7m0 0l7 7m-7-7v18\"></path></svg> <span class=\"font-medium text-gray-900 dark:text-white\" x-text=\"escalation.username\"></span> <span class=\"text-gray-500 dark:text-gray-400\">escalated to</span> <span class=\"font-medium text-gray-900 dark:text-white\" x-text=\"(escalation.targetType === 'group' ? '' : '@') + escalation.escalatedTo\"></span> <span x-show=\"escalation.targetType === 'group'\" class=\"px-2 py-0.5 text-xs rounded-full bg-gray-100 dark:bg-dark-bg-tertiary text-gray-600 dark:text-gray-300\">group</span></div><span class=\"text-xs text-gray-500 dark:text-gray-400\" x-text=\"new Date(escalation.createdAt).toLocaleString()\"></span></div><p x-show=\"escalation.reason\" class=\"text-sm text-gray-600 dark:text-gray-400 whitespace-pre-wrap\" x-text=\"escalation.reason\"></p></div></template></div></div><!-- Comments Tab --><div x-show=\"currentAlertTab === 'comments'\" x-transition:enter=\"transition-opacity ease-out duration-200\" x-transition:enter-start=\"opacity-0\" x-transition:enter-end=\"opacity-100\"><!-- Modern Add Comment Form --><div class=\"mb-8 bg-gradient-to-r from-blue-50 to-indigo-50 dark:from-gray-800 dark:to-gray-900 rounded-xl p-6 border border-blue-200/50 dark:border-blue-800/50 shadow-sm\"><div class=\"flex items-center mb-4\"><svg class=\"w-5 h-5 mr-2 text-blue-500\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M12 6v6m0 0v6m0-6h6m-6 0H6\"></path></svg><h4 class=\"text-lg font-semibold text-gray-900 dark:text-white\">Add Comment</h4><!-- Write is the default; Preview renders the text as it will be posted --><div class=\"ml-auto flex items-center text-sm\"><button @click=\"commentPreview = false\" :class=\"!commentPreview ? 'border-blue-500 text-blue-600 dark:text-blue-400' : 'border-transparent text-gray-500 dark:text-gray-400 hover:text-gray-700 dark:hover:text-gray-200'\" class=\"px-3 py-1 border-b-2 font-medium transition-colors duration-200\">Write</button> <button @click=\"commentPreview = true\" :class=\"commentPreview ? 'border-blue-500 text-blue-600 dark:text-blue-400' : 'border-transparent text-gray-500 dark:text-gray-400 hover:text-gray-700 dark:hover:text-gray-200'\" class=\"px-3 py-1 border-b-2 font-medium transition-colors duration-200\">Preview</button></div></div><div class=\"space-y-4\"><div x-show=\"commentPreview\" style=\"min-height: 7rem\" class=\"px-4 py-3 bg-white dark:bg-dark-bg-secondary border-2 border-gray-200 dark:border-dark-border-DEFAULT rounded-xl text-sm text-gray-700 dark:text-gray-300 leading-relaxed break-words\"><div x-show=\"newCommentContent.trim()\" x-html=\"renderCommentMarkdown(newCommentContent)\"></div><p x-show=\"!newCommentContent.trim()\" class=\"text-gray-400 dark:text-gray-500 italic\">Nothing to preview</p></div><div x-show=\"!commentPreview\" class=\"relative\"><textarea x-model=\"newCommentContent\" x-ref=\"commentInput\" :maxlength=\"maxCommentLength()\" @input.debounce.500ms=\"saveCommentDraft()\" rows=\"4\" placeholder=\"Share your thoughts, add notes, or provide updates about this alert...\" class=\"w-full px-4 py-3 bg-white dark:bg-dark-bg-secondary border-2 border-gray-200 dark:border-dark-border-DEFAULT rounded-xl shadow-sm focus:outline-none focus:ring-2 focus:ring-blue-500 focus:border-blue-500 dark:text-white resize-none placeholder-gray-400 dark:placeholder-gray-500 transition-all duration-200\"></textarea><div class=\"absolute bottom-3 right-3 text-xs\" :class=\"commentTooLong() ? 'text-red-500' : 'text-gray-400 dark:text-gray-500'\" x-text=\"[...newCommentContent].length + '/' + maxCommentLength()\"></div></div><div class=\"flex items-center justify-between\"><div class=\"flex items-center space-x-2 text-sm text-gray-500 dark:text-gray-400\"><svg class=\"w-4 h-4\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M13 16h-1v-4h-1m1-4h.01M21 12a9 9 0 11-18 0 9 9 0 0118 0z\"></path></svg> <span x-show=\"!commentDraftSavedAt\">Comments help track alert resolution progress</span> <span x-show=\"commentDraftSavedAt\" x-text=\"commentDraftSavedAt ? `Draft saved at ${new Date(commentDraftSavedAt).toLocaleTimeString()}` : ''\"></span></div><div class=\"flex items-center space-x-2\"><button @click=\"saveCommentDraft()\" :disabled=\"!newCommentContent.trim() || commentSubmitting\" class=\"inline-flex items-center px-4 py-3 bg-white dark:bg-dark-bg-secondary border border-gray-300 dark:border-dark-border-DEFAULT text-gray-700 dark:text-gray-300 hover:bg-gray-50 dark:hover:bg-dark-bg-tertiary text-sm font-medium rounded-xl transition-colors duration-200 disabled:opacity-50 disabled:cursor-not-allowed\">Save Draft</button> <button @click=\"addComment()\" :disabled=\"!newCommentContent.trim() || commentTooLong() || commentSubmitting\" class=\"inline-flex items-center px-6 py-3 bg-blue-600 hover:bg-blue-700 disabled:bg-gray-300 disabled:hover:bg-gray-300 text-white text-sm font-medium rounded-xl shadow-lg shadow-blue-600/25 transition-all duration-200 hover:shadow-blue-600/40 hover:scale-105 disabled:scale-100 disabled:shadow-none disabled:cursor-not-allowed\"><svg x-show=\"commentSubmitting\" class=\"animate-spin -ml-1 mr-2 h-4 w-4 text-white\" fill=\"none\" viewBox=\"0 0 24 24\"><circle class=\"opacity-25\" cx=\"12\" cy=\"12\" r=\"10\" stroke=\"currentColor\" stroke-width=\"4\"></circle> <path class=\"opacity-75\" fill=\"currentColor\" d=\"M4 12a8 8 0 018-8V0C5.373 0 0 5.373 0 12h4zm2 5.291A7.962 7.962 0 014 12H0c0 3.042 1.135 5.824 3 7.938l3-2.647z\"></path></svg> <svg x-show=\"!commentSubmitting\" class=\"w-4 h-4 mr-2\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M12 19l9 2-9-18-9 18 9-2zm0 0v-8\"></path></svg> <span x-show=\"!commentSubmitting\">Post Comment</span> <span x-show=\"commentSubmitting\">Posting...</span></button></div></div></div></div><!-- Modern Comments List --><div x-show=\"alertDetails?.comments && alertDetails.comments.length > 0\" class=\"space-y-4\"><div class=\"flex items-center mb-4\"><svg class=\"w-5 h-5 mr-2 text-gray-500\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M8 12h.01M12 12h.01M16 12h.01M21 12c0 4.418-4.03 8-9 8a9.863 9.863 0 01-4.255-.949L3 20l1.395-3.72C3.512 15.042 3 13.574 3 12c0-4.418 4.03-8 9-8s9 3.582 9 8z\"></path></svg><h4 class=\"text-lg font-semibold text-gray-900 dark:text-white\">Comments  <span class=\"text-sm font-normal text-gray-500 dark:text-gray-400\">(<span x-text=\"commentTagFilter ? taggedComments.length : (alertDetails?.commentsTotal || 0)\"></span>)</span></h4></div><!-- Set by clicking a #tag chip in a comment --><div x-show=\"commentTagFilter\" class=\"flex items-center justify-between rounded-lg border border-purple-200 dark:border-purple-800 bg-purple-50 dark:bg-purple-900/20 px-4 py-2 text-sm text-purple-800 dark:text-purple-200\"><span>Showing comments tagged <span class=\"font-semibold\" x-text=\"'#' + commentTagFilter\"></span> <span x-show=\"taggedCommentsLoading\" class=\"text-xs opacity-75\">(loading…)</span></span> <button @click=\"filterCommentsByTag('')\" class=\"rounded px-2 py-1 font-medium hover:bg-purple-100 hover:text-purple-500\">Show all</button></div><!-- Scrollable comments container --><div class=\"max-h-96 overflow-y-auto space-y-4 pr-2\"><!-- Only the most recent comments come with the alert --><div x-show=\"!commentTagFilter && alertDetails?.commentsCursor\" class=\"flex justify-center\"><button @click=\"loadOlderComments()\" :disabled=\"olderCommentsLoading\" class=\"px-4 py-2 text-sm font-medium text-blue-600 dark:text-blue-400 hover:bg-blue-50 dark:hover:bg-blue-900/20 rounded-lg disabled:opacity-50\"><span x-text=\"olderCommentsLoading ? 'Loading...' : 'Load older'\"></span></button></div><div x-show=\"commentTagFilter && !taggedCommentsLoading && taggedComments.length === 0\" class=\"text-center py-4 text-sm text-gray-500 dark:text-gray-400\">No comments carry this tag.</div><template x-for=\"comment in (commentTagFilter ? taggedComments : (alertDetails?.comments || []))\" x-key=\"comment.id\"><div class=\"bg-white dark:bg-dark-bg-tertiary rounded-xl p-6 shadow-sm border border-gray-200/50 dark:border-dark-border-subtle/50 hover:shadow-md transition-all duration-200\"><div class=\"flex items-start justify-between\"><div class=\"flex items-start space-x-4 flex-1 min-w-0\"><!-- User Avatar --><div class=\"flex-shrink-0\"><div class=\"w-10 h-10 bg-gradient-to-br from-blue-500 to-purple-600 rounded-full flex items-center justify-center shadow-lg\"><span class=\"text-white text-sm font-semibold\" x-text=\"comment.username.charAt(0).toUpperCase()\"></span></div></div><!-- Comment Content --><div class=\"flex-1 min-w-0\"><div class=\"flex items-center space-x-3 mb-3\"><span class=\"text-base font-semibold text-gray-900 dark:text-white\" x-text=\"comment.username\"></span> <span x-show=\"comment.isSystem\" class=\"inline-flex items-center px-2 py-1 rounded-full text-xs font-medium bg-blue-100 text-blue-800 dark:bg-blue-900/50 dark:text-blue-200 border border-blue-200 dark:border-blue-800\"><svg class=\"w-3 h-3 mr-1\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M9.75 17L9 20l-1 1h8l-1-1-.75-3M3 13h18M5 17h14a2 2 0 002-2V5a2 2 0 00-2-2H5a2 2 0 00-2 2v10a2 2 0 002 2z\"></path></svg> System</span><div class=\"flex items-center text-sm text-gray-500 dark:text-gray-400\"><svg class=\"w-4 h-4 mr-1\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M12 8v4l3 3m6-3a9 9 0 11-18 0 9 9 0 0118 0z\"></path></svg> <span x-text=\"new Date(comment.createdAt).toLocaleString()\"></span></div></div><div class=\"bg-gray-50 dark:bg-gray-800/50 rounded-lg p-4 border border-gray-200/50 dark:border-gray-700/50\"><div class=\"text-sm text-gray-700 dark:text-gray-300 leading-relaxed break-words\" @click=\"onCommentTagClick($event)\" x-html=\"renderCommentMarkdown(comment.content)\"></div></div></div></div><!-- Quote / Delete Buttons --><div class=\"flex-shrink-0 ml-4 flex items-center space-x-1\"><button @click=\"quoteComment(comment)\" title=\"Quote\" class=\"p-2 text-gray-400 hover:text-blue-600 dark:hover:text-blue-400 rounded-lg hover:bg-blue-50 dark:hover:bg-blue-900/20 transition-colors duration-200\"><svg class=\"w-5 h-5\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M3 10h10a8 8 0 018 8v2M3 10l6 6m-6-6l6-6\"></path></svg></button> <button x-show=\"canDeleteComment(comment)\" @click=\"deleteComment(comment.id)\" :disabled=\"commentDeleting[comment.id]\" class=\"p-2 text-gray-400 hover:text-red-600 dark:hover:text-red-400 rounded-lg hover:bg-red-50 dark:hover:bg-red-900/20 transition-colors duration-200 disabled:opacity-50 disabled:cursor-not-allowed\"><svg x-show=\"!commentDeleting[comment.id]\" class=\"w-5 h-5\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M19 7l-.867 12.142A2 2 0 0116.138 21H7.862a2 2 0 01-1.995-1.858L5 7m5 4v6m4-6v6m1-10V4a1 1 0 00-1-1h-4a1 1 0 00-1 1v3M4 7h16\"></path></svg> <svg x-show=\"commentDeleting[comment.id]\" class=\"animate-spin w-5 h-5\" fill=\"none\" viewBox=\"0 0 24 24\"><circle class=\"opacity-25\" cx=\"12\" cy=\"12\" r=\"10\" stroke=\"currentColor\" stroke-width=\"4\"></circle> <path class=\"opacity-75\" fill=\"currentColor\" d=\"M4 12a8 8 0 018-8V0C5.373 0 0 5.373 0 12h4zm2 5.291A7.962 7.962 0 014 12H0c0 3.042 1.135 5.824 3 7.938l3-2.647z\"></path></svg></button></div></div></div></template></div></div><div x-show=\"!alertDetails?.comments || alertDetails.comments.length === 0\" class=\"text-center py-8 text-gray-500 dark:text-gray-400\">No comments yet. Be the first to add one!</div></div><!-- Sentry Tab --><div x-show=\"currentAlertTab === 'sentry'\" x-transition:enter=\"transition-opacity ease-out duration-200\" x-transition:enter-start=\"opacity-0\" x-transition:enter-end=\"opacity-100\"><!-- Sentry Integration Content --><div x-data=\"{ sentryData: { project_info: null, release_info: null, issues: [] }, sentryLoading: false, sentryError: null, hasSentryToken: false }\" x-ref=\"sentryDataComponent\"><!-- Loading State --><div x-show=\"sentryLoading\" class=\"text-center py-12\"><div class=\"inline-block animate-spin rounded-full h-12 w-12 border-4 border-gray-300 border-t-blue-600\"></div><p class=\"mt-4 text-gray-600 dark:text-gray-400\">Loading Sentry data...</p></div><!-- Error State --><div x-show=\"sentryError && !sentryLoading\" class=\"text-center py-12\"><div class=\"mx-auto flex items-center justify-center h-12 w-12 rounded-full bg-red-100 dark:bg-red-900/20\"><svg class=\"h-6 w-6 text-red-600 dark:text-red-400\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M12 9v2m0 4h.01m-6.938 4h13.856c1.54 0 2.502-1.667 1.732-2.5L13.732 4c-.77-.833-1.866-.833-2.634 0L3.232 16.5c-.77.833.192 2.5 1.732 2.5z\"></path></svg></div><h3 class=\"mt-4 text-lg font-medium text-gray-900 dark:text-white\">Unable to load Sentry data</h3><p class=\"mt-2 text-sm text-gray-500 dark:text-gray-400\" x-text=\"sentryError\"></p><div x-show=\"!hasSentryToken\" class=\"mt-4\"><button @click=\"showSettings = true; activeTab = 'sentry'; unsubscribeFromAlertUpdates(); showAlertModal = false\" class=\"inline-flex items-center px-4 py-2 border border-transparent text-sm font-medium rounded-md text-white bg-blue-600 hover:bg-blue-700 focus:outline-none focus:ring-2 focus:ring-offset-2 focus:ring-blue-500\">Configure Sentry Token</button></div></div><!-- Initial State (no data loaded yet) --><div x-show=\"!sentryData.project_info && !sentryLoading && !sentryError\" class=\"text-center py-12\"><div class=\"mx-auto flex items-center justify-center h-12 w-12 rounded-full bg-gray-100 dark:bg-gray-800\"><svg class=\"h-6 w-6 text-gray-600 dark:text-gray-400\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M9 19v-6a2 2 0 00-2-2H5a2 2 0 00-2 2v6a2 2 0 002 2h2a2 2 0 002-2zm0 0V9a2 2 0 012-2h2a2 2 0 012 2v10m-6 0a2 2 0 002 2h2a2 2 0 002-2m0 0V5a2 2 0 012-2h2a2 2 0 012 2v14a2 2 0 01-2 2h-2a2 2 0 01-2-2z\"></path></svg></div><h3 class=\"mt-4 text-lg font-medium text-gray-900 dark:text-white\">Sentry Integration</h3><p class=\"mt-2 text-sm text-gray-500 dark:text-gray-400\">Data will be loaded automatically when you view this tab.</p></div><!-- Success State --><div x-show=\"sentryData.project_info && !sentryLoading && !sentryError\"><!-- Project Context Header --><div class=\"bg-gradient-to-r from-indigo-50 to-purple-50 dark:from-indigo-900/20 dark:to-purple-900/20 rounded-xl p-4 mb-6 border border-indigo-200/50 dark:border-indigo-800/50\"><div class=\"flex items-center justify-between\"><div class=\"flex items-center space-x-3\"><div class=\"w-10 h-10 bg-indigo-600 rounded-lg flex items-center justify-center\"><span class=\"text-white font-semibold text-sm\" x-text=\"sentryData.project_info?.name?.charAt(0) || 'S'\"></span></div><div><h3 class=\"font-semibold text-gray-900 dark:text-white\" x-text=\"sentryData.project_info?.name || 'Sentry Project'\"></h3><p class=\"text-sm text-gray-600 dark:text-gray-400\" x-text=\"sentryData.project_info?.platform || 'Unknown platform'\"></p></div></div><div class=\"text-right\" x-show=\"sentryData.release_info\"><div class=\"inline-flex items-center px-2.5 py-1 rounded-full text-xs font-medium bg-green-100 text-green-800 dark:bg-green-900/50 dark:text-green-200\"><span x-text=\"sentryData.release_info?.version || 'No release'\"></span></div><p class=\"text-xs text-gray-500 mt-1\" x-text=\"sentryData.release_info?.date_created ? 'Deployed ' + new Date(sentryData.release_info.date_created).toLocaleDateString() : ''\"></p></div></div></div><!-- Metrics Cards --><div class=\"grid grid-cols-1 md:grid-cols-4 gap-4 mb-8\"><!-- Crash-Free Sessions --><div class=\"bg-gradient-to-br from-green-50 to-green-100 dark:from-green-900/20 dark:to-green-800/20 rounded-xl p-4 border border-green-200/50 dark:border-green-800/50 cursor-pointer hover:shadow-lg transition-shadow duration-200\" @click=\"window.open(sentryData?.sentry_url || alertSentryUrl(alertDetails?.alert), '_blank', 'noopener')\"><div class=\"flex items-center\"><div class=\"flex-shrink-0\"><svg class=\"h-6 w-6 text-green-600 dark:text-green-400\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M9 12l2 2 4-4m6 2a9 9 0 11-18 0 9 9 0 0118 0z\"></path></svg></div><div class=\"ml-3 w-0 flex-1\"><dl><dt class=\"text-xs font-medium text-green-700 dark:text-green-300 truncate\">Crash-Free Sessions</dt><dd class=\"text-lg font-semibold text-green-900 dark:text-green-100\" x-text=\"sentryData?.project_stats?.has_session_data ? \n\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t\tMath.round(sentryData.project_stats.crash_free_session_rate * 100) / 100 + '%' : \n\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t(sentryData?.project_stats?.available_data ? 'No session data' : 'N/A')\"></dd></dl></div></div></div><!-- Crash-Free Users --><div class=\"bg-gradient-to-br from-blue-50 to-blue-100 dark:from-blue-900/20 dark:to-blue-800/20 rounded-xl p-4 border border-blue-200/50 dark:border-blue-800/50 cursor-pointer hover:shadow-lg transition-shadow duration-200\" @click=\"window.open(sentryData?.sentry_url || alertSentryUrl(alertDetails?.alert), '_blank', 'noopener')\"><div class=\"flex items-center\"><div class=\"flex-shrink-0\"><svg class=\"h-6 w-6 text-blue-600 dark:text-blue-400\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M17 20h5v-2a3 3 0 00-5.356-1.857M17 20H7m10 0v-2c0-.656-.126-1.283-.356-1.857M7 20H2v-2a3 3 0 015.356-1.857M7 20v-2c0-.656.126-1.283.356-1.857m0 0a5.002 5.002 0 019.288 0M15 7a3 3 0 11-6 0 3 3 0 016 0zm6 3a2 2 0 11-4 0 2 2 0 014 0zM7 10a2 2 0 11-4 0 2 2 0 014 0z\"></path></svg></div><div class=\"ml-3 w-0 flex-1\"><dl><dt class=\"text-xs font-medium text-blue-700 dark:text-blue-300 truncate\">Crash-Free Users</dt><dd class=\"text-lg font-semibold text-blue-900 dark:text-blue-100\" x-text=\"sentryData?.project_stats?.has_session_data ? \n\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t\tMath.round(sentryData.project_stats.crash_free_user_rate * 100) / 100 + '%' : \n\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t(sentryData?.project_stats?.available_data ? 'No session data' : 'N/A')\"></dd></dl></div></div></div><!-- Issues Count --><div class=\"bg-gradient-to-br from-orange-50 to-orange-100 dark:from-orange-900/20 dark:to-orange-800/20 rounded-xl p-4 border border-orange-200/50 dark:border-orange-800/50 cursor-pointer hover:shadow-lg transition-shadow duration-200\" @click=\"window.open(sentryData?.sentry_url || alertSentryUrl(alertDetails?.alert), '_blank', 'noopener')\"><div class=\"flex items-center\"><div class=\"flex-shrink-0\"><svg class=\"h-6 w-6 text-orange-600 dark:text-orange-400\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M12 8v4m0 4h.01M21 12a9 9 0 11-18 0 9 9 0 0118 0z\"></path></svg></div><div class=\"ml-3 w-0 flex-1\"><dl><dt class=\"text-xs font-medium text-orange-700 dark:text-orange-300 truncate\">Issues</dt><dd class=\"text-lg font-semibold text-orange-900 dark:text-orange-100\" x-text=\"sentryData.issues?.length || 0\"></dd></dl></div></div></div><!-- Apdex Score --><div class=\"bg-gradient-to-br from-purple-50 to-purple-100 dark:from-purple-900/20 dark:to-purple-800/20 rounded-xl p-4 border border-purple-200/50 dark:border-purple-800/50 cursor-pointer hover:shadow-lg transition-shadow duration-200\" @click=\"window.open(sentryData?.sentry_url || alertSentryUrl(alertDetails?.alert), '_blank', 'noopener')\"><div class=\"flex items-center\"><div class=\"flex-shrink-0\"><svg class=\"h-6 w-6 text-purple-600 dark:text-purple-400\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M13 10V3L4 14h7v7l9-11h-7z\"></path></svg></div><div class=\"ml-3 w-0 flex-1\"><dl><dt class=\"text-xs font-medium text-purple-700 dark:text-purple-300 truncate\">Apdex Score</dt><dd class=\"text-lg font-semibold text-purple-900 dark:text-purple-100\" x-text=\"sentryData?.project_stats?.has_performance_data ? sentryData.project_stats.apdex_score.toFixed(2) : 'N/A'\"></dd></dl></div></div></div></div><!-- Session Tracking Info --><div x-show=\"sentryData?.project_stats?.available_data && !sentryData.project_stats.has_session_data\" class=\"mb-6\"><div class=\"bg-blue-50 dark:bg-blue-900/20 border border-blue-200 dark:border-blue-800 rounded-lg p-4\"><div class=\"flex items-start\"><div class=\"flex-shrink-0\"><svg class=\"h-5 w-5 text-blue-600 dark:text-blue-400 mt-0.5\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M13 16h-1v-4h-1m1-4h.01M21 12a9 9 0 11-18 0 9 9 0 0118 0z\"></path></svg></div><div class=\"ml-3\"><h3 class=\"text-sm font-medium text-blue-800 dark:text-blue-200\">Session Tracking Not Available</h3><div class=\"mt-1 text-sm text-blue-700 dark:text-blue-300\"><p>Crash-free session metrics require session tracking to be enabled in your Sentry SDK. Without session tracking, these metrics will show \"No session data\".</p><p class=\"mt-1\"><a href=\"https://docs.sentry.io/platforms/javascript/configuration/releases/#release-health\" target=\"_blank\" class=\"font-medium underline hover:no-underline\">Learn how to enable session tracking →</a></p></div></div></div></div></div><!-- Data Status Indicator --><div x-show=\"!sentryData?.project_stats?.available_data && sentryData?.project_stats\" class=\"mb-6\"><div class=\"bg-yellow-50 dark:bg-yellow-900/20 border border-yellow-200 dark:border-yellow-800 rounded-lg p-3\"><div class=\"flex\"><div class=\"flex-shrink-0\"><svg class=\"h-5 w-5 text-yellow-400\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M12 9v2m0 4h.01M21 12a9 9 0 11-18 0 9 9 0 0118 0z\"></path></svg></div><div class=\"ml-3\"><p class=\"text-sm text-yellow-700 dark:text-yellow-300\">Event statistics are not available. This may be due to API limitations or the time range selected.</p></div></div></div></div><!-- Issues List --><div x-show=\"sentryData.issues && sentryData.issues.length > 0\"><h4 class=\"text-lg font-semibold text-gray-900 dark:text-white mb-4 flex items-center\"><svg class=\"w-5 h-5 mr-2 text-red-600 dark:text-red-400\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M12 8v4m0 4h.01M21 12a9 9 0 11-18 0 9 9 0 0118 0z\"></path></svg> Recent Issues</h4><div class=\"max-h-96 overflow-y-auto space-y-4 pr-2\"><template x-for=\"issue in sentryData.issues\" x-key=\"issue.id\"><div class=\"bg-white dark:bg-dark-bg-tertiary rounded-xl p-6 shadow-sm border border-red-200/50 dark:border-red-800/50 hover:shadow-md transition-all duration-200\"><div class=\"flex items-start justify-between\"><div class=\"flex-1 min-w-0\"><div class=\"flex items-start justify-between mb-2\"><div class=\"flex items-center space-x-2\"><!-- Level badge --><span class=\"inline-flex items-center px-2.5 py-0.5 rounded-full text-xs font-medium\" :class=\"issue.level === 'error' ? 'bg-red-100 text-red-800 dark:bg-red-900/50 dark:text-red-200' : 'bg-yellow-100 text-yellow-800 dark:bg-yellow-900/50 dark:text-yellow-200'\" x-text=\"issue.level\"></span><!-- Environment badge --><span x-show=\"issue.environment\" class=\"inline-flex items-center px-2 py-0.5 rounded-full text-xs font-medium bg-blue-100 text-blue-800 dark:bg-blue-900/50 dark:text-blue-200\" x-text=\"issue.environment\"></span><!-- Platform badge --><span x-show=\"issue.platform\" class=\"inline-flex items-center px-2 py-0.5 rounded-full text-xs font-medium bg-gray-100 text-gray-800 dark:bg-gray-800 dark:text-gray-200\" x-text=\"issue.platform\"></span><!-- Short ID --><span class=\"text-sm text-gray-500 dark:text-gray-400\" x-text=\"issue.short_id\"></span></div><!-- Status and assignment info --><div class=\"flex items-center space-x-2 text-right\"><span x-show=\"issue.assigned_to\" class=\"text-xs text-blue-600 dark:text-blue-400\" x-text=\"'Assigned: ' + issue.assigned_to.name\"></span> <span class=\"inline-flex items-center px-2 py-0.5 rounded-full text-xs font-medium\" :class=\"issue.status === 'resolved' ? 'bg-green-100 text-green-800 dark:bg-green-900/50 dark:text-green-200' : \n\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t issue.status === 'ignored' ? 'bg-gray-100 text-gray-800 dark:bg-gray-800 dark:text-gray-200' : \n\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t 'bg-orange-100 text-orange-800 dark:bg-orange-900/50 dark:text-orange-200'\" x-text=\"issue.status\"></span></div></div><h5 class=\"text-base font-medium text-gray-900 dark:text-white truncate mb-2\" x-text=\"issue.title\"></h5><div class=\"flex items-center space-x-4 text-sm text-gray-500 dark:text-gray-400\"><span x-text=\"issue.event_count + ' events'\"></span> <span x-text=\"issue.user_count + ' users'\"></span> <span x-text=\"'Last seen: ' + new Date(issue.last_seen).toLocaleString()\"></span></div><!-- Culprit/location info --><div x-show=\"issue.culprit\" class=\"mt-2\"><p class=\"text-sm text-gray-600 dark:text-gray-400 font-mono text-xs truncate\" x-text=\"issue.culprit\"></p></div></div><div class=\"flex-shrink-0 ml-4\"><a :href=\"issue.url\" target=\"_blank\" class=\"inline-flex items-center px-3 py-1 border border-transparent text-sm leading-4 font-medium rounded-md text-blue-700 bg-blue-100 hover:bg-blue-200 dark:bg-blue-900/50 dark:text-blue-200 dark:hover:bg-blue-800/50 transition-colors\">View in Sentry <svg class=\"ml-1 w-4 h-4\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M10 6H6a2 2 0 00-2 2v10a2 2 0 002 2h10a2 2 0 002-2v-4M14 4h6m0 0v6m0-6L10 14\"></path></svg></a></div></div></div></template></div></div><div x-show=\"!sentryData.issues || sentryData.issues.length === 0\" class=\"text-center py-8 text-gray-500 dark:text-gray-400\"><svg class=\"mx-auto h-12 w-12 text-gray-400\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M9 12l2 2 4-4m6 2a9 9 0 11-18 0 9 9 0 0118 0z\"></path></svg><h3 class=\"mt-2 text-sm font-medium text-gray-900 dark:text-white\">No issues found</h3><p class=\"mt-1 text-sm text-gray-500\">No issues were found in the selected time range.</p></div></div></div></div><!-- Activity Tab --><div x-show=\"currentAlertTab === 'activity'\" x-transition:enter=\"transition-opacity ease-out duration-200\" x-transition:enter-start=\"opacity-0\" x-transition:enter-end=\"opacity-100\" class=\"space-y-4\"><h3 class=\"text-lg font-semibold text-gray-900 dark:text-white\">Activity</h3><div x-show=\"alertActivityLoading && alertActivity.length === 0\" class=\"flex justify-center items-center py-12\"><div class=\"inline-block animate-spin rounded-full h-12 w-12 border-4 border-gray-300 border-t-blue-600\"></div></div><div x-show=\"!alertActivityLoading && alertActivity.length === 0\" class=\"text-center py-12 text-gray-500 dark:text-gray-400\">No activity recorded for this alert yet.</div><ul class=\"space-y-3\"><template x-for=\"activity in alertActivity\" :key=\"activity.id\"><li class=\"bg-white dark:bg-dark-bg-tertiary rounded-lg p-4 shadow-sm border border-gray-200 dark:border-dark-border-subtle\"><div class=\"flex justify-between items-start\"><span class=\"text-sm font-medium text-gray-900 dark:text-white\" x-text=\"describeActivity(activity)\"></span> <span class=\"text-xs text-gray-500 dark:text-gray-400 ml-4 whitespace-nowrap\" x-text=\"formatDateTime(activity.createdAt)\"></span></div><p x-show=\"activity.content\" class=\"mt-2 text-sm text-gray-700 dark:text-gray-300 whitespace-pre-wrap\" x-text=\"activity.content\"></p></li></template></ul><div x-show=\"alertActivityCursor\" class=\"flex justify-center\"><button @click=\"loadAlertActivity(false)\" :disabled=\"alertActivityLoading\" class=\"px-4 py-2 text-sm font-medium text-blue-600 dark:text-blue-400 hover:bg-blue-50 dark:hover:bg-blue-900/20 rounded-lg disabled:opacity-50\"><span x-text=\"alertActivityLoading ? 'Loading...' : 'Load more'\"></span></button></div></div><!-- History Tab --><div x-show=\"currentAlertTab === 'history'\" x-transition:enter=\"transition-opacity ease-out duration-200\" x-transition:enter-start=\"opacity-0\" x-transition:enter-end=\"opacity-100\"><!-- Loading State --><div x-show=\"historyLoading\" class=\"flex justify-center items-center py-12\"><div class=\"inline-block animate-spin rounded-full h-12 w-12 border-4 border-gray-300 border-t-blue-600\"></div></div><!-- History Timeline --><div x-show=\"!historyLoading && alertHistory?.history\" class=\"space-y-6\"><h3 class=\"text-lg font-semibold text-gray-900 dark:text-white\">Alert Occurrence Timeline</h3><!-- Timeline --><div class=\"relative max-h-96 overflow-y-auto pr-2\"><div class=\"absolute left-8 top-0 bottom-0 w-0.5 bg-gray-200 dark:bg-gray-700\"></div><template x-for=\"(event, index) in alertHistory?.history\" :key=\"event.id\"><div class=\"relative flex items-start mb-6 pl-16\"><!-- Timeline Dot --><div class=\"absolute left-6 w-4 h-4 rounded-full\" :class=\"event.resolved_at ? 'bg-green-500' : 'bg-yellow-500'\"></div><!-- Event Card --><div class=\"flex-1 bg-white dark:bg-dark-bg-tertiary rounded-lg p-4 shadow-sm border border-gray-200 dark:border-dark-border-subtle\"><div class=\"flex justify-between items-start mb-2\"><div><span class=\"text-xs font-medium text-gray-500 dark:text-gray-400\">Occurrence #<span x-text=\"alertHistory.total_occurrences - index\"></span></span><div class=\"text-sm text-gray-900 dark:text-white mt-1\"><strong>Fired:</strong> <span x-text=\"formatDateTime(event.fired_at)\"></span></div><div x-show=\"event.resolved_at\" class=\"text-sm text-green-600 dark:text-green-400 mt-1\"><strong>Resolved:</strong> <span x-text=\"formatDateTime(event.resolved_at)\"></span></div></div><div class=\"text-right\" x-show=\"event.duration_seconds\"><span class=\"text-xs text-gray-500 dark:text-gray-400\">Duration:</span><div class=\"text-sm font-semibold text-gray-900 dark:text-white\" x-text=\"formatDuration(event.duration_seconds)\"></div></div></div><!-- Acknowledgment Info --><div x-show=\"event.acknowledged_at\" class=\"mt-2 flex items-center text-xs text-blue-600 dark:text-blue-400\"><svg class=\"w-3 h-3 mr-1\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M5 13l4 4L19 7\"></path></svg> Acknowledged: <span x-text=\"formatDateTime(event.acknowledged_at)\" class=\"ml-1\"></span></div></div></div></template></div><!-- Empty State --><div x-show=\"!alertHistory?.history || alertHistory.history.length === 0\" class=\"text-center py-12 text-gray-500 dark:text-gray-400\">No history data available for this alert.</div></div></div></div></div></div></div><!-- End alertDetails content wrapper --></div></div></div></div>")
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
@@ -257,12 +431,12 @@ func SilenceModal() templ.Component {
 			}()
 		}
 		ctx = templ.InitializeContext(ctx)
-		templ_7745c5c3_Var5 := templ.GetChildren(ctx)
-		if templ_7745c5c3_Var5 == nil {
-			templ_7745c5c3_Var5 = templ.NopComponent
+		templ_7745c5c3_Var11 := templ.GetChildren(ctx)
+		if templ_7745c5c3_Var11 == nil {
+			templ_7745c5c3_Var11 = templ.NopComponent
 		}
 		ctx = templ.ClearChildren(ctx)
-		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 16, "<!-- Silence Dialog --><div x-show=\"showSilenceModal\" x-transition:enter=\"ease-out duration-300\" x-transition:enter-start=\"opacity-0\" x-transition:enter-end=\"opacity-100\" x-transition:leave=\"ease-in duration-200\" x-transition:leave-start=\"opacity-100\" x-transition:leave-end=\"opacity-0\" class=\"fixed inset-0 z-60 overflow-y-auto\" @click.away=\"showSilenceModal = false\" style=\"display: none;\"><div class=\"flex items-end justify-center min-h-screen pt-4 px-4 pb-20 text-center sm:block sm:p-0\"><!-- Backdrop --><div class=\"fixed inset-0 bg-gray-500/75 dark:bg-black/60 backdrop-blur-sm transition-opacity\" @click=\"showSilenceModal = false\"></div><span class=\"hidden sm:inline-block sm:align-middle sm:h-screen\">&#8203;</span><div class=\"relative inline-block align-bottom bg-white dark:bg-dark-bg-secondary rounded-xl text-left overflow-hidden shadow-2xl transform transition-all sm:my-8 sm:align-middle sm:max-w-lg sm:w-full z-10 border border-gray-200/50 dark:border-dark-border-subtle/50\" @click.stop x-transition:enter=\"ease-out duration-300\" x-transition:enter-start=\"opacity-0 translate-y-4 sm:translate-y-0 sm:scale-95\" x-transition:enter-end=\"opacity-100 translate-y-0 sm:scale-100\" x-transition:leave=\"ease-in duration-200\" x-transition:leave-start=\"opacity-100 translate-y-0 sm:scale-100\" x-transition:leave-end=\"opacity-0 translate-y-4 sm:translate-y-0 sm:scale-95\"><div class=\"bg-white dark:bg-dark-bg-secondary px-6 pt-6 pb-4\"><div class=\"sm:flex sm:items-start\"><div class=\"mx-auto flex-shrink-0 flex items-center justify-center h-12 w-12 rounded-full bg-purple-100 dark:bg-purple-900/50 sm:mx-0 sm:h-10 sm:w-10 shadow-lg shadow-purple-500/25\"><svg class=\"h-6 w-6 text-purple-600 dark:text-purple-400\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M5.586 15H4a1 1 0 01-1-1v-4a1 1 0 011-1h1.586l4.707-4.707C10.923 3.663 12 4.109 12 5v14c0 .891-1.077 1.337-1.707.707L5.586 15z\"></path> <path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M17 14l2-2m0 0l2-2m-2 2l-2-2m2 2l2 2\"></path></svg></div><div class=\"mt-3 text-center sm:mt-0 sm:ml-4 sm:text-left w-full\"><h3 class=\"text-lg font-semibold text-gray-900 dark:text-white\">Silence Alert</h3><div class=\"mt-2\"><p class=\"text-sm text-gray-500 dark:text-gray-400 mb-4\"><span x-show=\"silenceAction === 'single'\">Silence this alert to temporarily suppress notifications:</span> <span x-show=\"silenceAction === 'bulk'\">Silence <strong x-text=\"selectedAlerts.length + selectedGroups.length\"></strong> alert(s)/group(s):</span> <span x-show=\"silenceAction === 'group'\">Silence the group \"<strong x-text=\"currentGroupName\"></strong>\":</span></p><!-- Alert/Group Information --><div x-show=\"silenceAction === 'single' && currentSilenceAlert\" class=\"mb-4 p-3 bg-gray-50 dark:bg-dark-bg-tertiary rounded-md\"><div class=\"flex items-center space-x-2 text-sm\"><span class=\"font-medium text-gray-900 dark:text-white\">Alert:</span> <span class=\"text-gray-600 dark:text-gray-300\" x-text=\"currentSilenceAlert?.alertName\"></span></div><div class=\"flex items-center space-x-2 text-sm mt-1\"><span class=\"font-medium text-gray-900 dark:text-white\">Instance:</span> <span class=\"text-gray-600 dark:text-gray-300\" x-text=\"currentSilenceAlert?.instance\"></span></div></div><!-- Duration Selection --><div class=\"mb-4\"><label class=\"block text-sm font-medium text-gray-700 dark:text-gray-300 mb-2\">Silence Duration <span class=\"text-red-500\">*</span></label><!-- Duration Type Selection --><div class=\"mb-3\"><div class=\"flex items-center space-x-4\"><label for=\"silence-duration-preset\" class=\"flex items-center\"><input type=\"radio\" id=\"silence-duration-preset\" name=\"silence-duration-type\" x-model=\"silenceDurationType\" value=\"preset\" class=\"h-4 w-4 text-purple-600 focus:ring-purple-500 border-gray-300 dark:border-dark-border-DEFAULT\"> <span class=\"ml-2 text-sm text-gray-700 dark:text-gray-300\">Preset</span></label> <label for=\"silence-duration-custom\" class=\"flex items-center\"><input type=\"radio\" id=\"silence-duration-custom\" name=\"silence-duration-type\" x-model=\"silenceDurationType\" value=\"custom\" class=\"h-4 w-4 text-purple-600 focus:ring-purple-500 border-gray-300 dark:border-dark-border-DEFAULT\"> <span class=\"ml-2 text-sm text-gray-700 dark:text-gray-300\">Custom</span></label></div></div><!-- Preset Duration Dropdown --><div x-show=\"silenceDurationType === 'preset'\"><select id=\"silence-duration-select\" name=\"silence-duration-select\" x-model=\"silenceDuration\" class=\"w-full px-3 py-2 border border-gray-300 dark:border-dark-border-DEFAULT rounded-md shadow-sm focus:outline-none focus:ring-purple-500 focus:border-purple-500 dark:bg-dark-bg-tertiary dark:text-white\"><option value=\"1h\">1 hour</option> <option value=\"2h\">2 hours</option> <option value=\"4h\">4 hours</option> <option value=\"8h\">8 hours</option> <option value=\"12h\">12 hours</option> <option value=\"24h\">24 hours</option> <option value=\"2d\">2 days</option> <option value=\"7d\">7 days</option></select></div><!-- Custom Duration Input --><div x-show=\"silenceDurationType === 'custom'\"><input type=\"text\" id=\"silence-custom-duration\" name=\"silence-custom-duration\" x-model=\"customSilenceDuration\" placeholder=\"e.g., 1h30m, 2d, 1y, 30d12h\" class=\"w-full px-3 py-2 border border-gray-300 dark:border-dark-border-DEFAULT rounded-md shadow-sm focus:outline-none focus:ring-purple-500 focus:border-purple-500 dark:bg-dark-bg-tertiary dark:text-white\" @input=\"validateCustomDuration()\"><div class=\"mt-1 text-xs text-gray-500 dark:text-gray-400\">Supported formats: 1h30m, 2d, 1y, 30d12h (ns, µs, ms, s, m, h, d, y combinations)</div><div x-show=\"customDurationError\" class=\"mt-1 text-xs text-red-600 dark:text-red-400\" x-text=\"customDurationError\"></div></div></div><!-- Reason Input --><div class=\"mb-4\"><label for=\"silence-reason\" class=\"block text-sm font-medium text-gray-700 dark:text-gray-300 mb-2\">Silence Reason <span class=\"text-red-500\">*</span></label> <textarea id=\"silence-reason\" x-model=\"silenceReason\" rows=\"3\" placeholder=\"Describe why you are silencing this alert...\" class=\"w-full px-3 py-2 border border-gray-300 dark:border-dark-border-DEFAULT rounded-md shadow-sm focus:outline-none focus:ring-blue-500 focus:border-blue-500 dark:bg-dark-bg-tertiary dark:text-white resize-none\" @keydown.enter.meta.prevent=\"submitSilence()\" @keydown.enter.ctrl.prevent=\"submitSilence()\"></textarea><div class=\"mt-1 text-xs text-gray-500 dark:text-gray-400\">Press Ctrl+Enter or Cmd+Enter to submit</div></div><!-- Quick Reason Templates --><div class=\"mb-4\"><label class=\"block text-sm font-medium text-gray-700 dark:text-gray-300 mb-2\">Quick Templates:</label><div class=\"flex flex-wrap gap-2\"><button @click=\"silenceReason = 'Scheduled maintenance'\" class=\"px-3 py-1 text-xs bg-blue-100 dark:bg-blue-800 text-blue-800 dark:text-blue-200 rounded-full hover:bg-blue-200 dark:hover:bg-blue-700\">Maintenance</button> <button @click=\"silenceReason = 'Known issue being resolved'\" class=\"px-3 py-1 text-xs bg-green-100 dark:bg-green-800 text-green-800 dark:text-green-200 rounded-full hover:bg-green-200 dark:hover:bg-green-700\">Known issue</button> <button @click=\"silenceReason = 'Temporary expected behavior'\" class=\"px-3 py-1 text-xs bg-yellow-100 dark:bg-yellow-800 text-yellow-800 dark:text-yellow-200 rounded-full hover:bg-yellow-200 dark:hover:bg-yellow-700\">Expected</button> <button @click=\"silenceReason = 'Under investigation'\" class=\"px-3 py-1 text-xs bg-gray-100 dark:bg-dark-bg-secondary text-gray-800 dark:text-gray-200 rounded-full hover:bg-gray-200 dark:hover:bg-dark-bg-tertiary\">Investigating</button></div></div><!-- Info about what will be silenced --><div class=\"p-3 bg-blue-50 dark:bg-blue-900/50 border border-blue-200 dark:border-blue-800 rounded-md\"><div class=\"flex\"><svg class=\"w-5 h-5 text-blue-400 flex-shrink-0\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M13 16h-1v-4h-1m1-4h.01M21 12a9 9 0 11-18 0 9 9 0 0118 0z\"></path></svg><div class=\"ml-3\"><p class=\"text-sm text-blue-800 dark:text-blue-200\">Silencing will suppress notifications for this alert but it will still be visible in the dashboard.</p></div></div></div><!-- Validation Error --><div x-show=\"silenceError\" class=\"mt-4 p-3 bg-red-50 dark:bg-red-900/50 border border-red-200 dark:border-red-800 rounded-md\"><div class=\"flex\"><svg class=\"w-5 h-5 text-red-400\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M12 9v2m0 4h.01m-6.938 4h13.856c1.54 0 2.502-1.667 1.732-2.5L13.732 4c-.77-.833-1.964-.833-2.732 0L4.082 16.5c-.77.833.192 2.5 1.732 2.5z\"></path></svg><div class=\"ml-3\"><p class=\"text-sm text-red-800 dark:text-red-200\" x-text=\"silenceError\"></p></div></div></div></div></div></div></div><div class=\"bg-gray-50 dark:bg-dark-bg-tertiary px-6 py-4 border-t border-gray-200 dark:border-dark-border-subtle sm:flex sm:flex-row-reverse sm:gap-3\"><button type=\"button\" @click=\"submitSilence()\" :disabled=\"!silenceReason.trim() || silenceSubmitting\" class=\"w-full inline-flex justify-center items-center rounded-lg border border-transparent shadow-sm px-4 py-2 text-sm font-medium text-white sm:w-auto transition-all duration-200 focus:outline-none focus:ring-2 focus:ring-offset-2 dark:focus:ring-offset-dark-bg-secondary\" :class=\"{\n\t\t\t\t\t\t\t\t'bg-purple-600 hover:bg-purple-700 focus:ring-purple-500': silenceReason.trim() && !silenceSubmitting,\n\t\t\t\t\t\t\t\t'bg-gray-400 cursor-not-allowed': !silenceReason.trim() || silenceSubmitting\n\t\t\t\t\t\t\t}\"><svg x-show=\"silenceSubmitting\" class=\"animate-spin -ml-1 mr-2 h-4 w-4 text-white\" fill=\"none\" viewBox=\"0 0 24 24\"><circle class=\"opacity-25\" cx=\"12\" cy=\"12\" r=\"10\" stroke=\"currentColor\" stroke-width=\"4\"></circle> <path class=\"opacity-75\" fill=\"currentColor\" d=\"M4 12a8 8 0 018-8V0C5.373 0 0 5.373 0 12h4zm2 5.291A7.962 7.962 0 014 12H0c0 3.042 1.135 5.824 3 7.938l3-2.647z\"></path></svg> <span x-show=\"!silenceSubmitting\">Silence</span> <span x-show=\"silenceSubmitting\">Processing...</span></button> <button type=\"button\" @click=\"cancelSilence()\" :disabled=\"silenceSubmitting\" class=\"mt-3 w-full inline-flex justify-center rounded-lg border border-gray-300 dark:border-dark-border-DEFAULT shadow-sm px-4 py-2 bg-white dark:bg-dark-bg-tertiary text-sm font-medium text-gray-700 dark:text-gray-300 hover:bg-gray-100 dark:hover:bg-dark-bg-secondary focus:outline-none focus:ring-2 focus:ring-offset-2 focus:ring-gray-500 sm:mt-0 sm:w-auto transition-colors\" :class=\"{ 'opacity-50 cursor-not-allowed': silenceSubmitting }\">Cancel</button></div></div></div></div>")
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 22, "<!-- Silence Dialog --><div x-show=\"showSilenceModal\" x-transition:enter=\"ease-out duration-300\" x-transition:enter-start=\"opacity-0\" x-transition:enter-end=\"opacity-100\" x-transition:leave=\"ease-in duration-200\" x-transition:leave-start=\"opacity-100\" x-transition:leave-end=\"opacity-0\" class=\"fixed inset-0 z-60 overflow-y-auto\" @click.away=\"showSilenceModal = false\" style=\"display: none;\"><div class=\"flex items-end justify-center min-h-screen pt-4 px-4 pb-20 text-center sm:block sm:p-0\"><!-- Backdrop --><div class=\"fixed inset-0 bg-gray-500/75 dark:bg-black/60 backdrop-blur-sm transition-opacity\" @click=\"showSilenceModal = false\"></div><span class=\"hidden sm:inline-block sm:align-middle sm:h-screen\">&#8203;</span><div class=\"relative inline-block align-bottom bg-white dark:bg-dark-bg-secondary rounded-xl text-left overflow-hidden shadow-2xl transform transition-all sm:my-8 sm:align-middle sm:max-w-lg sm:w-full z-10 border border-gray-200/50 dark:border-dark-border-subtle/50\" @click.stop x-transition:enter=\"ease-out duration-300\" x-transition:enter-start=\"opacity-0 translate-y-4 sm:translate-y-0 sm:scale-95\" x-transition:enter-end=\"opacity-100 translate-y-0 sm:scale-100\" x-transition:leave=\"ease-in duration-200\" x-transition:leave-start=\"opacity-100 translate-y-0 sm:scale-100\" x-transition:leave-end=\"opacity-0 translate-y-4 sm:translate-y-0 sm:scale-95\"><div class=\"bg-white dark:bg-dark-bg-secondary px-6 pt-6 pb-4\"><div class=\"sm:flex sm:items-start\"><div class=\"mx-auto flex-shrink-0 flex items-center justify-center h-12 w-12 rounded-full bg-purple-100 dark:bg-purple-900/50 sm:mx-0 sm:h-10 sm:w-10 shadow-lg shadow-purple-500/25\"><svg class=\"h-6 w-6 text-purple-600 dark:text-purple-400\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M5.586 15H4a1 1 0 01-1-1v-4a1 1 0 011-1h1.586l4.707-4.707C10.923 3.663 12 4.109 12 5v14c0 .891-1.077 1.337-1.707.707L5.586 15z\"></path> <path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M17 14l2-2m0 0l2-2m-2 2l-2-2m2 2l2 2\"></path></svg></div><div class=\"mt-3 text-center sm:mt-0 sm:ml-4 sm:text-left w-full\"><h3 class=\"text-lg font-semibold text-gray-900 dark:text-white\" x-text=\"silenceMode === 'edit' ? 'Edit Silence' : 'Silence Alert'\"></h3><div class=\"mt-2\"><p class=\"text-sm text-gray-500 dark:text-gray-400 mb-4\"><span x-show=\"silenceMode === 'edit'\">Update the matchers, end time or reason of this silence:</span> <span x-show=\"silenceMode !== 'edit' && silenceAction === 'single'\">Silence this alert to temporarily suppress notifications:</span> <span x-show=\"silenceMode !== 'edit' && silenceAction === 'bulk'\">Silence <strong x-text=\"selectedAlerts.length + selectedGroups.length\"></strong> alert(s)/group(s):</span> <span x-show=\"silenceMode !== 'edit' && silenceAction === 'standalone'\">Create a silence from matchers, not tied to any alert:</span> <span x-show=\"silenceMode !== 'edit' && silenceAction === 'group'\">Silence the group \"<strong x-text=\"currentGroupName\"></strong>\":</span></p><!-- Alert/Group Information --><div x-show=\"silenceMode !== 'edit' && silenceAction === 'single' && currentSilenceAlert\" class=\"mb-4 p-3 bg-gray-50 dark:bg-dark-bg-tertiary rounded-md\"><div class=\"flex items-center space-x-2 text-sm\"><span class=\"font-medium text-gray-900 dark:text-white\">Alert:</span> <span class=\"text-gray-600 dark:text-gray-300\" x-text=\"currentSilenceAlert?.alertName\"></span></div><div class=\"flex items-center space-x-2 text-sm mt-1\"><span class=\"font-medium text-gray-900 dark:text-white\">Instance:</span> <span class=\"text-gray-600 dark:text-gray-300\" x-text=\"currentSilenceAlert?.instance\"></span></div></div><!-- Label matchers (single alert, create mode) --><div x-show=\"silenceMode !== 'edit' && silenceAction === 'single' && silenceLabelChoices.length > 0\" class=\"mb-4\"><span class=\"block text-sm font-medium text-gray-700 dark:text-gray-300 mb-2\">Match on labels</span><div class=\"space-y-1 max-h-48 overflow-y-auto p-2 rounded-md border border-gray-200 dark:border-dark-border-subtle\"><template x-for=\"label in silenceLabelChoices\" :key=\"label.name\"><label class=\"flex items-center space-x-2 text-xs font-mono cursor-pointer\"><input type=\"checkbox\" x-model=\"label.checked\" class=\"h-4 w-4 text-purple-600 focus:ring-purple-500 border-gray-300 dark:border-dark-border-DEFAULT rounded\"> <span class=\"text-gray-900 dark:text-white\" x-text=\"label.name\"></span> <span class=\"text-gray-400\">=</span> <span class=\"text-gray-600 dark:text-gray-300 break-all\" x-text=\"label.value\"></span></label></template></div></div><!-- Custom matchers (create mode) --><div x-show=\"silenceMode !== 'edit'\" class=\"mb-4\"><label for=\"silence-custom-matchers\" class=\"block text-sm font-medium text-gray-700 dark:text-gray-300 mb-2\">Custom matchers <span x-show=\"silenceAction === 'standalone'\" class=\"text-red-500\">*</span></label> <textarea id=\"silence-custom-matchers\" name=\"silence-custom-matchers\" rows=\"2\" x-model=\"silenceCustomMatchers\" placeholder=\"instance=~web-.*\" class=\"w-full px-3 py-2 text-sm font-mono border border-gray-300 dark:border-dark-border-DEFAULT rounded-md shadow-sm focus:outline-none focus:ring-purple-500 focus:border-purple-500 dark:bg-dark-bg-tertiary dark:text-white\"></textarea><p class=\"mt-1 text-xs text-gray-500 dark:text-gray-400\">One per line: <code>=</code>, <code>!=</code>, <code>=~</code> (regex) or <code>!~</code>. Replaces the label matcher of the same name.</p></div><!-- Suggested matchers (create mode) --><div x-show=\"silenceMode !== 'edit' && silenceAction !== 'standalone'\" class=\"mb-4\"><div class=\"flex items-center justify-between\"><span class=\"text-sm font-medium text-gray-700 dark:text-gray-300\">Suggested matchers</span> <button @click=\"loadSilenceSuggestions()\" :disabled=\"silenceSuggestionsLoading\" class=\"text-xs font-medium text-purple-600 hover:text-purple-800 dark:text-purple-400 dark:hover:text-purple-300 disabled:opacity-50\" x-text=\"silenceSuggestionsLoading ? 'Finding shared labels...' : (silenceSuggestions === null ? 'Suggest from shared labels' : 'Refresh')\"></button></div><div x-show=\"silenceSuggestions !== null && silenceSuggestions.length === 0\" class=\"mt-2 text-xs text-gray-500 dark:text-gray-400\">These alerts do not share any label value.</div><div x-show=\"silenceSuggestions && silenceSuggestions.length > 0\" class=\"mt-2 space-y-2 max-h-56 overflow-y-auto\"><template x-for=\"(suggestion, index) in silenceSuggestions || []\" :key=\"index\"><div class=\"flex items-start justify-between gap-3 p-2 rounded-md border border-gray-200 dark:border-dark-border-subtle bg-gray-50 dark:bg-dark-bg-tertiary\"><div class=\"min-w-0\"><div class=\"text-xs font-mono text-gray-900 dark:text-white break-all\" x-text=\"formatSilenceMatchers(suggestion.matchers)\"></div><div class=\"mt-1 text-xs text-gray-500 dark:text-gray-400\"><span x-text=\"'Mutes ' + suggestion.matchCount + ' active alert(s)'\"></span> <span x-show=\"suggestion.outsideCount > 0\" class=\"text-amber-600 dark:text-amber-400\" x-text=\"'— ' + suggestion.outsideCount + ' outside this selection'\"></span></div></div><button @click=\"createSilenceFromSuggestion(suggestion)\" :disabled=\"!silenceReason.trim() || silenceSubmitting\" :title=\"silenceReason.trim() ? 'Create this silence with the duration and reason below' : 'Enter a silence reason first'\" class=\"flex-shrink-0 px-2 py-1 text-xs font-medium rounded-md text-white bg-purple-600 hover:bg-purple-700 disabled:bg-gray-400 disabled:cursor-not-allowed\">Silence</button></div></template></div></div><!-- Matchers (edit mode) --><div x-show=\"silenceMode === 'edit'\" class=\"mb-4\"><label class=\"block text-sm font-medium text-gray-700 dark:text-gray-300 mb-2\">Matchers <span class=\"text-red-500\">*</span></label><div class=\"space-y-2\"><template x-for=\"(matcher, index) in silenceMatchers\" :key=\"index\"><div class=\"flex items-center space-x-2\"><input type=\"text\" x-model=\"matcher.name\" placeholder=\"label\" class=\"w-1/3 px-2 py-1.5 text-sm border border-gray-300 dark:border-dark-border-DEFAULT rounded-md focus:outline-none focus:ring-purple-500 focus:border-purple-500 dark:bg-dark-bg-tertiary dark:text-white\"> <select x-model=\"matcher.operator\" class=\"px-2 py-1.5 text-sm border border-gray-300 dark:border-dark-border-DEFAULT rounded-md focus:outline-none focus:ring-purple-500 focus:border-purple-500 dark:bg-dark-bg-tertiary dark:text-white\"><option value=\"=\">=</option> <option value=\"!=\">!=</option> <option value=\"=~\">=~</option> <option value=\"!~\">!~</option></select> <input type=\"text\" x-model=\"matcher.value\" placeholder=\"value\" class=\"flex-1 px-2 py-1.5 text-sm border border-gray-300 dark:border-dark-border-DEFAULT rounded-md focus:outline-none focus:ring-purple-500 focus:border-purple-500 dark:bg-dark-bg-tertiary dark:text-white\"> <button @click=\"silenceMatchers.splice(index, 1)\" :disabled=\"silenceMatchers.length <= 1\" class=\"p-1 text-gray-400 hover:text-red-500 disabled:opacity-30\" title=\"Remove matcher\"><svg class=\"w-4 h-4\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M6 18L18 6M6 6l12 12\"></path></svg></button></div></template></div><button @click=\"silenceMatchers.push({ name: '', value: '', operator: '=' })\" class=\"mt-2 text-xs font-medium text-purple-600 hover:text-purple-800 dark:text-purple-400 dark:hover:text-purple-300\">+ Add matcher</button></div><!-- End Time (edit mode) --><div x-show=\"silenceMode === 'edit'\" class=\"mb-4\"><label for=\"silence-ends-at\" class=\"block text-sm font-medium text-gray-700 dark:text-gray-300 mb-2\">Ends At <span class=\"text-red-500\">*</span></label> <input type=\"datetime-local\" id=\"silence-ends-at\" name=\"silence-ends-at\" x-model=\"silenceEndsAt\" class=\"w-full px-3 py-2 border border-gray-300 dark:border-dark-border-DEFAULT rounded-md shadow-sm focus:outline-none focus:ring-purple-500 focus:border-purple-500 dark:bg-dark-bg-tertiary dark:text-white\"><div class=\"mt-2 flex flex-wrap gap-2\"><template x-for=\"extension in ['1h', '4h', '24h', '7d']\" :key=\"extension\"><button @click=\"extendSilenceEndsAt(extension)\" class=\"px-3 py-1 text-xs bg-purple-100 dark:bg-purple-900/50 text-purple-800 dark:text-purple-200 rounded-full hover:bg-purple-200 dark:hover:bg-purple-900/70\" x-text=\"'+' + extension\"></button></template></div></div><!-- Duration Selection --><div x-show=\"silenceMode !== 'edit'\" class=\"mb-4\"><label class=\"block text-sm font-medium text-gray-700 dark:text-gray-300 mb-2\">Silence Duration <span class=\"text-red-500\">*</span></label><!-- Duration Type Selection --><div class=\"mb-3\"><div class=\"flex items-center space-x-4\"><label for=\"silence-duration-preset\" class=\"flex items-center\"><input type=\"radio\" id=\"silence-duration-preset\" name=\"silence-duration-type\" x-model=\"silenceDurationType\" value=\"preset\" class=\"h-4 w-4 text-purple-600 focus:ring-purple-500 border-gray-300 dark:border-dark-border-DEFAULT\"> <span class=\"ml-2 text-sm text-gray-700 dark:text-gray-300\">Preset</span></label> <label for=\"silence-duration-custom\" class=\"flex items-center\"><input type=\"radio\" id=\"silence-duration-custom\" name=\"silence-duration-type\" x-model=\"silenceDurationType\" value=\"custom\" class=\"h-4 w-4 text-purple-600 focus:ring-purple-500 border-gray-300 dark:border-dark-border-DEFAULT\"> <span class=\"ml-2 text-sm text-gray-700 dark:text-gray-300\">Custom</span></label></div></div><!-- Preset Duration Dropdown --><div x-show=\"silenceDurationType === 'preset'\"><select id=\"silence-duration-select\" name=\"silence-duration-select\" x-model=\"silenceDuration\" class=\"w-full px-3 py-2 border border-gray-300 dark:border-dark-border-DEFAULT rounded-md shadow-sm focus:outline-none focus:ring-purple-500 focus:border-purple-500 dark:bg-dark-bg-tertiary dark:text-white\"><option value=\"1h\">1 hour</option> <option value=\"2h\">2 hours</option> <option value=\"4h\">4 hours</option> <option value=\"8h\">8 hours</option> <option value=\"12h\">12 hours</option> <option value=\"24h\">24 hours</option> <option value=\"2d\">2 days</option> <option value=\"7d\">7 days</option></select></div><!-- Custom Duration Input --><div x-show=\"silenceDurationType === 'custom'\"><input type=\"text\" id=\"silence-custom-duration\" name=\"silence-custom-duration\" x-model=\"customSilenceDuration\" placeholder=\"e.g., 1h30m, 2d, 1y, 30d12h\" class=\"w-full px-3 py-2 border border-gray-300 dark:border-dark-border-DEFAULT rounded-md shadow-sm focus:outline-none focus:ring-purple-500 focus:border-purple-500 dark:bg-dark-bg-tertiary dark:text-white\" @input=\"validateCustomDuration()\"><div class=\"mt-1 text-xs text-gray-500 dark:text-gray-400\">Supported formats: 1h30m, 2d, 1y, 30d12h (ns, µs, ms, s, m, h, d, y combinations)</div><div x-show=\"customDurationError\" class=\"mt-1 text-xs text-red-600 dark:text-red-400\" x-text=\"customDurationError\"></div></div></div><!-- Reason Input --><div class=\"mb-4\"><label for=\"silence-reason\" class=\"block text-sm font-medium text-gray-700 dark:text-gray-300 mb-2\">Silence Reason <span class=\"text-red-500\">*</span></label> <textarea id=\"silence-reason\" x-model=\"silenceReason\" rows=\"3\" placeholder=\"Describe why you are silencing this alert...\" class=\"w-full px-3 py-2 border border-gray-300 dark:border-dark-border-DEFAULT rounded-md shadow-sm focus:outline-none focus:ring-blue-500 focus:border-blue-500 dark:bg-dark-bg-tertiary dark:text-white resize-none\" @keydown.enter.meta.prevent=\"submitSilence()\" @keydown.enter.ctrl.prevent=\"submitSilence()\"></textarea><div class=\"mt-1 text-xs text-gray-500 dark:text-gray-400\">Press Ctrl+Enter or Cmd+Enter to submit</div></div><!-- Quick Reason Templates --><div class=\"mb-4\"><label class=\"block text-sm font-medium text-gray-700 dark:text-gray-300 mb-2\">Quick Templates:</label><div class=\"flex flex-wrap gap-2\"><button @click=\"silenceReason = 'Scheduled maintenance'\" class=\"px-3 py-1 text-xs bg-blue-100 dark:bg-blue-800 text-blue-800 dark:text-blue-200 rounded-full hover:bg-blue-200 dark:hover:bg-blue-700\">Maintenance</button> <button @click=\"silenceReason = 'Known issue being resolved'\" class=\"px-3 py-1 text-xs bg-green-100 dark:bg-green-800 text-green-800 dark:text-green-200 rounded-full hover:bg-green-200 dark:hover:bg-green-700\">Known issue</button> <button @click=\"silenceReason = 'Temporary expected behavior'\" class=\"px-3 py-1 text-xs bg-yellow-100 dark:bg-yellow-800 text-yellow-800 dark:text-yellow-200 rounded-full hover:bg-yellow-200 dark:hover:bg-yellow-700\">Expected</button> <button @click=\"silenceReason = 'Under investigation'\" class=\"px-3 py-1 text-xs bg-gray-100 dark:bg-dark-bg-secondary text-gray-800 dark:text-gray-200 rounded-full hover:bg-gray-200 dark:hover:bg-dark-bg-tertiary\">Investigating</button></div></div><!-- Info about what will be silenced --><div class=\"p-3 bg-blue-50 dark:bg-blue-900/50 border border-blue-200 dark:border-blue-800 rounded-md\"><div class=\"flex\"><svg class=\"w-5 h-5 text-blue-400 flex-shrink-0\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M13 16h-1v-4h-1m1-4h.01M21 12a9 9 0 11-18 0 9 9 0 0118 0z\"></path></svg><div class=\"ml-3\"><p class=\"text-sm text-blue-800 dark:text-blue-200\">Silencing will suppress notifications for this alert but it will still be visible in the dashboard.</p></div></div></div><!-- Validation Error --><div x-show=\"silenceError\" class=\"mt-4 p-3 bg-red-50 dark:bg-red-900/50 border border-red-200 dark:border-red-800 rounded-md\"><div class=\"flex\"><svg class=\"w-5 h-5 text-red-400\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M12 9v2m0 4h.01m-6.938 4h13.856c1.54 0 2.502-1.667 1.732-2.5L13.732 4c-.77-.833-1.964-.833-2.732 0L4.082 16.5c-.77.833.192 2.5 1.732 2.5z\"></path></svg><div class=\"ml-3\"><p class=\"text-sm text-red-800 dark:text-red-200\" x-text=\"silenceError\"></p></div></div></div></div></div></div></div><div class=\"bg-gray-50 dark:bg-dark-bg-tertiary px-6 py-4 border-t border-gray-200 dark:border-dark-border-subtle sm:flex sm:flex-row-reverse sm:gap-3\"><button type=\"button\" @click=\"submitSilence()\" :disabled=\"!silenceReason.trim() || silenceSubmitting\" class=\"w-full inline-flex justify-center items-center rounded-lg border border-transparent shadow-sm px-4 py-2 text-sm font-medium text-white sm:w-auto transition-all duration-200 focus:outline-none focus:ring-2 focus:ring-offset-2 dark:focus:ring-offset-dark-bg-secondary\" :class=\"{\n\t\t\t\t\t\t\t\t'bg-purple-600 hover:bg-purple-700 focus:ring-purple-500': silenceReason.trim() && !silenceSubmitting,\n\t\t\t\t\t\t\t\t'bg-gray-400 cursor-not-allowed': !silenceReason.trim() || silenceSubmitting\n\t\t\t\t\t\t\t}\"><svg x-show=\"silenceSubmitting\" class=\"animate-spin -ml-1 mr-2 h-4 w-4 text-white\" fill=\"none\" viewBox=\"0 0 24 24\"><circle class=\"opacity-25\" cx=\"12\" cy=\"12\" r=\"10\" stroke=\"currentColor\" stroke-width=\"4\"></circle> <path class=\"opacity-75\" fill=\"currentColor\" d=\"M4 12a8 8 0 018-8V0C5.373 0 0 5.373 0 12h4zm2 5.291A7.962 7.962 0 014 12H0c0 3.042 1.135 5.824 3 7.938l3-2.647z\"></path></svg> <span x-show=\"!silenceSubmitting\" x-text=\"silenceMode === 'edit' ? 'Update Silence' : 'Silence'\"></span> <span x-show=\"silenceSubmitting\">Processing...</span></button> <button type=\"button\" @click=\"cancelSilence()\" :disabled=\"silenceSubmitting\" class=\"mt-3 w-full inline-flex justify-center rounded-lg border border-gray-300 dark:border-dark-border-DEFAULT shadow-sm px-4 py-2 bg-white dark:bg-dark-bg-tertiary text-sm font-medium text-gray-700 dark:text-gray-300 hover:bg-gray-100 dark:hover:bg-dark-bg-secondary focus:outline-none focus:ring-2 focus:ring-offset-2 focus:ring-gray-500 sm:mt-0 sm:w-auto transition-colors\" :class=\"{ 'opacity-50 cursor-not-allowed': silenceSubmitting }\">Cancel</button></div></div></div></div>")
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
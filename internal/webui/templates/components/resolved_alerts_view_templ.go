@@ -33,7 +33,7 @@ func ResolvedAlertsView() templ.Component {
 			templ_7745c5c3_Var1 = templ.NopComponent
 		}
 		ctx = templ.ClearChildren(ctx)
-		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 1, "<!-- Resolved Alerts View --><div x-show=\"displayMode === 'resolved'\"><!-- Time Range Picker --><div class=\"mb-4 bg-white dark:bg-dark-bg-secondary rounded-lg shadow-sm border border-gray-200 dark:border-dark-border-subtle p-4\"><div class=\"flex items-center justify-between mb-3\"><h3 class=\"text-sm font-medium text-gray-700 dark:text-gray-300\">Time Range & Filters</h3><div class=\"flex flex-wrap items-center gap-2\"><button @click=\"setResolvedTimeRange(1)\" :class=\"{'bg-blue-100 dark:bg-blue-900/30 border-blue-500 dark:border-blue-500 text-blue-700 dark:text-blue-400': resolvedTimeRange.preset === 1}\" class=\"px-2 py-1 text-xs rounded-md border border-gray-300 dark:border-dark-border-DEFAULT hover:bg-gray-50 dark:hover:bg-dark-bg-tertiary text-gray-700 dark:text-gray-300 transition-colors\">1h</button> <button @click=\"setResolvedTimeRange(12)\" :class=\"{'bg-blue-100 dark:bg-blue-900/30 border-blue-500 dark:border-blue-500 text-blue-700 dark:text-blue-400': resolvedTimeRange.preset === 12}\" class=\"px-2 py-1 text-xs rounded-md border border-gray-300 dark:border-dark-border-DEFAULT hover:bg-gray-50 dark:hover:bg-dark-bg-tertiary text-gray-700 dark:text-gray-300 transition-colors\">12h</button> <button @click=\"setResolvedTimeRange(24)\" :class=\"{'bg-blue-100 dark:bg-blue-900/30 border-blue-500 dark:border-blue-500 text-blue-700 dark:text-blue-400': resolvedTimeRange.preset === 24}\" class=\"px-2 py-1 text-xs rounded-md border border-gray-300 dark:border-dark-border-DEFAULT hover:bg-gray-50 dark:hover:bg-dark-bg-tertiary text-gray-700 dark:text-gray-300 transition-colors\">24h</button> <button @click=\"setResolvedTimeRange(72)\" :class=\"{'bg-blue-100 dark:bg-blue-900/30 border-blue-500 dark:border-blue-500 text-blue-700 dark:text-blue-400': resolvedTimeRange.preset === 72}\" class=\"px-2 py-1 text-xs rounded-md border border-gray-300 dark:border-dark-border-DEFAULT hover:bg-gray-50 dark:hover:bg-dark-bg-tertiary text-gray-700 dark:text-gray-300 transition-colors\">3d</button> <button @click=\"setResolvedTimeRange(168)\" :class=\"{'bg-blue-100 dark:bg-blue-900/30 border-blue-500 dark:border-blue-500 text-blue-700 dark:text-blue-400': resolvedTimeRange.preset === 168}\" class=\"px-2 py-1 text-xs rounded-md border border-gray-300 dark:border-dark-border-DEFAULT hover:bg-gray-50 dark:hover:bg-dark-bg-tertiary text-gray-700 dark:text-gray-300 transition-colors\">7d</button> <button @click=\"setResolvedTimeRange(720)\" :class=\"{'bg-blue-100 dark:bg-blue-900/30 border-blue-500 dark:border-blue-500 text-blue-700 dark:text-blue-400': resolvedTimeRange.preset === 720}\" class=\"px-2 py-1 text-xs rounded-md border border-gray-300 dark:border-dark-border-DEFAULT hover:bg-gray-50 dark:hover:bg-dark-bg-tertiary text-gray-700 dark:text-gray-300 transition-colors\">30d</button> <button @click=\"setResolvedTimeRange(2160)\" :class=\"{'bg-blue-100 dark:bg-blue-900/30 border-blue-500 dark:border-blue-500 text-blue-700 dark:text-blue-400': resolvedTimeRange.preset === 2160}\" class=\"px-2 py-1 text-xs rounded-md border border-gray-300 dark:border-dark-border-DEFAULT hover:bg-gray-50 dark:hover:bg-dark-bg-tertiary text-gray-700 dark:text-gray-300 transition-colors\">90d</button> <span x-show=\"!resolvedTimeRange.preset\" class=\"px-2 py-1 text-xs rounded-md bg-purple-100 dark:bg-purple-900/30 text-purple-700 dark:text-purple-400 border border-purple-300 dark:border-purple-700\">Custom</span></div></div><div class=\"grid grid-cols-1 md:grid-cols-3 gap-4\"><!-- Start DateTime --><div><label class=\"block text-xs font-medium text-gray-600 dark:text-gray-400 mb-1\">From</label> <input type=\"datetime-local\" x-model=\"resolvedTimeRange.start\" @change=\"applyResolvedTimeRange()\" class=\"block w-full border-gray-300 dark:border-dark-border-DEFAULT rounded-md shadow-sm focus:ring-blue-500 focus:border-blue-500 dark:bg-dark-bg-tertiary dark:text-white text-sm\"></div><!-- End DateTime --><div><label class=\"block text-xs font-medium text-gray-600 dark:text-gray-400 mb-1\">To</label> <input type=\"datetime-local\" x-model=\"resolvedTimeRange.end\" @change=\"applyResolvedTimeRange()\" class=\"block w-full border-gray-300 dark:border-dark-border-DEFAULT rounded-md shadow-sm focus:ring-blue-500 focus:border-blue-500 dark:bg-dark-bg-tertiary dark:text-white text-sm\"></div><!-- Include Silenced Toggle --><div class=\"flex items-end\"><label class=\"flex items-center cursor-pointer\"><input type=\"checkbox\" id=\"resolved-include-silenced\" name=\"resolved-include-silenced\" x-model=\"resolvedIncludeSilenced\" @change=\"applyResolvedTimeRange()\" class=\"h-4 w-4 text-blue-600 focus:ring-blue-500 border-gray-300 rounded\"> <span class=\"ml-2 text-sm text-gray-700 dark:text-gray-300\">Include Silenced</span></label></div></div><!-- Results Summary and Actions --><div class=\"mt-3 flex items-center justify-between text-sm\"><div class=\"text-gray-600 dark:text-gray-400\"><span x-show=\"resolvedLoading\" x-cloak class=\"flex items-center\"><svg class=\"animate-spin h-4 w-4 mr-2 text-blue-500\" xmlns=\"http://www.w3.org/2000/svg\" fill=\"none\" viewBox=\"0 0 24 24\"><circle class=\"opacity-25\" cx=\"12\" cy=\"12\" r=\"10\" stroke=\"currentColor\" stroke-width=\"4\"></circle> <path class=\"opacity-75\" fill=\"currentColor\" d=\"M4 12a8 8 0 018-8V0C5.373 0 0 5.373 0 12h4zm2 5.291A7.962 7.962 0 014 12H0c0 3.042 1.135 5.824 3 7.938l3-2.647z\"></path></svg> Loading resolved alerts...</span> <span x-show=\"!resolvedLoading\" x-text=\"`Showing ${resolvedAlerts.length} of ${resolvedTotalCount} resolved alerts`\"></span> <span x-show=\"!resolvedLoading\" class=\"ml-2 text-xs text-gray-500 dark:text-gray-500\">(Active filters: <span x-text=\"getActiveFiltersCount()\"></span>)</span></div><div class=\"flex items-center space-x-2\"><!-- Export Dropdown --><div x-data=\"{ exportOpen: false }\" class=\"relative\" @click.away=\"exportOpen = false\"><button @click=\"exportOpen = !exportOpen\" :disabled=\"resolvedLoading || resolvedAlerts.length === 0\" class=\"inline-flex items-center px-3 py-1.5 border border-gray-300 dark:border-dark-border-DEFAULT rounded-md text-xs font-medium text-gray-700 dark:text-gray-300 bg-white dark:bg-dark-bg-tertiary hover:bg-gray-50 dark:hover:bg-dark-bg-secondary focus:outline-none focus:ring-2 focus:ring-offset-2 focus:ring-blue-500 disabled:opacity-50 disabled:cursor-not-allowed\"><svg class=\"w-4 h-4 mr-1\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M4 16v1a3 3 0 003 3h10a3 3 0 003-3v-1m-4-4l-4 4m0 0l-4-4m4 4V4\"></path></svg> Export <svg class=\"w-3 h-3 ml-1\" fill=\"currentColor\" viewBox=\"0 0 20 20\"><path fill-rule=\"evenodd\" d=\"M5.293 7.293a1 1 0 011.414 0L10 10.586l3.293-3.293a1 1 0 111.414 1.414l-4 4a1 1 0 01-1.414 0l-4-4a1 1 0 010-1.414z\" clip-rule=\"evenodd\"></path></svg></button><!-- Dropdown Menu --><div x-show=\"exportOpen\" x-transition:enter=\"transition ease-out duration-100\" x-transition:enter-start=\"transform opacity-0 scale-95\" x-transition:enter-end=\"transform opacity-100 scale-100\" x-transition:leave=\"transition ease-in duration-75\" x-transition:leave-start=\"transform opacity-100 scale-100\" x-transition:leave-end=\"transform opacity-0 scale-95\" class=\"absolute right-0 mt-2 w-48 rounded-md shadow-lg bg-white dark:bg-dark-bg-tertiary ring-1 ring-black ring-opacity-5 z-50\"><div class=\"py-1\" role=\"menu\"><button @click=\"exportResolvedAlertsCSV(); exportOpen = false\" class=\"w-full text-left px-4 py-2 text-sm text-gray-700 dark:text-gray-300 hover:bg-gray-100 dark:hover:bg-dark-bg-secondary flex items-center\" role=\"menuitem\"><svg class=\"w-4 h-4 mr-2\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M9 12h6m-6 4h6m2 5H7a2 2 0 01-2-2V5a2 2 0 012-2h5.586a1 1 0 01.707.293l5.414 5.414a1 1 0 01.293.707V19a2 2 0 01-2 2z\"></path></svg> Export as CSV</button> <button @click=\"exportResolvedAlertsJSON(); exportOpen = false\" class=\"w-full text-left px-4 py-2 text-sm text-gray-700 dark:text-gray-300 hover:bg-gray-100 dark:hover:bg-dark-bg-secondary flex items-center\" role=\"menuitem\"><svg class=\"w-4 h-4 mr-2\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M10 20l4-16m4 4l4 4-4 4M6 16l-4-4 4-4\"></path></svg> Export as JSON</button></div></div></div><!-- Refresh Button --><button @click=\"loadResolvedAlerts()\" :disabled=\"resolvedLoading\" class=\"inline-flex items-center px-3 py-1.5 border border-gray-300 dark:border-dark-border-DEFAULT rounded-md text-xs font-medium text-gray-700 dark:text-gray-300 bg-white dark:bg-dark-bg-tertiary hover:bg-gray-50 dark:hover:bg-dark-bg-secondary focus:outline-none focus:ring-2 focus:ring-offset-2 focus:ring-blue-500 disabled:opacity-50 disabled:cursor-not-allowed\"><svg class=\"w-4 h-4 mr-1\" :class=\"{'animate-spin': resolvedLoading}\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M4 4v5h.582m15.356 2A8.001 8.001 0 004.582 9m0 0H9m11 11v-5h-.581m0 0a8.003 8.003 0 01-15.357-2m15.357 2H15\"></path></svg> Refresh</button></div></div></div><!-- Active Filters Context (only show if filters are active) --><div x-show=\"getActiveFiltersCount() > 0\" class=\"mb-4 bg-blue-50 dark:bg-blue-900/20 border border-blue-200 dark:border-blue-800 rounded-lg p-4\"><div class=\"flex items-start justify-between\"><div class=\"flex-1\"><div class=\"flex items-center mb-2\"><svg class=\"w-5 h-5 text-blue-600 dark:text-blue-400 mr-2\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M3 4a1 1 0 011-1h16a1 1 0 011 1v2.586a1 1 0 01-.293.707l-6.414 6.414a1 1 0 00-.293.707V17l-4 4v-6.586a1 1 0 00-.293-.707L3.293 7.293A1 1 0 013 6.586V4z\"></path></svg><h4 class=\"text-sm font-semibold text-blue-900 dark:text-blue-300\">Active Filters (<span x-text=\"getActiveFiltersCount()\"></span>)</h4></div><div class=\"flex flex-wrap gap-2 text-xs\"><template x-if=\"filters.severities && filters.severities.length > 0\"><div class=\"inline-flex items-center gap-1 px-2 py-1 bg-white dark:bg-blue-900/40 rounded border border-blue-300 dark:border-blue-700\"><span class=\"font-medium text-blue-700 dark:text-blue-300\">Severity:</span> <span class=\"text-blue-600 dark:text-blue-400\" x-text=\"filters.severities.join(', ')\"></span></div></template><template x-if=\"filters.teams && filters.teams.length > 0\"><div class=\"inline-flex items-center gap-1 px-2 py-1 bg-white dark:bg-blue-900/40 rounded border border-blue-300 dark:border-blue-700\"><span class=\"font-medium text-blue-700 dark:text-blue-300\">Team:</span> <span class=\"text-blue-600 dark:text-blue-400\" x-text=\"filters.teams.join(', ')\"></span></div></template><template x-if=\"filters.alertNames && filters.alertNames.length > 0\"><div class=\"inline-flex items-center gap-1 px-2 py-1 bg-white dark:bg-blue-900/40 rounded border border-blue-300 dark:border-blue-700\"><span class=\"font-medium text-blue-700 dark:text-blue-300\">Alert Name:</span> <span class=\"text-blue-600 dark:text-blue-400\" x-text=\"filters.alertNames.join(', ')\"></span></div></template><template x-if=\"searchQuery && searchQuery.trim().length > 0\"><div class=\"inline-flex items-center gap-1 px-2 py-1 bg-white dark:bg-blue-900/40 rounded border border-blue-300 dark:border-blue-700\"><span class=\"font-medium text-blue-700 dark:text-blue-300\">Search:</span> <span class=\"text-blue-600 dark:text-blue-400\" x-text=\"searchQuery\"></span></div></template><template x-if=\"resolvedIncludeSilenced\"><div class=\"inline-flex items-center gap-1 px-2 py-1 bg-white dark:bg-blue-900/40 rounded border border-blue-300 dark:border-blue-700\"><span class=\"font-medium text-blue-700 dark:text-blue-300\">Include Silenced:</span> <span class=\"text-blue-600 dark:text-blue-400\">Yes</span></div></template></div></div><button @click=\"filters.severities = []; filters.teams = []; filters.alertNames = []; searchQuery = ''; loadResolvedAlerts();\" class=\"ml-4 text-sm text-blue-700 dark:text-blue-400 hover:text-blue-900 dark:hover:text-blue-300 font-medium whitespace-nowrap\" title=\"Clear all filters\">Clear filters</button></div></div><!-- Resolved Alerts Table --><div class=\"bg-white dark:bg-dark-bg-secondary shadow overflow-hidden sm:rounded-lg\">")
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 1, "<!-- Resolved Alerts View --><div x-show=\"displayMode === 'resolved'\"><!-- Time Range Picker --><div class=\"mb-4 bg-white dark:bg-dark-bg-secondary rounded-lg shadow-sm border border-gray-200 dark:border-dark-border-subtle p-4\"><div class=\"flex items-center justify-between mb-3\"><h3 class=\"text-sm font-medium text-gray-700 dark:text-gray-300\">Time Range & Filters</h3><div class=\"flex flex-wrap items-center gap-2\"><button @click=\"setResolvedTimeRange(1)\" :class=\"{'bg-blue-100 dark:bg-blue-900/30 border-blue-500 dark:border-blue-500 text-blue-700 dark:text-blue-400': resolvedTimeRange.preset === 1}\" class=\"px-2 py-1 text-xs rounded-md border border-gray-300 dark:border-dark-border-DEFAULT hover:bg-gray-50 dark:hover:bg-dark-bg-tertiary text-gray-700 dark:text-gray-300 transition-colors\">1h</button> <button @click=\"setResolvedTimeRange(12)\" :class=\"{'bg-blue-100 dark:bg-blue-900/30 border-blue-500 dark:border-blue-500 text-blue-700 dark:text-blue-400': resolvedTimeRange.preset === 12}\" class=\"px-2 py-1 text-xs rounded-md border border-gray-300 dark:border-dark-border-DEFAULT hover:bg-gray-50 dark:hover:bg-dark-bg-tertiary text-gray-700 dark:text-gray-300 transition-colors\">12h</button> <button @click=\"setResolvedTimeRange(24)\" :class=\"{'bg-blue-100 dark:bg-blue-900/30 border-blue-500 dark:border-blue-500 text-blue-700 dark:text-blue-400': resolvedTimeRange.preset === 24}\" class=\"px-2 py-1 text-xs rounded-md border border-gray-300 dark:border-dark-border-DEFAULT hover:bg-gray-50 dark:hover:bg-dark-bg-tertiary text-gray-700 dark:text-gray-300 transition-colors\">24h</button> <button @click=\"setResolvedTimeRange(72)\" :class=\"{'bg-blue-100 dark:bg-blue-900/30 border-blue-500 dark:border-blue-500 text-blue-700 dark:text-blue-400': resolvedTimeRange.preset === 72}\" class=\"px-2 py-1 text-xs rounded-md border border-gray-300 dark:border-dark-border-DEFAULT hover:bg-gray-50 dark:hover:bg-dark-bg-tertiary text-gray-700 dark:text-gray-300 transition-colors\">3d</button> <button @click=\"setResolvedTimeRange(168)\" :class=\"{'bg-blue-100 dark:bg-blue-900/30 border-blue-500 dark:border-blue-500 text-blue-700 dark:text-blue-400': resolvedTimeRange.preset === 168}\" class=\"px-2 py-1 text-xs rounded-md border border-gray-300 dark:border-dark-border-DEFAULT hover:bg-gray-50 dark:hover:bg-dark-bg-tertiary text-gray-700 dark:text-gray-300 transition-colors\">7d</button> <button @click=\"setResolvedTimeRange(720)\" :class=\"{'bg-blue-100 dark:bg-blue-900/30 border-blue-500 dark:border-blue-500 text-blue-700 dark:text-blue-400': resolvedTimeRange.preset === 720}\" class=\"px-2 py-1 text-xs rounded-md border border-gray-300 dark:border-dark-border-DEFAULT hover:bg-gray-50 dark:hover:bg-dark-bg-tertiary text-gray-700 dark:text-gray-300 transition-colors\">30d</button> <button @click=\"setResolvedTimeRange(2160)\" :class=\"{'bg-blue-100 dark:bg-blue-900/30 border-blue-500 dark:border-blue-500 text-blue-700 dark:text-blue-400': resolvedTimeRange.preset === 2160}\" class=\"px-2 py-1 text-xs rounded-md border border-gray-300 dark:border-dark-border-DEFAULT hover:bg-gray-50 dark:hover:bg-dark-bg-tertiary text-gray-700 dark:text-gray-300 transition-colors\">90d</button> <span x-show=\"!resolvedTimeRange.preset\" class=\"px-2 py-1 text-xs rounded-md bg-purple-100 dark:bg-purple-900/30 text-purple-700 dark:text-purple-400 border border-purple-300 dark:border-purple-700\">Custom</span></div></div><div class=\"grid grid-cols-1 md:grid-cols-3 gap-4\"><!-- Start DateTime --><div><label class=\"block text-xs font-medium text-gray-600 dark:text-gray-400 mb-1\">From</label> <input type=\"datetime-local\" x-model=\"resolvedTimeRange.start\" @change=\"applyResolvedTimeRange()\" class=\"block w-full border-gray-300 dark:border-dark-border-DEFAULT rounded-md shadow-sm focus:ring-blue-500 focus:border-blue-500 dark:bg-dark-bg-tertiary dark:text-white text-sm\"></div><!-- End DateTime --><div><label class=\"block text-xs font-medium text-gray-600 dark:text-gray-400 mb-1\">To</label> <input type=\"datetime-local\" x-model=\"resolvedTimeRange.end\" @change=\"applyResolvedTimeRange()\" class=\"block w-full border-gray-300 dark:border-dark-border-DEFAULT rounded-md shadow-sm focus:ring-blue-500 focus:border-blue-500 dark:bg-dark-bg-tertiary dark:text-white text-sm\"></div><!-- Include Silenced Toggle --><div class=\"flex items-end\"><label class=\"flex items-center cursor-pointer\"><input type=\"checkbox\" id=\"resolved-include-silenced\" name=\"resolved-include-silenced\" x-model=\"resolvedIncludeSilenced\" @change=\"applyResolvedTimeRange()\" class=\"h-4 w-4 text-blue-600 focus:ring-blue-500 border-gray-300 rounded\"> <span class=\"ml-2 text-sm text-gray-700 dark:text-gray-300\">Include Silenced</span></label></div></div><!-- Results Summary and Actions --><div class=\"mt-3 flex items-center justify-between text-sm\"><div class=\"text-gray-600 dark:text-gray-400\"><span x-show=\"resolvedLoading\" x-cloak class=\"flex items-center\"><svg class=\"animate-spin h-4 w-4 mr-2 text-blue-500\" xmlns=\"http://www.w3.org/2000/svg\" fill=\"none\" viewBox=\"0 0 24 24\"><circle class=\"opacity-25\" cx=\"12\" cy=\"12\" r=\"10\" stroke=\"currentColor\" stroke-width=\"4\"></circle> <path class=\"opacity-75\" fill=\"currentColor\" d=\"M4 12a8 8 0 018-8V0C5.373 0 0 5.373 0 12h4zm2 5.291A7.962 7.962 0 014 12H0c0 3.042 1.135 5.824 3 7.938l3-2.647z\"></path></svg> Loading resolved alerts...</span> <span x-show=\"!resolvedLoading\" x-text=\"`Showing ${resolvedAlerts.length} of ${resolvedTotalCount} resolved alerts`\"></span> <span x-show=\"!resolvedLoading\" class=\"ml-2 text-xs text-gray-500 dark:text-gray-500\">(Active filters: <span x-text=\"getActiveFiltersCount()\"></span>)</span></div><div class=\"flex items-center space-x-2\"><!-- Stored resolved alerts with their discussion --><button @click=\"openResolvedArchive()\" class=\"inline-flex items-center px-3 py-1.5 border border-gray-300 dark:border-dark-border-DEFAULT rounded-md text-xs font-medium text-gray-700 dark:text-gray-300 bg-white dark:bg-dark-bg-tertiary hover:bg-gray-50 dark:hover:bg-dark-bg-secondary focus:outline-none focus:ring-2 focus:ring-offset-2 focus:ring-blue-500\"><svg class=\"w-4 h-4 mr-1\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M5 8h14M5 8a2 2 0 110-4h14a2 2 0 110 4M5 8v10a2 2 0 002 2h10a2 2 0 002-2V8m-9 4h4\"></path></svg> Archive</button><!-- Export Dropdown --><div x-data=\"{ exportOpen: false }\" class=\"relative\" @click.away=\"exportOpen = false\"><button @click=\"exportOpen = !exportOpen\" :disabled=\"resolvedLoading || resolvedAlerts.length === 0\" class=\"inline-flex items-center px-3 py-1.5 border border-gray-300 dark:border-dark-border-DEFAULT rounded-md text-xs font-medium text-gray-700 dark:text-gray-300 bg-white dark:bg-dark-bg-tertiary hover:bg-gray-50 dark:hover:bg-dark-bg-secondary focus:outline-none focus:ring-2 focus:ring-offset-2 focus:ring-blue-500 disabled:opacity-50 disabled:cursor-not-allowed\"><svg class=\"w-4 h-4 mr-1\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M4 16v1a3 3 0 003 3h10a3 3 0 003-3v-1m-4-4l-4 4m0 0l-4-4m4 4V4\"></path></svg> Export <svg class=\"w-3 h-3 ml-1\" fill=\"currentColor\" viewBox=\"0 0 20 20\"><path fill-rule=\"evenodd\" d=\"M5.293 7.293a1 1 0 011.414 0L10 10.586l3.293-3.293a1 1 0 111.414 1.414l-4 4a1 1 0 01-1.414 0l-4-4a1 1 0 010-1.414z\" clip-rule=\"evenodd\"></path></svg></button><!-- Dropdown Menu --><div x-show=\"exportOpen\" x-transition:enter=\"transition ease-out duration-100\" x-transition:enter-start=\"transform opacity-0 scale-95\" x-transition:enter-end=\"transform opacity-100 scale-100\" x-transition:leave=\"transition ease-in duration-75\" x-transition:leave-start=\"transform opacity-100 scale-100\" x-transition:leave-end=\"transform opacity-0 scale-95\" class=\"absolute right-0 mt-2 w-48 rounded-md shadow-lg bg-white dark:bg-dark-bg-tertiary ring-1 ring-black ring-opacity-5 z-50\"><div class=\"py-1\" role=\"menu\"><button @click=\"exportResolvedAlertsCSV(); exportOpen = false\" class=\"w-full text-left px-4 py-2 text-sm text-gray-700 dark:text-gray-300 hover:bg-gray-100 dark:hover:bg-dark-bg-secondary flex items-center\" role=\"menuitem\"><svg class=\"w-4 h-4 mr-2\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M9 12h6m-6 4h6m2 5H7a2 2 0 01-2-2V5a2 2 0 012-2h5.586a1 1 0 01.707.293l5.414 5.414a1 1 0 01.293.707V19a2 2 0 01-2 2z\"></path></svg> Export as CSV</button> <button @click=\"exportResolvedAlertsJSON(); exportOpen = false\" class=\"w-full text-left px-4 py-2 text-sm text-gray-700 dark:text-gray-300 hover:bg-gray-100 dark:hover:bg-dark-bg-secondary flex items-center\" role=\"menuitem\"><svg class=\"w-4 h-4 mr-2\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M10 20l4-16m4 4l4 4-4 4M6 16l-4-4 4-4\"></path></svg> Export as JSON</button></div></div></div><!-- Refresh Button --><button @click=\"loadResolvedAlerts()\" :disabled=\"resolvedLoading\" class=\"inline-flex items-center px-3 py-1.5 border border-gray-300 dark:border-dark-border-DEFAULT rounded-md text-xs font-medium text-gray-700 dark:text-gray-300 bg-white dark:bg-dark-bg-tertiary hover:bg-gray-50 dark:hover:bg-dark-bg-secondary focus:outline-none focus:ring-2 focus:ring-offset-2 focus:ring-blue-500 disabled:opacity-50 disabled:cursor-not-allowed\"><svg class=\"w-4 h-4 mr-1\" :class=\"{'animate-spin': resolvedLoading}\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M4 4v5h.582m15.356 2A8.001 8.001 0 004.582 9m0 0H9m11 11v-5h-.581m0 0a8.003 8.003 0 01-15.357-2m15.357 2H15\"></path></svg> Refresh</button></div></div></div><!-- Active Filters Context (only show if filters are active) --><div x-show=\"getActiveFiltersCount() > 0\" class=\"mb-4 bg-blue-50 dark:bg-blue-900/20 border border-blue-200 dark:border-blue-800 rounded-lg p-4\"><div class=\"flex items-start justify-between\"><div class=\"flex-1\"><div class=\"flex items-center mb-2\"><svg class=\"w-5 h-5 text-blue-600 dark:text-blue-400 mr-2\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M3 4a1 1 0 011-1h16a1 1 0 011 1v2.586a1 1 0 01-.293.707l-6.414 6.414a1 1 0 00-.293.707V17l-4 4v-6.586a1 1 0 00-.293-.707L3.293 7.293A1 1 0 013 6.586V4z\"></path></svg><h4 class=\"text-sm font-semibold text-blue-900 dark:text-blue-300\">Active Filters (<span x-text=\"getActiveFiltersCount()\"></span>)</h4></div><div class=\"flex flex-wrap gap-2 text-xs\"><template x-if=\"filters.severities && filters.severities.length > 0\"><div class=\"inline-flex items-center gap-1 px-2 py-1 bg-white dark:bg-blue-900/40 rounded border border-blue-300 dark:border-blue-700\"><span class=\"font-medium text-blue-700 dark:text-blue-300\">Severity:</span> <span class=\"text-blue-600 dark:text-blue-400\" x-text=\"filters.severities.join(', ')\"></span></div></template><template x-if=\"filters.teams && filters.teams.length > 0\"><div class=\"inline-flex items-center gap-1 px-2 py-1 bg-white dark:bg-blue-900/40 rounded border border-blue-300 dark:border-blue-700\"><span class=\"font-medium text-blue-700 dark:text-blue-300\">Team:</span> <span class=\"text-blue-600 dark:text-blue-400\" x-text=\"filters.teams.join(', ')\"></span></div></template><template x-if=\"filters.alertNames && filters.alertNames.length > 0\"><div class=\"inline-flex items-center gap-1 px-2 py-1 bg-white dark:bg-blue-900/40 rounded border border-blue-300 dark:border-blue-700\"><span class=\"font-medium text-blue-700 dark:text-blue-300\">Alert Name:</span> <span class=\"text-blue-600 dark:text-blue-400\" x-text=\"filters.alertNames.join(', ')\"></span></div></template><template x-if=\"searchQuery && searchQuery.trim().length > 0\"><div class=\"inline-flex items-center gap-1 px-2 py-1 bg-white dark:bg-blue-900/40 rounded border border-blue-300 dark:border-blue-700\"><span class=\"font-medium text-blue-700 dark:text-blue-300\">Search:</span> <span class=\"text-blue-600 dark:text-blue-400\" x-text=\"searchQuery\"></span></div></template><template x-if=\"resolvedIncludeSilenced\"><div class=\"inline-flex items-center gap-1 px-2 py-1 bg-white dark:bg-blue-900/40 rounded border border-blue-300 dark:border-blue-700\"><span class=\"font-medium text-blue-700 dark:text-blue-300\">Include Silenced:</span> <span class=\"text-blue-600 dark:text-blue-400\">Yes</span></div></template></div></div><button @click=\"filters.severities = []; filters.teams = []; filters.alertNames = []; searchQuery = ''; loadResolvedAlerts();\" class=\"ml-4 text-sm text-blue-700 dark:text-blue-400 hover:text-blue-900 dark:hover:text-blue-300 font-medium whitespace-nowrap\" title=\"Clear all filters\">Clear filters</button></div></div><!-- Resolved Alerts Table --><div class=\"bg-white dark:bg-dark-bg-secondary shadow overflow-hidden sm:rounded-lg\">")
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
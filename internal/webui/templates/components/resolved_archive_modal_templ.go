@@ -0,0 +1,43 @@
+// Code generated by templ - DO NOT EDIT.
+
+// templ: version: v0.3.906
+package components
+
+//lint:file-ignore SA4006 This context is only used if a nested component is present.
+
+import "github.com/a-h/templ"
+import templruntime "github.com/a-h/templ/runtime"
+
+// ResolvedArchiveModal lists the resolved alerts stored by the backend, page by
+// page, with their stored discussion. JavaScript logic is in the
+// dashboard_resolved_archive.templ mixin.
+func ResolvedArchiveModal() templ.Component {
+	return templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
+		templ_7745c5c3_W, ctx := templ_7745c5c3_Input.Writer, templ_7745c5c3_Input.Context
+		if templ_7745c5c3_CtxErr := ctx.Err(); templ_7745c5c3_CtxErr != nil {
+			return templ_7745c5c3_CtxErr
+		}
+		templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templruntime.GetBuffer(templ_7745c5c3_W)
+		if !templ_7745c5c3_IsBuffer {
+			defer func() {
+				templ_7745c5c3_BufErr := templruntime.ReleaseBuffer(templ_7745c5c3_Buffer)
+				if templ_7745c5c3_Err == nil {
+					templ_7745c5c3_Err = templ_7745c5c3_BufErr
+				}
+			}()
+		}
+		ctx = templ.InitializeContext(ctx)
+		templ_7745c5c3_Var1 := templ.GetChildren(ctx)
+		if templ_7745c5c3_Var1 == nil {
+			templ_7745c5c3_Var1 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 1, "<div x-show=\"showResolvedArchive\" class=\"fixed inset-0 z-50 overflow-y-auto\" x-transition style=\"display: none;\" @keydown.escape.window=\"showResolvedArchive && !showAlertModal && closeResolvedArchive()\"><div class=\"flex items-end justify-center min-h-screen pt-4 px-4 pb-20 text-center sm:block sm:p-0\"><!-- Background overlay --><div class=\"fixed inset-0 bg-gray-500/75 dark:bg-black/60 backdrop-blur-sm transition-opacity z-0\" @click=\"closeResolvedArchive()\"></div><!-- Modal panel --><div class=\"inline-block align-bottom bg-white dark:bg-dark-bg-secondary rounded-xl text-left overflow-hidden shadow-2xl transform transition-all sm:my-8 sm:align-middle sm:max-w-4xl sm:w-full relative z-10 border border-gray-200/50 dark:border-dark-border-subtle/50\" @click.stop><!-- Header --><div class=\"flex justify-between items-center px-6 py-4 border-b border-gray-200 dark:border-dark-border-subtle bg-gradient-to-r from-gray-50 to-white dark:from-dark-bg-secondary dark:to-dark-bg-tertiary\"><div><h3 class=\"text-lg font-semibold text-gray-900 dark:text-white\">Resolved Alerts Archive</h3><p class=\"text-xs text-gray-500 dark:text-gray-400\">Stored with their comments and acknowledgments until they expire<span x-show=\"archiveTtlHours\">, <span x-text=\"formatArchiveTtl(archiveTtlHours)\"></span> after resolving</span>. Updates live.</p></div><button @click=\"closeResolvedArchive()\" class=\"p-2 rounded-lg hover:bg-gray-100 dark:hover:bg-dark-bg-tertiary transition-colors group\"><svg class=\"w-5 h-5 text-gray-400 group-hover:text-gray-600 dark:group-hover:text-gray-300\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M6 18L18 6M6 6l12 12\"></path></svg></button></div><div class=\"px-6 py-4\"><div x-show=\"archiveError\" class=\"mb-4 p-3 bg-red-50 dark:bg-red-900/20 border border-red-200 dark:border-red-800 rounded-lg text-sm text-red-700 dark:text-red-400\" x-text=\"archiveError\"></div><div class=\"max-h-[60vh] overflow-y-auto\"><table class=\"min-w-full text-sm\"><thead class=\"sticky top-0 bg-gray-50 dark:bg-dark-bg-tertiary\"><tr class=\"text-left text-xs font-semibold text-gray-600 dark:text-gray-300 uppercase tracking-wider\"><th class=\"px-3 py-2\">Alert</th><th class=\"px-3 py-2\">Source</th><th class=\"px-3 py-2\">Resolved</th><th class=\"px-3 py-2\">Expires</th><th class=\"px-3 py-2\">Discussion</th><th class=\"px-3 py-2\"></th></tr></thead> <tbody class=\"divide-y divide-gray-100 dark:divide-gray-800\"><template x-for=\"alert in archiveAlerts\" :key=\"alert.fingerprint + alert.resolvedAt\"><tr class=\"hover:bg-gray-50 dark:hover:bg-dark-bg-tertiary\"><td class=\"px-3 py-2\"><div class=\"flex items-center gap-2 min-w-0\"><span class=\"text-xs font-medium px-2 py-0.5 rounded-full capitalize\" :class=\"getSeverityBadgeClasses(alert.severity)\" :style=\"getSeverityBadgeStyle(alert.severity)\" x-text=\"alert.severity || 'unknown'\"></span> <span class=\"font-medium text-gray-900 dark:text-white truncate\" x-text=\"alert.alertName\" :title=\"alert.summary\"></span></div></td><td class=\"px-3 py-2 text-gray-600 dark:text-gray-300\" x-text=\"alert.source || '-'\"></td><td class=\"px-3 py-2 text-gray-600 dark:text-gray-300 whitespace-nowrap\" x-text=\"new Date(alert.resolvedAt).toLocaleString()\"></td><td class=\"px-3 py-2 text-gray-600 dark:text-gray-300 whitespace-nowrap\" x-text=\"formatArchiveExpiry(alert.retainedUntil)\" :title=\"alert.retainedUntil ? new Date(alert.retainedUntil).toLocaleString() : ''\"></td><td class=\"px-3 py-2 whitespace-nowrap\"><span x-show=\"alert.commentCount > 0\" class=\"text-xs text-gray-600 dark:text-gray-300 mr-2\" x-text=\"alert.commentCount + ' comment' + (alert.commentCount === 1 ? '' : 's')\"></span> <span x-show=\"alert.acknowledgmentCount > 0\" class=\"text-xs text-emerald-700 dark:text-emerald-300\" x-text=\"alert.acknowledgmentCount + ' ack' + (alert.acknowledgmentCount === 1 ? '' : 's')\"></span> <span x-show=\"!alert.commentCount && !alert.acknowledgmentCount\" class=\"text-xs text-gray-400 dark:text-gray-500\">-</span></td><td class=\"px-3 py-2 text-right\"><button @click=\"openArchivedAlert(alert)\" class=\"text-xs font-medium text-blue-600 hover:text-blue-900 dark:text-blue-400 dark:hover:text-blue-300\">Details</button></td></tr></template></tbody></table><p x-show=\"!archiveLoading && archiveAlerts.length === 0 && !archiveError\" class=\"text-center text-sm text-gray-500 dark:text-gray-400 py-8\">No resolved alerts are stored.</p></div></div><!-- Footer --><div class=\"flex items-center justify-between px-6 py-3 border-t border-gray-200 dark:border-dark-border-subtle bg-gray-50 dark:bg-dark-bg-tertiary\"><button @click=\"clearResolvedArchive()\" :disabled=\"isRemovingResolvedAlerts || archiveTotal === 0\" class=\"px-3 py-1.5 text-xs font-medium rounded-md text-red-700 dark:text-red-400 border border-red-200 dark:border-red-800 hover:bg-red-50 dark:hover:bg-red-900/30 disabled:opacity-50 disabled:cursor-not-allowed\">Clear all</button><div class=\"flex items-center gap-3 text-xs text-gray-600 dark:text-gray-300\"><span x-show=\"archiveLoading\">Loading…</span> <span x-text=\"archivePageLabel()\"></span> <button @click=\"archivePage(-1)\" :disabled=\"archiveLoading || archiveOffset === 0\" class=\"px-2 py-1 rounded-md border border-gray-300 dark:border-dark-border-DEFAULT hover:bg-white dark:hover:bg-dark-bg-secondary disabled:opacity-50\">Previous</button> <button @click=\"archivePage(1)\" :disabled=\"archiveLoading || archiveOffset + archiveLimit >= archiveTotal\" class=\"px-2 py-1 rounded-md border border-gray-300 dark:border-dark-border-DEFAULT hover:bg-white dark:hover:bg-dark-bg-secondary disabled:opacity-50\">Next</button></div></div></div></div></div>")
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		return nil
+	})
+}
+
+var _ = templruntime.GeneratedTemplate
@@ -0,0 +1,44 @@
+// Code generated by templ - DO NOT EDIT.
+
+// templ: version: v0.3.906
+package components
+
+//lint:file-ignore SA4006 This context is only used if a nested component is present.
+
+import "github.com/a-h/templ"
+import templruntime "github.com/a-h/templ/runtime"
+
+// SilencesViewModal lists the silences of every Alertmanager grouped by source,
+// with a state filter, expire/edit actions and standalone silence creation.
+// Relies on the dashboard scope and the dashboard_silences mixin; editing and
+// creating reuse SilenceModal, which stacks above this one.
+func SilencesViewModal() templ.Component {
+	return templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
+		templ_7745c5c3_W, ctx := templ_7745c5c3_Input.Writer, templ_7745c5c3_Input.Context
+		if templ_7745c5c3_CtxErr := ctx.Err(); templ_7745c5c3_CtxErr != nil {
+			return templ_7745c5c3_CtxErr
+		}
+		templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templruntime.GetBuffer(templ_7745c5c3_W)
+		if !templ_7745c5c3_IsBuffer {
+			defer func() {
+				templ_7745c5c3_BufErr := templruntime.ReleaseBuffer(templ_7745c5c3_Buffer)
+				if templ_7745c5c3_Err == nil {
+					templ_7745c5c3_Err = templ_7745c5c3_BufErr
+				}
+			}()
+		}
+		ctx = templ.InitializeContext(ctx)
+		templ_7745c5c3_Var1 := templ.GetChildren(ctx)
+		if templ_7745c5c3_Var1 == nil {
+			templ_7745c5c3_Var1 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 1, "<!-- Silences management --><div x-show=\"showSilencesView\" x-transition:enter=\"ease-out duration-300\" x-transition:enter-start=\"opacity-0\" x-transition:enter-end=\"opacity-100\" x-transition:leave=\"ease-in duration-200\" x-transition:leave-start=\"opacity-100\" x-transition:leave-end=\"opacity-0\" class=\"fixed inset-0 z-50 overflow-y-auto\" style=\"display: none;\"><div class=\"flex items-end justify-center min-h-screen pt-4 px-4 pb-20 text-center sm:block sm:p-0\"><!-- Backdrop --><div class=\"fixed inset-0 bg-gray-500/75 dark:bg-black/60 backdrop-blur-sm transition-opacity\" @click=\"closeSilencesView()\"></div><span class=\"hidden sm:inline-block sm:align-middle sm:h-screen\">&#8203;</span><div class=\"relative inline-block align-bottom bg-white dark:bg-dark-bg-secondary rounded-xl text-left overflow-hidden shadow-2xl transform transition-all sm:my-8 sm:align-middle sm:max-w-5xl sm:w-full z-10 border border-gray-200/50 dark:border-dark-border-subtle/50\" @click.stop><!-- Header --><div class=\"flex items-center justify-between px-6 py-4 border-b border-gray-200 dark:border-dark-border-subtle\"><h3 class=\"text-lg font-semibold text-gray-900 dark:text-white\">Silences</h3><div class=\"flex items-center space-x-3\"><div class=\"flex items-center space-x-1 bg-gray-100 dark:bg-dark-bg-tertiary rounded-lg p-1\"><template x-for=\"option in [{ value: 'active', label: 'Active' }, { value: 'pending', label: 'Pending' }, { value: 'expired', label: 'Expired' }, { value: '', label: 'All' }]\" :key=\"option.value\"><button @click=\"setSilencesStateFilter(option.value)\" :class=\"silencesStateFilter === option.value ? 'bg-white dark:bg-dark-bg-secondary shadow text-gray-900 dark:text-white' : 'text-gray-700 dark:text-gray-300 hover:text-gray-900 dark:hover:text-white'\" class=\"px-3 py-1 text-sm font-medium rounded-md transition-colors\" x-text=\"option.label\"></button></template></div><button @click=\"createStandaloneSilence()\" class=\"px-3 py-1.5 text-sm font-medium rounded-md text-white bg-purple-600 hover:bg-purple-700\">New Silence</button> <button @click=\"closeSilencesView()\" class=\"p-1 text-gray-400 hover:text-gray-600 dark:hover:text-gray-200\" title=\"Close\"><svg class=\"w-5 h-5\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M6 18L18 6M6 6l12 12\"></path></svg></button></div></div><!-- Body --><div class=\"px-6 py-4 max-h-[70vh] overflow-y-auto\"><div x-show=\"silencesError\" class=\"mb-4 p-3 text-sm text-red-700 bg-red-50 dark:bg-red-900/30 dark:text-red-300 rounded-md\" x-text=\"silencesError\"></div><div x-show=\"silencesLoading\" class=\"py-8 text-center text-sm text-gray-500 dark:text-gray-400\">Loading silences...</div><div x-show=\"!silencesLoading && silencesList.length === 0 && !silencesError\" class=\"py-8 text-center text-sm text-gray-500 dark:text-gray-400\">No silences.</div><template x-for=\"group in silencesBySource()\" :key=\"group.source\"><div x-show=\"!silencesLoading\" class=\"mb-6\"><h4 class=\"text-sm font-semibold text-gray-700 dark:text-gray-300 mb-2\"><span x-text=\"group.source\"></span> <span class=\"ml-1 text-xs font-normal text-gray-500 dark:text-gray-400\" x-text=\"'(' + group.silences.length + ')'\"></span></h4><table class=\"min-w-full text-sm\"><thead><tr class=\"text-left text-xs uppercase text-gray-500 dark:text-gray-400 border-b border-gray-200 dark:border-dark-border-subtle\"><th class=\"py-2 pr-4 font-medium\">Matchers</th><th class=\"py-2 pr-4 font-medium\">Creator</th><th class=\"py-2 pr-4 font-medium\">Comment</th><th class=\"py-2 pr-4 font-medium\">State</th><th class=\"py-2 pr-4 font-medium\">Remaining</th><th class=\"py-2\"></th></tr></thead> <tbody><template x-for=\"silence in group.silences\" :key=\"silence.id\"><tr class=\"align-top border-b border-gray-100 dark:border-dark-border-subtle last:border-b-0\"><td class=\"py-2 pr-4 font-mono text-xs text-gray-900 dark:text-white break-all\" x-text=\"formatSilenceMatchers(silence.matchers)\"></td><td class=\"py-2 pr-4 text-gray-700 dark:text-gray-300\" x-text=\"silence.createdBy || 'unknown'\"></td><td class=\"py-2 pr-4 text-gray-600 dark:text-gray-400 break-words\" x-text=\"silence.comment\"></td><td class=\"py-2 pr-4\"><span class=\"text-xs font-medium px-2 py-0.5 rounded-full capitalize\" :class=\"silence.status?.state === 'active' ? 'bg-purple-100 text-purple-800 dark:bg-purple-900/50 dark:text-purple-200' : (silence.status?.state === 'pending' ? 'bg-yellow-100 text-yellow-800 dark:bg-yellow-900/50 dark:text-yellow-200' : 'bg-gray-100 text-gray-700 dark:bg-gray-700 dark:text-gray-300')\" x-text=\"silence.status?.state || 'unknown'\"></span></td><td class=\"py-2 pr-4 text-xs text-gray-500 dark:text-gray-400 whitespace-nowrap\" x-text=\"silenceTimeRemaining(silence)\"></td><td class=\"py-2 text-right whitespace-nowrap\"><button x-show=\"silence.status?.state !== 'expired'\" @click=\"editSilence(silence)\" :disabled=\"silenceExpiring[silence.id]\" class=\"px-2 py-1 text-xs font-medium rounded-md text-gray-700 bg-gray-100 hover:bg-gray-200 dark:text-gray-200 dark:bg-gray-700 dark:hover:bg-gray-600 disabled:opacity-50\">Edit</button> <button x-show=\"silence.status?.state !== 'expired'\" @click=\"expireSilence(silence)\" :disabled=\"silenceExpiring[silence.id]\" class=\"ml-1 px-2 py-1 text-xs font-medium rounded-md text-white bg-red-600 hover:bg-red-700 disabled:opacity-50\" x-text=\"silenceExpiring[silence.id] ? 'Expiring...' : 'Expire'\"></button></td></tr></template></tbody></table></div></template></div></div></div></div>")
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		return nil
+	})
+}
+
+var _ = templruntime.GeneratedTemplate
@@ -60,7 +60,7 @@ func Base(title string, content templ.Component) templ.Component {
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
-		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 4, "</div><!-- Dark mode toggle script --><script>\n\t\t\t// Global dark mode functions\n\t\t\twindow.darkModeState = {\n\t\t\t\tdarkMode: false,\n\t\t\t\tinit() {\n\t\t\t\t\t// Force light mode for now\n\t\t\t\t\tthis.darkMode = false;\n\t\t\t\t\tconsole.log('Dark mode disabled - forced to:', this.darkMode);\n\t\t\t\t\tthis.updateTheme();\n\t\t\t\t},\n\t\t\t\ttoggle() {\n\t\t\t\t\tthis.darkMode = !this.darkMode;\n\t\t\t\t\tconsole.log('Dark mode toggled to:', this.darkMode);\n\t\t\t\t\tlocalStorage.setItem('darkMode', this.darkMode.toString());\n\t\t\t\t\tconsole.log('Dark mode saved to localStorage:', localStorage.getItem('darkMode'));\n\t\t\t\t\tthis.updateTheme();\n\t\t\t\t\t// Dispatch event to update all Alpine components\n\t\t\t\t\twindow.dispatchEvent(new CustomEvent('darkModeChanged', { detail: this.darkMode }));\n\t\t\t\t},\n\t\t\t\tupdateTheme() {\n\t\t\t\t\tconsole.log('Updating theme, darkMode:', this.darkMode);\n\t\t\t\t\tif (this.darkMode) {\n\t\t\t\t\t\tdocument.documentElement.classList.add('dark');\n\t\t\t\t\t\tconsole.log('Added dark class to documentElement');\n\t\t\t\t\t} else {\n\t\t\t\t\t\tdocument.documentElement.classList.remove('dark');\n\t\t\t\t\t\tconsole.log('Removed dark class from documentElement');\n\t\t\t\t\t}\n\t\t\t\t}\n\t\t\t};\n\t\t\t\n\t\t\t// Initialize dark mode immediately (before page load)\n\t\t\twindow.darkModeState.init();\n\n\t\t\tfunction darkModeHandler() {\n\t\t\t\treturn {\n\t\t\t\t\tdarkMode: false,\n\t\t\t\t\tinit() {\n\t\t\t\t\t\t// Initialize from global state (without re-initializing global state)\n\t\t\t\t\t\tthis.darkMode = window.darkModeState.darkMode;\n\t\t\t\t\t\t\n\t\t\t\t\t\t// Listen for changes\n\t\t\t\t\t\twindow.addEventListener('darkModeChanged', (e) => {\n\t\t\t\t\t\t\tthis.darkMode = e.detail;\n\t\t\t\t\t\t});\n\t\t\t\t\t},\n\t\t\t\t\ttoggle() {\n\t\t\t\t\t\twindow.darkModeState.toggle();\n\t\t\t\t\t}\n\t\t\t\t}\n\t\t\t}\n\t\t</script><!-- Impersonation scripts --><script>\n\t\t\t// Impersonation state\n\t\t\twindow.impersonationState = {\n\t\t\t\tisImpersonating: false,\n\t\t\t\timpersonatedUsername: '',\n\t\t\t\tcanImpersonate: false,\n\t\t\t\tinitialized: false,\n\n\t\t\t\tasync init() {\n\t\t\t\t\ttry {\n\t\t\t\t\t\tconst response = await fetch('/api/impersonate/status');\n\t\t\t\t\t\tif (response.ok) {\n\t\t\t\t\t\t\tconst data = await response.json();\n\t\t\t\t\t\t\tthis.isImpersonating = data.is_impersonating || false;\n\t\t\t\t\t\t\tthis.impersonatedUsername = data.impersonated_username || '';\n\t\t\t\t\t\t\tthis.canImpersonate = data.can_impersonate || false;\n\t\t\t\t\t\t\tthis.updateUI();\n\t\t\t\t\t\t}\n\t\t\t\t\t} catch (error) {\n\t\t\t\t\t\tconsole.error('Error checking impersonation status:', error);\n\t\t\t\t\t} finally {\n\t\t\t\t\t\tthis.initialized = true;\n\t\t\t\t\t\t// Dispatch event for other components waiting on this\n\t\t\t\t\t\twindow.dispatchEvent(new CustomEvent('impersonationStateReady'));\n\t\t\t\t\t}\n\t\t\t\t},\n\n\t\t\t\tupdateUI() {\n\t\t\t\t\tconst banner = document.getElementById('impersonation-banner');\n\t\t\t\t\tconst spacer = document.getElementById('impersonation-spacer');\n\t\t\t\t\tconst usernameEl = document.getElementById('impersonated-username');\n\n\t\t\t\t\tif (this.isImpersonating && banner && spacer && usernameEl) {\n\t\t\t\t\t\tusernameEl.textContent = this.impersonatedUsername;\n\t\t\t\t\t\tbanner.style.display = 'flex';\n\t\t\t\t\t\tspacer.style.display = 'block';\n\t\t\t\t\t}\n\t\t\t\t}\n\t\t\t};\n\n\t\t\t// Initialize on page load\n\t\t\tdocument.addEventListener('DOMContentLoaded', function() {\n\t\t\t\twindow.impersonationState.init();\n\t\t\t});\n\n\t\t\t// Global function to stop impersonation\n\t\t\tasync function stopImpersonation() {\n\t\t\t\ttry {\n\t\t\t\t\tconst response = await fetch('/api/impersonate/stop', {\n\t\t\t\t\t\tmethod: 'POST',\n\t\t\t\t\t\theaders: { 'Content-Type': 'application/json' }\n\t\t\t\t\t});\n\t\t\t\t\tif (response.ok) {\n\t\t\t\t\t\twindow.location.reload();\n\t\t\t\t\t} else {\n\t\t\t\t\t\tconst data = await response.json();\n\t\t\t\t\t\talert(data.error || 'Failed to stop impersonation');\n\t\t\t\t\t}\n\t\t\t\t} catch (error) {\n\t\t\t\t\tconsole.error('Error stopping impersonation:', error);\n\t\t\t\t\talert('Failed to stop impersonation');\n\t\t\t\t}\n\t\t\t}\n\n\t\t\t// Global function to start impersonation\n\t\t\tasync function startImpersonation(username) {\n\t\t\t\ttry {\n\t\t\t\t\tconst response = await fetch('/api/impersonate/start', {\n\t\t\t\t\t\tmethod: 'POST',\n\t\t\t\t\t\theaders: { 'Content-Type': 'application/json' },\n\t\t\t\t\t\tbody: JSON.stringify({ username: username })\n\t\t\t\t\t});\n\t\t\t\t\tif (response.ok) {\n\t\t\t\t\t\twindow.location.reload();\n\t\t\t\t\t} else {\n\t\t\t\t\t\tconst data = await response.json();\n\t\t\t\t\t\talert(data.error || 'Failed to start impersonation');\n\t\t\t\t\t}\n\t\t\t\t} catch (error) {\n\t\t\t\t\tconsole.error('Error starting impersonation:', error);\n\t\t\t\t\talert('Failed to start impersonation');\n\t\t\t\t}\n\t\t\t}\n\n\t\t\t// Alpine.js component for impersonation dropdown\n\t\t\tfunction impersonationDropdown() {\n\t\t\t\treturn {\n\t\t\t\t\tisOpen: false,\n\t\t\t\t\tloading: false,\n\t\t\t\t\tsearch: '',\n\t\t\t\t\tusers: [],\n\t\t\t\t\tfilteredUsers: [],\n\n\t\t\t\t\ttoggleDropdown() {\n\t\t\t\t\t\tthis.isOpen = !this.isOpen;\n\t\t\t\t\t\tif (this.isOpen && this.users.length === 0) {\n\t\t\t\t\t\t\tthis.loadUsers();\n\t\t\t\t\t\t}\n\t\t\t\t\t},\n\n\t\t\t\t\tasync loadUsers() {\n\t\t\t\t\t\tthis.loading = true;\n\t\t\t\t\t\ttry {\n\t\t\t\t\t\t\tconst response = await fetch('/api/impersonate/users');\n\t\t\t\t\t\t\tif (response.ok) {\n\t\t\t\t\t\t\t\tconst data = await response.json();\n\t\t\t\t\t\t\t\tthis.users = data.users || [];\n\t\t\t\t\t\t\t\tthis.filteredUsers = this.users;\n\t\t\t\t\t\t\t}\n\t\t\t\t\t\t} catch (error) {\n\t\t\t\t\t\t\tconsole.error('Error loading users:', error);\n\t\t\t\t\t\t} finally {\n\t\t\t\t\t\t\tthis.loading = false;\n\t\t\t\t\t\t}\n\t\t\t\t\t},\n\n\t\t\t\t\tfilterUsers() {\n\t\t\t\t\t\tconst searchLower = this.search.toLowerCase();\n\t\t\t\t\t\tthis.filteredUsers = this.users.filter(user =>\n\t\t\t\t\t\t\tuser.username.toLowerCase().includes(searchLower) ||\n\t\t\t\t\t\t\t(user.email && user.email.toLowerCase().includes(searchLower))\n\t\t\t\t\t\t);\n\t\t\t\t\t},\n\n\t\t\t\t\tstartImpersonation(username) {\n\t\t\t\t\t\tthis.isOpen = false;\n\t\t\t\t\t\twindow.startImpersonation(username);\n\t\t\t\t\t}\n\t\t\t\t}\n\t\t\t}\n\n\t\t\t// Alpine.js component for connected users dropdown (admin only)\n\t\t\tfunction connectedUsersDropdown() {\n\t\t\t\treturn {\n\t\t\t\t\topen: false,\n\t\t\t\t\tloading: false,\n\t\t\t\t\tusers: [],\n\t\t\t\t\tcount: 0,\n\t\t\t\t\tcanAdmin: false,\n\t\t\t\t\tpollInterval: null,\n\n\t\t\t\t\tasync init() {\n\t\t\t\t\t\t// Wait for impersonationState to be ready (reuse its data instead of fetching again)\n\t\t\t\t\t\tif (!window.impersonationState.initialized) {\n\t\t\t\t\t\t\tawait new Promise(resolve => {\n\t\t\t\t\t\t\t\twindow.addEventListener('impersonationStateReady', resolve, { once: true });\n\t\t\t\t\t\t\t});\n\t\t\t\t\t\t}\n\t\t\t\t\t\tthis.canAdmin = window.impersonationState.canImpersonate;\n\t\t\t\t\t\tif (this.canAdmin) {\n\t\t\t\t\t\t\tawait this.refresh();\n\t\t\t\t\t\t\t// Poll every 30 seconds\n\t\t\t\t\t\t\tthis.pollInterval = setInterval(() => this.refresh(), 30000);\n\t\t\t\t\t\t}\n\t\t\t\t\t},\n\n\t\t\t\t\ttoggle() {\n\t\t\t\t\t\tthis.open = !this.open;\n\t\t\t\t\t\tif (this.open) {\n\t\t\t\t\t\t\tthis.refresh();\n\t\t\t\t\t\t}\n\t\t\t\t\t},\n\n\t\t\t\t\tasync refresh() {\n\t\t\t\t\t\tthis.loading = true;\n\t\t\t\t\t\ttry {\n\t\t\t\t\t\t\tconst response = await fetch('/api/admin/connected-users');\n\t\t\t\t\t\t\tif (response.ok) {\n\t\t\t\t\t\t\t\tconst data = await response.json();\n\t\t\t\t\t\t\t\tthis.users = data.users || [];\n\t\t\t\t\t\t\t\tthis.count = data.total_count || 0;\n\t\t\t\t\t\t\t}\n\t\t\t\t\t\t} catch (error) {\n\t\t\t\t\t\t\tconsole.error('Error loading connected users:', error);\n\t\t\t\t\t\t} finally {\n\t\t\t\t\t\t\tthis.loading = false;\n\t\t\t\t\t\t}\n\t\t\t\t\t},\n\n\t\t\t\t\tformatLastActivity(timestamp) {\n\t\t\t\t\t\tif (!timestamp) return '';\n\t\t\t\t\t\tconst date = new Date(timestamp);\n\t\t\t\t\t\tconst now = new Date();\n\t\t\t\t\t\tconst diffMs = now - date;\n\t\t\t\t\t\tconst diffMins = Math.floor(diffMs / 60000);\n\n\t\t\t\t\t\tif (diffMins < 1) return 'just now';\n\t\t\t\t\t\tif (diffMins < 60) return diffMins + 'm ago';\n\t\t\t\t\t\tconst diffHours = Math.floor(diffMins / 60);\n\t\t\t\t\t\tif (diffHours < 24) return diffHours + 'h ago';\n\t\t\t\t\t\tconst diffDays = Math.floor(diffHours / 24);\n\t\t\t\t\t\treturn diffDays + 'd ago';\n\t\t\t\t\t}\n\t\t\t\t}\n\t\t\t}\n\t\t</script><!-- Footer with timezone selector --><footer class=\"fixed bottom-0 left-0 right-0 bg-white dark:bg-dark-bg-secondary border-t border-gray-200 dark:border-dark-border px-4 py-2 flex items-center justify-between z-40\"><div class=\"flex items-center gap-4\">")
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 4, "</div><!-- Dark mode toggle script --><script>\n\t\t\t// Global dark mode functions. theme is the dashboard setting: 'light',\n\t\t\t// 'dark' or 'auto', which follows the OS and switches when it does.\n\t\t\twindow.darkModeState = {\n\t\t\t\tdarkMode: false,\n\t\t\t\ttheme: 'light',\n\t\t\t\tsystemDarkQuery: window.matchMedia ? window.matchMedia('(prefers-color-scheme: dark)') : null,\n\t\t\t\tinit() {\n\t\t\t\t\ttry {\n\t\t\t\t\t\tthis.theme = JSON.parse(localStorage.getItem('dashboardSettings') || '{}').theme || 'light';\n\t\t\t\t\t} catch (e) {\n\t\t\t\t\t\tthis.theme = 'light';\n\t\t\t\t\t}\n\t\t\t\t\tthis.systemDarkQuery?.addEventListener('change', () => {\n\t\t\t\t\t\tif (this.theme === 'auto') {\n\t\t\t\t\t\t\tthis.updateTheme();\n\t\t\t\t\t\t}\n\t\t\t\t\t});\n\t\t\t\t\tthis.updateTheme();\n\t\t\t\t},\n\t\t\t\tsetTheme(theme) {\n\t\t\t\t\tthis.theme = theme || 'light';\n\t\t\t\t\tthis.updateTheme();\n\t\t\t\t},\n\t\t\t\ttoggle() {\n\t\t\t\t\tthis.setTheme(this.darkMode ? 'light' : 'dark');\n\t\t\t\t},\n\t\t\t\tupdateTheme() {\n\t\t\t\t\tthis.darkMode = this.theme === 'dark' || (this.theme === 'auto' && !!this.systemDarkQuery?.matches);\n\t\t\t\t\t// Dispatch event to update all Alpine components\n\t\t\t\t\twindow.dispatchEvent(new CustomEvent('darkModeChanged', { detail: this.darkMode }));\n\t\t\t\t\tif (this.darkMode) {\n\t\t\t\t\t\tdocument.documentElement.classList.add('dark');\n\t\t\t\t\t\tconsole.log('Added dark class to documentElement');\n\t\t\t\t\t} else {\n\t\t\t\t\t\tdocument.documentElement.classList.remove('dark');\n\t\t\t\t\t\tconsole.log('Removed dark class from documentElement');\n\t\t\t\t\t}\n\n\t\t\t\t\t// Update meta theme-color for mobile browsers\n\t\t\t\t\tconst metaThemeColor = document.querySelector('meta[name=\"theme-color\"]');\n\t\t\t\t\tif (metaThemeColor) {\n\t\t\t\t\t\tmetaThemeColor.setAttribute('content', this.darkMode ? '#1f2937' : '#ffffff');\n\t\t\t\t\t}\n\t\t\t\t}\n\t\t\t};\n\t\t\t\n\t\t\t// Initialize dark mode immediately (before page load)\n\t\t\twindow.darkModeState.init();\n\n\t\t\tfunction darkModeHandler() {\n\t\t\t\treturn {\n\t\t\t\t\tdarkMode: false,\n\t\t\t\t\tinit() {\n\t\t\t\t\t\t// Initialize from global state (without re-initializing global state)\n\t\t\t\t\t\tthis.darkMode = window.darkModeState.darkMode;\n\t\t\t\t\t\t\n\t\t\t\t\t\t// Listen for changes\n\t\t\t\t\t\twindow.addEventListener('darkModeChanged', (e) => {\n\t\t\t\t\t\t\tthis.darkMode = e.detail;\n\t\t\t\t\t\t});\n\t\t\t\t\t},\n\t\t\t\t\ttoggle() {\n\t\t\t\t\t\twindow.darkModeState.toggle();\n\t\t\t\t\t}\n\t\t\t\t}\n\t\t\t}\n\t\t</script><!-- Impersonation scripts --><script>\n\t\t\t// Impersonation state\n\t\t\twindow.impersonationState = {\n\t\t\t\tisImpersonating: false,\n\t\t\t\timpersonatedUsername: '',\n\t\t\t\tcanImpersonate: false,\n\t\t\t\tisAdmin: false,\n\t\t\t\tinitialized: false,\n\n\t\t\t\tasync init() {\n\t\t\t\t\ttry {\n\t\t\t\t\t\tconst response = await fetch('/api/impersonate/status');\n\t\t\t\t\t\tif (response.ok) {\n\t\t\t\t\t\t\tconst data = await response.json();\n\t\t\t\t\t\t\tthis.isImpersonating = data.is_impersonating || false;\n\t\t\t\t\t\t\tthis.impersonatedUsername = data.impersonated_username || '';\n\t\t\t\t\t\t\tthis.canImpersonate = data.can_impersonate || false;\n\t\t\t\t\t\t\tthis.isAdmin = data.is_admin || false;\n\t\t\t\t\t\t\tthis.updateUI();\n\t\t\t\t\t\t}\n\t\t\t\t\t} catch (error) {\n\t\t\t\t\t\tconsole.error('Error checking impersonation status:', error);\n\t\t\t\t\t} finally {\n\t\t\t\t\t\tthis.initialized = true;\n\t\t\t\t\t\t// Dispatch event for other components waiting on this\n\t\t\t\t\t\twindow.dispatchEvent(new CustomEvent('impersonationStateReady'));\n\t\t\t\t\t}\n\t\t\t\t},\n\n\t\t\t\tupdateUI() {\n\t\t\t\t\tconst banner = document.getElementById('impersonation-banner');\n\t\t\t\t\tconst spacer = document.getElementById('impersonation-spacer');\n\t\t\t\t\tconst usernameEl = document.getElementById('impersonated-username');\n\n\t\t\t\t\tif (this.isImpersonating && banner && spacer && usernameEl) {\n\t\t\t\t\t\tusernameEl.textContent = this.impersonatedUsername;\n\t\t\t\t\t\tbanner.style.display = 'flex';\n\t\t\t\t\t\tspacer.style.display = 'block';\n\t\t\t\t\t}\n\t\t\t\t}\n\t\t\t};\n\n\t\t\t// Initialize on page load\n\t\t\tdocument.addEventListener('DOMContentLoaded', function() {\n\t\t\t\twindow.impersonationState.init();\n\t\t\t});\n\n\t\t\t// Global function to stop impersonation\n\t\t\tasync function stopImpersonation() {\n\t\t\t\ttry {\n\t\t\t\t\tconst response = await fetch('/api/impersonate/stop', {\n\t\t\t\t\t\tmethod: 'POST',\n\t\t\t\t\t\theaders: { 'Content-Type': 'application/json' }\n\t\t\t\t\t});\n\t\t\t\t\tif (response.ok) {\n\t\t\t\t\t\twindow.location.reload();\n\t\t\t\t\t} else {\n\t\t\t\t\t\tconst data = await response.json();\n\t\t\t\t\t\talert(data.error || 'Failed to stop impersonation');\n\t\t\t\t\t}\n\t\t\t\t} catch (error) {\n\t\t\t\t\tconsole.error('Error stopping impersonation:', error);\n\t\t\t\t\talert('Failed to stop impersonation');\n\t\t\t\t}\n\t\t\t}\n\n\t\t\t// Global function to start impersonation\n\t\t\tasync function startImpersonation(username) {\n\t\t\t\ttry {\n\t\t\t\t\tconst response = await fetch('/api/impersonate/start', {\n\t\t\t\t\t\tmethod: 'POST',\n\t\t\t\t\t\theaders: { 'Content-Type': 'application/json' },\n\t\t\t\t\t\tbody: JSON.stringify({ username: username })\n\t\t\t\t\t});\n\t\t\t\t\tif (response.ok) {\n\t\t\t\t\t\twindow.location.reload();\n\t\t\t\t\t} else {\n\t\t\t\t\t\tconst data = await response.json();\n\t\t\t\t\t\talert(data.error || 'Failed to start impersonation');\n\t\t\t\t\t}\n\t\t\t\t} catch (error) {\n\t\t\t\t\tconsole.error('Error starting impersonation:', error);\n\t\t\t\t\talert('Failed to start impersonation');\n\t\t\t\t}\n\t\t\t}\n\n\t\t\t// Alpine.js component for impersonation dropdown\n\t\t\tfunction impersonationDropdown() {\n\t\t\t\treturn {\n\t\t\t\t\tisOpen: false,\n\t\t\t\t\tloading: false,\n\t\t\t\t\tsearch: '',\n\t\t\t\t\tusers: [],\n\t\t\t\t\tfilteredUsers: [],\n\n\t\t\t\t\ttoggleDropdown() {\n\t\t\t\t\t\tthis.isOpen = !this.isOpen;\n\t\t\t\t\t\tif (this.isOpen && this.users.length === 0) {\n\t\t\t\t\t\t\tthis.loadUsers();\n\t\t\t\t\t\t}\n\t\t\t\t\t},\n\n\t\t\t\t\tasync loadUsers() {\n\t\t\t\t\t\tthis.loading = true;\n\t\t\t\t\t\ttry {\n\t\t\t\t\t\t\tconst response = await fetch('/api/impersonate/users');\n\t\t\t\t\t\t\tif (response.ok) {\n\t\t\t\t\t\t\t\tconst data = await response.json();\n\t\t\t\t\t\t\t\tthis.users = data.users || [];\n\t\t\t\t\t\t\t\tthis.filteredUsers = this.users;\n\t\t\t\t\t\t\t}\n\t\t\t\t\t\t} catch (error) {\n\t\t\t\t\t\t\tconsole.error('Error loading users:', error);\n\t\t\t\t\t\t} finally {\n\t\t\t\t\t\t\tthis.loading = false;\n\t\t\t\t\t\t}\n\t\t\t\t\t},\n\n\t\t\t\t\tfilterUsers() {\n\t\t\t\t\t\tconst searchLower = this.search.toLowerCase();\n\t\t\t\t\t\tthis.filteredUsers = this.users.filter(user =>\n\t\t\t\t\t\t\tuser.username.toLowerCase().includes(searchLower) ||\n\t\t\t\t\t\t\t(user.email && user.email.toLowerCase().includes(searchLower))\n\t\t\t\t\t\t);\n\t\t\t\t\t},\n\n\t\t\t\t\tstartImpersonation(username) {\n\t\t\t\t\t\tthis.isOpen = false;\n\t\t\t\t\t\twindow.startImpersonation(username);\n\t\t\t\t\t}\n\t\t\t\t}\n\t\t\t}\n\n\t\t\t// Alpine.js component for connected users dropdown (admin only)\n\t\t\tfunction connectedUsersDropdown() {\n\t\t\t\treturn {\n\t\t\t\t\topen: false,\n\t\t\t\t\tloading: false,\n\t\t\t\t\tusers: [],\n\t\t\t\t\tcount: 0,\n\t\t\t\t\tcanAdmin: false,\n\t\t\t\t\tpollInterval: null,\n\n\t\t\t\t\tasync init() {\n\t\t\t\t\t\t// Wait for impersonationState to be ready (reuse its data instead of fetching again)\n\t\t\t\t\t\tif (!window.impersonationState.initialized) {\n\t\t\t\t\t\t\tawait new Promise(resolve => {\n\t\t\t\t\t\t\t\twindow.addEventListener('impersonationStateReady', resolve, { once: true });\n\t\t\t\t\t\t\t});\n\t\t\t\t\t\t}\n\t\t\t\t\t\tthis.canAdmin = window.impersonationState.isAdmin;\n\t\t\t\t\t\tif (this.canAdmin) {\n\t\t\t\t\t\t\tawait this.refresh();\n\t\t\t\t\t\t\t// Poll every 30 seconds\n\t\t\t\t\t\t\tthis.pollInterval = setInterval(() => this.refresh(), 30000);\n\t\t\t\t\t\t}\n\t\t\t\t\t},\n\n\t\t\t\t\ttoggle() {\n\t\t\t\t\t\tthis.open = !this.open;\n\t\t\t\t\t\tif (this.open) {\n\t\t\t\t\t\t\tthis.refresh();\n\t\t\t\t\t\t}\n\t\t\t\t\t},\n\n\t\t\t\t\tasync refresh() {\n\t\t\t\t\t\tthis.loading = true;\n\t\t\t\t\t\ttry {\n\t\t\t\t\t\t\tconst response = await fetch('/api/admin/connected-users');\n\t\t\t\t\t\t\tif (response.ok) {\n\t\t\t\t\t\t\t\tconst data = await response.json();\n\t\t\t\t\t\t\t\tthis.users = data.users || [];\n\t\t\t\t\t\t\t\tthis.count = data.total_count || 0;\n\t\t\t\t\t\t\t}\n\t\t\t\t\t\t} catch (error) {\n\t\t\t\t\t\t\tconsole.error('Error loading connected users:', error);\n\t\t\t\t\t\t} finally {\n\t\t\t\t\t\t\tthis.loading = false;\n\t\t\t\t\t\t}\n\t\t\t\t\t},\n\n\t\t\t\t\tformatLastActivity(timestamp) {\n\t\t\t\t\t\tif (!timestamp) return '';\n\t\t\t\t\t\tconst date = new Date(timestamp);\n\t\t\t\t\t\tconst now = new Date();\n\t\t\t\t\t\tconst diffMs = now - date;\n\t\t\t\t\t\tconst diffMins = Math.floor(diffMs / 60000);\n\n\t\t\t\t\t\tif (diffMins < 1) return 'just now';\n\t\t\t\t\t\tif (diffMins < 60) return diffMins + 'm ago';\n\t\t\t\t\t\tconst diffHours = Math.floor(diffMins / 60);\n\t\t\t\t\t\tif (diffHours < 24) return diffHours + 'h ago';\n\t\t\t\t\t\tconst diffDays = Math.floor(diffHours / 24);\n\t\t\t\t\t\treturn diffDays + 'd ago';\n\t\t\t\t\t}\n\t\t\t\t}\n\t\t\t}\n\t\t</script><!-- Footer with timezone selector --><footer class=\"fixed bottom-0 left-0 right-0 bg-white dark:bg-dark-bg-secondary border-t border-gray-200 dark:border-dark-border px-4 py-2 flex items-center justify-between z-40\"><div class=\"flex items-center gap-4\">")
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
@@ -64,7 +64,7 @@ func NewDashboardContent() templ.Component {
 			templ_7745c5c3_Var2 = templ.NopComponent
 		}
 		ctx = templ.ClearChildren(ctx)
-		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 1, "<div class=\"min-h-screen bg-gray-50 dark:bg-dark-bg-primary\" x-data=\"newDashboard()\" @keydown.slash.window=\"focusSearch($event)\" @keydown.ctrl.f.window.prevent=\"focusSearch($event)\" @keydown.meta.f.window.prevent=\"focusSearch($event)\"><!-- Header --><header class=\"bg-white dark:bg-dark-bg-secondary shadow-sm border-b border-gray-200 dark:border-dark-border-subtle\"><div class=\"max-w-full mx-auto px-4 sm:px-6 lg:px-8\"><div class=\"flex items-center justify-between h-16\"><!-- Left: Logo & Title with Integrated Stats --><div class=\"flex items-center space-x-4 flex-1\"><div class=\"flex-shrink-0\"><div class=\"h-8 w-8 bg-gradient-to-r from-blue-500 to-purple-600 rounded-lg flex items-center justify-center\"><svg class=\"h-4 w-4 text-white\" fill=\"none\" viewBox=\"0 0 24 24\" stroke-width=\"1.5\" stroke=\"currentColor\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" d=\"M14.857 17.082a23.848 23.848 0 0 0 5.454-1.31A8.967 8.967 0 0 1 18 9.75V9A6 6 0 0 0 6 9v.75a8.967 8.967 0 0 1-2.312 6.022c1.733.64 3.56 1.085 5.455 1.31m5.714 0a24.255 24.255 0 0 1-5.714 0m5.714 0a3 3 0 1 1-5.714 0\"></path></svg></div></div><div class=\"flex items-center space-x-4\"><div><h1 class=\"text-lg font-semibold text-gray-900 dark:text-white\">Alert Dashboard</h1><p class=\"text-xs text-gray-500 dark:text-gray-400\" x-text=\"getStatusText()\"></p></div><!-- Divider --><div class=\"hidden lg:block h-8 w-px bg-gray-200 dark:bg-dark-border-subtle\"></div><!-- Integrated Stats Badges --><div class=\"hidden lg:flex items-center space-x-2\"><!-- Empty state when no alerts --><span x-show=\"Object.keys(metadata.counters.severityCounters || {}).length === 0 && metadata.totalAlerts === 0\" x-cloak class=\"text-xs text-gray-500 dark:text-gray-400 flex items-center\"><svg class=\"w-3.5 h-3.5 mr-1.5 text-green-500\" fill=\"none\" viewBox=\"0 0 24 24\" stroke-width=\"1.5\" stroke=\"currentColor\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" d=\"M9 12.75L11.25 15 15 9.75M21 12a9 9 0 11-18 0 9 9 0 0118 0z\"></path></svg> No active alerts</span><!-- Dynamic Severity Badges --><template x-for=\"[severity, count] in Object.entries(metadata.counters.severityCounters || {}).sort((a, b) => getSeverityPriority(b[0]) - getSeverityPriority(a[0]))\" :key=\"severity\"><span class=\"inline-flex items-center px-2 py-0.5 rounded-full text-xs font-medium transition-colors\" :class=\"getSeverityBadgeClasses(severity)\"><span class=\"w-1.5 h-1.5 rounded-full mr-1.5\" :class=\"getSeverityDotClasses(severity)\"></span> <span class=\"capitalize\" x-text=\"severity\"></span> <span class=\"ml-1 font-semibold\" x-text=\"count\"></span></span></template><!-- Total Badge (only show when there are alerts) --><span x-show=\"metadata.totalAlerts > 0\" x-cloak class=\"inline-flex items-center px-2 py-0.5 rounded-full text-xs font-medium bg-gray-100 text-gray-700 dark:bg-gray-700 dark:text-gray-300\"><span class=\"w-1.5 h-1.5 rounded-full mr-1.5 bg-gray-400\"></span> Total <span class=\"ml-1 font-semibold\" x-text=\"metadata.totalAlerts\"></span></span><!-- Secondary Stats Dropdown --><div class=\"relative\" x-data=\"{ statsOpen: false }\"><button @click=\"statsOpen = !statsOpen\" class=\"inline-flex items-center px-2 py-0.5 rounded-full text-xs font-medium bg-gray-50 text-gray-600 dark:bg-gray-800 dark:text-gray-400 hover:bg-gray-100 dark:hover:bg-gray-700 transition-colors\"><svg class=\"w-3 h-3 mr-1\" fill=\"none\" viewBox=\"0 0 24 24\" stroke-width=\"1.5\" stroke=\"currentColor\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" d=\"M6.75 12a.75.75 0 1 1-1.5 0 .75.75 0 0 1 1.5 0ZM12.75 12a.75.75 0 1 1-1.5 0 .75.75 0 0 1 1.5 0ZM18.75 12a.75.75 0 1 1-1.5 0 .75.75 0 0 1 1.5 0Z\"></path></svg> More</button><div x-show=\"statsOpen\" x-cloak @click.away=\"statsOpen = false\" x-transition class=\"absolute left-0 mt-2 w-48 bg-white dark:bg-dark-bg-secondary rounded-lg shadow-lg border border-gray-200 dark:border-dark-border-subtle z-50 py-2\"><div class=\"px-3 py-2 flex items-center justify-between text-sm\"><span class=\"text-gray-600 dark:text-gray-400\">Resolved</span> <span class=\"font-medium text-green-600 dark:text-green-400\" x-text=\"metadata.counters.resolved\"></span></div><div class=\"px-3 py-2 flex items-center justify-between text-sm\"><span class=\"text-gray-600 dark:text-gray-400\">Acknowledged</span> <span class=\"font-medium text-purple-600 dark:text-purple-400\" x-text=\"metadata.counters.acknowledged\"></span></div><div class=\"px-3 py-2 flex items-center justify-between text-sm\"><span class=\"text-gray-600 dark:text-gray-400\">With Comments</span> <span class=\"font-medium text-indigo-600 dark:text-indigo-400\" x-text=\"metadata.counters.withComments\"></span></div></div></div></div></div></div><!-- Center: Page Navigation --><div class=\"hidden md:flex items-center justify-center\">")
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 1, "<div class=\"min-h-screen bg-gray-50 dark:bg-dark-bg-primary\" x-data=\"newDashboard()\" @keydown.slash.window=\"focusSearch($event)\" @keydown.ctrl.f.window.prevent=\"focusSearch($event)\" @keydown.meta.f.window.prevent=\"focusSearch($event)\" @keydown.ctrl.k.window.prevent=\"openCommandPalette()\" @keydown.meta.k.window.prevent=\"openCommandPalette()\" @keydown.shift.r.window=\"onForceRefreshKey($event)\"><!-- Header --><header class=\"bg-white dark:bg-dark-bg-secondary shadow-sm border-b border-gray-200 dark:border-dark-border-subtle\"><div class=\"max-w-full mx-auto px-4 sm:px-6 lg:px-8\"><div class=\"flex items-center justify-between h-16\"><!-- Left: Logo & Title with Integrated Stats --><div class=\"flex items-center space-x-4 flex-1\"><div class=\"flex-shrink-0\"><div class=\"h-8 w-8 bg-gradient-to-r from-blue-500 to-purple-600 rounded-lg flex items-center justify-center\"><svg class=\"h-4 w-4 text-white\" fill=\"none\" viewBox=\"0 0 24 24\" stroke-width=\"1.5\" stroke=\"currentColor\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" d=\"M14.857 17.082a23.848 23.848 0 0 0 5.454-1.31A8.967 8.967 0 0 1 18 9.75V9A6 6 0 0 0 6 9v.75a8.967 8.967 0 0 1-2.312 6.022c1.733.64 3.56 1.085 5.455 1.31m5.714 0a24.255 24.255 0 0 1-5.714 0m5.714 0a3 3 0 1 1-5.714 0\"></path></svg></div></div><div class=\"flex items-center space-x-4\"><div><h1 class=\"text-lg font-semibold text-gray-900 dark:text-white\">Alert Dashboard</h1><p class=\"text-xs text-gray-500 dark:text-gray-400\" x-text=\"getStatusText()\"></p></div><!-- Divider --><div class=\"hidden lg:block h-8 w-px bg-gray-200 dark:bg-dark-border-subtle\"></div><!-- Integrated Stats Badges --><div class=\"hidden lg:flex items-center space-x-2\"><!-- Empty state when no alerts --><span x-show=\"Object.keys(metadata.counters.severityCounters || {}).length === 0 && metadata.totalAlerts === 0\" x-cloak class=\"text-xs text-gray-500 dark:text-gray-400 flex items-center\"><svg class=\"w-3.5 h-3.5 mr-1.5 text-green-500\" fill=\"none\" viewBox=\"0 0 24 24\" stroke-width=\"1.5\" stroke=\"currentColor\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" d=\"M9 12.75L11.25 15 15 9.75M21 12a9 9 0 11-18 0 9 9 0 0118 0z\"></path></svg> No active alerts</span><!-- Dynamic Severity Badges --><template x-for=\"[severity, count] in Object.entries(metadata.counters.severityCounters || {}).sort((a, b) => getSeverityPriority(b[0]) - getSeverityPriority(a[0]))\" :key=\"severity\"><span class=\"inline-flex items-center px-2 py-0.5 rounded-full text-xs font-medium transition-colors\" :class=\"getSeverityBadgeClasses(severity)\" :style=\"getSeverityBadgeStyle(severity)\"><span class=\"w-1.5 h-1.5 rounded-full mr-1.5\" :class=\"getSeverityDotClasses(severity)\" :style=\"getSeverityDotStyle(severity)\"></span> <span class=\"capitalize\" x-text=\"severity\"></span> <span class=\"ml-1 font-semibold\" x-text=\"count\"></span></span></template><!-- Total Badge (only show when there are alerts) --><span x-show=\"metadata.totalAlerts > 0\" x-cloak class=\"inline-flex items-center px-2 py-0.5 rounded-full text-xs font-medium bg-gray-100 text-gray-700 dark:bg-gray-700 dark:text-gray-300\"><span class=\"w-1.5 h-1.5 rounded-full mr-1.5 bg-gray-400\"></span> Total <span class=\"ml-1 font-semibold\" x-text=\"metadata.totalAlerts\"></span></span><!-- Secondary Stats Dropdown --><div class=\"relative\" x-data=\"{ statsOpen: false }\"><button @click=\"statsOpen = !statsOpen\" class=\"inline-flex items-center px-2 py-0.5 rounded-full text-xs font-medium bg-gray-50 text-gray-600 dark:bg-gray-800 dark:text-gray-400 hover:bg-gray-100 dark:hover:bg-gray-700 transition-colors\"><svg class=\"w-3 h-3 mr-1\" fill=\"none\" viewBox=\"0 0 24 24\" stroke-width=\"1.5\" stroke=\"currentColor\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" d=\"M6.75 12a.75.75 0 1 1-1.5 0 .75.75 0 0 1 1.5 0ZM12.75 12a.75.75 0 1 1-1.5 0 .75.75 0 0 1 1.5 0ZM18.75 12a.75.75 0 1 1-1.5 0 .75.75 0 0 1 1.5 0Z\"></path></svg> More</button><div x-show=\"statsOpen\" x-cloak @click.away=\"statsOpen = false\" x-transition class=\"absolute left-0 mt-2 w-48 bg-white dark:bg-dark-bg-secondary rounded-lg shadow-lg border border-gray-200 dark:border-dark-border-subtle z-50 py-2\"><div class=\"px-3 py-2 flex items-center justify-between text-sm\"><span class=\"text-gray-600 dark:text-gray-400\">Resolved</span> <span class=\"font-medium text-green-600 dark:text-green-400\" x-text=\"metadata.counters.resolved\"></span></div><div class=\"px-3 py-2 flex items-center justify-between text-sm\"><span class=\"text-gray-600 dark:text-gray-400\">Acknowledged</span> <span class=\"font-medium text-purple-600 dark:text-purple-400\" x-text=\"metadata.counters.acknowledged\"></span></div><div class=\"px-3 py-2 flex items-center justify-between text-sm\"><span class=\"text-gray-600 dark:text-gray-400\">With Comments</span> <span class=\"font-medium text-indigo-600 dark:text-indigo-400\" x-text=\"metadata.counters.withComments\"></span></div></div></div></div></div></div><!-- Center: Page Navigation --><div class=\"hidden md:flex items-center justify-center\">")
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
@@ -72,7 +72,7 @@ func NewDashboardContent() templ.Component {
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
-		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 2, "</div><!-- Right: Controls --><div class=\"flex items-center space-x-4 flex-1 justify-end\"><!-- Display Mode Selector --><div class=\"hidden md:flex items-center space-x-1 bg-gray-100 dark:bg-dark-bg-tertiary rounded-lg p-1\"><button @click=\"setDisplayMode('classic')\" :class=\"displayMode === 'classic' ? 'bg-white dark:bg-dark-bg-secondary shadow text-gray-900 dark:text-white' : 'text-gray-700 dark:text-gray-300 hover:text-gray-900 dark:hover:text-white'\" class=\"px-3 py-1 text-sm font-medium rounded-md transition-colors\">Classic</button> <button @click=\"setDisplayMode('resolved')\" :class=\"displayMode === 'resolved' ? 'bg-white dark:bg-dark-bg-secondary shadow text-gray-900 dark:text-white' : 'text-gray-700 dark:text-gray-300 hover:text-gray-900 dark:hover:text-white'\" class=\"px-3 py-1 text-sm font-medium rounded-md transition-colors\">Resolved</button> <button @click=\"setDisplayMode('acknowledge')\" :class=\"displayMode === 'acknowledge' ? 'bg-white dark:bg-dark-bg-secondary shadow text-gray-900 dark:text-white' : 'text-gray-700 dark:text-gray-300 hover:text-gray-900 dark:hover:text-white'\" class=\"px-3 py-1 text-sm font-medium rounded-md transition-colors\">Acknowledged</button> <button @click=\"setDisplayMode('hidden')\" :class=\"displayMode === 'hidden' ? 'bg-white dark:bg-dark-bg-secondary shadow text-gray-900 dark:text-white' : 'text-gray-700 dark:text-gray-300 hover:text-gray-900 dark:hover:text-white'\" class=\"px-3 py-1 text-sm font-medium rounded-md transition-colors\">Hidden</button></div><!-- View Mode Toggle --><div class=\"flex items-center space-x-1 bg-gray-100 dark:bg-dark-bg-tertiary rounded-lg p-1\"><button @click=\"setViewMode('list')\" :class=\"viewMode === 'list' ? 'bg-white dark:bg-dark-bg-secondary shadow text-gray-900 dark:text-white' : 'text-gray-600 dark:text-gray-400 hover:text-gray-900 dark:hover:text-white'\" class=\"p-2 rounded-md transition-colors\"><svg class=\"h-4 w-4\" fill=\"none\" viewBox=\"0 0 24 24\" stroke-width=\"1.5\" stroke=\"currentColor\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" d=\"M8.25 6.75h12M8.25 12h12m-12 5.25h12M3.75 6.75h.007v.008H3.75V6.75Zm.375 0a.375.375 0 1 1-.75 0 .375.375 0 0 1 .75 0ZM3.75 12h.007v.008H3.75V12Zm.375 0a.375.375 0 1 1-.75 0 .375.375 0 0 1 .75 0Zm-.375 5.25h.007v.008H3.75v-.008Zm.375 0a.375.375 0 1 1-.75 0 .375.375 0 0 1 .75 0Z\"></path></svg></button> <button @click=\"setViewMode('group')\" :class=\"viewMode === 'group' ? 'bg-white dark:bg-dark-bg-secondary shadow text-gray-900 dark:text-white' : 'text-gray-600 dark:text-gray-400 hover:text-gray-900 dark:hover:text-white'\" class=\"p-2 rounded-md transition-colors\"><svg class=\"h-4 w-4\" fill=\"none\" viewBox=\"0 0 24 24\" stroke-width=\"1.5\" stroke=\"currentColor\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" d=\"m2.25 12 8.954-8.955c.44-.439 1.152-.439 1.591 0L21.75 12M4.5 9.75v10.125c0 .621.504 1.125 1.125 1.125H9.75v-4.875c0-.621.504-1.125 1.125-1.125h2.25c.621 0 1.125.504 1.125 1.125V21h4.125c.621 0 1.125-.504 1.125-1.125V9.75M8.25 21h8.25\"></path></svg></button></div><!-- Group By Selector (only shown in group view) --><div x-show=\"viewMode === 'group'\" x-cloak class=\"flex items-center space-x-2\"><span class=\"text-sm text-gray-600 dark:text-gray-400\">Group by:</span> <select x-model=\"groupByLabel\" @change=\"setGroupByLabel($event.target.value)\" class=\"rounded-md border border-gray-300 dark:border-dark-border-DEFAULT bg-white dark:bg-dark-bg-tertiary text-sm text-gray-900 dark:text-white focus:outline-none focus:ring-2 focus:ring-blue-500 px-3 py-1.5\"><option value=\"alertname\">Alert Name</option> <option value=\"severity\">Severity</option> <option value=\"team\">Team</option> <option value=\"namespace\">Namespace</option> <option value=\"service\">Service</option> <option value=\"instance\">Instance</option> <option value=\"job\">Job</option><template x-for=\"label in metadata.availableGroupLabels\" :key=\"label\"><option :value=\"label\" x-text=\"label\"></option></template></select></div><!-- Impersonation Dropdown (only visible to admins) --><div x-data=\"{ ...impersonationDropdown(), canImpersonate: false }\" x-init=\"if (window.impersonationState?.initialized) { canImpersonate = window.impersonationState.canImpersonate } else { window.addEventListener('impersonationStateReady', () => { canImpersonate = window.impersonationState.canImpersonate }, { once: true }) }\" x-show=\"canImpersonate\" x-cloak class=\"relative\"><button @click=\"toggleDropdown()\" class=\"flex items-center gap-1 p-2 text-gray-600 dark:text-gray-300 hover:bg-gray-100 dark:hover:bg-dark-bg-tertiary rounded-md transition-colors\" title=\"Impersonate User\"><svg xmlns=\"http://www.w3.org/2000/svg\" class=\"h-5 w-5\" viewBox=\"0 0 20 20\" fill=\"currentColor\"><path d=\"M13 6a3 3 0 11-6 0 3 3 0 016 0zM18 8a2 2 0 11-4 0 2 2 0 014 0zM14 15a4 4 0 00-8 0v3h8v-3zM6 8a2 2 0 11-4 0 2 2 0 014 0zM16 18v-3a5.972 5.972 0 00-.75-2.906A3.005 3.005 0 0119 15v3h-3zM4.75 12.094A5.973 5.973 0 004 15v3H1v-3a3 3 0 013.75-2.906z\"></path></svg></button><!-- Dropdown panel --><div x-show=\"isOpen\" x-cloak @click.outside=\"isOpen = false\" x-transition class=\"absolute right-0 mt-2 w-72 bg-white dark:bg-dark-bg-secondary rounded-lg shadow-lg border border-gray-200 dark:border-dark-border-subtle z-50\"><div class=\"p-3 border-b border-gray-200 dark:border-dark-border-subtle\"><input type=\"text\" id=\"impersonate-search\" name=\"impersonate-search\" x-model=\"search\" @input=\"filterUsers()\" placeholder=\"Search users...\" class=\"w-full px-3 py-2 text-sm border border-gray-300 dark:border-dark-border-DEFAULT rounded bg-white dark:bg-dark-bg-tertiary text-gray-900 dark:text-white placeholder-gray-500 focus:outline-none focus:ring-2 focus:ring-amber-500\"></div><div class=\"max-h-64 overflow-y-auto\"><template x-if=\"loading\"><div class=\"px-3 py-4 text-center text-gray-500 dark:text-gray-400\">Loading users...</div></template><template x-if=\"!loading && filteredUsers.length === 0\"><div class=\"px-3 py-4 text-center text-gray-500 dark:text-gray-400\">No users found</div></template><template x-for=\"user in filteredUsers\" :key=\"user.id\"><button @click=\"startImpersonation(user.username)\" class=\"w-full px-3 py-2 text-left text-sm hover:bg-gray-100 dark:hover:bg-dark-bg-tertiary flex flex-col\"><span class=\"font-medium text-gray-900 dark:text-white\" x-text=\"user.username\"></span> <span class=\"text-gray-500 dark:text-gray-400 text-xs\" x-text=\"user.email\"></span></button></template></div></div></div><!-- Connected Users Dropdown (only visible to admins) --><div x-data=\"connectedUsersDropdown()\" x-init=\"init()\" x-show=\"canAdmin\" x-cloak class=\"relative\"><button @click=\"toggle()\" class=\"flex items-center gap-1 p-2 text-gray-600 dark:text-gray-300 hover:bg-gray-100 dark:hover:bg-dark-bg-tertiary rounded-md transition-colors relative\" title=\"Connected Users\"><svg xmlns=\"http://www.w3.org/2000/svg\" class=\"h-5 w-5\" viewBox=\"0 0 20 20\" fill=\"currentColor\"><path d=\"M9 6a3 3 0 11-6 0 3 3 0 016 0zM17 6a3 3 0 11-6 0 3 3 0 016 0zM12.93 17c.046-.327.07-.66.07-1a6.97 6.97 0 00-1.5-4.33A5 5 0 0119 16v1h-6.07zM6 11a5 5 0 015 5v1H1v-1a5 5 0 015-5z\"></path></svg> <span x-show=\"count > 0\" x-cloak class=\"absolute -top-1 -right-1 min-w-[18px] h-[18px] flex items-center justify-center text-xs font-bold text-white bg-green-500 rounded-full px-1\" x-text=\"count\"></span></button><!-- Dropdown panel --><div x-show=\"open\" x-cloak @click.outside=\"open = false\" x-transition class=\"absolute right-0 mt-2 w-80 bg-white dark:bg-dark-bg-secondary rounded-lg shadow-lg border border-gray-200 dark:border-dark-border-subtle z-50\"><div class=\"p-3 border-b border-gray-200 dark:border-dark-border-subtle flex items-center justify-between\"><span class=\"text-sm font-medium text-gray-700 dark:text-gray-200\">Connected Users</span> <span class=\"text-xs text-gray-500 dark:text-gray-400\" x-text=\"count + ' online'\"></span></div><div class=\"max-h-64 overflow-y-auto\"><template x-if=\"loading\"><div class=\"px-3 py-4 text-center text-gray-500 dark:text-gray-400\">Loading...</div></template><template x-if=\"!loading && users.length === 0\"><div class=\"px-3 py-4 text-center text-gray-500 dark:text-gray-400\">No users connected</div></template><template x-for=\"user in users\" :key=\"user.user_id\"><div class=\"px-3 py-2 border-b border-gray-100 dark:border-dark-border-subtle last:border-b-0\"><div class=\"flex items-center gap-2\"><span class=\"w-2 h-2 bg-green-500 rounded-full flex-shrink-0\"></span><div class=\"flex-1 min-w-0\"><div class=\"flex items-center justify-between\"><span class=\"font-medium text-sm text-gray-900 dark:text-white truncate\" x-text=\"user.username\"></span> <span class=\"text-xs text-gray-500 dark:text-gray-400\" x-text=\"user.session_count + ' session' + (user.session_count > 1 ? 's' : '')\"></span></div><div class=\"flex items-center justify-between text-xs text-gray-500 dark:text-gray-400\"><span class=\"truncate\" x-text=\"user.email\"></span> <span x-text=\"formatLastActivity(user.last_activity)\"></span></div></div></div></div></template></div></div></div><!-- Settings Button --><button @click=\"openSettings()\" class=\"p-2 rounded-md hover:bg-gray-100 dark:hover:bg-dark-bg-tertiary transition-colors text-gray-600 dark:text-gray-300\"><svg class=\"h-5 w-5\" viewBox=\"0 0 24 24\" stroke-width=\"1.5\" stroke=\"currentColor\" fill=\"none\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" d=\"M9.594 3.94c.09-.542.56-.94 1.11-.94h2.593c.55 0 1.02.398 1.11.94l.213 1.281c.063.374.313.686.645.87.074.04.147.083.22.127.325.196.72.257 1.075.124l1.217-.456a1.125 1.125 0 0 1 1.37.49l1.296 2.247a1.125 1.125 0 0 1-.26 1.431l-1.003.827c-.293.241-.438.613-.43.992a6.759 6.759 0 0 1 0 .255c-.008.378.137.75.43.991l1.004.827c.424.35.534.955.26 1.43l-1.298 2.247a1.125 1.125 0 0 1-1.369.491l-1.217-.456c-.355-.133-.75-.072-1.076.124a6.57 6.57 0 0 1-.22.128c-.331.183-.581.495-.644.869l-.213 1.281c-.09.543-.56.94-1.11.94h-2.594c-.55 0-1.019-.398-1.11-.94l-.213-1.281c-.062-.374-.312-.686-.644-.87a6.52 6.52 0 0 1-.22-.127c-.325-.196-.72-.257-1.076-.124l-1.217.456a1.125 1.125 0 0 1-1.369-.49l-1.297-2.247a1.125 1.125 0 0 1 .26-1.431l1.004-.827c.292-.24.437-.613.43-.991a6.932 6.932 0 0 1 0-.255c.007-.38-.138-.751-.43-.992l-1.004-.827a1.125 1.125 0 0 1-.26-1.43l1.297-2.247a1.125 1.125 0 0 1 1.37-.491l1.216.456c.356.133.751.072 1.076-.124.072-.044.146-.086.22-.128.332-.183.582-.495.644-.869l.214-1.28Z\"></path> <path stroke-linecap=\"round\" stroke-linejoin=\"round\" d=\"M15 12a3 3 0 1 1-6 0 3 3 0 0 1 6 0Z\"></path></svg></button><!-- User Menu --><div class=\"relative\" x-data=\"{ open: false }\"><button @click=\"open = !open\" class=\"flex items-center space-x-2 p-2 rounded-lg hover:bg-gray-100 dark:hover:bg-dark-bg-tertiary transition-all duration-200 ease-in-out group\"><div class=\"h-8 w-8 bg-gradient-to-br from-blue-500 to-purple-600 rounded-full flex items-center justify-center shadow-lg group-hover:scale-105 group-hover:shadow-xl transition-all duration-200 ease-in-out\"><span class=\"text-sm font-semibold tracking-wide text-white\" x-text=\"getUserInitials()\">U</span></div><svg class=\"h-4 w-4 text-gray-400 group-hover:text-gray-500 dark:group-hover:text-gray-300 transition-colors duration-200\" viewBox=\"0 0 24 24\" stroke-width=\"1.5\" stroke=\"currentColor\" fill=\"none\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" d=\"m19.5 8.25-7.5 7.5-7.5-7.5\"></path></svg></button><div x-show=\"open\" x-cloak @click.away=\"open = false\" x-transition class=\"absolute right-0 mt-2 w-48 bg-white dark:bg-dark-bg-secondary rounded-md shadow-lg py-1 z-50\"><a href=\"/profile\" class=\"block px-4 py-2 text-sm text-gray-700 dark:text-gray-200 hover:bg-gray-100 dark:hover:bg-dark-bg-tertiary\">Profile</a> <a href=\"/statistics\" class=\"block px-4 py-2 text-sm text-gray-700 dark:text-gray-200 hover:bg-gray-100 dark:hover:bg-dark-bg-tertiary\">Statistics</a> <a href=\"#\" hx-post=\"/api/v1/auth/logout\" hx-trigger=\"click\" hx-on::after-request=\"handleLogoutResponse(event)\" class=\"block px-4 py-2 text-sm text-gray-700 dark:text-gray-200 hover:bg-gray-100 dark:hover:bg-dark-bg-tertiary cursor-pointer\">Logout</a></div></div></div></div></div></header><!-- Notification Enable Banner --><div x-show=\"shouldShowNotificationBanner()\" x-cloak x-transition class=\"bg-blue-50 dark:bg-blue-900/20 border-b border-blue-200 dark:border-blue-800\"><div class=\"max-w-full mx-auto px-4 sm:px-6 lg:px-8 py-3\"><div class=\"flex items-center justify-between\"><div class=\"flex items-center space-x-3\"><svg class=\"h-5 w-5 text-blue-600 dark:text-blue-400 flex-shrink-0\" fill=\"none\" viewBox=\"0 0 24 24\" stroke=\"currentColor\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M15 17h5l-1.405-1.405A2.032 2.032 0 0118 14.158V11a6.002 6.002 0 00-4-5.659V5a2 2 0 10-4 0v.341C7.67 6.165 6 8.388 6 11v3.159c0 .538-.214 1.055-.595 1.436L4 17h5m6 0v1a3 3 0 11-6 0v-1m6 0H9\"></path></svg><div><p class=\"text-sm font-medium text-blue-900 dark:text-blue-100\">Enable browser notifications to get real-time alerts</p><p class=\"text-xs text-blue-700 dark:text-blue-300\">You'll be notified when new critical or warning alerts appear</p></div></div><div class=\"flex items-center space-x-2\"><button @click=\"enableNotifications()\" class=\"px-4 py-2 bg-blue-600 hover:bg-blue-700 text-white text-sm font-medium rounded-lg transition-colors\">Enable Notifications</button> <button @click=\"dismissNotificationBanner()\" aria-label=\"Dismiss notification prompt\" class=\"p-2 text-blue-600 dark:text-blue-400 hover:bg-blue-100 dark:hover:bg-blue-900/40 rounded-lg transition-colors\"><svg class=\"w-4 h-4\" fill=\"none\" viewBox=\"0 0 24 24\" stroke=\"currentColor\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M6 18L18 6M6 6l12 12\"></path></svg></button></div></div></div></div><!-- Main Content --><main class=\"max-w-full mx-auto px-4 sm:px-6 lg:px-8 py-6\"><!-- Filters & Search --><div class=\"bg-white dark:bg-dark-bg-secondary shadow rounded-lg mb-6\"><div class=\"px-6 py-4 border-b border-gray-200 dark:border-dark-border-subtle\"><div class=\"flex flex-col lg:flex-row lg:items-center lg:justify-between space-y-4 lg:space-y-0\"><!-- Search --><div class=\"flex-1 max-w-lg\"><div class=\"relative\"><div class=\"absolute inset-y-0 left-0 pl-3 flex items-center pointer-events-none\"><!-- Search icon (shown when not loading) --><svg x-show=\"!isSearching\" class=\"h-5 w-5 text-gray-400\" viewBox=\"0 0 24 24\" stroke-width=\"1.5\" stroke=\"currentColor\" fill=\"none\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" d=\"m21 21-5.197-5.197m0 0A7.5 7.5 0 1 0 5.196 5.196a7.5 7.5 0 0 0 10.607 10.607Z\"></path></svg><!-- Loading spinner (shown when searching) --><svg x-show=\"isSearching\" x-cloak class=\"h-5 w-5 text-blue-500 animate-spin\" xmlns=\"http://www.w3.org/2000/svg\" fill=\"none\" viewBox=\"0 0 24 24\"><circle class=\"opacity-25\" cx=\"12\" cy=\"12\" r=\"10\" stroke=\"currentColor\" stroke-width=\"4\"></circle> <path class=\"opacity-75\" fill=\"currentColor\" d=\"M4 12a8 8 0 018-8V0C5.373 0 0 5.373 0 12h4zm2 5.291A7.962 7.962 0 014 12H0c0 3.042 1.135 5.824 3 7.938l3-2.647z\"></path></svg></div><input x-model=\"searchQuery\" @input.debounce.300ms=\"isSearching = true; applyFilters().finally(() => { isSearching = false; })\" @keydown.enter=\"isSearching = true; applyFilters().finally(() => { isSearching = false; })\" id=\"dashboard-search\" name=\"dashboard-search\" type=\"text\" placeholder=\"Search alerts, instances, summaries...\" class=\"block w-full pl-10 pr-3 py-2 border border-gray-300 dark:border-dark-border-DEFAULT rounded-md leading-5 bg-white dark:bg-dark-bg-tertiary text-gray-900 dark:text-white placeholder-gray-500 dark:placeholder-gray-400 focus:outline-none focus:placeholder-gray-400 focus:ring-1 focus:ring-blue-500 focus:border-blue-500\" :class=\"{ 'ring-2 ring-blue-500': isSearching }\"></div></div><!-- Filter Controls --><div class=\"flex flex-wrap items-center gap-3\"><!-- Team Filter --><div class=\"relative\" x-data=\"{ open: false, searchTerm: '' }\"><button @click=\"open = !open\" type=\"button\" class=\"inline-flex items-center px-4 py-2 border border-gray-300 dark:border-dark-border-DEFAULT rounded-md shadow-sm bg-white dark:bg-dark-bg-tertiary text-sm font-medium text-gray-700 dark:text-gray-200 hover:bg-gray-50 dark:hover:bg-dark-bg-secondary focus:outline-none focus:ring-2 focus:ring-offset-2 focus:ring-blue-500 relative\" :class=\"{ 'ring-2 ring-blue-500 ring-opacity-50': filters.teams.length > 0 }\"><span>Team</span> <span x-show=\"filters.teams.length > 0\" class=\"ml-2 inline-flex items-center px-2 py-0.5 rounded-full text-xs font-medium bg-blue-100 text-blue-800 dark:bg-blue-900 dark:text-blue-200\" x-text=\"filters.teams.length\"></span> <svg class=\"ml-2 -mr-1 h-4 w-4\" viewBox=\"0 0 24 24\" stroke-width=\"1.5\" stroke=\"currentColor\" fill=\"none\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" d=\"m19.5 8.25-7.5 7.5-7.5-7.5\"></path></svg></button><div x-show=\"open\" x-cloak @click.away=\"open = false; searchTerm = ''\" x-transition class=\"absolute right-0 mt-2 w-72 rounded-md shadow-lg bg-white dark:bg-dark-bg-secondary ring-1 ring-black ring-opacity-5 z-50\"><div class=\"py-2\"><div class=\"px-4 py-2 border-b border-gray-200 dark:border-dark-border-DEFAULT\"><div class=\"flex items-center justify-between mb-2\"><span class=\"text-sm font-medium text-gray-700 dark:text-gray-200\">Teams</span> <button x-text=\"`Clear (${filters.teams.length})`\" @click=\"clearFilter('teams'); searchTerm = ''\" class=\"text-xs text-blue-600 dark:text-blue-400 hover:text-blue-500\" x-show=\"filters.teams.length > 0\"></button></div><!-- Search input for teams --><div class=\"relative\"><input type=\"text\" id=\"filter-team-search\" name=\"filter-team-search\" x-model=\"searchTerm\" placeholder=\"Search teams...\" class=\"w-full px-3 py-1.5 text-sm border border-gray-300 dark:border-dark-border-DEFAULT rounded-md bg-white dark:bg-dark-bg-tertiary text-gray-900 dark:text-white placeholder-gray-500 dark:placeholder-gray-400 focus:outline-none focus:ring-1 focus:ring-blue-500 focus:border-blue-500\"> <svg class=\"absolute right-2 top-1/2 -translate-y-1/2 h-4 w-4 text-gray-400\" viewBox=\"0 0 24 24\" stroke-width=\"1.5\" stroke=\"currentColor\" fill=\"none\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" d=\"m21 21-5.197-5.197m0 0A7.5 7.5 0 1 0 5.196 5.196a7.5 7.5 0 0 0 10.607 10.607Z\"></path></svg></div></div><div class=\"max-h-48 overflow-y-auto\"><template x-for=\"option in metadata.availableFilters.teams.filter(item => searchTerm === '' || item.toLowerCase().includes(searchTerm.toLowerCase()))\" :key=\"option\"><label :for=\"'filter-team-' + option\" class=\"flex items-center px-4 py-2 text-sm text-gray-700 dark:text-gray-200 hover:bg-gray-100 dark:hover:bg-dark-bg-tertiary cursor-pointer\"><input type=\"checkbox\" x-model=\"filters.teams\" :value=\"option\" @change=\"applyFilters()\" :id=\"'filter-team-' + option\" :name=\"'filter-team-' + option\" class=\"h-4 w-4 text-blue-600 focus:ring-blue-500 border-gray-300 rounded mr-3\"> <span x-text=\"option\" class=\"truncate\"></span></label></template><div x-show=\"metadata.availableFilters.teams.filter(item => searchTerm === '' || item.toLowerCase().includes(searchTerm.toLowerCase())).length === 0\" class=\"px-4 py-2 text-sm text-gray-500 dark:text-gray-400\"><span x-show=\"searchTerm === ''\">No teams available</span> <span x-show=\"searchTerm !== ''\">No matching teams</span></div></div></div></div></div><!-- Severity Filter --><div class=\"relative\" x-data=\"{ open: false, searchTerm: '' }\"><button @click=\"open = !open\" type=\"button\" class=\"inline-flex items-center px-4 py-2 border border-gray-300 dark:border-dark-border-DEFAULT rounded-md shadow-sm bg-white dark:bg-dark-bg-tertiary text-sm font-medium text-gray-700 dark:text-gray-200 hover:bg-gray-50 dark:hover:bg-dark-bg-secondary focus:outline-none focus:ring-2 focus:ring-offset-2 focus:ring-blue-500 relative\" :class=\"{ 'ring-2 ring-blue-500 ring-opacity-50': filters.severities.length > 0 }\"><span>Severity</span> <span x-show=\"filters.severities.length > 0\" class=\"ml-2 inline-flex items-center px-2 py-0.5 rounded-full text-xs font-medium bg-blue-100 text-blue-800 dark:bg-blue-900 dark:text-blue-200\" x-text=\"filters.severities.length\"></span> <svg class=\"ml-2 -mr-1 h-4 w-4\" viewBox=\"0 0 24 24\" stroke-width=\"1.5\" stroke=\"currentColor\" fill=\"none\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" d=\"m19.5 8.25-7.5 7.5-7.5-7.5\"></path></svg></button><div x-show=\"open\" x-cloak @click.away=\"open = false; searchTerm = ''\" x-transition class=\"absolute right-0 mt-2 w-72 rounded-md shadow-lg bg-white dark:bg-dark-bg-secondary ring-1 ring-black ring-opacity-5 z-50\"><div class=\"py-2\"><div class=\"px-4 py-2 border-b border-gray-200 dark:border-dark-border-DEFAULT\"><div class=\"flex items-center justify-between mb-2\"><span class=\"text-sm font-medium text-gray-700 dark:text-gray-200\">Severity Levels</span> <button x-text=\"`Clear (${filters.severities.length})`\" @click=\"clearFilter('severities'); searchTerm = ''\" class=\"text-xs text-blue-600 dark:text-blue-400 hover:text-blue-500\" x-show=\"filters.severities.length > 0\"></button></div><!-- Search input for severities --><div class=\"relative\"><input type=\"text\" id=\"filter-severity-search\" name=\"filter-severity-search\" x-model=\"searchTerm\" placeholder=\"Search severities...\" class=\"w-full px-3 py-1.5 text-sm border border-gray-300 dark:border-dark-border-DEFAULT rounded-md bg-white dark:bg-dark-bg-tertiary text-gray-900 dark:text-white placeholder-gray-500 dark:placeholder-gray-400 focus:outline-none focus:ring-1 focus:ring-blue-500 focus:border-blue-500\"> <svg class=\"absolute right-2 top-1/2 -translate-y-1/2 h-4 w-4 text-gray-400\" viewBox=\"0 0 24 24\" stroke-width=\"1.5\" stroke=\"currentColor\" fill=\"none\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" d=\"m21 21-5.197-5.197m0 0A7.5 7.5 0 1 0 5.196 5.196a7.5 7.5 0 0 0 10.607 10.607Z\"></path></svg></div></div><div class=\"max-h-48 overflow-y-auto\"><template x-for=\"option in metadata.availableFilters.severities.filter(item => searchTerm === '' || item.toLowerCase().includes(searchTerm.toLowerCase()))\" x-key=\"option\"><label :for=\"'filter-severity-' + option\" class=\"flex items-center px-4 py-2 text-sm text-gray-700 dark:text-gray-200 hover:bg-gray-100 dark:hover:bg-dark-bg-tertiary cursor-pointer\"><input type=\"checkbox\" :id=\"'filter-severity-' + option\" :name=\"'filter-severity-' + option\" x-model=\"filters.severities\" :value=\"option\" @change=\"applyFilters()\" class=\"h-4 w-4 text-blue-600 focus:ring-blue-500 border-gray-300 rounded mr-3\"> <span class=\"flex items-center\"><div class=\"w-2 h-2 rounded-full mr-2\" :class=\"{\n\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t 'bg-red-500': option === 'critical',\n\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t 'bg-yellow-500': option === 'warning',\n\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t 'bg-blue-500': option === 'info'\n\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t }\"></div><span x-text=\"option\"></span></span></label></template><div x-show=\"metadata.availableFilters.severities.filter(item => searchTerm === '' || item.toLowerCase().includes(searchTerm.toLowerCase())).length === 0\" class=\"px-4 py-2 text-sm text-gray-500 dark:text-gray-400\"><span x-show=\"searchTerm === ''\">No severities available</span> <span x-show=\"searchTerm !== ''\">No matching severities</span></div></div></div></div></div><!-- Alert Name Filter --><div class=\"relative\" x-data=\"{ open: false, searchTerm: '' }\"><button @click=\"open = !open\" type=\"button\" class=\"inline-flex items-center px-4 py-2 border border-gray-300 dark:border-dark-border-DEFAULT rounded-md shadow-sm bg-white dark:bg-dark-bg-tertiary text-sm font-medium text-gray-700 dark:text-gray-200 hover:bg-gray-50 dark:hover:bg-dark-bg-secondary focus:outline-none focus:ring-2 focus:ring-offset-2 focus:ring-blue-500 relative\" :class=\"{ 'ring-2 ring-blue-500 ring-opacity-50': filters.alertNames.length > 0 }\"><span>Alert Name</span> <span x-show=\"filters.alertNames.length > 0\" class=\"ml-2 inline-flex items-center px-2 py-0.5 rounded-full text-xs font-medium bg-blue-100 text-blue-800 dark:bg-blue-900 dark:text-blue-200\" x-text=\"filters.alertNames.length\"></span> <svg class=\"ml-2 -mr-1 h-4 w-4\" viewBox=\"0 0 24 24\" stroke-width=\"1.5\" stroke=\"currentColor\" fill=\"none\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" d=\"m19.5 8.25-7.5 7.5-7.5-7.5\"></path></svg></button><div x-show=\"open\" x-cloak @click.away=\"open = false; searchTerm = ''\" x-transition class=\"absolute right-0 mt-2 w-72 rounded-md shadow-lg bg-white dark:bg-dark-bg-secondary ring-1 ring-black ring-opacity-5 z-50\"><div class=\"py-2\"><div class=\"px-4 py-2 border-b border-gray-200 dark:border-dark-border-DEFAULT\"><div class=\"flex items-center justify-between mb-2\"><span class=\"text-sm font-medium text-gray-700 dark:text-gray-200\">Alert Names</span> <button x-text=\"`Clear (${filters.alertNames.length})`\" @click=\"clearFilter('alertNames'); searchTerm = ''\" class=\"text-xs text-blue-600 dark:text-blue-400 hover:text-blue-500\" x-show=\"filters.alertNames.length > 0\"></button></div><!-- Search input for alert names --><div class=\"relative\"><input type=\"text\" id=\"filter-alertname-search\" name=\"filter-alertname-search\" x-model=\"searchTerm\" placeholder=\"Search alert names...\" class=\"w-full px-3 py-1.5 text-sm border border-gray-300 dark:border-dark-border-DEFAULT rounded-md bg-white dark:bg-dark-bg-tertiary text-gray-900 dark:text-white placeholder-gray-500 dark:placeholder-gray-400 focus:outline-none focus:ring-1 focus:ring-blue-500 focus:border-blue-500\"> <svg class=\"absolute right-2 top-1/2 -translate-y-1/2 h-4 w-4 text-gray-400\" viewBox=\"0 0 24 24\" stroke-width=\"1.5\" stroke=\"currentColor\" fill=\"none\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" d=\"m21 21-5.197-5.197m0 0A7.5 7.5 0 1 0 5.196 5.196a7.5 7.5 0 0 0 10.607 10.607Z\"></path></svg></div></div><div class=\"max-h-48 overflow-y-auto\"><template x-for=\"option in metadata.availableFilters.alertNames.filter(name => searchTerm === '' || name.toLowerCase().includes(searchTerm.toLowerCase()))\" x-key=\"option\"><label :for=\"'filter-alertname-' + option\" class=\"flex items-center px-4 py-2 text-sm text-gray-700 dark:text-gray-200 hover:bg-gray-100 dark:hover:bg-dark-bg-tertiary cursor-pointer\"><input type=\"checkbox\" :id=\"'filter-alertname-' + option\" :name=\"'filter-alertname-' + option\" x-model=\"filters.alertNames\" :value=\"option\" @change=\"applyFilters()\" class=\"h-4 w-4 text-blue-600 focus:ring-blue-500 border-gray-300 rounded mr-3\"> <span x-text=\"option\" class=\"truncate\"></span></label></template><div x-show=\"metadata.availableFilters.alertNames.filter(name => searchTerm === '' || name.toLowerCase().includes(searchTerm.toLowerCase())).length === 0\" class=\"px-4 py-2 text-sm text-gray-500 dark:text-gray-400\"><span x-show=\"searchTerm === ''\">No alert names available</span> <span x-show=\"searchTerm !== ''\">No matching alert names</span></div></div></div></div></div><!-- Status Filter --><div class=\"relative\" x-data=\"{ open: false, searchTerm: '' }\"><button @click=\"open = !open\" type=\"button\" class=\"inline-flex items-center px-4 py-2 border border-gray-300 dark:border-dark-border-DEFAULT rounded-md shadow-sm bg-white dark:bg-dark-bg-tertiary text-sm font-medium text-gray-700 dark:text-gray-200 hover:bg-gray-50 dark:hover:bg-dark-bg-secondary focus:outline-none focus:ring-2 focus:ring-offset-2 focus:ring-blue-500 relative\" :class=\"{ 'ring-2 ring-blue-500 ring-opacity-50': filters.statuses.length > 0 }\"><span>Status</span> <span x-show=\"filters.statuses.length > 0\" class=\"ml-2 inline-flex items-center px-2 py-0.5 rounded-full text-xs font-medium bg-blue-100 text-blue-800 dark:bg-blue-900 dark:text-blue-200\" x-text=\"filters.statuses.length\"></span> <svg class=\"ml-2 -mr-1 h-4 w-4\" viewBox=\"0 0 24 24\" stroke-width=\"1.5\" stroke=\"currentColor\" fill=\"none\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" d=\"m19.5 8.25-7.5 7.5-7.5-7.5\"></path></svg></button><div x-show=\"open\" x-cloak @click.away=\"open = false; searchTerm = ''\" x-transition class=\"absolute right-0 mt-2 w-72 rounded-md shadow-lg bg-white dark:bg-dark-bg-secondary ring-1 ring-black ring-opacity-5 z-50\"><div class=\"py-2\"><div class=\"px-4 py-2 border-b border-gray-200 dark:border-dark-border-DEFAULT\"><div class=\"flex items-center justify-between mb-2\"><span class=\"text-sm font-medium text-gray-700 dark:text-gray-200\">Alert Status</span> <button x-text=\"`Clear (${filters.statuses.length})`\" @click=\"clearFilter('statuses'); searchTerm = ''\" class=\"text-xs text-blue-600 dark:text-blue-400 hover:text-blue-500\" x-show=\"filters.statuses.length > 0\"></button></div><!-- Search input for statuses --><div class=\"relative\"><input type=\"text\" id=\"filter-status-search\" name=\"filter-status-search\" x-model=\"searchTerm\" placeholder=\"Search statuses...\" class=\"w-full px-3 py-1.5 text-sm border border-gray-300 dark:border-dark-border-DEFAULT rounded-md bg-white dark:bg-dark-bg-tertiary text-gray-900 dark:text-white placeholder-gray-500 dark:placeholder-gray-400 focus:outline-none focus:ring-1 focus:ring-blue-500 focus:border-blue-500\"> <svg class=\"absolute right-2 top-1/2 -translate-y-1/2 h-4 w-4 text-gray-400\" viewBox=\"0 0 24 24\" stroke-width=\"1.5\" stroke=\"currentColor\" fill=\"none\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" d=\"m21 21-5.197-5.197m0 0A7.5 7.5 0 1 0 5.196 5.196a7.5 7.5 0 0 0 10.607 10.607Z\"></path></svg></div></div><div class=\"max-h-48 overflow-y-auto\"><template x-for=\"option in metadata.availableFilters.statuses.filter(item => searchTerm === '' || item.toLowerCase().includes(searchTerm.toLowerCase()))\" x-key=\"option\"><label :for=\"'filter-status-' + option\" class=\"flex items-center px-4 py-2 text-sm text-gray-700 dark:text-gray-200 hover:bg-gray-100 dark:hover:bg-dark-bg-tertiary cursor-pointer\"><input type=\"checkbox\" :id=\"'filter-status-' + option\" :name=\"'filter-status-' + option\" x-model=\"filters.statuses\" :value=\"option\" @change=\"applyFilters()\" class=\"h-4 w-4 text-blue-600 focus:ring-blue-500 border-gray-300 rounded mr-3\"> <span class=\"flex items-center\"><div class=\"flex items-center mr-2\"><div x-show=\"option !== 'silenced'\" class=\"w-2 h-2 rounded-full\" :class=\"{\n\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t 'bg-red-500': option === 'firing',\n\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t 'bg-green-500': option === 'resolved'\n\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t }\"></div><svg x-show=\"option === 'silenced'\" class=\"w-3 h-3 text-purple-600\" viewBox=\"0 0 24 24\" stroke-width=\"1.5\" stroke=\"currentColor\" fill=\"none\" title=\"Silenced\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" d=\"M17.25 9.75 19.5 12m0 0 2.25 2.25M19.5 12l2.25-2.25M19.5 12l-2.25 2.25m-10.5-6 4.72-4.72a.75.75 0 0 1 1.28.53v15.88a.75.75 0 0 1-1.28.53l-4.72-4.72H4.51c-.88 0-1.59-.79-1.59-1.78V9.51c0-.88.79-1.59 1.78-1.59h1.78Z\"></path></svg></div><span x-text=\"option\"></span></span></label></template><div x-show=\"metadata.availableFilters.statuses.filter(item => searchTerm === '' || item.toLowerCase().includes(searchTerm.toLowerCase())).length === 0\" class=\"px-4 py-2 text-sm text-gray-500 dark:text-gray-400\"><span x-show=\"searchTerm === ''\">No statuses available</span> <span x-show=\"searchTerm !== ''\">No matching statuses</span></div></div></div></div></div><!-- Alertmanager Filter --><div class=\"relative\" x-data=\"{ open: false, searchTerm: '' }\"><button @click=\"open = !open\" type=\"button\" class=\"inline-flex items-center px-4 py-2 border border-gray-300 dark:border-dark-border-DEFAULT rounded-md shadow-sm bg-white dark:bg-dark-bg-tertiary text-sm font-medium text-gray-700 dark:text-gray-200 hover:bg-gray-50 dark:hover:bg-dark-bg-secondary focus:outline-none focus:ring-2 focus:ring-offset-2 focus:ring-blue-500 relative\" :class=\"{ 'ring-2 ring-blue-500 ring-opacity-50': filters.alertmanagers.length > 0 }\"><span>Alertmanager</span> <span x-show=\"filters.alertmanagers.length > 0\" class=\"ml-2 inline-flex items-center px-2 py-0.5 rounded-full text-xs font-medium bg-blue-100 text-blue-800 dark:bg-blue-900 dark:text-blue-200\" x-text=\"filters.alertmanagers.length\"></span> <svg class=\"ml-2 -mr-1 h-4 w-4\" viewBox=\"0 0 24 24\" stroke-width=\"1.5\" stroke=\"currentColor\" fill=\"none\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" d=\"m19.5 8.25-7.5 7.5-7.5-7.5\"></path></svg></button><div x-show=\"open\" x-cloak @click.away=\"open = false; searchTerm = ''\" x-transition class=\"absolute right-0 mt-2 w-72 rounded-md shadow-lg bg-white dark:bg-dark-bg-secondary ring-1 ring-black ring-opacity-5 z-50\"><div class=\"py-2\"><div class=\"px-4 py-2 border-b border-gray-200 dark:border-dark-border-DEFAULT\"><div class=\"flex items-center justify-between mb-2\"><span class=\"text-sm font-medium text-gray-700 dark:text-gray-200\">Alertmanager Sources</span> <button x-text=\"`Clear (${filters.alertmanagers.length})`\" @click=\"clearFilter('alertmanagers'); searchTerm = ''\" class=\"text-xs text-blue-600 dark:text-blue-400 hover:text-blue-500\" x-show=\"filters.alertmanagers.length > 0\"></button></div><!-- Search input for alertmanagers --><div class=\"relative\"><input type=\"text\" id=\"filter-alertmanager-search\" name=\"filter-alertmanager-search\" x-model=\"searchTerm\" placeholder=\"Search alertmanagers...\" class=\"w-full px-3 py-1.5 text-sm border border-gray-300 dark:border-dark-border-DEFAULT rounded-md bg-white dark:bg-dark-bg-tertiary text-gray-900 dark:text-white placeholder-gray-500 dark:placeholder-gray-400 focus:outline-none focus:ring-1 focus:ring-blue-500 focus:border-blue-500\"> <svg class=\"absolute right-2 top-1/2 -translate-y-1/2 h-4 w-4 text-gray-400\" viewBox=\"0 0 24 24\" stroke-width=\"1.5\" stroke=\"currentColor\" fill=\"none\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" d=\"m21 21-5.197-5.197m0 0A7.5 7.5 0 1 0 5.196 5.196a7.5 7.5 0 0 0 10.607 10.607Z\"></path></svg></div></div><div class=\"max-h-48 overflow-y-auto\"><template x-for=\"option in metadata.availableFilters.alertmanagers.filter(item => searchTerm === '' || item.toLowerCase().includes(searchTerm.toLowerCase()))\" x-key=\"option\"><label :for=\"'filter-alertmanager-' + option\" class=\"flex items-center px-4 py-2 text-sm text-gray-700 dark:text-gray-200 hover:bg-gray-100 dark:hover:bg-dark-bg-tertiary cursor-pointer\"><input type=\"checkbox\" :id=\"'filter-alertmanager-' + option\" :name=\"'filter-alertmanager-' + option\" x-model=\"filters.alertmanagers\" :value=\"option\" @change=\"applyFilters()\" class=\"h-4 w-4 text-blue-600 focus:ring-blue-500 border-gray-300 rounded mr-3\"> <span x-text=\"option\" class=\"truncate\"></span></label></template><div x-show=\"metadata.availableFilters.alertmanagers.filter(item => searchTerm === '' || item.toLowerCase().includes(searchTerm.toLowerCase())).length === 0\" class=\"px-4 py-2 text-sm text-gray-500 dark:text-gray-400\"><span x-show=\"searchTerm === ''\">No alertmanagers available</span> <span x-show=\"searchTerm !== ''\">No matching alertmanagers</span></div></div></div></div></div><!-- Saved Filters Button --><button @click=\"showFilterPresetsModal = true; loadFilterPresets()\" class=\"inline-flex items-center px-3 py-2 border border-gray-300 dark:border-dark-border-DEFAULT rounded-md shadow-sm bg-white dark:bg-dark-bg-tertiary text-sm font-medium text-gray-700 dark:text-gray-200 hover:bg-gray-50 dark:hover:bg-dark-bg-secondary focus:outline-none focus:ring-2 focus:ring-offset-2 focus:ring-blue-500\"><svg class=\"h-4 w-4 mr-1\" viewBox=\"0 0 24 24\" stroke-width=\"1.5\" stroke=\"currentColor\" fill=\"none\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" d=\"M17.593 3.322c1.1.128 1.907 1.077 1.907 2.185V21L12 17.25 4.5 21V5.507c0-1.108.806-2.057 1.907-2.185a48.507 48.507 0 0 1 11.186 0Z\"></path></svg> Saved Filters</button><!-- Active Filter Preset Indicator --><div x-show=\"activePresetName\" class=\"inline-flex items-center px-3 py-1.5 bg-green-100 dark:bg-green-900/30 border border-green-300 dark:border-green-700 rounded-md\"><svg class=\"h-4 w-4 mr-1.5 text-green-600 dark:text-green-400\" viewBox=\"0 0 24 24\" stroke-width=\"1.5\" stroke=\"currentColor\" fill=\"none\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" d=\"M9 12.75 11.25 15 15 9.75M21 12a9 9 0 1 1-18 0 9 9 0 0 1 18 0Z\"></path></svg> <span class=\"text-sm font-medium text-green-700 dark:text-green-300\">Filter: <span x-text=\"activePresetName\"></span></span><!-- Filter hidden count badge --><span x-show=\"filterHiddenAlerts.length > 0 || filterHiddenRules.length > 0\" class=\"ml-1.5 inline-flex items-center px-1.5 py-0.5 rounded text-xs font-medium bg-amber-100 text-amber-800 dark:bg-amber-900/50 dark:text-amber-300\"><svg class=\"h-3 w-3 mr-0.5\" viewBox=\"0 0 24 24\" stroke-width=\"1.5\" stroke=\"currentColor\" fill=\"none\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" d=\"M3.98 8.223A10.477 10.477 0 0 0 1.934 12C3.226 16.338 7.244 19.5 12 19.5c.993 0 1.953-.138 2.863-.395M6.228 6.228A10.451 10.451 0 0 1 12 4.5c4.756 0 8.773 3.162 10.065 7.498a10.522 10.522 0 0 1-4.293 5.774M6.228 6.228 3 3m3.228 3.228 3.65 3.65m7.894 7.894L21 21m-3.228-3.228-3.65-3.65m0 0a3 3 0 1 0-4.243-4.243m4.242 4.242L9.88 9.88\"></path></svg> <span x-text=\"filterHiddenAlerts.length + filterHiddenRules.length\"></span></span> <button @click=\"clearFilterHiddenState(); activePresetName = null; clearAllFilters()\" class=\"ml-2 inline-flex items-center p-0.5 text-green-600 dark:text-green-400 hover:text-green-700 dark:hover:text-green-300\"><svg class=\"h-3.5 w-3.5\" viewBox=\"0 0 24 24\" stroke-width=\"2\" stroke=\"currentColor\" fill=\"none\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" d=\"M6 18 18 6M6 6l12 12\"></path></svg></button></div><!-- Clear All Filters --><button @click=\"clearAllFilters()\" x-show=\"hasActiveFilters()\" class=\"inline-flex items-center px-3 py-2 border border-gray-300 dark:border-dark-border-DEFAULT rounded-md shadow-sm bg-white dark:bg-dark-bg-tertiary text-sm font-medium text-gray-700 dark:text-gray-200 hover:bg-gray-50 dark:hover:bg-dark-bg-secondary focus:outline-none focus:ring-2 focus:ring-offset-2 focus:ring-red-500\"><svg class=\"h-4 w-4 mr-1\" viewBox=\"0 0 24 24\" stroke-width=\"1.5\" stroke=\"currentColor\" fill=\"none\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" d=\"M6 18 18 6M6 6l12 12\"></path></svg> Clear All</button></div></div></div><!-- Active Filters Display --><div x-show=\"hasActiveFilters()\" class=\"px-6 py-3 bg-blue-50 dark:bg-blue-900/20 border-b border-gray-200 dark:border-dark-border-DEFAULT\"><div class=\"flex flex-wrap items-center gap-2\"><span class=\"text-sm text-gray-600 dark:text-gray-300 font-medium\">Active filters:</span><!-- Search Filter Tag --><span x-show=\"searchQuery.length > 0\" class=\"inline-flex items-center px-2.5 py-0.5 rounded-full text-xs font-medium bg-blue-100 text-blue-800 dark:bg-blue-800 dark:text-blue-200\"><svg class=\"w-3 h-3 mr-1\" viewBox=\"0 0 24 24\" stroke-width=\"1.5\" stroke=\"currentColor\" fill=\"none\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" d=\"m21 21-5.197-5.197m0 0A7.5 7.5 0 1 0 5.196 5.196a7.5 7.5 0 0 0 10.607 10.607Z\"></path></svg> <span x-text=\"'Search: ' + searchQuery\"></span> <button @click=\"searchQuery = ''; applyFilters()\" class=\"ml-1 inline-flex items-center p-0.5 text-blue-600 dark:text-blue-300 hover:text-blue-500\"><svg class=\"w-3 h-3\" viewBox=\"0 0 24 24\" stroke-width=\"1.5\" stroke=\"currentColor\" fill=\"none\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" d=\"M6 18 18 6M6 6l12 12\"></path></svg></button></span><!-- Filter Tags -->")
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 2, "</div><!-- Right: Controls --><div class=\"flex items-center space-x-4 flex-1 justify-end\"><!-- Display Mode Selector --><div class=\"hidden md:flex items-center space-x-1 bg-gray-100 dark:bg-dark-bg-tertiary rounded-lg p-1\"><button @click=\"setDisplayMode('classic')\" :class=\"displayMode === 'classic' ? 'bg-white dark:bg-dark-bg-secondary shadow text-gray-900 dark:text-white' : 'text-gray-700 dark:text-gray-300 hover:text-gray-900 dark:hover:text-white'\" class=\"px-3 py-1 text-sm font-medium rounded-md transition-colors\">Classic</button> <button @click=\"setDisplayMode('resolved')\" :class=\"displayMode === 'resolved' ? 'bg-white dark:bg-dark-bg-secondary shadow text-gray-900 dark:text-white' : 'text-gray-700 dark:text-gray-300 hover:text-gray-900 dark:hover:text-white'\" class=\"px-3 py-1 text-sm font-medium rounded-md transition-colors\">Resolved</button> <button @click=\"setDisplayMode('acknowledge')\" :class=\"displayMode === 'acknowledge' ? 'bg-white dark:bg-dark-bg-secondary shadow text-gray-900 dark:text-white' : 'text-gray-700 dark:text-gray-300 hover:text-gray-900 dark:hover:text-white'\" class=\"px-3 py-1 text-sm font-medium rounded-md transition-colors\">Acknowledged</button> <button @click=\"setDisplayMode('hidden')\" :class=\"displayMode === 'hidden' ? 'bg-white dark:bg-dark-bg-secondary shadow text-gray-900 dark:text-white' : 'text-gray-700 dark:text-gray-300 hover:text-gray-900 dark:hover:text-white'\" class=\"px-3 py-1 text-sm font-medium rounded-md transition-colors\">Hidden</button></div><!-- View Mode Toggle --><div class=\"flex items-center space-x-1 bg-gray-100 dark:bg-dark-bg-tertiary rounded-lg p-1\"><button @click=\"setViewMode('list')\" :class=\"viewMode === 'list' ? 'bg-white dark:bg-dark-bg-secondary shadow text-gray-900 dark:text-white' : 'text-gray-600 dark:text-gray-400 hover:text-gray-900 dark:hover:text-white'\" class=\"p-2 rounded-md transition-colors\"><svg class=\"h-4 w-4\" fill=\"none\" viewBox=\"0 0 24 24\" stroke-width=\"1.5\" stroke=\"currentColor\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" d=\"M8.25 6.75h12M8.25 12h12m-12 5.25h12M3.75 6.75h.007v.008H3.75V6.75Zm.375 0a.375.375 0 1 1-.75 0 .375.375 0 0 1 .75 0ZM3.75 12h.007v.008H3.75V12Zm.375 0a.375.375 0 1 1-.75 0 .375.375 0 0 1 .75 0Zm-.375 5.25h.007v.008H3.75v-.008Zm.375 0a.375.375 0 1 1-.75 0 .375.375 0 0 1 .75 0Z\"></path></svg></button> <button @click=\"setViewMode('group')\" :class=\"viewMode === 'group' ? 'bg-white dark:bg-dark-bg-secondary shadow text-gray-900 dark:text-white' : 'text-gray-600 dark:text-gray-400 hover:text-gray-900 dark:hover:text-white'\" class=\"p-2 rounded-md transition-colors\"><svg class=\"h-4 w-4\" fill=\"none\" viewBox=\"0 0 24 24\" stroke-width=\"1.5\" stroke=\"currentColor\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" d=\"m2.25 12 8.954-8.955c.44-.439 1.152-.439 1.591 0L21.75 12M4.5 9.75v10.125c0 .621.504 1.125 1.125 1.125H9.75v-4.875c0-.621.504-1.125 1.125-1.125h2.25c.621 0 1.125.504 1.125 1.125V21h4.125c.621 0 1.125-.504 1.125-1.125V9.75M8.25 21h8.25\"></path></svg></button></div><!-- CSV export of the filtered table (list view) --><button x-show=\"viewMode === 'list'\" @click=\"exportAlertsCSV()\" class=\"p-2 text-gray-600 dark:text-gray-300 hover:bg-gray-100 dark:hover:bg-dark-bg-tertiary rounded-md transition-colors\" title=\"Export filtered alerts as CSV\"><svg class=\"h-4 w-4\" fill=\"none\" viewBox=\"0 0 24 24\" stroke-width=\"1.5\" stroke=\"currentColor\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" d=\"M3 16.5v2.25A2.25 2.25 0 0 0 5.25 21h13.5A2.25 2.25 0 0 0 21 18.75V16.5M16.5 12 12 16.5m0 0L7.5 12m4.5 4.5V3\"></path></svg></button><!-- Group By Selector (only shown in group view) --><div x-show=\"viewMode === 'group'\" x-cloak class=\"flex items-center space-x-2\"><span class=\"text-sm text-gray-600 dark:text-gray-400\">Group by:</span> <select x-model=\"groupByLabel\" @change=\"setGroupByLabel($event.target.value)\" class=\"rounded-md border border-gray-300 dark:border-dark-border-DEFAULT bg-white dark:bg-dark-bg-tertiary text-sm text-gray-900 dark:text-white focus:outline-none focus:ring-2 focus:ring-blue-500 px-3 py-1.5\"><option value=\"alertname\">Alert Name</option> <option value=\"severity\">Severity</option> <option value=\"team\">Team</option> <option value=\"namespace\">Namespace</option> <option value=\"service\">Service</option> <option value=\"instance\">Instance</option> <option value=\"job\">Job</option><template x-for=\"label in metadata.availableGroupLabels\" :key=\"label\"><option :value=\"label\" x-text=\"label\"></option></template></select><!-- Grouped view export --><div x-data=\"{ groupExportOpen: false }\" class=\"relative\" @click.away=\"groupExportOpen = false\"><button @click=\"groupExportOpen = !groupExportOpen\" class=\"p-2 text-gray-600 dark:text-gray-300 hover:bg-gray-100 dark:hover:bg-dark-bg-tertiary rounded-md transition-colors\" title=\"Export grouped view\"><svg class=\"h-4 w-4\" fill=\"none\" viewBox=\"0 0 24 24\" stroke-width=\"1.5\" stroke=\"currentColor\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" d=\"M3 16.5v2.25A2.25 2.25 0 0 0 5.25 21h13.5A2.25 2.25 0 0 0 21 18.75V16.5M16.5 12 12 16.5m0 0L7.5 12m4.5 4.5V3\"></path></svg></button><div x-show=\"groupExportOpen\" x-cloak class=\"absolute right-0 mt-2 w-44 rounded-md shadow-lg bg-white dark:bg-dark-bg-secondary ring-1 ring-black ring-opacity-5 z-50\"><div class=\"py-1\"><button @click=\"exportGroupedView('json'); groupExportOpen = false\" class=\"w-full text-left px-4 py-2 text-sm text-gray-700 dark:text-gray-300 hover:bg-gray-100 dark:hover:bg-dark-bg-tertiary\">Export as JSON</button> <button @click=\"exportGroupedView('markdown'); groupExportOpen = false\" class=\"w-full text-left px-4 py-2 text-sm text-gray-700 dark:text-gray-300 hover:bg-gray-100 dark:hover:bg-dark-bg-tertiary\">Export as Markdown</button></div></div></div></div><!-- Impersonation Dropdown (only visible to admins) --><div x-data=\"{ ...impersonationDropdown(), canImpersonate: false }\" x-init=\"if (window.impersonationState?.initialized) { canImpersonate = window.impersonationState.canImpersonate } else { window.addEventListener('impersonationStateReady', () => { canImpersonate = window.impersonationState.canImpersonate }, { once: true }) }\" x-show=\"canImpersonate\" x-cloak class=\"relative\"><button @click=\"toggleDropdown()\" class=\"flex items-center gap-1 p-2 text-gray-600 dark:text-gray-300 hover:bg-gray-100 dark:hover:bg-dark-bg-tertiary rounded-md transition-colors\" title=\"Impersonate User\"><svg xmlns=\"http://www.w3.org/2000/svg\" class=\"h-5 w-5\" viewBox=\"0 0 20 20\" fill=\"currentColor\"><path d=\"M13 6a3 3 0 11-6 0 3 3 0 016 0zM18 8a2 2 0 11-4 0 2 2 0 014 0zM14 15a4 4 0 00-8 0v3h8v-3zM6 8a2 2 0 11-4 0 2 2 0 014 0zM16 18v-3a5.972 5.972 0 00-.75-2.906A3.005 3.005 0 0119 15v3h-3zM4.75 12.094A5.973 5.973 0 004 15v3H1v-3a3 3 0 013.75-2.906z\"></path></svg></button><!-- Dropdown panel --><div x-show=\"isOpen\" x-cloak @click.outside=\"isOpen = false\" x-transition class=\"absolute right-0 mt-2 w-72 bg-white dark:bg-dark-bg-secondary rounded-lg shadow-lg border border-gray-200 dark:border-dark-border-subtle z-50\"><div class=\"p-3 border-b border-gray-200 dark:border-dark-border-subtle\"><input type=\"text\" id=\"impersonate-search\" name=\"impersonate-search\" x-model=\"search\" @input=\"filterUsers()\" placeholder=\"Search users...\" class=\"w-full px-3 py-2 text-sm border border-gray-300 dark:border-dark-border-DEFAULT rounded bg-white dark:bg-dark-bg-tertiary text-gray-900 dark:text-white placeholder-gray-500 focus:outline-none focus:ring-2 focus:ring-amber-500\"></div><div class=\"max-h-64 overflow-y-auto\"><template x-if=\"loading\"><div class=\"px-3 py-4 text-center text-gray-500 dark:text-gray-400\">Loading users...</div></template><template x-if=\"!loading && filteredUsers.length === 0\"><div class=\"px-3 py-4 text-center text-gray-500 dark:text-gray-400\">No users found</div></template><template x-for=\"user in filteredUsers\" :key=\"user.id\"><button @click=\"startImpersonation(user.username)\" class=\"w-full px-3 py-2 text-left text-sm hover:bg-gray-100 dark:hover:bg-dark-bg-tertiary flex flex-col\"><span class=\"font-medium text-gray-900 dark:text-white\" x-text=\"user.username\"></span> <span class=\"text-gray-500 dark:text-gray-400 text-xs\" x-text=\"user.email\"></span></button></template></div></div></div><!-- Connected Users Dropdown (only visible to admins) --><div x-data=\"connectedUsersDropdown()\" x-init=\"init()\" x-show=\"canAdmin\" x-cloak class=\"relative\"><button @click=\"toggle()\" class=\"flex items-center gap-1 p-2 text-gray-600 dark:text-gray-300 hover:bg-gray-100 dark:hover:bg-dark-bg-tertiary rounded-md transition-colors relative\" title=\"Connected Users\"><svg xmlns=\"http://www.w3.org/2000/svg\" class=\"h-5 w-5\" viewBox=\"0 0 20 20\" fill=\"currentColor\"><path d=\"M9 6a3 3 0 11-6 0 3 3 0 016 0zM17 6a3 3 0 11-6 0 3 3 0 016 0zM12.93 17c.046-.327.07-.66.07-1a6.97 6.97 0 00-1.5-4.33A5 5 0 0119 16v1h-6.07zM6 11a5 5 0 015 5v1H1v-1a5 5 0 015-5z\"></path></svg> <span x-show=\"count > 0\" x-cloak class=\"absolute -top-1 -right-1 min-w-[18px] h-[18px] flex items-center justify-center text-xs font-bold text-white bg-green-500 rounded-full px-1\" x-text=\"count\"></span></button><!-- Dropdown panel --><div x-show=\"open\" x-cloak @click.outside=\"open = false\" x-transition class=\"absolute right-0 mt-2 w-80 bg-white dark:bg-dark-bg-secondary rounded-lg shadow-lg border border-gray-200 dark:border-dark-border-subtle z-50\"><div class=\"p-3 border-b border-gray-200 dark:border-dark-border-subtle flex items-center justify-between\"><span class=\"text-sm font-medium text-gray-700 dark:text-gray-200\">Connected Users</span> <span class=\"text-xs text-gray-500 dark:text-gray-400\" x-text=\"count + ' online'\"></span></div><div class=\"max-h-64 overflow-y-auto\"><template x-if=\"loading\"><div class=\"px-3 py-4 text-center text-gray-500 dark:text-gray-400\">Loading...</div></template><template x-if=\"!loading && users.length === 0\"><div class=\"px-3 py-4 text-center text-gray-500 dark:text-gray-400\">No users connected</div></template><template x-for=\"user in users\" :key=\"user.user_id\"><div class=\"px-3 py-2 border-b border-gray-100 dark:border-dark-border-subtle last:border-b-0\"><div class=\"flex items-center gap-2\"><span class=\"w-2 h-2 bg-green-500 rounded-full flex-shrink-0\"></span><div class=\"flex-1 min-w-0\"><div class=\"flex items-center justify-between\"><span class=\"font-medium text-sm text-gray-900 dark:text-white truncate\" x-text=\"user.username\"></span> <span class=\"text-xs text-gray-500 dark:text-gray-400\" x-text=\"user.session_count + ' session' + (user.session_count > 1 ? 's' : '')\"></span></div><div class=\"flex items-center justify-between text-xs text-gray-500 dark:text-gray-400\"><span class=\"truncate\" x-text=\"user.email\"></span> <span x-text=\"formatLastActivity(user.last_activity)\"></span></div></div></div></div></template></div></div></div><!-- Force Refresh Button --><button @click=\"forceRefresh()\" :disabled=\"forceRefreshing\" class=\"p-2 rounded-md hover:bg-gray-100 dark:hover:bg-dark-bg-tertiary transition-colors text-gray-600 dark:text-gray-300 disabled:opacity-50\" title=\"Force refresh (Shift+R)\"><svg class=\"h-5 w-5\" :class=\"forceRefreshing && 'animate-spin'\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"1.5\" d=\"M4 4v5h.582m15.356 2A8.001 8.001 0 004.582 9m0 0H9m11 11v-5h-.581m0 0a8.003 8.003 0 01-15.357-2m15.357 2H15\"></path></svg></button><!-- Silences Button --><button @click=\"openSilencesView()\" class=\"p-2 rounded-md hover:bg-gray-100 dark:hover:bg-dark-bg-tertiary transition-colors text-gray-600 dark:text-gray-300\" title=\"Silences\"><svg class=\"h-5 w-5\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"1.5\" d=\"M5.586 15H4a1 1 0 01-1-1v-4a1 1 0 011-1h1.586l4.707-4.707C10.923 3.663 12 4.109 12 5v14c0 .891-1.077 1.337-1.707.707L5.586 15z\"></path> <path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"1.5\" d=\"M17 14l2-2m0 0l2-2m-2 2l-2-2m2 2l2 2\"></path></svg></button><!-- Settings Button --><button @click=\"openSettings()\" class=\"p-2 rounded-md hover:bg-gray-100 dark:hover:bg-dark-bg-tertiary transition-colors text-gray-600 dark:text-gray-300\"><svg class=\"h-5 w-5\" viewBox=\"0 0 24 24\" stroke-width=\"1.5\" stroke=\"currentColor\" fill=\"none\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" d=\"M9.594 3.94c.09-.542.56-.94 1.11-.94h2.593c.55 0 1.02.398 1.11.94l.213 1.281c.063.374.313.686.645.87.074.04.147.083.22.127.325.196.72.257 1.075.124l1.217-.456a1.125 1.125 0 0 1 1.37.49l1.296 2.247a1.125 1.125 0 0 1-.26 1.431l-1.003.827c-.293.241-.438.613-.43.992a6.759 6.759 0 0 1 0 .255c-.008.378.137.75.43.991l1.004.827c.424.35.534.955.26 1.43l-1.298 2.247a1.125 1.125 0 0 1-1.369.491l-1.217-.456c-.355-.133-.75-.072-1.076.124a6.57 6.57 0 0 1-.22.128c-.331.183-.581.495-.644.869l-.213 1.281c-.09.543-.56.94-1.11.94h-2.594c-.55 0-1.019-.398-1.11-.94l-.213-1.281c-.062-.374-.312-.686-.644-.87a6.52 6.52 0 0 1-.22-.127c-.325-.196-.72-.257-1.076-.124l-1.217.456a1.125 1.125 0 0 1-1.369-.49l-1.297-2.247a1.125 1.125 0 0 1 .26-1.431l1.004-.827c.292-.24.437-.613.43-.991a6.932 6.932 0 0 1 0-.255c.007-.38-.138-.751-.43-.992l-1.004-.827a1.125 1.125 0 0 1-.26-1.43l1.297-2.247a1.125 1.125 0 0 1 1.37-.491l1.216.456c.356.133.751.072 1.076-.124.072-.044.146-.086.22-.128.332-.183.582-.495.644-.869l.214-1.28Z\"></path> <path stroke-linecap=\"round\" stroke-linejoin=\"round\" d=\"M15 12a3 3 0 1 1-6 0 3 3 0 0 1 6 0Z\"></path></svg></button><!-- User Menu --><div class=\"relative\" x-data=\"{ open: false }\"><button @click=\"open = !open\" class=\"flex items-center space-x-2 p-2 rounded-lg hover:bg-gray-100 dark:hover:bg-dark-bg-tertiary transition-all duration-200 ease-in-out group\"><div class=\"h-8 w-8 bg-gradient-to-br from-blue-500 to-purple-600 rounded-full flex items-center justify-center shadow-lg group-hover:scale-105 group-hover:shadow-xl transition-all duration-200 ease-in-out\"><span class=\"text-sm font-semibold tracking-wide text-white\" x-text=\"getUserInitials()\">U</span></div><svg class=\"h-4 w-4 text-gray-400 group-hover:text-gray-500 dark:group-hover:text-gray-300 transition-colors duration-200\" viewBox=\"0 0 24 24\" stroke-width=\"1.5\" stroke=\"currentColor\" fill=\"none\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" d=\"m19.5 8.25-7.5 7.5-7.5-7.5\"></path></svg></button><div x-show=\"open\" x-cloak @click.away=\"open = false\" x-transition class=\"absolute right-0 mt-2 w-48 bg-white dark:bg-dark-bg-secondary rounded-md shadow-lg py-1 z-50\"><a href=\"/profile\" class=\"block px-4 py-2 text-sm text-gray-700 dark:text-gray-200 hover:bg-gray-100 dark:hover:bg-dark-bg-tertiary\">Profile</a> <a href=\"/statistics\" class=\"block px-4 py-2 text-sm text-gray-700 dark:text-gray-200 hover:bg-gray-100 dark:hover:bg-dark-bg-tertiary\">Statistics</a> <a href=\"#\" hx-post=\"/api/v1/auth/logout\" hx-trigger=\"click\" hx-on::after-request=\"handleLogoutResponse(event)\" class=\"block px-4 py-2 text-sm text-gray-700 dark:text-gray-200 hover:bg-gray-100 dark:hover:bg-dark-bg-tertiary cursor-pointer\">Logout</a></div></div></div></div></div></header><!-- Notification Enable Banner --><div x-show=\"shouldShowNotificationBanner()\" x-cloak x-transition class=\"bg-blue-50 dark:bg-blue-900/20 border-b border-blue-200 dark:border-blue-800\"><div class=\"max-w-full mx-auto px-4 sm:px-6 lg:px-8 py-3\"><div class=\"flex items-center justify-between\"><div class=\"flex items-center space-x-3\"><svg class=\"h-5 w-5 text-blue-600 dark:text-blue-400 flex-shrink-0\" fill=\"none\" viewBox=\"0 0 24 24\" stroke=\"currentColor\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M15 17h5l-1.405-1.405A2.032 2.032 0 0118 14.158V11a6.002 6.002 0 00-4-5.659V5a2 2 0 10-4 0v.341C7.67 6.165 6 8.388 6 11v3.159c0 .538-.214 1.055-.595 1.436L4 17h5m6 0v1a3 3 0 11-6 0v-1m6 0H9\"></path></svg><div><p class=\"text-sm font-medium text-blue-900 dark:text-blue-100\">Enable browser notifications to get real-time alerts</p><p class=\"text-xs text-blue-700 dark:text-blue-300\">You'll be notified when new critical or warning alerts appear</p></div></div><div class=\"flex items-center space-x-2\"><button @click=\"enableNotifications()\" class=\"px-4 py-2 bg-blue-600 hover:bg-blue-700 text-white text-sm font-medium rounded-lg transition-colors\">Enable Notifications</button> <button @click=\"dismissNotificationBanner()\" aria-label=\"Dismiss notification prompt\" class=\"p-2 text-blue-600 dark:text-blue-400 hover:bg-blue-100 dark:hover:bg-blue-900/40 rounded-lg transition-colors\"><svg class=\"w-4 h-4\" fill=\"none\" viewBox=\"0 0 24 24\" stroke=\"currentColor\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M6 18L18 6M6 6l12 12\"></path></svg></button></div></div></div></div><!-- Unreachable Alertmanager Banner --><div x-show=\"unhealthyAlertmanagers().length > 0\" x-cloak class=\"bg-red-50 dark:bg-red-900/20 border-b border-red-200 dark:border-red-800\"><div class=\"max-w-full mx-auto px-4 sm:px-6 lg:px-8 py-3\"><div class=\"flex items-center justify-between\"><div class=\"flex items-center space-x-3\"><svg class=\"h-5 w-5 text-red-600 dark:text-red-400 flex-shrink-0\" fill=\"none\" viewBox=\"0 0 24 24\" stroke=\"currentColor\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M12 9v2m0 4h.01m-6.938 4h13.856c1.54 0 2.502-1.667 1.732-3L13.732 4c-.77-1.333-2.694-1.333-3.464 0L3.34 16c-.77 1.333.192 3 1.732 3z\"></path></svg><div><p class=\"text-sm font-medium text-red-800 dark:text-red-200\">Cannot reach <span x-text=\"unhealthyAlertmanagers().join(', ')\"></span></p><p class=\"text-xs text-red-700 dark:text-red-400\">Alerts from these Alertmanagers are shown as last fetched and may be stale</p></div></div><a href=\"/status\" class=\"px-4 py-2 bg-red-600 hover:bg-red-700 text-white text-sm font-medium rounded-lg transition-colors\">View Status</a></div></div></div><!-- Main Content --><main class=\"max-w-full mx-auto px-4 sm:px-6 lg:px-8 py-6\"><!-- Filters & Search --><div class=\"bg-white dark:bg-dark-bg-secondary shadow rounded-lg mb-6\"><div class=\"px-6 py-4 border-b border-gray-200 dark:border-dark-border-subtle\"><div class=\"flex flex-col lg:flex-row lg:items-center lg:justify-between space-y-4 lg:space-y-0\"><!-- Search --><div class=\"flex-1 max-w-lg\"><div class=\"relative\" @click.outside=\"searchSuggestionsOpen = false\"><div class=\"absolute inset-y-0 left-0 pl-3 flex items-center pointer-events-none\"><!-- Search icon (shown when not loading) --><svg x-show=\"!isSearching\" class=\"h-5 w-5 text-gray-400\" viewBox=\"0 0 24 24\" stroke-width=\"1.5\" stroke=\"currentColor\" fill=\"none\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" d=\"m21 21-5.197-5.197m0 0A7.5 7.5 0 1 0 5.196 5.196a7.5 7.5 0 0 0 10.607 10.607Z\"></path></svg><!-- Loading spinner (shown when searching) --><svg x-show=\"isSearching\" x-cloak class=\"h-5 w-5 text-blue-500 animate-spin\" xmlns=\"http://www.w3.org/2000/svg\" fill=\"none\" viewBox=\"0 0 24 24\"><circle class=\"opacity-25\" cx=\"12\" cy=\"12\" r=\"10\" stroke=\"currentColor\" stroke-width=\"4\"></circle> <path class=\"opacity-75\" fill=\"currentColor\" d=\"M4 12a8 8 0 018-8V0C5.373 0 0 5.373 0 12h4zm2 5.291A7.962 7.962 0 014 12H0c0 3.042 1.135 5.824 3 7.938l3-2.647z\"></path></svg></div><input x-model=\"searchQuery\" @input.debounce.300ms=\"isSearching = true; applyFilters().finally(() => { isSearching = false; })\" @input=\"searchSuggestionsOpen = true; searchSuggestionIndex = -1\" @focus=\"searchSuggestionsOpen = true\" @change=\"recordSearch(searchQuery)\" @keydown.enter.prevent=\"onSearchEnter()\" @keydown.arrow-down.prevent=\"moveSearchSuggestion(1)\" @keydown.arrow-up.prevent=\"moveSearchSuggestion(-1)\" @keydown.escape=\"searchSuggestionsOpen = false\" autocomplete=\"off\" id=\"dashboard-search\" name=\"dashboard-search\" type=\"text\" placeholder=\"Search alerts, instances, summaries...\" class=\"block w-full pl-10 pr-3 py-2 border border-gray-300 dark:border-dark-border-DEFAULT rounded-md leading-5 bg-white dark:bg-dark-bg-tertiary text-gray-900 dark:text-white placeholder-gray-500 dark:placeholder-gray-400 focus:outline-none focus:placeholder-gray-400 focus:ring-1 focus:ring-blue-500 focus:border-blue-500\" :class=\"{ 'ring-2 ring-blue-500': isSearching }\"><!-- Autocomplete: label keys/values and recent searches --><div x-show=\"searchSuggestionsOpen && searchSuggestions().length > 0\" x-cloak class=\"absolute left-0 right-0 mt-1 rounded-md shadow-lg bg-white dark:bg-dark-bg-secondary ring-1 ring-black ring-opacity-5 z-50 py-1\"><template x-for=\"(suggestion, index) in searchSuggestions()\" :key=\"suggestion.kind + suggestion.text\"><button type=\"button\" @mousedown.prevent=\"applySearchSuggestion(suggestion)\" class=\"w-full flex items-center justify-between px-4 py-1.5 text-left text-sm text-gray-700 dark:text-gray-300 hover:bg-gray-100 dark:hover:bg-dark-bg-tertiary\" :class=\"{ 'bg-gray-100 dark:bg-dark-bg-tertiary': index === searchSuggestionIndex }\"><span class=\"font-mono truncate\" x-text=\"suggestion.text\"></span> <span class=\"ml-3 text-xs text-gray-400\" x-text=\"suggestion.kind === 'history' ? 'recent' : (suggestion.kind === 'key' ? 'label' : 'value')\"></span></button></template></div></div><p class=\"mt-1 text-xs text-gray-500 dark:text-gray-400\">Filter by label with <code>namespace=prod</code>, <code>severity!=info</code> or <code>instance=~web-.*</code>; other words match as text.</p></div><!-- Filter Controls --><div class=\"flex flex-wrap items-center gap-3\"><!-- Team Filter --><div class=\"relative\" x-data=\"{ open: false, searchTerm: '' }\"><button @click=\"open = !open\" type=\"button\" class=\"inline-flex items-center px-4 py-2 border border-gray-300 dark:border-dark-border-DEFAULT rounded-md shadow-sm bg-white dark:bg-dark-bg-tertiary text-sm font-medium text-gray-700 dark:text-gray-200 hover:bg-gray-50 dark:hover:bg-dark-bg-secondary focus:outline-none focus:ring-2 focus:ring-offset-2 focus:ring-blue-500 relative\" :class=\"{ 'ring-2 ring-blue-500 ring-opacity-50': filters.teams.length > 0 }\"><span>Team</span> <span x-show=\"filters.teams.length > 0\" class=\"ml-2 inline-flex items-center px-2 py-0.5 rounded-full text-xs font-medium bg-blue-100 text-blue-800 dark:bg-blue-900 dark:text-blue-200\" x-text=\"filters.teams.length\"></span> <svg class=\"ml-2 -mr-1 h-4 w-4\" viewBox=\"0 0 24 24\" stroke-width=\"1.5\" stroke=\"currentColor\" fill=\"none\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" d=\"m19.5 8.25-7.5 7.5-7.5-7.5\"></path></svg></button><div x-show=\"open\" x-cloak @click.away=\"open = false; searchTerm = ''\" x-transition class=\"absolute right-0 mt-2 w-72 rounded-md shadow-lg bg-white dark:bg-dark-bg-secondary ring-1 ring-black ring-opacity-5 z-50\"><div class=\"py-2\"><div class=\"px-4 py-2 border-b border-gray-200 dark:border-dark-border-DEFAULT\"><div class=\"flex items-center justify-between mb-2\"><span class=\"text-sm font-medium text-gray-700 dark:text-gray-200\">Teams</span> <button x-text=\"`Clear (${filters.teams.length})`\" @click=\"clearFilter('teams'); searchTerm = ''\" class=\"text-xs text-blue-600 dark:text-blue-400 hover:text-blue-500\" x-show=\"filters.teams.length > 0\"></button></div><!-- Search input for teams --><div class=\"relative\"><input type=\"text\" id=\"filter-team-search\" name=\"filter-team-search\" x-model=\"searchTerm\" placeholder=\"Search teams...\" class=\"w-full px-3 py-1.5 text-sm border border-gray-300 dark:border-dark-border-DEFAULT rounded-md bg-white dark:bg-dark-bg-tertiary text-gray-900 dark:text-white placeholder-gray-500 dark:placeholder-gray-400 focus:outline-none focus:ring-1 focus:ring-blue-500 focus:border-blue-500\"> <svg class=\"absolute right-2 top-1/2 -translate-y-1/2 h-4 w-4 text-gray-400\" viewBox=\"0 0 24 24\" stroke-width=\"1.5\" stroke=\"currentColor\" fill=\"none\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" d=\"m21 21-5.197-5.197m0 0A7.5 7.5 0 1 0 5.196 5.196a7.5 7.5 0 0 0 10.607 10.607Z\"></path></svg></div></div><div class=\"max-h-48 overflow-y-auto\"><template x-for=\"option in metadata.availableFilters.teams.filter(item => searchTerm === '' || item.toLowerCase().includes(searchTerm.toLowerCase()))\" :key=\"option\"><label :for=\"'filter-team-' + option\" class=\"flex items-center px-4 py-2 text-sm text-gray-700 dark:text-gray-200 hover:bg-gray-100 dark:hover:bg-dark-bg-tertiary cursor-pointer\"><input type=\"checkbox\" x-model=\"filters.teams\" :value=\"option\" @change=\"applyFilters()\" :id=\"'filter-team-' + option\" :name=\"'filter-team-' + option\" class=\"h-4 w-4 text-blue-600 focus:ring-blue-500 border-gray-300 rounded mr-3\"> <span x-text=\"option\" class=\"truncate\"></span></label></template><div x-show=\"metadata.availableFilters.teams.filter(item => searchTerm === '' || item.toLowerCase().includes(searchTerm.toLowerCase())).length === 0\" class=\"px-4 py-2 text-sm text-gray-500 dark:text-gray-400\"><span x-show=\"searchTerm === ''\">No teams available</span> <span x-show=\"searchTerm !== ''\">No matching teams</span></div></div></div></div></div><!-- Severity Filter --><div class=\"relative\" x-data=\"{ open: false, searchTerm: '' }\"><button @click=\"open = !open\" type=\"button\" class=\"inline-flex items-center px-4 py-2 border border-gray-300 dark:border-dark-border-DEFAULT rounded-md shadow-sm bg-white dark:bg-dark-bg-tertiary text-sm font-medium text-gray-700 dark:text-gray-200 hover:bg-gray-50 dark:hover:bg-dark-bg-secondary focus:outline-none focus:ring-2 focus:ring-offset-2 focus:ring-blue-500 relative\" :class=\"{ 'ring-2 ring-blue-500 ring-opacity-50': filters.severities.length > 0 }\"><span>Severity</span> <span x-show=\"filters.severities.length > 0\" class=\"ml-2 inline-flex items-center px-2 py-0.5 rounded-full text-xs font-medium bg-blue-100 text-blue-800 dark:bg-blue-900 dark:text-blue-200\" x-text=\"filters.severities.length\"></span> <svg class=\"ml-2 -mr-1 h-4 w-4\" viewBox=\"0 0 24 24\" stroke-width=\"1.5\" stroke=\"currentColor\" fill=\"none\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" d=\"m19.5 8.25-7.5 7.5-7.5-7.5\"></path></svg></button><div x-show=\"open\" x-cloak @click.away=\"open = false; searchTerm = ''\" x-transition class=\"absolute right-0 mt-2 w-72 rounded-md shadow-lg bg-white dark:bg-dark-bg-secondary ring-1 ring-black ring-opacity-5 z-50\"><div class=\"py-2\"><div class=\"px-4 py-2 border-b border-gray-200 dark:border-dark-border-DEFAULT\"><div class=\"flex items-center justify-between mb-2\"><span class=\"text-sm font-medium text-gray-700 dark:text-gray-200\">Severity Levels</span> <button x-text=\"`Clear (${filters.severities.length})`\" @click=\"clearFilter('severities'); searchTerm = ''\" class=\"text-xs text-blue-600 dark:text-blue-400 hover:text-blue-500\" x-show=\"filters.severities.length > 0\"></button></div><!-- Search input for severities --><div class=\"relative\"><input type=\"text\" id=\"filter-severity-search\" name=\"filter-severity-search\" x-model=\"searchTerm\" placeholder=\"Search severities...\" class=\"w-full px-3 py-1.5 text-sm border border-gray-300 dark:border-dark-border-DEFAULT rounded-md bg-white dark:bg-dark-bg-tertiary text-gray-900 dark:text-white placeholder-gray-500 dark:placeholder-gray-400 focus:outline-none focus:ring-1 focus:ring-blue-500 focus:border-blue-500\"> <svg class=\"absolute right-2 top-1/2 -translate-y-1/2 h-4 w-4 text-gray-400\" viewBox=\"0 0 24 24\" stroke-width=\"1.5\" stroke=\"currentColor\" fill=\"none\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" d=\"m21 21-5.197-5.197m0 0A7.5 7.5 0 1 0 5.196 5.196a7.5 7.5 0 0 0 10.607 10.607Z\"></path></svg></div></div><div class=\"max-h-48 overflow-y-auto\"><template x-for=\"option in metadata.availableFilters.severities.filter(item => searchTerm === '' || item.toLowerCase().includes(searchTerm.toLowerCase()))\" x-key=\"option\"><label :for=\"'filter-severity-' + option\" class=\"flex items-center px-4 py-2 text-sm text-gray-700 dark:text-gray-200 hover:bg-gray-100 dark:hover:bg-dark-bg-tertiary cursor-pointer\"><input type=\"checkbox\" :id=\"'filter-severity-' + option\" :name=\"'filter-severity-' + option\" x-model=\"filters.severities\" :value=\"option\" @change=\"applyFilters()\" class=\"h-4 w-4 text-blue-600 focus:ring-blue-500 border-gray-300 rounded mr-3\"> <span class=\"flex items-center\"><div class=\"w-2 h-2 rounded-full mr-2\" :class=\"{\n\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t 'bg-red-500': option === 'critical',\n\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t 'bg-yellow-500': option === 'warning',\n\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t 'bg-blue-500': option === 'info'\n\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t }\" :style=\"getSeverityDotStyle(option)\"></div><span x-text=\"option\"></span></span></label></template><div x-show=\"metadata.availableFilters.severities.filter(item => searchTerm === '' || item.toLowerCase().includes(searchTerm.toLowerCase())).length === 0\" class=\"px-4 py-2 text-sm text-gray-500 dark:text-gray-400\"><span x-show=\"searchTerm === ''\">No severities available</span> <span x-show=\"searchTerm !== ''\">No matching severities</span></div></div></div></div></div><!-- Alert Name Filter --><div class=\"relative\" x-data=\"{ open: false, searchTerm: '' }\"><button @click=\"open = !open\" type=\"button\" class=\"inline-flex items-center px-4 py-2 border border-gray-300 dark:border-dark-border-DEFAULT rounded-md shadow-sm bg-white dark:bg-dark-bg-tertiary text-sm font-medium text-gray-700 dark:text-gray-200 hover:bg-gray-50 dark:hover:bg-dark-bg-secondary focus:outline-none focus:ring-2 focus:ring-offset-2 focus:ring-blue-500 relative\" :class=\"{ 'ring-2 ring-blue-500 ring-opacity-50': filters.alertNames.length > 0 }\"><span>Alert Name</span> <span x-show=\"filters.alertNames.length > 0\" class=\"ml-2 inline-flex items-center px-2 py-0.5 rounded-full text-xs font-medium bg-blue-100 text-blue-800 dark:bg-blue-900 dark:text-blue-200\" x-text=\"filters.alertNames.length\"></span> <svg class=\"ml-2 -mr-1 h-4 w-4\" viewBox=\"0 0 24 24\" stroke-width=\"1.5\" stroke=\"currentColor\" fill=\"none\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" d=\"m19.5 8.25-7.5 7.5-7.5-7.5\"></path></svg></button><div x-show=\"open\" x-cloak @click.away=\"open = false; searchTerm = ''\" x-transition class=\"absolute right-0 mt-2 w-72 rounded-md shadow-lg bg-white dark:bg-dark-bg-secondary ring-1 ring-black ring-opacity-5 z-50\"><div class=\"py-2\"><div class=\"px-4 py-2 border-b border-gray-200 dark:border-dark-border-DEFAULT\"><div class=\"flex items-center justify-between mb-2\"><span class=\"text-sm font-medium text-gray-700 dark:text-gray-200\">Alert Names</span> <button x-text=\"`Clear (${filters.alertNames.length})`\" @click=\"clearFilter('alertNames'); searchTerm = ''\" class=\"text-xs text-blue-600 dark:text-blue-400 hover:text-blue-500\" x-show=\"filters.alertNames.length > 0\"></button></div><!-- Search input for alert names --><div class=\"relative\"><input type=\"text\" id=\"filter-alertname-search\" name=\"filter-alertname-search\" x-model=\"searchTerm\" placeholder=\"Search alert names...\" class=\"w-full px-3 py-1.5 text-sm border border-gray-300 dark:border-dark-border-DEFAULT rounded-md bg-white dark:bg-dark-bg-tertiary text-gray-900 dark:text-white placeholder-gray-500 dark:placeholder-gray-400 focus:outline-none focus:ring-1 focus:ring-blue-500 focus:border-blue-500\"> <svg class=\"absolute right-2 top-1/2 -translate-y-1/2 h-4 w-4 text-gray-400\" viewBox=\"0 0 24 24\" stroke-width=\"1.5\" stroke=\"currentColor\" fill=\"none\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" d=\"m21 21-5.197-5.197m0 0A7.5 7.5 0 1 0 5.196 5.196a7.5 7.5 0 0 0 10.607 10.607Z\"></path></svg></div></div><div class=\"max-h-48 overflow-y-auto\"><template x-for=\"option in metadata.availableFilters.alertNames.filter(name => searchTerm === '' || name.toLowerCase().includes(searchTerm.toLowerCase()))\" x-key=\"option\"><label :for=\"'filter-alertname-' + option\" class=\"flex items-center px-4 py-2 text-sm text-gray-700 dark:text-gray-200 hover:bg-gray-100 dark:hover:bg-dark-bg-tertiary cursor-pointer\"><input type=\"checkbox\" :id=\"'filter-alertname-' + option\" :name=\"'filter-alertname-' + option\" x-model=\"filters.alertNames\" :value=\"option\" @change=\"applyFilters()\" class=\"h-4 w-4 text-blue-600 focus:ring-blue-500 border-gray-300 rounded mr-3\"> <span x-text=\"option\" class=\"truncate\"></span></label></template><div x-show=\"metadata.availableFilters.alertNames.filter(name => searchTerm === '' || name.toLowerCase().includes(searchTerm.toLowerCase())).length === 0\" class=\"px-4 py-2 text-sm text-gray-500 dark:text-gray-400\"><span x-show=\"searchTerm === ''\">No alert names available</span> <span x-show=\"searchTerm !== ''\">No matching alert names</span></div></div></div></div></div><!-- Status Filter --><div class=\"relative\" x-data=\"{ open: false, searchTerm: '' }\"><button @click=\"open = !open\" type=\"button\" class=\"inline-flex items-center px-4 py-2 border border-gray-300 dark:border-dark-border-DEFAULT rounded-md shadow-sm bg-white dark:bg-dark-bg-tertiary text-sm font-medium text-gray-700 dark:text-gray-200 hover:bg-gray-50 dark:hover:bg-dark-bg-secondary focus:outline-none focus:ring-2 focus:ring-offset-2 focus:ring-blue-500 relative\" :class=\"{ 'ring-2 ring-blue-500 ring-opacity-50': filters.statuses.length > 0 }\"><span>Status</span> <span x-show=\"filters.statuses.length > 0\" class=\"ml-2 inline-flex items-center px-2 py-0.5 rounded-full text-xs font-medium bg-blue-100 text-blue-800 dark:bg-blue-900 dark:text-blue-200\" x-text=\"filters.statuses.length\"></span> <svg class=\"ml-2 -mr-1 h-4 w-4\" viewBox=\"0 0 24 24\" stroke-width=\"1.5\" stroke=\"currentColor\" fill=\"none\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" d=\"m19.5 8.25-7.5 7.5-7.5-7.5\"></path></svg></button><div x-show=\"open\" x-cloak @click.away=\"open = false; searchTerm = ''\" x-transition class=\"absolute right-0 mt-2 w-72 rounded-md shadow-lg bg-white dark:bg-dark-bg-secondary ring-1 ring-black ring-opacity-5 z-50\"><div class=\"py-2\"><div class=\"px-4 py-2 border-b border-gray-200 dark:border-dark-border-DEFAULT\"><div class=\"flex items-center justify-between mb-2\"><span class=\"text-sm font-medium text-gray-700 dark:text-gray-200\">Alert Status</span> <button x-text=\"`Clear (${filters.statuses.length})`\" @click=\"clearFilter('statuses'); searchTerm = ''\" class=\"text-xs text-blue-600 dark:text-blue-400 hover:text-blue-500\" x-show=\"filters.statuses.length > 0\"></button></div><!-- Search input for statuses --><div class=\"relative\"><input type=\"text\" id=\"filter-status-search\" name=\"filter-status-search\" x-model=\"searchTerm\" placeholder=\"Search statuses...\" class=\"w-full px-3 py-1.5 text-sm border border-gray-300 dark:border-dark-border-DEFAULT rounded-md bg-white dark:bg-dark-bg-tertiary text-gray-900 dark:text-white placeholder-gray-500 dark:placeholder-gray-400 focus:outline-none focus:ring-1 focus:ring-blue-500 focus:border-blue-500\"> <svg class=\"absolute right-2 top-1/2 -translate-y-1/2 h-4 w-4 text-gray-400\" viewBox=\"0 0 24 24\" stroke-width=\"1.5\" stroke=\"currentColor\" fill=\"none\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" d=\"m21 21-5.197-5.197m0 0A7.5 7.5 0 1 0 5.196 5.196a7.5 7.5 0 0 0 10.607 10.607Z\"></path></svg></div></div><div class=\"max-h-48 overflow-y-auto\"><template x-for=\"option in metadata.availableFilters.statuses.filter(item => searchTerm === '' || item.toLowerCase().includes(searchTerm.toLowerCase()))\" x-key=\"option\"><label :for=\"'filter-status-' + option\" class=\"flex items-center px-4 py-2 text-sm text-gray-700 dark:text-gray-200 hover:bg-gray-100 dark:hover:bg-dark-bg-tertiary cursor-pointer\"><input type=\"checkbox\" :id=\"'filter-status-' + option\" :name=\"'filter-status-' + option\" x-model=\"filters.statuses\" :value=\"option\" @change=\"applyFilters()\" class=\"h-4 w-4 text-blue-600 focus:ring-blue-500 border-gray-300 rounded mr-3\"> <span class=\"flex items-center\"><div class=\"flex items-center mr-2\"><div x-show=\"option !== 'silenced'\" class=\"w-2 h-2 rounded-full\" :class=\"{\n\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t 'bg-red-500': option === 'firing',\n\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t 'bg-green-500': option === 'resolved'\n\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t\t }\"></div><svg x-show=\"option === 'silenced'\" class=\"w-3 h-3 text-purple-600\" viewBox=\"0 0 24 24\" stroke-width=\"1.5\" stroke=\"currentColor\" fill=\"none\" title=\"Silenced\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" d=\"M17.25 9.75 19.5 12m0 0 2.25 2.25M19.5 12l2.25-2.25M19.5 12l-2.25 2.25m-10.5-6 4.72-4.72a.75.75 0 0 1 1.28.53v15.88a.75.75 0 0 1-1.28.53l-4.72-4.72H4.51c-.88 0-1.59-.79-1.59-1.78V9.51c0-.88.79-1.59 1.78-1.59h1.78Z\"></path></svg></div><span x-text=\"option\"></span></span></label></template><div x-show=\"metadata.availableFilters.statuses.filter(item => searchTerm === '' || item.toLowerCase().includes(searchTerm.toLowerCase())).length === 0\" class=\"px-4 py-2 text-sm text-gray-500 dark:text-gray-400\"><span x-show=\"searchTerm === ''\">No statuses available</span> <span x-show=\"searchTerm !== ''\">No matching statuses</span></div></div></div></div></div><!-- Alertmanager Filter --><div class=\"relative\" x-data=\"{ open: false, searchTerm: '' }\"><button @click=\"open = !open\" type=\"button\" class=\"inline-flex items-center px-4 py-2 border border-gray-300 dark:border-dark-border-DEFAULT rounded-md shadow-sm bg-white dark:bg-dark-bg-tertiary text-sm font-medium text-gray-700 dark:text-gray-200 hover:bg-gray-50 dark:hover:bg-dark-bg-secondary focus:outline-none focus:ring-2 focus:ring-offset-2 focus:ring-blue-500 relative\" :class=\"{ 'ring-2 ring-blue-500 ring-opacity-50': filters.alertmanagers.length > 0 }\"><span>Alertmanager</span> <span x-show=\"filters.alertmanagers.length > 0\" class=\"ml-2 inline-flex items-center px-2 py-0.5 rounded-full text-xs font-medium bg-blue-100 text-blue-800 dark:bg-blue-900 dark:text-blue-200\" x-text=\"filters.alertmanagers.length\"></span> <svg class=\"ml-2 -mr-1 h-4 w-4\" viewBox=\"0 0 24 24\" stroke-width=\"1.5\" stroke=\"currentColor\" fill=\"none\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" d=\"m19.5 8.25-7.5 7.5-7.5-7.5\"></path></svg></button><div x-show=\"open\" x-cloak @click.away=\"open = false; searchTerm = ''\" x-transition class=\"absolute right-0 mt-2 w-72 rounded-md shadow-lg bg-white dark:bg-dark-bg-secondary ring-1 ring-black ring-opacity-5 z-50\"><div class=\"py-2\"><div class=\"px-4 py-2 border-b border-gray-200 dark:border-dark-border-DEFAULT\"><div class=\"flex items-center justify-between mb-2\"><span class=\"text-sm font-medium text-gray-700 dark:text-gray-200\">Alertmanager Sources</span> <button x-text=\"`Clear (${filters.alertmanagers.length})`\" @click=\"clearFilter('alertmanagers'); searchTerm = ''\" class=\"text-xs text-blue-600 dark:text-blue-400 hover:text-blue-500\" x-show=\"filters.alertmanagers.length > 0\"></button></div><!-- Search input for alertmanagers --><div class=\"relative\"><input type=\"text\" id=\"filter-alertmanager-search\" name=\"filter-alertmanager-search\" x-model=\"searchTerm\" placeholder=\"Search alertmanagers...\" class=\"w-full px-3 py-1.5 text-sm border border-gray-300 dark:border-dark-border-DEFAULT rounded-md bg-white dark:bg-dark-bg-tertiary text-gray-900 dark:text-white placeholder-gray-500 dark:placeholder-gray-400 focus:outline-none focus:ring-1 focus:ring-blue-500 focus:border-blue-500\"> <svg class=\"absolute right-2 top-1/2 -translate-y-1/2 h-4 w-4 text-gray-400\" viewBox=\"0 0 24 24\" stroke-width=\"1.5\" stroke=\"currentColor\" fill=\"none\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" d=\"m21 21-5.197-5.197m0 0A7.5 7.5 0 1 0 5.196 5.196a7.5 7.5 0 0 0 10.607 10.607Z\"></path></svg></div></div><div class=\"max-h-48 overflow-y-auto\"><template x-for=\"option in metadata.availableFilters.alertmanagers.filter(item => searchTerm === '' || item.toLowerCase().includes(searchTerm.toLowerCase()))\" x-key=\"option\"><label :for=\"'filter-alertmanager-' + option\" class=\"flex items-center px-4 py-2 text-sm text-gray-700 dark:text-gray-200 hover:bg-gray-100 dark:hover:bg-dark-bg-tertiary cursor-pointer\"><input type=\"checkbox\" :id=\"'filter-alertmanager-' + option\" :name=\"'filter-alertmanager-' + option\" x-model=\"filters.alertmanagers\" :value=\"option\" @change=\"applyFilters()\" class=\"h-4 w-4 text-blue-600 focus:ring-blue-500 border-gray-300 rounded mr-3\"> <span x-text=\"option\" class=\"truncate\"></span></label></template><div x-show=\"metadata.availableFilters.alertmanagers.filter(item => searchTerm === '' || item.toLowerCase().includes(searchTerm.toLowerCase())).length === 0\" class=\"px-4 py-2 text-sm text-gray-500 dark:text-gray-400\"><span x-show=\"searchTerm === ''\">No alertmanagers available</span> <span x-show=\"searchTerm !== ''\">No matching alertmanagers</span></div></div></div></div></div><!-- Filter Preset Quick-Switcher --><div class=\"relative\" x-data=\"{ open: false }\"><button @click=\"open = !open; if (open) loadFilterPresets()\" type=\"button\" class=\"inline-flex items-center px-3 py-2 border border-gray-300 dark:border-dark-border-DEFAULT rounded-md shadow-sm bg-white dark:bg-dark-bg-tertiary text-sm font-medium text-gray-700 dark:text-gray-200 hover:bg-gray-50 dark:hover:bg-dark-bg-secondary focus:outline-none focus:ring-2 focus:ring-offset-2 focus:ring-blue-500\"><svg class=\"h-4 w-4 mr-1\" viewBox=\"0 0 24 24\" stroke-width=\"1.5\" stroke=\"currentColor\" fill=\"none\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" d=\"M17.593 3.322c1.1.128 1.907 1.077 1.907 2.185V21L12 17.25 4.5 21V5.507c0-1.108.806-2.057 1.907-2.185a48.507 48.507 0 0 1 11.186 0Z\"></path></svg> Saved Filters <svg class=\"ml-2 -mr-1 h-4 w-4\" viewBox=\"0 0 24 24\" stroke-width=\"1.5\" stroke=\"currentColor\" fill=\"none\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" d=\"m19.5 8.25-7.5 7.5-7.5-7.5\"></path></svg></button><div x-show=\"open\" x-cloak @click.away=\"open = false\" x-transition class=\"absolute left-0 mt-2 w-72 rounded-md shadow-lg bg-white dark:bg-dark-bg-secondary ring-1 ring-black ring-opacity-5 z-50\"><div class=\"py-2\"><div class=\"max-h-64 overflow-y-auto\"><template x-for=\"preset in presets\" :key=\"preset.id\"><button @click=\"open = false; applyFilterPreset(preset)\" type=\"button\" class=\"w-full flex items-center px-4 py-2 text-left text-sm text-gray-700 dark:text-gray-200 hover:bg-gray-50 dark:hover:bg-dark-bg-tertiary\" :class=\"{ 'font-semibold text-blue-700 dark:text-blue-300': preset.id === activeFilterPresetId }\"><span class=\"flex-1 truncate\" x-text=\"preset.name\"></span> <span x-show=\"preset.is_default\" class=\"ml-2 inline-flex items-center px-1.5 py-0.5 rounded text-xs font-medium bg-blue-100 text-blue-800 dark:bg-blue-900 dark:text-blue-200\">Default</span> <span x-show=\"preset.is_shared\" class=\"ml-2 inline-flex items-center px-1.5 py-0.5 rounded text-xs font-medium bg-green-100 text-green-800 dark:bg-green-900 dark:text-green-200\">Shared</span></button></template><div x-show=\"presets.length === 0\" class=\"px-4 py-2 text-sm text-gray-500 dark:text-gray-400\">No saved filters yet</div></div><div class=\"mt-2 pt-2 border-t border-gray-200 dark:border-dark-border-DEFAULT\"><button @click=\"open = false; openSavePresetForm()\" type=\"button\" class=\"w-full px-4 py-2 text-left text-sm text-gray-700 dark:text-gray-200 hover:bg-gray-50 dark:hover:bg-dark-bg-tertiary\">Save current filters as preset…</button> <button @click=\"open = false; saveActivePreset()\" type=\"button\" x-show=\"canUpdateActivePreset()\" class=\"w-full px-4 py-2 text-left text-sm text-gray-700 dark:text-gray-200 hover:bg-gray-50 dark:hover:bg-dark-bg-tertiary\">Update \"<span x-text=\"activePresetName\"></span>\"</button> <button @click=\"open = false; activeTab = 'list'; showFilterPresetsModal = true\" type=\"button\" class=\"w-full px-4 py-2 text-left text-sm text-gray-700 dark:text-gray-200 hover:bg-gray-50 dark:hover:bg-dark-bg-tertiary\">Manage presets…</button></div></div></div></div><!-- Active Filter Preset Indicator --><div x-show=\"activePresetName\" class=\"inline-flex items-center px-3 py-1.5 bg-green-100 dark:bg-green-900/30 border border-green-300 dark:border-green-700 rounded-md\"><svg class=\"h-4 w-4 mr-1.5 text-green-600 dark:text-green-400\" viewBox=\"0 0 24 24\" stroke-width=\"1.5\" stroke=\"currentColor\" fill=\"none\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" d=\"M9 12.75 11.25 15 15 9.75M21 12a9 9 0 1 1-18 0 9 9 0 0 1 18 0Z\"></path></svg> <span class=\"text-sm font-medium text-green-700 dark:text-green-300\">Filter: <span x-text=\"activePresetName\"></span></span><!-- Filter hidden count badge --><span x-show=\"filterHiddenAlerts.length > 0 || filterHiddenRules.length > 0\" class=\"ml-1.5 inline-flex items-center px-1.5 py-0.5 rounded text-xs font-medium bg-amber-100 text-amber-800 dark:bg-amber-900/50 dark:text-amber-300\"><svg class=\"h-3 w-3 mr-0.5\" viewBox=\"0 0 24 24\" stroke-width=\"1.5\" stroke=\"currentColor\" fill=\"none\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" d=\"M3.98 8.223A10.477 10.477 0 0 0 1.934 12C3.226 16.338 7.244 19.5 12 19.5c.993 0 1.953-.138 2.863-.395M6.228 6.228A10.451 10.451 0 0 1 12 4.5c4.756 0 8.773 3.162 10.065 7.498a10.522 10.522 0 0 1-4.293 5.774M6.228 6.228 3 3m3.228 3.228 3.65 3.65m7.894 7.894L21 21m-3.228-3.228-3.65-3.65m0 0a3 3 0 1 0-4.243-4.243m4.242 4.242L9.88 9.88\"></path></svg> <span x-text=\"filterHiddenAlerts.length + filterHiddenRules.length\"></span></span> <button @click=\"clearFilterHiddenState(); activePresetName = null; clearAllFilters()\" class=\"ml-2 inline-flex items-center p-0.5 text-green-600 dark:text-green-400 hover:text-green-700 dark:hover:text-green-300\"><svg class=\"h-3.5 w-3.5\" viewBox=\"0 0 24 24\" stroke-width=\"2\" stroke=\"currentColor\" fill=\"none\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" d=\"M6 18 18 6M6 6l12 12\"></path></svg></button></div><!-- Clear All Filters --><button @click=\"clearAllFilters()\" x-show=\"hasActiveFilters()\" class=\"inline-flex items-center px-3 py-2 border border-gray-300 dark:border-dark-border-DEFAULT rounded-md shadow-sm bg-white dark:bg-dark-bg-tertiary text-sm font-medium text-gray-700 dark:text-gray-200 hover:bg-gray-50 dark:hover:bg-dark-bg-secondary focus:outline-none focus:ring-2 focus:ring-offset-2 focus:ring-red-500\"><svg class=\"h-4 w-4 mr-1\" viewBox=\"0 0 24 24\" stroke-width=\"1.5\" stroke=\"currentColor\" fill=\"none\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" d=\"M6 18 18 6M6 6l12 12\"></path></svg> Clear All</button></div></div></div><!-- Active Filters Display --><div x-show=\"hasActiveFilters()\" class=\"px-6 py-3 bg-blue-50 dark:bg-blue-900/20 border-b border-gray-200 dark:border-dark-border-DEFAULT\"><div class=\"flex flex-wrap items-center gap-2\"><span class=\"text-sm text-gray-600 dark:text-gray-300 font-medium\">Active filters:</span><!-- Search Filter Tag --><span x-show=\"searchQuery.length > 0\" class=\"inline-flex items-center px-2.5 py-0.5 rounded-full text-xs font-medium bg-blue-100 text-blue-800 dark:bg-blue-800 dark:text-blue-200\"><svg class=\"w-3 h-3 mr-1\" viewBox=\"0 0 24 24\" stroke-width=\"1.5\" stroke=\"currentColor\" fill=\"none\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" d=\"m21 21-5.197-5.197m0 0A7.5 7.5 0 1 0 5.196 5.196a7.5 7.5 0 0 0 10.607 10.607Z\"></path></svg> <span x-text=\"'Search: ' + searchQuery\"></span> <button @click=\"searchQuery = ''; applyFilters()\" class=\"ml-1 inline-flex items-center p-0.5 text-blue-600 dark:text-blue-300 hover:text-blue-500\"><svg class=\"w-3 h-3\" viewBox=\"0 0 24 24\" stroke-width=\"1.5\" stroke=\"currentColor\" fill=\"none\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" d=\"M6 18 18 6M6 6l12 12\"></path></svg></button></span><!-- Filter Tags -->")
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
@@ -80,7 +80,7 @@ func NewDashboardContent() templ.Component {
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
-		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 3, "</div></div></div><!-- Alerts Content (Classic/Acknowledge/Hidden modes) --><div x-show=\"displayMode !== 'resolved'\" class=\"bg-white dark:bg-dark-bg-secondary shadow overflow-hidden sm:rounded-lg\"><!-- Bulk Actions Bar --><div x-show=\"selectedAlerts.length > 0\" class=\"px-6 py-3 bg-yellow-50 dark:bg-yellow-900/20 border-b border-gray-200 dark:border-dark-border-DEFAULT\"><div class=\"flex items-center justify-between\"><div class=\"flex items-center\"><span class=\"text-sm font-medium text-gray-900 dark:text-white\" x-text=\"selectedAlerts.length + ' selected'\"></span> <button @click=\"selectAll()\" class=\"ml-3 text-sm text-blue-600 dark:text-blue-400 hover:text-blue-500\">Select All</button> <button @click=\"clearSelection()\" class=\"ml-3 text-sm text-gray-600 dark:text-gray-400 hover:text-gray-500\">Clear</button></div><div class=\"flex items-center space-x-3\"><button @click=\"acknowledgeSelected()\" class=\"inline-flex items-center px-3 py-1.5 border border-green-300 text-sm leading-4 font-medium rounded text-green-700 bg-green-50 hover:bg-green-100 focus:outline-none focus:ring-2 focus:ring-offset-2 focus:ring-green-500\"><svg class=\"h-4 w-4 mr-1\" viewBox=\"0 0 24 24\" stroke-width=\"1.5\" stroke=\"currentColor\" fill=\"none\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" d=\"M4.5 12.75l6 6 9-13.5\"></path></svg> Acknowledge</button> <button @click=\"unacknowledgeSelected()\" class=\"inline-flex items-center px-3 py-1.5 border border-orange-300 text-sm leading-4 font-medium rounded text-orange-700 bg-orange-50 hover:bg-orange-100 focus:outline-none focus:ring-2 focus:ring-offset-2 focus:ring-orange-500\"><svg class=\"h-4 w-4 mr-1\" viewBox=\"0 0 24 24\" stroke-width=\"1.5\" stroke=\"currentColor\" fill=\"none\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" d=\"M6 18 18 6M6 6l12 12\"></path></svg> Unacknowledge</button><!-- Silence Button (show when unsilenced alerts are selected) --><button @click=\"silenceSelected()\" x-show=\"hasUnsilencedAlertsSelected()\" class=\"inline-flex items-center px-3 py-1.5 border border-purple-300 text-sm leading-4 font-medium rounded text-purple-700 bg-purple-50 hover:bg-purple-100 focus:outline-none focus:ring-2 focus:ring-offset-2 focus:ring-purple-500\"><!-- Heroicon: speaker-x-mark --><svg class=\"h-4 w-4 mr-1\" viewBox=\"0 0 24 24\" stroke-width=\"1.5\" stroke=\"currentColor\" fill=\"none\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" d=\"M17.25 9.75 19.5 12m0 0 2.25 2.25M19.5 12l2.25-2.25M19.5 12l-2.25 2.25m-10.5-6 4.72-4.72a.75.75 0 0 1 1.28.53v15.88a.75.75 0 0 1-1.28.53l-4.72-4.72H4.51c-.88 0-1.59-.79-1.59-1.78V9.51c0-.88.79-1.59 1.78-1.59h1.78Z\"></path></svg> Silence</button><!-- Unsilence Button (show when silenced alerts are selected) --><button @click=\"unsilenceSelected()\" x-show=\"hasSilencedAlertsSelected()\" class=\"inline-flex items-center px-3 py-1.5 border border-orange-300 text-sm leading-4 font-medium rounded text-orange-700 bg-orange-50 hover:bg-orange-100 focus:outline-none focus:ring-2 focus:ring-offset-2 focus:ring-orange-500\"><!-- Heroicon: speaker-wave --><svg class=\"h-4 w-4 mr-1\" viewBox=\"0 0 24 24\" stroke-width=\"1.5\" stroke=\"currentColor\" fill=\"none\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" d=\"M19.114 5.636a9 9 0 0 1 0 12.728M16.463 8.288a5.25 5.25 0 0 1 0 7.424M6.75 8.25l4.72-4.72a.75.75 0 0 1 1.28.53v15.88a.75.75 0 0 1-1.28.53l-4.72-4.72H4.51c-.88 0-1.59-.79-1.59-1.78V9.51c0-.88.79-1.59 1.78-1.59h1.78Z\"></path></svg> Unsilence</button> <button @click=\"resolveSelected()\" class=\"inline-flex items-center px-3 py-1.5 border border-blue-300 text-sm leading-4 font-medium rounded text-blue-700 bg-blue-50 hover:bg-blue-100 focus:outline-none focus:ring-2 focus:ring-offset-2 focus:ring-blue-500\"><svg class=\"h-4 w-4 mr-1\" viewBox=\"0 0 24 24\" stroke-width=\"1.5\" stroke=\"currentColor\" fill=\"none\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" d=\"M9 12.75 11.25 15 15 9.75M21 12a9 9 0 1 1-18 0 9 9 0 0 1 18 0Z\"></path></svg> Resolve</button><!-- Hide in Filter Button (show when filter is active) --><button @click=\"hideSelectedInFilter()\" x-show=\"activeFilterPresetId\" class=\"inline-flex items-center px-3 py-1.5 border border-amber-300 text-sm leading-4 font-medium rounded text-amber-700 bg-amber-50 hover:bg-amber-100 focus:outline-none focus:ring-2 focus:ring-offset-2 focus:ring-amber-500\"><!-- Heroicon: eye-slash --><svg class=\"h-4 w-4 mr-1\" viewBox=\"0 0 24 24\" stroke-width=\"1.5\" stroke=\"currentColor\" fill=\"none\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" d=\"M3.98 8.223A10.477 10.477 0 0 0 1.934 12C3.226 16.338 7.244 19.5 12 19.5c.993 0 1.953-.138 2.863-.395M6.228 6.228A10.451 10.451 0 0 1 12 4.5c4.756 0 8.773 3.162 10.065 7.498a10.522 10.522 0 0 1-4.293 5.774M6.228 6.228 3 3m3.228 3.228 3.65 3.65m7.894 7.894L21 21m-3.228-3.228-3.65-3.65m0 0a3 3 0 1 0-4.243-4.243m4.242 4.242L9.88 9.88\"></path></svg> Hide in Filter</button></div></div></div><!-- List View --><div x-show=\"viewMode === 'list'\" class=\"relative\">")
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 3, "</div></div></div><!-- Alerts Content (Classic/Acknowledge/Hidden modes) --><div x-show=\"displayMode !== 'resolved'\" class=\"bg-white dark:bg-dark-bg-secondary shadow overflow-hidden sm:rounded-lg\"><!-- Bulk Actions Bar --><div x-show=\"selectedAlerts.length > 0\" class=\"px-6 py-3 bg-yellow-50 dark:bg-yellow-900/20 border-b border-gray-200 dark:border-dark-border-DEFAULT\"><div class=\"flex items-center justify-between\"><div class=\"flex items-center\"><span class=\"text-sm font-medium text-gray-900 dark:text-white\" x-text=\"selectedAlerts.length + ' selected'\"></span> <button @click=\"selectAll()\" class=\"ml-3 text-sm text-blue-600 dark:text-blue-400 hover:text-blue-500\">Select All</button> <button @click=\"clearSelection()\" class=\"ml-3 text-sm text-gray-600 dark:text-gray-400 hover:text-gray-500\">Clear</button></div><div class=\"flex items-center space-x-3\"><button @click=\"acknowledgeSelected()\" class=\"inline-flex items-center px-3 py-1.5 border border-green-300 text-sm leading-4 font-medium rounded text-green-700 bg-green-50 hover:bg-green-100 focus:outline-none focus:ring-2 focus:ring-offset-2 focus:ring-green-500\"><svg class=\"h-4 w-4 mr-1\" viewBox=\"0 0 24 24\" stroke-width=\"1.5\" stroke=\"currentColor\" fill=\"none\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" d=\"M4.5 12.75l6 6 9-13.5\"></path></svg> Acknowledge</button> <button @click=\"unacknowledgeSelected()\" class=\"inline-flex items-center px-3 py-1.5 border border-orange-300 text-sm leading-4 font-medium rounded text-orange-700 bg-orange-50 hover:bg-orange-100 focus:outline-none focus:ring-2 focus:ring-offset-2 focus:ring-orange-500\"><svg class=\"h-4 w-4 mr-1\" viewBox=\"0 0 24 24\" stroke-width=\"1.5\" stroke=\"currentColor\" fill=\"none\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" d=\"M6 18 18 6M6 6l12 12\"></path></svg> Unacknowledge</button><!-- Silence Button (show when unsilenced alerts are selected) --><button @click=\"silenceSelected()\" x-show=\"hasUnsilencedAlertsSelected()\" class=\"inline-flex items-center px-3 py-1.5 border border-purple-300 text-sm leading-4 font-medium rounded text-purple-700 bg-purple-50 hover:bg-purple-100 focus:outline-none focus:ring-2 focus:ring-offset-2 focus:ring-purple-500\"><!-- Heroicon: speaker-x-mark --><svg class=\"h-4 w-4 mr-1\" viewBox=\"0 0 24 24\" stroke-width=\"1.5\" stroke=\"currentColor\" fill=\"none\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" d=\"M17.25 9.75 19.5 12m0 0 2.25 2.25M19.5 12l2.25-2.25M19.5 12l-2.25 2.25m-10.5-6 4.72-4.72a.75.75 0 0 1 1.28.53v15.88a.75.75 0 0 1-1.28.53l-4.72-4.72H4.51c-.88 0-1.59-.79-1.59-1.78V9.51c0-.88.79-1.59 1.78-1.59h1.78Z\"></path></svg> Silence</button><!-- Unsilence Button (show when silenced alerts are selected) --><button @click=\"unsilenceSelected()\" x-show=\"hasSilencedAlertsSelected()\" class=\"inline-flex items-center px-3 py-1.5 border border-orange-300 text-sm leading-4 font-medium rounded text-orange-700 bg-orange-50 hover:bg-orange-100 focus:outline-none focus:ring-2 focus:ring-offset-2 focus:ring-orange-500\"><!-- Heroicon: speaker-wave --><svg class=\"h-4 w-4 mr-1\" viewBox=\"0 0 24 24\" stroke-width=\"1.5\" stroke=\"currentColor\" fill=\"none\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" d=\"M19.114 5.636a9 9 0 0 1 0 12.728M16.463 8.288a5.25 5.25 0 0 1 0 7.424M6.75 8.25l4.72-4.72a.75.75 0 0 1 1.28.53v15.88a.75.75 0 0 1-1.28.53l-4.72-4.72H4.51c-.88 0-1.59-.79-1.59-1.78V9.51c0-.88.79-1.59 1.78-1.59h1.78Z\"></path></svg> Unsilence</button> <button @click=\"resolveSelected()\" class=\"inline-flex items-center px-3 py-1.5 border border-blue-300 text-sm leading-4 font-medium rounded text-blue-700 bg-blue-50 hover:bg-blue-100 focus:outline-none focus:ring-2 focus:ring-offset-2 focus:ring-blue-500\"><svg class=\"h-4 w-4 mr-1\" viewBox=\"0 0 24 24\" stroke-width=\"1.5\" stroke=\"currentColor\" fill=\"none\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" d=\"M9 12.75 11.25 15 15 9.75M21 12a9 9 0 1 1-18 0 9 9 0 0 1 18 0Z\"></path></svg> Resolve</button> <button @click=\"openSnoozeModal(selectedAlerts)\" class=\"inline-flex items-center px-3 py-1.5 border border-indigo-200 text-sm leading-4 font-medium rounded text-indigo-700 bg-indigo-50 hover:bg-indigo-200 focus:outline-none focus:ring-2 focus:ring-offset-2 focus:ring-indigo-500\"><!-- Heroicon: clock --><svg class=\"h-4 w-4 mr-1\" viewBox=\"0 0 24 24\" stroke-width=\"1.5\" stroke=\"currentColor\" fill=\"none\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" d=\"M12 6v6h4.5m4.5 0a9 9 0 1 1-18 0 9 9 0 0 1 18 0Z\"></path></svg> Snooze</button><!-- Hide in Filter Button (show when filter is active) --><button @click=\"hideSelectedInFilter()\" x-show=\"activeFilterPresetId\" class=\"inline-flex items-center px-3 py-1.5 border border-amber-300 text-sm leading-4 font-medium rounded text-amber-700 bg-amber-50 hover:bg-amber-100 focus:outline-none focus:ring-2 focus:ring-offset-2 focus:ring-amber-500\"><!-- Heroicon: eye-slash --><svg class=\"h-4 w-4 mr-1\" viewBox=\"0 0 24 24\" stroke-width=\"1.5\" stroke=\"currentColor\" fill=\"none\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" d=\"M3.98 8.223A10.477 10.477 0 0 0 1.934 12C3.226 16.338 7.244 19.5 12 19.5c.993 0 1.953-.138 2.863-.395M6.228 6.228A10.451 10.451 0 0 1 12 4.5c4.756 0 8.773 3.162 10.065 7.498a10.522 10.522 0 0 1-4.293 5.774M6.228 6.228 3 3m3.228 3.228 3.65 3.65m7.894 7.894L21 21m-3.228-3.228-3.65-3.65m0 0a3 3 0 1 0-4.243-4.243m4.242 4.242L9.88 9.88\"></path></svg> Hide in Filter</button></div></div></div><!-- List View --><div x-show=\"viewMode === 'list'\" class=\"relative\">")
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
@@ -120,15 +120,51 @@ func NewDashboardContent() templ.Component {
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
+		templ_7745c5c3_Err = components.SnoozeModal().Render(ctx, templ_7745c5c3_Buffer)
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		templ_7745c5c3_Err = components.ShareAlertModal().Render(ctx, templ_7745c5c3_Buffer)
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		templ_7745c5c3_Err = components.CommandPalette().Render(ctx, templ_7745c5c3_Buffer)
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		templ_7745c5c3_Err = components.EscalationModal().Render(ctx, templ_7745c5c3_Buffer)
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		templ_7745c5c3_Err = components.MentionToast().Render(ctx, templ_7745c5c3_Buffer)
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		templ_7745c5c3_Err = components.UndoToast().Render(ctx, templ_7745c5c3_Buffer)
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
 		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 8, "<!-- Silence Modal -->")
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
+		templ_7745c5c3_Err = components.SilencesViewModal().Render(ctx, templ_7745c5c3_Buffer)
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
 		templ_7745c5c3_Err = components.SilenceModal().Render(ctx, templ_7745c5c3_Buffer)
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
-		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 9, "<!-- Alert Details Modal -->")
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 9, "<!-- Resolved Alerts Archive (below the details modal it opens) -->")
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		templ_7745c5c3_Err = components.ResolvedArchiveModal().Render(ctx, templ_7745c5c3_Buffer)
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 10, "<!-- Alert Details Modal -->")
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
@@ -136,7 +172,7 @@ func NewDashboardContent() templ.Component {
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
-		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 10, "<!-- Filter Presets Modal -->")
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 11, "<!-- Filter Presets Modal -->")
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
@@ -144,7 +180,7 @@ func NewDashboardContent() templ.Component {
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
-		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 11, "<!-- Column Config Modal -->")
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 12, "<!-- Column Config Modal -->")
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
@@ -152,7 +188,7 @@ func NewDashboardContent() templ.Component {
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
-		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 12, "</div>")
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 13, "</div>")
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
@@ -168,6 +204,18 @@ func NewDashboardContent() templ.Component {
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
+		templ_7745c5c3_Err = scripts.DashboardResolvedArchiveMixin().Render(ctx, templ_7745c5c3_Buffer)
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		templ_7745c5c3_Err = scripts.DashboardSilencesMixin().Render(ctx, templ_7745c5c3_Buffer)
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		templ_7745c5c3_Err = scripts.DashboardCommandPaletteMixin().Render(ctx, templ_7745c5c3_Buffer)
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
 		templ_7745c5c3_Err = scripts.DashboardCore().Render(ctx, templ_7745c5c3_Buffer)
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
@@ -305,7 +305,30 @@ func ProfileContent(data ProfileData) templ.Component {
 				return templ_7745c5c3_Err
 			}
 		}
-		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 23, "<button hx-post=\"/api/v1/auth/logout\" hx-trigger=\"click\" hx-on::after-request=\"handleLogoutResponse(event)\" class=\"inline-flex items-center justify-center px-4 py-2 border border-red-300 dark:border-red-800 shadow-sm text-sm font-medium rounded-md text-red-700 dark:text-red-400 bg-white dark:bg-dark-bg-tertiary hover:bg-red-50 dark:hover:bg-red-900/20 focus:outline-none focus:ring-2 focus:ring-offset-2 focus:ring-red-500 transition-colors\"><svg class=\"mr-2 h-4 w-4\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M17 16l4-4m0 0l-4-4m4 4H7m6 4v1a3 3 0 01-3 3H6a3 3 0 01-3-3V7a3 3 0 013-3h4a3 3 0 013 3v1\"></path></svg> Sign Out</button></div></div></div></div></div></div><script>\n\t\tfunction profilePage() {\n\t\t\treturn {\n\t\t\t\tshowToast: false,\n\t\t\t\tuserId: '{ data.User.ID }',\n\t\t\t\t\n\t\t\t\tcopyUserId() {\n\t\t\t\t\tnavigator.clipboard.writeText(this.userId).then(() => {\n\t\t\t\t\t\tthis.showToast = true;\n\t\t\t\t\t\tsetTimeout(() => {\n\t\t\t\t\t\t\tthis.showToast = false;\n\t\t\t\t\t\t}, 2000);\n\t\t\t\t\t}).catch(err => {\n\t\t\t\t\t\tconsole.error('Failed to copy:', err);\n\t\t\t\t\t\t// Fallback for older browsers\n\t\t\t\t\t\tconst input = document.createElement('input');\n\t\t\t\t\t\tinput.value = this.userId;\n\t\t\t\t\t\tdocument.body.appendChild(input);\n\t\t\t\t\t\tinput.select();\n\t\t\t\t\t\tdocument.execCommand('copy');\n\t\t\t\t\t\tdocument.body.removeChild(input);\n\t\t\t\t\t\tthis.showToast = true;\n\t\t\t\t\t\tsetTimeout(() => {\n\t\t\t\t\t\t\tthis.showToast = false;\n\t\t\t\t\t\t}, 2000);\n\t\t\t\t\t});\n\t\t\t\t},\n\t\t\t\t\n\t\t\t\tshowChangePassword() {\n\t\t\t\t\t// TODO: Implement change password modal\n\t\t\t\t\talert('Change password functionality coming soon!');\n\t\t\t\t}\n\t\t\t}\n\t\t}\n\t\t\n\t\tfunction handleLogoutResponse(event) {\n\t\t\tif (event.detail.successful) {\n\t\t\t\twindow.location.href = '/';\n\t\t\t}\n\t\t}\n\t</script>")
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 23, "<a href=\"/api/v1/profile/export\" class=\"inline-flex items-center justify-center px-4 py-2 border border-gray-300 dark:border-gray-600 shadow-sm text-sm font-medium rounded-md text-gray-700 dark:text-gray-200 bg-white dark:bg-dark-bg-tertiary hover:bg-gray-50 dark:hover:bg-dark-bg-secondary focus:outline-none focus:ring-2 focus:ring-offset-2 focus:ring-blue-500 transition-colors\"><svg class=\"mr-2 h-4 w-4\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M4 16v1a3 3 0 003 3h10a3 3 0 003-3v-1m-4-4l-4 4m0 0l-4-4m4 4V4\"></path></svg> Export My Data</a> <button @click=\"deleteAccount($el.dataset.username)\" data-username=\"")
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		var templ_7745c5c3_Var15 string
+		templ_7745c5c3_Var15, templ_7745c5c3_Err = templ.JoinStringErrs(data.User.Username)
+		if templ_7745c5c3_Err != nil {
+			return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/webui/templates/pages/Profile.templ`, Line: 226, Col: 94}
+		}
+		_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var15))
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 24, "\" class=\"inline-flex items-center justify-center px-4 py-2 border border-red-300 dark:border-red-800 shadow-sm text-sm font-medium rounded-md text-red-700 dark:text-red-400 bg-white dark:bg-dark-bg-tertiary hover:bg-red-50 dark:hover:bg-red-900/20 focus:outline-none focus:ring-2 focus:ring-offset-2 focus:ring-red-500 transition-colors\"><svg class=\"mr-2 h-4 w-4\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M19 7l-.867 12.142A2 2 0 0116.138 21H7.862a2 2 0 01-1.995-1.858L5 7m5 4v6m4-6v6m1-10V4a1 1 0 00-1-1h-4a1 1 0 00-1 1v3M4 7h16\"></path></svg> Delete Account</button> <button hx-post=\"/api/v1/auth/logout\" hx-trigger=\"click\" hx-on::after-request=\"handleLogoutResponse(event)\" class=\"inline-flex items-center justify-center px-4 py-2 border border-red-300 dark:border-red-800 shadow-sm text-sm font-medium rounded-md text-red-700 dark:text-red-400 bg-white dark:bg-dark-bg-tertiary hover:bg-red-50 dark:hover:bg-red-900/20 focus:outline-none focus:ring-2 focus:ring-offset-2 focus:ring-red-500 transition-colors\"><svg class=\"mr-2 h-4 w-4\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M17 16l4-4m0 0l-4-4m4 4H7m6 4v1a3 3 0 01-3 3H6a3 3 0 01-3-3V7a3 3 0 013-3h4a3 3 0 013 3v1\"></path></svg> Sign Out</button></div></div></div></div></div>")
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		if data.User.OAuthProvider == nil {
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 25, "<!-- Change Password Dialog --> <div x-show=\"passwordDialogOpen\" x-cloak class=\"fixed inset-0 z-50 flex items-center justify-center bg-black/50\" @keydown.escape.window=\"passwordDialogOpen = false\"><div class=\"w-full max-w-md bg-white dark:bg-dark-bg-secondary rounded-lg shadow-xl border border-gray-200 dark:border-dark-border-subtle p-6\" @click.outside=\"passwordDialogOpen = false\"><h3 class=\"text-lg font-medium text-gray-900 dark:text-white mb-4\">Change Password</h3><form @submit.prevent=\"submitChangePassword\" class=\"space-y-4\"><div><label for=\"old-password\" class=\"block text-sm font-medium text-gray-700 dark:text-gray-300\">Current password</label> <input id=\"old-password\" type=\"password\" x-model=\"passwordForm.oldPassword\" required autocomplete=\"current-password\" class=\"mt-1 block w-full rounded-md border-gray-300 dark:border-gray-600 dark:bg-dark-bg-tertiary dark:text-white shadow-sm focus:border-blue-500 focus:ring-blue-500 sm:text-sm\"></div><div><label for=\"new-password\" class=\"block text-sm font-medium text-gray-700 dark:text-gray-300\">New password</label> <input id=\"new-password\" type=\"password\" x-model=\"passwordForm.newPassword\" required autocomplete=\"new-password\" class=\"mt-1 block w-full rounded-md border-gray-300 dark:border-gray-600 dark:bg-dark-bg-tertiary dark:text-white shadow-sm focus:border-blue-500 focus:ring-blue-500 sm:text-sm\"></div><div><label for=\"confirm-password\" class=\"block text-sm font-medium text-gray-700 dark:text-gray-300\">Confirm new password</label> <input id=\"confirm-password\" type=\"password\" x-model=\"passwordForm.confirmPassword\" required autocomplete=\"new-password\" class=\"mt-1 block w-full rounded-md border-gray-300 dark:border-gray-600 dark:bg-dark-bg-tertiary dark:text-white shadow-sm focus:border-blue-500 focus:ring-blue-500 sm:text-sm\"></div><p x-show=\"passwordMessage.text\" x-text=\"passwordMessage.text\" :class=\"passwordMessage.type === 'error' ? 'text-red-600 dark:text-red-400' : 'text-green-600 dark:text-green-400'\" class=\"text-sm\"></p><div class=\"flex justify-end space-x-3\"><button type=\"button\" @click=\"passwordDialogOpen = false\" class=\"px-4 py-2 border border-gray-300 dark:border-gray-600 text-sm font-medium rounded-md text-gray-700 dark:text-gray-200 bg-white dark:bg-dark-bg-tertiary hover:bg-gray-50 dark:hover:bg-dark-bg-secondary\">Cancel</button> <button type=\"submit\" :disabled=\"passwordSaving\" class=\"px-4 py-2 text-sm font-medium rounded-md text-white bg-blue-600 hover:bg-blue-700 disabled:opacity-50\"><span x-text=\"passwordSaving ? 'Saving...' : 'Change Password'\"></span></button></div></form></div></div>")
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+		}
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 26, "</div><script>\n\t\tfunction profilePage() {\n\t\t\treturn {\n\t\t\t\tshowToast: false,\n\t\t\t\tuserId: '{ data.User.ID }',\n\t\t\t\t\n\t\t\t\tcopyUserId() {\n\t\t\t\t\tnavigator.clipboard.writeText(this.userId).then(() => {\n\t\t\t\t\t\tthis.showToast = true;\n\t\t\t\t\t\tsetTimeout(() => {\n\t\t\t\t\t\t\tthis.showToast = false;\n\t\t\t\t\t\t}, 2000);\n\t\t\t\t\t}).catch(err => {\n\t\t\t\t\t\tconsole.error('Failed to copy:', err);\n\t\t\t\t\t\t// Fallback for older browsers\n\t\t\t\t\t\tconst input = document.createElement('input');\n\t\t\t\t\t\tinput.value = this.userId;\n\t\t\t\t\t\tdocument.body.appendChild(input);\n\t\t\t\t\t\tinput.select();\n\t\t\t\t\t\tdocument.execCommand('copy');\n\t\t\t\t\t\tdocument.body.removeChild(input);\n\t\t\t\t\t\tthis.showToast = true;\n\t\t\t\t\t\tsetTimeout(() => {\n\t\t\t\t\t\t\tthis.showToast = false;\n\t\t\t\t\t\t}, 2000);\n\t\t\t\t\t});\n\t\t\t\t},\n\t\t\t\t\n\t\t\t\tpasswordDialogOpen: false,\n\t\t\t\tpasswordForm: { oldPassword: '', newPassword: '', confirmPassword: '' },\n\t\t\t\tpasswordMessage: { text: '', type: '' },\n\t\t\t\tpasswordSaving: false,\n\n\t\t\t\tshowChangePassword() {\n\t\t\t\t\tthis.passwordForm = { oldPassword: '', newPassword: '', confirmPassword: '' };\n\t\t\t\t\tthis.passwordMessage = { text: '', type: '' };\n\t\t\t\t\tthis.passwordDialogOpen = true;\n\t\t\t\t},\n\n\t\t\t\tasync deleteAccount(expectedUsername) {\n\t\t\t\t\tconst username = prompt('This permanently deletes your account, comments, acknowledgments and preferences.\\n\\nType your username to confirm:');\n\t\t\t\t\tif (username === null) {\n\t\t\t\t\t\treturn;\n\t\t\t\t\t}\n\t\t\t\t\tif (username !== expectedUsername) {\n\t\t\t\t\t\talert('Username does not match, account not deleted.');\n\t\t\t\t\t\treturn;\n\t\t\t\t\t}\n\n\t\t\t\t\ttry {\n\t\t\t\t\t\tconst response = await fetch('/api/v1/profile/account', { method: 'DELETE' });\n\t\t\t\t\t\tconst result = await response.json();\n\t\t\t\t\t\tif (result.success) {\n\t\t\t\t\t\t\twindow.location.href = result.data.redirect;\n\t\t\t\t\t\t} else {\n\t\t\t\t\t\t\talert(result.error);\n\t\t\t\t\t\t}\n\t\t\t\t\t} catch (error) {\n\t\t\t\t\t\talert('Network error. Please try again.');\n\t\t\t\t\t}\n\t\t\t\t},\n\n\t\t\t\tasync submitChangePassword() {\n\t\t\t\t\tif (this.passwordForm.newPassword !== this.passwordForm.confirmPassword) {\n\t\t\t\t\t\tthis.passwordMessage = { text: 'New passwords do not match', type: 'error' };\n\t\t\t\t\t\treturn;\n\t\t\t\t\t}\n\n\t\t\t\t\tthis.passwordSaving = true;\n\t\t\t\t\ttry {\n\t\t\t\t\t\tconst response = await fetch('/api/v1/profile/password', {\n\t\t\t\t\t\t\tmethod: 'POST',\n\t\t\t\t\t\t\theaders: { 'Content-Type': 'application/json' },\n\t\t\t\t\t\t\tbody: JSON.stringify({\n\t\t\t\t\t\t\t\told_password: this.passwordForm.oldPassword,\n\t\t\t\t\t\t\t\tnew_password: this.passwordForm.newPassword\n\t\t\t\t\t\t\t})\n\t\t\t\t\t\t});\n\t\t\t\t\t\tconst result = await response.json();\n\n\t\t\t\t\t\tif (result.success) {\n\t\t\t\t\t\t\tthis.passwordMessage = { text: result.data.message, type: 'success' };\n\t\t\t\t\t\t\tsetTimeout(() => {\n\t\t\t\t\t\t\t\tthis.passwordDialogOpen = false;\n\t\t\t\t\t\t\t}, 1000);\n\t\t\t\t\t\t} else {\n\t\t\t\t\t\t\tthis.passwordMessage = { text: result.error, type: 'error' };\n\t\t\t\t\t\t}\n\t\t\t\t\t} catch (error) {\n\t\t\t\t\t\tthis.passwordMessage = { text: 'Network error. Please try again.', type: 'error' };\n\t\t\t\t\t} finally {\n\t\t\t\t\t\tthis.passwordSaving = false;\n\t\t\t\t\t}\n\t\t\t\t}\n\t\t\t}\n\t\t}\n\t\t\n\t\tfunction handleLogoutResponse(event) {\n\t\t\tif (event.detail.successful) {\n\t\t\t\twindow.location.href = '/';\n\t\t\t}\n\t\t}\n\t</script>")
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
@@ -329,51 +352,51 @@ func OAuthProviderBadge(provider string) templ.Component {
 			}()
 		}
 		ctx = templ.InitializeContext(ctx)
-		templ_7745c5c3_Var15 := templ.GetChildren(ctx)
-		if templ_7745c5c3_Var15 == nil {
-			templ_7745c5c3_Var15 = templ.NopComponent
+		templ_7745c5c3_Var16 := templ.GetChildren(ctx)
+		if templ_7745c5c3_Var16 == nil {
+			templ_7745c5c3_Var16 = templ.NopComponent
 		}
 		ctx = templ.ClearChildren(ctx)
-		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 24, "<div class=\"flex items-center\">")
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 27, "<div class=\"flex items-center\">")
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
 		switch provider {
 		case "github":
-			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 25, "<svg class=\"mr-1.5 h-3 w-3\" fill=\"currentColor\" viewBox=\"0 0 20 20\"><path fill-rule=\"evenodd\" d=\"M10 0C4.477 0 0 4.484 0 10.017c0 4.425 2.865 8.18 6.839 9.504.5.092.682-.217.682-.483 0-.237-.008-.868-.013-1.703-2.782.605-3.369-1.343-3.369-1.343-.454-1.158-1.11-1.466-1.11-1.466-.908-.62.069-.608.069-.608 1.003.07 1.531 1.032 1.531 1.032.892 1.53 2.341 1.088 2.91.832.092-.647.35-1.088.636-1.338-2.22-.253-4.555-1.113-4.555-4.951 0-1.093.39-1.988 1.029-2.688-.103-.253-.446-1.272.098-2.65 0 0 .84-.27 2.75 1.026A9.564 9.564 0 0110 4.844c.85.004 1.705.115 2.504.337 1.909-1.296 2.747-1.027 2.747-1.027.546 1.379.203 2.398.1 2.651.64.7 1.028 1.595 1.028 2.688 0 3.848-2.339 4.695-4.566 4.942.359.31.678.921.678 1.856 0 1.338-.012 2.419-.012 2.747 0 .268.18.58.688.482A10.019 10.019 0 0020 10.017C20 4.484 15.522 0 10 0z\" clip-rule=\"evenodd\"></path></svg> <span>GitHub</span>")
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 28, "<svg class=\"mr-1.5 h-3 w-3\" fill=\"currentColor\" viewBox=\"0 0 20 20\"><path fill-rule=\"evenodd\" d=\"M10 0C4.477 0 0 4.484 0 10.017c0 4.425 2.865 8.18 6.839 9.504.5.092.682-.217.682-.483 0-.237-.008-.868-.013-1.703-2.782.605-3.369-1.343-3.369-1.343-.454-1.158-1.11-1.466-1.11-1.466-.908-.62.069-.608.069-.608 1.003.07 1.531 1.032 1.531 1.032.892 1.53 2.341 1.088 2.91.832.092-.647.35-1.088.636-1.338-2.22-.253-4.555-1.113-4.555-4.951 0-1.093.39-1.988 1.029-2.688-.103-.253-.446-1.272.098-2.65 0 0 .84-.27 2.75 1.026A9.564 9.564 0 0110 4.844c.85.004 1.705.115 2.504.337 1.909-1.296 2.747-1.027 2.747-1.027.546 1.379.203 2.398.1 2.651.64.7 1.028 1.595 1.028 2.688 0 3.848-2.339 4.695-4.566 4.942.359.31.678.921.678 1.856 0 1.338-.012 2.419-.012 2.747 0 .268.18.58.688.482A10.019 10.019 0 0020 10.017C20 4.484 15.522 0 10 0z\" clip-rule=\"evenodd\"></path></svg> <span>GitHub</span>")
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
 		case "google":
-			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 26, "<svg class=\"mr-1.5 h-3 w-3\" viewBox=\"0 0 24 24\"><path fill=\"#4285F4\" d=\"M22.56 12.25c0-.78-.07-1.53-.2-2.25H12v4.26h5.92c-.26 1.37-1.04 2.53-2.21 3.31v2.77h3.57c2.08-1.92 3.28-4.74 3.28-8.09z\"></path> <path fill=\"#34A853\" d=\"M12 23c2.97 0 5.46-.98 7.28-2.66l-3.57-2.77c-.98.66-2.23 1.06-3.71 1.06-2.86 0-5.29-1.93-6.16-4.53H2.18v2.84C3.99 20.53 7.7 23 12 23z\"></path> <path fill=\"#FBBC05\" d=\"M5.84 14.09c-.22-.66-.35-1.36-.35-2.09s.13-1.43.35-2.09V7.07H2.18C1.43 8.55 1 10.22 1 12s.43 3.45 1.18 4.93l2.85-2.22.81-.62z\"></path> <path fill=\"#EA4335\" d=\"M12 5.38c1.62 0 3.06.56 4.21 1.64l3.15-3.15C17.45 2.09 14.97 1 12 1 7.7 1 3.99 3.47 2.18 7.07l3.66 2.84c.87-2.6 3.3-4.53 6.16-4.53z\"></path></svg> <span>Google</span>")
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 29, "<svg class=\"mr-1.5 h-3 w-3\" viewBox=\"0 0 24 24\"><path fill=\"#4285F4\" d=\"M22.56 12.25c0-.78-.07-1.53-.2-2.25H12v4.26h5.92c-.26 1.37-1.04 2.53-2.21 3.31v2.77h3.57c2.08-1.92 3.28-4.74 3.28-8.09z\"></path> <path fill=\"#34A853\" d=\"M12 23c2.97 0 5.46-.98 7.28-2.66l-3.57-2.77c-.98.66-2.23 1.06-3.71 1.06-2.86 0-5.29-1.93-6.16-4.53H2.18v2.84C3.99 20.53 7.7 23 12 23z\"></path> <path fill=\"#FBBC05\" d=\"M5.84 14.09c-.22-.66-.35-1.36-.35-2.09s.13-1.43.35-2.09V7.07H2.18C1.43 8.55 1 10.22 1 12s.43 3.45 1.18 4.93l2.85-2.22.81-.62z\"></path> <path fill=\"#EA4335\" d=\"M12 5.38c1.62 0 3.06.56 4.21 1.64l3.15-3.15C17.45 2.09 14.97 1 12 1 7.7 1 3.99 3.47 2.18 7.07l3.66 2.84c.87-2.6 3.3-4.53 6.16-4.53z\"></path></svg> <span>Google</span>")
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
 		case "microsoft":
-			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 27, "<svg class=\"mr-1.5 h-3 w-3\" viewBox=\"0 0 24 24\"><path fill=\"#f25022\" d=\"M1 1h10v10H1z\"></path> <path fill=\"#00a4ef\" d=\"M13 1h10v10H13z\"></path> <path fill=\"#7fba00\" d=\"M1 13h10v10H1z\"></path> <path fill=\"#ffb900\" d=\"M13 13h10v10H13z\"></path></svg> <span>Microsoft</span>")
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 30, "<svg class=\"mr-1.5 h-3 w-3\" viewBox=\"0 0 24 24\"><path fill=\"#f25022\" d=\"M1 1h10v10H1z\"></path> <path fill=\"#00a4ef\" d=\"M13 1h10v10H13z\"></path> <path fill=\"#7fba00\" d=\"M1 13h10v10H1z\"></path> <path fill=\"#ffb900\" d=\"M13 13h10v10H13z\"></path></svg> <span>Microsoft</span>")
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
 		default:
-			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 28, "<span>")
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 31, "<span>")
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
-			var templ_7745c5c3_Var16 string
-			templ_7745c5c3_Var16, templ_7745c5c3_Err = templ.JoinStringErrs(provider)
+			var templ_7745c5c3_Var17 string
+			templ_7745c5c3_Var17, templ_7745c5c3_Err = templ.JoinStringErrs(provider)
 			if templ_7745c5c3_Err != nil {
-				return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/webui/templates/pages/Profile.templ`, Line: 306, Col: 20}
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/webui/templates/pages/Profile.templ`, Line: 413, Col: 20}
 			}
-			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var16))
+			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var17))
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
-			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 29, "</span>")
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 32, "</span>")
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
 		}
-		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 30, "</div>")
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 33, "</div>")
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
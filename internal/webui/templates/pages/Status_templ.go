@@ -0,0 +1,86 @@
+// Code generated by templ - DO NOT EDIT.
+
+// templ: version: v0.3.906
+package pages
+
+//lint:file-ignore SA4006 This context is only used if a nested component is present.
+
+import "github.com/a-h/templ"
+import templruntime "github.com/a-h/templ/runtime"
+
+import (
+	"notificator/internal/webui/templates/components"
+	"notificator/internal/webui/templates/layouts"
+)
+
+type StatusPageData struct {
+	User ProfileUser
+}
+
+func Status(data StatusPageData) templ.Component {
+	return templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
+		templ_7745c5c3_W, ctx := templ_7745c5c3_Input.Writer, templ_7745c5c3_Input.Context
+		if templ_7745c5c3_CtxErr := ctx.Err(); templ_7745c5c3_CtxErr != nil {
+			return templ_7745c5c3_CtxErr
+		}
+		templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templruntime.GetBuffer(templ_7745c5c3_W)
+		if !templ_7745c5c3_IsBuffer {
+			defer func() {
+				templ_7745c5c3_BufErr := templruntime.ReleaseBuffer(templ_7745c5c3_Buffer)
+				if templ_7745c5c3_Err == nil {
+					templ_7745c5c3_Err = templ_7745c5c3_BufErr
+				}
+			}()
+		}
+		ctx = templ.InitializeContext(ctx)
+		templ_7745c5c3_Var1 := templ.GetChildren(ctx)
+		if templ_7745c5c3_Var1 == nil {
+			templ_7745c5c3_Var1 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		templ_7745c5c3_Err = layouts.Base("Alertmanager Status - Notificator", StatusContent(data)).Render(ctx, templ_7745c5c3_Buffer)
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		return nil
+	})
+}
+
+func StatusContent(data StatusPageData) templ.Component {
+	return templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
+		templ_7745c5c3_W, ctx := templ_7745c5c3_Input.Writer, templ_7745c5c3_Input.Context
+		if templ_7745c5c3_CtxErr := ctx.Err(); templ_7745c5c3_CtxErr != nil {
+			return templ_7745c5c3_CtxErr
+		}
+		templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templruntime.GetBuffer(templ_7745c5c3_W)
+		if !templ_7745c5c3_IsBuffer {
+			defer func() {
+				templ_7745c5c3_BufErr := templruntime.ReleaseBuffer(templ_7745c5c3_Buffer)
+				if templ_7745c5c3_Err == nil {
+					templ_7745c5c3_Err = templ_7745c5c3_BufErr
+				}
+			}()
+		}
+		ctx = templ.InitializeContext(ctx)
+		templ_7745c5c3_Var2 := templ.GetChildren(ctx)
+		if templ_7745c5c3_Var2 == nil {
+			templ_7745c5c3_Var2 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 1, "<div class=\"min-h-screen bg-slate-50 dark:bg-dark-bg-primary\" x-data=\"alertmanagerStatusPage()\"><header class=\"sticky top-0 z-40 bg-white/95 dark:bg-dark-bg-secondary/95 backdrop-blur-sm border-b border-slate-200 dark:border-slate-700/50\"><div class=\"px-6 py-4\"><div class=\"flex items-center justify-between\"><div class=\"flex-1\"><h1 class=\"text-xl font-semibold text-slate-900 dark:text-white tracking-tight\">Alertmanager Status</h1><p class=\"text-sm text-slate-500 dark:text-slate-400\"><span x-text=\"instances.length\"></span> sources <span class=\"text-slate-300 dark:text-slate-600 mx-1\">&bull;</span> <span x-text=\"unhealthy === 0 ? 'all healthy' : unhealthy + ' unhealthy'\"></span></p></div><div class=\"hidden md:flex items-center justify-center\">")
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		templ_7745c5c3_Err = components.PageNavigator("status").Render(ctx, templ_7745c5c3_Buffer)
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 2, "</div><div class=\"flex items-center gap-2 flex-1 justify-end\"><button @click=\"load()\" :disabled=\"loading\" class=\"inline-flex items-center gap-2 px-3 py-2 text-sm font-medium text-slate-600 dark:text-slate-300 hover:bg-slate-100 dark:hover:bg-slate-800 rounded-lg transition-colors disabled:opacity-50\"><svg class=\"w-4 h-4\" :class=\"loading && 'animate-spin'\" fill=\"none\" stroke=\"currentColor\" viewBox=\"0 0 24 24\"><path stroke-linecap=\"round\" stroke-linejoin=\"round\" stroke-width=\"2\" d=\"M4 4v5h.582m15.356 2A8.001 8.001 0 004.582 9m0 0H9m11 11v-5h-.581m0 0a8.003 8.003 0 01-15.357-2m15.357 2H15\"></path></svg> <span class=\"hidden sm:inline\">Refresh</span></button></div></div></div></header><div class=\"px-6 py-6 max-w-5xl mx-auto\"><!-- Unhealthy sources keep showing their last fetched alerts --><div x-show=\"unhealthy > 0\" class=\"mb-6 p-4 bg-red-50 dark:bg-red-900/20 border border-red-200 dark:border-red-800 rounded-xl\"><p class=\"text-sm font-medium text-red-800 dark:text-red-200\"><span x-text=\"unhealthy\"></span> Alertmanager(s) cannot be reached. Their alerts are shown as last fetched and may be stale.</p></div><div x-show=\"error\" class=\"mb-6 p-4 bg-red-50 dark:bg-red-900/20 border border-red-200 dark:border-red-800 rounded-xl text-sm text-red-700 dark:text-red-400\" x-text=\"error\"></div><div class=\"grid grid-cols-1 md:grid-cols-2 lg:grid-cols-3 gap-4\"><template x-for=\"instance in instances\" :key=\"instance.name\"><div class=\"bg-white dark:bg-dark-bg-secondary rounded-2xl shadow-sm border p-5\" :class=\"instance.state === 'unhealthy' ? 'border-red-300 dark:border-red-800' : 'border-slate-200 dark:border-slate-700/50'\"><div class=\"flex items-start justify-between gap-2 mb-3\"><div class=\"min-w-0\"><h2 class=\"text-base font-semibold text-slate-900 dark:text-white truncate\" x-text=\"instance.name\"></h2><p class=\"text-xs text-slate-500 dark:text-slate-400 truncate\" x-text=\"instance.url\" :title=\"instance.url\"></p></div><span class=\"inline-flex items-center gap-1.5 px-2 py-0.5 rounded-full text-xs font-medium\" :class=\"stateBadgeClass(instance.state)\"><span class=\"w-2 h-2 rounded-full\" :class=\"stateDotClass(instance.state)\"></span> <span x-text=\"instance.state\"></span></span></div><dl class=\"grid grid-cols-2 gap-x-4 gap-y-2 text-sm\"><dt class=\"text-slate-500 dark:text-slate-400\">Latency</dt><dd class=\"text-slate-900 dark:text-white text-right\" x-text=\"instance.state === 'unknown' ? '—' : instance.latencyMs + ' ms'\"></dd><dt class=\"text-slate-500 dark:text-slate-400\">Alerts shown</dt><dd class=\"text-slate-900 dark:text-white text-right\" x-text=\"instance.cachedAlerts\"></dd><dt class=\"text-slate-500 dark:text-slate-400\">Last fetch</dt><dd class=\"text-slate-900 dark:text-white text-right\" x-text=\"instance.fetchedAlerts + ' alerts'\"></dd><dt class=\"text-slate-500 dark:text-slate-400\">Success / failure</dt><dd class=\"text-slate-900 dark:text-white text-right\"><span x-text=\"instance.successCount\"></span> / <span x-text=\"instance.failureCount\"></span> <span class=\"text-xs text-slate-500\" x-text=\"'(' + successRate(instance) + ')'\"></span></dd><dt class=\"text-slate-500 dark:text-slate-400\">Last success</dt><dd class=\"text-slate-900 dark:text-white text-right\" x-text=\"formatAgo(instance.lastSuccess)\"></dd></dl><div x-show=\"instance.state === 'unhealthy'\" class=\"mt-3 p-2 bg-red-50 dark:bg-red-900/20 rounded-lg text-xs text-red-700 dark:text-red-400\"><p><span x-text=\"instance.consecutiveFailures\"></span> failed fetch(es) in a row, last failure <span x-text=\"formatAgo(instance.lastFailure)\"></span></p><p class=\"mt-1 break-all\" x-text=\"instance.lastError\"></p></div><div x-show=\"instance.circuitOpen\" class=\"mt-2 p-2 bg-amber-50 dark:bg-amber-900/20 rounded-lg text-xs text-amber-800 dark:text-amber-300\">Circuit open: fetches skip this Alertmanager, next attempt <span x-text=\"formatIn(instance.retryAt)\"></span> (<span x-text=\"instance.skippedCount\"></span> skipped)</div></div></template></div><p x-show=\"!loading && instances.length === 0 && !error\" class=\"text-center text-sm text-slate-500 dark:text-slate-400 py-12\">No Alertmanager is configured.</p></div></div><script>\n\t\tfunction alertmanagerStatusPage() {\n\t\t\treturn {\n\t\t\t\tinstances: [],\n\t\t\t\tunhealthy: 0,\n\t\t\t\tloading: false,\n\t\t\t\terror: '',\n\t\t\t\ttimer: null,\n\n\t\t\t\tinit() {\n\t\t\t\t\tthis.load();\n\t\t\t\t\tthis.timer = setInterval(() => this.load(), 10000);\n\t\t\t\t},\n\n\t\t\t\tasync load() {\n\t\t\t\t\tthis.loading = true;\n\t\t\t\t\ttry {\n\t\t\t\t\t\tconst response = await fetch('/api/v1/alertmanagers/status', { credentials: 'include' });\n\t\t\t\t\t\tconst result = await response.json();\n\t\t\t\t\t\tif (!result.success) {\n\t\t\t\t\t\t\tthrow new Error(result.error || 'Failed to load Alertmanager status');\n\t\t\t\t\t\t}\n\t\t\t\t\t\tthis.instances = result.data.instances || [];\n\t\t\t\t\t\tthis.unhealthy = result.data.unhealthy || 0;\n\t\t\t\t\t\tthis.error = '';\n\t\t\t\t\t} catch (error) {\n\t\t\t\t\t\tthis.error = error.message;\n\t\t\t\t\t} finally {\n\t\t\t\t\t\tthis.loading = false;\n\t\t\t\t\t}\n\t\t\t\t},\n\n\t\t\t\tstateBadgeClass(state) {\n\t\t\t\t\treturn {\n\t\t\t\t\t\thealthy: 'bg-emerald-100 text-emerald-700 dark:bg-emerald-900/30 dark:text-emerald-300',\n\t\t\t\t\t\tunhealthy: 'bg-red-100 text-red-700 dark:bg-red-900/30 dark:text-red-200'\n\t\t\t\t\t}[state] || 'bg-slate-100 text-slate-500 dark:bg-slate-800 dark:text-slate-400';\n\t\t\t\t},\n\n\t\t\t\tstateDotClass(state) {\n\t\t\t\t\treturn { healthy: 'bg-emerald-500', unhealthy: 'bg-red-500' }[state] || 'bg-slate-400';\n\t\t\t\t},\n\n\t\t\t\tsuccessRate(instance) {\n\t\t\t\t\tconst total = instance.successCount + instance.failureCount;\n\t\t\t\t\treturn total === 0 ? '—' : Math.round(instance.successCount / total * 100) + '%';\n\t\t\t\t},\n\n\t\t\t\tformatIn(timestamp) {\n\t\t\t\t\tif (!timestamp) return 'now';\n\t\t\t\t\tconst seconds = Math.floor((new Date(timestamp).getTime() - Date.now()) / 1000);\n\t\t\t\t\tif (seconds <= 0) return 'on the next refresh';\n\t\t\t\t\tif (seconds < 60) return 'in ' + seconds + 's';\n\t\t\t\t\treturn 'in ' + Math.ceil(seconds / 60) + 'm';\n\t\t\t\t},\n\n\t\t\t\tformatAgo(timestamp) {\n\t\t\t\t\tif (!timestamp) return 'never';\n\t\t\t\t\tconst seconds = Math.max(0, Math.floor((Date.now() - new Date(timestamp).getTime()) / 1000));\n\t\t\t\t\tif (seconds < 60) return seconds + 's ago';\n\t\t\t\t\tif (seconds < 3600) return Math.floor(seconds / 60) + 'm ago';\n\t\t\t\t\tif (seconds < 86400) return Math.floor(seconds / 3600) + 'h ago';\n\t\t\t\t\treturn Math.floor(seconds / 86400) + 'd ago';\n\t\t\t\t}\n\t\t\t};\n\t\t}\n\t</script>")
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		return nil
+	})
+}
+
+var _ = templruntime.GeneratedTemplate
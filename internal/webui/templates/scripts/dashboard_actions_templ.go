@@ -29,7 +29,7 @@ func DashboardActions() templ.Component {
 			templ_7745c5c3_Var1 = templ.NopComponent
 		}
 		ctx = templ.ClearChildren(ctx)
-		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 1, "<script>\n\t\twindow.dashboardActionsMixin = {\n\t\t\tcancelAcknowledgment() {\n\t\t\t\tthis.showAckModal = false;\n\t\t\t\tthis.ackReason = '';\n\t\t\t\tthis.ackError = '';\n\t\t\t\tthis.ackSubmitting = false;\n\t\t\t\tthis.currentAckAlert = null;\n\t\t\t\tthis.currentGroupName = '';\n\t\t\t},\n\t\t\t\n\t\t\tasync submitAcknowledgment() {\n\t\t\t\tif (!this.ackReason.trim()) {\n\t\t\t\t\tthis.ackError = 'Please provide a reason for the acknowledgment';\n\t\t\t\t\treturn;\n\t\t\t\t}\n\t\t\t\t\n\t\t\t\tthis.ackSubmitting = true;\n\t\t\t\tthis.ackError = '';\n\t\t\t\t\n\t\t\t\ttry {\n\t\t\t\t\tlet request;\n\t\t\t\t\tlet successMessage;\n\t\t\t\t\t\n\t\t\t\t\tswitch (this.ackAction) {\n\t\t\t\t\t\tcase 'single':\n\t\t\t\t\t\t\trequest = {\n\t\t\t\t\t\t\t\talertFingerprints: [this.currentAckAlert.fingerprint],\n\t\t\t\t\t\t\t\tgroupNames: [],\n\t\t\t\t\t\t\t\taction: 'acknowledge',\n\t\t\t\t\t\t\t\tcomment: this.ackReason\n\t\t\t\t\t\t\t};\n\t\t\t\t\t\t\tsuccessMessage = 'Alert acknowledged successfully';\n\t\t\t\t\t\t\tbreak;\n\t\t\t\t\t\t\t\n\t\t\t\t\t\tcase 'group':\n\t\t\t\t\t\t\trequest = {\n\t\t\t\t\t\t\t\talertFingerprints: [],\n\t\t\t\t\t\t\t\tgroupNames: [this.currentGroupName],\n\t\t\t\t\t\t\t\taction: 'acknowledge',\n\t\t\t\t\t\t\t\tcomment: this.ackReason\n\t\t\t\t\t\t\t};\n\t\t\t\t\t\t\tsuccessMessage = `Group \"${this.currentGroupName}\" acknowledged successfully`;\n\t\t\t\t\t\t\tbreak;\n\t\t\t\t\t\t\t\n\t\t\t\t\t\tcase 'bulk':\n\t\t\t\t\t\t\trequest = {\n\t\t\t\t\t\t\t\talertFingerprints: this.selectedAlerts,\n\t\t\t\t\t\t\t\tgroupNames: this.selectedGroups,\n\t\t\t\t\t\t\t\taction: 'acknowledge',\n\t\t\t\t\t\t\t\tcomment: this.ackReason\n\t\t\t\t\t\t\t};\n\t\t\t\t\t\t\tsuccessMessage = `${this.selectedAlerts.length + this.selectedGroups.length} items acknowledged successfully`;\n\t\t\t\t\t\t\tbreak;\n\t\t\t\t\t\t\t\n\t\t\t\t\t\tdefault:\n\t\t\t\t\t\t\tthis.ackError = 'Invalid acknowledgment action';\n\t\t\t\t\t\t\tthis.ackSubmitting = false;\n\t\t\t\t\t\t\treturn;\n\t\t\t\t\t}\n\t\t\t\t\t\n\t\t\t\t\tconst response = await fetch('/api/v1/dashboard/bulk-action', {\n\t\t\t\t\t\tmethod: 'POST',\n\t\t\t\t\t\tcredentials: 'include',\n\t\t\t\t\t\theaders: {\n\t\t\t\t\t\t\t'Content-Type': 'application/json',\n\t\t\t\t\t\t},\n\t\t\t\t\t\tbody: JSON.stringify(request)\n\t\t\t\t\t});\n\n\t\t\t\t\t// Check for authentication errors and redirect if needed\n\t\t\t\t\tif (window.dashboardInstance && window.dashboardInstance.handleAuthError(response)) {\n\t\t\t\t\t\treturn;\n\t\t\t\t\t}\n\n\t\t\t\t\tconst result = await response.json();\n\t\t\t\t\t\n\t\t\t\t\tif (result.success) {\n\t\t\t\t\t\tthis.showAckModal = false;\n\t\t\t\t\t\t\n\t\t\t\t\t\tif (this.ackAction === 'bulk') {\n\t\t\t\t\t\t\tthis.clearSelection();\n\t\t\t\t\t\t}\n\t\t\t\t\t\t\n\t\t\t\t\t\tthis.loadDashboardData();\n\t\t\t\t\t} else {\n\t\t\t\t\t\tthis.ackError = result.error || 'Failed to acknowledge';\n\t\t\t\t\t}\n\t\t\t\t} catch (error) {\n\t\t\t\t\tconsole.error('Error submitting acknowledgment:', error);\n\t\t\t\t\tthis.ackError = 'Network error: Failed to submit acknowledgment';\n\t\t\t\t} finally {\n\t\t\t\t\tthis.ackSubmitting = false;\n\t\t\t\t}\n\t\t\t},\n\n\t\t\tasync hideSelected() {\n\t\t\t\tif (this.selectedAlerts.length === 0 && this.selectedGroups.length === 0) {\n\t\t\t\t\treturn;\n\t\t\t\t}\n\n\t\t\t\ttry {\n\t\t\t\t\tlet hiddenCount = 0;\n\n\t\t\t\t\t// Hide individual alerts\n\t\t\t\t\tfor (const fingerprint of this.selectedAlerts) {\n\t\t\t\t\t\tconst response = await fetch('/api/v1/dashboard/hidden-alerts', {\n\t\t\t\t\t\t\tmethod: 'POST',\n\t\t\t\t\t\t\tcredentials: 'include',\n\t\t\t\t\t\t\theaders: {\n\t\t\t\t\t\t\t\t'Content-Type': 'application/json',\n\t\t\t\t\t\t\t},\n\t\t\t\t\t\t\tbody: JSON.stringify({\n\t\t\t\t\t\t\t\tfingerprint: fingerprint,\n\t\t\t\t\t\t\t\treason: 'Hidden from dashboard bulk action'\n\t\t\t\t\t\t\t})\n\t\t\t\t\t\t});\n\n\t\t\t\t\t\t// Check for authentication errors and redirect if needed\n\t\t\t\t\t\tif (window.dashboardInstance && window.dashboardInstance.handleAuthError(response)) {\n\t\t\t\t\t\t\treturn;\n\t\t\t\t\t\t}\n\n\t\t\t\t\t\tconst result = await response.json();\n\t\t\t\t\t\tif (result.success) {\n\t\t\t\t\t\t\thiddenCount++;\n\t\t\t\t\t\t}\n\t\t\t\t\t}\n\n\t\t\t\t\t// TODO: Handle group hiding when groups are supported\n\n\t\t\t\t\tif (hiddenCount > 0) {\n\n\t\t\t\t\t\tthis.clearSelection();\n\t\t\t\t\t\tthis.loadDashboardData();\n\t\t\t\t\t} else {\n\n\t\t\t\t\t}\n\t\t\t\t} catch (error) {\n\t\t\t\t\tconsole.error('Error hiding alerts:', error);\n\n\t\t\t\t}\n\t\t\t},\n\n\t\t\t// Hide selected alerts in the active filter (filter-specific hiding)\n\t\t\thideSelectedInFilter() {\n\t\t\t\tif (this.selectedAlerts.length === 0) {\n\t\t\t\t\treturn;\n\t\t\t\t}\n\n\t\t\t\t// Check if a filter preset is active\n\t\t\t\tif (!this.activeFilterPresetId) {\n\t\t\t\t\talert('No saved filter is currently active. Load a saved filter first, or use \"Hide Globally\" to hide alerts for all views.');\n\t\t\t\t\treturn;\n\t\t\t\t}\n\n\t\t\t\t// Add each selected alert to the filter hidden alerts\n\t\t\t\tlet addedCount = 0;\n\t\t\t\tfor (const fingerprint of this.selectedAlerts) {\n\t\t\t\t\tconst alert = this.alerts.find(a => a.fingerprint === fingerprint);\n\t\t\t\t\tif (alert) {\n\t\t\t\t\t\t// Check if not already in filter hidden\n\t\t\t\t\t\tconst alreadyHidden = this.filterHiddenAlerts.some(h => h.fingerprint === fingerprint);\n\t\t\t\t\t\tif (!alreadyHidden) {\n\t\t\t\t\t\t\tthis.addFilterHiddenAlert(fingerprint, alert.alertName, alert.instance, 'Hidden from bulk action');\n\t\t\t\t\t\t\taddedCount++;\n\t\t\t\t\t\t}\n\t\t\t\t\t}\n\t\t\t\t}\n\n\t\t\t\tif (addedCount > 0) {\n\t\t\t\t\tconsole.log(`Added ${addedCount} alerts to filter hidden list`);\n\t\t\t\t\tthis.clearSelection();\n\t\t\t\t\t// Reload to apply the filter\n\t\t\t\t\tthis.loadDashboardData();\n\t\t\t\t}\n\t\t\t},\n\n\t\t\t// Hide a single alert in the active filter\n\t\t\thideAlertInFilter(fingerprint) {\n\t\t\t\t// Check if a filter preset is active\n\t\t\t\tif (!this.activeFilterPresetId) {\n\t\t\t\t\talert('No saved filter is currently active. Load a saved filter first, or use \"Hide Globally\" to hide alerts for all views.');\n\t\t\t\t\treturn;\n\t\t\t\t}\n\n\t\t\t\tconst alert = this.alerts.find(a => a.fingerprint === fingerprint);\n\t\t\t\tif (alert) {\n\t\t\t\t\t// Check if not already in filter hidden\n\t\t\t\t\tconst alreadyHidden = this.filterHiddenAlerts.some(h => h.fingerprint === fingerprint);\n\t\t\t\t\tif (!alreadyHidden) {\n\t\t\t\t\t\tthis.addFilterHiddenAlert(fingerprint, alert.alertName, alert.instance, 'Hidden from alert action');\n\t\t\t\t\t\tconsole.log('Added alert to filter hidden list:', fingerprint);\n\t\t\t\t\t\t// Reload to apply the filter\n\t\t\t\t\t\tthis.loadDashboardData();\n\t\t\t\t\t} else {\n\t\t\t\t\t\tconsole.log('Alert already hidden in filter:', fingerprint);\n\t\t\t\t\t}\n\t\t\t\t}\n\t\t\t},\n\n\t\t\tacknowledgeAlert(fingerprint) {\n\t\t\t\tconst alert = this.alerts.find(a => a.fingerprint === fingerprint);\n\t\t\t\tif (!alert) { return; }\n\t\t\t\tthis.currentAckAlert = alert;\n\t\t\t\tthis.ackAction = 'single';\n\t\t\t\tthis.ackReason = '';\n\t\t\t\tthis.ackError = '';\n\t\t\t\tthis.showAckModal = true;\n\t\t\t},\n\n\t\t\tacknowledgeGroup(groupName) {\n\t\t\t\tthis.currentGroupName = groupName;\n\t\t\t\tthis.ackAction = 'group';\n\t\t\t\tthis.ackReason = '';\n\t\t\t\tthis.ackError = '';\n\t\t\t\tthis.showAckModal = true;\n\t\t\t},\n\n\t\t\tacknowledgeSelected() {\n\t\t\t\tif (this.selectedAlerts.length === 0 && this.selectedGroups.length === 0) {\n\t\t\t\t\t\n\t\t\t\t\treturn;\n\t\t\t\t}\n\t\t\t\t\n\t\t\t\tthis.ackAction = 'bulk';\n\t\t\t\tthis.ackReason = '';\n\t\t\t\tthis.ackError = '';\n\t\t\t\tthis.showAckModal = true;\n\t\t\t},\n\n\n\t\t\tasync unacknowledgeSelected() {\n\t\t\t\tconst request = {\n\t\t\t\t\talertFingerprints: this.selectedAlerts,\n\t\t\t\t\tgroupNames: this.selectedGroups,\n\t\t\t\t\taction: 'unacknowledge',\n\t\t\t\t\tcomment: 'Unacknowledged from dashboard'\n\t\t\t\t};\n\n\t\t\t\ttry {\n\t\t\t\t\tconst response = await fetch('/api/v1/dashboard/bulk-action', {\n\t\t\t\t\t\tmethod: 'POST',\n\t\t\t\t\t\tcredentials: 'include',\n\t\t\t\t\t\theaders: {\n\t\t\t\t\t\t\t'Content-Type': 'application/json',\n\t\t\t\t\t\t},\n\t\t\t\t\t\tbody: JSON.stringify(request)\n\t\t\t\t\t});\n\n\t\t\t\t\t// Check for authentication errors and redirect if needed\n\t\t\t\t\tif (window.dashboardInstance && window.dashboardInstance.handleAuthError(response)) {\n\t\t\t\t\t\treturn;\n\t\t\t\t\t}\n\n\t\t\t\t\tconst result = await response.json();\n\t\t\t\t\t\n\t\t\t\t\tif (result.success) {\n\t\t\t\t\t\t\n\t\t\t\t\t\tthis.clearSelection();\n\t\t\t\t\t\tthis.loadDashboardData();\n\t\t\t\t\t} else {\n\t\t\t\t\t\t\n\t\t\t\t\t}\n\t\t\t\t} catch (error) {\n\t\t\t\t\tconsole.error('Error unacknowledging alerts:', error);\n\t\t\t\t\t\n\t\t\t\t}\n\t\t\t},\n\n\t\t\tasync unacknowledgeAlert(fingerprint) {\n\t\t\t\tconst request = {\n\t\t\t\t\talertFingerprints: [fingerprint],\n\t\t\t\t\taction: 'unacknowledge',\n\t\t\t\t\tcomment: 'Unacknowledged from dashboard'\n\t\t\t\t};\n\n\t\t\t\ttry {\n\t\t\t\t\tconst response = await fetch('/api/v1/dashboard/bulk-action', {\n\t\t\t\t\t\tmethod: 'POST',\n\t\t\t\t\t\tcredentials: 'include',\n\t\t\t\t\t\theaders: {\n\t\t\t\t\t\t\t'Content-Type': 'application/json',\n\t\t\t\t\t\t},\n\t\t\t\t\t\tbody: JSON.stringify(request)\n\t\t\t\t\t});\n\n\t\t\t\t\t// Check for authentication errors and redirect if needed\n\t\t\t\t\tif (window.dashboardInstance && window.dashboardInstance.handleAuthError(response)) {\n\t\t\t\t\t\treturn;\n\t\t\t\t\t}\n\n\t\t\t\t\tconst result = await response.json();\n\t\t\t\t\t\n\t\t\t\t\tif (result.success) {\n\t\t\t\t\t\t\n\t\t\t\t\t\tthis.loadDashboardData();\n\t\t\t\t\t} else {\n\t\t\t\t\t\t\n\t\t\t\t\t}\n\t\t\t\t} catch (error) {\n\t\t\t\t\tconsole.error('Error unacknowledging alert:', error);\n\t\t\t\t\t\n\t\t\t\t}\n\t\t\t},\n\n\t\t\tasync resolveSelected() {\n\t\t\t\tconst request = {\n\t\t\t\t\talertFingerprints: this.selectedAlerts,\n\t\t\t\t\tgroupNames: this.selectedGroups,\n\t\t\t\t\taction: 'resolve',\n\t\t\t\t\tcomment: 'Resolved from dashboard'\n\t\t\t\t};\n\n\t\t\t\ttry {\n\t\t\t\t\tconst response = await fetch('/api/v1/dashboard/bulk-action', {\n\t\t\t\t\t\tmethod: 'POST',\n\t\t\t\t\t\tcredentials: 'include',\n\t\t\t\t\t\theaders: {\n\t\t\t\t\t\t\t'Content-Type': 'application/json',\n\t\t\t\t\t\t},\n\t\t\t\t\t\tbody: JSON.stringify(request)\n\t\t\t\t\t});\n\n\t\t\t\t\t// Check for authentication errors and redirect if needed\n\t\t\t\t\tif (window.dashboardInstance && window.dashboardInstance.handleAuthError(response)) {\n\t\t\t\t\t\treturn;\n\t\t\t\t\t}\n\n\t\t\t\t\tconst result = await response.json();\n\t\t\t\t\t\n\t\t\t\t\tif (result.success) {\n\t\t\t\t\t\t\n\t\t\t\t\t\tthis.clearSelection();\n\t\t\t\t\t\tthis.loadDashboardData();\n\t\t\t\t\t} else {\n\t\t\t\t\t\t\n\t\t\t\t\t}\n\t\t\t\t} catch (error) {\n\t\t\t\t\tconsole.error('Error resolving alerts:', error);\n\t\t\t\t\t\n\t\t\t\t}\n\t\t\t},\n\n\t\t\tasync resolveAlert(fingerprint) {\n\t\t\t\tconst request = {\n\t\t\t\t\talertFingerprints: [fingerprint],\n\t\t\t\t\taction: 'resolve',\n\t\t\t\t\tcomment: 'Resolved from dashboard'\n\t\t\t\t};\n\n\t\t\t\ttry {\n\t\t\t\t\tconst response = await fetch('/api/v1/dashboard/bulk-action', {\n\t\t\t\t\t\tmethod: 'POST',\n\t\t\t\t\t\tcredentials: 'include',\n\t\t\t\t\t\theaders: {\n\t\t\t\t\t\t\t'Content-Type': 'application/json',\n\t\t\t\t\t\t},\n\t\t\t\t\t\tbody: JSON.stringify(request)\n\t\t\t\t\t});\n\n\t\t\t\t\t// Check for authentication errors and redirect if needed\n\t\t\t\t\tif (window.dashboardInstance && window.dashboardInstance.handleAuthError(response)) {\n\t\t\t\t\t\treturn;\n\t\t\t\t\t}\n\n\t\t\t\t\tconst result = await response.json();\n\t\t\t\t\t\n\t\t\t\t\tif (result.success) {\n\t\t\t\t\t\t\n\t\t\t\t\t\tthis.loadDashboardData();\n\t\t\t\t\t} else {\n\t\t\t\t\t\t\n\t\t\t\t\t}\n\t\t\t\t} catch (error) {\n\t\t\t\t\tconsole.error('Error resolving alert:', error);\n\t\t\t\t\t\n\t\t\t\t}\n\t\t\t},\n\n\t\t\tconfirmRemoveResolvedAlerts() {\n\t\t\t\tif (confirm('Are you sure you want to remove all resolved alerts? This action cannot be undone.')) {\n\t\t\t\t\tthis.removeAllResolvedAlerts();\n\t\t\t\t}\n\t\t\t},\n\n\t\t\tasync removeAllResolvedAlerts() {\n\t\t\t\tthis.isRemovingResolvedAlerts = true;\n\t\t\t\t\n\t\t\t\ttry {\n\t\t\t\t\tconst response = await fetch('/api/v1/dashboard/remove-resolved-alerts', {\n\t\t\t\t\t\tmethod: 'DELETE',\n\t\t\t\t\t\tcredentials: 'include'\n\t\t\t\t\t});\n\n\t\t\t\t\t// Check for authentication errors and redirect if needed\n\t\t\t\t\tif (window.dashboardInstance && window.dashboardInstance.handleAuthError(response)) {\n\t\t\t\t\t\treturn;\n\t\t\t\t\t}\n\n\t\t\t\t\tconst result = await response.json();\n\t\t\t\t\t\n\t\t\t\t\tif (result.success) {\n\t\t\t\t\t\t\n\t\t\t\t\t\tif (this.displayMode === 'resolved') {\n\t\t\t\t\t\t\tawait this.loadDashboardData();\n\t\t\t\t\t\t}\n\t\t\t\t\t} else {\n\t\t\t\t\t\t\n\t\t\t\t\t}\n\t\t\t\t} catch (error) {\n\t\t\t\t\tconsole.error('Error removing resolved alerts:', error);\n\t\t\t\t\t\n\t\t\t\t} finally {\n\t\t\t\t\tthis.isRemovingResolvedAlerts = false;\n\t\t\t\t}\n\t\t\t},\n\n\t\t\tcancelSilence() {\n\t\t\t\tthis.showSilenceModal = false;\n\t\t\t\tthis.silenceReason = '';\n\t\t\t\tthis.silenceError = '';\n\t\t\t\tthis.silenceSubmitting = false;\n\t\t\t\tthis.currentSilenceAlert = null;\n\t\t\t\tthis.currentGroupName = '';\n\t\t\t\tthis.silenceDuration = '1h';\n\t\t\t},\n\t\t\t\n\t\t\tasync submitSilence() {\n\t\t\t\tif (!this.silenceReason.trim()) {\n\t\t\t\t\tthis.silenceError = 'Please provide a reason for the silence';\n\t\t\t\t\treturn;\n\t\t\t\t}\n\t\t\t\t\n\t\t\t\tif (this.silenceDurationType === 'custom') {\n\t\t\t\t\tif (!this.validateCustomDuration()) {\n\t\t\t\t\t\treturn;\n\t\t\t\t\t}\n\t\t\t\t}\n\t\t\t\t\n\t\t\t\tthis.silenceSubmitting = true;\n\t\t\t\tthis.silenceError = '';\n\t\t\t\t\n\t\t\t\ttry {\n\t\t\t\t\tlet request;\n\t\t\t\t\tlet successMessage;\n\t\t\t\t\t\n\t\t\t\t\tconst durationFields = {};\n\t\t\t\t\tif (this.silenceDurationType === 'custom') {\n\t\t\t\t\t\tdurationFields.silenceDurationType = 'custom';\n\t\t\t\t\t\tdurationFields.customSilenceDuration = this.customSilenceDuration.trim();\n\t\t\t\t\t} else {\n\t\t\t\t\t\tdurationFields.silenceDuration = this.parseDurationToSeconds(this.silenceDuration) * 1000000000;\n\t\t\t\t\t\tdurationFields.silenceDurationType = 'preset';\n\t\t\t\t\t}\n\t\t\t\t\t\n\t\t\t\t\tswitch (this.silenceAction) {\n\t\t\t\t\t\tcase 'single':\n\t\t\t\t\t\t\trequest = {\n\t\t\t\t\t\t\t\talertFingerprints: [this.currentSilenceAlert.fingerprint],\n\t\t\t\t\t\t\t\tgroupNames: [],\n\t\t\t\t\t\t\t\taction: 'silence',\n\t\t\t\t\t\t\t\tcomment: this.silenceReason,\n\t\t\t\t\t\t\t\t...durationFields\n\t\t\t\t\t\t\t};\n\t\t\t\t\t\t\tsuccessMessage = 'Alert silenced successfully';\n\t\t\t\t\t\t\tbreak;\n\t\t\t\t\t\t\t\n\t\t\t\t\t\tcase 'group':\n\t\t\t\t\t\t\trequest = {\n\t\t\t\t\t\t\t\talertFingerprints: [],\n\t\t\t\t\t\t\t\tgroupNames: [this.currentGroupName],\n\t\t\t\t\t\t\t\taction: 'silence',\n\t\t\t\t\t\t\t\tcomment: this.silenceReason,\n\t\t\t\t\t\t\t\t...durationFields\n\t\t\t\t\t\t\t};\n\t\t\t\t\t\t\tsuccessMessage = `Group \"${this.currentGroupName}\" silenced successfully`;\n\t\t\t\t\t\t\tbreak;\n\t\t\t\t\t\t\t\n\t\t\t\t\t\tcase 'bulk':\n\t\t\t\t\t\t\trequest = {\n\t\t\t\t\t\t\t\talertFingerprints: this.selectedAlerts,\n\t\t\t\t\t\t\t\tgroupNames: this.selectedGroups,\n\t\t\t\t\t\t\t\taction: 'silence',\n\t\t\t\t\t\t\t\tcomment: this.silenceReason,\n\t\t\t\t\t\t\t\t...durationFields\n\t\t\t\t\t\t\t};\n\t\t\t\t\t\t\tsuccessMessage = `${this.selectedAlerts.length + this.selectedGroups.length} items silenced successfully`;\n\t\t\t\t\t\t\tbreak;\n\t\t\t\t\t\t\t\n\t\t\t\t\t\tdefault:\n\t\t\t\t\t\t\tthis.silenceError = 'Invalid silence action';\n\t\t\t\t\t\t\tthis.silenceSubmitting = false;\n\t\t\t\t\t\t\treturn;\n\t\t\t\t\t}\n\t\t\t\t\t\n\t\t\t\t\tconst response = await fetch('/api/v1/dashboard/bulk-action', {\n\t\t\t\t\t\tmethod: 'POST',\n\t\t\t\t\t\tcredentials: 'include',\n\t\t\t\t\t\theaders: {\n\t\t\t\t\t\t\t'Content-Type': 'application/json',\n\t\t\t\t\t\t},\n\t\t\t\t\t\tbody: JSON.stringify(request)\n\t\t\t\t\t});\n\n\t\t\t\t\t// Check for authentication errors and redirect if needed\n\t\t\t\t\tif (window.dashboardInstance && window.dashboardInstance.handleAuthError(response)) {\n\t\t\t\t\t\treturn;\n\t\t\t\t\t}\n\n\t\t\t\t\tconst result = await response.json();\n\t\t\t\t\t\n\t\t\t\t\tif (result.success) {\n\t\t\t\t\t\t\n\t\t\t\t\t\tthis.showSilenceModal = false;\n\t\t\t\t\t\t\n\t\t\t\t\t\tif (this.silenceAction === 'bulk') {\n\t\t\t\t\t\t\tthis.clearSelection();\n\t\t\t\t\t\t}\n\t\t\t\t\t\t\n\t\t\t\t\t\tthis.loadDashboardData();\n\t\t\t\t\t} else {\n\t\t\t\t\t\tthis.silenceError = result.error || 'Failed to silence alert(s)';\n\t\t\t\t\t}\n\t\t\t\t} catch (error) {\n\t\t\t\t\tconsole.error('Error submitting silence:', error);\n\t\t\t\t\tthis.silenceError = 'Network error: Failed to submit silence';\n\t\t\t\t} finally {\n\t\t\t\t\tthis.silenceSubmitting = false;\n\t\t\t\t}\n\t\t\t},\n\n\t\t\tparseDurationToSeconds(duration) {\n\t\t\t\tif (!duration) return 0;\n\t\t\t\t\n\t\t\t\tif (duration.match(/^\\d+[hd]$/)) {\n\t\t\t\t\tconst value = parseInt(duration.slice(0, -1));\n\t\t\t\t\tconst unit = duration.slice(-1);\n\t\t\t\t\t\n\t\t\t\t\tswitch (unit) {\n\t\t\t\t\t\tcase 'h':\n\t\t\t\t\t\t\treturn value * 3600;\n\t\t\t\t\t\tcase 'd':\n\t\t\t\t\t\t\treturn value * 86400;\n\t\t\t\t\t\tdefault:\n\t\t\t\t\t\t\treturn value * 3600;\n\t\t\t\t\t}\n\t\t\t\t}\n\t\t\t\t\n\t\t\t\treturn this.parseComplexDurationToSeconds(duration);\n\t\t\t},\n\t\t\t\n\t\t\tparseComplexDurationToSeconds(duration) {\n\t\t\t\tif (!duration) return 0;\n\n\t\t\t\tlet totalSeconds = 0;\n\t\t\t\tconst units = {\n\t\t\t\t\t'ns': 0.000000001,\n\t\t\t\t\t'µs': 0.000001,\n\t\t\t\t\t'us': 0.000001,\n\t\t\t\t\t'ms': 0.001,\n\t\t\t\t\t's': 1,\n\t\t\t\t\t'm': 60,\n\t\t\t\t\t'h': 3600,\n\t\t\t\t\t'd': 86400,\n\t\t\t\t\t'y': 31536000  // 365 days\n\t\t\t\t};\n\n\t\t\t\tconst regex = /(\\d+(?:\\.\\d+)?)(ns|µs|us|ms|s|m|h|d|y)/g;\n\t\t\t\tlet match;\n\t\t\t\t\n\t\t\t\twhile ((match = regex.exec(duration)) !== null) {\n\t\t\t\t\tconst value = parseFloat(match[1]);\n\t\t\t\t\tconst unit = match[2];\n\t\t\t\t\t\n\t\t\t\t\tif (units[unit]) {\n\t\t\t\t\t\ttotalSeconds += value * units[unit];\n\t\t\t\t\t}\n\t\t\t\t}\n\t\t\t\t\n\t\t\t\treturn Math.round(totalSeconds);\n\t\t\t},\n\t\t\t\n\t\t\tvalidateCustomDuration() {\n\t\t\t\tif (this.silenceDurationType !== 'custom' || !this.customSilenceDuration) {\n\t\t\t\t\tthis.customDurationError = '';\n\t\t\t\t\treturn true;\n\t\t\t\t}\n\t\t\t\t\n\t\t\t\tconst duration = this.customSilenceDuration.trim();\n\t\t\t\t\n\t\t\t\tif (!duration) {\n\t\t\t\t\tthis.customDurationError = 'Duration cannot be empty';\n\t\t\t\t\treturn false;\n\t\t\t\t}\n\t\t\t\t\n\t\t\t\tconst validFormat = /^(\\d+(?:\\.\\d+)?)(ns|µs|us|ms|s|m|h|d|y)(\\d+(?:\\.\\d+)?(ns|µs|us|ms|s|m|h|d|y))*$/;\n\t\t\t\tif (!validFormat.test(duration)) {\n\t\t\t\t\tthis.customDurationError = 'Invalid format. Use combinations like 1h30m, 2d, 1y';\n\t\t\t\t\treturn false;\n\t\t\t\t}\n\n\t\t\t\tconst totalSeconds = this.parseComplexDurationToSeconds(duration);\n\n\t\t\t\tif (totalSeconds <= 0) {\n\t\t\t\t\tthis.customDurationError = 'Duration must be positive';\n\t\t\t\t\treturn false;\n\t\t\t\t}\n\n\t\t\t\tif (totalSeconds < 1) {\n\t\t\t\t\tthis.customDurationError = 'Duration must be at least 1 second';\n\t\t\t\t\treturn false;\n\t\t\t\t}\n\t\t\t\t\n\t\t\t\tthis.customDurationError = '';\n\t\t\t\treturn true;\n\t\t\t},\n\n\t\t\tsilenceAlert(fingerprint) {\n\t\t\t\tconst alert = this.alerts.find(a => a.fingerprint === fingerprint);\n\t\t\t\tif (!alert) { return; }\n\t\t\t\tthis.currentSilenceAlert = alert;\n\t\t\t\tthis.silenceAction = 'single';\n\t\t\t\tthis.silenceReason = '';\n\t\t\t\tthis.silenceError = '';\n\t\t\t\tthis.silenceDuration = '1h';\n\t\t\t\tthis.silenceDurationType = 'preset';\n\t\t\t\tthis.customSilenceDuration = '';\n\t\t\t\tthis.customDurationError = '';\n\t\t\t\tthis.showSilenceModal = true;\n\t\t\t},\n\n\t\t\tsilenceGroup(groupName) {\n\t\t\t\tthis.currentGroupName = groupName;\n\t\t\t\tthis.silenceAction = 'group';\n\t\t\t\tthis.silenceReason = '';\n\t\t\t\tthis.silenceError = '';\n\t\t\t\tthis.silenceDuration = '1h';\n\t\t\t\tthis.silenceDurationType = 'preset';\n\t\t\t\tthis.customSilenceDuration = '';\n\t\t\t\tthis.customDurationError = '';\n\t\t\t\tthis.showSilenceModal = true;\n\t\t\t},\n\n\t\t\tsilenceSelected() {\n\t\t\t\tif (this.selectedAlerts.length === 0 && this.selectedGroups.length === 0) {\n\t\t\t\t\t\n\t\t\t\t\treturn;\n\t\t\t\t}\n\t\t\t\t\n\t\t\t\tthis.silenceAction = 'bulk';\n\t\t\t\tthis.silenceReason = '';\n\t\t\t\tthis.silenceError = '';\n\t\t\t\tthis.silenceDuration = '1h';\n\t\t\t\tthis.silenceDurationType = 'preset';\n\t\t\t\tthis.customSilenceDuration = '';\n\t\t\t\tthis.customDurationError = '';\n\t\t\t\tthis.showSilenceModal = true;\n\t\t\t},\n\n\t\t\tasync unsilenceSelected() {\n\t\t\t\tif (this.selectedAlerts.length === 0 && this.selectedGroups.length === 0) {\n\t\t\t\t\t\n\t\t\t\t\treturn;\n\t\t\t\t}\n\n\t\t\t\ttry {\n\t\t\t\t\tconst request = {\n\t\t\t\t\t\talertFingerprints: this.selectedAlerts,\n\t\t\t\t\t\tgroupNames: this.selectedGroups,\n\t\t\t\t\t\taction: 'unsilence',\n\t\t\t\t\t\tcomment: 'Bulk unsilence action'\n\t\t\t\t\t};\n\n\t\t\t\t\tconst response = await fetch('/api/v1/dashboard/bulk-action', {\n\t\t\t\t\t\tmethod: 'POST',\n\t\t\t\t\t\tcredentials: 'include',\n\t\t\t\t\t\theaders: {\n\t\t\t\t\t\t\t'Content-Type': 'application/json',\n\t\t\t\t\t\t},\n\t\t\t\t\t\tbody: JSON.stringify(request)\n\t\t\t\t\t});\n\n\t\t\t\t\t// Check for authentication errors and redirect if needed\n\t\t\t\t\tif (window.dashboardInstance && window.dashboardInstance.handleAuthError(response)) {\n\t\t\t\t\t\treturn;\n\t\t\t\t\t}\n\n\t\t\t\t\tconst result = await response.json();\n\t\t\t\t\t\n\t\t\t\t\tif (result.success) {\n\t\t\t\t\t\t\n\t\t\t\t\t\tthis.clearSelection();\n\t\t\t\t\t\tthis.loadDashboardData();\n\t\t\t\t\t} else {\n\t\t\t\t\t\t\n\t\t\t\t\t}\n\t\t\t\t} catch (error) {\n\t\t\t\t\tconsole.error('Error unsilencing selected items:', error);\n\t\t\t\t\t\n\t\t\t\t}\n\t\t\t},\n\n\t\t\tisAlertSilenced(alert) {\n\t\t\t\tif (!alert) return false;\n\t\t\t\treturn alert.status?.state === 'suppressed' || \n\t\t\t\t\t   alert.status?.state === 'silenced' || \n\t\t\t\t\t   (alert.status?.silencedBy && alert.status.silencedBy.length > 0);\n\t\t\t},\n\n\t\t\thasUnsilencedAlertsSelected() {\n\t\t\t\treturn this.selectedAlerts.some(fingerprint => {\n\t\t\t\t\tconst alert = this.alerts.find(a => a.fingerprint === fingerprint);\n\t\t\t\t\treturn alert && !this.isAlertSilenced(alert);\n\t\t\t\t});\n\t\t\t},\n\n\t\t\thasSilencedAlertsSelected() {\n\t\t\t\treturn this.selectedAlerts.some(fingerprint => {\n\t\t\t\t\tconst alert = this.alerts.find(a => a.fingerprint === fingerprint);\n\t\t\t\t\treturn alert && this.isAlertSilenced(alert);\n\t\t\t\t});\n\t\t\t},\n\n\t\t\tisGroupFullySilenced(group) {\n\t\t\t\tif (!group || !group.alerts) return false;\n\t\t\t\treturn group.alerts.every(alert => this.isAlertSilenced(alert));\n\t\t\t},\n\n\t\t\tasync unsilenceAlert(fingerprint) {\n\t\t\t\ttry {\n\t\t\t\t\tconst response = await fetch('/api/v1/dashboard/bulk-action', {\n\t\t\t\t\t\tmethod: 'POST',\n\t\t\t\t\t\tcredentials: 'include',\n\t\t\t\t\t\theaders: {\n\t\t\t\t\t\t\t'Content-Type': 'application/json',\n\t\t\t\t\t\t},\n\t\t\t\t\t\tbody: JSON.stringify({\n\t\t\t\t\t\t\taction: 'unsilence',\n\t\t\t\t\t\t\talertFingerprints: [fingerprint],\n\t\t\t\t\t\t\tcomment: 'Unsilenced from table action'\n\t\t\t\t\t\t})\n\t\t\t\t\t});\n\n\t\t\t\t\tconst result = await response.json();\n\t\t\t\t\t\n\t\t\t\t\tif (result.success) {\n\t\t\t\t\t\t\n\t\t\t\t\t\tthis.loadDashboardData();\n\t\t\t\t\t} else {\n\t\t\t\t\t\t\n\t\t\t\t\t}\n\t\t\t\t} catch (error) {\n\t\t\t\t\tconsole.error('Error unsilencing alert:', error);\n\t\t\t\t\t\n\t\t\t\t}\n\t\t\t},\n\n\t\t\tasync unsilenceGroup(groupName) {\n\t\t\t\ttry {\n\t\t\t\t\tconst response = await fetch('/api/v1/dashboard/bulk-action', {\n\t\t\t\t\t\tmethod: 'POST',\n\t\t\t\t\t\tcredentials: 'include',\n\t\t\t\t\t\theaders: {\n\t\t\t\t\t\t\t'Content-Type': 'application/json',\n\t\t\t\t\t\t},\n\t\t\t\t\t\tbody: JSON.stringify({\n\t\t\t\t\t\t\taction: 'unsilence',\n\t\t\t\t\t\t\tgroupNames: [groupName],\n\t\t\t\t\t\t\tcomment: 'Unsilenced group action'\n\t\t\t\t\t\t})\n\t\t\t\t\t});\n\n\t\t\t\t\tconst result = await response.json();\n\t\t\t\t\t\n\t\t\t\t\tif (result.success) {\n\t\t\t\t\t\t\n\t\t\t\t\t\tthis.loadDashboardData();\n\t\t\t\t\t} else {\n\t\t\t\t\t\t\n\t\t\t\t\t}\n\t\t\t\t} catch (error) {\n\t\t\t\t\tconsole.error('Error unsilencing group:', error);\n\t\t\t\t\t\n\t\t\t\t}\n\t\t\t},\n\n\n\t\t\t// Utility function to check if an alert is hidden\n\t\t\tisAlertHidden(alert) {\n\t\t\t\t// Check against cached hidden alerts in settings modal if available\n\t\t\t\tif (window.currentSettingsModal && window.currentSettingsModal.hiddenAlerts) {\n\t\t\t\t\treturn window.currentSettingsModal.hiddenAlerts.some(hiddenAlert => \n\t\t\t\t\t\thiddenAlert.fingerprint === alert.fingerprint\n\t\t\t\t\t);\n\t\t\t\t}\n\t\t\t\t\n\t\t\t\t// Fallback: check if the alert is in the hidden display mode results\n\t\t\t\t// (This would mean it's currently being displayed in the hidden view)\n\t\t\t\tif (this.displayMode === 'hidden') {\n\t\t\t\t\treturn true;\n\t\t\t\t}\n\t\t\t\t\n\t\t\t\treturn false;\n\t\t\t}\n\t\t};\n\t</script>")
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 1, "<script>\n\t\twindow.dashboardActionsMixin = {\n\t\t\tcancelAcknowledgment() {\n\t\t\t\tthis.showAckModal = false;\n\t\t\t\tthis.ackReason = '';\n\t\t\t\tthis.ackDuration = '';\n\t\t\t\tthis.ackRenotify = false;\n\t\t\t\tthis.ackError = '';\n\t\t\t\tthis.ackSubmitting = false;\n\t\t\t\tthis.currentAckAlert = null;\n\t\t\t\tthis.currentGroupName = '';\n\t\t\t},\n\t\t\t\n\t\t\tasync submitAcknowledgment() {\n\t\t\t\tif (!this.ackReason.trim()) {\n\t\t\t\t\tthis.ackError = 'Please provide a reason for the acknowledgment';\n\t\t\t\t\treturn;\n\t\t\t\t}\n\t\t\t\t\n\t\t\t\tthis.ackSubmitting = true;\n\t\t\t\tthis.ackError = '';\n\t\t\t\t\n\t\t\t\ttry {\n\t\t\t\t\tlet request;\n\t\t\t\t\tlet successMessage;\n\t\t\t\t\t\n\t\t\t\t\tswitch (this.ackAction) {\n\t\t\t\t\t\tcase 'single':\n\t\t\t\t\t\t\trequest = {\n\t\t\t\t\t\t\t\talertFingerprints: [this.currentAckAlert.fingerprint],\n\t\t\t\t\t\t\t\tgroupNames: [],\n\t\t\t\t\t\t\t\taction: 'acknowledge',\n\t\t\t\t\t\t\t\tcomment: this.ackReason\n\t\t\t\t\t\t\t};\n\t\t\t\t\t\t\tsuccessMessage = 'Alert acknowledged successfully';\n\t\t\t\t\t\t\tbreak;\n\t\t\t\t\t\t\t\n\t\t\t\t\t\tcase 'group':\n\t\t\t\t\t\t\trequest = {\n\t\t\t\t\t\t\t\talertFingerprints: [],\n\t\t\t\t\t\t\t\tgroupNames: [this.currentGroupName],\n\t\t\t\t\t\t\t\taction: 'acknowledge',\n\t\t\t\t\t\t\t\tcomment: this.ackReason\n\t\t\t\t\t\t\t};\n\t\t\t\t\t\t\tsuccessMessage = `Group \"${this.currentGroupName}\" acknowledged successfully`;\n\t\t\t\t\t\t\tbreak;\n\t\t\t\t\t\t\t\n\t\t\t\t\t\tcase 'bulk':\n\t\t\t\t\t\t\trequest = {\n\t\t\t\t\t\t\t\talertFingerprints: this.selectedAlerts,\n\t\t\t\t\t\t\t\tgroupNames: this.selectedGroups,\n\t\t\t\t\t\t\t\taction: 'acknowledge',\n\t\t\t\t\t\t\t\tcomment: this.ackReason\n\t\t\t\t\t\t\t};\n\t\t\t\t\t\t\tsuccessMessage = `${this.selectedAlerts.length + this.selectedGroups.length} items acknowledged successfully`;\n\t\t\t\t\t\t\tbreak;\n\t\t\t\t\t\t\t\n\t\t\t\t\t\tdefault:\n\t\t\t\t\t\t\tthis.ackError = 'Invalid acknowledgment action';\n\t\t\t\t\t\t\tthis.ackSubmitting = false;\n\t\t\t\t\t\t\treturn;\n\t\t\t\t\t}\n\n\t\t\t\t\tif (this.ackDuration) {\n\t\t\t\t\t\trequest.ackDuration = this.ackDuration;\n\t\t\t\t\t\trequest.ackRenotify = this.ackRenotify;\n\t\t\t\t\t}\n\t\t\t\t\t\n\t\t\t\t\tconst response = await fetch('/api/v1/dashboard/bulk-action', {\n\t\t\t\t\t\tmethod: 'POST',\n\t\t\t\t\t\tcredentials: 'include',\n\t\t\t\t\t\theaders: {\n\t\t\t\t\t\t\t'Content-Type': 'application/json',\n\t\t\t\t\t\t},\n\t\t\t\t\t\tbody: JSON.stringify(request)\n\t\t\t\t\t});\n\n\t\t\t\t\t// Check for authentication errors and redirect if needed\n\t\t\t\t\tif (window.dashboardInstance && window.dashboardInstance.handleAuthError(response)) {\n\t\t\t\t\t\treturn;\n\t\t\t\t\t}\n\n\t\t\t\t\tconst result = await response.json();\n\t\t\t\t\t\n\t\t\t\t\tif (result.success) {\n\t\t\t\t\t\tthis.showAckModal = false;\n\t\t\t\t\t\t\n\t\t\t\t\t\tif (this.ackAction === 'bulk') {\n\t\t\t\t\t\t\tthis.clearSelection();\n\t\t\t\t\t\t}\n\t\t\t\t\t\t\n\t\t\t\t\t\tthis.loadDashboardData();\n\t\t\t\t\t} else {\n\t\t\t\t\t\tthis.ackError = result.error || 'Failed to acknowledge';\n\t\t\t\t\t}\n\t\t\t\t} catch (error) {\n\t\t\t\t\tconsole.error('Error submitting acknowledgment:', error);\n\t\t\t\t\tthis.ackError = 'Network error: Failed to submit acknowledgment';\n\t\t\t\t} finally {\n\t\t\t\t\tthis.ackSubmitting = false;\n\t\t\t\t}\n\t\t\t},\n\n\t\t\tasync hideSelected() {\n\t\t\t\tif (this.selectedAlerts.length === 0 && this.selectedGroups.length === 0) {\n\t\t\t\t\treturn;\n\t\t\t\t}\n\n\t\t\t\ttry {\n\t\t\t\t\tlet hiddenCount = 0;\n\n\t\t\t\t\t// Hide individual alerts\n\t\t\t\t\tfor (const fingerprint of this.selectedAlerts) {\n\t\t\t\t\t\tconst response = await fetch('/api/v1/dashboard/hidden-alerts', {\n\t\t\t\t\t\t\tmethod: 'POST',\n\t\t\t\t\t\t\tcredentials: 'include',\n\t\t\t\t\t\t\theaders: {\n\t\t\t\t\t\t\t\t'Content-Type': 'application/json',\n\t\t\t\t\t\t\t},\n\t\t\t\t\t\t\tbody: JSON.stringify({\n\t\t\t\t\t\t\t\tfingerprint: fingerprint,\n\t\t\t\t\t\t\t\treason: 'Hidden from dashboard bulk action'\n\t\t\t\t\t\t\t})\n\t\t\t\t\t\t});\n\n\t\t\t\t\t\t// Check for authentication errors and redirect if needed\n\t\t\t\t\t\tif (window.dashboardInstance && window.dashboardInstance.handleAuthError(response)) {\n\t\t\t\t\t\t\treturn;\n\t\t\t\t\t\t}\n\n\t\t\t\t\t\tconst result = await response.json();\n\t\t\t\t\t\tif (result.success) {\n\t\t\t\t\t\t\thiddenCount++;\n\t\t\t\t\t\t}\n\t\t\t\t\t}\n\n\t\t\t\t\t// TODO: Handle group hiding when groups are supported\n\n\t\t\t\t\tif (hiddenCount > 0) {\n\n\t\t\t\t\t\tthis.clearSelection();\n\t\t\t\t\t\tawait this.syncHiddenState();\n\t\t\t\t\t\tthis.loadDashboardData();\n\t\t\t\t\t} else {\n\n\t\t\t\t\t}\n\t\t\t\t} catch (error) {\n\t\t\t\t\tconsole.error('Error hiding alerts:', error);\n\n\t\t\t\t}\n\t\t\t},\n\n\t\t\topenSnoozeModal(fingerprints) {\n\t\t\t\tthis.snoozeFingerprints = Array.isArray(fingerprints) ? [...fingerprints] : [fingerprints];\n\t\t\t\tif (this.snoozeFingerprints.length === 0) return;\n\t\t\t\tthis.snoozeDuration = '1h';\n\t\t\t\tthis.snoozeReason = '';\n\t\t\t\tthis.snoozeError = '';\n\t\t\t\tthis.showSnoozeModal = true;\n\t\t\t},\n\n\t\t\t// Snooze the alerts picked in the snooze dialog; they drop out of this\n\t\t\t// user's view and come back on their own once the snooze ends\n\t\t\tasync submitSnooze() {\n\t\t\t\tif (this.snoozeSubmitting) return;\n\t\t\t\tthis.snoozeSubmitting = true;\n\t\t\t\tthis.snoozeError = '';\n\n\t\t\t\ttry {\n\t\t\t\t\tlet snoozedCount = 0;\n\t\t\t\t\tfor (const fingerprint of this.snoozeFingerprints) {\n\t\t\t\t\t\tconst alert = this.alerts.find(a => a.fingerprint === fingerprint);\n\t\t\t\t\t\tconst response = await fetch('/api/v1/dashboard/hidden-alerts', {\n\t\t\t\t\t\t\tmethod: 'POST',\n\t\t\t\t\t\t\tcredentials: 'include',\n\t\t\t\t\t\t\theaders: {\n\t\t\t\t\t\t\t\t'Content-Type': 'application/json',\n\t\t\t\t\t\t\t},\n\t\t\t\t\t\t\tbody: JSON.stringify({\n\t\t\t\t\t\t\t\tfingerprint: fingerprint,\n\t\t\t\t\t\t\t\talertName: alert?.alertName || '',\n\t\t\t\t\t\t\t\tinstance: alert?.instance || '',\n\t\t\t\t\t\t\t\treason: this.snoozeReason.trim(),\n\t\t\t\t\t\t\t\tsnoozeDuration: this.snoozeDuration\n\t\t\t\t\t\t\t})\n\t\t\t\t\t\t});\n\n\t\t\t\t\t\tif (window.dashboardInstance && window.dashboardInstance.handleAuthError(response)) {\n\t\t\t\t\t\t\treturn;\n\t\t\t\t\t\t}\n\n\t\t\t\t\t\tconst result = await response.json();\n\t\t\t\t\t\tif (result.success) {\n\t\t\t\t\t\t\tsnoozedCount++;\n\t\t\t\t\t\t} else {\n\t\t\t\t\t\t\tthis.snoozeError = result.error || 'Failed to snooze alert';\n\t\t\t\t\t\t}\n\t\t\t\t\t}\n\n\t\t\t\t\tif (snoozedCount > 0) {\n\t\t\t\t\t\tif (this.snoozeFingerprints.length > 1) {\n\t\t\t\t\t\t\tthis.clearSelection();\n\t\t\t\t\t\t}\n\t\t\t\t\t\tawait this.syncHiddenState();\n\t\t\t\t\t\tthis.loadDashboardData();\n\t\t\t\t\t}\n\t\t\t\t\tif (!this.snoozeError) {\n\t\t\t\t\t\tthis.showSnoozeModal = false;\n\t\t\t\t\t}\n\t\t\t\t} catch (error) {\n\t\t\t\t\tconsole.error('Error snoozing alerts:', error);\n\t\t\t\t\tthis.snoozeError = 'Network error: Failed to snooze alert';\n\t\t\t\t} finally {\n\t\t\t\t\tthis.snoozeSubmitting = false;\n\t\t\t\t}\n\t\t\t},\n\n\t\t\t// Active snooze of an alert, or undefined when it is not snoozed\n\t\t\talertSnooze(alert) {\n\t\t\t\tconst hidden = window.currentSettingsModal?.activeHiddenAlert(alert.fingerprint);\n\t\t\t\treturn hidden?.expires_at ? hidden : undefined;\n\t\t\t},\n\n\t\t\t// Re-read the user's hidden alerts and rules from the backend so the\n\t\t\t// client-side live-update filter matches the server, including hides\n\t\t\t// made from another browser\n\t\t\tasync syncHiddenState() {\n\t\t\t\tconst settings = window.currentSettingsModal;\n\t\t\t\tif (!settings) return;\n\t\t\t\tawait Promise.all([settings.loadHiddenAlerts(), settings.loadHiddenRules()]);\n\t\t\t},\n\n\t\t\t// Hide selected alerts in the active filter (filter-specific hiding)\n\t\t\thideSelectedInFilter() {\n\t\t\t\tif (this.selectedAlerts.length === 0) {\n\t\t\t\t\treturn;\n\t\t\t\t}\n\n\t\t\t\t// Check if a filter preset is active\n\t\t\t\tif (!this.activeFilterPresetId) {\n\t\t\t\t\talert('No saved filter is currently active. Load a saved filter first, or use \"Hide Globally\" to hide alerts for all views.');\n\t\t\t\t\treturn;\n\t\t\t\t}\n\n\t\t\t\t// Add each selected alert to the filter hidden alerts\n\t\t\t\tlet addedCount = 0;\n\t\t\t\tfor (const fingerprint of this.selectedAlerts) {\n\t\t\t\t\tconst alert = this.alerts.find(a => a.fingerprint === fingerprint);\n\t\t\t\t\tif (alert) {\n\t\t\t\t\t\t// Check if not already in filter hidden\n\t\t\t\t\t\tconst alreadyHidden = this.filterHiddenAlerts.some(h => h.fingerprint === fingerprint);\n\t\t\t\t\t\tif (!alreadyHidden) {\n\t\t\t\t\t\t\tthis.addFilterHiddenAlert(fingerprint, alert.alertName, alert.instance, 'Hidden from bulk action');\n\t\t\t\t\t\t\taddedCount++;\n\t\t\t\t\t\t}\n\t\t\t\t\t}\n\t\t\t\t}\n\n\t\t\t\tif (addedCount > 0) {\n\t\t\t\t\tconsole.log(`Added ${addedCount} alerts to filter hidden list`);\n\t\t\t\t\tthis.clearSelection();\n\t\t\t\t\t// Reload to apply the filter\n\t\t\t\t\tthis.loadDashboardData();\n\t\t\t\t}\n\t\t\t},\n\n\t\t\t// Hide a single alert in the active filter\n\t\t\thideAlertInFilter(fingerprint) {\n\t\t\t\t// Check if a filter preset is active\n\t\t\t\tif (!this.activeFilterPresetId) {\n\t\t\t\t\talert('No saved filter is currently active. Load a saved filter first, or use \"Hide Globally\" to hide alerts for all views.');\n\t\t\t\t\treturn;\n\t\t\t\t}\n\n\t\t\t\tconst alert = this.alerts.find(a => a.fingerprint === fingerprint);\n\t\t\t\tif (alert) {\n\t\t\t\t\t// Check if not already in filter hidden\n\t\t\t\t\tconst alreadyHidden = this.filterHiddenAlerts.some(h => h.fingerprint === fingerprint);\n\t\t\t\t\tif (!alreadyHidden) {\n\t\t\t\t\t\tthis.addFilterHiddenAlert(fingerprint, alert.alertName, alert.instance, 'Hidden from alert action');\n\t\t\t\t\t\tconsole.log('Added alert to filter hidden list:', fingerprint);\n\t\t\t\t\t\t// Reload to apply the filter\n\t\t\t\t\t\tthis.loadDashboardData();\n\t\t\t\t\t} else {\n\t\t\t\t\t\tconsole.log('Alert already hidden in filter:', fingerprint);\n\t\t\t\t\t}\n\t\t\t\t}\n\t\t\t},\n\n\t\t\tacknowledgeAlert(fingerprint) {\n\t\t\t\tconst alert = this.alerts.find(a => a.fingerprint === fingerprint);\n\t\t\t\tif (!alert) { return; }\n\t\t\t\tthis.currentAckAlert = alert;\n\t\t\t\tthis.ackAction = 'single';\n\t\t\t\tthis.ackReason = '';\n\t\t\t\tthis.ackDuration = '';\n\t\t\t\tthis.ackRenotify = false;\n\t\t\t\tthis.ackError = '';\n\t\t\t\tthis.showAckModal = true;\n\t\t\t},\n\n\t\t\tacknowledgeGroup(groupName) {\n\t\t\t\tthis.currentGroupName = groupName;\n\t\t\t\tthis.ackAction = 'group';\n\t\t\t\tthis.ackReason = '';\n\t\t\t\tthis.ackDuration = '';\n\t\t\t\tthis.ackRenotify = false;\n\t\t\t\tthis.ackError = '';\n\t\t\t\tthis.showAckModal = true;\n\t\t\t},\n\n\t\t\tacknowledgeSelected() {\n\t\t\t\tif (this.selectedAlerts.length === 0 && this.selectedGroups.length === 0) {\n\t\t\t\t\t\n\t\t\t\t\treturn;\n\t\t\t\t}\n\t\t\t\t\n\t\t\t\tthis.ackAction = 'bulk';\n\t\t\t\tthis.ackReason = '';\n\t\t\t\tthis.ackDuration = '';\n\t\t\t\tthis.ackRenotify = false;\n\t\t\t\tthis.ackError = '';\n\t\t\t\tthis.showAckModal = true;\n\t\t\t},\n\n\n\t\t\tasync unacknowledgeSelected() {\n\t\t\t\tconst request = {\n\t\t\t\t\talertFingerprints: this.selectedAlerts,\n\t\t\t\t\tgroupNames: this.selectedGroups,\n\t\t\t\t\taction: 'unacknowledge',\n\t\t\t\t\tcomment: 'Unacknowledged from dashboard'\n\t\t\t\t};\n\n\t\t\t\ttry {\n\t\t\t\t\tconst response = await fetch('/api/v1/dashboard/bulk-action', {\n\t\t\t\t\t\tmethod: 'POST',\n\t\t\t\t\t\tcredentials: 'include',\n\t\t\t\t\t\theaders: {\n\t\t\t\t\t\t\t'Content-Type': 'application/json',\n\t\t\t\t\t\t},\n\t\t\t\t\t\tbody: JSON.stringify(request)\n\t\t\t\t\t});\n\n\t\t\t\t\t// Check for authentication errors and redirect if needed\n\t\t\t\t\tif (window.dashboardInstance && window.dashboardInstance.handleAuthError(response)) {\n\t\t\t\t\t\treturn;\n\t\t\t\t\t}\n\n\t\t\t\t\tconst result = await response.json();\n\t\t\t\t\t\n\t\t\t\t\tif (result.success) {\n\t\t\t\t\t\t\n\t\t\t\t\t\tthis.clearSelection();\n\t\t\t\t\t\tthis.loadDashboardData();\n\t\t\t\t\t} else {\n\t\t\t\t\t\t\n\t\t\t\t\t}\n\t\t\t\t} catch (error) {\n\t\t\t\t\tconsole.error('Error unacknowledging alerts:', error);\n\t\t\t\t\t\n\t\t\t\t}\n\t\t\t},\n\n\t\t\tasync unacknowledgeAlert(fingerprint) {\n\t\t\t\tconst request = {\n\t\t\t\t\talertFingerprints: [fingerprint],\n\t\t\t\t\taction: 'unacknowledge',\n\t\t\t\t\tcomment: 'Unacknowledged from dashboard'\n\t\t\t\t};\n\n\t\t\t\ttry {\n\t\t\t\t\tconst response = await fetch('/api/v1/dashboard/bulk-action', {\n\t\t\t\t\t\tmethod: 'POST',\n\t\t\t\t\t\tcredentials: 'include',\n\t\t\t\t\t\theaders: {\n\t\t\t\t\t\t\t'Content-Type': 'application/json',\n\t\t\t\t\t\t},\n\t\t\t\t\t\tbody: JSON.stringify(request)\n\t\t\t\t\t});\n\n\t\t\t\t\t// Check for authentication errors and redirect if needed\n\t\t\t\t\tif (window.dashboardInstance && window.dashboardInstance.handleAuthError(response)) {\n\t\t\t\t\t\treturn;\n\t\t\t\t\t}\n\n\t\t\t\t\tconst result = await response.json();\n\t\t\t\t\t\n\t\t\t\t\tif (result.success) {\n\t\t\t\t\t\t\n\t\t\t\t\t\tthis.loadDashboardData();\n\t\t\t\t\t} else {\n\t\t\t\t\t\t\n\t\t\t\t\t}\n\t\t\t\t} catch (error) {\n\t\t\t\t\tconsole.error('Error unacknowledging alert:', error);\n\t\t\t\t\t\n\t\t\t\t}\n\t\t\t},\n\n\t\t\tasync resolveSelected() {\n\t\t\t\tconst request = {\n\t\t\t\t\talertFingerprints: this.selectedAlerts,\n\t\t\t\t\tgroupNames: this.selectedGroups,\n\t\t\t\t\taction: 'resolve',\n\t\t\t\t\tcomment: 'Resolved from dashboard'\n\t\t\t\t};\n\n\t\t\t\ttry {\n\t\t\t\t\tconst response = await fetch('/api/v1/dashboard/bulk-action', {\n\t\t\t\t\t\tmethod: 'POST',\n\t\t\t\t\t\tcredentials: 'include',\n\t\t\t\t\t\theaders: {\n\t\t\t\t\t\t\t'Content-Type': 'application/json',\n\t\t\t\t\t\t},\n\t\t\t\t\t\tbody: JSON.stringify(request)\n\t\t\t\t\t});\n\n\t\t\t\t\t// Check for authentication errors and redirect if needed\n\t\t\t\t\tif (window.dashboardInstance && window.dashboardInstance.handleAuthError(response)) {\n\t\t\t\t\t\treturn;\n\t\t\t\t\t}\n\n\t\t\t\t\tconst result = await response.json();\n\t\t\t\t\t\n\t\t\t\t\tif (result.success) {\n\t\t\t\t\t\t\n\t\t\t\t\t\tthis.clearSelection();\n\t\t\t\t\t\tthis.loadDashboardData();\n\t\t\t\t\t} else {\n\t\t\t\t\t\t\n\t\t\t\t\t}\n\t\t\t\t} catch (error) {\n\t\t\t\t\tconsole.error('Error resolving alerts:', error);\n\t\t\t\t\t\n\t\t\t\t}\n\t\t\t},\n\n\t\t\tasync resolveAlert(fingerprint) {\n\t\t\t\tconst request = {\n\t\t\t\t\talertFingerprints: [fingerprint],\n\t\t\t\t\taction: 'resolve',\n\t\t\t\t\tcomment: 'Resolved from dashboard'\n\t\t\t\t};\n\n\t\t\t\ttry {\n\t\t\t\t\tconst response = await fetch('/api/v1/dashboard/bulk-action', {\n\t\t\t\t\t\tmethod: 'POST',\n\t\t\t\t\t\tcredentials: 'include',\n\t\t\t\t\t\theaders: {\n\t\t\t\t\t\t\t'Content-Type': 'application/json',\n\t\t\t\t\t\t},\n\t\t\t\t\t\tbody: JSON.stringify(request)\n\t\t\t\t\t});\n\n\t\t\t\t\t// Check for authentication errors and redirect if needed\n\t\t\t\t\tif (window.dashboardInstance && window.dashboardInstance.handleAuthError(response)) {\n\t\t\t\t\t\treturn;\n\t\t\t\t\t}\n\n\t\t\t\t\tconst result = await response.json();\n\t\t\t\t\t\n\t\t\t\t\tif (result.success) {\n\t\t\t\t\t\t\n\t\t\t\t\t\tthis.loadDashboardData();\n\t\t\t\t\t} else {\n\t\t\t\t\t\t\n\t\t\t\t\t}\n\t\t\t\t} catch (error) {\n\t\t\t\t\tconsole.error('Error resolving alert:', error);\n\t\t\t\t\t\n\t\t\t\t}\n\t\t\t},\n\n\t\t\tconfirmRemoveResolvedAlerts() {\n\t\t\t\tif (confirm('Are you sure you want to remove all resolved alerts? You can undo this for a few seconds.')) {\n\t\t\t\t\tthis.removeAllResolvedAlerts();\n\t\t\t\t}\n\t\t\t},\n\n\t\t\t// Returns the error message, or '' once the resolved alerts are removed\n\t\t\tasync removeAllResolvedAlerts() {\n\t\t\t\tthis.isRemovingResolvedAlerts = true;\n\t\t\t\t\n\t\t\t\ttry {\n\t\t\t\t\tconst response = await fetch('/api/v1/dashboard/remove-resolved-alerts', {\n\t\t\t\t\t\tmethod: 'DELETE',\n\t\t\t\t\t\tcredentials: 'include'\n\t\t\t\t\t});\n\n\t\t\t\t\t// Check for authentication errors and redirect if needed\n\t\t\t\t\tif (window.dashboardInstance && window.dashboardInstance.handleAuthError(response)) {\n\t\t\t\t\t\treturn 'Not authenticated';\n\t\t\t\t\t}\n\n\t\t\t\t\tconst result = await response.json();\n\t\t\t\t\t\n\t\t\t\t\tif (result.success) {\n\t\t\t\t\t\t\n\t\t\t\t\t\tif (this.displayMode === 'resolved') {\n\t\t\t\t\t\t\tawait this.loadDashboardData();\n\t\t\t\t\t\t}\n\t\t\t\t\t\tthis.offerUndo('All resolved alerts removed', '/api/v1/dashboard/remove-resolved-alerts/undo', result.data, async () => {\n\t\t\t\t\t\t\tif (this.displayMode === 'resolved') {\n\t\t\t\t\t\t\t\tawait this.loadDashboardData();\n\t\t\t\t\t\t\t}\n\t\t\t\t\t\t\tif (this.showResolvedArchive) {\n\t\t\t\t\t\t\t\tawait this.loadResolvedArchive();\n\t\t\t\t\t\t\t}\n\t\t\t\t\t\t});\n\t\t\t\t\t\treturn '';\n\t\t\t\t\t}\n\t\t\t\t\treturn result.error || 'Failed to remove resolved alerts';\n\t\t\t\t} catch (error) {\n\t\t\t\t\tconsole.error('Error removing resolved alerts:', error);\n\t\t\t\t\treturn error.message;\n\t\t\t\t} finally {\n\t\t\t\t\tthis.isRemovingResolvedAlerts = false;\n\t\t\t\t}\n\t\t\t},\n\n\t\t\t// Shows the Undo toast after a \"clear all\", which the backend keeps\n\t\t\t// restorable until data.undoUntil. The undo posts data.clearedAt to\n\t\t\t// undoUrl, then runs onUndone.\n\t\t\tofferUndo(message, undoUrl, data, onUndone) {\n\t\t\t\t// Both times come from the backend, so client clock skew doesn't matter\n\t\t\t\tconst undoWindow = new Date(data?.undoUntil).getTime() - new Date(data?.clearedAt).getTime();\n\t\t\t\tif (!(undoWindow > 0)) return;\n\n\t\t\t\tclearTimeout(this._undoNoticeTimer);\n\t\t\t\tthis.undoNotice = { message, undoUrl, clearedAt: data.clearedAt, onUndone, undoing: false, error: '' };\n\t\t\t\tthis._undoNoticeTimer = setTimeout(() => { this.undoNotice = null; }, undoWindow);\n\t\t\t},\n\n\t\t\tasync undoClear() {\n\t\t\t\tconst notice = this.undoNotice;\n\t\t\t\tif (!notice || notice.undoing) return;\n\t\t\t\tnotice.undoing = true;\n\n\t\t\t\ttry {\n\t\t\t\t\tconst response = await fetch(notice.undoUrl, {\n\t\t\t\t\t\tmethod: 'POST',\n\t\t\t\t\t\tcredentials: 'include',\n\t\t\t\t\t\theaders: { 'Content-Type': 'application/json' },\n\t\t\t\t\t\tbody: JSON.stringify({ clearedAt: notice.clearedAt })\n\t\t\t\t\t});\n\t\t\t\t\tif (this.handleAuthError(response)) return;\n\n\t\t\t\t\tconst result = await response.json();\n\t\t\t\t\tif (!result.success) {\n\t\t\t\t\t\tthrow new Error(result.error || 'Failed to undo');\n\t\t\t\t\t}\n\t\t\t\t\tclearTimeout(this._undoNoticeTimer);\n\t\t\t\t\tthis.undoNotice = null;\n\t\t\t\t\tif (notice.onUndone) {\n\t\t\t\t\t\tawait notice.onUndone(result.data.restored);\n\t\t\t\t\t}\n\t\t\t\t} catch (error) {\n\t\t\t\t\tconsole.error('Error undoing clear:', error);\n\t\t\t\t\tnotice.error = error.message;\n\t\t\t\t} finally {\n\t\t\t\t\tnotice.undoing = false;\n\t\t\t\t}\n\t\t\t},\n\n\t\t\tcancelSilence() {\n\t\t\t\tthis.showSilenceModal = false;\n\t\t\t\tthis.silenceMode = 'create';\n\t\t\t\tthis.editingSilence = null;\n\t\t\t\tthis.silenceMatchers = [];\n\t\t\t\tthis.silenceEndsAt = '';\n\t\t\t\tthis.silenceSuggestions = null;\n\t\t\t\tthis.silenceReason = '';\n\t\t\t\tthis.silenceError = '';\n\t\t\t\tthis.silenceSubmitting = false;\n\t\t\t\tthis.currentSilenceAlert = null;\n\t\t\t\tthis.silenceLabelChoices = [];\n\t\t\t\tthis.silenceCustomMatchers = '';\n\t\t\t\tthis.currentGroupName = '';\n\t\t\t\tthis.silenceDuration = '1h';\n\t\t\t},\n\t\t\t\n\t\t\tasync submitSilence() {\n\t\t\t\tif (!this.silenceReason.trim()) {\n\t\t\t\t\tthis.silenceError = 'Please provide a reason for the silence';\n\t\t\t\t\treturn;\n\t\t\t\t}\n\n\t\t\t\tif (this.silenceMode === 'edit') {\n\t\t\t\t\treturn this.submitSilenceUpdate();\n\t\t\t\t}\n\t\t\t\t\n\t\t\t\tif (this.silenceDurationType === 'custom') {\n\t\t\t\t\tif (!this.validateCustomDuration()) {\n\t\t\t\t\t\treturn;\n\t\t\t\t\t}\n\t\t\t\t}\n\t\t\t\t\n\t\t\t\tconst custom = this.parseCustomSilenceMatchers();\n\t\t\t\tif (custom.error) {\n\t\t\t\t\tthis.silenceError = custom.error;\n\t\t\t\t\treturn;\n\t\t\t\t}\n\n\t\t\t\tif (this.silenceAction === 'standalone') {\n\t\t\t\t\tif (custom.matchers.length === 0) {\n\t\t\t\t\t\tthis.silenceError = 'At least one matcher is required';\n\t\t\t\t\t\treturn;\n\t\t\t\t\t}\n\t\t\t\t\treturn this.createSilenceFromSuggestion({ matchers: custom.matchers });\n\t\t\t\t}\n\n\t\t\t\tthis.silenceSubmitting = true;\n\t\t\t\tthis.silenceError = '';\n\t\t\t\t\n\t\t\t\ttry {\n\t\t\t\t\tlet request;\n\t\t\t\t\tlet successMessage;\n\t\t\t\t\t\n\t\t\t\t\tconst durationFields = { silenceMatchers: custom.lines };\n\t\t\t\t\tif (this.silenceDurationType === 'custom') {\n\t\t\t\t\t\tdurationFields.silenceDurationType = 'custom';\n\t\t\t\t\t\tdurationFields.customSilenceDuration = this.customSilenceDuration.trim();\n\t\t\t\t\t} else {\n\t\t\t\t\t\tdurationFields.silenceDuration = this.parseDurationToSeconds(this.silenceDuration) * 1000000000;\n\t\t\t\t\t\tdurationFields.silenceDurationType = 'preset';\n\t\t\t\t\t}\n\t\t\t\t\t\n\t\t\t\t\tswitch (this.silenceAction) {\n\t\t\t\t\t\tcase 'single': {\n\t\t\t\t\t\t\tconst silenceLabels = this.silenceLabelChoices.filter(l => l.checked).map(l => l.name);\n\t\t\t\t\t\t\tif (this.silenceLabelChoices.length > 0 && silenceLabels.length === 0 && custom.lines.length === 0) {\n\t\t\t\t\t\t\t\tthis.silenceError = 'Select at least one label or add a custom matcher';\n\t\t\t\t\t\t\t\tthis.silenceSubmitting = false;\n\t\t\t\t\t\t\t\treturn;\n\t\t\t\t\t\t\t}\n\t\t\t\t\t\t\trequest = {\n\t\t\t\t\t\t\t\talertFingerprints: [this.currentSilenceAlert.fingerprint],\n\t\t\t\t\t\t\t\tgroupNames: [],\n\t\t\t\t\t\t\t\taction: 'silence',\n\t\t\t\t\t\t\t\tcomment: this.silenceReason,\n\t\t\t\t\t\t\t\tsilenceLabels,\n\t\t\t\t\t\t\t\t...durationFields\n\t\t\t\t\t\t\t};\n\t\t\t\t\t\t\tsuccessMessage = 'Alert silenced successfully';\n\t\t\t\t\t\t\tbreak;\n\t\t\t\t\t\t}\n\t\t\t\t\t\t\t\n\t\t\t\t\t\tcase 'group':\n\t\t\t\t\t\t\trequest = {\n\t\t\t\t\t\t\t\talertFingerprints: [],\n\t\t\t\t\t\t\t\tgroupNames: [this.currentGroupName],\n\t\t\t\t\t\t\t\taction: 'silence',\n\t\t\t\t\t\t\t\tcomment: this.silenceReason,\n\t\t\t\t\t\t\t\t...durationFields\n\t\t\t\t\t\t\t};\n\t\t\t\t\t\t\tsuccessMessage = `Group \"${this.currentGroupName}\" silenced successfully`;\n\t\t\t\t\t\t\tbreak;\n\t\t\t\t\t\t\t\n\t\t\t\t\t\tcase 'bulk':\n\t\t\t\t\t\t\trequest = {\n\t\t\t\t\t\t\t\talertFingerprints: this.selectedAlerts,\n\t\t\t\t\t\t\t\tgroupNames: this.selectedGroups,\n\t\t\t\t\t\t\t\taction: 'silence',\n\t\t\t\t\t\t\t\tcomment: this.silenceReason,\n\t\t\t\t\t\t\t\t...durationFields\n\t\t\t\t\t\t\t};\n\t\t\t\t\t\t\tsuccessMessage = `${this.selectedAlerts.length + this.selectedGroups.length} items silenced successfully`;\n\t\t\t\t\t\t\tbreak;\n\t\t\t\t\t\t\t\n\t\t\t\t\t\tdefault:\n\t\t\t\t\t\t\tthis.silenceError = 'Invalid silence action';\n\t\t\t\t\t\t\tthis.silenceSubmitting = false;\n\t\t\t\t\t\t\treturn;\n\t\t\t\t\t}\n\t\t\t\t\t\n\t\t\t\t\tconst response = await fetch('/api/v1/dashboard/bulk-action', {\n\t\t\t\t\t\tmethod: 'POST',\n\t\t\t\t\t\tcredentials: 'include',\n\t\t\t\t\t\theaders: {\n\t\t\t\t\t\t\t'Content-Type': 'application/json',\n\t\t\t\t\t\t},\n\t\t\t\t\t\tbody: JSON.stringify(request)\n\t\t\t\t\t});\n\n\t\t\t\t\t// Check for authentication errors and redirect if needed\n\t\t\t\t\tif (window.dashboardInstance && window.dashboardInstance.handleAuthError(response)) {\n\t\t\t\t\t\treturn;\n\t\t\t\t\t}\n\n\t\t\t\t\tconst result = await response.json();\n\t\t\t\t\t\n\t\t\t\t\tif (result.success) {\n\t\t\t\t\t\t\n\t\t\t\t\t\tthis.showSilenceModal = false;\n\t\t\t\t\t\t\n\t\t\t\t\t\tif (this.silenceAction === 'bulk') {\n\t\t\t\t\t\t\tthis.clearSelection();\n\t\t\t\t\t\t}\n\t\t\t\t\t\t\n\t\t\t\t\t\tthis.loadDashboardData();\n\t\t\t\t\t\tthis.refreshSilencesView();\n\t\t\t\t\t} else {\n\t\t\t\t\t\tthis.silenceError = result.error || 'Failed to silence alert(s)';\n\t\t\t\t\t}\n\t\t\t\t} catch (error) {\n\t\t\t\t\tconsole.error('Error submitting silence:', error);\n\t\t\t\t\tthis.silenceError = 'Network error: Failed to submit silence';\n\t\t\t\t} finally {\n\t\t\t\t\tthis.silenceSubmitting = false;\n\t\t\t\t}\n\t\t\t},\n\n\t\t\t// Checkbox list of the alert's labels for picking silence matchers;\n\t\t\t// alertname and instance start checked\n\t\t\tbuildSilenceLabelChoices(alert) {\n\t\t\t\treturn Object.entries(alert?.labels || {})\n\t\t\t\t\t.sort(([a], [b]) => a.localeCompare(b))\n\t\t\t\t\t.map(([name, value]) => ({ name, value, checked: name === 'alertname' || name === 'instance' }));\n\t\t\t},\n\n\t\t\t// Validate the custom matchers box (one matcher per line, =, !=, =~ or !~)\n\t\t\t// so a bad regex is reported before anything is sent\n\t\t\tparseCustomSilenceMatchers() {\n\t\t\t\tconst lines = (this.silenceCustomMatchers || '').split('\\n').map(l => l.trim()).filter(l => l !== '');\n\t\t\t\tconst matchers = [];\n\t\t\t\tfor (const line of lines) {\n\t\t\t\t\tconst match = line.match(/^([a-zA-Z_][a-zA-Z0-9_]*)\\s*(=~|!~|!=|=)\\s*(.*)$/);\n\t\t\t\t\tif (!match) {\n\t\t\t\t\t\treturn { lines, matchers, error: `Invalid matcher \"${line}\": use name=value, name!=value, name=~regex or name!~regex` };\n\t\t\t\t\t}\n\t\t\t\t\tlet value = match[3];\n\t\t\t\t\tif (value.length >= 2 && value.startsWith('\"') && value.endsWith('\"')) {\n\t\t\t\t\t\tvalue = value.slice(1, -1);\n\t\t\t\t\t}\n\t\t\t\t\tif (match[2].endsWith('~')) {\n\t\t\t\t\t\ttry {\n\t\t\t\t\t\t\tnew RegExp(`^(?:${value})$`);\n\t\t\t\t\t\t} catch (e) {\n\t\t\t\t\t\t\treturn { lines, matchers, error: `Invalid regex in \"${line}\": ${e.message}` };\n\t\t\t\t\t\t}\n\t\t\t\t\t}\n\t\t\t\t\tmatchers.push({ name: match[1], value, isEqual: !match[2].startsWith('!'), isRegex: match[2].endsWith('~') });\n\t\t\t\t}\n\t\t\t\treturn { lines, matchers, error: '' };\n\t\t\t},\n\n\t\t\t// Fingerprints of the alerts the silence modal was opened for\n\t\t\tsilenceTargetFingerprints() {\n\t\t\t\tconst groupFingerprints = (names) => this.groups\n\t\t\t\t\t.filter(g => names.includes(g.groupName))\n\t\t\t\t\t.flatMap(g => (g.alerts || []).map(a => a.fingerprint));\n\n\t\t\t\tswitch (this.silenceAction) {\n\t\t\t\t\tcase 'single':\n\t\t\t\t\t\treturn this.currentSilenceAlert ? [this.currentSilenceAlert.fingerprint] : [];\n\t\t\t\t\tcase 'group':\n\t\t\t\t\t\treturn groupFingerprints([this.currentGroupName]);\n\t\t\t\t\tcase 'bulk':\n\t\t\t\t\t\treturn [...new Set([...this.selectedAlerts, ...groupFingerprints(this.selectedGroups)])];\n\t\t\t\t\tdefault:\n\t\t\t\t\t\treturn [];\n\t\t\t\t}\n\t\t\t},\n\n\t\t\tasync loadSilenceSuggestions() {\n\t\t\t\tconst fingerprints = this.silenceTargetFingerprints();\n\t\t\t\tif (fingerprints.length === 0) {\n\t\t\t\t\tthis.silenceError = 'No alerts to suggest matchers for';\n\t\t\t\t\treturn;\n\t\t\t\t}\n\n\t\t\t\tthis.silenceSuggestionsLoading = true;\n\t\t\t\tthis.silenceError = '';\n\n\t\t\t\ttry {\n\t\t\t\t\tconst response = await fetch('/api/v1/dashboard/silences/suggestions', {\n\t\t\t\t\t\tmethod: 'POST',\n\t\t\t\t\t\tcredentials: 'include',\n\t\t\t\t\t\theaders: {\n\t\t\t\t\t\t\t'Content-Type': 'application/json',\n\t\t\t\t\t\t},\n\t\t\t\t\t\tbody: JSON.stringify({ fingerprints: fingerprints })\n\t\t\t\t\t});\n\n\t\t\t\t\tif (window.dashboardInstance && window.dashboardInstance.handleAuthError(response)) {\n\t\t\t\t\t\treturn;\n\t\t\t\t\t}\n\n\t\t\t\t\tconst result = await response.json();\n\t\t\t\t\tif (result.success) {\n\t\t\t\t\t\tthis.silenceSuggestions = result.data || [];\n\t\t\t\t\t} else {\n\t\t\t\t\t\tthis.silenceError = result.error || 'Failed to load silence suggestions';\n\t\t\t\t\t}\n\t\t\t\t} catch (error) {\n\t\t\t\t\tconsole.error('Error loading silence suggestions:', error);\n\t\t\t\t\tthis.silenceError = 'Network error: Failed to load silence suggestions';\n\t\t\t\t} finally {\n\t\t\t\t\tthis.silenceSuggestionsLoading = false;\n\t\t\t\t}\n\t\t\t},\n\n\t\t\tformatSilenceMatchers(matchers) {\n\t\t\t\treturn (matchers || [])\n\t\t\t\t\t.map(m => `${m.name}${m.isEqual ? '' : '!'}${m.isRegex ? '=~' : '='}\"${m.value}\"`)\n\t\t\t\t\t.join(', ');\n\t\t\t},\n\n\t\t\t// One-click silence using a suggestion's matchers and the duration/reason from the form\n\t\t\tasync createSilenceFromSuggestion(suggestion) {\n\t\t\t\tif (!this.silenceReason.trim()) {\n\t\t\t\t\tthis.silenceError = 'Please provide a reason for the silence';\n\t\t\t\t\treturn;\n\t\t\t\t}\n\t\t\t\tif (this.silenceDurationType === 'custom' && !this.validateCustomDuration()) {\n\t\t\t\t\treturn;\n\t\t\t\t}\n\n\t\t\t\tconst seconds = this.silenceDurationType === 'custom'\n\t\t\t\t\t? this.parseComplexDurationToSeconds(this.customSilenceDuration.trim())\n\t\t\t\t\t: this.parseDurationToSeconds(this.silenceDuration);\n\n\t\t\t\tthis.silenceSubmitting = true;\n\t\t\t\tthis.silenceError = '';\n\n\t\t\t\ttry {\n\t\t\t\t\tconst response = await fetch('/api/v1/dashboard/silences', {\n\t\t\t\t\t\tmethod: 'POST',\n\t\t\t\t\t\tcredentials: 'include',\n\t\t\t\t\t\theaders: {\n\t\t\t\t\t\t\t'Content-Type': 'application/json',\n\t\t\t\t\t\t},\n\t\t\t\t\t\tbody: JSON.stringify({\n\t\t\t\t\t\t\tmatchers: suggestion.matchers,\n\t\t\t\t\t\t\tendsAt: new Date(Date.now() + seconds * 1000).toISOString(),\n\t\t\t\t\t\t\tcomment: this.silenceReason.trim()\n\t\t\t\t\t\t})\n\t\t\t\t\t});\n\n\t\t\t\t\tif (window.dashboardInstance && window.dashboardInstance.handleAuthError(response)) {\n\t\t\t\t\t\treturn;\n\t\t\t\t\t}\n\n\t\t\t\t\tconst result = await response.json();\n\t\t\t\t\tif (!result.success) {\n\t\t\t\t\t\tthis.silenceError = result.error || 'Failed to create silence';\n\t\t\t\t\t\treturn;\n\t\t\t\t\t}\n\n\t\t\t\t\tconst clearBulkSelection = this.silenceAction === 'bulk';\n\t\t\t\t\tthis.cancelSilence();\n\t\t\t\t\tif (clearBulkSelection) {\n\t\t\t\t\t\tthis.clearSelection();\n\t\t\t\t\t}\n\t\t\t\t\tthis.loadDashboardData();\n\t\t\t\t\tthis.refreshSilencesView();\n\t\t\t\t} catch (error) {\n\t\t\t\t\tconsole.error('Error creating silence:', error);\n\t\t\t\t\tthis.silenceError = 'Network error: Failed to create silence';\n\t\t\t\t} finally {\n\t\t\t\t\tthis.silenceSubmitting = false;\n\t\t\t\t}\n\t\t\t},\n\n\t\t\tparseDurationToSeconds(duration) {\n\t\t\t\tif (!duration) return 0;\n\t\t\t\t\n\t\t\t\tif (duration.match(/^\\d+[hd]$/)) {\n\t\t\t\t\tconst value = parseInt(duration.slice(0, -1));\n\t\t\t\t\tconst unit = duration.slice(-1);\n\t\t\t\t\t\n\t\t\t\t\tswitch (unit) {\n\t\t\t\t\t\tcase 'h':\n\t\t\t\t\t\t\treturn value * 3600;\n\t\t\t\t\t\tcase 'd':\n\t\t\t\t\t\t\treturn value * 86400;\n\t\t\t\t\t\tdefault:\n\t\t\t\t\t\t\treturn value * 3600;\n\t\t\t\t\t}\n\t\t\t\t}\n\t\t\t\t\n\t\t\t\treturn this.parseComplexDurationToSeconds(duration);\n\t\t\t},\n\t\t\t\n\t\t\tparseComplexDurationToSeconds(duration) {\n\t\t\t\tif (!duration) return 0;\n\n\t\t\t\tlet totalSeconds = 0;\n\t\t\t\tconst units = {\n\t\t\t\t\t'ns': 0.000000001,\n\t\t\t\t\t'µs': 0.000001,\n\t\t\t\t\t'us': 0.000001,\n\t\t\t\t\t'ms': 0.001,\n\t\t\t\t\t's': 1,\n\t\t\t\t\t'm': 60,\n\t\t\t\t\t'h': 3600,\n\t\t\t\t\t'd': 86400,\n\t\t\t\t\t'y': 31536000  // 365 days\n\t\t\t\t};\n\n\t\t\t\tconst regex = /(\\d+(?:\\.\\d+)?)(ns|µs|us|ms|s|m|h|d|y)/g;\n\t\t\t\tlet match;\n\t\t\t\t\n\t\t\t\twhile ((match = regex.exec(duration)) !== null) {\n\t\t\t\t\tconst value = parseFloat(match[1]);\n\t\t\t\t\tconst unit = match[2];\n\t\t\t\t\t\n\t\t\t\t\tif (units[unit]) {\n\t\t\t\t\t\ttotalSeconds += value * units[unit];\n\t\t\t\t\t}\n\t\t\t\t}\n\t\t\t\t\n\t\t\t\treturn Math.round(totalSeconds);\n\t\t\t},\n\t\t\t\n\t\t\tvalidateCustomDuration() {\n\t\t\t\tif (this.silenceDurationType !== 'custom' || !this.customSilenceDuration) {\n\t\t\t\t\tthis.customDurationError = '';\n\t\t\t\t\treturn true;\n\t\t\t\t}\n\t\t\t\t\n\t\t\t\tconst duration = this.customSilenceDuration.trim();\n\t\t\t\t\n\t\t\t\tif (!duration) {\n\t\t\t\t\tthis.customDurationError = 'Duration cannot be empty';\n\t\t\t\t\treturn false;\n\t\t\t\t}\n\t\t\t\t\n\t\t\t\tconst validFormat = /^(\\d+(?:\\.\\d+)?)(ns|µs|us|ms|s|m|h|d|y)(\\d+(?:\\.\\d+)?(ns|µs|us|ms|s|m|h|d|y))*$/;\n\t\t\t\tif (!validFormat.test(duration)) {\n\t\t\t\t\tthis.customDurationError = 'Invalid format. Use combinations like 1h30m, 2d, 1y';\n\t\t\t\t\treturn false;\n\t\t\t\t}\n\n\t\t\t\tconst totalSeconds = this.parseComplexDurationToSeconds(duration);\n\n\t\t\t\tif (totalSeconds <= 0) {\n\t\t\t\t\tthis.customDurationError = 'Duration must be positive';\n\t\t\t\t\treturn false;\n\t\t\t\t}\n\n\t\t\t\tif (totalSeconds < 1) {\n\t\t\t\t\tthis.customDurationError = 'Duration must be at least 1 second';\n\t\t\t\t\treturn false;\n\t\t\t\t}\n\t\t\t\t\n\t\t\t\tthis.customDurationError = '';\n\t\t\t\treturn true;\n\t\t\t},\n\n\t\t\tsilenceAlert(fingerprint) {\n\t\t\t\tconst alert = this.alerts.find(a => a.fingerprint === fingerprint);\n\t\t\t\tif (!alert) { return; }\n\t\t\t\tthis.currentSilenceAlert = alert;\n\t\t\t\tthis.silenceLabelChoices = this.buildSilenceLabelChoices(alert);\n\t\t\t\tthis.silenceAction = 'single';\n\t\t\t\tthis.silenceReason = '';\n\t\t\t\tthis.silenceError = '';\n\t\t\t\tthis.silenceMode = 'create';\n\t\t\t\tthis.silenceDuration = '1h';\n\t\t\t\tthis.silenceDurationType = 'preset';\n\t\t\t\tthis.customSilenceDuration = '';\n\t\t\t\tthis.customDurationError = '';\n\t\t\t\tthis.silenceSuggestions = null;\n\t\t\t\tthis.silenceCustomMatchers = '';\n\t\t\t\tthis.showSilenceModal = true;\n\t\t\t},\n\n\t\t\tsilenceGroup(groupName) {\n\t\t\t\tthis.currentGroupName = groupName;\n\t\t\t\tthis.silenceAction = 'group';\n\t\t\t\tthis.silenceReason = '';\n\t\t\t\tthis.silenceError = '';\n\t\t\t\tthis.silenceMode = 'create';\n\t\t\t\tthis.silenceDuration = '1h';\n\t\t\t\tthis.silenceDurationType = 'preset';\n\t\t\t\tthis.customSilenceDuration = '';\n\t\t\t\tthis.customDurationError = '';\n\t\t\t\tthis.silenceSuggestions = null;\n\t\t\t\tthis.silenceCustomMatchers = '';\n\t\t\t\tthis.showSilenceModal = true;\n\t\t\t},\n\n\t\t\tsilenceSelected() {\n\t\t\t\tif (this.selectedAlerts.length === 0 && this.selectedGroups.length === 0) {\n\t\t\t\t\t\n\t\t\t\t\treturn;\n\t\t\t\t}\n\t\t\t\t\n\t\t\t\tthis.silenceAction = 'bulk';\n\t\t\t\tthis.silenceReason = '';\n\t\t\t\tthis.silenceError = '';\n\t\t\t\tthis.silenceMode = 'create';\n\t\t\t\tthis.silenceDuration = '1h';\n\t\t\t\tthis.silenceDurationType = 'preset';\n\t\t\t\tthis.customSilenceDuration = '';\n\t\t\t\tthis.customDurationError = '';\n\t\t\t\tthis.silenceSuggestions = null;\n\t\t\t\tthis.silenceCustomMatchers = '';\n\t\t\t\tthis.showSilenceModal = true;\n\t\t\t},\n\n\t\t\tasync unsilenceSelected() {\n\t\t\t\tif (this.selectedAlerts.length === 0 && this.selectedGroups.length === 0) {\n\t\t\t\t\t\n\t\t\t\t\treturn;\n\t\t\t\t}\n\n\t\t\t\ttry {\n\t\t\t\t\tconst request = {\n\t\t\t\t\t\talertFingerprints: this.selectedAlerts,\n\t\t\t\t\t\tgroupNames: this.selectedGroups,\n\t\t\t\t\t\taction: 'unsilence',\n\t\t\t\t\t\tcomment: 'Bulk unsilence action'\n\t\t\t\t\t};\n\n\t\t\t\t\tconst response = await fetch('/api/v1/dashboard/bulk-action', {\n\t\t\t\t\t\tmethod: 'POST',\n\t\t\t\t\t\tcredentials: 'include',\n\t\t\t\t\t\theaders: {\n\t\t\t\t\t\t\t'Content-Type': 'application/json',\n\t\t\t\t\t\t},\n\t\t\t\t\t\tbody: JSON.stringify(request)\n\t\t\t\t\t});\n\n\t\t\t\t\t// Check for authentication errors and redirect if needed\n\t\t\t\t\tif (window.dashboardInstance && window.dashboardInstance.handleAuthError(response)) {\n\t\t\t\t\t\treturn;\n\t\t\t\t\t}\n\n\t\t\t\t\tconst result = await response.json();\n\t\t\t\t\t\n\t\t\t\t\tif (result.success) {\n\t\t\t\t\t\t\n\t\t\t\t\t\tthis.clearSelection();\n\t\t\t\t\t\tthis.loadDashboardData();\n\t\t\t\t\t} else {\n\t\t\t\t\t\t\n\t\t\t\t\t}\n\t\t\t\t} catch (error) {\n\t\t\t\t\tconsole.error('Error unsilencing selected items:', error);\n\t\t\t\t\t\n\t\t\t\t}\n\t\t\t},\n\n\t\t\tisAlertSilenced(alert) {\n\t\t\t\tif (!alert) return false;\n\t\t\t\treturn alert.status?.state === 'suppressed' || \n\t\t\t\t\t   alert.status?.state === 'silenced' || \n\t\t\t\t\t   (alert.status?.silencedBy && alert.status.silencedBy.length > 0);\n\t\t\t},\n\n\t\t\thasUnsilencedAlertsSelected() {\n\t\t\t\treturn this.selectedAlerts.some(fingerprint => {\n\t\t\t\t\tconst alert = this.alerts.find(a => a.fingerprint === fingerprint);\n\t\t\t\t\treturn alert && !this.isAlertSilenced(alert);\n\t\t\t\t});\n\t\t\t},\n\n\t\t\thasSilencedAlertsSelected() {\n\t\t\t\treturn this.selectedAlerts.some(fingerprint => {\n\t\t\t\t\tconst alert = this.alerts.find(a => a.fingerprint === fingerprint);\n\t\t\t\t\treturn alert && this.isAlertSilenced(alert);\n\t\t\t\t});\n\t\t\t},\n\n\t\t\tisGroupFullySilenced(group) {\n\t\t\t\tif (!group || !group.alerts) return false;\n\t\t\t\treturn group.alerts.every(alert => this.isAlertSilenced(alert));\n\t\t\t},\n\n\t\t\tasync unsilenceAlert(fingerprint) {\n\t\t\t\ttry {\n\t\t\t\t\tconst response = await fetch('/api/v1/dashboard/bulk-action', {\n\t\t\t\t\t\tmethod: 'POST',\n\t\t\t\t\t\tcredentials: 'include',\n\t\t\t\t\t\theaders: {\n\t\t\t\t\t\t\t'Content-Type': 'application/json',\n\t\t\t\t\t\t},\n\t\t\t\t\t\tbody: JSON.stringify({\n\t\t\t\t\t\t\taction: 'unsilence',\n\t\t\t\t\t\t\talertFingerprints: [fingerprint],\n\t\t\t\t\t\t\tcomment: 'Unsilenced from table action'\n\t\t\t\t\t\t})\n\t\t\t\t\t});\n\n\t\t\t\t\tconst result = await response.json();\n\t\t\t\t\t\n\t\t\t\t\tif (result.success) {\n\t\t\t\t\t\t\n\t\t\t\t\t\tthis.loadDashboardData();\n\t\t\t\t\t} else {\n\t\t\t\t\t\t\n\t\t\t\t\t}\n\t\t\t\t} catch (error) {\n\t\t\t\t\tconsole.error('Error unsilencing alert:', error);\n\t\t\t\t\t\n\t\t\t\t}\n\t\t\t},\n\n\t\t\tasync unsilenceGroup(groupName) {\n\t\t\t\ttry {\n\t\t\t\t\tconst response = await fetch('/api/v1/dashboard/bulk-action', {\n\t\t\t\t\t\tmethod: 'POST',\n\t\t\t\t\t\tcredentials: 'include',\n\t\t\t\t\t\theaders: {\n\t\t\t\t\t\t\t'Content-Type': 'application/json',\n\t\t\t\t\t\t},\n\t\t\t\t\t\tbody: JSON.stringify({\n\t\t\t\t\t\t\taction: 'unsilence',\n\t\t\t\t\t\t\tgroupNames: [groupName],\n\t\t\t\t\t\t\tcomment: 'Unsilenced group action'\n\t\t\t\t\t\t})\n\t\t\t\t\t});\n\n\t\t\t\t\tconst result = await response.json();\n\t\t\t\t\t\n\t\t\t\t\tif (result.success) {\n\t\t\t\t\t\t\n\t\t\t\t\t\tthis.loadDashboardData();\n\t\t\t\t\t} else {\n\t\t\t\t\t\t\n\t\t\t\t\t}\n\t\t\t\t} catch (error) {\n\t\t\t\t\tconsole.error('Error unsilencing group:', error);\n\t\t\t\t\t\n\t\t\t\t}\n\t\t\t},\n\n\n\t\t\t// Utility function to check if an alert is hidden\n\t\t\tisAlertHidden(alert) {\n\t\t\t\t// Check against cached hidden alerts in settings modal if available\n\t\t\t\tif (window.currentSettingsModal && window.currentSettingsModal.hiddenAlerts) {\n\t\t\t\t\treturn !!window.currentSettingsModal.activeHiddenAlert(alert.fingerprint);\n\t\t\t\t}\n\t\t\t\t\n\t\t\t\t// Fallback: check if the alert is in the hidden display mode results\n\t\t\t\t// (This would mean it's currently being displayed in the hidden view)\n\t\t\t\tif (this.displayMode === 'hidden') {\n\t\t\t\t\treturn true;\n\t\t\t\t}\n\t\t\t\t\n\t\t\t\treturn false;\n\t\t\t}\n\t\t};\n\t</script>")
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
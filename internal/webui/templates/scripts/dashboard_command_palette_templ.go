@@ -0,0 +1,40 @@
+// Code generated by templ - DO NOT EDIT.
+
+// templ: version: v0.3.906
+package scripts
+
+//lint:file-ignore SA4006 This context is only used if a nested component is present.
+
+import "github.com/a-h/templ"
+import templruntime "github.com/a-h/templ/runtime"
+
+func DashboardCommandPaletteMixin() templ.Component {
+	return templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
+		templ_7745c5c3_W, ctx := templ_7745c5c3_Input.Writer, templ_7745c5c3_Input.Context
+		if templ_7745c5c3_CtxErr := ctx.Err(); templ_7745c5c3_CtxErr != nil {
+			return templ_7745c5c3_CtxErr
+		}
+		templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templruntime.GetBuffer(templ_7745c5c3_W)
+		if !templ_7745c5c3_IsBuffer {
+			defer func() {
+				templ_7745c5c3_BufErr := templruntime.ReleaseBuffer(templ_7745c5c3_Buffer)
+				if templ_7745c5c3_Err == nil {
+					templ_7745c5c3_Err = templ_7745c5c3_BufErr
+				}
+			}()
+		}
+		ctx = templ.InitializeContext(ctx)
+		templ_7745c5c3_Var1 := templ.GetChildren(ctx)
+		if templ_7745c5c3_Var1 == nil {
+			templ_7745c5c3_Var1 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 1, "<script>\n\t\t// Commands of the Ctrl/Cmd+K palette. Features add their own with\n\t\t// window.registerDashboardCommand({ id, title, keywords, run, enabled })\n\t\t// or, for commands built from data (one per filter preset),\n\t\t// window.registerDashboardCommandProvider(dashboard => [...commands]).\n\t\t// run and enabled receive the dashboard instance.\n\t\twindow.dashboardCommands = window.dashboardCommands || [];\n\t\twindow.dashboardCommandProviders = window.dashboardCommandProviders || [];\n\n\t\twindow.registerDashboardCommand = function(command) {\n\t\t\twindow.dashboardCommands = window.dashboardCommands.filter(c => c.id !== command.id);\n\t\t\twindow.dashboardCommands.push(command);\n\t\t};\n\n\t\twindow.registerDashboardCommandProvider = function(provider) {\n\t\t\twindow.dashboardCommandProviders.push(provider);\n\t\t};\n\n\t\twindow.registerDashboardCommand({\n\t\t\tid: 'refresh',\n\t\t\ttitle: 'Refresh alerts',\n\t\t\tkeywords: 'reload update',\n\t\t\trun: dashboard => dashboard.loadDashboardData()\n\t\t});\n\t\twindow.registerDashboardCommand({\n\t\t\tid: 'force-refresh',\n\t\t\ttitle: 'Force refresh (full reload)',\n\t\t\tkeywords: 'reload refetch alertmanager shift+r',\n\t\t\trun: dashboard => dashboard.forceRefresh()\n\t\t});\n\t\twindow.registerDashboardCommand({\n\t\t\tid: 'resolved-archive',\n\t\t\ttitle: 'Browse resolved alerts archive',\n\t\t\tkeywords: 'resolved history stored clear',\n\t\t\trun: dashboard => dashboard.openResolvedArchive()\n\t\t});\n\t\twindow.registerDashboardCommand({\n\t\t\tid: 'toggle-grouping',\n\t\t\ttitle: 'Toggle grouping',\n\t\t\tkeywords: 'group list view',\n\t\t\trun: dashboard => dashboard.setViewMode(dashboard.viewMode === 'group' ? 'list' : 'group')\n\t\t});\n\t\twindow.registerDashboardCommand({\n\t\t\tid: 'open-settings',\n\t\t\ttitle: 'Open settings',\n\t\t\tkeywords: 'preferences hidden colors notifications',\n\t\t\trun: dashboard => dashboard.openSettings()\n\t\t});\n\t\twindow.registerDashboardCommand({\n\t\t\tid: 'ack-selected',\n\t\t\ttitle: 'Acknowledge selected alerts',\n\t\t\tkeywords: 'ack',\n\t\t\tenabled: dashboard => dashboard.selectedAlerts.length > 0 || dashboard.selectedGroups.length > 0,\n\t\t\trun: dashboard => dashboard.acknowledgeSelected()\n\t\t});\n\t\twindow.registerDashboardCommand({\n\t\t\tid: 'create-silence',\n\t\t\ttitle: 'Create silence',\n\t\t\tkeywords: 'silence mute new',\n\t\t\trun: dashboard => {\n\t\t\t\tif (dashboard.selectedAlerts.length > 0 || dashboard.selectedGroups.length > 0) {\n\t\t\t\t\tdashboard.silenceSelected();\n\t\t\t\t} else {\n\t\t\t\t\tdashboard.createStandaloneSilence();\n\t\t\t\t}\n\t\t\t}\n\t\t});\n\t\twindow.registerDashboardCommand({\n\t\t\tid: 'reset-sort',\n\t\t\ttitle: 'Reset sort to default',\n\t\t\tkeywords: 'order severity criticals',\n\t\t\tenabled: dashboard => !dashboard.isDefaultSort(),\n\t\t\trun: dashboard => {\n\t\t\t\tdashboard.resetSort();\n\t\t\t\tdashboard.applyFilters();\n\t\t\t}\n\t\t});\n\t\twindow.registerDashboardCommand({\n\t\t\tid: 'focus-search',\n\t\t\ttitle: 'Search alerts',\n\t\t\tkeywords: 'find filter',\n\t\t\trun: () => document.getElementById('dashboard-search')?.focus()\n\t\t});\n\t\twindow.registerDashboardCommandProvider(dashboard => (dashboard.presets || []).map(preset => ({\n\t\t\tid: 'filter-preset-' + preset.id,\n\t\t\ttitle: 'Switch filter preset: ' + preset.name,\n\t\t\tkeywords: 'filter preset saved',\n\t\t\trun: d => d.applyFilterPreset(preset)\n\t\t})));\n\n\t\twindow.dashboardCommandPaletteMixin = {\n\t\t\t// State (will be merged into dashboard)\n\t\t\tshowCommandPalette: false,\n\t\t\tcommandQuery: '',\n\t\t\tcommandIndex: 0,\n\n\t\t\topenCommandPalette() {\n\t\t\t\tif (this.showCommandPalette) return;\n\t\t\t\tthis.commandQuery = '';\n\t\t\t\tthis.commandIndex = 0;\n\t\t\t\tthis.showCommandPalette = true;\n\t\t\t\tthis.$nextTick(() => document.getElementById('command-palette-input')?.focus());\n\t\t\t},\n\n\t\t\tcloseCommandPalette() {\n\t\t\t\tthis.showCommandPalette = false;\n\t\t\t},\n\n\t\t\t// Registered and provided commands that can run now, best matches first\n\t\t\tpaletteCommands() {\n\t\t\t\tconst commands = [...window.dashboardCommands];\n\t\t\t\tfor (const provider of window.dashboardCommandProviders) {\n\t\t\t\t\ttry {\n\t\t\t\t\t\tcommands.push(...(provider(this) || []));\n\t\t\t\t\t} catch (error) {\n\t\t\t\t\t\tconsole.error('Command provider failed:', error);\n\t\t\t\t\t}\n\t\t\t\t}\n\n\t\t\t\tconst query = this.commandQuery.trim();\n\t\t\t\treturn commands\n\t\t\t\t\t.filter(command => !command.enabled || command.enabled(this))\n\t\t\t\t\t.map(command => ({ command, score: this.fuzzyScore(query, command.title + ' ' + (command.keywords || '')) }))\n\t\t\t\t\t.filter(match => match.score > 0)\n\t\t\t\t\t.sort((a, b) => b.score - a.score)\n\t\t\t\t\t.map(match => match.command);\n\t\t\t},\n\n\t\t\t// Scores how well text matches query when the query's characters appear\n\t\t\t// in order; consecutive characters and word starts score higher.\n\t\t\t// Returns 0 when the text does not match.\n\t\t\tfuzzyScore(query, text) {\n\t\t\t\tif (!query) return 1;\n\t\t\t\tconst q = query.toLowerCase();\n\t\t\t\tconst t = text.toLowerCase();\n\t\t\t\tlet score = 0;\n\t\t\t\tlet run = 0;\n\t\t\t\tlet position = 0;\n\t\t\t\tfor (const char of q) {\n\t\t\t\t\tif (char === ' ') continue;\n\t\t\t\t\tconst found = t.indexOf(char, position);\n\t\t\t\t\tif (found === -1) return 0;\n\t\t\t\t\trun = found === position ? run + 1 : 1;\n\t\t\t\t\tscore += run + (found === 0 || t[found - 1] === ' ' ? 2 : 0);\n\t\t\t\t\tposition = found + 1;\n\t\t\t\t}\n\t\t\t\treturn score;\n\t\t\t},\n\n\t\t\tmoveCommandSelection(delta) {\n\t\t\t\tconst count = this.paletteCommands().length;\n\t\t\t\tif (count === 0) return;\n\t\t\t\tthis.commandIndex = (this.commandIndex + delta + count) % count;\n\t\t\t},\n\n\t\t\trunSelectedCommand() {\n\t\t\t\tconst command = this.paletteCommands()[this.commandIndex];\n\t\t\t\tif (command) {\n\t\t\t\t\tthis.runCommand(command);\n\t\t\t\t}\n\t\t\t},\n\n\t\t\trunCommand(command) {\n\t\t\t\tthis.closeCommandPalette();\n\t\t\t\ttry {\n\t\t\t\t\tcommand.run(this);\n\t\t\t\t} catch (error) {\n\t\t\t\t\tconsole.error(`Command ${command.id} failed:`, error);\n\t\t\t\t}\n\t\t\t}\n\t\t};\n\t</script>")
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		return nil
+	})
+}
+
+var _ = templruntime.GeneratedTemplate
@@ -29,7 +29,7 @@ func DashboardCore() templ.Component {
 			templ_7745c5c3_Var1 = templ.NopComponent
 		}
 		ctx = templ.ClearChildren(ctx)
-		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 1, "<script>\n\t\tfunction newDashboard() {\n\t\t\treturn {\n\t\t\t\tloading: true,\n\t\t\t\talerts: [],\n\t\t\t\tgroups: [],\n\t\t\t\tmetadata: {\n\t\t\t\t\ttotalAlerts: 0,\n\t\t\t\t\tfilteredCount: 0,\n\t\t\t\t\tlastUpdate: null,\n\t\t\t\t\tcounters: {\n\t\t\t\t\t\tcritical: 0,\n\t\t\t\t\t\twarning: 0,\n\t\t\t\t\t\tinfo: 0,\n\t\t\t\t\t\tfiring: 0,\n\t\t\t\t\t\tresolved: 0,\n\t\t\t\t\t\tacknowledged: 0,\n\t\t\t\t\t\twithComments: 0,\n\t\t\t\t\t\tseverityCounters: {}\n\t\t\t\t\t},\n\t\t\t\t\tavailableFilters: {\n\t\t\t\t\t\talertmanagers: [],\n\t\t\t\t\t\tseverities: [],\n\t\t\t\t\t\tstatuses: [],\n\t\t\t\t\t\tteams: [],\n\t\t\t\t\t\talertNames: []\n\t\t\t\t\t}\n\t\t\t\t},\n\t\t\t\tsettings: {\n\t\t\t\t\ttheme: 'light',\n\t\t\t\t\trefreshInterval: 5,\n\t\t\t\t\tresolvedAlertsLimit: 100\n\t\t\t\t},\n\t\t\t\t\n\t\t\t\tisRemovingResolvedAlerts: false,\n\t\t\t\tisSearching: false,\n\n\t\t\t\thasInitiallyLoaded: false,\n\t\t\t\tsessionStorageKey: 'dashboard_session_state',\n\n\t\t\t\tdisplayMode: 'classic',\n\t\t\t\tviewMode: 'list',\n\t\t\t\tsortField: 'duration',\n\t\t\t\tsortDirection: 'asc',\n\t\t\t\tgroupByLabel: 'alertname', // Default group by alert name\n\t\t\t\tshowSettings: false,\n\t\t\t\t\n\t\t\t\tshowAckModal: false,\n\t\t\t\tackAction: 'single',\n\t\t\t\tackReason: '',\n\t\t\t\tackError: '',\n\t\t\t\tackSubmitting: false,\n\t\t\t\tcurrentAckAlert: null,\n\t\t\t\tcurrentGroupName: '',\n\t\t\t\t\n\t\t\t\tshowSilenceModal: false,\n\t\t\t\tsilenceAction: 'single',\n\t\t\t\tsilenceReason: '',\n\t\t\t\tsilenceError: '',\n\t\t\t\tsilenceSubmitting: false,\n\t\t\t\tcurrentSilenceAlert: null,\n\t\t\t\tsilenceDuration: '1h',\n\t\t\t\tsilenceDurationType: 'preset',\n\t\t\t\tcustomSilenceDuration: '',\n\t\t\t\tcustomDurationError: '',\n\t\t\t\t\n\t\t\t\tshowAlertModal: false,\n\t\t\t\talertDetails: null,\n\t\t\t\tcurrentAlertTab: 'overview',\n\t\t\t\talertDetailsLoading: false,\n\t\t\t\talertHistory: null,\n\t\t\t\thistoryLoading: false,\n\t\t\t\t\n\t\t\t\t// Filter presets modal state\n\t\t\t\tshowFilterPresetsModal: false,\n\t\t\t\tactivePresetName: null, // Track active default preset name\n\t\t\t\tincludeColumnConfig: true, // Whether to include column config when saving filter preset\n\n\t\t\t\t// Column config modal state\n\t\t\t\tshowColumnConfigModal: false,\n\n\t\t\t\tnewCommentContent: '',\n\t\t\t\tcommentSubmitting: false,\n\t\t\t\tcommentDeleting: {},\n\t\t\t\tcurrentUser: null,\n\t\t\t\t\n\t\t\t\tsearchQuery: '',\n\t\t\t\tfilters: {\n\t\t\t\t\talertmanagers: [],\n\t\t\t\t\tseverities: [],\n\t\t\t\t\tstatuses: [],\n\t\t\t\t\tteams: [],\n\t\t\t\t\talertNames: []\n\t\t\t\t},\n\t\t\t\t\n\t\t\t\tselectedAlerts: [],\n\t\t\t\tselectedGroups: [],\n\t\t\t\texpandedGroups: [],\n\t\t\t\t\n\t\t\t\t// Pagination\n\t\t\t\tcurrentPage: 1,\n\t\t\t\titemsPerPage: 50,\n\t\t\t\ttotalItems: 0,\n\n\t\t\t\t// Resolved alerts state (mixin will add more properties)\n\t\t\t\tresolvedAlerts: [],\n\t\t\t\tresolvedTotalCount: 0,\n\t\t\t\tresolvedLoading: false,\n\n\t\t\t\trefreshInterval: null,\n\t\t\t\tlastUpdateTime: null,\n\n\t\t\t\t// SSE (Server-Sent Events) support\n\t\t\t\tsseConnection: null,\n\t\t\t\tuseSSE: true,  // Feature flag for SSE\n\n\t\t\t\t// Adaptive polling rate (fallback when SSE not available)\n\t\t\t\trecentChanges: 0,      // Count of polls with changes\n\t\t\t\tpollCount: 0,          // Total polls since last adjustment\n\t\t\t\tbaseInterval: 5000,    // 5 seconds base\n\t\t\t\tcurrentInterval: 5000, // Current interval (adjusts)\n\t\t\t\tmaxInterval: 60000,    // 1 minute max\n\t\t\t\t\n\t\t\t\talertColors: {},\n\t\t\t\talertColorsTimestamp: 0,\n\n\t\t\t\t// Annotation button configs\n\t\t\t\tannotationButtonConfigs: [],\n\n\t\t\t\tcolumnWidths: {\n\t\t\t\t\talertName: 300,\n\t\t\t\t\taction: 100,\n\t\t\t\t\tinstance: 350,\n\t\t\t\t\tseverity: 150,\n\t\t\t\t\tstatus: 150,\n\t\t\t\t\tcomments: 130,\n\t\t\t\t\tteam: 200,\n\t\t\t\t\tsummary: 400,\n\t\t\t\t\tduration: 150,\n\t\t\t\t\tsource: 180\n\t\t\t\t},\n\t\t\t\tisResizing: false,\n\t\t\t\tstartX: 0,\n\t\t\t\tstartWidth: 0,\n\t\t\t\tcurrentColumn: null,\n\n\t\t\t\t// Dynamic columns configuration\n\t\t\t\tcolumns: [],\n\t\t\t\tvisibleColumns: [],\n\t\t\t\tresizingColumn: null,\n\t\t\t\tresizeStartX: 0,\n\t\t\t\tresizeStartWidth: 0,\n\t\t\t\tsorting: { field: null, direction: 'asc' },\n\n\t\t\t\tfocusSearch(event) {\n\t\t\t\t\t// All shortcuts are inert while a modal is open — the search input is\n\t\t\t\t\t// hidden behind the overlay, so focusing it would be invisible/confusing.\n\t\t\t\t\tif (this.showSettings || this.showAckModal || this.showSilenceModal ||\n\t\t\t\t\t\tthis.showAlertModal || this.showFilterPresetsModal ||\n\t\t\t\t\t\tthis.showColumnConfigModal) {\n\t\t\t\t\t\treturn;\n\t\t\t\t\t}\n\t\t\t\t\t// '/' must not fire while typing elsewhere; Ctrl/Cmd+F always wins.\n\t\t\t\t\tconst t = event.target;\n\t\t\t\t\tif (event.key === '/' &&\n\t\t\t\t\t\t(t.closest('input, textarea, select, [contenteditable]'))) {\n\t\t\t\t\t\treturn;\n\t\t\t\t\t}\n\t\t\t\t\tevent.preventDefault();\n\t\t\t\t\tdocument.getElementById('dashboard-search')?.focus();\n\t\t\t\t},\n\n\t\t\t\tgetDisplayStatus(status) {\n\t\t\t\t\tif (!status?.state) return 'unknown';\n\t\t\t\t\treturn status.state === 'suppressed' ? 'silenced' : status.state;\n\t\t\t\t},\n\n\t\t\t\tstatusMatches(status, value) {\n\t\t\t\t\tconst displayStatus = this.getDisplayStatus(status);\n\t\t\t\t\treturn displayStatus === value;\n\t\t\t\t},\n\n\t\t\t\t// Severity priority for sorting badges in header\n\t\t\t\tgetSeverityPriority(severity) {\n\t\t\t\t\tconst priorities = {\n\t\t\t\t\t\t'critical': 100,\n\t\t\t\t\t\t'page': 90,\n\t\t\t\t\t\t'warning': 80,\n\t\t\t\t\t\t'warn': 75,\n\t\t\t\t\t\t'info': 50,\n\t\t\t\t\t\t'information': 50,\n\t\t\t\t\t\t'low': 30,\n\t\t\t\t\t\t'none': 10\n\t\t\t\t\t};\n\t\t\t\t\treturn priorities[severity?.toLowerCase()] || 40;\n\t\t\t\t},\n\n\t\t\t\t// Get CSS classes for severity badge background/text\n\t\t\t\t// NOTE: Color values should match renderBadge() in dashboard_utilities.templ\n\t\t\t\t// for consistency between header badges and table cells\n\t\t\t\tgetSeverityBadgeClasses(severity) {\n\t\t\t\t\tconst sev = severity?.toLowerCase();\n\t\t\t\t\tswitch (sev) {\n\t\t\t\t\t\tcase 'critical':\n\t\t\t\t\t\tcase 'page':\n\t\t\t\t\t\t\treturn 'bg-red-100 text-red-800 dark:bg-red-900/50 dark:text-red-200';\n\t\t\t\t\t\tcase 'warning':\n\t\t\t\t\t\tcase 'warn':\n\t\t\t\t\t\t\treturn 'bg-yellow-100 text-yellow-800 dark:bg-yellow-900/50 dark:text-yellow-200';\n\t\t\t\t\t\tcase 'info':\n\t\t\t\t\t\tcase 'information':\n\t\t\t\t\t\t\treturn 'bg-blue-100 text-blue-800 dark:bg-blue-900/50 dark:text-blue-200';\n\t\t\t\t\t\tcase 'low':\n\t\t\t\t\t\tcase 'none':\n\t\t\t\t\t\t\treturn 'bg-gray-100 text-gray-700 dark:bg-gray-700 dark:text-gray-300';\n\t\t\t\t\t\tdefault:\n\t\t\t\t\t\t\treturn 'bg-purple-100 text-purple-800 dark:bg-purple-900/50 dark:text-purple-200';\n\t\t\t\t\t}\n\t\t\t\t},\n\n\t\t\t\t// Get CSS classes for severity dot indicator\n\t\t\t\tgetSeverityDotClasses(severity) {\n\t\t\t\t\tconst sev = severity?.toLowerCase();\n\t\t\t\t\tswitch (sev) {\n\t\t\t\t\t\tcase 'critical':\n\t\t\t\t\t\tcase 'page':\n\t\t\t\t\t\t\treturn 'bg-red-500';\n\t\t\t\t\t\tcase 'warning':\n\t\t\t\t\t\tcase 'warn':\n\t\t\t\t\t\t\treturn 'bg-yellow-500';\n\t\t\t\t\t\tcase 'info':\n\t\t\t\t\t\tcase 'information':\n\t\t\t\t\t\t\treturn 'bg-blue-500';\n\t\t\t\t\t\tcase 'low':\n\t\t\t\t\t\tcase 'none':\n\t\t\t\t\t\t\treturn 'bg-gray-400';\n\t\t\t\t\t\tdefault:\n\t\t\t\t\t\t\treturn 'bg-purple-500';\n\t\t\t\t\t}\n\t\t\t\t},\n\n\t\t\t\t// Check if response indicates authentication failure\n\t\t\t\thandleAuthError(response) {\n\t\t\t\t\t// Redirect to login if unauthorized or service unavailable\n\t\t\t\t\tif (response.status === 401 || response.status === 503) {\n\t\t\t\t\t\twindow.location.href = '/login';\n\t\t\t\t\t\treturn true;\n\t\t\t\t\t}\n\t\t\t\t\treturn false;\n\t\t\t\t},\n\n\t\t\t\t// Install global fetch interceptor to handle auth errors consistently\n\t\t\t\tinstallFetchInterceptor() {\n\t\t\t\t\tconst originalFetch = window.fetch;\n\t\t\t\t\tconst dashboard = this;\n\n\t\t\t\t\twindow.fetch = async function(...args) {\n\t\t\t\t\t\ttry {\n\t\t\t\t\t\t\tconst response = await originalFetch.apply(this, args);\n\n\t\t\t\t\t\t\t// Check for auth errors on any API call\n\t\t\t\t\t\t\tif (response.status === 401) {\n\t\t\t\t\t\t\t\tconsole.log('Session expired, redirecting to login');\n\t\t\t\t\t\t\t\tdashboard.stopAutoRefresh();\n\t\t\t\t\t\t\t\tdashboard.destroySSE();\n\t\t\t\t\t\t\t\twindow.location.href = '/login';\n\t\t\t\t\t\t\t\t// Return a never-resolving promise to prevent further processing\n\t\t\t\t\t\t\t\treturn new Promise(() => {});\n\t\t\t\t\t\t\t}\n\n\t\t\t\t\t\t\treturn response;\n\t\t\t\t\t\t} catch (error) {\n\t\t\t\t\t\t\t// Network errors - let them propagate\n\t\t\t\t\t\t\tthrow error;\n\t\t\t\t\t\t}\n\t\t\t\t\t};\n\t\t\t\t},\n\n\t\t\t\t// Validate session with backend\n\t\t\t\tasync validateSession() {\n\t\t\t\t\ttry {\n\t\t\t\t\t\tconst response = await fetch('/api/v1/auth/me', {\n\t\t\t\t\t\t\tcredentials: 'include'\n\t\t\t\t\t\t});\n\n\t\t\t\t\t\t// Check for authentication errors and redirect if needed\n\t\t\t\t\t\tif (this.handleAuthError(response)) {\n\t\t\t\t\t\t\treturn false;\n\t\t\t\t\t\t}\n\n\t\t\t\t\t\treturn response.ok;\n\t\t\t\t\t} catch (error) {\n\t\t\t\t\t\tconsole.error('Session validation failed:', error);\n\t\t\t\t\t\t// Redirect to login on network error (backend might be down)\n\t\t\t\t\t\twindow.location.href = '/login';\n\t\t\t\t\t\treturn false;\n\t\t\t\t\t}\n\t\t\t\t},\n\n\t\t\t\tasync init() {\n\t\t\t\t\t// Install global fetch interceptor for auth errors\n\t\t\t\t\tthis.installFetchInterceptor();\n\n\t\t\t\t\tObject.assign(this, window.dashboardDataMixin || {});\n\t\t\t\t\tObject.assign(this, window.dashboardActionsMixin || {});\n\t\t\t\t\tObject.assign(this, window.dashboardUtilitiesMixin || {});\n\t\t\t\t\tObject.assign(this, window.dashboardModalMixin || {});\n\t\t\t\t\tObject.assign(this, window.dashboardFilterPresetsMixin || {});\n\t\t\t\t\tObject.assign(this, window.dashboardResolvedAlertsMixin || {});\n\n\t\t\t\t\twindow.dashboardInstance = this;\n\n\t\t\t\t\tthis.initializeSessionTracking();\n\n\t\t\t\t\t// Initialize resolved alerts auto-load watcher\n\t\t\t\t\tif (this.initResolvedAutoLoad) {\n\t\t\t\t\t\tthis.initResolvedAutoLoad();\n\t\t\t\t\t}\n\n\t\t\t\t\t// Notification banner dismissed state is checked per-user in\n\t\t\t\t\t// shouldShowNotificationBanner() once currentUser is loaded below.\n\t\t\t\t\tthis.notificationBannerDismissed = false;\n\n\t\t\t\t\tthis.loadSettings();\n\t\t\t\t\tthis.loadColumnWidths();\n\t\t\t\t\tthis.initializeColumns();\n\t\t\t\t\tawait this.loadUserColumnPreferences(); // Load user column preferences\n\t\t\t\t\tawait this.loadCurrentUser();\n\t\t\t\t\tthis.loadAnnotationButtonConfigs();\n\n\t\t\t\t\t// Check if URL has filter parameters\n\t\t\t\t\tconst params = new URLSearchParams(window.location.search);\n\t\t\t\t\tconst hasURLFilters = params.has('search') || params.has('alertmanagers') ||\n\t\t\t\t\t\t\t\t\t\t  params.has('severities') || params.has('statuses') ||\n\t\t\t\t\t\t\t\t\t\t  params.has('teams') || params.has('alertNames') ||\n\t\t\t\t\t\t\t\t\t\t  params.has('acknowledged') || params.has('hasComments');\n\n\t\t\t\t\tlet defaultPresetLoaded = false;\n\n\t\t\t\t\tif (!hasURLFilters) {\n\t\t\t\t\t\t// No URL filters - try to load default preset (if exists, it will also load data)\n\t\t\t\t\t\tdefaultPresetLoaded = await this.loadDefaultFilterPreset();\n\t\t\t\t\t}\n\n\t\t\t\t\t// Load filters from URL (will override default preset if URL has filters)\n\t\t\t\t\tthis.loadFiltersFromURL();\n\n\t\t\t\t\t// Try SSE first, fallback to polling if not supported\n\t\t\t\t\tif (this.useSSE && typeof EventSource !== 'undefined') {\n\t\t\t\t\t\tthis.initSSE();\n\t\t\t\t\t} else {\n\t\t\t\t\t\tthis.startAutoRefresh();\n\t\t\t\t\t}\n\n\t\t\t\t\t// Load data if default preset wasn't loaded or URL has filters\n\t\t\t\t\tif (!defaultPresetLoaded) {\n\t\t\t\t\t\tthis.loadDashboardData();\n\t\t\t\t\t}\n\t\t\t\t\t\n\t\t\t\t\tthis.checkAlertFromURL();\n\n\t\t\t\t\tdocument.addEventListener('visibilitychange', async () => {\n\t\t\t\t\t\tif (!document.hidden) {\n\t\t\t\t\t\t\t// Validate session when page becomes visible\n\t\t\t\t\t\t\tconst sessionValid = await this.validateSession();\n\t\t\t\t\t\t\tif (!sessionValid) {\n\t\t\t\t\t\t\t\t// If session invalid, stop refresh and destroy SSE\n\t\t\t\t\t\t\t\tthis.stopAutoRefresh();\n\t\t\t\t\t\t\t\tthis.destroySSE();\n\t\t\t\t\t\t\t\t// validateSession() will handle redirect to login\n\t\t\t\t\t\t\t} else {\n\t\t\t\t\t\t\t\t// If SSE is enabled but not connected, try to reconnect\n\t\t\t\t\t\t\t\tif (this.useSSE && typeof EventSource !== 'undefined' && !this.sseConnection) {\n\t\t\t\t\t\t\t\t\t// Catch up on any alerts that fired while the tab was hidden\n\t\t\t\t\t\t\t\t\t// and SSE was disconnected, then re-establish the stream. A new\n\t\t\t\t\t\t\t\t\t// SSE connection only delivers events going forward, so without\n\t\t\t\t\t\t\t\t\t// this the gap window's alerts would never reach processNewAlerts.\n\t\t\t\t\t\t\t\t\tthis.loadDashboardIncremental();\n\t\t\t\t\t\t\t\t\tthis.initSSE();\n\t\t\t\t\t\t\t\t} else if (!this.sseConnection) {\n\t\t\t\t\t\t\t\t\t// Do one incremental fetch to catch any missed updates (polling mode)\n\t\t\t\t\t\t\t\t\tthis.loadDashboardIncremental();\n\t\t\t\t\t\t\t\t}\n\t\t\t\t\t\t\t\t// If SSE is connected, it will automatically receive updates\n\t\t\t\t\t\t\t}\n\t\t\t\t\t\t}\n\t\t\t\t\t\t// Don't stop auto-refresh when hidden - let it continue fetching in background\n\t\t\t\t\t\t// SSE connections will auto-reconnect on the browser's behalf\n\t\t\t\t\t});\n\t\t\t\t\t\n\t\t\t\t\tdocument.addEventListener('mousemove', this.handleMouseMove.bind(this));\n\t\t\t\t\tdocument.addEventListener('mouseup', this.handleMouseUp.bind(this));\n\t\t\t\t},\n\n\t\t\t\topenSettings() {\n\t\t\t\t\tthis.showSettings = true;\n\t\t\t\t},\n\t\t\t\t\n\t\t\t\tgetStatusText() {\n\t\t\t\t\tif (this.loading) return 'Loading...';\n\t\t\t\t\tif (this.metadata && this.metadata.lastUpdate) {\n\t\t\t\t\t\treturn `Last updated: ${new Date(this.metadata.lastUpdate).toLocaleTimeString()}`;\n\t\t\t\t\t}\n\t\t\t\t\treturn 'Ready';\n\t\t\t\t},\n\n\t\t\t\tinitializeSessionTracking() {\n\t\t\t\t\tconst sessionData = sessionStorage.getItem(this.sessionStorageKey);\n\t\t\t\t\t\n\t\t\t\t\tif (sessionData) {\n\t\t\t\t\t\ttry {\n\t\t\t\t\t\t\tconst data = JSON.parse(sessionData);\n\t\t\t\t\t\t\tthis.hasInitiallyLoaded = data.hasInitiallyLoaded || false;\n\t\t\t\t\t\t\tconsole.log('Session tracking restored - hasInitiallyLoaded:', this.hasInitiallyLoaded);\n\t\t\t\t\t\t} catch (e) {\n\t\t\t\t\t\t\tconsole.warn('Failed to parse session data, treating as fresh session');\n\t\t\t\t\t\t\tthis.hasInitiallyLoaded = false;\n\t\t\t\t\t\t}\n\t\t\t\t\t} else {\n\t\t\t\t\t\tconsole.log('Fresh session detected');\n\t\t\t\t\t\tthis.hasInitiallyLoaded = false;\n\t\t\t\t\t}\n\t\t\t\t\t\n\t\t\t\t\tthis.saveSessionState();\n\t\t\t\t},\n\n\t\t\t\tsaveSessionState() {\n\t\t\t\t\ttry {\n\t\t\t\t\t\tconst sessionData = {\n\t\t\t\t\t\t\thasInitiallyLoaded: this.hasInitiallyLoaded,\n\t\t\t\t\t\t\ttimestamp: Date.now()\n\t\t\t\t\t\t};\n\t\t\t\t\t\tsessionStorage.setItem(this.sessionStorageKey, JSON.stringify(sessionData));\n\t\t\t\t\t} catch (e) {\n\t\t\t\t\t\tconsole.warn('Failed to save session state:', e);\n\t\t\t\t\t}\n\t\t\t\t},\n\n\t\t\t\tsetDisplayMode(mode) {\n\t\t\t\t\tif (this.displayMode !== mode) {\n\t\t\t\t\t\tconst previousMode = this.displayMode;\n\t\t\t\t\t\tthis.displayMode = mode;\n\t\t\t\t\t\tthis.clearSelection();\n\t\t\t\t\t\tthis.currentPage = 1; // Each mode has its own result set size\n\n\t\t\t\t\t\t// Always reload when switching back from resolved to other views\n\t\t\t\t\t\tif (previousMode === 'resolved' && mode !== 'resolved') {\n\t\t\t\t\t\t\tconsole.log('Switching from resolved to', mode, '- reloading alerts');\n\t\t\t\t\t\t\t// Reset lastUpdateTime to force full reload and avoid stale incremental data\n\t\t\t\t\t\t\tthis.lastUpdateTime = null;\n\t\t\t\t\t\t\t// Initialize empty alerts array to prevent Alpine from trying to render undefined\n\t\t\t\t\t\t\tthis.alerts = [];\n\t\t\t\t\t\t\tthis.loadDashboardData();\n\t\t\t\t\t\t} else if (mode !== 'resolved') {\n\t\t\t\t\t\t\t// For other transitions between non-resolved modes, load as normal\n\t\t\t\t\t\t\tthis.loadDashboardData();\n\t\t\t\t\t\t} else {\n\t\t\t\t\t\t\t// Switching TO resolved mode - reset lastUpdateTime to prevent stale data\n\t\t\t\t\t\t\tthis.lastUpdateTime = null;\n\t\t\t\t\t\t}\n\t\t\t\t\t\t// Note: When switching TO resolved mode, don't call loadDashboardData\n\t\t\t\t\t\t// because the resolved view has its own data loading logic\n\t\t\t\t\t}\n\t\t\t\t},\n\n\t\t\t\tsetViewMode(mode) {\n\t\t\t\t\tif (this.viewMode !== mode) {\n\t\t\t\t\t\tthis.viewMode = mode;\n\t\t\t\t\t\tthis.clearSelection();\n\t\t\t\t\t\tthis.currentPage = 1;\n\t\t\t\t\t\tif (mode === 'group') {\n\t\t\t\t\t\t\tthis.expandedGroups = this.groups.map(g => g.groupName);\n\t\t\t\t\t\t}\n\t\t\t\t\t\tthis.loadDashboardData();\n\t\t\t\t\t}\n\t\t\t\t},\n\n\t\t\t\t// SSE connection management\n\t\t\t\tinitSSE() {\n\t\t\t\t\tif (!this.useSSE || this.sseConnection) return;\n\n\t\t\t\t\tconsole.log('Initializing SSE connection...');\n\t\t\t\t\tthis.sseConnection = new EventSource('/api/v1/dashboard/stream');\n\n\t\t\t\t\tthis.sseConnection.addEventListener('update', (event) => {\n\t\t\t\t\t\ttry {\n\t\t\t\t\t\t\tconst update = JSON.parse(event.data);\n\t\t\t\t\t\t\tthis.applyIncrementalUpdate(update, 'sse');\n\t\t\t\t\t\t} catch (error) {\n\t\t\t\t\t\t\tconsole.error('Error parsing SSE update:', error);\n\t\t\t\t\t\t}\n\t\t\t\t\t});\n\n\t\t\t\t\tthis.sseConnection.addEventListener('open', () => {\n\t\t\t\t\t\tconsole.log('SSE connection established');\n\t\t\t\t\t});\n\n\t\t\t\t\tthis.sseConnection.onerror = (error) => {\n\t\t\t\t\t\tconsole.log('SSE error, falling back to polling:', error);\n\t\t\t\t\t\tthis.destroySSE();\n\t\t\t\t\t\tthis.startAutoRefresh();\n\t\t\t\t\t};\n\t\t\t\t},\n\n\t\t\t\tdestroySSE() {\n\t\t\t\t\tif (this.sseConnection) {\n\t\t\t\t\t\tconsole.log('Closing SSE connection');\n\t\t\t\t\t\tthis.sseConnection.close();\n\t\t\t\t\t\tthis.sseConnection = null;\n\t\t\t\t\t}\n\t\t\t\t},\n\n\t\t\t\tstartAutoRefresh() {\n\t\t\t\t\tthis.stopAutoRefresh();\n\t\t\t\t\tthis.refreshInterval = setInterval(() => {\n\t\t\t\t\t\tthis.loadDashboardIncremental();\n\t\t\t\t\t}, this.currentInterval);\n\t\t\t\t},\n\n\t\t\t\tstopAutoRefresh() {\n\t\t\t\t\tif (this.refreshInterval) {\n\t\t\t\t\t\tclearInterval(this.refreshInterval);\n\t\t\t\t\t\tthis.refreshInterval = null;\n\t\t\t\t\t}\n\t\t\t\t},\n\n\t\t\t\t// Adaptive refresh - adjusts polling interval based on change rate\n\t\t\t\tadaptiveRefresh() {\n\t\t\t\t\tthis.pollCount++;\n\n\t\t\t\t\t// Adjust every 10 polls\n\t\t\t\t\tif (this.pollCount >= 10) {\n\t\t\t\t\t\tconst changeRate = this.recentChanges / this.pollCount;\n\n\t\t\t\t\t\tif (changeRate < 0.1) {\n\t\t\t\t\t\t\t// Few changes - slow down\n\t\t\t\t\t\t\tthis.currentInterval = Math.min(this.currentInterval * 1.5, this.maxInterval);\n\t\t\t\t\t\t\tconsole.log(`Adaptive polling: slowing down to ${this.currentInterval}ms (change rate: ${(changeRate * 100).toFixed(1)}%)`);\n\t\t\t\t\t\t} else if (changeRate > 0.5) {\n\t\t\t\t\t\t\t// Many changes - speed up\n\t\t\t\t\t\t\tthis.currentInterval = Math.max(this.currentInterval / 1.5, this.baseInterval);\n\t\t\t\t\t\t\tconsole.log(`Adaptive polling: speeding up to ${this.currentInterval}ms (change rate: ${(changeRate * 100).toFixed(1)}%)`);\n\t\t\t\t\t\t}\n\n\t\t\t\t\t\t// Reset counters\n\t\t\t\t\t\tthis.recentChanges = 0;\n\t\t\t\t\t\tthis.pollCount = 0;\n\n\t\t\t\t\t\t// Restart timer with new interval\n\t\t\t\t\t\tthis.stopAutoRefresh();\n\t\t\t\t\t\tthis.startAutoRefresh();\n\t\t\t\t\t}\n\t\t\t\t},\n\t\t\t\t// Notification banner functions\n\t\t\t\tshouldShowNotificationBanner() {\n\t\t\t\t\t// Don't show if dismissed this session\n\t\t\t\t\tif (this.notificationBannerDismissed) return false;\n\n\t\t\t\t\t// Don't show if dismissed previously (scoped per user; falls back to the\n\t\t\t\t\t// unscoped key if currentUser hasn't loaded yet)\n\t\t\t\t\tconst bannerKey = (this.currentUser && this.currentUser.id)\n\t\t\t\t\t\t? 'notificator_banner_dismissed_' + this.currentUser.id\n\t\t\t\t\t\t: 'notificator_banner_dismissed';\n\t\t\t\t\tif (localStorage.getItem(bannerKey) === 'true') return false;\n\n\t\t\t\t\t// Don't show if notification service not loaded\n\t\t\t\t\tif (!window.notificationService) return false;\n\n\t\t\t\t\t// Show if either permission not granted OR preference not enabled\n\t\t\t\t\tconst permissionGranted = 'Notification' in window && Notification.permission === 'granted';\n\t\t\t\t\tconst preferenceEnabled = window.notificationService.preferences.browserNotificationsEnabled;\n\n\t\t\t\t\treturn !permissionGranted || !preferenceEnabled;\n\t\t\t\t},\n\n\t\t\t\tasync enableNotifications() {\n\t\t\t\t\tif (!window.notificationService) return;\n\n\t\t\t\t\t// Request permission if needed\n\t\t\t\t\tif (!('Notification' in window)) {\n\t\t\t\t\t\tconsole.warn('Browser does not support notifications');\n\t\t\t\t\t\treturn;\n\t\t\t\t\t}\n\n\t\t\t\t\tif (Notification.permission !== 'granted') {\n\t\t\t\t\t\tconst granted = await window.notificationService.requestPermission();\n\t\t\t\t\t\tif (!granted) {\n\t\t\t\t\t\t\tconsole.log('Notification permission denied');\n\t\t\t\t\t\t\treturn;\n\t\t\t\t\t\t}\n\t\t\t\t\t}\n\n\t\t\t\t\t// Enable and save preference\n\t\t\t\t\twindow.notificationService.preferences.browserNotificationsEnabled = true;\n\t\t\t\t\tawait window.notificationService.savePreferences(window.notificationService.preferences);\n\n\t\t\t\t\t// Update permission status in service\n\t\t\t\t\twindow.notificationService.permissionGranted = Notification.permission === 'granted';\n\n\t\t\t\t\tconsole.log('Notifications enabled successfully');\n\n\t\t\t\t\t// Auto-dismiss the banner since notifications are now enabled\n\t\t\t\t\tthis.dismissNotificationBanner();\n\t\t\t\t},\n\n\t\t\t\tdismissNotificationBanner() {\n\t\t\t\t\tthis.notificationBannerDismissed = true;\n\t\t\t\t\t// Save to localStorage, scoped per user (falls back to the unscoped\n\t\t\t\t\t// key if currentUser hasn't loaded yet)\n\t\t\t\t\tconst bannerKey = (this.currentUser && this.currentUser.id)\n\t\t\t\t\t\t? 'notificator_banner_dismissed_' + this.currentUser.id\n\t\t\t\t\t\t: 'notificator_banner_dismissed';\n\t\t\t\t\tlocalStorage.setItem(bannerKey, 'true');\n\t\t\t\t}\n\t\t\t}\n\t\t}\n\t</script>")
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 1, "<script>\n\t\tfunction newDashboard() {\n\t\t\treturn {\n\t\t\t\tloading: true,\n\t\t\t\talerts: [],\n\t\t\t\tgroups: [],\n\t\t\t\tmetadata: {\n\t\t\t\t\ttotalAlerts: 0,\n\t\t\t\t\tfilteredCount: 0,\n\t\t\t\t\tlastUpdate: null,\n\t\t\t\t\tcounters: {\n\t\t\t\t\t\tcritical: 0,\n\t\t\t\t\t\twarning: 0,\n\t\t\t\t\t\tinfo: 0,\n\t\t\t\t\t\tfiring: 0,\n\t\t\t\t\t\tresolved: 0,\n\t\t\t\t\t\tacknowledged: 0,\n\t\t\t\t\t\twithComments: 0,\n\t\t\t\t\t\tseverityCounters: {}\n\t\t\t\t\t},\n\t\t\t\t\tavailableFilters: {\n\t\t\t\t\t\talertmanagers: [],\n\t\t\t\t\t\tseverities: [],\n\t\t\t\t\t\tstatuses: [],\n\t\t\t\t\t\tteams: [],\n\t\t\t\t\t\talertNames: []\n\t\t\t\t\t},\n\t\t\t\t\talertmanagerStatus: {},\n\t\t\t\t\tseverityColors: {}\n\t\t\t\t},\n\t\t\t\tsettings: {\n\t\t\t\t\ttheme: 'light',\n\t\t\t\t\trefreshInterval: 5,\n\t\t\t\t\tresolvedAlertsLimit: 100,\n\t\t\t\t\tnewAlertHighlightSeconds: 60,    // 0 disables the \"new alert\" highlight\n\t\t\t\t\tadaptiveMinInterval: 2,          // Fastest polling, in seconds, while critical alerts appear\n\t\t\t\t\tadaptiveMaxInterval: 60,         // Slowest polling, in seconds, while quiet and idle\n\t\t\t\t\tnewAlertHighlightStyle: 'border' // 'border', 'background' or 'none'\n\t\t\t\t},\n\t\t\t\t\n\t\t\t\tisRemovingResolvedAlerts: false,\n\t\t\t\tisSearching: false,\n\n\t\t\t\thasInitiallyLoaded: false,\n\t\t\t\tsessionStorageKey: 'dashboard_session_state',\n\n\t\t\t\tdisplayMode: 'classic',\n\t\t\t\tviewMode: 'list',\n\t\t\t\t// Until the user picks a sort: criticals first, newest first within a severity\n\t\t\t\tsortField: 'severity',\n\t\t\t\tsortDirection: 'desc',\n\t\t\t\tsortThen: [{ field: 'startsAt', direction: 'desc' }], // Secondary sort keys, also added with shift-click\n\t\t\t\tgroupByLabel: 'alertname', // Default group by alert name\n\t\t\t\tshowSettings: false,\n\t\t\t\t\n\t\t\t\tshowAckModal: false,\n\t\t\t\tackAction: 'single',\n\t\t\t\tackReason: '',\n\t\t\t\tackDuration: '', // e.g. \"2h\"; empty keeps the acknowledgment until removed\n\t\t\t\tackRenotify: false,\n\t\t\t\tackError: '',\n\t\t\t\tackSubmitting: false,\n\t\t\t\tcurrentAckAlert: null,\n\t\t\t\tcurrentGroupName: '',\n\n\t\t\t\t// Snoozing hides alerts from this user's view until the snooze ends\n\t\t\t\tshowSnoozeModal: false,\n\t\t\t\tsnoozeFingerprints: [],\n\t\t\t\tsnoozeDuration: '1h',\n\t\t\t\tsnoozeReason: '',\n\t\t\t\tsnoozeError: '',\n\t\t\t\tsnoozeSubmitting: false,\n\t\t\t\tsnoozeOptions: [\n\t\t\t\t\t{ label: '15 minutes', value: '15m' },\n\t\t\t\t\t{ label: '1 hour', value: '1h' },\n\t\t\t\t\t{ label: '4 hours', value: '4h' },\n\t\t\t\t\t{ label: '1 day', value: '24h' },\n\t\t\t\t\t{ label: '1 week', value: '168h' },\n\t\t\t\t],\n\n\t\t\t\tshowEscalateModal: false,\n\t\t\t\tescalateTargetType: 'user',\n\t\t\t\tescalateTarget: '',\n\t\t\t\tescalateUserQuery: '',\n\t\t\t\tescalateUserResults: [],\n\t\t\t\tescalateGroups: [],\n\t\t\t\tescalateReason: '',\n\t\t\t\tescalateError: '',\n\t\t\t\tescalateSubmitting: false,\n\t\t\t\t\n\t\t\t\tshowSilenceModal: false,\n\t\t\t\tsilenceExpiring: {},\n\t\t\t\tsilenceMode: 'create', // 'create' or 'edit'\n\t\t\t\teditingSilence: null,\n\t\t\t\tsilenceMatchers: [],\n\t\t\t\tsilenceEndsAt: '',\n\t\t\t\tsilenceSuggestions: null, // null until requested, then the list from the server\n\t\t\t\tsilenceSuggestionsLoading: false,\n\t\t\t\tsilenceAction: 'single',\n\t\t\t\tsilenceReason: '',\n\t\t\t\tsilenceError: '',\n\t\t\t\tsilenceSubmitting: false,\n\t\t\t\tcurrentSilenceAlert: null,\n\t\t\t\tsilenceLabelChoices: [], // { name, value, checked } per label of a single silenced alert\n\t\t\t\tsilenceCustomMatchers: '', // one Alertmanager matcher per line, e.g. instance=~web-.*\n\t\t\t\tsilenceDuration: '1h',\n\t\t\t\tsilenceDurationType: 'preset',\n\t\t\t\tcustomSilenceDuration: '',\n\t\t\t\tcustomDurationError: '',\n\t\t\t\t\n\t\t\t\tshowAlertModal: false,\n\t\t\t\talertDetails: null,\n\t\t\t\tcurrentAlertTab: 'overview',\n\t\t\t\talertDetailsLoading: false,\n\t\t\t\talertHistory: null,\n\t\t\t\thistoryLoading: false,\n\t\t\t\talertActivity: [],\n\t\t\t\talertActivityCursor: '',\n\t\t\t\talertActivityLoading: false,\n\t\t\t\talertExplain: null, // \"why am I seeing this alert\", loaded when its popover opens\n\t\t\t\tshowAlertExplain: false,\n\t\t\t\talertExplainLoading: false,\n\t\t\t\t\n\t\t\t\t// Filter presets modal state\n\t\t\t\tshowFilterPresetsModal: false,\n\t\t\t\tactivePresetName: null, // Track active default preset name\n\t\t\t\tincludeColumnConfig: true, // Whether to include column config when saving filter preset\n\n\t\t\t\t// Column config modal state\n\t\t\t\tshowColumnConfigModal: false,\n\n\t\t\t\tnewCommentContent: '',\n\t\t\t\tcommentDraftSavedAt: null,\n\t\t\t\tcommentPreview: false,\n\t\t\t\tcommentTagFilter: '',\n\t\t\t\ttaggedComments: [],\n\t\t\t\ttaggedCommentsLoading: false,\n\t\t\t\tcommentSubmitting: false,\n\t\t\t\tcommentDeleting: {},\n\t\t\t\tackRemoving: {},\n\t\t\t\tolderCommentsLoading: false,\n\t\t\t\tolderAcknowledgmentsLoading: false,\n\t\t\t\talertUpdatesSocket: null,\n\t\t\t\talertUpdatesStatus: '',\n\t\t\t\talertUpdatesRetryDelay: 0,\n\t\t\t\talertUpdatesRetryTimer: null,\n\t\t\t\talertViewers: [],\n\t\t\t\tdiscussionRestoring: false,\n\t\t\t\tcurrentUser: null,\n\t\t\t\t\n\t\t\t\tsearchQuery: '',\n\t\t\t\tsearchHistory: [],\n\t\t\t\tsearchSuggestionsOpen: false,\n\t\t\t\tsearchSuggestionIndex: -1,\n\t\t\t\tfilters: {\n\t\t\t\t\talertmanagers: [],\n\t\t\t\t\tseverities: [],\n\t\t\t\t\tstatuses: [],\n\t\t\t\t\tteams: [],\n\t\t\t\t\talertNames: []\n\t\t\t\t},\n\t\t\t\t\n\t\t\t\tselectedAlerts: [],\n\t\t\t\tselectedGroups: [],\n\t\t\t\texpandedGroups: [],\n\t\t\t\t\n\t\t\t\t// Pagination\n\t\t\t\tcurrentPage: 1,\n\t\t\t\titemsPerPage: 50,\n\t\t\t\ttotalItems: 0,\n\n\t\t\t\t// Resolved alerts state (mixin will add more properties)\n\t\t\t\tresolvedAlerts: [],\n\t\t\t\tresolvedTotalCount: 0,\n\t\t\t\tresolvedLoading: false,\n\n\t\t\t\trefreshInterval: null,\n\t\t\t\tlastUpdateTime: null,\n\t\t\t\tforceRefreshing: false,\n\n\t\t\t\t// Clock driving the fade-out of the \"new alert\" highlight\n\t\t\t\thighlightClock: Date.now(),\n\t\t\t\thighlightClockTimer: null,\n\n\t\t\t\t// Full value of the truncated table cell under the pointer\n\t\t\t\tcellTooltip: { show: false, text: '', x: 0, y: 0 },\n\n\t\t\t\t// SSE (Server-Sent Events) support\n\t\t\t\tsseConnection: null,\n\t\t\t\tuseSSE: true,  // Feature flag for SSE\n\n\t\t\t\t// Adaptive polling rate (fallback when SSE not available); bounded by\n\t\t\t\t// settings.adaptiveMinInterval / adaptiveMaxInterval\n\t\t\t\trecentChanges: 0,      // Count of polls with changes\n\t\t\t\tpollCount: 0,          // Total polls since last adjustment\n\t\t\t\tbaseInterval: 5000,    // 5 seconds base\n\t\t\t\tcurrentInterval: 5000, // Current interval (adjusts)\n\t\t\t\tlastUserActivity: Date.now(),\n\t\t\t\tidleAfter: 120000,     // No input for 2 minutes means the user is idle\n\t\t\t\t\n\t\t\t\talertColors: {},\n\t\t\t\talertColorsTimestamp: 0,\n\n\t\t\t\t// Annotation button configs\n\t\t\t\tannotationButtonConfigs: [],\n\t\t\t\tcopiedAnnotationButtonId: null,\n\t\t\t\talertLinkCopied: false,\n\t\t\t\tshowShareModal: false,\n\t\t\t\tshareTargets: null, // {comments, slack, slackChannel, email} from /share/targets, loaded on first share\n\t\t\t\tshareNote: '',\n\t\t\t\tshareStatus: '',\n\t\t\t\tshareError: '',\n\t\t\t\tshareSending: false,\n\n\t\t\t\t// Latest \"you were mentioned\" notice, shown as a toast\n\t\t\t\tmentionNotice: null,\n\t\t\t\t// Undo toast of the last \"clear all\", see offerUndo\n\t\t\t\tundoNotice: null,\n\n\t\t\t\tcolumnWidths: {\n\t\t\t\t\talertName: 300,\n\t\t\t\t\taction: 100,\n\t\t\t\t\tinstance: 350,\n\t\t\t\t\tseverity: 150,\n\t\t\t\t\tstatus: 150,\n\t\t\t\t\tcomments: 130,\n\t\t\t\t\tteam: 200,\n\t\t\t\t\tsummary: 400,\n\t\t\t\t\tduration: 150,\n\t\t\t\t\tsource: 180\n\t\t\t\t},\n\t\t\t\tisResizing: false,\n\t\t\t\tstartX: 0,\n\t\t\t\tstartWidth: 0,\n\t\t\t\tcurrentColumn: null,\n\n\t\t\t\t// Dynamic columns configuration\n\t\t\t\tcolumns: [],\n\t\t\t\tvisibleColumns: [],\n\t\t\t\tresizingColumn: null,\n\t\t\t\tresizeStartX: 0,\n\t\t\t\tresizeStartWidth: 0,\n\t\t\t\tcolumnLayoutSaveTimer: null,\n\t\t\t\tsorting: { field: null, direction: 'asc' },\n\n\t\t\t\tanyModalOpen() {\n\t\t\t\t\treturn this.showSettings || this.showAckModal || this.showSnoozeModal || this.showEscalateModal || this.showSilenceModal ||\n\t\t\t\t\t\tthis.showAlertModal || this.showFilterPresetsModal ||\n\t\t\t\t\t\tthis.showColumnConfigModal || this.showSilencesView || this.showCommandPalette || this.showResolvedArchive;\n\t\t\t\t},\n\n\t\t\t\tfocusSearch(event) {\n\t\t\t\t\t// All shortcuts are inert while a modal is open — the search input is\n\t\t\t\t\t// hidden behind the overlay, so focusing it would be invisible/confusing.\n\t\t\t\t\tif (this.anyModalOpen()) {\n\t\t\t\t\t\treturn;\n\t\t\t\t\t}\n\t\t\t\t\t// '/' must not fire while typing elsewhere; Ctrl/Cmd+F always wins.\n\t\t\t\t\tconst t = event.target;\n\t\t\t\t\tif (event.key === '/' &&\n\t\t\t\t\t\t(t.closest('input, textarea, select, [contenteditable]'))) {\n\t\t\t\t\t\treturn;\n\t\t\t\t\t}\n\t\t\t\t\tevent.preventDefault();\n\t\t\t\t\tdocument.getElementById('dashboard-search')?.focus();\n\t\t\t\t},\n\n\t\t\t\t// Shift+R, unless typing or a modal is open\n\t\t\t\tonForceRefreshKey(event) {\n\t\t\t\t\tif (this.anyModalOpen() || event.ctrlKey || event.metaKey ||\n\t\t\t\t\t\tevent.target.closest('input, textarea, select, [contenteditable]')) {\n\t\t\t\t\t\treturn;\n\t\t\t\t\t}\n\t\t\t\t\tevent.preventDefault();\n\t\t\t\t\tthis.forceRefresh();\n\t\t\t\t},\n\n\t\t\t\tgetDisplayStatus(status) {\n\t\t\t\t\tif (!status?.state) return 'unknown';\n\t\t\t\t\treturn status.state === 'suppressed' ? 'silenced' : status.state;\n\t\t\t\t},\n\n\t\t\t\tstatusMatches(status, value) {\n\t\t\t\t\tconst displayStatus = this.getDisplayStatus(status);\n\t\t\t\t\treturn displayStatus === value;\n\t\t\t\t},\n\n\t\t\t\t// Severity priority for sorting badges in header\n\t\t\t\tgetSeverityPriority(severity) {\n\t\t\t\t\tconst priorities = {\n\t\t\t\t\t\t'critical': 100,\n\t\t\t\t\t\t'page': 90,\n\t\t\t\t\t\t'warning': 80,\n\t\t\t\t\t\t'warn': 75,\n\t\t\t\t\t\t'info': 50,\n\t\t\t\t\t\t'information': 50,\n\t\t\t\t\t\t'low': 30,\n\t\t\t\t\t\t'none': 10\n\t\t\t\t\t};\n\t\t\t\t\treturn priorities[severity?.toLowerCase()] || 40;\n\t\t\t\t},\n\n\t\t\t\t// Get CSS classes for severity badge background/text\n\t\t\t\t// NOTE: Color values should match renderBadge() in dashboard_utilities.templ\n\t\t\t\t// for consistency between header badges and table cells\n\t\t\t\tgetSeverityBadgeClasses(severity) {\n\t\t\t\t\tconst sev = severity?.toLowerCase();\n\t\t\t\t\tswitch (sev) {\n\t\t\t\t\t\tcase 'critical':\n\t\t\t\t\t\tcase 'page':\n\t\t\t\t\t\t\treturn 'bg-red-100 text-red-800 dark:bg-red-900/50 dark:text-red-200';\n\t\t\t\t\t\tcase 'warning':\n\t\t\t\t\t\tcase 'warn':\n\t\t\t\t\t\t\treturn 'bg-yellow-100 text-yellow-800 dark:bg-yellow-900/50 dark:text-yellow-200';\n\t\t\t\t\t\tcase 'info':\n\t\t\t\t\t\tcase 'information':\n\t\t\t\t\t\t\treturn 'bg-blue-100 text-blue-800 dark:bg-blue-900/50 dark:text-blue-200';\n\t\t\t\t\t\tcase 'low':\n\t\t\t\t\t\tcase 'none':\n\t\t\t\t\t\t\treturn 'bg-gray-100 text-gray-700 dark:bg-gray-700 dark:text-gray-300';\n\t\t\t\t\t\tdefault:\n\t\t\t\t\t\t\treturn 'bg-purple-100 text-purple-800 dark:bg-purple-900/50 dark:text-purple-200';\n\t\t\t\t\t}\n\t\t\t\t},\n\n\t\t\t\t// Get CSS classes for severity dot indicator\n\t\t\t\tgetSeverityDotClasses(severity) {\n\t\t\t\t\tconst sev = severity?.toLowerCase();\n\t\t\t\t\tswitch (sev) {\n\t\t\t\t\t\tcase 'critical':\n\t\t\t\t\t\tcase 'page':\n\t\t\t\t\t\t\treturn 'bg-red-500';\n\t\t\t\t\t\tcase 'warning':\n\t\t\t\t\t\tcase 'warn':\n\t\t\t\t\t\t\treturn 'bg-yellow-500';\n\t\t\t\t\t\tcase 'info':\n\t\t\t\t\t\tcase 'information':\n\t\t\t\t\t\t\treturn 'bg-blue-500';\n\t\t\t\t\t\tcase 'low':\n\t\t\t\t\t\tcase 'none':\n\t\t\t\t\t\t\treturn 'bg-gray-400';\n\t\t\t\t\t\tdefault:\n\t\t\t\t\t\t\treturn 'bg-purple-500';\n\t\t\t\t\t}\n\t\t\t\t},\n\n\t\t\t\t// Color set for a severity in webui.severity_colors, or '' when it\n\t\t\t\t// keeps the built-in classes. The styles below override those classes.\n\t\t\t\tgetConfiguredSeverityColor(severity) {\n\t\t\t\t\treturn (this.metadata?.severityColors || {})[severity?.toLowerCase()] || '';\n\t\t\t\t},\n\n\t\t\t\tgetSeverityBadgeStyle(severity) {\n\t\t\t\t\tconst color = this.getConfiguredSeverityColor(severity);\n\t\t\t\t\treturn color ? `background-color: ${color}26; color: ${color};` : '';\n\t\t\t\t},\n\n\t\t\t\tgetSeverityDotStyle(severity) {\n\t\t\t\t\tconst color = this.getConfiguredSeverityColor(severity);\n\t\t\t\t\treturn color ? `background-color: ${color};` : '';\n\t\t\t\t},\n\n\t\t\t\t// Check if response indicates authentication failure\n\t\t\t\thandleAuthError(response) {\n\t\t\t\t\t// Redirect to login if unauthorized or service unavailable\n\t\t\t\t\tif (response.status === 401 || response.status === 503) {\n\t\t\t\t\t\twindow.location.href = '/login';\n\t\t\t\t\t\treturn true;\n\t\t\t\t\t}\n\t\t\t\t\treturn false;\n\t\t\t\t},\n\n\t\t\t\t// Install global fetch interceptor to handle auth errors consistently\n\t\t\t\tinstallFetchInterceptor() {\n\t\t\t\t\tconst originalFetch = window.fetch;\n\t\t\t\t\tconst dashboard = this;\n\n\t\t\t\t\twindow.fetch = async function(...args) {\n\t\t\t\t\t\ttry {\n\t\t\t\t\t\t\tconst response = await originalFetch.apply(this, args);\n\n\t\t\t\t\t\t\t// Check for auth errors on any API call\n\t\t\t\t\t\t\tif (response.status === 401) {\n\t\t\t\t\t\t\t\tconsole.log('Session expired, redirecting to login');\n\t\t\t\t\t\t\t\tdashboard.stopAutoRefresh();\n\t\t\t\t\t\t\t\tdashboard.destroySSE();\n\t\t\t\t\t\t\t\twindow.location.href = '/login';\n\t\t\t\t\t\t\t\t// Return a never-resolving promise to prevent further processing\n\t\t\t\t\t\t\t\treturn new Promise(() => {});\n\t\t\t\t\t\t\t}\n\n\t\t\t\t\t\t\treturn response;\n\t\t\t\t\t\t} catch (error) {\n\t\t\t\t\t\t\t// Network errors - let them propagate\n\t\t\t\t\t\t\tthrow error;\n\t\t\t\t\t\t}\n\t\t\t\t\t};\n\t\t\t\t},\n\n\t\t\t\t// Validate session with backend\n\t\t\t\tasync validateSession() {\n\t\t\t\t\ttry {\n\t\t\t\t\t\tconst response = await fetch('/api/v1/auth/me', {\n\t\t\t\t\t\t\tcredentials: 'include'\n\t\t\t\t\t\t});\n\n\t\t\t\t\t\t// Check for authentication errors and redirect if needed\n\t\t\t\t\t\tif (this.handleAuthError(response)) {\n\t\t\t\t\t\t\treturn false;\n\t\t\t\t\t\t}\n\n\t\t\t\t\t\treturn response.ok;\n\t\t\t\t\t} catch (error) {\n\t\t\t\t\t\tconsole.error('Session validation failed:', error);\n\t\t\t\t\t\t// Redirect to login on network error (backend might be down)\n\t\t\t\t\t\twindow.location.href = '/login';\n\t\t\t\t\t\treturn false;\n\t\t\t\t\t}\n\t\t\t\t},\n\n\t\t\t\tasync init() {\n\t\t\t\t\t// Install global fetch interceptor for auth errors\n\t\t\t\t\tthis.installFetchInterceptor();\n\n\t\t\t\t\tObject.assign(this, window.dashboardDataMixin || {});\n\t\t\t\t\tObject.assign(this, window.dashboardActionsMixin || {});\n\t\t\t\t\tObject.assign(this, window.dashboardUtilitiesMixin || {});\n\t\t\t\t\tObject.assign(this, window.dashboardModalMixin || {});\n\t\t\t\t\tObject.assign(this, window.dashboardFilterPresetsMixin || {});\n\t\t\t\t\tObject.assign(this, window.dashboardResolvedAlertsMixin || {});\n\t\t\t\t\tObject.assign(this, window.dashboardResolvedArchiveMixin || {});\n\t\t\t\t\tObject.assign(this, window.dashboardSilencesMixin || {});\n\t\t\t\t\tObject.assign(this, window.dashboardCommandPaletteMixin || {});\n\n\t\t\t\t\twindow.dashboardInstance = this;\n\n\t\t\t\t\tthis.initializeSessionTracking();\n\t\t\t\t\tthis.trackUserActivity();\n\n\t\t\t\t\t// Initialize resolved alerts auto-load watcher\n\t\t\t\t\tif (this.initResolvedAutoLoad) {\n\t\t\t\t\t\tthis.initResolvedAutoLoad();\n\t\t\t\t\t}\n\n\t\t\t\t\t// Notification banner dismissed state is checked per-user in\n\t\t\t\t\t// shouldShowNotificationBanner() once currentUser is loaded below.\n\t\t\t\t\tthis.notificationBannerDismissed = false;\n\n\t\t\t\t\tthis.loadSettings();\n\t\t\t\t\tthis.highlightClockTimer = setInterval(() => {\n\t\t\t\t\t\tthis.highlightClock = Date.now();\n\t\t\t\t\t}, 2000);\n\t\t\t\t\tthis.loadColumnWidths();\n\t\t\t\t\tthis.loadSearchHistory();\n\t\t\t\t\tthis.initializeColumns();\n\t\t\t\t\tawait this.loadUserColumnPreferences(); // Load user column preferences\n\t\t\t\t\tawait this.loadCurrentUser();\n\t\t\t\t\tthis.loadAnnotationButtonConfigs();\n\n\t\t\t\t\t// Check if URL has filter parameters\n\t\t\t\t\tconst params = new URLSearchParams(window.location.search);\n\t\t\t\t\tconst hasURLFilters = params.has('search') || params.has('alertmanagers') ||\n\t\t\t\t\t\t\t\t\t\t  params.has('severities') || params.has('statuses') ||\n\t\t\t\t\t\t\t\t\t\t  params.has('teams') || params.has('alertNames') ||\n\t\t\t\t\t\t\t\t\t\t  params.has('acknowledged') || params.has('hasComments');\n\n\t\t\t\t\tlet defaultPresetLoaded = false;\n\n\t\t\t\t\tif (!hasURLFilters) {\n\t\t\t\t\t\t// No URL filters - try to load default preset (if exists, it will also load data)\n\t\t\t\t\t\tdefaultPresetLoaded = await this.loadDefaultFilterPreset();\n\t\t\t\t\t}\n\t\t\t\t\t// Fill the toolbar preset switcher\n\t\t\t\t\tthis.loadFilterPresets();\n\n\t\t\t\t\t// Load filters from URL (will override default preset if URL has filters)\n\t\t\t\t\tthis.loadFiltersFromURL();\n\n\t\t\t\t\t// Try SSE first, fallback to polling if not supported\n\t\t\t\t\tif (this.useSSE && typeof EventSource !== 'undefined') {\n\t\t\t\t\t\tthis.initSSE();\n\t\t\t\t\t} else {\n\t\t\t\t\t\tthis.startAutoRefresh();\n\t\t\t\t\t}\n\n\t\t\t\t\t// Load data if default preset wasn't loaded or URL has filters\n\t\t\t\t\tif (!defaultPresetLoaded) {\n\t\t\t\t\t\tthis.loadDashboardData();\n\t\t\t\t\t}\n\t\t\t\t\t\n\t\t\t\t\tthis.checkAlertFromURL();\n\n\t\t\t\t\tdocument.addEventListener('visibilitychange', async () => {\n\t\t\t\t\t\tif (!document.hidden) {\n\t\t\t\t\t\t\t// Validate session when page becomes visible\n\t\t\t\t\t\t\tconst sessionValid = await this.validateSession();\n\t\t\t\t\t\t\tif (!sessionValid) {\n\t\t\t\t\t\t\t\t// If session invalid, stop refresh and destroy SSE\n\t\t\t\t\t\t\t\tthis.stopAutoRefresh();\n\t\t\t\t\t\t\t\tthis.destroySSE();\n\t\t\t\t\t\t\t\t// validateSession() will handle redirect to login\n\t\t\t\t\t\t\t} else {\n\t\t\t\t\t\t\t\t// Pick up hides made from another browser while this tab was away\n\t\t\t\t\t\t\t\tthis.syncHiddenState();\n\n\t\t\t\t\t\t\t\t// If SSE is enabled but not connected, try to reconnect\n\t\t\t\t\t\t\t\tif (this.useSSE && typeof EventSource !== 'undefined' && !this.sseConnection) {\n\t\t\t\t\t\t\t\t\t// Catch up on any alerts that fired while the tab was hidden\n\t\t\t\t\t\t\t\t\t// and SSE was disconnected, then re-establish the stream. A new\n\t\t\t\t\t\t\t\t\t// SSE connection only delivers events going forward, so without\n\t\t\t\t\t\t\t\t\t// this the gap window's alerts would never reach processNewAlerts.\n\t\t\t\t\t\t\t\t\tthis.loadDashboardIncremental();\n\t\t\t\t\t\t\t\t\tthis.initSSE();\n\t\t\t\t\t\t\t\t} else if (!this.sseConnection) {\n\t\t\t\t\t\t\t\t\t// Do one incremental fetch to catch any missed updates (polling mode)\n\t\t\t\t\t\t\t\t\tthis.loadDashboardIncremental();\n\t\t\t\t\t\t\t\t}\n\t\t\t\t\t\t\t\t// If SSE is connected, it will automatically receive updates\n\t\t\t\t\t\t\t}\n\t\t\t\t\t\t}\n\t\t\t\t\t\t// Don't stop auto-refresh when hidden - let it continue fetching in background\n\t\t\t\t\t\t// SSE connections will auto-reconnect on the browser's behalf\n\t\t\t\t\t});\n\t\t\t\t\t\n\t\t\t\t\tdocument.addEventListener('mousemove', this.handleMouseMove.bind(this));\n\t\t\t\t\tdocument.addEventListener('mouseup', this.handleMouseUp.bind(this));\n\t\t\t\t},\n\n\t\t\t\topenSettings() {\n\t\t\t\t\tthis.showSettings = true;\n\t\t\t\t},\n\t\t\t\t\n\t\t\t\tgetStatusText() {\n\t\t\t\t\tif (this.loading) return 'Loading...';\n\t\t\t\t\tif (this.metadata && this.metadata.lastUpdate) {\n\t\t\t\t\t\treturn `Last updated: ${new Date(this.metadata.lastUpdate).toLocaleTimeString()}`;\n\t\t\t\t\t}\n\t\t\t\t\treturn 'Ready';\n\t\t\t\t},\n\n\t\t\t\tinitializeSessionTracking() {\n\t\t\t\t\tconst sessionData = sessionStorage.getItem(this.sessionStorageKey);\n\t\t\t\t\t\n\t\t\t\t\tif (sessionData) {\n\t\t\t\t\t\ttry {\n\t\t\t\t\t\t\tconst data = JSON.parse(sessionData);\n\t\t\t\t\t\t\tthis.hasInitiallyLoaded = data.hasInitiallyLoaded || false;\n\t\t\t\t\t\t\tconsole.log('Session tracking restored - hasInitiallyLoaded:', this.hasInitiallyLoaded);\n\t\t\t\t\t\t} catch (e) {\n\t\t\t\t\t\t\tconsole.warn('Failed to parse session data, treating as fresh session');\n\t\t\t\t\t\t\tthis.hasInitiallyLoaded = false;\n\t\t\t\t\t\t}\n\t\t\t\t\t} else {\n\t\t\t\t\t\tconsole.log('Fresh session detected');\n\t\t\t\t\t\tthis.hasInitiallyLoaded = false;\n\t\t\t\t\t}\n\t\t\t\t\t\n\t\t\t\t\tthis.saveSessionState();\n\t\t\t\t},\n\n\t\t\t\tsaveSessionState() {\n\t\t\t\t\ttry {\n\t\t\t\t\t\tconst sessionData = {\n\t\t\t\t\t\t\thasInitiallyLoaded: this.hasInitiallyLoaded,\n\t\t\t\t\t\t\ttimestamp: Date.now()\n\t\t\t\t\t\t};\n\t\t\t\t\t\tsessionStorage.setItem(this.sessionStorageKey, JSON.stringify(sessionData));\n\t\t\t\t\t} catch (e) {\n\t\t\t\t\t\tconsole.warn('Failed to save session state:', e);\n\t\t\t\t\t}\n\t\t\t\t},\n\n\t\t\t\tsetDisplayMode(mode) {\n\t\t\t\t\tif (this.displayMode !== mode) {\n\t\t\t\t\t\tconst previousMode = this.displayMode;\n\t\t\t\t\t\tthis.displayMode = mode;\n\t\t\t\t\t\tthis.clearSelection();\n\t\t\t\t\t\tthis.currentPage = 1; // Each mode has its own result set size\n\n\t\t\t\t\t\t// Always reload when switching back from resolved to other views\n\t\t\t\t\t\tif (previousMode === 'resolved' && mode !== 'resolved') {\n\t\t\t\t\t\t\tconsole.log('Switching from resolved to', mode, '- reloading alerts');\n\t\t\t\t\t\t\t// Reset lastUpdateTime to force full reload and avoid stale incremental data\n\t\t\t\t\t\t\tthis.lastUpdateTime = null;\n\t\t\t\t\t\t\t// Initialize empty alerts array to prevent Alpine from trying to render undefined\n\t\t\t\t\t\t\tthis.alerts = [];\n\t\t\t\t\t\t\tthis.loadDashboardData();\n\t\t\t\t\t\t} else if (mode !== 'resolved') {\n\t\t\t\t\t\t\t// For other transitions between non-resolved modes, load as normal\n\t\t\t\t\t\t\tthis.loadDashboardData();\n\t\t\t\t\t\t} else {\n\t\t\t\t\t\t\t// Switching TO resolved mode - reset lastUpdateTime to prevent stale data\n\t\t\t\t\t\t\tthis.lastUpdateTime = null;\n\t\t\t\t\t\t}\n\t\t\t\t\t\t// Note: When switching TO resolved mode, don't call loadDashboardData\n\t\t\t\t\t\t// because the resolved view has its own data loading logic\n\t\t\t\t\t}\n\t\t\t\t},\n\n\t\t\t\tsetViewMode(mode) {\n\t\t\t\t\tif (this.viewMode !== mode) {\n\t\t\t\t\t\tthis.viewMode = mode;\n\t\t\t\t\t\tthis.clearSelection();\n\t\t\t\t\t\tthis.currentPage = 1;\n\t\t\t\t\t\tif (mode === 'group') {\n\t\t\t\t\t\t\tthis.expandedGroups = this.groups.map(g => g.groupName);\n\t\t\t\t\t\t}\n\t\t\t\t\t\tthis.loadDashboardData();\n\t\t\t\t\t}\n\t\t\t\t},\n\n\t\t\t\t// SSE connection management\n\t\t\t\tinitSSE() {\n\t\t\t\t\tif (!this.useSSE || this.sseConnection) return;\n\n\t\t\t\t\tconsole.log('Initializing SSE connection...');\n\t\t\t\t\tthis.sseConnection = new EventSource('/api/v1/dashboard/stream');\n\n\t\t\t\t\tthis.sseConnection.addEventListener('update', (event) => {\n\t\t\t\t\t\ttry {\n\t\t\t\t\t\t\tconst update = JSON.parse(event.data);\n\t\t\t\t\t\t\tthis.applyIncrementalUpdate(update, 'sse');\n\t\t\t\t\t\t} catch (error) {\n\t\t\t\t\t\t\tconsole.error('Error parsing SSE update:', error);\n\t\t\t\t\t\t}\n\t\t\t\t\t});\n\n\t\t\t\t\tthis.sseConnection.addEventListener('open', () => {\n\t\t\t\t\t\tconsole.log('SSE connection established');\n\t\t\t\t\t});\n\n\t\t\t\t\tthis.sseConnection.onerror = (error) => {\n\t\t\t\t\t\tconsole.log('SSE error, falling back to polling:', error);\n\t\t\t\t\t\tthis.destroySSE();\n\t\t\t\t\t\tthis.startAutoRefresh();\n\t\t\t\t\t};\n\t\t\t\t},\n\n\t\t\t\tdestroySSE() {\n\t\t\t\t\tif (this.sseConnection) {\n\t\t\t\t\t\tconsole.log('Closing SSE connection');\n\t\t\t\t\t\tthis.sseConnection.close();\n\t\t\t\t\t\tthis.sseConnection = null;\n\t\t\t\t\t}\n\t\t\t\t},\n\n\t\t\t\tstartAutoRefresh() {\n\t\t\t\t\tthis.stopAutoRefresh();\n\t\t\t\t\tthis.refreshInterval = setInterval(() => {\n\t\t\t\t\t\tthis.loadDashboardIncremental();\n\t\t\t\t\t}, this.currentInterval);\n\t\t\t\t},\n\n\t\t\t\tstopAutoRefresh() {\n\t\t\t\t\tif (this.refreshInterval) {\n\t\t\t\t\t\tclearInterval(this.refreshInterval);\n\t\t\t\t\t\tthis.refreshInterval = null;\n\t\t\t\t\t}\n\t\t\t\t},\n\n\t\t\t\t// Adaptive refresh - adjusts the polling interval after each poll:\n\t\t\t\t// - a poll bringing a new critical alert drops straight to the minimum\n\t\t\t\t// - every 10 polls, a change rate above 50% speeds up by 1.5x toward the\n\t\t\t\t//   minimum\n\t\t\t\t// - a change rate under 10% slows down by 1.5x toward the maximum while\n\t\t\t\t//   the user is idle, or back to the base interval while they are not\n\t\t\t\t// - input after an idle slow-down restores the base interval at once\n\t\t\t\tadaptiveRefresh(update) {\n\t\t\t\t\tthis.pollCount++;\n\t\t\t\t\tconst bounds = this.adaptiveBounds();\n\n\t\t\t\t\tconst newCritical = (update?.newAlerts || []).some(alert => alert.severity === 'critical');\n\t\t\t\t\tif (newCritical) {\n\t\t\t\t\t\tthis.recentChanges = 0;\n\t\t\t\t\t\tthis.pollCount = 0;\n\t\t\t\t\t\tif (this.currentInterval > bounds.min) {\n\t\t\t\t\t\t\tthis.setPollingInterval(bounds.min, 'new critical alert');\n\t\t\t\t\t\t}\n\t\t\t\t\t\treturn;\n\t\t\t\t\t}\n\n\t\t\t\t\t// Adjust every 10 polls\n\t\t\t\t\tif (this.pollCount < 10) return;\n\n\t\t\t\t\tconst changeRate = this.recentChanges / this.pollCount;\n\t\t\t\t\tconst rate = `change rate: ${(changeRate * 100).toFixed(1)}%`;\n\t\t\t\t\tthis.recentChanges = 0;\n\t\t\t\t\tthis.pollCount = 0;\n\n\t\t\t\t\tif (changeRate > 0.5) {\n\t\t\t\t\t\tthis.setPollingInterval(Math.max(this.currentInterval / 1.5, bounds.min), rate);\n\t\t\t\t\t} else if (changeRate < 0.1 && this.isUserIdle()) {\n\t\t\t\t\t\tthis.setPollingInterval(Math.min(this.currentInterval * 1.5, bounds.max), rate + ', user idle');\n\t\t\t\t\t} else if (changeRate < 0.1 && this.currentInterval < bounds.base) {\n\t\t\t\t\t\tthis.setPollingInterval(Math.min(this.currentInterval * 1.5, bounds.base), rate);\n\t\t\t\t\t}\n\t\t\t\t},\n\n\t\t\t\t// Polling bounds in ms from the settings; the base interval is kept\n\t\t\t\t// within them\n\t\t\t\tadaptiveBounds() {\n\t\t\t\t\tconst min = Math.max(1, Number(this.settings.adaptiveMinInterval) || 2) * 1000;\n\t\t\t\t\tconst max = Math.max(min, (Number(this.settings.adaptiveMaxInterval) || 60) * 1000);\n\t\t\t\t\treturn { min, max, base: Math.min(Math.max(this.baseInterval, min), max) };\n\t\t\t\t},\n\n\t\t\t\tsetPollingInterval(interval, reason) {\n\t\t\t\t\tinterval = Math.round(interval);\n\t\t\t\t\tif (interval === this.currentInterval) return;\n\t\t\t\t\tconsole.log(`Adaptive polling: ${interval > this.currentInterval ? 'slowing down' : 'speeding up'} to ${interval}ms (${reason})`);\n\t\t\t\t\tthis.currentInterval = interval;\n\n\t\t\t\t\t// Restart the timer with the new interval, unless SSE took over\n\t\t\t\t\tif (this.refreshInterval) {\n\t\t\t\t\t\tthis.stopAutoRefresh();\n\t\t\t\t\t\tthis.startAutoRefresh();\n\t\t\t\t\t}\n\t\t\t\t},\n\n\t\t\t\t// Clamps the current interval after the bounds changed in settings\n\t\t\t\tapplyAdaptiveBounds() {\n\t\t\t\t\tconst bounds = this.adaptiveBounds();\n\t\t\t\t\tthis.setPollingInterval(Math.min(Math.max(this.currentInterval, bounds.min), bounds.max), 'bounds changed');\n\t\t\t\t},\n\n\t\t\t\tisUserIdle() {\n\t\t\t\t\treturn Date.now() - this.lastUserActivity > this.idleAfter;\n\t\t\t\t},\n\n\t\t\t\ttrackUserActivity() {\n\t\t\t\t\tconst onActivity = () => {\n\t\t\t\t\t\tconst now = Date.now();\n\t\t\t\t\t\tif (now - this.lastUserActivity < 1000) return;\n\n\t\t\t\t\t\tconst wasIdle = this.isUserIdle();\n\t\t\t\t\t\tthis.lastUserActivity = now;\n\n\t\t\t\t\t\t// Catch up right away instead of waiting out a slowed-down poll\n\t\t\t\t\t\tconst bounds = this.adaptiveBounds();\n\t\t\t\t\t\tif (wasIdle && this.refreshInterval && this.currentInterval > bounds.base) {\n\t\t\t\t\t\t\tthis.setPollingInterval(bounds.base, 'user is back');\n\t\t\t\t\t\t\tthis.loadDashboardIncremental();\n\t\t\t\t\t\t}\n\t\t\t\t\t};\n\t\t\t\t\tfor (const event of ['mousemove', 'keydown', 'click', 'scroll', 'touchstart']) {\n\t\t\t\t\t\tdocument.addEventListener(event, onActivity, { passive: true });\n\t\t\t\t\t}\n\t\t\t\t},\n\t\t\t\t// Notification banner functions\n\t\t\t\tshouldShowNotificationBanner() {\n\t\t\t\t\t// Don't show if dismissed this session\n\t\t\t\t\tif (this.notificationBannerDismissed) return false;\n\n\t\t\t\t\t// Don't show if dismissed previously (scoped per user; falls back to the\n\t\t\t\t\t// unscoped key if currentUser hasn't loaded yet)\n\t\t\t\t\tconst bannerKey = (this.currentUser && this.currentUser.id)\n\t\t\t\t\t\t? 'notificator_banner_dismissed_' + this.currentUser.id\n\t\t\t\t\t\t: 'notificator_banner_dismissed';\n\t\t\t\t\tif (localStorage.getItem(bannerKey) === 'true') return false;\n\n\t\t\t\t\t// Don't show if notification service not loaded\n\t\t\t\t\tif (!window.notificationService) return false;\n\n\t\t\t\t\t// Show if either permission not granted OR preference not enabled\n\t\t\t\t\tconst permissionGranted = 'Notification' in window && Notification.permission === 'granted';\n\t\t\t\t\tconst preferenceEnabled = window.notificationService.preferences.browserNotificationsEnabled;\n\n\t\t\t\t\treturn !permissionGranted || !preferenceEnabled;\n\t\t\t\t},\n\n\t\t\t\tasync enableNotifications() {\n\t\t\t\t\tif (!window.notificationService) return;\n\n\t\t\t\t\t// Request permission if needed\n\t\t\t\t\tif (!('Notification' in window)) {\n\t\t\t\t\t\tconsole.warn('Browser does not support notifications');\n\t\t\t\t\t\treturn;\n\t\t\t\t\t}\n\n\t\t\t\t\tif (Notification.permission !== 'granted') {\n\t\t\t\t\t\tconst granted = await window.notificationService.requestPermission();\n\t\t\t\t\t\tif (!granted) {\n\t\t\t\t\t\t\tconsole.log('Notification permission denied');\n\t\t\t\t\t\t\treturn;\n\t\t\t\t\t\t}\n\t\t\t\t\t}\n\n\t\t\t\t\t// Enable and save preference\n\t\t\t\t\twindow.notificationService.preferences.browserNotificationsEnabled = true;\n\t\t\t\t\tawait window.notificationService.savePreferences(window.notificationService.preferences);\n\n\t\t\t\t\t// Update permission status in service\n\t\t\t\t\twindow.notificationService.permissionGranted = Notification.permission === 'granted';\n\n\t\t\t\t\tconsole.log('Notifications enabled successfully');\n\n\t\t\t\t\t// Auto-dismiss the banner since notifications are now enabled\n\t\t\t\t\tthis.dismissNotificationBanner();\n\t\t\t\t},\n\n\t\t\t\tdismissNotificationBanner() {\n\t\t\t\t\tthis.notificationBannerDismissed = true;\n\t\t\t\t\t// Save to localStorage, scoped per user (falls back to the unscoped\n\t\t\t\t\t// key if currentUser hasn't loaded yet)\n\t\t\t\t\tconst bannerKey = (this.currentUser && this.currentUser.id)\n\t\t\t\t\t\t? 'notificator_banner_dismissed_' + this.currentUser.id\n\t\t\t\t\t\t: 'notificator_banner_dismissed';\n\t\t\t\t\tlocalStorage.setItem(bannerKey, 'true');\n\t\t\t\t}\n\t\t\t}\n\t\t}\n\t</script>")
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
@@ -29,7 +29,7 @@ func DashboardData() templ.Component {
 			templ_7745c5c3_Var1 = templ.NopComponent
 		}
 		ctx = templ.ClearChildren(ctx)
-		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 1, "<script>\n\t\twindow.dashboardDataMixin = {\n\t\t\tasync loadDashboardData() {\n\t\t\t\tthis.loading = true;\n\t\t\t\t\n\t\t\t\ttry {\n\t\t\t\t\tconst params = new URLSearchParams();\n\t\t\t\t\t\n\t\t\t\t\tif (this.searchQuery) params.set('search', this.searchQuery);\n\t\t\t\t\tif (this.filters.alertmanagers.length > 0) params.set('alertmanagers', this.filters.alertmanagers.join(','));\n\t\t\t\t\tif (this.filters.severities.length > 0) params.set('severities', this.filters.severities.join(','));\n\t\t\t\t\tif (this.filters.statuses.length > 0) params.set('statuses', this.filters.statuses.join(','));\n\t\t\t\t\tif (this.filters.teams.length > 0) params.set('teams', this.filters.teams.join(','));\n\t\t\t\t\tif (this.filters.alertNames && this.filters.alertNames.length > 0) params.set('alertNames', this.filters.alertNames.join(','));\n\t\t\t\t\t\n\t\t\t\t\tparams.set('displayMode', this.displayMode);\n\t\t\t\t\tparams.set('viewMode', this.viewMode);\n\t\t\t\t\tparams.set('sortField', this.sortField);\n\t\t\t\t\tparams.set('sortDirection', this.sortDirection);\n\t\t\t\t\t\n\t\t\t\t\t// Add group-by parameter\n\t\t\t\t\tif (this.viewMode === 'group' && this.groupByLabel) {\n\t\t\t\t\t\tparams.set('groupBy', this.groupByLabel);\n\t\t\t\t\t}\n\t\t\t\t\t\n\t\t\t\t\t// Add pagination parameters\n\t\t\t\t\tparams.set('page', this.currentPage.toString());\n\t\t\t\t\tparams.set('limit', this.itemsPerPage.toString());\n\t\t\t\t\t\n\t\t\t\t\t\n\t\t\t\t\tif (this.settings.resolvedAlertsLimit && this.settings.resolvedAlertsLimit > 0) {\n\t\t\t\t\t\tparams.set('resolvedAlertsLimit', this.settings.resolvedAlertsLimit.toString());\n\t\t\t\t\t}\n\n\t\t\t\t\t// Add filter-specific hidden alerts (if a saved filter is active)\n\t\t\t\t\tif (this.filterHiddenAlerts && this.filterHiddenAlerts.length > 0) {\n\t\t\t\t\t\tparams.set('filterHiddenAlerts', JSON.stringify(this.filterHiddenAlerts));\n\t\t\t\t\t}\n\t\t\t\t\tif (this.filterHiddenRules && this.filterHiddenRules.length > 0) {\n\t\t\t\t\t\tparams.set('filterHiddenRules', JSON.stringify(this.filterHiddenRules));\n\t\t\t\t\t}\n\n\t\t\t\t\tconst response = await fetch(`/api/v1/dashboard/data?${params.toString()}`, {\n\t\t\t\t\t\tcredentials: 'include'\n\t\t\t\t\t});\n\n\t\t\t\t\t// Check for authentication errors and redirect if needed\n\t\t\t\t\tif (window.dashboardInstance && window.dashboardInstance.handleAuthError(response)) {\n\t\t\t\t\t\treturn;\n\t\t\t\t\t}\n\n\t\t\t\t\tconst result = await response.json();\n\t\t\t\t\t\n\t\t\t\t\tif (result.success) {\n\t\t\t\t\t\t// Apply colors first so the very first render is correctly colored.\n\t\t\t\t\t\t// The server embeds them in the response, removing the second\n\t\t\t\t\t\t// /alert-colors round-trip that caused the color-lag race.\n\t\t\t\t\t\tif (result.data.colors) {\n\t\t\t\t\t\t\tthis.alertColors = result.data.colors;\n\t\t\t\t\t\t}\n\t\t\t\t\t\tthis.alerts = result.data.alerts || [];\n\t\t\t\t\t\tthis.groups = result.data.groups || [];\n\t\t\t\t\t\tthis.metadata = result.data.metadata;\n\t\t\t\t\t\tthis.totalItems = result.data.metadata.totalCount || result.data.metadata.totalAlerts || 0;\n\t\t\t\t\t\tthis.settings = { ...this.settings, ...result.data.settings };\n\t\t\t\t\t\tthis.lastUpdateTime = Date.now();\n\n\t\t\t\t\t\t// Fallback only if the server didn't embed colors\n\t\t\t\t\t\tif (!result.data.colors) {\n\t\t\t\t\t\t\tawait this.loadAlertColors();\n\t\t\t\t\t\t}\n\n\t\t\t\t\t\t// Initialize notification service with seen alerts, only once per session\n\t\t\t\t\t\tif (window.notificationService && this.currentUser && !window.notificationService.seenAlertsInitialized) {\n\t\t\t\t\t\t\twindow.notificationService.initializeSeenAlerts(this.alerts, this.currentUser.id);\n\t\t\t\t\t\t}\n\n\t\t\t\t\t\tthis.updateURL();\n\t\t\t\t\t} else {\n\t\t\t\t\t\tconsole.error('Failed to load alerts: ' + result.error);\n\t\t\t\t\t}\n\t\t\t\t} catch (error) {\n\t\t\t\t\tconsole.error('Error loading dashboard data:', error);\n\t\t\t\t\tconsole.error('Failed to load dashboard data');\n\t\t\t\t} finally {\n\t\t\t\t\tthis.loading = false;\n\t\t\t\t}\n\t\t\t},\n\n\t\t\tasync loadDashboardIncremental() {\n\t\t\t\t// Skip incremental updates when in resolved mode (resolved view has its own data)\n\t\t\t\tif (this.displayMode === 'resolved') {\n\t\t\t\t\treturn;\n\t\t\t\t}\n\n\t\t\t\t// Don't show loading spinner for incremental updates\n\t\t\t\ttry {\n\t\t\t\t\tconst params = new URLSearchParams();\n\t\t\t\t\t\n\t\t\t\t\tif (this.searchQuery) params.set('search', this.searchQuery);\n\t\t\t\t\tif (this.filters.alertmanagers.length > 0) params.set('alertmanagers', this.filters.alertmanagers.join(','));\n\t\t\t\t\tif (this.filters.severities.length > 0) params.set('severities', this.filters.severities.join(','));\n\t\t\t\t\tif (this.filters.statuses.length > 0) params.set('statuses', this.filters.statuses.join(','));\n\t\t\t\t\tif (this.filters.teams.length > 0) params.set('teams', this.filters.teams.join(','));\n\t\t\t\t\tif (this.filters.alertNames && this.filters.alertNames.length > 0) params.set('alertNames', this.filters.alertNames.join(','));\n\t\t\t\t\t\n\t\t\t\t\tparams.set('displayMode', this.displayMode);\n\t\t\t\t\tparams.set('viewMode', this.viewMode);\n\t\t\t\t\tparams.set('sortField', this.sortField);\n\t\t\t\t\tparams.set('sortDirection', this.sortDirection);\n\t\t\t\t\t\n\t\t\t\t\t// Add group-by parameter\n\t\t\t\t\tif (this.viewMode === 'group' && this.groupByLabel) {\n\t\t\t\t\t\tparams.set('groupBy', this.groupByLabel);\n\t\t\t\t\t}\n\t\t\t\t\t\n\t\t\t\t\t// Add pagination parameters\n\t\t\t\t\tparams.set('page', this.currentPage.toString());\n\t\t\t\t\tparams.set('limit', this.itemsPerPage.toString());\n\t\t\t\t\t\n\t\t\t\t\t\n\t\t\t\t\tif (this.settings.resolvedAlertsLimit && this.settings.resolvedAlertsLimit > 0) {\n\t\t\t\t\t\tparams.set('resolvedAlertsLimit', this.settings.resolvedAlertsLimit.toString());\n\t\t\t\t\t}\n\n\t\t\t\t\t// Add filter-specific hidden alerts (if a saved filter is active)\n\t\t\t\t\tif (this.filterHiddenAlerts && this.filterHiddenAlerts.length > 0) {\n\t\t\t\t\t\tparams.set('filterHiddenAlerts', JSON.stringify(this.filterHiddenAlerts));\n\t\t\t\t\t}\n\t\t\t\t\tif (this.filterHiddenRules && this.filterHiddenRules.length > 0) {\n\t\t\t\t\t\tparams.set('filterHiddenRules', JSON.stringify(this.filterHiddenRules));\n\t\t\t\t\t}\n\n\t\t\t\t\tif (this.lastUpdateTime) {\n\t\t\t\t\t\tparams.set('lastUpdate', Math.floor(this.lastUpdateTime / 1000).toString());\n\t\t\t\t\t}\n\t\t\t\t\t\n\t\t\t\t\t// Prepare request body with client alert fingerprints\n\t\t\t\t\tconst clientAlerts = this.alerts.map(a => a.fingerprint);\n\t\t\t\t\t\n\t\t\t\t\tconst response = await fetch(`/api/v1/dashboard/incremental?${params.toString()}`, {\n\t\t\t\t\t\tmethod: 'POST',\n\t\t\t\t\t\theaders: {\n\t\t\t\t\t\t\t'Content-Type': 'application/json'\n\t\t\t\t\t\t},\n\t\t\t\t\t\tbody: JSON.stringify({ clientAlerts: clientAlerts }),\n\t\t\t\t\t\tcredentials: 'include'\n\t\t\t\t\t});\n\n\t\t\t\t\t// Check for authentication errors and redirect if needed\n\t\t\t\t\tif (window.dashboardInstance && window.dashboardInstance.handleAuthError(response)) {\n\t\t\t\t\t\treturn;\n\t\t\t\t\t}\n\n\t\t\t\t\tconst result = await response.json();\n\t\t\t\t\t\n\t\t\t\t\tif (result.success) {\n\t\t\t\t\t\tthis.applyIncrementalUpdate(result.data, 'poll');\n\t\t\t\t\t} else {\n\t\t\t\t\t\t// Fallback to full refresh if incremental fails\n\t\t\t\t\t\tconsole.warn('Incremental update failed, falling back to full refresh');\n\t\t\t\t\t\tawait this.loadDashboardData();\n\t\t\t\t\t}\n\t\t\t\t} catch (error) {\n\t\t\t\t\tconsole.error('Error loading incremental data:', error);\n\t\t\t\t\t// Fallback to full refresh on error\n\t\t\t\t\tawait this.loadDashboardData();\n\t\t\t\t}\n\t\t\t},\n\n\t\t\t// Load alert colors from user preferences\n\t\t\tasync loadAlertColors(force = false) {\n\t\t\t\t// Skip loading if colors are already loaded and not forcing refresh\n\t\t\t\tif (!force && Object.keys(this.alertColors).length > 0) {\n\t\t\t\t\treturn;\n\t\t\t\t}\n\n\t\t\t\t// Prevent concurrent requests - if already loading, skip\n\t\t\t\tif (this._loadingAlertColors) {\n\t\t\t\t\tconsole.log('Skipping alert colors load - request already in progress');\n\t\t\t\t\treturn;\n\t\t\t\t}\n\t\t\t\tthis._loadingAlertColors = true;\n\n\t\t\t\ttry {\n\t\t\t\t\tconsole.log('Loading alert colors...');\n\t\t\t\t\t\n\t\t\t\t\t// Build same URL parameters as dashboard data API\n\t\t\t\t\tconst params = new URLSearchParams();\n\t\t\t\t\t\n\t\t\t\t\tif (this.searchQuery) params.set('search', this.searchQuery);\n\t\t\t\t\tif (this.filters.alertmanagers.length > 0) params.set('alertmanagers', this.filters.alertmanagers.join(','));\n\t\t\t\t\tif (this.filters.severities.length > 0) params.set('severities', this.filters.severities.join(','));\n\t\t\t\t\tif (this.filters.statuses.length > 0) params.set('statuses', this.filters.statuses.join(','));\n\t\t\t\t\tif (this.filters.teams.length > 0) params.set('teams', this.filters.teams.join(','));\n\t\t\t\t\tif (this.filters.alertNames && this.filters.alertNames.length > 0) params.set('alertNames', this.filters.alertNames.join(','));\n\t\t\t\t\t\n\t\t\t\t\tparams.set('displayMode', this.displayMode);\n\t\t\t\t\tparams.set('viewMode', this.viewMode);\n\t\t\t\t\tparams.set('sortField', this.sortField);\n\t\t\t\t\tparams.set('sortDirection', this.sortDirection);\n\t\t\t\t\t\n\t\t\t\t\t// Add group-by parameter\n\t\t\t\t\tif (this.viewMode === 'group' && this.groupByLabel) {\n\t\t\t\t\t\tparams.set('groupBy', this.groupByLabel);\n\t\t\t\t\t}\n\t\t\t\t\t\n\t\t\t\t\t// Add pagination parameters\n\t\t\t\t\tparams.set('page', this.currentPage.toString());\n\t\t\t\t\tparams.set('limit', this.itemsPerPage.toString());\n\t\t\t\t\t\n\t\t\t\t\t\n\t\t\t\t\tif (this.settings.resolvedAlertsLimit && this.settings.resolvedAlertsLimit > 0) {\n\t\t\t\t\t\tparams.set('resolvedAlertsLimit', this.settings.resolvedAlertsLimit.toString());\n\t\t\t\t\t}\n\n\t\t\t\t\t// Add filter-specific hidden alerts (if a saved filter is active)\n\t\t\t\t\tif (this.filterHiddenAlerts && this.filterHiddenAlerts.length > 0) {\n\t\t\t\t\t\tparams.set('filterHiddenAlerts', JSON.stringify(this.filterHiddenAlerts));\n\t\t\t\t\t}\n\t\t\t\t\tif (this.filterHiddenRules && this.filterHiddenRules.length > 0) {\n\t\t\t\t\t\tparams.set('filterHiddenRules', JSON.stringify(this.filterHiddenRules));\n\t\t\t\t\t}\n\n\t\t\t\t\tconst response = await fetch(`/api/v1/dashboard/alert-colors?${params.toString()}`, {\n\t\t\t\t\t\tcredentials: 'include'\n\t\t\t\t\t});\n\n\t\t\t\t\t// Check for authentication errors and redirect if needed\n\t\t\t\t\tif (window.dashboardInstance && window.dashboardInstance.handleAuthError(response)) {\n\t\t\t\t\t\treturn;\n\t\t\t\t\t}\n\n\t\t\t\t\tconst result = await response.json();\n\t\t\t\t\t\n\t\t\t\t\tif (result.success) {\n\t\t\t\t\t\tthis.alertColors = result.data.colors || {};\n\t\t\t\t\t\tthis.alertColorsTimestamp = result.data.timestamp || Date.now();\n\t\t\t\t\t\tconsole.log(`Loaded colors for ${Object.keys(this.alertColors).length} alerts`);\n\t\t\t\t\t} else {\n\t\t\t\t\t\tconsole.warn('Failed to load alert colors:', result.error);\n\t\t\t\t\t}\n\t\t\t\t} catch (error) {\n\t\t\t\t\tconsole.error('Error loading alert colors:', error);\n\t\t\t\t} finally {\n\t\t\t\t\tthis._loadingAlertColors = false;\n\t\t\t\t}\n\t\t\t},\n\n\t\t\t// Fetch colors for only the pending changed alerts (SSE path) via the\n\t\t\t// bulk-colors endpoint, merging results into the existing color map.\n\t\t\t// Payload scales with changed alerts, not the full filtered set.\n\t\t\tasync loadBulkAlertColors() {\n\t\t\t\tconst pending = this._pendingColorAlerts || {};\n\t\t\t\tthis._pendingColorAlerts = {};\n\t\t\t\tconst alerts = Object.entries(pending).map(([fingerprint, labels]) => ({ fingerprint, labels }));\n\t\t\t\tif (alerts.length === 0) {\n\t\t\t\t\treturn;\n\t\t\t\t}\n\t\t\t\tif (alerts.length > 1000) {\n\t\t\t\t\t// Server caps bulk requests at 1000 alerts; churn this large is a\n\t\t\t\t\t// full refresh anyway\n\t\t\t\t\tawait this.loadAlertColors(true);\n\t\t\t\t\treturn;\n\t\t\t\t}\n\n\t\t\t\ttry {\n\t\t\t\t\tconst response = await fetch('/api/v1/dashboard/alerts/bulk-colors', {\n\t\t\t\t\t\tmethod: 'POST',\n\t\t\t\t\t\theaders: { 'Content-Type': 'application/json' },\n\t\t\t\t\t\tcredentials: 'include',\n\t\t\t\t\t\tbody: JSON.stringify({ alerts })\n\t\t\t\t\t});\n\n\t\t\t\t\tif (window.dashboardInstance && window.dashboardInstance.handleAuthError(response)) {\n\t\t\t\t\t\treturn;\n\t\t\t\t\t}\n\n\t\t\t\t\tconst result = await response.json();\n\n\t\t\t\t\tif (result.success && result.data.colors) {\n\t\t\t\t\t\tthis.alertColors = { ...this.alertColors, ...result.data.colors };\n\t\t\t\t\t\tthis.alertColorsTimestamp = result.data.timestamp || Date.now();\n\t\t\t\t\t\tconsole.log(`Loaded colors for ${alerts.length} changed alerts via bulk endpoint`);\n\t\t\t\t\t} else if (!result.success) {\n\t\t\t\t\t\tconsole.warn('Failed to load bulk alert colors:', result.error);\n\t\t\t\t\t\t// Re-queue the batch (without clobbering newer entries) so the\n\t\t\t\t\t\t// next debounced flush retries it\n\t\t\t\t\t\tthis._pendingColorAlerts = { ...pending, ...this._pendingColorAlerts };\n\t\t\t\t\t}\n\t\t\t\t} catch (error) {\n\t\t\t\t\tconsole.error('Error loading bulk alert colors:', error);\n\t\t\t\t\tthis._pendingColorAlerts = { ...pending, ...this._pendingColorAlerts };\n\t\t\t\t}\n\t\t\t},\n\n\t\t\t// Invalidate and reload alert colors when preferences change\n\t\t\tasync refreshAlertColors() {\n\t\t\t\tconsole.log('Refreshing alert colors due to preference changes...');\n\t\t\t\tawait this.loadAlertColors(true);\n\t\t\t\t// Trigger UI update by reassigning the object to ensure reactivity\n\t\t\t\tthis.alertColors = { ...this.alertColors };\n\t\t\t},\n\n\t\t\t// Apply incremental changes to the dashboard\n\t\t\t// source: 'sse' (Alertmanager-diff push, removedAlerts are genuinely resolved)\n\t\t\t//         or 'poll' (default; removedAlerts may just be filtered/silenced/paginated out)\n\t\t\tapplyIncrementalUpdate(update, source = 'poll') {\n\t\t\t\t// Track if this update has changes (for adaptive polling)\n\t\t\t\tconst hasChanges = (update.newAlerts?.length > 0 ||\n\t\t\t\t                    update.updatedAlerts?.length > 0 ||\n\t\t\t\t                    update.removedAlerts?.length > 0);\n\t\t\t\tif (hasChanges) {\n\t\t\t\t\tthis.recentChanges++;\n\t\t\t\t}\n\n\t\t\t\t// Create fingerprint maps for efficient lookups\n\t\t\t\tconst alertMap = new Map();\n\t\t\t\tthis.alerts.forEach((alert, index) => {\n\t\t\t\t\talertMap.set(alert.fingerprint, { alert, index });\n\t\t\t\t});\n\t\t\t\t\n\t\t\t\t// Track if we need to notify about new alerts\n\t\t\t\tconst oldAlerts = [...this.alerts];\n\t\t\t\t\n\t\t\t\t// Remove alerts that are no longer present\n\t\t\t\tif (update.removedAlerts && update.removedAlerts.length > 0) {\n\t\t\t\t\tthis.alerts = this.alerts.filter(alert =>\n\t\t\t\t\t\t!update.removedAlerts.includes(alert.fingerprint)\n\t\t\t\t\t);\n\t\t\t\t\t// Update selection to remove deleted alerts\n\t\t\t\t\tthis.selectedAlerts = this.selectedAlerts.filter(fingerprint =>\n\t\t\t\t\t\t!update.removedAlerts.includes(fingerprint)\n\t\t\t\t\t);\n\n\t\t\t\t\t// Prune color entries (and any pending color fetches) for removed\n\t\t\t\t\t// alerts so the maps stay bounded over long-lived SSE sessions\n\t\t\t\t\tupdate.removedAlerts.forEach(fingerprint => {\n\t\t\t\t\t\tdelete this.alertColors[fingerprint];\n\t\t\t\t\t\tif (this._pendingColorAlerts) {\n\t\t\t\t\t\t\tdelete this._pendingColorAlerts[fingerprint];\n\t\t\t\t\t\t}\n\t\t\t\t\t});\n\n\t\t\t\t\t// Only the SSE stream's removedAlerts reflect genuinely resolved alerts\n\t\t\t\t\t// (diffed against the live Alertmanager cache). The poll path's\n\t\t\t\t\t// removedAlerts also include alerts that were merely filtered/silenced/\n\t\t\t\t\t// acked/paginated out, so evicting the seen-set there would cause\n\t\t\t\t\t// still-firing alerts to re-notify spuriously.\n\t\t\t\t\tif (source === 'sse' && window.notificationService && this.currentUser) {\n\t\t\t\t\t\twindow.notificationService.forgetAlerts(update.removedAlerts, this.currentUser.id);\n\t\t\t\t\t}\n\t\t\t\t}\n\t\t\t\t\n\t\t\t\t// Update existing alerts (and remove those that no longer match filters)\n\t\t\t\tif (update.updatedAlerts && update.updatedAlerts.length > 0) {\n\t\t\t\t\tconst newAlertMap = new Map();\n\t\t\t\t\tthis.alerts.forEach((alert, index) => {\n\t\t\t\t\t\tnewAlertMap.set(alert.fingerprint, { alert, index });\n\t\t\t\t\t});\n\n\t\t\t\t\t// Track indices to remove (alerts that no longer match filters)\n\t\t\t\t\tconst indicesToRemove = [];\n\n\t\t\t\t\tupdate.updatedAlerts.forEach(updatedAlert => {\n\t\t\t\t\t\tconst existing = newAlertMap.get(updatedAlert.fingerprint);\n\t\t\t\t\t\tif (existing) {\n\t\t\t\t\t\t\t// Check if updated alert still matches current filters\n\t\t\t\t\t\t\tif (this.alertMatchesFilters(updatedAlert)) {\n\t\t\t\t\t\t\t\t// Update in place to maintain order\n\t\t\t\t\t\t\t\tthis.alerts[existing.index] = updatedAlert;\n\t\t\t\t\t\t\t} else {\n\t\t\t\t\t\t\t\t// Alert no longer matches filters (e.g., was silenced), mark for removal\n\t\t\t\t\t\t\t\tindicesToRemove.push(existing.index);\n\t\t\t\t\t\t\t\tconsole.log('Alert no longer matches filters, removing:', updatedAlert.alertName, 'status:', updatedAlert.status?.state);\n\t\t\t\t\t\t\t}\n\t\t\t\t\t\t}\n\t\t\t\t\t});\n\n\t\t\t\t\t// Remove alerts that no longer match filters (in reverse order to maintain indices)\n\t\t\t\t\tif (indicesToRemove.length > 0) {\n\t\t\t\t\t\tindicesToRemove.sort((a, b) => b - a); // Sort descending\n\t\t\t\t\t\tindicesToRemove.forEach(index => {\n\t\t\t\t\t\t\tthis.alerts.splice(index, 1);\n\t\t\t\t\t\t});\n\t\t\t\t\t\t// Also remove from selection\n\t\t\t\t\t\tconst removedFingerprints = update.updatedAlerts\n\t\t\t\t\t\t\t.filter((_, i) => indicesToRemove.includes(newAlertMap.get(update.updatedAlerts[i]?.fingerprint)?.index))\n\t\t\t\t\t\t\t.map(a => a.fingerprint);\n\t\t\t\t\t\tthis.selectedAlerts = this.selectedAlerts.filter(fp => !removedFingerprints.includes(fp));\n\t\t\t\t\t}\n\t\t\t\t}\n\t\t\t\t\n\t\t\t\t// Add new alerts (filter them first for SSE which sends unfiltered data)\n\t\t\t\tif (update.newAlerts && update.newAlerts.length > 0) {\n\t\t\t\t\tconst filteredNewAlerts = update.newAlerts.filter(alert => this.alertMatchesFilters(alert));\n\t\t\t\t\tif (filteredNewAlerts.length > 0) {\n\t\t\t\t\t\tthis.alerts.push(...filteredNewAlerts);\n\n\t\t\t\t\t\t// Sort after adding new alerts to maintain correct order\n\t\t\t\t\t\tthis.alerts = this.sortAlerts(this.alerts);\n\t\t\t\t\t}\n\t\t\t\t}\n\t\t\t\t\n\t\t\t\t// Update metadata and settings\n\t\t\t\tif (update.metadata) {\n\t\t\t\t\tthis.metadata = update.metadata;\n\t\t\t\t}\n\t\t\t\tif (update.settings) {\n\t\t\t\t\tthis.settings = { ...this.settings, ...update.settings };\n\t\t\t\t}\n\t\t\t\t\n\t\t\t\t// Update colors for new and updated alerts\n\t\t\t\tif (update.colors && Object.keys(update.colors).length > 0) {\n\t\t\t\t\t// Merge new colors with existing ones\n\t\t\t\t\tthis.alertColors = { ...this.alertColors, ...update.colors };\n\t\t\t\t\tthis.alertColorsTimestamp = Date.now();\n\t\t\t\t\tconsole.log(`Updated colors for ${Object.keys(update.colors).length} alerts from incremental update`);\n\t\t\t\t} else if (this.sseConnection && (update.newAlerts?.length > 0 || update.updatedAlerts?.length > 0)) {\n\t\t\t\t\t// SSE doesn't include colors (they're user-specific), so fetch them\n\t\t\t\t\t// for just the changed alerts via the bulk endpoint.\n\t\t\t\t\t// Debounce to prevent multiple rapid calls; pending alerts\n\t\t\t\t\t// accumulate across debounced updates so none are dropped.\n\t\t\t\t\tthis._pendingColorAlerts = this._pendingColorAlerts || {};\n\t\t\t\t\t[...(update.newAlerts || []), ...(update.updatedAlerts || [])].forEach(alert => {\n\t\t\t\t\t\tthis._pendingColorAlerts[alert.fingerprint] = alert.labels || {};\n\t\t\t\t\t});\n\t\t\t\t\tif (this._colorLoadTimeout) {\n\t\t\t\t\t\tclearTimeout(this._colorLoadTimeout);\n\t\t\t\t\t}\n\t\t\t\t\tthis._colorLoadTimeout = setTimeout(() => {\n\t\t\t\t\t\tthis.loadBulkAlertColors();\n\t\t\t\t\t}, 500);\n\t\t\t\t}\n\t\t\t\t\n\t\t\t\t// Update timestamp\n\t\t\t\tthis.lastUpdateTime = update.lastUpdateTime * 1000; // Convert to milliseconds\n\n\t\t\t\t// Process new alerts for notifications\n\t\t\t\tif (window.notificationService && this.currentUser) {\n\t\t\t\t\twindow.notificationService.processNewAlerts(this.alerts, this.filters, this.currentUser.id);\n\t\t\t\t}\n\n\t\t\t\t// Call adaptive refresh only when polling (not using SSE)\n\t\t\t\tif (!this.sseConnection && this.adaptiveRefresh) {\n\t\t\t\t\tthis.adaptiveRefresh();\n\t\t\t\t}\n\t\t\t},\n\n\t\t\t// Sort alerts based on current sorting configuration\n\t\t\tsortAlerts(alerts) {\n\t\t\t\treturn [...alerts].sort((a, b) => {\n\t\t\t\t\tlet aVal, bVal;\n\t\t\t\t\t\n\t\t\t\t\tswitch (this.sortField) {\n\t\t\t\t\t\tcase 'alertName':\n\t\t\t\t\t\t\taVal = a.alertName.toLowerCase();\n\t\t\t\t\t\t\tbVal = b.alertName.toLowerCase();\n\t\t\t\t\t\t\tbreak;\n\t\t\t\t\t\tcase 'severity':\n\t\t\t\t\t\t\tconst severityOrder = { 'critical': 4, 'critical-daytime': 3, 'warning': 2, 'info': 1 };\n\t\t\t\t\t\t\taVal = severityOrder[a.severity] || 0;\n\t\t\t\t\t\t\tbVal = severityOrder[b.severity] || 0;\n\t\t\t\t\t\t\tbreak;\n\t\t\t\t\t\tcase 'status':\n\t\t\t\t\t\t\taVal = ((typeof a.status === 'object' ? a.status?.state : a.status) || '').toLowerCase();\n\t\t\t\t\t\t\tbVal = ((typeof b.status === 'object' ? b.status?.state : b.status) || '').toLowerCase();\n\t\t\t\t\t\t\tbreak;\n\t\t\t\t\t\tcase 'instance':\n\t\t\t\t\t\t\taVal = (a.instance || '').toLowerCase();\n\t\t\t\t\t\t\tbVal = (b.instance || '').toLowerCase();\n\t\t\t\t\t\t\tbreak;\n\t\t\t\t\t\tcase 'team':\n\t\t\t\t\t\t\taVal = (a.labels.team || '').toLowerCase();\n\t\t\t\t\t\t\tbVal = (b.labels.team || '').toLowerCase();\n\t\t\t\t\t\t\tbreak;\n\t\t\t\t\t\tcase 'startsAt':\n\t\t\t\t\t\t\taVal = new Date(a.startsAt).getTime();\n\t\t\t\t\t\t\tbVal = new Date(b.startsAt).getTime();\n\t\t\t\t\t\t\tbreak;\n\t\t\t\t\t\tcase 'duration':\n\t\t\t\t\t\tdefault:\n\t\t\t\t\t\t\taVal = a.duration;\n\t\t\t\t\t\t\tbVal = b.duration;\n\t\t\t\t\t\t\tbreak;\n\t\t\t\t\t}\n\t\t\t\t\t\n\t\t\t\t\tif (this.sortDirection === 'asc') {\n\t\t\t\t\t\treturn aVal < bVal ? -1 : aVal > bVal ? 1 : 0;\n\t\t\t\t\t} else {\n\t\t\t\t\t\treturn aVal > bVal ? -1 : aVal < bVal ? 1 : 0;\n\t\t\t\t\t}\n\t\t\t\t});\n\t\t\t},\n\n\t\t\t// Check if an alert matches current filter settings\n\t\t\t// Used to filter SSE updates which arrive unfiltered\n\t\t\talertMatchesFilters(alert) {\n\t\t\t\t// Check alertmanager filter\n\t\t\t\tif (this.filters.alertmanagers && this.filters.alertmanagers.length > 0) {\n\t\t\t\t\tif (!this.filters.alertmanagers.includes(alert.source)) {\n\t\t\t\t\t\treturn false;\n\t\t\t\t\t}\n\t\t\t\t}\n\n\t\t\t\t// Check severity filter\n\t\t\t\tif (this.filters.severities && this.filters.severities.length > 0) {\n\t\t\t\t\tconst alertSeverity = (alert.severity || '').toLowerCase();\n\t\t\t\t\tconst matchesSeverity = this.filters.severities.some(s => s.toLowerCase() === alertSeverity);\n\t\t\t\t\tif (!matchesSeverity) {\n\t\t\t\t\t\treturn false;\n\t\t\t\t\t}\n\t\t\t\t}\n\n\t\t\t\t// Check status filter\n\t\t\t\tif (this.filters.statuses && this.filters.statuses.length > 0) {\n\t\t\t\t\tconst alertStatus = (alert.status?.state || alert.status || '').toLowerCase();\n\t\t\t\t\tconst matchesStatus = this.filters.statuses.some(s => s.toLowerCase() === alertStatus);\n\t\t\t\t\tif (!matchesStatus) {\n\t\t\t\t\t\treturn false;\n\t\t\t\t\t}\n\t\t\t\t}\n\n\t\t\t\t// Check team filter\n\t\t\t\tif (this.filters.teams && this.filters.teams.length > 0) {\n\t\t\t\t\tconst alertTeam = alert.team || alert.labels?.team || '';\n\t\t\t\t\tif (!this.filters.teams.includes(alertTeam)) {\n\t\t\t\t\t\treturn false;\n\t\t\t\t\t}\n\t\t\t\t}\n\n\t\t\t\t// Check alertName filter\n\t\t\t\tif (this.filters.alertNames && this.filters.alertNames.length > 0) {\n\t\t\t\t\tif (!this.filters.alertNames.includes(alert.alertName)) {\n\t\t\t\t\t\treturn false;\n\t\t\t\t\t}\n\t\t\t\t}\n\n\t\t\t\t// Check search query\n\t\t\t\tif (this.searchQuery && this.searchQuery.trim() !== '') {\n\t\t\t\t\tconst query = this.searchQuery.toLowerCase();\n\t\t\t\t\tconst searchableText = [\n\t\t\t\t\t\talert.alertName,\n\t\t\t\t\t\talert.summary,\n\t\t\t\t\t\talert.instance,\n\t\t\t\t\t\talert.team,\n\t\t\t\t\t\talert.source,\n\t\t\t\t\t\tJSON.stringify(alert.labels)\n\t\t\t\t\t].join(' ').toLowerCase();\n\n\t\t\t\t\tif (!searchableText.includes(query)) {\n\t\t\t\t\t\treturn false;\n\t\t\t\t\t}\n\t\t\t\t}\n\n\t\t\t\t// Check hidden-ness (global + filter-preset), mirroring the server's\n\t\t\t\t// applyDashboardFilters: hidden mode shows only hidden alerts, every\n\t\t\t\t// other mode drops them\n\t\t\t\t// Global rules serialize camelCase (labelKey/labelValue/isRegex/enabled),\n\t\t\t\t// unlike preset rules — normalize before reusing the matcher\n\t\t\t\tconst isGlobalHidden =\n\t\t\t\t\t(window.currentSettingsModal?.hiddenAlerts || []).some(hidden => hidden.fingerprint === alert.fingerprint) ||\n\t\t\t\t\t(window.currentSettingsModal?.hiddenRules || []).some(rule => this.alertMatchesHiddenRule(alert, {\n\t\t\t\t\t\tis_enabled: rule.enabled,\n\t\t\t\t\t\tlabel_key: rule.labelKey,\n\t\t\t\t\t\tlabel_value: rule.labelValue,\n\t\t\t\t\t\tis_regex: rule.isRegex\n\t\t\t\t\t}));\n\t\t\t\tconst isFilterHidden =\n\t\t\t\t\t(this.filterHiddenAlerts || []).some(hidden => hidden.fingerprint === alert.fingerprint) ||\n\t\t\t\t\t(this.filterHiddenRules || []).some(rule => this.alertMatchesHiddenRule(alert, rule));\n\t\t\t\tconst isHidden = isGlobalHidden || isFilterHidden;\n\n\t\t\t\tif (this.displayMode === 'hidden') {\n\t\t\t\t\tif (!isHidden) {\n\t\t\t\t\t\treturn false;\n\t\t\t\t\t}\n\t\t\t\t} else if (isHidden) {\n\t\t\t\t\treturn false;\n\t\t\t\t}\n\n\t\t\t\t// Check display mode - don't show resolved in classic mode\n\t\t\t\tif (this.displayMode === 'classic') {\n\t\t\t\t\tconst isResolved = alert.isResolved || (alert.status?.state || alert.status || '').toLowerCase() === 'resolved';\n\t\t\t\t\tif (isResolved) {\n\t\t\t\t\t\treturn false;\n\t\t\t\t\t}\n\t\t\t\t}\n\n\t\t\t\treturn true;\n\t\t\t},\n\n\t\t\t// Check if an alert matches a filter-preset hidden rule\n\t\t\t// Mirrors HiddenAlertsService.IsAlertHiddenByFilter on the server\n\t\t\talertMatchesHiddenRule(alert, rule) {\n\t\t\t\tif (!rule || !rule.is_enabled) return false;\n\n\t\t\t\tconst labelValue = alert.labels?.[rule.label_key];\n\t\t\t\tif (labelValue === undefined) return false;\n\n\t\t\t\tif (rule.is_regex) {\n\t\t\t\t\t// Server only compiles regexes with a non-empty value\n\t\t\t\t\t// (CompileFilterRules); new RegExp('') would match everything\n\t\t\t\t\tif (rule.label_value === '') return false;\n\t\t\t\t\ttry {\n\t\t\t\t\t\treturn new RegExp(rule.label_value).test(labelValue);\n\t\t\t\t\t} catch (e) {\n\t\t\t\t\t\t// Invalid user-supplied regex must not break the SSE merge\n\t\t\t\t\t\treturn false;\n\t\t\t\t\t}\n\t\t\t\t}\n\t\t\t\t// Exact match or empty value (match all alerts carrying the label)\n\t\t\t\treturn rule.label_value === '' || rule.label_value === labelValue;\n\t\t\t}\n\t\t};\n\t</script>")
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 1, "<script>\n\t\twindow.dashboardDataMixin = {\n\t\t\tasync loadDashboardData() {\n\t\t\t\tthis.loading = true;\n\t\t\t\t\n\t\t\t\ttry {\n\t\t\t\t\tconst params = new URLSearchParams();\n\t\t\t\t\t\n\t\t\t\t\tif (this.searchQuery) params.set('search', this.searchQuery);\n\t\t\t\t\tif (this.filters.alertmanagers.length > 0) params.set('alertmanagers', this.filters.alertmanagers.join(','));\n\t\t\t\t\tif (this.filters.severities.length > 0) params.set('severities', this.filters.severities.join(','));\n\t\t\t\t\tif (this.filters.statuses.length > 0) params.set('statuses', this.filters.statuses.join(','));\n\t\t\t\t\tif (this.filters.teams.length > 0) params.set('teams', this.filters.teams.join(','));\n\t\t\t\t\tif (this.filters.alertNames && this.filters.alertNames.length > 0) params.set('alertNames', this.filters.alertNames.join(','));\n\t\t\t\t\t\n\t\t\t\t\tparams.set('displayMode', this.displayMode);\n\t\t\t\t\tparams.set('viewMode', this.viewMode);\n\t\t\t\t\tparams.set('sortField', this.sortField);\n\t\t\t\t\tparams.set('sortDirection', this.sortDirection);\n\t\t\t\t\tif (this.sortThen.length > 0) params.set('sortThen', this.sortThenParam());\n\t\t\t\t\t\n\t\t\t\t\t// Add group-by parameter\n\t\t\t\t\tif (this.viewMode === 'group' && this.groupByLabel) {\n\t\t\t\t\t\tparams.set('groupBy', this.groupByLabel);\n\t\t\t\t\t}\n\t\t\t\t\t\n\t\t\t\t\t// Add pagination parameters\n\t\t\t\t\tparams.set('page', this.currentPage.toString());\n\t\t\t\t\tparams.set('limit', this.itemsPerPage.toString());\n\t\t\t\t\t\n\t\t\t\t\t\n\t\t\t\t\tif (this.settings.resolvedAlertsLimit && this.settings.resolvedAlertsLimit > 0) {\n\t\t\t\t\t\tparams.set('resolvedAlertsLimit', this.settings.resolvedAlertsLimit.toString());\n\t\t\t\t\t}\n\n\t\t\t\t\t// Add filter-specific hidden alerts (if a saved filter is active)\n\t\t\t\t\tif (this.filterHiddenAlerts && this.filterHiddenAlerts.length > 0) {\n\t\t\t\t\t\tparams.set('filterHiddenAlerts', JSON.stringify(this.filterHiddenAlerts));\n\t\t\t\t\t}\n\t\t\t\t\tif (this.filterHiddenRules && this.filterHiddenRules.length > 0) {\n\t\t\t\t\t\tparams.set('filterHiddenRules', JSON.stringify(this.filterHiddenRules));\n\t\t\t\t\t}\n\n\t\t\t\t\tconst response = await fetch(`/api/v1/dashboard/data?${params.toString()}`, {\n\t\t\t\t\t\tcredentials: 'include'\n\t\t\t\t\t});\n\n\t\t\t\t\t// Check for authentication errors and redirect if needed\n\t\t\t\t\tif (window.dashboardInstance && window.dashboardInstance.handleAuthError(response)) {\n\t\t\t\t\t\treturn;\n\t\t\t\t\t}\n\n\t\t\t\t\tconst result = await response.json();\n\t\t\t\t\t\n\t\t\t\t\tif (result.success) {\n\t\t\t\t\t\t// Apply colors first so the very first render is correctly colored.\n\t\t\t\t\t\t// The server embeds them in the response, removing the second\n\t\t\t\t\t\t// /alert-colors round-trip that caused the color-lag race.\n\t\t\t\t\t\tif (result.data.colors) {\n\t\t\t\t\t\t\tthis.alertColors = result.data.colors;\n\t\t\t\t\t\t}\n\t\t\t\t\t\tthis.alerts = result.data.alerts || [];\n\t\t\t\t\t\tthis.groups = result.data.groups || [];\n\t\t\t\t\t\tthis.metadata = result.data.metadata;\n\t\t\t\t\t\tthis.totalItems = result.data.metadata.totalCount || result.data.metadata.totalAlerts || 0;\n\t\t\t\t\t\tthis.settings = { ...this.settings, ...result.data.settings };\n\t\t\t\t\t\tthis.lastUpdateTime = Date.now();\n\n\t\t\t\t\t\t// Fallback only if the server didn't embed colors\n\t\t\t\t\t\tif (!result.data.colors) {\n\t\t\t\t\t\t\tawait this.loadAlertColors();\n\t\t\t\t\t\t}\n\n\t\t\t\t\t\t// Initialize notification service with seen alerts, only once per session\n\t\t\t\t\t\tif (window.notificationService && this.currentUser && !window.notificationService.seenAlertsInitialized) {\n\t\t\t\t\t\t\twindow.notificationService.initializeSeenAlerts(this.alerts, this.currentUser.id);\n\t\t\t\t\t\t}\n\n\t\t\t\t\t\tthis.updateURL();\n\t\t\t\t\t} else {\n\t\t\t\t\t\tconsole.error('Failed to load alerts: ' + result.error);\n\t\t\t\t\t}\n\t\t\t\t} catch (error) {\n\t\t\t\t\tconsole.error('Error loading dashboard data:', error);\n\t\t\t\t\tconsole.error('Failed to load dashboard data');\n\t\t\t\t} finally {\n\t\t\t\t\tthis.loading = false;\n\t\t\t\t}\n\t\t\t},\n\n\t\t\t// Force Refresh: has the server re-fetch every Alertmanager now, then\n\t\t\t// rebuilds the view from a full load instead of merging a diff, so\n\t\t\t// changes the incremental path missed show up. Notifications run again\n\t\t\t// for the reloaded alerts; ones already notified stay quiet.\n\t\t\tasync forceRefresh() {\n\t\t\t\tif (this.forceRefreshing || this.displayMode === 'resolved') return;\n\t\t\t\tthis.forceRefreshing = true;\n\n\t\t\t\ttry {\n\t\t\t\t\tconst response = await fetch('/api/v1/dashboard/refresh', {\n\t\t\t\t\t\tmethod: 'POST',\n\t\t\t\t\t\tcredentials: 'include'\n\t\t\t\t\t});\n\t\t\t\t\tif (window.dashboardInstance && window.dashboardInstance.handleAuthError(response)) {\n\t\t\t\t\t\treturn;\n\t\t\t\t\t}\n\n\t\t\t\t\tthis.lastUpdateTime = null;\n\t\t\t\t\tawait this.loadDashboardData();\n\n\t\t\t\t\tif (window.notificationService && this.currentUser) {\n\t\t\t\t\t\twindow.notificationService.processNewAlerts(this.alerts, this.filters, this.currentUser.id);\n\t\t\t\t\t}\n\t\t\t\t} catch (error) {\n\t\t\t\t\tconsole.error('Error forcing a refresh:', error);\n\t\t\t\t} finally {\n\t\t\t\t\tthis.forceRefreshing = false;\n\t\t\t\t}\n\t\t\t},\n\n\t\t\tasync loadDashboardIncremental() {\n\t\t\t\t// Skip incremental updates when in resolved mode (resolved view has its own data)\n\t\t\t\tif (this.displayMode === 'resolved') {\n\t\t\t\t\treturn;\n\t\t\t\t}\n\n\t\t\t\t// Don't show loading spinner for incremental updates\n\t\t\t\ttry {\n\t\t\t\t\tconst params = new URLSearchParams();\n\t\t\t\t\t\n\t\t\t\t\tif (this.searchQuery) params.set('search', this.searchQuery);\n\t\t\t\t\tif (this.filters.alertmanagers.length > 0) params.set('alertmanagers', this.filters.alertmanagers.join(','));\n\t\t\t\t\tif (this.filters.severities.length > 0) params.set('severities', this.filters.severities.join(','));\n\t\t\t\t\tif (this.filters.statuses.length > 0) params.set('statuses', this.filters.statuses.join(','));\n\t\t\t\t\tif (this.filters.teams.length > 0) params.set('teams', this.filters.teams.join(','));\n\t\t\t\t\tif (this.filters.alertNames && this.filters.alertNames.length > 0) params.set('alertNames', this.filters.alertNames.join(','));\n\t\t\t\t\t\n\t\t\t\t\tparams.set('displayMode', this.displayMode);\n\t\t\t\t\tparams.set('viewMode', this.viewMode);\n\t\t\t\t\tparams.set('sortField', this.sortField);\n\t\t\t\t\tparams.set('sortDirection', this.sortDirection);\n\t\t\t\t\tif (this.sortThen.length > 0) params.set('sortThen', this.sortThenParam());\n\t\t\t\t\t\n\t\t\t\t\t// Add group-by parameter\n\t\t\t\t\tif (this.viewMode === 'group' && this.groupByLabel) {\n\t\t\t\t\t\tparams.set('groupBy', this.groupByLabel);\n\t\t\t\t\t}\n\t\t\t\t\t\n\t\t\t\t\t// Add pagination parameters\n\t\t\t\t\tparams.set('page', this.currentPage.toString());\n\t\t\t\t\tparams.set('limit', this.itemsPerPage.toString());\n\t\t\t\t\t\n\t\t\t\t\t\n\t\t\t\t\tif (this.settings.resolvedAlertsLimit && this.settings.resolvedAlertsLimit > 0) {\n\t\t\t\t\t\tparams.set('resolvedAlertsLimit', this.settings.resolvedAlertsLimit.toString());\n\t\t\t\t\t}\n\n\t\t\t\t\t// Add filter-specific hidden alerts (if a saved filter is active)\n\t\t\t\t\tif (this.filterHiddenAlerts && this.filterHiddenAlerts.length > 0) {\n\t\t\t\t\t\tparams.set('filterHiddenAlerts', JSON.stringify(this.filterHiddenAlerts));\n\t\t\t\t\t}\n\t\t\t\t\tif (this.filterHiddenRules && this.filterHiddenRules.length > 0) {\n\t\t\t\t\t\tparams.set('filterHiddenRules', JSON.stringify(this.filterHiddenRules));\n\t\t\t\t\t}\n\n\t\t\t\t\tif (this.lastUpdateTime) {\n\t\t\t\t\t\tparams.set('lastUpdate', Math.floor(this.lastUpdateTime / 1000).toString());\n\t\t\t\t\t}\n\t\t\t\t\t\n\t\t\t\t\t// Prepare request body with client alert fingerprints\n\t\t\t\t\tconst clientAlerts = this.alerts.map(a => a.fingerprint);\n\t\t\t\t\t\n\t\t\t\t\tconst response = await fetch(`/api/v1/dashboard/incremental?${params.toString()}`, {\n\t\t\t\t\t\tmethod: 'POST',\n\t\t\t\t\t\theaders: {\n\t\t\t\t\t\t\t'Content-Type': 'application/json'\n\t\t\t\t\t\t},\n\t\t\t\t\t\tbody: JSON.stringify({ clientAlerts: clientAlerts }),\n\t\t\t\t\t\tcredentials: 'include'\n\t\t\t\t\t});\n\n\t\t\t\t\t// Check for authentication errors and redirect if needed\n\t\t\t\t\tif (window.dashboardInstance && window.dashboardInstance.handleAuthError(response)) {\n\t\t\t\t\t\treturn;\n\t\t\t\t\t}\n\n\t\t\t\t\tconst result = await response.json();\n\t\t\t\t\t\n\t\t\t\t\tif (result.success) {\n\t\t\t\t\t\tthis.applyIncrementalUpdate(result.data, 'poll');\n\t\t\t\t\t} else {\n\t\t\t\t\t\t// Fallback to full refresh if incremental fails\n\t\t\t\t\t\tconsole.warn('Incremental update failed, falling back to full refresh');\n\t\t\t\t\t\tawait this.loadDashboardData();\n\t\t\t\t\t}\n\t\t\t\t} catch (error) {\n\t\t\t\t\tconsole.error('Error loading incremental data:', error);\n\t\t\t\t\t// Fallback to full refresh on error\n\t\t\t\t\tawait this.loadDashboardData();\n\t\t\t\t}\n\t\t\t},\n\n\t\t\t// Load alert colors from user preferences\n\t\t\tasync loadAlertColors(force = false) {\n\t\t\t\t// Skip loading if colors are already loaded and not forcing refresh\n\t\t\t\tif (!force && Object.keys(this.alertColors).length > 0) {\n\t\t\t\t\treturn;\n\t\t\t\t}\n\n\t\t\t\t// Prevent concurrent requests - if already loading, skip\n\t\t\t\tif (this._loadingAlertColors) {\n\t\t\t\t\tconsole.log('Skipping alert colors load - request already in progress');\n\t\t\t\t\treturn;\n\t\t\t\t}\n\t\t\t\tthis._loadingAlertColors = true;\n\n\t\t\t\ttry {\n\t\t\t\t\tconsole.log('Loading alert colors...');\n\t\t\t\t\t\n\t\t\t\t\t// Build same URL parameters as dashboard data API\n\t\t\t\t\tconst params = new URLSearchParams();\n\t\t\t\t\t\n\t\t\t\t\tif (this.searchQuery) params.set('search', this.searchQuery);\n\t\t\t\t\tif (this.filters.alertmanagers.length > 0) params.set('alertmanagers', this.filters.alertmanagers.join(','));\n\t\t\t\t\tif (this.filters.severities.length > 0) params.set('severities', this.filters.severities.join(','));\n\t\t\t\t\tif (this.filters.statuses.length > 0) params.set('statuses', this.filters.statuses.join(','));\n\t\t\t\t\tif (this.filters.teams.length > 0) params.set('teams', this.filters.teams.join(','));\n\t\t\t\t\tif (this.filters.alertNames && this.filters.alertNames.length > 0) params.set('alertNames', this.filters.alertNames.join(','));\n\t\t\t\t\t\n\t\t\t\t\tparams.set('displayMode', this.displayMode);\n\t\t\t\t\tparams.set('viewMode', this.viewMode);\n\t\t\t\t\tparams.set('sortField', this.sortField);\n\t\t\t\t\tparams.set('sortDirection', this.sortDirection);\n\t\t\t\t\tif (this.sortThen.length > 0) params.set('sortThen', this.sortThenParam());\n\t\t\t\t\t\n\t\t\t\t\t// Add group-by parameter\n\t\t\t\t\tif (this.viewMode === 'group' && this.groupByLabel) {\n\t\t\t\t\t\tparams.set('groupBy', this.groupByLabel);\n\t\t\t\t\t}\n\t\t\t\t\t\n\t\t\t\t\t// Add pagination parameters\n\t\t\t\t\tparams.set('page', this.currentPage.toString());\n\t\t\t\t\tparams.set('limit', this.itemsPerPage.toString());\n\t\t\t\t\t\n\t\t\t\t\t\n\t\t\t\t\tif (this.settings.resolvedAlertsLimit && this.settings.resolvedAlertsLimit > 0) {\n\t\t\t\t\t\tparams.set('resolvedAlertsLimit', this.settings.resolvedAlertsLimit.toString());\n\t\t\t\t\t}\n\n\t\t\t\t\t// Add filter-specific hidden alerts (if a saved filter is active)\n\t\t\t\t\tif (this.filterHiddenAlerts && this.filterHiddenAlerts.length > 0) {\n\t\t\t\t\t\tparams.set('filterHiddenAlerts', JSON.stringify(this.filterHiddenAlerts));\n\t\t\t\t\t}\n\t\t\t\t\tif (this.filterHiddenRules && this.filterHiddenRules.length > 0) {\n\t\t\t\t\t\tparams.set('filterHiddenRules', JSON.stringify(this.filterHiddenRules));\n\t\t\t\t\t}\n\n\t\t\t\t\tconst response = await fetch(`/api/v1/dashboard/alert-colors?${params.toString()}`, {\n\t\t\t\t\t\tcredentials: 'include'\n\t\t\t\t\t});\n\n\t\t\t\t\t// Check for authentication errors and redirect if needed\n\t\t\t\t\tif (window.dashboardInstance && window.dashboardInstance.handleAuthError(response)) {\n\t\t\t\t\t\treturn;\n\t\t\t\t\t}\n\n\t\t\t\t\tconst result = await response.json();\n\t\t\t\t\t\n\t\t\t\t\tif (result.success) {\n\t\t\t\t\t\tthis.alertColors = result.data.colors || {};\n\t\t\t\t\t\tthis.alertColorsTimestamp = result.data.timestamp || Date.now();\n\t\t\t\t\t\tconsole.log(`Loaded colors for ${Object.keys(this.alertColors).length} alerts`);\n\t\t\t\t\t} else {\n\t\t\t\t\t\tconsole.warn('Failed to load alert colors:', result.error);\n\t\t\t\t\t}\n\t\t\t\t} catch (error) {\n\t\t\t\t\tconsole.error('Error loading alert colors:', error);\n\t\t\t\t} finally {\n\t\t\t\t\tthis._loadingAlertColors = false;\n\t\t\t\t}\n\t\t\t},\n\n\t\t\t// Fetch colors for only the pending changed alerts (SSE path) via the\n\t\t\t// bulk-colors endpoint, merging results into the existing color map.\n\t\t\t// Payload scales with changed alerts, not the full filtered set.\n\t\t\tasync loadBulkAlertColors() {\n\t\t\t\tconst pending = this._pendingColorAlerts || {};\n\t\t\t\tthis._pendingColorAlerts = {};\n\t\t\t\tconst alerts = Object.entries(pending).map(([fingerprint, labels]) => ({ fingerprint, labels }));\n\t\t\t\tif (alerts.length === 0) {\n\t\t\t\t\treturn;\n\t\t\t\t}\n\t\t\t\tif (alerts.length > 1000) {\n\t\t\t\t\t// Server caps bulk requests at 1000 alerts; churn this large is a\n\t\t\t\t\t// full refresh anyway\n\t\t\t\t\tawait this.loadAlertColors(true);\n\t\t\t\t\treturn;\n\t\t\t\t}\n\n\t\t\t\ttry {\n\t\t\t\t\tconst response = await fetch('/api/v1/dashboard/alerts/bulk-colors', {\n\t\t\t\t\t\tmethod: 'POST',\n\t\t\t\t\t\theaders: { 'Content-Type': 'application/json' },\n\t\t\t\t\t\tcredentials: 'include',\n\t\t\t\t\t\tbody: JSON.stringify({ alerts })\n\t\t\t\t\t});\n\n\t\t\t\t\tif (window.dashboardInstance && window.dashboardInstance.handleAuthError(response)) {\n\t\t\t\t\t\treturn;\n\t\t\t\t\t}\n\n\t\t\t\t\tconst result = await response.json();\n\n\t\t\t\t\tif (result.success && result.data.colors) {\n\t\t\t\t\t\tthis.alertColors = { ...this.alertColors, ...result.data.colors };\n\t\t\t\t\t\tthis.alertColorsTimestamp = result.data.timestamp || Date.now();\n\t\t\t\t\t\tconsole.log(`Loaded colors for ${alerts.length} changed alerts via bulk endpoint`);\n\t\t\t\t\t} else if (!result.success) {\n\t\t\t\t\t\tconsole.warn('Failed to load bulk alert colors:', result.error);\n\t\t\t\t\t\t// Re-queue the batch (without clobbering newer entries) so the\n\t\t\t\t\t\t// next debounced flush retries it\n\t\t\t\t\t\tthis._pendingColorAlerts = { ...pending, ...this._pendingColorAlerts };\n\t\t\t\t\t}\n\t\t\t\t} catch (error) {\n\t\t\t\t\tconsole.error('Error loading bulk alert colors:', error);\n\t\t\t\t\tthis._pendingColorAlerts = { ...pending, ...this._pendingColorAlerts };\n\t\t\t\t}\n\t\t\t},\n\n\t\t\t// Invalidate and reload alert colors when preferences change\n\t\t\tasync refreshAlertColors() {\n\t\t\t\tconsole.log('Refreshing alert colors due to preference changes...');\n\t\t\t\tawait this.loadAlertColors(true);\n\t\t\t\t// Trigger UI update by reassigning the object to ensure reactivity\n\t\t\t\tthis.alertColors = { ...this.alertColors };\n\t\t\t},\n\n\t\t\t// Apply incremental changes to the dashboard\n\t\t\t// source: 'sse' (Alertmanager-diff push, removedAlerts are genuinely resolved)\n\t\t\t//         or 'poll' (default; removedAlerts may just be filtered/silenced/paginated out)\n\t\t\tapplyIncrementalUpdate(update, source = 'poll') {\n\t\t\t\t// Track if this update has changes (for adaptive polling)\n\t\t\t\tconst hasChanges = (update.newAlerts?.length > 0 ||\n\t\t\t\t                    update.updatedAlerts?.length > 0 ||\n\t\t\t\t                    update.removedAlerts?.length > 0);\n\t\t\t\tif (hasChanges) {\n\t\t\t\t\tthis.recentChanges++;\n\t\t\t\t}\n\n\t\t\t\t// Create fingerprint maps for efficient lookups\n\t\t\t\tconst alertMap = new Map();\n\t\t\t\tthis.alerts.forEach((alert, index) => {\n\t\t\t\t\talertMap.set(alert.fingerprint, { alert, index });\n\t\t\t\t});\n\t\t\t\t\n\t\t\t\t// Track if we need to notify about new alerts\n\t\t\t\tconst oldAlerts = [...this.alerts];\n\t\t\t\t\n\t\t\t\t// Remove alerts that are no longer present\n\t\t\t\tif (update.removedAlerts && update.removedAlerts.length > 0) {\n\t\t\t\t\tthis.alerts = this.alerts.filter(alert =>\n\t\t\t\t\t\t!update.removedAlerts.includes(alert.fingerprint)\n\t\t\t\t\t);\n\t\t\t\t\t// Update selection to remove deleted alerts\n\t\t\t\t\tthis.selectedAlerts = this.selectedAlerts.filter(fingerprint =>\n\t\t\t\t\t\t!update.removedAlerts.includes(fingerprint)\n\t\t\t\t\t);\n\n\t\t\t\t\t// Prune color entries (and any pending color fetches) for removed\n\t\t\t\t\t// alerts so the maps stay bounded over long-lived SSE sessions\n\t\t\t\t\tupdate.removedAlerts.forEach(fingerprint => {\n\t\t\t\t\t\tdelete this.alertColors[fingerprint];\n\t\t\t\t\t\tif (this._pendingColorAlerts) {\n\t\t\t\t\t\t\tdelete this._pendingColorAlerts[fingerprint];\n\t\t\t\t\t\t}\n\t\t\t\t\t});\n\n\t\t\t\t\t// Only the SSE stream's removedAlerts reflect genuinely resolved alerts\n\t\t\t\t\t// (diffed against the live Alertmanager cache). The poll path's\n\t\t\t\t\t// removedAlerts also include alerts that were merely filtered/silenced/\n\t\t\t\t\t// acked/paginated out, so evicting the seen-set there would cause\n\t\t\t\t\t// still-firing alerts to re-notify spuriously.\n\t\t\t\t\tif (source === 'sse' && window.notificationService && this.currentUser) {\n\t\t\t\t\t\twindow.notificationService.forgetAlerts(update.removedAlerts, this.currentUser.id);\n\t\t\t\t\t}\n\t\t\t\t}\n\t\t\t\t\n\t\t\t\t// Update existing alerts (and remove those that no longer match filters)\n\t\t\t\tif (update.updatedAlerts && update.updatedAlerts.length > 0) {\n\t\t\t\t\tconst newAlertMap = new Map();\n\t\t\t\t\tthis.alerts.forEach((alert, index) => {\n\t\t\t\t\t\tnewAlertMap.set(alert.fingerprint, { alert, index });\n\t\t\t\t\t});\n\n\t\t\t\t\t// Track indices to remove (alerts that no longer match filters)\n\t\t\t\t\tconst indicesToRemove = [];\n\n\t\t\t\t\tupdate.updatedAlerts.forEach(updatedAlert => {\n\t\t\t\t\t\tconst existing = newAlertMap.get(updatedAlert.fingerprint);\n\t\t\t\t\t\tif (existing) {\n\t\t\t\t\t\t\t// Check if updated alert still matches current filters\n\t\t\t\t\t\t\tif (this.alertMatchesFilters(updatedAlert)) {\n\t\t\t\t\t\t\t\t// Update in place to maintain order\n\t\t\t\t\t\t\t\tthis.alerts[existing.index] = updatedAlert;\n\t\t\t\t\t\t\t} else {\n\t\t\t\t\t\t\t\t// Alert no longer matches filters (e.g., was silenced), mark for removal\n\t\t\t\t\t\t\t\tindicesToRemove.push(existing.index);\n\t\t\t\t\t\t\t\tconsole.log('Alert no longer matches filters, removing:', updatedAlert.alertName, 'status:', updatedAlert.status?.state);\n\t\t\t\t\t\t\t}\n\t\t\t\t\t\t}\n\t\t\t\t\t});\n\n\t\t\t\t\t// Remove alerts that no longer match filters (in reverse order to maintain indices)\n\t\t\t\t\tif (indicesToRemove.length > 0) {\n\t\t\t\t\t\tindicesToRemove.sort((a, b) => b - a); // Sort descending\n\t\t\t\t\t\tindicesToRemove.forEach(index => {\n\t\t\t\t\t\t\tthis.alerts.splice(index, 1);\n\t\t\t\t\t\t});\n\t\t\t\t\t\t// Also remove from selection\n\t\t\t\t\t\tconst removedFingerprints = update.updatedAlerts\n\t\t\t\t\t\t\t.filter((_, i) => indicesToRemove.includes(newAlertMap.get(update.updatedAlerts[i]?.fingerprint)?.index))\n\t\t\t\t\t\t\t.map(a => a.fingerprint);\n\t\t\t\t\t\tthis.selectedAlerts = this.selectedAlerts.filter(fp => !removedFingerprints.includes(fp));\n\t\t\t\t\t}\n\t\t\t\t}\n\t\t\t\t\n\t\t\t\t// Add new alerts (filter them first for SSE which sends unfiltered data)\n\t\t\t\tif (update.newAlerts && update.newAlerts.length > 0) {\n\t\t\t\t\tconst filteredNewAlerts = update.newAlerts.filter(alert => this.alertMatchesFilters(alert));\n\t\t\t\t\tif (filteredNewAlerts.length > 0) {\n\t\t\t\t\t\tthis.alerts.push(...filteredNewAlerts);\n\n\t\t\t\t\t\t// Sort after adding new alerts to maintain correct order\n\t\t\t\t\t\tthis.alerts = this.sortAlerts(this.alerts);\n\t\t\t\t\t}\n\t\t\t\t}\n\t\t\t\t\n\t\t\t\t// Update metadata and settings\n\t\t\t\tif (update.metadata) {\n\t\t\t\t\tthis.metadata = update.metadata;\n\t\t\t\t}\n\t\t\t\tif (update.settings) {\n\t\t\t\t\tthis.settings = { ...this.settings, ...update.settings };\n\t\t\t\t}\n\t\t\t\t\n\t\t\t\t// Update colors for new and updated alerts\n\t\t\t\tif (update.colors && Object.keys(update.colors).length > 0) {\n\t\t\t\t\t// Merge new colors with existing ones\n\t\t\t\t\tthis.alertColors = { ...this.alertColors, ...update.colors };\n\t\t\t\t\tthis.alertColorsTimestamp = Date.now();\n\t\t\t\t\tconsole.log(`Updated colors for ${Object.keys(update.colors).length} alerts from incremental update`);\n\t\t\t\t} else if (this.sseConnection && (update.newAlerts?.length > 0 || update.updatedAlerts?.length > 0)) {\n\t\t\t\t\t// SSE doesn't include colors (they're user-specific), so fetch them\n\t\t\t\t\t// for just the changed alerts via the bulk endpoint.\n\t\t\t\t\t// Debounce to prevent multiple rapid calls; pending alerts\n\t\t\t\t\t// accumulate across debounced updates so none are dropped.\n\t\t\t\t\tthis._pendingColorAlerts = this._pendingColorAlerts || {};\n\t\t\t\t\t[...(update.newAlerts || []), ...(update.updatedAlerts || [])].forEach(alert => {\n\t\t\t\t\t\tthis._pendingColorAlerts[alert.fingerprint] = alert.labels || {};\n\t\t\t\t\t});\n\t\t\t\t\tif (this._colorLoadTimeout) {\n\t\t\t\t\t\tclearTimeout(this._colorLoadTimeout);\n\t\t\t\t\t}\n\t\t\t\t\tthis._colorLoadTimeout = setTimeout(() => {\n\t\t\t\t\t\tthis.loadBulkAlertColors();\n\t\t\t\t\t}, 500);\n\t\t\t\t}\n\t\t\t\t\n\t\t\t\t// Update timestamp\n\t\t\t\tthis.lastUpdateTime = update.lastUpdateTime * 1000; // Convert to milliseconds\n\n\t\t\t\t// Process new alerts for notifications\n\t\t\t\tif (window.notificationService && this.currentUser) {\n\t\t\t\t\twindow.notificationService.forgetLapsedAcknowledgments(\n\t\t\t\t\t\t[...(update.newAlerts || []), ...(update.updatedAlerts || [])],\n\t\t\t\t\t\tthis.currentUser.id\n\t\t\t\t\t);\n\t\t\t\t\twindow.notificationService.processNewAlerts(this.alerts, this.filters, this.currentUser.id);\n\t\t\t\t}\n\n\t\t\t\t// Call adaptive refresh only when polling (not using SSE)\n\t\t\t\tif (!this.sseConnection && this.adaptiveRefresh) {\n\t\t\t\t\tthis.adaptiveRefresh(update);\n\t\t\t\t}\n\t\t\t},\n\n\t\t\t// Sort alerts on the primary key, then on each shift-click key in turn\n\t\t\tsortAlerts(alerts) {\n\t\t\t\tconst keys = [{ field: this.sortField, direction: this.sortDirection }, ...this.sortThen];\n\t\t\t\treturn [...alerts].sort((a, b) => {\n\t\t\t\t\tfor (const key of keys) {\n\t\t\t\t\t\tconst result = this.compareAlertsBy(a, b, key.field);\n\t\t\t\t\t\tif (result !== 0) {\n\t\t\t\t\t\t\treturn key.direction === 'desc' ? -result : result;\n\t\t\t\t\t\t}\n\t\t\t\t\t}\n\t\t\t\t\treturn 0;\n\t\t\t\t});\n\t\t\t},\n\n\t\t\t// Orders a and b on a single sort field, returning -1, 0 or 1\n\t\t\tcompareAlertsBy(a, b, field) {\n\t\t\t\tlet aVal, bVal;\n\n\t\t\t\tswitch (field) {\n\t\t\t\t\tcase 'alertName':\n\t\t\t\t\t\taVal = a.alertName.toLowerCase();\n\t\t\t\t\t\tbVal = b.alertName.toLowerCase();\n\t\t\t\t\t\tbreak;\n\t\t\t\t\tcase 'severity':\n\t\t\t\t\t\tconst severityOrder = { 'critical': 4, 'critical-daytime': 3, 'warning': 2, 'info': 1 };\n\t\t\t\t\t\taVal = severityOrder[a.severity] || 0;\n\t\t\t\t\t\tbVal = severityOrder[b.severity] || 0;\n\t\t\t\t\t\tbreak;\n\t\t\t\t\tcase 'status':\n\t\t\t\t\t\taVal = ((typeof a.status === 'object' ? a.status?.state : a.status) || '').toLowerCase();\n\t\t\t\t\t\tbVal = ((typeof b.status === 'object' ? b.status?.state : b.status) || '').toLowerCase();\n\t\t\t\t\t\tbreak;\n\t\t\t\t\tcase 'instance':\n\t\t\t\t\t\taVal = (a.instance || '').toLowerCase();\n\t\t\t\t\t\tbVal = (b.instance || '').toLowerCase();\n\t\t\t\t\t\tbreak;\n\t\t\t\t\tcase 'team':\n\t\t\t\t\t\taVal = (a.labels.team || '').toLowerCase();\n\t\t\t\t\t\tbVal = (b.labels.team || '').toLowerCase();\n\t\t\t\t\t\tbreak;\n\t\t\t\t\tcase 'startsAt':\n\t\t\t\t\t\taVal = new Date(a.startsAt).getTime();\n\t\t\t\t\t\tbVal = new Date(b.startsAt).getTime();\n\t\t\t\t\t\tbreak;\n\t\t\t\t\tcase 'priorityScore':\n\t\t\t\t\t\taVal = a.priorityScore || 0;\n\t\t\t\t\t\tbVal = b.priorityScore || 0;\n\t\t\t\t\t\tbreak;\n\t\t\t\t\tcase 'commentCount':\n\t\t\t\t\t\taVal = a.commentCount || 0;\n\t\t\t\t\t\tbVal = b.commentCount || 0;\n\t\t\t\t\t\tbreak;\n\t\t\t\t\tcase 'acknowledgmentCount':\n\t\t\t\t\t\taVal = a.acknowledgmentCount || 0;\n\t\t\t\t\t\tbVal = b.acknowledgmentCount || 0;\n\t\t\t\t\t\tbreak;\n\t\t\t\t\tcase 'isAcknowledged':\n\t\t\t\t\t\taVal = a.isAcknowledged ? 1 : 0;\n\t\t\t\t\t\tbVal = b.isAcknowledged ? 1 : 0;\n\t\t\t\t\t\tbreak;\n\t\t\t\t\tcase 'duration':\n\t\t\t\t\tdefault:\n\t\t\t\t\t\taVal = a.duration;\n\t\t\t\t\t\tbVal = b.duration;\n\t\t\t\t\t\tbreak;\n\t\t\t\t}\n\n\t\t\t\treturn aVal < bVal ? -1 : aVal > bVal ? 1 : 0;\n\t\t\t},\n\n\t\t\t// Check if an alert matches current filter settings\n\t\t\t// Used to filter SSE updates which arrive unfiltered\n\t\t\talertMatchesFilters(alert) {\n\t\t\t\t// Check alertmanager filter\n\t\t\t\tif (this.filters.alertmanagers && this.filters.alertmanagers.length > 0) {\n\t\t\t\t\tif (!this.filters.alertmanagers.includes(alert.source)) {\n\t\t\t\t\t\treturn false;\n\t\t\t\t\t}\n\t\t\t\t}\n\n\t\t\t\t// Check severity filter\n\t\t\t\tif (this.filters.severities && this.filters.severities.length > 0) {\n\t\t\t\t\tconst alertSeverity = (alert.severity || '').toLowerCase();\n\t\t\t\t\tconst matchesSeverity = this.filters.severities.some(s => s.toLowerCase() === alertSeverity);\n\t\t\t\t\tif (!matchesSeverity) {\n\t\t\t\t\t\treturn false;\n\t\t\t\t\t}\n\t\t\t\t}\n\n\t\t\t\t// Check status filter\n\t\t\t\tif (this.filters.statuses && this.filters.statuses.length > 0) {\n\t\t\t\t\tconst alertStatus = (alert.status?.state || alert.status || '').toLowerCase();\n\t\t\t\t\tconst matchesStatus = this.filters.statuses.some(s => s.toLowerCase() === alertStatus);\n\t\t\t\t\tif (!matchesStatus) {\n\t\t\t\t\t\treturn false;\n\t\t\t\t\t}\n\t\t\t\t}\n\n\t\t\t\t// Check team filter\n\t\t\t\tif (this.filters.teams && this.filters.teams.length > 0) {\n\t\t\t\t\tconst alertTeam = alert.team || alert.labels?.team || '';\n\t\t\t\t\tif (!this.filters.teams.includes(alertTeam)) {\n\t\t\t\t\t\treturn false;\n\t\t\t\t\t}\n\t\t\t\t}\n\n\t\t\t\t// Check alertName filter\n\t\t\t\tif (this.filters.alertNames && this.filters.alertNames.length > 0) {\n\t\t\t\t\tif (!this.filters.alertNames.includes(alert.alertName)) {\n\t\t\t\t\t\treturn false;\n\t\t\t\t\t}\n\t\t\t\t}\n\n\t\t\t\t// Check search query\n\t\t\t\tif (this.searchQuery && this.searchQuery.trim() !== '') {\n\t\t\t\t\tconst { matchers, text } = this.parsedSearch();\n\n\t\t\t\t\tconst labels = alert.labels || {};\n\t\t\t\t\tif (!matchers.every(m => (m.regex ? m.regex.test(labels[m.name] ?? '') : (labels[m.name] ?? '') === m.value) === m.isEqual)) {\n\t\t\t\t\t\treturn false;\n\t\t\t\t\t}\n\n\t\t\t\t\tif (text) {\n\t\t\t\t\t\tconst searchableText = [\n\t\t\t\t\t\t\talert.alertName,\n\t\t\t\t\t\t\talert.summary,\n\t\t\t\t\t\t\talert.instance,\n\t\t\t\t\t\t\talert.team,\n\t\t\t\t\t\t\talert.source,\n\t\t\t\t\t\t\tJSON.stringify(alert.labels)\n\t\t\t\t\t\t].join(' ').toLowerCase();\n\n\t\t\t\t\t\tif (!searchableText.includes(text.toLowerCase())) {\n\t\t\t\t\t\t\treturn false;\n\t\t\t\t\t\t}\n\t\t\t\t\t}\n\t\t\t\t}\n\n\t\t\t\t// Check hidden-ness (global + filter-preset), mirroring the server's\n\t\t\t\t// applyDashboardFilters: hidden mode shows only hidden alerts, every\n\t\t\t\t// other mode drops them\n\t\t\t\t// Global rules serialize camelCase (labelKey/labelValue/isRegex/enabled),\n\t\t\t\t// unlike preset rules — normalize before reusing the matcher\n\t\t\t\tconst isGlobalHidden =\n\t\t\t\t\t!!window.currentSettingsModal?.activeHiddenAlert(alert.fingerprint) ||\n\t\t\t\t\t(window.currentSettingsModal?.hiddenRules || []).some(rule => this.alertMatchesHiddenRule(alert, {\n\t\t\t\t\t\tis_enabled: rule.enabled,\n\t\t\t\t\t\tlabel_key: rule.labelKey,\n\t\t\t\t\t\tlabel_value: rule.labelValue,\n\t\t\t\t\t\tis_regex: rule.isRegex\n\t\t\t\t\t}));\n\t\t\t\tconst isFilterHidden =\n\t\t\t\t\t(this.filterHiddenAlerts || []).some(hidden => hidden.fingerprint === alert.fingerprint) ||\n\t\t\t\t\t(this.filterHiddenRules || []).some(rule => this.alertMatchesHiddenRule(alert, rule));\n\t\t\t\tconst isHidden = isGlobalHidden || isFilterHidden;\n\n\t\t\t\tif (this.displayMode === 'hidden') {\n\t\t\t\t\tif (!isHidden) {\n\t\t\t\t\t\treturn false;\n\t\t\t\t\t}\n\t\t\t\t} else if (isHidden) {\n\t\t\t\t\treturn false;\n\t\t\t\t}\n\n\t\t\t\t// Check display mode - don't show resolved in classic mode\n\t\t\t\tif (this.displayMode === 'classic') {\n\t\t\t\t\tconst isResolved = alert.isResolved || (alert.status?.state || alert.status || '').toLowerCase() === 'resolved';\n\t\t\t\t\tif (isResolved) {\n\t\t\t\t\t\treturn false;\n\t\t\t\t\t}\n\t\t\t\t}\n\n\t\t\t\treturn true;\n\t\t\t},\n\n\t\t\t// Parse the search once per query instead of once per alert, so its\n\t\t\t// regexes are compiled once; reparsed when alerts come or go since\n\t\t\t// they change the known label keys\n\t\t\tparsedSearch() {\n\t\t\t\tconst cache = this._parsedSearchCache;\n\t\t\t\tif (cache && cache.query === this.searchQuery && cache.alertCount === this.alerts.length) {\n\t\t\t\t\treturn cache.parsed;\n\t\t\t\t}\n\t\t\t\tconst parsed = this.parseSearchQuery(this.searchQuery, new Set(this.knownLabelKeys()));\n\t\t\t\tthis._parsedSearchCache = { query: this.searchQuery, alertCount: this.alerts.length, parsed };\n\t\t\t\treturn parsed;\n\t\t\t},\n\n\t\t\t// Split a search into label matchers and free text\n\t\t\t// Mirrors parseSearchQuery in dashboard_handlers.go: key=value, key!=value,\n\t\t\t// key=~regex, key!~regex and key:value, the last only for a known label\n\t\t\t// key so node1:9100 stays free text; anything unparsable (such as a\n\t\t\t// malformed regex) stays free text\n\t\t\tparseSearchQuery(query, labelKeys) {\n\t\t\t\tconst matchers = [];\n\t\t\t\tconst words = [];\n\n\t\t\t\tfor (const token of query.trim().split(/\\s+/)) {\n\t\t\t\t\tlet expr = token;\n\t\t\t\t\tif (!/[=!]/.test(token)) {\n\t\t\t\t\t\tconst colon = token.indexOf(':');\n\t\t\t\t\t\tif (colon > 0 && labelKeys.has(token.slice(0, colon))) {\n\t\t\t\t\t\t\texpr = token.slice(0, colon) + '=' + token.slice(colon + 1);\n\t\t\t\t\t\t}\n\t\t\t\t\t}\n\n\t\t\t\t\tconst match = expr.match(/^\\s*([a-zA-Z_][a-zA-Z0-9_]*)\\s*(=~|!~|!=|=)(.*)$/);\n\t\t\t\t\tif (match) {\n\t\t\t\t\t\tlet value = match[3].trim();\n\t\t\t\t\t\tif (value.length >= 2 && value.startsWith('\"') && value.endsWith('\"')) {\n\t\t\t\t\t\t\tvalue = value.slice(1, -1);\n\t\t\t\t\t\t}\n\t\t\t\t\t\tconst matcher = { name: match[1], value, isEqual: !match[2].startsWith('!'), regex: null };\n\t\t\t\t\t\ttry {\n\t\t\t\t\t\t\tif (match[2].endsWith('~')) {\n\t\t\t\t\t\t\t\tmatcher.regex = new RegExp(`^(?:${value})$`);\n\t\t\t\t\t\t\t}\n\t\t\t\t\t\t\tmatchers.push(matcher);\n\t\t\t\t\t\t\tcontinue;\n\t\t\t\t\t\t} catch (e) {\n\t\t\t\t\t\t\t// Malformed regex: fall back to a plain word\n\t\t\t\t\t\t}\n\t\t\t\t\t}\n\t\t\t\t\twords.push(token);\n\t\t\t\t}\n\n\t\t\t\treturn { matchers, text: words.join(' ') };\n\t\t\t},\n\n\t\t\t// Compile each hidden-rule pattern once instead of once per alert;\n\t\t\t// invalid patterns are cached as null\n\t\t\tcompiledHiddenRuleRegex(pattern) {\n\t\t\t\tif (!this._hiddenRuleRegexCache) {\n\t\t\t\t\tthis._hiddenRuleRegexCache = new Map();\n\t\t\t\t}\n\t\t\t\tif (!this._hiddenRuleRegexCache.has(pattern)) {\n\t\t\t\t\tlet regex = null;\n\t\t\t\t\ttry {\n\t\t\t\t\t\tregex = new RegExp(pattern);\n\t\t\t\t\t} catch (e) {\n\t\t\t\t\t\t// Invalid user-supplied regex must not break the SSE merge\n\t\t\t\t\t}\n\t\t\t\t\tthis._hiddenRuleRegexCache.set(pattern, regex);\n\t\t\t\t}\n\t\t\t\treturn this._hiddenRuleRegexCache.get(pattern);\n\t\t\t},\n\n\t\t\t// Check if an alert matches a filter-preset hidden rule\n\t\t\t// Mirrors HiddenAlertsService.IsAlertHiddenByFilter on the server\n\t\t\talertMatchesHiddenRule(alert, rule) {\n\t\t\t\tif (!rule || !rule.is_enabled) return false;\n\n\t\t\t\tconst labelValue = alert.labels?.[rule.label_key];\n\t\t\t\tif (labelValue === undefined) return false;\n\n\t\t\t\tif (rule.is_regex) {\n\t\t\t\t\t// Server only compiles regexes with a non-empty value\n\t\t\t\t\t// (CompileFilterRules); new RegExp('') would match everything\n\t\t\t\t\tif (rule.label_value === '') return false;\n\t\t\t\t\tconst regex = this.compiledHiddenRuleRegex(rule.label_value);\n\t\t\t\t\treturn regex !== null && regex.test(labelValue);\n\t\t\t\t}\n\t\t\t\t// Exact match or empty value (match all alerts carrying the label)\n\t\t\t\treturn rule.label_value === '' || rule.label_value === labelValue;\n\t\t\t}\n\t\t};\n\t</script>")
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
@@ -29,7 +29,7 @@ func DashboardModal() templ.Component {
 			templ_7745c5c3_Var1 = templ.NopComponent
 		}
 		ctx = templ.ClearChildren(ctx)
-		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 1, "<script>\n\t\twindow.dashboardModalMixin = {\n\t\t\tasync showAlertDetails(fingerprint) {\n\t\t\t\tthis.alertDetailsLoading = true;\n\t\t\t\tthis.showAlertModal = true;\n\t\t\t\tthis.currentAlertTab = 'overview';\n\t\t\t\tthis.alertDetails = null;\n\n\t\t\t\tconst currentPath = window.location.pathname;\n\t\t\t\tconst newPath = `/dashboard/alert/${fingerprint}`;\n\t\t\t\tif (currentPath !== newPath) {\n\t\t\t\t\twindow.history.pushState({ alertId: fingerprint }, '', newPath);\n\t\t\t\t}\n\n\t\t\t\ttry {\n\t\t\t\t\tconst response = await fetch(`/api/v1/dashboard/alert/${fingerprint}`, {\n\t\t\t\t\t\tcredentials: 'include'\n\t\t\t\t\t});\n\n\t\t\t\t\t// Check for authentication errors and redirect if needed\n\t\t\t\t\tif (window.dashboardInstance && window.dashboardInstance.handleAuthError(response)) {\n\t\t\t\t\t\treturn;\n\t\t\t\t\t}\n\n\t\t\t\t\tconst result = await response.json();\n\t\t\t\t\t\n\t\t\t\t\tif (result.success) {\n\t\t\t\t\t\tthis.alertDetails = result.data;\n\t\t\t\t\t} else {\n\t\t\t\t\t\tconsole.error('Failed to load alert details: ' + result.error);\n\t\t\t\t\t\tthis.closeAlertModal();\n\t\t\t\t\t}\n\t\t\t\t} catch (error) {\n\t\t\t\t\tconsole.error('Error loading alert details:', error);\n\t\t\t\t\tconsole.error('Failed to load alert details');\n\t\t\t\t\tthis.closeAlertModal();\n\t\t\t\t} finally {\n\t\t\t\t\tthis.alertDetailsLoading = false;\n\t\t\t\t}\n\t\t\t},\n\n\t\t\tcloseAlertModal() {\n\t\t\t\tthis.showAlertModal = false;\n\t\t\t\tthis.alertDetails = null;\n\t\t\t\tthis.currentAlertTab = 'overview';\n\t\t\t\t\n\t\t\t\tthis.newCommentContent = '';\n\t\t\t\tthis.commentSubmitting = false;\n\t\t\t\tthis.commentDeleting = {};\n\t\t\t\t\n\t\t\t\tif (window.location.pathname.includes('/alert/')) {\n\t\t\t\t\twindow.history.pushState({}, '', '/dashboard');\n\t\t\t\t}\n\t\t\t},\n\n\t\t\tacknowledgeCurrentAlert() {\n\t\t\t\tif (this.alertDetails?.alert) {\n\t\t\t\t\tthis.currentAckAlert = this.alertDetails.alert;\n\t\t\t\t\tthis.ackAction = 'single';\n\t\t\t\t\tthis.ackReason = '';\n\t\t\t\t\tthis.ackError = '';\n\t\t\t\t\tthis.showAckModal = true;\n\t\t\t\t}\n\t\t\t},\n\n\t\t\tsilenceCurrentAlert() {\n\t\t\t\tif (this.alertDetails?.alert) {\n\t\t\t\t\tthis.currentSilenceAlert = this.alertDetails.alert;\n\t\t\t\t\tthis.silenceAction = 'single';\n\t\t\t\t\tthis.silenceReason = '';\n\t\t\t\t\tthis.silenceError = '';\n\t\t\t\t\tthis.silenceDuration = '1h';\n\t\t\t\t\tthis.silenceDurationType = 'preset';\n\t\t\t\t\tthis.customSilenceDuration = '';\n\t\t\t\t\tthis.customDurationError = '';\n\t\t\t\t\tthis.showSilenceModal = true;\n\t\t\t\t}\n\t\t\t},\n\n\t\t\tunsilenceCurrentAlert() {\n\t\t\t\tif (this.alertDetails?.alert) {\n\t\t\t\t\tthis.processUnsilenceAction(this.alertDetails.alert.fingerprint);\n\t\t\t\t}\n\t\t\t},\n\n\t\t\tasync processUnsilenceAction(fingerprint) {\n\t\t\t\ttry {\n\t\t\t\t\tconst response = await fetch('/api/v1/dashboard/bulk-action', {\n\t\t\t\t\t\tmethod: 'POST',\n\t\t\t\t\t\tcredentials: 'include',\n\t\t\t\t\t\theaders: {\n\t\t\t\t\t\t\t'Content-Type': 'application/json',\n\t\t\t\t\t\t},\n\t\t\t\t\t\tbody: JSON.stringify({\n\t\t\t\t\t\t\taction: 'unsilence',\n\t\t\t\t\t\t\talertFingerprints: [fingerprint],\n\t\t\t\t\t\t\tcomment: 'Unsilenced from alert details'\n\t\t\t\t\t\t})\n\t\t\t\t\t});\n\n\t\t\t\t\tconst result = await response.json();\n\t\t\t\t\t\n\t\t\t\t\tif (result.success) {\n\t\t\t\t\t\tconsole.log('Alert unsilenced successfully');\n\t\t\t\t\t\t// Refresh alert details to show updated state\n\t\t\t\t\t\tif (this.alertDetails?.alert?.fingerprint) {\n\t\t\t\t\t\t\tawait this.showAlertDetails(this.alertDetails.alert.fingerprint);\n\t\t\t\t\t\t}\n\t\t\t\t\t} else {\n\t\t\t\t\t\tconsole.error('Failed to unsilence alert: ' + (result.error || 'Unknown error'));\n\t\t\t\t\t}\n\t\t\t\t} catch (error) {\n\t\t\t\t\tconsole.error('Error unsilencing alert:', error);\n\t\t\t\t\tconsole.error('Failed to unsilence alert');\n\t\t\t\t}\n\t\t\t},\n\n\t\t\tisAlertSilenced(alert) {\n\t\t\t\tif (!alert) return false;\n\t\t\t\treturn alert.status?.state === 'suppressed' || \n\t\t\t\t\t   alert.status?.state === 'silenced' || \n\t\t\t\t\t   (alert.status?.silencedBy && alert.status.silencedBy.length > 0);\n\t\t\t},\n\n\t\t\tgetSilenceButtonText(alert) {\n\t\t\t\tif (!alert) return 'Unsilence';\n\t\t\t\tconst silenceCount = alert.status?.silencedBy?.length || 0;\n\t\t\t\treturn silenceCount > 1 ? `Unsilence (${silenceCount})` : 'Unsilence';\n\t\t\t},\n\n\t\t\t// Comment Management Functions\n\t\t\tasync addComment() {\n\t\t\t\tif (!this.newCommentContent.trim()) {\n\t\t\t\t\tconsole.log('Please enter a comment');\n\t\t\t\t\treturn;\n\t\t\t\t}\n\n\t\t\t\tif (!this.alertDetails?.alert?.fingerprint) {\n\t\t\t\t\tconsole.error('Alert information not available');\n\t\t\t\t\treturn;\n\t\t\t\t}\n\n\t\t\t\tthis.commentSubmitting = true;\n\t\t\t\t\n\t\t\t\ttry {\n\t\t\t\t\tconst response = await fetch(`/api/v1/dashboard/alert/${this.alertDetails.alert.fingerprint}/comments`, {\n\t\t\t\t\t\tmethod: 'POST',\n\t\t\t\t\t\tcredentials: 'include',\n\t\t\t\t\t\theaders: {\n\t\t\t\t\t\t\t'Content-Type': 'application/json',\n\t\t\t\t\t\t},\n\t\t\t\t\t\tbody: JSON.stringify({\n\t\t\t\t\t\t\tcontent: this.newCommentContent.trim()\n\t\t\t\t\t\t})\n\t\t\t\t\t});\n\n\t\t\t\t\t// Check for authentication errors and redirect if needed\n\t\t\t\t\tif (window.dashboardInstance && window.dashboardInstance.handleAuthError(response)) {\n\t\t\t\t\t\treturn;\n\t\t\t\t\t}\n\n\t\t\t\t\tconst result = await response.json();\n\t\t\t\t\t\n\t\t\t\t\tif (result.success) {\n\t\t\t\t\t\tconsole.log('Comment added successfully');\n\t\t\t\t\t\tthis.newCommentContent = '';\n\t\t\t\t\t\t\n\t\t\t\t\t\t// Refresh alert details to show the new comment\n\t\t\t\t\t\tawait this.refreshComments();\n\t\t\t\t\t} else {\n\t\t\t\t\t\tconsole.error('Failed to add comment: ' + result.error);\n\t\t\t\t\t}\n\t\t\t\t} catch (error) {\n\t\t\t\t\tconsole.error('Error adding comment:', error);\n\t\t\t\t\tconsole.error('Failed to add comment');\n\t\t\t\t} finally {\n\t\t\t\t\tthis.commentSubmitting = false;\n\t\t\t\t}\n\t\t\t},\n\n\t\t\tasync deleteComment(commentId) {\n\t\t\t\tif (!commentId || !this.alertDetails?.alert?.fingerprint) {\n\t\t\t\t\tconsole.error('Comment information not available');\n\t\t\t\t\treturn;\n\t\t\t\t}\n\n\t\t\t\tthis.commentDeleting[commentId] = true;\n\t\t\t\t\n\t\t\t\ttry {\n\t\t\t\t\tconst response = await fetch(`/api/v1/dashboard/alert/${this.alertDetails.alert.fingerprint}/comments/${commentId}`, {\n\t\t\t\t\t\tmethod: 'DELETE',\n\t\t\t\t\t\tcredentials: 'include'\n\t\t\t\t\t});\n\n\t\t\t\t\t// Check for authentication errors and redirect if needed\n\t\t\t\t\tif (window.dashboardInstance && window.dashboardInstance.handleAuthError(response)) {\n\t\t\t\t\t\treturn;\n\t\t\t\t\t}\n\n\t\t\t\t\tconst result = await response.json();\n\t\t\t\t\t\n\t\t\t\t\tif (result.success) {\n\t\t\t\t\t\tconsole.log('Comment deleted successfully');\n\t\t\t\t\t\t\n\t\t\t\t\t\t// Refresh alert details to remove the deleted comment\n\t\t\t\t\t\tawait this.refreshComments();\n\t\t\t\t\t} else {\n\t\t\t\t\t\tconsole.error('Failed to delete comment: ' + result.error);\n\t\t\t\t\t}\n\t\t\t\t} catch (error) {\n\t\t\t\t\tconsole.error('Error deleting comment:', error);\n\t\t\t\t\tconsole.error('Failed to delete comment');\n\t\t\t\t} finally {\n\t\t\t\t\t// Remove deleting state for this comment\n\t\t\t\t\tdelete this.commentDeleting[commentId];\n\t\t\t\t}\n\t\t\t},\n\n\t\t\tasync refreshComments() {\n\t\t\t\tif (!this.alertDetails?.alert?.fingerprint) {\n\t\t\t\t\treturn;\n\t\t\t\t}\n\n\t\t\t\ttry {\n\t\t\t\t\tconst response = await fetch(`/api/v1/dashboard/alert/${this.alertDetails.alert.fingerprint}`, {\n\t\t\t\t\t\tcredentials: 'include'\n\t\t\t\t\t});\n\t\t\t\t\tconst result = await response.json();\n\t\t\t\t\t\n\t\t\t\t\tif (result.success) {\n\t\t\t\t\t\t// Update only the comments and maintain other alert details\n\t\t\t\t\t\tthis.alertDetails.comments = result.data.comments || [];\n\t\t\t\t\t\t// Update comment count in alert object if it exists\n\t\t\t\t\t\tif (this.alertDetails.alert) {\n\t\t\t\t\t\t\tthis.alertDetails.alert.commentCount = this.alertDetails.comments.length;\n\t\t\t\t\t\t}\n\t\t\t\t\t}\n\t\t\t\t} catch (error) {\n\t\t\t\t\tconsole.error('Error refreshing comments:', error);\n\t\t\t\t}\n\t\t\t},\n\n\t\t\tcopyAlertAsIssue() {\n\t\t\t\tif (!this.alertDetails?.alert) {\n\t\t\t\t\tconsole.error('No alert data available');\n\t\t\t\t\treturn;\n\t\t\t\t}\n\n\t\t\t\tconst alert = this.alertDetails.alert;\n\t\t\t\tconst comments = this.alertDetails.comments || [];\n\t\t\t\t\n\t\t\t\tconst formatDate = (dateStr) => {\n\t\t\t\t\tif (!dateStr) return 'N/A';\n\t\t\t\t\treturn new Date(dateStr).toLocaleString();\n\t\t\t\t};\n\t\t\t\t\n\t\t\t\tconst calculateDuration = (start, end) => {\n\t\t\t\t\tif (!start) return 'N/A';\n\t\t\t\t\tconst startTime = new Date(start);\n\t\t\t\t\tconst endTime = end ? new Date(end) : new Date();\n\t\t\t\t\tconst diffMs = endTime - startTime;\n\t\t\t\t\t\n\t\t\t\t\tconst hours = Math.floor(diffMs / (1000 * 60 * 60));\n\t\t\t\t\tconst minutes = Math.floor((diffMs % (1000 * 60 * 60)) / (1000 * 60));\n\t\t\t\t\t\n\t\t\t\t\tif (hours > 0) {\n\t\t\t\t\t\treturn `${hours}h ${minutes}m`;\n\t\t\t\t\t}\n\t\t\t\t\treturn `${minutes}m`;\n\t\t\t\t};\n\n\t\t\t\t// Build markdown content\n\t\t\t\tlet markdown = `# Alert: ${alert.alertname || alert.labels?.alertname || 'Unknown'}\\n\\n`;\n\t\t\t\t\n\t\t\t\t// Summary section\n\t\t\t\tif (alert.summary) {\n\t\t\t\t\tmarkdown += `## Summary\\n${alert.summary}\\n\\n`;\n\t\t\t\t}\n\n\t\t\t\t// Details section\n\t\t\t\tmarkdown += `## Details\\n`;\n\t\t\t\tmarkdown += `- **Status**: ${(alert.status?.state || 'unknown').toUpperCase()}\\n`;\n\t\t\t\tmarkdown += `- **Severity**: ${(alert.severity || 'unknown').toUpperCase()}\\n`;\n\t\t\t\tif (alert.instance) {\n\t\t\t\t\tmarkdown += `- **Instance**: ${alert.instance}\\n`;\n\t\t\t\t}\n\t\t\t\tmarkdown += `- **Started**: ${formatDate(alert.startsAt)}\\n`;\n\t\t\t\tif (alert.endsAt) {\n\t\t\t\t\tmarkdown += `- **Ended**: ${formatDate(alert.endsAt)}\\n`;\n\t\t\t\t}\n\t\t\t\tmarkdown += `- **Duration**: ${calculateDuration(alert.startsAt, alert.endsAt)}\\n\\n`;\n\n\t\t\t\t// Labels section\n\t\t\t\tif (alert.labels && Object.keys(alert.labels).length > 0) {\n\t\t\t\t\tmarkdown += `## Labels\\n`;\n\t\t\t\t\tObject.entries(alert.labels).forEach(([key, value]) => {\n\t\t\t\t\t\tmarkdown += `- **${key}**: ${value}\\n`;\n\t\t\t\t\t});\n\t\t\t\t\tmarkdown += '\\n';\n\t\t\t\t}\n\n\t\t\t\t// Annotations section\n\t\t\t\tif (alert.annotations && Object.keys(alert.annotations).length > 0) {\n\t\t\t\t\tmarkdown += `## Annotations\\n`;\n\t\t\t\t\tObject.entries(alert.annotations).forEach(([key, value]) => {\n\t\t\t\t\t\tmarkdown += `- **${key}**: ${value}\\n`;\n\t\t\t\t\t});\n\t\t\t\t\tmarkdown += '\\n';\n\t\t\t\t}\n\n\t\t\t\t// Comments section\n\t\t\t\tif (comments.length > 0) {\n\t\t\t\t\tmarkdown += `## Comments\\n`;\n\t\t\t\t\tcomments.forEach(comment => {\n\t\t\t\t\t\tconst commentDate = formatDate(comment.createdAt);\n\t\t\t\t\t\tmarkdown += `**${comment.username}** (${commentDate}):\\n`;\n\t\t\t\t\t\tmarkdown += `${comment.content}\\n\\n`;\n\t\t\t\t\t});\n\t\t\t\t}\n\n\t\t\t\t// Alert ID section\n\t\t\t\tmarkdown += `## Alert ID\\n`;\n\t\t\t\tmarkdown += `\\`${alert.fingerprint}\\`\\n`;\n\n\t\t\t\t// Copy to clipboard\n\t\t\t\tthis.copyToClipboard(markdown);\n\t\t\t\tconsole.log('Alert copied as issue template');\n\t\t\t},\n\n\t\t\tasync unacknowledgeCurrentAlert() {\n\t\t\t\tif (!this.alertDetails?.alert?.fingerprint) {\n\t\t\t\t\tconsole.error('No alert information available');\n\t\t\t\t\treturn;\n\t\t\t\t}\n\t\t\t\t\n\t\t\t\ttry {\n\t\t\t\t\tconst request = {\n\t\t\t\t\t\talertFingerprints: [this.alertDetails.alert.fingerprint],\n\t\t\t\t\t\taction: 'unacknowledge',\n\t\t\t\t\t\tcomment: 'Unacknowledged from alert details'\n\t\t\t\t\t};\n\n\t\t\t\t\tconst response = await fetch('/api/v1/dashboard/bulk-action', {\n\t\t\t\t\t\tmethod: 'POST',\n\t\t\t\t\t\tcredentials: 'include',\n\t\t\t\t\t\theaders: {\n\t\t\t\t\t\t\t'Content-Type': 'application/json',\n\t\t\t\t\t\t},\n\t\t\t\t\t\tbody: JSON.stringify(request)\n\t\t\t\t\t});\n\n\t\t\t\t\tconst result = await response.json();\n\t\t\t\t\t\n\t\t\t\t\tif (result.success) {\n\t\t\t\t\t\tconsole.log('Alert unacknowledged successfully');\n\t\t\t\t\t\t// Refresh alert details to show updated state\n\t\t\t\t\t\tif (this.alertDetails?.alert?.fingerprint) {\n\t\t\t\t\t\t\tawait this.showAlertDetails(this.alertDetails.alert.fingerprint);\n\t\t\t\t\t\t}\n\t\t\t\t\t} else {\n\t\t\t\t\t\tconsole.error('Failed to unacknowledge alert: ' + (result.error || 'Unknown error'));\n\t\t\t\t\t}\n\t\t\t\t} catch (error) {\n\t\t\t\t\tconsole.error('Error unacknowledging alert:', error);\n\t\t\t\t\tconsole.error('Failed to unacknowledge alert');\n\t\t\t\t}\n\t\t\t},\n\n\t\t\t// Sentry Integration Functions  \n\t\t\tasync loadSentryDataForTab() {\n\t\t\t\t// This function is called from the tab button click\n\t\t\t\t// Find the Sentry data component using document.querySelector since $refs doesn't work across components\n\t\t\t\tconst sentryComponent = document.querySelector('[x-ref=\"sentryDataComponent\"]');\n\t\t\t\t\n\t\t\t\tif (sentryComponent && sentryComponent._x_dataStack && sentryComponent._x_dataStack[0]) {\n\t\t\t\t\t// Get the Alpine component data\n\t\t\t\t\tconst componentData = sentryComponent._x_dataStack[0];\n\t\t\t\t\t// Set loading state\n\t\t\t\t\tcomponentData.sentryLoading = true;\n\t\t\t\t\tcomponentData.sentryError = null;\n\t\t\t\t\t\n\t\t\t\t\tawait this.loadSentryData(componentData);\n\t\t\t\t} else {\n\t\t\t\t\tconsole.error('Could not find Sentry data component. Element found:', !!sentryComponent, \n\t\t\t\t\t\t'Has _x_dataStack:', !!(sentryComponent && sentryComponent._x_dataStack));\n\t\t\t\t}\n\t\t\t},\n\n\t\t\tasync loadSentryData(component) {\n\t\t\t\ttry {\n\t\t\t\t\t// Get current alert from the component that has alert details\n\t\t\t\t\tlet alert = null;\n\t\t\t\t\tlet fingerprint = null;\n\t\t\t\t\t\n\t\t\t\t\t// Try to get alert from the component's alert details\n\t\t\t\t\tif (component && component.alertDetails?.alert) {\n\t\t\t\t\t\talert = component.alertDetails.alert;\n\t\t\t\t\t\tfingerprint = alert.fingerprint;\n\t\t\t\t\t} \n\t\t\t\t\t// Fallback to current alert from dashboard instance\n\t\t\t\t\telse if (window.dashboardInstance && window.dashboardInstance.currentAlert) {\n\t\t\t\t\t\talert = window.dashboardInstance.currentAlert;\n\t\t\t\t\t\tfingerprint = alert.fingerprint;\n\t\t\t\t\t}\n\t\t\t\t\t// Last resort: use alertDetails from parent modal component\n\t\t\t\t\telse if (this.alertDetails?.alert) {\n\t\t\t\t\t\talert = this.alertDetails.alert;\n\t\t\t\t\t\tfingerprint = alert.fingerprint;\n\t\t\t\t\t}\n\t\t\t\t\t\n\t\t\t\t\tif (!alert || !fingerprint) {\n\t\t\t\t\t\tconsole.error('No current alert available for Sentry data');\n\t\t\t\t\t\tif (component) {\n\t\t\t\t\t\t\tcomponent.sentryError = 'No alert data available';\n\t\t\t\t\t\t\tcomponent.sentryLoading = false;\n\t\t\t\t\t\t}\n\t\t\t\t\t\treturn;\n\t\t\t\t\t}\n\n\t\t\t\t\tconsole.log('Loading Sentry data for alert fingerprint:', fingerprint);\n\n\t\t\t\t\tconst response = await fetch(`/api/v1/dashboard/sentry/${encodeURIComponent(fingerprint)}`, {\n\t\t\t\t\t\tcredentials: 'include'\n\t\t\t\t\t});\n\n\t\t\t\t\tif (response.ok) {\n\t\t\t\t\t\tconst result = await response.json();\n\t\t\t\t\t\t\n\t\t\t\t\t\tif (result.has_sentry_label) {\n\t\t\t\t\t\t\tif (result.auth_status?.has_api_token) {\n\t\t\t\t\t\t\t\t// User has token and can view data\n\t\t\t\t\t\t\t\tif (component) {\n\t\t\t\t\t\t\t\t\tcomponent.sentryData = result;\n\t\t\t\t\t\t\t\t\tcomponent.sentryError = null;\n\t\t\t\t\t\t\t\t\tcomponent.hasSentryToken = true;\n\t\t\t\t\t\t\t\t}\n\t\t\t\t\t\t\t} else {\n\t\t\t\t\t\t\t\t// User needs to configure token\n\t\t\t\t\t\t\t\tif (component) {\n\t\t\t\t\t\t\t\t\tcomponent.sentryData = null;\n\t\t\t\t\t\t\t\t\tcomponent.sentryError = 'Sentry token not configured';\n\t\t\t\t\t\t\t\t\tcomponent.hasSentryToken = false;\n\t\t\t\t\t\t\t\t}\n\t\t\t\t\t\t\t}\n\t\t\t\t\t\t} else {\n\t\t\t\t\t\t\t// Alert doesn't have sentry label\n\t\t\t\t\t\t\tif (component) {\n\t\t\t\t\t\t\t\tcomponent.sentryData = null;\n\t\t\t\t\t\t\t\tcomponent.sentryError = 'This alert does not have Sentry integration data';\n\t\t\t\t\t\t\t\tcomponent.hasSentryToken = false;\n\t\t\t\t\t\t\t}\n\t\t\t\t\t\t}\n\t\t\t\t\t} else {\n\t\t\t\t\t\tconsole.error('Failed to load Sentry data:', response.status);\n\t\t\t\t\t\tif (component) {\n\t\t\t\t\t\t\tcomponent.sentryError = 'Failed to load Sentry data';\n\t\t\t\t\t\t}\n\t\t\t\t\t}\n\t\t\t\t} catch (error) {\n\t\t\t\t\tconsole.error('Error loading Sentry data:', error);\n\t\t\t\t\tif (component) {\n\t\t\t\t\t\tcomponent.sentryError = 'Error loading Sentry data: ' + error.message;\n\t\t\t\t\t}\n\t\t\t\t} finally {\n\t\t\t\t\tif (component) {\n\t\t\t\t\t\tcomponent.sentryLoading = false;\n\t\t\t\t\t}\n\t\t\t\t}\n\t\t\t},\n\n\t\t\t// Helper methods for annotation buttons\n\t\t\thasMatchingAnnotation(buttonConfig) {\n\t\t\t\tif (!buttonConfig || !buttonConfig.enabled) return false;\n\t\t\t\tconst annotations = this.alertDetails?.alert?.annotations || {};\n\t\t\t\treturn buttonConfig.annotation_keys?.some(key => annotations[key]);\n\t\t\t},\n\n\t\t\tgetAnnotationUrl(buttonConfig) {\n\t\t\t\tconst annotations = this.alertDetails?.alert?.annotations || {};\n\t\t\t\tconst matchedKey = buttonConfig.annotation_keys?.find(key => annotations[key]);\n\t\t\t\treturn matchedKey ? annotations[matchedKey] : null;\n\t\t\t},\n\n\t\t\topenAnnotationUrl(buttonConfig) {\n\t\t\t\tconst url = this.getAnnotationUrl(buttonConfig);\n\t\t\t\tif (url) {\n\t\t\t\t\twindow.open(url, '_blank');\n\t\t\t\t}\n\t\t\t}\n\t\t};\n\n\t\t// Global function for Sentry data loading that can be called from Alpine.js components\n\t\twindow.loadSentryData = function() {\n\t\t\t// Get the parent dashboard component that has the modal mixin\n\t\t\tconst dashboardComponent = window.dashboardInstance;\n\t\t\tif (dashboardComponent && dashboardComponent.loadSentryData) {\n\t\t\t\t// Pass the current Alpine.js component (this) to the function\n\t\t\t\tdashboardComponent.loadSentryData(this);\n\t\t\t} else {\n\t\t\t\tconsole.error('Dashboard instance not found or loadSentryData method not available');\n\t\t\t\tthis.sentryError = 'Dashboard not properly initialized';\n\t\t\t\tthis.sentryLoading = false;\n\t\t\t}\n\t\t};\n\n\t\twindow.dashboardModalMixin.loadAlertHistory = async function() {\n\t\t\tif (!this.alertDetails?.alert?.fingerprint) {\n\t\t\t\tconsole.error('No alert fingerprint available');\n\t\t\t\treturn;\n\t\t\t}\n\n\t\t\tthis.historyLoading = true;\n\n\t\t\ttry {\n\t\t\t\tconst response = await fetch(\n\t\t\t\t\t`/api/v1/dashboard/alert/${this.alertDetails.alert.fingerprint}/history`,\n\t\t\t\t\t{ credentials: 'include' }\n\t\t\t\t);\n\n\t\t\t\tif (response.ok) {\n\t\t\t\t\tconst result = await response.json();\n\t\t\t\t\tif (result.success) {\n\t\t\t\t\t\tthis.alertHistory = result.data;\n\t\t\t\t\t} else {\n\t\t\t\t\t\tconsole.error('Failed to load alert history:', result.error);\n\t\t\t\t\t\tthis.alertHistory = null;\n\t\t\t\t\t}\n\t\t\t\t} else {\n\t\t\t\t\tconsole.error('Failed to fetch alert history');\n\t\t\t\t\tthis.alertHistory = null;\n\t\t\t\t}\n\t\t\t} catch (error) {\n\t\t\t\tconsole.error('Error loading alert history:', error);\n\t\t\t\tthis.alertHistory = null;\n\t\t\t} finally {\n\t\t\t\tthis.historyLoading = false;\n\t\t\t}\n\t\t};\n\n\t\twindow.dashboardModalMixin.formatDuration = function(seconds) {\n\t\t\tif (!seconds || seconds < 0) return '0s';\n\t\t\tconst hours = Math.floor(seconds / 3600);\n\t\t\tconst minutes = Math.floor((seconds % 3600) / 60);\n\t\t\tconst secs = Math.floor(seconds % 60);\n\t\t\tif (hours > 0) return `${hours}h ${minutes}m`;\n\t\t\tif (minutes > 0) return `${minutes}m ${secs}s`;\n\t\t\treturn `${secs}s`;\n\t\t};\n\n\t\twindow.dashboardModalMixin.formatDateTime = function(dateStr) {\n\t\t\tif (!dateStr) return 'N/A';\n\t\t\treturn new Date(dateStr).toLocaleString();\n\t\t};\n\t</script>")
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 1, "<script>\n\t\twindow.dashboardModalMixin = {\n\t\t\tasync showAlertDetails(fingerprint) {\n\t\t\t\t// Keep what was typed on the alert being left\n\t\t\t\tthis.saveCommentDraft();\n\t\t\t\tthis.alertDetailsLoading = true;\n\t\t\t\tthis.showAlertModal = true;\n\t\t\t\tthis.currentAlertTab = 'overview';\n\t\t\t\tthis.alertDetails = null;\n\t\t\t\tthis.showAlertExplain = false;\n\t\t\t\tthis.alertExplain = null;\n\n\t\t\t\tconst currentPath = window.location.pathname;\n\t\t\t\tconst newPath = `/dashboard/alert/${fingerprint}`;\n\t\t\t\tif (currentPath !== newPath) {\n\t\t\t\t\twindow.history.pushState({ alertId: fingerprint }, '', newPath);\n\t\t\t\t}\n\n\t\t\t\ttry {\n\t\t\t\t\tconst response = await fetch(`/api/v1/dashboard/alert/${fingerprint}`, {\n\t\t\t\t\t\tcredentials: 'include'\n\t\t\t\t\t});\n\n\t\t\t\t\t// Check for authentication errors and redirect if needed\n\t\t\t\t\tif (window.dashboardInstance && window.dashboardInstance.handleAuthError(response)) {\n\t\t\t\t\t\treturn;\n\t\t\t\t\t}\n\n\t\t\t\t\tconst result = await response.json();\n\t\t\t\t\t\n\t\t\t\t\tif (result.success) {\n\t\t\t\t\t\tthis.alertDetails = result.data;\n\t\t\t\t\t\tthis.commentTagFilter = '';\n\t\t\t\t\t\tthis.taggedComments = [];\n\t\t\t\t\t\tthis.restoreCommentDraft(fingerprint);\n\t\t\t\t\t\tthis.subscribeToAlertUpdates(fingerprint);\n\t\t\t\t\t} else if (response.status === 404) {\n\t\t\t\t\t\t// No longer active (e.g. a shared link to a resolved alert): show its stored data\n\t\t\t\t\t\tthis.alertDetailsLoading = false;\n\t\t\t\t\t\tawait this.showResolvedAlertDetails({ fingerprint });\n\t\t\t\t\t\treturn;\n\t\t\t\t\t} else {\n\t\t\t\t\t\tconsole.error('Failed to load alert details: ' + result.error);\n\t\t\t\t\t\tthis.closeAlertModal();\n\t\t\t\t\t}\n\t\t\t\t} catch (error) {\n\t\t\t\t\tconsole.error('Error loading alert details:', error);\n\t\t\t\t\tconsole.error('Failed to load alert details');\n\t\t\t\t\tthis.closeAlertModal();\n\t\t\t\t} finally {\n\t\t\t\t\tthis.alertDetailsLoading = false;\n\t\t\t\t}\n\t\t\t},\n\n\t\t\tasync expireSilence(silence) {\n\t\t\t\tif (!confirm('Expire this silence? Alerts it matches will notify again.')) {\n\t\t\t\t\treturn;\n\t\t\t\t}\n\n\t\t\t\tthis.silenceExpiring = { ...this.silenceExpiring, [silence.id]: true };\n\t\t\t\ttry {\n\t\t\t\t\tconst params = silence.source ? `?alertmanager=${encodeURIComponent(silence.source)}` : '';\n\t\t\t\t\tconst response = await fetch(`/api/v1/dashboard/silences/${encodeURIComponent(silence.id)}${params}`, {\n\t\t\t\t\t\tmethod: 'DELETE',\n\t\t\t\t\t\tcredentials: 'include'\n\t\t\t\t\t});\n\n\t\t\t\t\tif (window.dashboardInstance && window.dashboardInstance.handleAuthError(response)) {\n\t\t\t\t\t\treturn;\n\t\t\t\t\t}\n\n\t\t\t\t\tconst result = await response.json();\n\t\t\t\t\tif (!result.success) {\n\t\t\t\t\t\talert(result.error || 'Failed to expire silence');\n\t\t\t\t\t}\n\n\t\t\t\t\t// Refresh the silence list (also after \"already expired\"/\"not found\")\n\t\t\t\t\tconst fingerprint = this.alertDetails?.alert?.fingerprint;\n\t\t\t\t\tif (fingerprint) {\n\t\t\t\t\t\tconst detailsResponse = await fetch(`/api/v1/dashboard/alert/${fingerprint}`, {\n\t\t\t\t\t\t\tcredentials: 'include'\n\t\t\t\t\t\t});\n\t\t\t\t\t\tconst detailsResult = await detailsResponse.json();\n\t\t\t\t\t\tif (detailsResult.success) {\n\t\t\t\t\t\t\tthis.alertDetails = detailsResult.data;\n\t\t\t\t\t\t}\n\t\t\t\t\t}\n\t\t\t\t\tthis.loadDashboardData();\n\t\t\t\t\tthis.refreshSilencesView();\n\t\t\t\t} catch (error) {\n\t\t\t\t\tconsole.error('Error expiring silence:', error);\n\t\t\t\t\talert('Network error: Failed to expire silence');\n\t\t\t\t} finally {\n\t\t\t\t\tconst { [silence.id]: _, ...rest } = this.silenceExpiring;\n\t\t\t\t\tthis.silenceExpiring = rest;\n\t\t\t\t}\n\t\t\t},\n\n\t\t\teditSilence(silence) {\n\t\t\t\tthis.silenceMode = 'edit';\n\t\t\t\tthis.editingSilence = silence;\n\t\t\t\tthis.silenceMatchers = (silence.matchers || []).map(m => ({\n\t\t\t\t\tname: m.name,\n\t\t\t\t\tvalue: m.value,\n\t\t\t\t\toperator: m.isEqual ? (m.isRegex ? '=~' : '=') : (m.isRegex ? '!~' : '!=')\n\t\t\t\t}));\n\t\t\t\tthis.silenceEndsAt = this.formatDateTimeLocal(new Date(silence.endsAt));\n\t\t\t\tthis.silenceReason = silence.comment || '';\n\t\t\t\tthis.silenceError = '';\n\t\t\t\tthis.showSilenceModal = true;\n\t\t\t},\n\n\t\t\textendSilenceEndsAt(extension) {\n\t\t\t\tconst base = this.silenceEndsAt ? new Date(this.silenceEndsAt) : new Date();\n\t\t\t\tconst from = base > new Date() ? base : new Date();\n\t\t\t\tthis.silenceEndsAt = this.formatDateTimeLocal(new Date(from.getTime() + this.parseDurationToSeconds(extension) * 1000));\n\t\t\t},\n\n\t\t\t// formatDateTimeLocal renders a date in the local format expected by datetime-local inputs\n\t\t\tformatDateTimeLocal(date) {\n\t\t\t\tconst pad = (n) => String(n).padStart(2, '0');\n\t\t\t\treturn `${date.getFullYear()}-${pad(date.getMonth() + 1)}-${pad(date.getDate())}T${pad(date.getHours())}:${pad(date.getMinutes())}`;\n\t\t\t},\n\n\t\t\tasync submitSilenceUpdate() {\n\t\t\t\tconst matchers = this.silenceMatchers\n\t\t\t\t\t.filter(m => m.name.trim() !== '')\n\t\t\t\t\t.map(m => ({\n\t\t\t\t\t\tname: m.name.trim(),\n\t\t\t\t\t\tvalue: m.value,\n\t\t\t\t\t\tisEqual: !m.operator.startsWith('!'),\n\t\t\t\t\t\tisRegex: m.operator.endsWith('~')\n\t\t\t\t\t}));\n\t\t\t\tif (matchers.length === 0) {\n\t\t\t\t\tthis.silenceError = 'At least one matcher is required';\n\t\t\t\t\treturn;\n\t\t\t\t}\n\n\t\t\t\tconst endsAt = new Date(this.silenceEndsAt);\n\t\t\t\tif (isNaN(endsAt.getTime()) || endsAt <= new Date()) {\n\t\t\t\t\tthis.silenceError = 'End time must be in the future';\n\t\t\t\t\treturn;\n\t\t\t\t}\n\n\t\t\t\tthis.silenceSubmitting = true;\n\t\t\t\tthis.silenceError = '';\n\n\t\t\t\ttry {\n\t\t\t\t\tconst silence = this.editingSilence;\n\t\t\t\t\tconst params = silence.source ? `?alertmanager=${encodeURIComponent(silence.source)}` : '';\n\t\t\t\t\tconst response = await fetch(`/api/v1/dashboard/silences/${encodeURIComponent(silence.id)}${params}`, {\n\t\t\t\t\t\tmethod: 'PUT',\n\t\t\t\t\t\tcredentials: 'include',\n\t\t\t\t\t\theaders: {\n\t\t\t\t\t\t\t'Content-Type': 'application/json',\n\t\t\t\t\t\t},\n\t\t\t\t\t\tbody: JSON.stringify({\n\t\t\t\t\t\t\tmatchers: matchers,\n\t\t\t\t\t\t\tendsAt: endsAt.toISOString(),\n\t\t\t\t\t\t\tcomment: this.silenceReason.trim()\n\t\t\t\t\t\t})\n\t\t\t\t\t});\n\n\t\t\t\t\tif (window.dashboardInstance && window.dashboardInstance.handleAuthError(response)) {\n\t\t\t\t\t\treturn;\n\t\t\t\t\t}\n\n\t\t\t\t\tconst result = await response.json();\n\t\t\t\t\tif (!result.success) {\n\t\t\t\t\t\tthis.silenceError = result.error || 'Failed to update silence';\n\t\t\t\t\t\treturn;\n\t\t\t\t\t}\n\n\t\t\t\t\tthis.cancelSilence();\n\n\t\t\t\t\tconst fingerprint = this.alertDetails?.alert?.fingerprint;\n\t\t\t\t\tif (fingerprint) {\n\t\t\t\t\t\tconst detailsResponse = await fetch(`/api/v1/dashboard/alert/${fingerprint}`, {\n\t\t\t\t\t\t\tcredentials: 'include'\n\t\t\t\t\t\t});\n\t\t\t\t\t\tconst detailsResult = await detailsResponse.json();\n\t\t\t\t\t\tif (detailsResult.success) {\n\t\t\t\t\t\t\tthis.alertDetails = detailsResult.data;\n\t\t\t\t\t\t}\n\t\t\t\t\t}\n\t\t\t\t\tthis.loadDashboardData();\n\t\t\t\t\tthis.refreshSilencesView();\n\t\t\t\t} catch (error) {\n\t\t\t\t\tconsole.error('Error updating silence:', error);\n\t\t\t\t\tthis.silenceError = 'Network error: Failed to update silence';\n\t\t\t\t} finally {\n\t\t\t\t\tthis.silenceSubmitting = false;\n\t\t\t\t}\n\t\t\t},\n\n\t\t\t// Live comment/acknowledgment updates for the open alert, pushed by\n\t\t\t// the backend through /ws/alerts/:alertKey. A dropped socket is\n\t\t\t// retried with exponential backoff while the modal stays open.\n\t\t\tsubscribeToAlertUpdates(fingerprint) {\n\t\t\t\tthis.unsubscribeFromAlertUpdates();\n\n\t\t\t\tconst protocol = window.location.protocol === 'https:' ? 'wss:' : 'ws:';\n\t\t\t\tconst socket = new WebSocket(`${protocol}//${window.location.host}/ws/alerts/${encodeURIComponent(fingerprint)}`);\n\t\t\t\tsocket.onmessage = (event) => {\n\t\t\t\t\t// The first frame confirms the backend subscription\n\t\t\t\t\tif (this.alertUpdatesStatus !== 'connected') {\n\t\t\t\t\t\tconst resumed = this.alertUpdatesStatus === 'reconnecting';\n\t\t\t\t\t\tthis.alertUpdatesStatus = 'connected';\n\t\t\t\t\t\tthis.alertUpdatesRetryDelay = 0;\n\t\t\t\t\t\tif (resumed) {\n\t\t\t\t\t\t\t// Catch up on anything missed during the outage\n\t\t\t\t\t\t\tthis.refreshComments();\n\t\t\t\t\t\t}\n\t\t\t\t\t}\n\t\t\t\t\ttry {\n\t\t\t\t\t\tthis.handleAlertUpdate(JSON.parse(event.data));\n\t\t\t\t\t} catch (error) {\n\t\t\t\t\t\tconsole.error('Error handling alert update:', error);\n\t\t\t\t\t}\n\t\t\t\t};\n\t\t\t\tsocket.onclose = () => {\n\t\t\t\t\t// Sockets closed by unsubscribeFromAlertUpdates are no longer current\n\t\t\t\t\tif (this.alertUpdatesSocket !== socket) {\n\t\t\t\t\t\treturn;\n\t\t\t\t\t}\n\t\t\t\t\tthis.alertUpdatesSocket = null;\n\t\t\t\t\tthis.scheduleAlertUpdatesReconnect(fingerprint);\n\t\t\t\t};\n\t\t\t\tthis.alertUpdatesSocket = socket;\n\t\t\t},\n\n\t\t\tscheduleAlertUpdatesReconnect(fingerprint) {\n\t\t\t\tif (!this.showAlertModal || this.alertDetails?.alert?.fingerprint !== fingerprint) {\n\t\t\t\t\treturn;\n\t\t\t\t}\n\n\t\t\t\tthis.alertUpdatesStatus = 'reconnecting';\n\t\t\t\tthis.alertUpdatesRetryDelay = Math.min(this.alertUpdatesRetryDelay ? this.alertUpdatesRetryDelay * 2 : 1000, 30000);\n\t\t\t\tthis.alertUpdatesRetryTimer = setTimeout(() => {\n\t\t\t\t\tthis.alertUpdatesRetryTimer = null;\n\t\t\t\t\tthis.subscribeToAlertUpdates(fingerprint);\n\t\t\t\t}, this.alertUpdatesRetryDelay);\n\t\t\t},\n\n\t\t\tunsubscribeFromAlertUpdates() {\n\t\t\t\tif (this.alertUpdatesRetryTimer) {\n\t\t\t\t\tclearTimeout(this.alertUpdatesRetryTimer);\n\t\t\t\t\tthis.alertUpdatesRetryTimer = null;\n\t\t\t\t}\n\t\t\t\tif (this.alertUpdatesSocket) {\n\t\t\t\t\tconst socket = this.alertUpdatesSocket;\n\t\t\t\t\tthis.alertUpdatesSocket = null;\n\t\t\t\t\tsocket.close();\n\t\t\t\t}\n\t\t\t},\n\n\t\t\thandleAlertUpdate(update) {\n\t\t\t\t// Mentions reach every socket of the mentioned user, whatever alert it follows\n\t\t\t\tif (update.type === 'MENTION') {\n\t\t\t\t\tthis.notifyMention(update.mention);\n\t\t\t\t\treturn;\n\t\t\t\t}\n\t\t\t\tif (!this.alertDetails?.alert || update.alertKey !== this.alertDetails.alert.fingerprint) {\n\t\t\t\t\treturn;\n\t\t\t\t}\n\t\t\t\t// Keepalive only, nothing changed\n\t\t\t\tif (update.type === 'HEARTBEAT') {\n\t\t\t\t\treturn;\n\t\t\t\t}\n\t\t\t\t// The first frame and joins/leaves carry everyone viewing the alert\n\t\t\t\tif (update.presence) {\n\t\t\t\t\tthis.alertViewers = update.presence.viewers || [];\n\t\t\t\t\treturn;\n\t\t\t\t}\n\n\t\t\t\tswitch (update.type) {\n\t\t\t\t\tcase 'COMMENT_DELETED': {\n\t\t\t\t\t\tthis.alertDetails.comments = (this.alertDetails.comments || []).filter(c => c.id !== update.deletedCommentId);\n\t\t\t\t\t\tthis.taggedComments = this.taggedComments.filter(c => c.id !== update.deletedCommentId);\n\t\t\t\t\t\tthis.alertDetails.commentsTotal = Math.max(0, (this.alertDetails.commentsTotal || 0) - 1);\n\t\t\t\t\t\tconst count = this.alertDetails.commentsTotal;\n\t\t\t\t\t\tthis.alertDetails.alert.commentCount = count;\n\t\t\t\t\t\tconst listed = this.alerts.find(a => a.fingerprint === update.alertKey);\n\t\t\t\t\t\tif (listed) {\n\t\t\t\t\t\t\tlisted.commentCount = count;\n\t\t\t\t\t\t}\n\t\t\t\t\t\tbreak;\n\t\t\t\t\t}\n\t\t\t\t\tcase 'ESCALATION_ADDED':\n\t\t\t\t\t\tthis.addEscalation(update.escalation);\n\t\t\t\t\t\tbreak;\n\t\t\t\t\tcase 'ACKNOWLEDGMENT_EXPIRED':\n\t\t\t\t\tcase 'ACKNOWLEDGMENT_DELETED': {\n\t\t\t\t\t\t// A single removal carries the acknowledgment ID, clearing all carries the alert key\n\t\t\t\t\t\tconst acks = this.alertDetails.acknowledgments || [];\n\t\t\t\t\t\tif (acks.some(a => a.id === update.deletedAcknowledgmentId)) {\n\t\t\t\t\t\t\tthis.alertDetails.acknowledgments = acks.filter(a => a.id !== update.deletedAcknowledgmentId);\n\t\t\t\t\t\t\tthis.alertDetails.acknowledgmentsTotal = Math.max(0, (this.alertDetails.acknowledgmentsTotal || 0) - 1);\n\t\t\t\t\t\t} else {\n\t\t\t\t\t\t\tthis.refreshComments();\n\t\t\t\t\t\t}\n\t\t\t\t\t\tbreak;\n\t\t\t\t\t}\n\t\t\t\t\tcase 'COMMENT_ADDED':\n\t\t\t\t\tcase 'ACKNOWLEDGMENT_ADDED':\n\t\t\t\t\t\tthis.refreshComments();\n\t\t\t\t\t\tbreak;\n\t\t\t\t}\n\n\t\t\t\tif (this.currentAlertTab === 'activity') {\n\t\t\t\t\tthis.loadAlertActivity(true);\n\t\t\t\t}\n\t\t\t},\n\n\t\t\tnotifyMention(mention) {\n\t\t\t\tif (!mention) {\n\t\t\t\t\treturn;\n\t\t\t\t}\n\t\t\t\tthis._notifiedMentions = this._notifiedMentions || new Set();\n\t\t\t\tif (this._notifiedMentions.has(mention.commentId)) {\n\t\t\t\t\treturn;\n\t\t\t\t}\n\t\t\t\tthis._notifiedMentions.add(mention.commentId);\n\n\t\t\t\tconst alertName = mention.alertName || mention.alertKey;\n\t\t\t\tconst message = `You were mentioned by ${mention.mentionedBy} on alert ${alertName}`;\n\t\t\t\tthis.mentionNotice = { ...mention, alertName, message };\n\t\t\t\tclearTimeout(this._mentionNoticeTimer);\n\t\t\t\tthis._mentionNoticeTimer = setTimeout(() => { this.mentionNotice = null; }, 10000);\n\n\t\t\t\tif ('Notification' in window && Notification.permission === 'granted') {\n\t\t\t\t\ttry {\n\t\t\t\t\t\tconst notification = new Notification(message, {\n\t\t\t\t\t\t\tbody: mention.content,\n\t\t\t\t\t\t\ticon: '/static/images/info-icon.png',\n\t\t\t\t\t\t\ttag: `mention-${mention.commentId}`\n\t\t\t\t\t\t});\n\t\t\t\t\t\tnotification.onclick = () => {\n\t\t\t\t\t\t\twindow.focus();\n\t\t\t\t\t\t\tthis.openMentionedAlert();\n\t\t\t\t\t\t};\n\t\t\t\t\t} catch (error) {\n\t\t\t\t\t\tconsole.error('Failed to show mention notification:', error);\n\t\t\t\t\t}\n\t\t\t\t}\n\t\t\t},\n\n\t\t\topenMentionedAlert() {\n\t\t\t\tconst mention = this.mentionNotice;\n\t\t\t\tthis.mentionNotice = null;\n\t\t\t\tif (mention) {\n\t\t\t\t\tthis.showAlertDetails(mention.alertKey);\n\t\t\t\t}\n\t\t\t},\n\n\t\t\tcloseAlertModal() {\n\t\t\t\tthis.saveCommentDraft();\n\t\t\t\tthis.unsubscribeFromAlertUpdates();\n\t\t\t\tthis.alertUpdatesStatus = '';\n\t\t\t\tthis.alertViewers = [];\n\t\t\t\tthis.alertUpdatesRetryDelay = 0;\n\t\t\t\tthis.showAlertModal = false;\n\t\t\t\tthis.alertDetails = null;\n\t\t\t\tthis.currentAlertTab = 'overview';\n\t\t\t\tthis.alertActivity = [];\n\t\t\t\tthis.alertActivityCursor = '';\n\t\t\t\tthis.showAlertExplain = false;\n\t\t\t\tthis.alertExplain = null;\n\t\t\t\t\n\t\t\t\tthis.newCommentContent = '';\n\t\t\t\tthis.commentDraftSavedAt = null;\n\t\t\t\tthis.commentPreview = false;\n\t\t\t\tthis.commentTagFilter = '';\n\t\t\t\tthis.taggedComments = [];\n\t\t\t\tthis.commentSubmitting = false;\n\t\t\t\tthis.commentDeleting = {};\n\t\t\t\t\n\t\t\t\tif (window.location.pathname.includes('/alert/')) {\n\t\t\t\t\twindow.history.pushState({}, '', '/dashboard');\n\t\t\t\t}\n\t\t\t},\n\n\t\t\tacknowledgeCurrentAlert() {\n\t\t\t\tif (this.alertDetails?.alert) {\n\t\t\t\t\tthis.currentAckAlert = this.alertDetails.alert;\n\t\t\t\t\tthis.ackAction = 'single';\n\t\t\t\t\tthis.ackReason = '';\n\t\t\t\t\tthis.ackDuration = '';\n\t\t\t\t\tthis.ackRenotify = false;\n\t\t\t\t\tthis.ackError = '';\n\t\t\t\t\tthis.showAckModal = true;\n\t\t\t\t}\n\t\t\t},\n\n\t\t\tescalateCurrentAlert() {\n\t\t\t\tif (!this.alertDetails?.alert) {\n\t\t\t\t\treturn;\n\t\t\t\t}\n\t\t\t\tthis.escalateTargetType = 'user';\n\t\t\t\tthis.escalateTarget = '';\n\t\t\t\tthis.escalateUserQuery = '';\n\t\t\t\tthis.escalateUserResults = [];\n\t\t\t\tthis.escalateReason = '';\n\t\t\t\tthis.escalateError = '';\n\t\t\t\tthis.showEscalateModal = true;\n\t\t\t},\n\n\t\t\tcancelEscalation() {\n\t\t\t\tif (this.escalateSubmitting) {\n\t\t\t\t\treturn;\n\t\t\t\t}\n\t\t\t\tthis.showEscalateModal = false;\n\t\t\t\tthis.escalateError = '';\n\t\t\t},\n\n\t\t\tasync searchEscalationUsers() {\n\t\t\t\tconst query = this.escalateUserQuery.trim();\n\t\t\t\tthis.escalateTarget = '';\n\t\t\t\tif (!query) {\n\t\t\t\t\tthis.escalateUserResults = [];\n\t\t\t\t\treturn;\n\t\t\t\t}\n\n\t\t\t\ttry {\n\t\t\t\t\tconst response = await fetch(`/api/v1/users/search?q=${encodeURIComponent(query)}`, {\n\t\t\t\t\t\tcredentials: 'include'\n\t\t\t\t\t});\n\t\t\t\t\tconst result = await response.json();\n\t\t\t\t\tthis.escalateUserResults = result.success ? (result.data.users || []) : [];\n\t\t\t\t} catch (error) {\n\t\t\t\t\tconsole.error('Error searching users:', error);\n\t\t\t\t\tthis.escalateUserResults = [];\n\t\t\t\t}\n\t\t\t},\n\n\t\t\tasync loadEscalationGroups() {\n\t\t\t\ttry {\n\t\t\t\t\tconst response = await fetch('/api/v1/oauth/groups', {\n\t\t\t\t\t\tcredentials: 'include'\n\t\t\t\t\t});\n\t\t\t\t\tconst result = await response.json();\n\t\t\t\t\tthis.escalateGroups = result.success ? (result.data.groups || []) : [];\n\t\t\t\t} catch (error) {\n\t\t\t\t\tconsole.error('Error loading groups:', error);\n\t\t\t\t\tthis.escalateGroups = [];\n\t\t\t\t}\n\t\t\t},\n\n\t\t\tasync submitEscalation() {\n\t\t\t\tconst fingerprint = this.alertDetails?.alert?.fingerprint;\n\t\t\t\tif (!fingerprint || !this.escalateTarget) {\n\t\t\t\t\tthis.escalateError = 'Please choose who to escalate to';\n\t\t\t\t\treturn;\n\t\t\t\t}\n\n\t\t\t\tthis.escalateSubmitting = true;\n\t\t\t\tthis.escalateError = '';\n\n\t\t\t\ttry {\n\t\t\t\t\tconst response = await fetch(`/api/v1/dashboard/alert/${fingerprint}/escalate`, {\n\t\t\t\t\t\tmethod: 'POST',\n\t\t\t\t\t\theaders: { 'Content-Type': 'application/json' },\n\t\t\t\t\t\tcredentials: 'include',\n\t\t\t\t\t\tbody: JSON.stringify({\n\t\t\t\t\t\t\tescalatedTo: this.escalateTarget,\n\t\t\t\t\t\t\ttargetType: this.escalateTargetType,\n\t\t\t\t\t\t\treason: this.escalateReason.trim()\n\t\t\t\t\t\t})\n\t\t\t\t\t});\n\n\t\t\t\t\tif (window.dashboardInstance && window.dashboardInstance.handleAuthError(response)) {\n\t\t\t\t\t\treturn;\n\t\t\t\t\t}\n\n\t\t\t\t\tconst result = await response.json();\n\t\t\t\t\tif (!result.success) {\n\t\t\t\t\t\tthis.escalateError = result.error || 'Failed to escalate alert';\n\t\t\t\t\t\treturn;\n\t\t\t\t\t}\n\n\t\t\t\t\tthis.addEscalation(result.data);\n\t\t\t\t\tthis.escalateSubmitting = false;\n\t\t\t\t\tthis.cancelEscalation();\n\t\t\t\t} catch (error) {\n\t\t\t\t\tconsole.error('Error escalating alert:', error);\n\t\t\t\t\tthis.escalateError = 'Network error: Failed to escalate alert';\n\t\t\t\t} finally {\n\t\t\t\t\tthis.escalateSubmitting = false;\n\t\t\t\t}\n\t\t\t},\n\n\t\t\t// Escalations arrive both from our own request and from the live\n\t\t\t// update stream, so skip ones we already have\n\t\t\taddEscalation(escalation) {\n\t\t\t\tif (!this.alertDetails || !escalation) {\n\t\t\t\t\treturn;\n\t\t\t\t}\n\t\t\t\tconst escalations = this.alertDetails.escalations || [];\n\t\t\t\tif (!escalations.some(e => e.id === escalation.id)) {\n\t\t\t\t\tthis.alertDetails.escalations = [escalation, ...escalations];\n\t\t\t\t}\n\t\t\t},\n\n\t\t\tsilenceCurrentAlert() {\n\t\t\t\tif (this.alertDetails?.alert) {\n\t\t\t\t\tthis.currentSilenceAlert = this.alertDetails.alert;\n\t\t\t\t\tthis.silenceLabelChoices = this.buildSilenceLabelChoices(this.alertDetails.alert);\n\t\t\t\t\tthis.silenceCustomMatchers = '';\n\t\t\t\t\tthis.silenceAction = 'single';\n\t\t\t\t\tthis.silenceReason = '';\n\t\t\t\t\tthis.silenceError = '';\n\t\t\t\t\tthis.silenceMode = 'create';\n\t\t\t\t\tthis.silenceDuration = '1h';\n\t\t\t\t\tthis.silenceDurationType = 'preset';\n\t\t\t\t\tthis.customSilenceDuration = '';\n\t\t\t\t\tthis.customDurationError = '';\n\t\t\t\t\tthis.showSilenceModal = true;\n\t\t\t\t}\n\t\t\t},\n\n\t\t\tunsilenceCurrentAlert() {\n\t\t\t\tif (this.alertDetails?.alert) {\n\t\t\t\t\tthis.processUnsilenceAction(this.alertDetails.alert.fingerprint);\n\t\t\t\t}\n\t\t\t},\n\n\t\t\tasync processUnsilenceAction(fingerprint) {\n\t\t\t\ttry {\n\t\t\t\t\tconst response = await fetch('/api/v1/dashboard/bulk-action', {\n\t\t\t\t\t\tmethod: 'POST',\n\t\t\t\t\t\tcredentials: 'include',\n\t\t\t\t\t\theaders: {\n\t\t\t\t\t\t\t'Content-Type': 'application/json',\n\t\t\t\t\t\t},\n\t\t\t\t\t\tbody: JSON.stringify({\n\t\t\t\t\t\t\taction: 'unsilence',\n\t\t\t\t\t\t\talertFingerprints: [fingerprint],\n\t\t\t\t\t\t\tcomment: 'Unsilenced from alert details'\n\t\t\t\t\t\t})\n\t\t\t\t\t});\n\n\t\t\t\t\tconst result = await response.json();\n\t\t\t\t\t\n\t\t\t\t\tif (result.success) {\n\t\t\t\t\t\tconsole.log('Alert unsilenced successfully');\n\t\t\t\t\t\t// Refresh alert details to show updated state\n\t\t\t\t\t\tif (this.alertDetails?.alert?.fingerprint) {\n\t\t\t\t\t\t\tawait this.showAlertDetails(this.alertDetails.alert.fingerprint);\n\t\t\t\t\t\t}\n\t\t\t\t\t} else {\n\t\t\t\t\t\tconsole.error('Failed to unsilence alert: ' + (result.error || 'Unknown error'));\n\t\t\t\t\t}\n\t\t\t\t} catch (error) {\n\t\t\t\t\tconsole.error('Error unsilencing alert:', error);\n\t\t\t\t\tconsole.error('Failed to unsilence alert');\n\t\t\t\t}\n\t\t\t},\n\n\t\t\tisAlertSilenced(alert) {\n\t\t\t\tif (!alert) return false;\n\t\t\t\treturn alert.status?.state === 'suppressed' || \n\t\t\t\t\t   alert.status?.state === 'silenced' || \n\t\t\t\t\t   (alert.status?.silencedBy && alert.status.silencedBy.length > 0);\n\t\t\t},\n\n\t\t\tgetSilenceButtonText(alert) {\n\t\t\t\tif (!alert) return 'Unsilence';\n\t\t\t\tconst silenceCount = alert.status?.silencedBy?.length || 0;\n\t\t\t\treturn silenceCount > 1 ? `Unsilence (${silenceCount})` : 'Unsilence';\n\t\t\t},\n\n\t\t\t// renderCommentMarkdown turns comment text into HTML for the comment\n\t\t\t// list and the editor preview. It supports a small Markdown subset\n\t\t\t// (paragraphs, > quotes, - lists, ``` code blocks) and escapes the\n\t\t\t// text first, so comments can't inject markup.\n\t\t\trenderCommentMarkdown(text) {\n\t\t\t\tconst lines = String(text || '').split('\\n');\n\t\t\t\tconst startsBlock = (line) => /^(>|\\s*[-*]\\s+|\\s*\\x60{3})/.test(line);\n\t\t\t\tconst blocks = [];\n\t\t\t\tlet i = 0;\n\t\t\t\twhile (i < lines.length) {\n\t\t\t\t\tconst line = lines[i];\n\t\t\t\t\tif (line.trim().startsWith('\\x60\\x60\\x60')) {\n\t\t\t\t\t\tconst code = [];\n\t\t\t\t\t\tfor (i++; i < lines.length && !lines[i].trim().startsWith('\\x60\\x60\\x60'); i++) {\n\t\t\t\t\t\t\tcode.push(lines[i]);\n\t\t\t\t\t\t}\n\t\t\t\t\t\ti++; // closing fence\n\t\t\t\t\t\tblocks.push(`<pre class=\"bg-gray-100 dark:bg-gray-700 rounded p-2 my-1 overflow-x-auto font-mono text-xs\">${this.escapeHtml(code.join('\\n'))}</pre>`);\n\t\t\t\t\t} else if (line.startsWith('>')) {\n\t\t\t\t\t\tconst quoted = [];\n\t\t\t\t\t\tfor (; i < lines.length && lines[i].startsWith('>'); i++) {\n\t\t\t\t\t\t\tquoted.push(lines[i].replace(/^>\\s?/, ''));\n\t\t\t\t\t\t}\n\t\t\t\t\t\tblocks.push(`<blockquote class=\"border-l-4 border-gray-300 dark:border-gray-600 pl-3 my-1 italic text-gray-500 dark:text-gray-400\">${this.renderCommentMarkdown(quoted.join('\\n'))}</blockquote>`);\n\t\t\t\t\t} else if (/^\\s*[-*]\\s+/.test(line)) {\n\t\t\t\t\t\tconst items = [];\n\t\t\t\t\t\tfor (; i < lines.length && /^\\s*[-*]\\s+/.test(lines[i]); i++) {\n\t\t\t\t\t\t\titems.push(`<li>${this.renderCommentInline(lines[i].replace(/^\\s*[-*]\\s+/, ''))}</li>`);\n\t\t\t\t\t\t}\n\t\t\t\t\t\tblocks.push(`<ul class=\"list-disc ml-4 my-1\">${items.join('')}</ul>`);\n\t\t\t\t\t} else if (!line.trim()) {\n\t\t\t\t\t\ti++;\n\t\t\t\t\t} else {\n\t\t\t\t\t\tconst paragraph = [];\n\t\t\t\t\t\tfor (; i < lines.length && lines[i].trim() && (paragraph.length === 0 || !startsBlock(lines[i])); i++) {\n\t\t\t\t\t\t\tparagraph.push(this.renderCommentInline(lines[i]));\n\t\t\t\t\t\t}\n\t\t\t\t\t\tblocks.push(`<p class=\"my-1\">${paragraph.join('<br>')}</p>`);\n\t\t\t\t\t}\n\t\t\t\t}\n\t\t\t\treturn blocks.join('');\n\t\t\t},\n\n\t\t\t// renderCommentInline renders **bold**, *italic*, `code` and\n\t\t\t// [links](https://…) in one line, and highlights @mentions. #tags\n\t\t\t// become chips that filterCommentsByTag when clicked in the list.\n\t\t\t// A backslash keeps the next character literal, as in the \\> lines\n\t\t\t// written by the Quote button.\n\t\t\trenderCommentInline(text) {\n\t\t\t\tconst held = [];\n\t\t\t\tconst hold = (html) => `\\u0000${held.push(html) - 1}\\u0000`;\n\n\t\t\t\tlet html = String(text).replace(/\\u0000/g, '')\n\t\t\t\t\t.replace(/\\\\([\\\\`*_>#@\\[\\]()])/g, (_, char) => hold(this.escapeHtml(char)))\n\t\t\t\t\t.replace(/`([^`]+)`/g, (_, code) => hold(`<code class=\"bg-gray-100 dark:bg-gray-700 rounded px-1 font-mono text-xs\">${this.escapeHtml(code)}</code>`));\n\n\t\t\t\thtml = this.escapeHtml(html)\n\t\t\t\t\t.replace(/\\[([^\\]]+)\\]\\((https?:\\/\\/[^\\s)]+)\\)/g, (_, label, url) => hold(`<a href=\"${url}\" target=\"_blank\" rel=\"noopener noreferrer\" class=\"text-blue-600 dark:text-blue-400 underline\">${label}</a>`))\n\t\t\t\t\t.replace(/\\*\\*([^*]+)\\*\\*/g, '<strong>$1</strong>')\n\t\t\t\t\t.replace(/\\*([^*\\s](?:[^*]*[^*\\s])?)\\*/g, '<em>$1</em>')\n\t\t\t\t\t.replace(/(^|[^\\w.+@-])@(\\w[\\w.+-]*)/g, (_, before, name) => {\n\t\t\t\t\t\tconst username = name.replace(/\\.+$/, '');\n\t\t\t\t\t\tconst rest = name.slice(username.length);\n\t\t\t\t\t\treturn `${before}<span class=\"rounded px-1 font-semibold bg-blue-100 text-blue-700 dark:bg-blue-900/50 dark:text-blue-400\">@${username}</span>${rest}`;\n\t\t\t\t\t})\n\t\t\t\t\t// & excluded so escaped entities like &#039; aren't taken for tags\n\t\t\t\t\t.replace(/(^|[^\\w&])#([A-Za-z][\\w-]*)/g, (_, before, tag) => `${before}<button type=\"button\" data-comment-tag=\"${tag.toLowerCase()}\" title=\"Show comments tagged #${tag}\" class=\"rounded px-1 font-semibold bg-purple-50 text-purple-600 hover:bg-purple-100 dark:bg-purple-900/30 dark:text-purple-400\">#${tag}</button>`);\n\n\t\t\t\twhile (html.includes('\\u0000')) {\n\t\t\t\t\thtml = html.replace(/\\u0000(\\d+)\\u0000/g, (_, index) => held[index]);\n\t\t\t\t}\n\t\t\t\treturn html;\n\t\t\t},\n\n\t\t\t// Comment Management Functions\n\t\t\tquoteComment(comment) {\n\t\t\t\tif (!comment) {\n\t\t\t\t\treturn;\n\t\t\t\t}\n\n\t\t\t\tconst quote = this.formatCommentQuote(comment);\n\t\t\t\tconst draft = this.newCommentContent.trimEnd();\n\t\t\t\tthis.newCommentContent = draft ? `${draft}\\n\\n${quote}` : quote;\n\t\t\t\tthis.saveCommentDraft();\n\t\t\t\tthis.commentPreview = false;\n\n\t\t\t\tthis.$nextTick(() => {\n\t\t\t\t\tconst input = this.$refs.commentInput;\n\t\t\t\t\tif (input) {\n\t\t\t\t\t\tinput.focus();\n\t\t\t\t\t\tinput.setSelectionRange(input.value.length, input.value.length);\n\t\t\t\t\t\tinput.scrollIntoView({ behavior: 'smooth', block: 'center' });\n\t\t\t\t\t}\n\t\t\t\t});\n\t\t\t},\n\n\t\t\t// Unposted comments are kept in localStorage per user and alert, so\n\t\t\t// closing the modal by accident doesn't lose them. Drafts older than\n\t\t\t// commentDraftMaxAge are dropped when next looked up.\n\t\t\tcommentDraftMaxAge: 7 * 24 * 60 * 60 * 1000,\n\n\t\t\tcommentDraftKey(fingerprint) {\n\t\t\t\tconst userId = this.currentUser?.id || 'anonymous';\n\t\t\t\treturn `notificator_comment_draft_${userId}_${fingerprint}`;\n\t\t\t},\n\n\t\t\tsaveCommentDraft() {\n\t\t\t\tconst fingerprint = this.alertDetails?.alert?.fingerprint;\n\t\t\t\tif (!fingerprint) {\n\t\t\t\t\treturn;\n\t\t\t\t}\n\t\t\t\tif (!this.newCommentContent.trim()) {\n\t\t\t\t\tthis.clearCommentDraft(fingerprint);\n\t\t\t\t\treturn;\n\t\t\t\t}\n\t\t\t\tconst savedAt = Date.now();\n\t\t\t\ttry {\n\t\t\t\t\tlocalStorage.setItem(this.commentDraftKey(fingerprint), JSON.stringify({ content: this.newCommentContent, savedAt }));\n\t\t\t\t\tthis.commentDraftSavedAt = savedAt;\n\t\t\t\t} catch (error) {\n\t\t\t\t\tconsole.error('Error saving comment draft:', error);\n\t\t\t\t}\n\t\t\t},\n\n\t\t\trestoreCommentDraft(fingerprint) {\n\t\t\t\tthis.newCommentContent = '';\n\t\t\t\tthis.commentDraftSavedAt = null;\n\t\t\t\ttry {\n\t\t\t\t\tconst draft = JSON.parse(localStorage.getItem(this.commentDraftKey(fingerprint)) || 'null');\n\t\t\t\t\tif (!draft || typeof draft.content !== 'string') {\n\t\t\t\t\t\treturn;\n\t\t\t\t\t}\n\t\t\t\t\tif (Date.now() - draft.savedAt > this.commentDraftMaxAge) {\n\t\t\t\t\t\tthis.clearCommentDraft(fingerprint);\n\t\t\t\t\t\treturn;\n\t\t\t\t\t}\n\t\t\t\t\tthis.newCommentContent = draft.content;\n\t\t\t\t\tthis.commentDraftSavedAt = draft.savedAt;\n\t\t\t\t} catch (error) {\n\t\t\t\t\tconsole.error('Error restoring comment draft:', error);\n\t\t\t\t}\n\t\t\t},\n\n\t\t\tclearCommentDraft(fingerprint) {\n\t\t\t\tlocalStorage.removeItem(this.commentDraftKey(fingerprint));\n\t\t\t\tthis.commentDraftSavedAt = null;\n\t\t\t},\n\n\t\t\t// maxCommentLength is the backend's limit, sent with the alert details.\n\t\t\t// Lengths are counted in code points like the backend does.\n\t\t\tmaxCommentLength() {\n\t\t\t\treturn this.alertDetails?.maxCommentLength || 1000;\n\t\t\t},\n\n\t\t\tcommentTooLong() {\n\t\t\t\treturn [...this.newCommentContent].length > this.maxCommentLength();\n\t\t\t},\n\n\t\t\tasync addComment() {\n\t\t\t\tif (!this.newCommentContent.trim()) {\n\t\t\t\t\tconsole.log('Please enter a comment');\n\t\t\t\t\treturn;\n\t\t\t\t}\n\n\t\t\t\tif (!this.alertDetails?.alert?.fingerprint) {\n\t\t\t\t\tconsole.error('Alert information not available');\n\t\t\t\t\treturn;\n\t\t\t\t}\n\n\t\t\t\tthis.commentSubmitting = true;\n\t\t\t\t\n\t\t\t\ttry {\n\t\t\t\t\tconst response = await fetch(`/api/v1/dashboard/alert/${this.alertDetails.alert.fingerprint}/comments`, {\n\t\t\t\t\t\tmethod: 'POST',\n\t\t\t\t\t\tcredentials: 'include',\n\t\t\t\t\t\theaders: {\n\t\t\t\t\t\t\t'Content-Type': 'application/json',\n\t\t\t\t\t\t},\n\t\t\t\t\t\tbody: JSON.stringify({\n\t\t\t\t\t\t\tcontent: this.newCommentContent.trim()\n\t\t\t\t\t\t})\n\t\t\t\t\t});\n\n\t\t\t\t\t// Check for authentication errors and redirect if needed\n\t\t\t\t\tif (window.dashboardInstance && window.dashboardInstance.handleAuthError(response)) {\n\t\t\t\t\t\treturn;\n\t\t\t\t\t}\n\n\t\t\t\t\tconst result = await response.json();\n\t\t\t\t\t\n\t\t\t\t\tif (result.success) {\n\t\t\t\t\t\tconsole.log('Comment added successfully');\n\t\t\t\t\t\tthis.newCommentContent = '';\n\t\t\t\t\t\tthis.commentPreview = false;\n\t\t\t\t\t\tthis.clearCommentDraft(this.alertDetails.alert.fingerprint);\n\t\t\t\t\t\t\n\t\t\t\t\t\t// Refresh alert details to show the new comment\n\t\t\t\t\t\tawait this.refreshComments();\n\t\t\t\t\t} else {\n\t\t\t\t\t\tconsole.error('Failed to add comment: ' + result.error);\n\t\t\t\t\t}\n\t\t\t\t} catch (error) {\n\t\t\t\t\tconsole.error('Error adding comment:', error);\n\t\t\t\t\tconsole.error('Failed to add comment');\n\t\t\t\t} finally {\n\t\t\t\t\tthis.commentSubmitting = false;\n\t\t\t\t}\n\t\t\t},\n\n\t\t\tasync deleteComment(commentId) {\n\t\t\t\tif (!commentId || !this.alertDetails?.alert?.fingerprint) {\n\t\t\t\t\tconsole.error('Comment information not available');\n\t\t\t\t\treturn;\n\t\t\t\t}\n\n\t\t\t\tif (!confirm('Delete this comment? This action cannot be undone.')) {\n\t\t\t\t\treturn;\n\t\t\t\t}\n\n\t\t\t\tthis.commentDeleting[commentId] = true;\n\t\t\t\t\n\t\t\t\ttry {\n\t\t\t\t\tconst response = await fetch(`/api/v1/dashboard/alert/${this.alertDetails.alert.fingerprint}/comments/${commentId}`, {\n\t\t\t\t\t\tmethod: 'DELETE',\n\t\t\t\t\t\tcredentials: 'include'\n\t\t\t\t\t});\n\n\t\t\t\t\t// Check for authentication errors and redirect if needed\n\t\t\t\t\tif (window.dashboardInstance && window.dashboardInstance.handleAuthError(response)) {\n\t\t\t\t\t\treturn;\n\t\t\t\t\t}\n\n\t\t\t\t\tconst result = await response.json();\n\t\t\t\t\t\n\t\t\t\t\tif (result.success) {\n\t\t\t\t\t\tconsole.log('Comment deleted successfully');\n\t\t\t\t\t\t\n\t\t\t\t\t\t// Refresh alert details to remove the deleted comment\n\t\t\t\t\t\tawait this.refreshComments();\n\t\t\t\t\t\tconst listed = this.alerts.find(a => a.fingerprint === this.alertDetails?.alert?.fingerprint);\n\t\t\t\t\t\tif (listed) {\n\t\t\t\t\t\t\tlisted.commentCount = this.alertDetails.commentsTotal;\n\t\t\t\t\t\t}\n\t\t\t\t\t} else {\n\t\t\t\t\t\tconsole.error('Failed to delete comment: ' + result.error);\n\t\t\t\t\t}\n\t\t\t\t} catch (error) {\n\t\t\t\t\tconsole.error('Error deleting comment:', error);\n\t\t\t\t\tconsole.error('Failed to delete comment');\n\t\t\t\t} finally {\n\t\t\t\t\t// Remove deleting state for this comment\n\t\t\t\t\tdelete this.commentDeleting[commentId];\n\t\t\t\t}\n\t\t\t},\n\n\t\t\tasync removeAcknowledgment(ackId) {\n\t\t\t\tconst fingerprint = this.alertDetails?.alert?.fingerprint;\n\t\t\t\tif (!ackId || !fingerprint) {\n\t\t\t\t\treturn;\n\t\t\t\t}\n\n\t\t\t\tif (!confirm('Remove this acknowledgment?')) {\n\t\t\t\t\treturn;\n\t\t\t\t}\n\n\t\t\t\tthis.ackRemoving[ackId] = true;\n\n\t\t\t\ttry {\n\t\t\t\t\tconst response = await fetch(`/api/v1/dashboard/alert/${fingerprint}/acknowledgments/${ackId}`, {\n\t\t\t\t\t\tmethod: 'DELETE',\n\t\t\t\t\t\tcredentials: 'include'\n\t\t\t\t\t});\n\n\t\t\t\t\tif (window.dashboardInstance && window.dashboardInstance.handleAuthError(response)) {\n\t\t\t\t\t\treturn;\n\t\t\t\t\t}\n\n\t\t\t\t\tconst result = await response.json();\n\n\t\t\t\t\tif (result.success) {\n\t\t\t\t\t\tthis.alertDetails.acknowledgments = (this.alertDetails.acknowledgments || []).filter(a => a.id !== ackId);\n\t\t\t\t\t\tthis.alertDetails.acknowledgmentsTotal = Math.max(0, (this.alertDetails.acknowledgmentsTotal || 0) - 1);\n\t\t\t\t\t\tif (result.data.remaining === 0) {\n\t\t\t\t\t\t\tthis.alertDetails.alert.isAcknowledged = false;\n\t\t\t\t\t\t\tconst listed = this.alerts.find(a => a.fingerprint === fingerprint);\n\t\t\t\t\t\t\tif (listed) {\n\t\t\t\t\t\t\t\tlisted.isAcknowledged = false;\n\t\t\t\t\t\t\t}\n\t\t\t\t\t\t}\n\t\t\t\t\t} else {\n\t\t\t\t\t\tconsole.error('Failed to remove acknowledgment: ' + result.error);\n\t\t\t\t\t}\n\t\t\t\t} catch (error) {\n\t\t\t\t\tconsole.error('Error removing acknowledgment:', error);\n\t\t\t\t} finally {\n\t\t\t\t\tdelete this.ackRemoving[ackId];\n\t\t\t\t}\n\t\t\t},\n\n\t\t\t// loadOlderComments prepends the next page of older comments\n\t\t\tasync loadOlderComments() {\n\t\t\t\tconst fingerprint = this.alertDetails?.alert?.fingerprint;\n\t\t\t\tconst cursor = this.alertDetails?.commentsCursor;\n\t\t\t\tif (!fingerprint || !cursor || this.olderCommentsLoading) {\n\t\t\t\t\treturn;\n\t\t\t\t}\n\n\t\t\t\tthis.olderCommentsLoading = true;\n\n\t\t\t\ttry {\n\t\t\t\t\tconst response = await fetch(`/api/v1/dashboard/alert/${fingerprint}/comments?limit=20&before=${encodeURIComponent(cursor)}`, {\n\t\t\t\t\t\tcredentials: 'include'\n\t\t\t\t\t});\n\t\t\t\t\tconst result = await response.json();\n\n\t\t\t\t\tif (result.success) {\n\t\t\t\t\t\tthis.alertDetails.comments = (result.data.comments || []).concat(this.alertDetails.comments || []);\n\t\t\t\t\t\tthis.alertDetails.commentsTotal = result.data.total;\n\t\t\t\t\t\tthis.alertDetails.commentsCursor = result.data.nextCursor || '';\n\t\t\t\t\t} else {\n\t\t\t\t\t\tconsole.error('Failed to load older comments: ' + result.error);\n\t\t\t\t\t}\n\t\t\t\t} catch (error) {\n\t\t\t\t\tconsole.error('Error loading older comments:', error);\n\t\t\t\t} finally {\n\t\t\t\t\tthis.olderCommentsLoading = false;\n\t\t\t\t}\n\t\t\t},\n\n\t\t\t// loadOlderAcknowledgments appends the next page of older acknowledgments\n\t\t\tasync loadOlderAcknowledgments() {\n\t\t\t\tconst fingerprint = this.alertDetails?.alert?.fingerprint;\n\t\t\t\tconst cursor = this.alertDetails?.acknowledgmentsCursor;\n\t\t\t\tif (!fingerprint || !cursor || this.olderAcknowledgmentsLoading) {\n\t\t\t\t\treturn;\n\t\t\t\t}\n\n\t\t\t\tthis.olderAcknowledgmentsLoading = true;\n\n\t\t\t\ttry {\n\t\t\t\t\tconst response = await fetch(`/api/v1/dashboard/alert/${fingerprint}/acknowledgments?limit=20&before=${encodeURIComponent(cursor)}`, {\n\t\t\t\t\t\tcredentials: 'include'\n\t\t\t\t\t});\n\t\t\t\t\tconst result = await response.json();\n\n\t\t\t\t\tif (result.success) {\n\t\t\t\t\t\tthis.alertDetails.acknowledgments = (this.alertDetails.acknowledgments || []).concat(result.data.acknowledgments || []);\n\t\t\t\t\t\tthis.alertDetails.acknowledgmentsTotal = result.data.total;\n\t\t\t\t\t\tthis.alertDetails.acknowledgmentsCursor = result.data.nextCursor || '';\n\t\t\t\t\t} else {\n\t\t\t\t\t\tconsole.error('Failed to load older acknowledgments: ' + result.error);\n\t\t\t\t\t}\n\t\t\t\t} catch (error) {\n\t\t\t\t\tconsole.error('Error loading older acknowledgments:', error);\n\t\t\t\t} finally {\n\t\t\t\t\tthis.olderAcknowledgmentsLoading = false;\n\t\t\t\t}\n\t\t\t},\n\n\t\t\t// Copies the comments and acknowledgments kept from the alert's last\n\t\t\t// resolution back onto it; the backend refuses a second restore\n\t\t\tasync restorePreviousDiscussion() {\n\t\t\t\tconst fingerprint = this.alertDetails?.alert?.fingerprint;\n\t\t\t\tif (!fingerprint || this.discussionRestoring) {\n\t\t\t\t\treturn;\n\t\t\t\t}\n\n\t\t\t\tthis.discussionRestoring = true;\n\t\t\t\ttry {\n\t\t\t\t\tconst response = await fetch(`/api/v1/dashboard/alert/${fingerprint}/restore-discussion`, {\n\t\t\t\t\t\tmethod: 'POST',\n\t\t\t\t\t\tcredentials: 'include'\n\t\t\t\t\t});\n\n\t\t\t\t\tif (window.dashboardInstance && window.dashboardInstance.handleAuthError(response)) {\n\t\t\t\t\t\treturn;\n\t\t\t\t\t}\n\n\t\t\t\t\tconst result = await response.json();\n\t\t\t\t\tif (!result.success) {\n\t\t\t\t\t\tconsole.error('Failed to restore previous discussion: ' + result.error);\n\t\t\t\t\t\treturn;\n\t\t\t\t\t}\n\t\t\t\t\tthis.alertDetails.previousDiscussion = null;\n\t\t\t\t\tawait this.refreshComments();\n\t\t\t\t} catch (error) {\n\t\t\t\t\tconsole.error('Error restoring previous discussion:', error);\n\t\t\t\t} finally {\n\t\t\t\t\tthis.discussionRestoring = false;\n\t\t\t\t}\n\t\t\t},\n\n\t\t\tasync refreshComments() {\n\t\t\t\tif (!this.alertDetails?.alert?.fingerprint) {\n\t\t\t\t\treturn;\n\t\t\t\t}\n\n\t\t\t\ttry {\n\t\t\t\t\tconst response = await fetch(`/api/v1/dashboard/alert/${this.alertDetails.alert.fingerprint}`, {\n\t\t\t\t\t\tcredentials: 'include'\n\t\t\t\t\t});\n\t\t\t\t\tconst result = await response.json();\n\t\t\t\t\t\n\t\t\t\t\tif (result.success) {\n\t\t\t\t\t\t// Update only the comments and acknowledgments and maintain other alert details.\n\t\t\t\t\t\t// This goes back to the most recent page; older entries can be loaded again.\n\t\t\t\t\t\tthis.alertDetails.comments = result.data.comments || [];\n\t\t\t\t\t\tthis.alertDetails.commentsTotal = result.data.commentsTotal || 0;\n\t\t\t\t\t\tthis.alertDetails.commentsCursor = result.data.commentsCursor || '';\n\t\t\t\t\t\tthis.alertDetails.acknowledgments = result.data.acknowledgments || [];\n\t\t\t\t\t\tthis.alertDetails.acknowledgmentsTotal = result.data.acknowledgmentsTotal || 0;\n\t\t\t\t\t\tthis.alertDetails.acknowledgmentsCursor = result.data.acknowledgmentsCursor || '';\n\t\t\t\t\t\t// Update comment count in alert object if it exists\n\t\t\t\t\t\tif (this.alertDetails.alert) {\n\t\t\t\t\t\t\tthis.alertDetails.alert.commentCount = this.alertDetails.commentsTotal;\n\t\t\t\t\t\t}\n\t\t\t\t\t\tif (this.commentTagFilter) {\n\t\t\t\t\t\t\tthis.filterCommentsByTag(this.commentTagFilter);\n\t\t\t\t\t\t}\n\t\t\t\t\t}\n\t\t\t\t} catch (error) {\n\t\t\t\t\tconsole.error('Error refreshing comments:', error);\n\t\t\t\t}\n\t\t\t},\n\n\t\t\t// filterCommentsByTag shows only the open alert's comments carrying\n\t\t\t// #tag, as stored by the backend when they were posted. An empty tag\n\t\t\t// goes back to all comments.\n\t\t\tasync filterCommentsByTag(tag) {\n\t\t\t\tconst fingerprint = this.alertDetails?.alert?.fingerprint;\n\t\t\t\tthis.commentTagFilter = tag || '';\n\t\t\t\tthis.taggedComments = [];\n\t\t\t\tif (!this.commentTagFilter || !fingerprint) {\n\t\t\t\t\treturn;\n\t\t\t\t}\n\n\t\t\t\tthis.taggedCommentsLoading = true;\n\t\t\t\ttry {\n\t\t\t\t\tconst response = await fetch(`/api/v1/dashboard/alert/${fingerprint}/comments?tag=${encodeURIComponent(this.commentTagFilter)}`, {\n\t\t\t\t\t\tcredentials: 'include'\n\t\t\t\t\t});\n\n\t\t\t\t\tif (window.dashboardInstance && window.dashboardInstance.handleAuthError(response)) {\n\t\t\t\t\t\treturn;\n\t\t\t\t\t}\n\n\t\t\t\t\tconst result = await response.json();\n\t\t\t\t\t// A newer filter or another alert replaced this one meanwhile\n\t\t\t\t\tif (this.commentTagFilter !== tag || this.alertDetails?.alert?.fingerprint !== fingerprint) {\n\t\t\t\t\t\treturn;\n\t\t\t\t\t}\n\t\t\t\t\tif (result.success) {\n\t\t\t\t\t\tthis.taggedComments = result.data.comments || [];\n\t\t\t\t\t} else {\n\t\t\t\t\t\tconsole.error('Failed to filter comments: ' + result.error);\n\t\t\t\t\t}\n\t\t\t\t} catch (error) {\n\t\t\t\t\tconsole.error('Error filtering comments:', error);\n\t\t\t\t} finally {\n\t\t\t\t\tthis.taggedCommentsLoading = false;\n\t\t\t\t}\n\t\t\t},\n\n\t\t\tonCommentTagClick(event) {\n\t\t\t\tconst chip = event.target.closest('[data-comment-tag]');\n\t\t\t\tif (chip) {\n\t\t\t\t\tthis.filterCommentsByTag(chip.dataset.commentTag);\n\t\t\t\t}\n\t\t\t},\n\n\t\t\t// Shareable link opening this alert's details (see AlertLinkPage)\n\t\t\talertShareLink(fingerprint) {\n\t\t\t\treturn `${window.location.origin}/alert/${encodeURIComponent(fingerprint)}`;\n\t\t\t},\n\n\t\t\t// Opens the share dialog, or just copies the link when there is\n\t\t\t// nowhere else to share to\n\t\t\tasync shareCurrentAlert() {\n\t\t\t\tconst fingerprint = this.alertDetails?.alert?.fingerprint;\n\t\t\t\tif (!fingerprint) return;\n\n\t\t\t\tif (this.shareTargets === null) {\n\t\t\t\t\tawait this.loadShareTargets();\n\t\t\t\t}\n\t\t\t\tif (!this.shareTargets.comments && !this.shareTargets.slack && !this.shareTargets.email) {\n\t\t\t\t\tthis.copyShareLink();\n\t\t\t\t\treturn;\n\t\t\t\t}\n\n\t\t\t\tthis.shareNote = '';\n\t\t\t\tthis.shareStatus = '';\n\t\t\t\tthis.shareError = '';\n\t\t\t\tthis.showShareModal = true;\n\t\t\t},\n\n\t\t\tasync loadShareTargets() {\n\t\t\t\ttry {\n\t\t\t\t\tconst response = await fetch('/api/v1/dashboard/share/targets', { credentials: 'include' });\n\t\t\t\t\tconst result = await response.json();\n\t\t\t\t\tthis.shareTargets = result.success ? result.data : { comments: false, slack: false, email: false };\n\t\t\t\t} catch (error) {\n\t\t\t\t\tconsole.error('Error loading share targets:', error);\n\t\t\t\t\tthis.shareTargets = { comments: false, slack: false, email: false };\n\t\t\t\t}\n\t\t\t},\n\n\t\t\tcopyShareLink() {\n\t\t\t\tconst fingerprint = this.alertDetails?.alert?.fingerprint;\n\t\t\t\tif (!fingerprint) return;\n\n\t\t\t\tthis.copyToClipboard(this.alertShareLink(fingerprint));\n\t\t\t\tthis.alertLinkCopied = true;\n\t\t\t\tthis.shareStatus = 'Link copied to the clipboard';\n\t\t\t\tsetTimeout(() => { this.alertLinkCopied = false; }, 2000);\n\t\t\t},\n\n\t\t\tasync shareAsComment() {\n\t\t\t\tconst fingerprint = this.alertDetails?.alert?.fingerprint;\n\t\t\t\tif (!fingerprint) return;\n\n\t\t\t\tconst note = this.shareNote.trim();\n\t\t\t\tconst link = this.alertShareLink(fingerprint);\n\t\t\t\tawait this.sendShare(`/api/v1/dashboard/alert/${fingerprint}/comments`,\n\t\t\t\t\t{ content: note ? `${note}\\n\\n${link}` : `🔗 ${link}` },\n\t\t\t\t\t'Posted as a comment');\n\t\t\t\tif (!this.shareError) {\n\t\t\t\t\tawait this.refreshComments();\n\t\t\t\t}\n\t\t\t},\n\n\t\t\tasync shareToSlack() {\n\t\t\t\tconst fingerprint = this.alertDetails?.alert?.fingerprint;\n\t\t\t\tif (!fingerprint) return;\n\n\t\t\t\tawait this.sendShare(`/api/v1/dashboard/alert/${fingerprint}/share/slack`,\n\t\t\t\t\t{ note: this.shareNote.trim() },\n\t\t\t\t\tthis.shareTargets.slackChannel ? `Sent to ${this.shareTargets.slackChannel}` : 'Sent to Slack');\n\t\t\t},\n\n\t\t\tasync shareByEmail() {\n\t\t\t\tconst fingerprint = this.alertDetails?.alert?.fingerprint;\n\t\t\t\tif (!fingerprint) return;\n\n\t\t\t\tawait this.sendShare(`/api/v1/dashboard/alert/${fingerprint}/share/email`,\n\t\t\t\t\t{ note: this.shareNote.trim() },\n\t\t\t\t\t'Sent by email');\n\t\t\t},\n\n\t\t\tasync sendShare(url, body, successMessage) {\n\t\t\t\tthis.shareSending = true;\n\t\t\t\tthis.shareStatus = '';\n\t\t\t\tthis.shareError = '';\n\t\t\t\ttry {\n\t\t\t\t\tconst response = await fetch(url, {\n\t\t\t\t\t\tmethod: 'POST',\n\t\t\t\t\t\tcredentials: 'include',\n\t\t\t\t\t\theaders: { 'Content-Type': 'application/json' },\n\t\t\t\t\t\tbody: JSON.stringify(body)\n\t\t\t\t\t});\n\n\t\t\t\t\tif (window.dashboardInstance && window.dashboardInstance.handleAuthError(response)) {\n\t\t\t\t\t\treturn;\n\t\t\t\t\t}\n\n\t\t\t\t\tconst result = await response.json();\n\t\t\t\t\tif (result.success) {\n\t\t\t\t\t\tthis.shareStatus = successMessage;\n\t\t\t\t\t} else {\n\t\t\t\t\t\tthis.shareError = result.error || 'Failed to share the alert';\n\t\t\t\t\t}\n\t\t\t\t} catch (error) {\n\t\t\t\t\tconsole.error('Error sharing alert:', error);\n\t\t\t\t\tthis.shareError = 'Network error: failed to share the alert';\n\t\t\t\t} finally {\n\t\t\t\t\tthis.shareSending = false;\n\t\t\t\t}\n\t\t\t},\n\n\t\t\t// mailto: link drafting an email in the user's mail client, for when\n\t\t\t// the server has no SMTP settings to send it with\n\t\t\tshareEmailHref() {\n\t\t\t\tconst alert = this.alertDetails?.alert;\n\t\t\t\tif (!alert) return '#';\n\n\t\t\t\tconst subject = `[${alert.severity || 'alert'}] ${alert.alertName}`;\n\t\t\t\tconst note = this.shareNote.trim();\n\t\t\t\tconst body = (note ? note + '\\n\\n' : '') + this.alertShareLink(alert.fingerprint);\n\t\t\t\treturn `mailto:?subject=${encodeURIComponent(subject)}&body=${encodeURIComponent(body)}`;\n\t\t\t},\n\n\t\t\tcopyAlertAsIssue() {\n\t\t\t\tif (!this.alertDetails?.alert) {\n\t\t\t\t\tconsole.error('No alert data available');\n\t\t\t\t\treturn;\n\t\t\t\t}\n\n\t\t\t\tconst alert = this.alertDetails.alert;\n\t\t\t\tconst comments = this.alertDetails.comments || [];\n\t\t\t\t\n\t\t\t\tconst formatDate = (dateStr) => {\n\t\t\t\t\tif (!dateStr) return 'N/A';\n\t\t\t\t\treturn new Date(dateStr).toLocaleString();\n\t\t\t\t};\n\t\t\t\t\n\t\t\t\tconst calculateDuration = (start, end) => {\n\t\t\t\t\tif (!start) return 'N/A';\n\t\t\t\t\tconst startTime = new Date(start);\n\t\t\t\t\tconst endTime = end ? new Date(end) : new Date();\n\t\t\t\t\tconst diffMs = endTime - startTime;\n\t\t\t\t\t\n\t\t\t\t\tconst hours = Math.floor(diffMs / (1000 * 60 * 60));\n\t\t\t\t\tconst minutes = Math.floor((diffMs % (1000 * 60 * 60)) / (1000 * 60));\n\t\t\t\t\t\n\t\t\t\t\tif (hours > 0) {\n\t\t\t\t\t\treturn `${hours}h ${minutes}m`;\n\t\t\t\t\t}\n\t\t\t\t\treturn `${minutes}m`;\n\t\t\t\t};\n\n\t\t\t\t// Build markdown content\n\t\t\t\tlet markdown = `# Alert: ${alert.alertname || alert.labels?.alertname || 'Unknown'}\\n\\n`;\n\t\t\t\t\n\t\t\t\t// Summary section\n\t\t\t\tif (alert.summary) {\n\t\t\t\t\tmarkdown += `## Summary\\n${alert.summary}\\n\\n`;\n\t\t\t\t}\n\n\t\t\t\t// Details section\n\t\t\t\tmarkdown += `## Details\\n`;\n\t\t\t\tmarkdown += `- **Status**: ${(alert.status?.state || 'unknown').toUpperCase()}\\n`;\n\t\t\t\tmarkdown += `- **Severity**: ${(alert.severity || 'unknown').toUpperCase()}\\n`;\n\t\t\t\tif (alert.instance) {\n\t\t\t\t\tmarkdown += `- **Instance**: ${alert.instance}\\n`;\n\t\t\t\t}\n\t\t\t\tmarkdown += `- **Started**: ${formatDate(alert.startsAt)}\\n`;\n\t\t\t\tif (alert.endsAt) {\n\t\t\t\t\tmarkdown += `- **Ended**: ${formatDate(alert.endsAt)}\\n`;\n\t\t\t\t}\n\t\t\t\tmarkdown += `- **Duration**: ${calculateDuration(alert.startsAt, alert.endsAt)}\\n\\n`;\n\n\t\t\t\t// Labels section\n\t\t\t\tif (alert.labels && Object.keys(alert.labels).length > 0) {\n\t\t\t\t\tmarkdown += `## Labels\\n`;\n\t\t\t\t\tObject.entries(alert.labels).forEach(([key, value]) => {\n\t\t\t\t\t\tmarkdown += `- **${key}**: ${value}\\n`;\n\t\t\t\t\t});\n\t\t\t\t\tmarkdown += '\\n';\n\t\t\t\t}\n\n\t\t\t\t// Annotations section\n\t\t\t\tif (alert.annotations && Object.keys(alert.annotations).length > 0) {\n\t\t\t\t\tmarkdown += `## Annotations\\n`;\n\t\t\t\t\tObject.entries(alert.annotations).forEach(([key, value]) => {\n\t\t\t\t\t\tmarkdown += `- **${key}**: ${value}\\n`;\n\t\t\t\t\t});\n\t\t\t\t\tmarkdown += '\\n';\n\t\t\t\t}\n\n\t\t\t\t// Comments section\n\t\t\t\tif (comments.length > 0) {\n\t\t\t\t\tmarkdown += `## Comments\\n`;\n\t\t\t\t\tcomments.forEach(comment => {\n\t\t\t\t\t\tconst commentDate = formatDate(comment.createdAt);\n\t\t\t\t\t\tmarkdown += `**${comment.username}** (${commentDate}):\\n`;\n\t\t\t\t\t\tmarkdown += `${comment.content}\\n\\n`;\n\t\t\t\t\t});\n\t\t\t\t}\n\n\t\t\t\t// Alert ID section\n\t\t\t\tmarkdown += `## Alert ID\\n`;\n\t\t\t\tmarkdown += `\\`${alert.fingerprint}\\`\\n`;\n\n\t\t\t\t// Copy to clipboard\n\t\t\t\tthis.copyToClipboard(markdown);\n\t\t\t\tconsole.log('Alert copied as issue template');\n\t\t\t},\n\n\t\t\t// Download the alert as models.Alert JSON for tickets and reproductions\n\t\t\texportAlertJSON() {\n\t\t\t\tconst fingerprint = this.alertDetails?.alert?.fingerprint;\n\t\t\t\tif (!fingerprint) {\n\t\t\t\t\tconsole.error('No alert data available');\n\t\t\t\t\treturn;\n\t\t\t\t}\n\t\t\t\tthis.downloadExport(`/api/v1/dashboard/alert/${encodeURIComponent(fingerprint)}/export`);\n\t\t\t},\n\n\t\t\tasync unacknowledgeCurrentAlert() {\n\t\t\t\tif (!this.alertDetails?.alert?.fingerprint) {\n\t\t\t\t\tconsole.error('No alert information available');\n\t\t\t\t\treturn;\n\t\t\t\t}\n\t\t\t\t\n\t\t\t\ttry {\n\t\t\t\t\tconst request = {\n\t\t\t\t\t\talertFingerprints: [this.alertDetails.alert.fingerprint],\n\t\t\t\t\t\taction: 'unacknowledge',\n\t\t\t\t\t\tcomment: 'Unacknowledged from alert details'\n\t\t\t\t\t};\n\n\t\t\t\t\tconst response = await fetch('/api/v1/dashboard/bulk-action', {\n\t\t\t\t\t\tmethod: 'POST',\n\t\t\t\t\t\tcredentials: 'include',\n\t\t\t\t\t\theaders: {\n\t\t\t\t\t\t\t'Content-Type': 'application/json',\n\t\t\t\t\t\t},\n\t\t\t\t\t\tbody: JSON.stringify(request)\n\t\t\t\t\t});\n\n\t\t\t\t\tconst result = await response.json();\n\t\t\t\t\t\n\t\t\t\t\tif (result.success) {\n\t\t\t\t\t\tconsole.log('Alert unacknowledged successfully');\n\t\t\t\t\t\t// Refresh alert details to show updated state\n\t\t\t\t\t\tif (this.alertDetails?.alert?.fingerprint) {\n\t\t\t\t\t\t\tawait this.showAlertDetails(this.alertDetails.alert.fingerprint);\n\t\t\t\t\t\t}\n\t\t\t\t\t} else {\n\t\t\t\t\t\tconsole.error('Failed to unacknowledge alert: ' + (result.error || 'Unknown error'));\n\t\t\t\t\t}\n\t\t\t\t} catch (error) {\n\t\t\t\t\tconsole.error('Error unacknowledging alert:', error);\n\t\t\t\t\tconsole.error('Failed to unacknowledge alert');\n\t\t\t\t}\n\t\t\t},\n\n\t\t\t// Sentry Integration Functions  \n\t\t\tasync loadSentryDataForTab() {\n\t\t\t\t// This function is called from the tab button click\n\t\t\t\t// Find the Sentry data component using document.querySelector since $refs doesn't work across components\n\t\t\t\tconst sentryComponent = document.querySelector('[x-ref=\"sentryDataComponent\"]');\n\t\t\t\t\n\t\t\t\tif (sentryComponent && sentryComponent._x_dataStack && sentryComponent._x_dataStack[0]) {\n\t\t\t\t\t// Get the Alpine component data\n\t\t\t\t\tconst componentData = sentryComponent._x_dataStack[0];\n\t\t\t\t\t// Set loading state\n\t\t\t\t\tcomponentData.sentryLoading = true;\n\t\t\t\t\tcomponentData.sentryError = null;\n\t\t\t\t\t\n\t\t\t\t\tawait this.loadSentryData(componentData);\n\t\t\t\t} else {\n\t\t\t\t\tconsole.error('Could not find Sentry data component. Element found:', !!sentryComponent, \n\t\t\t\t\t\t'Has _x_dataStack:', !!(sentryComponent && sentryComponent._x_dataStack));\n\t\t\t\t}\n\t\t\t},\n\n\t\t\tasync loadSentryData(component) {\n\t\t\t\ttry {\n\t\t\t\t\t// Get current alert from the component that has alert details\n\t\t\t\t\tlet alert = null;\n\t\t\t\t\tlet fingerprint = null;\n\t\t\t\t\t\n\t\t\t\t\t// Try to get alert from the component's alert details\n\t\t\t\t\tif (component && component.alertDetails?.alert) {\n\t\t\t\t\t\talert = component.alertDetails.alert;\n\t\t\t\t\t\tfingerprint = alert.fingerprint;\n\t\t\t\t\t} \n\t\t\t\t\t// Fallback to current alert from dashboard instance\n\t\t\t\t\telse if (window.dashboardInstance && window.dashboardInstance.currentAlert) {\n\t\t\t\t\t\talert = window.dashboardInstance.currentAlert;\n\t\t\t\t\t\tfingerprint = alert.fingerprint;\n\t\t\t\t\t}\n\t\t\t\t\t// Last resort: use alertDetails from parent modal component\n\t\t\t\t\telse if (this.alertDetails?.alert) {\n\t\t\t\t\t\talert = this.alertDetails.alert;\n\t\t\t\t\t\tfingerprint = alert.fingerprint;\n\t\t\t\t\t}\n\t\t\t\t\t\n\t\t\t\t\tif (!alert || !fingerprint) {\n\t\t\t\t\t\tconsole.error('No current alert available for Sentry data');\n\t\t\t\t\t\tif (component) {\n\t\t\t\t\t\t\tcomponent.sentryError = 'No alert data available';\n\t\t\t\t\t\t\tcomponent.sentryLoading = false;\n\t\t\t\t\t\t}\n\t\t\t\t\t\treturn;\n\t\t\t\t\t}\n\n\t\t\t\t\tconsole.log('Loading Sentry data for alert fingerprint:', fingerprint);\n\n\t\t\t\t\tconst response = await fetch(`/api/v1/dashboard/sentry/${encodeURIComponent(fingerprint)}`, {\n\t\t\t\t\t\tcredentials: 'include'\n\t\t\t\t\t});\n\n\t\t\t\t\tif (response.ok) {\n\t\t\t\t\t\tconst result = await response.json();\n\t\t\t\t\t\t\n\t\t\t\t\t\tif (result.has_sentry_label) {\n\t\t\t\t\t\t\tif (result.error) {\n\t\t\t\t\t\t\t\t// Invalid URL, unknown project or a token Sentry refused\n\t\t\t\t\t\t\t\tif (component) {\n\t\t\t\t\t\t\t\t\tcomponent.sentryData = null;\n\t\t\t\t\t\t\t\t\tcomponent.sentryError = result.error;\n\t\t\t\t\t\t\t\t\tcomponent.hasSentryToken = !result.auth_status?.token_rejected\n\t\t\t\t\t\t\t\t\t\t&& result.auth_status?.auth_method !== 'none';\n\t\t\t\t\t\t\t\t}\n\t\t\t\t\t\t\t} else if (result.auth_status?.auth_method && result.auth_status.auth_method !== 'none') {\n\t\t\t\t\t\t\t\t// User has token and can view data\n\t\t\t\t\t\t\t\tif (component) {\n\t\t\t\t\t\t\t\t\tcomponent.sentryData = result;\n\t\t\t\t\t\t\t\t\tcomponent.sentryError = null;\n\t\t\t\t\t\t\t\t\tcomponent.hasSentryToken = true;\n\t\t\t\t\t\t\t\t}\n\t\t\t\t\t\t\t} else {\n\t\t\t\t\t\t\t\t// User needs to configure token\n\t\t\t\t\t\t\t\tif (component) {\n\t\t\t\t\t\t\t\t\tcomponent.sentryData = null;\n\t\t\t\t\t\t\t\t\tcomponent.sentryError = 'Sentry token not configured';\n\t\t\t\t\t\t\t\t\tcomponent.hasSentryToken = false;\n\t\t\t\t\t\t\t\t}\n\t\t\t\t\t\t\t}\n\t\t\t\t\t\t} else {\n\t\t\t\t\t\t\t// Alert doesn't have sentry label\n\t\t\t\t\t\t\tif (component) {\n\t\t\t\t\t\t\t\tcomponent.sentryData = null;\n\t\t\t\t\t\t\t\tcomponent.sentryError = 'This alert does not have Sentry integration data';\n\t\t\t\t\t\t\t\tcomponent.hasSentryToken = false;\n\t\t\t\t\t\t\t}\n\t\t\t\t\t\t}\n\t\t\t\t\t} else {\n\t\t\t\t\t\tconsole.error('Failed to load Sentry data:', response.status);\n\t\t\t\t\t\tif (component) {\n\t\t\t\t\t\t\tcomponent.sentryError = 'Failed to load Sentry data';\n\t\t\t\t\t\t}\n\t\t\t\t\t}\n\t\t\t\t} catch (error) {\n\t\t\t\t\tconsole.error('Error loading Sentry data:', error);\n\t\t\t\t\tif (component) {\n\t\t\t\t\t\tcomponent.sentryError = 'Error loading Sentry data: ' + error.message;\n\t\t\t\t\t}\n\t\t\t\t} finally {\n\t\t\t\t\tif (component) {\n\t\t\t\t\t\tcomponent.sentryLoading = false;\n\t\t\t\t\t}\n\t\t\t\t}\n\t\t\t},\n\n\t\t\t// Link to the alert's Sentry project: its sentry annotation or label, or\n\t\t\t// a project label when the backend has an organization configured\n\t\t\talertSentryUrl(alert) {\n\t\t\t\tif (!alert) return null;\n\t\t\t\treturn alert.annotations?.sentry || alert.labels?.sentry || null;\n\t\t\t},\n\n\t\t\talertHasSentryLink(alert) {\n\t\t\t\tif (!alert) return false;\n\t\t\t\tif (this.alertSentryUrl(alert)) return true;\n\t\t\t\tconst projectLabels = window.currentSettingsModal?.sentryConfig?.projectLabels || [];\n\t\t\t\treturn projectLabels.some(key => alert.labels?.[key]);\n\t\t\t},\n\n\t\t\t// Helper methods for annotation buttons\n\t\t\thasMatchingAnnotation(buttonConfig) {\n\t\t\t\tif (!buttonConfig || !buttonConfig.enabled) return false;\n\t\t\t\tconst value = this.getAnnotationUrl(buttonConfig);\n\t\t\t\tif (!value) return false;\n\t\t\t\treturn this.isCopyAnnotationButton(buttonConfig) || this.isSafeAnnotationUrl(value);\n\t\t\t},\n\n\t\t\tgetAnnotationUrl(buttonConfig) {\n\t\t\t\tconst annotations = this.alertDetails?.alert?.annotations || {};\n\t\t\t\tconst matchedKey = buttonConfig.annotation_keys?.find(key => annotations[key]);\n\t\t\t\treturn matchedKey ? annotations[matchedKey] : null;\n\t\t\t},\n\n\t\t\t// Enabled buttons in their configured display order\n\t\t\tsortedAnnotationButtons() {\n\t\t\t\treturn (this.annotationButtonConfigs || [])\n\t\t\t\t\t.filter(config => config.enabled)\n\t\t\t\t\t.slice()\n\t\t\t\t\t.sort((a, b) => (a.display_order || 0) - (b.display_order || 0));\n\t\t\t},\n\n\t\t\tisCopyAnnotationButton(buttonConfig) {\n\t\t\t\treturn buttonConfig?.button_type === 'copy';\n\t\t\t},\n\n\t\t\t// Link buttons only open http(s) URLs so an annotation cannot run script\n\t\t\tisSafeAnnotationUrl(value) {\n\t\t\t\ttry {\n\t\t\t\t\tconst url = new URL(value);\n\t\t\t\t\treturn url.protocol === 'http:' || url.protocol === 'https:';\n\t\t\t\t} catch (e) {\n\t\t\t\t\treturn false;\n\t\t\t\t}\n\t\t\t},\n\n\t\t\trunAnnotationButton(buttonConfig) {\n\t\t\t\tconst value = this.getAnnotationUrl(buttonConfig);\n\t\t\t\tif (!value) return;\n\n\t\t\t\tif (this.isCopyAnnotationButton(buttonConfig)) {\n\t\t\t\t\tthis.copyToClipboard(value);\n\t\t\t\t\tthis.copiedAnnotationButtonId = buttonConfig.id;\n\t\t\t\t\tsetTimeout(() => {\n\t\t\t\t\t\tif (this.copiedAnnotationButtonId === buttonConfig.id) {\n\t\t\t\t\t\t\tthis.copiedAnnotationButtonId = null;\n\t\t\t\t\t\t}\n\t\t\t\t\t}, 2000);\n\t\t\t\t\treturn;\n\t\t\t\t}\n\t\t\t\tthis.openAnnotationUrl(buttonConfig);\n\t\t\t},\n\n\t\t\topenAnnotationUrl(buttonConfig) {\n\t\t\t\tconst url = this.getAnnotationUrl(buttonConfig);\n\t\t\t\tif (url && this.isSafeAnnotationUrl(url)) {\n\t\t\t\t\twindow.open(url, '_blank', 'noopener');\n\t\t\t\t} else if (url) {\n\t\t\t\t\tconsole.warn('Refusing to open non-http annotation value for button:', buttonConfig.label);\n\t\t\t\t}\n\t\t\t}\n\t\t};\n\n\t\t// Global function for Sentry data loading that can be called from Alpine.js components\n\t\twindow.loadSentryData = function() {\n\t\t\t// Get the parent dashboard component that has the modal mixin\n\t\t\tconst dashboardComponent = window.dashboardInstance;\n\t\t\tif (dashboardComponent && dashboardComponent.loadSentryData) {\n\t\t\t\t// Pass the current Alpine.js component (this) to the function\n\t\t\t\tdashboardComponent.loadSentryData(this);\n\t\t\t} else {\n\t\t\t\tconsole.error('Dashboard instance not found or loadSentryData method not available');\n\t\t\t\tthis.sentryError = 'Dashboard not properly initialized';\n\t\t\t\tthis.sentryLoading = false;\n\t\t\t}\n\t\t};\n\n\t\twindow.dashboardModalMixin.loadAlertHistory = async function() {\n\t\t\tif (!this.alertDetails?.alert?.fingerprint) {\n\t\t\t\tconsole.error('No alert fingerprint available');\n\t\t\t\treturn;\n\t\t\t}\n\n\t\t\tthis.historyLoading = true;\n\n\t\t\ttry {\n\t\t\t\tconst response = await fetch(\n\t\t\t\t\t`/api/v1/dashboard/alert/${this.alertDetails.alert.fingerprint}/history`,\n\t\t\t\t\t{ credentials: 'include' }\n\t\t\t\t);\n\n\t\t\t\tif (response.ok) {\n\t\t\t\t\tconst result = await response.json();\n\t\t\t\t\tif (result.success) {\n\t\t\t\t\t\tthis.alertHistory = result.data;\n\t\t\t\t\t} else {\n\t\t\t\t\t\tconsole.error('Failed to load alert history:', result.error);\n\t\t\t\t\t\tthis.alertHistory = null;\n\t\t\t\t\t}\n\t\t\t\t} else {\n\t\t\t\t\tconsole.error('Failed to fetch alert history');\n\t\t\t\t\tthis.alertHistory = null;\n\t\t\t\t}\n\t\t\t} catch (error) {\n\t\t\t\tconsole.error('Error loading alert history:', error);\n\t\t\t\tthis.alertHistory = null;\n\t\t\t} finally {\n\t\t\t\tthis.historyLoading = false;\n\t\t\t}\n\t\t};\n\n\t\t// loadAlertActivity fetches the alert's timeline. With reset it starts\n\t\t// over from the newest entry, otherwise it appends the next page.\n\t\twindow.dashboardModalMixin.loadAlertActivity = async function(reset = true) {\n\t\t\tconst fingerprint = this.alertDetails?.alert?.fingerprint;\n\t\t\tif (!fingerprint) {\n\t\t\t\treturn;\n\t\t\t}\n\t\t\tif (!reset && !this.alertActivityCursor) {\n\t\t\t\treturn;\n\t\t\t}\n\n\t\t\tthis.alertActivityLoading = true;\n\n\t\t\ttry {\n\t\t\t\tconst params = new URLSearchParams({ limit: '50' });\n\t\t\t\tif (!reset) {\n\t\t\t\t\tparams.set('before', this.alertActivityCursor);\n\t\t\t\t}\n\n\t\t\t\tconst response = await fetch(\n\t\t\t\t\t`/api/v1/dashboard/alert/${fingerprint}/activity?${params}`,\n\t\t\t\t\t{ credentials: 'include' }\n\t\t\t\t);\n\t\t\t\tconst result = await response.json();\n\n\t\t\t\tif (result.success) {\n\t\t\t\t\tconst activities = result.data.activities || [];\n\t\t\t\t\tthis.alertActivity = reset ? activities : this.alertActivity.concat(activities);\n\t\t\t\t\tthis.alertActivityCursor = result.data.nextCursor || '';\n\t\t\t\t} else {\n\t\t\t\t\tconsole.error('Failed to load alert activity:', result.error);\n\t\t\t\t}\n\t\t\t} catch (error) {\n\t\t\t\tconsole.error('Error loading alert activity:', error);\n\t\t\t} finally {\n\t\t\t\tthis.alertActivityLoading = false;\n\t\t\t}\n\t\t};\n\n\t\t// toggleAlertExplain opens the \"why am I seeing this alert\" popover. The\n\t\t// explanation is reloaded on every open since preferences may have changed.\n\t\twindow.dashboardModalMixin.toggleAlertExplain = async function() {\n\t\t\tif (this.showAlertExplain) {\n\t\t\t\tthis.showAlertExplain = false;\n\t\t\t\treturn;\n\t\t\t}\n\t\t\tconst fingerprint = this.alertDetails?.alert?.fingerprint;\n\t\t\tif (!fingerprint) {\n\t\t\t\treturn;\n\t\t\t}\n\n\t\t\tthis.showAlertExplain = true;\n\t\t\tthis.alertExplain = null;\n\t\t\tthis.alertExplainLoading = true;\n\n\t\t\ttry {\n\t\t\t\tconst response = await fetch(`/api/v1/dashboard/alert/${fingerprint}/explain`, { credentials: 'include' });\n\t\t\t\tconst result = await response.json();\n\t\t\t\tthis.alertExplain = result.success ? result.data : { error: result.error || 'Failed to explain this alert' };\n\t\t\t} catch (error) {\n\t\t\t\tconsole.error('Error explaining alert:', error);\n\t\t\t\tthis.alertExplain = { error: error.message };\n\t\t\t} finally {\n\t\t\t\tthis.alertExplainLoading = false;\n\t\t\t}\n\t\t};\n\n\t\twindow.dashboardModalMixin.describeColorConditions = function(conditions) {\n\t\t\tconst entries = Object.entries(conditions || {});\n\t\t\tif (entries.length === 0) {\n\t\t\t\treturn 'any alert';\n\t\t\t}\n\t\t\treturn entries.map(([key, value]) => `${key}=${value}`).join(', ');\n\t\t};\n\n\t\twindow.dashboardModalMixin.describeHiddenRule = function(rule) {\n\t\t\tconst value = rule.labelValue || '*';\n\t\t\treturn `${rule.name || rule.labelKey} (${rule.labelKey}${rule.isRegex ? '=~' : '='}${value})`;\n\t\t};\n\n\t\twindow.dashboardModalMixin.describeActivity = function(activity) {\n\t\t\tconst who = activity.username || 'Someone';\n\t\t\tswitch (activity.type) {\n\t\t\t\tcase 'comment':\n\t\t\t\t\treturn `${who} commented`;\n\t\t\t\tcase 'ack':\n\t\t\t\t\treturn `${who} acknowledged`;\n\t\t\t\tcase 'ack_removed':\n\t\t\t\t\treturn `${who} removed an acknowledgment`;\n\t\t\t\tcase 'ack_expired':\n\t\t\t\t\treturn `${who}'s acknowledgment expired`;\n\t\t\t\tcase 'escalation':\n\t\t\t\t\treturn `${who} escalated to ${activity.target}`;\n\t\t\t\tcase 'hide':\n\t\t\t\t\treturn `${who} hid this alert`;\n\t\t\t\tcase 'snooze':\n\t\t\t\t\treturn `${who} snoozed this alert`;\n\t\t\t\tdefault:\n\t\t\t\t\treturn `${who}: ${activity.type}`;\n\t\t\t}\n\t\t};\n\n\t\t// Time left before an expiring acknowledgment lapses, e.g. \"1h 20m left\"\n\t\twindow.dashboardModalMixin.ackTimeRemaining = function(expiresAt) {\n\t\t\tconst seconds = Math.floor((new Date(expiresAt).getTime() - this.highlightClock) / 1000);\n\t\t\tif (seconds < 60) return 'expiring';\n\t\t\tif (seconds < 3600) return `${Math.floor(seconds / 60)}m left`;\n\t\t\tif (seconds < 86400) return `${Math.floor(seconds / 3600)}h ${Math.floor((seconds % 3600) / 60)}m left`;\n\t\t\treturn `${Math.floor(seconds / 86400)}d ${Math.floor((seconds % 86400) / 3600)}h left`;\n\t\t};\n\n\t\twindow.dashboardModalMixin.formatDuration = function(seconds) {\n\t\t\tif (!seconds || seconds < 0) return '0s';\n\t\t\tconst hours = Math.floor(seconds / 3600);\n\t\t\tconst minutes = Math.floor((seconds % 3600) / 60);\n\t\t\tconst secs = Math.floor(seconds % 60);\n\t\t\tif (hours > 0) return `${hours}h ${minutes}m`;\n\t\t\tif (minutes > 0) return `${minutes}m ${secs}s`;\n\t\t\treturn `${secs}s`;\n\t\t};\n\n\t\twindow.dashboardModalMixin.formatDateTime = function(dateStr) {\n\t\t\tif (!dateStr) return 'N/A';\n\t\t\treturn new Date(dateStr).toLocaleString();\n\t\t};\n\t</script>")
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
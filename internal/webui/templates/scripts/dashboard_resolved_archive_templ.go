@@ -0,0 +1,40 @@
+// Code generated by templ - DO NOT EDIT.
+
+// templ: version: v0.3.906
+package scripts
+
+//lint:file-ignore SA4006 This context is only used if a nested component is present.
+
+import "github.com/a-h/templ"
+import templruntime "github.com/a-h/templ/runtime"
+
+func DashboardResolvedArchiveMixin() templ.Component {
+	return templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
+		templ_7745c5c3_W, ctx := templ_7745c5c3_Input.Writer, templ_7745c5c3_Input.Context
+		if templ_7745c5c3_CtxErr := ctx.Err(); templ_7745c5c3_CtxErr != nil {
+			return templ_7745c5c3_CtxErr
+		}
+		templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templruntime.GetBuffer(templ_7745c5c3_W)
+		if !templ_7745c5c3_IsBuffer {
+			defer func() {
+				templ_7745c5c3_BufErr := templruntime.ReleaseBuffer(templ_7745c5c3_Buffer)
+				if templ_7745c5c3_Err == nil {
+					templ_7745c5c3_Err = templ_7745c5c3_BufErr
+				}
+			}()
+		}
+		ctx = templ.InitializeContext(ctx)
+		templ_7745c5c3_Var1 := templ.GetChildren(ctx)
+		if templ_7745c5c3_Var1 == nil {
+			templ_7745c5c3_Var1 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 1, "<script>\n\t\t// Browser over the resolved alerts the backend stores with their\n\t\t// discussion (GET /api/v1/dashboard/resolved-alerts), as opposed to the\n\t\t// Resolved view, which aggregates the statistics subsystem. While open it\n\t\t// follows /api/v1/dashboard/resolved-alerts/stream to refresh live.\n\t\twindow.dashboardResolvedArchiveMixin = {\n\t\t\t// State (will be merged into dashboard)\n\t\t\tshowResolvedArchive: false,\n\t\t\tarchiveAlerts: [],\n\t\t\tarchiveTotal: 0,\n\t\t\tarchiveOffset: 0,\n\t\t\tarchiveLimit: 25,\n\t\t\tarchiveLoading: false,\n\t\t\tarchiveError: '',\n\t\t\tarchiveStream: null,\n\t\t\tarchiveReloadTimer: null,\n\t\t\tarchiveTtlHours: 0, // TTL given to alerts resolved from now on\n\n\t\t\topenResolvedArchive() {\n\t\t\t\tthis.showResolvedArchive = true;\n\t\t\t\tthis.archiveOffset = 0;\n\t\t\t\tthis.archiveError = '';\n\t\t\t\tthis.loadResolvedArchive();\n\t\t\t\tthis.loadArchiveTtl();\n\t\t\t\tthis.startResolvedArchiveStream();\n\t\t\t},\n\n\t\t\tasync loadArchiveTtl() {\n\t\t\t\ttry {\n\t\t\t\t\tconst response = await fetch('/api/v1/dashboard/resolved-alerts/settings', { credentials: 'include' });\n\t\t\t\t\tconst result = await response.json();\n\t\t\t\t\tif (result.success) {\n\t\t\t\t\t\tthis.archiveTtlHours = result.data.ttlHours;\n\t\t\t\t\t}\n\t\t\t\t} catch (error) {\n\t\t\t\t\tconsole.error('Error loading resolved alert settings:', error);\n\t\t\t\t}\n\t\t\t},\n\n\t\t\tformatArchiveTtl(hours) {\n\t\t\t\tif (!hours) return '';\n\t\t\t\tif (hours % 24 === 0) {\n\t\t\t\t\tconst days = hours / 24;\n\t\t\t\t\treturn days + (days === 1 ? ' day' : ' days');\n\t\t\t\t}\n\t\t\t\treturn hours + (hours === 1 ? ' hour' : ' hours');\n\t\t\t},\n\n\t\t\t// Relative time until a stored alert is dropped\n\t\t\tformatArchiveExpiry(retainedUntil) {\n\t\t\t\tconst expiresAt = new Date(retainedUntil).getTime();\n\t\t\t\tif (!retainedUntil || expiresAt <= 0) return '-';\n\t\t\t\tconst minutes = Math.floor((expiresAt - Date.now()) / 60000);\n\t\t\t\tif (minutes < 1) return 'soon';\n\t\t\t\tif (minutes < 60) return 'in ' + minutes + 'm';\n\t\t\t\tif (minutes < 1440) return 'in ' + Math.floor(minutes / 60) + 'h';\n\t\t\t\treturn 'in ' + Math.floor(minutes / 1440) + 'd';\n\t\t\t},\n\n\t\t\tcloseResolvedArchive() {\n\t\t\t\tthis.showResolvedArchive = false;\n\t\t\t\tthis.stopResolvedArchiveStream();\n\t\t\t},\n\n\t\t\tasync loadResolvedArchive() {\n\t\t\t\tthis.archiveLoading = true;\n\t\t\t\ttry {\n\t\t\t\t\tconst params = new URLSearchParams({ limit: this.archiveLimit, offset: this.archiveOffset });\n\t\t\t\t\tconst response = await fetch(`/api/v1/dashboard/resolved-alerts?${params}`, { credentials: 'include' });\n\t\t\t\t\tif (this.handleAuthError(response)) return;\n\n\t\t\t\t\tconst result = await response.json();\n\t\t\t\t\tif (!result.success) {\n\t\t\t\t\t\tthrow new Error(result.error || 'Failed to load resolved alerts');\n\t\t\t\t\t}\n\t\t\t\t\tthis.archiveAlerts = result.data.alerts || [];\n\t\t\t\t\tthis.archiveTotal = result.data.total_count || 0;\n\t\t\t\t\tthis.archiveError = '';\n\t\t\t\t} catch (error) {\n\t\t\t\t\tconsole.error('Error loading resolved alerts archive:', error);\n\t\t\t\t\tthis.archiveError = error.message;\n\t\t\t\t} finally {\n\t\t\t\t\tthis.archiveLoading = false;\n\t\t\t\t}\n\t\t\t},\n\n\t\t\tarchivePage(delta) {\n\t\t\t\tconst offset = this.archiveOffset + delta * this.archiveLimit;\n\t\t\t\tif (offset < 0 || offset >= this.archiveTotal) return;\n\t\t\t\tthis.archiveOffset = offset;\n\t\t\t\tthis.loadResolvedArchive();\n\t\t\t},\n\n\t\t\tarchivePageLabel() {\n\t\t\t\tif (this.archiveTotal === 0) return 'No resolved alerts';\n\t\t\t\tconst last = Math.min(this.archiveOffset + this.archiveLimit, this.archiveTotal);\n\t\t\t\treturn `${this.archiveOffset + 1}–${last} of ${this.archiveTotal}`;\n\t\t\t},\n\n\t\t\tstartResolvedArchiveStream() {\n\t\t\t\tif (this.archiveStream || typeof EventSource === 'undefined') return;\n\t\t\t\tthis.archiveStream = new EventSource('/api/v1/dashboard/resolved-alerts/stream');\n\t\t\t\tthis.archiveStream.addEventListener('resolved', () => {\n\t\t\t\t\t// Alerts often resolve in bursts; reload once per burst\n\t\t\t\t\tclearTimeout(this.archiveReloadTimer);\n\t\t\t\t\tthis.archiveReloadTimer = setTimeout(() => this.loadResolvedArchive(), 1000);\n\t\t\t\t});\n\t\t\t},\n\n\t\t\tstopResolvedArchiveStream() {\n\t\t\t\tclearTimeout(this.archiveReloadTimer);\n\t\t\t\tif (this.archiveStream) {\n\t\t\t\t\tthis.archiveStream.close();\n\t\t\t\t\tthis.archiveStream = null;\n\t\t\t\t}\n\t\t\t},\n\n\t\t\t// The details modal falls back to the stored alert for resolved fingerprints\n\t\t\topenArchivedAlert(alert) {\n\t\t\t\tthis.showAlertDetails(alert.fingerprint);\n\t\t\t},\n\n\t\t\tasync clearResolvedArchive() {\n\t\t\t\tif (!confirm('Remove all resolved alerts and their stored comments and acknowledgments? You can undo this for a few seconds.')) {\n\t\t\t\t\treturn;\n\t\t\t\t}\n\t\t\t\tconst error = await this.removeAllResolvedAlerts();\n\t\t\t\tif (error) {\n\t\t\t\t\tthis.archiveError = error;\n\t\t\t\t\treturn;\n\t\t\t\t}\n\t\t\t\tthis.archiveOffset = 0;\n\t\t\t\tawait this.loadResolvedArchive();\n\t\t\t}\n\t\t};\n\t</script>")
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		return nil
+	})
+}
+
+var _ = templruntime.GeneratedTemplate
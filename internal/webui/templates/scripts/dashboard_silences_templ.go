@@ -0,0 +1,40 @@
+// Code generated by templ - DO NOT EDIT.
+
+// templ: version: v0.3.906
+package scripts
+
+//lint:file-ignore SA4006 This context is only used if a nested component is present.
+
+import "github.com/a-h/templ"
+import templruntime "github.com/a-h/templ/runtime"
+
+func DashboardSilencesMixin() templ.Component {
+	return templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
+		templ_7745c5c3_W, ctx := templ_7745c5c3_Input.Writer, templ_7745c5c3_Input.Context
+		if templ_7745c5c3_CtxErr := ctx.Err(); templ_7745c5c3_CtxErr != nil {
+			return templ_7745c5c3_CtxErr
+		}
+		templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templruntime.GetBuffer(templ_7745c5c3_W)
+		if !templ_7745c5c3_IsBuffer {
+			defer func() {
+				templ_7745c5c3_BufErr := templruntime.ReleaseBuffer(templ_7745c5c3_Buffer)
+				if templ_7745c5c3_Err == nil {
+					templ_7745c5c3_Err = templ_7745c5c3_BufErr
+				}
+			}()
+		}
+		ctx = templ.InitializeContext(ctx)
+		templ_7745c5c3_Var1 := templ.GetChildren(ctx)
+		if templ_7745c5c3_Var1 == nil {
+			templ_7745c5c3_Var1 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 1, "<script>\n\t\twindow.dashboardSilencesMixin = {\n\t\t\t// State (will be merged into dashboard)\n\t\t\tshowSilencesView: false,\n\t\t\tsilencesList: [],\n\t\t\tsilencesLoading: false,\n\t\t\tsilencesError: '',\n\t\t\tsilencesStateFilter: 'active', // 'active', 'pending', 'expired' or '' for all\n\n\t\t\topenSilencesView() {\n\t\t\t\tthis.showSilencesView = true;\n\t\t\t\tthis.loadSilences();\n\t\t\t},\n\n\t\t\tcloseSilencesView() {\n\t\t\t\tthis.showSilencesView = false;\n\t\t\t\tthis.silencesError = '';\n\t\t\t},\n\n\t\t\tasync loadSilences() {\n\t\t\t\tthis.silencesLoading = true;\n\t\t\t\tthis.silencesError = '';\n\n\t\t\t\ttry {\n\t\t\t\t\tconst params = this.silencesStateFilter ? `?state=${encodeURIComponent(this.silencesStateFilter)}` : '';\n\t\t\t\t\tconst response = await fetch(`/api/v1/dashboard/silences${params}`, {\n\t\t\t\t\t\tcredentials: 'include'\n\t\t\t\t\t});\n\n\t\t\t\t\tif (window.dashboardInstance && window.dashboardInstance.handleAuthError(response)) {\n\t\t\t\t\t\treturn;\n\t\t\t\t\t}\n\n\t\t\t\t\tconst result = await response.json();\n\t\t\t\t\tif (result.success) {\n\t\t\t\t\t\tthis.silencesList = result.data.silences || [];\n\t\t\t\t\t} else {\n\t\t\t\t\t\tthis.silencesError = result.error || 'Failed to load silences';\n\t\t\t\t\t}\n\t\t\t\t} catch (error) {\n\t\t\t\t\tconsole.error('Error loading silences:', error);\n\t\t\t\t\tthis.silencesError = 'Network error: Failed to load silences';\n\t\t\t\t} finally {\n\t\t\t\t\tthis.silencesLoading = false;\n\t\t\t\t}\n\t\t\t},\n\n\t\t\t// Reload the list after a silence changed elsewhere (expire, edit, create)\n\t\t\trefreshSilencesView() {\n\t\t\t\tif (this.showSilencesView) {\n\t\t\t\t\tthis.loadSilences();\n\t\t\t\t}\n\t\t\t},\n\n\t\t\tsetSilencesStateFilter(state) {\n\t\t\t\tthis.silencesStateFilter = state;\n\t\t\t\tthis.loadSilences();\n\t\t\t},\n\n\t\t\t// Silences grouped by Alertmanager; the server already sorts by source\n\t\t\tsilencesBySource() {\n\t\t\t\tconst groups = [];\n\t\t\t\tfor (const silence of this.silencesList) {\n\t\t\t\t\tconst last = groups[groups.length - 1];\n\t\t\t\t\tif (last && last.source === silence.source) {\n\t\t\t\t\t\tlast.silences.push(silence);\n\t\t\t\t\t} else {\n\t\t\t\t\t\tgroups.push({ source: silence.source, silences: [silence] });\n\t\t\t\t\t}\n\t\t\t\t}\n\t\t\t\treturn groups;\n\t\t\t},\n\n\t\t\tsilenceTimeRemaining(silence) {\n\t\t\t\tconst state = silence.status?.state;\n\t\t\t\tif (state === 'expired') return 'expired';\n\t\t\t\tif (state === 'pending') return 'starts ' + new Date(silence.startsAt).toLocaleString();\n\n\t\t\t\tconst seconds = Math.max(0, Math.floor((new Date(silence.endsAt) - Date.now()) / 1000));\n\t\t\t\tif (seconds < 3600) return `${Math.floor(seconds / 60)}m left`;\n\t\t\t\tif (seconds < 86400) return `${Math.floor(seconds / 3600)}h ${Math.floor((seconds % 3600) / 60)}m left`;\n\t\t\t\treturn `${Math.floor(seconds / 86400)}d ${Math.floor((seconds % 86400) / 3600)}h left`;\n\t\t\t},\n\n\t\t\t// Open the silence form for a silence built only from custom matchers\n\t\t\tcreateStandaloneSilence() {\n\t\t\t\tthis.currentSilenceAlert = null;\n\t\t\t\tthis.silenceLabelChoices = [];\n\t\t\t\tthis.silenceCustomMatchers = '';\n\t\t\t\tthis.silenceAction = 'standalone';\n\t\t\t\tthis.silenceReason = '';\n\t\t\t\tthis.silenceError = '';\n\t\t\t\tthis.silenceMode = 'create';\n\t\t\t\tthis.silenceDuration = '1h';\n\t\t\t\tthis.silenceDurationType = 'preset';\n\t\t\t\tthis.customSilenceDuration = '';\n\t\t\t\tthis.customDurationError = '';\n\t\t\t\tthis.silenceSuggestions = null;\n\t\t\t\tthis.showSilenceModal = true;\n\t\t\t}\n\t\t};\n\t</script>")
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		return nil
+	})
+}
+
+var _ = templruntime.GeneratedTemplate
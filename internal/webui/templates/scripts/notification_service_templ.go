@@ -29,7 +29,7 @@ func NotificationService() templ.Component {
 			templ_7745c5c3_Var1 = templ.NopComponent
 		}
 		ctx = templ.ClearChildren(ctx)
-		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 1, "<script>\n\t\t// Browser Notification Service\n\t\twindow.NotificationService = {\n\t\t\t// State\n\t\t\tpermissionGranted: false,\n\t\t\tpreferences: {\n\t\t\t\tbrowserNotificationsEnabled: false,\n\t\t\t\tenabledSeverities: ['critical', 'warning'],\n\t\t\t\tsoundNotificationsEnabled: true\n\t\t\t},\n\t\t\tseenAlerts: new Set(),\n\t\t\tseenAlertsInitialized: false, // Track if seenAlerts has been properly initialized from dashboard\n\t\t\tnotificationTimestamps: [], // Track recent notification times for rate limiting\n\t\t\tnotificationQueue: [], // Queue for notifications when rate limited\n\t\t\tseenChannel: null, // BroadcastChannel to dedupe seen alerts across tabs (best-effort)\n\n\t\t\t// Initialize the notification service\n\t\t\tasync init(userID) {\n\t\t\t\tconsole.log('Initializing NotificationService...');\n\n\t\t\t\t// Dedupe notifications across tabs via BroadcastChannel, if supported\n\t\t\t\tif ('BroadcastChannel' in window) {\n\t\t\t\t\tthis.seenChannel = new BroadcastChannel('notificator_seen_alerts_' + userID);\n\t\t\t\t\tthis.seenChannel.onmessage = (event) => {\n\t\t\t\t\t\tconst fingerprints = event.data;\n\t\t\t\t\t\tif (Array.isArray(fingerprints)) {\n\t\t\t\t\t\t\tfingerprints.forEach(fp => this.seenAlerts.add(fp));\n\t\t\t\t\t\t}\n\t\t\t\t\t};\n\t\t\t\t}\n\n\t\t\t\t// Load preferences from backend\n\t\t\t\tawait this.loadPreferences();\n\n\t\t\t\t// Check current browser permission status\n\t\t\t\tif ('Notification' in window) {\n\t\t\t\t\tthis.permissionGranted = Notification.permission === 'granted';\n\t\t\t\t\tconsole.log('Notification permission status:', Notification.permission);\n\n\t\t\t\t\t// Auto-enable if browser permission granted but preference not saved\n\t\t\t\t\tif (this.permissionGranted && !this.preferences.browserNotificationsEnabled) {\n\t\t\t\t\t\tthis.preferences.browserNotificationsEnabled = true;\n\t\t\t\t\t\tawait this.savePreferences(this.preferences);\n\t\t\t\t\t\tconsole.log('Auto-enabled browser notifications (permission already granted)');\n\t\t\t\t\t}\n\t\t\t\t} else {\n\t\t\t\t\tconsole.warn('Browser does not support notifications');\n\t\t\t\t}\n\n\t\t\t\t// Initialize seen alerts from localStorage with 24h expiration\n\t\t\t\tconst storageKey = 'notificator_seen_alerts_' + userID;\n\t\t\t\tconst stored = localStorage.getItem(storageKey);\n\t\t\t\tif (stored) {\n\t\t\t\t\ttry {\n\t\t\t\t\t\tconst seenData = JSON.parse(stored);\n\t\t\t\t\t\tconst now = Date.now();\n\t\t\t\t\t\tconst twentyFourHours = 24 * 60 * 60 * 1000;\n\n\t\t\t\t\t\t// Filter out alerts older than 24 hours\n\t\t\t\t\t\tconst validAlerts = seenData.filter(item => {\n\t\t\t\t\t\t\treturn item.timestamp && (now - item.timestamp) < twentyFourHours;\n\t\t\t\t\t\t});\n\n\t\t\t\t\t\tthis.seenAlerts = new Set(validAlerts.map(item => item.fingerprint));\n\n\t\t\t\t\t\t// Save back the cleaned data\n\t\t\t\t\t\tif (validAlerts.length !== seenData.length) {\n\t\t\t\t\t\t\tlocalStorage.setItem(storageKey, JSON.stringify(validAlerts));\n\t\t\t\t\t\t\tconsole.log('Cleaned', seenData.length - validAlerts.length, 'expired alerts');\n\t\t\t\t\t\t}\n\n\t\t\t\t\t\tconsole.log('Loaded', this.seenAlerts.size, 'seen alerts from storage');\n\t\t\t\t\t} catch (e) {\n\t\t\t\t\t\tconsole.error('Failed to parse seen alerts:', e);\n\t\t\t\t\t\tthis.seenAlerts = new Set();\n\t\t\t\t\t}\n\t\t\t\t}\n\t\t\t},\n\n\t\t\t// Load notification preferences from backend\n\t\t\tasync loadPreferences() {\n\t\t\t\ttry {\n\t\t\t\t\tconst response = await fetch('/api/v1/notifications/preferences', {\n\t\t\t\t\t\tcredentials: 'include'\n\t\t\t\t\t});\n\n\t\t\t\t\tif (response.ok) {\n\t\t\t\t\t\tconst result = await response.json();\n\t\t\t\t\t\tif (result.success && result.data) {\n\t\t\t\t\t\t\tthis.preferences = {\n\t\t\t\t\t\t\t\tbrowserNotificationsEnabled: result.data.browser_notifications_enabled || false,\n\t\t\t\t\t\t\t\tenabledSeverities: result.data.enabled_severities || ['critical', 'warning'],\n\t\t\t\t\t\t\t\tsoundNotificationsEnabled: result.data.sound_notifications_enabled !== undefined ? result.data.sound_notifications_enabled : true\n\t\t\t\t\t\t\t};\n\t\t\t\t\t\t\tconsole.log('Loaded notification preferences:', this.preferences);\n\t\t\t\t\t\t\tthis.preferencesLoaded = true;\n\t\t\t\t\t\t}\n\t\t\t\t\t}\n\t\t\t\t} catch (error) {\n\t\t\t\t\tconsole.error('Failed to load notification preferences:', error);\n\t\t\t\t}\n\t\t\t},\n\n\t\t\t// Save notification preferences to backend\n\t\t\tasync savePreferences(preferences) {\n\t\t\t\ttry {\n\t\t\t\t\tconst response = await fetch('/api/v1/notifications/preferences', {\n\t\t\t\t\t\tmethod: 'POST',\n\t\t\t\t\t\tcredentials: 'include',\n\t\t\t\t\t\theaders: {\n\t\t\t\t\t\t\t'Content-Type': 'application/json'\n\t\t\t\t\t\t},\n\t\t\t\t\t\tbody: JSON.stringify({\n\t\t\t\t\t\t\tbrowser_notifications_enabled: preferences.browserNotificationsEnabled,\n\t\t\t\t\t\t\tenabled_severities: preferences.enabledSeverities,\n\t\t\t\t\t\t\tsound_notifications_enabled: preferences.soundNotificationsEnabled\n\t\t\t\t\t\t})\n\t\t\t\t\t});\n\n\t\t\t\t\tif (response.ok) {\n\t\t\t\t\t\tconst result = await response.json();\n\t\t\t\t\t\tif (result.success) {\n\t\t\t\t\t\t\tthis.preferences = preferences;\n\t\t\t\t\t\t\tconsole.log('Saved notification preferences');\n\t\t\t\t\t\t\treturn true;\n\t\t\t\t\t\t}\n\t\t\t\t\t}\n\t\t\t\t\treturn false;\n\t\t\t\t} catch (error) {\n\t\t\t\t\tconsole.error('Failed to save notification preferences:', error);\n\t\t\t\t\treturn false;\n\t\t\t\t}\n\t\t\t},\n\n\t\t\t// Request browser notification permission\n\t\t\tasync requestPermission() {\n\t\t\t\tif (!('Notification' in window)) {\n\t\t\t\t\tconsole.warn('Browser does not support notifications');\n\t\t\t\t\treturn false;\n\t\t\t\t}\n\n\t\t\t\ttry {\n\t\t\t\t\tconst permission = await Notification.requestPermission();\n\t\t\t\t\tthis.permissionGranted = permission === 'granted';\n\t\t\t\t\tconsole.log('Notification permission:', permission);\n\t\t\t\t\treturn this.permissionGranted;\n\t\t\t\t} catch (error) {\n\t\t\t\t\tconsole.error('Failed to request notification permission:', error);\n\t\t\t\t\treturn false;\n\t\t\t\t}\n\t\t\t},\n\n\t\t\t// Initialize seen alerts (call once per session on dashboard load)\n\t\t\tinitializeSeenAlerts(alerts, userID) {\n\t\t\t\tconst fingerprints = alerts.map(a => a.fingerprint);\n\t\t\t\tfingerprints.forEach(fp => this.seenAlerts.add(fp));\n\t\t\t\tthis.seenAlertsInitialized = true; // Mark as properly initialized\n\n\t\t\t\t// Persist via the existing merge logic (union, with TTL bookkeeping)\n\t\t\t\tthis.markAsSeen(fingerprints, userID);\n\n\t\t\t\tconsole.log('Initialized', this.seenAlerts.size, 'seen alerts (seenAlertsInitialized=true)');\n\t\t\t},\n\n\t\t\t// Mark alerts as seen\n\t\t\tmarkAsSeen(fingerprints, userID) {\n\t\t\t\tfingerprints.forEach(fp => this.seenAlerts.add(fp));\n\n\t\t\t\t// Load existing data, add new fingerprints with timestamps, save back\n\t\t\t\tconst storageKey = 'notificator_seen_alerts_' + userID;\n\t\t\t\tconst stored = localStorage.getItem(storageKey);\n\t\t\t\tlet seenData = [];\n\n\t\t\t\tif (stored) {\n\t\t\t\t\ttry {\n\t\t\t\t\t\tseenData = JSON.parse(stored);\n\t\t\t\t\t} catch (e) {\n\t\t\t\t\t\tconsole.error('Failed to parse seen alerts:', e);\n\t\t\t\t\t}\n\t\t\t\t}\n\n\t\t\t\t// Add new fingerprints with current timestamp\n\t\t\t\tconst now = Date.now();\n\t\t\t\tconst newData = fingerprints.map(fp => ({ fingerprint: fp, timestamp: now }));\n\t\t\t\tseenData.push(...newData);\n\n\t\t\t\t// Remove duplicates (keep most recent timestamp)\n\t\t\t\tconst fingerprintMap = new Map();\n\t\t\t\tseenData.forEach(item => {\n\t\t\t\t\tif (!fingerprintMap.has(item.fingerprint) || item.timestamp > fingerprintMap.get(item.fingerprint).timestamp) {\n\t\t\t\t\t\tfingerprintMap.set(item.fingerprint, item);\n\t\t\t\t\t}\n\t\t\t\t});\n\n\t\t\t\t// Apply 24h TTL before persisting to bound storage growth\n\t\t\t\tconst twentyFourHours = 24 * 60 * 60 * 1000;\n\t\t\t\tconst now2 = Date.now();\n\t\t\t\tlocalStorage.setItem(storageKey, JSON.stringify(Array.from(fingerprintMap.values()).filter(item => (now2 - item.timestamp) < twentyFourHours)));\n\n\t\t\t\t// Notify other tabs so they don't re-notify for the same alerts\n\t\t\t\tif (this.seenChannel) {\n\t\t\t\t\tthis.seenChannel.postMessage(fingerprints);\n\t\t\t\t}\n\t\t\t},\n\n\t\t\t// Forget alerts that have genuinely resolved (SSE-confirmed) so that if the\n\t\t\t// same fingerprint fires again later, it is treated as new and re-notifies.\n\t\t\tforgetAlerts(fingerprints, userID) {\n\t\t\t\tif (!fingerprints || fingerprints.length === 0) {\n\t\t\t\t\treturn;\n\t\t\t\t}\n\n\t\t\t\tfingerprints.forEach(fp => this.seenAlerts.delete(fp));\n\n\t\t\t\tconst storageKey = 'notificator_seen_alerts_' + userID;\n\t\t\t\tconst stored = localStorage.getItem(storageKey);\n\t\t\t\tif (!stored) {\n\t\t\t\t\treturn;\n\t\t\t\t}\n\n\t\t\t\ttry {\n\t\t\t\t\tconst seenData = JSON.parse(stored);\n\t\t\t\t\tconst filtered = seenData.filter(item => !fingerprints.includes(item.fingerprint));\n\t\t\t\t\tlocalStorage.setItem(storageKey, JSON.stringify(filtered));\n\t\t\t\t\tconsole.log('Forgot', seenData.length - filtered.length, 'resolved alert(s) from seen set');\n\t\t\t\t} catch (e) {\n\t\t\t\t\tconsole.error('Failed to parse seen alerts while forgetting resolved alerts:', e);\n\t\t\t\t}\n\t\t\t},\n\n\t\t\t// Detect new alerts (not in seen set)\n\t\t\tdetectNewAlerts(alerts) {\n\t\t\t\treturn alerts.filter(alert => !this.seenAlerts.has(alert.fingerprint));\n\t\t\t},\n\n\t\t\t// Check if we should notify for this alert\n\t\t\tshouldNotify(alert) {\n\t\t\t\t// Check if notifications are enabled\n\t\t\t\tif (!this.preferences.browserNotificationsEnabled) {\n\t\t\t\t\treturn false;\n\t\t\t\t}\n\n\t\t\t\t// Check if browser permission granted\n\t\t\t\tif (!this.permissionGranted) {\n\t\t\t\t\treturn false;\n\t\t\t\t}\n\n\t\t\t\t// Check if severity is enabled\n\t\t\t\tconst severity = alert.severity || alert.labels?.severity || 'info';\n\t\t\t\tconst normalizedSeverity = severity.toLowerCase();\n\n\t\t\t\t// Handle 'information' as 'info'\n\t\t\t\tlet severityToCheck = normalizedSeverity === 'information' ? 'info' : normalizedSeverity;\n\n\t\t\t\t// Handle 'critical-daytime' as 'critical'\n\t\t\t\tif (severityToCheck === 'critical-daytime') {\n\t\t\t\t\tseverityToCheck = 'critical';\n\t\t\t\t}\n\n\t\t\t\tif (!this.preferences.enabledSeverities.includes(severityToCheck)) {\n\t\t\t\t\treturn false;\n\t\t\t\t}\n\n\t\t\t\treturn true;\n\t\t\t},\n\n\t\t\t// Get notification icon based on severity\n\t\t\tgetNotificationIcon(severity) {\n\t\t\t\tconst severityLower = severity.toLowerCase();\n\t\t\t\tconst iconMap = {\n\t\t\t\t\t'critical': '/static/images/critical-icon.png',\n\t\t\t\t\t'critical-daytime': '/static/images/warning-icon.png',\n\t\t\t\t\t'warning': '/static/images/warning-icon.png',\n\t\t\t\t\t'info': '/static/images/info-icon.png',\n\t\t\t\t\t'information': '/static/images/info-icon.png',\n\t\t\t\t\t'success': '/static/images/success-icon.png'\n\t\t\t\t};\n\t\t\t\treturn iconMap[severityLower] || '/static/images/default-icon.png';\n\t\t\t},\n\n\t\t\t// Get notification sound based on severity\n\t\t\tgetNotificationSound(severity) {\n\t\t\t\tconst severityLower = severity.toLowerCase();\n\t\t\t\tconst soundMap = {\n\t\t\t\t\t'critical': '/static/sounds/critical.mp3',\n\t\t\t\t\t'critical-daytime': '/static/sounds/warning.mp3',\n\t\t\t\t\t'warning': '/static/sounds/warning.mp3',\n\t\t\t\t\t'info': '/static/sounds/info.mp3',\n\t\t\t\t\t'information': '/static/sounds/info.mp3'\n\t\t\t\t};\n\t\t\t\treturn soundMap[severityLower] || '/static/sounds/info.mp3';\n\t\t\t},\n\n\t\t\t// Play notification sound\n\t\t\tplayNotificationSound(severity) {\n\t\t\t\t// Check if sounds are enabled\n\t\t\t\tif (!this.preferences.soundNotificationsEnabled) {\n\t\t\t\t\treturn;\n\t\t\t\t}\n\n\t\t\t\ttry {\n\t\t\t\t\tconst soundFile = this.getNotificationSound(severity);\n\t\t\t\t\tconst audio = new Audio(soundFile);\n\t\t\t\t\taudio.volume = 0.7; // Fixed volume at 70%\n\n\t\t\t\t\t// Play with error handling\n\t\t\t\t\taudio.play().catch(err => {\n\t\t\t\t\t\t// Browsers may block autoplay - this is expected\n\t\t\t\t\t\tconsole.warn('Could not play notification sound (may be blocked by browser):', err.message);\n\t\t\t\t\t});\n\t\t\t\t} catch (error) {\n\t\t\t\t\tconsole.error('Error creating audio for notification sound:', error);\n\t\t\t\t}\n\t\t\t},\n\n\t\t\t// Show browser notification (with rate limiting)\n\t\t\tshowNotification(alert) {\n\t\t\t\tif (!this.shouldNotify(alert)) {\n\t\t\t\t\treturn;\n\t\t\t\t}\n\n\t\t\t\t// Check if we can show notification (rate limit: max 5 per minute)\n\t\t\t\tif (this.canShowNotification()) {\n\t\t\t\t\t// Show immediately\n\t\t\t\t\tthis.showNotificationImmediate(alert);\n\t\t\t\t} else {\n\t\t\t\t\t// Add to queue\n\t\t\t\t\tconsole.log('Rate limit reached, queuing notification for:', alert.alertName || alert.fingerprint);\n\t\t\t\t\tthis.notificationQueue.push(alert);\n\n\t\t\t\t\t// Start processing queue if not already running\n\t\t\t\t\tsetTimeout(() => this.processNotificationQueue(), 10000);\n\t\t\t\t}\n\t\t\t},\n\n\t\t\t// Check if an alert is in the user's GLOBAL Hidden Alerts list (settings modal, not a\n\t\t\t// preset-scoped filterHiddenAlerts). That list is loaded client-side into the settings\n\t\t\t// modal's Alpine component (window.currentSettingsModal.hiddenAlerts) on page init.\n\t\t\tisGloballyHidden(alert) {\n\t\t\t\tconst hiddenAlerts = window.currentSettingsModal?.hiddenAlerts;\n\t\t\t\tif (!hiddenAlerts || hiddenAlerts.length === 0) {\n\t\t\t\t\treturn false;\n\t\t\t\t}\n\t\t\t\treturn hiddenAlerts.some(hidden => hidden.fingerprint === alert.fingerprint);\n\t\t\t},\n\n\t\t\t// Check if an alert matches the current filters\n\t\t\talertMatchesFilters(alert, filters) {\n\t\t\t\tif (!filters) {\n\t\t\t\t\treturn true;\n\t\t\t\t}\n\n\t\t\t\t// Check alertmanager filter\n\t\t\t\tif (filters.alertmanagers && filters.alertmanagers.length > 0) {\n\t\t\t\t\tif (!filters.alertmanagers.includes(alert.source)) {\n\t\t\t\t\t\treturn false;\n\t\t\t\t\t}\n\t\t\t\t}\n\n\t\t\t\t// Check severity filter\n\t\t\t\tif (filters.severities && filters.severities.length > 0) {\n\t\t\t\t\tconst alertSeverity = (alert.severity || '').toLowerCase();\n\t\t\t\t\tconst matchesSeverity = filters.severities.some(s => s.toLowerCase() === alertSeverity);\n\t\t\t\t\tif (!matchesSeverity) {\n\t\t\t\t\t\treturn false;\n\t\t\t\t\t}\n\t\t\t\t}\n\n\t\t\t\t// Check status filter\n\t\t\t\tif (filters.statuses && filters.statuses.length > 0) {\n\t\t\t\t\tconst alertStatus = (alert.status?.state || alert.status || '').toLowerCase();\n\t\t\t\t\tconst matchesStatus = filters.statuses.some(s => s.toLowerCase() === alertStatus);\n\t\t\t\t\tif (!matchesStatus) {\n\t\t\t\t\t\treturn false;\n\t\t\t\t\t}\n\t\t\t\t}\n\n\t\t\t\t// Check team filter\n\t\t\t\tif (filters.teams && filters.teams.length > 0) {\n\t\t\t\t\tconst alertTeam = alert.team || alert.labels?.team || '';\n\t\t\t\t\tif (!filters.teams.includes(alertTeam)) {\n\t\t\t\t\t\treturn false;\n\t\t\t\t\t}\n\t\t\t\t}\n\n\t\t\t\t// Check alertName filter\n\t\t\t\tif (filters.alertNames && filters.alertNames.length > 0) {\n\t\t\t\t\tif (!filters.alertNames.includes(alert.alertName)) {\n\t\t\t\t\t\treturn false;\n\t\t\t\t\t}\n\t\t\t\t}\n\n\t\t\t\treturn true;\n\t\t\t},\n\n\t\t\t// Process new alerts and show notifications\n\t\t\tprocessNewAlerts(allAlerts, currentFilters, userID) {\n\t\t\t\t// Skip if userID is not available (user not logged in or profile not loaded)\n\t\t\t\tif (!userID) {\n\t\t\t\t\treturn;\n\t\t\t\t}\n\n\t\t\t\t// Skip notification processing if seenAlerts hasn't been properly initialized\n\t\t\t\t// This prevents race conditions during page load where SSE updates arrive\n\t\t\t\t// before the dashboard has initialized the seen alerts set\n\t\t\t\tif (!this.seenAlertsInitialized) {\n\t\t\t\t\treturn;\n\t\t\t\t}\n\n\t\t\t\t// Detect which alerts are new\n\t\t\t\tconst newAlerts = this.detectNewAlerts(allAlerts);\n\n\t\t\t\tif (newAlerts.length === 0) {\n\t\t\t\t\treturn;\n\t\t\t\t}\n\n\t\t\t\t// Filter alerts based on user's current filters\n\t\t\t\tconst filteredNewAlerts = newAlerts.filter(alert => {\n\t\t\t\t\tif (this.isGloballyHidden(alert)) {\n\t\t\t\t\t\treturn false;\n\t\t\t\t\t}\n\t\t\t\t\treturn this.alertMatchesFilters(alert, currentFilters);\n\t\t\t\t});\n\n\t\t\t\tif (filteredNewAlerts.length === 0) {\n\t\t\t\t\t// Still mark all as seen to avoid re-notifying when filter changes\n\t\t\t\t\tconst newFingerprints = newAlerts.map(a => a.fingerprint);\n\t\t\t\t\tthis.markAsSeen(newFingerprints, userID);\n\t\t\t\t\treturn;\n\t\t\t\t}\n\n\t\t\t\t// Show notifications for filtered new alerts with staggered delay to avoid browser spam\n\t\t\t\tfilteredNewAlerts.forEach((alert, index) => {\n\t\t\t\t\tsetTimeout(() => {\n\t\t\t\t\t\tthis.showNotification(alert);\n\t\t\t\t\t}, index * 500); // 500ms stagger between each notification\n\t\t\t\t});\n\n\t\t\t\t// Mark ALL new alerts as seen (not just filtered) to avoid re-notifying when filter changes\n\t\t\t\tconst newFingerprints = newAlerts.map(a => a.fingerprint);\n\t\t\t\tthis.markAsSeen(newFingerprints, userID);\n\t\t\t},\n\n\t\t\t// Check if we can show a notification (rate limiting: max 5 per minute)\n\t\t\tcanShowNotification() {\n\t\t\t\tconst now = Date.now();\n\t\t\t\tconst oneMinute = 60 * 1000;\n\n\t\t\t\t// Remove timestamps older than 1 minute\n\t\t\t\tthis.notificationTimestamps = this.notificationTimestamps.filter(timestamp => {\n\t\t\t\t\treturn (now - timestamp) < oneMinute;\n\t\t\t\t});\n\n\t\t\t\t// Check if we're under the limit\n\t\t\t\treturn this.notificationTimestamps.length < 5;\n\t\t\t},\n\n\t\t\t// Record that a notification was shown\n\t\t\trecordNotification() {\n\t\t\t\tthis.notificationTimestamps.push(Date.now());\n\t\t\t},\n\n\t\t\t// Process queued notifications (called periodically)\n\t\t\tprocessNotificationQueue() {\n\t\t\t\tif (this.notificationQueue.length === 0) {\n\t\t\t\t\treturn;\n\t\t\t\t}\n\n\t\t\t\twhile (this.notificationQueue.length > 0 && this.canShowNotification()) {\n\t\t\t\t\tconst alert = this.notificationQueue.shift();\n\t\t\t\t\tthis.showNotificationImmediate(alert);\n\t\t\t\t}\n\n\t\t\t\t// If there are still queued notifications, check again in 10 seconds\n\t\t\t\tif (this.notificationQueue.length > 0) {\n\t\t\t\t\tsetTimeout(() => this.processNotificationQueue(), 10000);\n\t\t\t\t}\n\t\t\t},\n\n\t\t\t// Show notification immediately (used internally, bypasses rate limit check)\n\t\t\tshowNotificationImmediate(alert) {\n\t\t\t\t// Record that we're showing a notification\n\t\t\t\tthis.recordNotification();\n\n\t\t\t\t// Call the original showNotification logic\n\t\t\t\tif (!this.shouldNotify(alert)) {\n\t\t\t\t\treturn;\n\t\t\t\t}\n\n\t\t\t\tconst alertName = alert.alertName || alert.labels?.alertname || 'Alert';\n\t\t\t\tconst summary = alert.summary || alert.annotations?.summary || '';\n\t\t\t\tconst severity = alert.severity || alert.labels?.severity || 'info';\n\t\t\t\tconst source = alert.source || '';\n\t\t\t\tconst fingerprint = alert.fingerprint;\n\n\t\t\t\tif (!fingerprint) {\n\t\t\t\t\tconsole.error('Cannot show notification: alert fingerprint is missing', alert);\n\t\t\t\t\treturn;\n\t\t\t\t}\n\n\t\t\t\tthis.playNotificationSound(severity);\n\n\t\t\t\tconst title = `Alert: ${alertName}`;\n\t\t\t\tconst body = summary || `${severity.toUpperCase()} alert from ${source}`;\n\n\t\t\t\tconst options = {\n\t\t\t\t\tbody: body,\n\t\t\t\t\ticon: this.getNotificationIcon(severity),\n\t\t\t\t\tbadge: '/static/images/default-icon.png',\n\t\t\t\t\ttag: fingerprint,\n\t\t\t\t\trequireInteraction: ['critical', 'critical-daytime'].includes(severity.toLowerCase()),\n\t\t\t\t\tdata: {\n\t\t\t\t\t\tfingerprint: fingerprint,\n\t\t\t\t\t\talertName: alertName\n\t\t\t\t\t}\n\t\t\t\t};\n\n\t\t\t\ttry {\n\t\t\t\t\tconst notification = new Notification(title, options);\n\n\t\t\t\t\tnotification.onclick = () => {\n\t\t\t\t\t\twindow.focus();\n\n\t\t\t\t\t\tif (!fingerprint) {\n\t\t\t\t\t\t\tconsole.error('Cannot navigate: fingerprint is missing');\n\t\t\t\t\t\t\treturn;\n\t\t\t\t\t\t}\n\n\t\t\t\t\t\tconsole.log('Notification clicked, navigating to alert:', fingerprint);\n\n\t\t\t\t\t\tif (window.location.pathname.startsWith('/dashboard')) {\n\t\t\t\t\t\t\tif (window.dashboardInstance && window.dashboardInstance.showAlertDetails) {\n\t\t\t\t\t\t\t\twindow.dashboardInstance.showAlertDetails(fingerprint);\n\t\t\t\t\t\t\t} else {\n\t\t\t\t\t\t\t\twindow.location.href = `/dashboard/alert/${fingerprint}`;\n\t\t\t\t\t\t\t}\n\t\t\t\t\t\t} else {\n\t\t\t\t\t\t\twindow.location.href = `/dashboard/alert/${fingerprint}`;\n\t\t\t\t\t\t}\n\n\t\t\t\t\t\tnotification.close();\n\t\t\t\t\t};\n\n\t\t\t\t\tconsole.log('Showed notification for alert:', alertName, 'fingerprint:', fingerprint);\n\t\t\t\t} catch (error) {\n\t\t\t\t\tconsole.error('Failed to show notification:', error);\n\t\t\t\t}\n\t\t\t}\n\t\t};\n\n\t\t// Make it globally available\n\t\twindow.notificationService = window.NotificationService;\n\t</script>")
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 1, "<script>\n\t\t// Browser Notification Service\n\t\twindow.NotificationService = {\n\t\t\t// State\n\t\t\tpermissionGranted: false,\n\t\t\tpreferences: {\n\t\t\t\tbrowserNotificationsEnabled: false,\n\t\t\t\tenabledSeverities: ['critical', 'warning'],\n\t\t\t\tsoundNotificationsEnabled: true,\n\t\t\t\tdnd: null // Do-not-disturb schedule, see isDoNotDisturbActive()\n\t\t\t},\n\t\t\tseenAlerts: new Set(),\n\t\t\tseenAlertsInitialized: false, // Track if seenAlerts has been properly initialized from dashboard\n\t\t\tnotificationTimestamps: [], // Track recent notification times for rate limiting\n\t\t\tnotificationQueue: [], // Queue for notifications when rate limited\n\t\t\tseenChannel: null, // BroadcastChannel to dedupe seen alerts across tabs (best-effort)\n\t\t\tloadedAt: Date.now(),\n\t\t\thandledAckLapses: {}, // fingerprint -> acknowledgmentLapsedAt already forgotten\n\n\t\t\t// Initialize the notification service\n\t\t\tasync init(userID) {\n\t\t\t\tconsole.log('Initializing NotificationService...');\n\n\t\t\t\t// Dedupe notifications across tabs via BroadcastChannel, if supported\n\t\t\t\tif ('BroadcastChannel' in window) {\n\t\t\t\t\tthis.seenChannel = new BroadcastChannel('notificator_seen_alerts_' + userID);\n\t\t\t\t\tthis.seenChannel.onmessage = (event) => {\n\t\t\t\t\t\tconst fingerprints = event.data;\n\t\t\t\t\t\tif (Array.isArray(fingerprints)) {\n\t\t\t\t\t\t\tfingerprints.forEach(fp => this.seenAlerts.add(fp));\n\t\t\t\t\t\t}\n\t\t\t\t\t};\n\t\t\t\t}\n\n\t\t\t\t// Load preferences from backend\n\t\t\t\tawait this.loadPreferences();\n\n\t\t\t\t// Check current browser permission status\n\t\t\t\tif ('Notification' in window) {\n\t\t\t\t\tthis.permissionGranted = Notification.permission === 'granted';\n\t\t\t\t\tconsole.log('Notification permission status:', Notification.permission);\n\n\t\t\t\t\t// Auto-enable if browser permission granted but preference not saved\n\t\t\t\t\tif (this.permissionGranted && !this.preferences.browserNotificationsEnabled) {\n\t\t\t\t\t\tthis.preferences.browserNotificationsEnabled = true;\n\t\t\t\t\t\tawait this.savePreferences(this.preferences);\n\t\t\t\t\t\tconsole.log('Auto-enabled browser notifications (permission already granted)');\n\t\t\t\t\t}\n\t\t\t\t} else {\n\t\t\t\t\tconsole.warn('Browser does not support notifications');\n\t\t\t\t}\n\n\t\t\t\t// Initialize seen alerts from localStorage with 24h expiration\n\t\t\t\tconst storageKey = 'notificator_seen_alerts_' + userID;\n\t\t\t\tconst stored = localStorage.getItem(storageKey);\n\t\t\t\tif (stored) {\n\t\t\t\t\ttry {\n\t\t\t\t\t\tconst seenData = JSON.parse(stored);\n\t\t\t\t\t\tconst now = Date.now();\n\t\t\t\t\t\tconst twentyFourHours = 24 * 60 * 60 * 1000;\n\n\t\t\t\t\t\t// Filter out alerts older than 24 hours\n\t\t\t\t\t\tconst validAlerts = seenData.filter(item => {\n\t\t\t\t\t\t\treturn item.timestamp && (now - item.timestamp) < twentyFourHours;\n\t\t\t\t\t\t});\n\n\t\t\t\t\t\tthis.seenAlerts = new Set(validAlerts.map(item => item.fingerprint));\n\n\t\t\t\t\t\t// Save back the cleaned data\n\t\t\t\t\t\tif (validAlerts.length !== seenData.length) {\n\t\t\t\t\t\t\tlocalStorage.setItem(storageKey, JSON.stringify(validAlerts));\n\t\t\t\t\t\t\tconsole.log('Cleaned', seenData.length - validAlerts.length, 'expired alerts');\n\t\t\t\t\t\t}\n\n\t\t\t\t\t\tconsole.log('Loaded', this.seenAlerts.size, 'seen alerts from storage');\n\t\t\t\t\t} catch (e) {\n\t\t\t\t\t\tconsole.error('Failed to parse seen alerts:', e);\n\t\t\t\t\t\tthis.seenAlerts = new Set();\n\t\t\t\t\t}\n\t\t\t\t}\n\t\t\t},\n\n\t\t\t// Load notification preferences from backend\n\t\t\tasync loadPreferences() {\n\t\t\t\ttry {\n\t\t\t\t\tconst response = await fetch('/api/v1/notifications/preferences', {\n\t\t\t\t\t\tcredentials: 'include'\n\t\t\t\t\t});\n\n\t\t\t\t\tif (response.ok) {\n\t\t\t\t\t\tconst result = await response.json();\n\t\t\t\t\t\tif (result.success && result.data) {\n\t\t\t\t\t\t\tthis.preferences = {\n\t\t\t\t\t\t\t\tbrowserNotificationsEnabled: result.data.browser_notifications_enabled || false,\n\t\t\t\t\t\t\t\tenabledSeverities: result.data.enabled_severities || ['critical', 'warning'],\n\t\t\t\t\t\t\t\tsoundNotificationsEnabled: result.data.sound_notifications_enabled !== undefined ? result.data.sound_notifications_enabled : true,\n\t\t\t\t\t\t\t\tdnd: result.data.dnd || null\n\t\t\t\t\t\t\t};\n\t\t\t\t\t\t\tconsole.log('Loaded notification preferences:', this.preferences);\n\t\t\t\t\t\t\tthis.preferencesLoaded = true;\n\t\t\t\t\t\t}\n\t\t\t\t\t}\n\t\t\t\t} catch (error) {\n\t\t\t\t\tconsole.error('Failed to load notification preferences:', error);\n\t\t\t\t}\n\t\t\t},\n\n\t\t\t// Save notification preferences to backend\n\t\t\tasync savePreferences(preferences) {\n\t\t\t\ttry {\n\t\t\t\t\tconst response = await fetch('/api/v1/notifications/preferences', {\n\t\t\t\t\t\tmethod: 'POST',\n\t\t\t\t\t\tcredentials: 'include',\n\t\t\t\t\t\theaders: {\n\t\t\t\t\t\t\t'Content-Type': 'application/json'\n\t\t\t\t\t\t},\n\t\t\t\t\t\tbody: JSON.stringify({\n\t\t\t\t\t\t\tbrowser_notifications_enabled: preferences.browserNotificationsEnabled,\n\t\t\t\t\t\t\tenabled_severities: preferences.enabledSeverities,\n\t\t\t\t\t\t\tsound_notifications_enabled: preferences.soundNotificationsEnabled\n\t\t\t\t\t\t})\n\t\t\t\t\t});\n\n\t\t\t\t\tif (response.ok) {\n\t\t\t\t\t\tconst result = await response.json();\n\t\t\t\t\t\tif (result.success) {\n\t\t\t\t\t\t\tthis.preferences = preferences;\n\t\t\t\t\t\t\tconsole.log('Saved notification preferences');\n\t\t\t\t\t\t\treturn true;\n\t\t\t\t\t\t}\n\t\t\t\t\t}\n\t\t\t\t\treturn false;\n\t\t\t\t} catch (error) {\n\t\t\t\t\tconsole.error('Failed to save notification preferences:', error);\n\t\t\t\t\treturn false;\n\t\t\t\t}\n\t\t\t},\n\n\t\t\t// Request browser notification permission\n\t\t\tasync requestPermission() {\n\t\t\t\tif (!('Notification' in window)) {\n\t\t\t\t\tconsole.warn('Browser does not support notifications');\n\t\t\t\t\treturn false;\n\t\t\t\t}\n\n\t\t\t\ttry {\n\t\t\t\t\tconst permission = await Notification.requestPermission();\n\t\t\t\t\tthis.permissionGranted = permission === 'granted';\n\t\t\t\t\tconsole.log('Notification permission:', permission);\n\t\t\t\t\treturn this.permissionGranted;\n\t\t\t\t} catch (error) {\n\t\t\t\t\tconsole.error('Failed to request notification permission:', error);\n\t\t\t\t\treturn false;\n\t\t\t\t}\n\t\t\t},\n\n\t\t\t// Initialize seen alerts (call once per session on dashboard load)\n\t\t\tinitializeSeenAlerts(alerts, userID) {\n\t\t\t\tconst fingerprints = alerts.map(a => a.fingerprint);\n\t\t\t\tfingerprints.forEach(fp => this.seenAlerts.add(fp));\n\t\t\t\tthis.seenAlertsInitialized = true; // Mark as properly initialized\n\n\t\t\t\t// Persist via the existing merge logic (union, with TTL bookkeeping)\n\t\t\t\tthis.markAsSeen(fingerprints, userID);\n\n\t\t\t\tconsole.log('Initialized', this.seenAlerts.size, 'seen alerts (seenAlertsInitialized=true)');\n\t\t\t},\n\n\t\t\t// Mark alerts as seen\n\t\t\tmarkAsSeen(fingerprints, userID) {\n\t\t\t\tfingerprints.forEach(fp => this.seenAlerts.add(fp));\n\n\t\t\t\t// Load existing data, add new fingerprints with timestamps, save back\n\t\t\t\tconst storageKey = 'notificator_seen_alerts_' + userID;\n\t\t\t\tconst stored = localStorage.getItem(storageKey);\n\t\t\t\tlet seenData = [];\n\n\t\t\t\tif (stored) {\n\t\t\t\t\ttry {\n\t\t\t\t\t\tseenData = JSON.parse(stored);\n\t\t\t\t\t} catch (e) {\n\t\t\t\t\t\tconsole.error('Failed to parse seen alerts:', e);\n\t\t\t\t\t}\n\t\t\t\t}\n\n\t\t\t\t// Add new fingerprints with current timestamp\n\t\t\t\tconst now = Date.now();\n\t\t\t\tconst newData = fingerprints.map(fp => ({ fingerprint: fp, timestamp: now }));\n\t\t\t\tseenData.push(...newData);\n\n\t\t\t\t// Remove duplicates (keep most recent timestamp)\n\t\t\t\tconst fingerprintMap = new Map();\n\t\t\t\tseenData.forEach(item => {\n\t\t\t\t\tif (!fingerprintMap.has(item.fingerprint) || item.timestamp > fingerprintMap.get(item.fingerprint).timestamp) {\n\t\t\t\t\t\tfingerprintMap.set(item.fingerprint, item);\n\t\t\t\t\t}\n\t\t\t\t});\n\n\t\t\t\t// Apply 24h TTL before persisting to bound storage growth\n\t\t\t\tconst twentyFourHours = 24 * 60 * 60 * 1000;\n\t\t\t\tconst now2 = Date.now();\n\t\t\t\tlocalStorage.setItem(storageKey, JSON.stringify(Array.from(fingerprintMap.values()).filter(item => (now2 - item.timestamp) < twentyFourHours)));\n\n\t\t\t\t// Notify other tabs so they don't re-notify for the same alerts\n\t\t\t\tif (this.seenChannel) {\n\t\t\t\t\tthis.seenChannel.postMessage(fingerprints);\n\t\t\t\t}\n\t\t\t},\n\n\t\t\t// Forget alerts that have genuinely resolved (SSE-confirmed) so that if the\n\t\t\t// same fingerprint fires again later, it is treated as new and re-notifies.\n\t\t\tforgetAlerts(fingerprints, userID) {\n\t\t\t\tif (!fingerprints || fingerprints.length === 0) {\n\t\t\t\t\treturn;\n\t\t\t\t}\n\n\t\t\t\tfingerprints.forEach(fp => this.seenAlerts.delete(fp));\n\n\t\t\t\tconst storageKey = 'notificator_seen_alerts_' + userID;\n\t\t\t\tconst stored = localStorage.getItem(storageKey);\n\t\t\t\tif (!stored) {\n\t\t\t\t\treturn;\n\t\t\t\t}\n\n\t\t\t\ttry {\n\t\t\t\t\tconst seenData = JSON.parse(stored);\n\t\t\t\t\tconst filtered = seenData.filter(item => !fingerprints.includes(item.fingerprint));\n\t\t\t\t\tlocalStorage.setItem(storageKey, JSON.stringify(filtered));\n\t\t\t\t\tconsole.log('Forgot', seenData.length - filtered.length, 'resolved alert(s) from seen set');\n\t\t\t\t} catch (e) {\n\t\t\t\t\tconsole.error('Failed to parse seen alerts while forgetting resolved alerts:', e);\n\t\t\t\t}\n\t\t\t},\n\n\t\t\t// Forget alerts whose acknowledgment lapsed after asking to be renotified,\n\t\t\t// so processNewAlerts notifies about them again. Lapses from before this\n\t\t\t// page loaded are left alone.\n\t\t\tforgetLapsedAcknowledgments(alerts, userID) {\n\t\t\t\tconst lapsed = alerts.filter(alert => {\n\t\t\t\t\tconst lapsedAt = alert.acknowledgmentLapsedAt ? Date.parse(alert.acknowledgmentLapsedAt) : 0;\n\t\t\t\t\tif (!lapsedAt || lapsedAt <= this.loadedAt || this.handledAckLapses[alert.fingerprint] === lapsedAt) {\n\t\t\t\t\t\treturn false;\n\t\t\t\t\t}\n\t\t\t\t\tthis.handledAckLapses[alert.fingerprint] = lapsedAt;\n\t\t\t\t\treturn true;\n\t\t\t\t});\n\t\t\t\tthis.forgetAlerts(lapsed.map(alert => alert.fingerprint), userID);\n\t\t\t},\n\n\t\t\t// Detect new alerts (not in seen set)\n\t\t\tdetectNewAlerts(alerts) {\n\t\t\t\treturn alerts.filter(alert => !this.seenAlerts.has(alert.fingerprint));\n\t\t\t},\n\n\t\t\t// Check if we should notify for this alert\n\t\t\tshouldNotify(alert) {\n\t\t\t\t// Check if notifications are enabled\n\t\t\t\tif (!this.preferences.browserNotificationsEnabled) {\n\t\t\t\t\treturn false;\n\t\t\t\t}\n\n\t\t\t\t// Check if browser permission granted\n\t\t\t\tif (!this.permissionGranted) {\n\t\t\t\t\treturn false;\n\t\t\t\t}\n\n\t\t\t\t// Check if severity is enabled\n\t\t\t\tconst severity = alert.severity || alert.labels?.severity || 'info';\n\t\t\t\tconst normalizedSeverity = severity.toLowerCase();\n\n\t\t\t\t// Handle 'information' as 'info'\n\t\t\t\tlet severityToCheck = normalizedSeverity === 'information' ? 'info' : normalizedSeverity;\n\n\t\t\t\t// Handle 'critical-daytime' as 'critical'\n\t\t\t\tif (severityToCheck === 'critical-daytime') {\n\t\t\t\t\tseverityToCheck = 'critical';\n\t\t\t\t}\n\n\t\t\t\tif (!this.preferences.enabledSeverities.includes(severityToCheck)) {\n\t\t\t\t\treturn false;\n\t\t\t\t}\n\n\t\t\t\t// Alerts still show in the table during do-not-disturb, they just don't notify\n\t\t\t\tif (this.isDoNotDisturbActive() && !(this.preferences.dnd.allow_critical && severityToCheck === 'critical')) {\n\t\t\t\t\treturn false;\n\t\t\t\t}\n\n\t\t\t\treturn true;\n\t\t\t},\n\n\t\t\t// Whether the do-not-disturb schedule mutes notifications at `now`. The\n\t\t\t// window starts on each listed day (0 = Sunday) at start_time and ends at\n\t\t\t// end_time in the schedule's timezone, on the next day when end <= start.\n\t\t\tisDoNotDisturbActive(now = new Date()) {\n\t\t\t\tconst dnd = this.preferences.dnd;\n\t\t\t\tif (!dnd || !dnd.enabled || !Array.isArray(dnd.days) || dnd.days.length === 0) {\n\t\t\t\t\treturn false;\n\t\t\t\t}\n\n\t\t\t\tconst toMinutes = (value) => {\n\t\t\t\t\tconst match = /^(\\d{2}):(\\d{2})$/.exec(value || '');\n\t\t\t\t\treturn match ? Number(match[1]) * 60 + Number(match[2]) : null;\n\t\t\t\t};\n\t\t\t\tconst start = toMinutes(dnd.start_time);\n\t\t\t\tconst end = toMinutes(dnd.end_time);\n\t\t\t\tif (start === null || end === null || start === end) {\n\t\t\t\t\treturn false;\n\t\t\t\t}\n\n\t\t\t\tconst local = this.zonedDayAndMinutes(now, dnd.timezone || 'UTC');\n\t\t\t\tconst days = dnd.days.map(Number);\n\t\t\t\tif (start < end) {\n\t\t\t\t\treturn days.includes(local.day) && local.minutes >= start && local.minutes < end;\n\t\t\t\t}\n\t\t\t\t// Overnight window: the evening part belongs to today, the morning part to yesterday\n\t\t\t\tif (local.minutes >= start) {\n\t\t\t\t\treturn days.includes(local.day);\n\t\t\t\t}\n\t\t\t\treturn local.minutes < end && days.includes((local.day + 6) % 7);\n\t\t\t},\n\n\t\t\t// Weekday (0 = Sunday) and minutes since midnight of `date` in an IANA timezone\n\t\t\tzonedDayAndMinutes(date, timeZone) {\n\t\t\t\tlet parts;\n\t\t\t\ttry {\n\t\t\t\t\tparts = new Intl.DateTimeFormat('en-US', {\n\t\t\t\t\t\ttimeZone: timeZone, weekday: 'short', hour: '2-digit', minute: '2-digit', hourCycle: 'h23'\n\t\t\t\t\t}).formatToParts(date);\n\t\t\t\t} catch (e) {\n\t\t\t\t\tconsole.warn('Invalid do-not-disturb timezone, using local time:', timeZone);\n\t\t\t\t\treturn { day: date.getDay(), minutes: date.getHours() * 60 + date.getMinutes() };\n\t\t\t\t}\n\t\t\t\tconst get = (type) => parts.find(p => p.type === type).value;\n\t\t\t\tconst weekdays = ['Sun', 'Mon', 'Tue', 'Wed', 'Thu', 'Fri', 'Sat'];\n\t\t\t\treturn {\n\t\t\t\t\tday: weekdays.indexOf(get('weekday')),\n\t\t\t\t\tminutes: Number(get('hour')) * 60 + Number(get('minute'))\n\t\t\t\t};\n\t\t\t},\n\n\t\t\t// Get notification icon based on severity\n\t\t\tgetNotificationIcon(severity) {\n\t\t\t\tconst severityLower = severity.toLowerCase();\n\t\t\t\tconst iconMap = {\n\t\t\t\t\t'critical': '/static/images/critical-icon.png',\n\t\t\t\t\t'critical-daytime': '/static/images/warning-icon.png',\n\t\t\t\t\t'warning': '/static/images/warning-icon.png',\n\t\t\t\t\t'info': '/static/images/info-icon.png',\n\t\t\t\t\t'information': '/static/images/info-icon.png',\n\t\t\t\t\t'success': '/static/images/success-icon.png'\n\t\t\t\t};\n\t\t\t\treturn iconMap[severityLower] || '/static/images/default-icon.png';\n\t\t\t},\n\n\t\t\t// Get notification sound based on severity\n\t\t\tgetNotificationSound(severity) {\n\t\t\t\tconst severityLower = severity.toLowerCase();\n\t\t\t\tconst soundMap = {\n\t\t\t\t\t'critical': '/static/sounds/critical.mp3',\n\t\t\t\t\t'critical-daytime': '/static/sounds/warning.mp3',\n\t\t\t\t\t'warning': '/static/sounds/warning.mp3',\n\t\t\t\t\t'info': '/static/sounds/info.mp3',\n\t\t\t\t\t'information': '/static/sounds/info.mp3'\n\t\t\t\t};\n\t\t\t\treturn soundMap[severityLower] || '/static/sounds/info.mp3';\n\t\t\t},\n\n\t\t\t// Play notification sound\n\t\t\tplayNotificationSound(severity) {\n\t\t\t\t// Check if sounds are enabled\n\t\t\t\tif (!this.preferences.soundNotificationsEnabled) {\n\t\t\t\t\treturn;\n\t\t\t\t}\n\n\t\t\t\ttry {\n\t\t\t\t\tconst soundFile = this.getNotificationSound(severity);\n\t\t\t\t\tconst audio = new Audio(soundFile);\n\t\t\t\t\taudio.volume = 0.7; // Fixed volume at 70%\n\n\t\t\t\t\t// Play with error handling\n\t\t\t\t\taudio.play().catch(err => {\n\t\t\t\t\t\t// Browsers may block autoplay - this is expected\n\t\t\t\t\t\tconsole.warn('Could not play notification sound (may be blocked by browser):', err.message);\n\t\t\t\t\t});\n\t\t\t\t} catch (error) {\n\t\t\t\t\tconsole.error('Error creating audio for notification sound:', error);\n\t\t\t\t}\n\t\t\t},\n\n\t\t\t// Show browser notification (with rate limiting)\n\t\t\tshowNotification(alert) {\n\t\t\t\tif (!this.shouldNotify(alert)) {\n\t\t\t\t\treturn;\n\t\t\t\t}\n\n\t\t\t\t// Check if we can show notification (rate limit: max 5 per minute)\n\t\t\t\tif (this.canShowNotification()) {\n\t\t\t\t\t// Show immediately\n\t\t\t\t\tthis.showNotificationImmediate(alert);\n\t\t\t\t} else {\n\t\t\t\t\t// Add to queue\n\t\t\t\t\tconsole.log('Rate limit reached, queuing notification for:', alert.alertName || alert.fingerprint);\n\t\t\t\t\tthis.notificationQueue.push(alert);\n\n\t\t\t\t\t// Start processing queue if not already running\n\t\t\t\t\tsetTimeout(() => this.processNotificationQueue(), 10000);\n\t\t\t\t}\n\t\t\t},\n\n\t\t\t// Check if an alert is in the user's GLOBAL Hidden Alerts list (settings modal, not a\n\t\t\t// preset-scoped filterHiddenAlerts). That list is loaded client-side into the settings\n\t\t\t// modal's Alpine component (window.currentSettingsModal.hiddenAlerts) on page init.\n\t\t\tisGloballyHidden(alert) {\n\t\t\t\tconst hiddenAlerts = window.currentSettingsModal?.hiddenAlerts;\n\t\t\t\tif (!hiddenAlerts || hiddenAlerts.length === 0) {\n\t\t\t\t\treturn false;\n\t\t\t\t}\n\t\t\t\treturn !!window.currentSettingsModal.activeHiddenAlert(alert.fingerprint);\n\t\t\t},\n\n\t\t\t// Check if an alert matches the current filters\n\t\t\talertMatchesFilters(alert, filters) {\n\t\t\t\tif (!filters) {\n\t\t\t\t\treturn true;\n\t\t\t\t}\n\n\t\t\t\t// Check alertmanager filter\n\t\t\t\tif (filters.alertmanagers && filters.alertmanagers.length > 0) {\n\t\t\t\t\tif (!filters.alertmanagers.includes(alert.source)) {\n\t\t\t\t\t\treturn false;\n\t\t\t\t\t}\n\t\t\t\t}\n\n\t\t\t\t// Check severity filter\n\t\t\t\tif (filters.severities && filters.severities.length > 0) {\n\t\t\t\t\tconst alertSeverity = (alert.severity || '').toLowerCase();\n\t\t\t\t\tconst matchesSeverity = filters.severities.some(s => s.toLowerCase() === alertSeverity);\n\t\t\t\t\tif (!matchesSeverity) {\n\t\t\t\t\t\treturn false;\n\t\t\t\t\t}\n\t\t\t\t}\n\n\t\t\t\t// Check status filter\n\t\t\t\tif (filters.statuses && filters.statuses.length > 0) {\n\t\t\t\t\tconst alertStatus = (alert.status?.state || alert.status || '').toLowerCase();\n\t\t\t\t\tconst matchesStatus = filters.statuses.some(s => s.toLowerCase() === alertStatus);\n\t\t\t\t\tif (!matchesStatus) {\n\t\t\t\t\t\treturn false;\n\t\t\t\t\t}\n\t\t\t\t}\n\n\t\t\t\t// Check team filter\n\t\t\t\tif (filters.teams && filters.teams.length > 0) {\n\t\t\t\t\tconst alertTeam = alert.team || alert.labels?.team || '';\n\t\t\t\t\tif (!filters.teams.includes(alertTeam)) {\n\t\t\t\t\t\treturn false;\n\t\t\t\t\t}\n\t\t\t\t}\n\n\t\t\t\t// Check alertName filter\n\t\t\t\tif (filters.alertNames && filters.alertNames.length > 0) {\n\t\t\t\t\tif (!filters.alertNames.includes(alert.alertName)) {\n\t\t\t\t\t\treturn false;\n\t\t\t\t\t}\n\t\t\t\t}\n\n\t\t\t\treturn true;\n\t\t\t},\n\n\t\t\t// Process new alerts and show notifications\n\t\t\tprocessNewAlerts(allAlerts, currentFilters, userID) {\n\t\t\t\t// Skip if userID is not available (user not logged in or profile not loaded)\n\t\t\t\tif (!userID) {\n\t\t\t\t\treturn;\n\t\t\t\t}\n\n\t\t\t\t// Skip notification processing if seenAlerts hasn't been properly initialized\n\t\t\t\t// This prevents race conditions during page load where SSE updates arrive\n\t\t\t\t// before the dashboard has initialized the seen alerts set\n\t\t\t\tif (!this.seenAlertsInitialized) {\n\t\t\t\t\treturn;\n\t\t\t\t}\n\n\t\t\t\t// Detect which alerts are new\n\t\t\t\tconst newAlerts = this.detectNewAlerts(allAlerts);\n\n\t\t\t\tif (newAlerts.length === 0) {\n\t\t\t\t\treturn;\n\t\t\t\t}\n\n\t\t\t\t// Filter alerts based on user's current filters\n\t\t\t\tconst filteredNewAlerts = newAlerts.filter(alert => {\n\t\t\t\t\tif (this.isGloballyHidden(alert)) {\n\t\t\t\t\t\treturn false;\n\t\t\t\t\t}\n\t\t\t\t\treturn this.alertMatchesFilters(alert, currentFilters);\n\t\t\t\t});\n\n\t\t\t\tif (filteredNewAlerts.length === 0) {\n\t\t\t\t\t// Still mark all as seen to avoid re-notifying when filter changes\n\t\t\t\t\tconst newFingerprints = newAlerts.map(a => a.fingerprint);\n\t\t\t\t\tthis.markAsSeen(newFingerprints, userID);\n\t\t\t\t\treturn;\n\t\t\t\t}\n\n\t\t\t\t// Show notifications for filtered new alerts with staggered delay to avoid browser spam\n\t\t\t\tfilteredNewAlerts.forEach((alert, index) => {\n\t\t\t\t\tsetTimeout(() => {\n\t\t\t\t\t\tthis.showNotification(alert);\n\t\t\t\t\t}, index * 500); // 500ms stagger between each notification\n\t\t\t\t});\n\n\t\t\t\t// Mark ALL new alerts as seen (not just filtered) to avoid re-notifying when filter changes\n\t\t\t\tconst newFingerprints = newAlerts.map(a => a.fingerprint);\n\t\t\t\tthis.markAsSeen(newFingerprints, userID);\n\t\t\t},\n\n\t\t\t// Check if we can show a notification (rate limiting: max 5 per minute)\n\t\t\tcanShowNotification() {\n\t\t\t\tconst now = Date.now();\n\t\t\t\tconst oneMinute = 60 * 1000;\n\n\t\t\t\t// Remove timestamps older than 1 minute\n\t\t\t\tthis.notificationTimestamps = this.notificationTimestamps.filter(timestamp => {\n\t\t\t\t\treturn (now - timestamp) < oneMinute;\n\t\t\t\t});\n\n\t\t\t\t// Check if we're under the limit\n\t\t\t\treturn this.notificationTimestamps.length < 5;\n\t\t\t},\n\n\t\t\t// Record that a notification was shown\n\t\t\trecordNotification() {\n\t\t\t\tthis.notificationTimestamps.push(Date.now());\n\t\t\t},\n\n\t\t\t// Process queued notifications (called periodically)\n\t\t\tprocessNotificationQueue() {\n\t\t\t\tif (this.notificationQueue.length === 0) {\n\t\t\t\t\treturn;\n\t\t\t\t}\n\n\t\t\t\twhile (this.notificationQueue.length > 0 && this.canShowNotification()) {\n\t\t\t\t\tconst alert = this.notificationQueue.shift();\n\t\t\t\t\tthis.showNotificationImmediate(alert);\n\t\t\t\t}\n\n\t\t\t\t// If there are still queued notifications, check again in 10 seconds\n\t\t\t\tif (this.notificationQueue.length > 0) {\n\t\t\t\t\tsetTimeout(() => this.processNotificationQueue(), 10000);\n\t\t\t\t}\n\t\t\t},\n\n\t\t\t// Show notification immediately (used internally, bypasses rate limit check)\n\t\t\tshowNotificationImmediate(alert) {\n\t\t\t\t// Record that we're showing a notification\n\t\t\t\tthis.recordNotification();\n\n\t\t\t\t// Call the original showNotification logic\n\t\t\t\tif (!this.shouldNotify(alert)) {\n\t\t\t\t\treturn;\n\t\t\t\t}\n\n\t\t\t\tconst alertName = alert.alertName || alert.labels?.alertname || 'Alert';\n\t\t\t\tconst summary = alert.summary || alert.annotations?.summary || '';\n\t\t\t\tconst severity = alert.severity || alert.labels?.severity || 'info';\n\t\t\t\tconst source = alert.source || '';\n\t\t\t\tconst fingerprint = alert.fingerprint;\n\n\t\t\t\tif (!fingerprint) {\n\t\t\t\t\tconsole.error('Cannot show notification: alert fingerprint is missing', alert);\n\t\t\t\t\treturn;\n\t\t\t\t}\n\n\t\t\t\tthis.playNotificationSound(severity);\n\n\t\t\t\tconst title = `Alert: ${alertName}`;\n\t\t\t\tconst body = summary || `${severity.toUpperCase()} alert from ${source}`;\n\n\t\t\t\tconst options = {\n\t\t\t\t\tbody: body,\n\t\t\t\t\ticon: this.getNotificationIcon(severity),\n\t\t\t\t\tbadge: '/static/images/default-icon.png',\n\t\t\t\t\ttag: fingerprint,\n\t\t\t\t\trequireInteraction: ['critical', 'critical-daytime'].includes(severity.toLowerCase()),\n\t\t\t\t\tdata: {\n\t\t\t\t\t\tfingerprint: fingerprint,\n\t\t\t\t\t\talertName: alertName\n\t\t\t\t\t}\n\t\t\t\t};\n\n\t\t\t\ttry {\n\t\t\t\t\tconst notification = new Notification(title, options);\n\n\t\t\t\t\tnotification.onclick = () => {\n\t\t\t\t\t\twindow.focus();\n\n\t\t\t\t\t\tif (!fingerprint) {\n\t\t\t\t\t\t\tconsole.error('Cannot navigate: fingerprint is missing');\n\t\t\t\t\t\t\treturn;\n\t\t\t\t\t\t}\n\n\t\t\t\t\t\tconsole.log('Notification clicked, navigating to alert:', fingerprint);\n\n\t\t\t\t\t\tif (window.location.pathname.startsWith('/dashboard')) {\n\t\t\t\t\t\t\tif (window.dashboardInstance && window.dashboardInstance.showAlertDetails) {\n\t\t\t\t\t\t\t\twindow.dashboardInstance.showAlertDetails(fingerprint);\n\t\t\t\t\t\t\t} else {\n\t\t\t\t\t\t\t\twindow.location.href = `/dashboard/alert/${fingerprint}`;\n\t\t\t\t\t\t\t}\n\t\t\t\t\t\t} else {\n\t\t\t\t\t\t\twindow.location.href = `/dashboard/alert/${fingerprint}`;\n\t\t\t\t\t\t}\n\n\t\t\t\t\t\tnotification.close();\n\t\t\t\t\t};\n\n\t\t\t\t\tconsole.log('Showed notification for alert:', alertName, 'fingerprint:', fingerprint);\n\t\t\t\t} catch (error) {\n\t\t\t\t\tconsole.error('Failed to show notification:', error);\n\t\t\t\t}\n\t\t\t}\n\t\t};\n\n\t\t// Make it globally available\n\t\twindow.notificationService = window.NotificationService;\n\t</script>")
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}